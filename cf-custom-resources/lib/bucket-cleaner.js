@@ -132,6 +132,11 @@ exports.handler = async function (event, context) {
       case "Update":
         break;
       case "Delete":
+        // Objects under an object lock retention can't be deleted, so the bucket is retained with its objects instead.
+        if (props.ObjectLockEnabled === "true") {
+          console.log(`Skip emptying bucket ${props.BucketName} with object lock enabled.`);
+          break;
+        }
         await cleanBucket(props.BucketName);
         break;
       default:
@@ -0,0 +1,124 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+"use strict";
+
+const { CodePipelineClient, GetPipelineExecutionCommand } = require("@aws-sdk/client-codepipeline");
+const { SecretsManagerClient, GetSecretValueCommand } = require("@aws-sdk/client-secrets-manager");
+
+const commitStates = { STARTED: "pending", SUCCEEDED: "success", FAILED: "failure", STOPPED: "error", CANCELED: "error" };
+const deploymentStates = { STARTED: "in_progress", SUCCEEDED: "success", FAILED: "failure", STOPPED: "error", CANCELED: "error" };
+const deployStagePrefix = "DeployTo-";
+
+// This is used for test purposes only
+let defaultGitHubAPIURL = "https://api.github.com";
+
+/**
+ * Call the GitHub REST API of the repository.
+ *
+ * @param {string} token GitHub access token
+ * @param {string} method HTTP method of the request
+ * @param {string} path path of the request under the repository
+ * @param {object} [body] request body
+ * @returns {Promise} Promise that is resolved with the parsed response body, or rejected on connection error or HTTP error response
+ */
+const github = function (token, method, path, body) {
+  return new Promise((resolve, reject) => {
+    const https = require("https");
+    const { URL } = require("url");
+
+    const payload = body && JSON.stringify(body);
+    const parsedUrl = new URL(`${defaultGitHubAPIURL}/repos/${process.env.REPOSITORY}${path}`);
+    const headers = {
+      Authorization: `Bearer ${token}`,
+      Accept: "application/vnd.github+json",
+      "User-Agent": "copilot-pipeline",
+    };
+    if (payload) {
+      headers["Content-Type"] = "application/json";
+      headers["Content-Length"] = Buffer.byteLength(payload);
+    }
+    const req = https
+      .request({
+        hostname: parsedUrl.hostname,
+        port: 443,
+        path: parsedUrl.pathname + parsedUrl.search,
+        method,
+        headers,
+      })
+      .on("error", reject)
+      .on("response", (res) => {
+        let data = "";
+        res.on("data", (chunk) => (data += chunk));
+        res.on("end", () => {
+          if (res.statusCode >= 400) {
+            reject(new Error(`${method} ${path}: ${res.statusCode} ${data}`));
+            return;
+          }
+          resolve(data ? JSON.parse(data) : {});
+        });
+      });
+    if (payload) {
+      req.write(payload);
+    }
+    req.end();
+  });
+};
+
+/**
+ * Reports the state change of a pipeline stage as a GitHub commit status of the source revision,
+ * and as a GitHub deployment status for deployment stages if deployments are enabled.
+ *
+ * @param {object} event the "CodePipeline Stage Execution State Change" EventBridge event
+ */
+exports.handler = async function (event) {
+  const { pipeline, stage, state } = event.detail;
+  const executionId = event.detail["execution-id"];
+  if (!commitStates[state]) {
+    return;
+  }
+  const out = await new CodePipelineClient().send(
+    new GetPipelineExecutionCommand({ pipelineName: pipeline, pipelineExecutionId: executionId })
+  );
+  const revisions = out.pipelineExecution.artifactRevisions || [];
+  if (revisions.length === 0) {
+    return;
+  }
+  const sha = revisions[0].revisionId;
+  const secret = await new SecretsManagerClient().send(
+    new GetSecretValueCommand({ SecretId: process.env.TOKEN_SECRET })
+  );
+  const token = secret.SecretString;
+  const url = `https://${event.region}.console.aws.amazon.com/codesuite/codepipeline/pipelines/${pipeline}/executions/${executionId}/timeline`;
+  await github(token, "POST", `/statuses/${sha}`, {
+    state: commitStates[state],
+    target_url: url,
+    context: `copilot/${pipeline}/${stage}`,
+    description: `Stage ${stage} ${state.toLowerCase()}`,
+  });
+  if (process.env.DEPLOYMENTS !== "true" || !stage.startsWith(deployStagePrefix)) {
+    return;
+  }
+  const environment = stage.slice(deployStagePrefix.length);
+  let deployment;
+  if (state === "STARTED") {
+    deployment = await github(token, "POST", "/deployments", {
+      ref: sha,
+      environment,
+      auto_merge: false,
+      required_contexts: [],
+      description: `Deployed by ${pipeline}`,
+    });
+  } else {
+    [deployment] = await github(token, "GET", `/deployments?sha=${sha}&environment=${encodeURIComponent(environment)}`);
+  }
+  if (deployment) {
+    await github(token, "POST", `/deployments/${deployment.id}/statuses`, {
+      state: deploymentStates[state],
+      log_url: url,
+    });
+  }
+};
+
+exports.withDefaultGitHubAPIURL = function (url) {
+  defaultGitHubAPIURL = url;
+};
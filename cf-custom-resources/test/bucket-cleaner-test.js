@@ -103,6 +103,38 @@ describe("Bucket Cleaner", () => {
       });
   });
 
+  test("Skip emptying the bucket when object lock is enabled", () => {
+    const headBucketFake = sinon.fake.resolves({});
+    s3Mock.on(s3.HeadBucketCommand).callsFake(headBucketFake);
+    const listObjectVersionsFake = sinon.fake.resolves({});
+    s3Mock.on(s3.ListObjectVersionsCommand).callsFake(listObjectVersionsFake);
+
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return (
+          body.Status === "SUCCESS" &&
+          body.PhysicalResourceId === "bucket-cleaner-mockID"
+        );
+      })
+      .reply(200);
+
+    return LambdaTester(bucketCleanerHandler.handler)
+      .event({
+        RequestType: "Delete",
+        RequestId: testRequestId,
+        ResourceProperties: {
+          BucketName: testBucketName,
+          ObjectLockEnabled: "true"
+        },
+        LogicalResourceId: "mockID",
+      })
+      .expectResolve(() => {
+        sinon.assert.notCalled(headBucketFake);
+        sinon.assert.notCalled(listObjectVersionsFake);
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
   test("Return early when the bucket is gone", () => {
     const notFoundError = new Error();
     notFoundError.name = "ResourceNotFoundException";
@@ -0,0 +1,155 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+"use strict";
+
+describe("GitHub Status", () => {
+  const codepipeline = require("@aws-sdk/client-codepipeline");
+  const secretsmanager = require("@aws-sdk/client-secrets-manager");
+  const { mockClient } = require("aws-sdk-client-mock");
+  const LambdaTester = require("lambda-tester").noVersionCheck();
+  const sinon = require("sinon");
+  const githubStatusHandler = require("../lib/github-status");
+  const nock = require("nock");
+  const GitHubAPIURL = "https://api.github.example.com";
+
+  const codepipelineMock = mockClient(codepipeline.CodePipelineClient);
+  const secretsManagerMock = mockClient(secretsmanager.SecretsManagerClient);
+
+  const testSha = "0123456789abcdef";
+  const event = (stage, state) => ({
+    region: "us-west-2",
+    detail: {
+      pipeline: "pipeline-phonetool-web",
+      "execution-id": "exec-id",
+      stage,
+      state,
+    },
+  });
+
+  beforeEach(() => {
+    githubStatusHandler.withDefaultGitHubAPIURL(GitHubAPIURL);
+    process.env.REPOSITORY = "aws/phonetool";
+    process.env.TOKEN_SECRET = "github-token";
+    process.env.DEPLOYMENTS = "false";
+    codepipelineMock.reset();
+    secretsManagerMock.reset();
+    codepipelineMock.on(codepipeline.GetPipelineExecutionCommand).resolves({
+      pipelineExecution: {
+        artifactRevisions: [{ revisionId: testSha }],
+      },
+    });
+    secretsManagerMock.on(secretsmanager.GetSecretValueCommand).resolves({
+      SecretString: "token",
+    });
+  });
+  afterEach(() => {
+    nock.cleanAll();
+  });
+
+  test("ignores states that are not reported", () => {
+    const getExecutionFake = sinon.fake.resolves({});
+    codepipelineMock.on(codepipeline.GetPipelineExecutionCommand).callsFake(getExecutionFake);
+
+    return LambdaTester(githubStatusHandler.handler)
+      .event(event("Build", "RESUMED"))
+      .expectResolve(() => {
+        sinon.assert.notCalled(getExecutionFake);
+      });
+  });
+
+  test("does not report executions without a source revision", () => {
+    codepipelineMock.on(codepipeline.GetPipelineExecutionCommand).resolves({
+      pipelineExecution: { artifactRevisions: [] },
+    });
+    const getSecretFake = sinon.fake.resolves({});
+    secretsManagerMock.on(secretsmanager.GetSecretValueCommand).callsFake(getSecretFake);
+
+    return LambdaTester(githubStatusHandler.handler)
+      .event(event("Build", "STARTED"))
+      .expectResolve(() => {
+        sinon.assert.notCalled(getSecretFake);
+      });
+  });
+
+  test("reports the stage as a commit status", () => {
+    const request = nock(GitHubAPIURL, { reqheaders: { authorization: "Bearer token" } })
+      .post(`/repos/aws/phonetool/statuses/${testSha}`, (body) => {
+        return (
+          body.state === "success" &&
+          body.context === "copilot/pipeline-phonetool-web/Build" &&
+          body.description === "Stage Build succeeded" &&
+          body.target_url ===
+            "https://us-west-2.console.aws.amazon.com/codesuite/codepipeline/pipelines/pipeline-phonetool-web/executions/exec-id/timeline"
+        );
+      })
+      .reply(201, {});
+
+    return LambdaTester(githubStatusHandler.handler)
+      .event(event("Build", "SUCCEEDED"))
+      .expectResolve(() => {
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
+  test("does not create deployments if they are disabled", () => {
+    const request = nock(GitHubAPIURL)
+      .post(`/repos/aws/phonetool/statuses/${testSha}`)
+      .reply(201, {});
+
+    return LambdaTester(githubStatusHandler.handler)
+      .event(event("DeployTo-test", "STARTED"))
+      .expectResolve(() => {
+        expect(request.isDone()).toBe(true);
+        expect(nock.pendingMocks()).toEqual([]);
+      });
+  });
+
+  test("creates a deployment when a deployment stage starts", () => {
+    process.env.DEPLOYMENTS = "true";
+    const request = nock(GitHubAPIURL)
+      .post(`/repos/aws/phonetool/statuses/${testSha}`, (body) => body.state === "pending")
+      .reply(201, {})
+      .post("/repos/aws/phonetool/deployments", (body) => {
+        return body.ref === testSha && body.environment === "test";
+      })
+      .reply(201, { id: 42 })
+      .post("/repos/aws/phonetool/deployments/42/statuses", (body) => body.state === "in_progress")
+      .reply(201, {});
+
+    return LambdaTester(githubStatusHandler.handler)
+      .event(event("DeployTo-test", "STARTED"))
+      .expectResolve(() => {
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
+  test("updates the existing deployment when a deployment stage fails", () => {
+    process.env.DEPLOYMENTS = "true";
+    const request = nock(GitHubAPIURL)
+      .post(`/repos/aws/phonetool/statuses/${testSha}`, (body) => body.state === "failure")
+      .reply(201, {})
+      .get("/repos/aws/phonetool/deployments")
+      .query({ sha: testSha, environment: "test" })
+      .reply(200, [{ id: 42 }])
+      .post("/repos/aws/phonetool/deployments/42/statuses", (body) => body.state === "failure")
+      .reply(201, {});
+
+    return LambdaTester(githubStatusHandler.handler)
+      .event(event("DeployTo-test", "FAILED"))
+      .expectResolve(() => {
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
+  test("fails if GitHub rejects the request", () => {
+    nock(GitHubAPIURL)
+      .post(`/repos/aws/phonetool/statuses/${testSha}`)
+      .reply(401, "Bad credentials");
+
+    return LambdaTester(githubStatusHandler.handler)
+      .event(event("Build", "FAILED"))
+      .expectReject((err) => {
+        expect(err.message).toEqual(`POST /statuses/${testSha}: 401 Bad credentials`);
+      });
+  });
+});
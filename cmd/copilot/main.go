@@ -7,6 +7,7 @@ package main
 import (
 	"errors"
 	"os"
+	"time"
 
 	"github.com/aws/copilot-cli/cmd/copilot/template"
 	"github.com/aws/copilot-cli/internal/pkg/cli"
@@ -31,7 +32,18 @@ func init() {
 
 func main() {
 	cmd := buildRootCmd()
-	if err := cmd.Execute(); err != nil {
+	if ran, err := cli.RunPlugin(cmd, os.Args[1:]); ran || err != nil {
+		exitOnError(err)
+		return
+	}
+	start := time.Now()
+	executed, err := cmd.ExecuteC()
+	cli.RecordAudit(executed, start, err)
+	exitOnError(err)
+}
+
+func exitOnError(err error) {
+	if err != nil {
 		var ac actionRecommender
 		var exitCodeErr exitCodeError
 
@@ -80,20 +92,28 @@ func buildRootCmd() *cobra.Command {
 	cmd.AddCommand(cli.BuildEnvCmd())
 	cmd.AddCommand(cli.BuildSvcCmd())
 	cmd.AddCommand(cli.BuildJobCmd())
+	cmd.AddCommand(cli.BuildPreviewCmd())
 	cmd.AddCommand(cli.BuildTaskCmd())
+	cmd.AddCommand(cli.BuildECSCmd())
 	cmd.AddCommand(cli.BuildRunLocalCmd())
+	cmd.AddCommand(cli.BuildImageCmd())
 
 	// "Extend" command group
 	cmd.AddCommand(cli.BuildStorageCmd())
 	cmd.AddCommand(cli.BuildSecretCmd())
+	cmd.AddCommand(cli.BuildPluginCmd())
 
 	// "Settings" command group.
 	cmd.AddCommand(cli.BuildVersionCmd())
+	cmd.AddCommand(cli.BuildAuditCmd())
+	cmd.AddCommand(cli.BuildIAMCmd())
 	cmd.AddCommand(cli.BuildCompletionCmd(cmd))
 
 	// "Release" command group.
 	cmd.AddCommand(cli.BuildPipelineCmd())
 	cmd.AddCommand(cli.BuildDeployCmd())
+	cmd.AddCommand(cli.BuildValidateCmd())
+	cmd.AddCommand(cli.BuildReleaseCmd())
 
 	cmd.SetUsageTemplate(template.RootUsage)
 	return cmd
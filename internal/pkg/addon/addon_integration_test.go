@@ -32,6 +32,19 @@ func TestAddons(t *testing.T) {
 			}),
 			outFileName: "aurora.yml",
 		},
+		"aurora with reader instances and RDS Proxy": {
+			addonMarshaler: addon.WorkloadServerlessV2Template(addon.RDSProps{
+				ClusterName:     "aurora",
+				Engine:          "PostgreSQL",
+				InitialDBName:   "main",
+				Envs:            []string{"test"},
+				MinCapacity:     1,
+				MaxCapacity:     16.5,
+				ReaderInstances: 2,
+				RDSProxy:        true,
+			}),
+			outFileName: "aurora-proxy.yml",
+		},
 		"ddb": {
 			addonMarshaler: addon.WorkloadDDBTemplate(&addon.DynamoDBProps{
 				StorageProps: &addon.StorageProps{
@@ -72,6 +85,19 @@ func TestAddons(t *testing.T) {
 			}),
 			outFileName: "bucket.yml",
 		},
+		"s3 with lifecycle transitions, replication, EventBridge and policy presets": {
+			addonMarshaler: addon.WorkloadS3Template(&addon.S3Props{
+				StorageProps: &addon.StorageProps{
+					Name: "bucket",
+				},
+				TransitionToIADays:      30,
+				TransitionToGlacierDays: 90,
+				ReplicationBucket:       "my-replica-bucket",
+				EventBridge:             true,
+				PolicyPresets:           []string{addon.S3PolicyPresetTLS12, addon.S3PolicyPresetSameAccount},
+			}),
+			outFileName: "bucket-options.yml",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -144,6 +144,22 @@ func TestWorkload_Template(t *testing.T) {
 			},
 			wantedErr: errors.New(`condition "IsProd" defined in "first.yaml" at Ln 28, Col 13 is different than in "invalid-conditions.yaml" at Ln 2, Col 13`),
 		},
+		"returns err on invalid Globals fields": {
+			workloadName: testSvcName,
+			setupMocks: func(m addonMocks) {
+				m.ws.EXPECT().WorkloadAddonsAbsPath(testSvcName).Return("mockPath")
+				m.ws.EXPECT().ListFiles("mockPath").Return([]string{"first.yaml", "invalid-globals.yaml"}, nil)
+
+				first, _ := os.ReadFile(filepath.Join("testdata", "merge", "first.yaml"))
+				m.ws.EXPECT().WorkloadAddonFileAbsPath(testSvcName, "first.yaml").Return("mockPath")
+				m.ws.EXPECT().ReadFile("mockPath").Return(first, nil)
+
+				second, _ := os.ReadFile(filepath.Join("testdata", "merge", "invalid-globals.yaml"))
+				m.ws.EXPECT().WorkloadAddonFileAbsPath(testSvcName, "invalid-globals.yaml").Return("mockPath")
+				m.ws.EXPECT().ReadFile("mockPath").Return(second, nil)
+			},
+			wantedErr: errors.New(`global property "Function.Runtime" defined in "first.yaml" at Ln 91, Col 18 is different than in "invalid-globals.yaml" at Ln 3, Col 14`),
+		},
 		"returns err on invalid Resources fields": {
 			workloadName: testSvcName,
 			setupMocks: func(m addonMocks) {
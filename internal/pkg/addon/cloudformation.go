@@ -19,6 +19,7 @@ const (
 	mappingsSection
 	conditionsSection
 	transformSection
+	globalsSection
 	resourcesSection
 	outputsSection
 )
@@ -30,7 +31,8 @@ type cfnTemplate struct {
 	Mappings   yaml.Node `yaml:"Mappings,omitempty"`
 	Conditions yaml.Node `yaml:"Conditions,omitempty"`
 	Transform  yaml.Node `yaml:"Transform,omitempty"`
-	Resources  yaml.Node `yaml:"Resources"` // Don't omit as this is the only section that's required by CloudFormation.
+	Globals    yaml.Node `yaml:"Globals,omitempty"` // Only valid for templates with the AWS::Serverless transform.
+	Resources  yaml.Node `yaml:"Resources"`         // Don't omit as this is the only section that's required by CloudFormation.
 	Outputs    yaml.Node `yaml:"Outputs,omitempty"`
 
 	name            string
@@ -61,6 +63,9 @@ func (t *cfnTemplate) merge(other *cfnTemplate) error {
 	if err := t.mergeTransform(other.Transform); err != nil {
 		return wrapKeyAlreadyExistsErr(transformSection, t, other, err)
 	}
+	if err := t.mergeGlobals(other.Globals); err != nil {
+		return wrapKeyAlreadyExistsErr(globalsSection, t, other, err)
+	}
 	if err := t.mergeResources(other.Resources); err != nil {
 		return wrapKeyAlreadyExistsErr(resourcesSection, t, other, err)
 	}
@@ -102,6 +107,12 @@ func (t *cfnTemplate) mergeTransform(transform yaml.Node) error {
 	return nil
 }
 
+// mergeGlobals updates t's Globals with additional SAM global properties.
+// If a property for the same resource type already exists with a different value, returns errGlobalsAlreadyExists.
+func (t *cfnTemplate) mergeGlobals(globals yaml.Node) error {
+	return mergeTwoLevelMaps(&t.Globals, &globals)
+}
+
 // mergeResources updates t's Resources with additional resources.
 // If a resource already exists with a different value, returns errResourceAlreadyExists.
 func (t *cfnTemplate) mergeResources(resources yaml.Node) error {
@@ -72,6 +72,15 @@ func (e *errConditionAlreadyExists) Error() string {
 	return fmt.Sprintf(`condition %s`, e.errKeyAlreadyExists.Error())
 }
 
+// errGlobalsAlreadyExists occurs if two addons have the same SAM global property for a resource type but with different values.
+type errGlobalsAlreadyExists struct {
+	*errKeyAlreadyExists
+}
+
+func (e *errGlobalsAlreadyExists) Error() string {
+	return fmt.Sprintf(`global property %s`, e.errKeyAlreadyExists.Error())
+}
+
 // errResourceAlreadyExists occurs if two addons have the same resource under Resources but with different values.
 type errResourceAlreadyExists struct {
 	*errKeyAlreadyExists
@@ -117,6 +126,10 @@ func wrapKeyAlreadyExistsErr(section cfnSection, merged, newTpl *cfnTemplate, er
 		return &errConditionAlreadyExists{
 			keyExistsErr,
 		}
+	case globalsSection:
+		return &errGlobalsAlreadyExists{
+			keyExistsErr,
+		}
 	case resourcesSection:
 		return &errResourceAlreadyExists{
 			keyExistsErr,
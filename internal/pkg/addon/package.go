@@ -147,6 +147,11 @@ var resourcePackageConfig = map[string][]packagePropertyConfig{
 			PropertyPath: []string{"DefinitionUri"},
 		},
 	},
+	"AWS::Serverless::HttpApi": {
+		{
+			PropertyPath: []string{"DefinitionUri"},
+		},
+	},
 	"AWS::ElasticBeanstalk::ApplicationVersion": {
 		{
 			PropertyPath:       []string{"SourceBundle"},
@@ -190,6 +195,28 @@ var resourcePackageConfig = map[string][]packagePropertyConfig{
 	},
 }
 
+// globalsPackageConfig maps a resource type under the SAM "Globals" section to configuration
+// for how to transform its properties. The keys of the map are the names of the resource
+// types without the "AWS::Serverless::" prefix, as they appear in the "Globals" section.
+var globalsPackageConfig = map[string][]packagePropertyConfig{
+	"Function": {
+		{
+			PropertyPath: []string{"CodeUri"},
+			ForceZip:     true,
+		},
+	},
+	"Api": {
+		{
+			PropertyPath: []string{"DefinitionUri"},
+		},
+	},
+	"HttpApi": {
+		{
+			PropertyPath: []string{"DefinitionUri"},
+		},
+	},
+}
+
 // PackageConfig contains data needed to package a Stack.
 type PackageConfig struct {
 	Bucket        string
@@ -222,6 +249,15 @@ func (s *stack) packageAssets(cfg PackageConfig) error {
 		return fmt.Errorf("package transforms: %w", err)
 	}
 
+	// package SAM global properties
+	for resType, node := range mappingNode(&s.template.Globals) {
+		for _, conf := range globalsPackageConfig[resType] {
+			if err := cfg.packageProperty(node, conf); err != nil {
+				return fmt.Errorf("package global property %q of %q: %w", strings.Join(conf.PropertyPath, "."), resType, err)
+			}
+		}
+	}
+
 	// package resources
 	for name, node := range mappingNode(&s.template.Resources) {
 		resType := yamlMapGet(node, "Type").Value
@@ -343,6 +343,46 @@ Outputs:
     Value: !GetAtt bucket.DomainName
   bucket:
     Value: !Ref bucket
+`,
+		},
+		"AWS::Serverless::Function and Globals, zipped directory": {
+			setupMocks: func(m addonMocks) {
+				m.uploader.EXPECT().Upload(bucket, lambdaZipS3Path, gomock.Any()).Return(s3.URL("us-west-2", bucket, "asdf"), nil).Times(2)
+				m.uploader.EXPECT().Upload(bucket, indexFileS3Path, gomock.Any()).Return(s3.URL("us-west-2", bucket, "hjkl"), nil)
+			},
+			inTemplate: `
+Transform: AWS::Serverless-2016-10-31
+Globals:
+  Function:
+    CodeUri: lambda
+    Runtime: nodejs20.x
+Resources:
+  Test:
+    Type: AWS::Serverless::Function
+    Properties:
+      CodeUri: lambda/
+      Handler: "index.handler"
+  TestApi:
+    Type: AWS::Serverless::HttpApi
+    Properties:
+      DefinitionUri: lambda/index.js
+`,
+			outTemplate: `
+Transform: AWS::Serverless-2016-10-31
+Globals:
+  Function:
+    CodeUri: s3://mockBucket/asdf
+    Runtime: nodejs20.x
+Resources:
+  Test:
+    Type: AWS::Serverless::Function
+    Properties:
+      CodeUri: s3://mockBucket/asdf
+      Handler: "index.handler"
+  TestApi:
+    Type: AWS::Serverless::HttpApi
+    Properties:
+      DefinitionUri: s3://mockBucket/hjkl
 `,
 		},
 		"error on file not existing": {
@@ -44,6 +44,21 @@ const (
 	RDSEngineTypePostgreSQL = "PostgreSQL"
 )
 
+// Default engine versions of Aurora Serverless v2 clusters.
+const (
+	DefaultAuroraMySQLEngineVersion      = "8.0.mysql_aurora.3.02.0"
+	DefaultAuroraPostgreSQLEngineVersion = "14.4"
+)
+
+// Presets of statements to add to the policy of an S3 bucket.
+const (
+	S3PolicyPresetTLS12       = "tls-1.2"      // Deny requests that use a TLS version older than 1.2.
+	S3PolicyPresetSameAccount = "same-account" // Deny requests from principals outside of the account.
+)
+
+// S3PolicyPresets are the supported presets of statements for the policy of an S3 bucket.
+var S3PolicyPresets = []string{S3PolicyPresetTLS12, S3PolicyPresetSameAccount}
+
 var regexpMatchAttribute = regexp.MustCompile(`^(\S+):([sbnSBN])`)
 
 var storageTemplateFunctions = map[string]interface{}{
@@ -61,6 +76,22 @@ type StorageProps struct {
 // S3Props contains S3-specific properties.
 type S3Props struct {
 	*StorageProps
+
+	TransitionToIADays      int      // Number of days after which objects move to S3 Standard-IA. Zero to not transition them.
+	TransitionToGlacierDays int      // Number of days after which objects move to S3 Glacier Flexible Retrieval. Zero to not transition them.
+	ReplicationBucket       string   // Name of the bucket in another region to replicate objects to. Empty to not replicate.
+	EventBridge             bool     // Whether to send the notifications of the bucket to Amazon EventBridge.
+	PolicyPresets           []string // Presets of statements to add to the bucket policy.
+}
+
+// HasPolicyPreset returns true if the preset of bucket policy statements is enabled.
+func (p S3Props) HasPolicyPreset(preset string) bool {
+	for _, enabled := range p.PolicyPresets {
+		if enabled == preset {
+			return true
+		}
+	}
+	return false
 }
 
 // WorkloadS3Template creates a marshaler for a workload-level S3 addon.
@@ -273,6 +304,53 @@ type RDSProps struct {
 	InitialDBName  string   // The name of the initial database created inside the cluster.
 	ParameterGroup string   // The parameter group to use for the cluster.
 	Envs           []string // The copilot environments found inside the current app.
+
+	// Aurora Serverless v2 specific properties.
+	EngineVersion   string  // The version of the database engine. Defaults to the version of the engine tested with Copilot.
+	MinCapacity     float64 // The minimum capacity of the cluster in ACUs. Defaults to 0.5.
+	MaxCapacity     float64 // The maximum capacity of the cluster in ACUs. Defaults to 8.
+	ReaderInstances int     // The number of reader instances in addition to the writer instance.
+	RDSProxy        bool    // Whether to create an RDS Proxy in front of the cluster.
+
+	SubnetGroup string // The name of the environment's subnet group to place the cluster in. Defaults to the private subnets.
+}
+
+// SubnetsOutputName returns the name of the environment stack output that holds the subnets to place the cluster in.
+func (p RDSProps) SubnetsOutputName() string {
+	if p.SubnetGroup != "" {
+		return template.SubnetGroupPlacement(p.SubnetGroup)
+	}
+	return "PrivateSubnets"
+}
+
+// EngineVersionOrDefault returns the version of the database engine of an Aurora Serverless v2 cluster.
+func (p RDSProps) EngineVersionOrDefault() string {
+	if p.EngineVersion != "" {
+		return p.EngineVersion
+	}
+	if p.Engine == RDSEngineTypeMySQL {
+		return DefaultAuroraMySQLEngineVersion
+	}
+	return DefaultAuroraPostgreSQLEngineVersion
+}
+
+// ParameterGroupFamily returns the family of the cluster parameter group that matches
+// the engine version of an Aurora Serverless v2 cluster, such as "aurora-postgresql15" for version "15.4".
+func (p RDSProps) ParameterGroupFamily() string {
+	if p.Engine == RDSEngineTypeMySQL {
+		return "aurora-mysql8.0"
+	}
+	major, _, _ := strings.Cut(p.EngineVersionOrDefault(), ".")
+	return "aurora-postgresql" + major
+}
+
+// ReaderInstanceNumbers returns the numbers, starting from 1, of the reader instances of the cluster.
+func (p RDSProps) ReaderInstanceNumbers() []int {
+	nums := make([]int, p.ReaderInstances)
+	for i := range nums {
+		nums[i] = i + 1
+	}
+	return nums
 }
 
 // WorkloadServerlessV1Template creates a marshaler for a workload-level Aurora Serverless v1 addon.
@@ -389,10 +467,12 @@ func RDWSParamsForRDS() *RDSParams {
 }
 
 // EnvParamsForRDS creates a parameter marshaler for an environment-level RDS addon.
-func EnvParamsForRDS() *RDSParams {
+// The cluster is placed in the subnet group if one is specified, otherwise in the private subnets.
+func EnvParamsForRDS(subnetGroup string) *RDSParams {
 	return &RDSParams{
-		parser:   template.New(),
-		tmplPath: envRDSParamsPath,
+		SubnetGroup: subnetGroup,
+		parser:      template.New(),
+		tmplPath:    envRDSParamsPath,
 	}
 }
 
@@ -407,6 +487,8 @@ func RDWSParamsForEnvRDS() *RDSParams {
 
 // RDSParams represents the addons.parameters.yml file for a RDS Aurora Serverless cluster.
 type RDSParams struct {
+	SubnetGroup string // The name of the environment's subnet group that holds the cluster, if any.
+
 	parser   template.Parser
 	tmplPath string
 }
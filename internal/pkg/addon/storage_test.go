@@ -112,6 +112,16 @@ func TestS3Template_MarshalBinary(t *testing.T) {
 	}
 }
 
+func TestS3Props_HasPolicyPreset(t *testing.T) {
+	props := S3Props{
+		PolicyPresets: []string{S3PolicyPresetTLS12},
+	}
+
+	require.True(t, props.HasPolicyPreset(S3PolicyPresetTLS12))
+	require.False(t, props.HasPolicyPreset(S3PolicyPresetSameAccount))
+	require.False(t, S3Props{}.HasPolicyPreset(S3PolicyPresetTLS12))
+}
+
 func TestRDSTemplate_MarshalBinary(t *testing.T) {
 	testCases := map[string]struct {
 		version string
@@ -205,6 +215,47 @@ func TestRDSTemplate_MarshalBinary(t *testing.T) {
 	}
 }
 
+func TestRDSProps_ReaderInstanceNumbers(t *testing.T) {
+	require.Empty(t, RDSProps{}.ReaderInstanceNumbers())
+	require.Equal(t, []int{1, 2, 3}, RDSProps{ReaderInstances: 3}.ReaderInstanceNumbers())
+}
+
+func TestRDSProps_EngineVersion(t *testing.T) {
+	testCases := map[string]struct {
+		in RDSProps
+
+		wantedVersion string
+		wantedFamily  string
+	}{
+		"default mysql version": {
+			in:            RDSProps{Engine: RDSEngineTypeMySQL},
+			wantedVersion: DefaultAuroraMySQLEngineVersion,
+			wantedFamily:  "aurora-mysql8.0",
+		},
+		"default postgresql version": {
+			in:            RDSProps{Engine: RDSEngineTypePostgreSQL},
+			wantedVersion: DefaultAuroraPostgreSQLEngineVersion,
+			wantedFamily:  "aurora-postgresql14",
+		},
+		"custom postgresql version": {
+			in:            RDSProps{Engine: RDSEngineTypePostgreSQL, EngineVersion: "15.4"},
+			wantedVersion: "15.4",
+			wantedFamily:  "aurora-postgresql15",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wantedVersion, tc.in.EngineVersionOrDefault())
+			require.Equal(t, tc.wantedFamily, tc.in.ParameterGroupFamily())
+		})
+	}
+}
+
+func TestRDSProps_SubnetsOutputName(t *testing.T) {
+	require.Equal(t, "PrivateSubnets", RDSProps{}.SubnetsOutputName())
+	require.Equal(t, "SubnetGroupdataSubnets", RDSProps{SubnetGroup: "data"}.SubnetsOutputName())
+}
+
 func TestRDSParams_MarshalBinary(t *testing.T) {
 	testCases := map[string]struct {
 		mockDependencies func(ctrl *gomock.Controller, r *RDSParams)
@@ -574,7 +625,7 @@ func TestConstructors(t *testing.T) {
 	})
 
 	t.Run("parameter marshaler for env-level aurora accessible by a workload", func(t *testing.T) {
-		out := EnvParamsForRDS()
+		out := EnvParamsForRDS("")
 		require.Equal(t, envRDSParamsPath, out.tmplPath)
 	})
 
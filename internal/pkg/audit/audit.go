@@ -0,0 +1,83 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package audit records the mutating commands run with the CLI in an audit log.
+package audit
+
+import (
+	"sync"
+	"time"
+)
+
+// Results of an audited command.
+const (
+	ResultSuccess = "success"
+	ResultFailure = "failure"
+)
+
+// Operations on a CloudFormation stack.
+const (
+	StackOperationDeploy = "deploy"
+	StackOperationDelete = "delete"
+)
+
+// Statuses of an operation on a CloudFormation stack.
+const (
+	StackStatusSucceeded  = "succeeded"
+	StackStatusFailed     = "failed"
+	StackStatusNoChanges  = "no changes"
+	StackStatusInProgress = "in progress"
+)
+
+// Entry is the record of a command in the audit log.
+type Entry struct {
+	Time     time.Time         `json:"time"`
+	Command  string            `json:"command"`
+	Args     []string          `json:"args,omitempty"`
+	Flags    map[string]string `json:"flags,omitempty"`
+	App      string            `json:"app,omitempty"`
+	Caller   string            `json:"caller,omitempty"`
+	Version  string            `json:"version"`
+	Duration string            `json:"duration"`
+	Result   string            `json:"result"`
+	Error    string            `json:"error,omitempty"`
+	Stacks   []StackSummary    `json:"stacks,omitempty"`
+}
+
+// StackSummary is the outcome of an operation on a CloudFormation stack during a command.
+type StackSummary struct {
+	Name      string `json:"name"`
+	Operation string `json:"operation"`
+	Status    string `json:"status"`
+}
+
+var recorder struct {
+	mu     sync.Mutex
+	stacks []StackSummary
+}
+
+// RecordStack records the outcome of an operation on a stack so that it's summarized in the entry of the current command.
+func RecordStack(stack StackSummary) {
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	recorder.stacks = append(recorder.stacks, stack)
+}
+
+// RecordedStacks returns the outcomes of the operations on stacks recorded so far, in order.
+func RecordedStacks() []StackSummary {
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	if len(recorder.stacks) == 0 {
+		return nil
+	}
+	stacks := make([]StackSummary, len(recorder.stacks))
+	copy(stacks, recorder.stacks)
+	return stacks
+}
+
+// ResetRecordedStacks forgets the outcomes of the operations on stacks recorded so far.
+func ResetRecordedStacks() {
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	recorder.stacks = nil
+}
@@ -0,0 +1,47 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordStack(t *testing.T) {
+	// GIVEN
+	ResetRecordedStacks()
+	defer ResetRecordedStacks()
+	require.Nil(t, RecordedStacks())
+
+	// WHEN
+	RecordStack(StackSummary{
+		Name:      "phonetool-test",
+		Operation: StackOperationDeploy,
+		Status:    StackStatusNoChanges,
+	})
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			RecordStack(StackSummary{
+				Name:      "phonetool-test-api",
+				Operation: StackOperationDelete,
+				Status:    StackStatusSucceeded,
+			})
+		}()
+	}
+	wg.Wait()
+
+	// THEN
+	stacks := RecordedStacks()
+	require.Len(t, stacks, 11)
+	require.Equal(t, StackSummary{
+		Name:      "phonetool-test",
+		Operation: StackOperationDeploy,
+		Status:    StackStatusNoChanges,
+	}, stacks[0])
+}
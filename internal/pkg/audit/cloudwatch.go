@@ -0,0 +1,74 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+)
+
+// Entries are put in a log stream per day.
+const logStreamNameFormat = "2006/01/02"
+
+type logClient interface {
+	PutLogEvent(in cloudwatchlogs.PutLogEventInput) error
+	LogEvents(opts cloudwatchlogs.LogEventsOpts) (*cloudwatchlogs.LogEventsOutput, error)
+}
+
+// CloudWatchLog is an audit log shipped to a CloudWatch log group.
+type CloudWatchLog struct {
+	logGroup string
+	client   logClient
+}
+
+// NewCloudWatchLog returns an audit log shipped to the log group.
+func NewCloudWatchLog(client logClient, logGroup string) *CloudWatchLog {
+	return &CloudWatchLog{
+		logGroup: logGroup,
+		client:   client,
+	}
+}
+
+// Write puts the entry in the log stream of the day of the entry, and creates the log group and the log stream if they don't exist.
+func (l *CloudWatchLog) Write(entry Entry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("marshal audit log entry: %w", err)
+	}
+	return l.client.PutLogEvent(cloudwatchlogs.PutLogEventInput{
+		LogGroup:  l.logGroup,
+		LogStream: entry.Time.UTC().Format(logStreamNameFormat),
+		Message:   string(data),
+		Timestamp: entry.Time,
+	})
+}
+
+// Entries returns the last entries of the log group, up to limit, from the oldest to the newest.
+// It returns all the entries if limit is 0.
+func (l *CloudWatchLog) Entries(limit int) ([]Entry, error) {
+	opts := cloudwatchlogs.LogEventsOpts{
+		LogGroup: l.logGroup,
+	}
+	if limit != 0 {
+		// Entries are spread across one log stream per day, so the last entries are in at most limit log streams.
+		opts.Limit = aws.Int64(int64(limit))
+		opts.LogStreamLimit = limit
+	}
+	out, err := l.client.LogEvents(opts)
+	if err != nil {
+		return nil, fmt.Errorf("get audit log entries from log group %s: %w", l.logGroup, err)
+	}
+	entries := make([]Entry, 0, len(out.Events))
+	for _, event := range out.Events {
+		var entry Entry
+		if err := json.Unmarshal([]byte(event.Message), &entry); err != nil {
+			return nil, fmt.Errorf("unmarshal audit log entry from log stream %s: %w", event.LogStreamName, err)
+		}
+		entries = append(entries, entry)
+	}
+	return lastEntries(entries, limit), nil
+}
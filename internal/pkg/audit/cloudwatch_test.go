@@ -0,0 +1,153 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/audit/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloudWatchLog_Write(t *testing.T) {
+	mockTime := time.Date(2017, 5, 11, 23, 30, 0, 0, time.FixedZone("PDT", -7*60*60))
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MocklogClient)
+
+		wantedErr error
+	}{
+		"error if the log event cannot be put": {
+			setupMocks: func(m *mocks.MocklogClient) {
+				m.EXPECT().PutLogEvent(gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"puts the entry in the log stream of its day in UTC": {
+			setupMocks: func(m *mocks.MocklogClient) {
+				m.EXPECT().PutLogEvent(cloudwatchlogs.PutLogEventInput{
+					LogGroup:  "copilot-audit",
+					LogStream: "2017/05/12",
+					Message:   `{"time":"2017-05-11T23:30:00-07:00","command":"copilot svc deploy","version":"v1.0.0","duration":"1s","result":"success"}`,
+					Timestamp: mockTime,
+				}).Return(nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMocklogClient(ctrl)
+			tc.setupMocks(m)
+			log := NewCloudWatchLog(m, "copilot-audit")
+
+			// WHEN
+			err := log.Write(Entry{
+				Time:     mockTime,
+				Command:  "copilot svc deploy",
+				Version:  "v1.0.0",
+				Duration: "1s",
+				Result:   ResultSuccess,
+			})
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCloudWatchLog_Entries(t *testing.T) {
+	testCases := map[string]struct {
+		inLimit    int
+		setupMocks func(m *mocks.MocklogClient)
+
+		wantedEntries []Entry
+		wantedErr     error
+	}{
+		"error if the log events cannot be retrieved": {
+			setupMocks: func(m *mocks.MocklogClient) {
+				m.EXPECT().LogEvents(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get audit log entries from log group copilot-audit: some error"),
+		},
+		"error if an event is not an entry": {
+			setupMocks: func(m *mocks.MocklogClient) {
+				m.EXPECT().LogEvents(gomock.Any()).Return(&cloudwatchlogs.LogEventsOutput{
+					Events: []*cloudwatchlogs.Event{
+						{
+							LogStreamName: "2017/05/11",
+							Message:       "hello",
+						},
+					},
+				}, nil)
+			},
+			wantedErr: errors.New("unmarshal audit log entry from log stream 2017/05/11: invalid character 'h' looking for beginning of value"),
+		},
+		"returns all the entries without limit": {
+			setupMocks: func(m *mocks.MocklogClient) {
+				m.EXPECT().LogEvents(cloudwatchlogs.LogEventsOpts{
+					LogGroup: "copilot-audit",
+				}).Return(&cloudwatchlogs.LogEventsOutput{
+					Events: []*cloudwatchlogs.Event{
+						{
+							LogStreamName: "2017/05/11",
+							Message:       `{"time":"2017-05-11T12:29:10Z","command":"copilot svc deploy","version":"v1.0.0","duration":"1s","result":"success"}`,
+						},
+					},
+				}, nil)
+			},
+			wantedEntries: []Entry{
+				{
+					Time:     time.Date(2017, 5, 11, 12, 29, 10, 0, time.UTC),
+					Command:  "copilot svc deploy",
+					Version:  "v1.0.0",
+					Duration: "1s",
+					Result:   ResultSuccess,
+				},
+			},
+		},
+		"returns the last entries up to the limit": {
+			inLimit: 10,
+			setupMocks: func(m *mocks.MocklogClient) {
+				m.EXPECT().LogEvents(cloudwatchlogs.LogEventsOpts{
+					LogGroup:       "copilot-audit",
+					Limit:          aws.Int64(10),
+					LogStreamLimit: 10,
+				}).Return(&cloudwatchlogs.LogEventsOutput{}, nil)
+			},
+			wantedEntries: []Entry{},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMocklogClient(ctrl)
+			tc.setupMocks(m)
+			log := NewCloudWatchLog(m, "copilot-audit")
+
+			// WHEN
+			entries, err := log.Entries(tc.inLimit)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedEntries, entries)
+		})
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/afero"
+)
+
+// FileName is the name of the audit log file in the copilot directory of a workspace.
+const FileName = "audit.jsonl"
+
+// FileLog is an audit log stored as a file with one JSON entry per line.
+type FileLog struct {
+	path string
+	fs   afero.Fs
+}
+
+// NewFileLog returns an audit log stored in the file at path.
+func NewFileLog(fs afero.Fs, path string) *FileLog {
+	return &FileLog{
+		path: path,
+		fs:   fs,
+	}
+}
+
+// Write appends the entry to the file, and creates the file if it doesn't exist.
+func (l *FileLog) Write(entry Entry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("marshal audit log entry: %w", err)
+	}
+	f, err := l.fs.OpenFile(l.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644 /* -rw-r--r-- */)
+	if err != nil {
+		return fmt.Errorf("open audit log file %s: %w", l.path, err)
+	}
+	defer f.Close()
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("write to audit log file %s: %w", l.path, err)
+	}
+	return nil
+}
+
+// Entries returns the last entries of the file, up to limit, from the oldest to the newest.
+// It returns all the entries if limit is 0.
+func (l *FileLog) Entries(limit int) ([]Entry, error) {
+	f, err := l.fs.Open(l.path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("open audit log file %s: %w", l.path, err)
+	}
+	defer f.Close()
+	var entries []Entry
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var entry Entry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return nil, fmt.Errorf("unmarshal line %d of audit log file %s: %w", line, l.path, err)
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read audit log file %s: %w", l.path, err)
+	}
+	return lastEntries(entries, limit), nil
+}
+
+func lastEntries(entries []Entry, limit int) []Entry {
+	if limit == 0 || len(entries) <= limit {
+		return entries
+	}
+	return entries[len(entries)-limit:]
+}
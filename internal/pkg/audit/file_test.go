@@ -0,0 +1,123 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileLog_Write(t *testing.T) {
+	// GIVEN
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, "/copilot/audit.jsonl", []byte(`{"time":"2017-05-11T12:29:10Z","command":"copilot svc deploy","version":"v1.0.0","duration":"1s","result":"success"}`+"\n"), 0644))
+	log := NewFileLog(fs, "/copilot/audit.jsonl")
+
+	// WHEN
+	err := log.Write(Entry{
+		Time:     time.Date(2017, 5, 11, 12, 30, 0, 0, time.UTC),
+		Command:  "copilot env deploy",
+		Flags:    map[string]string{"name": "test"},
+		App:      "phonetool",
+		Caller:   "arn:aws:iam::123456789012:user/jane",
+		Version:  "v1.0.0",
+		Duration: "2m0s",
+		Result:   ResultFailure,
+		Error:    "some error",
+		Stacks: []StackSummary{
+			{
+				Name:      "phonetool-test",
+				Operation: StackOperationDeploy,
+				Status:    StackStatusFailed,
+			},
+		},
+	})
+
+	// THEN
+	require.NoError(t, err)
+	content, err := afero.ReadFile(fs, "/copilot/audit.jsonl")
+	require.NoError(t, err)
+	require.Equal(t, `{"time":"2017-05-11T12:29:10Z","command":"copilot svc deploy","version":"v1.0.0","duration":"1s","result":"success"}
+{"time":"2017-05-11T12:30:00Z","command":"copilot env deploy","flags":{"name":"test"},"app":"phonetool","caller":"arn:aws:iam::123456789012:user/jane","version":"v1.0.0","duration":"2m0s","result":"failure","error":"some error","stacks":[{"name":"phonetool-test","operation":"deploy","status":"failed"}]}
+`, string(content))
+}
+
+func TestFileLog_Entries(t *testing.T) {
+	const content = `{"time":"2017-05-11T12:29:10Z","command":"copilot svc deploy","version":"v1.0.0","duration":"1s","result":"success"}
+
+{"time":"2017-05-11T12:30:00Z","command":"copilot env deploy","version":"v1.0.0","duration":"2m0s","result":"failure","error":"some error"}
+`
+	testCases := map[string]struct {
+		inContent *string
+		inLimit   int
+
+		wantedEntries []Entry
+		wantedErr     string
+	}{
+		"returns no entry if the file doesn't exist": {},
+		"error if an entry cannot be unmarshaled": {
+			inContent: aws.String(`{"time":`),
+			wantedErr: "unmarshal line 1 of audit log file /copilot/audit.jsonl: unexpected end of JSON input",
+		},
+		"returns all the entries without limit": {
+			inContent: aws.String(content),
+			wantedEntries: []Entry{
+				{
+					Time:     time.Date(2017, 5, 11, 12, 29, 10, 0, time.UTC),
+					Command:  "copilot svc deploy",
+					Version:  "v1.0.0",
+					Duration: "1s",
+					Result:   ResultSuccess,
+				},
+				{
+					Time:     time.Date(2017, 5, 11, 12, 30, 0, 0, time.UTC),
+					Command:  "copilot env deploy",
+					Version:  "v1.0.0",
+					Duration: "2m0s",
+					Result:   ResultFailure,
+					Error:    "some error",
+				},
+			},
+		},
+		"returns the last entries up to the limit": {
+			inContent: aws.String(content),
+			inLimit:   1,
+			wantedEntries: []Entry{
+				{
+					Time:     time.Date(2017, 5, 11, 12, 30, 0, 0, time.UTC),
+					Command:  "copilot env deploy",
+					Version:  "v1.0.0",
+					Duration: "2m0s",
+					Result:   ResultFailure,
+					Error:    "some error",
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			fs := afero.NewMemMapFs()
+			if tc.inContent != nil {
+				require.NoError(t, afero.WriteFile(fs, "/copilot/audit.jsonl", []byte(*tc.inContent), 0644))
+			}
+			log := NewFileLog(fs, "/copilot/audit.jsonl")
+
+			// WHEN
+			entries, err := log.Entries(tc.inLimit)
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedEntries, entries)
+		})
+	}
+}
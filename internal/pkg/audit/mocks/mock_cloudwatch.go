@@ -0,0 +1,64 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/audit/cloudwatch.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	cloudwatchlogs "github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MocklogClient is a mock of logClient interface.
+type MocklogClient struct {
+	ctrl     *gomock.Controller
+	recorder *MocklogClientMockRecorder
+}
+
+// MocklogClientMockRecorder is the mock recorder for MocklogClient.
+type MocklogClientMockRecorder struct {
+	mock *MocklogClient
+}
+
+// NewMocklogClient creates a new mock instance.
+func NewMocklogClient(ctrl *gomock.Controller) *MocklogClient {
+	mock := &MocklogClient{ctrl: ctrl}
+	mock.recorder = &MocklogClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocklogClient) EXPECT() *MocklogClientMockRecorder {
+	return m.recorder
+}
+
+// LogEvents mocks base method.
+func (m *MocklogClient) LogEvents(opts cloudwatchlogs.LogEventsOpts) (*cloudwatchlogs.LogEventsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogEvents", opts)
+	ret0, _ := ret[0].(*cloudwatchlogs.LogEventsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LogEvents indicates an expected call of LogEvents.
+func (mr *MocklogClientMockRecorder) LogEvents(opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogEvents", reflect.TypeOf((*MocklogClient)(nil).LogEvents), opts)
+}
+
+// PutLogEvent mocks base method.
+func (m *MocklogClient) PutLogEvent(in cloudwatchlogs.PutLogEventInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutLogEvent", in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutLogEvent indicates an expected call of PutLogEvent.
+func (mr *MocklogClientMockRecorder) PutLogEvent(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutLogEvent", reflect.TypeOf((*MocklogClient)(nil).PutLogEvent), in)
+}
@@ -6,6 +6,8 @@ package aas
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	aas "github.com/aws/aws-sdk-go/service/applicationautoscaling"
@@ -15,12 +17,44 @@ import (
 
 const (
 	// ECS service resource ID format: service/${clusterName}/${serviceName}.
-	fmtECSResourceID    = "service/%s/%s"
-	ecsServiceNamespace = "ecs"
+	fmtECSResourceID     = "service/%s/%s"
+	ecsServiceNamespace  = "ecs"
+	ecsScalableDimension = "ecs:service:DesiredCount"
+
+	// Format of the time of one-time scheduled actions: at(yyyy-mm-ddThh:mm:ss).
+	fmtAtSchedule     = "at(%s)"
+	atScheduleTimeFmt = "2006-01-02T15:04:05"
 )
 
 type api interface {
 	DescribeScalingPolicies(input *aas.DescribeScalingPoliciesInput) (*aas.DescribeScalingPoliciesOutput, error)
+	DescribeScalableTargets(input *aas.DescribeScalableTargetsInput) (*aas.DescribeScalableTargetsOutput, error)
+	RegisterScalableTarget(input *aas.RegisterScalableTargetInput) (*aas.RegisterScalableTargetOutput, error)
+	DescribeScheduledActions(input *aas.DescribeScheduledActionsInput) (*aas.DescribeScheduledActionsOutput, error)
+	PutScheduledAction(input *aas.PutScheduledActionInput) (*aas.PutScheduledActionOutput, error)
+}
+
+// Capacity is the range of the number of tasks Application Auto Scaling keeps an ECS service in.
+type Capacity struct {
+	Min int
+	Max int
+}
+
+// ScheduledAction is a one-time change of the capacity of an ECS service.
+type ScheduledAction struct {
+	Name     string
+	At       time.Time
+	Capacity Capacity
+}
+
+// ErrScalableTargetNotFound occurs when an ECS service is not registered with Application Auto Scaling.
+type ErrScalableTargetNotFound struct {
+	cluster string
+	service string
+}
+
+func (e *ErrScalableTargetNotFound) Error() string {
+	return fmt.Sprintf("ECS service %s/%s is not registered as a scalable target", e.cluster, e.service)
 }
 
 // ApplicationAutoscaling wraps an Amazon Application Auto Scaling client.
@@ -62,3 +96,97 @@ func (a *ApplicationAutoscaling) ECSServiceAlarmNames(cluster, service string) (
 	}
 	return alarms, nil
 }
+
+// ECSServiceCapacity returns the minimum and maximum number of tasks of the ECS service.
+func (a *ApplicationAutoscaling) ECSServiceCapacity(cluster, service string) (*Capacity, error) {
+	resp, err := a.client.DescribeScalableTargets(&aas.DescribeScalableTargetsInput{
+		ResourceIds:       aws.StringSlice([]string{fmt.Sprintf(fmtECSResourceID, cluster, service)}),
+		ScalableDimension: aws.String(ecsScalableDimension),
+		ServiceNamespace:  aws.String(ecsServiceNamespace),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe scalable target for ECS service %s/%s: %w", cluster, service, err)
+	}
+	if len(resp.ScalableTargets) == 0 {
+		return nil, &ErrScalableTargetNotFound{
+			cluster: cluster,
+			service: service,
+		}
+	}
+	target := resp.ScalableTargets[0]
+	return &Capacity{
+		Min: int(aws.Int64Value(target.MinCapacity)),
+		Max: int(aws.Int64Value(target.MaxCapacity)),
+	}, nil
+}
+
+// SetECSServiceCapacity updates the minimum and maximum number of tasks of the ECS service.
+func (a *ApplicationAutoscaling) SetECSServiceCapacity(cluster, service string, capacity Capacity) error {
+	if _, err := a.client.RegisterScalableTarget(&aas.RegisterScalableTargetInput{
+		ResourceId:        aws.String(fmt.Sprintf(fmtECSResourceID, cluster, service)),
+		ScalableDimension: aws.String(ecsScalableDimension),
+		ServiceNamespace:  aws.String(ecsServiceNamespace),
+		MinCapacity:       aws.Int64(int64(capacity.Min)),
+		MaxCapacity:       aws.Int64(int64(capacity.Max)),
+	}); err != nil {
+		return fmt.Errorf("register scalable target for ECS service %s/%s: %w", cluster, service, err)
+	}
+	return nil
+}
+
+// ScheduleECSServiceCapacity creates or replaces a one-time scheduled action that sets
+// the minimum and maximum number of tasks of the ECS service at the time of the action.
+func (a *ApplicationAutoscaling) ScheduleECSServiceCapacity(cluster, service string, action ScheduledAction) error {
+	if _, err := a.client.PutScheduledAction(&aas.PutScheduledActionInput{
+		ResourceId:          aws.String(fmt.Sprintf(fmtECSResourceID, cluster, service)),
+		ScalableDimension:   aws.String(ecsScalableDimension),
+		ServiceNamespace:    aws.String(ecsServiceNamespace),
+		ScheduledActionName: aws.String(action.Name),
+		Schedule:            aws.String(fmt.Sprintf(fmtAtSchedule, action.At.UTC().Format(atScheduleTimeFmt))),
+		Timezone:            aws.String("UTC"),
+		ScalableTargetAction: &aas.ScalableTargetAction{
+			MinCapacity: aws.Int64(int64(action.Capacity.Min)),
+			MaxCapacity: aws.Int64(int64(action.Capacity.Max)),
+		},
+	}); err != nil {
+		return fmt.Errorf("put scheduled action %s for ECS service %s/%s: %w", action.Name, cluster, service, err)
+	}
+	return nil
+}
+
+// ECSServiceScheduledAction returns the one-time scheduled action named name of the ECS service.
+// It returns nil if the scheduled action doesn't exist.
+func (a *ApplicationAutoscaling) ECSServiceScheduledAction(cluster, service, name string) (*ScheduledAction, error) {
+	resp, err := a.client.DescribeScheduledActions(&aas.DescribeScheduledActionsInput{
+		ResourceId:           aws.String(fmt.Sprintf(fmtECSResourceID, cluster, service)),
+		ScalableDimension:    aws.String(ecsScalableDimension),
+		ServiceNamespace:     aws.String(ecsServiceNamespace),
+		ScheduledActionNames: aws.StringSlice([]string{name}),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe scheduled action %s for ECS service %s/%s: %w", name, cluster, service, err)
+	}
+	if len(resp.ScheduledActions) == 0 {
+		return nil, nil
+	}
+	action := resp.ScheduledActions[0]
+	schedule := aws.StringValue(action.Schedule)
+	if !strings.HasPrefix(schedule, "at(") || !strings.HasSuffix(schedule, ")") {
+		return nil, fmt.Errorf("scheduled action %s is not a one-time action: schedule is %q", name, schedule)
+	}
+	at, err := time.Parse(atScheduleTimeFmt, strings.TrimSuffix(strings.TrimPrefix(schedule, "at("), ")"))
+	if err != nil {
+		return nil, fmt.Errorf("parse schedule %q of scheduled action %s: %w", schedule, name, err)
+	}
+	out := &ScheduledAction{
+		Name: name,
+		At:   at,
+	}
+	if action.ScalableTargetAction != nil {
+		out.Capacity = Capacity{
+			Min: int(aws.Int64Value(action.ScalableTargetAction.MinCapacity)),
+			Max: int(aws.Int64Value(action.ScalableTargetAction.MaxCapacity)),
+		}
+	}
+	return out, nil
+}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	aas "github.com/aws/aws-sdk-go/service/applicationautoscaling"
@@ -139,3 +140,264 @@ func TestCloudWatch_ECSServiceAutoscalingAlarms(t *testing.T) {
 
 	}
 }
+
+func TestApplicationAutoscaling_ECSServiceCapacity(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m aasMocks)
+
+		wantErr      error
+		wantCapacity *Capacity
+	}{
+		"errors if failed to describe the scalable target": {
+			setupMocks: func(m aasMocks) {
+				m.client.EXPECT().DescribeScalableTargets(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: errors.New("describe scalable target for ECS service mockCluster/mockService: some error"),
+		},
+		"errors if the service is not a scalable target": {
+			setupMocks: func(m aasMocks) {
+				m.client.EXPECT().DescribeScalableTargets(gomock.Any()).Return(&aas.DescribeScalableTargetsOutput{}, nil)
+			},
+			wantErr: &ErrScalableTargetNotFound{cluster: "mockCluster", service: "mockService"},
+		},
+		"success": {
+			setupMocks: func(m aasMocks) {
+				m.client.EXPECT().DescribeScalableTargets(&aas.DescribeScalableTargetsInput{
+					ResourceIds:       aws.StringSlice([]string{"service/mockCluster/mockService"}),
+					ScalableDimension: aws.String("ecs:service:DesiredCount"),
+					ServiceNamespace:  aws.String("ecs"),
+				}).Return(&aas.DescribeScalableTargetsOutput{
+					ScalableTargets: []*aas.ScalableTarget{
+						{
+							MinCapacity: aws.Int64(1),
+							MaxCapacity: aws.Int64(10),
+						},
+					},
+				}, nil)
+			},
+			wantCapacity: &Capacity{Min: 1, Max: 10},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := aasMocks{
+				client: mocks.NewMockapi(ctrl),
+			}
+			tc.setupMocks(m)
+			aasSvc := ApplicationAutoscaling{
+				client: m.client,
+			}
+
+			// WHEN
+			got, err := aasSvc.ECSServiceCapacity("mockCluster", "mockService")
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantCapacity, got)
+		})
+	}
+}
+
+func TestApplicationAutoscaling_SetECSServiceCapacity(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m aasMocks)
+
+		wantErr error
+	}{
+		"errors if failed to register the scalable target": {
+			setupMocks: func(m aasMocks) {
+				m.client.EXPECT().RegisterScalableTarget(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: errors.New("register scalable target for ECS service mockCluster/mockService: some error"),
+		},
+		"success": {
+			setupMocks: func(m aasMocks) {
+				m.client.EXPECT().RegisterScalableTarget(&aas.RegisterScalableTargetInput{
+					ResourceId:        aws.String("service/mockCluster/mockService"),
+					ScalableDimension: aws.String("ecs:service:DesiredCount"),
+					ServiceNamespace:  aws.String("ecs"),
+					MinCapacity:       aws.Int64(5),
+					MaxCapacity:       aws.Int64(20),
+				}).Return(&aas.RegisterScalableTargetOutput{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := aasMocks{
+				client: mocks.NewMockapi(ctrl),
+			}
+			tc.setupMocks(m)
+			aasSvc := ApplicationAutoscaling{
+				client: m.client,
+			}
+
+			// WHEN
+			err := aasSvc.SetECSServiceCapacity("mockCluster", "mockService", Capacity{Min: 5, Max: 20})
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestApplicationAutoscaling_ScheduleECSServiceCapacity(t *testing.T) {
+	mockAction := ScheduledAction{
+		Name:     "mockAction",
+		At:       time.Date(2024, time.March, 1, 8, 30, 0, 0, time.FixedZone("PST", -8*60*60)),
+		Capacity: Capacity{Min: 1, Max: 10},
+	}
+	testCases := map[string]struct {
+		setupMocks func(m aasMocks)
+
+		wantErr error
+	}{
+		"errors if failed to put the scheduled action": {
+			setupMocks: func(m aasMocks) {
+				m.client.EXPECT().PutScheduledAction(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: errors.New("put scheduled action mockAction for ECS service mockCluster/mockService: some error"),
+		},
+		"schedules the action in UTC": {
+			setupMocks: func(m aasMocks) {
+				m.client.EXPECT().PutScheduledAction(&aas.PutScheduledActionInput{
+					ResourceId:          aws.String("service/mockCluster/mockService"),
+					ScalableDimension:   aws.String("ecs:service:DesiredCount"),
+					ServiceNamespace:    aws.String("ecs"),
+					ScheduledActionName: aws.String("mockAction"),
+					Schedule:            aws.String("at(2024-03-01T16:30:00)"),
+					Timezone:            aws.String("UTC"),
+					ScalableTargetAction: &aas.ScalableTargetAction{
+						MinCapacity: aws.Int64(1),
+						MaxCapacity: aws.Int64(10),
+					},
+				}).Return(&aas.PutScheduledActionOutput{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := aasMocks{
+				client: mocks.NewMockapi(ctrl),
+			}
+			tc.setupMocks(m)
+			aasSvc := ApplicationAutoscaling{
+				client: m.client,
+			}
+
+			// WHEN
+			err := aasSvc.ScheduleECSServiceCapacity("mockCluster", "mockService", mockAction)
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestApplicationAutoscaling_ECSServiceScheduledAction(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m aasMocks)
+
+		wantErr    error
+		wantAction *ScheduledAction
+	}{
+		"errors if failed to describe the scheduled action": {
+			setupMocks: func(m aasMocks) {
+				m.client.EXPECT().DescribeScheduledActions(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: errors.New("describe scheduled action mockAction for ECS service mockCluster/mockService: some error"),
+		},
+		"errors if the schedule is not a one-time schedule": {
+			setupMocks: func(m aasMocks) {
+				m.client.EXPECT().DescribeScheduledActions(gomock.Any()).Return(&aas.DescribeScheduledActionsOutput{
+					ScheduledActions: []*aas.ScheduledAction{
+						{
+							Schedule: aws.String("rate(1 day)"),
+						},
+					},
+				}, nil)
+			},
+			wantErr: errors.New(`scheduled action mockAction is not a one-time action: schedule is "rate(1 day)"`),
+		},
+		"returns nil if the scheduled action does not exist": {
+			setupMocks: func(m aasMocks) {
+				m.client.EXPECT().DescribeScheduledActions(gomock.Any()).Return(&aas.DescribeScheduledActionsOutput{}, nil)
+			},
+		},
+		"success": {
+			setupMocks: func(m aasMocks) {
+				m.client.EXPECT().DescribeScheduledActions(&aas.DescribeScheduledActionsInput{
+					ResourceId:           aws.String("service/mockCluster/mockService"),
+					ScalableDimension:    aws.String("ecs:service:DesiredCount"),
+					ServiceNamespace:     aws.String("ecs"),
+					ScheduledActionNames: aws.StringSlice([]string{"mockAction"}),
+				}).Return(&aas.DescribeScheduledActionsOutput{
+					ScheduledActions: []*aas.ScheduledAction{
+						{
+							Schedule: aws.String("at(2024-03-01T16:30:00)"),
+							ScalableTargetAction: &aas.ScalableTargetAction{
+								MinCapacity: aws.Int64(1),
+								MaxCapacity: aws.Int64(10),
+							},
+						},
+					},
+				}, nil)
+			},
+			wantAction: &ScheduledAction{
+				Name:     "mockAction",
+				At:       time.Date(2024, time.March, 1, 16, 30, 0, 0, time.UTC),
+				Capacity: Capacity{Min: 1, Max: 10},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := aasMocks{
+				client: mocks.NewMockapi(ctrl),
+			}
+			tc.setupMocks(m)
+			aasSvc := ApplicationAutoscaling{
+				client: m.client,
+			}
+
+			// WHEN
+			got, err := aasSvc.ECSServiceScheduledAction("mockCluster", "mockService", "mockAction")
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantAction, got)
+		})
+	}
+}
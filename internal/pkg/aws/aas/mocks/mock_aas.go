@@ -34,6 +34,21 @@ func (m *Mockapi) EXPECT() *MockapiMockRecorder {
 	return m.recorder
 }
 
+// DescribeScalableTargets mocks base method.
+func (m *Mockapi) DescribeScalableTargets(input *applicationautoscaling.DescribeScalableTargetsInput) (*applicationautoscaling.DescribeScalableTargetsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeScalableTargets", input)
+	ret0, _ := ret[0].(*applicationautoscaling.DescribeScalableTargetsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeScalableTargets indicates an expected call of DescribeScalableTargets.
+func (mr *MockapiMockRecorder) DescribeScalableTargets(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeScalableTargets", reflect.TypeOf((*Mockapi)(nil).DescribeScalableTargets), input)
+}
+
 // DescribeScalingPolicies mocks base method.
 func (m *Mockapi) DescribeScalingPolicies(input *applicationautoscaling.DescribeScalingPoliciesInput) (*applicationautoscaling.DescribeScalingPoliciesOutput, error) {
 	m.ctrl.T.Helper()
@@ -48,3 +63,48 @@ func (mr *MockapiMockRecorder) DescribeScalingPolicies(input interface{}) *gomoc
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeScalingPolicies", reflect.TypeOf((*Mockapi)(nil).DescribeScalingPolicies), input)
 }
+
+// DescribeScheduledActions mocks base method.
+func (m *Mockapi) DescribeScheduledActions(input *applicationautoscaling.DescribeScheduledActionsInput) (*applicationautoscaling.DescribeScheduledActionsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeScheduledActions", input)
+	ret0, _ := ret[0].(*applicationautoscaling.DescribeScheduledActionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeScheduledActions indicates an expected call of DescribeScheduledActions.
+func (mr *MockapiMockRecorder) DescribeScheduledActions(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeScheduledActions", reflect.TypeOf((*Mockapi)(nil).DescribeScheduledActions), input)
+}
+
+// PutScheduledAction mocks base method.
+func (m *Mockapi) PutScheduledAction(input *applicationautoscaling.PutScheduledActionInput) (*applicationautoscaling.PutScheduledActionOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutScheduledAction", input)
+	ret0, _ := ret[0].(*applicationautoscaling.PutScheduledActionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutScheduledAction indicates an expected call of PutScheduledAction.
+func (mr *MockapiMockRecorder) PutScheduledAction(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutScheduledAction", reflect.TypeOf((*Mockapi)(nil).PutScheduledAction), input)
+}
+
+// RegisterScalableTarget mocks base method.
+func (m *Mockapi) RegisterScalableTarget(input *applicationautoscaling.RegisterScalableTargetInput) (*applicationautoscaling.RegisterScalableTargetOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterScalableTarget", input)
+	ret0, _ := ret[0].(*applicationautoscaling.RegisterScalableTargetOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterScalableTarget indicates an expected call of RegisterScalableTarget.
+func (mr *MockapiMockRecorder) RegisterScalableTarget(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterScalableTarget", reflect.TypeOf((*Mockapi)(nil).RegisterScalableTarget), input)
+}
@@ -42,8 +42,31 @@ func New(s *session.Session) *ACM {
 
 // ValidateCertAliases validates if aliases are all valid against the provided ACM certificates.
 func (a *ACM) ValidateCertAliases(aliases []string, certs []string) error {
+	domainsOfCert, err := a.CertificateDomains(certs)
+	if err != nil {
+		return err
+	}
 	validAliases := make(map[string]bool)
-	domainsOfCert := make(map[string][]string)
+	for _, domains := range domainsOfCert {
+		for _, alias := range filterValidAliases(domains, aliases) {
+			validAliases[alias] = true
+		}
+	}
+	for _, alias := range aliases {
+		if !validAliases[alias] {
+			return &errInValidAliasAgainstCert{
+				certs:         certs,
+				alias:         alias,
+				domainsOfCert: domainsOfCert,
+			}
+		}
+	}
+	return nil
+}
+
+// CertificateDomains returns the domain names protected by each of the provided ACM certificates.
+func (a *ACM) CertificateDomains(certs []string) (map[string][]string, error) {
+	domainsOfCert := make(map[string][]string, len(certs))
 	ctx, cancelWait := context.WithTimeout(context.Background(), waitForFindValidAliasesTimeout)
 	defer cancelWait()
 	g, ctx := errgroup.WithContext(ctx)
@@ -55,29 +78,21 @@ func (a *ACM) ValidateCertAliases(aliases []string, certs []string) error {
 			if err != nil {
 				return err
 			}
-			validCertAliases := filterValidAliases(domains, aliases)
 			mux.Lock()
 			defer mux.Unlock()
 			domainsOfCert[cert] = domains
-			for _, alias := range validCertAliases {
-				validAliases[alias] = true
-			}
 			return nil
 		})
 	}
 	if err := g.Wait(); err != nil {
-		return err
+		return nil, err
 	}
-	for _, alias := range aliases {
-		if !validAliases[alias] {
-			return &errInValidAliasAgainstCert{
-				certs:         certs,
-				alias:         alias,
-				domainsOfCert: domainsOfCert,
-			}
-		}
-	}
-	return nil
+	return domainsOfCert, nil
+}
+
+// IsAliasProtected returns true if the alias matches one of the domains of a certificate, either exactly or through a wildcard.
+func IsAliasProtected(alias string, domains []string) bool {
+	return len(filterValidAliases(domains, []string{alias})) == 1
 }
 
 func (a *ACM) validDomainsOfCert(ctx context.Context, cert string) ([]string, error) {
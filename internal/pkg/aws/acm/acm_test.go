@@ -107,3 +107,107 @@ func TestACM_ValidateCertAliases(t *testing.T) {
 
 	}
 }
+
+func TestACM_CertificateDomains(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m acmMocks)
+		inCerts    []string
+
+		wanted  map[string][]string
+		wantErr error
+	}{
+		"errors if failed to describe certificates": {
+			inCerts: []string{"mockCertARN"},
+			setupMocks: func(m acmMocks) {
+				m.client.EXPECT().DescribeCertificateWithContext(gomock.Any(), gomock.Any()).Return(nil, errors.New("some error"))
+			},
+
+			wantErr: fmt.Errorf("describe certificate mockCertARN: some error"),
+		},
+		"success": {
+			inCerts: []string{"mockCertARN1", "mockCertARN2"},
+			setupMocks: func(m acmMocks) {
+				m.client.EXPECT().DescribeCertificateWithContext(gomock.Any(), &acm.DescribeCertificateInput{
+					CertificateArn: aws.String("mockCertARN1"),
+				}).Return(&acm.DescribeCertificateOutput{
+					Certificate: &acm.CertificateDetail{
+						SubjectAlternativeNames: aws.StringSlice([]string{"copilot.com", "*.copilot.com"}),
+					},
+				}, nil)
+				m.client.EXPECT().DescribeCertificateWithContext(gomock.Any(), &acm.DescribeCertificateInput{
+					CertificateArn: aws.String("mockCertARN2"),
+				}).Return(&acm.DescribeCertificateOutput{
+					Certificate: &acm.CertificateDetail{
+						SubjectAlternativeNames: aws.StringSlice([]string{"example.com"}),
+					},
+				}, nil)
+			},
+
+			wanted: map[string][]string{
+				"mockCertARN1": {"copilot.com", "*.copilot.com"},
+				"mockCertARN2": {"example.com"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := acmMocks{
+				client: mocks.NewMockapi(ctrl),
+			}
+			tc.setupMocks(m)
+			acmSvc := ACM{
+				client: m.client,
+			}
+
+			// WHEN
+			got, err := acmSvc.CertificateDomains(tc.inCerts)
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestIsAliasProtected(t *testing.T) {
+	testCases := map[string]struct {
+		inAlias   string
+		inDomains []string
+
+		wanted bool
+	}{
+		"exact match": {
+			inAlias:   "example.com",
+			inDomains: []string{"example.com"},
+			wanted:    true,
+		},
+		"wildcard match": {
+			inAlias:   "v1.copilot.com",
+			inDomains: []string{"*.copilot.com"},
+			wanted:    true,
+		},
+		"wildcard only matches one level": {
+			inAlias:   "myapp.v1.copilot.com",
+			inDomains: []string{"*.copilot.com"},
+		},
+		"alias without a dot": {
+			inAlias:   "localhost",
+			inDomains: []string{"localhost"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, IsAliasProtected(tc.inAlias, tc.inDomains))
+		})
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package budgets provides a client to make API requests to AWS Budgets.
+package budgets
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/budgets"
+)
+
+type api interface {
+	DescribeBudget(input *budgets.DescribeBudgetInput) (*budgets.DescribeBudgetOutput, error)
+}
+
+// Budgets wraps an AWS Budgets client.
+type Budgets struct {
+	client api
+}
+
+// New returns a Budgets configured against the input session.
+func New(s *session.Session) *Budgets {
+	return &Budgets{
+		client: budgets.New(s),
+	}
+}
+
+// Budget holds the limit of a cost budget and the spend that it tracks.
+type Budget struct {
+	Name            string
+	Limit           float64
+	ActualSpend     float64
+	ForecastedSpend float64 // Zero if AWS Budgets doesn't have enough data to forecast the spend yet.
+	Unit            string
+}
+
+// ExceedsLimit returns true if the forecasted spend is over the limit of the budget.
+func (b *Budget) ExceedsLimit() bool {
+	return b.ForecastedSpend > b.Limit
+}
+
+// Budget returns the limit and the spend of a budget in an account.
+func (b *Budgets) Budget(accountID, name string) (*Budget, error) {
+	out, err := b.client.DescribeBudget(&budgets.DescribeBudgetInput{
+		AccountId:  aws.String(accountID),
+		BudgetName: aws.String(name),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe budget %s: %w", name, err)
+	}
+	budget := &Budget{
+		Name: name,
+	}
+	if limit := out.Budget.BudgetLimit; limit != nil {
+		if budget.Limit, err = amount(limit); err != nil {
+			return nil, fmt.Errorf("parse limit of budget %s: %w", name, err)
+		}
+		budget.Unit = aws.StringValue(limit.Unit)
+	}
+	if spend := out.Budget.CalculatedSpend; spend != nil {
+		if budget.ActualSpend, err = amount(spend.ActualSpend); err != nil {
+			return nil, fmt.Errorf("parse actual spend of budget %s: %w", name, err)
+		}
+		if budget.ForecastedSpend, err = amount(spend.ForecastedSpend); err != nil {
+			return nil, fmt.Errorf("parse forecasted spend of budget %s: %w", name, err)
+		}
+	}
+	return budget, nil
+}
+
+func amount(spend *budgets.Spend) (float64, error) {
+	if spend == nil || spend.Amount == nil {
+		return 0, nil
+	}
+	return strconv.ParseFloat(aws.StringValue(spend.Amount), 64)
+}
@@ -0,0 +1,109 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package budgets
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/budgets"
+	"github.com/aws/copilot-cli/internal/pkg/aws/budgets/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBudgets_Budget(t *testing.T) {
+	mockInput := &budgets.DescribeBudgetInput{
+		AccountId:  aws.String("123456789012"),
+		BudgetName: aws.String("phonetool-prod"),
+	}
+	testCases := map[string]struct {
+		mockClient func(m *mocks.Mockapi)
+
+		wanted      *Budget
+		wantedError error
+	}{
+		"error if fail to describe the budget": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeBudget(mockInput).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe budget phonetool-prod: some error"),
+		},
+		"error if the forecasted spend is not a number": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeBudget(mockInput).Return(&budgets.DescribeBudgetOutput{
+					Budget: &budgets.Budget{
+						CalculatedSpend: &budgets.CalculatedSpend{
+							ForecastedSpend: &budgets.Spend{Amount: aws.String("many"), Unit: aws.String("USD")},
+						},
+					},
+				}, nil)
+			},
+			wantedError: errors.New(`parse forecasted spend of budget phonetool-prod: strconv.ParseFloat: parsing "many": invalid syntax`),
+		},
+		"returns the limit and the spend of the budget": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeBudget(mockInput).Return(&budgets.DescribeBudgetOutput{
+					Budget: &budgets.Budget{
+						BudgetLimit: &budgets.Spend{Amount: aws.String("500.0"), Unit: aws.String("USD")},
+						CalculatedSpend: &budgets.CalculatedSpend{
+							ActualSpend:     &budgets.Spend{Amount: aws.String("312.5"), Unit: aws.String("USD")},
+							ForecastedSpend: &budgets.Spend{Amount: aws.String("620.25"), Unit: aws.String("USD")},
+						},
+					},
+				}, nil)
+			},
+			wanted: &Budget{
+				Name:            "phonetool-prod",
+				Limit:           500,
+				ActualSpend:     312.5,
+				ForecastedSpend: 620.25,
+				Unit:            "USD",
+			},
+		},
+		"no forecast yet": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeBudget(mockInput).Return(&budgets.DescribeBudgetOutput{
+					Budget: &budgets.Budget{
+						BudgetLimit:     &budgets.Spend{Amount: aws.String("500.0"), Unit: aws.String("USD")},
+						CalculatedSpend: &budgets.CalculatedSpend{},
+					},
+				}, nil)
+			},
+			wanted: &Budget{
+				Name:  "phonetool-prod",
+				Limit: 500,
+				Unit:  "USD",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+
+			b := &Budgets{
+				client: m,
+			}
+
+			got, err := b.Budget("123456789012", "phonetool-prod")
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestBudget_ExceedsLimit(t *testing.T) {
+	require.True(t, (&Budget{Limit: 100, ForecastedSpend: 100.01}).ExceedsLimit())
+	require.False(t, (&Budget{Limit: 100, ForecastedSpend: 100}).ExceedsLimit())
+	require.False(t, (&Budget{Limit: 100}).ExceedsLimit())
+}
@@ -279,7 +279,12 @@ func (c *CloudFormation) Outputs(stack *Stack) (map[string]string, error) {
 
 // Events returns the list of stack events in **chronological** order.
 func (c *CloudFormation) Events(stackName string) ([]StackEvent, error) {
-	return c.events(stackName, func(in *cloudformation.StackEvent) bool { return true })
+	return c.events(stackName, func(in *cloudformation.StackEvent) bool { return true }, 0)
+}
+
+// LatestEvents returns up to limit of the most recent stack events in **chronological** order.
+func (c *CloudFormation) LatestEvents(stackName string, limit int) ([]StackEvent, error) {
+	return c.events(stackName, func(in *cloudformation.StackEvent) bool { return true }, limit)
 }
 
 // StackResources returns the list of resources created as part of a CloudFormation stack.
@@ -301,10 +306,12 @@ func (c *CloudFormation) StackResources(name string) ([]*StackResource, error) {
 	return resources, nil
 }
 
-func (c *CloudFormation) events(stackName string, match eventMatcher) ([]StackEvent, error) {
+// events returns the matching stack events in chronological order.
+// If limit is positive, only the limit most recent matching events are returned.
+func (c *CloudFormation) events(stackName string, match eventMatcher, limit int) ([]StackEvent, error) {
 	var nextToken *string
 	var events []StackEvent
-	for {
+	for limit <= 0 || len(events) < limit {
 		out, err := c.client.DescribeStackEvents(&cloudformation.DescribeStackEventsInput{
 			NextToken: nextToken,
 			StackName: aws.String(stackName),
@@ -322,6 +329,9 @@ func (c *CloudFormation) events(stackName string, match eventMatcher) ([]StackEv
 			break
 		}
 	}
+	if limit > 0 && len(events) > limit {
+		events = events[:limit]
+	}
 	// Reverse the events so that they're returned in chronological order.
 	// Taken from https://github.com/golang/go/wiki/SliceTricks#reversing.
 	for i := len(events)/2 - 1; i >= 0; i-- {
@@ -340,7 +350,7 @@ func (c *CloudFormation) ErrorEvents(stackName string) ([]StackEvent, error) {
 			}
 		}
 		return false
-	})
+	}, 0)
 }
 
 // ListStacksWithTags returns all the stacks in the current AWS account and region with the specified matching
@@ -1259,6 +1259,83 @@ func TestCloudFormation_Events(t *testing.T) {
 	}
 }
 
+func TestCloudFormation_LatestEvents(t *testing.T) {
+	testCases := map[string]struct {
+		createMock   func(ctrl *gomock.Controller) client
+		wantedEvents []StackEvent
+		wantedErr    error
+	}{
+		"return a wrapped error if fail to describe stack events": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DescribeStackEvents(gomock.Any()).Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedErr: errors.New("describe stack events for stack id: some error"),
+		},
+		"stop paginating once enough events are retrieved and return them in chronological order": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DescribeStackEvents(&cloudformation.DescribeStackEventsInput{
+					StackName: aws.String(mockStack.Name),
+				}).Return(&cloudformation.DescribeStackEventsOutput{
+					StackEvents: []*cloudformation.StackEvent{
+						{
+							ResourceType: aws.String("ecs"),
+						},
+					},
+					NextToken: aws.String("1111"),
+				}, nil)
+				m.EXPECT().DescribeStackEvents(&cloudformation.DescribeStackEventsInput{
+					StackName: aws.String(mockStack.Name),
+					NextToken: aws.String("1111"),
+				}).Return(&cloudformation.DescribeStackEventsOutput{
+					StackEvents: []*cloudformation.StackEvent{
+						{
+							ResourceType: aws.String("s3"),
+						},
+						{
+							ResourceType: aws.String("iam"),
+						},
+					},
+					NextToken: aws.String("2222"),
+				}, nil)
+				return m
+			},
+			wantedEvents: []StackEvent{
+				{
+					ResourceType: aws.String("s3"),
+				},
+				{
+					ResourceType: aws.String("ecs"),
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				client: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			events, err := c.LatestEvents(mockStack.Name, 2)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedEvents, events)
+		})
+	}
+}
+
 func TestStackDescriber_StackResources(t *testing.T) {
 	testCases := map[string]struct {
 		createMock func(ctrl *gomock.Controller) client
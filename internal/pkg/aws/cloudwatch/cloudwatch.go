@@ -6,6 +6,7 @@ package cloudwatch
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -29,6 +30,9 @@ var humanizeDuration = humanize.RelTime
 
 type api interface {
 	DescribeAlarms(input *cloudwatch.DescribeAlarmsInput) (*cloudwatch.DescribeAlarmsOutput, error)
+	PutMetricData(input *cloudwatch.PutMetricDataInput) (*cloudwatch.PutMetricDataOutput, error)
+	SetAlarmState(input *cloudwatch.SetAlarmStateInput) (*cloudwatch.SetAlarmStateOutput, error)
+	DescribeAlarmHistory(input *cloudwatch.DescribeAlarmHistoryInput) (*cloudwatch.DescribeAlarmHistoryOutput, error)
 }
 
 type resourceGetter interface {
@@ -59,6 +63,13 @@ type AlarmDescription struct {
 	Environment string `json:"environment"`
 }
 
+// AlarmStateChange is a transition of a CloudWatch alarm from one state to another.
+type AlarmStateChange struct {
+	AlarmName string    `json:"alarmName"`
+	Summary   string    `json:"summary"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
 // New returns a CloudWatch struct configured against the input session.
 func New(s *session.Session) *CloudWatch {
 	return &CloudWatch{
@@ -133,6 +144,72 @@ func (cw *CloudWatch) AlarmStatuses(opts ...DescribeAlarmOpts) ([]AlarmStatus, e
 	return alarmStatuses, nil
 }
 
+// PutCountMetric publishes a datapoint of the metric with the dimensions in the namespace.
+func (cw *CloudWatch) PutCountMetric(namespace, metric string, dimensions map[string]string, value float64) error {
+	names := make([]string, 0, len(dimensions))
+	for name := range dimensions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var dims []*cloudwatch.Dimension
+	for _, name := range names {
+		dims = append(dims, &cloudwatch.Dimension{
+			Name:  aws.String(name),
+			Value: aws.String(dimensions[name]),
+		})
+	}
+	_, err := cw.client.PutMetricData(&cloudwatch.PutMetricDataInput{
+		Namespace: aws.String(namespace),
+		MetricData: []*cloudwatch.MetricDatum{
+			{
+				MetricName: aws.String(metric),
+				Dimensions: dims,
+				Unit:       aws.String(cloudwatch.StandardUnitCount),
+				Value:      aws.Float64(value),
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("put metric data %s in namespace %s: %w", metric, namespace, err)
+	}
+	return nil
+}
+
+// TriggerAlarm sets the state of the alarm to ALARM until its next evaluation.
+func (cw *CloudWatch) TriggerAlarm(name, reason string) error {
+	_, err := cw.client.SetAlarmState(&cloudwatch.SetAlarmStateInput{
+		AlarmName:   aws.String(name),
+		StateValue:  aws.String(cloudwatch.StateValueAlarm),
+		StateReason: aws.String(reason),
+	})
+	if err != nil {
+		return fmt.Errorf("set state of alarm %s: %w", name, err)
+	}
+	return nil
+}
+
+// AlarmStateChanges returns up to limit of the latest state changes of the alarm, from the most recent to the oldest.
+func (cw *CloudWatch) AlarmStateChanges(alarmName string, limit int) ([]AlarmStateChange, error) {
+	out, err := cw.client.DescribeAlarmHistory(&cloudwatch.DescribeAlarmHistoryInput{
+		AlarmName:       aws.String(alarmName),
+		HistoryItemType: aws.String(cloudwatch.HistoryItemTypeStateUpdate),
+		MaxRecords:      aws.Int64(int64(limit)),
+		ScanBy:          aws.String(cloudwatch.ScanByTimestampDescending),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe history of alarm %s: %w", alarmName, err)
+	}
+	changes := make([]AlarmStateChange, len(out.AlarmHistoryItems))
+	for i, item := range out.AlarmHistoryItems {
+		changes[i] = AlarmStateChange{
+			AlarmName: aws.StringValue(item.AlarmName),
+			Summary:   aws.StringValue(item.HistorySummary),
+			Timestamp: aws.TimeValue(item.Timestamp),
+		}
+	}
+	return changes, nil
+}
+
 // AlarmDescriptions returns the config of alarms filtered by name.
 func (cw *CloudWatch) AlarmDescriptions(alarmNames []string) ([]*AlarmDescription, error) {
 	if len(alarmNames) == 0 {
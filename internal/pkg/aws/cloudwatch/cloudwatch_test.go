@@ -602,3 +602,183 @@ func TestCloudWatch_AlarmDescriptions(t *testing.T) {
 		})
 	}
 }
+
+func TestCloudWatch_PutCountMetric(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m cloudWatchMocks)
+
+		wantedErr error
+	}{
+		"errors if fail to put metric data": {
+			setupMocks: func(m cloudWatchMocks) {
+				m.cw.EXPECT().PutMetricData(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("put metric data ValidationFailures in namespace Copilot/Deployments: some error"),
+		},
+		"success with sorted dimensions": {
+			setupMocks: func(m cloudWatchMocks) {
+				m.cw.EXPECT().PutMetricData(&cloudwatch.PutMetricDataInput{
+					Namespace: aws.String("Copilot/Deployments"),
+					MetricData: []*cloudwatch.MetricDatum{
+						{
+							MetricName: aws.String("ValidationFailures"),
+							Dimensions: []*cloudwatch.Dimension{
+								{Name: aws.String("App"), Value: aws.String("phonetool")},
+								{Name: aws.String("Env"), Value: aws.String("test")},
+								{Name: aws.String("Service"), Value: aws.String("api")},
+							},
+							Unit:  aws.String("Count"),
+							Value: aws.Float64(1),
+						},
+					},
+				}).Return(&cloudwatch.PutMetricDataOutput{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockcwClient := mocks.NewMockapi(ctrl)
+			tc.setupMocks(cloudWatchMocks{
+				cw: mockcwClient,
+			})
+			cwSvc := CloudWatch{
+				client: mockcwClient,
+			}
+
+			// WHEN
+			err := cwSvc.PutCountMetric("Copilot/Deployments", "ValidationFailures", map[string]string{
+				"Service": "api",
+				"App":     "phonetool",
+				"Env":     "test",
+			}, 1)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCloudWatch_TriggerAlarm(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m cloudWatchMocks)
+
+		wantedErr error
+	}{
+		"errors if fail to set alarm state": {
+			setupMocks: func(m cloudWatchMocks) {
+				m.cw.EXPECT().SetAlarmState(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("set state of alarm mock-alarm: some error"),
+		},
+		"success": {
+			setupMocks: func(m cloudWatchMocks) {
+				m.cw.EXPECT().SetAlarmState(&cloudwatch.SetAlarmStateInput{
+					AlarmName:   aws.String("mock-alarm"),
+					StateValue:  aws.String("ALARM"),
+					StateReason: aws.String("validation failed"),
+				}).Return(&cloudwatch.SetAlarmStateOutput{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockcwClient := mocks.NewMockapi(ctrl)
+			tc.setupMocks(cloudWatchMocks{
+				cw: mockcwClient,
+			})
+			cwSvc := CloudWatch{
+				client: mockcwClient,
+			}
+
+			// WHEN
+			err := cwSvc.TriggerAlarm("mock-alarm", "validation failed")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCloudWatch_AlarmStateChanges(t *testing.T) {
+	mockTime := time.Unix(1700000000, 0)
+	testCases := map[string]struct {
+		setupMocks func(m cloudWatchMocks)
+
+		wantedChanges []AlarmStateChange
+		wantedErr     error
+	}{
+		"errors if fail to describe alarm history": {
+			setupMocks: func(m cloudWatchMocks) {
+				m.cw.EXPECT().DescribeAlarmHistory(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("describe history of alarm mock-alarm: some error"),
+		},
+		"success": {
+			setupMocks: func(m cloudWatchMocks) {
+				m.cw.EXPECT().DescribeAlarmHistory(&cloudwatch.DescribeAlarmHistoryInput{
+					AlarmName:       aws.String("mock-alarm"),
+					HistoryItemType: aws.String("StateUpdate"),
+					MaxRecords:      aws.Int64(10),
+					ScanBy:          aws.String("TimestampDescending"),
+				}).Return(&cloudwatch.DescribeAlarmHistoryOutput{
+					AlarmHistoryItems: []*cloudwatch.AlarmHistoryItem{
+						{
+							AlarmName:      aws.String("mock-alarm"),
+							HistorySummary: aws.String("Alarm updated from OK to ALARM"),
+							Timestamp:      aws.Time(mockTime),
+						},
+					},
+				}, nil)
+			},
+			wantedChanges: []AlarmStateChange{
+				{
+					AlarmName: "mock-alarm",
+					Summary:   "Alarm updated from OK to ALARM",
+					Timestamp: mockTime,
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockcwClient := mocks.NewMockapi(ctrl)
+			tc.setupMocks(cloudWatchMocks{
+				cw: mockcwClient,
+			})
+			cwSvc := CloudWatch{
+				client: mockcwClient,
+			}
+
+			// WHEN
+			changes, err := cwSvc.AlarmStateChanges("mock-alarm", 10)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedChanges, changes)
+		})
+	}
+}
@@ -35,6 +35,21 @@ func (m *Mockapi) EXPECT() *MockapiMockRecorder {
 	return m.recorder
 }
 
+// DescribeAlarmHistory mocks base method.
+func (m *Mockapi) DescribeAlarmHistory(input *cloudwatch.DescribeAlarmHistoryInput) (*cloudwatch.DescribeAlarmHistoryOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeAlarmHistory", input)
+	ret0, _ := ret[0].(*cloudwatch.DescribeAlarmHistoryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeAlarmHistory indicates an expected call of DescribeAlarmHistory.
+func (mr *MockapiMockRecorder) DescribeAlarmHistory(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAlarmHistory", reflect.TypeOf((*Mockapi)(nil).DescribeAlarmHistory), input)
+}
+
 // DescribeAlarms mocks base method.
 func (m *Mockapi) DescribeAlarms(input *cloudwatch.DescribeAlarmsInput) (*cloudwatch.DescribeAlarmsOutput, error) {
 	m.ctrl.T.Helper()
@@ -50,6 +65,36 @@ func (mr *MockapiMockRecorder) DescribeAlarms(input interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAlarms", reflect.TypeOf((*Mockapi)(nil).DescribeAlarms), input)
 }
 
+// PutMetricData mocks base method.
+func (m *Mockapi) PutMetricData(input *cloudwatch.PutMetricDataInput) (*cloudwatch.PutMetricDataOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutMetricData", input)
+	ret0, _ := ret[0].(*cloudwatch.PutMetricDataOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutMetricData indicates an expected call of PutMetricData.
+func (mr *MockapiMockRecorder) PutMetricData(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutMetricData", reflect.TypeOf((*Mockapi)(nil).PutMetricData), input)
+}
+
+// SetAlarmState mocks base method.
+func (m *Mockapi) SetAlarmState(input *cloudwatch.SetAlarmStateInput) (*cloudwatch.SetAlarmStateOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAlarmState", input)
+	ret0, _ := ret[0].(*cloudwatch.SetAlarmStateOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetAlarmState indicates an expected call of SetAlarmState.
+func (mr *MockapiMockRecorder) SetAlarmState(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAlarmState", reflect.TypeOf((*Mockapi)(nil).SetAlarmState), input)
+}
+
 // MockresourceGetter is a mock of resourceGetter interface.
 type MockresourceGetter struct {
 	ctrl     *gomock.Controller
@@ -5,11 +5,13 @@
 package cloudwatchlogs
 
 import (
+	"errors"
 	"fmt"
 	"sort"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
 )
@@ -27,6 +29,9 @@ var (
 type api interface {
 	DescribeLogStreams(input *cloudwatchlogs.DescribeLogStreamsInput) (*cloudwatchlogs.DescribeLogStreamsOutput, error)
 	GetLogEvents(input *cloudwatchlogs.GetLogEventsInput) (*cloudwatchlogs.GetLogEventsOutput, error)
+	CreateLogGroup(input *cloudwatchlogs.CreateLogGroupInput) (*cloudwatchlogs.CreateLogGroupOutput, error)
+	CreateLogStream(input *cloudwatchlogs.CreateLogStreamInput) (*cloudwatchlogs.CreateLogStreamOutput, error)
+	PutLogEvents(input *cloudwatchlogs.PutLogEventsInput) (*cloudwatchlogs.PutLogEventsOutput, error)
 }
 
 // CloudWatchLogs wraps an AWS Cloudwatch Logs client.
@@ -50,6 +55,9 @@ type LogEventsOpts struct {
 	StartTime              *int64
 	EndTime                *int64
 	StreamLastEventTime    map[string]int64
+	// If set, every page of log events of each log stream is retrieved and passed to OnPage as soon as it's received,
+	// instead of only the latest page being returned.
+	OnPage func(events []*Event) error
 
 	LogStreamLimit int
 }
@@ -126,22 +134,40 @@ func (c *CloudWatchLogs) LogEvents(opts LogEventsOpts) (*LogEventsOutput, error)
 			in.SetStartTime(streamLastEventTime[logStream] + 1)
 		}
 		// TODO: https://github.com/aws/copilot-cli/pull/628#discussion_r374291068 and https://github.com/aws/copilot-cli/pull/628#discussion_r374294362
-		resp, err := c.client.GetLogEvents(in)
-		if err != nil {
-			return nil, fmt.Errorf("get log events of %s/%s: %w", opts.LogGroup, logStream, err)
-		}
+		in.NextToken = nil
+		for {
+			resp, err := c.client.GetLogEvents(in)
+			if err != nil {
+				return nil, fmt.Errorf("get log events of %s/%s: %w", opts.LogGroup, logStream, err)
+			}
 
-		for _, event := range resp.Events {
-			log := &Event{
-				LogStreamName: logStream,
-				IngestionTime: aws.Int64Value(event.IngestionTime),
-				Message:       aws.StringValue(event.Message),
-				Timestamp:     aws.Int64Value(event.Timestamp),
+			var page []*Event
+			for _, event := range resp.Events {
+				log := &Event{
+					LogStreamName: logStream,
+					IngestionTime: aws.Int64Value(event.IngestionTime),
+					Message:       aws.StringValue(event.Message),
+					Timestamp:     aws.Int64Value(event.Timestamp),
+				}
+				page = append(page, log)
 			}
-			events = append(events, log)
-		}
-		if len(resp.Events) != 0 {
-			streamLastEventTime[logStream] = *resp.Events[len(resp.Events)-1].Timestamp
+			if len(resp.Events) != 0 {
+				streamLastEventTime[logStream] = *resp.Events[len(resp.Events)-1].Timestamp
+			}
+			if opts.OnPage == nil {
+				events = append(events, page...)
+				break
+			}
+			if len(page) != 0 {
+				if err := opts.OnPage(page); err != nil {
+					return nil, err
+				}
+			}
+			// The forward token stays the same once the end of the log stream is reached.
+			if resp.NextForwardToken == nil || aws.StringValue(resp.NextForwardToken) == aws.StringValue(in.NextToken) {
+				break
+			}
+			in.NextToken = resp.NextForwardToken
 		}
 	}
 	sort.SliceStable(events, func(i, j int) bool { return events[i].Timestamp < events[j].Timestamp })
@@ -158,6 +184,55 @@ func (c *CloudWatchLogs) LogEvents(opts LogEventsOpts) (*LogEventsOutput, error)
 	}, nil
 }
 
+// PutLogEventInput holds the fields needed to put a log event.
+type PutLogEventInput struct {
+	LogGroup  string
+	LogStream string
+	Message   string
+	Timestamp time.Time
+}
+
+// PutLogEvent puts a log event in the log stream, and creates the log group and the log stream if they don't exist.
+func (c *CloudWatchLogs) PutLogEvent(in PutLogEventInput) error {
+	err := c.putLogEvent(in)
+	if !isErrCode(err, cloudwatchlogs.ErrCodeResourceNotFoundException) {
+		return err
+	}
+	if _, err := c.client.CreateLogGroup(&cloudwatchlogs.CreateLogGroupInput{
+		LogGroupName: aws.String(in.LogGroup),
+	}); err != nil && !isErrCode(err, cloudwatchlogs.ErrCodeResourceAlreadyExistsException) {
+		return fmt.Errorf("create log group %s: %w", in.LogGroup, err)
+	}
+	if _, err := c.client.CreateLogStream(&cloudwatchlogs.CreateLogStreamInput{
+		LogGroupName:  aws.String(in.LogGroup),
+		LogStreamName: aws.String(in.LogStream),
+	}); err != nil && !isErrCode(err, cloudwatchlogs.ErrCodeResourceAlreadyExistsException) {
+		return fmt.Errorf("create log stream %s/%s: %w", in.LogGroup, in.LogStream, err)
+	}
+	return c.putLogEvent(in)
+}
+
+func (c *CloudWatchLogs) putLogEvent(in PutLogEventInput) error {
+	if _, err := c.client.PutLogEvents(&cloudwatchlogs.PutLogEventsInput{
+		LogGroupName:  aws.String(in.LogGroup),
+		LogStreamName: aws.String(in.LogStream),
+		LogEvents: []*cloudwatchlogs.InputLogEvent{
+			{
+				Message:   aws.String(in.Message),
+				Timestamp: aws.Int64(in.Timestamp.UnixMilli()),
+			},
+		},
+	}); err != nil {
+		return fmt.Errorf("put log event in %s/%s: %w", in.LogGroup, in.LogStream, err)
+	}
+	return nil
+}
+
+func isErrCode(err error, code string) bool {
+	var aerr awserr.Error
+	return errors.As(err, &aerr) && aerr.Code() == code
+}
+
 func truncateEvents(limit int, events []*Event) []*Event {
 	if len(events) <= limit {
 		return events
@@ -173,12 +248,17 @@ func truncateStreams(limit int, streams []string) []string {
 }
 
 func initGetLogEventsInput(opts LogEventsOpts) *cloudwatchlogs.GetLogEventsInput {
-	return &cloudwatchlogs.GetLogEventsInput{
+	in := &cloudwatchlogs.GetLogEventsInput{
 		LogGroupName: aws.String(opts.LogGroup),
 		StartTime:    opts.StartTime,
 		EndTime:      opts.EndTime,
 		Limit:        opts.Limit,
 	}
+	if opts.OnPage != nil {
+		// Page forward from the oldest events in the time range.
+		in.StartFromHead = aws.Bool(true)
+	}
+	return in
 }
 
 // Example: if the prefixes is []string{"a"} and all is []string{"a", "b", "ab"}
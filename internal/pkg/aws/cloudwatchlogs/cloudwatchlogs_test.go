@@ -7,8 +7,10 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs/mocks"
 	"github.com/golang/mock/gomock"
@@ -25,12 +27,89 @@ func TestLogEvents(t *testing.T) {
 		limit                    *int64
 		logStreamLimit           int
 		lastEventTime            map[string]int64
+		streamPages              bool
 		mockcloudwatchlogsClient func(m *mocks.Mockapi)
 
 		wantLogEvents     []*Event
+		wantPages         [][]*Event
 		wantLastEventTime map[string]int64
 		wantErr           error
 	}{
+		"should stream every page of log events of each log stream": {
+			logGroupName: "mockLogGroup",
+			startTime:    aws.Int64(1234),
+			endTime:      aws.Int64(5678),
+			streamPages:  true,
+			mockcloudwatchlogsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeLogStreams(gomock.Any()).Return(&cloudwatchlogs.DescribeLogStreamsOutput{
+					LogStreams: []*cloudwatchlogs.LogStream{
+						{
+							LogStreamName: aws.String("copilot/mockLogGroup/fooLogStream"),
+						},
+					},
+				}, nil)
+				m.EXPECT().GetLogEvents(&cloudwatchlogs.GetLogEventsInput{
+					LogGroupName:  aws.String("mockLogGroup"),
+					LogStreamName: aws.String("copilot/mockLogGroup/fooLogStream"),
+					StartTime:     aws.Int64(1234),
+					EndTime:       aws.Int64(5678),
+					StartFromHead: aws.Bool(true),
+				}).Return(&cloudwatchlogs.GetLogEventsOutput{
+					Events: []*cloudwatchlogs.OutputLogEvent{
+						{
+							Message:   aws.String("first page"),
+							Timestamp: aws.Int64(2000),
+						},
+					},
+					NextForwardToken: aws.String("f/1"),
+				}, nil)
+				m.EXPECT().GetLogEvents(&cloudwatchlogs.GetLogEventsInput{
+					LogGroupName:  aws.String("mockLogGroup"),
+					LogStreamName: aws.String("copilot/mockLogGroup/fooLogStream"),
+					StartTime:     aws.Int64(1234),
+					EndTime:       aws.Int64(5678),
+					StartFromHead: aws.Bool(true),
+					NextToken:     aws.String("f/1"),
+				}).Return(&cloudwatchlogs.GetLogEventsOutput{
+					Events: []*cloudwatchlogs.OutputLogEvent{
+						{
+							Message:   aws.String("second page"),
+							Timestamp: aws.Int64(3000),
+						},
+					},
+					NextForwardToken: aws.String("f/2"),
+				}, nil)
+				m.EXPECT().GetLogEvents(&cloudwatchlogs.GetLogEventsInput{
+					LogGroupName:  aws.String("mockLogGroup"),
+					LogStreamName: aws.String("copilot/mockLogGroup/fooLogStream"),
+					StartTime:     aws.Int64(1234),
+					EndTime:       aws.Int64(5678),
+					StartFromHead: aws.Bool(true),
+					NextToken:     aws.String("f/2"),
+				}).Return(&cloudwatchlogs.GetLogEventsOutput{
+					NextForwardToken: aws.String("f/2"),
+				}, nil)
+			},
+			wantPages: [][]*Event{
+				{
+					{
+						LogStreamName: "copilot/mockLogGroup/fooLogStream",
+						Message:       "first page",
+						Timestamp:     2000,
+					},
+				},
+				{
+					{
+						LogStreamName: "copilot/mockLogGroup/fooLogStream",
+						Message:       "second page",
+						Timestamp:     3000,
+					},
+				},
+			},
+			wantLastEventTime: map[string]int64{
+				"copilot/mockLogGroup/fooLogStream": 3000,
+			},
+		},
 		"should get log stream name and return log events": {
 			logGroupName: "mockLogGroup",
 			logStream:    []string{"copilot/mockLogGroup/foo", "copilot/mockLogGroup/bar"},
@@ -371,6 +450,14 @@ func TestLogEvents(t *testing.T) {
 			service := CloudWatchLogs{
 				client: mockcloudwatchlogsClient,
 			}
+			var gotPages [][]*Event
+			var onPage func(events []*Event) error
+			if tc.streamPages {
+				onPage = func(events []*Event) error {
+					gotPages = append(gotPages, events)
+					return nil
+				}
+			}
 			gotLogEventsOutput, gotErr := service.LogEvents(LogEventsOpts{
 				LogGroup:               tc.logGroupName,
 				EndTime:                tc.endTime,
@@ -379,6 +466,7 @@ func TestLogEvents(t *testing.T) {
 				StartTime:              tc.startTime,
 				StreamLastEventTime:    tc.lastEventTime,
 				LogStreamLimit:         tc.logStreamLimit,
+				OnPage:                 onPage,
 			})
 
 			if gotErr != nil {
@@ -386,8 +474,91 @@ func TestLogEvents(t *testing.T) {
 			} else {
 				require.NoError(t, gotErr)
 				require.ElementsMatch(t, tc.wantLogEvents, gotLogEventsOutput.Events)
+				require.Equal(t, tc.wantPages, gotPages)
 				require.Equal(t, tc.wantLastEventTime, gotLogEventsOutput.StreamLastEventTime)
 			}
 		})
 	}
 }
+
+func TestCloudWatchLogs_PutLogEvent(t *testing.T) {
+	mockTime := time.Unix(1494505750, 0)
+	putInput := &cloudwatchlogs.PutLogEventsInput{
+		LogGroupName:  aws.String("copilot-audit"),
+		LogStreamName: aws.String("2017/05/11"),
+		LogEvents: []*cloudwatchlogs.InputLogEvent{
+			{
+				Message:   aws.String("hello"),
+				Timestamp: aws.Int64(1494505750000),
+			},
+		},
+	}
+	errNotFound := awserr.New(cloudwatchlogs.ErrCodeResourceNotFoundException, "The specified log group does not exist.", nil)
+	errExists := awserr.New(cloudwatchlogs.ErrCodeResourceAlreadyExistsException, "The specified log group already exists.", nil)
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wantedErr error
+	}{
+		"error if fail to put the log event": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().PutLogEvents(putInput).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("put log event in copilot-audit/2017/05/11: some error"),
+		},
+		"puts the log event in an existing log stream": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().PutLogEvents(putInput).Return(&cloudwatchlogs.PutLogEventsOutput{}, nil)
+			},
+		},
+		"error if fail to create the log group": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().PutLogEvents(putInput).Return(nil, errNotFound)
+				m.EXPECT().CreateLogGroup(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("create log group copilot-audit: some error"),
+		},
+		"creates the log stream in an existing log group": {
+			setupMocks: func(m *mocks.Mockapi) {
+				gomock.InOrder(
+					m.EXPECT().PutLogEvents(putInput).Return(nil, errNotFound),
+					m.EXPECT().CreateLogGroup(&cloudwatchlogs.CreateLogGroupInput{
+						LogGroupName: aws.String("copilot-audit"),
+					}).Return(nil, errExists),
+					m.EXPECT().CreateLogStream(&cloudwatchlogs.CreateLogStreamInput{
+						LogGroupName:  aws.String("copilot-audit"),
+						LogStreamName: aws.String("2017/05/11"),
+					}).Return(&cloudwatchlogs.CreateLogStreamOutput{}, nil),
+					m.EXPECT().PutLogEvents(putInput).Return(&cloudwatchlogs.PutLogEventsOutput{}, nil),
+				)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			service := CloudWatchLogs{
+				client: m,
+			}
+
+			// WHEN
+			err := service.PutLogEvent(PutLogEventInput{
+				LogGroup:  "copilot-audit",
+				LogStream: "2017/05/11",
+				Message:   "hello",
+				Timestamp: mockTime,
+			})
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
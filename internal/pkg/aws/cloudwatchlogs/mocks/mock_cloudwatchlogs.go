@@ -34,6 +34,36 @@ func (m *Mockapi) EXPECT() *MockapiMockRecorder {
 	return m.recorder
 }
 
+// CreateLogGroup mocks base method.
+func (m *Mockapi) CreateLogGroup(input *cloudwatchlogs.CreateLogGroupInput) (*cloudwatchlogs.CreateLogGroupOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLogGroup", input)
+	ret0, _ := ret[0].(*cloudwatchlogs.CreateLogGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLogGroup indicates an expected call of CreateLogGroup.
+func (mr *MockapiMockRecorder) CreateLogGroup(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLogGroup", reflect.TypeOf((*Mockapi)(nil).CreateLogGroup), input)
+}
+
+// CreateLogStream mocks base method.
+func (m *Mockapi) CreateLogStream(input *cloudwatchlogs.CreateLogStreamInput) (*cloudwatchlogs.CreateLogStreamOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLogStream", input)
+	ret0, _ := ret[0].(*cloudwatchlogs.CreateLogStreamOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLogStream indicates an expected call of CreateLogStream.
+func (mr *MockapiMockRecorder) CreateLogStream(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLogStream", reflect.TypeOf((*Mockapi)(nil).CreateLogStream), input)
+}
+
 // DescribeLogStreams mocks base method.
 func (m *Mockapi) DescribeLogStreams(input *cloudwatchlogs.DescribeLogStreamsInput) (*cloudwatchlogs.DescribeLogStreamsOutput, error) {
 	m.ctrl.T.Helper()
@@ -63,3 +93,18 @@ func (mr *MockapiMockRecorder) GetLogEvents(input interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogEvents", reflect.TypeOf((*Mockapi)(nil).GetLogEvents), input)
 }
+
+// PutLogEvents mocks base method.
+func (m *Mockapi) PutLogEvents(input *cloudwatchlogs.PutLogEventsInput) (*cloudwatchlogs.PutLogEventsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutLogEvents", input)
+	ret0, _ := ret[0].(*cloudwatchlogs.PutLogEventsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutLogEvents indicates an expected call of PutLogEvents.
+func (mr *MockapiMockRecorder) PutLogEvents(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutLogEvents", reflect.TypeOf((*Mockapi)(nil).PutLogEvents), input)
+}
@@ -0,0 +1,113 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package codebuild provides a client to make API requests to AWS CodeBuild.
+package codebuild
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/codebuild"
+)
+
+// Statuses of a build.
+const (
+	BuildStatusInProgress = codebuild.StatusTypeInProgress
+	BuildStatusSucceeded  = codebuild.StatusTypeSucceeded
+)
+
+type api interface {
+	StartBuild(input *codebuild.StartBuildInput) (*codebuild.StartBuildOutput, error)
+	BatchGetBuilds(input *codebuild.BatchGetBuildsInput) (*codebuild.BatchGetBuildsOutput, error)
+}
+
+// CodeBuild wraps an AWS CodeBuild client.
+type CodeBuild struct {
+	client api
+}
+
+// New returns a CodeBuild struct configured against the input session.
+func New(s *session.Session) *CodeBuild {
+	return &CodeBuild{
+		client: codebuild.New(s),
+	}
+}
+
+// Build holds the status of a build and the location of its logs.
+type Build struct {
+	ID        string
+	Status    string
+	LogGroup  string // Empty until the build starts writing logs.
+	LogStream string
+}
+
+// IsInProgress returns true if the build hasn't completed yet.
+func (b *Build) IsInProgress() bool {
+	return b.Status == BuildStatusInProgress
+}
+
+// StartBuild starts a build of the project with the environment variables, and returns the ID of the build.
+// It returns ErrProjectNotFound if the project doesn't exist.
+func (c *CodeBuild) StartBuild(project string, envVars map[string]string) (string, error) {
+	names := make([]string, 0, len(envVars))
+	for name := range envVars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	overrides := make([]*codebuild.EnvironmentVariable, len(names))
+	for i, name := range names {
+		overrides[i] = &codebuild.EnvironmentVariable{
+			Name:  aws.String(name),
+			Value: aws.String(envVars[name]),
+			Type:  aws.String(codebuild.EnvironmentVariableTypePlaintext),
+		}
+	}
+	out, err := c.client.StartBuild(&codebuild.StartBuildInput{
+		ProjectName:                  aws.String(project),
+		EnvironmentVariablesOverride: overrides,
+	})
+	if err != nil {
+		var aerr awserr.Error
+		if errors.As(err, &aerr) && aerr.Code() == codebuild.ErrCodeResourceNotFoundException {
+			return "", &ErrProjectNotFound{project: project}
+		}
+		return "", fmt.Errorf("start build of project %s: %w", project, err)
+	}
+	return aws.StringValue(out.Build.Id), nil
+}
+
+// Build returns the build with the ID.
+func (c *CodeBuild) Build(id string) (*Build, error) {
+	out, err := c.client.BatchGetBuilds(&codebuild.BatchGetBuildsInput{
+		Ids: aws.StringSlice([]string{id}),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get build %s: %w", id, err)
+	}
+	if len(out.Builds) == 0 {
+		return nil, fmt.Errorf("build %s not found", id)
+	}
+	build := &Build{
+		ID:     id,
+		Status: aws.StringValue(out.Builds[0].BuildStatus),
+	}
+	if logs := out.Builds[0].Logs; logs != nil {
+		build.LogGroup = aws.StringValue(logs.GroupName)
+		build.LogStream = aws.StringValue(logs.StreamName)
+	}
+	return build, nil
+}
+
+// ErrProjectNotFound is returned when a CodeBuild project doesn't exist.
+type ErrProjectNotFound struct {
+	project string
+}
+
+func (e *ErrProjectNotFound) Error() string {
+	return fmt.Sprintf("CodeBuild project %s not found", e.project)
+}
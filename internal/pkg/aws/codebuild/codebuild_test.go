@@ -0,0 +1,171 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package codebuild
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/codebuild"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codebuild/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCodeBuild_StartBuild(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wantedID  string
+		wantedErr error
+	}{
+		"error if the project doesn't exist": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().StartBuild(gomock.Any()).Return(nil, awserr.New(codebuild.ErrCodeResourceNotFoundException, "Project cannot be found", nil))
+			},
+			wantedErr: errors.New("CodeBuild project phonetool-test-TaskRunner not found"),
+		},
+		"error if the build cannot be started": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().StartBuild(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("start build of project phonetool-test-TaskRunner: some error"),
+		},
+		"starts the build with the environment variables": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().StartBuild(&codebuild.StartBuildInput{
+					ProjectName: aws.String("phonetool-test-TaskRunner"),
+					EnvironmentVariablesOverride: []*codebuild.EnvironmentVariable{
+						{
+							Name:  aws.String("A"),
+							Value: aws.String("1"),
+							Type:  aws.String("PLAINTEXT"),
+						},
+						{
+							Name:  aws.String("B"),
+							Value: aws.String("2"),
+							Type:  aws.String("PLAINTEXT"),
+						},
+					},
+				}).Return(&codebuild.StartBuildOutput{
+					Build: &codebuild.Build{
+						Id: aws.String("phonetool-test-TaskRunner:1234"),
+					},
+				}, nil)
+			},
+			wantedID: "phonetool-test-TaskRunner:1234",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			cb := CodeBuild{
+				client: m,
+			}
+
+			// WHEN
+			id, err := cb.StartBuild("phonetool-test-TaskRunner", map[string]string{
+				"B": "2",
+				"A": "1",
+			})
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedID, id)
+		})
+	}
+}
+
+func TestCodeBuild_Build(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wantedBuild *Build
+		wantedErr   error
+	}{
+		"error if the build cannot be retrieved": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().BatchGetBuilds(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get build phonetool-test-TaskRunner:1234: some error"),
+		},
+		"error if the build doesn't exist": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().BatchGetBuilds(gomock.Any()).Return(&codebuild.BatchGetBuildsOutput{}, nil)
+			},
+			wantedErr: errors.New("build phonetool-test-TaskRunner:1234 not found"),
+		},
+		"returns a build without logs yet": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().BatchGetBuilds(gomock.Any()).Return(&codebuild.BatchGetBuildsOutput{
+					Builds: []*codebuild.Build{
+						{
+							BuildStatus: aws.String("IN_PROGRESS"),
+						},
+					},
+				}, nil)
+			},
+			wantedBuild: &Build{
+				ID:     "phonetool-test-TaskRunner:1234",
+				Status: BuildStatusInProgress,
+			},
+		},
+		"returns the build with its logs": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().BatchGetBuilds(&codebuild.BatchGetBuildsInput{
+					Ids: aws.StringSlice([]string{"phonetool-test-TaskRunner:1234"}),
+				}).Return(&codebuild.BatchGetBuildsOutput{
+					Builds: []*codebuild.Build{
+						{
+							BuildStatus: aws.String("FAILED"),
+							Logs: &codebuild.LogsLocation{
+								GroupName:  aws.String("/aws/codebuild/phonetool-test-TaskRunner"),
+								StreamName: aws.String("1234"),
+							},
+						},
+					},
+				}, nil)
+			},
+			wantedBuild: &Build{
+				ID:        "phonetool-test-TaskRunner:1234",
+				Status:    "FAILED",
+				LogGroup:  "/aws/codebuild/phonetool-test-TaskRunner",
+				LogStream: "1234",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			cb := CodeBuild{
+				client: m,
+			}
+
+			// WHEN
+			build, err := cb.Build("phonetool-test-TaskRunner:1234")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedBuild, build)
+		})
+	}
+}
@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/aws/codebuild/codebuild.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	codebuild "github.com/aws/aws-sdk-go/service/codebuild"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// Mockapi is a mock of api interface.
+type Mockapi struct {
+	ctrl     *gomock.Controller
+	recorder *MockapiMockRecorder
+}
+
+// MockapiMockRecorder is the mock recorder for Mockapi.
+type MockapiMockRecorder struct {
+	mock *Mockapi
+}
+
+// NewMockapi creates a new mock instance.
+func NewMockapi(ctrl *gomock.Controller) *Mockapi {
+	mock := &Mockapi{ctrl: ctrl}
+	mock.recorder = &MockapiMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockapi) EXPECT() *MockapiMockRecorder {
+	return m.recorder
+}
+
+// BatchGetBuilds mocks base method.
+func (m *Mockapi) BatchGetBuilds(input *codebuild.BatchGetBuildsInput) (*codebuild.BatchGetBuildsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchGetBuilds", input)
+	ret0, _ := ret[0].(*codebuild.BatchGetBuildsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchGetBuilds indicates an expected call of BatchGetBuilds.
+func (mr *MockapiMockRecorder) BatchGetBuilds(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGetBuilds", reflect.TypeOf((*Mockapi)(nil).BatchGetBuilds), input)
+}
+
+// StartBuild mocks base method.
+func (m *Mockapi) StartBuild(input *codebuild.StartBuildInput) (*codebuild.StartBuildOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartBuild", input)
+	ret0, _ := ret[0].(*codebuild.StartBuildOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartBuild indicates an expected call of StartBuild.
+func (mr *MockapiMockRecorder) StartBuild(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartBuild", reflect.TypeOf((*Mockapi)(nil).StartBuild), input)
+}
@@ -40,6 +40,7 @@ type api interface {
 	DescribeRouteTables(input *ec2.DescribeRouteTablesInput) (*ec2.DescribeRouteTablesOutput, error)
 	DescribeAvailabilityZones(input *ec2.DescribeAvailabilityZonesInput) (*ec2.DescribeAvailabilityZonesOutput, error)
 	DescribeManagedPrefixLists(input *ec2.DescribeManagedPrefixListsInput) (*ec2.DescribeManagedPrefixListsOutput, error)
+	DescribeAddresses(input *ec2.DescribeAddressesInput) (*ec2.DescribeAddressesOutput, error)
 }
 
 // Filter contains the name and values of a filter.
@@ -201,6 +202,22 @@ func (c *EC2) ListVPCs() ([]VPC, error) {
 	return vpcs, nil
 }
 
+// ElasticIPCount returns the number of Elastic IP addresses allocated for VPCs in the region.
+func (c *EC2) ElasticIPCount() (int, error) {
+	out, err := c.client.DescribeAddresses(&ec2.DescribeAddressesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("domain"),
+				Values: aws.StringSlice([]string{ec2.DomainTypeVpc}),
+			},
+		},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("describe Elastic IP addresses: %w", err)
+	}
+	return len(out.Addresses), nil
+}
+
 // ListAZs returns the list of opted-in and available availability zones.
 func (c *EC2) ListAZs() ([]AZ, error) {
 	resp, err := c.client.DescribeAvailabilityZones(&ec2.DescribeAvailabilityZonesInput{
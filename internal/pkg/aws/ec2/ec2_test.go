@@ -1011,3 +1011,57 @@ func TestEC2_HasDNSSupport(t *testing.T) {
 		})
 	}
 }
+
+func TestEC2_ElasticIPCount(t *testing.T) {
+	testCases := map[string]struct {
+		mockEC2Client func(m *mocks.Mockapi)
+
+		wantedError error
+		wantedCount int
+	}{
+		"fail to describe addresses": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeAddresses(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("describe Elastic IP addresses: some error"),
+		},
+		"success": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeAddresses(&ec2.DescribeAddressesInput{
+					Filters: []*ec2.Filter{
+						{
+							Name:   aws.String("domain"),
+							Values: aws.StringSlice([]string{"vpc"}),
+						},
+					},
+				}).Return(&ec2.DescribeAddressesOutput{
+					Addresses: []*ec2.Address{
+						{AllocationId: aws.String("eipalloc-1")},
+						{AllocationId: aws.String("eipalloc-2")},
+					},
+				}, nil)
+			},
+			wantedCount: 2,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.mockEC2Client(mockAPI)
+
+			ec2Client := EC2{
+				client: mockAPI,
+			}
+
+			count, err := ec2Client.ElasticIPCount()
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedCount, count)
+			}
+		})
+	}
+}
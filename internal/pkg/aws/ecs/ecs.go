@@ -7,6 +7,7 @@ package ecs
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -23,6 +24,7 @@ const (
 	waitServiceStablePollingInterval = 15 * time.Second
 	waitServiceStableMaxTry          = 80
 	stableServiceDeploymentNum       = 1
+	maxDeleteTaskDefinitions         = 10 // The maximum number of task definitions deleted per DeleteTaskDefinitions call.
 
 	// EndpointsID is the ID to look up the ECS service endpoint.
 	EndpointsID = ecs.EndpointsID
@@ -33,6 +35,9 @@ type api interface {
 	DescribeServices(input *ecs.DescribeServicesInput) (*ecs.DescribeServicesOutput, error)
 	DescribeTasks(input *ecs.DescribeTasksInput) (*ecs.DescribeTasksOutput, error)
 	DescribeTaskDefinition(input *ecs.DescribeTaskDefinitionInput) (*ecs.DescribeTaskDefinitionOutput, error)
+	ListTaskDefinitions(input *ecs.ListTaskDefinitionsInput) (*ecs.ListTaskDefinitionsOutput, error)
+	DeregisterTaskDefinition(input *ecs.DeregisterTaskDefinitionInput) (*ecs.DeregisterTaskDefinitionOutput, error)
+	DeleteTaskDefinitions(input *ecs.DeleteTaskDefinitionsInput) (*ecs.DeleteTaskDefinitionsOutput, error)
 	ExecuteCommand(input *ecs.ExecuteCommandInput) (*ecs.ExecuteCommandOutput, error)
 	ListTasks(input *ecs.ListTasksInput) (*ecs.ListTasksOutput, error)
 	RunTask(input *ecs.RunTaskInput) (*ecs.RunTaskOutput, error)
@@ -63,8 +68,21 @@ type RunTaskInput struct {
 	SecurityGroups  []string
 	TaskFamilyName  string
 	StartedBy       string
-	PlatformVersion string
+	PlatformVersion string // Only applies to tasks launched on Fargate.
 	EnableExec      bool
+
+	LaunchType       string // Defaults to FARGATE.
+	CapacityProvider string // Launches the tasks with the capacity provider instead of the launch type if set.
+}
+
+// RunCommandTaskInput holds the fields needed to run a task that overrides the command of a container.
+type RunCommandTaskInput struct {
+	Cluster        string
+	TaskDefinition string
+	Container      string
+	Command        []string
+	Network        NetworkConfiguration
+	StartedBy      string
 }
 
 // ExecuteCommandInput holds the fields needed to execute commands in a running container.
@@ -99,6 +117,75 @@ func (e *ECS) TaskDefinition(taskDefName string) (*TaskDefinition, error) {
 	return &td, nil
 }
 
+// ActiveTaskDefinitions calls ECS API and returns the ARNs of the active revisions of a task definition family,
+// sorted from the newest to the oldest revision.
+func (e *ECS) ActiveTaskDefinitions(family string) ([]string, error) {
+	var arns []string
+	in := &ecs.ListTaskDefinitionsInput{
+		FamilyPrefix: aws.String(family),
+		Status:       aws.String(ecs.TaskDefinitionStatusActive),
+		Sort:         aws.String(ecs.SortOrderDesc),
+	}
+	for {
+		resp, err := e.client.ListTaskDefinitions(in)
+		if err != nil {
+			return nil, fmt.Errorf("list task definitions of family %s: %w", family, err)
+		}
+		for _, arn := range aws.StringValueSlice(resp.TaskDefinitionArns) {
+			// The family prefix also matches the families that start with the same name.
+			if taskDefinitionFamily(arn) == family {
+				arns = append(arns, arn)
+			}
+		}
+		if resp.NextToken == nil {
+			break
+		}
+		in.NextToken = resp.NextToken
+	}
+	return arns, nil
+}
+
+// DeregisterTaskDefinition calls ECS API and marks a task definition revision as inactive.
+func (e *ECS) DeregisterTaskDefinition(arn string) error {
+	if _, err := e.client.DeregisterTaskDefinition(&ecs.DeregisterTaskDefinitionInput{
+		TaskDefinition: aws.String(arn),
+	}); err != nil {
+		return fmt.Errorf("deregister task definition %s: %w", arn, err)
+	}
+	return nil
+}
+
+// DeleteTaskDefinitions calls ECS API and deletes inactive task definition revisions.
+func (e *ECS) DeleteTaskDefinitions(arns []string) error {
+	for start := 0; start < len(arns); start += maxDeleteTaskDefinitions {
+		end := start + maxDeleteTaskDefinitions
+		if end > len(arns) {
+			end = len(arns)
+		}
+		resp, err := e.client.DeleteTaskDefinitions(&ecs.DeleteTaskDefinitionsInput{
+			TaskDefinitions: aws.StringSlice(arns[start:end]),
+		})
+		if err != nil {
+			return fmt.Errorf("delete task definitions: %w", err)
+		}
+		if len(resp.Failures) > 0 {
+			failure := resp.Failures[0]
+			return fmt.Errorf("delete task definition %s: %s", aws.StringValue(failure.Arn), aws.StringValue(failure.Reason))
+		}
+	}
+	return nil
+}
+
+// taskDefinitionFamily returns the family of a task definition ARN like
+// "arn:aws:ecs:us-west-2:123456789012:task-definition/family:3".
+func taskDefinitionFamily(arn string) string {
+	name := arn[strings.LastIndex(arn, "/")+1:]
+	if i := strings.LastIndex(name, ":"); i != -1 {
+		return name[:i]
+	}
+	return name
+}
+
 // Service calls ECS API and returns the specified service running in the cluster.
 func (e *ECS) Service(clusterName, serviceName string) (*Service, error) {
 	svcs, err := e.Services(clusterName, serviceName)
@@ -413,23 +500,40 @@ func (e *ECS) ActiveServices(clusterARN string, serviceARNs ...string) ([]string
 // RunTask runs a number of tasks with the task definition and network configurations in a cluster, and returns after
 // the task(s) is running or fails to run, along with task ARNs if possible.
 func (e *ECS) RunTask(input RunTaskInput) ([]*Task, error) {
-	resp, err := e.client.RunTask(&ecs.RunTaskInput{
+	in := &ecs.RunTaskInput{
 		Cluster:        aws.String(input.Cluster),
 		Count:          aws.Int64(int64(input.Count)),
-		LaunchType:     aws.String(ecs.LaunchTypeFargate),
 		StartedBy:      aws.String(input.StartedBy),
 		TaskDefinition: aws.String(input.TaskFamilyName),
 		NetworkConfiguration: &ecs.NetworkConfiguration{
 			AwsvpcConfiguration: &ecs.AwsVpcConfiguration{
-				AssignPublicIp: aws.String(ecs.AssignPublicIpEnabled),
+				// Tasks on EC2 container instances can't be assigned a public IP.
+				AssignPublicIp: aws.String(ecs.AssignPublicIpDisabled),
 				Subnets:        aws.StringSlice(input.Subnets),
 				SecurityGroups: aws.StringSlice(input.SecurityGroups),
 			},
 		},
 		EnableExecuteCommand: aws.Bool(input.EnableExec),
-		PlatformVersion:      aws.String(input.PlatformVersion),
 		PropagateTags:        aws.String(ecs.PropagateTagsTaskDefinition),
-	})
+	}
+	if input.CapacityProvider != "" {
+		in.CapacityProviderStrategy = []*ecs.CapacityProviderStrategyItem{
+			{
+				CapacityProvider: aws.String(input.CapacityProvider),
+				Weight:           aws.Int64(1),
+			},
+		}
+	} else {
+		in.LaunchType = aws.String(ecs.LaunchTypeFargate)
+		if input.LaunchType != "" {
+			in.LaunchType = aws.String(input.LaunchType)
+		}
+	}
+	if input.isFargate() {
+		in.NetworkConfiguration.AwsvpcConfiguration.AssignPublicIp = aws.String(ecs.AssignPublicIpEnabled)
+		in.PlatformVersion = aws.String(input.PlatformVersion)
+	}
+	resp, err := e.client.RunTask(in)
 	if err != nil {
 		return nil, fmt.Errorf("run task(s) %s: %w", input.TaskFamilyName, err)
 	}
@@ -461,6 +565,56 @@ func (e *ECS) RunTask(input RunTaskInput) ([]*Task, error) {
 	return tasks, nil
 }
 
+// isFargate returns true if the tasks are launched on Fargate rather than on EC2 container instances.
+func (in RunTaskInput) isFargate() bool {
+	switch in.CapacityProvider {
+	case "":
+		return in.LaunchType == "" || in.LaunchType == ecs.LaunchTypeFargate
+	case TaskCapacityProviderFargate, TaskCapacityProviderFargateSpot:
+		return true
+	default:
+		return false
+	}
+}
+
+// RunCommandTask starts a Fargate task that runs the command in the container, and returns the ARN of the task
+// without waiting for it to be running.
+func (e *ECS) RunCommandTask(input RunCommandTaskInput) (string, error) {
+	resp, err := e.client.RunTask(&ecs.RunTaskInput{
+		Cluster:        aws.String(input.Cluster),
+		Count:          aws.Int64(1),
+		LaunchType:     aws.String(ecs.LaunchTypeFargate),
+		StartedBy:      aws.String(input.StartedBy),
+		TaskDefinition: aws.String(input.TaskDefinition),
+		NetworkConfiguration: &ecs.NetworkConfiguration{
+			AwsvpcConfiguration: &ecs.AwsVpcConfiguration{
+				AssignPublicIp: aws.String(input.Network.AssignPublicIp),
+				Subnets:        aws.StringSlice(input.Network.Subnets),
+				SecurityGroups: aws.StringSlice(input.Network.SecurityGroups),
+			},
+		},
+		Overrides: &ecs.TaskOverride{
+			ContainerOverrides: []*ecs.ContainerOverride{
+				{
+					Name:    aws.String(input.Container),
+					Command: aws.StringSlice(input.Command),
+				},
+			},
+		},
+		PropagateTags: aws.String(ecs.PropagateTagsTaskDefinition),
+	})
+	if err != nil {
+		return "", fmt.Errorf("run task %s: %w", input.TaskDefinition, err)
+	}
+	if len(resp.Failures) > 0 {
+		return "", fmt.Errorf("run task %s: %s", input.TaskDefinition, aws.StringValue(resp.Failures[0].Reason))
+	}
+	if len(resp.Tasks) == 0 {
+		return "", fmt.Errorf("run task %s: no task was started", input.TaskDefinition)
+	}
+	return aws.StringValue(resp.Tasks[0].TaskArn), nil
+}
+
 // DescribeTasks returns the tasks with the taskARNs in the cluster.
 func (e *ECS) DescribeTasks(cluster string, taskARNs []string) ([]*Task, error) {
 	resp, err := e.client.DescribeTasks(&ecs.DescribeTasksInput{
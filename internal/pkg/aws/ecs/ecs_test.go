@@ -104,6 +104,136 @@ func TestECS_TaskDefinition(t *testing.T) {
 	}
 }
 
+func TestECS_ActiveTaskDefinitions(t *testing.T) {
+	testCases := map[string]struct {
+		mockECSClient func(m *mocks.Mockapi)
+
+		wantErr  error
+		wantARNs []string
+	}{
+		"should return wrapped error given error": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListTaskDefinitions(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: errors.New("list task definitions of family phonetool-test-api: some error"),
+		},
+		"returns the revisions of the family across pages": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListTaskDefinitions(&ecs.ListTaskDefinitionsInput{
+					FamilyPrefix: aws.String("phonetool-test-api"),
+					Status:       aws.String("ACTIVE"),
+					Sort:         aws.String("DESC"),
+				}).Return(&ecs.ListTaskDefinitionsOutput{
+					TaskDefinitionArns: aws.StringSlice([]string{
+						"arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-api:3",
+						"arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-api-worker:8",
+					}),
+					NextToken: aws.String("next"),
+				}, nil)
+				m.EXPECT().ListTaskDefinitions(&ecs.ListTaskDefinitionsInput{
+					FamilyPrefix: aws.String("phonetool-test-api"),
+					Status:       aws.String("ACTIVE"),
+					Sort:         aws.String("DESC"),
+					NextToken:    aws.String("next"),
+				}).Return(&ecs.ListTaskDefinitionsOutput{
+					TaskDefinitionArns: aws.StringSlice([]string{
+						"arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-api:2",
+					}),
+				}, nil)
+			},
+			wantARNs: []string{
+				"arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-api:3",
+				"arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-api:2",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECSClient := mocks.NewMockapi(ctrl)
+			tc.mockECSClient(mockECSClient)
+
+			service := ECS{
+				client: mockECSClient,
+			}
+
+			got, err := service.ActiveTaskDefinitions("phonetool-test-api")
+
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantARNs, got)
+		})
+	}
+}
+
+func TestECS_DeleteTaskDefinitions(t *testing.T) {
+	arns := make([]string, 12)
+	for i := range arns {
+		arns[i] = fmt.Sprintf("arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-api:%d", i+1)
+	}
+	testCases := map[string]struct {
+		mockECSClient func(m *mocks.Mockapi)
+
+		wantErr error
+	}{
+		"deletes the task definitions in batches": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				gomock.InOrder(
+					m.EXPECT().DeleteTaskDefinitions(&ecs.DeleteTaskDefinitionsInput{
+						TaskDefinitions: aws.StringSlice(arns[:10]),
+					}).Return(&ecs.DeleteTaskDefinitionsOutput{}, nil),
+					m.EXPECT().DeleteTaskDefinitions(&ecs.DeleteTaskDefinitionsInput{
+						TaskDefinitions: aws.StringSlice(arns[10:]),
+					}).Return(&ecs.DeleteTaskDefinitionsOutput{}, nil),
+				)
+			},
+		},
+		"should return the first failure": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DeleteTaskDefinitions(gomock.Any()).Return(&ecs.DeleteTaskDefinitionsOutput{
+					Failures: []*ecs.Failure{
+						{
+							Arn:    aws.String(arns[0]),
+							Reason: aws.String("TASK_DEFINITION_IN_USE"),
+						},
+					},
+				}, nil)
+			},
+			wantErr: fmt.Errorf("delete task definition %s: TASK_DEFINITION_IN_USE", arns[0]),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECSClient := mocks.NewMockapi(ctrl)
+			tc.mockECSClient(mockECSClient)
+
+			service := ECS{
+				client: mockECSClient,
+			}
+
+			err := service.DeleteTaskDefinitions(arns)
+
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestECS_Service(t *testing.T) {
 	testCases := map[string]struct {
 		clusterName   string
@@ -1238,6 +1368,9 @@ func TestECS_RunTask(t *testing.T) {
 		startedBy       string
 		platformVersion string
 		enableExec      bool
+
+		launchType       string
+		capacityProvider string
 	}
 
 	runTaskInput := input{
@@ -1313,6 +1446,91 @@ func TestECS_RunTask(t *testing.T) {
 				},
 			},
 		},
+		"run tasks on EC2 container instances": {
+			input: input{
+				cluster:         "my-cluster",
+				count:           3,
+				subnets:         []string{"subnet-1", "subnet-2"},
+				securityGroups:  []string{"sg-1", "sg-2"},
+				taskFamilyName:  "my-task",
+				startedBy:       "task",
+				platformVersion: "LATEST",
+				enableExec:      true,
+				launchType:      "EC2",
+			},
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().RunTask(&ecs.RunTaskInput{
+					Cluster:        aws.String("my-cluster"),
+					Count:          aws.Int64(3),
+					LaunchType:     aws.String(ecs.LaunchTypeEc2),
+					StartedBy:      aws.String("task"),
+					TaskDefinition: aws.String("my-task"),
+					NetworkConfiguration: &ecs.NetworkConfiguration{
+						AwsvpcConfiguration: &ecs.AwsVpcConfiguration{
+							AssignPublicIp: aws.String(ecs.AssignPublicIpDisabled),
+							Subnets:        aws.StringSlice([]string{"subnet-1", "subnet-2"}),
+							SecurityGroups: aws.StringSlice([]string{"sg-1", "sg-2"}),
+						},
+					},
+					EnableExecuteCommand: aws.Bool(true),
+					PropagateTags:        aws.String(ecs.PropagateTagsTaskDefinition),
+				}).Return(&ecs.RunTaskOutput{
+					Tasks: ecsTasks,
+				}, nil)
+				m.EXPECT().WaitUntilTasksRunning(&describeTasksInput).Times(1)
+				m.EXPECT().DescribeTasks(&describeTasksInput).Return(&ecs.DescribeTasksOutput{
+					Tasks: ecsTasks,
+				}, nil)
+			},
+			wantedTasks: []*Task{
+				{
+					TaskArn: aws.String("task-1"),
+				},
+				{
+					TaskArn: aws.String("task-2"),
+				},
+				{
+					TaskArn: aws.String("task-3"),
+				},
+			},
+		},
+		"run tasks with a capacity provider": {
+			input: input{
+				cluster:          "my-cluster",
+				count:            3,
+				subnets:          []string{"subnet-1", "subnet-2"},
+				securityGroups:   []string{"sg-1", "sg-2"},
+				taskFamilyName:   "my-task",
+				startedBy:        "task",
+				platformVersion:  "LATEST",
+				enableExec:       true,
+				capacityProvider: "gpu-instances",
+			},
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().RunTask(&ecs.RunTaskInput{
+					Cluster: aws.String("my-cluster"),
+					Count:   aws.Int64(3),
+					CapacityProviderStrategy: []*ecs.CapacityProviderStrategyItem{
+						{
+							CapacityProvider: aws.String("gpu-instances"),
+							Weight:           aws.Int64(1),
+						},
+					},
+					StartedBy:      aws.String("task"),
+					TaskDefinition: aws.String("my-task"),
+					NetworkConfiguration: &ecs.NetworkConfiguration{
+						AwsvpcConfiguration: &ecs.AwsVpcConfiguration{
+							AssignPublicIp: aws.String(ecs.AssignPublicIpDisabled),
+							Subnets:        aws.StringSlice([]string{"subnet-1", "subnet-2"}),
+							SecurityGroups: aws.StringSlice([]string{"sg-1", "sg-2"}),
+						},
+					},
+					EnableExecuteCommand: aws.Bool(true),
+					PropagateTags:        aws.String(ecs.PropagateTagsTaskDefinition),
+				}).Return(&ecs.RunTaskOutput{}, errors.New("error"))
+			},
+			wantedError: errors.New("run task(s) my-task: error"),
+		},
 		"run task failed": {
 			input: runTaskInput,
 
@@ -1438,6 +1656,9 @@ func TestECS_RunTask(t *testing.T) {
 				StartedBy:       tc.startedBy,
 				PlatformVersion: tc.platformVersion,
 				EnableExec:      tc.enableExec,
+
+				LaunchType:       tc.launchType,
+				CapacityProvider: tc.capacityProvider,
 			})
 
 			if tc.wantedError != nil {
@@ -1449,6 +1670,104 @@ func TestECS_RunTask(t *testing.T) {
 	}
 }
 
+func TestECS_RunCommandTask(t *testing.T) {
+	wantedRunTaskInput := &ecs.RunTaskInput{
+		Cluster:        aws.String("my-cluster"),
+		Count:          aws.Int64(1),
+		LaunchType:     aws.String(ecs.LaunchTypeFargate),
+		StartedBy:      aws.String("copilot-deployment-validation"),
+		TaskDefinition: aws.String("arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-api:3"),
+		NetworkConfiguration: &ecs.NetworkConfiguration{
+			AwsvpcConfiguration: &ecs.AwsVpcConfiguration{
+				AssignPublicIp: aws.String(ecs.AssignPublicIpDisabled),
+				Subnets:        aws.StringSlice([]string{"subnet-1", "subnet-2"}),
+				SecurityGroups: aws.StringSlice([]string{"sg-1"}),
+			},
+		},
+		Overrides: &ecs.TaskOverride{
+			ContainerOverrides: []*ecs.ContainerOverride{
+				{
+					Name:    aws.String("api"),
+					Command: aws.StringSlice([]string{"./smoke-test.sh", "--quick"}),
+				},
+			},
+		},
+		PropagateTags: aws.String(ecs.PropagateTagsTaskDefinition),
+	}
+	testCases := map[string]struct {
+		mockECSClient func(m *mocks.Mockapi)
+
+		wantedError   error
+		wantedTaskARN string
+	}{
+		"errors if the task cannot be run": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().RunTask(wantedRunTaskInput).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("run task arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-api:3: some error"),
+		},
+		"errors if ECS fails to place the task": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().RunTask(wantedRunTaskInput).Return(&ecs.RunTaskOutput{
+					Failures: []*ecs.Failure{
+						{
+							Reason: aws.String("RESOURCE:ENI"),
+						},
+					},
+				}, nil)
+			},
+			wantedError: errors.New("run task arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-api:3: RESOURCE:ENI"),
+		},
+		"returns the ARN of the started task": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().RunTask(wantedRunTaskInput).Return(&ecs.RunTaskOutput{
+					Tasks: []*ecs.Task{
+						{
+							TaskArn: aws.String("task-1"),
+						},
+					},
+				}, nil)
+			},
+			wantedTaskARN: "task-1",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockECSClient := mocks.NewMockapi(ctrl)
+			tc.mockECSClient(mockECSClient)
+			ecs := ECS{
+				client: mockECSClient,
+			}
+
+			// WHEN
+			taskARN, err := ecs.RunCommandTask(RunCommandTaskInput{
+				Cluster:        "my-cluster",
+				TaskDefinition: "arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-api:3",
+				Container:      "api",
+				Command:        []string{"./smoke-test.sh", "--quick"},
+				Network: NetworkConfiguration{
+					AssignPublicIp: "DISABLED",
+					Subnets:        []string{"subnet-1", "subnet-2"},
+					SecurityGroups: []string{"sg-1"},
+				},
+				StartedBy: "copilot-deployment-validation",
+			})
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedTaskARN, taskARN)
+		})
+	}
+}
+
 func TestECS_DescribeTasks(t *testing.T) {
 	inCluster := "my-cluster"
 	inTaskARNs := []string{"task-1", "task-2", "task-3"}
@@ -101,3 +101,13 @@ func (e *ErrTaskENIInfoNotFound) Error() string {
 	}
 	return ""
 }
+
+// ErrExecuteCommandAgentNotRunning occurs when the ECS Exec agent of a container is not running yet.
+type ErrExecuteCommandAgentNotRunning struct {
+	taskID    string
+	container string
+}
+
+func (e *ErrExecuteCommandAgentNotRunning) Error() string {
+	return fmt.Sprintf("the ECS Exec agent of container %s in task %s is not running", e.container, e.taskID)
+}
@@ -34,6 +34,36 @@ func (m *Mockapi) EXPECT() *MockapiMockRecorder {
 	return m.recorder
 }
 
+// DeleteTaskDefinitions mocks base method.
+func (m *Mockapi) DeleteTaskDefinitions(input *ecs.DeleteTaskDefinitionsInput) (*ecs.DeleteTaskDefinitionsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTaskDefinitions", input)
+	ret0, _ := ret[0].(*ecs.DeleteTaskDefinitionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTaskDefinitions indicates an expected call of DeleteTaskDefinitions.
+func (mr *MockapiMockRecorder) DeleteTaskDefinitions(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTaskDefinitions", reflect.TypeOf((*Mockapi)(nil).DeleteTaskDefinitions), input)
+}
+
+// DeregisterTaskDefinition mocks base method.
+func (m *Mockapi) DeregisterTaskDefinition(input *ecs.DeregisterTaskDefinitionInput) (*ecs.DeregisterTaskDefinitionOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeregisterTaskDefinition", input)
+	ret0, _ := ret[0].(*ecs.DeregisterTaskDefinitionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeregisterTaskDefinition indicates an expected call of DeregisterTaskDefinition.
+func (mr *MockapiMockRecorder) DeregisterTaskDefinition(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterTaskDefinition", reflect.TypeOf((*Mockapi)(nil).DeregisterTaskDefinition), input)
+}
+
 // DescribeClusters mocks base method.
 func (m *Mockapi) DescribeClusters(input *ecs.DescribeClustersInput) (*ecs.DescribeClustersOutput, error) {
 	m.ctrl.T.Helper()
@@ -123,6 +153,21 @@ func (mr *MockapiMockRecorder) ListServicesByNamespacePages(input, fn interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServicesByNamespacePages", reflect.TypeOf((*Mockapi)(nil).ListServicesByNamespacePages), input, fn)
 }
 
+// ListTaskDefinitions mocks base method.
+func (m *Mockapi) ListTaskDefinitions(input *ecs.ListTaskDefinitionsInput) (*ecs.ListTaskDefinitionsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTaskDefinitions", input)
+	ret0, _ := ret[0].(*ecs.ListTaskDefinitionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTaskDefinitions indicates an expected call of ListTaskDefinitions.
+func (mr *MockapiMockRecorder) ListTaskDefinitions(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskDefinitions", reflect.TypeOf((*Mockapi)(nil).ListTaskDefinitions), input)
+}
+
 // ListTasks mocks base method.
 func (m *Mockapi) ListTasks(input *ecs.ListTasksInput) (*ecs.ListTasksOutput, error) {
 	m.ctrl.T.Helper()
@@ -42,8 +42,8 @@ const (
 
 // Image contains very basic info of a container image.
 type Image struct {
-	ID     string
-	Digest string
+	ID     string `json:"id"`
+	Digest string `json:"digest"`
 }
 
 // Task wraps up ECS Task struct.
@@ -153,6 +153,50 @@ func (t *Task) attachmentENI() (*ecs.Attachment, error) {
 	return attachmentENI, nil
 }
 
+// ExitCode returns the exit code of the container of a stopped task.
+func (t *Task) ExitCode(containerName string) (int, error) {
+	for _, container := range t.Containers {
+		if aws.StringValue(container.Name) != containerName {
+			continue
+		}
+		if container.ExitCode == nil {
+			return 0, fmt.Errorf("container %s exited without an exit code: %s", containerName, aws.StringValue(container.Reason))
+		}
+		return int(aws.Int64Value(container.ExitCode)), nil
+	}
+	return 0, fmt.Errorf("container %s not found in task", containerName)
+}
+
+// SSMTarget returns the target of a Session Manager session with the container of a task that has ECS Exec enabled.
+// For example, "ecs:my-cluster_4082490ee6c245e09d2145010aa1ba8d_4082490ee6c245e09d2145010aa1ba8d-2531612879".
+func (t *Task) SSMTarget(containerName string) (string, error) {
+	taskID, err := TaskID(aws.StringValue(t.TaskArn))
+	if err != nil {
+		return "", err
+	}
+	clusterARN, err := arn.Parse(aws.StringValue(t.ClusterArn))
+	if err != nil {
+		return "", fmt.Errorf("parse cluster ARN %s: %w", aws.StringValue(t.ClusterArn), err)
+	}
+	cluster := strings.TrimPrefix(clusterARN.Resource, "cluster/")
+	for _, container := range t.Containers {
+		if aws.StringValue(container.Name) != containerName {
+			continue
+		}
+		for _, agent := range container.ManagedAgents {
+			if aws.StringValue(agent.Name) != ecs.ManagedAgentNameExecuteCommandAgent {
+				continue
+			}
+			if aws.StringValue(agent.LastStatus) != lastStatusRunning || container.RuntimeId == nil {
+				return "", &ErrExecuteCommandAgentNotRunning{taskID: taskID, container: containerName}
+			}
+			return fmt.Sprintf("ecs:%s_%s_%s", cluster, taskID, aws.StringValue(container.RuntimeId)), nil
+		}
+		return "", fmt.Errorf("ECS Exec is not enabled for container %s in task %s", containerName, taskID)
+	}
+	return "", fmt.Errorf("container %s not found in task %s", containerName, taskID)
+}
+
 // TaskStatus contains the status info of a task.
 type TaskStatus struct {
 	Health           string    `json:"health"`
@@ -260,6 +304,45 @@ func (t *TaskDefinition) EntryPoint(containerName string) ([]string, error) {
 	return nil, fmt.Errorf("container %s not found", containerName)
 }
 
+// ContainerEFSVolume holds basic info of an EFS file system mounted into a container.
+type ContainerEFSVolume struct {
+	Name          string
+	FileSystemID  string
+	ContainerPath string
+}
+
+// EFSVolumes returns the EFS file systems mounted at their root directory into the container of the task definition.
+// File systems mounted with an access point or a root directory are not returned.
+func (t *TaskDefinition) EFSVolumes(containerName string) ([]*ContainerEFSVolume, error) {
+	for _, container := range t.ContainerDefinitions {
+		if aws.StringValue(container.Name) != containerName {
+			continue
+		}
+		var volumes []*ContainerEFSVolume
+		for _, mp := range container.MountPoints {
+			for _, vol := range t.Volumes {
+				if aws.StringValue(vol.Name) != aws.StringValue(mp.SourceVolume) || vol.EfsVolumeConfiguration == nil {
+					continue
+				}
+				cfg := vol.EfsVolumeConfiguration
+				if root := aws.StringValue(cfg.RootDirectory); root != "" && root != "/" {
+					continue
+				}
+				if cfg.AuthorizationConfig != nil && aws.StringValue(cfg.AuthorizationConfig.AccessPointId) != "" {
+					continue
+				}
+				volumes = append(volumes, &ContainerEFSVolume{
+					Name:          aws.StringValue(vol.Name),
+					FileSystemID:  aws.StringValue(cfg.FileSystemId),
+					ContainerPath: aws.StringValue(mp.ContainerPath),
+				})
+			}
+		}
+		return volumes, nil
+	}
+	return nil, fmt.Errorf("container %s not found", containerName)
+}
+
 // TaskID parses the task ARN and returns the task ID.
 // For example: arn:aws:ecs:us-west-2:123456789:task/my-project-test-Cluster-9F7Y0RLP60R7/4082490ee6c245e09d2145010aa1ba8d,
 // arn:aws:ecs:us-west-2:123456789:task/4082490ee6c245e09d2145010aa1ba8d
@@ -306,6 +306,135 @@ func TestTask_PrivateIP(t *testing.T) {
 	}
 }
 
+func TestTask_ExitCode(t *testing.T) {
+	testCases := map[string]struct {
+		containers []*ecs.Container
+
+		wantedExitCode int
+		wantedErr      error
+	}{
+		"errors if the container is not in the task": {
+			containers: []*ecs.Container{
+				{
+					Name: aws.String("firelens"),
+				},
+			},
+			wantedErr: errors.New("container api not found in task"),
+		},
+		"errors if the container has no exit code": {
+			containers: []*ecs.Container{
+				{
+					Name:   aws.String("api"),
+					Reason: aws.String("CannotPullContainerError"),
+				},
+			},
+			wantedErr: errors.New("container api exited without an exit code: CannotPullContainerError"),
+		},
+		"returns the exit code of the container": {
+			containers: []*ecs.Container{
+				{
+					Name:     aws.String("firelens"),
+					ExitCode: aws.Int64(0),
+				},
+				{
+					Name:     aws.String("api"),
+					ExitCode: aws.Int64(2),
+				},
+			},
+			wantedExitCode: 2,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			task := Task{
+				Containers: tc.containers,
+			}
+
+			out, err := task.ExitCode("api")
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedExitCode, out)
+		})
+	}
+}
+
+func TestTask_SSMTarget(t *testing.T) {
+	testCases := map[string]struct {
+		containers []*ecs.Container
+
+		wanted    string
+		wantedErr error
+	}{
+		"errors if the container is not in the task": {
+			containers: []*ecs.Container{
+				{
+					Name: aws.String("firelens"),
+				},
+			},
+			wantedErr: errors.New("container api not found in task 4082490ee6c245e09d2145010aa1ba8d"),
+		},
+		"errors if ECS Exec is not enabled": {
+			containers: []*ecs.Container{
+				{
+					Name: aws.String("api"),
+				},
+			},
+			wantedErr: errors.New("ECS Exec is not enabled for container api in task 4082490ee6c245e09d2145010aa1ba8d"),
+		},
+		"errors if the agent is not running yet": {
+			containers: []*ecs.Container{
+				{
+					Name: aws.String("api"),
+					ManagedAgents: []*ecs.ManagedAgent{
+						{
+							Name:       aws.String("ExecuteCommandAgent"),
+							LastStatus: aws.String("PENDING"),
+						},
+					},
+				},
+			},
+			wantedErr: errors.New("the ECS Exec agent of container api in task 4082490ee6c245e09d2145010aa1ba8d is not running"),
+		},
+		"returns the target of the container": {
+			containers: []*ecs.Container{
+				{
+					Name:      aws.String("api"),
+					RuntimeId: aws.String("4082490ee6c245e09d2145010aa1ba8d-2531612879"),
+					ManagedAgents: []*ecs.ManagedAgent{
+						{
+							Name:       aws.String("ExecuteCommandAgent"),
+							LastStatus: aws.String("RUNNING"),
+						},
+					},
+				},
+			},
+			wanted: "ecs:phonetool-test-Cluster_4082490ee6c245e09d2145010aa1ba8d_4082490ee6c245e09d2145010aa1ba8d-2531612879",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			task := Task{
+				TaskArn:    aws.String("arn:aws:ecs:us-west-2:123456789012:task/phonetool-test-Cluster/4082490ee6c245e09d2145010aa1ba8d"),
+				ClusterArn: aws.String("arn:aws:ecs:us-west-2:123456789012:cluster/phonetool-test-Cluster"),
+				Containers: tc.containers,
+			}
+
+			out, err := task.SSMTarget("api")
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, out)
+		})
+	}
+}
+
 func Test_TaskID(t *testing.T) {
 	testCases := map[string]struct {
 		taskARN string
@@ -628,6 +757,109 @@ func TestTaskDefinition_EntryPoint(t *testing.T) {
 	}
 }
 
+func TestTaskDefinition_EFSVolumes(t *testing.T) {
+	testCases := map[string]struct {
+		inContainers    []*ecs.ContainerDefinition
+		inVolumes       []*ecs.Volume
+		inContainerName string
+
+		wantedVolumes []*ContainerEFSVolume
+		wantedError   error
+	}{
+		"should return the EFS volumes mounted at their root directory into the container": {
+			inContainers: []*ecs.ContainerDefinition{
+				{
+					Name: aws.String("container-1"),
+					MountPoints: []*ecs.MountPoint{
+						{SourceVolume: aws.String("data"), ContainerPath: aws.String("/data")},
+						{SourceVolume: aws.String("managed"), ContainerPath: aws.String("/managed")},
+						{SourceVolume: aws.String("subdir"), ContainerPath: aws.String("/subdir")},
+						{SourceVolume: aws.String("scratch"), ContainerPath: aws.String("/scratch")},
+					},
+				},
+				{
+					Name: aws.String("container-2"),
+					MountPoints: []*ecs.MountPoint{
+						{SourceVolume: aws.String("other"), ContainerPath: aws.String("/other")},
+					},
+				},
+			},
+			inVolumes: []*ecs.Volume{
+				{
+					Name: aws.String("data"),
+					EfsVolumeConfiguration: &ecs.EFSVolumeConfiguration{
+						FileSystemId:  aws.String("fs-1"),
+						RootDirectory: aws.String("/"),
+					},
+				},
+				{
+					Name: aws.String("managed"),
+					EfsVolumeConfiguration: &ecs.EFSVolumeConfiguration{
+						FileSystemId: aws.String("fs-2"),
+						AuthorizationConfig: &ecs.EFSAuthorizationConfig{
+							AccessPointId: aws.String("fsap-1"),
+						},
+					},
+				},
+				{
+					Name: aws.String("subdir"),
+					EfsVolumeConfiguration: &ecs.EFSVolumeConfiguration{
+						FileSystemId:  aws.String("fs-3"),
+						RootDirectory: aws.String("/subdir"),
+					},
+				},
+				{
+					Name: aws.String("scratch"),
+				},
+				{
+					Name: aws.String("other"),
+					EfsVolumeConfiguration: &ecs.EFSVolumeConfiguration{
+						FileSystemId: aws.String("fs-4"),
+					},
+				},
+			},
+			inContainerName: "container-1",
+			wantedVolumes: []*ContainerEFSVolume{
+				{
+					Name:          "data",
+					FileSystemID:  "fs-1",
+					ContainerPath: "/data",
+				},
+			},
+		},
+		"container not found": {
+			inContainers: []*ecs.ContainerDefinition{
+				{
+					Name: aws.String("container-1"),
+				},
+			},
+			inContainerName: "container-3",
+			wantedError:     errors.New("container container-3 not found"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			taskDefinition := TaskDefinition{
+				ContainerDefinitions: tc.inContainers,
+				Volumes:              tc.inVolumes,
+			}
+
+			// WHEN
+			got, err := taskDefinition.EFSVolumes(tc.inContainerName)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedVolumes, got)
+		})
+	}
+}
+
 func TestShortTaskID(t *testing.T) {
 	testCases := map[string]struct {
 		inTaskId     string
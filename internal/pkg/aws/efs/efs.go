@@ -0,0 +1,79 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package efs provides a client to make API requests to Amazon Elastic File System.
+package efs
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/efs"
+)
+
+type api interface {
+	DescribeMountTargets(input *efs.DescribeMountTargetsInput) (*efs.DescribeMountTargetsOutput, error)
+	DescribeMountTargetSecurityGroups(input *efs.DescribeMountTargetSecurityGroupsInput) (*efs.DescribeMountTargetSecurityGroupsOutput, error)
+}
+
+// EFS wraps an Amazon Elastic File System client.
+type EFS struct {
+	client api
+}
+
+// New returns an EFS struct configured against the input session.
+func New(s *session.Session) *EFS {
+	return &EFS{
+		client: efs.New(s),
+	}
+}
+
+// FileSystemNetwork contains the network configuration of the mount targets of a file system.
+type FileSystemNetwork struct {
+	VPCID          string
+	SecurityGroups []string // Unique IDs of the security groups of all the mount targets.
+}
+
+// FileSystemNetwork returns the VPC and the security groups of the mount targets of a file system.
+func (e *EFS) FileSystemNetwork(fsID string) (*FileSystemNetwork, error) {
+	var mountTargets []*efs.MountTargetDescription
+	in := &efs.DescribeMountTargetsInput{
+		FileSystemId: aws.String(fsID),
+	}
+	for {
+		out, err := e.client.DescribeMountTargets(in)
+		if err != nil {
+			return nil, fmt.Errorf("describe mount targets of file system %s: %w", fsID, err)
+		}
+		mountTargets = append(mountTargets, out.MountTargets...)
+		if out.NextMarker == nil {
+			break
+		}
+		in.Marker = out.NextMarker
+	}
+	if len(mountTargets) == 0 {
+		return nil, fmt.Errorf("file system %s has no mount targets", fsID)
+	}
+	network := &FileSystemNetwork{
+		VPCID: aws.StringValue(mountTargets[0].VpcId),
+	}
+	seen := make(map[string]bool)
+	for _, mt := range mountTargets {
+		out, err := e.client.DescribeMountTargetSecurityGroups(&efs.DescribeMountTargetSecurityGroupsInput{
+			MountTargetId: mt.MountTargetId,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("describe security groups of mount target %s: %w", aws.StringValue(mt.MountTargetId), err)
+		}
+		for _, sg := range aws.StringValueSlice(out.SecurityGroups) {
+			if !seen[sg] {
+				seen[sg] = true
+				network.SecurityGroups = append(network.SecurityGroups, sg)
+			}
+		}
+	}
+	sort.Strings(network.SecurityGroups)
+	return network, nil
+}
@@ -21,6 +21,9 @@ import (
 const (
 	// TargetHealthStateHealthy wraps the ELBV2 health status HEALTHY.
 	TargetHealthStateHealthy = elbv2.TargetHealthStateEnumHealthy
+
+	// DescribeTags accepts at most 20 resources per call.
+	maxDescribeTagsResources = 20
 )
 
 type api interface {
@@ -29,6 +32,7 @@ type api interface {
 	DescribeRulesWithContext(context.Context, *elbv2.DescribeRulesInput, ...request.Option) (*elbv2.DescribeRulesOutput, error)
 	DescribeLoadBalancers(input *elbv2.DescribeLoadBalancersInput) (*elbv2.DescribeLoadBalancersOutput, error)
 	DescribeListeners(input *elbv2.DescribeListenersInput) (*elbv2.DescribeListenersOutput, error)
+	DescribeTags(input *elbv2.DescribeTagsInput) (*elbv2.DescribeTagsOutput, error)
 }
 
 // ELBV2 wraps an AWS ELBV2 client.
@@ -203,6 +207,27 @@ func (e *ELBV2) LoadBalancer(nameOrARN string) (*LoadBalancer, error) {
 	}, nil
 }
 
+// ApplicationLoadBalancerCount returns the number of Application Load Balancers in the region.
+func (e *ELBV2) ApplicationLoadBalancerCount() (int, error) {
+	var count int
+	in := &elbv2.DescribeLoadBalancersInput{}
+	for {
+		out, err := e.client.DescribeLoadBalancers(in)
+		if err != nil {
+			return 0, fmt.Errorf("describe load balancers: %w", err)
+		}
+		for _, lb := range out.LoadBalancers {
+			if aws.StringValue(lb.Type) == elbv2.LoadBalancerTypeEnumApplication {
+				count++
+			}
+		}
+		if out.NextMarker == nil {
+			return count, nil
+		}
+		in.Marker = out.NextMarker
+	}
+}
+
 // Listener contains information about a listener.
 type Listener struct {
 	ARN      string
@@ -236,3 +261,100 @@ func (e *ELBV2) listeners(lbARN string) ([]Listener, error) {
 	}
 	return listeners, nil
 }
+
+// ListenerRule contains information about a rule of a listener.
+type ListenerRule struct {
+	ARN             string
+	Priority        string // Either a number or "default".
+	IsDefault       bool
+	Hosts           []string
+	Paths           []string
+	TargetGroupARNs []string
+}
+
+// ListenerRules returns the rules of a listener, ordered by priority.
+func (e *ELBV2) ListenerRules(listenerARN string) ([]ListenerRule, error) {
+	var rules []ListenerRule
+	in := &elbv2.DescribeRulesInput{ListenerArn: aws.String(listenerARN)}
+	for {
+		out, err := e.client.DescribeRules(in)
+		if err != nil {
+			return nil, fmt.Errorf("describe rules of listener %q: %w", listenerARN, err)
+		}
+		for _, rule := range out.Rules {
+			rules = append(rules, listenerRule(rule))
+		}
+		if out.NextMarker == nil {
+			break
+		}
+		in.Marker = out.NextMarker
+	}
+	return rules, nil
+}
+
+func listenerRule(rule *elbv2.Rule) ListenerRule {
+	out := ListenerRule{
+		ARN:       aws.StringValue(rule.RuleArn),
+		Priority:  aws.StringValue(rule.Priority),
+		IsDefault: aws.BoolValue(rule.IsDefault),
+	}
+	for _, condition := range rule.Conditions {
+		// Values is a legacy field that allowed specifying only a single value per condition.
+		values := aws.StringValueSlice(condition.Values)
+		switch aws.StringValue(condition.Field) {
+		case "host-header":
+			if condition.HostHeaderConfig != nil {
+				values = append(values, aws.StringValueSlice(condition.HostHeaderConfig.Values)...)
+			}
+			out.Hosts = append(out.Hosts, values...)
+		case "path-pattern":
+			if condition.PathPatternConfig != nil {
+				values = append(values, aws.StringValueSlice(condition.PathPatternConfig.Values)...)
+			}
+			out.Paths = append(out.Paths, values...)
+		}
+	}
+	for _, action := range rule.Actions {
+		if aws.StringValue(action.Type) != elbv2.ActionTypeEnumForward {
+			continue
+		}
+		if arn := aws.StringValue(action.TargetGroupArn); arn != "" {
+			out.TargetGroupARNs = append(out.TargetGroupARNs, arn)
+			continue
+		}
+		if action.ForwardConfig == nil {
+			continue
+		}
+		for _, tg := range action.ForwardConfig.TargetGroups {
+			out.TargetGroupARNs = append(out.TargetGroupARNs, aws.StringValue(tg.TargetGroupArn))
+		}
+	}
+	sort.Strings(out.Hosts)
+	sort.Strings(out.Paths)
+	return out
+}
+
+// ResourceTags returns the tags of load balancing resources, such as target groups, keyed by the ARN of the resource.
+func (e *ELBV2) ResourceTags(resourceARNs []string) (map[string]map[string]string, error) {
+	tags := make(map[string]map[string]string, len(resourceARNs))
+	for start := 0; start < len(resourceARNs); start += maxDescribeTagsResources {
+		end := start + maxDescribeTagsResources
+		if end > len(resourceARNs) {
+			end = len(resourceARNs)
+		}
+		out, err := e.client.DescribeTags(&elbv2.DescribeTagsInput{
+			ResourceArns: aws.StringSlice(resourceARNs[start:end]),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("describe tags of %s: %w", english.WordSeries(resourceARNs[start:end], "and"), err)
+		}
+		for _, desc := range out.TagDescriptions {
+			resourceTags := make(map[string]string, len(desc.Tags))
+			for _, tag := range desc.Tags {
+				resourceTags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+			}
+			tags[aws.StringValue(desc.ResourceArn)] = resourceTags
+		}
+	}
+	return tags, nil
+}
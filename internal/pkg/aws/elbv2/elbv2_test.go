@@ -556,6 +556,62 @@ func TestELBV2_LoadBalancer(t *testing.T) {
 	}
 }
 
+func TestELBV2_ApplicationLoadBalancerCount(t *testing.T) {
+	testCases := map[string]struct {
+		setUpMock func(m *mocks.Mockapi)
+
+		expectedErr   string
+		expectedCount int
+	}{
+		"error if fail to describe load balancers": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeLoadBalancers(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			expectedErr: "describe load balancers: some error",
+		},
+		"counts only application load balancers across pages": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeLoadBalancers(&elbv2.DescribeLoadBalancersInput{}).Return(&elbv2.DescribeLoadBalancersOutput{
+					LoadBalancers: []*elbv2.LoadBalancer{
+						{Type: aws.String("application")},
+						{Type: aws.String("network")},
+					},
+					NextMarker: aws.String("next"),
+				}, nil)
+				m.EXPECT().DescribeLoadBalancers(&elbv2.DescribeLoadBalancersInput{
+					Marker: aws.String("next"),
+				}).Return(&elbv2.DescribeLoadBalancersOutput{
+					LoadBalancers: []*elbv2.LoadBalancer{
+						{Type: aws.String("application")},
+					},
+				}, nil)
+			},
+			expectedCount: 2,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.setUpMock(mockAPI)
+
+			elbv2Client := ELBV2{
+				client: mockAPI,
+			}
+
+			actual, err := elbv2Client.ApplicationLoadBalancerCount()
+			if tc.expectedErr != "" {
+				require.EqualError(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedCount, actual)
+			}
+		})
+	}
+}
+
 func TestELBV2_listeners(t *testing.T) {
 	mockLBARN := aws.String("mockLoadBalancerARN")
 	mockOutput := &elbv2.DescribeListenersOutput{
@@ -627,3 +683,208 @@ func TestELBV2_listeners(t *testing.T) {
 		})
 	}
 }
+
+func TestELBV2_ListenerRules(t *testing.T) {
+	testCases := map[string]struct {
+		setUpMock func(m *mocks.Mockapi)
+
+		expectedErr   string
+		expectedRules []ListenerRule
+	}{
+		"error if describe call fails": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeRules(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			expectedErr: `describe rules of listener "mockListenerARN": some error`,
+		},
+		"successfully return the rules of all pages": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeRules(&elbv2.DescribeRulesInput{
+					ListenerArn: aws.String("mockListenerARN"),
+				}).Return(&elbv2.DescribeRulesOutput{
+					Rules: []*elbv2.Rule{
+						{
+							RuleArn:  aws.String("rule1"),
+							Priority: aws.String("1"),
+							Conditions: []*elbv2.RuleCondition{
+								{
+									Field: aws.String("host-header"),
+									HostHeaderConfig: &elbv2.HostHeaderConditionConfig{
+										Values: aws.StringSlice([]string{"b.example.com", "a.example.com"}),
+									},
+								},
+								{
+									Field:  aws.String("path-pattern"),
+									Values: aws.StringSlice([]string{"/api/*"}),
+								},
+							},
+							Actions: []*elbv2.Action{
+								{
+									Type:           aws.String(elbv2.ActionTypeEnumForward),
+									TargetGroupArn: aws.String("tg1"),
+								},
+							},
+						},
+					},
+					NextMarker: aws.String("next"),
+				}, nil)
+				m.EXPECT().DescribeRules(&elbv2.DescribeRulesInput{
+					ListenerArn: aws.String("mockListenerARN"),
+					Marker:      aws.String("next"),
+				}).Return(&elbv2.DescribeRulesOutput{
+					Rules: []*elbv2.Rule{
+						{
+							RuleArn:  aws.String("rule2"),
+							Priority: aws.String("2"),
+							Conditions: []*elbv2.RuleCondition{
+								{
+									Field: aws.String("path-pattern"),
+									PathPatternConfig: &elbv2.PathPatternConditionConfig{
+										Values: aws.StringSlice([]string{"/"}),
+									},
+								},
+							},
+							Actions: []*elbv2.Action{
+								{
+									Type: aws.String(elbv2.ActionTypeEnumForward),
+									ForwardConfig: &elbv2.ForwardActionConfig{
+										TargetGroups: []*elbv2.TargetGroupTuple{
+											{TargetGroupArn: aws.String("tg2")},
+										},
+									},
+								},
+							},
+						},
+						{
+							RuleArn:   aws.String("default"),
+							Priority:  aws.String("default"),
+							IsDefault: aws.Bool(true),
+							Actions: []*elbv2.Action{
+								{
+									Type: aws.String(elbv2.ActionTypeEnumFixedResponse),
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+			expectedRules: []ListenerRule{
+				{
+					ARN:             "rule1",
+					Priority:        "1",
+					Hosts:           []string{"a.example.com", "b.example.com"},
+					Paths:           []string{"/api/*"},
+					TargetGroupARNs: []string{"tg1"},
+				},
+				{
+					ARN:             "rule2",
+					Priority:        "2",
+					Paths:           []string{"/"},
+					TargetGroupARNs: []string{"tg2"},
+				},
+				{
+					ARN:       "default",
+					Priority:  "default",
+					IsDefault: true,
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.setUpMock(mockAPI)
+
+			elbv2Client := ELBV2{
+				client: mockAPI,
+			}
+
+			actual, err := elbv2Client.ListenerRules("mockListenerARN")
+			if tc.expectedErr != "" {
+				require.EqualError(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedRules, actual)
+			}
+		})
+	}
+}
+
+func TestELBV2_ResourceTags(t *testing.T) {
+	var manyARNs []string
+	for i := 0; i < 21; i++ {
+		manyARNs = append(manyARNs, fmt.Sprintf("tg%d", i))
+	}
+	testCases := map[string]struct {
+		inARNs    []string
+		setUpMock func(m *mocks.Mockapi)
+
+		expectedErr  string
+		expectedTags map[string]map[string]string
+	}{
+		"error if describe call fails": {
+			inARNs: []string{"tg1"},
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeTags(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			expectedErr: "describe tags of tg1: some error",
+		},
+		"describe the tags in batches of 20 resources": {
+			inARNs: manyARNs,
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeTags(&elbv2.DescribeTagsInput{
+					ResourceArns: aws.StringSlice(manyARNs[:20]),
+				}).Return(&elbv2.DescribeTagsOutput{
+					TagDescriptions: []*elbv2.TagDescription{
+						{
+							ResourceArn: aws.String("tg0"),
+							Tags: []*elbv2.Tag{
+								{Key: aws.String("copilot-service"), Value: aws.String("api")},
+							},
+						},
+					},
+				}, nil)
+				m.EXPECT().DescribeTags(&elbv2.DescribeTagsInput{
+					ResourceArns: aws.StringSlice(manyARNs[20:]),
+				}).Return(&elbv2.DescribeTagsOutput{
+					TagDescriptions: []*elbv2.TagDescription{
+						{
+							ResourceArn: aws.String("tg20"),
+							Tags: []*elbv2.Tag{
+								{Key: aws.String("copilot-service"), Value: aws.String("frontend")},
+							},
+						},
+					},
+				}, nil)
+			},
+			expectedTags: map[string]map[string]string{
+				"tg0":  {"copilot-service": "api"},
+				"tg20": {"copilot-service": "frontend"},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.setUpMock(mockAPI)
+
+			elbv2Client := ELBV2{
+				client: mockAPI,
+			}
+
+			actual, err := elbv2Client.ResourceTags(tc.inARNs)
+			if tc.expectedErr != "" {
+				require.EqualError(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedTags, actual)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ package iam
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -26,6 +27,7 @@ type api interface {
 	DeleteRole(input *iam.DeleteRoleInput) (*iam.DeleteRoleOutput, error)
 	CreateServiceLinkedRole(input *iam.CreateServiceLinkedRoleInput) (*iam.CreateServiceLinkedRoleOutput, error)
 	ListPolicies(input *iam.ListPoliciesInput) (*iam.ListPoliciesOutput, error)
+	SimulatePrincipalPolicy(input *iam.SimulatePrincipalPolicyInput) (*iam.SimulatePolicyResponse, error)
 }
 
 // IAM wraps the AWS SDK's IAM client.
@@ -125,6 +127,109 @@ func (c *IAM) ListPolicyNames() ([]string, error) {
 	return policyNames, nil
 }
 
+// PolicySimulation holds the actions to simulate on the same resources.
+type PolicySimulation struct {
+	Actions      []string
+	ResourceARNs []string          // Resources the actions are performed on. Defaults to all resources.
+	Context      map[string]string // Values of the condition keys of the requests, e.g. "aws:RequestedRegion".
+}
+
+// DeniedAction is an action that the simulated policies don't allow on a resource.
+type DeniedAction struct {
+	Action      string
+	ResourceARN string
+	Explicit    bool // True if a statement denies the action, false if no statement allows it.
+}
+
+// SimulatePolicy simulates the IAM policies attached to the caller, and returns the actions that the caller isn't allowed to perform.
+// The caller ARN can be the ARN of an IAM user, an IAM role, or an STS assumed-role session.
+// The root user is allowed to perform every action, so no actions are denied for it.
+func (c *IAM) SimulatePolicy(callerARN string, sim PolicySimulation) ([]DeniedAction, error) {
+	principalARN, err := principalARN(callerARN)
+	if err != nil {
+		return nil, err
+	}
+	if principalARN == "" {
+		return nil, nil
+	}
+	in := &iam.SimulatePrincipalPolicyInput{
+		PolicySourceArn: aws.String(principalARN),
+		ActionNames:     aws.StringSlice(sim.Actions),
+		ContextEntries:  contextEntries(sim.Context),
+	}
+	if len(sim.ResourceARNs) > 0 {
+		in.ResourceArns = aws.StringSlice(sim.ResourceARNs)
+	}
+	var denied []DeniedAction
+	for {
+		out, err := c.client.SimulatePrincipalPolicy(in)
+		if err != nil {
+			return nil, fmt.Errorf("simulate policies of principal %s: %w", principalARN, err)
+		}
+		for _, result := range out.EvaluationResults {
+			decision := aws.StringValue(result.EvalDecision)
+			if decision == iam.PolicyEvaluationDecisionTypeAllowed {
+				continue
+			}
+			denied = append(denied, DeniedAction{
+				Action:      aws.StringValue(result.EvalActionName),
+				ResourceARN: aws.StringValue(result.EvalResourceName),
+				Explicit:    decision == iam.PolicyEvaluationDecisionTypeExplicitDeny,
+			})
+		}
+		if !aws.BoolValue(out.IsTruncated) {
+			return denied, nil
+		}
+		in.Marker = out.Marker
+	}
+}
+
+func contextEntries(context map[string]string) []*iam.ContextEntry {
+	if len(context) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(context))
+	for key := range context {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	entries := make([]*iam.ContextEntry, len(keys))
+	for i, key := range keys {
+		entries[i] = &iam.ContextEntry{
+			ContextKeyName:   aws.String(key),
+			ContextKeyType:   aws.String(iam.ContextKeyTypeEnumString),
+			ContextKeyValues: aws.StringSlice([]string{context[key]}),
+		}
+	}
+	return entries
+}
+
+// principalARN returns the ARN of the IAM principal whose policies apply to the caller,
+// or an empty string if the caller is the root user.
+func principalARN(callerARN string) (string, error) {
+	parsed, err := arn.Parse(callerARN)
+	if err != nil {
+		return "", fmt.Errorf("parse caller ARN %s: %w", callerARN, err)
+	}
+	if parsed.Resource == "root" {
+		return "", nil
+	}
+	if parsed.Service != "sts" {
+		return callerARN, nil
+	}
+	// Sample assumed-role ARN format: arn:aws:sts::1111:assumed-role/Admin/session-name
+	parts := strings.Split(parsed.Resource, "/")
+	if len(parts) < 2 || parts[0] != "assumed-role" {
+		return "", fmt.Errorf("caller ARN %s is not an assumed-role session", callerARN)
+	}
+	return arn.ARN{
+		Partition: parsed.Partition,
+		Service:   "iam",
+		AccountID: parsed.AccountID,
+		Resource:  "role/" + parts[1],
+	}.String(), nil
+}
+
 func (c *IAM) deleteRolePolicies(roleName string) error {
 	policyNames, err := c.listRolePolicyNames(roleName)
 	if err != nil {
@@ -303,3 +303,131 @@ func TestIAM_ListPolicies(t *testing.T) {
 		})
 	}
 }
+
+func TestIAM_SimulatePolicy(t *testing.T) {
+	testCases := map[string]struct {
+		inCallerARN string
+		inClient    func(ctrl *gomock.Controller) *mocks.Mockapi
+
+		wantedDenied []DeniedAction
+		wantedErr    error
+	}{
+		"error if the caller ARN is malformed": {
+			inCallerARN: "admin",
+			inClient: func(ctrl *gomock.Controller) *mocks.Mockapi {
+				return mocks.NewMockapi(ctrl)
+			},
+			wantedErr: errors.New("parse caller ARN admin: arn: invalid prefix"),
+		},
+		"nothing is denied to the root user": {
+			inCallerARN: "arn:aws:iam::1111:root",
+			inClient: func(ctrl *gomock.Controller) *mocks.Mockapi {
+				return mocks.NewMockapi(ctrl)
+			},
+		},
+		"wraps error on failure": {
+			inCallerARN: "arn:aws:iam::1111:user/alice",
+			inClient: func(ctrl *gomock.Controller) *mocks.Mockapi {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().SimulatePrincipalPolicy(gomock.Any()).Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedErr: errors.New("simulate policies of principal arn:aws:iam::1111:user/alice: some error"),
+		},
+		"simulates the policies of the role of an assumed-role session across pages": {
+			inCallerARN: "arn:aws:sts::1111:assumed-role/Admin/alice",
+			inClient: func(ctrl *gomock.Controller) *mocks.Mockapi {
+				m := mocks.NewMockapi(ctrl)
+				gomock.InOrder(
+					m.EXPECT().SimulatePrincipalPolicy(gomock.Any()).DoAndReturn(func(in *iam.SimulatePrincipalPolicyInput) (*iam.SimulatePolicyResponse, error) {
+						require.Equal(t, &iam.SimulatePrincipalPolicyInput{
+							PolicySourceArn: aws.String("arn:aws:iam::1111:role/Admin"),
+							ActionNames:     aws.StringSlice([]string{"iam:CreateRole", "ssm:PutParameter", "cloudformation:CreateStack"}),
+							ResourceArns:    aws.StringSlice([]string{"arn:aws:iam::1111:role/app-env-CFNExecutionRole"}),
+							ContextEntries: []*iam.ContextEntry{
+								{
+									ContextKeyName:   aws.String("aws:RequestTag/copilot-application"),
+									ContextKeyType:   aws.String("string"),
+									ContextKeyValues: aws.StringSlice([]string{"app"}),
+								},
+								{
+									ContextKeyName:   aws.String("aws:RequestedRegion"),
+									ContextKeyType:   aws.String("string"),
+									ContextKeyValues: aws.StringSlice([]string{"us-west-2"}),
+								},
+							},
+						}, in)
+						return &iam.SimulatePolicyResponse{
+							EvaluationResults: []*iam.EvaluationResult{
+								{
+									EvalActionName:   aws.String("iam:CreateRole"),
+									EvalResourceName: aws.String("arn:aws:iam::1111:role/app-env-CFNExecutionRole"),
+									EvalDecision:     aws.String("implicitDeny"),
+								},
+								{
+									EvalActionName:   aws.String("ssm:PutParameter"),
+									EvalResourceName: aws.String("arn:aws:iam::1111:role/app-env-CFNExecutionRole"),
+									EvalDecision:     aws.String("allowed"),
+								},
+							},
+							IsTruncated: aws.Bool(true),
+							Marker:      aws.String("next"),
+						}, nil
+					}),
+					m.EXPECT().SimulatePrincipalPolicy(gomock.Any()).DoAndReturn(func(in *iam.SimulatePrincipalPolicyInput) (*iam.SimulatePolicyResponse, error) {
+						require.Equal(t, "next", aws.StringValue(in.Marker))
+						return &iam.SimulatePolicyResponse{
+							EvaluationResults: []*iam.EvaluationResult{
+								{
+									EvalActionName:   aws.String("cloudformation:CreateStack"),
+									EvalResourceName: aws.String("arn:aws:iam::1111:role/app-env-CFNExecutionRole"),
+									EvalDecision:     aws.String("explicitDeny"),
+								},
+							},
+						}, nil
+					}),
+				)
+				return m
+			},
+			wantedDenied: []DeniedAction{
+				{
+					Action:      "iam:CreateRole",
+					ResourceARN: "arn:aws:iam::1111:role/app-env-CFNExecutionRole",
+				},
+				{
+					Action:      "cloudformation:CreateStack",
+					ResourceARN: "arn:aws:iam::1111:role/app-env-CFNExecutionRole",
+					Explicit:    true,
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			iam := &IAM{
+				client: tc.inClient(ctrl),
+			}
+
+			// WHEN
+			denied, err := iam.SimulatePolicy(tc.inCallerARN, PolicySimulation{
+				Actions:      []string{"iam:CreateRole", "ssm:PutParameter", "cloudformation:CreateStack"},
+				ResourceARNs: []string{"arn:aws:iam::1111:role/app-env-CFNExecutionRole"},
+				Context: map[string]string{
+					"aws:RequestedRegion":                "us-west-2",
+					"aws:RequestTag/copilot-application": "app",
+				},
+			})
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedDenied, denied)
+			}
+		})
+	}
+}
@@ -34,6 +34,7 @@ type Caller struct {
 	RootUserARN string
 	Account     string
 	UserID      string
+	ARN         string // ARN of the IAM user, role session, or root user that makes the calls.
 }
 
 // Get returns the Caller associated with the Client's session.
@@ -51,5 +52,6 @@ func (s STS) Get() (Caller, error) {
 		RootUserARN: fmt.Sprintf("arn:%s:iam::%s:root", parsedARN.Partition, aws.StringValue(out.Account)),
 		Account:     aws.StringValue(out.Account),
 		UserID:      aws.StringValue(out.UserId),
+		ARN:         aws.StringValue(out.Arn),
 	}, nil
 }
@@ -56,6 +56,7 @@ func TestIdentity_Get(t *testing.T) {
 				Account:     mockAccount,
 				RootUserARN: fmt.Sprintf("arn:aws:iam::%s:root", mockAccount),
 				UserID:      mockUserID,
+				ARN:         mockARN,
 			},
 		},
 		"should return Identity in non standard partition": {
@@ -70,6 +71,7 @@ func TestIdentity_Get(t *testing.T) {
 				Account:     mockAccount,
 				RootUserARN: fmt.Sprintf("arn:aws-cn:iam::%s:root", mockAccount),
 				UserID:      mockUserID,
+				ARN:         mockChinaARN,
 			},
 		},
 	}
@@ -0,0 +1,43 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package kms provides a client to make API requests to AWS Key Management Service.
+package kms
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/kms"
+)
+
+type api interface {
+	CreateGrant(input *kms.CreateGrantInput) (*kms.CreateGrantOutput, error)
+}
+
+// KMS wraps an AWS Key Management Service client.
+type KMS struct {
+	client api
+}
+
+// New returns a KMS configured against the input session.
+func New(s *session.Session) *KMS {
+	return &KMS{
+		client: kms.New(s),
+	}
+}
+
+// GrantDecrypt allows a principal, possibly in another account, to decrypt data with a key without changing the key policy.
+// Creating a grant with the same name, key and principal again doesn't create a new grant.
+func (k *KMS) GrantDecrypt(keyARN, principalARN, name string) error {
+	if _, err := k.client.CreateGrant(&kms.CreateGrantInput{
+		KeyId:            aws.String(keyARN),
+		GranteePrincipal: aws.String(principalARN),
+		Name:             aws.String(name),
+		Operations:       aws.StringSlice([]string{kms.GrantOperationDecrypt, kms.GrantOperationDescribeKey}),
+	}); err != nil {
+		return fmt.Errorf("grant %s access to decrypt with key %s: %w", principalARN, keyARN, err)
+	}
+	return nil
+}
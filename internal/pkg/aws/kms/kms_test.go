@@ -0,0 +1,66 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package kms
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/copilot-cli/internal/pkg/aws/kms/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKMS_GrantDecrypt(t *testing.T) {
+	const (
+		mockKey  = "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"
+		mockRole = "arn:aws:iam::210987654321:role/phonetool-prod-EnvManagerRole"
+	)
+	mockInput := &kms.CreateGrantInput{
+		KeyId:            aws.String(mockKey),
+		GranteePrincipal: aws.String(mockRole),
+		Name:             aws.String("copilot-phonetool-release-prod"),
+		Operations:       aws.StringSlice([]string{"Decrypt", "DescribeKey"}),
+	}
+	testCases := map[string]struct {
+		mockClient func(m *mocks.Mockapi)
+
+		wantedError error
+	}{
+		"error if fail to create the grant": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateGrant(mockInput).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("grant arn:aws:iam::210987654321:role/phonetool-prod-EnvManagerRole access to decrypt with key arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab: some error"),
+		},
+		"creates the grant": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateGrant(mockInput).Return(&kms.CreateGrantOutput{
+					GrantId: aws.String("grant-1"),
+				}, nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			client := KMS{
+				client: m,
+			}
+
+			err := client.GrantDecrypt(mockKey, mockRole, "copilot-phonetool-release-prod")
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
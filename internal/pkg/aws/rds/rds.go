@@ -0,0 +1,110 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package rds provides a client to make API requests to Amazon Relational Database Service.
+package rds
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/rds"
+)
+
+type api interface {
+	DescribeDBClusters(input *rds.DescribeDBClustersInput) (*rds.DescribeDBClustersOutput, error)
+	DescribeDBInstances(input *rds.DescribeDBInstancesInput) (*rds.DescribeDBInstancesOutput, error)
+}
+
+// RDS wraps an Amazon Relational Database Service client.
+type RDS struct {
+	client api
+}
+
+// New returns a RDS struct configured against the input session.
+func New(s *session.Session) *RDS {
+	return &RDS{
+		client: rds.New(s),
+	}
+}
+
+// Endpoint is the network address of a database.
+type Endpoint struct {
+	Host string
+	Port int
+}
+
+// Endpoint returns the endpoint of the DB cluster with the identifier, or of the DB instance if no cluster matches.
+// The writer endpoint is returned for DB clusters.
+func (r *RDS) Endpoint(identifier string) (*Endpoint, error) {
+	endpoint, err := r.clusterEndpoint(identifier)
+	if err != nil {
+		return nil, err
+	}
+	if endpoint != nil {
+		return endpoint, nil
+	}
+	endpoint, err = r.instanceEndpoint(identifier)
+	if err != nil {
+		return nil, err
+	}
+	if endpoint != nil {
+		return endpoint, nil
+	}
+	return nil, &ErrDBNotFound{identifier: identifier}
+}
+
+func (r *RDS) clusterEndpoint(identifier string) (*Endpoint, error) {
+	out, err := r.client.DescribeDBClusters(&rds.DescribeDBClustersInput{
+		DBClusterIdentifier: aws.String(identifier),
+	})
+	if err != nil {
+		if isErrCode(err, rds.ErrCodeDBClusterNotFoundFault) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("describe DB cluster %s: %w", identifier, err)
+	}
+	if len(out.DBClusters) == 0 || out.DBClusters[0].Endpoint == nil {
+		return nil, nil
+	}
+	return &Endpoint{
+		Host: aws.StringValue(out.DBClusters[0].Endpoint),
+		Port: int(aws.Int64Value(out.DBClusters[0].Port)),
+	}, nil
+}
+
+func (r *RDS) instanceEndpoint(identifier string) (*Endpoint, error) {
+	out, err := r.client.DescribeDBInstances(&rds.DescribeDBInstancesInput{
+		DBInstanceIdentifier: aws.String(identifier),
+	})
+	if err != nil {
+		if isErrCode(err, rds.ErrCodeDBInstanceNotFoundFault) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("describe DB instance %s: %w", identifier, err)
+	}
+	if len(out.DBInstances) == 0 || out.DBInstances[0].Endpoint == nil {
+		return nil, nil
+	}
+	return &Endpoint{
+		Host: aws.StringValue(out.DBInstances[0].Endpoint.Address),
+		Port: int(aws.Int64Value(out.DBInstances[0].Endpoint.Port)),
+	}, nil
+}
+
+func isErrCode(err error, code string) bool {
+	var aerr awserr.Error
+	return errors.As(err, &aerr) && aerr.Code() == code
+}
+
+// ErrDBNotFound is returned when no DB cluster or DB instance matches an identifier.
+type ErrDBNotFound struct {
+	identifier string
+}
+
+func (e *ErrDBNotFound) Error() string {
+	return fmt.Sprintf("no DB cluster or DB instance found with identifier %s", e.identifier)
+}
@@ -0,0 +1,111 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package rds
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/copilot-cli/internal/pkg/aws/rds/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRDS_Endpoint(t *testing.T) {
+	const mockIdentifier = "mydb"
+	errClusterNotFound := awserr.New(rds.ErrCodeDBClusterNotFoundFault, "DBCluster mydb not found.", nil)
+	errInstanceNotFound := awserr.New(rds.ErrCodeDBInstanceNotFoundFault, "DBInstance mydb not found.", nil)
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wanted    *Endpoint
+		wantedErr error
+	}{
+		"error if fail to describe the DB cluster": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeDBClusters(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("describe DB cluster mydb: some error"),
+		},
+		"returns the writer endpoint of the DB cluster": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeDBClusters(&rds.DescribeDBClustersInput{
+					DBClusterIdentifier: aws.String(mockIdentifier),
+				}).Return(&rds.DescribeDBClustersOutput{
+					DBClusters: []*rds.DBCluster{
+						{
+							Endpoint: aws.String("mydb.cluster-abc.us-west-2.rds.amazonaws.com"),
+							Port:     aws.Int64(5432),
+						},
+					},
+				}, nil)
+			},
+			wanted: &Endpoint{
+				Host: "mydb.cluster-abc.us-west-2.rds.amazonaws.com",
+				Port: 5432,
+			},
+		},
+		"error if fail to describe the DB instance": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeDBClusters(gomock.Any()).Return(nil, errClusterNotFound)
+				m.EXPECT().DescribeDBInstances(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("describe DB instance mydb: some error"),
+		},
+		"returns the endpoint of the DB instance if there is no DB cluster": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeDBClusters(gomock.Any()).Return(nil, errClusterNotFound)
+				m.EXPECT().DescribeDBInstances(&rds.DescribeDBInstancesInput{
+					DBInstanceIdentifier: aws.String(mockIdentifier),
+				}).Return(&rds.DescribeDBInstancesOutput{
+					DBInstances: []*rds.DBInstance{
+						{
+							Endpoint: &rds.Endpoint{
+								Address: aws.String("mydb.abc.us-west-2.rds.amazonaws.com"),
+								Port:    aws.Int64(3306),
+							},
+						},
+					},
+				}, nil)
+			},
+			wanted: &Endpoint{
+				Host: "mydb.abc.us-west-2.rds.amazonaws.com",
+				Port: 3306,
+			},
+		},
+		"error if there is neither a DB cluster nor a DB instance": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeDBClusters(gomock.Any()).Return(nil, errClusterNotFound)
+				m.EXPECT().DescribeDBInstances(gomock.Any()).Return(nil, errInstanceNotFound)
+			},
+			wantedErr: errors.New("no DB cluster or DB instance found with identifier mydb"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			client := RDS{
+				client: m,
+			}
+
+			// WHEN
+			got, err := client.Endpoint(mockIdentifier)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
@@ -46,6 +46,25 @@ type s3API interface {
 	ListObjectsV2(input *s3.ListObjectsV2Input) (*s3.ListObjectsV2Output, error)
 	DeleteObjects(input *s3.DeleteObjectsInput) (*s3.DeleteObjectsOutput, error)
 	HeadBucket(input *s3.HeadBucketInput) (*s3.HeadBucketOutput, error)
+	GetObject(input *s3.GetObjectInput) (*s3.GetObjectOutput, error)
+}
+
+// ErrObjectNotFound occurs when an object doesn't exist in a bucket.
+type ErrObjectNotFound struct {
+	bucket string
+	key    string
+}
+
+func (e *ErrObjectNotFound) Error() string {
+	return fmt.Sprintf("object %s not found in bucket %s", e.key, e.bucket)
+}
+
+// NewMockErrObjectNotFound creates a mock ErrObjectNotFound.
+func NewMockErrObjectNotFound() *ErrObjectNotFound {
+	return &ErrObjectNotFound{
+		bucket: "mockBucket",
+		key:    "mockKey",
+	}
 }
 
 // NamedBinary is a named binary to be uploaded.
@@ -78,6 +97,31 @@ func (s *S3) Upload(bucket, key string, data io.Reader) (string, error) {
 	return s.upload(bucket, key, data)
 }
 
+// Download returns the content of the object under the specified key in an S3 bucket.
+// If the object doesn't exist, it returns an *ErrObjectNotFound error.
+func (s *S3) Download(bucket, key string) ([]byte, error) {
+	resp, err := s.s3Client.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		var aerr awserr.Error
+		if errors.As(err, &aerr) && aerr.Code() == s3.ErrCodeNoSuchKey {
+			return nil, &ErrObjectNotFound{
+				bucket: bucket,
+				key:    key,
+			}
+		}
+		return nil, fmt.Errorf("download %s from bucket %s: %w", key, bucket, err)
+	}
+	defer resp.Body.Close()
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read %s from bucket %s: %w", key, bucket, err)
+	}
+	return content, nil
+}
+
 // EmptyBucket deletes all objects within the bucket.
 func (s *S3) EmptyBucket(bucket string) error {
 	var listResp *s3.ListObjectVersionsOutput
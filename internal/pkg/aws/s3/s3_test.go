@@ -79,6 +79,66 @@ func TestS3_Upload(t *testing.T) {
 	}
 }
 
+func TestS3_Download(t *testing.T) {
+	testCases := map[string]struct {
+		mockS3Client func(m *mocks.Mocks3API)
+
+		wanted    string
+		wantedErr error
+	}{
+		"return ErrObjectNotFound if the object doesn't exist": {
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().GetObject(gomock.Any()).Return(nil, awserr.New(s3.ErrCodeNoSuchKey, "message", nil))
+			},
+			wantedErr: &ErrObjectNotFound{
+				bucket: "mockBucket",
+				key:    "manual/deployments/mockStack.json",
+			},
+		},
+		"return wrapped error if download fails": {
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().GetObject(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("download manual/deployments/mockStack.json from bucket mockBucket: some error"),
+		},
+		"return the content of the object": {
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().GetObject(&s3.GetObjectInput{
+					Bucket: aws.String("mockBucket"),
+					Key:    aws.String("manual/deployments/mockStack.json"),
+				}).Return(&s3.GetObjectOutput{
+					Body: io.NopCloser(bytes.NewBufferString("hello")),
+				}, nil)
+			},
+			wanted: "hello",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockS3Client := mocks.NewMocks3API(ctrl)
+			tc.mockS3Client(mockS3Client)
+
+			service := S3{
+				s3Client: mockS3Client,
+			}
+
+			got, err := service.Download("mockBucket", "manual/deployments/mockStack.json")
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, string(got))
+		})
+	}
+}
+
 func TestS3_EmptyBucket(t *testing.T) {
 	batchObject1 := make([]*s3.ObjectVersion, 1000)
 	batchObject2 := make([]*s3.ObjectVersion, 10)
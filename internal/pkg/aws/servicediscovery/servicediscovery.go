@@ -0,0 +1,117 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package servicediscovery provides a client to make API requests to AWS Cloud Map.
+package servicediscovery
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+)
+
+const (
+	namespaceResourcePrefix = "namespace/"
+
+	// Attribute that Amazon ECS sets on the instances that it registers for its tasks.
+	instanceAttributeTaskDefinitionFamily = "ECS_TASK_DEFINITION_FAMILY"
+)
+
+type api interface {
+	ListServices(input *servicediscovery.ListServicesInput) (*servicediscovery.ListServicesOutput, error)
+	ListInstances(input *servicediscovery.ListInstancesInput) (*servicediscovery.ListInstancesOutput, error)
+}
+
+// ServiceDiscovery wraps an AWS Cloud Map client.
+type ServiceDiscovery struct {
+	client api
+}
+
+// New returns a ServiceDiscovery configured against the input session.
+func New(s *session.Session) *ServiceDiscovery {
+	return &ServiceDiscovery{
+		client: servicediscovery.New(s),
+	}
+}
+
+// TaskFamilies returns the task definition families of the Amazon ECS tasks registered to the Cloud Map service
+// with the given name in a namespace. It returns an empty slice if the namespace doesn't have such a service.
+func (s *ServiceDiscovery) TaskFamilies(namespaceARN, serviceName string) ([]string, error) {
+	namespaceID, err := namespaceID(namespaceARN)
+	if err != nil {
+		return nil, err
+	}
+	serviceID, err := s.serviceID(namespaceID, serviceName)
+	if err != nil {
+		return nil, err
+	}
+	if serviceID == "" {
+		return nil, nil
+	}
+	seen := make(map[string]bool)
+	var families []string
+	in := &servicediscovery.ListInstancesInput{
+		ServiceId: aws.String(serviceID),
+	}
+	for {
+		out, err := s.client.ListInstances(in)
+		if err != nil {
+			return nil, fmt.Errorf("list instances of service %s: %w", serviceName, err)
+		}
+		for _, instance := range out.Instances {
+			family := aws.StringValue(instance.Attributes[instanceAttributeTaskDefinitionFamily])
+			if family == "" || seen[family] {
+				continue
+			}
+			seen[family] = true
+			families = append(families, family)
+		}
+		if out.NextToken == nil {
+			return families, nil
+		}
+		in.NextToken = out.NextToken
+	}
+}
+
+// serviceID returns the ID of the service with the given name in a namespace, or an empty string if it doesn't exist.
+func (s *ServiceDiscovery) serviceID(namespaceID, serviceName string) (string, error) {
+	in := &servicediscovery.ListServicesInput{
+		Filters: []*servicediscovery.ServiceFilter{
+			{
+				Name:      aws.String(servicediscovery.ServiceFilterNameNamespaceId),
+				Condition: aws.String(servicediscovery.FilterConditionEq),
+				Values:    aws.StringSlice([]string{namespaceID}),
+			},
+		},
+	}
+	for {
+		out, err := s.client.ListServices(in)
+		if err != nil {
+			return "", fmt.Errorf("list services in namespace %s: %w", namespaceID, err)
+		}
+		for _, service := range out.Services {
+			if aws.StringValue(service.Name) == serviceName {
+				return aws.StringValue(service.Id), nil
+			}
+		}
+		if out.NextToken == nil {
+			return "", nil
+		}
+		in.NextToken = out.NextToken
+	}
+}
+
+func namespaceID(namespaceARN string) (string, error) {
+	parsed, err := arn.Parse(namespaceARN)
+	if err != nil {
+		return "", fmt.Errorf("parse namespace ARN %s: %w", namespaceARN, err)
+	}
+	if !strings.HasPrefix(parsed.Resource, namespaceResourcePrefix) {
+		return "", fmt.Errorf("%s is not the ARN of a Cloud Map namespace", namespaceARN)
+	}
+	return strings.TrimPrefix(parsed.Resource, namespaceResourcePrefix), nil
+}
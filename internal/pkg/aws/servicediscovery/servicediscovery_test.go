@@ -0,0 +1,128 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package servicediscovery
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/aws/copilot-cli/internal/pkg/aws/servicediscovery/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceDiscovery_TaskFamilies(t *testing.T) {
+	const mockNamespaceARN = "arn:aws:servicediscovery:us-west-2:123456789012:namespace/ns-1234"
+	mockListServicesInput := func(token *string) *servicediscovery.ListServicesInput {
+		return &servicediscovery.ListServicesInput{
+			Filters: []*servicediscovery.ServiceFilter{
+				{
+					Name:      aws.String("NAMESPACE_ID"),
+					Condition: aws.String("EQ"),
+					Values:    aws.StringSlice([]string{"ns-1234"}),
+				},
+			},
+			NextToken: token,
+		}
+	}
+	testCases := map[string]struct {
+		inNamespaceARN string
+		mockClient     func(m *mocks.Mockapi)
+
+		wanted      []string
+		wantedError error
+	}{
+		"error if the namespace ARN is malformed": {
+			inNamespaceARN: "ns-1234",
+			mockClient:     func(m *mocks.Mockapi) {},
+			wantedError:    errors.New("parse namespace ARN ns-1234: arn: invalid prefix"),
+		},
+		"error if fail to list services": {
+			inNamespaceARN: mockNamespaceARN,
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListServices(mockListServicesInput(nil)).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list services in namespace ns-1234: some error"),
+		},
+		"no families if the service doesn't exist": {
+			inNamespaceARN: mockNamespaceARN,
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListServices(mockListServicesInput(nil)).Return(&servicediscovery.ListServicesOutput{
+					Services: []*servicediscovery.ServiceSummary{
+						{Id: aws.String("srv-1"), Name: aws.String("frontend")},
+					},
+				}, nil)
+			},
+		},
+		"error if fail to list instances": {
+			inNamespaceARN: mockNamespaceARN,
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListServices(mockListServicesInput(nil)).Return(&servicediscovery.ListServicesOutput{
+					Services: []*servicediscovery.ServiceSummary{
+						{Id: aws.String("srv-2"), Name: aws.String("api")},
+					},
+				}, nil)
+				m.EXPECT().ListInstances(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list instances of service api: some error"),
+		},
+		"success across pages": {
+			inNamespaceARN: mockNamespaceARN,
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListServices(mockListServicesInput(nil)).Return(&servicediscovery.ListServicesOutput{
+					Services: []*servicediscovery.ServiceSummary{
+						{Id: aws.String("srv-1"), Name: aws.String("frontend")},
+					},
+					NextToken: aws.String("next"),
+				}, nil)
+				m.EXPECT().ListServices(mockListServicesInput(aws.String("next"))).Return(&servicediscovery.ListServicesOutput{
+					Services: []*servicediscovery.ServiceSummary{
+						{Id: aws.String("srv-2"), Name: aws.String("api")},
+					},
+				}, nil)
+				m.EXPECT().ListInstances(&servicediscovery.ListInstancesInput{
+					ServiceId: aws.String("srv-2"),
+				}).Return(&servicediscovery.ListInstancesOutput{
+					Instances: []*servicediscovery.InstanceSummary{
+						{Attributes: aws.StringMap(map[string]string{"ECS_TASK_DEFINITION_FAMILY": "phonetool-test-api"})},
+						{Attributes: aws.StringMap(map[string]string{"ECS_TASK_DEFINITION_FAMILY": "phonetool-test-api"})},
+					},
+					NextToken: aws.String("next"),
+				}, nil)
+				m.EXPECT().ListInstances(&servicediscovery.ListInstancesInput{
+					ServiceId: aws.String("srv-2"),
+					NextToken: aws.String("next"),
+				}).Return(&servicediscovery.ListInstancesOutput{
+					Instances: []*servicediscovery.InstanceSummary{
+						{Attributes: aws.StringMap(map[string]string{"ECS_TASK_DEFINITION_FAMILY": "shop-prod-api"})},
+						{Attributes: aws.StringMap(map[string]string{"AWS_INSTANCE_IPV4": "10.0.0.1"})},
+					},
+				}, nil)
+			},
+			wanted: []string{"phonetool-test-api", "shop-prod-api"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			sd := ServiceDiscovery{
+				client: m,
+			}
+
+			got, err := sd.TaskFamilies(tc.inNamespaceARN, "api")
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package servicequotas
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/aws/copilot-cli/internal/pkg/aws/servicequotas/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceQuotas_Value(t *testing.T) {
+	mockInput := &servicequotas.GetServiceQuotaInput{
+		ServiceCode: aws.String("vpc"),
+		QuotaCode:   aws.String("L-F678F1CE"),
+	}
+	mockDefaultInput := &servicequotas.GetAWSDefaultServiceQuotaInput{
+		ServiceCode: aws.String("vpc"),
+		QuotaCode:   aws.String("L-F678F1CE"),
+	}
+	testCases := map[string]struct {
+		mockClient func(m *mocks.Mockapi)
+
+		wanted      float64
+		wantedError error
+	}{
+		"error if fail to get the quota": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetServiceQuota(mockInput).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get quota L-F678F1CE of service vpc: some error"),
+		},
+		"returns the value applied to the account": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetServiceQuota(mockInput).Return(&servicequotas.GetServiceQuotaOutput{
+					Quota: &servicequotas.ServiceQuota{Value: aws.Float64(10)},
+				}, nil)
+			},
+			wanted: 10,
+		},
+		"error if fail to get the default quota": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetServiceQuota(mockInput).Return(nil, &servicequotas.NoSuchResourceException{})
+				m.EXPECT().GetAWSDefaultServiceQuota(mockDefaultInput).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get default quota L-F678F1CE of service vpc: some error"),
+		},
+		"returns the default value if the quota was never adjusted": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetServiceQuota(mockInput).Return(nil, &servicequotas.NoSuchResourceException{})
+				m.EXPECT().GetAWSDefaultServiceQuota(mockDefaultInput).Return(&servicequotas.GetAWSDefaultServiceQuotaOutput{
+					Quota: &servicequotas.ServiceQuota{Value: aws.Float64(5)},
+				}, nil)
+			},
+			wanted: 5,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			sq := ServiceQuotas{
+				client: m,
+			}
+
+			got, err := sq.Value("vpc", "L-F678F1CE")
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package sqs provides a client to make API requests to Amazon Simple Queue Service.
+package sqs
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sqs"
+)
+
+type api interface {
+	GetQueueAttributes(input *sqs.GetQueueAttributesInput) (*sqs.GetQueueAttributesOutput, error)
+	StartMessageMoveTask(input *sqs.StartMessageMoveTaskInput) (*sqs.StartMessageMoveTaskOutput, error)
+}
+
+// SQS wraps an Amazon Simple Queue Service client.
+type SQS struct {
+	client api
+}
+
+// New returns a SQS struct configured against the input session.
+func New(s *session.Session) *SQS {
+	return &SQS{
+		client: sqs.New(s),
+	}
+}
+
+// Queue contains the attributes of a queue.
+type Queue struct {
+	URL      string
+	ARN      string
+	Messages int // Approximate number of messages available for retrieval.
+}
+
+// Queue returns the ARN and the approximate number of messages of the queue at the given URL.
+func (s *SQS) Queue(url string) (*Queue, error) {
+	out, err := s.client.GetQueueAttributes(&sqs.GetQueueAttributesInput{
+		QueueUrl: aws.String(url),
+		AttributeNames: aws.StringSlice([]string{
+			sqs.QueueAttributeNameQueueArn,
+			sqs.QueueAttributeNameApproximateNumberOfMessages,
+		}),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get attributes of queue %s: %w", url, err)
+	}
+	queue := &Queue{
+		URL: url,
+		ARN: aws.StringValue(out.Attributes[sqs.QueueAttributeNameQueueArn]),
+	}
+	if messages, ok := out.Attributes[sqs.QueueAttributeNameApproximateNumberOfMessages]; ok {
+		count, err := strconv.Atoi(aws.StringValue(messages))
+		if err != nil {
+			return nil, fmt.Errorf("parse number of messages %q of queue %s: %w", aws.StringValue(messages), url, err)
+		}
+		queue.Messages = count
+	}
+	return queue, nil
+}
+
+// MoveMessagesInput holds the configuration to move the messages of a dead-letter queue.
+type MoveMessagesInput struct {
+	SourceARN            string
+	DestinationARN       string // Optional. If empty, the messages are moved back to their original source queues.
+	MaxMessagesPerSecond int    // Optional. If zero, Amazon SQS optimizes the velocity based on the backlog.
+}
+
+// MoveMessages starts a task that moves the messages of a dead-letter queue and returns the handle of the task.
+func (s *SQS) MoveMessages(in MoveMessagesInput) (string, error) {
+	input := &sqs.StartMessageMoveTaskInput{
+		SourceArn: aws.String(in.SourceARN),
+	}
+	if in.DestinationARN != "" {
+		input.DestinationArn = aws.String(in.DestinationARN)
+	}
+	if in.MaxMessagesPerSecond != 0 {
+		input.MaxNumberOfMessagesPerSecond = aws.Int64(int64(in.MaxMessagesPerSecond))
+	}
+	out, err := s.client.StartMessageMoveTask(input)
+	if err != nil {
+		return "", fmt.Errorf("start message move task from queue %s: %w", in.SourceARN, err)
+	}
+	return aws.StringValue(out.TaskHandle), nil
+}
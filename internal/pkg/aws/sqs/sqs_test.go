@@ -0,0 +1,161 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package sqs
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sqs/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	mockQueueURL = "https://sqs.us-west-2.amazonaws.com/123456789012/phonetool-test-worker-DeadLetterQueue"
+	mockQueueARN = "arn:aws:sqs:us-west-2:123456789012:phonetool-test-worker-DeadLetterQueue"
+)
+
+func TestSQS_Queue(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wanted    *Queue
+		wantedErr error
+	}{
+		"error if fail to get the queue attributes": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetQueueAttributes(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get attributes of queue " + mockQueueURL + ": some error"),
+		},
+		"error if the number of messages is not a number": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetQueueAttributes(gomock.Any()).Return(&sqs.GetQueueAttributesOutput{
+					Attributes: map[string]*string{
+						"QueueArn":                    aws.String(mockQueueARN),
+						"ApproximateNumberOfMessages": aws.String("many"),
+					},
+				}, nil)
+			},
+			wantedErr: errors.New(`parse number of messages "many" of queue ` + mockQueueURL + `: strconv.Atoi: parsing "many": invalid syntax`),
+		},
+		"success": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetQueueAttributes(&sqs.GetQueueAttributesInput{
+					QueueUrl:       aws.String(mockQueueURL),
+					AttributeNames: aws.StringSlice([]string{"QueueArn", "ApproximateNumberOfMessages"}),
+				}).Return(&sqs.GetQueueAttributesOutput{
+					Attributes: map[string]*string{
+						"QueueArn":                    aws.String(mockQueueARN),
+						"ApproximateNumberOfMessages": aws.String("42"),
+					},
+				}, nil)
+			},
+			wanted: &Queue{
+				URL:      mockQueueURL,
+				ARN:      mockQueueARN,
+				Messages: 42,
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			client := &SQS{
+				client: m,
+			}
+
+			// WHEN
+			got, err := client.Queue(mockQueueURL)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestSQS_MoveMessages(t *testing.T) {
+	testCases := map[string]struct {
+		in         MoveMessagesInput
+		setupMocks func(m *mocks.Mockapi)
+
+		wanted    string
+		wantedErr error
+	}{
+		"error if fail to start the message move task": {
+			in: MoveMessagesInput{
+				SourceARN: mockQueueARN,
+			},
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().StartMessageMoveTask(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("start message move task from queue " + mockQueueARN + ": some error"),
+		},
+		"moves messages back to their source queues": {
+			in: MoveMessagesInput{
+				SourceARN: mockQueueARN,
+			},
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().StartMessageMoveTask(&sqs.StartMessageMoveTaskInput{
+					SourceArn: aws.String(mockQueueARN),
+				}).Return(&sqs.StartMessageMoveTaskOutput{
+					TaskHandle: aws.String("mockHandle"),
+				}, nil)
+			},
+			wanted: "mockHandle",
+		},
+		"moves messages to a destination with a rate limit": {
+			in: MoveMessagesInput{
+				SourceARN:            mockQueueARN,
+				DestinationARN:       "arn:aws:sqs:us-west-2:123456789012:phonetool-test-worker-EventsQueue",
+				MaxMessagesPerSecond: 10,
+			},
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().StartMessageMoveTask(&sqs.StartMessageMoveTaskInput{
+					SourceArn:                    aws.String(mockQueueARN),
+					DestinationArn:               aws.String("arn:aws:sqs:us-west-2:123456789012:phonetool-test-worker-EventsQueue"),
+					MaxNumberOfMessagesPerSecond: aws.Int64(10),
+				}).Return(&sqs.StartMessageMoveTaskOutput{
+					TaskHandle: aws.String("mockHandle"),
+				}, nil)
+			},
+			wanted: "mockHandle",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			client := &SQS{
+				client: m,
+			}
+
+			// WHEN
+			got, err := client.MoveMessages(tc.in)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
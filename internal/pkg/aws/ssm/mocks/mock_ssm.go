@@ -85,3 +85,55 @@ func (mr *MockapiMockRecorder) PutParameter(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutParameter", reflect.TypeOf((*Mockapi)(nil).PutParameter), arg0)
 }
+
+// StartSession mocks base method.
+func (m *Mockapi) StartSession(arg0 *ssm.StartSessionInput) (*ssm.StartSessionOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartSession", arg0)
+	ret0, _ := ret[0].(*ssm.StartSessionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartSession indicates an expected call of StartSession.
+func (mr *MockapiMockRecorder) StartSession(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartSession", reflect.TypeOf((*Mockapi)(nil).StartSession), arg0)
+}
+
+// MockssmSessionStarter is a mock of ssmSessionStarter interface.
+type MockssmSessionStarter struct {
+	ctrl     *gomock.Controller
+	recorder *MockssmSessionStarterMockRecorder
+}
+
+// MockssmSessionStarterMockRecorder is the mock recorder for MockssmSessionStarter.
+type MockssmSessionStarterMockRecorder struct {
+	mock *MockssmSessionStarter
+}
+
+// NewMockssmSessionStarter creates a new mock instance.
+func NewMockssmSessionStarter(ctrl *gomock.Controller) *MockssmSessionStarter {
+	mock := &MockssmSessionStarter{ctrl: ctrl}
+	mock.recorder = &MockssmSessionStarterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockssmSessionStarter) EXPECT() *MockssmSessionStarterMockRecorder {
+	return m.recorder
+}
+
+// StartPortForwardingSession mocks base method.
+func (m *MockssmSessionStarter) StartPortForwardingSession(ssmSess *ssm.StartSessionOutput, in *ssm.StartSessionInput, endpoint string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartPortForwardingSession", ssmSess, in, endpoint)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartPortForwardingSession indicates an expected call of StartPortForwardingSession.
+func (mr *MockssmSessionStarterMockRecorder) StartPortForwardingSession(ssmSess, in, endpoint interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartPortForwardingSession", reflect.TypeOf((*MockssmSessionStarter)(nil).StartPortForwardingSession), ssmSess, in, endpoint)
+}
@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"strconv"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/request"
@@ -16,23 +17,38 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
 )
 
+const portForwardingToRemoteHostDocument = "AWS-StartPortForwardingSessionToRemoteHost"
+
 type api interface {
 	PutParameter(*ssm.PutParameterInput) (*ssm.PutParameterOutput, error)
 	AddTagsToResource(*ssm.AddTagsToResourceInput) (*ssm.AddTagsToResourceOutput, error)
 	GetParameterWithContext(context.Context, *ssm.GetParameterInput, ...request.Option) (*ssm.GetParameterOutput, error)
+	StartSession(*ssm.StartSessionInput) (*ssm.StartSessionOutput, error)
+}
+
+type ssmSessionStarter interface {
+	StartPortForwardingSession(ssmSess *ssm.StartSessionOutput, in *ssm.StartSessionInput, endpoint string) error
 }
 
 // SSM wraps an AWS SSM client.
 type SSM struct {
-	client api
+	client         api
+	endpoint       string
+	newSessStarter func() ssmSessionStarter
 }
 
 // New returns a SSM service configured against the input session.
 func New(s *session.Session) *SSM {
+	client := ssm.New(s)
 	return &SSM{
-		client: ssm.New(s),
+		client:   client,
+		endpoint: client.Endpoint,
+		newSessStarter: func() ssmSessionStarter {
+			return exec.NewSSMPluginCommand(s)
+		},
 	}
 }
 
@@ -42,6 +58,7 @@ type PutSecretInput struct {
 	Value     string
 	Overwrite bool
 	Tags      map[string]string
+	KeyID     string // Optional. The KMS key to encrypt the secret with. Defaults to the AWS managed key for SSM.
 }
 
 // PutSecretOutput wraps an ssm PutParameterOutput struct.
@@ -66,17 +83,51 @@ func (s *SSM) PutSecret(in PutSecretInput) (*PutSecretOutput, error) {
 
 // GetSecretValue retrieves the value of a parameter from AWS Systems Manager Parameter Store.
 // It takes the name of the parameter as input and returns the corresponding value as a string.
+// ErrParameterNotFound is returned if the parameter does not exist.
 func (s *SSM) GetSecretValue(ctx context.Context, name string) (string, error) {
 	resp, err := s.client.GetParameterWithContext(ctx, &ssm.GetParameterInput{
 		Name:           aws.String(name),
 		WithDecryption: aws.Bool(true),
 	})
 	if err != nil {
+		var awsErr awserr.Error
+		if errors.As(err, &awsErr) && awsErr.Code() == ssm.ErrCodeParameterNotFound {
+			return "", &ErrParameterNotFound{name}
+		}
 		return "", fmt.Errorf("get parameter %q from SSM: %w", name, err)
 	}
 	return aws.StringValue(resp.Parameter.Value), nil
 }
 
+// PortForwardingSessionInput holds the fields needed to forward a local port to a remote host through a target.
+type PortForwardingSessionInput struct {
+	Target    string // Managed node that the traffic goes through, for example "ecs:<cluster>_<task ID>_<container runtime ID>".
+	Host      string
+	Port      int
+	LocalPort int
+}
+
+// StartPortForwardingSession forwards the local port to the remote host through the target until the session is terminated.
+func (s *SSM) StartPortForwardingSession(in PortForwardingSessionInput) error {
+	input := &ssm.StartSessionInput{
+		DocumentName: aws.String(portForwardingToRemoteHostDocument),
+		Target:       aws.String(in.Target),
+		Parameters: map[string][]*string{
+			"host":            aws.StringSlice([]string{in.Host}),
+			"portNumber":      aws.StringSlice([]string{strconv.Itoa(in.Port)}),
+			"localPortNumber": aws.StringSlice([]string{strconv.Itoa(in.LocalPort)}),
+		},
+	}
+	resp, err := s.client.StartSession(input)
+	if err != nil {
+		return fmt.Errorf("start session to %s: %w", in.Target, err)
+	}
+	if err := s.newSessStarter().StartPortForwardingSession(resp, input, s.endpoint); err != nil {
+		return fmt.Errorf("start session %s using ssm plugin: %w", aws.StringValue(resp.SessionId), err)
+	}
+	return nil
+}
+
 func (s *SSM) createSecret(in PutSecretInput) (*PutSecretOutput, error) {
 	// Create a secret while adding the tags in a single call instead of separate calls to `PutParameter` and
 	// `AddTagsToResource` so that there won't be a case where the parameter is created while the tags are not added.
@@ -90,6 +141,9 @@ func (s *SSM) createSecret(in PutSecretInput) (*PutSecretOutput, error) {
 		Value:    aws.String(in.Value),
 		Tags:     tags,
 	}
+	if in.KeyID != "" {
+		input.KeyId = aws.String(in.KeyID)
+	}
 	output, err := s.client.PutParameter(input)
 	if err == nil {
 		return (*PutSecretOutput)(output), nil
@@ -114,6 +168,9 @@ func (s *SSM) overwriteSecret(in PutSecretInput) (*PutSecretOutput, error) {
 		Value:     aws.String(in.Value),
 		Overwrite: aws.Bool(in.Overwrite),
 	}
+	if in.KeyID != "" {
+		input.KeyId = aws.String(in.KeyID)
+	}
 	output, err := s.client.PutParameter(input)
 	if err != nil {
 		return nil, fmt.Errorf("update parameter %s: %w", in.Name, err)
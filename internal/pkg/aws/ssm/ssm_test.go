@@ -67,6 +67,38 @@ func TestSSM_PutSecret(t *testing.T) {
 				Version: aws.Int64(1),
 			},
 		},
+		"create a new secret encrypted with a customer managed key": {
+			inPutSecretInput: PutSecretInput{
+				Name:  fmt.Sprintf("/copilot/%s/%s/secrets/db-password", mockApp, mockEnv),
+				Value: "super secure password",
+				Tags: map[string]string{
+					deploy.AppTagKey: mockApp,
+				},
+				KeyID: "arn:aws:kms:us-west-2:123456789012:key/mrk-1234abcd",
+			},
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().PutParameter(&ssm.PutParameterInput{
+					DataType: aws.String("text"),
+					Type:     aws.String("SecureString"),
+					Name:     aws.String(fmt.Sprintf("/copilot/%s/%s/secrets/db-password", mockApp, mockEnv)),
+					Value:    aws.String("super secure password"),
+					KeyId:    aws.String("arn:aws:kms:us-west-2:123456789012:key/mrk-1234abcd"),
+					Tags: []*ssm.Tag{
+						{
+							Key:   aws.String(deploy.AppTagKey),
+							Value: aws.String(mockApp),
+						},
+					},
+				}).Return(&ssm.PutParameterOutput{
+					Tier:    aws.String("Standard"),
+					Version: aws.Int64(1),
+				}, nil)
+			},
+			wantedOut: &PutSecretOutput{
+				Tier:    aws.String("Standard"),
+				Version: aws.Int64(1),
+			},
+		},
 		"attempt to create a new secret even if overwrite is true": {
 			inPutSecretInput: PutSecretInput{
 				Name:  fmt.Sprintf("/copilot/%s/%s/secrets/db-password", mockApp, mockEnv),
@@ -377,6 +409,14 @@ func TestSSM_GetSecretValue(t *testing.T) {
 			},
 			wantError: `get parameter "asdf" from SSM: some error`,
 		},
+		"parameter not found": {
+			secretName: "asdf",
+			setupMock: func(m *mocks.Mockapi) {
+				m.EXPECT().GetParameterWithContext(gomock.Any(), gomock.Any()).
+					Return(nil, awserr.New(ssm.ErrCodeParameterNotFound, "not found", nil))
+			},
+			wantError: `parameter asdf not found`,
+		},
 		"success": {
 			secretName: "asdf",
 			setupMock: func(m *mocks.Mockapi) {
@@ -413,3 +453,78 @@ func TestSSM_GetSecretValue(t *testing.T) {
 		})
 	}
 }
+
+func TestSSM_StartPortForwardingSession(t *testing.T) {
+	const (
+		mockTarget   = "ecs:phonetool-test-Cluster_1234_1234-567"
+		mockEndpoint = "https://ssm.us-west-2.amazonaws.com"
+	)
+	mockInput := &ssm.StartSessionInput{
+		DocumentName: aws.String("AWS-StartPortForwardingSessionToRemoteHost"),
+		Target:       aws.String(mockTarget),
+		Parameters: map[string][]*string{
+			"host":            aws.StringSlice([]string{"mydb.abc.us-west-2.rds.amazonaws.com"}),
+			"portNumber":      aws.StringSlice([]string{"5432"}),
+			"localPortNumber": aws.StringSlice([]string{"15432"}),
+		},
+	}
+	mockSession := &ssm.StartSessionOutput{
+		SessionId: aws.String("mockSessionID"),
+	}
+	tests := map[string]struct {
+		setupMocks func(m *mocks.Mockapi, starter *mocks.MockssmSessionStarter)
+
+		wantError string
+	}{
+		"error if fail to start the session": {
+			setupMocks: func(m *mocks.Mockapi, starter *mocks.MockssmSessionStarter) {
+				m.EXPECT().StartSession(mockInput).Return(nil, errors.New("some error"))
+			},
+			wantError: "start session to " + mockTarget + ": some error",
+		},
+		"error if the ssm plugin fails": {
+			setupMocks: func(m *mocks.Mockapi, starter *mocks.MockssmSessionStarter) {
+				m.EXPECT().StartSession(mockInput).Return(mockSession, nil)
+				starter.EXPECT().StartPortForwardingSession(mockSession, mockInput, mockEndpoint).Return(errors.New("some error"))
+			},
+			wantError: "start session mockSessionID using ssm plugin: some error",
+		},
+		"success": {
+			setupMocks: func(m *mocks.Mockapi, starter *mocks.MockssmSessionStarter) {
+				m.EXPECT().StartSession(mockInput).Return(mockSession, nil)
+				starter.EXPECT().StartPortForwardingSession(mockSession, mockInput, mockEndpoint).Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			api := mocks.NewMockapi(ctrl)
+			starter := mocks.NewMockssmSessionStarter(ctrl)
+			tc.setupMocks(api, starter)
+
+			ssm := SSM{
+				client:   api,
+				endpoint: mockEndpoint,
+				newSessStarter: func() ssmSessionStarter {
+					return starter
+				},
+			}
+
+			err := ssm.StartPortForwardingSession(PortForwardingSessionInput{
+				Target:    mockTarget,
+				Host:      "mydb.abc.us-west-2.rds.amazonaws.com",
+				Port:      5432,
+				LocalPort: 15432,
+			})
+			if tc.wantError != "" {
+				require.EqualError(t, err, tc.wantError)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -34,6 +34,21 @@ func (m *Mockapi) EXPECT() *MockapiMockRecorder {
 	return m.recorder
 }
 
+// DescribeExecution mocks base method.
+func (m *Mockapi) DescribeExecution(input *sfn.DescribeExecutionInput) (*sfn.DescribeExecutionOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeExecution", input)
+	ret0, _ := ret[0].(*sfn.DescribeExecutionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeExecution indicates an expected call of DescribeExecution.
+func (mr *MockapiMockRecorder) DescribeExecution(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeExecution", reflect.TypeOf((*Mockapi)(nil).DescribeExecution), input)
+}
+
 // DescribeStateMachine mocks base method.
 func (m *Mockapi) DescribeStateMachine(input *sfn.DescribeStateMachineInput) (*sfn.DescribeStateMachineOutput, error) {
 	m.ctrl.T.Helper()
@@ -49,6 +64,36 @@ func (mr *MockapiMockRecorder) DescribeStateMachine(input interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeStateMachine", reflect.TypeOf((*Mockapi)(nil).DescribeStateMachine), input)
 }
 
+// GetExecutionHistory mocks base method.
+func (m *Mockapi) GetExecutionHistory(input *sfn.GetExecutionHistoryInput) (*sfn.GetExecutionHistoryOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExecutionHistory", input)
+	ret0, _ := ret[0].(*sfn.GetExecutionHistoryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExecutionHistory indicates an expected call of GetExecutionHistory.
+func (mr *MockapiMockRecorder) GetExecutionHistory(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExecutionHistory", reflect.TypeOf((*Mockapi)(nil).GetExecutionHistory), input)
+}
+
+// ListExecutions mocks base method.
+func (m *Mockapi) ListExecutions(input *sfn.ListExecutionsInput) (*sfn.ListExecutionsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExecutions", input)
+	ret0, _ := ret[0].(*sfn.ListExecutionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExecutions indicates an expected call of ListExecutions.
+func (mr *MockapiMockRecorder) ListExecutions(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExecutions", reflect.TypeOf((*Mockapi)(nil).ListExecutions), input)
+}
+
 // StartExecution mocks base method.
 func (m *Mockapi) StartExecution(input *sfn.StartExecutionInput) (*sfn.StartExecutionOutput, error) {
 	m.ctrl.T.Helper()
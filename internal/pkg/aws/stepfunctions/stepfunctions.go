@@ -5,7 +5,9 @@
 package stepfunctions
 
 import (
+	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
@@ -15,8 +17,32 @@ import (
 type api interface {
 	DescribeStateMachine(input *sfn.DescribeStateMachineInput) (*sfn.DescribeStateMachineOutput, error)
 	StartExecution(input *sfn.StartExecutionInput) (*sfn.StartExecutionOutput, error)
+	ListExecutions(input *sfn.ListExecutionsInput) (*sfn.ListExecutionsOutput, error)
+	DescribeExecution(input *sfn.DescribeExecutionInput) (*sfn.DescribeExecutionOutput, error)
+	GetExecutionHistory(input *sfn.GetExecutionHistoryInput) (*sfn.GetExecutionHistoryOutput, error)
 }
 
+// Execution holds the status of a state machine execution.
+type Execution struct {
+	Name      string    `json:"name"`
+	Status    string    `json:"status"`
+	StartDate time.Time `json:"startDate"`
+	StopDate  time.Time `json:"stopDate"` // Zero if the execution is still running.
+
+	// Error and Cause are only set by DescribeExecution, if the execution failed.
+	Error string `json:"error,omitempty"`
+	Cause string `json:"cause,omitempty"`
+}
+
+// Execution statuses.
+const (
+	ExecutionStatusRunning   = sfn.ExecutionStatusRunning
+	ExecutionStatusSucceeded = sfn.ExecutionStatusSucceeded
+	ExecutionStatusFailed    = sfn.ExecutionStatusFailed
+	ExecutionStatusTimedOut  = sfn.ExecutionStatusTimedOut
+	ExecutionStatusAborted   = sfn.ExecutionStatusAborted
+)
+
 // StepFunctions wraps an AWS StepFunctions client.
 type StepFunctions struct {
 	client api
@@ -41,13 +67,94 @@ func (s *StepFunctions) StateMachineDefinition(stateMachineARN string) (string,
 	return aws.StringValue(out.Definition), nil
 }
 
-//Execute starts a state machine execution.
-func (s *StepFunctions) Execute(arn string) error {
-	_, err := s.client.StartExecution(&sfn.StartExecutionInput{
+// Execute starts a state machine execution and returns the ARN of the execution.
+func (s *StepFunctions) Execute(arn string) (string, error) {
+	out, err := s.client.StartExecution(&sfn.StartExecutionInput{
 		StateMachineArn: aws.String(arn),
 	})
 	if err != nil {
-		return fmt.Errorf("execute state machine %s: %w", arn, err)
+		return "", fmt.Errorf("execute state machine %s: %w", arn, err)
+	}
+	return aws.StringValue(out.ExecutionArn), nil
+}
+
+// DescribeExecution returns the status of an execution, and the error that it failed with if any.
+func (s *StepFunctions) DescribeExecution(executionARN string) (*Execution, error) {
+	out, err := s.client.DescribeExecution(&sfn.DescribeExecutionInput{
+		ExecutionArn: aws.String(executionARN),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe execution %s: %w", executionARN, err)
+	}
+	return &Execution{
+		Name:      aws.StringValue(out.Name),
+		Status:    aws.StringValue(out.Status),
+		StartDate: aws.TimeValue(out.StartDate),
+		StopDate:  aws.TimeValue(out.StopDate),
+		Error:     aws.StringValue(out.Error),
+		Cause:     aws.StringValue(out.Cause),
+	}, nil
+}
+
+// Executions returns up to limit of the most recent executions of a state machine, starting with the most recent one.
+func (s *StepFunctions) Executions(stateMachineARN string, limit int) ([]Execution, error) {
+	var executions []Execution
+	in := &sfn.ListExecutionsInput{
+		StateMachineArn: aws.String(stateMachineARN),
+	}
+	for {
+		in.MaxResults = aws.Int64(int64(limit - len(executions)))
+		out, err := s.client.ListExecutions(in)
+		if err != nil {
+			return nil, fmt.Errorf("list executions of state machine %s: %w", stateMachineARN, err)
+		}
+		for _, item := range out.Executions {
+			executions = append(executions, Execution{
+				Name:      aws.StringValue(item.Name),
+				Status:    aws.StringValue(item.Status),
+				StartDate: aws.TimeValue(item.StartDate),
+				StopDate:  aws.TimeValue(item.StopDate),
+			})
+		}
+		if out.NextToken == nil || len(executions) >= limit {
+			return executions, nil
+		}
+		in.NextToken = out.NextToken
+	}
+}
+
+// ExecutionTaskARNs returns the ARNs of the Amazon ECS tasks started by an execution, in the order they were started.
+func (s *StepFunctions) ExecutionTaskARNs(executionARN string) ([]string, error) {
+	var taskARNs []string
+	in := &sfn.GetExecutionHistoryInput{
+		ExecutionArn: aws.String(executionARN),
+	}
+	for {
+		out, err := s.client.GetExecutionHistory(in)
+		if err != nil {
+			return nil, fmt.Errorf("get history of execution %s: %w", executionARN, err)
+		}
+		for _, event := range out.Events {
+			details := event.TaskSubmittedEventDetails
+			if aws.StringValue(event.Type) != sfn.HistoryEventTypeTaskSubmitted || details == nil || aws.StringValue(details.ResourceType) != "ecs" {
+				continue
+			}
+			// The output of a submitted ECS task is the response of the RunTask API.
+			var runTask struct {
+				Tasks []struct {
+					TaskArn string
+				}
+			}
+			if err := json.Unmarshal([]byte(aws.StringValue(details.Output)), &runTask); err != nil {
+				return nil, fmt.Errorf("unmarshal output of the task submitted by execution %s: %w", executionARN, err)
+			}
+			for _, task := range runTask.Tasks {
+				taskARNs = append(taskARNs, task.TaskArn)
+			}
+		}
+		if out.NextToken == nil {
+			return taskARNs, nil
+		}
+		in.NextToken = out.NextToken
 	}
-	return nil
 }
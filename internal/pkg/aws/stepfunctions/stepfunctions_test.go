@@ -76,6 +76,7 @@ func TestStepFunctions_Execute(t *testing.T) {
 
 		mockStepFunctionsClient func(m *mocks.Mockapi)
 
+		wantedARN   string
 		wantedError error
 	}{
 
@@ -94,10 +95,11 @@ func TestStepFunctions_Execute(t *testing.T) {
 				m.EXPECT().StartExecution(&sfn.StartExecutionInput{
 					StateMachineArn: aws.String("forca barca"),
 				}).Return(&sfn.StartExecutionOutput{
-					ExecutionArn: aws.String("forca barca"),
+					ExecutionArn: aws.String("forca barca:execution-1"),
 					StartDate:    func() *time.Time { t := time.Now(); return &t }(),
 				}, nil)
 			},
+			wantedARN: "forca barca:execution-1",
 		},
 	}
 
@@ -112,10 +114,265 @@ func TestStepFunctions_Execute(t *testing.T) {
 				client: mockStepFunctionsClient,
 			}
 
-			err := sfn.Execute(tc.inStateMachineARN)
+			arn, err := sfn.Execute(tc.inStateMachineARN)
 			if tc.wantedError != nil {
 				require.EqualError(t, tc.wantedError, err.Error())
+				return
 			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedARN, arn)
+		})
+	}
+}
+
+func TestStepFunctions_Executions(t *testing.T) {
+	startDate := time.Date(2024, time.March, 1, 10, 0, 0, 0, time.UTC)
+	stopDate := startDate.Add(time.Minute)
+	testCases := map[string]struct {
+		inLimit int
+
+		mockStepFunctionsClient func(m *mocks.Mockapi)
+
+		wantedError      error
+		wantedExecutions []Execution
+	}{
+		"fail to list executions": {
+			inLimit: 10,
+			mockStepFunctionsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListExecutions(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list executions of state machine mockARN: some error"),
+		},
+		"paginates until the limit is reached": {
+			inLimit: 2,
+			mockStepFunctionsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListExecutions(&sfn.ListExecutionsInput{
+					StateMachineArn: aws.String("mockARN"),
+					MaxResults:      aws.Int64(2),
+				}).Return(&sfn.ListExecutionsOutput{
+					Executions: []*sfn.ExecutionListItem{
+						{
+							Name:      aws.String("running"),
+							Status:    aws.String(sfn.ExecutionStatusRunning),
+							StartDate: aws.Time(startDate),
+						},
+					},
+					NextToken: aws.String("token"),
+				}, nil)
+				m.EXPECT().ListExecutions(&sfn.ListExecutionsInput{
+					StateMachineArn: aws.String("mockARN"),
+					MaxResults:      aws.Int64(1),
+					NextToken:       aws.String("token"),
+				}).Return(&sfn.ListExecutionsOutput{
+					Executions: []*sfn.ExecutionListItem{
+						{
+							Name:      aws.String("failed"),
+							Status:    aws.String(sfn.ExecutionStatusFailed),
+							StartDate: aws.Time(startDate),
+							StopDate:  aws.Time(stopDate),
+						},
+					},
+					NextToken: aws.String("another token"),
+				}, nil)
+			},
+			wantedExecutions: []Execution{
+				{
+					Name:      "running",
+					Status:    ExecutionStatusRunning,
+					StartDate: startDate,
+				},
+				{
+					Name:      "failed",
+					Status:    ExecutionStatusFailed,
+					StartDate: startDate,
+					StopDate:  stopDate,
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStepFunctionsClient := mocks.NewMockapi(ctrl)
+			tc.mockStepFunctionsClient(mockStepFunctionsClient)
+			sfn := StepFunctions{
+				client: mockStepFunctionsClient,
+			}
+
+			out, err := sfn.Executions("mockARN", tc.inLimit)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedExecutions, out)
+		})
+	}
+}
+
+func TestStepFunctions_DescribeExecution(t *testing.T) {
+	startDate := time.Date(2024, time.March, 1, 10, 0, 0, 0, time.UTC)
+	stopDate := startDate.Add(time.Minute)
+	testCases := map[string]struct {
+		mockStepFunctionsClient func(m *mocks.Mockapi)
+
+		wantedExecution *Execution
+		wantedError     error
+	}{
+		"fail to describe execution": {
+			mockStepFunctionsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeExecution(&sfn.DescribeExecutionInput{
+					ExecutionArn: aws.String("mockExecutionARN"),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe execution mockExecutionARN: some error"),
+		},
+		"returns the error and cause of a failed execution": {
+			mockStepFunctionsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeExecution(&sfn.DescribeExecutionInput{
+					ExecutionArn: aws.String("mockExecutionARN"),
+				}).Return(&sfn.DescribeExecutionOutput{
+					Name:      aws.String("failed"),
+					Status:    aws.String(sfn.ExecutionStatusFailed),
+					StartDate: aws.Time(startDate),
+					StopDate:  aws.Time(stopDate),
+					Error:     aws.String("States.TaskFailed"),
+					Cause:     aws.String("Essential container in task exited"),
+				}, nil)
+			},
+			wantedExecution: &Execution{
+				Name:      "failed",
+				Status:    ExecutionStatusFailed,
+				StartDate: startDate,
+				StopDate:  stopDate,
+				Error:     "States.TaskFailed",
+				Cause:     "Essential container in task exited",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStepFunctionsClient := mocks.NewMockapi(ctrl)
+			tc.mockStepFunctionsClient(mockStepFunctionsClient)
+			sfn := StepFunctions{
+				client: mockStepFunctionsClient,
+			}
+
+			out, err := sfn.DescribeExecution("mockExecutionARN")
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedExecution, out)
+		})
+	}
+}
+
+func TestStepFunctions_ExecutionTaskARNs(t *testing.T) {
+	testCases := map[string]struct {
+		mockStepFunctionsClient func(m *mocks.Mockapi)
+
+		wantedTaskARNs []string
+		wantedError    error
+	}{
+		"fail to get the execution history": {
+			mockStepFunctionsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetExecutionHistory(&sfn.GetExecutionHistoryInput{
+					ExecutionArn: aws.String("mockExecutionARN"),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get history of execution mockExecutionARN: some error"),
+		},
+		"fail to unmarshal the output of a submitted task": {
+			mockStepFunctionsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetExecutionHistory(gomock.Any()).Return(&sfn.GetExecutionHistoryOutput{
+					Events: []*sfn.HistoryEvent{
+						{
+							Type: aws.String(sfn.HistoryEventTypeTaskSubmitted),
+							TaskSubmittedEventDetails: &sfn.TaskSubmittedEventDetails{
+								ResourceType: aws.String("ecs"),
+								Output:       aws.String("{"),
+							},
+						},
+					},
+				}, nil)
+			},
+			wantedError: errors.New("unmarshal output of the task submitted by execution mockExecutionARN: unexpected end of JSON input"),
+		},
+		"returns the ECS tasks submitted across pages": {
+			mockStepFunctionsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetExecutionHistory(&sfn.GetExecutionHistoryInput{
+					ExecutionArn: aws.String("mockExecutionARN"),
+				}).Return(&sfn.GetExecutionHistoryOutput{
+					Events: []*sfn.HistoryEvent{
+						{
+							Type: aws.String(sfn.HistoryEventTypeExecutionStarted),
+						},
+						{
+							Type: aws.String(sfn.HistoryEventTypeTaskSubmitted),
+							TaskSubmittedEventDetails: &sfn.TaskSubmittedEventDetails{
+								ResourceType: aws.String("dynamodb"),
+								Output:       aws.String(`{}`),
+							},
+						},
+						{
+							Type: aws.String(sfn.HistoryEventTypeTaskSubmitted),
+							TaskSubmittedEventDetails: &sfn.TaskSubmittedEventDetails{
+								ResourceType: aws.String("ecs"),
+								Output:       aws.String(`{"Tasks":[{"TaskArn":"arn:aws:ecs:us-west-2:123456789012:task/cluster/1"}]}`),
+							},
+						},
+					},
+					NextToken: aws.String("token"),
+				}, nil)
+				m.EXPECT().GetExecutionHistory(&sfn.GetExecutionHistoryInput{
+					ExecutionArn: aws.String("mockExecutionARN"),
+					NextToken:    aws.String("token"),
+				}).Return(&sfn.GetExecutionHistoryOutput{
+					Events: []*sfn.HistoryEvent{
+						{
+							Type: aws.String(sfn.HistoryEventTypeTaskSubmitted),
+							TaskSubmittedEventDetails: &sfn.TaskSubmittedEventDetails{
+								ResourceType: aws.String("ecs"),
+								Output:       aws.String(`{"Tasks":[{"TaskArn":"arn:aws:ecs:us-west-2:123456789012:task/cluster/2"}]}`),
+							},
+						},
+					},
+				}, nil)
+			},
+			wantedTaskARNs: []string{
+				"arn:aws:ecs:us-west-2:123456789012:task/cluster/1",
+				"arn:aws:ecs:us-west-2:123456789012:task/cluster/2",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStepFunctionsClient := mocks.NewMockapi(ctrl)
+			tc.mockStepFunctionsClient(mockStepFunctionsClient)
+			sfn := StepFunctions{
+				client: mockStepFunctionsClient,
+			}
+
+			out, err := sfn.ExecutionTaskARNs("mockExecutionARN")
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedTaskARNs, out)
 		})
 	}
 }
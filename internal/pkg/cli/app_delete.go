@@ -23,6 +23,7 @@ import (
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/version"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
 
 	"github.com/spf13/afero"
@@ -183,6 +184,11 @@ func (o *deleteAppOpts) Ask() error {
 	return nil
 }
 
+// ValidateVersionPin returns an error if the application doesn't allow this version of Copilot to delete it.
+func (o *deleteAppOpts) ValidateVersionPin() error {
+	return validateVersionPin(o.store, o.name, version.Version, "")
+}
+
 // Execute deletes the application.
 // It removes the pipelines, all the services from each environment, the environments, the pipeline S3 buckets,
 // the application, removes the variables from the config store, and deletes the local workspace.
@@ -412,5 +418,6 @@ func buildAppDeleteCommand() *cobra.Command {
 
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	markAudited(cmd)
 	return cmd
 }
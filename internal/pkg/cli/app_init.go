@@ -25,6 +25,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
@@ -42,8 +43,16 @@ const (
 type initAppVars struct {
 	name                string
 	permissionsBoundary string
+	cfnExecutionRole    string
+	envRolesTemplate    string
+	ciRoles             []string
 	domainName          string
 	resourceTags        map[string]string
+
+	ecrKeepImages         int
+	ecrExpireUntaggedDays int
+
+	versionPinVars
 }
 
 type initAppOpts struct {
@@ -58,6 +67,7 @@ type initAppOpts struct {
 	iam                  policyLister
 	iamRoleManager       roleManager
 	isSessionFromEnvVars func() (bool, error)
+	fs                   afero.Fs
 
 	existingWorkspace func() (wsAppManager, error)
 	newWorkspace      func(appName string) (wsAppManager, error)
@@ -84,6 +94,7 @@ func newInitAppOpts(vars initAppVars) (*initAppOpts, error) {
 		prog:           termprogress.NewSpinner(log.DiagnosticWriter),
 		iam:            iamClient,
 		iamRoleManager: iamClient,
+		fs:             fs,
 		isSessionFromEnvVars: func() (bool, error) {
 			return sessions.AreCredsFromEnvVars(sess)
 		},
@@ -117,6 +128,22 @@ func (o *initAppOpts) Validate() error {
 			return err
 		}
 	}
+	if o.cfnExecutionRole != "" {
+		if err := validateIAMRoleARN(o.cfnExecutionRole); err != nil {
+			return fmt.Errorf("--%s: %w", cfnExecutionRoleFlag, err)
+		}
+	}
+	for _, role := range o.ciRoles {
+		if err := validateIAMRoleARN(role); err != nil {
+			return fmt.Errorf("--%s %s: %w", ciRolesFlag, role, err)
+		}
+	}
+	if err := validateImageLifecycle(o.ecrKeepImages, o.ecrExpireUntaggedDays); err != nil {
+		return err
+	}
+	if err := o.versionPinVars.validate(); err != nil {
+		return err
+	}
 	if o.domainName != "" {
 		o.prog.Start(fmt.Sprintf("Validating ownership of %q", o.domainName))
 		defer o.prog.Stop("")
@@ -236,7 +263,10 @@ func (o *initAppOpts) Execute() error {
 		DomainHostedZoneID:  hostedZoneID,
 		PermissionsBoundary: o.permissionsBoundary,
 		AdditionalTags:      o.resourceTags,
+		ImageLifecycle:      o.imageLifecycle(),
 		Version:             version.LatestTemplateVersion(),
+		CFNExecutionRoleARN: o.cfnExecutionRole,
+		CIRoleARNs:          o.ciRoles,
 	})
 	if err != nil {
 		return err
@@ -249,14 +279,56 @@ func (o *initAppOpts) Execute() error {
 		DomainHostedZoneID:  hostedZoneID,
 		PermissionsBoundary: o.permissionsBoundary,
 		Tags:                o.resourceTags,
+		ImageLifecycle:      o.imageLifecycle(),
+		CFNExecutionRoleARN: o.cfnExecutionRole,
+		CIRoleARNs:          o.ciRoles,
+		VersionPin:          o.versionPinVars.applyTo(nil),
 	}); err != nil {
 		return err
 	}
 	log.Successf("The directory %s will hold service manifests for application %s.\n", color.HighlightResource(workspace.CopilotDirName), color.HighlightUserInput(o.name))
+	if o.envRolesTemplate != "" {
+		if err := o.writeEnvRolesTemplate(caller.Account); err != nil {
+			return err
+		}
+	}
 	log.Infoln()
 	return nil
 }
 
+// writeEnvRolesTemplate writes the template that creates the roles to create environments in other accounts
+// from the application account.
+func (o *initAppOpts) writeEnvRolesTemplate(accountID string) error {
+	appConfig := stack.NewAppStackConfig(&deploy.CreateAppInput{
+		Name:                o.name,
+		AccountID:           accountID,
+		PermissionsBoundary: o.permissionsBoundary,
+		Version:             version.LatestTemplateVersion(),
+	})
+	tpl, err := appConfig.EnvRolesTemplate()
+	if err != nil {
+		return fmt.Errorf("generate environment roles template: %w", err)
+	}
+	if err := afero.WriteFile(o.fs, o.envRolesTemplate, []byte(tpl), 0644); err != nil {
+		return fmt.Errorf("write environment roles template to %s: %w", o.envRolesTemplate, err)
+	}
+	log.Successf("Wrote the template of roles %s and %s for your environment accounts to %s.\n",
+		color.HighlightResource(stack.NameForAppEnvManagerRole(o.name)), color.HighlightResource(stack.NameForAppEnvCFNExecutionRole(o.name)),
+		color.HighlightResource(o.envRolesTemplate))
+	return nil
+}
+
+func (o *initAppOpts) imageLifecycle() *config.ImageLifecycle {
+	lifecycle := &config.ImageLifecycle{
+		KeepLastImages:          o.ecrKeepImages,
+		ExpireUntaggedAfterDays: o.ecrExpireUntaggedDays,
+	}
+	if lifecycle.IsEmpty() {
+		return nil
+	}
+	return lifecycle
+}
+
 func (o *initAppOpts) validateAppName(name string) error {
 	if err := validateAppNameString(name); err != nil {
 		return err
@@ -410,8 +482,18 @@ An application is a collection of containerized services that operate together.`
   /code $ copilot app init --domain example.com
   Create a new application with an existing IAM policy as the permissions boundary for roles.
   /code $ copilot app init --permissions-boundary myPermissionsBoundaryPolicy
+  Create a new application whose stacks are deployed by CloudFormation with an existing service role.
+  /code $ copilot app init --cfn-execution-role arn:aws:iam::123456789012:role/CloudFormationServiceRole
   Create a new application with resource tags.
-  /code $ copilot app init --resource-tags department=MyDept,team=MyTeam`,
+  /code $ copilot app init --resource-tags department=MyDept,team=MyTeam
+  Create a new application whose ECR repositories keep the last 20 images and expire untagged images after 7 days.
+  /code $ copilot app init --ecr-keep-images 20 --ecr-expire-untagged-days 7
+  Create a new application that can only be updated with the Copilot CLI v1.32.0 or later.
+  /code $ copilot app init --min-cli-version v1.32.0
+  Create a new application whose images can be pushed by a GitHub Actions role.
+  /code $ copilot app init --ci-roles arn:aws:iam::123456789012:role/github-actions
+  Create a new application and write the template of the role to create its environments in other accounts.
+  /code $ copilot app init --env-roles-template env-roles.yml`,
 		Args: reservedArgs,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newInitAppOpts(vars)
@@ -426,6 +508,16 @@ An application is a collection of containerized services that operate together.`
 	}
 	cmd.Flags().StringVar(&vars.domainName, domainNameFlag, "", domainNameFlagDescription)
 	cmd.Flags().StringVar(&vars.permissionsBoundary, permissionsBoundaryFlag, "", permissionsBoundaryFlagDescription)
+	cmd.Flags().StringVar(&vars.cfnExecutionRole, cfnExecutionRoleFlag, "", appCFNExecutionRoleFlagDescription)
+	cmd.Flags().StringVar(&vars.envRolesTemplate, envRolesTemplateFlag, "", envRolesTemplateFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.ciRoles, ciRolesFlag, nil, ciRolesFlagDescription)
 	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
+	cmd.Flags().IntVar(&vars.ecrKeepImages, ecrKeepImagesFlag, 0, ecrKeepImagesFlagDescription)
+	cmd.Flags().IntVar(&vars.ecrExpireUntaggedDays, ecrExpireUntaggedDaysFlag, 0, ecrExpireUntaggedDaysFlagDescription)
+	cmd.Flags().StringVar(&vars.minCLIVersion, minCLIVersionFlag, "", minCLIVersionFlagDescription)
+	cmd.Flags().StringVar(&vars.maxCLIVersion, maxCLIVersionFlag, "", maxCLIVersionFlagDescription)
+	cmd.Flags().StringVar(&vars.minEnvTemplateVersion, minEnvTemplateVersionFlag, "", minEnvTemplateVersionFlagDescription)
+	cmd.Flags().StringVar(&vars.maxEnvTemplateVersion, maxEnvTemplateVersionFlag, "", maxEnvTemplateVersionFlagDescription)
+	markAudited(cmd)
 	return cmd
 }
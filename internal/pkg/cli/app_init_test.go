@@ -16,6 +16,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/version"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/require"
 )
 
@@ -29,9 +30,11 @@ type initAppMocks struct {
 
 func TestInitAppOpts_Validate(t *testing.T) {
 	testCases := map[string]struct {
-		inAppName      string
-		inDomainName   string
-		inPBPolicyName string
+		inAppName          string
+		inDomainName       string
+		inPBPolicyName     string
+		inCFNExecutionRole string
+		inCIRoles          []string
 
 		mock func(m *initAppMocks)
 
@@ -151,6 +154,20 @@ func TestInitAppOpts_Validate(t *testing.T) {
 			},
 			wantedError: errors.New("IAM policy \"nonexistentPolicyName\" not found in this account"),
 		},
+		"invalid CloudFormation execution role": {
+			inCFNExecutionRole: "arn:aws:iam::123456789012:policy/CloudFormationServiceRole",
+			mock:               func(m *initAppMocks) {},
+			wantedError:        errors.New("--cfn-execution-role: value must be the ARN of an IAM role (example: arn:aws:iam::111122223333:role/CloudFormationServiceRole)"),
+		},
+		"invalid CI role": {
+			inCIRoles:   []string{"arn:aws:iam::123456789012:role/github-actions", "jenkins"},
+			mock:        func(m *initAppMocks) {},
+			wantedError: errors.New("--ci-roles jenkins: value must be the ARN of an IAM role (example: arn:aws:iam::111122223333:role/CloudFormationServiceRole)"),
+		},
+		"valid CI roles": {
+			inCIRoles: []string{"arn:aws:iam::123456789012:role/github-actions", "arn:aws:iam::210987654321:role/jenkins"},
+			mock:      func(m *initAppMocks) {},
+		},
 		"invalid domain name that doesn't have a hosted zone": {
 			inDomainName: "badMockDomain.com",
 			mock: func(m *initAppMocks) {
@@ -219,6 +236,8 @@ func TestInitAppOpts_Validate(t *testing.T) {
 					name:                tc.inAppName,
 					domainName:          tc.inDomainName,
 					permissionsBoundary: tc.inPBPolicyName,
+					cfnExecutionRole:    tc.inCFNExecutionRole,
+					ciRoles:             tc.inCIRoles,
 				},
 			}
 
@@ -635,9 +654,15 @@ func TestInitAppOpts_Execute(t *testing.T) {
 		inDomainName                string
 		inDomainHostedZoneID        string
 		inPermissionsBoundaryPolicy string
-
-		expectedError error
-		mocking       func(m *initAppExecuteMocks)
+		inECRKeepImages             int
+		inECRExpireUntaggedDays     int
+		inCFNExecutionRole          string
+		inEnvRolesTemplate          string
+		inCIRoles                   []string
+
+		expectedError          error
+		wantedEnvRolesTemplate []string
+		mocking                func(m *initAppExecuteMocks)
 	}{
 		"with a successful call to add app": {
 			inDomainName:                "amazon.com",
@@ -674,6 +699,121 @@ func TestInitAppOpts_Execute(t *testing.T) {
 				}).Return(nil)
 			},
 		},
+		"with an image lifecycle policy": {
+			inECRKeepImages:         20,
+			inECRExpireUntaggedDays: 7,
+
+			mocking: func(m *initAppExecuteMocks) {
+				m.identityService.EXPECT().Get().Return(identity.Caller{
+					Account: "12345",
+				}, nil)
+				m.store.EXPECT().CreateApplication(&config.Application{
+					AccountID: "12345",
+					Name:      "myapp",
+					Tags: map[string]string{
+						"owner": "boss",
+					},
+					ImageLifecycle: &config.ImageLifecycle{
+						KeepLastImages:          20,
+						ExpireUntaggedAfterDays: 7,
+					},
+				})
+				m.newWorkspace = func(appName string) (wsAppManager, error) {
+					return m.ws, nil
+				}
+				m.deployer.EXPECT().DeployApp(&deploy.CreateAppInput{
+					Name:      "myapp",
+					AccountID: "12345",
+					AdditionalTags: map[string]string{
+						"owner": "boss",
+					},
+					ImageLifecycle: &config.ImageLifecycle{
+						KeepLastImages:          20,
+						ExpireUntaggedAfterDays: 7,
+					},
+					Version: version.LatestTemplateVersion(),
+				}).Return(nil)
+			},
+		},
+		"with a CloudFormation execution role": {
+			inCFNExecutionRole: "arn:aws:iam::12345:role/CloudFormationServiceRole",
+
+			mocking: func(m *initAppExecuteMocks) {
+				m.identityService.EXPECT().Get().Return(identity.Caller{
+					Account: "12345",
+				}, nil)
+				m.store.EXPECT().CreateApplication(&config.Application{
+					AccountID: "12345",
+					Name:      "myapp",
+					Tags: map[string]string{
+						"owner": "boss",
+					},
+					CFNExecutionRoleARN: "arn:aws:iam::12345:role/CloudFormationServiceRole",
+				})
+				m.newWorkspace = func(appName string) (wsAppManager, error) {
+					return m.ws, nil
+				}
+				m.deployer.EXPECT().DeployApp(&deploy.CreateAppInput{
+					Name:      "myapp",
+					AccountID: "12345",
+					AdditionalTags: map[string]string{
+						"owner": "boss",
+					},
+					Version:             version.LatestTemplateVersion(),
+					CFNExecutionRoleARN: "arn:aws:iam::12345:role/CloudFormationServiceRole",
+				}).Return(nil)
+			},
+		},
+		"with CI roles": {
+			inCIRoles: []string{"arn:aws:iam::12345:role/github-actions"},
+
+			mocking: func(m *initAppExecuteMocks) {
+				m.identityService.EXPECT().Get().Return(identity.Caller{
+					Account: "12345",
+				}, nil)
+				m.store.EXPECT().CreateApplication(&config.Application{
+					AccountID: "12345",
+					Name:      "myapp",
+					Tags: map[string]string{
+						"owner": "boss",
+					},
+					CIRoleARNs: []string{"arn:aws:iam::12345:role/github-actions"},
+				})
+				m.newWorkspace = func(appName string) (wsAppManager, error) {
+					return m.ws, nil
+				}
+				m.deployer.EXPECT().DeployApp(&deploy.CreateAppInput{
+					Name:      "myapp",
+					AccountID: "12345",
+					AdditionalTags: map[string]string{
+						"owner": "boss",
+					},
+					Version:    version.LatestTemplateVersion(),
+					CIRoleARNs: []string{"arn:aws:iam::12345:role/github-actions"},
+				}).Return(nil)
+			},
+		},
+		"with a template for the roles of environments in other accounts": {
+			inPermissionsBoundaryPolicy: "mockPolicy",
+			inEnvRolesTemplate:          "env-roles.yml",
+
+			mocking: func(m *initAppExecuteMocks) {
+				m.identityService.EXPECT().Get().Return(identity.Caller{
+					Account: "12345",
+				}, nil)
+				m.store.EXPECT().CreateApplication(gomock.Any()).Return(nil)
+				m.newWorkspace = func(appName string) (wsAppManager, error) {
+					return m.ws, nil
+				}
+				m.deployer.EXPECT().DeployApp(gomock.Any()).Return(nil)
+			},
+			wantedEnvRolesTemplate: []string{
+				"RoleName: myapp-EnvCFNExecutionRole",
+				"RoleName: myapp-EnvManagerRole",
+				"iam::12345:root",
+				"policy/mockPolicy",
+			},
+		},
 		"should return error from workspace.Create": {
 			expectedError: mockError,
 			mocking: func(m *initAppExecuteMocks) {
@@ -725,6 +865,7 @@ func TestInitAppOpts_Execute(t *testing.T) {
 				progress:        mocks.NewMockprogress(ctrl),
 			}
 			tc.mocking(m)
+			fs := afero.NewMemMapFs()
 
 			opts := &initAppOpts{
 				initAppVars: initAppVars{
@@ -734,7 +875,13 @@ func TestInitAppOpts_Execute(t *testing.T) {
 					resourceTags: map[string]string{
 						"owner": "boss",
 					},
+					ecrKeepImages:         tc.inECRKeepImages,
+					ecrExpireUntaggedDays: tc.inECRExpireUntaggedDays,
+					cfnExecutionRole:      tc.inCFNExecutionRole,
+					envRolesTemplate:      tc.inEnvRolesTemplate,
+					ciRoles:               tc.inCIRoles,
 				},
+				fs:       fs,
 				store:    m.store,
 				identity: m.identityService,
 				cfn:      m.deployer,
@@ -753,6 +900,13 @@ func TestInitAppOpts_Execute(t *testing.T) {
 			} else {
 				require.True(t, errors.Is(err, tc.expectedError))
 			}
+			if tc.inEnvRolesTemplate != "" {
+				content, err := afero.ReadFile(fs, tc.inEnvRolesTemplate)
+				require.NoError(t, err)
+				for _, wanted := range tc.wantedEnvRolesTemplate {
+					require.Contains(t, string(content), wanted)
+				}
+			}
 		})
 	}
 }
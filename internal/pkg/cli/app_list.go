@@ -4,9 +4,11 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
@@ -19,6 +21,7 @@ import (
 )
 
 type listAppOpts struct {
+	outputVars
 	store applicationLister
 	w     io.Writer
 }
@@ -29,25 +32,55 @@ func (o *listAppOpts) Execute() error {
 	if err != nil {
 		return fmt.Errorf("list applications: %w", err)
 	}
+	return writeOutput(o.w, o.format(), appList(apps))
+}
+
+// appList is the list of applications written by "app ls".
+type appList []*config.Application
 
-	for _, app := range apps {
-		fmt.Fprintln(o.w, app.Name)
+// HumanString returns the names of the applications, one per line.
+func (l appList) HumanString() string {
+	b := &strings.Builder{}
+	for _, app := range l {
+		fmt.Fprintln(b, app.Name)
 	}
+	return b.String()
+}
 
-	return nil
+// JSONString returns the applications marshaled in JSON.
+func (l appList) JSONString() (string, error) {
+	type serializedApps struct {
+		Applications []*config.Application `json:"applications"`
+	}
+	apps := []*config.Application(l)
+	if apps == nil {
+		apps = []*config.Application{}
+	}
+	b, err := json.Marshal(serializedApps{Applications: apps})
+	if err != nil {
+		return "", fmt.Errorf("marshal applications: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
 }
 
 // buildAppListCommand builds the command to list existing applications.
 func buildAppListCommand() *cobra.Command {
+	vars := outputVars{}
 	cmd := &cobra.Command{
 		Use:   "ls",
 		Short: "Lists all the applications in your account.",
 		Example: `
   List all the applications in your account and region.
-  /code $ copilot app ls`,
+  /code $ copilot app ls
+  List the applications with their configuration in YAML.
+  /code $ copilot app ls --output yaml`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			if err := vars.validateOutput(); err != nil {
+				return err
+			}
 			opts := listAppOpts{
-				w: os.Stdout,
+				outputVars: vars,
+				w:          os.Stdout,
 			}
 			sess, err := sessions.ImmutableProvider(sessions.UserAgentExtras("app ls")).Default()
 			if err != nil {
@@ -57,5 +90,6 @@ func buildAppListCommand() *cobra.Command {
 			return opts.Execute()
 		}),
 	}
+	addOutputFlags(cmd.Flags(), &vars)
 	return cmd
 }
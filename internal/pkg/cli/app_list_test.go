@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strings"
 	"testing"
 
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
@@ -68,3 +69,59 @@ func TestListAppOpts_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestListAppOpts_Execute_Output(t *testing.T) {
+	apps := []*config.Application{
+		{Name: "app1", AccountID: "123456789012", Version: "v1.0.0"},
+		{Name: "app2", AccountID: "123456789012", Domain: "example.com", Version: "v1.0.0"},
+	}
+	testCases := map[string]struct {
+		inFormat string
+
+		wanted string
+	}{
+		"table": {
+			wanted: "app1\napp2\n",
+		},
+		"json": {
+			inFormat: outputFormatJSON,
+			wanted:   `{"schemaVersion":1,"applications":[{"name":"app1","account":"123456789012","domain":"","domainHostedZoneID":"","version":"v1.0.0"},{"name":"app2","account":"123456789012","domain":"example.com","domainHostedZoneID":"","version":"v1.0.0"}]}` + "\n",
+		},
+		"yaml": {
+			inFormat: outputFormatYAML,
+			wanted: `schemaVersion: 1
+applications:
+  - name: app1
+    account: "123456789012"
+    domain: ""
+    domainHostedZoneID: ""
+    version: v1.0.0
+  - name: app2
+    account: "123456789012"
+    domain: example.com
+    domainHostedZoneID: ""
+    version: v1.0.0
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockstore := mocks.NewMockstore(ctrl)
+			mockstore.EXPECT().ListApplications().Return(apps, nil)
+			b := &strings.Builder{}
+			opts := listAppOpts{
+				outputVars: outputVars{outputFormat: tc.inFormat},
+				store:      mockstore,
+				w:          b,
+			}
+
+			err := opts.Execute()
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, b.String())
+		})
+	}
+}
@@ -37,8 +37,8 @@ const (
 )
 
 type showAppVars struct {
-	name             string
-	shouldOutputJSON bool
+	outputVars
+	name string
 }
 
 type showAppOpts struct {
@@ -84,6 +84,9 @@ func newShowAppOpts(vars showAppVars) (*showAppOpts, error) {
 
 // Validate returns an error if the values provided by the user are invalid.
 func (o *showAppOpts) Validate() error {
+	if err := o.validateOutput(); err != nil {
+		return err
+	}
 	if o.name != "" {
 		_, err := o.store.GetApplication(o.name)
 		if err != nil {
@@ -109,16 +112,7 @@ func (o *showAppOpts) Execute() error {
 	if err != nil {
 		return err
 	}
-	if !o.shouldOutputJSON {
-		fmt.Fprint(o.w, description.HumanString())
-		return nil
-	}
-	data, err := description.JSONString()
-	if err != nil {
-		return fmt.Errorf("get JSON string: %w", err)
-	}
-	fmt.Fprint(o.w, data)
-	return nil
+	return writeOutput(o.w, o.format(), description)
 }
 func (o *showAppOpts) populateDeployedWorkloads(listWorkloads func(app, env string) ([]string, error), deployedEnvsFor map[string][]string, env string, lock sync.Locker) error {
 	deployedworkload, err := listWorkloads(o.name, env)
@@ -260,7 +254,7 @@ func buildAppShowCmd() *cobra.Command {
 		}),
 	}
 	// The flags bound by viper are available to all sub-commands through viper.GetString({flagName})
-	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	addOutputFlags(cmd.Flags(), &vars.outputVars)
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, tryReadingAppName(), appFlagDescription)
 	return cmd
 }
@@ -38,6 +38,11 @@ const (
 // appUpgradeVars holds flag values.
 type appUpgradeVars struct {
 	name string
+
+	ecrKeepImages         int
+	ecrExpireUntaggedDays int
+
+	versionPinVars
 }
 
 // appUpgradeOpts represents the app upgrade command and holds the necessary data
@@ -88,7 +93,10 @@ func (o *appUpgradeOpts) Validate() error {
 			return fmt.Errorf("get application %s: %w", o.name, err)
 		}
 	}
-	return nil
+	if err := validateImageLifecycle(o.ecrKeepImages, o.ecrExpireUntaggedDays); err != nil {
+		return err
+	}
+	return o.versionPinVars.validate()
 }
 
 // Ask asks for fields that are required but not passed in.
@@ -99,6 +107,11 @@ func (o *appUpgradeOpts) Ask() error {
 	return nil
 }
 
+// ValidateVersionPin returns an error if the application doesn't allow this version of Copilot to upgrade it.
+func (o *appUpgradeOpts) ValidateVersionPin() error {
+	return validateVersionPin(o.store, o.name, o.templateVersion, "")
+}
+
 // Execute updates the cloudformation stack as well as the stackset of an application to the latest version.
 // If any stack is busy updating, it spins and waits until the stack can be updated.
 func (o *appUpgradeOpts) Execute() error {
@@ -111,7 +124,13 @@ func (o *appUpgradeOpts) Execute() error {
 	if err != nil {
 		return fmt.Errorf("get template version of application %s: %v", o.name, err)
 	}
-	if !o.shouldUpgradeApp(appVersion) {
+	if o.shouldUpdateImageLifecycle() && semver.Compare(appVersion, o.templateVersion) > 0 {
+		return fmt.Errorf("cannot update the image lifecycle of application %s on version %s with version %s of the template: upgrade Copilot and try again", o.name, appVersion, o.templateVersion)
+	}
+	if !o.shouldUpgradeApp(appVersion) && !o.shouldUpdateImageLifecycle() {
+		if o.versionPinVars.isSet() {
+			return o.updateVersionPin()
+		}
 		return nil
 	}
 	app, err := o.store.GetApplication(o.name)
@@ -164,6 +183,12 @@ Are you using the latest version of AWS Copilot?`, o.name, o.templateVersion, ap
 	return false
 }
 
+// shouldUpdateImageLifecycle returns true if the user wants to set a new ECR lifecycle policy
+// on the application's repositories, in which case the application is redeployed even if it's on the latest version.
+func (o *appUpgradeOpts) shouldUpdateImageLifecycle() bool {
+	return o.ecrKeepImages != 0 || o.ecrExpireUntaggedDays != 0
+}
+
 func (o *appUpgradeOpts) upgradeApplication(app *config.Application, fromVersion, toVersion string) error {
 	caller, err := o.identity.Get()
 	if err != nil {
@@ -175,11 +200,14 @@ func (o *appUpgradeOpts) upgradeApplication(app *config.Application, fromVersion
 	}
 	// Upgrade app CloudFormation resources.
 	if err := o.upgrader.UpgradeApplication(&deploy.CreateAppInput{
-		Name:               o.name,
-		AccountID:          caller.Account,
-		DomainName:         app.Domain,
-		DomainHostedZoneID: app.DomainHostedZoneID,
-		Version:            toVersion,
+		Name:                o.name,
+		AccountID:           caller.Account,
+		DomainName:          app.Domain,
+		DomainHostedZoneID:  app.DomainHostedZoneID,
+		ImageLifecycle:      app.ImageLifecycle,
+		CIRoleARNs:          app.CIRoleARNs,
+		Version:             toVersion,
+		CFNExecutionRoleARN: app.CFNExecutionRoleARN,
 	}); err != nil {
 		return fmt.Errorf("upgrade application %s from version %s to version %s: %v", app.Name, fromVersion, toVersion, err)
 	}
@@ -194,9 +222,39 @@ func (o *appUpgradeOpts) upgradeAppSSMStore(app *config.Application) error {
 		}
 		app.DomainHostedZoneID = hostedZoneID
 	}
+	if o.shouldUpdateImageLifecycle() {
+		lifecycle := &config.ImageLifecycle{}
+		if app.ImageLifecycle != nil {
+			lifecycle = app.ImageLifecycle
+		}
+		if o.ecrKeepImages != 0 {
+			lifecycle.KeepLastImages = o.ecrKeepImages
+		}
+		if o.ecrExpireUntaggedDays != 0 {
+			lifecycle.ExpireUntaggedAfterDays = o.ecrExpireUntaggedDays
+		}
+		app.ImageLifecycle = lifecycle
+	}
+	if o.versionPinVars.isSet() {
+		app.VersionPin = o.versionPinVars.applyTo(app.VersionPin)
+	}
+	if err := o.store.UpdateApplication(app); err != nil {
+		return fmt.Errorf("update application %s: %w", app.Name, err)
+	}
+	return nil
+}
+
+// updateVersionPin stores the new version pin of the application without redeploying its resources.
+func (o *appUpgradeOpts) updateVersionPin() error {
+	app, err := o.store.GetApplication(o.name)
+	if err != nil {
+		return fmt.Errorf("get application %s: %w", o.name, err)
+	}
+	app.VersionPin = o.versionPinVars.applyTo(app.VersionPin)
 	if err := o.store.UpdateApplication(app); err != nil {
 		return fmt.Errorf("update application %s: %w", app.Name, err)
 	}
+	log.Successf("Updated the version pin of application %s.\n", color.HighlightUserInput(o.name))
 	return nil
 }
 
@@ -208,7 +266,11 @@ func buildAppUpgradeCmd() *cobra.Command {
 		Short: "Upgrades the template of an application to the latest version.",
 		Example: `
     Upgrade the application "my-app" to the latest version
-    /code $ copilot app upgrade -n my-app`,
+    /code $ copilot app upgrade -n my-app
+    Apply an ECR lifecycle policy to the existing repositories of the application "my-app"
+    /code $ copilot app upgrade -n my-app --ecr-keep-images 20 --ecr-expire-untagged-days 7
+    Only allow the Copilot CLI v1.32.0 through v1.33.0 to update the application "my-app"
+    /code $ copilot app upgrade -n my-app --min-cli-version v1.32.0 --max-cli-version v1.33.0`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newAppUpgradeOpts(vars)
 			if err != nil {
@@ -218,5 +280,12 @@ func buildAppUpgradeCmd() *cobra.Command {
 		}),
 	}
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().IntVar(&vars.ecrKeepImages, ecrKeepImagesFlag, 0, ecrKeepImagesFlagDescription)
+	cmd.Flags().IntVar(&vars.ecrExpireUntaggedDays, ecrExpireUntaggedDaysFlag, 0, ecrExpireUntaggedDaysFlagDescription)
+	cmd.Flags().StringVar(&vars.minCLIVersion, minCLIVersionFlag, "", minCLIVersionFlagDescription)
+	cmd.Flags().StringVar(&vars.maxCLIVersion, maxCLIVersionFlag, "", maxCLIVersionFlagDescription)
+	cmd.Flags().StringVar(&vars.minEnvTemplateVersion, minEnvTemplateVersionFlag, "", minEnvTemplateVersionFlagDescription)
+	cmd.Flags().StringVar(&vars.maxEnvTemplateVersion, maxEnvTemplateVersionFlag, "", maxEnvTemplateVersionFlagDescription)
+	markAudited(cmd)
 	return cmd
 }
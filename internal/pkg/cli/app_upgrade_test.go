@@ -33,8 +33,11 @@ type appUpgradeMocks struct {
 func TestAppUpgradeOpts_Validate(t *testing.T) {
 	testError := errors.New("some error")
 	testCases := map[string]struct {
-		inAppName  string
-		setupMocks func(mocks appUpgradeMocks)
+		inAppName       string
+		inECRKeepImages int
+		inMinCLIVersion string
+		inMaxCLIVersion string
+		setupMocks      func(mocks appUpgradeMocks)
 
 		wantedError error
 	}{
@@ -57,6 +60,31 @@ func TestAppUpgradeOpts_Validate(t *testing.T) {
 
 			wantedError: fmt.Errorf("get application %s: %w", "my-app", testError),
 		},
+		"invalid number of images to keep": {
+			inAppName:       "my-app",
+			inECRKeepImages: -1,
+
+			setupMocks: func(m appUpgradeMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name: "my-app",
+				}, nil)
+			},
+
+			wantedError: errors.New("--ecr-keep-images must be a non-negative integer"),
+		},
+		"invalid version pin": {
+			inAppName:       "my-app",
+			inMinCLIVersion: "v1.33.0",
+			inMaxCLIVersion: "v1.32.0",
+
+			setupMocks: func(m appUpgradeMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name: "my-app",
+				}, nil)
+			},
+
+			wantedError: errors.New("--min-cli-version and --max-cli-version: minimum version v1.33.0 is greater than maximum version v1.32.0"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -73,7 +101,12 @@ func TestAppUpgradeOpts_Validate(t *testing.T) {
 
 			opts := &appUpgradeOpts{
 				appUpgradeVars: appUpgradeVars{
-					name: tc.inAppName,
+					name:          tc.inAppName,
+					ecrKeepImages: tc.inECRKeepImages,
+					versionPinVars: versionPinVars{
+						minCLIVersion: tc.inMinCLIVersion,
+						maxCLIVersion: tc.inMaxCLIVersion,
+					},
 				},
 				store: mockStoreReader,
 			}
@@ -332,6 +365,109 @@ func TestAppUpgradeOpts_Execute(t *testing.T) {
 				}
 			},
 		},
+		"should update the image lifecycle even if app is up-to-date": {
+			given: func(ctrl *gomock.Controller) *appUpgradeOpts {
+				mockIdentity := mocks.NewMockidentityService(ctrl)
+				mockIdentity.EXPECT().Get().Return(identity.Caller{Account: "1234"}, nil)
+
+				mockStore := mocks.NewMockstore(ctrl)
+				mockStore.EXPECT().GetApplication("phonetool").Return(&config.Application{
+					Name: "phonetool",
+					ImageLifecycle: &config.ImageLifecycle{
+						KeepLastImages:          10,
+						ExpireUntaggedAfterDays: 14,
+					},
+				}, nil)
+				mockStore.EXPECT().UpdateApplication(&config.Application{
+					Name: "phonetool",
+					ImageLifecycle: &config.ImageLifecycle{
+						KeepLastImages:          20,
+						ExpireUntaggedAfterDays: 14,
+					},
+				}).Return(nil)
+
+				mockUpgrader := mocks.NewMockappUpgrader(ctrl)
+				mockUpgrader.EXPECT().UpgradeApplication(&deploy.CreateAppInput{
+					Name:      "phonetool",
+					AccountID: "1234",
+					ImageLifecycle: &config.ImageLifecycle{
+						KeepLastImages:          20,
+						ExpireUntaggedAfterDays: 14,
+					},
+					Version: mockTemplateVersion,
+				}).Return(nil)
+
+				return &appUpgradeOpts{
+					appUpgradeVars: appUpgradeVars{
+						name:          "phonetool",
+						ecrKeepImages: 20,
+					},
+					newVersionGetter: func(string) (versionGetter, error) {
+						return &versionGetterDouble{
+							VersionFn: func() (string, error) {
+								return mockTemplateVersion, nil
+							},
+						}, nil
+					},
+					identity: mockIdentity,
+					store:    mockStore,
+					upgrader: mockUpgrader,
+				}
+			},
+		},
+		"should return an error if the image lifecycle is updated on an app with a newer version": {
+			given: func(ctrl *gomock.Controller) *appUpgradeOpts {
+				return &appUpgradeOpts{
+					appUpgradeVars: appUpgradeVars{
+						name:          "phonetool",
+						ecrKeepImages: 20,
+					},
+					newVersionGetter: func(string) (versionGetter, error) {
+						return &versionGetterDouble{
+							VersionFn: func() (string, error) {
+								return "v1.30.0", nil
+							},
+						}, nil
+					},
+				}
+			},
+			wantedErr: errors.New("cannot update the image lifecycle of application phonetool on version v1.30.0 with version v1.29.0 of the template: upgrade Copilot and try again"),
+		},
+		"should only update the version pin if app is up-to-date": {
+			given: func(ctrl *gomock.Controller) *appUpgradeOpts {
+				mockStore := mocks.NewMockstore(ctrl)
+				mockStore.EXPECT().GetApplication("phonetool").Return(&config.Application{
+					Name: "phonetool",
+					VersionPin: &config.VersionPin{
+						MinCLIVersion: "v1.28.0",
+					},
+				}, nil)
+				mockStore.EXPECT().UpdateApplication(&config.Application{
+					Name: "phonetool",
+					VersionPin: &config.VersionPin{
+						MinCLIVersion:         "v1.28.0",
+						MaxEnvTemplateVersion: "v1.29.0",
+					},
+				}).Return(nil)
+
+				return &appUpgradeOpts{
+					appUpgradeVars: appUpgradeVars{
+						name: "phonetool",
+						versionPinVars: versionPinVars{
+							maxEnvTemplateVersion: "1.29.0",
+						},
+					},
+					newVersionGetter: func(string) (versionGetter, error) {
+						return &versionGetterDouble{
+							VersionFn: func() (string, error) {
+								return mockTemplateVersion, nil
+							},
+						}, nil
+					},
+					store: mockStore,
+				}
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -0,0 +1,160 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/audit"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/version"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	// auditLogEnvVar is the environment variable that enables the audit log and sets where the entries are stored.
+	auditLogEnvVar = "COPILOT_AUDIT_LOG"
+
+	auditLogWorkspaceDestination        = "workspace"
+	auditLogCloudWatchDestinationPrefix = "cloudwatch:"
+
+	redactedFlagValue = "REDACTED"
+
+	// auditAnnotation marks the commands that create, update or delete resources, which are recorded in the audit log.
+	auditAnnotation = "audit"
+)
+
+// redactedFlags are the flags whose values are sensitive and never written to the audit log.
+var redactedFlags = map[string]bool{
+	accessKeyIDFlag:       true,
+	secretAccessKeyFlag:   true,
+	sessionTokenFlag:      true,
+	githubAccessTokenFlag: true,
+	valuesFlag:            true,
+	defaultValueFlag:      true,
+	envVarsFlag:           true,
+}
+
+// newAuditLog returns the audit log at the destination set with the COPILOT_AUDIT_LOG environment variable.
+func newAuditLog(dest string, fs afero.Fs, sess *session.Session) (auditLog, error) {
+	if dest == auditLogWorkspaceDestination {
+		ws, err := workspace.Use(fs)
+		if err != nil {
+			return nil, fmt.Errorf("find the workspace of the audit log: %w", err)
+		}
+		return audit.NewFileLog(fs, filepath.Join(ws.CopilotDirAbs, audit.FileName)), nil
+	}
+	if logGroup, ok := strings.CutPrefix(dest, auditLogCloudWatchDestinationPrefix); ok && logGroup != "" {
+		return audit.NewCloudWatchLog(cloudwatchlogs.New(sess), logGroup), nil
+	}
+	return nil, &errInvalidAuditLogDestination{dest: dest}
+}
+
+// RecordAudit records the command in the audit log if the COPILOT_AUDIT_LOG environment variable is set and the command
+// creates, updates or deletes resources. Failing to record the command doesn't fail the command.
+func RecordAudit(cmd *cobra.Command, start time.Time, cmdErr error) {
+	dest := os.Getenv(auditLogEnvVar)
+	if dest == "" || cmd == nil || !isAudited(cmd) {
+		return
+	}
+	if err := recordAudit(cmd, dest, start, cmdErr); err != nil {
+		log.Warningf("Failed to record the command in the audit log: %v\n", err)
+	}
+}
+
+// markAudited marks the command as one that creates, updates or deletes resources, so that it's recorded in the audit log.
+func markAudited(cmd *cobra.Command) {
+	if cmd.Annotations == nil {
+		cmd.Annotations = make(map[string]string)
+	}
+	cmd.Annotations[auditAnnotation] = "true"
+}
+
+func isAudited(cmd *cobra.Command) bool {
+	return cmd.Annotations[auditAnnotation] == "true"
+}
+
+func recordAudit(cmd *cobra.Command, dest string, start time.Time, cmdErr error) error {
+	sess, err := sessions.ImmutableProvider(sessions.UserAgentExtras("audit")).Default()
+	if err != nil {
+		return fmt.Errorf("default session: %v", err)
+	}
+	w, err := newAuditLog(dest, afero.NewOsFs(), sess)
+	if err != nil {
+		return err
+	}
+	entry := newAuditEntry(cmd, start, time.Now(), cmdErr)
+	// The entry is still recorded if the caller cannot be identified, for example when the credentials expired.
+	if caller, err := identity.New(sess).Get(); err == nil {
+		entry.Caller = caller.RootUserARN
+	}
+	return w.Write(entry)
+}
+
+// newAuditEntry returns the entry of the audit log for the command that ran between start and end.
+func newAuditEntry(cmd *cobra.Command, start, end time.Time, cmdErr error) audit.Entry {
+	entry := audit.Entry{
+		Time:     start.UTC(),
+		Command:  cmd.CommandPath(),
+		Version:  version.Version,
+		Duration: end.Sub(start).Round(time.Millisecond).String(),
+		Result:   audit.ResultSuccess,
+		Stacks:   audit.RecordedStacks(),
+	}
+	if args := cmd.Flags().Args(); len(args) != 0 {
+		entry.Args = args
+	}
+	cmd.Flags().Visit(func(f *pflag.Flag) {
+		if entry.Flags == nil {
+			entry.Flags = make(map[string]string)
+		}
+		entry.Flags[f.Name] = f.Value.String()
+		if redactedFlags[f.Name] {
+			entry.Flags[f.Name] = redactedFlagValue
+		}
+	})
+	if f := cmd.Flags().Lookup(appFlag); f != nil {
+		entry.App = f.Value.String()
+	}
+	if cmdErr != nil {
+		entry.Result = audit.ResultFailure
+		entry.Error = cmdErr.Error()
+	}
+	return entry
+}
+
+// BuildAuditCmd is the top level command for the audit log.
+func BuildAuditCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "audit",
+		Short: `Commands for the audit log.
+The audit log records the commands that create, update or delete resources.`,
+		Long: fmt.Sprintf(`Commands for the audit log.
+The audit log records the commands that create, update or delete resources.
+Enable it by setting the %s environment variable to "%s" to store
+the entries in the copilot directory of your workspace, or to "%s<log group>"
+to ship them to an Amazon CloudWatch Logs log group.`, auditLogEnvVar, auditLogWorkspaceDestination, auditLogCloudWatchDestinationPrefix),
+	}
+
+	cmd.AddCommand(buildAuditListCmd())
+
+	cmd.SetUsageTemplate(template.Usage)
+	cmd.Annotations = map[string]string{
+		"group": group.Settings,
+	}
+	return cmd
+}
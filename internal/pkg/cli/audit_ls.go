@@ -0,0 +1,165 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/audit"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const (
+	defaultAuditLogLimit = 20
+
+	// Display settings of the audit log table.
+	auditTableMinCellWidth = 12
+	auditTableTabWidth     = 4
+	auditTableCellPadding  = 2
+)
+
+type auditListVars struct {
+	limit            int
+	shouldOutputJSON bool
+}
+
+type auditListOpts struct {
+	auditListVars
+
+	dest    string // Value of the COPILOT_AUDIT_LOG environment variable.
+	w       io.Writer
+	log     auditLogReader
+	initLog func() error
+}
+
+func newAuditListOpts(vars auditListVars) *auditListOpts {
+	opts := &auditListOpts{
+		auditListVars: vars,
+		dest:          os.Getenv(auditLogEnvVar),
+		w:             log.OutputWriter,
+	}
+	opts.initLog = func() error {
+		sess, err := sessions.ImmutableProvider(sessions.UserAgentExtras("audit ls")).Default()
+		if err != nil {
+			return fmt.Errorf("default session: %v", err)
+		}
+		l, err := newAuditLog(opts.dest, afero.NewOsFs(), sess)
+		if err != nil {
+			return err
+		}
+		opts.log = l
+		return nil
+	}
+	return opts
+}
+
+// Validate returns an error if the audit log is not enabled or if the flag values are invalid.
+func (o *auditListOpts) Validate() error {
+	if o.dest == "" {
+		return fmt.Errorf("the audit log is not enabled: set the %s environment variable", auditLogEnvVar)
+	}
+	if o.limit < 0 {
+		return errors.New("--limit cannot be negative")
+	}
+	return nil
+}
+
+// Ask is a no-op for this command.
+func (o *auditListOpts) Ask() error {
+	return nil
+}
+
+// Execute writes the latest entries of the audit log.
+func (o *auditListOpts) Execute() error {
+	if err := o.initLog(); err != nil {
+		return err
+	}
+	entries, err := o.log.Entries(o.limit)
+	if err != nil {
+		return fmt.Errorf("list audit log entries: %w", err)
+	}
+	if o.shouldOutputJSON {
+		data, err := json.Marshal(struct {
+			Entries []audit.Entry `json:"entries"`
+		}{
+			Entries: entries,
+		})
+		if err != nil {
+			return fmt.Errorf("marshal audit log entries: %w", err)
+		}
+		fmt.Fprintln(o.w, string(data))
+		return nil
+	}
+	if len(entries) == 0 {
+		log.Infoln("No commands are recorded in the audit log yet.")
+		return nil
+	}
+	writer := tabwriter.NewWriter(o.w, auditTableMinCellWidth, auditTableTabWidth, auditTableCellPadding, ' ', 0)
+	headers := []string{"Time", "Command", "Caller", "Result", "Stacks"}
+	fmt.Fprintf(writer, "%s\n", strings.Join(headers, "\t"))
+	underlines := make([]string, len(headers))
+	for i, header := range headers {
+		underlines[i] = strings.Repeat("-", len(header))
+	}
+	fmt.Fprintf(writer, "%s\n", strings.Join(underlines, "\t"))
+	for _, entry := range entries {
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\n", entry.Time.Format(time.RFC3339), auditCommandLine(entry),
+			valueOrDash(entry.Caller), entry.Result, auditStacksSummary(entry.Stacks))
+	}
+	return writer.Flush()
+}
+
+func auditCommandLine(entry audit.Entry) string {
+	return strings.Join(append([]string{entry.Command}, entry.Args...), " ")
+}
+
+func auditStacksSummary(stacks []audit.StackSummary) string {
+	if len(stacks) == 0 {
+		return "-"
+	}
+	summaries := make([]string, len(stacks))
+	for i, stack := range stacks {
+		summaries[i] = fmt.Sprintf("%s (%s %s)", stack.Name, stack.Operation, stack.Status)
+	}
+	return strings.Join(summaries, ", ")
+}
+
+func valueOrDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+// buildAuditListCmd builds the command to list the latest entries of the audit log.
+func buildAuditListCmd() *cobra.Command {
+	vars := auditListVars{}
+	cmd := &cobra.Command{
+		Use:   "ls",
+		Short: "Lists the latest commands recorded in the audit log.",
+		Long: `Lists the latest commands recorded in the audit log, with the caller that ran them,
+their result and the CloudFormation stacks that they deployed or deleted.`,
+		Example: `
+  Lists the last 20 commands recorded in the audit log.
+  /code $ COPILOT_AUDIT_LOG=workspace copilot audit ls
+  Lists the last 100 commands shipped to the "copilot-audit" log group in JSON format.
+  /code $ COPILOT_AUDIT_LOG=cloudwatch:copilot-audit copilot audit ls --limit 100 --json`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			return run(newAuditListOpts(vars))
+		}),
+	}
+	cmd.Flags().IntVar(&vars.limit, limitFlag, defaultAuditLogLimit, auditLimitFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
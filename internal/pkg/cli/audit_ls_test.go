@@ -0,0 +1,149 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/audit"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditListOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inDest  string
+		inLimit int
+
+		wantedErr error
+	}{
+		"error if the audit log is not enabled": {
+			wantedErr: errors.New("the audit log is not enabled: set the COPILOT_AUDIT_LOG environment variable"),
+		},
+		"error if the limit is negative": {
+			inDest:    "workspace",
+			inLimit:   -1,
+			wantedErr: errors.New("--limit cannot be negative"),
+		},
+		"valid": {
+			inDest:  "workspace",
+			inLimit: 20,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			opts := &auditListOpts{
+				auditListVars: auditListVars{
+					limit: tc.inLimit,
+				},
+				dest: tc.inDest,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestAuditListOpts_Execute(t *testing.T) {
+	entries := []audit.Entry{
+		{
+			Time:     time.Date(2017, 5, 11, 12, 29, 10, 0, time.UTC),
+			Command:  "copilot svc deploy",
+			Caller:   "arn:aws:iam::123456789012:user/jane",
+			Version:  "v1.0.0",
+			Duration: "1m30s",
+			Result:   audit.ResultSuccess,
+			Stacks: []audit.StackSummary{
+				{
+					Name:      "phonetool-test-api",
+					Operation: audit.StackOperationDeploy,
+					Status:    audit.StackStatusSucceeded,
+				},
+			},
+		},
+		{
+			Time:     time.Date(2017, 5, 11, 12, 35, 0, 0, time.UTC),
+			Command:  "copilot app init",
+			Args:     []string{"phonetool"},
+			Version:  "v1.0.0",
+			Duration: "2s",
+			Result:   audit.ResultFailure,
+			Error:    "some error",
+		},
+	}
+	testCases := map[string]struct {
+		inJSON     bool
+		setupMocks func(m *mocks.MockauditLogReader)
+
+		wantedOutput string
+		wantedErr    error
+	}{
+		"error if the entries cannot be listed": {
+			setupMocks: func(m *mocks.MockauditLogReader) {
+				m.EXPECT().Entries(10).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list audit log entries: some error"),
+		},
+		"writes the entries in a table": {
+			setupMocks: func(m *mocks.MockauditLogReader) {
+				m.EXPECT().Entries(10).Return(entries, nil)
+			},
+			wantedOutput: `Time                  Command                     Caller                               Result      Stacks
+----                  -------                     ------                               ------      ------
+2017-05-11T12:29:10Z  copilot svc deploy          arn:aws:iam::123456789012:user/jane  success     phonetool-test-api (deploy succeeded)
+2017-05-11T12:35:00Z  copilot app init phonetool  -                                    failure     -
+`,
+		},
+		"writes the entries in JSON": {
+			inJSON: true,
+			setupMocks: func(m *mocks.MockauditLogReader) {
+				m.EXPECT().Entries(10).Return(entries[1:], nil)
+			},
+			wantedOutput: `{"entries":[{"time":"2017-05-11T12:35:00Z","command":"copilot app init","args":["phonetool"],"version":"v1.0.0","duration":"2s","result":"failure","error":"some error"}]}` + "\n",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockauditLogReader(ctrl)
+			tc.setupMocks(m)
+			b := &bytes.Buffer{}
+			opts := &auditListOpts{
+				auditListVars: auditListVars{
+					limit:            10,
+					shouldOutputJSON: tc.inJSON,
+				},
+				w:       b,
+				initLog: func() error { return nil },
+				log:     m,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOutput, b.String())
+		})
+	}
+}
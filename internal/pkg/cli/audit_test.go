@@ -0,0 +1,320 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/audit"
+	"github.com/aws/copilot-cli/internal/pkg/version"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAuditEntry(t *testing.T) {
+	start := time.Date(2017, 5, 11, 12, 29, 10, 0, time.UTC)
+	testCases := map[string]struct {
+		inArgs   []string
+		inErr    error
+		inStacks []audit.StackSummary
+
+		wanted audit.Entry
+	}{
+		"records the changed flags and redacts the sensitive ones": {
+			inArgs: []string{"--app", "phonetool", "--name", "api", "--env-vars", "PASSWORD=hunter2"},
+			inStacks: []audit.StackSummary{
+				{
+					Name:      "phonetool-test-api",
+					Operation: audit.StackOperationDeploy,
+					Status:    audit.StackStatusSucceeded,
+				},
+			},
+			wanted: audit.Entry{
+				Time:    start,
+				Command: "copilot svc deploy",
+				Flags: map[string]string{
+					"app":      "phonetool",
+					"name":     "api",
+					"env-vars": "REDACTED",
+				},
+				App:      "phonetool",
+				Version:  version.Version,
+				Duration: "1m30s",
+				Result:   audit.ResultSuccess,
+				Stacks: []audit.StackSummary{
+					{
+						Name:      "phonetool-test-api",
+						Operation: audit.StackOperationDeploy,
+						Status:    audit.StackStatusSucceeded,
+					},
+				},
+			},
+		},
+		"records the positional arguments and the error of a failed command": {
+			inArgs: []string{"extra"},
+			inErr:  errors.New("some error"),
+			wanted: audit.Entry{
+				Time:     start,
+				Command:  "copilot svc deploy",
+				Args:     []string{"extra"},
+				App:      "default",
+				Version:  version.Version,
+				Duration: "1m30s",
+				Result:   audit.ResultFailure,
+				Error:    "some error",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			audit.ResetRecordedStacks()
+			defer audit.ResetRecordedStacks()
+			for _, stack := range tc.inStacks {
+				audit.RecordStack(stack)
+			}
+			root := &cobra.Command{Use: "copilot"}
+			svc := &cobra.Command{Use: "svc"}
+			deploy := &cobra.Command{Use: "deploy"}
+			deploy.Flags().String(appFlag, "default", "")
+			deploy.Flags().String(nameFlag, "", "")
+			deploy.Flags().String(envVarsFlag, "", "")
+			root.AddCommand(svc)
+			svc.AddCommand(deploy)
+			require.NoError(t, deploy.ParseFlags(tc.inArgs))
+
+			// WHEN
+			entry := newAuditEntry(deploy, start, start.Add(90*time.Second), tc.inErr)
+
+			// THEN
+			require.Equal(t, tc.wanted, entry)
+		})
+	}
+}
+
+func TestNewAuditLog(t *testing.T) {
+	testCases := map[string]struct {
+		inDest string
+
+		wantedErr error
+	}{
+		"error if the destination is unknown": {
+			inDest:    "s3",
+			wantedErr: errors.New(`invalid value "s3" for the COPILOT_AUDIT_LOG environment variable`),
+		},
+		"error if the log group is missing": {
+			inDest:    "cloudwatch:",
+			wantedErr: errors.New(`invalid value "cloudwatch:" for the COPILOT_AUDIT_LOG environment variable`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			_, err := newAuditLog(tc.inDest, afero.NewMemMapFs(), nil)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+// stackMutatingCalls are the methods that deploy or delete the stacks of an application.
+var stackMutatingCalls = map[string]bool{
+	"DeployApp":                  true,
+	"DeployService":              true,
+	"DeployWorkload":             true,
+	"DeployEnvironment":          true,
+	"DeployTask":                 true,
+	"CreateAndRenderEnvironment": true,
+	"UpdateAndRenderEnvironment": true,
+	"UpgradeApplication":         true,
+	"UpdateApplication":          true,
+	"CreatePipeline":             true,
+	"UpdatePipeline":             true,
+	"DeleteApp":                  true,
+	"DeleteApplication":          true,
+	"DeleteEnvironment":          true,
+	"DeleteService":              true,
+	"DeleteJob":                  true,
+	"DeleteWorkload":             true,
+	"DeleteTask":                 true,
+	"DeletePipeline":             true,
+}
+
+func TestCommands_Audited(t *testing.T) {
+	// GIVEN
+	src := parseCommandBuilders(t)
+	root := &cobra.Command{Use: "copilot"}
+	// The same top level commands as cmd/copilot.
+	root.AddCommand(BuildInitCmd(), BuildDocsCmd(), BuildAppCmd(), BuildEnvCmd(), BuildSvcCmd(), BuildJobCmd(),
+		BuildPreviewCmd(), BuildTaskCmd(), BuildECSCmd(), BuildRunLocalCmd(), BuildImageCmd(), BuildStorageCmd(),
+		BuildSecretCmd(), BuildPluginCmd(), BuildVersionCmd(), BuildAuditCmd(), BuildIAMCmd(), BuildCompletionCmd(root),
+		BuildPipelineCmd(), BuildDeployCmd(), BuildValidateCmd(), BuildReleaseCmd())
+	topLevel := []string{"BuildInitCmd", "BuildDocsCmd", "BuildAppCmd", "BuildEnvCmd", "BuildSvcCmd", "BuildJobCmd",
+		"BuildPreviewCmd", "BuildTaskCmd", "BuildECSCmd", "BuildRunLocalCmd", "BuildImageCmd", "BuildStorageCmd",
+		"BuildSecretCmd", "BuildPluginCmd", "BuildVersionCmd", "BuildAuditCmd", "BuildIAMCmd", "BuildCompletionCmd",
+		"BuildPipelineCmd", "BuildDeployCmd", "BuildValidateCmd", "BuildReleaseCmd"}
+
+	// WHEN
+	var walk func(cmd *cobra.Command, builders []string)
+	walk = func(cmd *cobra.Command, builders []string) {
+		builder, ok := src.builderOf(cmd.Name(), builders)
+		if !ok {
+			t.Errorf("cannot find the function that builds %q", cmd.CommandPath())
+			return
+		}
+		// THEN
+		if src.isMutating(builder) && !isAudited(cmd) {
+			t.Errorf("%q validates the version pin of the application or changes stacks, but isn't marked with markAudited", cmd.CommandPath())
+		}
+		for _, child := range cmd.Commands() {
+			walk(child, src.children[builder])
+		}
+	}
+	for _, cmd := range root.Commands() {
+		walk(cmd, topLevel)
+	}
+}
+
+// commandBuilders is the result of parsing the functions of this package that build cobra commands.
+type commandBuilders struct {
+	names    map[string]string   // Name of the command built by each function.
+	children map[string][]string // Functions that build the subcommands of each function's command.
+	opts     map[string][]string // Options structs that each function's command creates.
+
+	versionPinned map[string]bool // Options structs with a ValidateVersionPin method.
+	mutating      map[string]bool // Options structs that deploy or delete stacks.
+}
+
+func parseCommandBuilders(t *testing.T) *commandBuilders {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", func(fi fs.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	require.NoError(t, err)
+
+	src := &commandBuilders{
+		names:         make(map[string]string),
+		children:      make(map[string][]string),
+		opts:          make(map[string][]string),
+		versionPinned: make(map[string]bool),
+		mutating:      make(map[string]bool),
+	}
+	constructors := make(map[string]string) // Options struct returned by each constructor.
+	var builders []*ast.FuncDecl
+	for _, file := range pkgs["cli"].Files {
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok {
+				continue
+			}
+			if fn.Recv != nil {
+				recv := typeName(fn.Recv.List[0].Type)
+				if fn.Name.Name == "ValidateVersionPin" {
+					src.versionPinned[recv] = true
+				}
+				ast.Inspect(fn.Body, func(n ast.Node) bool {
+					if call, ok := n.(*ast.CallExpr); ok {
+						if sel, ok := call.Fun.(*ast.SelectorExpr); ok && stackMutatingCalls[sel.Sel.Name] {
+							src.mutating[recv] = true
+						}
+					}
+					return true
+				})
+				continue
+			}
+			if fn.Type.Results == nil {
+				continue
+			}
+			result := typeName(fn.Type.Results.List[0].Type)
+			if strings.HasSuffix(result, "Opts") {
+				constructors[fn.Name.Name] = result
+			}
+			if result == "cobra.Command" && isBuilderName(fn.Name.Name) {
+				builders = append(builders, fn)
+			}
+		}
+	}
+	for _, fn := range builders {
+		builder := fn.Name.Name
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.KeyValueExpr:
+				if key, ok := n.Key.(*ast.Ident); ok && key.Name == "Use" && src.names[builder] == "" {
+					if lit, ok := n.Value.(*ast.BasicLit); ok {
+						use, _ := strconv.Unquote(lit.Value)
+						src.names[builder] = strings.Fields(use)[0]
+					}
+				}
+			case *ast.CallExpr:
+				ident, ok := n.Fun.(*ast.Ident)
+				if !ok {
+					return true
+				}
+				if opts, ok := constructors[ident.Name]; ok {
+					src.opts[builder] = append(src.opts[builder], opts)
+				}
+				if isBuilderName(ident.Name) {
+					src.children[builder] = append(src.children[builder], ident.Name)
+				}
+			}
+			return true
+		})
+	}
+	return src
+}
+
+// isBuilderName returns true if the function is named like the functions that build commands,
+// for example "buildSvcDeployCmd" or "buildAppInitCommand".
+func isBuilderName(name string) bool {
+	name = strings.ToLower(name)
+	return strings.HasPrefix(name, "build") && (strings.HasSuffix(name, "cmd") || strings.HasSuffix(name, "command"))
+}
+
+// builderOf returns the function among builders that builds the command with the given name.
+func (src *commandBuilders) builderOf(name string, builders []string) (string, bool) {
+	for _, builder := range builders {
+		if src.names[builder] == name {
+			return builder, true
+		}
+	}
+	return "", false
+}
+
+// isMutating returns true if the command built by the function validates the version pin of the application,
+// or deploys or deletes stacks.
+func (src *commandBuilders) isMutating(builder string) bool {
+	for _, opts := range src.opts[builder] {
+		if src.versionPinned[opts] || src.mutating[opts] {
+			return true
+		}
+	}
+	return false
+}
+
+// typeName returns the name of a type expression without the pointer, for example "cobra.Command" for "*cobra.Command".
+func typeName(expr ast.Expr) string {
+	switch expr := expr.(type) {
+	case *ast.StarExpr:
+		return typeName(expr.X)
+	case *ast.SelectorExpr:
+		return typeName(expr.X) + "." + expr.Sel.Name
+	case *ast.Ident:
+		return expr.Name
+	}
+	return ""
+}
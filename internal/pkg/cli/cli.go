@@ -95,6 +95,9 @@ func run(cmd cmd) error {
 	if err := cmd.Ask(); err != nil {
 		return err
 	}
+	if err := validateCmdVersionPin(cmd); err != nil {
+		return err
+	}
 	if err := cmd.Execute(); err != nil {
 		return err
 	}
@@ -106,6 +109,16 @@ func run(cmd cmd) error {
 	return nil
 }
 
+// validateCmdVersionPin returns an error if the command updates the resources of an application
+// that doesn't allow this version of Copilot.
+func validateCmdVersionPin(cmd cmd) error {
+	pinnedCmd, ok := cmd.(versionPinnedCommand)
+	if !ok {
+		return nil
+	}
+	return pinnedCmd.ValidateVersionPin()
+}
+
 func logRecommendedActions(actions []string) {
 	if len(actions) == 0 {
 		return
@@ -19,10 +19,12 @@ import (
 	"github.com/dustin/go-humanize/english"
 	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
 
 	"github.com/aws/copilot-cli/cmd/copilot/template"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	clideploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/cli/group"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
@@ -76,6 +78,7 @@ type deployVars struct {
 	workloadNames      []string
 	deployAllWorkloads bool
 	yesInitWkld        bool
+	maxParallel        int
 
 	deployEnv  *bool
 	yesInitEnv *bool
@@ -112,6 +115,7 @@ type deployOpts struct {
 	storeWorkloads         []*config.Workload
 	initializedWsWorkloads []string
 	deploymentOrderMap     map[string]int
+	parallelWorkloads      map[string]bool // Workloads that deploy at the same time as other workloads.
 }
 
 func newDeployOpts(vars deployVars) (*deployOpts, error) {
@@ -126,6 +130,7 @@ func newDeployOpts(vars deployVars) (*deployOpts, error) {
 		return nil, err
 	}
 	prompter := prompt.New()
+	builtImages := clideploy.NewBuiltImages()
 	return &deployOpts{
 		deployVars: vars,
 		store:      store,
@@ -150,6 +155,7 @@ func newDeployOpts(vars deployVars) (*deployOpts, error) {
 				disableRollback:   o.disableRollback,
 				showDiff:          o.showDiff,
 				skipDiffPrompt:    o.skipDiffPrompt,
+				diffFormat:        o.diffFormat,
 				allowEnvDowngrade: o.allowWkldDowngrade,
 				detach:            o.detach,
 			})
@@ -183,6 +189,8 @@ func newDeployOpts(vars deployVars) (*deployOpts, error) {
 					cmd:             exec.NewCmd(),
 					templateVersion: version.LatestTemplateVersion(),
 					sessProvider:    sessProvider,
+					builtImages:     builtImages,
+					inParallel:      o.parallelWorkloads[workloadName],
 				}
 				opts.newJobDeployer = func() (workloadDeployer, error) {
 					return newJobDeployer(opts)
@@ -203,6 +211,8 @@ func newDeployOpts(vars deployVars) (*deployOpts, error) {
 					cmd:             exec.NewCmd(),
 					sessProvider:    sessProvider,
 					templateVersion: version.LatestTemplateVersion(),
+					builtImages:     builtImages,
+					inParallel:      o.parallelWorkloads[workloadName],
 				}
 				opts.newSvcDeployer = func() (workloadDeployer, error) {
 					return newSvcDeployer(opts)
@@ -311,7 +321,9 @@ func (o *deployOpts) parseDeploymentOrderTags(namesWithOptionalOrder []string) e
 //
 //	[][]string{ {"be"}, {"fe"}, {"worker", "job", "db"} }.
 //
-// TODO: when there's a dependsOn field in the manifest, we should modify this function to respect it.
+// If the manifest of "worker" declares "db" under "deploy.depends_on", it returns instead
+//
+//	[][]string{ {"be"}, {"fe"}, {"job", "db"}, {"worker"} }.
 func (o *deployOpts) getDeploymentOrder() ([][]string, error) {
 
 	// Get a map from workload name to deployment priority
@@ -375,6 +387,74 @@ func (o *deployOpts) getDeploymentOrder() ([][]string, error) {
 		}
 		res = append(res, v.workloads)
 	}
+	if len(res) == 1 && len(res[0]) == 1 {
+		return res, nil
+	}
+	return o.orderByDependencies(res)
+}
+
+// orderByDependencies splits each group of workloads with the same priority so that a workload is deployed
+// after the workloads declared under "deploy.depends_on" in its manifest.
+// Dependencies that aren't part of the deployment are assumed to be deployed already.
+func (o *deployOpts) orderByDependencies(groups [][]string) ([][]string, error) {
+	groupOf := make(map[string]int)
+	for i, group := range groups {
+		slices.Sort(group)
+		for _, name := range group {
+			groupOf[name] = i
+		}
+	}
+	dependencies := make(map[string][]string)
+	for i, group := range groups {
+		for _, name := range group {
+			mft, err := o.ws.ReadWorkloadManifest(name)
+			if err != nil {
+				return nil, fmt.Errorf("read manifest for workload %s: %w", name, err)
+			}
+			dependsOn, err := mft.DeployDependencies()
+			if err != nil {
+				return nil, fmt.Errorf("get dependencies of workload %s: %w", name, err)
+			}
+			for _, dep := range dependsOn {
+				if dep == name {
+					return nil, fmt.Errorf("workload %s cannot depend on itself", name)
+				}
+				j, ok := groupOf[dep]
+				if !ok {
+					continue
+				}
+				if j > i {
+					return nil, fmt.Errorf("workload %s depends on %s, which is ordered to deploy after it", name, dep)
+				}
+				if j == i {
+					dependencies[name] = append(dependencies[name], dep)
+				}
+			}
+		}
+	}
+	res := make([][]string, 0, len(groups))
+	for _, group := range groups {
+		remaining := slices.Clone(group)
+		deployed := make(map[string]bool)
+		for len(remaining) > 0 {
+			var ready, blocked []string
+			for _, name := range remaining {
+				if slices.ContainsFunc(dependencies[name], func(dep string) bool { return !deployed[dep] }) {
+					blocked = append(blocked, name)
+					continue
+				}
+				ready = append(ready, name)
+			}
+			if len(ready) == 0 {
+				return nil, fmt.Errorf("deploy dependencies of workloads %s form a cycle", english.WordSeries(blocked, "and"))
+			}
+			for _, name := range ready {
+				deployed[name] = true
+			}
+			res = append(res, ready)
+			remaining = blocked
+		}
+	}
 	return res, nil
 }
 
@@ -433,7 +513,16 @@ func getTotalNumberOfWorkloads(deploymentGroups [][]workloadCommand) int {
 	return count
 }
 
+// ValidateVersionPin returns an error if the application doesn't allow this version of Copilot to deploy its workloads.
+func (o *deployOpts) ValidateVersionPin() error {
+	return validateVersionPin(o.store, o.appName, version.Version, "")
+}
+
 func (o *deployOpts) Run() error {
+	if o.maxParallel < 0 {
+		return fmt.Errorf("--%s must not be negative", maxParallelFlag)
+	}
+
 	if err := o.askNames(); err != nil {
 		return err
 	}
@@ -458,6 +547,14 @@ func (o *deployOpts) Run() error {
 	if err != nil {
 		return err
 	}
+	if o.maxParallel > 1 {
+		o.parallelWorkloads = make(map[string]bool)
+		for _, deploymentGroup := range deploymentOrderGroups {
+			for _, workload := range deploymentGroup {
+				o.parallelWorkloads[workload] = len(deploymentGroup) > 1
+			}
+		}
+	}
 
 	cmds := make([][]workloadCommand, len(deploymentOrderGroups))
 	// Do all our asking before executing deploy commands.
@@ -495,10 +592,12 @@ func (o *deployOpts) Run() error {
 	}
 
 	for g, deploymentGroup := range cmds {
-		// TODO parallelize this. Steps involve:
-		// 1. Modify the cmd to optionally disable progress tracker
-		// 2. Modify labeledSyncBuffer so it can display a spinner.
-		// 3. Wrap Execute() in a goroutine with ErrorGroup and context
+		if o.maxParallel > 1 && len(deploymentGroup) > 1 {
+			if err := o.executeInParallel(deploymentGroup); err != nil {
+				return fmt.Errorf("execute deployments in group %d: %w", g+1, err)
+			}
+			continue
+		}
 		for i, cmd := range deploymentGroup {
 			if err := cmd.Execute(); err != nil {
 				var errNoInfraChanges *errNoInfrastructureChanges
@@ -519,6 +618,46 @@ func (o *deployOpts) Run() error {
 	return nil
 }
 
+// executeInParallel deploys the workloads of a group at the same time, at most maxParallel at once.
+// Once every deployment completes, it recommends actions for the workloads that deployed successfully.
+func (o *deployOpts) executeInParallel(deploymentGroup []workloadCommand) error {
+	names := make([]string, len(deploymentGroup))
+	for i, cmd := range deploymentGroup {
+		names[i] = cmd.name
+	}
+	log.Infof("Deploying %s in parallel.\n", english.WordSeries(names, "and"))
+	var g errgroup.Group
+	g.SetLimit(o.maxParallel)
+	changed := make([]bool, len(deploymentGroup))
+	for i, cmd := range deploymentGroup {
+		// create a copy of loop variables to avoid data race.
+		i, cmd := i, cmd
+		g.Go(func() error {
+			if err := cmd.Execute(); err != nil {
+				var errNoInfraChanges *errNoInfrastructureChanges
+				if errors.As(err, &errNoInfraChanges) {
+					return nil
+				}
+				log.Errorf("Failed to deploy %s.\n", cmd.name)
+				return fmt.Errorf("execute deployment of %s: %w", cmd.name, err)
+			}
+			log.Successf("Deployed %s.\n", cmd.name)
+			changed[i] = true
+			return nil
+		})
+	}
+	err := g.Wait()
+	for i, cmd := range deploymentGroup {
+		if !changed[i] {
+			continue
+		}
+		if err := cmd.RecommendActions(); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
 func logDeploymentOrderInfo(cmds [][]workloadCommand, totalCount int) {
 	log.Infof("Will deploy %d %s in the following order.\n", totalCount, english.PluralWord(totalCount, "workload", ""))
 	for i := 0; i < len(cmds); i++ {
@@ -693,6 +832,9 @@ func (o *deployOpts) maybeInitEnv() error {
 		if err = cmd.Ask(); err != nil {
 			return err
 		}
+		if err = validateCmdVersionPin(cmd); err != nil {
+			return err
+		}
 		if err = cmd.Execute(); err != nil {
 			return err
 		}
@@ -725,6 +867,9 @@ func (o *deployOpts) maybeDeployEnv() error {
 		if err = cmd.Ask(); err != nil {
 			return err
 		}
+		if err = validateCmdVersionPin(cmd); err != nil {
+			return err
+		}
 		return cmd.Execute()
 	}
 	return nil
@@ -770,6 +915,9 @@ func BuildDeployCmd() *cobra.Command {
   /code $ copilot deploy --all --env prod --name backend
   Deploys multiple workloads in a prescribed order (fe and worker, then be).
   /code $ copilot deploy -n fe/1 -n be/2 -n worker/1
+  Deploys all local, initialized workloads after the workloads in their "deploy.depends_on",
+    with up to 3 workloads that don't depend on each other deployed at the same time.
+  /code $ copilot deploy --all -e prod --max-parallel 3
   Initializes and deploys all local workloads after deploying environment changes.
   /code $ copilot deploy --all --init-wkld --deploy-env -e prod`,
 
@@ -793,6 +941,9 @@ func BuildDeployCmd() *cobra.Command {
 				}
 			}
 
+			if err := opts.ValidateVersionPin(); err != nil {
+				return err
+			}
 			if err := opts.Run(); err != nil {
 				return err
 			}
@@ -813,6 +964,7 @@ func BuildDeployCmd() *cobra.Command {
 	cmd.Flags().BoolVar(&initEnvironment, yesInitEnvFlag, false, yesInitEnvFlagDescription)
 	cmd.Flags().BoolVar(&vars.yesInitWkld, yesInitWorkloadFlag, false, yesInitWorkloadFlagDescription)
 	cmd.Flags().BoolVar(&vars.deployAllWorkloads, allFlag, false, allWorkloadsFlagDescription)
+	cmd.Flags().IntVar(&vars.maxParallel, maxParallelFlag, 1, maxParallelFlagDescription)
 
 	cmd.Flags().StringVar(&vars.profile, profileFlag, "", profileFlagDescription)
 	cmd.Flags().StringVar(&vars.tempCreds.AccessKeyID, accessKeyIDFlag, "", accessKeyIDFlagDescription)
@@ -824,5 +976,6 @@ func BuildDeployCmd() *cobra.Command {
 	cmd.Annotations = map[string]string{
 		"group": group.Release,
 	}
+	markAudited(cmd)
 	return cmd
 }
@@ -6,6 +6,7 @@ package deploy
 import (
 	"fmt"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/copilot-cli/internal/pkg/aws/acm"
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
@@ -61,7 +62,7 @@ func (backendSvcDeployer) IsServiceAvailableInRegion(region string) (bool, error
 
 // UploadArtifacts uploads the deployment artifacts such as the container image, custom resources, addons and env files.
 func (d *backendSvcDeployer) UploadArtifacts() (*UploadArtifactsOutput, error) {
-	return d.uploadArtifacts(d.buildAndPushContainerImages, d.uploadArtifactsToS3, d.uploadCustomResources)
+	return d.uploadArtifacts(d.updateImageLifecycle, d.buildAndPushContainerImages, d.uploadArtifactsToS3, d.uploadCustomResources)
 }
 
 // GenerateCloudFormationTemplate generates a CloudFormation template and parameters for a workload.
@@ -80,6 +81,13 @@ func (d *backendSvcDeployer) DeployWorkload(in *DeployWorkloadInput) (ActionReco
 	if err != nil {
 		return nil, err
 	}
+	if err := d.validateServiceConnectAlias(d.backendMft.Network.Connect); err != nil {
+		return nil, err
+	}
+	stackConfigOutput.validator, err = d.newDeploymentValidator(d.backendMft.DeployConfig.Validation, "")
+	if err != nil {
+		return nil, err
+	}
 	if err := d.deploy(in.Options, *stackConfigOutput); err != nil {
 		return nil, err
 	}
@@ -127,6 +135,9 @@ func (d *backendSvcDeployer) validateALBRuntime() error {
 	if d.backendMft.HTTP.IsEmpty() {
 		return nil
 	}
+	if aws.BoolValue(d.backendMft.HTTP.MutualTLS) && d.envConfig.HTTPConfig.Private.MutualTLS.IsEmpty() {
+		return fmt.Errorf(`"http.mutual_tls" requires "http.private.mutual_tls" to be configured in the manifest of environment %s`, d.env.Name)
+	}
 	if err := d.validateRuntimeRoutingRule(d.backendMft.HTTP.Main); err != nil {
 		return fmt.Errorf(`validate ALB runtime configuration for "http": %w`, err)
 	}
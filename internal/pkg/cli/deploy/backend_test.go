@@ -254,6 +254,29 @@ func TestBackendSvcDeployer_stackConfiguration(t *testing.T) {
 			},
 			expectedErr: `validate ALB runtime configuration for "http.additional_rules[0]": cannot deploy service mock-svc without "alias" to environment mock-env with certificate imported`,
 		},
+		"failure if mutual tls is required but not configured on the internal load balancer": {
+			App: &config.Application{
+				Name: mockAppName,
+			},
+			Env: &config.Environment{
+				Name: mockEnvName,
+			},
+			Manifest: &manifest.BackendService{
+				BackendServiceConfig: manifest.BackendServiceConfig{
+					HTTP: manifest.HTTP{
+						MutualTLS: aws.Bool(true),
+						Main: manifest.RoutingRule{
+							Path: aws.String("/"),
+						},
+					},
+				},
+			},
+			setupMocks: func(m *deployMocks) {
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return(mockAppName+".local", nil)
+				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
+			},
+			expectedErr: `"http.mutual_tls" requires "http.private.mutual_tls" to be configured in the manifest of environment mock-env`,
+		},
 		"success if env has imported certs but alb not configured": {
 			App: &config.Application{
 				Name: mockAppName,
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"slices"
 	"sort"
 	"strings"
 	"sync"
@@ -35,6 +36,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/template/artifactpath"
 	"github.com/aws/copilot-cli/internal/pkg/template/diff"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/spf13/afero"
@@ -68,6 +70,7 @@ type prefixListGetter interface {
 type envDescriber interface {
 	ValidateCFServiceDomainAliases() error
 	Params() (map[string]string, error)
+	Manifest() ([]byte, error)
 }
 
 type lbDescriber interface {
@@ -180,7 +183,123 @@ func NewEnvDeployer(in *NewEnvDeployerInput) (*envDeployer, error) {
 
 // Validate returns an error if the environment manifest is incompatible with services and application configurations.
 func (d *envDeployer) Validate(mft *manifest.Environment) error {
-	return d.validateCDN(mft)
+	if err := d.validateCDN(mft); err != nil {
+		return err
+	}
+	if err := d.validateRemoteTaskRunner(mft); err != nil {
+		return err
+	}
+	return d.validateMutualTLS(mft)
+}
+
+// NetworkMigrationPlan lists the changes to an environment's network that delete or replace
+// existing networking resources, and the steps to migrate workloads safely.
+type NetworkMigrationPlan struct {
+	Changes []string
+	Steps   []string
+}
+
+// IsEmpty returns true if the deployment does not introduce destructive network changes.
+func (p *NetworkMigrationPlan) IsEmpty() bool {
+	return p == nil || len(p.Changes) == 0
+}
+
+// String returns the human-readable migration plan.
+func (p *NetworkMigrationPlan) String() string {
+	if p.IsEmpty() {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString("The following network changes will delete or replace resources used by running workloads:\n")
+	for _, change := range p.Changes {
+		b.WriteString(fmt.Sprintf("  - %s\n", change))
+	}
+	b.WriteString("To migrate your workloads:\n")
+	for i, step := range p.Steps {
+		b.WriteString(fmt.Sprintf("  %d. %s\n", i+1, step))
+	}
+	return b.String()
+}
+
+// NetworkMigrationPlan compares the network configuration of mft against the deployed environment manifest.
+// It returns a non-empty plan if the deployment would delete or replace the VPC, subnets, internet gateway, or NAT gateways.
+func (d *envDeployer) NetworkMigrationPlan(mft *manifest.Environment) (*NetworkMigrationPlan, error) {
+	raw, err := d.envDescriber.Manifest()
+	if err != nil {
+		var errNotFound *awscloudformation.ErrStackNotFound
+		if errors.As(err, &errNotFound) {
+			return &NetworkMigrationPlan{}, nil
+		}
+		return nil, fmt.Errorf("retrieve the deployed manifest for environment %q: %w", d.env.Name, err)
+	}
+	deployed, err := manifest.UnmarshalEnvironment(raw)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal the deployed manifest for environment %q: %w", d.env.Name, err)
+	}
+	changes := destructiveNetworkChanges(deployed, mft)
+	if len(changes) == 0 {
+		return &NetworkMigrationPlan{}, nil
+	}
+	return &NetworkMigrationPlan{
+		Changes: changes,
+		Steps: []string{
+			fmt.Sprintf("Delete the workloads deployed in environment %q with %s and %s.", d.env.Name,
+				color.HighlightCode(fmt.Sprintf("copilot svc delete --env %s", d.env.Name)), color.HighlightCode(fmt.Sprintf("copilot job delete --env %s", d.env.Name))),
+			fmt.Sprintf("Deploy the environment with %s to apply the network changes.", color.HighlightCode(fmt.Sprintf("copilot env deploy --name %s", d.env.Name))),
+			fmt.Sprintf("Redeploy the workloads with %s and %s.",
+				color.HighlightCode(fmt.Sprintf("copilot svc deploy --env %s", d.env.Name)), color.HighlightCode(fmt.Sprintf("copilot job deploy --env %s", d.env.Name))),
+		},
+	}, nil
+}
+
+// destructiveNetworkChanges returns descriptions of the VPC changes between the deployed and the new manifest
+// that would cause CloudFormation to delete or replace networking resources.
+func destructiveNetworkChanges(deployed, mft *manifest.Environment) []string {
+	prevVPC, currVPC := deployed.Network.VPC, mft.Network.VPC
+	prevImported, currImported := prevVPC.ImportedVPC(), currVPC.ImportedVPC()
+	switch {
+	case prevImported == nil && currImported != nil:
+		return []string{
+			fmt.Sprintf("The environment switches from a Copilot-managed VPC to the imported VPC %s. The managed VPC, subnets, internet gateway, and NAT gateways will be deleted.", currImported.ID),
+		}
+	case prevImported != nil && currImported == nil:
+		return []string{
+			fmt.Sprintf("The environment switches from the imported VPC %s to a Copilot-managed VPC. The security groups and load balancers of the environment will be replaced.", prevImported.ID),
+		}
+	case prevImported != nil && currImported != nil:
+		if prevImported.ID != currImported.ID {
+			return []string{
+				fmt.Sprintf("The imported VPC changes from %s to %s. The security groups and load balancers of the environment will be replaced.", prevImported.ID, currImported.ID),
+			}
+		}
+		return nil
+	}
+	prevManaged, currManaged := managedVPCOrDefault(prevVPC.ManagedVPC()), managedVPCOrDefault(currVPC.ManagedVPC())
+	var changes []string
+	if prevManaged.CIDR != currManaged.CIDR {
+		changes = append(changes, fmt.Sprintf("The VPC CIDR changes from %s to %s. The managed VPC, subnets, internet gateway, and NAT gateways will be replaced.", prevManaged.CIDR, currManaged.CIDR))
+	}
+	if !slices.Equal(prevManaged.PublicSubnetCIDRs, currManaged.PublicSubnetCIDRs) {
+		changes = append(changes, fmt.Sprintf("The public subnet CIDRs change from %v to %v. The public subnets and NAT gateways will be replaced.", prevManaged.PublicSubnetCIDRs, currManaged.PublicSubnetCIDRs))
+	}
+	if !slices.Equal(prevManaged.PrivateSubnetCIDRs, currManaged.PrivateSubnetCIDRs) {
+		changes = append(changes, fmt.Sprintf("The private subnet CIDRs change from %v to %v. The private subnets will be replaced.", prevManaged.PrivateSubnetCIDRs, currManaged.PrivateSubnetCIDRs))
+	}
+	if len(prevManaged.AZs) != 0 && len(currManaged.AZs) != 0 && !slices.Equal(prevManaged.AZs, currManaged.AZs) {
+		changes = append(changes, fmt.Sprintf("The availability zones change from %v to %v. The subnets and NAT gateways will be replaced.", prevManaged.AZs, currManaged.AZs))
+	}
+	return changes
+}
+
+func managedVPCOrDefault(vpc *template.ManagedVPC) *template.ManagedVPC {
+	if vpc != nil {
+		return vpc
+	}
+	return &template.ManagedVPC{
+		CIDR:               cfnstack.DefaultVPCCIDR,
+		PublicSubnetCIDRs:  cfnstack.DefaultPublicSubnetCIDRs,
+		PrivateSubnetCIDRs: cfnstack.DefaultPrivateSubnetCIDRs,
+	}
 }
 
 // UploadEnvArtifactsOutput holds URLs of artifacts pushed to S3 buckets.
@@ -213,7 +332,7 @@ func (d *envDeployer) UploadArtifacts() (*UploadEnvArtifactsOutput, error) {
 }
 
 // DeployDiff returns the stringified diff of the template against the deployed template of the environment.
-func (d *envDeployer) DeployDiff(template string) (string, error) {
+func (d *envDeployer) DeployDiff(template string, opts ...diff.WriteOption) (string, error) {
 	tmpl, err := d.tmplGetter.Template(cfnstack.NameForEnv(d.app.Name, d.env.Name))
 	if err != nil {
 		var errNotFound *awscloudformation.ErrStackNotFound
@@ -227,7 +346,7 @@ func (d *envDeployer) DeployDiff(template string) (string, error) {
 		return "", fmt.Errorf("parse the diff against the deployed env stack %q: %w", d.env.Name, err)
 	}
 	buf := strings.Builder{}
-	if err := diffTree.Write(&buf); err != nil {
+	if err := diffTree.Write(&buf, opts...); err != nil {
 		return "", err
 	}
 	return buf.String(), nil
@@ -262,6 +381,7 @@ type DeployEnvironmentInput struct {
 	DisableRollback     bool
 	Version             string
 	Detach              bool
+	TaskRunnerBinaryURL string // URL of the Copilot binary run by the task runner of the environment.
 }
 
 // GenerateCloudFormationTemplate returns the environment stack's template and parameter configuration.
@@ -417,6 +537,7 @@ func (d *envDeployer) buildStackInput(in *DeployEnvironmentInput) (*cfnstack.Env
 		RawMft:               in.RawManifest,
 		PermissionsBoundary:  in.PermissionsBoundary,
 		Version:              in.Version,
+		TaskRunnerBinaryURL:  in.TaskRunnerBinaryURL,
 	}, nil
 }
 
@@ -500,6 +621,27 @@ func (d *envDeployer) validateCDN(mft *manifest.Environment) error {
 	return nil
 }
 
+// validateRemoteTaskRunner returns an error if the task runner is enabled in an application without a permissions boundary.
+// The task runner can only create IAM roles with the permissions boundary of the application.
+func (d *envDeployer) validateRemoteTaskRunner(mft *manifest.Environment) error {
+	if !mft.RemoteTaskRunnerEnabled() || d.app.PermissionsBoundary != "" {
+		return nil
+	}
+	return fmt.Errorf(`"tasks.remote_runner" requires a permissions boundary for the IAM roles of application %s: run %s to set one`,
+		d.app.Name, color.HighlightCode(fmt.Sprintf("copilot app init %s --permissions-boundary <policy>", d.app.Name)))
+}
+
+// validateMutualTLS returns an error if mutual TLS is enabled on the public load balancer but it has no HTTPS listener.
+func (d *envDeployer) validateMutualTLS(mft *manifest.Environment) error {
+	if mft.HTTPConfig.Public.MutualTLS.IsEmpty() {
+		return nil
+	}
+	if d.app.Domain == "" && !mft.HasImportedPublicALBCerts() {
+		return fmt.Errorf(`"http.public.mutual_tls" requires an HTTPS listener: associate a domain with application %s or import "http.public.certificates"`, d.app.Name)
+	}
+	return nil
+}
+
 // validateALBWorkloadsDontRedirect verifies that none of the public ALB Workloads
 // in this environment have a redirect in their HTTPWithDomain listener.
 // If any services redirect, an error is returned.
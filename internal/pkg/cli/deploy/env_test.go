@@ -806,6 +806,17 @@ func TestEnvDeployer_Validate(t *testing.T) {
 				m.envDescriber.EXPECT().ValidateCFServiceDomainAliases().Return(nil)
 			},
 		},
+		"remote task runner enabled without a permissions boundary": {
+			app: &config.Application{
+				Name: "phonetool",
+			},
+			mft: func() *manifest.Environment {
+				mft, _ := manifest.UnmarshalEnvironment([]byte("name: test\ntype: Environment\ntasks:\n  remote_runner: true\n"))
+				return mft
+			}(),
+			setUpMocks: func(m *envDeployerMocks, ctrl *gomock.Controller) {},
+			expected:   `"tasks.remote_runner" requires a permissions boundary for the IAM roles of application phonetool: run ` + "`copilot app init phonetool --permissions-boundary <policy>`" + ` to set one`,
+		},
 		"cdn tls termination enabled, fail to get env stack params": {
 			app: &config.Application{},
 			mft: mftCDNTerminateTLSAndHTTPCert,
@@ -1020,6 +1031,40 @@ If you'd like to use these services without a CDN, ensure each service's A recor
 				m.lbDescriber.EXPECT().DescribeRule(gomock.Any(), "svc1RuleARN").Return(listenerRuleNoRedirect, nil)
 			},
 		},
+		"public mutual tls enabled without an https listener": {
+			app: &config.Application{
+				Name: "phonetool",
+			},
+			mft: &manifest.Environment{
+				EnvironmentConfig: manifest.EnvironmentConfig{
+					HTTPConfig: manifest.EnvironmentHTTPConfig{
+						Public: manifest.PublicHTTPConfig{
+							MutualTLS: manifest.MutualTLSConfig{
+								CABundle: aws.String("s3://my-bucket/ca.pem"),
+							},
+						},
+					},
+				},
+			},
+			expected: `"http.public.mutual_tls" requires an HTTPS listener: associate a domain with application phonetool or import "http.public.certificates"`,
+		},
+		"public mutual tls enabled with imported certificates": {
+			app: &config.Application{
+				Name: "phonetool",
+			},
+			mft: &manifest.Environment{
+				EnvironmentConfig: manifest.EnvironmentConfig{
+					HTTPConfig: manifest.EnvironmentHTTPConfig{
+						Public: manifest.PublicHTTPConfig{
+							Certificates: []string{"mockCertARN"},
+							MutualTLS: manifest.MutualTLSConfig{
+								Mode: aws.String(manifest.MutualTLSModePassthrough),
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for name, tc := range tests {
@@ -1063,3 +1108,128 @@ If you'd like to use these services without a CDN, ensure each service's A recor
 		})
 	}
 }
+
+func TestEnvDeployer_NetworkMigrationPlan(t *testing.T) {
+	const (
+		managedDefault = `name: test
+type: Environment
+`
+		managedCustom = `name: test
+type: Environment
+network:
+  vpc:
+    cidr: 10.1.0.0/16
+    subnets:
+      public:
+        - cidr: 10.1.0.0/24
+        - cidr: 10.1.1.0/24
+      private:
+        - cidr: 10.1.2.0/24
+        - cidr: 10.1.3.0/24
+`
+		imported = `name: test
+type: Environment
+network:
+  vpc:
+    id: vpc-1234
+    subnets:
+      public:
+        - id: subnet-1
+        - id: subnet-2
+`
+	)
+	tests := map[string]struct {
+		deployed      string
+		mft           string
+		setUpMocks    func(m *envDeployerMocks)
+		wantedChanges []string
+		wantedErr     string
+	}{
+		"error if fail to retrieve the deployed manifest": {
+			mft: managedDefault,
+			setUpMocks: func(m *envDeployerMocks) {
+				m.envDescriber.EXPECT().Manifest().Return(nil, errors.New("some error"))
+			},
+			wantedErr: `retrieve the deployed manifest for environment "test": some error`,
+		},
+		"no changes if the network configuration is the same": {
+			deployed: imported,
+			mft:      imported,
+		},
+		"no changes if the default managed VPC is written out explicitly": {
+			deployed: managedDefault,
+			mft: `name: test
+type: Environment
+network:
+  vpc:
+    cidr: 10.0.0.0/16
+    subnets:
+      public:
+        - cidr: 10.0.0.0/24
+        - cidr: 10.0.1.0/24
+      private:
+        - cidr: 10.0.2.0/24
+        - cidr: 10.0.3.0/24
+`,
+		},
+		"switch from managed to imported VPC": {
+			deployed: managedDefault,
+			mft:      imported,
+			wantedChanges: []string{
+				"The environment switches from a Copilot-managed VPC to the imported VPC vpc-1234. The managed VPC, subnets, internet gateway, and NAT gateways will be deleted.",
+			},
+		},
+		"switch from imported to managed VPC": {
+			deployed: imported,
+			mft:      managedCustom,
+			wantedChanges: []string{
+				"The environment switches from the imported VPC vpc-1234 to a Copilot-managed VPC. The security groups and load balancers of the environment will be replaced.",
+			},
+		},
+		"change the CIDRs of a managed VPC": {
+			deployed: managedDefault,
+			mft:      managedCustom,
+			wantedChanges: []string{
+				"The VPC CIDR changes from 10.0.0.0/16 to 10.1.0.0/16. The managed VPC, subnets, internet gateway, and NAT gateways will be replaced.",
+				"The public subnet CIDRs change from [10.0.0.0/24 10.0.1.0/24] to [10.1.0.0/24 10.1.1.0/24]. The public subnets and NAT gateways will be replaced.",
+				"The private subnet CIDRs change from [10.0.2.0/24 10.0.3.0/24] to [10.1.2.0/24 10.1.3.0/24]. The private subnets will be replaced.",
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &envDeployerMocks{
+				envDescriber: mocks.NewMockenvDescriber(ctrl),
+			}
+			if tc.setUpMocks != nil {
+				tc.setUpMocks(m)
+			} else {
+				m.envDescriber.EXPECT().Manifest().Return([]byte(tc.deployed), nil)
+			}
+			mft, err := manifest.UnmarshalEnvironment([]byte(tc.mft))
+			require.NoError(t, err)
+			d := &envDeployer{
+				app: &config.Application{
+					Name: "app",
+				},
+				env: &config.Environment{
+					Name: "test",
+				},
+				envDescriber: m.envDescriber,
+			}
+
+			plan, err := d.NetworkMigrationPlan(mft)
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedChanges, plan.Changes)
+			require.Equal(t, len(tc.wantedChanges) == 0, plan.IsEmpty())
+		})
+	}
+}
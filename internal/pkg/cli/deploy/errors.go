@@ -21,6 +21,23 @@ func (e *errSvcWithNoALBAliasDeployingToEnvWithImportedCerts) Error() string {
 	return fmt.Sprintf(`cannot deploy service %s without "alias" to environment %s with certificate imported`, e.name, e.envName)
 }
 
+type errServiceConnectAliasTaken struct {
+	alias     string
+	namespace string
+	family    string
+}
+
+func (e *errServiceConnectAliasTaken) Error() string {
+	return fmt.Sprintf("Service Connect alias %q is already used in namespace %s by tasks of family %s", e.alias, e.namespace, e.family)
+}
+
+// RecommendActions returns recommended actions to be taken after the error.
+// Implements main.actionRecommender interface.
+func (e *errServiceConnectAliasTaken) RecommendActions() string {
+	return fmt.Sprintf(`Services of every application that joins the namespace must use a unique alias.
+Update %s in the manifest of the service and redeploy it.`, color.HighlightCode(`"network.connect.alias"`))
+}
+
 type errSvcWithALBAliasHostedZoneWithCDNEnabled struct {
 	envName string
 }
@@ -84,3 +101,19 @@ If you'd like to use %s without a CDN, ensure %s A record is pointed to the ALB.
 		english.PluralWord(len(e.services), "its", "each service's"),
 	)
 }
+
+type errDeploymentValidationFailed struct {
+	svc    string
+	reason string
+}
+
+func (e *errDeploymentValidationFailed) Error() string {
+	return fmt.Sprintf("validation of the deployment of service %s failed: %s", e.svc, e.reason)
+}
+
+// RecommendActions returns recommended actions to be taken after the error.
+// Implements main.actionRecommender interface.
+func (e *errDeploymentValidationFailed) RecommendActions() string {
+	return fmt.Sprintf("Copilot set the deployment validation alarm so that ECS rolls back service %s to its previous deployment.\nRun %s to follow the rollback.",
+		e.svc, color.HighlightCode(fmt.Sprintf("copilot svc status --name %s", e.svc)))
+}
@@ -43,6 +43,17 @@ var (
 		color.HighlightCode("copilot app init --domain example.com"))
 )
 
+// Outputs of the environment stack used to reach the service.
+const (
+	envOutputPublicLoadBalancerDNSName = "PublicLoadBalancerDNSName"
+	envOutputPublicALBAccessible       = "PublicALBAccessible"
+	envOutputCloudFrontDomainName      = "CloudFrontDomainName"
+)
+
+type envOutputsGetter interface {
+	Outputs() (map[string]string, error)
+}
+
 type elbGetter interface {
 	LoadBalancer(nameOrARN string) (*elbv2.LoadBalancer, error)
 }
@@ -106,7 +117,7 @@ func (lbWebSvcDeployer) IsServiceAvailableInRegion(region string) (bool, error)
 
 // UploadArtifacts uploads the deployment artifacts such as the container image, custom resources, addons and env files.
 func (d *lbWebSvcDeployer) UploadArtifacts() (*UploadArtifactsOutput, error) {
-	return d.uploadArtifacts(d.buildAndPushContainerImages, d.uploadArtifactsToS3, d.uploadCustomResources)
+	return d.uploadArtifacts(d.updateImageLifecycle, d.buildAndPushContainerImages, d.uploadArtifactsToS3, d.uploadCustomResources)
 }
 
 // GenerateCloudFormationTemplate generates a CloudFormation template and parameters for a workload.
@@ -125,6 +136,12 @@ func (d *lbWebSvcDeployer) DeployWorkload(in *DeployWorkloadInput) (ActionRecomm
 	if err != nil {
 		return nil, err
 	}
+	if err := d.validateServiceConnectAlias(d.lbMft.Network.Connect); err != nil {
+		return nil, err
+	}
+	if err := d.addDeploymentValidator(stackConfigOutput); err != nil {
+		return nil, err
+	}
 	if err := d.deploy(in.Options, *stackConfigOutput); err != nil {
 		return nil, err
 	}
@@ -142,6 +159,9 @@ func (d *lbWebSvcDeployer) stackConfiguration(in *StackRuntimeConfiguration) (*s
 	if err := d.validateNLBRuntime(); err != nil {
 		return nil, err
 	}
+	if err := d.validateCDNRuntime(); err != nil {
+		return nil, err
+	}
 	var opts []stack.LoadBalancedWebServiceOption
 	if d.lbMft.HTTPOrBool.ImportedALB != nil {
 		lb, err := d.elbGetter.LoadBalancer(aws.StringValue(d.lbMft.HTTPOrBool.ImportedALB))
@@ -180,6 +200,56 @@ func (d *lbWebSvcDeployer) stackConfiguration(in *StackRuntimeConfiguration) (*s
 	}, nil
 }
 
+func (d *lbWebSvcDeployer) addDeploymentValidator(out *svcStackConfigurationOutput) error {
+	cfg := d.lbMft.DeployConfig.Validation
+	var url string
+	if cfg.Path != nil {
+		var err error
+		if url, err = d.validationBaseURL(); err != nil {
+			return fmt.Errorf("get the URL to validate the deployment of service %s: %w", d.name, err)
+		}
+	}
+	validator, err := d.newDeploymentValidator(cfg, url)
+	if err != nil {
+		return err
+	}
+	out.validator = validator
+	return nil
+}
+
+// validationBaseURL returns the URL, without path, at which the CLI can reach the service.
+func (d *lbWebSvcDeployer) validationBaseURL() (string, error) {
+	aliases, err := d.lbMft.HTTPOrBool.Main.Alias.ToStringSlice()
+	if err != nil {
+		return "", err
+	}
+	if len(aliases) > 0 {
+		return "https://" + aliases[0], nil
+	}
+	if d.lbMft.HTTPOrBool.ImportedALB != nil {
+		lb, err := d.elbGetter.LoadBalancer(aws.StringValue(d.lbMft.HTTPOrBool.ImportedALB))
+		if err != nil {
+			return "", err
+		}
+		return "http://" + lb.DNSName, nil
+	}
+	if d.app.Domain != "" {
+		return fmt.Sprintf("https://%s.%s.%s.%s", d.name, d.env.Name, d.app.Name, d.app.Domain), nil
+	}
+	outputs, err := d.envOutputsGetter.Outputs()
+	if err != nil {
+		return "", fmt.Errorf("get stack outputs for environment %s: %w", d.env.Name, err)
+	}
+	// Environments deployed before the output was introduced always have an accessible load balancer.
+	if accessible, ok := outputs[envOutputPublicALBAccessible]; !ok || accessible == "true" {
+		return "http://" + outputs[envOutputPublicLoadBalancerDNSName], nil
+	}
+	if cfDNS, ok := outputs[envOutputCloudFrontDomainName]; ok {
+		return "https://" + cfDNS, nil
+	}
+	return "", fmt.Errorf("the load balancer of environment %s is not accessible", d.env.Name)
+}
+
 func (d *lbWebSvcDeployer) validateALBRuntime() error {
 	if d.lbMft.HTTPOrBool.Disabled() {
 		return nil
@@ -189,6 +259,10 @@ func (d *lbWebSvcDeployer) validateALBRuntime() error {
 		return fmt.Errorf(`validate imported ALB configuration for "http": %w`, err)
 	}
 
+	if aws.BoolValue(d.lbMft.HTTPOrBool.MutualTLS) && d.envConfig.HTTPConfig.Public.MutualTLS.IsEmpty() {
+		return fmt.Errorf(`"http.mutual_tls" requires "http.public.mutual_tls" to be configured in the manifest of environment %s`, d.env.Name)
+	}
+
 	if err := d.validateRuntimeRoutingRule(d.lbMft.HTTPOrBool.Main); err != nil {
 		return fmt.Errorf(`validate ALB runtime configuration for "http": %w`, err)
 	}
@@ -313,6 +387,37 @@ func (d *lbWebSvcDeployer) validateNLBRuntime() error {
 	return nil
 }
 
+func (d *lbWebSvcDeployer) validateCDNRuntime() error {
+	if d.lbMft.HTTPOrBool.Disabled() || !d.lbMft.HTTPOrBool.CDNEnabled() {
+		return nil
+	}
+	if d.envConfig.CDNEnabled() {
+		return fmt.Errorf(`cannot enable "http.cdn" when env %s already places a CloudFront distribution in front of its load balancer`, d.env.Name)
+	}
+	cdn := d.lbMft.HTTPOrBool.CDN.Advanced
+	if len(cdn.Aliases) == 0 {
+		return nil
+	}
+	if cdn.Certificate != nil {
+		cfCertValidator := d.newAliasCertValidator(aws.String(cloudfront.CertRegion))
+		if err := cfCertValidator.ValidateCertAliases(cdn.Aliases, []string{aws.StringValue(cdn.Certificate)}); err != nil {
+			return fmt.Errorf(`validate "http.cdn.aliases" against the certificate %s: %w`, aws.StringValue(cdn.Certificate), err)
+		}
+		return nil
+	}
+	if d.app.Domain == "" {
+		return fmt.Errorf(`cannot specify "http.cdn.aliases" when application is not associated with a domain or "http.cdn.certificate" is not set`)
+	}
+	// The CloudFront certificate is validated with the same custom resource as the one of static sites.
+	if err := validateMinAppVersion(d.app.Name, aws.StringValue(d.lbMft.Name), d.appVersionGetter, version.AppTemplateMinStaticSite); err != nil {
+		return fmt.Errorf("cdn alias not supported: %w", err)
+	}
+	if err := validateAliases(d.app, d.env.Name, cdn.Aliases...); err != nil {
+		return fmt.Errorf(`validate "http.cdn.aliases": %w`, err)
+	}
+	return nil
+}
+
 func validateLBWSAlias(alias manifest.Alias, app *config.Application, envName string) error {
 	if alias.IsEmpty() {
 		return nil
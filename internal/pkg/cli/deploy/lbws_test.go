@@ -4,9 +4,14 @@
 package deploy
 
 import (
+	"errors"
 	"testing"
 	"time"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
+	"github.com/aws/copilot-cli/internal/pkg/cli/deploy/mocks"
+	"github.com/golang/mock/gomock"
+
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
@@ -124,3 +129,113 @@ func mockLoadBalancedWebServiceDeployer(opts ...func(deployer *lbWebSvcDeployer)
 	}
 	return deployer
 }
+
+func TestLbWebSvcDeployer_validationBaseURL(t *testing.T) {
+	testCases := map[string]struct {
+		inAlias       manifest.Alias
+		inImportedALB *string
+		inAppDomain   string
+		setupMocks    func(outputs *mocks.MockenvOutputsGetter, elb *mocks.MockelbGetter)
+
+		wantedURL string
+		wantedErr error
+	}{
+		"uses the first alias": {
+			inAlias: manifest.Alias{
+				StringSliceOrString: manifest.StringSliceOrString{
+					StringSlice: []string{"v1.example.com", "v2.example.com"},
+				},
+			},
+			inAppDomain: "example.com",
+			wantedURL:   "https://v1.example.com",
+		},
+		"uses the DNS name of the imported load balancer": {
+			inImportedALB: aws.String("demo-alb"),
+			setupMocks: func(_ *mocks.MockenvOutputsGetter, elb *mocks.MockelbGetter) {
+				elb.EXPECT().LoadBalancer("demo-alb").Return(&elbv2.LoadBalancer{
+					DNSName: "demo-alb-123.us-west-2.elb.amazonaws.com",
+				}, nil)
+			},
+			wantedURL: "http://demo-alb-123.us-west-2.elb.amazonaws.com",
+		},
+		"uses the default domain of the service if the application has a domain": {
+			inAppDomain: "example.com",
+			wantedURL:   "https://api.test.phonetool.example.com",
+		},
+		"errors if the outputs of the environment cannot be read": {
+			setupMocks: func(outputs *mocks.MockenvOutputsGetter, _ *mocks.MockelbGetter) {
+				outputs.EXPECT().Outputs().Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get stack outputs for environment test: some error"),
+		},
+		"uses the DNS name of the environment load balancer": {
+			setupMocks: func(outputs *mocks.MockenvOutputsGetter, _ *mocks.MockelbGetter) {
+				outputs.EXPECT().Outputs().Return(map[string]string{
+					"PublicLoadBalancerDNSName": "phonetool-test-123.us-west-2.elb.amazonaws.com",
+				}, nil)
+			},
+			wantedURL: "http://phonetool-test-123.us-west-2.elb.amazonaws.com",
+		},
+		"uses the CloudFront distribution if the load balancer only accepts its traffic": {
+			setupMocks: func(outputs *mocks.MockenvOutputsGetter, _ *mocks.MockelbGetter) {
+				outputs.EXPECT().Outputs().Return(map[string]string{
+					"PublicLoadBalancerDNSName": "phonetool-test-123.us-west-2.elb.amazonaws.com",
+					"PublicALBAccessible":       "false",
+					"CloudFrontDomainName":      "d111111abcdef8.cloudfront.net",
+				}, nil)
+			},
+			wantedURL: "https://d111111abcdef8.cloudfront.net",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			outputs := mocks.NewMockenvOutputsGetter(ctrl)
+			elb := mocks.NewMockelbGetter(ctrl)
+			if tc.setupMocks != nil {
+				tc.setupMocks(outputs, elb)
+			}
+			deployer := lbWebSvcDeployer{
+				svcDeployer: &svcDeployer{
+					workloadDeployer: &workloadDeployer{
+						name: "api",
+						app: &config.Application{
+							Name:   "phonetool",
+							Domain: tc.inAppDomain,
+						},
+						env: &config.Environment{
+							Name: "test",
+						},
+						envOutputsGetter: outputs,
+					},
+				},
+				elbGetter: elb,
+				lbMft: &manifest.LoadBalancedWebService{
+					LoadBalancedWebServiceConfig: manifest.LoadBalancedWebServiceConfig{
+						HTTPOrBool: manifest.HTTPOrBool{
+							HTTP: manifest.HTTP{
+								Main: manifest.RoutingRule{
+									Alias: tc.inAlias,
+								},
+								ImportedALB: tc.inImportedALB,
+							},
+						},
+					},
+				},
+			}
+
+			// WHEN
+			url, err := deployer.validationBaseURL()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedURL, url)
+		})
+	}
+}
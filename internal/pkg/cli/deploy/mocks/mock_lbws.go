@@ -11,6 +11,44 @@ import (
 	gomock "github.com/golang/mock/gomock"
 )
 
+// MockenvOutputsGetter is a mock of envOutputsGetter interface.
+type MockenvOutputsGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockenvOutputsGetterMockRecorder
+}
+
+// MockenvOutputsGetterMockRecorder is the mock recorder for MockenvOutputsGetter.
+type MockenvOutputsGetterMockRecorder struct {
+	mock *MockenvOutputsGetter
+}
+
+// NewMockenvOutputsGetter creates a new mock instance.
+func NewMockenvOutputsGetter(ctrl *gomock.Controller) *MockenvOutputsGetter {
+	mock := &MockenvOutputsGetter{ctrl: ctrl}
+	mock.recorder = &MockenvOutputsGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockenvOutputsGetter) EXPECT() *MockenvOutputsGetterMockRecorder {
+	return m.recorder
+}
+
+// Outputs mocks base method.
+func (m *MockenvOutputsGetter) Outputs() (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Outputs")
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Outputs indicates an expected call of Outputs.
+func (mr *MockenvOutputsGetterMockRecorder) Outputs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Outputs", reflect.TypeOf((*MockenvOutputsGetter)(nil).Outputs))
+}
+
 // MockelbGetter is a mock of elbGetter interface.
 type MockelbGetter struct {
 	ctrl     *gomock.Controller
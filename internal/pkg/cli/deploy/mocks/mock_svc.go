@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 	time "time"
 
+	deploy "github.com/aws/copilot-cli/internal/pkg/deploy"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -176,3 +177,78 @@ func (mr *MockaliasCertValidatorMockRecorder) ValidateCertAliases(aliases, certs
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateCertAliases", reflect.TypeOf((*MockaliasCertValidator)(nil).ValidateCertAliases), aliases, certs)
 }
+
+// MockserviceConnectRegistry is a mock of serviceConnectRegistry interface.
+type MockserviceConnectRegistry struct {
+	ctrl     *gomock.Controller
+	recorder *MockserviceConnectRegistryMockRecorder
+}
+
+// MockserviceConnectRegistryMockRecorder is the mock recorder for MockserviceConnectRegistry.
+type MockserviceConnectRegistryMockRecorder struct {
+	mock *MockserviceConnectRegistry
+}
+
+// NewMockserviceConnectRegistry creates a new mock instance.
+func NewMockserviceConnectRegistry(ctrl *gomock.Controller) *MockserviceConnectRegistry {
+	mock := &MockserviceConnectRegistry{ctrl: ctrl}
+	mock.recorder = &MockserviceConnectRegistryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockserviceConnectRegistry) EXPECT() *MockserviceConnectRegistryMockRecorder {
+	return m.recorder
+}
+
+// TaskFamilies mocks base method.
+func (m *MockserviceConnectRegistry) TaskFamilies(namespaceARN, serviceName string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TaskFamilies", namespaceARN, serviceName)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TaskFamilies indicates an expected call of TaskFamilies.
+func (mr *MockserviceConnectRegistryMockRecorder) TaskFamilies(namespaceARN, serviceName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskFamilies", reflect.TypeOf((*MockserviceConnectRegistry)(nil).TaskFamilies), namespaceARN, serviceName)
+}
+
+// MockdeploymentRecorder is a mock of deploymentRecorder interface.
+type MockdeploymentRecorder struct {
+	ctrl     *gomock.Controller
+	recorder *MockdeploymentRecorderMockRecorder
+}
+
+// MockdeploymentRecorderMockRecorder is the mock recorder for MockdeploymentRecorder.
+type MockdeploymentRecorderMockRecorder struct {
+	mock *MockdeploymentRecorder
+}
+
+// NewMockdeploymentRecorder creates a new mock instance.
+func NewMockdeploymentRecorder(ctrl *gomock.Controller) *MockdeploymentRecorder {
+	mock := &MockdeploymentRecorder{ctrl: ctrl}
+	mock.recorder = &MockdeploymentRecorderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdeploymentRecorder) EXPECT() *MockdeploymentRecorderMockRecorder {
+	return m.recorder
+}
+
+// Record mocks base method.
+func (m *MockdeploymentRecorder) Record(stackName string, revision deploy.DeploymentRevision) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", stackName, revision)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockdeploymentRecorderMockRecorder) Record(stackName, revision interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockdeploymentRecorder)(nil).Record), stackName, revision)
+}
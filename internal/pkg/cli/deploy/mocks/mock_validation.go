@@ -0,0 +1,212 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/cli/deploy/validation.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	http "net/http"
+	reflect "reflect"
+
+	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockdeploymentServiceDescriber is a mock of deploymentServiceDescriber interface.
+type MockdeploymentServiceDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockdeploymentServiceDescriberMockRecorder
+}
+
+// MockdeploymentServiceDescriberMockRecorder is the mock recorder for MockdeploymentServiceDescriber.
+type MockdeploymentServiceDescriberMockRecorder struct {
+	mock *MockdeploymentServiceDescriber
+}
+
+// NewMockdeploymentServiceDescriber creates a new mock instance.
+func NewMockdeploymentServiceDescriber(ctrl *gomock.Controller) *MockdeploymentServiceDescriber {
+	mock := &MockdeploymentServiceDescriber{ctrl: ctrl}
+	mock.recorder = &MockdeploymentServiceDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdeploymentServiceDescriber) EXPECT() *MockdeploymentServiceDescriberMockRecorder {
+	return m.recorder
+}
+
+// Service mocks base method.
+func (m *MockdeploymentServiceDescriber) Service(app, env, svc string) (*ecs.Service, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Service", app, env, svc)
+	ret0, _ := ret[0].(*ecs.Service)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Service indicates an expected call of Service.
+func (mr *MockdeploymentServiceDescriberMockRecorder) Service(app, env, svc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockdeploymentServiceDescriber)(nil).Service), app, env, svc)
+}
+
+// MockcommandTaskRunner is a mock of commandTaskRunner interface.
+type MockcommandTaskRunner struct {
+	ctrl     *gomock.Controller
+	recorder *MockcommandTaskRunnerMockRecorder
+}
+
+// MockcommandTaskRunnerMockRecorder is the mock recorder for MockcommandTaskRunner.
+type MockcommandTaskRunnerMockRecorder struct {
+	mock *MockcommandTaskRunner
+}
+
+// NewMockcommandTaskRunner creates a new mock instance.
+func NewMockcommandTaskRunner(ctrl *gomock.Controller) *MockcommandTaskRunner {
+	mock := &MockcommandTaskRunner{ctrl: ctrl}
+	mock.recorder = &MockcommandTaskRunnerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcommandTaskRunner) EXPECT() *MockcommandTaskRunnerMockRecorder {
+	return m.recorder
+}
+
+// DescribeTasks mocks base method.
+func (m *MockcommandTaskRunner) DescribeTasks(cluster string, taskARNs []string) ([]*ecs.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeTasks", cluster, taskARNs)
+	ret0, _ := ret[0].([]*ecs.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTasks indicates an expected call of DescribeTasks.
+func (mr *MockcommandTaskRunnerMockRecorder) DescribeTasks(cluster, taskARNs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTasks", reflect.TypeOf((*MockcommandTaskRunner)(nil).DescribeTasks), cluster, taskARNs)
+}
+
+// RunCommandTask mocks base method.
+func (m *MockcommandTaskRunner) RunCommandTask(input ecs.RunCommandTaskInput) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunCommandTask", input)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunCommandTask indicates an expected call of RunCommandTask.
+func (mr *MockcommandTaskRunnerMockRecorder) RunCommandTask(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunCommandTask", reflect.TypeOf((*MockcommandTaskRunner)(nil).RunCommandTask), input)
+}
+
+// StopTasks mocks base method.
+func (m *MockcommandTaskRunner) StopTasks(tasks []string, opts ...ecs.StopTasksOpts) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{tasks}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StopTasks", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StopTasks indicates an expected call of StopTasks.
+func (mr *MockcommandTaskRunnerMockRecorder) StopTasks(tasks interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{tasks}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopTasks", reflect.TypeOf((*MockcommandTaskRunner)(nil).StopTasks), varargs...)
+}
+
+// MockvalidationAlarmTrigger is a mock of validationAlarmTrigger interface.
+type MockvalidationAlarmTrigger struct {
+	ctrl     *gomock.Controller
+	recorder *MockvalidationAlarmTriggerMockRecorder
+}
+
+// MockvalidationAlarmTriggerMockRecorder is the mock recorder for MockvalidationAlarmTrigger.
+type MockvalidationAlarmTriggerMockRecorder struct {
+	mock *MockvalidationAlarmTrigger
+}
+
+// NewMockvalidationAlarmTrigger creates a new mock instance.
+func NewMockvalidationAlarmTrigger(ctrl *gomock.Controller) *MockvalidationAlarmTrigger {
+	mock := &MockvalidationAlarmTrigger{ctrl: ctrl}
+	mock.recorder = &MockvalidationAlarmTriggerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockvalidationAlarmTrigger) EXPECT() *MockvalidationAlarmTriggerMockRecorder {
+	return m.recorder
+}
+
+// PutCountMetric mocks base method.
+func (m *MockvalidationAlarmTrigger) PutCountMetric(namespace, metric string, dimensions map[string]string, value float64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutCountMetric", namespace, metric, dimensions, value)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutCountMetric indicates an expected call of PutCountMetric.
+func (mr *MockvalidationAlarmTriggerMockRecorder) PutCountMetric(namespace, metric, dimensions, value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutCountMetric", reflect.TypeOf((*MockvalidationAlarmTrigger)(nil).PutCountMetric), namespace, metric, dimensions, value)
+}
+
+// TriggerAlarm mocks base method.
+func (m *MockvalidationAlarmTrigger) TriggerAlarm(name, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TriggerAlarm", name, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TriggerAlarm indicates an expected call of TriggerAlarm.
+func (mr *MockvalidationAlarmTriggerMockRecorder) TriggerAlarm(name, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TriggerAlarm", reflect.TypeOf((*MockvalidationAlarmTrigger)(nil).TriggerAlarm), name, reason)
+}
+
+// MockhttpGetter is a mock of httpGetter interface.
+type MockhttpGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockhttpGetterMockRecorder
+}
+
+// MockhttpGetterMockRecorder is the mock recorder for MockhttpGetter.
+type MockhttpGetterMockRecorder struct {
+	mock *MockhttpGetter
+}
+
+// NewMockhttpGetter creates a new mock instance.
+func NewMockhttpGetter(ctrl *gomock.Controller) *MockhttpGetter {
+	mock := &MockhttpGetter{ctrl: ctrl}
+	mock.recorder = &MockhttpGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockhttpGetter) EXPECT() *MockhttpGetterMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockhttpGetter) Get(url string) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", url)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockhttpGetterMockRecorder) Get(url interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockhttpGetter)(nil).Get), url)
+}
@@ -11,6 +11,7 @@ import (
 
 	addon "github.com/aws/copilot-cli/internal/pkg/addon"
 	cloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	config "github.com/aws/copilot-cli/internal/pkg/config"
 	cloudformation0 "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	dockerengine "github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
 	gomock "github.com/golang/mock/gomock"
@@ -359,6 +360,43 @@ func (mr *MockserviceDeployerMockRecorder) DeployService(conf, bucketName, detac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployService", reflect.TypeOf((*MockserviceDeployer)(nil).DeployService), varargs...)
 }
 
+// MockimageLifecycleUpdater is a mock of imageLifecycleUpdater interface.
+type MockimageLifecycleUpdater struct {
+	ctrl     *gomock.Controller
+	recorder *MockimageLifecycleUpdaterMockRecorder
+}
+
+// MockimageLifecycleUpdaterMockRecorder is the mock recorder for MockimageLifecycleUpdater.
+type MockimageLifecycleUpdaterMockRecorder struct {
+	mock *MockimageLifecycleUpdater
+}
+
+// NewMockimageLifecycleUpdater creates a new mock instance.
+func NewMockimageLifecycleUpdater(ctrl *gomock.Controller) *MockimageLifecycleUpdater {
+	mock := &MockimageLifecycleUpdater{ctrl: ctrl}
+	mock.recorder = &MockimageLifecycleUpdaterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockimageLifecycleUpdater) EXPECT() *MockimageLifecycleUpdaterMockRecorder {
+	return m.recorder
+}
+
+// UpdateWorkloadImageLifecycle mocks base method.
+func (m *MockimageLifecycleUpdater) UpdateWorkloadImageLifecycle(app *config.Application, wlName string, lifecycle *config.ImageLifecycle) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkloadImageLifecycle", app, wlName, lifecycle)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWorkloadImageLifecycle indicates an expected call of UpdateWorkloadImageLifecycle.
+func (mr *MockimageLifecycleUpdaterMockRecorder) UpdateWorkloadImageLifecycle(app, wlName, lifecycle interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkloadImageLifecycle", reflect.TypeOf((*MockimageLifecycleUpdater)(nil).UpdateWorkloadImageLifecycle), app, wlName, lifecycle)
+}
+
 // MockdeployedTemplateGetter is a mock of deployedTemplateGetter interface.
 type MockdeployedTemplateGetter struct {
 	ctrl     *gomock.Controller
@@ -4,9 +4,13 @@
 package deploy
 
 import (
+	"bufio"
+	"bytes"
 	"errors"
 	"fmt"
+	"path/filepath"
 	"regexp"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
@@ -26,6 +30,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/version"
+	"github.com/spf13/afero"
 )
 
 var rdwsAliasUsedWithoutDomainFriendlyText = fmt.Sprintf("To use %s, your application must be associated with a domain: %s.\n",
@@ -80,7 +85,7 @@ func (rdwsDeployer) IsServiceAvailableInRegion(region string) (bool, error) {
 
 // UploadArtifacts uploads the deployment artifacts such as the container image, custom resources, addons and env files.
 func (d *rdwsDeployer) UploadArtifacts() (*UploadArtifactsOutput, error) {
-	return d.uploadArtifacts(d.buildAndPushContainerImages, d.uploadArtifactsToS3, d.uploadCustomResources)
+	return d.uploadArtifacts(d.updateImageLifecycle, d.buildAndPushContainerImages, d.uploadArtifactsToS3, d.uploadCustomResources)
 }
 
 type rdwsDeployOutput struct {
@@ -130,6 +135,21 @@ func (d *rdwsDeployer) stackConfiguration(in *StackRuntimeConfiguration) (*rdwsS
 	if err != nil {
 		return nil, err
 	}
+	if d.rdwsMft.EnvFile != nil {
+		// App Runner can't reference an env file from S3, so the variables are rendered in the template instead.
+		path := aws.StringValue(d.rdwsMft.EnvFile)
+		content, err := afero.ReadFile(d.fs, filepath.Join(d.workspacePath, path))
+		if err != nil {
+			return nil, fmt.Errorf("read env file %s: %w", path, err)
+		}
+		if rc.EnvFileVariables, err = parseEnvFile(content); err != nil {
+			return nil, fmt.Errorf("parse env file %s: %w", path, err)
+		}
+	}
+
+	if err := d.validateVPCConnector(); err != nil {
+		return nil, err
+	}
 
 	if d.app.Domain == "" && d.rdwsMft.Alias != nil {
 		log.Errorf(rdwsAliasUsedWithoutDomainFriendlyText)
@@ -187,6 +207,40 @@ func (d *rdwsDeployer) stackConfiguration(in *StackRuntimeConfiguration) (*rdwsS
 	}, nil
 }
 
+// parseEnvFile parses the content of an env file following the same format as Amazon ECS:
+// each line is a VARIABLE=VALUE pair, and empty lines or lines starting with "#" are ignored.
+func parseEnvFile(content []byte) (map[string]string, error) {
+	vars := make(map[string]string)
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		name, value, ok := strings.Cut(line, "=")
+		if !ok || name == "" {
+			return nil, fmt.Errorf("line %d is not in the VARIABLE=VALUE format", lineNum)
+		}
+		vars[name] = value
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return vars, nil
+}
+
+// validateVPCConnector returns an error if the service references a VPC connector that the environment doesn't share.
+func (d *rdwsDeployer) validateVPCConnector() error {
+	name := aws.StringValue(d.rdwsMft.Network.VPC.Connector)
+	if name == "" || d.envConfig == nil {
+		return nil
+	}
+	if _, ok := d.envConfig.Network.VPC.AppRunnerConnectors[name]; !ok {
+		return fmt.Errorf(`"network.vpc.connector" %s is not defined in "network.vpc.app_runner_connectors" of the manifest of environment %s`, name, d.env.Name)
+	}
+	return nil
+}
+
 func validateRDSvcAliasAndAppVersion(svcName, alias, envName string, app *config.Application, appVersionGetter versionGetter) error {
 	if alias == "" {
 		return nil
@@ -21,6 +21,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/require"
 )
 
@@ -73,8 +74,11 @@ func TestSvcDeployOpts_rdWebServiceStackConfiguration(t *testing.T) {
 	}
 	tests := map[string]struct {
 		inAlias       string
+		inEnvFile     *string
+		inConnector   *string
 		inApp         *config.Application
 		inEnvironment *config.Environment
+		inEnvConfig   *manifest.Environment
 
 		mock func(m *deployRDSvcMocks)
 
@@ -169,6 +173,66 @@ func TestSvcDeployOpts_rdWebServiceStackConfiguration(t *testing.T) {
 
 			wantErr: fmt.Errorf("mockDomain is a root domain alias, which is not supported yet"),
 		},
+		"fail to read the env file": {
+			inAlias:   "v1.mockDomain",
+			inEnvFile: aws.String("missing.env"),
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name:   mockAppName,
+				Domain: "mockDomain",
+			},
+			mock: func(m *deployRDSvcMocks) {
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
+			},
+
+			wantErr: fmt.Errorf("read env file missing.env: open missing.env: file does not exist"),
+		},
+		"error if the VPC connector is not shared by the environment": {
+			inConnector: aws.String("shared"),
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inEnvConfig: &manifest.Environment{},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mock: func(m *deployRDSvcMocks) {
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
+			},
+
+			wantErr: errors.New(`"network.vpc.connector" shared is not defined in "network.vpc.app_runner_connectors" of the manifest of environment mockEnv`),
+		},
+		"success with a VPC connector shared by the environment": {
+			inConnector: aws.String("shared"),
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inEnvConfig: func() *manifest.Environment {
+				envConfig := &manifest.Environment{}
+				envConfig.Network.VPC.AppRunnerConnectors = map[string]manifest.AppRunnerConnector{
+					"shared": {},
+				}
+				return envConfig
+			}(),
+			inAlias: "v1.mockDomain",
+			inApp: &config.Application{
+				Name:   mockAppName,
+				Domain: "mockDomain",
+			},
+			mock: func(m *deployRDSvcMocks) {
+				m.mockAppVersionGetter.EXPECT().Version().Return("v1.0.0", nil)
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
+			},
+			wantAlias: "v1.mockDomain",
+		},
 		"success": {
 			inAlias: "v1.mockDomain",
 			inEnvironment: &config.Environment{
@@ -206,9 +270,11 @@ func TestSvcDeployOpts_rdWebServiceStackConfiguration(t *testing.T) {
 						name:             mockName,
 						app:              tc.inApp,
 						env:              tc.inEnvironment,
+						envConfig:        tc.inEnvConfig,
 						resources:        mockResources,
 						endpointGetter:   m.mockEndpointGetter,
 						envVersionGetter: m.mockEnvVersionGetter,
+						fs:               afero.NewMemMapFs(),
 					},
 					newSvcUpdater: func(f func(*session.Session) serviceForceUpdater) serviceForceUpdater {
 						return nil
@@ -231,12 +297,14 @@ func TestSvcDeployOpts_rdWebServiceStackConfiguration(t *testing.T) {
 						RequestDrivenWebServiceHttpConfig: manifest.RequestDrivenWebServiceHttpConfig{
 							Alias: aws.String(tc.inAlias),
 						},
+						EnvFile: tc.inEnvFile,
 					},
 				},
 				newStack: func() cloudformation.StackConfiguration {
 					return new(stubCloudFormationStack)
 				},
 			}
+			deployer.rdwsMft.Network.VPC.Connector = tc.inConnector
 
 			got, gotErr := deployer.stackConfiguration(&StackRuntimeConfiguration{
 				AddonsURL: mockAddonsURL,
@@ -252,6 +320,54 @@ func TestSvcDeployOpts_rdWebServiceStackConfiguration(t *testing.T) {
 	}
 }
 
+func TestParseEnvFile(t *testing.T) {
+	testCases := map[string]struct {
+		in string
+
+		wanted    map[string]string
+		wantedErr error
+	}{
+		"skips empty lines and comments": {
+			in: `# Database configuration.
+DB_HOST=db.example.com
+
+  DB_PORT=5432
+`,
+			wanted: map[string]string{
+				"DB_HOST": "db.example.com",
+				"DB_PORT": "5432",
+			},
+		},
+		"keeps everything after the first equal sign": {
+			in: "QUERY=a=b&c=d\nEMPTY=",
+			wanted: map[string]string{
+				"QUERY": "a=b&c=d",
+				"EMPTY": "",
+			},
+		},
+		"error if a line is missing the equal sign": {
+			in:        "DB_HOST=db.example.com\nDB_PORT",
+			wantedErr: errors.New("line 2 is not in the VARIABLE=VALUE format"),
+		},
+		"error if a line is missing the variable name": {
+			in:        "=5432",
+			wantedErr: errors.New("line 1 is not in the VARIABLE=VALUE format"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := parseEnvFile([]byte(tc.in))
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
 func mockRDWSDeployer(opts ...func(*rdwsDeployer)) *rdwsDeployer {
 	deployer := &rdwsDeployer{
 		svcDeployer: &svcDeployer{
@@ -11,12 +11,17 @@ import (
 	"regexp"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"golang.org/x/mod/semver"
 
 	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/aws/copilot-cli/internal/pkg/aws/servicediscovery"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 )
@@ -38,10 +43,20 @@ type aliasCertValidator interface {
 	ValidateCertAliases(aliases []string, certs []string) error
 }
 
+type serviceConnectRegistry interface {
+	TaskFamilies(namespaceARN, serviceName string) ([]string, error)
+}
+
+type deploymentRecorder interface {
+	Record(stackName string, revision deploy.DeploymentRevision) error
+}
+
 type svcDeployer struct {
 	*workloadDeployer
 	newSvcUpdater func(func(*session.Session) serviceForceUpdater) serviceForceUpdater
 	now           func() time.Time
+	scRegistry    serviceConnectRegistry
+	history       deploymentRecorder
 }
 
 func newSvcDeployer(in *WorkloadDeployerInput) (*svcDeployer, error) {
@@ -54,10 +69,50 @@ func newSvcDeployer(in *WorkloadDeployerInput) (*svcDeployer, error) {
 		newSvcUpdater: func(f func(*session.Session) serviceForceUpdater) serviceForceUpdater {
 			return f(wkldDeployer.envSess)
 		},
-		now: time.Now,
+		now:        time.Now,
+		scRegistry: servicediscovery.New(wkldDeployer.envSess),
+		history:    deploy.NewDeploymentHistory(s3.New(wkldDeployer.envSess), wkldDeployer.resources.S3Bucket),
 	}, nil
 }
 
+// validateServiceConnectAlias returns an error if the environment's services join a Cloud Map namespace shared with
+// other applications, and tasks of another workload already registered one of the Service Connect aliases of the service.
+func (d *svcDeployer) validateServiceConnectAlias(connect manifest.ServiceConnectBoolOrArgs) error {
+	if d.envConfig == nil || !connect.Enabled() {
+		return nil
+	}
+	namespace := d.envConfig.ServiceConnectNamespace()
+	if namespace == "" {
+		return nil
+	}
+	alias := d.name
+	if connect.Alias != nil {
+		alias = aws.StringValue(connect.Alias)
+	}
+	aliases := []string{alias}
+	for _, port := range connect.Ports {
+		aliases = append(aliases, aws.StringValue(port.Alias))
+	}
+	// Copilot names task definition families after the application, environment, and workload.
+	family := fmt.Sprintf("%s-%s-%s", d.app.Name, d.env.Name, d.name)
+	for _, alias := range aliases {
+		families, err := d.scRegistry.TaskFamilies(namespace, alias)
+		if err != nil {
+			return fmt.Errorf("get the tasks registered to Service Connect alias %q: %w", alias, err)
+		}
+		for _, f := range families {
+			if f != family {
+				return &errServiceConnectAliasTaken{
+					alias:     alias,
+					namespace: namespace,
+					family:    f,
+				}
+			}
+		}
+	}
+	return nil
+}
+
 func (d *svcDeployer) deploy(deployOptions Options, stackConfigOutput svcStackConfigurationOutput) error {
 	opts := []awscloudformation.StackOption{
 		awscloudformation.WithRoleARN(d.env.ExecutionRoleARN),
@@ -66,12 +121,20 @@ func (d *svcDeployer) deploy(deployOptions Options, stackConfigOutput svcStackCo
 		opts = append(opts, awscloudformation.WithDisableRollback())
 	}
 	cmdRunAt := d.now()
+	validation := d.startValidation(stackConfigOutput.validator, deployOptions.Detach, cmdRunAt)
+	hasInfraChanges := true
 	if err := d.deployer.DeployService(stackConfigOutput.conf, d.resources.S3Bucket, deployOptions.Detach, opts...); err != nil {
+		hasInfraChanges = false
 		var errEmptyCS *awscloudformation.ErrChangeSetEmpty
 		if !errors.As(err, &errEmptyCS) {
+			if validationErr := validation.failure(); validationErr != nil {
+				// The failed validation is most likely the reason why the deployment was rolled back.
+				return validationErr
+			}
 			return fmt.Errorf("deploy service: %w", err)
 		}
 		if !deployOptions.ForceNewUpdate {
+			validation.failure()
 			log.Warningln("Set --force to force an update for the service.")
 			return fmt.Errorf("deploy service: %w", err)
 		}
@@ -80,6 +143,7 @@ func (d *svcDeployer) deploy(deployOptions Options, stackConfigOutput svcStackCo
 	if deployOptions.ForceNewUpdate {
 		lastUpdatedAt, err := stackConfigOutput.svcUpdater.LastUpdatedAt(d.app.Name, d.env.Name, d.name)
 		if err != nil {
+			validation.failure()
 			return fmt.Errorf("get the last updated deployment time for %s: %w", d.name, err)
 		}
 		if cmdRunAt.After(lastUpdatedAt) {
@@ -87,16 +151,70 @@ func (d *svcDeployer) deploy(deployOptions Options, stackConfigOutput svcStackCo
 				spinner:    d.spinner,
 				svcUpdater: stackConfigOutput.svcUpdater,
 			}); err != nil {
+				if validationErr := validation.failure(); validationErr != nil {
+					return validationErr
+				}
 				return err
 			}
 		}
 	}
+	validated, err := validation.result()
+	if err != nil {
+		return err
+	}
+	if validated {
+		log.Successf("Validated the deployment of service %s.\n", color.HighlightUserInput(d.name))
+	}
+	// With --detach, the stack update might still fail and roll back, so the deployment isn't recorded.
+	if hasInfraChanges && !deployOptions.Detach {
+		d.recordDeployment(stackConfigOutput.conf, cmdRunAt)
+	}
 	return nil
 }
 
+// recordDeployment adds the deployed stack to the deployment history of the service, so that "svc rollback" can restore it.
+// Failing to record the deployment doesn't fail the deployment.
+func (d *svcDeployer) recordDeployment(conf cloudformation.StackConfiguration, deployedAt time.Time) {
+	if d.history == nil {
+		return
+	}
+	revision, err := deploymentRevision(conf, deployedAt)
+	if err == nil {
+		err = d.history.Record(conf.StackName(), revision)
+	}
+	if err != nil {
+		log.Warningf("Failed to record the deployment of service %s to roll back to it later: %v\n", d.name, err)
+	}
+}
+
+func deploymentRevision(conf cloudformation.StackConfiguration, deployedAt time.Time) (deploy.DeploymentRevision, error) {
+	tpl, err := conf.Template()
+	if err != nil {
+		return deploy.DeploymentRevision{}, fmt.Errorf("generate template: %w", err)
+	}
+	params, err := conf.Parameters()
+	if err != nil {
+		return deploy.DeploymentRevision{}, fmt.Errorf("generate parameters: %w", err)
+	}
+	revision := deploy.DeploymentRevision{
+		Template:   tpl,
+		Parameters: make(map[string]string, len(params)),
+		Tags:       make(map[string]string),
+		DeployedAt: deployedAt,
+	}
+	for _, param := range params {
+		revision.Parameters[aws.StringValue(param.ParameterKey)] = aws.StringValue(param.ParameterValue)
+	}
+	for _, tag := range conf.Tags() {
+		revision.Tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+	return revision, nil
+}
+
 type svcStackConfigurationOutput struct {
 	conf       cloudformation.StackConfiguration
 	svcUpdater serviceForceUpdater
+	validator  *deploymentValidator // Nil if the service has no post-deployment validation.
 }
 
 type errAppOutOfDate struct {
@@ -3,6 +3,22 @@
 
 package deploy
 
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/cli/deploy/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
 type versionGetterDouble struct {
 	VersionFn func() (string, error)
 }
@@ -10,3 +26,151 @@ type versionGetterDouble struct {
 func (d *versionGetterDouble) Version() (string, error) {
 	return d.VersionFn()
 }
+
+func TestSvcDeployer_validateServiceConnectAlias(t *testing.T) {
+	const mockNamespace = "arn:aws:servicediscovery:us-west-2:123456789012:namespace/ns-1234"
+	sharedNamespaceEnv := &manifest.Environment{}
+	sharedNamespaceEnv.Network.Connect.Namespace = aws.String(mockNamespace)
+	testCases := map[string]struct {
+		inEnvConfig *manifest.Environment
+		inConnect   manifest.ServiceConnectBoolOrArgs
+		mockReg     func(m *mocks.MockserviceConnectRegistry)
+
+		wantedError error
+	}{
+		"skip if the environment uses its own namespace": {
+			inEnvConfig: &manifest.Environment{},
+			inConnect: manifest.ServiceConnectBoolOrArgs{
+				EnableServiceConnect: aws.Bool(true),
+			},
+			mockReg: func(m *mocks.MockserviceConnectRegistry) {},
+		},
+		"skip if Service Connect is disabled": {
+			inEnvConfig: sharedNamespaceEnv,
+			mockReg:     func(m *mocks.MockserviceConnectRegistry) {},
+		},
+		"error if fail to get the tasks registered to the alias": {
+			inEnvConfig: sharedNamespaceEnv,
+			inConnect: manifest.ServiceConnectBoolOrArgs{
+				EnableServiceConnect: aws.Bool(true),
+			},
+			mockReg: func(m *mocks.MockserviceConnectRegistry) {
+				m.EXPECT().TaskFamilies(mockNamespace, "api").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New(`get the tasks registered to Service Connect alias "api": some error`),
+		},
+		"error if the alias is registered by a workload of another application": {
+			inEnvConfig: sharedNamespaceEnv,
+			inConnect: manifest.ServiceConnectBoolOrArgs{
+				ServiceConnectArgs: manifest.ServiceConnectArgs{
+					Alias: aws.String("users"),
+				},
+			},
+			mockReg: func(m *mocks.MockserviceConnectRegistry) {
+				m.EXPECT().TaskFamilies(mockNamespace, "users").Return([]string{"phonetool-test-api", "shop-prod-users"}, nil)
+			},
+			wantedError: errors.New(`Service Connect alias "users" is already used in namespace ` + mockNamespace + ` by tasks of family shop-prod-users`),
+		},
+		"error if the alias of a port is registered by a workload of another application": {
+			inEnvConfig: sharedNamespaceEnv,
+			inConnect: manifest.ServiceConnectBoolOrArgs{
+				ServiceConnectArgs: manifest.ServiceConnectArgs{
+					Ports: []manifest.ServiceConnectPort{
+						{Port: aws.Uint16(50051), Alias: aws.String("grpc")},
+					},
+				},
+			},
+			mockReg: func(m *mocks.MockserviceConnectRegistry) {
+				m.EXPECT().TaskFamilies(mockNamespace, "api").Return([]string{"phonetool-test-api"}, nil)
+				m.EXPECT().TaskFamilies(mockNamespace, "grpc").Return([]string{"shop-prod-grpc"}, nil)
+			},
+			wantedError: errors.New(`Service Connect alias "grpc" is already used in namespace ` + mockNamespace + ` by tasks of family shop-prod-grpc`),
+		},
+		"success if the alias is only registered by the service itself": {
+			inEnvConfig: sharedNamespaceEnv,
+			inConnect: manifest.ServiceConnectBoolOrArgs{
+				EnableServiceConnect: aws.Bool(true),
+			},
+			mockReg: func(m *mocks.MockserviceConnectRegistry) {
+				m.EXPECT().TaskFamilies(mockNamespace, "api").Return([]string{"phonetool-test-api"}, nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockserviceConnectRegistry(ctrl)
+			tc.mockReg(m)
+			d := &svcDeployer{
+				workloadDeployer: &workloadDeployer{
+					name:      "api",
+					app:       &config.Application{Name: "phonetool"},
+					env:       &config.Environment{Name: "test"},
+					envConfig: tc.inEnvConfig,
+				},
+				scRegistry: m,
+			}
+
+			err := d.validateServiceConnectAlias(tc.inConnect)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSvcDeployer_recordDeployment(t *testing.T) {
+	deployedAt := time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MockdeploymentRecorder)
+
+		wantedLog string
+	}{
+		"records the deployed template and parameters": {
+			setupMocks: func(m *mocks.MockdeploymentRecorder) {
+				m.EXPECT().Record("demo", deploy.DeploymentRevision{
+					Template: `
+Resources:
+  Queue:
+    Type: AWS::SQS::Queue`,
+					Parameters: map[string]string{},
+					Tags:       map[string]string{},
+					DeployedAt: deployedAt,
+				}).Return(nil)
+			},
+		},
+		"warns if the deployment cannot be recorded": {
+			setupMocks: func(m *mocks.MockdeploymentRecorder) {
+				m.EXPECT().Record(gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedLog: "Failed to record the deployment of service api to roll back to it later: some error\n",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockdeploymentRecorder(ctrl)
+			tc.setupMocks(m)
+			buf := new(bytes.Buffer)
+			log.DiagnosticWriter = buf
+			d := &svcDeployer{
+				workloadDeployer: &workloadDeployer{
+					name: "api",
+				},
+				history: m,
+			}
+
+			// WHEN
+			d.recordDeployment(new(stubCloudFormationStack), deployedAt)
+
+			// THEN
+			require.Contains(t, buf.String(), tc.wantedLog)
+		})
+	}
+}
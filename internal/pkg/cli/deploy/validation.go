@@ -0,0 +1,328 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+)
+
+const (
+	// Metric that the alarm of the deployment validation evaluates.
+	deploymentValidationNamespace  = "Copilot/Deployments"
+	deploymentValidationMetricName = "ValidationFailures"
+
+	deploymentValidationStartedBy = "copilot-deployment-validation"
+
+	deploymentPrimaryStatus = "PRIMARY"
+	taskStoppedStatus       = "STOPPED"
+
+	defaultValidationPollInterval = 5 * time.Second
+	validationHTTPRequestTimeout  = 10 * time.Second
+)
+
+type deploymentServiceDescriber interface {
+	Service(app, env, svc string) (*awsecs.Service, error)
+}
+
+type commandTaskRunner interface {
+	RunCommandTask(input awsecs.RunCommandTaskInput) (string, error)
+	DescribeTasks(cluster string, taskARNs []string) ([]*awsecs.Task, error)
+	StopTasks(tasks []string, opts ...awsecs.StopTasksOpts) error
+}
+
+type validationAlarmTrigger interface {
+	PutCountMetric(namespace, metric string, dimensions map[string]string, value float64) error
+	TriggerAlarm(name, reason string) error
+}
+
+type httpGetter interface {
+	Get(url string) (*http.Response, error)
+}
+
+// deploymentValidator runs the post-deployment validation of a service once all of its traffic is
+// served by the new deployment, and sets the rollback alarm of the service if the validation fails.
+type deploymentValidator struct {
+	app string
+	env string
+	svc string
+
+	url     string   // Empty if the validation runs a command.
+	command []string // Empty if the validation sends an HTTP request.
+	status  int
+	timeout time.Duration
+
+	svcDescriber deploymentServiceDescriber
+	taskRunner   commandTaskRunner
+	alarm        validationAlarmTrigger
+	httpClient   httpGetter
+	pollInterval time.Duration
+}
+
+// newDeploymentValidator returns a validator for the service, or nil if the manifest doesn't configure a validation.
+func (d *svcDeployer) newDeploymentValidator(cfg manifest.DeploymentValidation, url string) (*deploymentValidator, error) {
+	if cfg.IsEmpty() {
+		return nil, nil
+	}
+	command, err := cfg.Command.ToStringSlice()
+	if err != nil {
+		return nil, fmt.Errorf(`convert "deployment.validation.command" to string slice: %w`, err)
+	}
+	v := &deploymentValidator{
+		app:          d.app.Name,
+		env:          d.env.Name,
+		svc:          d.name,
+		command:      command,
+		status:       cfg.StatusOrDefault(),
+		timeout:      cfg.TimeoutOrDefault(),
+		svcDescriber: ecs.New(d.envSess),
+		taskRunner:   awsecs.New(d.envSess),
+		alarm:        cloudwatch.New(d.envSess),
+		httpClient: &http.Client{
+			Timeout: validationHTTPRequestTimeout,
+		},
+		pollInterval: defaultValidationPollInterval,
+	}
+	if cfg.Path != nil {
+		v.url = url + aws.StringValue(cfg.Path)
+	}
+	return v, nil
+}
+
+// validate waits until the deployment started after the time since serves all the traffic of the service,
+// and then runs the validation.
+// It returns the error of the context if the context is canceled before the deployment serves all the traffic.
+func (v *deploymentValidator) validate(ctx context.Context, since time.Time) error {
+	deployment, svc, err := v.waitForDeployment(ctx, since)
+	if err != nil {
+		return err
+	}
+	if aws.Int64Value(deployment.DesiredCount) == 0 {
+		log.Warningf("Skipping the validation of the deployment of service %s because its desired count is 0.\n", v.svc)
+		return nil
+	}
+	// The validation is not canceled with the deployment so that its result is always reported.
+	checkCtx, cancel := context.WithTimeout(context.Background(), v.timeout)
+	defer cancel()
+	var reason string
+	if v.url != "" {
+		reason = v.checkHTTP(checkCtx)
+	} else {
+		reason = v.checkCommand(checkCtx, deployment, svc)
+	}
+	if reason == "" {
+		return nil
+	}
+	return v.fail(reason)
+}
+
+// waitForDeployment polls the service until its primary deployment, created after since, runs all its tasks
+// and the previous deployments don't run any task.
+func (v *deploymentValidator) waitForDeployment(ctx context.Context, since time.Time) (*sdkecs.Deployment, *awsecs.Service, error) {
+	for {
+		// Errors are ignored as the service might not exist yet and the deployment will be retried.
+		svc, err := v.svcDescriber.Service(v.app, v.env, v.svc)
+		if err == nil {
+			if deployment := completedPrimaryDeployment(svc, since); deployment != nil {
+				return deployment, svc, nil
+			}
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+		// Describe the service one last time once the context is canceled, in case the deployment
+		// completed between two polls.
+		select {
+		case <-ctx.Done():
+		case <-time.After(v.pollInterval):
+		}
+	}
+}
+
+func completedPrimaryDeployment(svc *awsecs.Service, since time.Time) *sdkecs.Deployment {
+	var primary *sdkecs.Deployment
+	for _, deployment := range svc.Deployments {
+		if aws.StringValue(deployment.Status) != deploymentPrimaryStatus {
+			if aws.Int64Value(deployment.RunningCount) != 0 {
+				return nil
+			}
+			continue
+		}
+		primary = deployment
+	}
+	if primary == nil || aws.TimeValue(primary.CreatedAt).Before(since) {
+		return nil
+	}
+	if aws.Int64Value(primary.DesiredCount) == 0 {
+		// A service scaled to zero runs no task, so its deployment only completes once ECS says so.
+		if aws.StringValue(primary.RolloutState) != sdkecs.DeploymentRolloutStateCompleted {
+			return nil
+		}
+		return primary
+	}
+	if aws.Int64Value(primary.RunningCount) != aws.Int64Value(primary.DesiredCount) {
+		return nil
+	}
+	return primary
+}
+
+// checkHTTP sends requests to the URL until it responds with the expected status code,
+// and returns the reason of the failure if it never does.
+func (v *deploymentValidator) checkHTTP(ctx context.Context) string {
+	var reason string
+	for {
+		resp, err := v.httpClient.Get(v.url)
+		switch {
+		case err != nil:
+			reason = fmt.Sprintf("GET %s: %v", v.url, err)
+		case resp.StatusCode != v.status:
+			resp.Body.Close()
+			reason = fmt.Sprintf("GET %s returned status %d instead of %d", v.url, resp.StatusCode, v.status)
+		default:
+			resp.Body.Close()
+			return ""
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Sprintf("%s after %s", reason, v.timeout)
+		case <-time.After(v.pollInterval):
+		}
+	}
+}
+
+// checkCommand runs the command in the main container of the new deployment in a one-off task,
+// and returns the reason of the failure if the command doesn't exit with 0.
+func (v *deploymentValidator) checkCommand(ctx context.Context, deployment *sdkecs.Deployment, svc *awsecs.Service) string {
+	network := awsecs.NetworkConfiguration{
+		AssignPublicIp: sdkecs.AssignPublicIpDisabled,
+	}
+	if cfg := deployment.NetworkConfiguration; cfg != nil && cfg.AwsvpcConfiguration != nil {
+		network = awsecs.NetworkConfiguration{
+			AssignPublicIp: aws.StringValue(cfg.AwsvpcConfiguration.AssignPublicIp),
+			SecurityGroups: aws.StringValueSlice(cfg.AwsvpcConfiguration.SecurityGroups),
+			Subnets:        aws.StringValueSlice(cfg.AwsvpcConfiguration.Subnets),
+		}
+	}
+	cluster := aws.StringValue(svc.ClusterArn)
+	taskARN, err := v.taskRunner.RunCommandTask(awsecs.RunCommandTaskInput{
+		Cluster:        cluster,
+		TaskDefinition: aws.StringValue(deployment.TaskDefinition),
+		Container:      v.svc,
+		Command:        v.command,
+		Network:        network,
+		StartedBy:      deploymentValidationStartedBy,
+	})
+	if err != nil {
+		return fmt.Sprintf("run the validation command: %v", err)
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			_ = v.taskRunner.StopTasks([]string{taskARN}, awsecs.WithStopTaskCluster(cluster),
+				awsecs.WithStopTaskReason("Copilot deployment validation timed out"))
+			return fmt.Sprintf("the validation command did not exit after %s", v.timeout)
+		case <-time.After(v.pollInterval):
+		}
+		tasks, err := v.taskRunner.DescribeTasks(cluster, []string{taskARN})
+		if err != nil || len(tasks) == 0 || aws.StringValue(tasks[0].LastStatus) != taskStoppedStatus {
+			continue
+		}
+		exitCode, err := tasks[0].ExitCode(v.svc)
+		if err != nil {
+			return fmt.Sprintf("the validation command did not run: %v", err)
+		}
+		if exitCode != 0 {
+			return fmt.Sprintf("the validation command exited with code %d", exitCode)
+		}
+		return ""
+	}
+}
+
+// fail sets the rollback alarm of the service so that ECS rolls back the deployment.
+func (v *deploymentValidator) fail(reason string) error {
+	if err := v.alarm.PutCountMetric(deploymentValidationNamespace, deploymentValidationMetricName, map[string]string{
+		"App":     v.app,
+		"Env":     v.env,
+		"Service": v.svc,
+	}, 1); err != nil {
+		return fmt.Errorf("record failed validation of service %s: %w", v.svc, err)
+	}
+	if err := v.alarm.TriggerAlarm(template.DeploymentValidationAlarmName(v.app, v.env, v.svc), reason); err != nil {
+		return fmt.Errorf("roll back service %s after failed validation: %w", v.svc, err)
+	}
+	return &errDeploymentValidationFailed{
+		svc:    v.svc,
+		reason: reason,
+	}
+}
+
+// validationRun is a deployment validation running alongside the deployment of the service.
+type validationRun struct {
+	cancel context.CancelFunc
+	done   <-chan error
+}
+
+// startValidation starts validating the deployment created after since in the background.
+// It returns nil if the service has no validation or if the deployment is detached.
+func (d *svcDeployer) startValidation(validator *deploymentValidator, detach bool, since time.Time) *validationRun {
+	if validator == nil {
+		return nil
+	}
+	if detach {
+		log.Warningf("Skipping the validation of the deployment of service %s because the deployment is detached.\n", d.name)
+		return nil
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- validator.validate(ctx, since)
+	}()
+	return &validationRun{
+		cancel: cancel,
+		done:   done,
+	}
+}
+
+// failure returns the error of the validation if it already failed, without waiting for it.
+func (r *validationRun) failure() error {
+	if r == nil {
+		return nil
+	}
+	r.cancel()
+	select {
+	case err := <-r.done:
+		var errFailed *errDeploymentValidationFailed
+		if errors.As(err, &errFailed) {
+			return err
+		}
+	default:
+	}
+	return nil
+}
+
+// result waits for the validation to complete and returns its error.
+// It returns false if no deployment of the service was validated.
+func (r *validationRun) result() (bool, error) {
+	if r == nil {
+		return false, nil
+	}
+	r.cancel()
+	err := <-r.done
+	if errors.Is(err, context.Canceled) {
+		return false, nil
+	}
+	return true, err
+}
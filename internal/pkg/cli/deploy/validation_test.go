@@ -0,0 +1,268 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkecs "github.com/aws/aws-sdk-go/service/ecs"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/cli/deploy/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type validationMocks struct {
+	svcDescriber *mocks.MockdeploymentServiceDescriber
+	taskRunner   *mocks.MockcommandTaskRunner
+	alarm        *mocks.MockvalidationAlarmTrigger
+	httpClient   *mocks.MockhttpGetter
+}
+
+func TestDeploymentValidator_validate(t *testing.T) {
+	const (
+		mockApp     = "phonetool"
+		mockEnv     = "test"
+		mockSvc     = "api"
+		mockCluster = "arn:aws:ecs:us-west-2:123456789012:cluster/phonetool-test"
+		mockTaskDef = "arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-api:3"
+		mockTask    = "arn:aws:ecs:us-west-2:123456789012:task/phonetool-test/1234"
+		mockAlarm   = "phonetool-test-api-CopilotDeploymentValidationAlarm"
+	)
+	since := time.Unix(1494505750, 0)
+	inProgressService := &awsecs.Service{
+		ClusterArn: aws.String(mockCluster),
+		Deployments: []*sdkecs.Deployment{
+			{
+				Status:       aws.String("PRIMARY"),
+				CreatedAt:    aws.Time(since.Add(time.Second)),
+				DesiredCount: aws.Int64(2),
+				RunningCount: aws.Int64(2),
+			},
+			{
+				Status:       aws.String("ACTIVE"),
+				CreatedAt:    aws.Time(since.Add(-time.Hour)),
+				RunningCount: aws.Int64(1),
+			},
+		},
+	}
+	completedService := &awsecs.Service{
+		ClusterArn: aws.String(mockCluster),
+		Deployments: []*sdkecs.Deployment{
+			{
+				Status:         aws.String("PRIMARY"),
+				CreatedAt:      aws.Time(since.Add(time.Second)),
+				DesiredCount:   aws.Int64(2),
+				RunningCount:   aws.Int64(2),
+				TaskDefinition: aws.String(mockTaskDef),
+				NetworkConfiguration: &sdkecs.NetworkConfiguration{
+					AwsvpcConfiguration: &sdkecs.AwsVpcConfiguration{
+						AssignPublicIp: aws.String("DISABLED"),
+						Subnets:        aws.StringSlice([]string{"subnet-1"}),
+						SecurityGroups: aws.StringSlice([]string{"sg-1"}),
+					},
+				},
+			},
+		},
+	}
+	scaledToZeroService := &awsecs.Service{
+		ClusterArn: aws.String(mockCluster),
+		Deployments: []*sdkecs.Deployment{
+			{
+				Status:       aws.String("PRIMARY"),
+				CreatedAt:    aws.Time(since.Add(time.Second)),
+				DesiredCount: aws.Int64(0),
+				RunningCount: aws.Int64(0),
+				RolloutState: aws.String("COMPLETED"),
+			},
+		},
+	}
+	response := func(status int) *http.Response {
+		return &http.Response{
+			StatusCode: status,
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+	}
+	stoppedTask := func(exitCode int64) []*awsecs.Task {
+		return []*awsecs.Task{
+			{
+				LastStatus: aws.String("STOPPED"),
+				Containers: []*sdkecs.Container{
+					{
+						Name:     aws.String(mockSvc),
+						ExitCode: aws.Int64(exitCode),
+					},
+				},
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		inURL       string
+		inCommand   []string
+		inCancelled bool
+		setupMocks  func(m validationMocks)
+
+		wantedErr error
+	}{
+		"returns the error of the context if the deployment never serves all the traffic": {
+			inURL:       "http://example.com/healthz",
+			inCancelled: true,
+			setupMocks: func(m validationMocks) {
+				m.svcDescriber.EXPECT().Service(mockApp, mockEnv, mockSvc).Return(inProgressService, nil).AnyTimes()
+			},
+			wantedErr: context.Canceled,
+		},
+		"waits for the previous deployment to be drained before sending requests": {
+			inURL: "http://example.com/healthz",
+			setupMocks: func(m validationMocks) {
+				gomock.InOrder(
+					m.svcDescriber.EXPECT().Service(mockApp, mockEnv, mockSvc).Return(nil, errors.New("some error")),
+					m.svcDescriber.EXPECT().Service(mockApp, mockEnv, mockSvc).Return(inProgressService, nil),
+					m.svcDescriber.EXPECT().Service(mockApp, mockEnv, mockSvc).Return(completedService, nil),
+				)
+				gomock.InOrder(
+					m.httpClient.EXPECT().Get("http://example.com/healthz").Return(nil, errors.New("connection refused")),
+					m.httpClient.EXPECT().Get("http://example.com/healthz").Return(response(http.StatusOK), nil),
+				)
+			},
+		},
+		"skips the validation once the deployment of a service scaled to zero completes": {
+			inURL: "http://example.com/healthz",
+			setupMocks: func(m validationMocks) {
+				m.svcDescriber.EXPECT().Service(mockApp, mockEnv, mockSvc).Return(scaledToZeroService, nil)
+				m.httpClient.EXPECT().Get(gomock.Any()).Times(0)
+				m.alarm.EXPECT().TriggerAlarm(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		"triggers the alarm if the URL never responds with the expected status": {
+			inURL: "http://example.com/healthz",
+			setupMocks: func(m validationMocks) {
+				m.svcDescriber.EXPECT().Service(mockApp, mockEnv, mockSvc).Return(completedService, nil)
+				m.httpClient.EXPECT().Get("http://example.com/healthz").Return(response(http.StatusServiceUnavailable), nil).MinTimes(1)
+				m.alarm.EXPECT().PutCountMetric("Copilot/Deployments", "ValidationFailures", map[string]string{
+					"App":     mockApp,
+					"Env":     mockEnv,
+					"Service": mockSvc,
+				}, float64(1)).Return(nil)
+				m.alarm.EXPECT().TriggerAlarm(mockAlarm, "GET http://example.com/healthz returned status 503 instead of 200 after 50ms").Return(nil)
+			},
+			wantedErr: errors.New("validation of the deployment of service api failed: GET http://example.com/healthz returned status 503 instead of 200 after 50ms"),
+		},
+		"returns an error if the alarm cannot be triggered": {
+			inURL: "http://example.com/healthz",
+			setupMocks: func(m validationMocks) {
+				m.svcDescriber.EXPECT().Service(mockApp, mockEnv, mockSvc).Return(completedService, nil)
+				m.httpClient.EXPECT().Get("http://example.com/healthz").Return(response(http.StatusNotFound), nil).MinTimes(1)
+				m.alarm.EXPECT().PutCountMetric(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				m.alarm.EXPECT().TriggerAlarm(mockAlarm, gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedErr: errors.New("roll back service api after failed validation: some error"),
+		},
+		"runs the command in the main container of the new deployment": {
+			inCommand: []string{"./smoke-test.sh"},
+			setupMocks: func(m validationMocks) {
+				m.svcDescriber.EXPECT().Service(mockApp, mockEnv, mockSvc).Return(completedService, nil)
+				m.taskRunner.EXPECT().RunCommandTask(awsecs.RunCommandTaskInput{
+					Cluster:        mockCluster,
+					TaskDefinition: mockTaskDef,
+					Container:      mockSvc,
+					Command:        []string{"./smoke-test.sh"},
+					Network: awsecs.NetworkConfiguration{
+						AssignPublicIp: "DISABLED",
+						Subnets:        []string{"subnet-1"},
+						SecurityGroups: []string{"sg-1"},
+					},
+					StartedBy: "copilot-deployment-validation",
+				}).Return(mockTask, nil)
+				gomock.InOrder(
+					m.taskRunner.EXPECT().DescribeTasks(mockCluster, []string{mockTask}).Return([]*awsecs.Task{
+						{
+							LastStatus: aws.String("RUNNING"),
+						},
+					}, nil),
+					m.taskRunner.EXPECT().DescribeTasks(mockCluster, []string{mockTask}).Return(stoppedTask(0), nil),
+				)
+			},
+		},
+		"triggers the alarm if the command exits with a non-zero code": {
+			inCommand: []string{"./smoke-test.sh"},
+			setupMocks: func(m validationMocks) {
+				m.svcDescriber.EXPECT().Service(mockApp, mockEnv, mockSvc).Return(completedService, nil)
+				m.taskRunner.EXPECT().RunCommandTask(gomock.Any()).Return(mockTask, nil)
+				m.taskRunner.EXPECT().DescribeTasks(mockCluster, []string{mockTask}).Return(stoppedTask(1), nil)
+				m.alarm.EXPECT().PutCountMetric(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				m.alarm.EXPECT().TriggerAlarm(mockAlarm, "the validation command exited with code 1").Return(nil)
+			},
+			wantedErr: errors.New("validation of the deployment of service api failed: the validation command exited with code 1"),
+		},
+		"stops the task and triggers the alarm if the command times out": {
+			inCommand: []string{"./smoke-test.sh"},
+			setupMocks: func(m validationMocks) {
+				m.svcDescriber.EXPECT().Service(mockApp, mockEnv, mockSvc).Return(completedService, nil)
+				m.taskRunner.EXPECT().RunCommandTask(gomock.Any()).Return(mockTask, nil)
+				m.taskRunner.EXPECT().DescribeTasks(mockCluster, []string{mockTask}).Return([]*awsecs.Task{
+					{
+						LastStatus: aws.String("RUNNING"),
+					},
+				}, nil).AnyTimes()
+				m.taskRunner.EXPECT().StopTasks([]string{mockTask}, gomock.Any(), gomock.Any()).Return(nil)
+				m.alarm.EXPECT().PutCountMetric(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				m.alarm.EXPECT().TriggerAlarm(mockAlarm, "the validation command did not exit after 50ms").Return(nil)
+			},
+			wantedErr: errors.New("validation of the deployment of service api failed: the validation command did not exit after 50ms"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := validationMocks{
+				svcDescriber: mocks.NewMockdeploymentServiceDescriber(ctrl),
+				taskRunner:   mocks.NewMockcommandTaskRunner(ctrl),
+				alarm:        mocks.NewMockvalidationAlarmTrigger(ctrl),
+				httpClient:   mocks.NewMockhttpGetter(ctrl),
+			}
+			tc.setupMocks(m)
+			v := &deploymentValidator{
+				app:          mockApp,
+				env:          mockEnv,
+				svc:          mockSvc,
+				url:          tc.inURL,
+				command:      tc.inCommand,
+				status:       http.StatusOK,
+				timeout:      50 * time.Millisecond,
+				svcDescriber: m.svcDescriber,
+				taskRunner:   m.taskRunner,
+				alarm:        m.alarm,
+				httpClient:   m.httpClient,
+				pollInterval: time.Millisecond,
+			}
+			ctx, cancel := context.WithCancel(context.Background())
+			if tc.inCancelled {
+				cancel()
+			}
+			defer cancel()
+
+			// WHEN
+			err := v.validate(ctx, since)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -81,7 +81,7 @@ func workerCustomResources(fs template.Reader) ([]*customresource.CustomResource
 
 // UploadArtifacts uploads the deployment artifacts such as the container image, custom resources, addons and env files.
 func (d *workerSvcDeployer) UploadArtifacts() (*UploadArtifactsOutput, error) {
-	return d.uploadArtifacts(d.buildAndPushContainerImages, d.uploadArtifactsToS3, d.uploadCustomResources)
+	return d.uploadArtifacts(d.updateImageLifecycle, d.buildAndPushContainerImages, d.uploadArtifactsToS3, d.uploadCustomResources)
 }
 
 type workerSvcDeployOutput struct {
@@ -130,6 +130,10 @@ func (d *workerSvcDeployer) DeployWorkload(in *DeployWorkloadInput) (ActionRecom
 	if err != nil {
 		return nil, err
 	}
+	stackConfigOutput.validator, err = d.newDeploymentValidator(d.wsMft.DeployConfig.Validation, "")
+	if err != nil {
+		return nil, err
+	}
 	if err := d.deploy(in.Options, stackConfigOutput.svcStackConfigurationOutput); err != nil {
 		return nil, err
 	}
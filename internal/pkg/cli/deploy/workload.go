@@ -6,11 +6,13 @@ package deploy
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strings"
 	"sync"
@@ -67,6 +69,7 @@ const (
 	paddingInSpacesForBuildAndPush = 5
 	pollIntervalForBuildAndPush    = 60 * time.Millisecond
 	defaultNumLinesForBuildAndPush = 5
+	defaultMaxConcurrentBuilds     = 4
 )
 
 // ActionRecommender contains methods that output action recommendation.
@@ -109,6 +112,10 @@ type serviceDeployer interface {
 	DeployService(conf cloudformation.StackConfiguration, bucketName string, detach bool, opts ...awscloudformation.StackOption) error
 }
 
+type imageLifecycleUpdater interface {
+	UpdateWorkloadImageLifecycle(app *config.Application, wlName string, lifecycle *config.ImageLifecycle) error
+}
+
 type deployedTemplateGetter interface {
 	Template(stackName string) (string, error)
 }
@@ -138,6 +145,7 @@ type StackRuntimeConfiguration struct {
 	CustomResourceURLs        map[string]string
 	StaticSiteAssetMappingURL string
 	Version                   string
+	DeployValues              map[string]string // Manifest fields overridden with the --values flag.
 }
 
 // DeployWorkloadInput is the input of DeployWorkload.
@@ -174,6 +182,7 @@ type workloadDeployer struct {
 	app           *config.Application
 	env           *config.Environment
 	image         ContainerImageIdentifier
+	builtImages   *BuiltImages
 	resources     *stack.AppRegionalResources
 	mft           interface{}
 	rawMft        string
@@ -184,9 +193,11 @@ type workloadDeployer struct {
 	s3Client           uploader
 	addons             stackBuilder
 	repository         repositoryService
+	lifecycleUpdater   imageLifecycleUpdater
 	deployer           serviceDeployer
 	tmplGetter         deployedTemplateGetter
 	endpointGetter     endpointGetter
+	envOutputsGetter   envOutputsGetter
 	spinner            spinner
 	templateFS         template.Reader
 	envVersionGetter   versionGetter
@@ -194,6 +205,8 @@ type workloadDeployer struct {
 	docker             dockerEngineRunChecker
 	customResources    customResourcesFunc
 	labeledTermPrinter func(fw syncbuffer.FileWriter, bufs []*syncbuffer.LabeledSyncBuffer, opts ...syncbuffer.LabeledTermPrinterOption) LabeledTermPrinter
+	inParallel         bool
+	registryCache      bool
 
 	// Cached variables.
 	defaultSess              *session.Session
@@ -213,6 +226,7 @@ type ImagePerContainer struct {
 type WorkloadDeployerInput struct {
 	SessionProvider  *sessions.Provider
 	Name             string
+	Source           string // Optional. Workload in the workspace whose addons and image repository are used, if Name is a copy of it.
 	App              *config.Application
 	Env              *config.Environment
 	Image            ContainerImageIdentifier
@@ -220,6 +234,9 @@ type WorkloadDeployerInput struct {
 	RawMft           string      // With env var interpolation only.
 	EnvVersionGetter versionGetter
 	Overrider        Overrider
+	BuiltImages      *BuiltImages // Images already built by other workloads of the same deployment.
+	InParallel       bool         // Whether other workloads deploy at the same time, in which case the progress isn't rendered.
+	RegistryCache    bool         // Whether the images import and export their build cache from the image repository of the workload.
 
 	// Workload specific configuration.
 	customResources customResourcesFunc
@@ -230,24 +247,103 @@ type ContainerImageIdentifier struct {
 	Digest            string
 	CustomTag         string
 	GitShortCommitTag string
+	GitBranch         string // Current git branch, used by the additional tags of the image.
 	RepoTags          []string
+	RepoURL           string // Repository of another workload the image was pushed to. Empty if the image was pushed to the workload's repository.
+}
+
+// BuiltImages records the images built and pushed by the workloads of a deployment,
+// so that a sidecar built from the same Dockerfile as another workload reuses its image instead of building it again.
+type BuiltImages struct {
+	mu     sync.Mutex
+	images map[string]builtImage // Keyed by the build arguments of the image.
+}
+
+type builtImage struct {
+	workload string
+	repoURL  string
+	digest   string
+}
+
+// NewBuiltImages returns an empty record of built images.
+func NewBuiltImages() *BuiltImages {
+	return &BuiltImages{
+		images: make(map[string]builtImage),
+	}
+}
+
+func (b *BuiltImages) get(args *dockerengine.BuildArguments) (builtImage, bool) {
+	if b == nil {
+		return builtImage{}, false
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	img, ok := b.images[buildKey(args)]
+	return img, ok
+}
+
+func (b *BuiltImages) add(args *dockerengine.BuildArguments, img builtImage) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if _, ok := b.images[buildKey(args)]; ok {
+		return
+	}
+	b.images[buildKey(args)] = img
+}
+
+// buildKey returns a key identifying the image produced by the build arguments, ignoring where the image is pushed and how it's tagged.
+func buildKey(args *dockerengine.BuildArguments) string {
+	key, _ := json.Marshal(struct {
+		Dockerfile        string
+		DockerfileContent string
+		Context           string
+		Target            string
+		Platform          string
+		Args              map[string]string // Marshaled with sorted keys.
+	}{
+		Dockerfile:        filepath.Clean(args.Dockerfile),
+		DockerfileContent: args.DockerfileContent,
+		Context:           filepath.Clean(args.Context),
+		Target:            args.Target,
+		Platform:          args.Platform,
+		Args:              args.Args,
+	})
+	return string(key)
 }
 
 // ImageActionInput represent the input parameters for building and uploading container images.
 type ImageActionInput struct {
-	Name              string
-	WorkspacePath     string
-	Image             ContainerImageIdentifier
-	Builder           repositoryService
-	CustomTag         string
-	GitShortCommitTag string
-	Mft               interface{}
+	Name                string
+	WorkspacePath       string
+	Image               ContainerImageIdentifier
+	Builder             repositoryService
+	CustomTag           string
+	GitShortCommitTag   string
+	Mft                 interface{}
+	TagVars             *manifest.ImageTagVars // Values of the placeholders in "image.tags". If nil, the additional tags are not applied.
+	BuiltImages         *BuiltImages           // Images built by other workloads of the deployment. If nil, every image is built.
+	MaxConcurrentBuilds int                    // Maximum number of images built at the same time. Defaults to 4.
+	InParallel          bool                   // Whether other workloads build images at the same time, in which case each build output is printed once complete.
+	RegistryCache       bool                   // Whether the images import and export their build cache from the image repository.
 
 	Login              func() (string, error)
 	CheckDockerEngine  func() error
 	LabeledTermPrinter func(fw syncbuffer.FileWriter, bufs []*syncbuffer.LabeledSyncBuffer, opts ...syncbuffer.LabeledTermPrinterOption) LabeledTermPrinter
 }
 
+// discardFile is a file writer that discards all writes.
+// Unlike deploying without a progress tracker, rendering to it still waits for the stack to finish updating.
+type discardFile struct{}
+
+// Write implements the io.Writer interface and discards p.
+func (discardFile) Write(p []byte) (int, error) { return io.Discard.Write(p) }
+
+// Fd returns the file descriptor of stderr, which is never written to.
+func (discardFile) Fd() uintptr { return os.Stderr.Fd() }
+
 // newWorkloadDeployer is the constructor for workloadDeployer.
 func newWorkloadDeployer(in *WorkloadDeployerInput) (*workloadDeployer, error) {
 	ws, err := workspace.Use(afero.NewOsFs())
@@ -266,24 +362,29 @@ func newWorkloadDeployer(in *WorkloadDeployerInput) (*workloadDeployer, error) {
 	if err != nil {
 		return nil, fmt.Errorf("create default session with region %s: %w", in.Env.Region, err)
 	}
-	resources, err := cloudformation.New(defaultSession, cloudformation.WithProgressTracker(os.Stderr)).GetAppResourcesByRegion(in.App, in.Env.Region)
+	appCFN := cloudformation.New(defaultSession, cloudformation.WithProgressTracker(os.Stderr))
+	resources, err := appCFN.GetAppResourcesByRegion(in.App, in.Env.Region)
 	if err != nil {
 		return nil, fmt.Errorf("get application %s resources from region %s: %w", in.App.Name, in.Env.Region, err)
 	}
 
+	source := in.Name
+	if in.Source != "" {
+		source = in.Source
+	}
 	var addons stackBuilder
-	addons, err = addon.ParseFromWorkload(in.Name, ws)
+	addons, err = addon.ParseFromWorkload(source, ws)
 	if err != nil {
 		var notFoundErr *addon.ErrAddonsNotFound
 		if !errors.As(err, &notFoundErr) {
-			return nil, fmt.Errorf("parse addons stack for workload %s: %w", in.Name, err)
+			return nil, fmt.Errorf("parse addons stack for workload %s: %w", source, err)
 		}
 		addons = nil // so that we can check for no addons with nil comparison
 	}
 
-	repoName := RepoName(in.App.Name, in.Name)
+	repoName := RepoName(in.App.Name, source)
 	repository := repository.NewWithURI(
-		ecr.New(defaultSessEnvRegion), repoName, resources.RepositoryURLs[in.Name])
+		ecr.New(defaultSessEnvRegion), repoName, resources.RepositoryURLs[source])
 	store := config.NewSSMStore(identity.New(defaultSession), ssm.New(defaultSession), aws.StringValue(defaultSession.Config.Region))
 	envDescriber, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
 		App:         in.App.Name,
@@ -303,7 +404,12 @@ func newWorkloadDeployer(in *WorkloadDeployerInput) (*workloadDeployer, error) {
 		return nil, fmt.Errorf("unmarshal the manifest used to deploy environment %s: %w", in.Env.Name, err)
 	}
 
-	cfn := cloudformation.New(envSession, cloudformation.WithProgressTracker(os.Stderr))
+	var console termprogress.FileWriter = os.Stderr
+	if in.InParallel {
+		// Rendering the progress of several deployments in place at the same time would garble the terminal.
+		console = discardFile{}
+	}
+	cfn := cloudformation.New(envSession, cloudformation.WithProgressTracker(console))
 
 	labeledTermPrinter := func(fw syncbuffer.FileWriter, bufs []*syncbuffer.LabeledSyncBuffer, opts ...syncbuffer.LabeledTermPrinterOption) LabeledTermPrinter {
 		return syncbuffer.NewLabeledTermPrinter(fw, bufs, opts...)
@@ -314,16 +420,19 @@ func newWorkloadDeployer(in *WorkloadDeployerInput) (*workloadDeployer, error) {
 		app:                      in.App,
 		env:                      in.Env,
 		image:                    in.Image,
+		builtImages:              in.BuiltImages,
 		resources:                resources,
 		workspacePath:            ws.Path(),
 		fs:                       afero.NewOsFs(),
 		s3Client:                 s3.New(envSession),
 		addons:                   addons,
 		repository:               repository,
+		lifecycleUpdater:         appCFN,
 		deployer:                 cfn,
 		tmplGetter:               cfn,
 		endpointGetter:           envDescriber,
-		spinner:                  termprogress.NewSpinner(log.DiagnosticWriter),
+		envOutputsGetter:         envDescriber,
+		spinner:                  termprogress.NewSpinner(console),
 		templateFS:               template.New(),
 		envVersionGetter:         in.EnvVersionGetter,
 		overrider:                in.Overrider,
@@ -335,6 +444,8 @@ func newWorkloadDeployer(in *WorkloadDeployerInput) (*workloadDeployer, error) {
 		store:                    store,
 		envConfig:                envConfig,
 		labeledTermPrinter:       labeledTermPrinter,
+		inParallel:               in.InParallel,
+		registryCache:            in.RegistryCache,
 
 		mft:    in.Mft,
 		rawMft: in.RawMft,
@@ -342,7 +453,7 @@ func newWorkloadDeployer(in *WorkloadDeployerInput) (*workloadDeployer, error) {
 }
 
 // DeployDiff returns the stringified diff of the template against the deployed template of the workload.
-func (d *workloadDeployer) DeployDiff(template string) (string, error) {
+func (d *workloadDeployer) DeployDiff(template string, opts ...diff.WriteOption) (string, error) {
 	tmpl, err := d.tmplGetter.Template(stack.NameForWorkload(d.app.Name, d.env.Name, d.name))
 	if err != nil {
 		var errNotFound *awscloudformation.ErrStackNotFound
@@ -356,7 +467,7 @@ func (d *workloadDeployer) DeployDiff(template string) (string, error) {
 		return "", fmt.Errorf("parse the diff against the deployed %q in environment %q: %w", d.name, d.env.Name, err)
 	}
 	buf := strings.Builder{}
-	if err := diffTree.Write(&buf); err != nil {
+	if err := diffTree.Write(&buf, opts...); err != nil {
 		return "", err
 	}
 	return buf.String(), nil
@@ -429,10 +540,43 @@ func (d *workloadDeployer) buildAndPushContainerImages(out *UploadArtifactsOutpu
 		Login:              d.repository.Login,
 		CheckDockerEngine:  d.docker.CheckDockerEngineRunning,
 		LabeledTermPrinter: d.labeledTermPrinter,
+		BuiltImages:        d.builtImages,
+		InParallel:         d.inParallel,
+		RegistryCache:      d.registryCache,
+		TagVars: &manifest.ImageTagVars{
+			App:       d.app.Name,
+			Env:       d.env.Name,
+			Name:      d.name,
+			Tag:       d.image.CustomTag,
+			GitBranch: d.image.GitBranch,
+			GitCommit: d.image.GitShortCommitTag,
+		},
 	}, out, d.repository.BuildAndPush)
 
 }
 
+// updateImageLifecycle applies the lifecycle policy in the manifest to the ECR repository of the workload.
+func (d *workloadDeployer) updateImageLifecycle(_ *UploadArtifactsOutput) error {
+	mft, ok := d.mft.(interface {
+		ImageLifecycle() manifest.ImageLifecycle
+	})
+	if !ok {
+		return nil
+	}
+	lifecycle := mft.ImageLifecycle()
+	var in *config.ImageLifecycle
+	if !lifecycle.IsEmpty() {
+		in = &config.ImageLifecycle{
+			KeepLastImages:          aws.IntValue(lifecycle.KeepImages),
+			ExpireUntaggedAfterDays: aws.IntValue(lifecycle.ExpireUntaggedDays),
+		}
+	}
+	if err := d.lifecycleUpdater.UpdateWorkloadImageLifecycle(d.app, d.name, in); err != nil {
+		return fmt.Errorf("update the image lifecycle of %s: %w", d.name, err)
+	}
+	return nil
+}
+
 // BuildContainerImages builds the all the images given the build arguments
 func BuildContainerImages(in *ImageActionInput, out *UploadArtifactsOutput) error {
 	return processContainerImages(in, out, in.Builder.Build)
@@ -440,13 +584,32 @@ func BuildContainerImages(in *ImageActionInput, out *UploadArtifactsOutput) erro
 
 func processContainerImages(in *ImageActionInput, out *UploadArtifactsOutput, buildFunc func(ctx context.Context, args *dockerengine.BuildArguments, w io.Writer) (string, error)) error {
 	//this function could either build or buildAndPush the image based on the function received
-	buildArgsPerContainer, err := buildArgsPerContainer(in.Name, in.WorkspacePath, in.Image, in.Mft)
+	buildArgsPerContainer, err := buildArgsPerContainer(in.Name, in.WorkspacePath, in.Image, in.Mft, in.TagVars)
 	if err != nil {
 		return err
 	}
 	if len(buildArgsPerContainer) == 0 {
 		return nil
 	}
+	out.ImageDigests = make(map[string]ContainerImageIdentifier, len(buildArgsPerContainer))
+	for name, buildArgs := range buildArgsPerContainer {
+		if name == in.Name {
+			continue
+		}
+		img, ok := in.BuiltImages.get(buildArgs)
+		if !ok {
+			continue
+		}
+		log.Infof("Reusing the image of %s for the container %q instead of building it again.\n", img.workload, name)
+		out.ImageDigests[name] = ContainerImageIdentifier{
+			Digest:  img.digest,
+			RepoURL: img.repoURL,
+		}
+		delete(buildArgsPerContainer, name)
+	}
+	if len(buildArgsPerContainer) == 0 {
+		return nil
+	}
 	if err := in.CheckDockerEngine(); err != nil {
 		return fmt.Errorf("check if docker engine is running: %w", err)
 	}
@@ -454,15 +617,38 @@ func processContainerImages(in *ImageActionInput, out *UploadArtifactsOutput, bu
 	if err != nil {
 		return fmt.Errorf("login to image repository: %w", err)
 	}
+	if in.RegistryCache {
+		for name, buildArgs := range buildArgsPerContainer {
+			ref := fmt.Sprintf("type=registry,ref=%s:%s", uri, registryCacheTag(name))
+			buildArgs.CacheFrom = append(buildArgs.CacheFrom, ref)
+			buildArgs.CacheTo = append(buildArgs.CacheTo, ref+",mode=max")
+		}
+	}
 	isMultipleContainerImages := len(buildArgsPerContainer) > 1
-	if isMultipleContainerImages {
-		return buildContainerImagesInParallel(in, uri, buildArgsPerContainer, buildFunc, out)
+	if isMultipleContainerImages || in.InParallel {
+		err = buildContainerImagesInParallel(in, uri, buildArgsPerContainer, buildFunc, out)
+	} else {
+		err = buildSingleContainerImage(in, uri, buildArgsPerContainer, buildFunc, out)
 	}
-	return buildSingleContainerImage(in, uri, buildArgsPerContainer, buildFunc, out)
+	if err != nil {
+		return err
+	}
+	for name, buildArgs := range buildArgsPerContainer {
+		in.BuiltImages.add(buildArgs, builtImage{
+			workload: in.Name,
+			repoURL:  uri,
+			digest:   out.ImageDigests[name].Digest,
+		})
+	}
+	return nil
+}
+
+// registryCacheTag returns the tag of the build cache of a container image in the image repository.
+func registryCacheTag(container string) string {
+	return fmt.Sprintf("cache-%s", container)
 }
 
 func buildSingleContainerImage(in *ImageActionInput, uri string, buildArgsPerContainer map[string]*dockerengine.BuildArguments, buildFunc func(ctx context.Context, args *dockerengine.BuildArguments, w io.Writer) (string, error), out *UploadArtifactsOutput) error {
-	out.ImageDigests = make(map[string]ContainerImageIdentifier, len(buildArgsPerContainer))
 	for name, buildArgs := range buildArgsPerContainer {
 		buildArgs.URI = uri
 		digest, err := buildFunc(context.Background(), buildArgs, os.Stderr)
@@ -485,15 +671,31 @@ func buildSingleContainerImage(in *ImageActionInput, uri string, buildArgsPerCon
 
 func buildContainerImagesInParallel(in *ImageActionInput, uri string, buildArgsPerContainer map[string]*dockerengine.BuildArguments, buildFunc func(ctx context.Context, args *dockerengine.BuildArguments, w io.Writer) (string, error), out *UploadArtifactsOutput) error {
 	var digestsMu sync.Mutex
-	out.ImageDigests = make(map[string]ContainerImageIdentifier, len(buildArgsPerContainer))
 	var labeledBuffers []*syncbuffer.LabeledSyncBuffer
 	g, ctx := errgroup.WithContext(context.Background())
+	maxConcurrentBuilds := in.MaxConcurrentBuilds
+	if maxConcurrentBuilds <= 0 {
+		maxConcurrentBuilds = defaultMaxConcurrentBuilds
+	}
+	// Only the builds are bounded, the goroutines that copy their outputs and print them must always run.
+	buildSlots := make(chan struct{}, maxConcurrentBuilds)
 	cursor := cursor.New()
 	cursor.Hide()
-	for name, buildArgs := range buildArgsPerContainer {
+	// Queue the build of the main container first, then the sidecars in alphabetical order.
+	names := make([]string, 0, len(buildArgsPerContainer))
+	for name := range buildArgsPerContainer {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if names[i] == in.Name || names[j] == in.Name {
+			return names[i] == in.Name
+		}
+		return names[i] < names[j]
+	})
+	for _, name := range names {
 		// create a copy of loop variables to avoid data race.
 		name := name
-		buildArgs := buildArgs
+		buildArgs := buildArgsPerContainer[name]
 
 		buildArgs.URI = uri
 		buildArgsList, err := buildArgs.GenerateDockerBuildArgs(dockerengine.New(exec.NewCmd()))
@@ -505,6 +707,17 @@ func buildContainerImagesInParallel(in *ImageActionInput, uri string, buildArgsP
 		pr, pw := io.Pipe()
 		g.Go(func() error {
 			defer pw.Close()
+			select {
+			case buildSlots <- struct{}{}:
+			default:
+				fmt.Fprintf(pw, "Waiting for another build to complete: at most %d images are built at the same time.\n", maxConcurrentBuilds)
+				select {
+				case buildSlots <- struct{}{}:
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+			defer func() { <-buildSlots }()
 			digest, err := buildFunc(ctx, buildArgs, pw)
 			if err != nil {
 				return fmt.Errorf("build and push the image %q: %w", name, err)
@@ -532,7 +745,7 @@ func buildContainerImagesInParallel(in *ImageActionInput, uri string, buildArgsP
 		})
 	}
 	opts := []syncbuffer.LabeledTermPrinterOption{syncbuffer.WithPadding(paddingInSpacesForBuildAndPush)}
-	if os.Getenv("CI") != "true" {
+	if os.Getenv("CI") != "true" && !in.InParallel {
 		opts = append(opts, syncbuffer.WithNumLines(defaultNumLinesForBuildAndPush))
 	}
 	ltp := in.LabeledTermPrinter(os.Stderr, labeledBuffers, opts...)
@@ -555,7 +768,7 @@ func buildContainerImagesInParallel(in *ImageActionInput, uri string, buildArgsP
 	return nil
 }
 
-func buildArgsPerContainer(name, workspacePath string, img ContainerImageIdentifier, unmarshaledManifest interface{}) (map[string]*dockerengine.BuildArguments, error) {
+func buildArgsPerContainer(name, workspacePath string, img ContainerImageIdentifier, unmarshaledManifest interface{}, tagVars *manifest.ImageTagVars) (map[string]*dockerengine.BuildArguments, error) {
 	type dfArgs interface {
 		BuildArgs(rootDirectory string) (map[string]*manifest.DockerBuildArgs, error)
 		ContainerPlatform() string
@@ -574,6 +787,9 @@ func buildArgsPerContainer(name, workspacePath string, img ContainerImageIdentif
 		if img.Tag() != "" {
 			tags = append(tags, img.Tag())
 		}
+		if container == name && tagVars != nil {
+			tags = appendAdditionalImageTags(tags, unmarshaledManifest, *tagVars)
+		}
 		if container != name {
 			tags = []string{fmt.Sprintf("%s-%s", container, imageTagLatest)}
 			if img.GitShortCommitTag != "" {
@@ -591,6 +807,7 @@ func buildArgsPerContainer(name, workspacePath string, img ContainerImageIdentif
 			Context:    aws.StringValue(buildArgs.Context),
 			Args:       buildArgs.Args,
 			CacheFrom:  buildArgs.CacheFrom,
+			CacheTo:    buildArgs.CacheTo,
 			Target:     aws.StringValue(buildArgs.Target),
 			Platform:   mf.ContainerPlatform(),
 			Tags:       tags,
@@ -600,6 +817,28 @@ func buildArgsPerContainer(name, workspacePath string, img ContainerImageIdentif
 	return dArgs, nil
 }
 
+// appendAdditionalImageTags appends the tags from "image.tags" to the tags of the main container image.
+// Tags with a placeholder without value are skipped so that the image can still be pushed.
+func appendAdditionalImageTags(tags []string, mft interface{}, vars manifest.ImageTagVars) []string {
+	mf, ok := mft.(interface {
+		ImageTags() []string
+	})
+	if !ok {
+		return tags
+	}
+	for _, template := range mf.ImageTags() {
+		tag, ok := vars.ImageTag(template)
+		if !ok {
+			log.Warningf("Skipping the image tag %q because one of its placeholders has no value.\n", template)
+			continue
+		}
+		if !slices.Contains(tags, tag) {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
 func (d *workloadDeployer) uploadArtifactsToS3(out *UploadArtifactsOutput) error {
 	var err error
 	out.EnvFileARNs, err = d.pushEnvFilesToS3Bucket(&pushEnvFilesToS3BucketInput{
@@ -735,6 +974,22 @@ func envFiles(unmarshaledManifest interface{}) map[string]string {
 	return nil
 }
 
+// tracingConfig returns the content of the configuration file of the OpenTelemetry collector sidecar, if any.
+func (d *workloadDeployer) tracingConfig() (string, error) {
+	mf, ok := d.mft.(interface {
+		TracingConfigFile() string
+	})
+	if !ok || mf.TracingConfigFile() == "" {
+		return "", nil
+	}
+	path := mf.TracingConfigFile()
+	content, err := afero.ReadFile(d.fs, filepath.Join(d.workspacePath, path))
+	if err != nil {
+		return "", fmt.Errorf("read tracing config file %s: %w", path, err)
+	}
+	return string(content), nil
+}
+
 func (d *workloadDeployer) pushAddonsTemplateToS3Bucket() (string, error) {
 	if d.addons == nil {
 		return "", nil
@@ -773,17 +1028,34 @@ func (d *workloadDeployer) runtimeConfig(in *StackRuntimeConfiguration) (*stack.
 	if err != nil {
 		return nil, fmt.Errorf("get version of environment %q: %w", d.env.Name, err)
 	}
+	var envKMSKeyARN string
+	var envDeniesIntraEnvTraffic bool
+	var envConnectNamespace string
+	if d.envConfig != nil {
+		envKMSKeyARN = d.envConfig.KMSKeyARN()
+		envDeniesIntraEnvTraffic = d.envConfig.DeniesIntraEnvTraffic()
+		envConnectNamespace = d.envConfig.ServiceConnectNamespace()
+	}
+	tracingConfig, err := d.tracingConfig()
+	if err != nil {
+		return nil, err
+	}
 	if len(in.ImageDigests) == 0 {
 		return &stack.RuntimeConfig{
 			AddonsTemplateURL:        in.AddonsURL,
 			EnvFileARNs:              in.EnvFileARNs,
+			TracingConfig:            tracingConfig,
 			AdditionalTags:           in.Tags,
 			ServiceDiscoveryEndpoint: endpoint,
 			AccountID:                d.env.AccountID,
 			Region:                   d.env.Region,
 			CustomResourcesURL:       in.CustomResourceURLs,
 			EnvVersion:               envVersion,
+			EnvKMSKeyARN:             envKMSKeyARN,
+			EnvDeniesIntraEnvTraffic: envDeniesIntraEnvTraffic,
+			EnvConnectNamespace:      envConnectNamespace,
 			Version:                  in.Version,
+			DeployValues:             in.DeployValues,
 		}, nil
 	}
 	images := make(map[string]stack.ECRImage, len(in.ImageDigests))
@@ -795,8 +1067,13 @@ func (d *workloadDeployer) runtimeConfig(in *StackRuntimeConfiguration) (*stack.
 		if container != d.name {
 			imageTag = img.GitShortCommitTag
 		}
+		repoURL := d.resources.RepositoryURLs[d.name]
+		if img.RepoURL != "" {
+			// The image was built by another workload, so refer to it by digest in that workload's repository.
+			repoURL, imageTag = img.RepoURL, ""
+		}
 		images[container] = stack.ECRImage{
-			RepoURL:           d.resources.RepositoryURLs[d.name],
+			RepoURL:           repoURL,
 			ImageTag:          imageTag,
 			Digest:            img.Digest,
 			MainContainerName: d.name,
@@ -806,6 +1083,7 @@ func (d *workloadDeployer) runtimeConfig(in *StackRuntimeConfiguration) (*stack.
 	return &stack.RuntimeConfig{
 		AddonsTemplateURL:        in.AddonsURL,
 		EnvFileARNs:              in.EnvFileARNs,
+		TracingConfig:            tracingConfig,
 		AdditionalTags:           in.Tags,
 		PushedImages:             images,
 		ServiceDiscoveryEndpoint: endpoint,
@@ -813,7 +1091,11 @@ func (d *workloadDeployer) runtimeConfig(in *StackRuntimeConfiguration) (*stack.
 		Region:                   d.env.Region,
 		CustomResourcesURL:       in.CustomResourceURLs,
 		EnvVersion:               envVersion,
+		EnvKMSKeyARN:             envKMSKeyARN,
+		EnvDeniesIntraEnvTraffic: envDeniesIntraEnvTraffic,
+		EnvConnectNamespace:      envConnectNamespace,
 		Version:                  in.Version,
+		DeployValues:             in.DeployValues,
 	}, nil
 }
 
@@ -5,11 +5,13 @@ package deploy
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io"
 	"path/filepath"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -117,6 +119,7 @@ type mockWorkloadMft struct {
 	dockerBuildArgs map[string]*manifest.DockerBuildArgs
 	workloadName    string
 	customEnvFiles  map[string]string
+	imageTags       []string
 }
 
 func (m *mockWorkloadMft) EnvFiles() map[string]string {
@@ -132,6 +135,10 @@ func (m *mockWorkloadMft) BuildArgs(rootDirectory string) (map[string]*manifest.
 	return m.dockerBuildArgs, nil
 }
 
+func (m *mockWorkloadMft) ImageTags() []string {
+	return m.imageTags
+}
+
 func (m *mockWorkloadMft) ContainerPlatform() string {
 	return "mockContainerPlatform"
 }
@@ -193,7 +200,12 @@ func TestWorkloadDeployer_UploadArtifacts(t *testing.T) {
 		inRegion          string
 		inMockUserTag     string
 		inMockGitTag      string
+		inMockGitBranch   string
+		inImageTags       []string
 		inDockerBuildArgs map[string]*manifest.DockerBuildArgs
+		inBuiltImages     *BuiltImages
+		inParallel        bool
+		inRegistryCache   bool
 
 		mock                func(t *testing.T, m *deployMocks)
 		mockServiceDeployer func(deployer *workloadDeployer) artifactsUploader
@@ -280,6 +292,127 @@ func TestWorkloadDeployer_UploadArtifacts(t *testing.T) {
 				},
 			},
 		},
+		"build and push image with the build cache in the image repository": {
+			inMockUserTag:   "v1.0",
+			inRegistryCache: true,
+			inDockerBuildArgs: map[string]*manifest.DockerBuildArgs{
+				"mockWkld": {
+					Dockerfile: aws.String("mockDockerfile"),
+					Context:    aws.String("mockContext"),
+					CacheFrom:  []string{"type=local,src=/tmp/cache"},
+				},
+			},
+			mock: func(t *testing.T, m *deployMocks) {
+				m.mockdockerEngineRunChecker.EXPECT().CheckDockerEngineRunning().Return(nil)
+				m.mockRepositoryService.EXPECT().Login().Return(mockURI, nil)
+				m.mockRepositoryService.EXPECT().BuildAndPush(gomock.Any(), &dockerengine.BuildArguments{
+					URI:        mockURI,
+					Dockerfile: "mockDockerfile",
+					Context:    "mockContext",
+					Platform:   "mockContainerPlatform",
+					Tags:       []string{"latest", "v1.0"},
+					CacheFrom:  []string{"type=local,src=/tmp/cache", "type=registry,ref=mockRepoURI:cache-mockWkld"},
+					CacheTo:    []string{"type=registry,ref=mockRepoURI:cache-mockWkld,mode=max"},
+					Labels: map[string]string{
+						"com.aws.copilot.image.builder":        "copilot-cli",
+						"com.aws.copilot.image.container.name": "mockWkld",
+					},
+				}, gomock.Any()).Return("mockDigest", nil)
+				m.mockAddons = nil
+			},
+			wantImages: map[string]ContainerImageIdentifier{
+				mockName: {
+					Digest:    "mockDigest",
+					CustomTag: "v1.0",
+					RepoTags: []string{
+						"mockRepoURI:latest",
+						"mockRepoURI:v1.0",
+					},
+				},
+			},
+		},
+		"print the output of a single image once built when deploying in parallel": {
+			inMockUserTag: "v1.0",
+			inMockGitTag:  "gitTag",
+			inParallel:    true,
+			inDockerBuildArgs: map[string]*manifest.DockerBuildArgs{
+				"mockWkld": {
+					Dockerfile: aws.String("mockDockerfile"),
+					Context:    aws.String("mockContext"),
+				},
+			},
+			mock: func(t *testing.T, m *deployMocks) {
+				m.mockdockerEngineRunChecker.EXPECT().CheckDockerEngineRunning().Return(nil)
+				m.mockRepositoryService.EXPECT().Login().Return(mockURI, nil)
+				m.mockRepositoryService.EXPECT().BuildAndPush(gomock.Any(), &dockerengine.BuildArguments{
+					URI:        mockURI,
+					Dockerfile: "mockDockerfile",
+					Context:    "mockContext",
+					Platform:   "mockContainerPlatform",
+					Tags:       []string{"latest", "v1.0"},
+					Labels: map[string]string{
+						"com.aws.copilot.image.builder":        "copilot-cli",
+						"com.aws.copilot.image.container.name": "mockWkld",
+					},
+				}, gomock.Any()).Return("mockDigest", nil)
+				m.mockLabeledTermPrinter.EXPECT().IsDone().Return(true).AnyTimes()
+				m.mockLabeledTermPrinter.EXPECT().Print().AnyTimes()
+				m.mockAddons = nil
+			},
+			wantImages: map[string]ContainerImageIdentifier{
+				mockName: {
+					Digest:            "mockDigest",
+					CustomTag:         "v1.0",
+					GitShortCommitTag: "gitTag",
+					RepoTags: []string{
+						"mockRepoURI:latest",
+						"mockRepoURI:v1.0",
+					},
+				},
+			},
+		},
+		"build and push image with additional tags successfully": {
+			inMockUserTag:   "v1.0",
+			inMockGitTag:    "gitTag",
+			inMockGitBranch: "feature/x",
+			inImageTags:     []string{"latest", "${env}-${tag}", "${git_branch}", "${git_commit}"},
+			inDockerBuildArgs: map[string]*manifest.DockerBuildArgs{
+				"mockWkld": {
+					Dockerfile: aws.String("mockDockerfile"),
+					Context:    aws.String("mockContext"),
+				},
+			},
+			mock: func(t *testing.T, m *deployMocks) {
+				m.mockdockerEngineRunChecker.EXPECT().CheckDockerEngineRunning().Return(nil)
+				m.mockRepositoryService.EXPECT().Login().Return(mockURI, nil)
+				m.mockRepositoryService.EXPECT().BuildAndPush(gomock.Any(), &dockerengine.BuildArguments{
+					URI:        mockURI,
+					Dockerfile: "mockDockerfile",
+					Context:    "mockContext",
+					Platform:   "mockContainerPlatform",
+					Tags:       []string{"latest", "v1.0", "test-v1.0", "feature-x", "gitTag"},
+					Labels: map[string]string{
+						"com.aws.copilot.image.builder":        "copilot-cli",
+						"com.aws.copilot.image.container.name": "mockWkld",
+					},
+				}, gomock.Any()).Return("mockDigest", nil)
+				m.mockAddons = nil
+			},
+			wantImages: map[string]ContainerImageIdentifier{
+				mockName: {
+					Digest:            "mockDigest",
+					CustomTag:         "v1.0",
+					GitShortCommitTag: "gitTag",
+					RepoTags: []string{
+						"mockRepoURI:feature-x",
+						"mockRepoURI:gitTag",
+						"mockRepoURI:latest",
+						"mockRepoURI:test-v1.0",
+						"mockRepoURI:v1.0",
+					},
+				},
+			},
+		},
 		"build and push image with gitshortcommit successfully": {
 			inMockGitTag: "gitTag",
 			inDockerBuildArgs: map[string]*manifest.DockerBuildArgs{
@@ -315,6 +448,62 @@ func TestWorkloadDeployer_UploadArtifacts(t *testing.T) {
 				},
 			},
 		},
+		"reuse the sidecar image built by another workload": {
+			inDockerBuildArgs: map[string]*manifest.DockerBuildArgs{
+				"nginx": {
+					Dockerfile: aws.String("sidecarMockDockerfile"),
+					Context:    aws.String("sidecarMockContext"),
+				},
+				"logging": {
+					Dockerfile: aws.String("web/Dockerfile"),
+					Context:    aws.String("Users/bowie"),
+				},
+			},
+			inBuiltImages: func() *BuiltImages {
+				images := NewBuiltImages()
+				images.add(&dockerengine.BuildArguments{
+					Dockerfile: "web/Dockerfile",
+					Context:    "Users/bowie/",
+					Platform:   "mockContainerPlatform",
+				}, builtImage{
+					workload: "web",
+					repoURL:  "webRepoURI",
+					digest:   "webDigest",
+				})
+				return images
+			}(),
+			inMockGitTag: "gitTag",
+			mock: func(t *testing.T, m *deployMocks) {
+				m.mockdockerEngineRunChecker.EXPECT().CheckDockerEngineRunning().Return(nil)
+				m.mockRepositoryService.EXPECT().Login().Return(mockURI, nil)
+				m.mockRepositoryService.EXPECT().BuildAndPush(gomock.Any(), &dockerengine.BuildArguments{
+					URI:        mockURI,
+					Dockerfile: "sidecarMockDockerfile",
+					Context:    "sidecarMockContext",
+					Platform:   "mockContainerPlatform",
+					Tags:       []string{"nginx-latest", "nginx-gitTag"},
+					Labels: map[string]string{
+						"com.aws.copilot.image.builder":        "copilot-cli",
+						"com.aws.copilot.image.container.name": "nginx",
+					},
+				}, gomock.Any()).Return("sidecarMockDigest1", nil)
+				m.mockAddons = nil
+			},
+			wantImages: map[string]ContainerImageIdentifier{
+				"nginx": {
+					Digest:            "sidecarMockDigest1",
+					GitShortCommitTag: "gitTag",
+					RepoTags: []string{
+						"mockRepoURI:nginx-gitTag",
+						"mockRepoURI:nginx-latest",
+					},
+				},
+				"logging": {
+					Digest:  "webDigest",
+					RepoURL: "webRepoURI",
+				},
+			},
+		},
 		"build and push sidecar container images only with git tag Successfully": {
 			inDockerBuildArgs: map[string]*manifest.DockerBuildArgs{
 				"nginx": {
@@ -667,13 +856,18 @@ func TestWorkloadDeployer_UploadArtifacts(t *testing.T) {
 				image: ContainerImageIdentifier{
 					CustomTag:         tc.inMockUserTag,
 					GitShortCommitTag: tc.inMockGitTag,
+					GitBranch:         tc.inMockGitBranch,
 				},
+				builtImages:   tc.inBuiltImages,
+				inParallel:    tc.inParallel,
+				registryCache: tc.inRegistryCache,
 				workspacePath: mockWorkspacePath,
 				mft: &mockWorkloadMft{
 					workloadName:    mockName,
 					fileName:        tc.inEnvFile,
 					customEnvFiles:  tc.customEnvFiles,
 					dockerBuildArgs: tc.inDockerBuildArgs,
+					imageTags:       tc.inImageTags,
 				},
 				fs:              m.mockFileSystem,
 				s3Client:        m.mockUploader,
@@ -750,6 +944,8 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 		inForceDeploy     bool
 		inDisableRollback bool
 		inRedirectToHTTPS *bool
+		inMutualTLS       *bool
+		inCDN             manifest.Union[*bool, manifest.ServiceCDNConfig]
 
 		// Cached variables.
 		inEnvironmentConfig func() *manifest.Environment
@@ -811,6 +1007,24 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 			},
 			wantErr: fmt.Errorf(`validate ALB runtime configuration for "http": cannot configure http to https redirect without having a domain associated with the app "mockApp" or importing any certificates in env "mockEnv"`),
 		},
+		"fail if mutual tls is required but not configured on the public load balancer": {
+			inMutualTLS: aws.Bool(true),
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inEnvironmentConfig: func() *manifest.Environment {
+				return &manifest.Environment{}
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mock: func(m *deployMocks) {
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
+			},
+			wantErr: fmt.Errorf(`"http.mutual_tls" requires "http.public.mutual_tls" to be configured in the manifest of environment mockEnv`),
+		},
 		"cannot specify alias hosted zone when no certificates are imported in the env": {
 			inEnvironment: &config.Environment{
 				Name:   mockEnvName,
@@ -1082,6 +1296,81 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 			},
 			wantErr: fmt.Errorf(`validate 'nlb.alias': alias "v1.v2.mockDomain" is not supported in hosted zones managed by Copilot`),
 		},
+		"fail to enable cdn when the environment already has one": {
+			inCDN: manifest.BasicToUnion[*bool, manifest.ServiceCDNConfig](aws.Bool(true)),
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inEnvironmentConfig: func() *manifest.Environment {
+				envConfig := &manifest.Environment{}
+				envConfig.CDNConfig.Enabled = aws.Bool(true)
+				return envConfig
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mock: func(m *deployMocks) {
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
+			},
+			wantErr: fmt.Errorf(`cannot enable "http.cdn" when env mockEnv already places a CloudFront distribution in front of its load balancer`),
+		},
+		"fail to validate cdn aliases against the cdn certificate": {
+			inCDN: manifest.AdvancedToUnion[*bool](manifest.ServiceCDNConfig{
+				Aliases:     []string{"cdn.example.com"},
+				Certificate: aws.String(mockCDNCertARN),
+			}),
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mock: func(m *deployMocks) {
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
+				m.mockValidator.EXPECT().ValidateCertAliases([]string{"cdn.example.com"}, []string{mockCDNCertARN}).Return(mockError)
+			},
+			wantErr: fmt.Errorf(`validate "http.cdn.aliases" against the certificate mockCDNCertARN: some error`),
+		},
+		"fail to enable cdn aliases when app is not associated with a domain": {
+			inCDN: manifest.AdvancedToUnion[*bool](manifest.ServiceCDNConfig{
+				Aliases: []string{"cdn.example.com"},
+			}),
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mock: func(m *deployMocks) {
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
+			},
+			wantErr: fmt.Errorf(`cannot specify "http.cdn.aliases" when application is not associated with a domain or "http.cdn.certificate" is not set`),
+		},
+		"fail to enable cdn aliases because of invalid alias": {
+			inCDN: manifest.AdvancedToUnion[*bool](manifest.ServiceCDNConfig{
+				Aliases: []string{"v1.v2.mockDomain"},
+			}),
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name:   mockAppName,
+				Domain: "mockDomain",
+			},
+			mock: func(m *deployMocks) {
+				m.mockAppVersionGetter.EXPECT().Version().Return("v1.2.0", nil)
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
+			},
+			wantErr: fmt.Errorf(`validate "http.cdn.aliases": alias "v1.v2.mockDomain" is not supported in hosted zones managed by Copilot`),
+		},
 		"error if fail to deploy service": {
 			inEnvironment: &config.Environment{
 				Name:   mockEnvName,
@@ -1397,6 +1686,8 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 						},
 						HTTPOrBool: manifest.HTTPOrBool{
 							HTTP: manifest.HTTP{
+								MutualTLS: tc.inMutualTLS,
+								CDN:       tc.inCDN,
 								Main: manifest.RoutingRule{
 									Path:            aws.String("/"),
 									Alias:           tc.inAliases,
@@ -1459,6 +1750,36 @@ func TestUploadArtifacts(t *testing.T) {
 
 }
 
+func TestBuiltImages(t *testing.T) {
+	images := NewBuiltImages()
+	images.add(&dockerengine.BuildArguments{
+		URI:        "apiRepoURI",
+		Dockerfile: "api/Dockerfile",
+		Context:    "api",
+		Args:       map[string]string{"GO_VERSION": "1.21"},
+		Tags:       []string{"latest"},
+		Labels:     map[string]string{"com.aws.copilot.image.container.name": "api"},
+	}, builtImage{workload: "api", repoURL: "apiRepoURI", digest: "sha256:api"})
+
+	got, ok := images.get(&dockerengine.BuildArguments{
+		URI:        "workerRepoURI",
+		Dockerfile: "./api/Dockerfile",
+		Context:    "api/",
+		Args:       map[string]string{"GO_VERSION": "1.21"},
+		Tags:       []string{"sidecar-latest"},
+		Labels:     map[string]string{"com.aws.copilot.image.container.name": "sidecar"},
+	})
+	require.True(t, ok)
+	require.Equal(t, builtImage{workload: "api", repoURL: "apiRepoURI", digest: "sha256:api"}, got)
+
+	_, ok = images.get(&dockerengine.BuildArguments{
+		Dockerfile: "api/Dockerfile",
+		Context:    "api",
+		Args:       map[string]string{"GO_VERSION": "1.22"},
+	})
+	require.False(t, ok, "images built with different arguments must not be reused")
+}
+
 type deployDiffMocks struct {
 	mockDeployedTmplGetter *mocks.MockdeployedTemplateGetter
 }
@@ -1541,3 +1862,182 @@ func TestWorkloadDeployer_DeployDiff(t *testing.T) {
 		})
 	}
 }
+
+func TestWorkloadDeployer_tracingConfig(t *testing.T) {
+	backendWithTracing := func(tracing manifest.Union[*string, manifest.TracingConfig]) *manifest.BackendService {
+		mft := &manifest.BackendService{}
+		mft.Observability.Tracing = tracing
+		return mft
+	}
+	testCases := map[string]struct {
+		inMft   interface{}
+		inFiles map[string]string
+
+		wanted    string
+		wantedErr error
+	}{
+		"no configuration for a manifest without tracing": {
+			inMft: &manifest.ScheduledJob{},
+		},
+		"no configuration for the default collector configuration": {
+			inMft: backendWithTracing(manifest.BasicToUnion[*string, manifest.TracingConfig](aws.String("otel"))),
+		},
+		"error if the configuration file cannot be read": {
+			inMft: backendWithTracing(manifest.AdvancedToUnion[*string](manifest.TracingConfig{
+				Vendor:     aws.String("otel"),
+				ConfigFile: aws.String("otel/missing.yml"),
+			})),
+			wantedErr: errors.New("read tracing config file otel/missing.yml: open /ws/otel/missing.yml: file does not exist"),
+		},
+		"reads the configuration file relative to the workspace": {
+			inMft: backendWithTracing(manifest.AdvancedToUnion[*string](manifest.TracingConfig{
+				Vendor:     aws.String("otel"),
+				ConfigFile: aws.String("otel/config.yml"),
+			})),
+			inFiles: map[string]string{
+				"/ws/otel/config.yml": "receivers:\n  otlp:\n",
+			},
+			wanted: "receivers:\n  otlp:\n",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			for path, content := range tc.inFiles {
+				require.NoError(t, afero.WriteFile(fs, path, []byte(content), 0644))
+			}
+			deployer := &workloadDeployer{
+				mft:           tc.inMft,
+				fs:            fs,
+				workspacePath: "/ws",
+			}
+
+			got, err := deployer.tracingConfig()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestWorkloadDeployer_updateImageLifecycle(t *testing.T) {
+	backendWithLifecycle := func(lifecycle manifest.ImageLifecycle) *manifest.BackendService {
+		mft := &manifest.BackendService{}
+		mft.ImageConfig.Image.Lifecycle = lifecycle
+		return mft
+	}
+	mockApp := &config.Application{Name: "phonetool"}
+	testCases := map[string]struct {
+		inMft interface{}
+		mock  func(m *mocks.MockimageLifecycleUpdater)
+
+		wantedErr error
+	}{
+		"skip manifests without an image": {
+			inMft: &manifest.StaticSite{},
+			mock:  func(m *mocks.MockimageLifecycleUpdater) {},
+		},
+		"fall back to the lifecycle of the application if the manifest has none": {
+			inMft: backendWithLifecycle(manifest.ImageLifecycle{}),
+			mock: func(m *mocks.MockimageLifecycleUpdater) {
+				m.EXPECT().UpdateWorkloadImageLifecycle(mockApp, "api", nil).Return(nil)
+			},
+		},
+		"apply the lifecycle of the manifest": {
+			inMft: backendWithLifecycle(manifest.ImageLifecycle{
+				KeepImages: aws.Int(5),
+			}),
+			mock: func(m *mocks.MockimageLifecycleUpdater) {
+				m.EXPECT().UpdateWorkloadImageLifecycle(mockApp, "api", &config.ImageLifecycle{
+					KeepLastImages: 5,
+				}).Return(nil)
+			},
+		},
+		"wrap the error from updating the lifecycle": {
+			inMft: backendWithLifecycle(manifest.ImageLifecycle{
+				ExpireUntaggedDays: aws.Int(7),
+			}),
+			mock: func(m *mocks.MockimageLifecycleUpdater) {
+				m.EXPECT().UpdateWorkloadImageLifecycle(mockApp, "api", &config.ImageLifecycle{
+					ExpireUntaggedAfterDays: 7,
+				}).Return(errors.New("some error"))
+			},
+			wantedErr: errors.New("update the image lifecycle of api: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			updater := mocks.NewMockimageLifecycleUpdater(ctrl)
+			tc.mock(updater)
+			deployer := &workloadDeployer{
+				name:             "api",
+				app:              mockApp,
+				mft:              tc.inMft,
+				lifecycleUpdater: updater,
+			}
+
+			err := deployer.updateImageLifecycle(&UploadArtifactsOutput{})
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestBuildContainerImagesInParallel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	printer := mocks.NewMockLabeledTermPrinter(ctrl)
+	printer.EXPECT().IsDone().Return(true).AnyTimes()
+	printer.EXPECT().Print().AnyTimes()
+
+	var mu sync.Mutex
+	var running, maxRunning int
+	var started []string
+	buildFunc := func(ctx context.Context, args *dockerengine.BuildArguments, w io.Writer) (string, error) {
+		mu.Lock()
+		started = append(started, args.Labels[labelForContainerName])
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		mu.Unlock()
+		time.Sleep(10 * time.Millisecond)
+		mu.Lock()
+		running--
+		mu.Unlock()
+		return "sha256:" + args.Labels[labelForContainerName], nil
+	}
+	buildArgs := make(map[string]*dockerengine.BuildArguments)
+	for _, name := range []string{"sidecar-b", "main", "sidecar-a", "sidecar-c"} {
+		buildArgs[name] = &dockerengine.BuildArguments{
+			Dockerfile: "Dockerfile",
+			Tags:       []string{"latest"},
+			Labels:     map[string]string{labelForContainerName: name},
+		}
+	}
+	out := &UploadArtifactsOutput{ImageDigests: make(map[string]ContainerImageIdentifier)}
+
+	err := buildContainerImagesInParallel(&ImageActionInput{
+		Name:                "main",
+		MaxConcurrentBuilds: 2,
+		LabeledTermPrinter: func(fw syncbuffer.FileWriter, bufs []*syncbuffer.LabeledSyncBuffer, opts ...syncbuffer.LabeledTermPrinterOption) LabeledTermPrinter {
+			return printer
+		},
+	}, "uri", buildArgs, buildFunc, out)
+
+	require.NoError(t, err)
+	require.LessOrEqual(t, maxRunning, 2)
+	require.Len(t, out.ImageDigests, 4)
+	require.Equal(t, "sha256:sidecar-c", out.ImageDigests["sidecar-c"].Digest)
+	require.ElementsMatch(t, []string{"main", "sidecar-a", "sidecar-b", "sidecar-c"}, started)
+}
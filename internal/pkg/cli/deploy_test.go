@@ -581,7 +581,8 @@ type: Worker Service`)
 				m.EXPECT().GetWorkload("app", "be").Return(&mockBeWl, nil)
 			},
 			mockWs: func(m *mocks.MockwsWlDirReader) {
-				m.EXPECT().ReadWorkloadManifest("fe").Times(0)
+				m.EXPECT().ReadWorkloadManifest("fe").Return(workspace.WorkloadManifest(`name: fe`), nil)
+				m.EXPECT().ReadWorkloadManifest("be").Return(workspace.WorkloadManifest(`name: be`), nil)
 				m.EXPECT().ListEnvironments().Return([]string{"test"}, nil)
 				m.EXPECT().ListWorkloads().Return([]string{"fe", "be"}, nil)
 			},
@@ -669,6 +670,8 @@ type: Worker Service`)
 			mockWs: func(m *mocks.MockwsWlDirReader) {
 				m.EXPECT().ListWorkloads().Return([]string{"fe", "be", "worker"}, nil)
 				m.EXPECT().ListEnvironments().Return([]string{"test"}, nil)
+				m.EXPECT().ReadWorkloadManifest("be").Return(workspace.WorkloadManifest(`name: be`), nil)
+				m.EXPECT().ReadWorkloadManifest("worker").Return(workspace.WorkloadManifest(`name: worker`), nil)
 			},
 			mockPrompt: func(m *mocks.Mockprompter) {
 				m.EXPECT().MultiSelect(
@@ -1140,6 +1143,11 @@ func Test_deployOpts_getDeploymentOrder(t *testing.T) {
 		Name: "worker",
 		Type: "Worker Service",
 	}
+	mockNoDependencies := func(m *mocks.MockwsWlDirReader, names ...string) {
+		for _, name := range names {
+			m.EXPECT().ReadWorkloadManifest(name).Return(workspace.WorkloadManifest(`name: `+name), nil)
+		}
+	}
 	tests := map[string]struct {
 		inWorkloadNames []string
 		inInitWkld      bool
@@ -1155,6 +1163,7 @@ func Test_deployOpts_getDeploymentOrder(t *testing.T) {
 			want:            [][]string{{"be", "db", "fe"}},
 			mockWs: func(m *mocks.MockwsWlDirReader) {
 				m.EXPECT().ListWorkloads().Return([]string{"be", "db", "fe"}, nil)
+				mockNoDependencies(m, "be", "db", "fe")
 			},
 			mockStore: func(m *mocks.Mockstore) {
 				m.EXPECT().ListWorkloads("app").Return([]*config.Workload{
@@ -1170,6 +1179,7 @@ func Test_deployOpts_getDeploymentOrder(t *testing.T) {
 			want:            [][]string{{"fe"}, {"be", "db"}, {"worker"}},
 			mockWs: func(m *mocks.MockwsWlDirReader) {
 				m.EXPECT().ListWorkloads().Return([]string{"be", "db", "fe", "worker"}, nil)
+				mockNoDependencies(m, "be", "db", "fe", "worker")
 			},
 			mockStore: func(m *mocks.Mockstore) {
 				m.EXPECT().ListWorkloads("app").Return([]*config.Workload{
@@ -1186,6 +1196,7 @@ func Test_deployOpts_getDeploymentOrder(t *testing.T) {
 			want:            [][]string{{"fe"}, {"be"}, {"db"}, {"worker"}},
 			mockWs: func(m *mocks.MockwsWlDirReader) {
 				m.EXPECT().ListWorkloads().Return([]string{"be", "db", "fe", "worker"}, nil)
+				mockNoDependencies(m, "be", "db", "fe", "worker")
 			},
 			mockStore: func(m *mocks.Mockstore) {
 				m.EXPECT().ListWorkloads("app").Return([]*config.Workload{
@@ -1202,6 +1213,7 @@ func Test_deployOpts_getDeploymentOrder(t *testing.T) {
 			want:            [][]string{{"be"}, {"db"}, {"fe", "worker"}},
 			mockWs: func(m *mocks.MockwsWlDirReader) {
 				m.EXPECT().ListWorkloads().Return([]string{"be", "db", "fe", "worker"}, nil)
+				mockNoDependencies(m, "be", "db", "fe", "worker")
 			},
 			mockStore: func(m *mocks.Mockstore) {
 				m.EXPECT().ListWorkloads("app").Return([]*config.Workload{
@@ -1218,6 +1230,7 @@ func Test_deployOpts_getDeploymentOrder(t *testing.T) {
 			want:            [][]string{{"be"}, {"db"}, {"fe"}},
 			mockWs: func(m *mocks.MockwsWlDirReader) {
 				m.EXPECT().ListWorkloads().Return([]string{"be", "db", "fe", "worker"}, nil)
+				mockNoDependencies(m, "be", "db", "fe")
 			},
 			mockStore: func(m *mocks.Mockstore) {
 				m.EXPECT().ListWorkloads("app").Return([]*config.Workload{
@@ -1231,8 +1244,10 @@ func Test_deployOpts_getDeploymentOrder(t *testing.T) {
 			inWorkloadNames: []string{"be/2", "db/3"},
 			inDeployAll:     false,
 			want:            [][]string{{"be"}, {"db"}},
-			mockWs:          func(m *mocks.MockwsWlDirReader) {},
-			mockStore:       func(m *mocks.Mockstore) {},
+			mockWs: func(m *mocks.MockwsWlDirReader) {
+				mockNoDependencies(m, "be", "db")
+			},
+			mockStore: func(m *mocks.Mockstore) {},
 		},
 		"order tags, --all true, initWkld false": {
 			inWorkloadNames: []string{"fe/2", "be/1"},
@@ -1241,6 +1256,7 @@ func Test_deployOpts_getDeploymentOrder(t *testing.T) {
 			want:            [][]string{{"be"}, {"fe"}, {"db"}},
 			mockWs: func(m *mocks.MockwsWlDirReader) {
 				m.EXPECT().ListWorkloads().Return([]string{"be", "db", "fe", "worker"}, nil)
+				mockNoDependencies(m, "be", "db", "fe")
 			},
 			mockStore: func(m *mocks.Mockstore) {
 				m.EXPECT().ListWorkloads("app").Return([]*config.Workload{
@@ -1262,6 +1278,92 @@ func Test_deployOpts_getDeploymentOrder(t *testing.T) {
 				}, nil)
 			},
 		},
+		"single workload without reading its dependencies": {
+			inWorkloadNames: []string{"fe"},
+			want:            [][]string{{"fe"}},
+			mockWs:          func(m *mocks.MockwsWlDirReader) {},
+			mockStore:       func(m *mocks.Mockstore) {},
+		},
+		"deploys workloads after the workloads they depend on": {
+			inWorkloadNames: []string{"fe", "be", "db", "worker"},
+			want:            [][]string{{"db", "worker"}, {"be"}, {"fe"}},
+			mockWs: func(m *mocks.MockwsWlDirReader) {
+				m.EXPECT().ReadWorkloadManifest("fe").Return(workspace.WorkloadManifest(`name: fe
+deploy:
+  depends_on: [be, db]`), nil)
+				m.EXPECT().ReadWorkloadManifest("be").Return(workspace.WorkloadManifest(`name: be
+deploy:
+  depends_on: [db]`), nil)
+				mockNoDependencies(m, "db", "worker")
+			},
+			mockStore: func(m *mocks.Mockstore) {},
+		},
+		"ignores dependencies that are not part of the deployment": {
+			inWorkloadNames: []string{"fe", "worker"},
+			want:            [][]string{{"fe", "worker"}},
+			mockWs: func(m *mocks.MockwsWlDirReader) {
+				m.EXPECT().ReadWorkloadManifest("fe").Return(workspace.WorkloadManifest(`name: fe
+deploy:
+  depends_on: [be]`), nil)
+				mockNoDependencies(m, "worker")
+			},
+			mockStore: func(m *mocks.Mockstore) {},
+		},
+		"dependencies in an earlier priority group are satisfied": {
+			inWorkloadNames: []string{"fe/2", "worker/2", "be/1"},
+			want:            [][]string{{"be"}, {"fe", "worker"}},
+			mockWs: func(m *mocks.MockwsWlDirReader) {
+				m.EXPECT().ReadWorkloadManifest("fe").Return(workspace.WorkloadManifest(`name: fe
+deploy:
+  depends_on: [be]`), nil)
+				mockNoDependencies(m, "be", "worker")
+			},
+			mockStore: func(m *mocks.Mockstore) {},
+		},
+		"error if a workload depends on a workload with a later priority": {
+			inWorkloadNames: []string{"fe/1", "be/2"},
+			wantErr:         "workload fe depends on be, which is ordered to deploy after it",
+			mockWs: func(m *mocks.MockwsWlDirReader) {
+				m.EXPECT().ReadWorkloadManifest("fe").Return(workspace.WorkloadManifest(`name: fe
+deploy:
+  depends_on: [be]`), nil)
+			},
+			mockStore: func(m *mocks.Mockstore) {},
+		},
+		"error if a workload depends on itself": {
+			inWorkloadNames: []string{"fe", "be"},
+			wantErr:         "workload fe cannot depend on itself",
+			mockWs: func(m *mocks.MockwsWlDirReader) {
+				m.EXPECT().ReadWorkloadManifest("fe").Return(workspace.WorkloadManifest(`name: fe
+deploy:
+  depends_on: [fe]`), nil)
+				mockNoDependencies(m, "be")
+			},
+			mockStore: func(m *mocks.Mockstore) {},
+		},
+		"error if workloads depend on each other in a cycle": {
+			inWorkloadNames: []string{"fe", "be", "db"},
+			wantErr:         "deploy dependencies of workloads be and fe form a cycle",
+			mockWs: func(m *mocks.MockwsWlDirReader) {
+				m.EXPECT().ReadWorkloadManifest("fe").Return(workspace.WorkloadManifest(`name: fe
+deploy:
+  depends_on: [be]`), nil)
+				m.EXPECT().ReadWorkloadManifest("be").Return(workspace.WorkloadManifest(`name: be
+deploy:
+  depends_on: [fe, db]`), nil)
+				mockNoDependencies(m, "db")
+			},
+			mockStore: func(m *mocks.Mockstore) {},
+		},
+		"error if fail to read a manifest": {
+			inWorkloadNames: []string{"fe", "be"},
+			wantErr:         "read manifest for workload fe: some error",
+			mockWs: func(m *mocks.MockwsWlDirReader) {
+				m.EXPECT().ReadWorkloadManifest("fe").Return(nil, errors.New("some error"))
+				mockNoDependencies(m, "be")
+			},
+			mockStore: func(m *mocks.Mockstore) {},
+		},
 		"no workloads, empty groupsMap": {
 			inWorkloadNames: []string{},
 			wantErr:         "generate deployment groups: no workloads were specified",
@@ -1301,3 +1403,60 @@ func Test_deployOpts_getDeploymentOrder(t *testing.T) {
 		})
 	}
 }
+
+func Test_deployOpts_executeInParallel(t *testing.T) {
+	tests := map[string]struct {
+		mockCmds func(fe, be *mocks.MockactionCommand)
+		wantErr  string
+	}{
+		"recommends actions once every workload is deployed": {
+			mockCmds: func(fe, be *mocks.MockactionCommand) {
+				fe.EXPECT().Execute().Return(nil)
+				be.EXPECT().Execute().Return(nil)
+				gomock.InOrder(
+					fe.EXPECT().RecommendActions().Return(nil),
+					be.EXPECT().RecommendActions().Return(nil),
+				)
+			},
+		},
+		"skips recommending actions for workloads with no changes": {
+			mockCmds: func(fe, be *mocks.MockactionCommand) {
+				fe.EXPECT().Execute().Return(&errNoInfrastructureChanges{parentErr: errors.New("no changes")})
+				be.EXPECT().Execute().Return(nil)
+				be.EXPECT().RecommendActions().Return(nil)
+			},
+		},
+		"completes the other deployments if one fails": {
+			mockCmds: func(fe, be *mocks.MockactionCommand) {
+				fe.EXPECT().Execute().Return(nil)
+				be.EXPECT().Execute().Return(errors.New("some error"))
+				fe.EXPECT().RecommendActions().Return(nil)
+			},
+			wantErr: "execute deployment of be: some error",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			fe := mocks.NewMockactionCommand(ctrl)
+			be := mocks.NewMockactionCommand(ctrl)
+			tt.mockCmds(fe, be)
+			o := &deployOpts{
+				deployVars: deployVars{
+					maxParallel: 2,
+				},
+			}
+
+			err := o.executeInParallel([]workloadCommand{
+				{name: "fe", actionCommand: fe},
+				{name: "be", actionCommand: be},
+			})
+
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	awscfn "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+)
+
+// Sources to discover applications, environments and workloads from.
+const (
+	discoverSSM = "ssm"
+	discoverCFN = "cfn"
+)
+
+var discoverSources = []string{discoverSSM, discoverCFN}
+
+// newOpsConfigStore returns the config store to discover resources from for operational commands.
+// By default, resources are read from the SSM parameters of the config store. With "cfn", they are
+// discovered from the tags of the CloudFormation stacks in the account and region of the session instead.
+func newOpsConfigStore(discover string, sess *session.Session) (opsConfigStore, error) {
+	switch discover {
+	case "", discoverSSM:
+		return config.NewSSMStore(identity.New(sess), ssm.New(sess), aws.StringValue(sess.Config.Region)), nil
+	case discoverCFN:
+		return deploy.NewStackConfigStore(awscfn.New(sess)), nil
+	default:
+		return nil, fmt.Errorf("invalid `--%s` value %q: must be one of %s", discoverFlag, discover, strings.Join(discoverSources, ", "))
+	}
+}
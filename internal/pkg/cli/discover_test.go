@@ -0,0 +1,56 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOpsConfigStore(t *testing.T) {
+	testCases := map[string]struct {
+		inDiscover string
+
+		wantedStore interface{}
+		wantedErr   error
+	}{
+		"defaults to the SSM config store": {
+			wantedStore: &config.Store{},
+		},
+		"SSM config store": {
+			inDiscover:  "ssm",
+			wantedStore: &config.Store{},
+		},
+		"CloudFormation stacks": {
+			inDiscover:  "cfn",
+			wantedStore: &deploy.StackConfigStore{},
+		},
+		"error on unknown source": {
+			inDiscover: "tags",
+			wantedErr:  errors.New("invalid `--discover` value \"tags\": must be one of ssm, cfn"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			sess := session.Must(session.NewSession(&aws.Config{
+				Region: aws.String("us-west-2"),
+			}))
+
+			got, err := newOpsConfigStore(tc.inDiscover, sess)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.IsType(t, tc.wantedStore, got)
+		})
+	}
+}
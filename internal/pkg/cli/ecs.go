@@ -0,0 +1,28 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/spf13/cobra"
+)
+
+// BuildECSCmd is the top level command for inspecting the Amazon ECS resources of services.
+func BuildECSCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "ecs",
+		Short: `Commands for Amazon ECS resources.
+Low-level inspection of the Amazon ECS tasks of your services.`,
+	}
+
+	cmd.AddCommand(buildECSTasksCmd())
+	cmd.AddCommand(buildECSDescribeTaskCmd())
+
+	cmd.SetUsageTemplate(template.Usage)
+	cmd.Annotations = map[string]string{
+		"group": group.Develop,
+	}
+	return cmd
+}
@@ -0,0 +1,96 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	ecsDescribeTaskNamePrompt     = "Which service's task would you like to describe?"
+	ecsDescribeTaskNameHelpPrompt = "The task will be looked up in the running and recently stopped Amazon ECS tasks of the service."
+)
+
+type ecsDescribeTaskVars struct {
+	ecsTasksVars
+	taskID string
+}
+
+type ecsDescribeTaskOpts struct {
+	*ecsTasksOpts
+	taskID string
+}
+
+func newECSDescribeTaskOpts(vars ecsDescribeTaskVars) (*ecsDescribeTaskOpts, error) {
+	tasksOpts, err := newECSTasksOpts(vars.ecsTasksVars)
+	if err != nil {
+		return nil, err
+	}
+	tasksOpts.namePrompt = ecsDescribeTaskNamePrompt
+	tasksOpts.nameHelp = ecsDescribeTaskNameHelpPrompt
+	return &ecsDescribeTaskOpts{
+		ecsTasksOpts: tasksOpts,
+		taskID:       vars.taskID,
+	}, nil
+}
+
+// Validate returns an error if the task ID is missing.
+func (o *ecsDescribeTaskOpts) Validate() error {
+	if o.taskID == "" {
+		return errors.New("task ID is required, run `copilot ecs tasks` to list the tasks of the service")
+	}
+	return nil
+}
+
+// Execute shows the details of the task and the exit codes of its containers.
+func (o *ecsDescribeTaskOpts) Execute() error {
+	if err := o.initTasksDescriber(); err != nil {
+		return err
+	}
+	task, err := o.describer.Task(o.taskID)
+	if err != nil {
+		return fmt.Errorf("describe task of service %s: %w", o.svcName, err)
+	}
+	if o.shouldOutputJSON {
+		data, err := task.JSONString()
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+		return nil
+	}
+	fmt.Fprint(o.w, task.HumanString())
+	return nil
+}
+
+// buildECSDescribeTaskCmd builds the command for describing an Amazon ECS task of a service.
+func buildECSDescribeTaskCmd() *cobra.Command {
+	vars := ecsDescribeTaskVars{}
+	cmd := &cobra.Command{
+		Use:   "describe-task",
+		Short: "Describes an Amazon ECS task of a deployed service.",
+		Long: `Describes a running or recently stopped Amazon ECS task of a deployed service,
+including the status, health and exit code of each of its containers.`,
+
+		Example: `
+  Describes the task starting with "8c38184" of the service "api" in the "prod" environment.
+  /code $ copilot ecs describe-task -n api -e prod --task-id 8c38184`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newECSDescribeTaskOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVar(&vars.taskID, taskIDFlag, "", ecsTaskIDFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
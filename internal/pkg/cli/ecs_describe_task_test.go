@@ -0,0 +1,126 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestECSDescribeTask_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inTaskID string
+
+		wantedError error
+	}{
+		"error if the task ID is missing": {
+			wantedError: errors.New("task ID is required, run `copilot ecs tasks` to list the tasks of the service"),
+		},
+		"success": {
+			inTaskID: "4082490e",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &ecsDescribeTaskOpts{
+				ecsTasksOpts: &ecsTasksOpts{},
+				taskID:       tc.inTaskID,
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestECSDescribeTask_Execute(t *testing.T) {
+	mockTask := &describe.ECSTask{
+		ID:            "4082490ee6c245e09d2145010aa1ba8d",
+		LastStatus:    "STOPPED",
+		StoppedReason: "Essential container in task exited",
+		Containers: []*describe.ECSTaskContainer{
+			{
+				Name:       "api",
+				LastStatus: "STOPPED",
+				ExitCode:   aws.Int64(1),
+			},
+		},
+	}
+	testCases := map[string]struct {
+		shouldOutputJSON bool
+
+		setupMocks func(m *mocks.MockecsTasksDescriber)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"return error if fail to describe the task": {
+			setupMocks: func(m *mocks.MockecsTasksDescriber) {
+				m.EXPECT().Task("4082490e").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe task of service api: some error"),
+		},
+		"success in JSON": {
+			shouldOutputJSON: true,
+			setupMocks: func(m *mocks.MockecsTasksDescriber) {
+				m.EXPECT().Task("4082490e").Return(mockTask, nil)
+			},
+			wantedContent: `{"id":"4082490ee6c245e09d2145010aa1ba8d","taskDefinitionARN":"","revision":"","lastStatus":"STOPPED","health":"","startedAt":"0001-01-01T00:00:00Z","stoppedAt":"0001-01-01T00:00:00Z","stoppedReason":"Essential container in task exited","containers":[{"name":"api","image":"","lastStatus":"STOPPED","healthStatus":"","exitCode":1}]}` + "\n",
+		},
+		"success in human format": {
+			setupMocks: func(m *mocks.MockecsTasksDescriber) {
+				m.EXPECT().Task("4082490e").Return(mockTask, nil)
+			},
+			wantedContent: mockTask.HumanString(),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockecsTasksDescriber(ctrl)
+			tc.setupMocks(m)
+			b := &bytes.Buffer{}
+			opts := &ecsDescribeTaskOpts{
+				ecsTasksOpts: &ecsTasksOpts{
+					ecsTasksVars: ecsTasksVars{
+						appName:          "phonetool",
+						envName:          "prod",
+						svcName:          "api",
+						shouldOutputJSON: tc.shouldOutputJSON,
+					},
+					w:                  b,
+					describer:          m,
+					initTasksDescriber: func() error { return nil },
+				},
+				taskID: "4082490e",
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
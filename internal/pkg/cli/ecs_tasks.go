@@ -0,0 +1,197 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	ecsTasksNamePrompt     = "Which service's tasks would you like to show?"
+	ecsTasksNameHelpPrompt = "The running and recently stopped Amazon ECS tasks of the service will be shown."
+)
+
+// ecsServiceTypes are the service types that run on Amazon ECS.
+var ecsServiceTypes = []string{
+	manifestinfo.LoadBalancedWebServiceType,
+	manifestinfo.BackendServiceType,
+	manifestinfo.WorkerServiceType,
+}
+
+type ecsTasksVars struct {
+	appName          string
+	envName          string
+	svcName          string
+	shouldOutputJSON bool
+}
+
+type ecsTasksOpts struct {
+	ecsTasksVars
+
+	w                    io.Writer
+	store                store
+	sel                  deploySelector
+	describer            ecsTasksDescriber
+	initTasksDescriber   func() error
+	namePrompt, nameHelp string
+}
+
+func newECSTasksOpts(vars ecsTasksVars) (*ecsTasksOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("ecs tasks"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+	configStore := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	deployStore, err := deploy.NewStore(sessProvider, configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	opts := &ecsTasksOpts{
+		ecsTasksVars: vars,
+		w:            log.OutputWriter,
+		store:        configStore,
+		sel:          selector.NewDeploySelect(prompt.New(), configStore, deployStore),
+		namePrompt:   ecsTasksNamePrompt,
+		nameHelp:     ecsTasksNameHelpPrompt,
+	}
+	opts.initTasksDescriber = func() error {
+		d, err := describe.NewECSTasksDescriber(&describe.NewServiceStatusConfig{
+			App:         opts.appName,
+			Env:         opts.envName,
+			Svc:         opts.svcName,
+			ConfigStore: configStore,
+		})
+		if err != nil {
+			return fmt.Errorf("create tasks describer for service %s in application %s: %w", opts.svcName, opts.appName, err)
+		}
+		opts.describer = d
+		return nil
+	}
+	return opts, nil
+}
+
+// Validate is a no-op for this command.
+func (o *ecsTasksOpts) Validate() error {
+	return nil
+}
+
+// Ask prompts for and validates any required flags.
+func (o *ecsTasksOpts) Ask() error {
+	if err := o.validateOrAskApp(); err != nil {
+		return err
+	}
+	return o.validateAndAskSvcEnvName()
+}
+
+// Execute shows the running and recently stopped tasks of the service.
+func (o *ecsTasksOpts) Execute() error {
+	if err := o.initTasksDescriber(); err != nil {
+		return err
+	}
+	tasks, err := o.describer.Tasks()
+	if err != nil {
+		return fmt.Errorf("describe tasks of service %s: %w", o.svcName, err)
+	}
+	if o.shouldOutputJSON {
+		data, err := tasks.JSONString()
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+		return nil
+	}
+	fmt.Fprint(o.w, tasks.HumanString())
+	return nil
+}
+
+func (o *ecsTasksOpts) validateOrAskApp() error {
+	if o.appName != "" {
+		_, err := o.store.GetApplication(o.appName)
+		return err
+	}
+	app, err := o.sel.Application(svcAppNamePrompt, wkldAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *ecsTasksOpts) validateAndAskSvcEnvName() error {
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	if o.svcName != "" {
+		svc, err := o.store.GetService(o.appName, o.svcName)
+		if err != nil {
+			return err
+		}
+		if !isECSServiceType(svc.Type) {
+			return fmt.Errorf("service %s is a %s that does not run on Amazon ECS", o.svcName, svc.Type)
+		}
+	}
+	// Note: we let prompter handle the case when there is only option for user to choose from.
+	// This is naturally the case when `o.envName != "" && o.svcName != ""`.
+	deployedService, err := o.sel.DeployedService(o.namePrompt, o.nameHelp, o.appName,
+		selector.WithEnv(o.envName), selector.WithName(o.svcName), selector.WithServiceTypesFilter(ecsServiceTypes))
+	if err != nil {
+		return fmt.Errorf("select deployed services for application %s: %w", o.appName, err)
+	}
+	o.svcName = deployedService.Name
+	o.envName = deployedService.Env
+	return nil
+}
+
+func isECSServiceType(svcType string) bool {
+	for _, t := range ecsServiceTypes {
+		if svcType == t {
+			return true
+		}
+	}
+	return false
+}
+
+// buildECSTasksCmd builds the command for listing the Amazon ECS tasks of a service.
+func buildECSTasksCmd() *cobra.Command {
+	vars := ecsTasksVars{}
+	cmd := &cobra.Command{
+		Use:   "tasks",
+		Short: "Lists the Amazon ECS tasks of a deployed service.",
+		Long: `Lists the running and recently stopped Amazon ECS tasks of a deployed service,
+with their private IP, capacity provider, start time and the reason they stopped.`,
+
+		Example: `
+  Lists the tasks of the service "api" in the "prod" environment.
+  /code $ copilot ecs tasks -n api -e prod`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newECSTasksOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
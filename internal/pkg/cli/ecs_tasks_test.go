@@ -0,0 +1,188 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestECSTasks_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inApp string
+		inEnv string
+		inSvc string
+
+		setupMocks func(store *mocks.Mockstore, sel *mocks.MockdeploySelector)
+
+		wantedApp   string
+		wantedEnv   string
+		wantedSvc   string
+		wantedError error
+	}{
+		"error if fail to select the application": {
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockdeploySelector) {
+				sel.EXPECT().Application(gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("select application: some error"),
+		},
+		"error if the service does not run on Amazon ECS": {
+			inApp: "phonetool",
+			inSvc: "frontend",
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockdeploySelector) {
+				store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				store.EXPECT().GetService("phonetool", "frontend").Return(&config.Workload{
+					Type: manifestinfo.RequestDrivenWebServiceType,
+				}, nil)
+			},
+			wantedError: errors.New("service frontend is a Request-Driven Web Service that does not run on Amazon ECS"),
+		},
+		"error if fail to select a deployed service": {
+			inApp: "phonetool",
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockdeploySelector) {
+				store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				sel.EXPECT().DeployedService(ecsTasksNamePrompt, ecsTasksNameHelpPrompt, "phonetool", gomock.Any()).
+					Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("select deployed services for application phonetool: some error"),
+		},
+		"success": {
+			inApp: "phonetool",
+			inEnv: "prod",
+			inSvc: "api",
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockdeploySelector) {
+				store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				store.EXPECT().GetEnvironment("phonetool", "prod").Return(&config.Environment{}, nil)
+				store.EXPECT().GetService("phonetool", "api").Return(&config.Workload{
+					Type: manifestinfo.BackendServiceType,
+				}, nil)
+				sel.EXPECT().DeployedService(ecsTasksNamePrompt, ecsTasksNameHelpPrompt, "phonetool", gomock.Any()).
+					Return(&selector.DeployedService{
+						Env:  "prod",
+						Name: "api",
+					}, nil)
+			},
+			wantedApp: "phonetool",
+			wantedEnv: "prod",
+			wantedSvc: "api",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mocks.NewMockstore(ctrl)
+			sel := mocks.NewMockdeploySelector(ctrl)
+			tc.setupMocks(store, sel)
+			opts := &ecsTasksOpts{
+				ecsTasksVars: ecsTasksVars{
+					appName: tc.inApp,
+					envName: tc.inEnv,
+					svcName: tc.inSvc,
+				},
+				store:      store,
+				sel:        sel,
+				namePrompt: ecsTasksNamePrompt,
+				nameHelp:   ecsTasksNameHelpPrompt,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedApp, opts.appName)
+			require.Equal(t, tc.wantedEnv, opts.envName)
+			require.Equal(t, tc.wantedSvc, opts.svcName)
+		})
+	}
+}
+
+func TestECSTasks_Execute(t *testing.T) {
+	mockTasks := &describe.ECSTasks{
+		Service: "api",
+		Running: []*describe.ECSTask{
+			{
+				ID:         "4082490ee6c245e09d2145010aa1ba8d",
+				LastStatus: "RUNNING",
+			},
+		},
+	}
+	testCases := map[string]struct {
+		shouldOutputJSON bool
+
+		setupMocks func(m *mocks.MockecsTasksDescriber)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"return error if fail to describe the tasks": {
+			setupMocks: func(m *mocks.MockecsTasksDescriber) {
+				m.EXPECT().Tasks().Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe tasks of service api: some error"),
+		},
+		"success in JSON": {
+			shouldOutputJSON: true,
+			setupMocks: func(m *mocks.MockecsTasksDescriber) {
+				m.EXPECT().Tasks().Return(mockTasks, nil)
+			},
+			wantedContent: `{"service":"api","running":[{"id":"4082490ee6c245e09d2145010aa1ba8d","taskDefinitionARN":"","revision":"","lastStatus":"RUNNING","health":"","startedAt":"0001-01-01T00:00:00Z","stoppedAt":"0001-01-01T00:00:00Z","containers":null}],"stopped":null}` + "\n",
+		},
+		"success in human format": {
+			setupMocks: func(m *mocks.MockecsTasksDescriber) {
+				m.EXPECT().Tasks().Return(mockTasks, nil)
+			},
+			wantedContent: mockTasks.HumanString(),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockecsTasksDescriber(ctrl)
+			tc.setupMocks(m)
+			b := &bytes.Buffer{}
+			opts := &ecsTasksOpts{
+				ecsTasksVars: ecsTasksVars{
+					appName:          "phonetool",
+					envName:          "prod",
+					svcName:          "api",
+					shouldOutputJSON: tc.shouldOutputJSON,
+				},
+				w:                  b,
+				describer:          m,
+				initTasksDescriber: func() error { return nil },
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
@@ -41,11 +41,15 @@ Environments are deployment stages shared between services.`,
 	cmd.AddCommand(buildEnvInitCmd())
 	cmd.AddCommand(buildEnvListCmd())
 	cmd.AddCommand(buildEnvShowCmd())
+	cmd.AddCommand(buildEnvCertsCmd())
 	cmd.AddCommand(buildEnvUpgradeCmd())
 	cmd.AddCommand(buildEnvPkgCmd())
 	cmd.AddCommand(buildEnvOverrideCmd())
 	cmd.AddCommand(buildEnvDeployCmd())
+	cmd.AddCommand(buildEnvTunnelCmd())
 	cmd.AddCommand(buildEnvDeleteCmd())
+	cmd.AddCommand(buildEnvDetachCmd())
+	cmd.AddCommand(buildEnvAdoptCmd())
 	cmd.SetUsageTemplate(template.Usage)
 	cmd.Annotations = map[string]string{
 		"group": group.Develop,
@@ -0,0 +1,251 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/version"
+	"github.com/spf13/cobra"
+)
+
+const (
+	envAdoptAppNameHelpPrompt = "An existing environment will be added to the selected application."
+	envAdoptNamePrompt        = "What is the name of the environment you would like to adopt?"
+	envAdoptNameHelpPrompt    = `The name of an environment that was deployed by Copilot for this application,
+for example an environment removed with "copilot env detach".`
+
+	fmtAdoptEnvAddToAppStart    = "Linking account %s and region %s to application %s."
+	fmtAdoptEnvAddToAppFailed   = "Failed to link account %s and region %s to application %s.\n"
+	fmtAdoptEnvAddToAppComplete = "Linked account %s and region %s to application %s.\n"
+)
+
+var (
+	envAdoptAppNamePrompt = fmt.Sprintf("Which %s would you like to add the environment to?", color.Emphasize("application"))
+)
+
+type adoptEnvVars struct {
+	appName string
+	name    string
+	profile string
+	region  string
+}
+
+type adoptEnvOpts struct {
+	adoptEnvVars
+
+	store        environmentStore
+	envStack     environmentGetter
+	appDeployer  appDeployer
+	sessProvider sessionProvider
+	prog         progress
+	prompt       prompter
+	sel          appSelector
+
+	// initRuntimeClients is overridden in tests.
+	initRuntimeClients func(*adoptEnvOpts) error
+}
+
+func newAdoptEnvOpts(vars adoptEnvVars) (*adoptEnvOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("env adopt"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+	store := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+
+	prompter := prompt.New()
+	return &adoptEnvOpts{
+		adoptEnvVars: vars,
+
+		store:        store,
+		appDeployer:  cloudformation.New(defaultSess),
+		sessProvider: sessProvider,
+		prog:         termprogress.NewSpinner(log.DiagnosticWriter),
+		sel:          selector.NewAppEnvSelector(prompter, store),
+		prompt:       prompter,
+
+		initRuntimeClients: func(o *adoptEnvOpts) error {
+			sess, err := o.envSession()
+			if err != nil {
+				return err
+			}
+			o.envStack = cloudformation.New(sess)
+			return nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the individual user inputs are invalid.
+func (o *adoptEnvOpts) Validate() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return err
+		}
+	}
+	if o.name != "" {
+		if err := validateEnvironmentName(o.name); err != nil {
+			return err
+		}
+		if err := o.validateEnvNotInApp(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask prompts for fields that are required but not passed in.
+func (o *adoptEnvOpts) Ask() error {
+	if o.appName == "" {
+		app, err := o.sel.Application(envAdoptAppNamePrompt, envAdoptAppNameHelpPrompt)
+		if err != nil {
+			return fmt.Errorf("ask for application: %w", err)
+		}
+		o.appName = app
+	}
+	if o.name != "" {
+		return nil
+	}
+	name, err := o.prompt.Get(envAdoptNamePrompt, envAdoptNameHelpPrompt, validateEnvironmentName, prompt.WithFinalMessage("Environment name:"))
+	if err != nil {
+		return fmt.Errorf("get environment name: %w", err)
+	}
+	o.name = name
+	return o.validateEnvNotInApp()
+}
+
+// ValidateVersionPin returns an error if the application doesn't allow this version of Copilot to adopt environments.
+func (o *adoptEnvOpts) ValidateVersionPin() error {
+	return validateVersionPin(o.store, o.appName, version.Version, "")
+}
+
+// Execute registers an environment stack deployed by Copilot to the application by:
+// 1. Reading the account, region and IAM roles of the environment from its CloudFormation stack.
+// 2. Adding the environment's account and region to the application's regional resources.
+// 3. Storing the environment's configuration in the application's parameters.
+func (o *adoptEnvOpts) Execute() error {
+	if err := o.initRuntimeClients(o); err != nil {
+		return err
+	}
+	env, err := o.envStack.GetEnvironment(o.appName, o.name)
+	if err != nil {
+		return fmt.Errorf("get environment %s from stack %s: %w", o.name, stack.NameForEnv(o.appName, o.name), err)
+	}
+	app, err := o.store.GetApplication(o.appName)
+	if err != nil {
+		return fmt.Errorf("get application %s: %w", o.appName, err)
+	}
+	o.prog.Start(fmt.Sprintf(fmtAdoptEnvAddToAppStart, color.HighlightUserInput(env.AccountID), color.HighlightUserInput(env.Region), color.HighlightUserInput(o.appName)))
+	if err := o.addToApp(app, env); err != nil {
+		o.prog.Stop(log.Serrorf(fmtAdoptEnvAddToAppFailed, color.HighlightUserInput(env.AccountID), color.HighlightUserInput(env.Region), color.HighlightUserInput(o.appName)))
+		return err
+	}
+	o.prog.Stop(log.Ssuccessf(fmtAdoptEnvAddToAppComplete, color.HighlightUserInput(env.AccountID), color.HighlightUserInput(env.Region), color.HighlightUserInput(o.appName)))
+
+	if err := o.store.CreateEnvironment(env); err != nil {
+		return fmt.Errorf("store environment %s in application %s: %w", o.name, o.appName, err)
+	}
+	log.Successf("Adopted environment %s in application %s.\n", color.HighlightUserInput(o.name), color.HighlightUserInput(o.appName))
+	return nil
+}
+
+// RecommendActions prints follow-up actions after the environment is adopted.
+func (o *adoptEnvOpts) RecommendActions() error {
+	logRecommendedActions([]string{
+		fmt.Sprintf("Run %s to check the environment's resources.", color.HighlightCode(fmt.Sprintf("copilot env show --name %s", o.name))),
+		fmt.Sprintf("Run %s to update the environment with your manifest.", color.HighlightCode(fmt.Sprintf("copilot env deploy --name %s", o.name))),
+	})
+	return nil
+}
+
+func (o *adoptEnvOpts) validateEnvNotInApp() error {
+	_, err := o.store.GetEnvironment(o.appName, o.name)
+	if err == nil {
+		return fmt.Errorf("environment %s already exists in application %s", color.HighlightUserInput(o.name), color.HighlightUserInput(o.appName))
+	}
+	var errNoSuchEnvironment *config.ErrNoSuchEnvironment
+	if !errors.As(err, &errNoSuchEnvironment) {
+		return fmt.Errorf("validate if environment exists: %w", err)
+	}
+	return nil
+}
+
+func (o *adoptEnvOpts) addToApp(app *config.Application, env *config.Environment) error {
+	if err := o.appDeployer.AddEnvToApp(&cloudformation.AddEnvToAppOpts{
+		App:          app,
+		EnvName:      env.Name,
+		EnvAccountID: env.AccountID,
+		EnvRegion:    env.Region,
+	}); err != nil {
+		return fmt.Errorf("add env %s to application %s: %w", env.Name, app.Name, err)
+	}
+	// Environments in other accounts need permissions to update the application's hosted zone.
+	if app.Domain == "" || env.AccountID == app.AccountID {
+		return nil
+	}
+	if err := o.appDeployer.DelegateDNSPermissions(app, env.AccountID); err != nil {
+		return fmt.Errorf("delegate DNS permissions to account %s: %w", env.AccountID, err)
+	}
+	return nil
+}
+
+func (o *adoptEnvOpts) envSession() (*session.Session, error) {
+	if o.profile == "" {
+		if o.region == "" {
+			return o.sessProvider.Default()
+		}
+		return o.sessProvider.DefaultWithRegion(o.region)
+	}
+	sess, err := o.sessProvider.FromProfile(o.profile)
+	if err != nil {
+		return nil, fmt.Errorf("create session from profile %s: %w", o.profile, err)
+	}
+	if o.region == "" {
+		return sess, nil
+	}
+	return sess.Copy(&aws.Config{
+		Region: aws.String(o.region),
+	}), nil
+}
+
+// buildEnvAdoptCmd builds the command to add an existing environment to an application.
+func buildEnvAdoptCmd() *cobra.Command {
+	vars := adoptEnvVars{}
+	cmd := &cobra.Command{
+		Use:   "adopt",
+		Short: "Adds an existing environment to your application.",
+		Long: `Adds an existing environment to your application.
+The environment must have been deployed by Copilot, for example before it was removed with "copilot env detach".`,
+		Example: `
+  Add the "test" environment deployed in the "us-west-2" region of the account of the "test" profile.
+  /code $ copilot env adopt --name test --profile test --region us-west-2`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newAdoptEnvOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVar(&vars.profile, profileFlag, "", envAdoptProfileFlagDescription)
+	cmd.Flags().StringVar(&vars.region, regionFlag, "", envAdoptRegionFlagDescription)
+	markAudited(cmd)
+	return cmd
+}
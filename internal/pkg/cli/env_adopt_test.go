@@ -0,0 +1,200 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdoptEnvOpts_Validate(t *testing.T) {
+	const (
+		testApp = "phonetool"
+		testEnv = "test"
+	)
+	testCases := map[string]struct {
+		inEnvName string
+		mockStore func(m *mocks.MockenvironmentStore)
+
+		wantedError error
+	}{
+		"error if the application doesn't exist": {
+			mockStore: func(m *mocks.MockenvironmentStore) {
+				m.EXPECT().GetApplication(testApp).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"error if the environment name is invalid": {
+			inEnvName: "123env",
+			mockStore: func(m *mocks.MockenvironmentStore) {
+				m.EXPECT().GetApplication(testApp).Return(&config.Application{Name: testApp}, nil)
+			},
+			wantedError: errors.New("environment name 123env is invalid: value must have a length of at least 2, start with a letter, contain only lower-case letters, numbers, and hyphens, and have no consecutive or trailing hyphen"),
+		},
+		"error if the environment is already in the application": {
+			inEnvName: testEnv,
+			mockStore: func(m *mocks.MockenvironmentStore) {
+				m.EXPECT().GetApplication(testApp).Return(&config.Application{Name: testApp}, nil)
+				m.EXPECT().GetEnvironment(testApp, testEnv).Return(&config.Environment{Name: testEnv}, nil)
+			},
+			wantedError: errors.New("environment test already exists in application phonetool"),
+		},
+		"error if fail to check if the environment exists": {
+			inEnvName: testEnv,
+			mockStore: func(m *mocks.MockenvironmentStore) {
+				m.EXPECT().GetApplication(testApp).Return(&config.Application{Name: testApp}, nil)
+				m.EXPECT().GetEnvironment(testApp, testEnv).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("validate if environment exists: some error"),
+		},
+		"valid if the environment is not in the application": {
+			inEnvName: testEnv,
+			mockStore: func(m *mocks.MockenvironmentStore) {
+				m.EXPECT().GetApplication(testApp).Return(&config.Application{Name: testApp}, nil)
+				m.EXPECT().GetEnvironment(testApp, testEnv).Return(nil, &config.ErrNoSuchEnvironment{
+					ApplicationName: testApp,
+					EnvironmentName: testEnv,
+				})
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockenvironmentStore(ctrl)
+			tc.mockStore(m)
+			opts := &adoptEnvOpts{
+				adoptEnvVars: adoptEnvVars{
+					appName: testApp,
+					name:    tc.inEnvName,
+				},
+				store: m,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestAdoptEnvOpts_Execute(t *testing.T) {
+	const (
+		testApp = "phonetool"
+		testEnv = "test"
+	)
+	mockEnv := &config.Environment{
+		App:              testApp,
+		Name:             testEnv,
+		Region:           "us-west-2",
+		AccountID:        "222222222222",
+		ManagerRoleARN:   "arn:aws:iam::222222222222:role/phonetool-test-EnvManagerRole",
+		ExecutionRoleARN: "arn:aws:iam::222222222222:role/phonetool-test-CFNExecutionRole",
+	}
+	type mocksForAdopt struct {
+		store       *mocks.MockenvironmentStore
+		envStack    *mocks.MockenvironmentGetter
+		appDeployer *mocks.MockappDeployer
+		prog        *mocks.Mockprogress
+	}
+	testCases := map[string]struct {
+		setupMocks func(m mocksForAdopt)
+
+		wantedError error
+	}{
+		"error if fail to read the environment stack": {
+			setupMocks: func(m mocksForAdopt) {
+				m.envStack.EXPECT().GetEnvironment(testApp, testEnv).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get environment test from stack phonetool-test: some error"),
+		},
+		"error if fail to add the environment to the application": {
+			setupMocks: func(m mocksForAdopt) {
+				m.envStack.EXPECT().GetEnvironment(testApp, testEnv).Return(mockEnv, nil)
+				m.store.EXPECT().GetApplication(testApp).Return(&config.Application{Name: testApp, AccountID: "111111111111"}, nil)
+				m.appDeployer.EXPECT().AddEnvToApp(gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("add env test to application phonetool: some error"),
+		},
+		"error if fail to delegate DNS permissions to the environment account": {
+			setupMocks: func(m mocksForAdopt) {
+				app := &config.Application{Name: testApp, AccountID: "111111111111", Domain: "example.com"}
+				m.envStack.EXPECT().GetEnvironment(testApp, testEnv).Return(mockEnv, nil)
+				m.store.EXPECT().GetApplication(testApp).Return(app, nil)
+				m.appDeployer.EXPECT().AddEnvToApp(gomock.Any()).Return(nil)
+				m.appDeployer.EXPECT().DelegateDNSPermissions(app, "222222222222").Return(errors.New("some error"))
+			},
+			wantedError: errors.New("delegate DNS permissions to account 222222222222: some error"),
+		},
+		"stores the environment read from its stack": {
+			setupMocks: func(m mocksForAdopt) {
+				app := &config.Application{Name: testApp, AccountID: "111111111111"}
+				m.envStack.EXPECT().GetEnvironment(testApp, testEnv).Return(mockEnv, nil)
+				m.store.EXPECT().GetApplication(testApp).Return(app, nil)
+				m.appDeployer.EXPECT().AddEnvToApp(&cloudformation.AddEnvToAppOpts{
+					App:          app,
+					EnvName:      testEnv,
+					EnvAccountID: "222222222222",
+					EnvRegion:    "us-west-2",
+				}).Return(nil)
+				m.store.EXPECT().CreateEnvironment(mockEnv).Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocksForAdopt{
+				store:       mocks.NewMockenvironmentStore(ctrl),
+				envStack:    mocks.NewMockenvironmentGetter(ctrl),
+				appDeployer: mocks.NewMockappDeployer(ctrl),
+				prog:        mocks.NewMockprogress(ctrl),
+			}
+			m.prog.EXPECT().Start(gomock.Any()).AnyTimes()
+			m.prog.EXPECT().Stop(gomock.Any()).AnyTimes()
+			tc.setupMocks(m)
+			opts := &adoptEnvOpts{
+				adoptEnvVars: adoptEnvVars{
+					appName: testApp,
+					name:    testEnv,
+				},
+				store:       m.store,
+				appDeployer: m.appDeployer,
+				prog:        m.prog,
+				initRuntimeClients: func(o *adoptEnvOpts) error {
+					o.envStack = m.envStack
+					return nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
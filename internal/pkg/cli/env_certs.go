@@ -0,0 +1,177 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/dustin/go-humanize/english"
+	"github.com/spf13/cobra"
+)
+
+const (
+	envCertsNamePrompt = "Which environment of %s would you like to show the certificates of?"
+	envCertsHelpPrompt = "The certificates imported in the environment and the service aliases they protect will be shown."
+)
+
+type envCertsVars struct {
+	appName          string
+	name             string
+	shouldOutputJSON bool
+}
+
+type envCertsOpts struct {
+	envCertsVars
+
+	w                io.Writer
+	store            store
+	describer        envCertificatesDescriber
+	sel              configSelector
+	initEnvDescriber func() error
+}
+
+func newEnvCertsOpts(vars envCertsVars) (*envCertsOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("env certs"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, err
+	}
+	store := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	deployStore, err := deploy.NewStore(sessProvider, store)
+	if err != nil {
+		return nil, fmt.Errorf("connect to copilot deploy store: %w", err)
+	}
+
+	opts := &envCertsOpts{
+		envCertsVars: vars,
+		store:        store,
+		w:            log.OutputWriter,
+		sel:          selector.NewConfigSelector(prompt.New(), store),
+	}
+	opts.initEnvDescriber = func() error {
+		d, err := describe.NewEnvCertificatesDescriber(describe.NewEnvDescriberConfig{
+			App:         opts.appName,
+			Env:         opts.name,
+			ConfigStore: store,
+			DeployStore: deployStore,
+		})
+		if err != nil {
+			return fmt.Errorf("creating certificates describer for environment %s in application %s: %w", opts.name, opts.appName, err)
+		}
+		opts.describer = d
+		return nil
+	}
+	return opts, nil
+}
+
+// Validate is a no-op for this command.
+func (o *envCertsOpts) Validate() error {
+	return nil
+}
+
+// Ask validates required fields that users passed in, otherwise it prompts for them.
+func (o *envCertsOpts) Ask() error {
+	if err := o.validateOrAskApp(); err != nil {
+		return err
+	}
+	return o.validateOrAskEnv()
+}
+
+// Execute shows the certificates imported in the environment and the aliases that they protect.
+func (o *envCertsOpts) Execute() error {
+	if err := o.initEnvDescriber(); err != nil {
+		return err
+	}
+	certs, err := o.describer.Describe()
+	if err != nil {
+		return fmt.Errorf("describe certificates of environment %s: %w", o.name, err)
+	}
+	if o.shouldOutputJSON {
+		data, err := certs.JSONString()
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+		return nil
+	}
+	if len(certs.Certificates) == 0 {
+		log.Infof("Environment %s does not import any certificates in %s.\n", o.name, color.HighlightCode("http.public.certificates"))
+		return nil
+	}
+	fmt.Fprint(o.w, certs.HumanString())
+	if missing := certs.MissingAliases(); len(missing) != 0 {
+		log.Warningf("%s not protected by any of the imported certificates.\n", english.Plural(len(missing), "alias is", "aliases are"))
+		log.Infof("Add a certificate for them to %s in the manifest, then run %s.\n",
+			color.HighlightCode("http.public.certificates"), color.HighlightCode(fmt.Sprintf("copilot env deploy --name %s", o.name)))
+	}
+	return nil
+}
+
+func (o *envCertsOpts) validateOrAskApp() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return fmt.Errorf("validate application name %q: %v", o.appName, err)
+		}
+		return nil
+	}
+	app, err := o.sel.Application(envShowAppNamePrompt, envShowAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *envCertsOpts) validateOrAskEnv() error {
+	if o.name != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.name); err != nil {
+			return fmt.Errorf("validate environment name %q in application %q: %v", o.name, o.appName, err)
+		}
+		return nil
+	}
+	env, err := o.sel.Environment(fmt.Sprintf(envCertsNamePrompt, color.HighlightUserInput(o.appName)), envCertsHelpPrompt, o.appName)
+	if err != nil {
+		return fmt.Errorf("select environment for application %s: %w", o.appName, err)
+	}
+	o.name = env
+	return nil
+}
+
+// buildEnvCertsCmd builds the command for showing the certificates of an environment.
+func buildEnvCertsCmd() *cobra.Command {
+	vars := envCertsVars{}
+	cmd := &cobra.Command{
+		Use:   "certs",
+		Short: "Shows the certificates of an environment and the aliases they protect.",
+		Long: `Shows the certificates imported in an environment's public load balancer,
+and which certificate protects each alias of the services deployed in the environment.`,
+
+		Example: `
+  Show the certificates of the "prod" environment and the aliases they protect.
+  /code $ copilot env certs -n prod`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newEnvCertsOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", envFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
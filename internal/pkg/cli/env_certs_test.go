@@ -0,0 +1,164 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvCerts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inApp string
+		inEnv string
+
+		setupMocks func(store *mocks.Mockstore, sel *mocks.MockconfigSelector)
+
+		wantedApp   string
+		wantedEnv   string
+		wantedError error
+	}{
+		"error if the environment does not exist": {
+			inApp: "phonetool",
+			inEnv: "test",
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockconfigSelector) {
+				store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				store.EXPECT().GetEnvironment("phonetool", "test").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New(`validate environment name "test" in application "phonetool": some error`),
+		},
+		"prompts for the environment": {
+			inApp: "phonetool",
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockconfigSelector) {
+				store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				sel.EXPECT().Environment(gomock.Any(), gomock.Any(), "phonetool").Return("prod", nil)
+			},
+			wantedApp: "phonetool",
+			wantedEnv: "prod",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mocks.NewMockstore(ctrl)
+			sel := mocks.NewMockconfigSelector(ctrl)
+			tc.setupMocks(store, sel)
+			opts := &envCertsOpts{
+				envCertsVars: envCertsVars{
+					appName: tc.inApp,
+					name:    tc.inEnv,
+				},
+				store: store,
+				sel:   sel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedApp, opts.appName)
+			require.Equal(t, tc.wantedEnv, opts.name)
+		})
+	}
+}
+
+func TestEnvCerts_Execute(t *testing.T) {
+	mockCerts := &describe.EnvCertificates{
+		Environment: "test",
+		Certificates: []*describe.EnvCertificate{
+			{
+				ARN:     "mockCertARN",
+				Domains: []string{"*.example.com"},
+			},
+		},
+		Aliases: []*describe.ServiceAlias{
+			{
+				Service:        "frontend",
+				Alias:          "www.example.com",
+				CertificateARN: "mockCertARN",
+			},
+		},
+	}
+	testCases := map[string]struct {
+		shouldOutputJSON bool
+
+		setupMocks func(m *mocks.MockenvCertificatesDescriber)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"return error if fail to describe the certificates": {
+			setupMocks: func(m *mocks.MockenvCertificatesDescriber) {
+				m.EXPECT().Describe().Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("describe certificates of environment test: some error"),
+		},
+		"should print nothing if no certificates are imported": {
+			setupMocks: func(m *mocks.MockenvCertificatesDescriber) {
+				m.EXPECT().Describe().Return(&describe.EnvCertificates{Environment: "test"}, nil)
+			},
+		},
+		"should print human format": {
+			setupMocks: func(m *mocks.MockenvCertificatesDescriber) {
+				m.EXPECT().Describe().Return(mockCerts, nil)
+			},
+			wantedContent: mockCerts.HumanString(),
+		},
+		"should print JSON format": {
+			shouldOutputJSON: true,
+			setupMocks: func(m *mocks.MockenvCertificatesDescriber) {
+				m.EXPECT().Describe().Return(mockCerts, nil)
+			},
+			wantedContent: `{"environment":"test","certificates":[{"arn":"mockCertARN","domains":["*.example.com"]}],"aliases":[{"service":"frontend","alias":"www.example.com","certificateARN":"mockCertARN"}]}` + "\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			describer := mocks.NewMockenvCertificatesDescriber(ctrl)
+			tc.setupMocks(describer)
+			b := &bytes.Buffer{}
+			opts := &envCertsOpts{
+				envCertsVars: envCertsVars{
+					appName:          "phonetool",
+					name:             "test",
+					shouldOutputJSON: tc.shouldOutputJSON,
+				},
+				w:                b,
+				describer:        describer,
+				initEnvDescriber: func() error { return nil },
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
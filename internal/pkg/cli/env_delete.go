@@ -32,6 +32,7 @@ import (
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/version"
 	"github.com/spf13/cobra"
 	"gopkg.in/yaml.v3"
 )
@@ -166,6 +167,11 @@ func (o *deleteEnvOpts) Ask() error {
 	return nil
 }
 
+// ValidateVersionPin returns an error if the application doesn't allow this version of Copilot to delete its environments.
+func (o *deleteEnvOpts) ValidateVersionPin() error {
+	return validateVersionPin(o.store, o.appName, version.Version, "")
+}
+
 // Execute deletes the environment from the application by:
 // 1. Emptying environment managed S3 buckets.
 // 2. Deleting the cloudformation stack.
@@ -519,7 +525,10 @@ func (o *deleteEnvOpts) tryDeleteRoles() error {
 	if err != nil {
 		return err
 	}
-	_ = o.iam.DeleteRole(env.ExecutionRoleARN)
+	if !env.CustomExecutionRole {
+		// The role provided by the user isn't managed by Copilot.
+		_ = o.iam.DeleteRole(env.ExecutionRoleARN)
+	}
 	_ = o.iam.DeleteRole(env.ManagerRoleARN)
 	return nil
 }
@@ -580,5 +589,6 @@ func buildEnvDeleteCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", envFlagDescription)
 	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	markAudited(cmd)
 	return cmd
 }
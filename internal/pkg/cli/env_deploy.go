@@ -20,6 +20,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	templatediff "github.com/aws/copilot-cli/internal/pkg/template/diff"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
@@ -31,7 +32,11 @@ import (
 	"golang.org/x/mod/semver"
 )
 
-const continueDeploymentPrompt = "Continue with the deployment?"
+const (
+	continueDeploymentPrompt       = "Continue with the deployment?"
+	fmtConfirmNetworkChangesPrompt = "Type %s to confirm the network changes:"
+	fmtNetworkChangesPhrase        = "replace %s network"
+)
 
 type deployEnvVars struct {
 	appName           string
@@ -40,8 +45,11 @@ type deployEnvVars struct {
 	disableRollback   bool
 	showDiff          bool
 	skipDiffPrompt    bool
+	diffFormat        string
 	allowEnvDowngrade bool
 	detach            bool
+
+	allowNetworkChanges bool
 }
 
 type deployEnvOpts struct {
@@ -136,7 +144,7 @@ func newEnvDeployer(opts *deployEnvOpts, ws deploy.WorkspaceAddonsReaderPathGett
 
 // Validate is a no-op for this command.
 func (o *deployEnvOpts) Validate() error {
-	return nil
+	return validateDiffFormat(o.diffFormat, o.showDiff)
 }
 
 // Ask prompts for and validates any required flags.
@@ -170,6 +178,11 @@ func validateEnvVersion(vg versionGetter, name, templateVersion string) error {
 	return nil
 }
 
+// ValidateVersionPin returns an error if the application doesn't allow this version of Copilot to deploy its environments.
+func (o *deployEnvOpts) ValidateVersionPin() error {
+	return validateVersionPin(o.store, o.appName, o.templateVersion, o.templateVersion)
+}
+
 // Execute deploys an environment given a manifest.
 func (o *deployEnvOpts) Execute() error {
 	if !o.allowEnvDowngrade {
@@ -196,6 +209,9 @@ func (o *deployEnvOpts) Execute() error {
 	if err := deployer.Validate(mft); err != nil {
 		return err
 	}
+	if err := o.confirmNetworkChanges(deployer, mft); err != nil {
+		return err
+	}
 	artifacts, err := deployer.UploadArtifacts()
 	if err != nil {
 		return fmt.Errorf("upload artifacts for environment %s: %w", o.name, err)
@@ -211,6 +227,7 @@ func (o *deployEnvOpts) Execute() error {
 		DisableRollback:     o.disableRollback,
 		Version:             o.templateVersion,
 		Detach:              o.detach,
+		TaskRunnerBinaryURL: taskRunnerBinaryURL(),
 	}
 	if o.showDiff {
 		contd, err := o.showDiffAndConfirmDeployment(deployer, deployInput)
@@ -287,7 +304,7 @@ func (o *deployEnvOpts) showDiffAndConfirmDeployment(deployer envDeployer, input
 	if err != nil {
 		return false, fmt.Errorf("generate the template for environment %q: %w", o.name, err)
 	}
-	if err := diff(deployer, output.Template, os.Stdout); err != nil {
+	if err := diff(deployer, output.Template, os.Stdout, templatediff.Format(o.diffFormat)); err != nil {
 		var errHasDiff *errHasDiff
 		if !errors.As(err, &errHasDiff) {
 			return false, fmt.Errorf("generate diff for environment %q: %w", o.name, err)
@@ -303,6 +320,36 @@ func (o *deployEnvOpts) showDiffAndConfirmDeployment(deployer envDeployer, input
 	return contd, nil
 }
 
+// confirmNetworkChanges prints a migration plan and asks the user to type a confirmation phrase
+// if the deployment would delete or replace the environment's networking resources.
+func (o *deployEnvOpts) confirmNetworkChanges(deployer envDeployer, mft *manifest.Environment) error {
+	plan, err := deployer.NetworkMigrationPlan(mft)
+	if err != nil {
+		return fmt.Errorf("detect network changes for environment %q: %w", o.name, err)
+	}
+	if plan.IsEmpty() {
+		return nil
+	}
+	log.Warning(plan.String())
+	if o.allowNetworkChanges {
+		return nil
+	}
+	phrase := fmt.Sprintf(fmtNetworkChangesPhrase, o.name)
+	answer, err := o.prompt.Get(fmt.Sprintf(fmtConfirmNetworkChangesPrompt, color.HighlightUserInput(phrase)),
+		fmt.Sprintf("Pass the %s flag to skip this confirmation in non-interactive environments.", color.HighlightCode("--"+allowNetworkChangesFlag)),
+		nil)
+	if err != nil {
+		return fmt.Errorf("confirm network changes for environment %q: %w", o.name, err)
+	}
+	if answer != phrase {
+		return &errNetworkChangesNotConfirmed{
+			envName: o.name,
+			phrase:  phrase,
+		}
+	}
+	return nil
+}
+
 func (o *deployEnvOpts) validateOrAskEnvName() error {
 	if o.name != "" {
 		return o.validateEnvName()
@@ -400,7 +447,10 @@ Deploy an environment named "test".
 	cmd.Flags().BoolVar(&vars.disableRollback, noRollbackFlag, false, noRollbackFlagDescription)
 	cmd.Flags().BoolVar(&vars.showDiff, diffFlag, false, diffFlagDescription)
 	cmd.Flags().BoolVar(&vars.skipDiffPrompt, diffAutoApproveFlag, false, diffAutoApproveFlagDescription)
+	cmd.Flags().StringVar(&vars.diffFormat, diffFormatFlag, "", diffFormatFlagDescription)
 	cmd.Flags().BoolVar(&vars.allowEnvDowngrade, allowDowngradeFlag, false, allowDowngradeFlagDescription)
 	cmd.Flags().BoolVar(&vars.detach, detachFlag, false, detachFlagDescription)
+	cmd.Flags().BoolVar(&vars.allowNetworkChanges, allowNetworkChangesFlag, false, allowNetworkChangesFlagDescription)
+	markAudited(cmd)
 	return cmd
 }
@@ -159,6 +159,7 @@ func TestDeployEnvOpts_Execute(t *testing.T) {
 		inShowDiff        bool
 		inSkipDiffPrompt  bool
 		inAllowDowngrade  bool
+		inAllowNetwork    bool
 		unmarshalManifest func(in []byte) (*manifest.Environment, error)
 		setUpMocks        func(m *deployEnvExecuteMocks)
 		wantedDiff        string
@@ -220,6 +221,71 @@ func TestDeployEnvOpts_Execute(t *testing.T) {
 			},
 			wantedErr: errors.New("mock error"),
 		},
+		"fail to detect network changes": {
+			setUpMocks: func(m *deployEnvExecuteMocks) {
+				m.envVersionGetter.EXPECT().Version().Return(mockEnvVersion, nil)
+				m.ws.EXPECT().ReadEnvironmentManifest(gomock.Any()).Return([]byte("name: mockEnv\ntype: Environment\n"), nil)
+				m.interpolator.EXPECT().Interpolate(gomock.Any()).Return("name: mockEnv\ntype: Environment\n", nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{
+					RootUserARN: "mockRootUserARN",
+				}, nil)
+				m.deployer.EXPECT().Validate(gomock.Any()).Return(nil)
+				m.deployer.EXPECT().NetworkMigrationPlan(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New(`detect network changes for environment "mockEnv": some error`),
+		},
+		"error if the confirmation phrase for network changes does not match": {
+			setUpMocks: func(m *deployEnvExecuteMocks) {
+				m.envVersionGetter.EXPECT().Version().Return(mockEnvVersion, nil)
+				m.ws.EXPECT().ReadEnvironmentManifest(gomock.Any()).Return([]byte("name: mockEnv\ntype: Environment\n"), nil)
+				m.interpolator.EXPECT().Interpolate(gomock.Any()).Return("name: mockEnv\ntype: Environment\n", nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{
+					RootUserARN: "mockRootUserARN",
+				}, nil)
+				m.deployer.EXPECT().Validate(gomock.Any()).Return(nil)
+				m.deployer.EXPECT().NetworkMigrationPlan(gomock.Any()).Return(&deploy.NetworkMigrationPlan{
+					Changes: []string{"mock change"},
+				}, nil)
+				m.prompter.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return("yes", nil)
+				m.deployer.EXPECT().UploadArtifacts().Times(0)
+			},
+			wantedErr: errors.New("network changes for environment mockEnv were not confirmed"),
+		},
+		"deploy after the confirmation phrase for network changes matches": {
+			setUpMocks: func(m *deployEnvExecuteMocks) {
+				m.envVersionGetter.EXPECT().Version().Return(mockEnvVersion, nil)
+				m.ws.EXPECT().ReadEnvironmentManifest(gomock.Any()).Return([]byte("name: mockEnv\ntype: Environment\n"), nil)
+				m.interpolator.EXPECT().Interpolate(gomock.Any()).Return("name: mockEnv\ntype: Environment\n", nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{
+					RootUserARN: "mockRootUserARN",
+				}, nil)
+				m.deployer.EXPECT().Validate(gomock.Any()).Return(nil)
+				m.deployer.EXPECT().NetworkMigrationPlan(gomock.Any()).Return(&deploy.NetworkMigrationPlan{
+					Changes: []string{"mock change"},
+				}, nil)
+				m.prompter.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return("replace mockEnv network", nil)
+				m.deployer.EXPECT().UploadArtifacts().Return(&deploy.UploadEnvArtifactsOutput{}, nil)
+				m.deployer.EXPECT().DeployEnvironment(gomock.Any()).Return(nil)
+			},
+		},
+		"skip the confirmation phrase for network changes if allowed by flag": {
+			inAllowNetwork: true,
+			setUpMocks: func(m *deployEnvExecuteMocks) {
+				m.envVersionGetter.EXPECT().Version().Return(mockEnvVersion, nil)
+				m.ws.EXPECT().ReadEnvironmentManifest(gomock.Any()).Return([]byte("name: mockEnv\ntype: Environment\n"), nil)
+				m.interpolator.EXPECT().Interpolate(gomock.Any()).Return("name: mockEnv\ntype: Environment\n", nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{
+					RootUserARN: "mockRootUserARN",
+				}, nil)
+				m.deployer.EXPECT().Validate(gomock.Any()).Return(nil)
+				m.deployer.EXPECT().NetworkMigrationPlan(gomock.Any()).Return(&deploy.NetworkMigrationPlan{
+					Changes: []string{"mock change"},
+				}, nil)
+				m.prompter.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				m.deployer.EXPECT().UploadArtifacts().Return(&deploy.UploadEnvArtifactsOutput{}, nil)
+				m.deployer.EXPECT().DeployEnvironment(gomock.Any()).Return(nil)
+			},
+		},
 		"fail to upload artifacts": {
 			setUpMocks: func(m *deployEnvExecuteMocks) {
 				m.envVersionGetter.EXPECT().Version().Return(mockEnvVersion, nil)
@@ -229,6 +295,7 @@ func TestDeployEnvOpts_Execute(t *testing.T) {
 					RootUserARN: "mockRootUserARN",
 				}, nil)
 				m.deployer.EXPECT().Validate(gomock.Any()).Return(nil)
+				m.deployer.EXPECT().NetworkMigrationPlan(gomock.Any()).Return(&deploy.NetworkMigrationPlan{}, nil)
 				m.deployer.EXPECT().UploadArtifacts().Return(nil, errors.New("some error"))
 			},
 			wantedErr: errors.New("upload artifacts for environment mockEnv: some error"),
@@ -243,6 +310,7 @@ func TestDeployEnvOpts_Execute(t *testing.T) {
 					RootUserARN: "mockRootUserARN",
 				}, nil)
 				m.deployer.EXPECT().Validate(gomock.Any()).Return(nil)
+				m.deployer.EXPECT().NetworkMigrationPlan(gomock.Any()).Return(&deploy.NetworkMigrationPlan{}, nil)
 				m.deployer.EXPECT().UploadArtifacts().Return(&deploy.UploadEnvArtifactsOutput{}, nil)
 				m.deployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(nil, errors.New("some error"))
 			},
@@ -258,6 +326,7 @@ func TestDeployEnvOpts_Execute(t *testing.T) {
 					RootUserARN: "mockRootUserARN",
 				}, nil)
 				m.deployer.EXPECT().Validate(gomock.Any()).Return(nil)
+				m.deployer.EXPECT().NetworkMigrationPlan(gomock.Any()).Return(&deploy.NetworkMigrationPlan{}, nil)
 				m.deployer.EXPECT().UploadArtifacts().Return(&deploy.UploadEnvArtifactsOutput{}, nil)
 				m.deployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{}, nil)
 				m.deployer.EXPECT().DeployDiff(gomock.Any()).Return("", errors.New("some error"))
@@ -274,6 +343,7 @@ func TestDeployEnvOpts_Execute(t *testing.T) {
 					RootUserARN: "mockRootUserARN",
 				}, nil)
 				m.deployer.EXPECT().Validate(gomock.Any()).Return(nil)
+				m.deployer.EXPECT().NetworkMigrationPlan(gomock.Any()).Return(&deploy.NetworkMigrationPlan{}, nil)
 				m.deployer.EXPECT().UploadArtifacts().Return(&deploy.UploadEnvArtifactsOutput{}, nil)
 				m.deployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{}, nil)
 				m.deployer.EXPECT().DeployDiff(gomock.Any()).Return("", nil)
@@ -291,6 +361,7 @@ func TestDeployEnvOpts_Execute(t *testing.T) {
 					RootUserARN: "mockRootUserARN",
 				}, nil)
 				m.deployer.EXPECT().Validate(gomock.Any()).Return(nil)
+				m.deployer.EXPECT().NetworkMigrationPlan(gomock.Any()).Return(&deploy.NetworkMigrationPlan{}, nil)
 				m.deployer.EXPECT().UploadArtifacts().Return(&deploy.UploadEnvArtifactsOutput{}, nil)
 				m.deployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{}, nil)
 				m.deployer.EXPECT().DeployDiff(gomock.Any()).Return("", nil)
@@ -308,6 +379,7 @@ func TestDeployEnvOpts_Execute(t *testing.T) {
 					RootUserARN: "mockRootUserARN",
 				}, nil)
 				m.deployer.EXPECT().Validate(gomock.Any()).Return(nil)
+				m.deployer.EXPECT().NetworkMigrationPlan(gomock.Any()).Return(&deploy.NetworkMigrationPlan{}, nil)
 				m.deployer.EXPECT().UploadArtifacts().Return(&deploy.UploadEnvArtifactsOutput{}, nil)
 				m.deployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{}, nil)
 				m.deployer.EXPECT().DeployDiff(gomock.Any()).Return("", nil)
@@ -325,6 +397,7 @@ func TestDeployEnvOpts_Execute(t *testing.T) {
 					RootUserARN: "mockRootUserARN",
 				}, nil)
 				m.deployer.EXPECT().Validate(gomock.Any()).Return(nil)
+				m.deployer.EXPECT().NetworkMigrationPlan(gomock.Any()).Return(&deploy.NetworkMigrationPlan{}, nil)
 				m.deployer.EXPECT().UploadArtifacts().Return(&deploy.UploadEnvArtifactsOutput{}, nil)
 				m.deployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{}, nil)
 				m.deployer.EXPECT().DeployDiff(gomock.Any()).Return("", nil)
@@ -342,6 +415,7 @@ func TestDeployEnvOpts_Execute(t *testing.T) {
 					RootUserARN: "mockRootUserARN",
 				}, nil)
 				m.deployer.EXPECT().Validate(gomock.Any()).Return(nil)
+				m.deployer.EXPECT().NetworkMigrationPlan(gomock.Any()).Return(&deploy.NetworkMigrationPlan{}, nil)
 				m.deployer.EXPECT().UploadArtifacts().Return(&deploy.UploadEnvArtifactsOutput{}, nil)
 				m.deployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{}, nil)
 				m.deployer.EXPECT().DeployDiff(gomock.Any()).Return("", nil)
@@ -361,6 +435,7 @@ func TestDeployEnvOpts_Execute(t *testing.T) {
 					RootUserARN: "mockRootUserARN",
 				}, nil)
 				m.deployer.EXPECT().Validate(gomock.Any()).Return(nil)
+				m.deployer.EXPECT().NetworkMigrationPlan(gomock.Any()).Return(&deploy.NetworkMigrationPlan{}, nil)
 				m.deployer.EXPECT().UploadArtifacts().Return(&deploy.UploadEnvArtifactsOutput{}, nil)
 				m.deployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{}, nil)
 				m.deployer.EXPECT().DeployDiff(gomock.Any()).Return("", nil)
@@ -377,6 +452,7 @@ func TestDeployEnvOpts_Execute(t *testing.T) {
 					RootUserARN: "mockRootUserARN",
 				}, nil)
 				m.deployer.EXPECT().Validate(gomock.Any()).Return(nil)
+				m.deployer.EXPECT().NetworkMigrationPlan(gomock.Any()).Return(&deploy.NetworkMigrationPlan{}, nil)
 				m.deployer.EXPECT().UploadArtifacts().Return(&deploy.UploadEnvArtifactsOutput{}, nil)
 				m.deployer.EXPECT().DeployEnvironment(gomock.Any()).DoAndReturn(func(_ *deploy.DeployEnvironmentInput) error {
 					return errors.New("some error")
@@ -393,6 +469,7 @@ func TestDeployEnvOpts_Execute(t *testing.T) {
 					RootUserARN: "mockRootUserARN",
 				}, nil)
 				m.deployer.EXPECT().Validate(gomock.Any()).Return(nil)
+				m.deployer.EXPECT().NetworkMigrationPlan(gomock.Any()).Return(&deploy.NetworkMigrationPlan{}, nil)
 				m.deployer.EXPECT().UploadArtifacts().Return(&deploy.UploadEnvArtifactsOutput{
 					AddonsURL: "mockAddonsURL",
 					CustomResourceURLs: map[string]string{
@@ -431,10 +508,11 @@ func TestDeployEnvOpts_Execute(t *testing.T) {
 			tc.setUpMocks(m)
 			opts := deployEnvOpts{
 				deployEnvVars: deployEnvVars{
-					name:              "mockEnv",
-					showDiff:          tc.inShowDiff,
-					skipDiffPrompt:    tc.inSkipDiffPrompt,
-					allowEnvDowngrade: tc.inAllowDowngrade,
+					name:                "mockEnv",
+					showDiff:            tc.inShowDiff,
+					skipDiffPrompt:      tc.inSkipDiffPrompt,
+					allowEnvDowngrade:   tc.inAllowDowngrade,
+					allowNetworkChanges: tc.inAllowNetwork,
 				},
 				ws:       m.ws,
 				identity: m.identity,
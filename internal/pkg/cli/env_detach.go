@@ -0,0 +1,198 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/version"
+	"github.com/spf13/cobra"
+)
+
+const (
+	envDetachAppNameHelpPrompt = "An environment will be detached from the selected application."
+	envDetachNamePrompt        = "Which environment would you like to detach?"
+	fmtDetachEnvPrompt         = "Are you sure you want to detach environment %q from application %q?"
+	detachEnvConfirmHelp       = "The environment's resources keep running, but Copilot won't manage them until the environment is adopted again."
+)
+
+var (
+	envDetachAppNamePrompt = fmt.Sprintf("From which %s would you like to detach the environment?", color.Emphasize("application"))
+)
+
+var (
+	errEnvDetachCancelled = errors.New("env detach cancelled - no changes made")
+)
+
+type detachEnvVars struct {
+	appName          string
+	name             string
+	skipConfirmation bool
+}
+
+type detachEnvOpts struct {
+	detachEnvVars
+
+	store  environmentStore
+	prompt prompter
+	sel    configSelector
+
+	// Cached configuration of the environment to print recommended actions.
+	envConfig *config.Environment
+}
+
+func newDetachEnvOpts(vars detachEnvVars) (*detachEnvOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("env detach"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+	store := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+
+	prompter := prompt.New()
+	return &detachEnvOpts{
+		detachEnvVars: vars,
+
+		store:  store,
+		sel:    selector.NewConfigSelector(prompter, store),
+		prompt: prompter,
+	}, nil
+}
+
+// Validate returns an error if the individual user inputs are invalid.
+func (o *detachEnvOpts) Validate() error {
+	if o.name != "" {
+		if _, err := o.getEnvConfig(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask prompts for fields that are required but not passed in.
+func (o *detachEnvOpts) Ask() error {
+	if err := o.askAppName(); err != nil {
+		return err
+	}
+	if err := o.askEnvName(); err != nil {
+		return err
+	}
+	if o.skipConfirmation {
+		return nil
+	}
+	detachConfirmed, err := o.prompt.Confirm(fmt.Sprintf(fmtDetachEnvPrompt, o.name, o.appName), detachEnvConfirmHelp, prompt.WithConfirmFinalMessage())
+	if err != nil {
+		return fmt.Errorf("confirm to detach environment %s: %w", o.name, err)
+	}
+	if !detachConfirmed {
+		return errEnvDetachCancelled
+	}
+	return nil
+}
+
+// ValidateVersionPin returns an error if the application doesn't allow this version of Copilot to detach its environments.
+func (o *detachEnvOpts) ValidateVersionPin() error {
+	return validateVersionPin(o.store, o.appName, version.Version, "")
+}
+
+// Execute removes the environment from the application's configuration.
+// Unlike "env delete", the environment's CloudFormation stack, IAM roles and the application's regional resources
+// are left intact so that the environment can be registered again with "env adopt".
+func (o *detachEnvOpts) Execute() error {
+	if _, err := o.getEnvConfig(); err != nil {
+		return err
+	}
+	if err := o.store.DeleteEnvironment(o.appName, o.name); err != nil {
+		return fmt.Errorf("delete environment %s configuration from application %s: %w", o.name, o.appName, err)
+	}
+	log.Successf("Detached environment %s from application %s.\n", color.HighlightUserInput(o.name), color.HighlightUserInput(o.appName))
+	log.Warningf("The resources of environment %s and of its workloads are still deployed in account %s and region %s.\n",
+		o.name, o.envConfig.AccountID, o.envConfig.Region)
+	return nil
+}
+
+// RecommendActions prints how to register the environment to an application again.
+func (o *detachEnvOpts) RecommendActions() error {
+	logRecommendedActions([]string{
+		fmt.Sprintf("Run %s with credentials for account %s to register the environment again.",
+			color.HighlightCode(fmt.Sprintf("copilot env adopt --app %s --name %s --region %s", o.appName, o.name, o.envConfig.Region)),
+			o.envConfig.AccountID),
+	})
+	return nil
+}
+
+func (o *detachEnvOpts) askAppName() error {
+	if o.appName != "" {
+		return nil
+	}
+	app, err := o.sel.Application(envDetachAppNamePrompt, envDetachAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("ask for application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *detachEnvOpts) askEnvName() error {
+	if o.name != "" {
+		return nil
+	}
+	env, err := o.sel.Environment(envDetachNamePrompt, "", o.appName)
+	if err != nil {
+		return fmt.Errorf("select environment to detach: %w", err)
+	}
+	o.name = env
+	return nil
+}
+
+func (o *detachEnvOpts) getEnvConfig() (*config.Environment, error) {
+	if o.envConfig != nil {
+		return o.envConfig, nil
+	}
+	env, err := o.store.GetEnvironment(o.appName, o.name)
+	if err != nil {
+		return nil, fmt.Errorf("get environment %s configuration from app %s: %w", o.name, o.appName, err)
+	}
+	o.envConfig = env
+	return env, nil
+}
+
+// buildEnvDetachCmd builds the command to detach an environment from an application.
+func buildEnvDetachCmd() *cobra.Command {
+	vars := detachEnvVars{}
+	cmd := &cobra.Command{
+		Use:   "detach",
+		Short: "Removes an environment from your application without deleting its resources.",
+		Long: `Removes an environment from your application without deleting its resources.
+The environment's stack keeps running and can be registered again with "copilot env adopt".`,
+		Example: `
+  Detach the "test" environment.
+  /code $ copilot env detach --name test
+
+  Detach the "test" environment without prompting.
+  /code $ copilot env detach --name test --yes`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newDetachEnvOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", envFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	markAudited(cmd)
+	return cmd
+}
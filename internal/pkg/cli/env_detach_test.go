@@ -0,0 +1,166 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetachEnvOpts_Ask(t *testing.T) {
+	const (
+		testApp = "phonetool"
+		testEnv = "test"
+	)
+	testCases := map[string]struct {
+		inAppName          string
+		inEnvName          string
+		inSkipConfirmation bool
+
+		mockDependencies func(ctrl *gomock.Controller, o *detachEnvOpts)
+
+		wantedAppName string
+		wantedEnvName string
+		wantedError   error
+	}{
+		"prompts for the application and environment": {
+			inSkipConfirmation: true,
+			mockDependencies: func(ctrl *gomock.Controller, o *detachEnvOpts) {
+				m := mocks.NewMockconfigSelector(ctrl)
+				m.EXPECT().Application(envDetachAppNamePrompt, envDetachAppNameHelpPrompt).Return(testApp, nil)
+				m.EXPECT().Environment(envDetachNamePrompt, "", testApp).Return(testEnv, nil)
+				o.sel = m
+			},
+			wantedAppName: testApp,
+			wantedEnvName: testEnv,
+		},
+		"error if fail to select the environment": {
+			inAppName: testApp,
+			mockDependencies: func(ctrl *gomock.Controller, o *detachEnvOpts) {
+				m := mocks.NewMockconfigSelector(ctrl)
+				m.EXPECT().Environment(envDetachNamePrompt, "", testApp).Return("", errors.New("some error"))
+				o.sel = m
+			},
+			wantedError: errors.New("select environment to detach: some error"),
+		},
+		"error if the detach is not confirmed": {
+			inAppName: testApp,
+			inEnvName: testEnv,
+			mockDependencies: func(ctrl *gomock.Controller, o *detachEnvOpts) {
+				m := mocks.NewMockprompter(ctrl)
+				m.EXPECT().Confirm(fmt.Sprintf(fmtDetachEnvPrompt, testEnv, testApp), detachEnvConfirmHelp, gomock.Any()).Return(false, nil)
+				o.prompt = m
+			},
+			wantedError: errEnvDetachCancelled,
+		},
+		"confirmed detach": {
+			inAppName: testApp,
+			inEnvName: testEnv,
+			mockDependencies: func(ctrl *gomock.Controller, o *detachEnvOpts) {
+				m := mocks.NewMockprompter(ctrl)
+				m.EXPECT().Confirm(fmt.Sprintf(fmtDetachEnvPrompt, testEnv, testApp), detachEnvConfirmHelp, gomock.Any()).Return(true, nil)
+				o.prompt = m
+			},
+			wantedAppName: testApp,
+			wantedEnvName: testEnv,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			opts := &detachEnvOpts{
+				detachEnvVars: detachEnvVars{
+					appName:          tc.inAppName,
+					name:             tc.inEnvName,
+					skipConfirmation: tc.inSkipConfirmation,
+				},
+			}
+			tc.mockDependencies(ctrl, opts)
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedAppName, opts.appName)
+			require.Equal(t, tc.wantedEnvName, opts.name)
+		})
+	}
+}
+
+func TestDetachEnvOpts_Execute(t *testing.T) {
+	const (
+		testApp = "phonetool"
+		testEnv = "test"
+	)
+	testCases := map[string]struct {
+		mockStore func(m *mocks.MockenvironmentStore)
+
+		wantedError error
+	}{
+		"error if the environment doesn't exist": {
+			mockStore: func(m *mocks.MockenvironmentStore) {
+				m.EXPECT().GetEnvironment(testApp, testEnv).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get environment test configuration from app phonetool: some error"),
+		},
+		"error if fail to delete the environment configuration": {
+			mockStore: func(m *mocks.MockenvironmentStore) {
+				m.EXPECT().GetEnvironment(testApp, testEnv).Return(&config.Environment{Name: testEnv}, nil)
+				m.EXPECT().DeleteEnvironment(testApp, testEnv).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("delete environment test configuration from application phonetool: some error"),
+		},
+		"only removes the environment configuration": {
+			mockStore: func(m *mocks.MockenvironmentStore) {
+				m.EXPECT().GetEnvironment(testApp, testEnv).Return(&config.Environment{
+					Name:      testEnv,
+					AccountID: "123456789012",
+					Region:    "us-west-2",
+				}, nil)
+				m.EXPECT().DeleteEnvironment(testApp, testEnv).Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockenvironmentStore(ctrl)
+			tc.mockStore(m)
+			opts := &detachEnvOpts{
+				detachEnvVars: detachEnvVars{
+					appName: testApp,
+					name:    testEnv,
+				},
+				store: m,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -24,13 +24,16 @@ import (
 	"github.com/aws/aws-sdk-go/service/ssm"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
 	"github.com/aws/copilot-cli/internal/pkg/aws/iam"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/partitions"
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/aws/copilot-cli/internal/pkg/aws/servicequotas"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
@@ -76,6 +79,10 @@ https://aws.github.io/copilot-cli/docs/credentials/#environment-credentials`
 	fmtDNSDelegationStart    = "Sharing DNS permissions for this application to account %s."
 	fmtDNSDelegationFailed   = "Failed to grant DNS permissions to account %s.\n\n"
 	fmtDNSDelegationComplete = "Shared DNS permissions for this application to account %s.\n\n"
+
+	envInitPreflightStart    = "Checking IAM permissions and service quotas for the environment."
+	envInitPreflightFailed   = "Found problems that prevent the environment from being created.\n\n"
+	envInitPreflightComplete = "Checked IAM permissions and service quotas for the environment.\n\n"
 )
 
 var (
@@ -149,11 +156,16 @@ type initEnvVars struct {
 	adjustVPC          adjustVPCVars // Configure parameters for VPC resources generated while initializing an environment.
 	telemetry          telemetryVars // Configure observability and monitoring settings.
 	importCerts        []string      // Additional existing ACM certificates to use.
+	importClusterName  string        // Existing ECS cluster to use instead of creating one.
 	internalALBSubnets []string      // Subnets to be used for internal ALB placement.
 	allowVPCIngress    bool          // True means the env stack will create ingress to the internal ALB from ports 80/443.
+	kmsKeyARN          string        // Customer managed KMS key to encrypt the environment's resources with.
+	cfnExecutionRole   string        // Existing IAM role assumed by CloudFormation to deploy the environment and its workloads.
+	skipPreflight      bool          // True means the IAM permissions and service quotas are not checked before creating the environment.
 
 	tempCreds tempCredsVars // Temporary credentials to initialize the environment. Mutually exclusive with the profile.
 	region    string        // The region to create the environment in.
+	account   string        // The account to create the environment in by assuming the roles of the environment roles template.
 }
 
 type initEnvOpts struct {
@@ -178,8 +190,14 @@ type initEnvOpts struct {
 	appCFN              appResourcesGetter
 	manifestWriter      environmentManifestWriter
 	envLister           wsEnvironmentsLister
+	appPermissions      permissionSimulator
+	preflight           *envPreflight
+
+	sess      *session.Session // Session pointing to environment's AWS account and region.
+	appRegion string           // Region of the application's resources.
 
-	sess *session.Session // Session pointing to environment's AWS account and region.
+	// Role of the environment roles template that CloudFormation assumes to create the bootstrap stack with --account.
+	bootstrapExecutionRole string
 
 	// Cached variables.
 	wsAppName        string
@@ -202,13 +220,15 @@ func newInitEnvOpts(vars initEnvVars) (*initEnvOpts, error) {
 		return nil, err
 	}
 	return &initEnvOpts{
-		initEnvVars:  vars,
-		sessProvider: sessProvider,
-		store:        store,
-		appDeployer:  deploycfn.New(defaultSession, deploycfn.WithProgressTracker(os.Stderr)),
-		identity:     identity.New(defaultSession),
-		prog:         termprogress.NewSpinner(log.DiagnosticWriter),
-		prompt:       prompter,
+		initEnvVars:    vars,
+		sessProvider:   sessProvider,
+		store:          store,
+		appDeployer:    deploycfn.New(defaultSession, deploycfn.WithProgressTracker(os.Stderr)),
+		identity:       identity.New(defaultSession),
+		appPermissions: iam.New(defaultSession),
+		appRegion:      aws.StringValue(defaultSession.Config.Region),
+		prog:           termprogress.NewSpinner(log.DiagnosticWriter),
+		prompt:         prompter,
 		selCreds: func() (credsSelector, error) {
 			cfg, err := profile.NewConfig()
 			if err != nil {
@@ -252,6 +272,21 @@ func (o *initEnvOpts) Validate() error {
 	if err := o.validateCustomizedResources(); err != nil {
 		return err
 	}
+	if o.kmsKeyARN != "" {
+		if err := validateKMSKeyARN(o.kmsKeyARN); err != nil {
+			return fmt.Errorf("--%s: %w", kmsKeyARNFlag, err)
+		}
+	}
+	if o.cfnExecutionRole != "" {
+		if err := validateIAMRoleARN(o.cfnExecutionRole); err != nil {
+			return fmt.Errorf("--%s: %w", cfnExecutionRoleFlag, err)
+		}
+	}
+	if o.account != "" {
+		if err := validateAccountID(o.account); err != nil {
+			return fmt.Errorf("--%s: %w", envAccountFlag, err)
+		}
+	}
 	return o.validateCredentials()
 }
 
@@ -269,6 +304,11 @@ func (o *initEnvOpts) Ask() error {
 	return o.askCustomizedResources()
 }
 
+// ValidateVersionPin returns an error if the application doesn't allow this version of Copilot to deploy its environments.
+func (o *initEnvOpts) ValidateVersionPin() error {
+	return validateVersionPin(o.store, o.appName, o.templateVersion, o.templateVersion)
+}
+
 // Execute deploys a new environment with CloudFormation and adds it to SSM.
 func (o *initEnvOpts) Execute() error {
 	if err := o.initRuntimeClients(); err != nil {
@@ -292,6 +332,11 @@ func (o *initEnvOpts) Execute() error {
 	if err != nil {
 		return fmt.Errorf("get identity: %w", err)
 	}
+	if !o.skipPreflight {
+		if err := o.runPreflight(envCaller); err != nil {
+			return err
+		}
+	}
 
 	// 1. Write environment manifest.
 	path, err := o.writeManifest()
@@ -323,7 +368,12 @@ func (o *initEnvOpts) Execute() error {
 	}
 
 	// 5. Start creating the CloudFormation stack for the environment.
-	if err := o.deployEnv(app); err != nil {
+	execRoleARN := o.cfnExecutionRoleARN(app, envCaller.Account)
+	bootstrapRoleARN := execRoleARN
+	if bootstrapRoleARN == "" {
+		bootstrapRoleARN = o.bootstrapExecutionRole
+	}
+	if err := o.deployEnv(app, bootstrapRoleARN); err != nil {
 		return err
 	}
 
@@ -332,6 +382,11 @@ func (o *initEnvOpts) Execute() error {
 	if err != nil {
 		return fmt.Errorf("get environment struct for %s: %w", o.name, err)
 	}
+	if execRoleARN != "" {
+		// CloudFormation keeps assuming the user's role instead of the one created by the environment stack.
+		env.ExecutionRoleARN = execRoleARN
+		env.CustomExecutionRole = true
+	}
 	if err := o.store.CreateEnvironment(env); err != nil {
 		return fmt.Errorf("store environment: %w", err)
 	}
@@ -364,6 +419,43 @@ func (o *initEnvOpts) initRuntimeClients() error {
 	if o.iam == nil {
 		o.iam = iam.New(o.sess)
 	}
+	if o.preflight == nil && !o.skipPreflight {
+		o.preflight = &envPreflight{
+			envPermissions: iam.New(o.sess),
+			appPermissions: o.appPermissions,
+			quotas:         servicequotas.New(o.sess),
+			network:        ec2.New(o.sess),
+			loadBalancers:  elbv2.New(o.sess),
+		}
+	}
+	return nil
+}
+
+// runPreflight returns an error listing the problems that would prevent the environment from being created.
+func (o *initEnvOpts) runPreflight(envCaller identity.Caller) error {
+	appCaller, err := o.identity.Get()
+	if err != nil {
+		return fmt.Errorf("get identity of the application account: %w", err)
+	}
+	o.prog.Start(envInitPreflightStart)
+	report := o.preflight.run(envPreflightInput{
+		app:          o.appName,
+		env:          o.name,
+		envRegion:    aws.StringValue(o.sess.Config.Region),
+		appRegion:    o.appRegion,
+		envCallerARN: envCaller.ARN,
+		appCallerARN: appCaller.ARN,
+		createsVPC:   o.importVPCConfig() == nil,
+		azCount:      len(o.adjustVPC.AZs),
+	})
+	if len(report.blockers) != 0 {
+		o.prog.Stop(log.Serror(envInitPreflightFailed))
+		return &errEnvPreflightBlocked{blockers: report.blockers}
+	}
+	o.prog.Stop(log.Ssuccess(envInitPreflightComplete))
+	for _, warning := range report.warnings {
+		log.Warningf("%s.\n", warning)
+	}
 	return nil
 }
 
@@ -374,6 +466,11 @@ func (o *initEnvOpts) validateCustomizedResources() error {
 	if (o.importVPC.isSet() || o.adjustVPC.isSet()) && o.defaultConfig {
 		return fmt.Errorf("cannot import or configure vpc if --%s is set", defaultConfigFlag)
 	}
+	if o.importClusterName != "" {
+		if err := o.validateImportedCluster(); err != nil {
+			return err
+		}
+	}
 	if o.internalALBSubnets != nil && (o.adjustVPC.isSet() || o.defaultConfig) {
 		log.Error(`To specify internal ALB subnet placement, you must import existing resources, including subnets.
 For default config without subnet placement specification, Copilot will place the internal ALB in the generated private subnets.`)
@@ -413,6 +510,9 @@ func (o *initEnvOpts) askEnvName() error {
 }
 
 func (o *initEnvOpts) askEnvSession() error {
+	if o.account != "" {
+		return o.assumeEnvManagerRole()
+	}
 	if o.profile != "" {
 		sess, err := o.sessProvider.FromProfile(o.profile)
 		if err != nil {
@@ -449,6 +549,43 @@ func (o *initEnvOpts) askEnvSession() error {
 	return nil
 }
 
+// assumeEnvManagerRole creates a session in the environment's account from the application's credentials,
+// by assuming the role created by "copilot app init --env-roles-template" in that account.
+// CloudFormation assumes the execution role of the same template to create the bootstrap stack of the environment,
+// which creates the roles that the application's credentials assume afterwards.
+func (o *initEnvOpts) assumeEnvManagerRole() error {
+	defaultSess, err := o.sessProvider.Default()
+	if err != nil {
+		return err
+	}
+	region := aws.StringValue(defaultSess.Config.Region)
+	if o.region != "" {
+		region = o.region
+	}
+	partition, err := partitions.Region(region).Partition()
+	if err != nil {
+		return err
+	}
+	roleARN := arn.ARN{
+		Partition: partition.ID(),
+		Service:   "iam",
+		AccountID: o.account,
+		Resource:  "role/" + stack.NameForAppEnvManagerRole(o.appName),
+	}.String()
+	sess, err := o.sessProvider.FromRole(roleARN, region)
+	if err != nil {
+		return fmt.Errorf("create session from role %s: %w", roleARN, err)
+	}
+	o.sess = sess
+	o.bootstrapExecutionRole = arn.ARN{
+		Partition: partition.ID(),
+		Service:   "iam",
+		AccountID: o.account,
+		Resource:  "role/" + stack.NameForAppEnvCFNExecutionRole(o.appName),
+	}.String()
+	return nil
+}
+
 func (o *initEnvOpts) askEnvRegion() error {
 	region := aws.StringValue(o.sess.Config.Region)
 	if o.region != "" {
@@ -720,7 +857,19 @@ func (o *initEnvOpts) adjustVPCConfig() *config.AdjustVPC {
 	}
 }
 
-func (o *initEnvOpts) deployEnv(app *config.Application) error {
+// cfnExecutionRoleARN returns the existing role that CloudFormation assumes to deploy the environment, if any.
+// The role of the application is only used if the environment is in the same account as the application.
+func (o *initEnvOpts) cfnExecutionRoleARN(app *config.Application, envAccountID string) string {
+	if o.cfnExecutionRole != "" {
+		return o.cfnExecutionRole
+	}
+	if envAccountID == app.AccountID {
+		return app.CFNExecutionRoleARN
+	}
+	return ""
+}
+
+func (o *initEnvOpts) deployEnv(app *config.Application, execRoleARN string) error {
 	envRegion := aws.StringValue(o.sess.Config.Region)
 	resources, err := o.appCFN.GetAppResourcesByRegion(app, envRegion)
 	if err != nil {
@@ -756,7 +905,11 @@ func (o *initEnvOpts) deployEnv(app *config.Application) error {
 	if err := o.cleanUpDanglingRoles(o.appName, o.name); err != nil {
 		return err
 	}
-	if err := o.envDeployer.CreateAndRenderEnvironment(stack.NewBootstrapEnvStackConfig(deployEnvInput), artifactBucketARN); err != nil {
+	var opts []cloudformation.StackOption
+	if execRoleARN != "" {
+		opts = append(opts, cloudformation.WithRoleARN(execRoleARN))
+	}
+	if err := o.envDeployer.CreateAndRenderEnvironment(stack.NewBootstrapEnvStackConfig(deployEnvInput), artifactBucketARN, opts...); err != nil {
 		var existsErr *cloudformation.ErrStackAlreadyExists
 		if errors.As(err, &existsErr) {
 			// Do nothing if the stack already exists.
@@ -802,6 +955,12 @@ func (o *initEnvOpts) validateCredentials() error {
 	if o.profile != "" && o.tempCreds.SessionToken != "" {
 		return fmt.Errorf("cannot specify both --%s and --%s", profileFlag, sessionTokenFlag)
 	}
+	if o.account != "" && o.profile != "" {
+		return fmt.Errorf("cannot specify both --%s and --%s", envAccountFlag, profileFlag)
+	}
+	if o.account != "" && o.tempCreds.AccessKeyID != "" {
+		return fmt.Errorf("cannot specify both --%s and --%s", envAccountFlag, accessKeyIDFlag)
+	}
 	return nil
 }
 
@@ -865,6 +1024,19 @@ func (o *initEnvOpts) tryDeletingEnvRoles(app, env string) {
 	}
 }
 
+func (o *initEnvOpts) validateImportedCluster() error {
+	if o.defaultConfig {
+		return fmt.Errorf("cannot specify both `--%s` and `--%s`", importClusterNameFlag, defaultConfigFlag)
+	}
+	if o.telemetry.EnableContainerInsights {
+		return fmt.Errorf("cannot specify both `--%s` and `--%s`: enable Container Insights on the imported cluster instead", importClusterNameFlag, enableContainerInsightsFlag)
+	}
+	if strings.HasPrefix(o.importClusterName, "arn:") {
+		return fmt.Errorf("`--%s` must be the name of an ECS cluster, not an ARN", importClusterNameFlag)
+	}
+	return nil
+}
+
 func (o *initEnvOpts) writeManifest() (string, error) {
 	customizedEnv := &config.CustomizeEnv{
 		ImportVPC:                   o.importVPCConfig(),
@@ -880,6 +1052,8 @@ func (o *initEnvOpts) writeManifest() (string, error) {
 		Name:         o.name,
 		CustomConfig: customizedEnv,
 		Telemetry:    o.telemetry.toConfig(),
+		KMSKeyARN:    o.kmsKeyARN,
+		ClusterName:  o.importClusterName,
 	}
 
 	var manifestExists bool
@@ -940,7 +1114,19 @@ func buildEnvInitCmd() *cobra.Command {
   /code $ copilot env init --override-vpc-cidr 10.1.0.0/16 \
   /code --override-az-names us-west-2b,us-west-2c \
   /code --override-public-cidrs 10.1.0.0/24,10.1.1.0/24 \
-  /code --override-private-cidrs 10.1.2.0/24,10.1.3.0/24`,
+  /code --override-private-cidrs 10.1.2.0/24,10.1.3.0/24
+
+  Creates an environment that runs its workloads in an existing ECS cluster.
+  /code $ copilot env init --name prod --import-cluster-name shared-cluster
+
+  Creates an environment whose resources are encrypted with a customer managed KMS key.
+  /code $ copilot env init --name prod --kms-key-arn arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab
+
+  Creates an environment whose stacks are deployed by CloudFormation with an existing service role.
+  /code $ copilot env init --name prod --cfn-execution-role arn:aws:iam::123456789012:role/CloudFormationServiceRole
+
+  Creates an environment in an account provisioned with "copilot app init --env-roles-template", with the credentials of the application's account.
+  /code $ copilot env init --name prod --account 123456789012 --region us-west-2`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newInitEnvOpts(vars)
 			if err != nil {
@@ -956,6 +1142,7 @@ func buildEnvInitCmd() *cobra.Command {
 	cmd.Flags().StringVar(&vars.tempCreds.SecretAccessKey, secretAccessKeyFlag, "", secretAccessKeyFlagDescription)
 	cmd.Flags().StringVar(&vars.tempCreds.SessionToken, sessionTokenFlag, "", sessionTokenFlagDescription)
 	cmd.Flags().StringVar(&vars.region, regionFlag, "", envRegionTokenFlagDescription)
+	cmd.Flags().StringVar(&vars.account, envAccountFlag, "", envAccountFlagDescription)
 	cmd.Flags().BoolVar(&vars.allowAppDowngrade, allowDowngradeFlag, false, allowDowngradeFlagDescription)
 
 	cmd.Flags().BoolVar(&vars.isProduction, prodEnvFlag, false, prodEnvFlagDescription) // Deprecated. Use telemetry flags instead.
@@ -965,6 +1152,7 @@ func buildEnvInitCmd() *cobra.Command {
 	cmd.Flags().StringSliceVar(&vars.importVPC.PublicSubnetIDs, publicSubnetsFlag, nil, publicSubnetsFlagDescription)
 	cmd.Flags().StringSliceVar(&vars.importVPC.PrivateSubnetIDs, privateSubnetsFlag, nil, privateSubnetsFlagDescription)
 	cmd.Flags().StringSliceVar(&vars.importCerts, certsFlag, nil, certsFlagDescription)
+	cmd.Flags().StringVar(&vars.importClusterName, importClusterNameFlag, "", importClusterNameFlagDescription)
 	cmd.Flags().IPNetVar(&vars.adjustVPC.CIDR, overrideVPCCIDRFlag, net.IPNet{}, overrideVPCCIDRFlagDescription)
 	cmd.Flags().StringSliceVar(&vars.adjustVPC.AZs, overrideAZsFlag, nil, overrideAZsFlagDescription)
 	// TODO: use IPNetSliceVar when it is available (https://github.com/spf13/pflag/issues/273).
@@ -973,6 +1161,9 @@ func buildEnvInitCmd() *cobra.Command {
 	cmd.Flags().StringSliceVar(&vars.internalALBSubnets, internalALBSubnetsFlag, nil, internalALBSubnetsFlagDescription)
 	cmd.Flags().BoolVar(&vars.allowVPCIngress, allowVPCIngressFlag, false, allowVPCIngressFlagDescription)
 	cmd.Flags().BoolVar(&vars.defaultConfig, defaultConfigFlag, false, defaultConfigFlagDescription)
+	cmd.Flags().StringVar(&vars.kmsKeyARN, kmsKeyARNFlag, "", kmsKeyARNFlagDescription)
+	cmd.Flags().StringVar(&vars.cfnExecutionRole, cfnExecutionRoleFlag, "", envCFNExecutionRoleFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipPreflight, skipPreflightFlag, false, skipPreflightFlagDescription)
 
 	flags := pflag.NewFlagSet("Common", pflag.ContinueOnError)
 	flags.AddFlag(cmd.Flags().Lookup(appFlag))
@@ -982,14 +1173,18 @@ func buildEnvInitCmd() *cobra.Command {
 	flags.AddFlag(cmd.Flags().Lookup(secretAccessKeyFlag))
 	flags.AddFlag(cmd.Flags().Lookup(sessionTokenFlag))
 	flags.AddFlag(cmd.Flags().Lookup(regionFlag))
+	flags.AddFlag(cmd.Flags().Lookup(envAccountFlag))
 	flags.AddFlag(cmd.Flags().Lookup(defaultConfigFlag))
 	flags.AddFlag(cmd.Flags().Lookup(allowDowngradeFlag))
+	flags.AddFlag(cmd.Flags().Lookup(cfnExecutionRoleFlag))
+	flags.AddFlag(cmd.Flags().Lookup(skipPreflightFlag))
 
 	resourcesImportFlags := pflag.NewFlagSet("Import Existing Resources", pflag.ContinueOnError)
 	resourcesImportFlags.AddFlag(cmd.Flags().Lookup(vpcIDFlag))
 	resourcesImportFlags.AddFlag(cmd.Flags().Lookup(publicSubnetsFlag))
 	resourcesImportFlags.AddFlag(cmd.Flags().Lookup(privateSubnetsFlag))
 	resourcesImportFlags.AddFlag(cmd.Flags().Lookup(certsFlag))
+	resourcesImportFlags.AddFlag(cmd.Flags().Lookup(importClusterNameFlag))
 
 	resourcesConfigFlags := pflag.NewFlagSet("Configure Default Resources", pflag.ContinueOnError)
 	resourcesConfigFlags.AddFlag(cmd.Flags().Lookup(overrideVPCCIDRFlag))
@@ -1002,13 +1197,17 @@ func buildEnvInitCmd() *cobra.Command {
 	telemetryFlags := pflag.NewFlagSet("Telemetry", pflag.ContinueOnError)
 	telemetryFlags.AddFlag(cmd.Flags().Lookup(enableContainerInsightsFlag))
 
+	encryptionFlags := pflag.NewFlagSet("Encryption", pflag.ContinueOnError)
+	encryptionFlags.AddFlag(cmd.Flags().Lookup(kmsKeyARNFlag))
+
 	cmd.Annotations = map[string]string{
 		// The order of the sections we want to display.
-		"sections":                    "Common,Import Existing Resources,Configure Default Resources,Telemetry",
+		"sections":                    "Common,Import Existing Resources,Configure Default Resources,Telemetry,Encryption",
 		"Common":                      flags.FlagUsages(),
 		"Import Existing Resources":   resourcesImportFlags.FlagUsages(),
 		"Configure Default Resources": resourcesConfigFlags.FlagUsages(),
 		"Telemetry":                   telemetryFlags.FlagUsages(),
+		"Encryption":                  encryptionFlags.FlagUsages(),
 	}
 
 	cmd.SetUsageTemplate(`{{h1 "Usage"}}{{if .Runnable}}
@@ -1025,5 +1224,6 @@ func buildEnvInitCmd() *cobra.Command {
 {{h1 "Examples"}}{{code .Example}}{{end}}
 `)
 
+	markAudited(cmd)
 	return cmd
 }
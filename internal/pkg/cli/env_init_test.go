@@ -18,6 +18,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/iam"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
@@ -57,7 +58,12 @@ func TestInitEnvOpts_Validate(t *testing.T) {
 		inAZs         []string
 		inPublicCIDRs []string
 
+		inKMSKeyARN         string
+		inImportClusterName string
+		inContainerInsights bool
+
 		inProfileName     string
+		inAccount         string
 		inAccessKeyID     string
 		inSecretAccessKey string
 		inSessionToken    string
@@ -184,6 +190,29 @@ func TestInitEnvOpts_Validate(t *testing.T) {
 			},
 			wantedErrMsg: "cannot specify both --profile and --aws-secret-access-key",
 		},
+		"should err if the account is not an account ID": {
+			inAppName: "phonetool",
+			inEnvName: "test",
+			inAccount: "phonetool",
+			setupMocks: func(m *initEnvMocks) {
+				m.wsAppName = "phonetool"
+				m.store.EXPECT().GetApplication("phonetool").Return(nil, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(nil, &config.ErrNoSuchEnvironment{})
+			},
+			wantedErrMsg: "--account: value must be a 12-digit AWS account ID (example: 111122223333)",
+		},
+		"should err if both account and profile are set": {
+			inAppName:     "phonetool",
+			inEnvName:     "test",
+			inAccount:     "111122223333",
+			inProfileName: "default",
+			setupMocks: func(m *initEnvMocks) {
+				m.wsAppName = "phonetool"
+				m.store.EXPECT().GetApplication("phonetool").Return(nil, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(nil, &config.ErrNoSuchEnvironment{})
+			},
+			wantedErrMsg: "cannot specify both --account and --profile",
+		},
 		"should err if both profile and session token are set": {
 			inAppName:      "phonetool",
 			inEnvName:      "test",
@@ -276,6 +305,54 @@ func TestInitEnvOpts_Validate(t *testing.T) {
 				m.store.EXPECT().GetApplication("phonetool").Return(nil, nil)
 			},
 		},
+		"fail if kms key is not the arn of a key": {
+			inKMSKeyARN: "arn:aws:kms:us-west-2:123456789012:alias/my-key",
+			setupMocks: func(m *initEnvMocks) {
+				m.wsAppName = "phonetool"
+				m.store.EXPECT().GetApplication("phonetool").Return(nil, nil)
+			},
+			wantedErrMsg: "--kms-key-arn: value must be the ARN of a KMS key (example: arn:aws:kms:us-west-2:111122223333:key/1234abcd-12ab-34cd-56ef-1234567890ab)",
+		},
+		"valid kms key": {
+			inKMSKeyARN: "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+			setupMocks: func(m *initEnvMocks) {
+				m.wsAppName = "phonetool"
+				m.store.EXPECT().GetApplication("phonetool").Return(nil, nil)
+			},
+		},
+		"fail if an imported cluster is used with the default config": {
+			inImportClusterName: "shared",
+			inDefault:           true,
+			setupMocks: func(m *initEnvMocks) {
+				m.wsAppName = "phonetool"
+				m.store.EXPECT().GetApplication("phonetool").Return(nil, nil)
+			},
+			wantedErrMsg: "cannot specify both `--import-cluster-name` and `--default-config`",
+		},
+		"fail if an imported cluster is used with container insights": {
+			inImportClusterName: "shared",
+			inContainerInsights: true,
+			setupMocks: func(m *initEnvMocks) {
+				m.wsAppName = "phonetool"
+				m.store.EXPECT().GetApplication("phonetool").Return(nil, nil)
+			},
+			wantedErrMsg: "cannot specify both `--import-cluster-name` and `--container-insights`: enable Container Insights on the imported cluster instead",
+		},
+		"fail if the imported cluster is an ARN": {
+			inImportClusterName: "arn:aws:ecs:us-west-2:123456789012:cluster/shared",
+			setupMocks: func(m *initEnvMocks) {
+				m.wsAppName = "phonetool"
+				m.store.EXPECT().GetApplication("phonetool").Return(nil, nil)
+			},
+			wantedErrMsg: "`--import-cluster-name` must be the name of an ECS cluster, not an ARN",
+		},
+		"valid imported cluster": {
+			inImportClusterName: "shared",
+			setupMocks: func(m *initEnvMocks) {
+				m.wsAppName = "phonetool"
+				m.store.EXPECT().GetApplication("phonetool").Return(nil, nil)
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -296,6 +373,11 @@ func TestInitEnvOpts_Validate(t *testing.T) {
 					name:               tc.inEnvName,
 					defaultConfig:      tc.inDefault,
 					internalALBSubnets: tc.inInternalALBSubnets,
+					kmsKeyARN:          tc.inKMSKeyARN,
+					importClusterName:  tc.inImportClusterName,
+					telemetry: telemetryVars{
+						EnableContainerInsights: tc.inContainerInsights,
+					},
 					adjustVPC: adjustVPCVars{
 						AZs:               tc.inAZs,
 						PublicSubnetCIDRs: tc.inPublicCIDRs,
@@ -308,6 +390,7 @@ func TestInitEnvOpts_Validate(t *testing.T) {
 					},
 					appName: tc.inAppName,
 					profile: tc.inProfileName,
+					account: tc.inAccount,
 					tempCreds: tempCredsVars{
 						AccessKeyID:     tc.inAccessKeyID,
 						SecretAccessKey: tc.inSecretAccessKey,
@@ -366,6 +449,7 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 		inProfile            string
 		inTempCreds          tempCredsVars
 		inRegion             string
+		inAccount            string
 		inDefault            bool
 		inImportVPCVars      importVPCVars
 		inAdjustVPCVars      adjustVPCVars
@@ -444,6 +528,17 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 				m.sessProvider.EXPECT().FromStaticCreds("abcd", "efgh", "").Return(mockSession, nil)
 			},
 		},
+		"should assume the manager role of the environment roles template if the account is provided": {
+			inAppName: mockApp,
+			inEnv:     mockEnv,
+			inAccount: "111122223333",
+			inDefault: true,
+			setupMocks: func(m initEnvMocks) {
+				m.sessProvider.EXPECT().Default().Return(mockSession, nil)
+				m.sessProvider.EXPECT().FromRole("arn:aws:iam::111122223333:role/test-app-EnvManagerRole", mockRegion).Return(mockSession, nil)
+				m.selCreds.EXPECT().Creds(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
 		"should prompt for credentials if no profile or temp creds flags are provided": {
 			inAppName: mockApp,
 			inEnv:     mockEnv,
@@ -967,6 +1062,7 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 					profile:            tc.inProfile,
 					tempCreds:          tc.inTempCreds,
 					region:             tc.inRegion,
+					account:            tc.inAccount,
 					defaultConfig:      tc.inDefault,
 					adjustVPC:          tc.inAdjustVPCVars,
 					importVPC:          tc.inImportVPCVars,
@@ -1011,6 +1107,10 @@ type initEnvExecuteMocks struct {
 	appCFN           *mocks.MockappResourcesGetter
 	manifestWriter   *mocks.MockenvironmentManifestWriter
 	appVersionGetter *mocks.MockversionGetter
+	permissions      *mocks.MockpermissionSimulator
+	quotas           *mocks.MockquotaGetter
+	network          *mocks.MockenvNetworkCounter
+	loadBalancers    *mocks.MockalbCounter
 }
 
 func TestInitEnvOpts_Execute(t *testing.T) {
@@ -1023,6 +1123,8 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 	testCases := map[string]struct {
 		enableContainerInsights bool
 		allowDowngrade          bool
+		runPreflight            bool
+		bootstrapExecutionRole  string
 		setupMocks              func(m *initEnvExecuteMocks)
 		wantedErrorS            string
 	}{
@@ -1053,6 +1155,44 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 			},
 			wantedErrorS: "get identity: some identity error",
 		},
+		"returns pre-flight blockers before writing the manifest": {
+			runPreflight: true,
+			setupMocks: func(m *initEnvExecuteMocks) {
+				m.appVersionGetter.EXPECT().Version().Return(mockAppVersion, nil)
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{ARN: "arn:aws:iam::1234:user/alice", Account: "1234"}, nil).Times(2)
+				m.permissions.EXPECT().SimulatePolicy("arn:aws:iam::1234:user/alice", gomock.Any()).DoAndReturn(func(_ string, sim iam.PolicySimulation) ([]iam.DeniedAction, error) {
+					if sim.Actions[0] != "iam:CreateRole" {
+						return nil, nil
+					}
+					return []iam.DeniedAction{
+						{Action: "iam:CreateRole", ResourceARN: "arn:aws:iam::1234:role/phonetool-test-CFNExecutionRole", Explicit: true},
+					}, nil
+				}).Times(4)
+				m.quotas.EXPECT().Value(gomock.Any(), gomock.Any()).Return(float64(5), nil).Times(3)
+				m.network.EXPECT().ListVPCs().Return(make([]ec2.VPC, 5), nil)
+				m.network.EXPECT().ElasticIPCount().Return(0, nil)
+				m.loadBalancers.EXPECT().ApplicationLoadBalancerCount().Return(0, nil)
+				m.manifestWriter.EXPECT().WriteEnvironmentManifest(gomock.Any(), gomock.Any()).Times(0)
+				m.progress.EXPECT().Start(envInitPreflightStart)
+				m.progress.EXPECT().Stop(log.Serror(envInitPreflightFailed))
+			},
+			wantedErrorS: `environment cannot be created:
+arn:aws:iam::1234:user/alice is not allowed to perform iam:CreateRole on arn:aws:iam::1234:role/phonetool-test-CFNExecutionRole
+no VPC can be created in the region: 5 in use, quota is 5`,
+		},
+		"returns error when failed to get the identity of the application account for pre-flight checks": {
+			runPreflight: true,
+			setupMocks: func(m *initEnvExecuteMocks) {
+				m.appVersionGetter.EXPECT().Version().Return(mockAppVersion, nil)
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				gomock.InOrder(
+					m.identity.EXPECT().Get().Return(identity.Caller{Account: "1234"}, nil),
+					m.identity.EXPECT().Get().Return(identity.Caller{}, mockError),
+				)
+			},
+			wantedErrorS: "get identity of the application account: some error",
+		},
 		"fail to write manifest": {
 			setupMocks: func(m *initEnvExecuteMocks) {
 				m.appVersionGetter.EXPECT().Version().Return(mockAppVersion, nil)
@@ -1230,7 +1370,7 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 				// Don't attempt to delete any roles since an environment stack already exists.
 				m.iam.EXPECT().ListRoleTags(gomock.Any()).Times(0)
 				m.cfn.EXPECT().Exists("phonetool-test").Return(true, nil)
-				m.deployer.EXPECT().CreateAndRenderEnvironment(gomock.Any(), gomock.Any()).DoAndReturn(func(conf deploycfn.StackConfiguration, bucketARN string) error {
+				m.deployer.EXPECT().CreateAndRenderEnvironment(gomock.Any(), gomock.Any()).DoAndReturn(func(conf deploycfn.StackConfiguration, bucketARN string, opts ...cloudformation.StackOption) error {
 					require.Equal(t, conf, stack.NewBootstrapEnvStackConfig(&stack.EnvConfig{
 						Name: "test",
 						App: deploy.AppInformation{
@@ -1257,6 +1397,93 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 					}, nil)
 			},
 		},
+		"deploys and stores the environment with the CloudFormation execution role of the application": {
+			setupMocks: func(m *initEnvExecuteMocks) {
+				app := &config.Application{
+					Name:                "phonetool",
+					AccountID:           "1234",
+					CFNExecutionRoleARN: "arn:aws:iam::1234:role/CloudFormationServiceRole",
+				}
+				m.appVersionGetter.EXPECT().Version().Return(mockAppVersion, nil)
+				m.store.EXPECT().GetApplication("phonetool").Return(app, nil)
+				m.store.EXPECT().CreateEnvironment(&config.Environment{
+					App:                 "phonetool",
+					Name:                "test",
+					AccountID:           "1234",
+					Region:              "mars-1",
+					ExecutionRoleARN:    "arn:aws:iam::1234:role/CloudFormationServiceRole",
+					CustomExecutionRole: true,
+				}).Return(nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{RootUserARN: "some arn", Account: "1234"}, nil).Times(2)
+				m.manifestWriter.EXPECT().WriteEnvironmentManifest(gomock.Any(), "test").Return("/environments/test/manifest.yml", nil)
+				m.iam.EXPECT().CreateECSServiceLinkedRole().Return(nil)
+				m.iam.EXPECT().ListRoleTags(gomock.Any()).Return(nil, errors.New("does not exist")).Times(2)
+				m.cfn.EXPECT().Exists("phonetool-test").Return(false, nil)
+				m.deployer.EXPECT().CreateAndRenderEnvironment(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(conf deploycfn.StackConfiguration, bucketARN string, opts ...cloudformation.StackOption) error {
+					s := cloudformation.NewStack("phonetool-test", "")
+					for _, opt := range opts {
+						opt(s)
+					}
+					require.Equal(t, "arn:aws:iam::1234:role/CloudFormationServiceRole", aws.StringValue(s.RoleARN))
+					return nil
+				})
+				m.deployer.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{
+					AccountID:        "1234",
+					Region:           "mars-1",
+					Name:             "test",
+					App:              "phonetool",
+					ExecutionRoleARN: "arn:aws:iam::1234:role/phonetool-test-CFNExecutionRole",
+				}, nil)
+				m.deployer.EXPECT().AddEnvToApp(gomock.Any()).Return(nil)
+				m.appCFN.EXPECT().GetAppResourcesByRegion(app, "us-west-2").
+					Return(&stack.AppRegionalResources{
+						S3Bucket: "mockBucket",
+					}, nil)
+			},
+		},
+		"creates the bootstrap stack with the execution role of the environment roles template": {
+			bootstrapExecutionRole: "arn:aws:iam::5678:role/phonetool-EnvCFNExecutionRole",
+			setupMocks: func(m *initEnvExecuteMocks) {
+				app := &config.Application{
+					Name:      "phonetool",
+					AccountID: "1234",
+				}
+				m.appVersionGetter.EXPECT().Version().Return(mockAppVersion, nil)
+				m.store.EXPECT().GetApplication("phonetool").Return(app, nil)
+				m.store.EXPECT().CreateEnvironment(&config.Environment{
+					App:              "phonetool",
+					Name:             "test",
+					AccountID:        "5678",
+					Region:           "mars-1",
+					ExecutionRoleARN: "arn:aws:iam::5678:role/phonetool-test-CFNExecutionRole",
+				}).Return(nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{RootUserARN: "some arn", Account: "5678"}, nil).Times(2)
+				m.manifestWriter.EXPECT().WriteEnvironmentManifest(gomock.Any(), "test").Return("/environments/test/manifest.yml", nil)
+				m.iam.EXPECT().CreateECSServiceLinkedRole().Return(nil)
+				m.iam.EXPECT().ListRoleTags(gomock.Any()).Return(nil, errors.New("does not exist")).Times(2)
+				m.cfn.EXPECT().Exists("phonetool-test").Return(false, nil)
+				m.deployer.EXPECT().CreateAndRenderEnvironment(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(conf deploycfn.StackConfiguration, bucketARN string, opts ...cloudformation.StackOption) error {
+					s := cloudformation.NewStack("phonetool-test", "")
+					for _, opt := range opts {
+						opt(s)
+					}
+					require.Equal(t, "arn:aws:iam::5678:role/phonetool-EnvCFNExecutionRole", aws.StringValue(s.RoleARN))
+					return nil
+				})
+				m.deployer.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{
+					AccountID:        "5678",
+					Region:           "mars-1",
+					Name:             "test",
+					App:              "phonetool",
+					ExecutionRoleARN: "arn:aws:iam::5678:role/phonetool-test-CFNExecutionRole",
+				}, nil)
+				m.deployer.EXPECT().AddEnvToApp(gomock.Any()).Return(nil)
+				m.appCFN.EXPECT().GetAppResourcesByRegion(app, "us-west-2").
+					Return(&stack.AppRegionalResources{
+						S3Bucket: "mockBucket",
+					}, nil)
+			},
+		},
 		"failed to delegate DNS (app has Domain and env and apps are different)": {
 			setupMocks: func(m *initEnvExecuteMocks) {
 				m.appVersionGetter.EXPECT().Version().Return(mockAppVersion, nil)
@@ -1325,6 +1552,10 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 				appCFN:           mocks.NewMockappResourcesGetter(ctrl),
 				manifestWriter:   mocks.NewMockenvironmentManifestWriter(ctrl),
 				appVersionGetter: mocks.NewMockversionGetter(ctrl),
+				permissions:      mocks.NewMockpermissionSimulator(ctrl),
+				quotas:           mocks.NewMockquotaGetter(ctrl),
+				network:          mocks.NewMockenvNetworkCounter(ctrl),
+				loadBalancers:    mocks.NewMockalbCounter(ctrl),
 			}
 			tc.setupMocks(m)
 			provider := sessions.ImmutableProvider()
@@ -1338,6 +1569,14 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 						EnableContainerInsights: tc.enableContainerInsights,
 					},
 					allowAppDowngrade: tc.allowDowngrade,
+					skipPreflight:     !tc.runPreflight,
+				},
+				preflight: &envPreflight{
+					envPermissions: m.permissions,
+					appPermissions: m.permissions,
+					quotas:         m.quotas,
+					network:        m.network,
+					loadBalancers:  m.loadBalancers,
 				},
 				store:       m.store,
 				envDeployer: m.deployer,
@@ -1348,12 +1587,14 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 				cfn:         m.cfn,
 				prog:        m.progress,
 				sess:        sess,
+				appRegion:   "us-west-2",
 				appCFN:      m.appCFN,
 				newAppVersionGetter: func(appName string) (versionGetter, error) {
 					return m.appVersionGetter, nil
 				},
-				manifestWriter:  m.manifestWriter,
-				templateVersion: mockCurrVersion,
+				manifestWriter:         m.manifestWriter,
+				templateVersion:        mockCurrVersion,
+				bootstrapExecutionRole: tc.bootstrapExecutionRole,
 			}
 
 			// WHEN
@@ -1369,6 +1610,44 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 	}
 }
 
+func TestInitEnvOpts_cfnExecutionRoleARN(t *testing.T) {
+	testCases := map[string]struct {
+		inRole       string
+		inEnvAccount string
+
+		wantedRole string
+	}{
+		"uses the role from the flag": {
+			inRole:       "arn:aws:iam::4567:role/EnvServiceRole",
+			inEnvAccount: "4567",
+			wantedRole:   "arn:aws:iam::4567:role/EnvServiceRole",
+		},
+		"defaults to the role of the application in the same account": {
+			inEnvAccount: "1234",
+			wantedRole:   "arn:aws:iam::1234:role/AppServiceRole",
+		},
+		"does not use the role of the application in another account": {
+			inEnvAccount: "4567",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &initEnvOpts{
+				initEnvVars: initEnvVars{
+					cfnExecutionRole: tc.inRole,
+				},
+			}
+
+			role := opts.cfnExecutionRoleARN(&config.Application{
+				AccountID:           "1234",
+				CFNExecutionRoleARN: "arn:aws:iam::1234:role/AppServiceRole",
+			}, tc.inEnvAccount)
+
+			require.Equal(t, tc.wantedRole, role)
+		})
+	}
+}
+
 func TestInitEnvOpts_delegateDNSFromApp(t *testing.T) {
 	testCases := map[string]struct {
 		app            *config.Application
@@ -27,8 +27,8 @@ const (
 )
 
 type listEnvVars struct {
-	appName          string
-	shouldOutputJSON bool
+	outputVars
+	appName string
 }
 
 type listEnvOpts struct {
@@ -82,19 +82,17 @@ func (o *listEnvOpts) Execute() error {
 		return err
 	}
 
-	var out string
-	if o.shouldOutputJSON {
-		data, err := o.jsonOutput(envs)
-		if err != nil {
-			return err
-		}
-		out = data
-	} else {
-		out = o.humanOutput(envs)
+	if !o.isStructured() {
+		fmt.Fprint(o.w, o.humanOutput(envs))
+		return nil
 	}
-	fmt.Fprint(o.w, out)
-
-	return nil
+	data, err := o.jsonOutput(envs)
+	if err != nil {
+		return err
+	}
+	out := newOutputWriter(o.w, o.format())
+	fmt.Fprint(out, data)
+	return out.Flush()
 }
 
 func (o *listEnvOpts) humanOutput(envs []*config.Environment) string {
@@ -126,6 +124,9 @@ func buildEnvListCmd() *cobra.Command {
   Lists all the environments for the frontend application.
   /code $ copilot env ls -a frontend`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			if err := vars.validateOutput(); err != nil {
+				return err
+			}
 			opts, err := newListEnvOpts(vars)
 			if err != nil {
 				return err
@@ -137,6 +138,6 @@ func buildEnvListCmd() *cobra.Command {
 		}),
 	}
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
-	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	addOutputFlags(cmd.Flags(), &vars.outputVars)
 	return cmd
 }
@@ -85,8 +85,8 @@ func TestEnvList_Execute(t *testing.T) {
 		"with json envs": {
 			listOpts: listEnvOpts{
 				listEnvVars: listEnvVars{
-					shouldOutputJSON: true,
-					appName:          "coolapp",
+					outputVars: outputVars{shouldOutputJSON: true},
+					appName:    "coolapp",
 				},
 				store: mockstore,
 			},
@@ -102,7 +102,7 @@ func TestEnvList_Execute(t *testing.T) {
 						{Name: "test2"},
 					}, nil)
 			},
-			expectedContent: "{\"environments\":[{\"app\":\"\",\"name\":\"test\",\"region\":\"\",\"accountID\":\"\",\"registryURL\":\"\",\"executionRoleARN\":\"\",\"managerRoleARN\":\"\"},{\"app\":\"\",\"name\":\"test2\",\"region\":\"\",\"accountID\":\"\",\"registryURL\":\"\",\"executionRoleARN\":\"\",\"managerRoleARN\":\"\"}]}\n",
+			expectedContent: "{\"schemaVersion\":1,\"environments\":[{\"app\":\"\",\"name\":\"test\",\"region\":\"\",\"accountID\":\"\",\"registryURL\":\"\",\"executionRoleARN\":\"\",\"managerRoleARN\":\"\"},{\"app\":\"\",\"name\":\"test2\",\"region\":\"\",\"accountID\":\"\",\"registryURL\":\"\",\"executionRoleARN\":\"\",\"managerRoleARN\":\"\"}]}\n",
 		},
 		"with envs": {
 			listOpts: listEnvOpts{
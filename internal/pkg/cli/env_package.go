@@ -17,6 +17,7 @@ import (
 
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 
+	templatediff "github.com/aws/copilot-cli/internal/pkg/template/diff"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 
@@ -209,12 +210,13 @@ func (o *packageEnvOpts) Execute() error {
 		PermissionsBoundary: o.appCfg.PermissionsBoundary,
 		ForceNewUpdate:      o.forceNewUpdate,
 		Version:             o.templateVersion,
+		TaskRunnerBinaryURL: taskRunnerBinaryURL(),
 	})
 	if err != nil {
 		return fmt.Errorf("generate CloudFormation template from environment %q manifest: %v", o.name, err)
 	}
 	if o.showDiff {
-		if err := diff(packager, res.Template, o.diffWriter); err != nil {
+		if err := diff(packager, res.Template, o.diffWriter, templatediff.FormatHuman); err != nil {
 			var errHasDiff *errHasDiff
 			if errors.As(err, &errHasDiff) {
 				return err
@@ -460,6 +460,7 @@ func TestPackageEnvOpts_Execute(t *testing.T) {
 					ForceNewUpdate:      false,
 					RawManifest:         "name: test\ntype: Environment\n",
 					PermissionsBoundary: "mockPermissionsBoundaryPolicy",
+					TaskRunnerBinaryURL: taskRunnerBinaryURL(),
 				}).Return(&deploy.GenerateCloudFormationTemplateOutput{
 					Template:   "template",
 					Parameters: "parameters",
@@ -527,6 +528,7 @@ func TestPackageEnvOpts_Execute(t *testing.T) {
 					ForceNewUpdate:      false,
 					RawManifest:         "name: test\ntype: Environment\n",
 					PermissionsBoundary: "mockPermissionsBoundaryPolicy",
+					TaskRunnerBinaryURL: taskRunnerBinaryURL(),
 				}).Return(&deploy.GenerateCloudFormationTemplateOutput{
 					Template:   "template",
 					Parameters: "parameters",
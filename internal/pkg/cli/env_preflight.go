@@ -0,0 +1,211 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/copilot-cli/internal/pkg/aws/iam"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+)
+
+// Service Quotas codes for the resources created with an environment.
+const (
+	vpcServiceCode          = "vpc"
+	vpcsPerRegionQuotaCode  = "L-F678F1CE"
+	ec2ServiceCode          = "ec2"
+	elasticIPsQuotaCode     = "L-0263D0A3"
+	elbServiceCode          = "elasticloadbalancing"
+	albsPerRegionQuotaCode  = "L-53DA6B97"
+	defaultEnvAZCount       = 2
+	fmtPreflightQuotaReason = "%s: %d in use, quota is %d"
+)
+
+// envAccountPreflightSimulations returns the actions performed with the environment's credentials while creating the environment.
+func envAccountPreflightSimulations(in envPreflightInput, partition, account string) []iam.PolicySimulation {
+	stackName := stack.NameForEnv(in.app, in.env)
+	return []iam.PolicySimulation{
+		{
+			Actions: []string{
+				"cloudformation:CreateStack",
+				"cloudformation:DescribeStacks",
+				"cloudformation:DescribeStackEvents",
+				"cloudformation:CreateChangeSet",
+				"cloudformation:DescribeChangeSet",
+				"cloudformation:ExecuteChangeSet",
+			},
+			ResourceARNs: []string{fmt.Sprintf("arn:%s:cloudformation:%s:%s:stack/%s/*", partition, in.envRegion, account, stackName)},
+			Context: map[string]string{
+				"aws:RequestedRegion": in.envRegion,
+			},
+		},
+		{
+			Actions: []string{
+				"iam:CreateRole",
+				"iam:GetRole",
+				"iam:PutRolePolicy",
+				"iam:TagRole",
+			},
+			ResourceARNs: []string{
+				fmt.Sprintf("arn:%s:iam::%s:role/%s-CFNExecutionRole", partition, account, stackName),
+				fmt.Sprintf("arn:%s:iam::%s:role/%s-EnvManagerRole", partition, account, stackName),
+			},
+			Context: map[string]string{
+				"aws:RequestTag/" + deploy.AppTagKey: in.app,
+				"aws:RequestTag/" + deploy.EnvTagKey: in.env,
+			},
+		},
+	}
+}
+
+// appAccountPreflightSimulations returns the actions performed with the application's credentials while creating the environment.
+func appAccountPreflightSimulations(in envPreflightInput, partition, account string) []iam.PolicySimulation {
+	return []iam.PolicySimulation{
+		{
+			Actions: []string{
+				"cloudformation:DescribeStackSet",
+				"cloudformation:UpdateStackSet",
+				"cloudformation:CreateStackInstances",
+			},
+			ResourceARNs: []string{fmt.Sprintf("arn:%s:cloudformation:%s:%s:stackset/%s:*", partition, in.appRegion, account, stack.NameForAppStackSet(in.app))},
+			Context: map[string]string{
+				"aws:RequestedRegion": in.appRegion,
+			},
+		},
+		{
+			Actions:      []string{"ssm:PutParameter"},
+			ResourceARNs: []string{fmt.Sprintf("arn:%s:ssm:%s:%s:parameter/copilot/applications/%s/environments/%s", partition, in.appRegion, account, in.app, in.env)},
+			Context: map[string]string{
+				"aws:RequestedRegion": in.appRegion,
+			},
+		},
+	}
+}
+
+// envPreflight checks that an environment can be created before any of its resources are.
+type envPreflight struct {
+	envPermissions permissionSimulator
+	appPermissions permissionSimulator
+	quotas         quotaGetter
+	network        envNetworkCounter
+	loadBalancers  albCounter
+}
+
+// envPreflightInput holds the properties of the environment about to be created.
+type envPreflightInput struct {
+	app          string
+	env          string
+	envRegion    string
+	appRegion    string
+	envCallerARN string
+	appCallerARN string
+	createsVPC   bool // True if Copilot creates a new VPC and NAT gateways for the environment.
+	azCount      int  // Number of availability zones that get a NAT gateway.
+}
+
+// envPreflightReport holds the problems found by the pre-flight checks.
+// Blockers prevent the environment from being created, warnings might make a later deployment fail.
+type envPreflightReport struct {
+	blockers []string
+	warnings []string
+}
+
+// run simulates the IAM permissions needed to create the environment and compares the resources in use against their quotas.
+// Checks that can't be performed, for example because the caller isn't allowed to simulate policies, are reported as warnings.
+func (p *envPreflight) run(in envPreflightInput) *envPreflightReport {
+	report := &envPreflightReport{}
+	p.checkPermissions(report, p.envPermissions, in.envCallerARN, func(partition, account string) []iam.PolicySimulation {
+		return envAccountPreflightSimulations(in, partition, account)
+	})
+	p.checkPermissions(report, p.appPermissions, in.appCallerARN, func(partition, account string) []iam.PolicySimulation {
+		return appAccountPreflightSimulations(in, partition, account)
+	})
+	if in.createsVPC {
+		p.checkVPCQuota(report)
+		p.checkElasticIPQuota(report, in.azCount)
+	}
+	p.checkALBQuota(report)
+	return report
+}
+
+// checkPermissions reports the actions explicitly denied to the caller as blockers.
+// Actions that no policy of the caller allows are reported as warnings, since they can still be allowed by
+// the policies of the resources or by conditions that the simulation can't evaluate.
+func (p *envPreflight) checkPermissions(report *envPreflightReport, simulator permissionSimulator, callerARN string,
+	simulations func(partition, account string) []iam.PolicySimulation) {
+	caller, err := arn.Parse(callerARN)
+	if err != nil {
+		report.warnings = append(report.warnings, fmt.Sprintf("could not simulate the permissions of %s: %v", callerARN, err))
+		return
+	}
+	for _, sim := range simulations(caller.Partition, caller.AccountID) {
+		denied, err := simulator.SimulatePolicy(callerARN, sim)
+		if err != nil {
+			report.warnings = append(report.warnings, fmt.Sprintf("could not simulate the permissions of %s: %v", callerARN, err))
+			return
+		}
+		for _, action := range denied {
+			if action.Explicit {
+				report.blockers = append(report.blockers, fmt.Sprintf("%s is not allowed to perform %s on %s", callerARN, action.Action, action.ResourceARN))
+				continue
+			}
+			report.warnings = append(report.warnings, fmt.Sprintf("%s might not be allowed to perform %s on %s: no policy of the caller allows it", callerARN, action.Action, action.ResourceARN))
+		}
+	}
+}
+
+func (p *envPreflight) checkVPCQuota(report *envPreflightReport) {
+	quota, err := p.quotas.Value(vpcServiceCode, vpcsPerRegionQuotaCode)
+	if err != nil {
+		report.warnings = append(report.warnings, fmt.Sprintf("could not get the quota of VPCs per region: %v", err))
+		return
+	}
+	vpcs, err := p.network.ListVPCs()
+	if err != nil {
+		report.warnings = append(report.warnings, fmt.Sprintf("could not count VPCs: %v", err))
+		return
+	}
+	if float64(len(vpcs)) >= quota {
+		report.blockers = append(report.blockers, fmt.Sprintf(fmtPreflightQuotaReason, "no VPC can be created in the region", len(vpcs), int(quota)))
+	}
+}
+
+func (p *envPreflight) checkElasticIPQuota(report *envPreflightReport, azCount int) {
+	if azCount == 0 {
+		azCount = defaultEnvAZCount
+	}
+	quota, err := p.quotas.Value(ec2ServiceCode, elasticIPsQuotaCode)
+	if err != nil {
+		report.warnings = append(report.warnings, fmt.Sprintf("could not get the quota of Elastic IP addresses: %v", err))
+		return
+	}
+	count, err := p.network.ElasticIPCount()
+	if err != nil {
+		report.warnings = append(report.warnings, fmt.Sprintf("could not count Elastic IP addresses: %v", err))
+		return
+	}
+	if float64(count+azCount) > quota {
+		report.warnings = append(report.warnings, fmt.Sprintf(fmtPreflightQuotaReason,
+			fmt.Sprintf("not enough Elastic IP addresses for %d NAT gateways", azCount), count, int(quota)))
+	}
+}
+
+func (p *envPreflight) checkALBQuota(report *envPreflightReport) {
+	quota, err := p.quotas.Value(elbServiceCode, albsPerRegionQuotaCode)
+	if err != nil {
+		report.warnings = append(report.warnings, fmt.Sprintf("could not get the quota of Application Load Balancers per region: %v", err))
+		return
+	}
+	count, err := p.loadBalancers.ApplicationLoadBalancerCount()
+	if err != nil {
+		report.warnings = append(report.warnings, fmt.Sprintf("could not count Application Load Balancers: %v", err))
+		return
+	}
+	if float64(count+1) > quota {
+		report.warnings = append(report.warnings, fmt.Sprintf(fmtPreflightQuotaReason,
+			"no Application Load Balancer can be created for load balanced services", count, int(quota)))
+	}
+}
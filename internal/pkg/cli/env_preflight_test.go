@@ -0,0 +1,201 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
+	"github.com/aws/copilot-cli/internal/pkg/aws/iam"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type envPreflightMocks struct {
+	envPermissions *mocks.MockpermissionSimulator
+	appPermissions *mocks.MockpermissionSimulator
+	quotas         *mocks.MockquotaGetter
+	network        *mocks.MockenvNetworkCounter
+	loadBalancers  *mocks.MockalbCounter
+}
+
+func TestEnvPreflight_run(t *testing.T) {
+	const (
+		envCaller = "arn:aws:iam::222222222222:role/EnvAdmin"
+		appCaller = "arn:aws:iam::111111111111:role/AppAdmin"
+	)
+	testCases := map[string]struct {
+		inCreatesVPC bool
+		inAZCount    int
+		setupMocks   func(m envPreflightMocks)
+
+		wantedBlockers []string
+		wantedWarnings []string
+	}{
+		"simulates the actions on the resources of the environment": {
+			setupMocks: func(m envPreflightMocks) {
+				gomock.InOrder(
+					m.envPermissions.EXPECT().SimulatePolicy(envCaller, iam.PolicySimulation{
+						Actions: []string{
+							"cloudformation:CreateStack",
+							"cloudformation:DescribeStacks",
+							"cloudformation:DescribeStackEvents",
+							"cloudformation:CreateChangeSet",
+							"cloudformation:DescribeChangeSet",
+							"cloudformation:ExecuteChangeSet",
+						},
+						ResourceARNs: []string{"arn:aws:cloudformation:us-west-2:222222222222:stack/phonetool-test/*"},
+						Context:      map[string]string{"aws:RequestedRegion": "us-west-2"},
+					}).Return(nil, nil),
+					m.envPermissions.EXPECT().SimulatePolicy(envCaller, iam.PolicySimulation{
+						Actions: []string{"iam:CreateRole", "iam:GetRole", "iam:PutRolePolicy", "iam:TagRole"},
+						ResourceARNs: []string{
+							"arn:aws:iam::222222222222:role/phonetool-test-CFNExecutionRole",
+							"arn:aws:iam::222222222222:role/phonetool-test-EnvManagerRole",
+						},
+						Context: map[string]string{
+							"aws:RequestTag/copilot-application": "phonetool",
+							"aws:RequestTag/copilot-environment": "test",
+						},
+					}).Return(nil, nil),
+					m.appPermissions.EXPECT().SimulatePolicy(appCaller, iam.PolicySimulation{
+						Actions: []string{
+							"cloudformation:DescribeStackSet",
+							"cloudformation:UpdateStackSet",
+							"cloudformation:CreateStackInstances",
+						},
+						ResourceARNs: []string{"arn:aws:cloudformation:us-east-1:111111111111:stackset/phonetool-infrastructure:*"},
+						Context:      map[string]string{"aws:RequestedRegion": "us-east-1"},
+					}).Return(nil, nil),
+					m.appPermissions.EXPECT().SimulatePolicy(appCaller, iam.PolicySimulation{
+						Actions:      []string{"ssm:PutParameter"},
+						ResourceARNs: []string{"arn:aws:ssm:us-east-1:111111111111:parameter/copilot/applications/phonetool/environments/test"},
+						Context:      map[string]string{"aws:RequestedRegion": "us-east-1"},
+					}).Return(nil, nil),
+				)
+				m.quotas.EXPECT().Value(elbServiceCode, albsPerRegionQuotaCode).Return(float64(50), nil)
+				m.loadBalancers.EXPECT().ApplicationLoadBalancerCount().Return(3, nil)
+			},
+		},
+		"reports explicitly denied actions as blockers and implicitly denied actions as warnings": {
+			setupMocks: func(m envPreflightMocks) {
+				m.envPermissions.EXPECT().SimulatePolicy(envCaller, gomock.Any()).Return([]iam.DeniedAction{
+					{Action: "iam:CreateRole", ResourceARN: "arn:aws:iam::222222222222:role/phonetool-test-CFNExecutionRole", Explicit: true},
+					{Action: "iam:TagRole", ResourceARN: "arn:aws:iam::222222222222:role/phonetool-test-CFNExecutionRole"},
+				}, nil).Times(2)
+				m.appPermissions.EXPECT().SimulatePolicy(appCaller, gomock.Any()).Return(nil, nil)
+				m.appPermissions.EXPECT().SimulatePolicy(appCaller, gomock.Any()).Return([]iam.DeniedAction{
+					{Action: "ssm:PutParameter", ResourceARN: "arn:aws:ssm:us-east-1:111111111111:parameter/copilot/applications/phonetool/environments/test", Explicit: true},
+				}, nil)
+				m.quotas.EXPECT().Value(elbServiceCode, albsPerRegionQuotaCode).Return(float64(50), nil)
+				m.loadBalancers.EXPECT().ApplicationLoadBalancerCount().Return(3, nil)
+			},
+			wantedBlockers: []string{
+				"arn:aws:iam::222222222222:role/EnvAdmin is not allowed to perform iam:CreateRole on arn:aws:iam::222222222222:role/phonetool-test-CFNExecutionRole",
+				"arn:aws:iam::222222222222:role/EnvAdmin is not allowed to perform iam:CreateRole on arn:aws:iam::222222222222:role/phonetool-test-CFNExecutionRole",
+				"arn:aws:iam::111111111111:role/AppAdmin is not allowed to perform ssm:PutParameter on arn:aws:ssm:us-east-1:111111111111:parameter/copilot/applications/phonetool/environments/test",
+			},
+			wantedWarnings: []string{
+				"arn:aws:iam::222222222222:role/EnvAdmin might not be allowed to perform iam:TagRole on arn:aws:iam::222222222222:role/phonetool-test-CFNExecutionRole: no policy of the caller allows it",
+				"arn:aws:iam::222222222222:role/EnvAdmin might not be allowed to perform iam:TagRole on arn:aws:iam::222222222222:role/phonetool-test-CFNExecutionRole: no policy of the caller allows it",
+			},
+		},
+		"reports checks that can't be performed as warnings": {
+			inCreatesVPC: true,
+			setupMocks: func(m envPreflightMocks) {
+				m.envPermissions.EXPECT().SimulatePolicy(envCaller, gomock.Any()).Return(nil, errors.New("access denied"))
+				m.appPermissions.EXPECT().SimulatePolicy(appCaller, gomock.Any()).Return(nil, nil).Times(2)
+				m.quotas.EXPECT().Value(vpcServiceCode, vpcsPerRegionQuotaCode).Return(float64(0), errors.New("some error"))
+				m.quotas.EXPECT().Value(ec2ServiceCode, elasticIPsQuotaCode).Return(float64(5), nil)
+				m.network.EXPECT().ElasticIPCount().Return(0, errors.New("some error"))
+				m.quotas.EXPECT().Value(elbServiceCode, albsPerRegionQuotaCode).Return(float64(50), nil)
+				m.loadBalancers.EXPECT().ApplicationLoadBalancerCount().Return(0, errors.New("some error"))
+			},
+			wantedWarnings: []string{
+				"could not simulate the permissions of arn:aws:iam::222222222222:role/EnvAdmin: access denied",
+				"could not get the quota of VPCs per region: some error",
+				"could not count Elastic IP addresses: some error",
+				"could not count Application Load Balancers: some error",
+			},
+		},
+		"reports a blocker if no more VPCs can be created and warnings for Elastic IPs and load balancers": {
+			inCreatesVPC: true,
+			inAZCount:    3,
+			setupMocks: func(m envPreflightMocks) {
+				m.envPermissions.EXPECT().SimulatePolicy(envCaller, gomock.Any()).Return(nil, nil).Times(2)
+				m.appPermissions.EXPECT().SimulatePolicy(appCaller, gomock.Any()).Return(nil, nil).Times(2)
+				m.quotas.EXPECT().Value(vpcServiceCode, vpcsPerRegionQuotaCode).Return(float64(5), nil)
+				m.network.EXPECT().ListVPCs().Return(make([]ec2.VPC, 5), nil)
+				m.quotas.EXPECT().Value(ec2ServiceCode, elasticIPsQuotaCode).Return(float64(5), nil)
+				m.network.EXPECT().ElasticIPCount().Return(3, nil)
+				m.quotas.EXPECT().Value(elbServiceCode, albsPerRegionQuotaCode).Return(float64(50), nil)
+				m.loadBalancers.EXPECT().ApplicationLoadBalancerCount().Return(50, nil)
+			},
+			wantedBlockers: []string{
+				"no VPC can be created in the region: 5 in use, quota is 5",
+			},
+			wantedWarnings: []string{
+				"not enough Elastic IP addresses for 3 NAT gateways: 3 in use, quota is 5",
+				"no Application Load Balancer can be created for load balanced services: 50 in use, quota is 50",
+			},
+		},
+		"defaults to two NAT gateways": {
+			inCreatesVPC: true,
+			setupMocks: func(m envPreflightMocks) {
+				m.envPermissions.EXPECT().SimulatePolicy(envCaller, gomock.Any()).Return(nil, nil).Times(2)
+				m.appPermissions.EXPECT().SimulatePolicy(appCaller, gomock.Any()).Return(nil, nil).Times(2)
+				m.quotas.EXPECT().Value(vpcServiceCode, vpcsPerRegionQuotaCode).Return(float64(5), nil)
+				m.network.EXPECT().ListVPCs().Return(make([]ec2.VPC, 1), nil)
+				m.quotas.EXPECT().Value(ec2ServiceCode, elasticIPsQuotaCode).Return(float64(5), nil)
+				m.network.EXPECT().ElasticIPCount().Return(4, nil)
+				m.quotas.EXPECT().Value(elbServiceCode, albsPerRegionQuotaCode).Return(float64(50), nil)
+				m.loadBalancers.EXPECT().ApplicationLoadBalancerCount().Return(0, nil)
+			},
+			wantedWarnings: []string{
+				"not enough Elastic IP addresses for 2 NAT gateways: 4 in use, quota is 5",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := envPreflightMocks{
+				envPermissions: mocks.NewMockpermissionSimulator(ctrl),
+				appPermissions: mocks.NewMockpermissionSimulator(ctrl),
+				quotas:         mocks.NewMockquotaGetter(ctrl),
+				network:        mocks.NewMockenvNetworkCounter(ctrl),
+				loadBalancers:  mocks.NewMockalbCounter(ctrl),
+			}
+			tc.setupMocks(m)
+			preflight := &envPreflight{
+				envPermissions: m.envPermissions,
+				appPermissions: m.appPermissions,
+				quotas:         m.quotas,
+				network:        m.network,
+				loadBalancers:  m.loadBalancers,
+			}
+
+			// WHEN
+			report := preflight.run(envPreflightInput{
+				app:          "phonetool",
+				env:          "test",
+				envRegion:    "us-west-2",
+				appRegion:    "us-east-1",
+				envCallerARN: envCaller,
+				appCallerARN: appCaller,
+				createsVPC:   tc.inCreatesVPC,
+				azCount:      tc.inAZCount,
+			})
+
+			// THEN
+			require.Equal(t, tc.wantedBlockers, report.blockers)
+			require.Equal(t, tc.wantedWarnings, report.warnings)
+		})
+	}
+}
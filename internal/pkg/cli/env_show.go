@@ -30,11 +30,12 @@ const (
 )
 
 type showEnvVars struct {
+	outputVars
 	appName               string
 	name                  string
-	shouldOutputJSON      bool
 	shouldOutputResources bool
 	shouldOutputManifest  bool
+	showPriorities        bool
 }
 
 type showEnvOpts struct {
@@ -45,6 +46,8 @@ type showEnvOpts struct {
 	describer        envDescriber
 	sel              configSelector
 	initEnvDescriber func() error
+
+	newRulePriorities func() (rulePrioritiesDescriber, error)
 }
 
 func newShowEnvOpts(vars showEnvVars) (*showEnvOpts, error) {
@@ -80,12 +83,19 @@ func newShowEnvOpts(vars showEnvVars) (*showEnvOpts, error) {
 		opts.describer = d
 		return nil
 	}
+	opts.newRulePriorities = func() (rulePrioritiesDescriber, error) {
+		return describe.NewRulePrioritiesDescriber(&describe.NewRulePrioritiesConfig{
+			App:         opts.appName,
+			Env:         opts.name,
+			ConfigStore: store,
+		})
+	}
 	return opts, nil
 }
 
 // Validate returns an error if any optional flags are invalid.
 func (o *showEnvOpts) Validate() error {
-	return nil
+	return o.validateOutput()
 }
 
 // Ask validates required fields that users passed in, otherwise it prompts for them.
@@ -98,6 +108,9 @@ func (o *showEnvOpts) Ask() error {
 
 // Execute shows the environments through the prompt.
 func (o *showEnvOpts) Execute() error {
+	if o.showPriorities {
+		return o.writeRulePriorities()
+	}
 	if err := o.initEnvDescriber(); err != nil {
 		return err
 	}
@@ -109,16 +122,7 @@ func (o *showEnvOpts) Execute() error {
 	if err != nil {
 		return fmt.Errorf("describe environment %s: %w", o.name, err)
 	}
-	content := env.HumanString()
-	if o.shouldOutputJSON {
-		data, err := env.JSONString()
-		if err != nil {
-			return err
-		}
-		content = data
-	}
-	fmt.Fprint(o.w, content)
-	return nil
+	return writeOutput(o.w, o.format(), env)
 }
 
 func (o *showEnvOpts) validateOrAskApp() error {
@@ -168,6 +172,28 @@ func (o *showEnvOpts) writeManifest() error {
 	return nil
 }
 
+// writeRulePriorities writes the priorities of the listener rules of the environment and warns about the rules
+// that are shadowed by the rules of other services.
+func (o *showEnvOpts) writeRulePriorities() error {
+	describer, err := o.newRulePriorities()
+	if err != nil {
+		return err
+	}
+	priorities, err := describer.Describe()
+	if err != nil {
+		return fmt.Errorf("describe listener rule priorities of environment %s: %w", o.name, err)
+	}
+	fmt.Fprint(o.w, priorities.HumanString())
+	for _, conflict := range priorities.Conflicts {
+		log.Warningf("Rule of service %s at priority %s never receives traffic: %s.\n", conflict.ShadowedService, conflict.ShadowedPriority, conflict)
+	}
+	if len(priorities.Conflicts) > 0 {
+		log.Infof("Give each service a distinct %s or %s to resolve the conflicts, then redeploy them.\n",
+			color.HighlightCode("http.path"), color.HighlightCode("http.alias"))
+	}
+	return nil
+}
+
 // buildEnvShowCmd builds the command for showing environments in an application.
 func buildEnvShowCmd() *cobra.Command {
 	vars := showEnvVars{}
@@ -180,7 +206,9 @@ func buildEnvShowCmd() *cobra.Command {
   Print configuration for the "test" environment.
   /code $ copilot env show -n test
   Print manifest file for deploying the "prod" environment.
-  /code $ copilot env show -n prod --manifest`,
+  /code $ copilot env show -n prod --manifest
+  Print the priorities of the listener rules of the "test" environment and the conflicts between services.
+  /code $ copilot env show -n test --show-priorities`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newShowEnvOpts(vars)
 			if err != nil {
@@ -191,11 +219,14 @@ func buildEnvShowCmd() *cobra.Command {
 	}
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", envFlagDescription)
-	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	addOutputFlags(cmd.Flags(), &vars.outputVars)
 	cmd.Flags().BoolVar(&vars.shouldOutputResources, resourcesFlag, false, envResourcesFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputManifest, manifestFlag, false, manifestFlagDescription)
+	cmd.Flags().BoolVar(&vars.showPriorities, showPrioritiesFlag, false, showPrioritiesFlagDescription)
 
 	cmd.MarkFlagsMutuallyExclusive(jsonFlag, manifestFlag)
+	cmd.MarkFlagsMutuallyExclusive(outputFlag, manifestFlag)
 	cmd.MarkFlagsMutuallyExclusive(resourcesFlag, manifestFlag)
+	cmd.MarkFlagsMutuallyExclusive(showPrioritiesFlag, jsonFlag, outputFlag, manifestFlag, resourcesFlag)
 	return cmd
 }
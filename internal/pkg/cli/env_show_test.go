@@ -21,9 +21,10 @@ import (
 )
 
 type showEnvMocks struct {
-	storeSvc  *mocks.Mockstore
-	describer *mocks.MockenvDescriber
-	sel       *mocks.MockconfigSelector
+	storeSvc       *mocks.Mockstore
+	describer      *mocks.MockenvDescriber
+	sel            *mocks.MockconfigSelector
+	rulePriorities *mocks.MockrulePrioritiesDescriber
 }
 
 func TestEnvShow_Ask(t *testing.T) {
@@ -194,6 +195,7 @@ func TestEnvShow_Execute(t *testing.T) {
 		inputEnv             string
 		shouldOutputJSON     bool
 		shouldOutputManifest bool
+		showPriorities       bool
 
 		setupMocks func(mocks showEnvMocks)
 
@@ -261,7 +263,7 @@ Resources
 				m.describer.EXPECT().Describe().Return(&mockEnvDescription, nil)
 			},
 
-			wantedContent: "{\"environment\":{\"app\":\"testApp\",\"name\":\"testEnv\",\"region\":\"us-west-2\",\"accountID\":\"123456789012\",\"registryURL\":\"\",\"executionRoleARN\":\"\",\"managerRoleARN\":\"\"},\"services\":[{\"app\":\"testApp\",\"name\":\"testSvc1\",\"type\":\"load-balanced\"},{\"app\":\"testApp\",\"name\":\"testSvc2\",\"type\":\"load-balanced\"},{\"app\":\"testApp\",\"name\":\"testSvc3\",\"type\":\"load-balanced\"}],\"jobs\":[{\"app\":\"testApp\",\"name\":\"testJob1\",\"type\":\"Scheduled Job\"},{\"app\":\"testApp\",\"name\":\"testJob2\",\"type\":\"Scheduled Job\"}],\"tags\":{\"copilot-application\":\"testApp\",\"copilot-environment\":\"testEnv\",\"key1\":\"value1\",\"key2\":\"value2\"},\"resources\":[{\"type\":\"AWS::IAM::Role\",\"physicalID\":\"testApp-testEnv-CFNExecutionRole\"},{\"type\":\"testApp-testEnv-Cluster\",\"physicalID\":\"AWS::ECS::Cluster-jI63pYBWU6BZ\"}],\"environmentVPC\":{\"id\":\"\",\"publicSubnetIDs\":null,\"privateSubnetIDs\":null}}\n",
+			wantedContent: "{\"schemaVersion\":1,\"environment\":{\"app\":\"testApp\",\"name\":\"testEnv\",\"region\":\"us-west-2\",\"accountID\":\"123456789012\",\"registryURL\":\"\",\"executionRoleARN\":\"\",\"managerRoleARN\":\"\"},\"services\":[{\"app\":\"testApp\",\"name\":\"testSvc1\",\"type\":\"load-balanced\"},{\"app\":\"testApp\",\"name\":\"testSvc2\",\"type\":\"load-balanced\"},{\"app\":\"testApp\",\"name\":\"testSvc3\",\"type\":\"load-balanced\"}],\"jobs\":[{\"app\":\"testApp\",\"name\":\"testJob1\",\"type\":\"Scheduled Job\"},{\"app\":\"testApp\",\"name\":\"testJob2\",\"type\":\"Scheduled Job\"}],\"tags\":{\"copilot-application\":\"testApp\",\"copilot-environment\":\"testEnv\",\"key1\":\"value1\",\"key2\":\"value2\"},\"resources\":[{\"type\":\"AWS::IAM::Role\",\"physicalID\":\"testApp-testEnv-CFNExecutionRole\"},{\"type\":\"testApp-testEnv-Cluster\",\"physicalID\":\"AWS::ECS::Cluster-jI63pYBWU6BZ\"}],\"environmentVPC\":{\"id\":\"\",\"publicSubnetIDs\":null,\"privateSubnetIDs\":null}}\n",
 		},
 		"should print manifest file": {
 			inputEnv:             "testEnv",
@@ -272,6 +274,42 @@ Resources
 
 			wantedContent: "hello\n",
 		},
+		"return error if fail to describe the listener rule priorities": {
+			inputEnv:       "testEnv",
+			showPriorities: true,
+			setupMocks: func(m showEnvMocks) {
+				m.rulePriorities.EXPECT().Describe().Return(nil, mockError)
+			},
+
+			wantedError: fmt.Errorf("describe listener rule priorities of environment testEnv: some error"),
+		},
+		"should print the listener rule priorities": {
+			inputEnv:       "testEnv",
+			showPriorities: true,
+			setupMocks: func(m showEnvMocks) {
+				m.rulePriorities.EXPECT().Describe().Return(&describe.RulePriorities{
+					Rules: []describe.ListenerRulePriority{
+						{Listener: "public HTTP", Priority: "1", Service: "api", Paths: []string{"/api"}},
+						{Listener: "public HTTP", Priority: "2", Service: "orders", Paths: []string{"/api"}},
+					},
+					Conflicts: []describe.RulePriorityConflict{
+						{Listener: "public HTTP", Paths: []string{"/api"}, Service: "api", Priority: "1", ShadowedService: "orders", ShadowedPriority: "2"},
+					},
+				}, nil)
+			},
+
+			wantedContent: `Listener Rules
+
+  Listener     Priority    Service     Hosts       Paths
+  --------     --------    -------     -----       -----
+  public HTTP  1           api         -           /api
+  public HTTP  2           orders      -           /api
+
+Conflicts
+
+  - service api (priority 1) shadows service orders (priority 2) on the public HTTP listener for any host and /api
+`,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -282,9 +320,11 @@ Resources
 			b := &bytes.Buffer{}
 			mockStoreReader := mocks.NewMockstore(ctrl)
 			mockEnvDescriber := mocks.NewMockenvDescriber(ctrl)
+			mockRulePriorities := mocks.NewMockrulePrioritiesDescriber(ctrl)
 
 			mocks := showEnvMocks{
-				describer: mockEnvDescriber,
+				describer:      mockEnvDescriber,
+				rulePriorities: mockRulePriorities,
 			}
 
 			tc.setupMocks(mocks)
@@ -292,13 +332,17 @@ Resources
 			showEnvs := &showEnvOpts{
 				showEnvVars: showEnvVars{
 					name:                 tc.inputEnv,
-					shouldOutputJSON:     tc.shouldOutputJSON,
+					outputVars:           outputVars{shouldOutputJSON: tc.shouldOutputJSON},
 					shouldOutputManifest: tc.shouldOutputManifest,
+					showPriorities:       tc.showPriorities,
 				},
 				store:            mockStoreReader,
 				describer:        mockEnvDescriber,
 				initEnvDescriber: func() error { return nil },
-				w:                b,
+				newRulePriorities: func() (rulePrioritiesDescriber, error) {
+					return mockRulePriorities, nil
+				},
+				w: b,
 			}
 
 			// WHEN
@@ -0,0 +1,399 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkssm "github.com/aws/aws-sdk-go/service/ssm"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/rds"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/task"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/version"
+	"github.com/spf13/cobra"
+)
+
+const (
+	envTunnelAppNamePrompt  = "In which application is the environment?"
+	envTunnelEnvNamePrompt  = "Into which environment would you like to open a tunnel?"
+	envTunnelEnvNameHelpMsg = "Copilot forwards a local port to a host in the VPC of the environment."
+
+	envTunnelRDSTargetPrefix = "rds:"
+
+	// The jump host is a temporary Fargate task that only keeps the ECS Exec agent running.
+	envTunnelJumpHostImage  = "public.ecr.aws/amazonlinux/amazonlinux:2023-minimal"
+	envTunnelJumpHostCPU    = 256
+	envTunnelJumpHostMemory = 512
+	// The jump host stops on its own after this duration, in case it isn't stopped when the tunnel is closed.
+	envTunnelJumpHostMaxLifetime = 12 * time.Hour
+
+	envTunnelAgentPollInterval = 3 * time.Second
+	envTunnelAgentTimeout      = 3 * time.Minute
+)
+
+type envTunnelVars struct {
+	appName   string
+	name      string
+	target    string
+	localPort int
+	svcName   string // Empty if the tunnel goes through a temporary task.
+}
+
+type envTunnelOpts struct {
+	envTunnelVars
+
+	store            store
+	sel              appEnvSelector
+	prompter         prompter
+	ssmPluginManager ssmPluginManager
+
+	// Clients configured against the session of the environment.
+	configureClients func(env *config.Environment) error
+	dbDescriber      dbEndpointDescriber
+	deployer         taskDeployer
+	runner           taskRunner
+	tasks            jumpHostTaskManager
+	svcDescriber     serviceDescriber
+	forwarder        portForwarder
+
+	agentPollInterval time.Duration
+	agentTimeout      time.Duration
+}
+
+func newEnvTunnelOpts(vars envTunnelVars) (*envTunnelOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("env tunnel"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, err
+	}
+	store := config.NewSSMStore(identity.New(defaultSess), sdkssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	deployStore, err := deploy.NewStore(sessProvider, store)
+	if err != nil {
+		return nil, fmt.Errorf("connect to copilot deploy store: %w", err)
+	}
+	prompter := prompt.New()
+	opts := &envTunnelOpts{
+		envTunnelVars:     vars,
+		store:             store,
+		sel:               selector.NewAppEnvSelector(prompter, store),
+		prompter:          prompter,
+		ssmPluginManager:  exec.NewSSMPluginCommand(nil),
+		agentPollInterval: envTunnelAgentPollInterval,
+		agentTimeout:      envTunnelAgentTimeout,
+	}
+	opts.configureClients = func(env *config.Environment) error {
+		sess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+		if err != nil {
+			return fmt.Errorf("get session from role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
+		}
+		envDescriber, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
+			App:         opts.appName,
+			Env:         opts.name,
+			ConfigStore: store,
+			DeployStore: deployStore,
+		})
+		if err != nil {
+			return fmt.Errorf("create describer for environment %s in application %s: %w", opts.name, opts.appName, err)
+		}
+		ecsClient := ecs.New(sess)
+		awsECSClient := awsecs.New(sess)
+		opts.dbDescriber = rds.New(sess)
+		opts.deployer = cloudformation.New(sess, cloudformation.WithProgressTracker(os.Stderr))
+		opts.runner = &task.EnvRunner{
+			Count:                 1,
+			GroupName:             envTunnelJumpHostName(opts.appName, opts.name),
+			App:                   opts.appName,
+			Env:                   opts.name,
+			VPCGetter:             ec2.New(sess),
+			ClusterGetter:         ecsClient,
+			Starter:               awsECSClient,
+			EnvironmentDescriber:  envDescriber,
+			NonZeroExitCodeGetter: ecsClient,
+		}
+		opts.tasks = awsECSClient
+		opts.svcDescriber = ecsClient
+		opts.forwarder = ssm.New(sess)
+		return nil
+	}
+	return opts, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *envTunnelOpts) Validate() error {
+	if o.target == "" {
+		return fmt.Errorf("--%s is required", tunnelTargetFlag)
+	}
+	if err := validateTunnelTarget(o.target); err != nil {
+		return err
+	}
+	if o.localPort < 0 || o.localPort > 65535 {
+		return fmt.Errorf("local port %d is out of range [1-65535]", o.localPort)
+	}
+	return validateSSMBinary(o.prompter, o.ssmPluginManager, nil)
+}
+
+// Ask prompts for and validates any required flags.
+func (o *envTunnelOpts) Ask() error {
+	if err := o.validateOrAskApp(); err != nil {
+		return err
+	}
+	if err := o.validateOrAskEnv(); err != nil {
+		return err
+	}
+	if o.svcName != "" {
+		if _, err := o.store.GetService(o.appName, o.svcName); err != nil {
+			return fmt.Errorf("get service %s: %w", o.svcName, err)
+		}
+	}
+	return nil
+}
+
+// ValidateVersionPin returns an error if the application doesn't allow this version of Copilot to run tasks in its environments.
+func (o *envTunnelOpts) ValidateVersionPin() error {
+	return validateVersionPin(o.store, o.appName, version.Version, "")
+}
+
+// Execute forwards the local port to the target through a task in the environment until the tunnel is closed.
+func (o *envTunnelOpts) Execute() error {
+	env, err := o.store.GetEnvironment(o.appName, o.name)
+	if err != nil {
+		return fmt.Errorf("get environment %s: %w", o.name, err)
+	}
+	if err := o.configureClients(env); err != nil {
+		return err
+	}
+	host, port, err := o.remoteEndpoint()
+	if err != nil {
+		return err
+	}
+	localPort := o.localPort
+	if localPort == 0 {
+		localPort = port
+	}
+	target, closeJumpHost, err := o.openJumpHost(env)
+	if err != nil {
+		return err
+	}
+	defer closeJumpHost()
+	log.Infof("Forwarding %s to %s through environment %s. Press Ctrl+C to close the tunnel.\n",
+		color.HighlightResource(fmt.Sprintf("localhost:%d", localPort)), color.HighlightResource(net.JoinHostPort(host, strconv.Itoa(port))), o.name)
+	if err := o.forwarder.StartPortForwardingSession(ssm.PortForwardingSessionInput{
+		Target:    target,
+		Host:      host,
+		Port:      port,
+		LocalPort: localPort,
+	}); err != nil {
+		return fmt.Errorf("forward local port %d to %s: %w", localPort, net.JoinHostPort(host, strconv.Itoa(port)), err)
+	}
+	return nil
+}
+
+func (o *envTunnelOpts) validateOrAskApp() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return fmt.Errorf("validate application name %q: %v", o.appName, err)
+		}
+		return nil
+	}
+	app, err := o.sel.Application(envTunnelAppNamePrompt, envShowAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *envTunnelOpts) validateOrAskEnv() error {
+	if o.name != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.name); err != nil {
+			return fmt.Errorf("validate environment name %q in application %q: %v", o.name, o.appName, err)
+		}
+		return nil
+	}
+	env, err := o.sel.Environment(envTunnelEnvNamePrompt, envTunnelEnvNameHelpMsg, o.appName)
+	if err != nil {
+		return fmt.Errorf("select environment for application %s: %w", o.appName, err)
+	}
+	o.name = env
+	return nil
+}
+
+// remoteEndpoint returns the host and the port of the target.
+func (o *envTunnelOpts) remoteEndpoint() (string, int, error) {
+	if id, ok := strings.CutPrefix(o.target, envTunnelRDSTargetPrefix); ok {
+		endpoint, err := o.dbDescriber.Endpoint(id)
+		if err != nil {
+			return "", 0, fmt.Errorf("get endpoint of database %s: %w", id, err)
+		}
+		return endpoint.Host, endpoint.Port, nil
+	}
+	host, port, _ := net.SplitHostPort(o.target)
+	portNumber, _ := strconv.Atoi(port)
+	return host, portNumber, nil
+}
+
+// openJumpHost returns the Session Manager target that forwards the traffic, and a function to close it.
+func (o *envTunnelOpts) openJumpHost(env *config.Environment) (string, func(), error) {
+	if o.svcName != "" {
+		target, err := o.serviceJumpHost()
+		return target, func() {}, err
+	}
+	return o.temporaryJumpHost(env)
+}
+
+func (o *envTunnelOpts) serviceJumpHost() (string, error) {
+	svc, err := o.svcDescriber.DescribeService(o.appName, o.name, o.svcName)
+	if err != nil {
+		return "", fmt.Errorf("describe ECS service for %s in environment %s: %w", o.svcName, o.name, err)
+	}
+	for _, t := range awsecs.FilterRunningTasks(svc.Tasks) {
+		// The first essential container is named with the workload name.
+		if target, err := t.SSMTarget(o.svcName); err == nil {
+			return target, nil
+		}
+	}
+	log.Errorf("Is %s set in the manifest of service %s?\n", color.HighlightCode("exec: true"), o.svcName)
+	return "", fmt.Errorf("found no running task of service %s with ECS Exec enabled in environment %s", o.svcName, o.name)
+}
+
+func (o *envTunnelOpts) temporaryJumpHost(env *config.Environment) (string, func(), error) {
+	app, err := o.store.GetApplication(o.appName)
+	if err != nil {
+		return "", nil, fmt.Errorf("get application %s: %w", o.appName, err)
+	}
+	name := envTunnelJumpHostName(o.appName, o.name)
+	if err := o.deployer.DeployTask(&deploy.CreateTaskResourcesInput{
+		Name:                name,
+		CPU:                 envTunnelJumpHostCPU,
+		Memory:              envTunnelJumpHostMemory,
+		Image:               envTunnelJumpHostImage,
+		Command:             []string{"sleep", strconv.Itoa(int(envTunnelJumpHostMaxLifetime.Seconds()))},
+		PermissionsBoundary: app.PermissionsBoundary,
+		App:                 o.appName,
+		Env:                 o.name,
+	}, awscloudformation.WithRoleARN(env.ExecutionRoleARN)); err != nil {
+		return "", nil, fmt.Errorf("provision resources for jump host %s: %w", name, err)
+	}
+	tasks, err := o.runner.Run()
+	if err != nil {
+		return "", nil, fmt.Errorf("run jump host %s: %w", name, err)
+	}
+	if len(tasks) == 0 {
+		return "", nil, fmt.Errorf("run jump host %s: no task was started", name)
+	}
+	jumpHost := tasks[0]
+	stop := func() {
+		if err := o.tasks.StopTasks([]string{jumpHost.TaskARN}, awsecs.WithStopTaskCluster(jumpHost.ClusterARN),
+			awsecs.WithStopTaskReason("Copilot tunnel closed")); err != nil {
+			log.Errorf("Failed to stop jump host task %s: %v\n", jumpHost.TaskARN, err)
+		}
+	}
+	target, err := o.waitForExecAgent(jumpHost, name)
+	if err != nil {
+		stop()
+		return "", nil, err
+	}
+	return target, stop, nil
+}
+
+// waitForExecAgent polls the task until the ECS Exec agent of its container is running.
+func (o *envTunnelOpts) waitForExecAgent(jumpHost *task.Task, container string) (string, error) {
+	deadline := time.Now().Add(o.agentTimeout)
+	for {
+		tasks, err := o.tasks.DescribeTasks(jumpHost.ClusterARN, []string{jumpHost.TaskARN})
+		if err != nil {
+			return "", fmt.Errorf("describe jump host task %s: %w", jumpHost.TaskARN, err)
+		}
+		if len(tasks) == 0 {
+			return "", fmt.Errorf("jump host task %s not found", jumpHost.TaskARN)
+		}
+		target, err := tasks[0].SSMTarget(container)
+		if err == nil {
+			return target, nil
+		}
+		var errNotRunning *awsecs.ErrExecuteCommandAgentNotRunning
+		if !errors.As(err, &errNotRunning) {
+			return "", fmt.Errorf("get session target of jump host task %s: %w", jumpHost.TaskARN, err)
+		}
+		if time.Now().After(deadline) {
+			return "", fmt.Errorf("%w after %s", err, o.agentTimeout)
+		}
+		time.Sleep(o.agentPollInterval)
+	}
+}
+
+// envTunnelJumpHostName returns the name of the task group of the temporary jump host of an environment.
+func envTunnelJumpHostName(app, env string) string {
+	return fmt.Sprintf("%s-%s-tunnel", app, env)
+}
+
+func validateTunnelTarget(target string) error {
+	if id, ok := strings.CutPrefix(target, envTunnelRDSTargetPrefix); ok {
+		if id == "" {
+			return fmt.Errorf(`target %q must be in the format "rds:<identifier>"`, target)
+		}
+		return nil
+	}
+	host, port, err := net.SplitHostPort(target)
+	if err != nil || host == "" {
+		return fmt.Errorf(`target %q must be in the format "rds:<identifier>" or "<host>:<port>"`, target)
+	}
+	if portNumber, err := strconv.Atoi(port); err != nil || portNumber < 1 || portNumber > 65535 {
+		return fmt.Errorf("port %q of target %q is not a valid port number", port, target)
+	}
+	return nil
+}
+
+// buildEnvTunnelCmd builds the command to forward a local port to a host in the VPC of an environment.
+func buildEnvTunnelCmd() *cobra.Command {
+	vars := envTunnelVars{}
+	cmd := &cobra.Command{
+		Use:   "tunnel",
+		Short: "Forward a local port to a host in the VPC of an environment.",
+		Long: `Forward a local port to a host in the VPC of an environment, such as a private database.
+The traffic goes through a temporary task that is stopped when the tunnel is closed,
+or through a task of a service with ECS Exec enabled.`,
+		Example: `
+  Reach the RDS database "mydb" of the "test" environment on localhost:5432.
+  /code $ copilot env tunnel -n test --target rds:mydb --local-port 5432
+  Reach a private host through a task of the "api" service.
+  /code $ copilot env tunnel -n test --target cache.internal:6379 --service api`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newEnvTunnelOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVar(&vars.target, tunnelTargetFlag, "", tunnelTargetFlagDescription)
+	cmd.Flags().IntVar(&vars.localPort, tunnelLocalPortFlag, 0, tunnelLocalPortFlagDescription)
+	cmd.Flags().StringVar(&vars.svcName, tunnelServiceFlag, "", tunnelServiceFlagDescription)
+	markAudited(cmd)
+	return cmd
+}
@@ -0,0 +1,304 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkecs "github.com/aws/aws-sdk-go/service/ecs"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/rds"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/task"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvTunnelOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inTarget    string
+		inLocalPort int
+		setupMocks  func(m *mocks.MockssmPluginManager)
+
+		wantedError error
+	}{
+		"error if the target is missing": {
+			wantedError: errors.New("--target is required"),
+		},
+		"error if the RDS identifier is missing": {
+			inTarget:    "rds:",
+			wantedError: errors.New(`target "rds:" must be in the format "rds:<identifier>"`),
+		},
+		"error if the target has no port": {
+			inTarget:    "cache.internal",
+			wantedError: errors.New(`target "cache.internal" must be in the format "rds:<identifier>" or "<host>:<port>"`),
+		},
+		"error if the port of the target is invalid": {
+			inTarget:    "cache.internal:redis",
+			wantedError: errors.New(`port "redis" of target "cache.internal:redis" is not a valid port number`),
+		},
+		"error if the local port is out of range": {
+			inTarget:    "rds:mydb",
+			inLocalPort: 70000,
+			wantedError: errors.New("local port 70000 is out of range [1-65535]"),
+		},
+		"error if the ssm plugin is invalid": {
+			inTarget: "rds:mydb",
+			setupMocks: func(m *mocks.MockssmPluginManager) {
+				m.EXPECT().ValidateBinary().Return(errors.New("some error"))
+			},
+			wantedError: errors.New("validate ssm plugin: some error"),
+		},
+		"success": {
+			inTarget:    "cache.internal:6379",
+			inLocalPort: 16379,
+			setupMocks: func(m *mocks.MockssmPluginManager) {
+				m.EXPECT().ValidateBinary().Return(nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockssmPluginManager(ctrl)
+			if tc.setupMocks != nil {
+				tc.setupMocks(m)
+			}
+			opts := &envTunnelOpts{
+				envTunnelVars: envTunnelVars{
+					target:    tc.inTarget,
+					localPort: tc.inLocalPort,
+				},
+				ssmPluginManager: m,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+type envTunnelMocks struct {
+	store        *mocks.Mockstore
+	dbDescriber  *mocks.MockdbEndpointDescriber
+	deployer     *mocks.MocktaskDeployer
+	runner       *mocks.MocktaskRunner
+	tasks        *mocks.MockjumpHostTaskManager
+	svcDescriber *mocks.MockserviceDescriber
+	forwarder    *mocks.MockportForwarder
+}
+
+func TestEnvTunnelOpts_Execute(t *testing.T) {
+	const (
+		mockApp        = "phonetool"
+		mockEnv        = "test"
+		mockClusterARN = "arn:aws:ecs:us-west-2:123456789012:cluster/phonetool-test-Cluster"
+		mockTaskARN    = "arn:aws:ecs:us-west-2:123456789012:task/phonetool-test-Cluster/4082490ee6c245e09d2145010aa1ba8d"
+		mockTarget     = "ecs:phonetool-test-Cluster_4082490ee6c245e09d2145010aa1ba8d_4082490ee6c245e09d2145010aa1ba8d-2531612879"
+		mockHost       = "mydb.abc.us-west-2.rds.amazonaws.com"
+	)
+	mockEnvironment := &config.Environment{
+		App:              mockApp,
+		Name:             mockEnv,
+		ExecutionRoleARN: "arn:aws:iam::123456789012:role/phonetool-test-CFNExecutionRole",
+	}
+	ecsTask := func(container, agentStatus string) *awsecs.Task {
+		return &awsecs.Task{
+			TaskArn:    aws.String(mockTaskARN),
+			ClusterArn: aws.String(mockClusterARN),
+			LastStatus: aws.String("RUNNING"),
+			Containers: []*sdkecs.Container{
+				{
+					Name:      aws.String(container),
+					RuntimeId: aws.String("4082490ee6c245e09d2145010aa1ba8d-2531612879"),
+					ManagedAgents: []*sdkecs.ManagedAgent{
+						{
+							Name:       aws.String("ExecuteCommandAgent"),
+							LastStatus: aws.String(agentStatus),
+						},
+					},
+				},
+			},
+		}
+	}
+	jumpHost := []*task.Task{
+		{
+			TaskARN:    mockTaskARN,
+			ClusterARN: mockClusterARN,
+		},
+	}
+	testCases := map[string]struct {
+		inTarget    string
+		inLocalPort int
+		inService   string
+		setupMocks  func(m envTunnelMocks)
+
+		wantedError error
+	}{
+		"error if the database cannot be found": {
+			inTarget: "rds:mydb",
+			setupMocks: func(m envTunnelMocks) {
+				m.dbDescriber.EXPECT().Endpoint("mydb").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get endpoint of database mydb: some error"),
+		},
+		"error if the jump host cannot be provisioned": {
+			inTarget: "rds:mydb",
+			setupMocks: func(m envTunnelMocks) {
+				m.dbDescriber.EXPECT().Endpoint("mydb").Return(&rds.Endpoint{Host: mockHost, Port: 5432}, nil)
+				m.store.EXPECT().GetApplication(mockApp).Return(&config.Application{Name: mockApp}, nil)
+				m.deployer.EXPECT().DeployTask(gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("provision resources for jump host phonetool-test-tunnel: some error"),
+		},
+		"stops the jump host if its ECS Exec agent never runs": {
+			inTarget: "rds:mydb",
+			setupMocks: func(m envTunnelMocks) {
+				m.dbDescriber.EXPECT().Endpoint("mydb").Return(&rds.Endpoint{Host: mockHost, Port: 5432}, nil)
+				m.store.EXPECT().GetApplication(mockApp).Return(&config.Application{Name: mockApp}, nil)
+				m.deployer.EXPECT().DeployTask(gomock.Any(), gomock.Any()).Return(nil)
+				m.runner.EXPECT().Run().Return(jumpHost, nil)
+				m.tasks.EXPECT().DescribeTasks(mockClusterARN, []string{mockTaskARN}).
+					Return([]*awsecs.Task{ecsTask("phonetool-test-tunnel", "PENDING")}, nil).MinTimes(1)
+				m.tasks.EXPECT().StopTasks([]string{mockTaskARN}, gomock.Any(), gomock.Any()).Return(nil)
+			},
+			wantedError: errors.New("the ECS Exec agent of container phonetool-test-tunnel in task 4082490ee6c245e09d2145010aa1ba8d is not running after 10ms"),
+		},
+		"forwards the port through a temporary jump host and stops it": {
+			inTarget: "rds:mydb",
+			setupMocks: func(m envTunnelMocks) {
+				m.dbDescriber.EXPECT().Endpoint("mydb").Return(&rds.Endpoint{Host: mockHost, Port: 5432}, nil)
+				m.store.EXPECT().GetApplication(mockApp).Return(&config.Application{Name: mockApp, PermissionsBoundary: "boundary"}, nil)
+				m.deployer.EXPECT().DeployTask(&deploy.CreateTaskResourcesInput{
+					Name:                "phonetool-test-tunnel",
+					CPU:                 256,
+					Memory:              512,
+					Image:               "public.ecr.aws/amazonlinux/amazonlinux:2023-minimal",
+					Command:             []string{"sleep", "43200"},
+					PermissionsBoundary: "boundary",
+					App:                 mockApp,
+					Env:                 mockEnv,
+				}, gomock.Len(1)).Return(nil)
+				m.runner.EXPECT().Run().Return(jumpHost, nil)
+				gomock.InOrder(
+					m.tasks.EXPECT().DescribeTasks(mockClusterARN, []string{mockTaskARN}).
+						Return([]*awsecs.Task{ecsTask("phonetool-test-tunnel", "PENDING")}, nil),
+					m.tasks.EXPECT().DescribeTasks(mockClusterARN, []string{mockTaskARN}).
+						Return([]*awsecs.Task{ecsTask("phonetool-test-tunnel", "RUNNING")}, nil),
+				)
+				gomock.InOrder(
+					m.forwarder.EXPECT().StartPortForwardingSession(ssm.PortForwardingSessionInput{
+						Target:    mockTarget,
+						Host:      mockHost,
+						Port:      5432,
+						LocalPort: 5432,
+					}).Return(nil),
+					m.tasks.EXPECT().StopTasks([]string{mockTaskARN}, gomock.Any(), gomock.Any()).Return(nil),
+				)
+			},
+		},
+		"error if the service has no task with ECS Exec enabled": {
+			inTarget:  "cache.internal:6379",
+			inService: "api",
+			setupMocks: func(m envTunnelMocks) {
+				m.svcDescriber.EXPECT().DescribeService(mockApp, mockEnv, "api").Return(&ecs.ServiceDesc{
+					Tasks: []*awsecs.Task{
+						{
+							TaskArn:    aws.String(mockTaskARN),
+							ClusterArn: aws.String(mockClusterARN),
+							LastStatus: aws.String("RUNNING"),
+							Containers: []*sdkecs.Container{
+								{
+									Name: aws.String("api"),
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+			wantedError: errors.New("found no running task of service api with ECS Exec enabled in environment test"),
+		},
+		"forwards the port through a task of the service": {
+			inTarget:    "cache.internal:6379",
+			inLocalPort: 16379,
+			inService:   "api",
+			setupMocks: func(m envTunnelMocks) {
+				m.svcDescriber.EXPECT().DescribeService(mockApp, mockEnv, "api").Return(&ecs.ServiceDesc{
+					Tasks: []*awsecs.Task{ecsTask("api", "RUNNING")},
+				}, nil)
+				m.forwarder.EXPECT().StartPortForwardingSession(ssm.PortForwardingSessionInput{
+					Target:    mockTarget,
+					Host:      "cache.internal",
+					Port:      6379,
+					LocalPort: 16379,
+				}).Return(nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := envTunnelMocks{
+				store:        mocks.NewMockstore(ctrl),
+				dbDescriber:  mocks.NewMockdbEndpointDescriber(ctrl),
+				deployer:     mocks.NewMocktaskDeployer(ctrl),
+				runner:       mocks.NewMocktaskRunner(ctrl),
+				tasks:        mocks.NewMockjumpHostTaskManager(ctrl),
+				svcDescriber: mocks.NewMockserviceDescriber(ctrl),
+				forwarder:    mocks.NewMockportForwarder(ctrl),
+			}
+			m.store.EXPECT().GetEnvironment(mockApp, mockEnv).Return(mockEnvironment, nil)
+			tc.setupMocks(m)
+			opts := &envTunnelOpts{
+				envTunnelVars: envTunnelVars{
+					appName:   mockApp,
+					name:      mockEnv,
+					target:    tc.inTarget,
+					localPort: tc.inLocalPort,
+					svcName:   tc.inService,
+				},
+				store: m.store,
+				configureClients: func(env *config.Environment) error {
+					return nil
+				},
+				dbDescriber:       m.dbDescriber,
+				deployer:          m.deployer,
+				runner:            m.runner,
+				tasks:             m.tasks,
+				svcDescriber:      m.svcDescriber,
+				forwarder:         m.forwarder,
+				agentPollInterval: time.Millisecond,
+				agentTimeout:      10 * time.Millisecond,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
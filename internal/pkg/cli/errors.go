@@ -6,6 +6,7 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/dustin/go-humanize/english"
@@ -80,6 +81,21 @@ func (e *errCannotDowngradeEnvVersion) RecommendActions() string {
 	return e.init().RecommendActions()
 }
 
+type errNetworkChangesNotConfirmed struct {
+	envName string
+	phrase  string
+}
+
+func (e *errNetworkChangesNotConfirmed) Error() string {
+	return fmt.Sprintf("network changes for environment %s were not confirmed", e.envName)
+}
+
+// RecommendActions returns recommended actions to be taken after the error.
+func (e *errNetworkChangesNotConfirmed) RecommendActions() string {
+	return fmt.Sprintf(`Type %s exactly to confirm the network changes, or follow the migration plan above.
+You can also pass %s to skip the confirmation.`, color.HighlightUserInput(e.phrase), color.HighlightCode("--"+allowNetworkChangesFlag))
+}
+
 type errCannotDowngradeAppVersion struct {
 	appName         string
 	appVersion      string
@@ -121,6 +137,36 @@ func (e *errCannotDowngradeVersion) RecommendActions() string {
 		color.HighlightCode(fmt.Sprintf("%s %s", e.componentType, e.componentName)), color.HighlightCode(fmt.Sprintf("--%s", allowDowngradeFlag)))
 }
 
+type errVersionNotAllowed struct {
+	app       string
+	component string
+	version   string
+	min       string
+	max       string
+}
+
+func (e *errVersionNotAllowed) Error() string {
+	return fmt.Sprintf("application %q only allows %s versions %s, but this command uses version %s", e.app, e.component, e.allowedRange(), e.version)
+}
+
+func (e *errVersionNotAllowed) RecommendActions() string {
+	return fmt.Sprintf(`The version pin of application %s prevents Copilot components from being updated by a different version of Copilot.
+- We recommend installing a version of the Copilot CLI %s and running this command again.
+- Alternatively, you can update the pin with %s.`,
+		color.HighlightUserInput(e.app), e.allowedRange(), color.HighlightCode(fmt.Sprintf("copilot app upgrade -n %s", e.app)))
+}
+
+func (e *errVersionNotAllowed) allowedRange() string {
+	switch {
+	case e.min != "" && e.max != "":
+		return fmt.Sprintf("between %s and %s", e.min, e.max)
+	case e.min != "":
+		return fmt.Sprintf("%s or later", e.min)
+	default:
+		return fmt.Sprintf("%s or earlier", e.max)
+	}
+}
+
 type errNoInfrastructureChanges struct {
 	parentErr error
 }
@@ -149,6 +195,20 @@ func (e *errBucketEmptyingFailed) RecommendActions() string {
 		english.PluralWord(len(e.failedBuckets), "an S3 bucket", "S3 buckets"), english.PluralWord(len(e.failedBuckets), "bucket is", "buckets are"), english.PluralWord(len(e.failedBuckets), "bucket", "buckets"))
 }
 
+type errEnvPreflightBlocked struct {
+	blockers []string
+}
+
+func (e *errEnvPreflightBlocked) Error() string {
+	return fmt.Sprintf("environment cannot be created:\n%s", strings.Join(e.blockers, "\n"))
+}
+
+func (e *errEnvPreflightBlocked) RecommendActions() string {
+	return fmt.Sprintf(`Grant the missing IAM permissions or request a quota increase in the Service Quotas console, then run %s again.
+You can run the command with %s to create the environment without these checks.`,
+		color.HighlightCode("copilot env init"), color.HighlightCode("--skip-preflight"))
+}
+
 type errPipelineDependsOnEnv struct {
 	pipeline string
 	env      string
@@ -192,3 +252,36 @@ For more information on YAML overrides see %s
     Action: 'sts:AssumeRole'`),
 		color.Emphasize("https://aws.github.io/copilot-cli/docs/developing/overrides/yamlpatch/"))
 }
+
+type errInvalidAuditLogDestination struct {
+	dest string
+}
+
+func (e *errInvalidAuditLogDestination) Error() string {
+	return fmt.Sprintf("invalid value %q for the %s environment variable", e.dest, auditLogEnvVar)
+}
+
+func (e *errInvalidAuditLogDestination) RecommendActions() string {
+	return fmt.Sprintf(`Set the %s environment variable to %s to store the audit log in your workspace,
+or to %s to ship it to an Amazon CloudWatch Logs log group.`,
+		auditLogEnvVar, color.HighlightUserInput(auditLogWorkspaceDestination), color.HighlightUserInput(auditLogCloudWatchDestinationPrefix+"<log group>"))
+}
+
+type errTaskRunnerNotFound struct {
+	app       string
+	env       string
+	parentErr error
+}
+
+func (e *errTaskRunnerNotFound) Error() string {
+	return fmt.Sprintf("environment %s of application %s does not have a task runner: %v", e.env, e.app, e.parentErr)
+}
+
+func (e *errTaskRunnerNotFound) RecommendActions() string {
+	return fmt.Sprintf(`Enable the task runner in the manifest of the environment:
+%s
+Then run %s.`,
+		color.HighlightCodeBlock(`tasks:
+  remote_runner: true`),
+		color.HighlightCode(fmt.Sprintf("copilot env deploy --name %s", e.env)))
+}
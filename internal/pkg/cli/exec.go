@@ -14,5 +14,6 @@ type execVars struct {
 	command          string
 	taskID           string
 	containerName    string
+	discover         string
 	skipConfirmation *bool // If nil, we will prompt to upgrade the ssm plugin.
 }
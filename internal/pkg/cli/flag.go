@@ -9,8 +9,12 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/governance"
+	"github.com/aws/copilot-cli/internal/pkg/iampolicy"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/task"
 	"github.com/dustin/go-humanize/english"
 )
 
@@ -36,7 +40,10 @@ const (
 	detachFlag         = "detach"
 
 	// Deploy flags.
-	yesInitWorkloadFlag = "init-wkld"
+	yesInitWorkloadFlag     = "init-wkld"
+	allowNetworkChangesFlag = "allow-network-changes"
+	showPrioritiesFlag      = "show-priorities"
+	maxParallelFlag         = "max-parallel"
 
 	// Build flags.
 	dockerFileFlag          = "dockerfile"
@@ -45,26 +52,46 @@ const (
 	imageTagFlag            = "tag"
 	stackOutputDirFlag      = "output-dir"
 	uploadAssetsFlag        = "upload-assets"
+	registryCacheFlag       = "registry-cache"
 	deployFlag              = "deploy"
 	diffFlag                = "diff"
 	diffAutoApproveFlag     = "diff-yes"
+	diffFormatFlag          = "diff-format"
 	sourcesFlag             = "sources"
+	handoffFlag             = "handoff"
+	governedTemplateFlag    = "governed-template"
 
 	// Flags for operational commands.
+	discoverFlag                = "discover"
 	limitFlag                   = "limit"
+	eventsFlag                  = "events"
 	lastFlag                    = "last"
 	followFlag                  = "follow"
 	previousFlag                = "previous"
 	sinceFlag                   = "since"
+	untilFlag                   = "until"
+	outputFileFlag              = "output-file"
+	outputFlag                  = "output"
 	startTimeFlag               = "start-time"
 	endTimeFlag                 = "end-time"
 	tasksFlag                   = "tasks"
 	logGroupFlag                = "log-group"
 	containerLogFlag            = "container"
+	jsonFilterFlag              = "json-filter"
 	includeStateMachineLogsFlag = "include-state-machine"
 	resourcesFlag               = "resources"
+	diagramFlag                 = "diagram"
 	taskIDFlag                  = "task-id"
 	containerFlag               = "container"
+	startedByFlag               = "started-by"
+	latestFlag                  = "latest"
+	redriveRateFlag             = "rate"
+	minCapacityFlag             = "min"
+	maxCapacityFlag             = "max"
+	revertAfterFlag             = "revert-after"
+
+	// Flags for IAM policies.
+	iamPolicyForFlag = "for"
 
 	// Run local flags
 	portOverrideFlag   = "port-override"
@@ -73,6 +100,7 @@ const (
 	proxyNetworkFlag   = "proxy-network"
 	watchFlag          = "watch"
 	useTaskRoleFlag    = "use-task-role"
+	withFlag           = "with"
 
 	// Flags for CI/CD.
 	githubURLFlag         = "github-url"
@@ -83,7 +111,8 @@ const (
 	pipelineTypeFlag      = "pipeline-type"
 
 	// Flags for ls.
-	localFlag = "local"
+	localFlag    = "local"
+	deployedFlag = "deployed"
 
 	// Flags for storage.
 	storageTypeFlag                    = "storage-type"
@@ -98,6 +127,17 @@ const (
 	storageRDSEngineFlag               = "engine"
 	storageRDSInitialDBFlag            = "initial-db"
 	storageRDSParameterGroupFlag       = "parameter-group"
+	storageRDSMinCapacityFlag          = "min-capacity"
+	storageRDSMaxCapacityFlag          = "max-capacity"
+	storageRDSReaderInstancesFlag      = "reader-instances"
+	storageRDSProxyFlag                = "rds-proxy"
+	storageRDSEngineVersionFlag        = "engine-version"
+	storageRDSSubnetGroupFlag          = "subnet-group"
+	storageS3TransitionToIAFlag        = "transition-ia-days"
+	storageS3TransitionToGlacierFlag   = "transition-glacier-days"
+	storageS3ReplicationBucketFlag     = "replication-bucket"
+	storageS3EventBridgeFlag           = "eventbridge"
+	storageS3PolicyPresetsFlag         = "policy-presets"
 
 	// Flags for one-off tasks.
 	taskGroupNameFlag            = "task-group-name"
@@ -118,14 +158,22 @@ const (
 	entrypointFlag               = "entrypoint"
 	taskDefaultFlag              = "default"
 	generateCommandFlag          = "generate-cmd"
+	remoteFlag                   = "remote"
+	roleNamePrefixFlag           = "role-name-prefix"
+	envRoleFlag                  = "env-role"
+	efsFlag                      = "efs"
+	efsFromFlag                  = "efs-from"
 	osFlag                       = "platform-os"
 	archFlag                     = "platform-arch"
+	launchTypeFlag               = "launch-type"
+	capacityProviderFlag         = "capacity-provider"
 
 	// Flags for environment configurations.
 	vpcIDFlag                      = "import-vpc-id"
 	publicSubnetsFlag              = "import-public-subnets"
 	privateSubnetsFlag             = "import-private-subnets"
 	certsFlag                      = "import-cert-arns"
+	importClusterNameFlag          = "import-cluster-name"
 	internalALBSubnetsFlag         = "internal-alb-subnets"
 	allowVPCIngressFlag            = "internal-alb-allow-vpc-ingress"
 	overrideVPCCIDRFlag            = "override-vpc-cidr"
@@ -135,6 +183,7 @@ const (
 
 	enableContainerInsightsFlag = "container-insights"
 	defaultConfigFlag           = "default-config"
+	kmsKeyARNFlag               = "kms-key-arn"
 
 	accessKeyIDFlag     = "aws-access-key-id"
 	secretAccessKeyFlag = "aws-secret-access-key"
@@ -142,9 +191,11 @@ const (
 	regionFlag          = "region"
 
 	// Flags for creating secrets.
-	valuesFlag        = "values"
-	overwriteFlag     = "overwrite"
-	inputFilePathFlag = "cli-input-yaml"
+	valuesFlag               = "values"
+	defaultValueFlag         = "default-value"
+	overwriteFlag            = "overwrite"
+	overwriteOnlyChangedFlag = "overwrite-only-changed"
+	inputFilePathFlag        = "cli-input-yaml"
 
 	// Flags for overriding templates.
 	iacToolFlag       = "tool"
@@ -158,13 +209,37 @@ const (
 	ingressTypeFlag         = "ingress-type"
 	retriesFlag             = "retries"
 	timeoutFlag             = "timeout"
+	waitFlag                = "wait"
 	scheduleFlag            = "schedule"
 	domainNameFlag          = "domain"
 	permissionsBoundaryFlag = "permissions-boundary"
+	cfnExecutionRoleFlag    = "cfn-execution-role"
+	envRolesTemplateFlag    = "env-roles-template"
+	envAccountFlag          = "account"
+	ciRolesFlag             = "ci-roles"
+	skipPreflightFlag       = "skip-preflight"
 	prodEnvFlag             = "prod"
 	deleteSecretFlag        = "delete-secret"
 	deployEnvFlag           = "deploy-env"
 	yesInitEnvFlag          = "init-env"
+
+	ecrKeepImagesFlag         = "ecr-keep-images"
+	ecrExpireUntaggedDaysFlag = "ecr-expire-untagged-days"
+
+	minCLIVersionFlag         = "min-cli-version"
+	maxCLIVersionFlag         = "max-cli-version"
+	minEnvTemplateVersionFlag = "min-env-template-version"
+	maxEnvTemplateVersionFlag = "max-env-template-version"
+
+	// Flags for tunnels.
+	tunnelTargetFlag    = "target"
+	tunnelLocalPortFlag = "local-port"
+	tunnelServiceFlag   = "service"
+
+	// Flags for cleanups.
+	taskDefinitionsFlag = "task-definitions"
+	keepFlag            = "keep"
+	deleteFlag          = "delete"
 )
 
 // Short flag names.
@@ -228,6 +303,10 @@ This flag is useful only when '%s' flag is specified`, secretsFlag)
 	subnetsFlagDescription = fmt.Sprintf(`Optional. The subnet IDs for the task to use. Can be specified multiple times.
 Cannot be specified with --%s, --%s or --%s.`, appFlag, envFlag, taskDefaultFlag)
 	securityGroupsFlagDescription = "Optional. Additional security group IDs for the task to use. Can be specified multiple times."
+	efsFlagDescription            = `Optional. An EFS file system to mount into the container,
+formatted as volume-name:fs-id:/container/path. Can be specified multiple times.`
+	efsFromFlagDescription = `Optional. Name of a service or job in the workspace whose
+EFS volumes to mount into the container.`
 	taskRunDefaultFlagDescription = fmt.Sprintf(`Optional. Run tasks in default cluster and default subnets. 
 Cannot be specified with --%s, --%s or --%s.`, appFlag, envFlag, subnetsFlag)
 	taskExecDefaultFlagDescription = fmt.Sprintf(`Optional. Execute commands in running tasks in default cluster and default subnets. 
@@ -241,12 +320,21 @@ Cannot be specified with --%s, --%s or --%s.`, taskDefaultFlag, subnetsFlag, sec
 	osFlagDescription   = fmt.Sprintf(`Optional. Operating system of the task. Must be specified along with '%s'.`, archFlag)
 	archFlagDescription = fmt.Sprintf(`Optional. Architecture of the task. Must be specified along with '%s'.`, osFlag)
 
+	launchTypeFlagDescription = fmt.Sprintf(`Optional. Launch type of the task. Must be one of %s. Defaults to %s.
+Tasks launched on EC2 container instances don't get a public IP address.`, strings.Join(task.LaunchTypes, ", "), task.LaunchTypeFargate)
+	capacityProviderFlagDescription = fmt.Sprintf(`Optional. Name of a capacity provider of the cluster to run the task with.
+Cannot be specified with '--%s %s'.`, launchTypeFlag, task.LaunchTypeEC2)
+
 	secretNameFlagDescription = fmt.Sprintf(`The name of the secret.
 Mutually exclusive with the --%s flag.`, inputFilePathFlag)
 	secretValuesFlagDescription = fmt.Sprintf(`Values of the secret in each environment. Specified as <environment>=<value> separated by commas.
 Mutually exclusive with the --%s flag.`, inputFilePathFlag)
 	secretInputFilePathFlagDescription = fmt.Sprintf(`Optional. A YAML file in which the secret values are specified.
-Mutually exclusive with the -%s ,--%s and --%s flags.`, nameFlagShort, nameFlag, valuesFlag)
+Mutually exclusive with the -%s ,--%s, --%s and --%s flags.`, nameFlagShort, nameFlag, valuesFlag, defaultValueFlag)
+	secretDefaultValueFlagDescription = fmt.Sprintf(`Optional. Value of the secret in every environment without a value in --%s.
+Mutually exclusive with the --%s flag.`, valuesFlag, inputFilePathFlag)
+	secretOverwriteOnlyChangedFlagDescription = fmt.Sprintf(`Optional. Whether to overwrite an existing secret only if its value changed.
+Mutually exclusive with the --%s flag.`, overwriteFlag)
 
 	iacToolFlagDescription = fmt.Sprintf(`Infrastructure as Code tool to override a template.
 Must be one of: %s.`, strings.Join(applyAll(validIaCTools, strconv.Quote), ", "))
@@ -255,11 +343,25 @@ Must be one of: %s.`, strings.Join(applyAll(validIaCTools, strconv.Quote), ", ")
 Defaults to a random environment.`
 	skipResourcesFlagDescription = `Optional. Skip asking for which resources to override and generate empty IaC extension files.`
 
+	svcDeployValuesFlagDescription = fmt.Sprintf(`Optional. Overrides of manifest fields for this deployment, specified as key=value pairs.
+Keys must be one of: %s, or "%s" for the image tag.
+The applied overrides are recorded in the stack metadata.`, strings.Join(manifest.DeployValueKeys, ", "), deployValueImageTag)
+	handoffFlagDescription = fmt.Sprintf(`Optional. Render the inputs of a governed template instead of deploying the service.
+Must be one of: %s. Must be specified with --%s.`, strings.Join(governance.Kinds, ", "), governedTemplateFlag)
+
+	iamPolicyForFlagDescription = fmt.Sprintf(`The operation to generate the IAM policy for.
+Must be one of: %s.`, strings.Join(applyAll(iampolicy.Operations, strconv.Quote), ", "))
+	iamPolicyAppFlagDescription = `Optional. Name of the application to scope the resources of the policy to.
+Defaults to every application.`
+
 	repoURLFlagDescription = fmt.Sprintf(`The repository URL to trigger your pipeline.
 Supported providers are: %s.`, strings.Join(manifest.PipelineProviders, ", "))
 
 	ingressTypeFlagDescription = fmt.Sprintf(`Required for a Request-Driven Web Service. Allowed source of traffic to your service.
 Must be one of %s.`, english.OxfordWordSeries(rdwsIngressOptions, "or"))
+
+	storageS3PolicyPresetsFlagDescription = fmt.Sprintf(`Optional. Presets of statements to add to the bucket policy.
+Must be one or more of: %s.`, strings.Join(applyAll(addon.S3PolicyPresets, strconv.Quote), ", "))
 )
 
 const (
@@ -276,6 +378,13 @@ const (
 Allows you to categorize resources.`
 	diffFlagDescription            = "Compares the generated CloudFormation template to the deployed stack."
 	diffAutoApproveFlagDescription = "Skip interactive approval of diff before deploying."
+	diffFormatFlagDescription      = `Optional. Print the diff in a machine-readable format: json or sarif.
+Must be specified with --diff.`
+	governedTemplateFlagDescription = `Optional. Path to the AWS Proton schema file or AWS Service Catalog product template
+that governs the service. Fails if the manifest uses fields that the template doesn't support.`
+	discoverFlagDescription = `Optional. Where to discover the application, environments and services from: ssm or cfn.
+Defaults to ssm. With cfn, resources are found from the tags of the CloudFormation stacks
+in the default account and region, for when the SSM config store is unavailable.`
 
 	// Deployment.
 	deployFlagDescription         = `Deploy your service or job to a new or existing environment.`
@@ -291,18 +400,42 @@ production environment.`
 	yesInitWorkloadFlagDescription = "Optional. When specified with --all, initialize all local workloads before deployment."
 	allWorkloadsFlagDescription    = "Optional. Deploy all workloads with manifests in the current Copilot workspace."
 	detachFlagDescription          = "Optional. Skip displaying CloudFormation deployment progress."
+	maxParallelFlagDescription     = `Optional. Maximum number of workloads that don't depend on each other
+to deploy at the same time. Defaults to 1.
+CloudFormation deployment progress isn't displayed while deploying in parallel.`
+
+	allowNetworkChangesFlagDescription = `Optional. Skip the confirmation phrase when the deployment
+deletes or replaces the environment's VPC, subnets, or gateways.`
+	imageBumpNameFlagDescription      = "Optional. Name of the service or job. Defaults to all workloads in the workspace."
+	imageBumpGitBranchFlagDescription = `Optional. Create a git branch with this name
+and commit the manifests with updated image digests to it.`
 
 	// Operational.
-	jsonFlagDescription = "Optional. Output in JSON format."
+	jsonFlagDescription       = "Optional. Output in JSON format."
+	jsonOutputFlagDescription = "Optional. Output in JSON format. Equivalent to --output json."
+	outputFlagDescription     = `Optional. Output format: table, json or yaml. Defaults to table.
+The json and yaml formats follow the versioned schemas documented at https://aws.github.io/copilot-cli/docs/output/.`
 
 	limitFlagDescription = `Optional. The maximum number of log events returned. Default is 10
 unless any time filtering flags are set.`
-	lastFlagDescription = `Optional. The number of executions of the scheduled job for which
+	svcEventsLimitFlagDescription = "Optional. The maximum number of events in the timeline shown with --events, up to 100."
+	svcStatusWatchFlagDescription = `Optional. Refresh the deployment, task and alarm statuses of the service
+every 5 seconds until interrupted.`
+	auditLimitFlagDescription = "Optional. The maximum number of audit log entries returned. Set to 0 to return all the entries."
+	lastFlagDescription       = `Optional. The number of executions of the scheduled job for which
 logs should be shown.`
 	followFlagDescription   = "Optional. Specifies if the logs should be streamed."
 	previousFlagDescription = "Optional. Print logs for the last stopped task if exists."
 	sinceFlagDescription    = `Optional. Only return logs newer than a relative duration like 5s, 2m, or 3h.
 Defaults to all logs. Only one of start-time / since may be used.`
+	svcLogsSinceFlagDescription = `Optional. Only return logs newer than a relative duration like 5s, 2m, or 3h,
+or than a specific date (RFC3339). Defaults to all logs.
+Only one of start-time / since may be used.`
+	untilFlagDescription = `Optional. Only return logs older than a relative duration like 5s, 2m, or 3h,
+or than a specific date (RFC3339). Defaults to all logs.
+Only one of end-time / until / follow may be used.`
+	outputFileFlagDescription = `Optional. Write all the log events in the time range to a file in JSON Lines format
+instead of showing them. Only one of output-file / follow may be used.`
 	startTimeFlagDescription = `Optional. Only return logs after a specific date (RFC3339).
 Defaults to all logs. Only one of start-time / since may be used.`
 	endTimeFlagDescription = `Optional. Only return logs before a specific date (RFC3339).
@@ -311,6 +444,8 @@ Defaults to all logs. Only one of end-time / follow may be used.`
 	includeStateMachineLogsFlagDescription = "Optional. Include logs from the state machine executions."
 	logGroupFlagDescription                = "Optional. Only return logs from specific log group."
 	containerLogFlagDescription            = "Optional. Return only logs from a specific container."
+	jsonFilterFlagDescription              = `Optional. Only return log events whose message is a JSON object
+matching the expression. For example: 'level=="error" && request.status>=500'.`
 
 	envResourcesFlagDescription      = "Optional. Show the resources in your environment."
 	svcResourcesFlagDescription      = "Optional. Show the resources in your service."
@@ -318,6 +453,9 @@ Defaults to all logs. Only one of end-time / follow may be used.`
 	localSvcFlagDescription          = "Only show services in the workspace."
 	localJobFlagDescription          = "Only show jobs in the workspace."
 	localPipelineFlagDescription     = "Only show pipelines in the workspace."
+	deployedSvcFlagDescription       = "Optional. Show the environments each service is deployed in."
+	showPrioritiesFlagDescription    = `Optional. Show the priorities of the listener rules of the
+environment's load balancers and the conflicts between services.`
 
 	// Run local
 	envVarOverrideFlagDescription = `Optional. Override environment variables passed to containers.
@@ -328,20 +466,35 @@ Example: --port-override 5000:80 binds localhost:5000 to the service's port 80.`
 	proxyNetworkFlagDescription = `Optional. Set the IP Network used by --proxy.`
 	watchFlagDescription        = `Optional. Watch changes to local files and restart containers when updated. Directories and files in the main .dockerignore file are ignored.`
 	useTaskRoleFlagDescription  = "Optional. Run containers with TaskRole credentials instead of session credentials."
+	withFlagDescription         = `Optional. Names of other workloads from the workspace to run locally alongside the workload.
+Their containers are reachable by their service connect and service discovery names.`
 
 	svcManifestFlagDescription = `Optional. Name of the environment in which the service was deployed;
 output the manifest file used for that deployment.`
+	svcEventsFlagDescription = `Optional. Name of the environment in which the service was deployed;
+output a chronological timeline of the latest CloudFormation stack events,
+ECS service events and alarm state changes of the service in that environment.`
+	svcDiagramFlagDescription = `Optional. Path of a file to write a Mermaid diagram of the service's infrastructure to.
+Must end with .mmd, .mermaid, or .md.`
 	manifestFlagDescription = "Optional. Output the manifest file used for the deployment."
 
 	execYesFlagDescription     = "Optional. Whether to update the Session Manager Plugin."
 	taskIDFlagDescription      = "Optional. ID of the task you want to exec in."
+	ecsTaskIDFlagDescription   = "ID of the task to describe. A prefix of the ID is accepted."
 	execCommandFlagDescription = `Optional. The command that is passed to a running container.`
-	containerFlagDescription   = "Optional. The specific container you want to exec in. By default the first essential container will be used."
+	containerFlagDescription   = "Optional. The specific container you want to exec in. By default the main container of the service is used."
+
+	taskExecResourceTagsFlagDescription = `Optional. Only exec in tasks launched with all of these tags.
+Labels with a key and value separated by commas.`
+	taskExecStartedByFlagDescription = `Optional. Only exec in tasks with this "startedBy" value.`
+	taskExecLatestFlagDescription    = "Optional. Exec in the most recently started task instead of prompting."
 
 	// Build.
 	imageTagFlagDescription     = `Optional. The tag for the container images Copilot builds from Dockerfiles.`
 	uploadAssetsFlagDescription = `Optional. Whether to upload assets (container images, Lambda functions, etc.).
 Uploaded asset locations are filled in the template configuration.`
+	registryCacheFlagDescription = `Optional. Whether to import and export the build cache of the container images
+from the ECR repository of the workload. Must be used with --upload-assets.`
 	stackOutputDirFlagDescription = "Optional. Writes the stack template and template configuration to a directory."
 
 	// CI/CD.
@@ -371,6 +524,26 @@ With "workload" lifecycle, use "v1" or "v2".
 Must be either "MySQL" or "PostgreSQL".`
 	storageRDSInitialDBFlagDescription      = "The initial database to create in the cluster."
 	storageRDSParameterGroupFlagDescription = "Optional. The name of the parameter group to associate with the cluster."
+	storageRDSMinCapacityFlagDescription    = `Optional. The minimum capacity of the Aurora Serverless v2 cluster
+in Aurora capacity units (ACUs), from 0.5 through 128. Defaults to 0.5.`
+	storageRDSMaxCapacityFlagDescription = `Optional. The maximum capacity of the Aurora Serverless v2 cluster
+in Aurora capacity units (ACUs), from 0.5 through 128. Defaults to 8.`
+	storageRDSReaderInstancesFlagDescription = `Optional. The number of reader instances to create
+in the Aurora Serverless v2 cluster in addition to the writer instance.`
+	storageRDSProxyFlagDescription = `Optional. Create an RDS Proxy in front of the Aurora Serverless v2 cluster
+to pool the connections of your workloads.`
+	storageRDSEngineVersionFlagDescription = `Optional. The engine version of the Aurora Serverless v2 cluster,
+such as "8.0.mysql_aurora.3.04.0" for MySQL or "15.4" for PostgreSQL.`
+	storageRDSSubnetGroupFlagDescription = `Optional. The name of the environment's subnet group
+to place the Aurora Serverless cluster in. Defaults to the private subnets.`
+	storageS3TransitionToIAFlagDescription = `Optional. The number of days after creation to move objects
+to the S3 Standard-IA storage class. Must be at least 30.`
+	storageS3TransitionToGlacierFlagDescription = `Optional. The number of days after creation to move objects
+to the S3 Glacier Flexible Retrieval storage class.`
+	storageS3ReplicationBucketFlagDescription = `Optional. The name of an existing versioned bucket
+to replicate the objects of the bucket to.`
+	storageS3EventBridgeFlagDescription = `Optional. Send the event notifications of the bucket to Amazon EventBridge.
+Required for worker services to subscribe to the bucket.`
 
 	// One-off tasks.
 	countFlagDescription         = "Optional. The number of tasks to set up."
@@ -393,12 +566,22 @@ Tasks with the same group name share the same set of resources.
 To use it for an ECS service, specify --generate-cmd <cluster name>/<service name>.
 Alternatively, if the service or job is created with Copilot, specify --generate-cmd <application>/<environment>/<service or job name>.
 Cannot be specified with any other flags.`
+	taskOutputFlagDescription = `Optional. Wait for the tasks to stop and print a result document in the given format.
+Must be "json". The document has the ARN, stop code, stop reason, duration and log stream URL
+of each task, and the exit code of each container. Exits with a non-zero code if an essential container did.`
+	taskRunTimeoutFlagDescription = `Optional. Maximum time to wait for the tasks to stop with --output. Defaults to no limit.
+Accepts valid Go duration strings. For example: "30m", "1h30m".`
+	remoteFlagDescription = `Optional. Run the task from the task runner of the environment instead of from this machine.
+Requires --app, --env, --image and "tasks.remote_runner" to be enabled in the environment manifest.
+Streams the logs of the task and exits with the exit code of the task.`
 
 	// Environment configurations.
-	vpcIDFlagDescription              = "Optional. Use an existing VPC ID."
-	publicSubnetsFlagDescription      = "Optional. Use existing public subnet IDs."
-	privateSubnetsFlagDescription     = "Optional. Use existing private subnet IDs."
-	certsFlagDescription              = "Optional. Apply existing ACM certificates to the internet-facing load balancer."
+	vpcIDFlagDescription             = "Optional. Use an existing VPC ID."
+	publicSubnetsFlagDescription     = "Optional. Use existing public subnet IDs."
+	privateSubnetsFlagDescription    = "Optional. Use existing private subnet IDs."
+	certsFlagDescription             = "Optional. Apply existing ACM certificates to the internet-facing load balancer."
+	importClusterNameFlagDescription = `Optional. Name of an existing ECS cluster to run the environment's workloads in,
+instead of creating one. Cannot be specified with --container-insights or --default-config.`
 	internalALBSubnetsFlagDescription = `Optional. Specify subnet IDs for an internal load balancer.
 By default, the load balancer will be placed in your private subnets.
 Cannot be specified with --default-config or any of the --override flags.`
@@ -414,12 +597,18 @@ Cannot be specified with --default-config or any of the --override flags.`
 
 	enableContainerInsightsFlagDescription = "Optional. Enable CloudWatch Container Insights."
 	defaultConfigFlagDescription           = "Optional. Skip prompting and use default environment configuration."
+	kmsKeyARNFlagDescription               = `Optional. ARN of a customer managed KMS key to encrypt
+the environment's log groups and secrets with, instead of AWS managed keys.`
 
 	profileFlagDescription         = "Name of the profile for the environment account."
 	accessKeyIDFlagDescription     = "Optional. An AWS access key for the environment account."
 	secretAccessKeyFlagDescription = "Optional. An AWS secret access key for the environment account."
 	sessionTokenFlagDescription    = "Optional. An AWS session token for temporary credentials."
 	envRegionTokenFlagDescription  = "Optional. An AWS region where the environment will be created."
+	envAdoptProfileFlagDescription = `Optional. Name of the profile for the account of the environment.
+Defaults to the default credentials.`
+	envAdoptRegionFlagDescription = `Optional. The AWS region where the environment is deployed.
+Defaults to the region of the profile.`
 
 	// Other.
 	domainNameFlagDescription      = "Optional. Your existing custom domain name."
@@ -428,9 +617,20 @@ Cannot be specified with --default-config or any of the --override flags.`
 	noSubscriptionFlagDescription  = "Optional. Turn off selection for adding subscriptions for worker services."
 	subscribeTopicsFlagDescription = `Optional. SNS topics to subscribe to from other services in your application.
 Must be of format '<svcName>:<topicName>'.`
+	previewEnvFlagDescription    = "Name of the environment that hosts previews."
+	previewBranchFlagDescription = `Optional. Git branch of the preview.
+Defaults to the current branch of the workspace.`
+	previewDomainFlagDescription = `Optional. Domain under which the host of the preview is created.
+Defaults to the domain of the environment, "<env>.<app>.<domain>".`
 	retriesFlagDescription = "Optional. The number of times to try restarting the job on a failure."
 	timeoutFlagDescription = `Optional. The total execution time for the task, including retries.
 Accepts valid Go duration strings. For example: "2h", "1h30m", "900s".`
+	jobRunWaitFlagDescription = `Optional. Wait for the execution of the job to complete.
+Exits with code 2 if the task fails, 3 on timeout, 4 on throttling, 5 on a Step Functions runtime error, and 1 otherwise.`
+	jobRunFollowFlagDescription = `Optional. Stream the logs of the job's task until the execution completes.
+Exits with the exit code of the task's container if it is non-zero, and otherwise as with --wait.`
+	jobRunTimeoutFlagDescription = `Optional. Maximum time to wait for the execution with --wait or --follow. Defaults to no limit.
+Accepts valid Go duration strings. For example: "30m", "1h30m".`
 	scheduleFlagDescription = `The schedule on which to run this job. 
 Accepts cron expressions of the format (M H DoM M DoW) and schedule definition strings. 
 For example: "0 * * * *", "@daily", "@weekly", "@every 1h30m".
@@ -440,6 +640,66 @@ are also accepted.`
 	secretOverwriteFlagDescription     = "Optional. Whether to overwrite an existing secret."
 	permissionsBoundaryFlagDescription = `Optional. The name or ARN of an existing IAM policy with which to set a
 permissions boundary for all roles generated within the application.`
+	taskPermissionsBoundaryFlagDescription = `Optional. The name of an existing IAM policy with which to set a
+permissions boundary for the roles generated for the task.`
+	roleNamePrefixFlagDescription = `Optional. Prefix of the names of the roles generated for the task.`
+	envRoleFlagDescription        = `Optional. The ARN of the role to assume in the environment
+instead of the environment manager role.`
+	appCFNExecutionRoleFlagDescription = `Optional. The ARN of an existing IAM role that CloudFormation assumes
+to deploy the stacks of the application and its pipelines.`
+	envRolesTemplateFlagDescription = `Optional. Path to a file to write a CloudFormation template to.
+The template creates the IAM roles to create the environments of the application in another account
+from the application account. Deploy it to your environment accounts, for example with a StackSet,
+and create the environments with "copilot env init --account".`
+	ciRolesFlagDescription = `Optional. The ARNs of existing IAM roles of external CI systems,
+such as a GitHub Actions or Jenkins role, allowed to push and pull the images
+of the ECR repositories and to read the artifact buckets of the application.`
+	envCFNExecutionRoleFlagDescription = `Optional. The ARN of an existing IAM role that CloudFormation assumes
+to deploy the stacks of the environment and its workloads.
+Defaults to the role of the application if the environment is in the application's account.`
+	envAccountFlagDescription = `Optional. ID of the account to create the environment in with the roles
+created by "copilot app init --env-roles-template", using the credentials of the application's account
+instead of credentials of the environment's account.`
+	skipPreflightFlagDescription = "Optional. Skip checking IAM permissions and service quotas before creating the environment."
+
+	ecrKeepImagesFlagDescription = `Optional. The number of most recent images to keep
+in each ECR repository created by Copilot for the application.`
+	ecrExpireUntaggedDaysFlagDescription = `Optional. The number of days after which untagged images
+expire in each ECR repository created by Copilot for the application.`
+
+	minCLIVersionFlagDescription = `Optional. The earliest Copilot CLI version allowed to run commands
+that update the resources of the application, such as v1.32.0.`
+	maxCLIVersionFlagDescription = `Optional. The latest Copilot CLI version allowed to run commands
+that update the resources of the application, such as v1.33.0.`
+	minEnvTemplateVersionFlagDescription = `Optional. The earliest template version allowed
+to deploy the environments of the application, such as v1.32.0.`
+	maxEnvTemplateVersionFlagDescription = `Optional. The latest template version allowed
+to deploy the environments of the application, such as v1.33.0.`
+
+	redriveRateFlagDescription = `Optional. The maximum number of messages to move per second, between 1 and 500.
+Defaults to a rate that Amazon SQS optimizes based on the number of messages.`
+
+	minCapacityFlagDescription = `Optional. The minimum number of tasks to override the capacity of the service with.
+Defaults to the current minimum.`
+	maxCapacityFlagDescription = `Optional. The maximum number of tasks to override the capacity of the service with.
+Defaults to the current maximum.`
+	revertAfterFlagDescription = `Optional. How long the override lasts before the capacity
+reverts to its value prior to the override, such as 30m or 2h.`
+
+	taskDefinitionsFlagDescription = `Deregister the task definition revisions of the service
+beyond the retention count. Revisions used by the service or by running tasks are kept.`
+	keepTaskDefinitionsFlagDescription = `Optional. The number of most recent task definition revisions to keep.
+Defaults to "deployment.keep_task_definitions" in the deployed manifest, or 10.`
+	deleteTaskDefinitionsFlagDescription = `Optional. Also delete the deregistered revisions,
+instead of leaving them inactive.`
+
+	tunnelTargetFlagDescription = `The host to reach in the VPC of the environment.
+Either "rds:<identifier>" for an RDS DB cluster or DB instance, or "<host>:<port>".`
+	tunnelLocalPortFlagDescription = `Optional. The local port to forward to the target.
+Defaults to the port of the target.`
+	tunnelServiceFlagDescription = `Optional. The name of a service with ECS Exec enabled whose task forwards the traffic.
+Defaults to a temporary task that is stopped when the tunnel is closed.`
+
 	prodEnvFlagDescription    = "If the environment contains production services."
 	deployEnvFlagDescription  = "Deploy the target environment before deploying the workload."
 	yesInitEnvFlagDescription = "Confirm initializing the target environment if it does not exist."
@@ -43,3 +43,18 @@ func imageTagFromGit(r execRunner) string {
 	}
 	return commit
 }
+
+// gitBranch returns the current git branch in case the user is in a git repository.
+// Returns the empty string if the HEAD is detached or if the branch can't be retrieved.
+func gitBranch(r execRunner) string {
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	if err := r.Run("git", []string{"rev-parse", "--abbrev-ref", "HEAD"}, exec.Stdout(&stdout), exec.Stderr(&stderr)); err != nil {
+		return ""
+	}
+	branch := strings.TrimSpace(stdout.String())
+	if branch == "HEAD" {
+		return ""
+	}
+	return branch
+}
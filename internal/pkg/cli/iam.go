@@ -0,0 +1,39 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/spf13/cobra"
+)
+
+// BuildIAMCmd is the top level command for the IAM permissions needed by Copilot.
+func BuildIAMCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "iam",
+		Short: `Commands for IAM permissions.
+Generate least-privilege IAM policies to run Copilot without administrator access.`,
+	}
+
+	cmd.AddCommand(buildIAMPolicyCmd())
+
+	cmd.SetUsageTemplate(template.Usage)
+	cmd.Annotations = map[string]string{
+		"group": group.Settings,
+	}
+	return cmd
+}
+
+func buildIAMPolicyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "policy",
+		Short: "Commands for IAM policies.",
+	}
+
+	cmd.AddCommand(buildIAMPolicyGenerateCmd())
+
+	cmd.SetUsageTemplate(template.Usage)
+	return cmd
+}
@@ -0,0 +1,104 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/aws/copilot-cli/internal/pkg/iampolicy"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/spf13/cobra"
+)
+
+const (
+	iamPolicyOperationPrompt     = "Which operation would you like to generate an IAM policy for?"
+	iamPolicyOperationHelpPrompt = `The policy grants the permissions that the operation needs with your credentials.
+"init" includes the permissions to create an application, an environment and deploy a service.`
+)
+
+type iamPolicyGenerateVars struct {
+	operation string
+	appName   string
+}
+
+type iamPolicyGenerateOpts struct {
+	iamPolicyGenerateVars
+
+	w      io.Writer
+	prompt prompter
+}
+
+func newIAMPolicyGenerateOpts(vars iamPolicyGenerateVars) *iamPolicyGenerateOpts {
+	return &iamPolicyGenerateOpts{
+		iamPolicyGenerateVars: vars,
+		w:                     log.OutputWriter,
+		prompt:                prompt.New(),
+	}
+}
+
+// Validate returns an error if the operation is not supported.
+func (o *iamPolicyGenerateOpts) Validate() error {
+	if o.operation == "" {
+		return nil
+	}
+	for _, op := range iampolicy.Operations {
+		if o.operation == op {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid operation %q: must be one of %s", o.operation, prettify(iampolicy.Operations))
+}
+
+// Ask prompts for the operation if it is not provided.
+func (o *iamPolicyGenerateOpts) Ask() error {
+	if o.operation != "" {
+		return nil
+	}
+	op, err := o.prompt.SelectOne(iamPolicyOperationPrompt, iamPolicyOperationHelpPrompt, iampolicy.Operations, prompt.WithFinalMessage("Operation:"))
+	if err != nil {
+		return fmt.Errorf("select operation: %w", err)
+	}
+	o.operation = op
+	return nil
+}
+
+// Execute writes the IAM policy document for the operation.
+func (o *iamPolicyGenerateOpts) Execute() error {
+	doc, err := iampolicy.Generate(o.operation, o.appName)
+	if err != nil {
+		return err
+	}
+	data, err := doc.JSONString()
+	if err != nil {
+		return err
+	}
+	fmt.Fprint(o.w, data)
+	return nil
+}
+
+// buildIAMPolicyGenerateCmd builds the command to generate the IAM policy of a Copilot operation.
+func buildIAMPolicyGenerateCmd() *cobra.Command {
+	vars := iamPolicyGenerateVars{}
+	cmd := &cobra.Command{
+		Use:   "generate",
+		Short: "Generates the least-privilege IAM policy needed to run a Copilot operation.",
+		Long: `Generates the least-privilege IAM policy needed to run a Copilot operation.
+The policy is generated from the templates that the operation deploys with your credentials,
+so that security teams can grant scoped permissions instead of administrator access.
+Environment and workload stacks are deployed by the roles that Copilot creates in each environment.`,
+		Example: `
+  Generates the policy to create environments in any application.
+  /code $ copilot iam policy generate --for env-init
+  Generates the policy to deploy services of the "phonetool" application and saves it to a file.
+  /code $ copilot iam policy generate --for svc-deploy --app phonetool > policy.json`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			return run(newIAMPolicyGenerateOpts(vars))
+		}),
+	}
+	cmd.Flags().StringVar(&vars.operation, iamPolicyForFlag, "", iamPolicyForFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, "", iamPolicyAppFlagDescription)
+	return cmd
+}
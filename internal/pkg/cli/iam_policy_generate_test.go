@@ -0,0 +1,124 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/iampolicy"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIAMPolicyGenerateOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inOperation string
+
+		wantedError error
+	}{
+		"valid if the operation is not provided": {},
+		"valid if the operation is supported": {
+			inOperation: iampolicy.OperationEnvInit,
+		},
+		"errors if the operation is not supported": {
+			inOperation: "svc-delete",
+			wantedError: errors.New(`invalid operation "svc-delete": must be one of "init", "env-init", "svc-deploy", "pipeline-deploy"`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &iamPolicyGenerateOpts{
+				iamPolicyGenerateVars: iamPolicyGenerateVars{
+					operation: tc.inOperation,
+				},
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestIAMPolicyGenerateOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inOperation string
+		mockPrompt  func(m *mocks.Mockprompter)
+
+		wantedOperation string
+		wantedError     error
+	}{
+		"does not prompt if the operation is provided": {
+			inOperation:     iampolicy.OperationSvcDeploy,
+			mockPrompt:      func(m *mocks.Mockprompter) {},
+			wantedOperation: iampolicy.OperationSvcDeploy,
+		},
+		"prompts for the operation": {
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().SelectOne(iamPolicyOperationPrompt, iamPolicyOperationHelpPrompt, iampolicy.Operations, gomock.Any()).
+					Return(iampolicy.OperationPipelineDeploy, nil)
+			},
+			wantedOperation: iampolicy.OperationPipelineDeploy,
+		},
+		"errors if failed to select the operation": {
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("select operation: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockprompter(ctrl)
+			tc.mockPrompt(m)
+			opts := &iamPolicyGenerateOpts{
+				iamPolicyGenerateVars: iamPolicyGenerateVars{
+					operation: tc.inOperation,
+				},
+				prompt: m,
+			}
+
+			err := opts.Ask()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOperation, opts.operation)
+		})
+	}
+}
+
+func TestIAMPolicyGenerateOpts_Execute(t *testing.T) {
+	// GIVEN
+	b := &bytes.Buffer{}
+	opts := &iamPolicyGenerateOpts{
+		iamPolicyGenerateVars: iamPolicyGenerateVars{
+			operation: iampolicy.OperationEnvInit,
+			appName:   "phonetool",
+		},
+		w: b,
+	}
+
+	// WHEN
+	err := opts.Execute()
+
+	// THEN
+	require.NoError(t, err)
+	var doc iampolicy.Document
+	require.NoError(t, json.Unmarshal(b.Bytes(), &doc))
+	require.Equal(t, "2012-10-17", doc.Version)
+	require.NotEmpty(t, doc.Statement)
+}
@@ -0,0 +1,27 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/spf13/cobra"
+)
+
+// BuildImageCmd is the top level command for container images.
+func BuildImageCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "image",
+		Short: `Commands for container images.
+Keep the images referenced by your manifests pinned and up to date.`,
+	}
+
+	cmd.AddCommand(buildImageBumpCmd())
+
+	cmd.SetUsageTemplate(template.Usage)
+	cmd.Annotations = map[string]string{
+		"group": group.Develop,
+	}
+	return cmd
+}
@@ -0,0 +1,326 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	defaultImageTag          = "latest"
+	imageBumpCommitMessage   = "Bump image digests in Copilot manifests"
+	imageManifestKey         = "image"
+	imageManifestLocationKey = "location"
+)
+
+type imageBumpVars struct {
+	name      string
+	gitBranch string
+}
+
+type imageBumpOpts struct {
+	imageBumpVars
+
+	ws       wsWorkloadManifestOverwriter
+	resolver imageDigestResolver
+	runner   execRunner
+
+	digests map[string]string // Cache of the digests of the references resolved so far.
+}
+
+func newImageBumpOpts(vars imageBumpVars) (*imageBumpOpts, error) {
+	ws, err := workspace.Use(afero.NewOsFs())
+	if err != nil {
+		return nil, err
+	}
+	runner := exec.NewCmd()
+	return &imageBumpOpts{
+		imageBumpVars: vars,
+		ws:            ws,
+		resolver:      dockerengine.New(runner),
+		runner:        runner,
+		digests:       make(map[string]string),
+	}, nil
+}
+
+// Validate returns an error if the workload to bump doesn't exist in the workspace.
+func (o *imageBumpOpts) Validate() error {
+	if o.name == "" {
+		return nil
+	}
+	names, err := o.ws.ListWorkloads()
+	if err != nil {
+		return fmt.Errorf("list workloads in the workspace: %w", err)
+	}
+	for _, name := range names {
+		if name == o.name {
+			return nil
+		}
+	}
+	return fmt.Errorf("workload %s does not exist in the workspace", o.name)
+}
+
+// Ask is a no-op for this command.
+func (o *imageBumpOpts) Ask() error {
+	return nil
+}
+
+// Execute pins the images of the workloads' manifests to the digests that their tags currently point to,
+// and optionally commits the updated manifests to a new git branch.
+func (o *imageBumpOpts) Execute() error {
+	names := []string{o.name}
+	if o.name == "" {
+		var err error
+		if names, err = o.ws.ListWorkloads(); err != nil {
+			return fmt.Errorf("list workloads in the workspace: %w", err)
+		}
+	}
+	var updated []string
+	for _, name := range names {
+		path, err := o.bump(name)
+		if err != nil {
+			return err
+		}
+		if path != "" {
+			updated = append(updated, path)
+		}
+	}
+	if len(updated) == 0 {
+		log.Infoln("All images are pinned to the latest digests of their tags.")
+		return nil
+	}
+	if o.gitBranch == "" {
+		return nil
+	}
+	if err := o.commit(updated); err != nil {
+		return err
+	}
+	log.Successf("Committed the updated manifests to the git branch %s.\n", color.HighlightUserInput(o.gitBranch))
+	log.Infoln("Push the branch and open a pull request to deploy the new images.")
+	return nil
+}
+
+// bump pins the images of the workload's manifest and returns the path of the manifest if it was updated.
+func (o *imageBumpOpts) bump(name string) (string, error) {
+	raw, err := o.ws.ReadWorkloadManifest(name)
+	if err != nil {
+		return "", fmt.Errorf("read manifest of %s: %w", name, err)
+	}
+	var doc yaml.Node
+	if err := yaml.Unmarshal(raw, &doc); err != nil {
+		return "", fmt.Errorf("unmarshal manifest of %s: %w", name, err)
+	}
+	edits := make(map[*yaml.Node]string)
+	for _, node := range imageRefNodes(&doc) {
+		pinned, ok := o.pin(name, node.Value)
+		if ok {
+			edits[node] = pinned
+		}
+	}
+	if len(edits) == 0 {
+		return "", nil
+	}
+	out, err := replaceScalars(raw, edits)
+	if err != nil {
+		return "", fmt.Errorf("update images in manifest of %s: %w", name, err)
+	}
+	path, err := o.ws.OverwriteWorkloadManifest(out, name)
+	if err != nil {
+		return "", fmt.Errorf("write manifest of %s: %w", name, err)
+	}
+	return path, nil
+}
+
+// pin returns the reference of the image pinned to the digest its tag points to,
+// and false if the reference can't be pinned or is already up to date.
+func (o *imageBumpOpts) pin(wkld, value string) (string, bool) {
+	if strings.Contains(value, "$") {
+		log.Warningf("Skip image %s of %s: images that reference environment variables can't be pinned.\n", value, wkld)
+		return "", false
+	}
+	ref := parseImageRef(value)
+	if ref.tag == "" && ref.digest != "" {
+		log.Warningf("Skip image %s of %s: there is no tag to resolve to a newer digest.\n", value, wkld)
+		return "", false
+	}
+	if ref.tag == "" {
+		ref.tag = defaultImageTag
+	}
+	tagged := fmt.Sprintf("%s:%s", ref.name, ref.tag)
+	digest, ok := o.digests[tagged]
+	if !ok {
+		var err error
+		if digest, err = o.resolver.ImageDigest(context.Background(), tagged); err != nil {
+			log.Warningf("Skip image %s of %s: %v\n", value, wkld, err)
+			return "", false
+		}
+		o.digests[tagged] = digest
+	}
+	if digest == ref.digest {
+		return "", false
+	}
+	pinned := fmt.Sprintf("%s@%s", tagged, digest)
+	if ref.digest == "" {
+		log.Successf("Pinned image %s of %s to %s.\n", color.HighlightUserInput(tagged), wkld, color.HighlightResource(digest))
+	} else {
+		log.Successf("Bumped image %s of %s from %s to %s.\n", color.HighlightUserInput(tagged), wkld, ref.digest, color.HighlightResource(digest))
+	}
+	return pinned, true
+}
+
+func (o *imageBumpOpts) commit(paths []string) error {
+	if err := o.runner.Run("git", []string{"checkout", "-b", o.gitBranch}); err != nil {
+		return fmt.Errorf("create git branch %s: %w", o.gitBranch, err)
+	}
+	if err := o.runner.Run("git", append([]string{"add"}, paths...)); err != nil {
+		return fmt.Errorf("stage updated manifests: %w", err)
+	}
+	if err := o.runner.Run("git", []string{"commit", "-m", imageBumpCommitMessage}); err != nil {
+		return fmt.Errorf("commit updated manifests: %w", err)
+	}
+	return nil
+}
+
+// imageRef is a reference to a container image of the form name[:tag][@digest].
+type imageRef struct {
+	name   string
+	tag    string
+	digest string
+}
+
+func parseImageRef(value string) imageRef {
+	var ref imageRef
+	if i := strings.Index(value, "@"); i != -1 {
+		ref.digest = value[i+1:]
+		value = value[:i]
+	}
+	// The registry's host can have a port, so a tag only follows a colon after the last slash.
+	if i := strings.LastIndex(value, ":"); i != -1 && i > strings.LastIndex(value, "/") {
+		ref.tag = value[i+1:]
+		value = value[:i]
+	}
+	ref.name = value
+	return ref
+}
+
+// imageRefNodes returns the scalar nodes that reference an image in a manifest:
+// the value of "image.location", or of "image" when it's a string like for sidecars.
+func imageRefNodes(node *yaml.Node) []*yaml.Node {
+	var nodes []*yaml.Node
+	switch node.Kind {
+	case yaml.DocumentNode, yaml.SequenceNode:
+		for _, child := range node.Content {
+			nodes = append(nodes, imageRefNodes(child)...)
+		}
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, val := node.Content[i], node.Content[i+1]
+			if key.Value != imageManifestKey {
+				nodes = append(nodes, imageRefNodes(val)...)
+				continue
+			}
+			if isImageRefScalar(val) {
+				nodes = append(nodes, val)
+				continue
+			}
+			if val.Kind != yaml.MappingNode {
+				continue
+			}
+			for j := 0; j+1 < len(val.Content); j += 2 {
+				if val.Content[j].Value == imageManifestLocationKey && isImageRefScalar(val.Content[j+1]) {
+					nodes = append(nodes, val.Content[j+1])
+				}
+			}
+		}
+	}
+	return nodes
+}
+
+func isImageRefScalar(node *yaml.Node) bool {
+	return node.Kind == yaml.ScalarNode && node.Tag == "!!str" && node.Value != ""
+}
+
+// replaceScalars replaces the values of the scalar nodes in the raw YAML document in place,
+// so that comments and formatting of the rest of the document are preserved.
+func replaceScalars(raw []byte, values map[*yaml.Node]string) ([]byte, error) {
+	nodes := make([]*yaml.Node, 0, len(values))
+	for node := range values {
+		nodes = append(nodes, node)
+	}
+	// Replace from the end of the document so that the positions of the remaining nodes don't shift.
+	sort.Slice(nodes, func(i, j int) bool {
+		if nodes[i].Line != nodes[j].Line {
+			return nodes[i].Line > nodes[j].Line
+		}
+		return nodes[i].Column > nodes[j].Column
+	})
+	lines := bytes.Split(raw, []byte("\n"))
+	for _, node := range nodes {
+		if node.Line < 1 || node.Line > len(lines) {
+			return nil, fmt.Errorf("value %s is out of the document", node.Value)
+		}
+		line := lines[node.Line-1]
+		start := node.Column - 1
+		old := quoteScalar(node.Value, node.Style)
+		if start < 0 || !bytes.HasPrefix(line[start:], []byte(old)) {
+			return nil, fmt.Errorf("locate value %s on line %d", node.Value, node.Line)
+		}
+		updated := append([]byte{}, line[:start]...)
+		updated = append(updated, quoteScalar(values[node], node.Style)...)
+		updated = append(updated, line[start+len(old):]...)
+		lines[node.Line-1] = updated
+	}
+	return bytes.Join(lines, []byte("\n")), nil
+}
+
+func quoteScalar(value string, style yaml.Style) string {
+	switch {
+	case style&yaml.DoubleQuotedStyle != 0:
+		return `"` + value + `"`
+	case style&yaml.SingleQuotedStyle != 0:
+		return `'` + value + `'`
+	}
+	return value
+}
+
+// buildImageBumpCmd builds the command to pin the images of manifests to digests.
+func buildImageBumpCmd() *cobra.Command {
+	vars := imageBumpVars{}
+	cmd := &cobra.Command{
+		Use:   "bump",
+		Short: "Pins the images of your manifests to the digests of their tags.",
+		Long: `Pins the images of your manifests to the digests of their tags.
+Resolves the tag of each image.location, and sidecar image, to the digest it currently points to
+and rewrites the reference as name:tag@digest. Images that are already pinned are updated
+if their tag moved to a new digest since.`,
+		Example: `
+  Pin the images of all the workloads in the workspace.
+  /code $ copilot image bump
+  Pin the images of the "frontend" service and commit the changes to a new branch.
+  /code $ copilot image bump -n frontend --git-branch bump-images`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newImageBumpOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", imageBumpNameFlagDescription)
+	cmd.Flags().StringVar(&vars.gitBranch, gitBranchFlag, "", imageBumpGitBranchFlagDescription)
+	return cmd
+}
@@ -0,0 +1,266 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type imageBumpMocks struct {
+	ws       *mocks.MockwsWorkloadManifestOverwriter
+	resolver *mocks.MockimageDigestResolver
+	runner   *mocks.MockexecRunner
+}
+
+func TestImageBumpOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inName     string
+		setupMocks func(m imageBumpMocks)
+
+		wantedErr string
+	}{
+		"skip validation if no name is provided": {
+			setupMocks: func(m imageBumpMocks) {},
+		},
+		"error if the workloads can't be listed": {
+			inName: "frontend",
+			setupMocks: func(m imageBumpMocks) {
+				m.ws.EXPECT().ListWorkloads().Return(nil, errors.New("some error"))
+			},
+			wantedErr: "list workloads in the workspace: some error",
+		},
+		"error if the workload doesn't exist": {
+			inName: "frontend",
+			setupMocks: func(m imageBumpMocks) {
+				m.ws.EXPECT().ListWorkloads().Return([]string{"api"}, nil)
+			},
+			wantedErr: "workload frontend does not exist in the workspace",
+		},
+		"success": {
+			inName: "frontend",
+			setupMocks: func(m imageBumpMocks) {
+				m.ws.EXPECT().ListWorkloads().Return([]string{"api", "frontend"}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			m := imageBumpMocks{
+				ws: mocks.NewMockwsWorkloadManifestOverwriter(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &imageBumpOpts{
+				imageBumpVars: imageBumpVars{name: tc.inName},
+				ws:            m.ws,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestImageBumpOpts_Execute(t *testing.T) {
+	const (
+		oldDigest = "sha256:1111111111111111111111111111111111111111111111111111111111111111"
+		newDigest = "sha256:2222222222222222222222222222222222222222222222222222222222222222"
+	)
+	mockManifest := `name: frontend
+type: Load Balanced Web Service
+
+image:
+  location: public.ecr.aws/nginx/nginx:1.25 # Pinned by copilot image bump.
+  port: 80
+
+sidecars:
+  datadog:
+    image: "public.ecr.aws/datadog/agent"
+  envoy:
+    image: ${ENVOY_IMAGE}
+
+environments:
+  prod:
+    image:
+      location: 'public.ecr.aws/nginx/nginx:1.25@` + oldDigest + `'
+`
+	wantedManifest := `name: frontend
+type: Load Balanced Web Service
+
+image:
+  location: public.ecr.aws/nginx/nginx:1.25@` + newDigest + ` # Pinned by copilot image bump.
+  port: 80
+
+sidecars:
+  datadog:
+    image: "public.ecr.aws/datadog/agent:latest@` + newDigest + `"
+  envoy:
+    image: ${ENVOY_IMAGE}
+
+environments:
+  prod:
+    image:
+      location: 'public.ecr.aws/nginx/nginx:1.25@` + newDigest + `'
+`
+	testCases := map[string]struct {
+		inName      string
+		inGitBranch string
+		setupMocks  func(m imageBumpMocks)
+
+		wantedErr string
+	}{
+		"error if the workloads can't be listed": {
+			setupMocks: func(m imageBumpMocks) {
+				m.ws.EXPECT().ListWorkloads().Return(nil, errors.New("some error"))
+			},
+			wantedErr: "list workloads in the workspace: some error",
+		},
+		"error if a manifest can't be read": {
+			inName: "frontend",
+			setupMocks: func(m imageBumpMocks) {
+				m.ws.EXPECT().ReadWorkloadManifest("frontend").Return(nil, errors.New("some error"))
+			},
+			wantedErr: "read manifest of frontend: some error",
+		},
+		"do not write manifests whose images are up to date or can't be resolved": {
+			setupMocks: func(m imageBumpMocks) {
+				m.ws.EXPECT().ListWorkloads().Return([]string{"api", "worker"}, nil)
+				m.ws.EXPECT().ReadWorkloadManifest("api").Return(workspace.WorkloadManifest(`name: api
+image:
+  location: public.ecr.aws/nginx/nginx:1.25@`+oldDigest), nil)
+				m.ws.EXPECT().ReadWorkloadManifest("worker").Return(workspace.WorkloadManifest(`name: worker
+image:
+  location: private.example.com:5000/worker:v1`), nil)
+				m.resolver.EXPECT().ImageDigest(gomock.Any(), "public.ecr.aws/nginx/nginx:1.25").Return(oldDigest, nil)
+				m.resolver.EXPECT().ImageDigest(gomock.Any(), "private.example.com:5000/worker:v1").Return("", errors.New("unauthorized"))
+				m.ws.EXPECT().OverwriteWorkloadManifest(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		"error if the manifest can't be written": {
+			inName: "frontend",
+			setupMocks: func(m imageBumpMocks) {
+				m.ws.EXPECT().ReadWorkloadManifest("frontend").Return(workspace.WorkloadManifest(mockManifest), nil)
+				m.resolver.EXPECT().ImageDigest(gomock.Any(), gomock.Any()).Return(newDigest, nil).AnyTimes()
+				m.ws.EXPECT().OverwriteWorkloadManifest(gomock.Any(), "frontend").Return("", errors.New("some error"))
+			},
+			wantedErr: "write manifest of frontend: some error",
+		},
+		"pin and bump the images of the manifest while preserving its formatting": {
+			inName: "frontend",
+			setupMocks: func(m imageBumpMocks) {
+				m.ws.EXPECT().ReadWorkloadManifest("frontend").Return(workspace.WorkloadManifest(mockManifest), nil)
+				m.resolver.EXPECT().ImageDigest(gomock.Any(), "public.ecr.aws/nginx/nginx:1.25").Return(newDigest, nil).Times(1)
+				m.resolver.EXPECT().ImageDigest(gomock.Any(), "public.ecr.aws/datadog/agent:latest").Return(newDigest, nil)
+				m.ws.EXPECT().OverwriteWorkloadManifest([]byte(wantedManifest), "frontend").Return("/copilot/frontend/manifest.yml", nil)
+				m.runner.EXPECT().Run(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		"error if the git branch can't be created": {
+			inName:      "frontend",
+			inGitBranch: "bump-images",
+			setupMocks: func(m imageBumpMocks) {
+				m.ws.EXPECT().ReadWorkloadManifest("frontend").Return(workspace.WorkloadManifest(mockManifest), nil)
+				m.resolver.EXPECT().ImageDigest(gomock.Any(), gomock.Any()).Return(newDigest, nil).AnyTimes()
+				m.ws.EXPECT().OverwriteWorkloadManifest(gomock.Any(), "frontend").Return("/copilot/frontend/manifest.yml", nil)
+				m.runner.EXPECT().Run("git", []string{"checkout", "-b", "bump-images"}).Return(errors.New("some error"))
+			},
+			wantedErr: "create git branch bump-images: some error",
+		},
+		"commit the updated manifests to the git branch": {
+			inName:      "frontend",
+			inGitBranch: "bump-images",
+			setupMocks: func(m imageBumpMocks) {
+				m.ws.EXPECT().ReadWorkloadManifest("frontend").Return(workspace.WorkloadManifest(mockManifest), nil)
+				m.resolver.EXPECT().ImageDigest(gomock.Any(), gomock.Any()).Return(newDigest, nil).AnyTimes()
+				m.ws.EXPECT().OverwriteWorkloadManifest(gomock.Any(), "frontend").Return("/copilot/frontend/manifest.yml", nil)
+				gomock.InOrder(
+					m.runner.EXPECT().Run("git", []string{"checkout", "-b", "bump-images"}).Return(nil),
+					m.runner.EXPECT().Run("git", []string{"add", "/copilot/frontend/manifest.yml"}).Return(nil),
+					m.runner.EXPECT().Run("git", []string{"commit", "-m", imageBumpCommitMessage}).Return(nil),
+				)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			m := imageBumpMocks{
+				ws:       mocks.NewMockwsWorkloadManifestOverwriter(ctrl),
+				resolver: mocks.NewMockimageDigestResolver(ctrl),
+				runner:   mocks.NewMockexecRunner(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &imageBumpOpts{
+				imageBumpVars: imageBumpVars{
+					name:      tc.inName,
+					gitBranch: tc.inGitBranch,
+				},
+				ws:       m.ws,
+				resolver: m.resolver,
+				runner:   m.runner,
+				digests:  make(map[string]string),
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestParseImageRef(t *testing.T) {
+	testCases := map[string]struct {
+		in     string
+		wanted imageRef
+	}{
+		"name only": {
+			in:     "nginx",
+			wanted: imageRef{name: "nginx"},
+		},
+		"name and tag": {
+			in:     "public.ecr.aws/nginx/nginx:1.25",
+			wanted: imageRef{name: "public.ecr.aws/nginx/nginx", tag: "1.25"},
+		},
+		"registry with a port and no tag": {
+			in:     "localhost:5000/nginx",
+			wanted: imageRef{name: "localhost:5000/nginx"},
+		},
+		"name, tag and digest": {
+			in:     "localhost:5000/nginx:1.25@sha256:abc",
+			wanted: imageRef{name: "localhost:5000/nginx", tag: "1.25", digest: "sha256:abc"},
+		},
+		"name and digest": {
+			in:     "nginx@sha256:abc",
+			wanted: imageRef{name: "nginx", digest: "sha256:abc"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, parseImageRef(tc.in))
+		})
+	}
+}
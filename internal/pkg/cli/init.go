@@ -668,5 +668,6 @@ func BuildInitCmd() *cobra.Command {
 	cmd.Annotations = map[string]string{
 		"group": group.GettingStarted,
 	}
+	markAudited(cmd)
 	return cmd
 }
@@ -11,11 +11,18 @@ import (
 	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
 
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/audit"
+	"github.com/aws/copilot-cli/internal/pkg/aws/aas"
+	"github.com/aws/copilot-cli/internal/pkg/aws/budgets"
 	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/efs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/iam"
+	"github.com/aws/copilot-cli/internal/pkg/aws/rds"
 	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sqs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
 	clideploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/config"
@@ -31,8 +38,10 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/initialize"
 	"github.com/aws/copilot-cli/internal/pkg/logging"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/plugin"
 	"github.com/aws/copilot-cli/internal/pkg/task"
 	"github.com/aws/copilot-cli/internal/pkg/template"
+	templatediff "github.com/aws/copilot-cli/internal/pkg/template/diff"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
@@ -56,6 +65,13 @@ type actionCommand interface {
 	RecommendActions() error
 }
 
+// versionPinnedCommand is the interface that every command that updates the Copilot-managed resources of an application implements.
+type versionPinnedCommand interface {
+	cmd
+	// ValidateVersionPin returns an error if the version of Copilot used by the command isn't allowed by the application.
+	ValidateVersionPin() error
+}
+
 // SSM store interfaces.
 
 type serviceStore interface {
@@ -145,6 +161,19 @@ type store interface {
 	wlStore
 }
 
+// opsConfigStore is the read-only subset of the config store needed by operational commands.
+type opsConfigStore interface {
+	applicationGetter
+	applicationLister
+	environmentGetter
+	environmentLister
+	GetService(appName, svcName string) (*config.Workload, error)
+	ListServices(appName string) ([]*config.Workload, error)
+	GetJob(appName, jobName string) (*config.Workload, error)
+	ListJobs(appName string) ([]*config.Workload, error)
+	wlStore
+}
+
 type deployedEnvironmentLister interface {
 	ListEnvironmentsDeployedTo(appName, svcName string) ([]string, error)
 	ListDeployedServices(appName, envName string) ([]string, error)
@@ -328,6 +357,21 @@ type wsWlDirReader interface {
 	Summary() (*workspace.Summary, error)
 }
 
+type wsReleaseReader interface {
+	wsWlDirReader
+	ReadReleaseManifest() (*manifest.Release, error)
+}
+
+type deploymentHistory interface {
+	Revisions(stackName string) ([]deploy.DeploymentRevision, error)
+	Save(stackName string, revisions []deploy.DeploymentRevision) error
+}
+
+type workloadStackRestorer interface {
+	DeployedStack(stackName string) (*cloudformation.DeployedStack, error)
+	DeployService(conf cloudformation.StackConfiguration, bucketName string, detach bool, opts ...awscloudformation.StackOption) error
+}
+
 type wsEnvironmentReader interface {
 	wsEnvironmentsLister
 	HasEnvironments() (bool, error)
@@ -375,9 +419,14 @@ type stackDescriber interface {
 	Resources() ([]*stackdescr.Resource, error)
 }
 
+type deployedStackDescriber interface {
+	Describe(name string) (*awscloudformation.StackDescription, error)
+	TemplateBody(name string) (string, error)
+}
+
 // Interfaces for deploying resources through CloudFormation. Facilitates mocking.
 type environmentDeployer interface {
-	CreateAndRenderEnvironment(conf cloudformation.StackConfiguration, bucketARN string) error
+	CreateAndRenderEnvironment(conf cloudformation.StackConfiguration, bucketARN string, opts ...awscloudformation.StackOption) error
 	DeleteEnvironment(appName, envName, cfnExecRoleARN string) error
 	GetEnvironment(appName, envName string) (*config.Environment, error)
 	Template(stackName string) (string, error)
@@ -401,8 +450,8 @@ type imageRemover interface {
 }
 
 type pipelineDeployer interface {
-	CreatePipeline(bucketName string, stackConfig cloudformation.StackConfiguration) error
-	UpdatePipeline(bucketName string, stackConfig cloudformation.StackConfiguration) error
+	CreatePipeline(bucketName string, stackConfig cloudformation.StackConfiguration, opts ...awscloudformation.StackOption) error
+	UpdatePipeline(bucketName string, stackConfig cloudformation.StackConfiguration, opts ...awscloudformation.StackOption) error
 	PipelineExists(stackConfig cloudformation.StackConfiguration) (bool, error)
 	DeletePipeline(pipeline deploy.Pipeline) error
 	AddPipelineResourcesToApp(app *config.Application, region string) error
@@ -411,6 +460,10 @@ type pipelineDeployer interface {
 	// TODO: Add StreamPipelineCreation method
 }
 
+type artifactKeyGranter interface {
+	GrantDecrypt(keyARN, principalARN, name string) error
+}
+
 type appDeployer interface {
 	DeployApp(in *deploy.CreateAppInput) error
 	AddServiceToApp(app *config.Application, svcName string, opts ...cloudformation.AddWorkloadToAppOpt) error
@@ -445,6 +498,14 @@ type taskRunner interface {
 	CheckNonZeroExitCode([]*task.Task) error
 }
 
+type taskResultWaiter interface {
+	Wait(tasks []*task.Task) (*task.Results, error)
+}
+
+type remoteTaskRunner interface {
+	Run() error
+}
+
 type defaultClusterGetter interface {
 	HasDefaultCluster() (bool, error)
 }
@@ -476,6 +537,48 @@ type envDescriber interface {
 	ValidateCFServiceDomainAliases() error
 }
 
+type envCertificatesDescriber interface {
+	Describe() (*describe.EnvCertificates, error)
+}
+
+type deadLetterQueuesDescriber interface {
+	DeadLetterQueues() ([]*describe.DeadLetterQueue, error)
+}
+
+type messageMover interface {
+	MoveMessages(in sqs.MoveMessagesInput) (string, error)
+}
+
+type deployedManifestGetter interface {
+	Manifest() ([]byte, error)
+}
+
+type taskDefinitionCleaner interface {
+	StaleTaskDefinitions(app, env, svc string, keep int) ([]string, error)
+	DeregisterTaskDefinitions(arns []string) error
+	DeleteTaskDefinitions(arns []string) error
+}
+
+type ecsTasksDescriber interface {
+	Tasks() (*describe.ECSTasks, error)
+	Task(id string) (*describe.ECSTask, error)
+}
+
+type serviceCapacityDescriber interface {
+	Describe() (*describe.ServiceCapacity, error)
+}
+
+type serviceCapacityOverrider interface {
+	ECSServiceCapacity(cluster, service string) (*aas.Capacity, error)
+	ECSServiceScheduledAction(cluster, service, name string) (*aas.ScheduledAction, error)
+	SetECSServiceCapacity(cluster, service string, capacity aas.Capacity) error
+	ScheduleECSServiceCapacity(cluster, service string, action aas.ScheduledAction) error
+}
+
+type capacityRevertGetter interface {
+	ECSServiceScheduledAction(cluster, service, name string) (*aas.ScheduledAction, error)
+}
+
 type versionCompatibilityChecker interface {
 	versionGetter
 	AvailableFeatures() ([]string, error)
@@ -485,6 +588,14 @@ type versionGetter interface {
 	Version() (string, error)
 }
 
+type envOutputsGetter interface {
+	Outputs() (map[string]string, error)
+}
+
+type budgetDescriber interface {
+	Budget(accountID, name string) (*budgets.Budget, error)
+}
+
 type appUpgrader interface {
 	UpgradeApplication(in *deploy.CreateAppInput) error
 }
@@ -628,6 +739,19 @@ type ecsCommandExecutor interface {
 	ExecuteCommand(in awsecs.ExecuteCommandInput) error
 }
 
+type dbEndpointDescriber interface {
+	Endpoint(identifier string) (*rds.Endpoint, error)
+}
+
+type portForwarder interface {
+	StartPortForwardingSession(in ssm.PortForwardingSessionInput) error
+}
+
+type jumpHostTaskManager interface {
+	DescribeTasks(cluster string, taskARNs []string) ([]*awsecs.Task, error)
+	StopTasks(tasks []string, opts ...awsecs.StopTasksOpts) error
+}
+
 type ssmPluginManager interface {
 	ValidateBinary() error
 	InstallLatestBinary() error
@@ -657,10 +781,32 @@ type stackExistChecker interface {
 	Exists(string) (bool, error)
 }
 
+type permissionSimulator interface {
+	SimulatePolicy(callerARN string, sim iam.PolicySimulation) ([]iam.DeniedAction, error)
+}
+
+type quotaGetter interface {
+	Value(serviceCode, quotaCode string) (float64, error)
+}
+
+type envNetworkCounter interface {
+	ListVPCs() ([]ec2.VPC, error)
+	ElasticIPCount() (int, error)
+}
+
+type albCounter interface {
+	ApplicationLoadBalancerCount() (int, error)
+}
+
 type runningTaskSelector interface {
 	RunningTask(prompt, help string, opts ...selector.TaskOpts) (*awsecs.Task, error)
 }
 
+type containerSelector interface {
+	Task(prompt, help string, tasks []*awsecs.Task) (*awsecs.Task, error)
+	Container(prompt, help string, task *awsecs.Task, mainContainer string) (string, error)
+}
+
 type dockerEngine interface {
 	CheckDockerEngineRunning() error
 	GetPlatform() (string, string, error)
@@ -700,7 +846,7 @@ type workloadDeployer interface {
 }
 
 type templateDiffer interface {
-	DeployDiff(inTmpl string) (string, error)
+	DeployDiff(inTmpl string, opts ...templatediff.WriteOption) (string, error)
 }
 
 type dockerEngineRunner interface {
@@ -730,6 +876,7 @@ type runner interface {
 type envDeployer interface {
 	DeployEnvironment(in *clideploy.DeployEnvironmentInput) error
 	Validate(*manifest.Environment) error
+	NetworkMigrationPlan(*manifest.Environment) (*clideploy.NetworkMigrationPlan, error)
 	UploadArtifacts() (*clideploy.UploadEnvArtifactsOutput, error)
 	GenerateCloudFormationTemplate(in *clideploy.DeployEnvironmentInput) (
 		*clideploy.GenerateCloudFormationTemplateOutput, error)
@@ -759,3 +906,42 @@ type secretGetter interface {
 type dockerWorkload interface {
 	Dockerfile() string
 }
+
+type auditLogWriter interface {
+	Write(entry audit.Entry) error
+}
+
+type auditLogReader interface {
+	Entries(limit int) ([]audit.Entry, error)
+}
+
+type auditLog interface {
+	auditLogWriter
+	auditLogReader
+}
+
+type pluginFinder interface {
+	Find(name string) (*plugin.Plugin, error)
+}
+
+type pluginLister interface {
+	List() ([]plugin.Plugin, error)
+}
+
+type rulePrioritiesDescriber interface {
+	Describe() (*describe.RulePriorities, error)
+}
+
+type wsWorkloadManifestOverwriter interface {
+	manifestReader
+	wlLister
+	OverwriteWorkloadManifest(data []byte, name string) (string, error)
+}
+
+type imageDigestResolver interface {
+	ImageDigest(ctx context.Context, ref string) (string, error)
+}
+
+type efsFileSystemDescriber interface {
+	FileSystemNetwork(fsID string) (*efs.FileSystemNetwork, error)
+}
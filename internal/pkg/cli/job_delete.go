@@ -30,6 +30,7 @@ import (
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/version"
 )
 
 const (
@@ -154,6 +155,11 @@ func (o *deleteJobOpts) Ask() error {
 	return nil
 }
 
+// ValidateVersionPin returns an error if the application doesn't allow this version of Copilot to delete its jobs.
+func (o *deleteJobOpts) ValidateVersionPin() error {
+	return validateVersionPin(o.store, o.appName, version.Version, "")
+}
+
 // Execute deletes the job's CloudFormation stack.
 // If the job is being removed from the application, Execute will
 // also delete the ECR repository and the SSM parameter.
@@ -381,5 +387,6 @@ func buildJobDeleteCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", jobFlagDescription)
 	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
 	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	markAudited(cmd)
 	return cmd
 }
@@ -19,6 +19,7 @@ import (
 	deploycfn "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/exec"
+	templatediff "github.com/aws/copilot-cli/internal/pkg/template/diff"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 
 	"github.com/spf13/cobra"
@@ -51,7 +52,10 @@ type deployJobOpts struct {
 	sel                  wsSelector
 	prompt               prompter
 	gitShortCommit       string
+	gitBranch            string
 	diffWriter           io.Writer
+	builtImages          *deploy.BuiltImages // Images built by the other workloads of the same deployment.
+	inParallel           bool                // Whether other workloads of the same deployment deploy at the same time.
 
 	// cached variables
 	targetApp         *config.Application
@@ -113,11 +117,14 @@ func newJobDeployer(o *deployJobOpts) (workloadDeployer, error) {
 		Image: deploy.ContainerImageIdentifier{
 			CustomTag:         o.imageTag,
 			GitShortCommitTag: o.gitShortCommit,
+			GitBranch:         o.gitBranch,
 		},
 		Mft:              content,
 		RawMft:           o.rawMft,
 		EnvVersionGetter: o.envFeaturesDescriber,
 		Overrider:        ovrdr,
+		BuiltImages:      o.builtImages,
+		InParallel:       o.inParallel,
 	}
 	var deployer workloadDeployer
 	switch t := content.(type) {
@@ -161,6 +168,11 @@ func (o *deployJobOpts) Ask() error {
 	return nil
 }
 
+// ValidateVersionPin returns an error if the application doesn't allow this version of Copilot to deploy its jobs.
+func (o *deployJobOpts) ValidateVersionPin() error {
+	return validateVersionPin(o.store, o.appName, o.templateVersion, "")
+}
+
 // Execute builds and pushes the container image for the job.
 func (o *deployJobOpts) Execute() error {
 	if !o.clientConfigured {
@@ -222,7 +234,7 @@ func (o *deployJobOpts) Execute() error {
 		if err != nil {
 			return fmt.Errorf("generate the template for job %q against environment %q: %w", o.name, o.envName, err)
 		}
-		if err := diff(deployer, output.Template, o.diffWriter); err != nil {
+		if err := diff(deployer, output.Template, o.diffWriter, templatediff.FormatHuman); err != nil {
 			var errHasDiff *errHasDiff
 			if !errors.As(err, &errHasDiff) {
 				return err
@@ -284,6 +296,7 @@ After fixing the deployment, you can:
 
 func (o *deployJobOpts) configureClients() error {
 	o.gitShortCommit = imageTagFromGit(o.cmd) // Best effort assign git tag.
+	o.gitBranch = gitBranch(o.cmd)
 	env, err := o.store.GetEnvironment(o.appName, o.envName)
 	if err != nil {
 		return err
@@ -416,5 +429,6 @@ func buildJobDeployCmd() *cobra.Command {
 	cmd.Flags().BoolVar(&vars.showDiff, diffFlag, false, diffFlagDescription)
 	cmd.Flags().BoolVar(&vars.allowWkldDowngrade, allowDowngradeFlag, false, allowDowngradeFlagDescription)
 	cmd.Flags().BoolVar(&vars.detach, detachFlag, false, detachFlagDescription)
+	markAudited(cmd)
 	return cmd
 }
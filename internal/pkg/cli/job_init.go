@@ -267,6 +267,11 @@ func envsWithPrivateSubnetsOnly(store store, initEnvDescriber func(string, strin
 	return privateOnlyEnvs, err
 }
 
+// ValidateVersionPin returns an error if the application doesn't allow this version of Copilot to add jobs to it.
+func (o *initJobOpts) ValidateVersionPin() error {
+	return validateVersionPin(o.store, o.appName, o.templateVersion, "")
+}
+
 // Execute writes the job's manifest file, creates an ECR repo, and stores the name in SSM.
 func (o *initJobOpts) Execute() error {
 	if !o.allowAppDowngrade {
@@ -496,5 +501,6 @@ func buildJobInitCmd() *cobra.Command {
 		"group": group.Develop,
 	}
 
+	markAudited(cmd)
 	return cmd
 }
@@ -31,6 +31,7 @@ type listJobOpts struct {
 	// Dependencies
 	sel  appSelector
 	list workloadListWriter
+	out  *outputWriter // Converts the JSON output of the list writer to the output format. Nil if unused.
 }
 
 func newListJobOpts(vars listWkldVars) (*listJobOpts, error) {
@@ -47,26 +48,28 @@ func newListJobOpts(vars listWkldVars) (*listJobOpts, error) {
 	if err != nil {
 		return nil, err
 	}
+	out := newOutputWriter(os.Stdout, vars.format())
 	jobLister := &list.JobListWriter{
 		Ws:    ws,
 		Store: store,
-		Out:   os.Stdout,
+		Out:   out,
 
 		ShowLocalJobs: vars.shouldShowLocalWorkloads,
-		OutputJSON:    vars.shouldOutputJSON,
+		OutputJSON:    vars.isStructured(),
 	}
 
 	return &listJobOpts{
 		listWkldVars: vars,
 
 		list: jobLister,
+		out:  out,
 		sel:  selector.NewAppEnvSelector(prompt.New(), store),
 	}, nil
 }
 
-// Validate is a no-op for this command.
+// Validate returns an error if the output flags are invalid.
 func (o *listJobOpts) Validate() error {
-	return nil
+	return o.validateOutput()
 }
 
 // Ask asks for fields that are required but not passed in.
@@ -88,6 +91,9 @@ func (o *listJobOpts) Execute() error {
 	if err := o.list.Write(o.appName); err != nil {
 		return err
 	}
+	if o.out != nil {
+		return o.out.Flush()
+	}
 	return nil
 }
 
@@ -104,6 +110,9 @@ func buildJobListCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
 			if err := opts.Ask(); err != nil {
 				return err
 			}
@@ -111,7 +120,7 @@ func buildJobListCmd() *cobra.Command {
 		}),
 	}
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
-	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	addOutputFlags(cmd.Flags(), &vars.outputVars)
 	cmd.Flags().BoolVar(&vars.shouldShowLocalWorkloads, localFlag, false, localJobFlagDescription)
 	return cmd
 }
@@ -26,8 +26,8 @@ func TestListJobOpts_Execute(t *testing.T) {
 		"with successful call to list.Jobs": {
 			opts: listJobOpts{
 				listWkldVars: listWkldVars{
-					shouldOutputJSON: true,
-					appName:          "coolapp",
+					outputVars: outputVars{shouldOutputJSON: true},
+					appName:    "coolapp",
 				},
 				list: mockLister,
 			},
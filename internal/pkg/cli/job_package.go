@@ -36,6 +36,7 @@ type packageJobVars struct {
 	tag                string
 	outputDir          string
 	uploadAssets       bool
+	registryCache      bool
 	showDiff           bool
 	allowWkldDowngrade bool
 }
@@ -86,6 +87,7 @@ func newPackageJobOpts(vars packageJobVars) (*packageJobOpts, error) {
 				tag:                o.tag,
 				outputDir:          o.outputDir,
 				uploadAssets:       o.uploadAssets,
+				registryCache:      o.registryCache,
 				allowWkldDowngrade: o.allowWkldDowngrade,
 				showDiff:           o.showDiff,
 			},
@@ -102,6 +104,7 @@ func newPackageJobOpts(vars packageJobVars) (*packageJobOpts, error) {
 			sessProvider:      sessProvider,
 			newStackGenerator: newWorkloadStackGenerator,
 			gitShortCommit:    imageTagFromGit(o.runner),
+			gitBranch:         gitBranch(o.runner),
 			templateVersion:   version.LatestTemplateVersion(),
 		}
 	}
@@ -110,6 +113,9 @@ func newPackageJobOpts(vars packageJobVars) (*packageJobOpts, error) {
 
 // Validate returns an error if the values provided by the user are invalid.
 func (o *packageJobOpts) Validate() error {
+	if o.registryCache && !o.uploadAssets {
+		return fmt.Errorf("--%s must be used with --%s", registryCacheFlag, uploadAssetsFlag)
+	}
 	if o.appName == "" {
 		return errNoAppInWorkspace
 	}
@@ -209,6 +215,7 @@ func buildJobPackageCmd() *cobra.Command {
 	cmd.Flags().StringVar(&vars.tag, imageTagFlag, "", imageTagFlagDescription)
 	cmd.Flags().StringVar(&vars.outputDir, stackOutputDirFlag, "", stackOutputDirFlagDescription)
 	cmd.Flags().BoolVar(&vars.uploadAssets, uploadAssetsFlag, false, uploadAssetsFlagDescription)
+	cmd.Flags().BoolVar(&vars.registryCache, registryCacheFlag, false, registryCacheFlagDescription)
 	cmd.Flags().BoolVar(&vars.showDiff, diffFlag, false, diffFlagDescription)
 	cmd.Flags().BoolVar(&vars.allowWkldDowngrade, allowDowngradeFlag, false, allowDowngradeFlagDescription)
 
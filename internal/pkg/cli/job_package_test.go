@@ -24,14 +24,24 @@ func TestPackageJobOpts_Validate(t *testing.T) {
 		inEnvName string
 		inJobName string
 
-		inShowDiff     bool
-		inOutputDir    string
-		inUploadAssets bool
+		inShowDiff      bool
+		inOutputDir     string
+		inUploadAssets  bool
+		inRegistryCache bool
 
 		setupMocks func()
 
 		wantedErrorS string
 	}{
+		"error if the registry cache is used without uploading assets": {
+			inAppName:       "phonetool",
+			inRegistryCache: true,
+			setupMocks: func() {
+				mockWorkspace.EXPECT().ListJobs().Times(0)
+				mockStore.EXPECT().GetEnvironment(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedErrorS: "--registry-cache must be used with --upload-assets",
+		},
 		"invalid workspace": {
 			setupMocks: func() {
 				mockWorkspace.EXPECT().ListJobs().Times(0)
@@ -95,9 +105,10 @@ func TestPackageJobOpts_Validate(t *testing.T) {
 					envName: tc.inEnvName,
 					appName: tc.inAppName,
 
-					showDiff:     tc.inShowDiff,
-					outputDir:    tc.inOutputDir,
-					uploadAssets: tc.inUploadAssets,
+					showDiff:      tc.inShowDiff,
+					outputDir:     tc.inOutputDir,
+					uploadAssets:  tc.inUploadAssets,
+					registryCache: tc.inRegistryCache,
 				},
 				ws:    mockWorkspace,
 				store: mockStore,
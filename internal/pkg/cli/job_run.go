@@ -4,22 +4,27 @@
 package cli
 
 import (
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/runner/jobrunner"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/version"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
@@ -29,6 +34,10 @@ type jobRunVars struct {
 	appName string
 	envName string
 	jobName string
+
+	wait    bool
+	follow  bool
+	timeout time.Duration
 }
 
 type jobRunOpts struct {
@@ -80,8 +89,16 @@ func newJobRunOpts(vars jobRunVars) (*jobRunOpts, error) {
 			Env: opts.envName,
 			Job: opts.jobName,
 
+			Wait:    opts.wait,
+			Follow:  opts.follow,
+			Timeout: opts.timeout,
+
 			CFN:          cloudformation.New(sess),
 			StateMachine: stepfunctions.New(sess),
+
+			Logs:      cloudwatchlogs.New(sess),
+			ExitCodes: ecs.New(sess),
+			LogWriter: log.OutputWriter,
 		}), nil
 	}
 	opts.newEnvCompatibilityChecker = func() (versionCompatibilityChecker, error) {
@@ -98,9 +115,14 @@ func newJobRunOpts(vars jobRunVars) (*jobRunOpts, error) {
 	return opts, nil
 }
 
-// Validate is a no-op for this command.
-// it's a no-op because all 3 flags are required, and `Validate` only validate optional flags.
+// Validate returns an error if the optional flags are invalid.
 func (o *jobRunOpts) Validate() error {
+	if o.timeout < 0 {
+		return errors.New("`--timeout` must not be negative")
+	}
+	if o.timeout != 0 && !o.wait && !o.follow {
+		return errors.New("`--timeout` can only be specified with `--wait` or `--follow`")
+	}
 	return nil
 }
 
@@ -118,6 +140,11 @@ func (o *jobRunOpts) Ask() error {
 	return nil
 }
 
+// ValidateVersionPin returns an error if the application doesn't allow this version of Copilot to run its jobs.
+func (o *jobRunOpts) ValidateVersionPin() error {
+	return validateVersionPin(o.configStore, o.appName, version.Version, "")
+}
+
 // Execute runs the "job run" command.
 func (o *jobRunOpts) Execute() error {
 	if err := o.validateEnvCompatible(); err != nil {
@@ -127,9 +154,19 @@ func (o *jobRunOpts) Execute() error {
 	if err != nil {
 		return err
 	}
+	switch {
+	case o.follow:
+		log.Infof("Streaming the logs of job %q until its execution completes.\n", o.jobName)
+	case o.wait:
+		log.Infof("Waiting for the execution of job %q to complete.\n", o.jobName)
+	}
 	if err := runner.Run(); err != nil {
 		return fmt.Errorf("execute job %q: %w", o.jobName, err)
 	}
+	if o.wait || o.follow {
+		log.Successf("Job %q completed successfully\n", o.jobName)
+		return nil
+	}
 	log.Successf("Invoked job %q successfully\n", o.jobName)
 	return nil
 }
@@ -204,7 +241,16 @@ func (o *jobRunOpts) validateEnvCompatible() error {
 	if err != nil {
 		return err
 	}
-	return validateMinEnvVersion(o.ws, envStack, o.appName, o.envName, template.JobRunMinEnvVersion, "job run")
+	minVersion, feature := template.JobRunMinEnvVersion, "job run"
+	switch {
+	case o.follow:
+		// Following the execution requires the environment manager role to read the history of executions.
+		minVersion, feature = template.JobRunFollowMinEnvVersion, "job run --follow"
+	case o.wait:
+		// Waiting for the execution requires the environment manager role to describe executions.
+		minVersion, feature = template.JobRunWaitMinEnvVersion, "job run --wait"
+	}
+	return validateMinEnvVersion(o.ws, envStack, o.appName, o.envName, minVersion, feature)
 }
 
 func buildJobRunCmd() *cobra.Command {
@@ -216,7 +262,11 @@ func buildJobRunCmd() *cobra.Command {
 		Long:  "Invoke a job in an environment.",
 		Example: `
   Run a job named "report-gen" in an application named "report" within a "test" environment
-  /code $ copilot job run -a report -n report-gen -e test`,
+  /code $ copilot job run -a report -n report-gen -e test
+  Run the job and wait up to 30 minutes for it to complete, exiting with a non-zero code if it fails
+  /code $ copilot job run -n report-gen -e test --wait --timeout 30m
+  Run the job, stream its logs, and exit with the exit code of its container
+  /code $ copilot job run -n report-gen -e test --follow`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newJobRunOpts(vars)
 			if err != nil {
@@ -228,5 +278,9 @@ func buildJobRunCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().StringVarP(&vars.jobName, nameFlag, nameFlagShort, "", jobFlagDescription)
 	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().BoolVar(&vars.wait, waitFlag, false, jobRunWaitFlagDescription)
+	cmd.Flags().BoolVar(&vars.follow, followFlag, false, jobRunFollowFlagDescription)
+	cmd.Flags().DurationVar(&vars.timeout, timeoutFlag, 0, jobRunTimeoutFlagDescription)
+	markAudited(cmd)
 	return cmd
 }
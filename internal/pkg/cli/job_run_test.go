@@ -7,9 +7,11 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/runner/jobrunner"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -19,6 +21,54 @@ type jobRunMock struct {
 	sel         *mocks.MockconfigSelector
 }
 
+func TestJobRun_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inWait    bool
+		inFollow  bool
+		inTimeout time.Duration
+
+		wantedError error
+	}{
+		"valid without waiting": {},
+		"valid with wait and timeout": {
+			inWait:    true,
+			inTimeout: 30 * time.Minute,
+		},
+		"valid with follow and timeout": {
+			inFollow:  true,
+			inTimeout: 30 * time.Minute,
+		},
+		"error if timeout is negative": {
+			inWait:      true,
+			inTimeout:   -time.Minute,
+			wantedError: errors.New("`--timeout` must not be negative"),
+		},
+		"error if timeout is specified without wait": {
+			inTimeout:   30 * time.Minute,
+			wantedError: errors.New("`--timeout` can only be specified with `--wait` or `--follow`"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &jobRunOpts{
+				jobRunVars: jobRunVars{
+					wait:    tc.inWait,
+					follow:  tc.inFollow,
+					timeout: tc.inTimeout,
+				},
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestJobRun_Ask(t *testing.T) {
 	const (
 		inputApp = "my-app"
@@ -162,9 +212,12 @@ func TestJobRun_Execute(t *testing.T) {
 		appName        string
 		envName        string
 		jobName        string
+		wait           bool
+		follow         bool
 		mockjobRunner  func(ctrl *gomock.Controller) runner
 		mockEnvChecker func(ctrl *gomock.Controller) versionCompatibilityChecker
 		wantedError    error
+		wantedExitCode int
 	}{
 		"successfully invoke job": {
 			jobName: "mockJob",
@@ -193,6 +246,45 @@ func TestJobRun_Execute(t *testing.T) {
 			},
 			wantedError: fmt.Errorf(`execute job "mockJob": some error`),
 		},
+		"should return an error with an exit code when the job fails": {
+			jobName: "mockJob",
+			wait:    true,
+			mockjobRunner: func(ctrl *gomock.Controller) runner {
+				m := mocks.NewMockrunner(ctrl)
+				m.EXPECT().Run().Return(&jobrunner.ErrExecutionFailed{
+					ExecutionARN:   "mockExecutionARN",
+					Classification: jobrunner.FailureTaskFailed,
+					Reason:         "execution completed with status FAILED: States.TaskFailed",
+				})
+				return m
+			},
+			mockEnvChecker: func(ctrl *gomock.Controller) versionCompatibilityChecker {
+				m := mocks.NewMockversionCompatibilityChecker(ctrl)
+				m.EXPECT().Version().Return("v1.34.0", nil)
+				return m
+			},
+			wantedError:    errors.New(`execute job "mockJob": execution mockExecutionARN failed with task-failure: execution completed with status FAILED: States.TaskFailed`),
+			wantedExitCode: 2,
+		},
+		"should return an error with the exit code of the task when following the job": {
+			jobName: "mockJob",
+			follow:  true,
+			mockjobRunner: func(ctrl *gomock.Controller) runner {
+				m := mocks.NewMockrunner(ctrl)
+				m.EXPECT().Run().Return(&mockErrorWithExitCode{
+					error:    errors.New("container mockJob in task 1 exited with status code 42"),
+					exitCode: 42,
+				})
+				return m
+			},
+			mockEnvChecker: func(ctrl *gomock.Controller) versionCompatibilityChecker {
+				m := mocks.NewMockversionCompatibilityChecker(ctrl)
+				m.EXPECT().Version().Return("v1.34.0", nil)
+				return m
+			},
+			wantedError:    errors.New(`execute job "mockJob": container mockJob in task 1 exited with status code 42`),
+			wantedExitCode: 42,
+		},
 		"should return a wrapped error when environment version cannot be retrieved": {
 			appName: "finance",
 			envName: "test",
@@ -207,6 +299,36 @@ func TestJobRun_Execute(t *testing.T) {
 			},
 			wantedError: errors.New(`retrieve version of environment stack "test" in application "finance": some error`),
 		},
+		"should return an error when waiting and environment template version is below v1.34.0": {
+			appName: "finance",
+			envName: "test",
+			jobName: "report",
+			wait:    true,
+			mockjobRunner: func(ctrl *gomock.Controller) runner {
+				return nil
+			},
+			mockEnvChecker: func(ctrl *gomock.Controller) versionCompatibilityChecker {
+				m := mocks.NewMockversionCompatibilityChecker(ctrl)
+				m.EXPECT().Version().Return("v1.33.0", nil)
+				return m
+			},
+			wantedError: errors.New(`environment "test" is on version "v1.33.0" which does not support the "job run --wait" feature`),
+		},
+		"should return an error when following and environment template version is below v1.34.0": {
+			appName: "finance",
+			envName: "test",
+			jobName: "report",
+			follow:  true,
+			mockjobRunner: func(ctrl *gomock.Controller) runner {
+				return nil
+			},
+			mockEnvChecker: func(ctrl *gomock.Controller) versionCompatibilityChecker {
+				m := mocks.NewMockversionCompatibilityChecker(ctrl)
+				m.EXPECT().Version().Return("v1.33.0", nil)
+				return m
+			},
+			wantedError: errors.New(`environment "test" is on version "v1.33.0" which does not support the "job run --follow" feature`),
+		},
 		"should return an error when environment template version is below v1.12.0": {
 			appName: "finance",
 			envName: "test",
@@ -233,6 +355,8 @@ func TestJobRun_Execute(t *testing.T) {
 					appName: tc.appName,
 					envName: tc.envName,
 					jobName: tc.jobName,
+					wait:    tc.wait,
+					follow:  tc.follow,
 				},
 				newRunner: func() (runner, error) {
 					return tc.mockjobRunner(ctrl), nil
@@ -247,6 +371,11 @@ func TestJobRun_Execute(t *testing.T) {
 			// THEN
 			if tc.wantedError != nil {
 				require.EqualError(t, err, tc.wantedError.Error())
+				if tc.wantedExitCode != 0 {
+					var exitCodeErr interface{ ExitCode() int }
+					require.ErrorAs(t, err, &exitCodeErr)
+					require.Equal(t, tc.wantedExitCode, exitCodeErr.ExitCode())
+				}
 			} else {
 				require.NoError(t, err)
 			}
@@ -254,3 +383,12 @@ func TestJobRun_Execute(t *testing.T) {
 	}
 
 }
+
+type mockErrorWithExitCode struct {
+	error
+	exitCode int
+}
+
+func (e *mockErrorWithExitCode) ExitCode() int {
+	return e.exitCode
+}
@@ -0,0 +1,163 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	jobStatusNamePrompt     = "Which job's status would you like to show?"
+	jobStatusNameHelpPrompt = "Displays the job's recent executions, missed scheduled invocations and alarm statuses."
+)
+
+type jobStatusVars struct {
+	jobName string
+	envName string
+	appName string
+	outputVars
+}
+
+type jobStatusOpts struct {
+	jobStatusVars
+
+	w                   io.Writer
+	store               store
+	statusDescriber     statusDescriber
+	sel                 deploySelector
+	initStatusDescriber func(*jobStatusOpts) error
+}
+
+func newJobStatusOpts(vars jobStatusVars) (*jobStatusOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("job status"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+
+	configStore := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	deployStore, err := deploy.NewStore(sessProvider, configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	return &jobStatusOpts{
+		jobStatusVars: vars,
+		store:         configStore,
+		w:             log.OutputWriter,
+		sel:           selector.NewDeploySelect(prompt.New(), configStore, deployStore),
+		initStatusDescriber: func(o *jobStatusOpts) error {
+			d, err := describe.NewJobStatusDescriber(&describe.NewJobStatusConfig{
+				App:         o.appName,
+				Env:         o.envName,
+				Job:         o.jobName,
+				ConfigStore: configStore,
+			})
+			if err != nil {
+				return fmt.Errorf("create status describer for job %s in application %s: %w", o.jobName, o.appName, err)
+			}
+			o.statusDescriber = d
+			return nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the output flags are invalid.
+func (o *jobStatusOpts) Validate() error {
+	return o.validateOutput()
+}
+
+// Ask prompts for and validates any required flags.
+func (o *jobStatusOpts) Ask() error {
+	if err := o.validateOrAskApp(); err != nil {
+		return err
+	}
+	return o.validateAndAskJobEnvName()
+}
+
+// Execute displays the status of the job.
+func (o *jobStatusOpts) Execute() error {
+	if err := o.initStatusDescriber(o); err != nil {
+		return err
+	}
+	jobStatus, err := o.statusDescriber.Describe()
+	if err != nil {
+		return fmt.Errorf("describe status of job %s: %w", o.jobName, err)
+	}
+	return writeOutput(o.w, o.format(), jobStatus)
+}
+
+func (o *jobStatusOpts) validateOrAskApp() error {
+	if o.appName != "" {
+		_, err := o.store.GetApplication(o.appName)
+		return err
+	}
+	app, err := o.sel.Application(jobAppNamePrompt, wkldAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *jobStatusOpts) validateAndAskJobEnvName() error {
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	if o.jobName != "" {
+		if _, err := o.store.GetJob(o.appName, o.jobName); err != nil {
+			return err
+		}
+	}
+	deployedJob, err := o.sel.DeployedJob(jobStatusNamePrompt, jobStatusNameHelpPrompt, o.appName, selector.WithEnv(o.envName), selector.WithName(o.jobName))
+	if err != nil {
+		return fmt.Errorf("select deployed jobs for application %s: %w", o.appName, err)
+	}
+	o.jobName = deployedJob.Name
+	o.envName = deployedJob.Env
+	return nil
+}
+
+// buildJobStatusCmd builds the command for showing the status of a deployed job.
+func buildJobStatusCmd() *cobra.Command {
+	vars := jobStatusVars{}
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Shows status of a deployed job.",
+		Long: `Shows status of a deployed job's recent executions, scheduled invocations that did not start an execution,
+consecutive failures and alarm statuses.`,
+
+		Example: `
+  Shows status of the deployed job "my-job"
+  /code $ copilot job status -n my-job
+  Shows status of the job "my-job" in the "prod" environment as JSON
+  /code $ copilot job status -n my-job -e prod --json`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newJobStatusOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.jobName, nameFlag, nameFlagShort, "", jobFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	addOutputFlags(cmd.Flags(), &vars.outputVars)
+	return cmd
+}
@@ -0,0 +1,193 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+)
+
+type jobStatusAskMock struct {
+	store *mocks.Mockstore
+	sel   *mocks.MockdeploySelector
+}
+
+func TestJobStatus_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inputApp string
+		inputJob string
+		inputEnv string
+
+		setupMocks func(m jobStatusAskMock)
+
+		wantedApp   string
+		wantedEnv   string
+		wantedJob   string
+		wantedError error
+	}{
+		"validate app env and job with all flags passed in": {
+			inputApp: "phonetool",
+			inputJob: "report",
+			inputEnv: "test",
+			setupMocks: func(m jobStatusAskMock) {
+				gomock.InOrder(
+					m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil),
+					m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test"}, nil),
+					m.store.EXPECT().GetJob("phonetool", "report").Return(&config.Workload{}, nil),
+				)
+				m.sel.EXPECT().DeployedJob(jobStatusNamePrompt, jobStatusNameHelpPrompt, "phonetool", gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedJob{
+						Env:  "test",
+						Name: "report",
+					}, nil)
+			},
+			wantedApp: "phonetool",
+			wantedEnv: "test",
+			wantedJob: "report",
+		},
+		"errors if failed to select application": {
+			setupMocks: func(m jobStatusAskMock) {
+				m.sel.EXPECT().Application(jobAppNamePrompt, wkldAppNameHelpPrompt).Return("", errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("select application: some error"),
+		},
+		"errors if the job does not exist": {
+			inputApp: "phonetool",
+			inputJob: "report",
+			setupMocks: func(m jobStatusAskMock) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.store.EXPECT().GetJob("phonetool", "report").Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("some error"),
+		},
+		"prompt for job and env": {
+			setupMocks: func(m jobStatusAskMock) {
+				m.sel.EXPECT().Application(jobAppNamePrompt, wkldAppNameHelpPrompt).Return("phonetool", nil)
+				m.sel.EXPECT().DeployedJob(jobStatusNamePrompt, jobStatusNameHelpPrompt, "phonetool", gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedJob{
+						Env:  "test",
+						Name: "report",
+					}, nil)
+			},
+			wantedApp: "phonetool",
+			wantedEnv: "test",
+			wantedJob: "report",
+		},
+		"errors if failed to select deployed job": {
+			inputApp: "phonetool",
+			setupMocks: func(m jobStatusAskMock) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.sel.EXPECT().DeployedJob(jobStatusNamePrompt, jobStatusNameHelpPrompt, "phonetool", gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("select deployed jobs for application phonetool: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := jobStatusAskMock{
+				store: mocks.NewMockstore(ctrl),
+				sel:   mocks.NewMockdeploySelector(ctrl),
+			}
+			tc.setupMocks(m)
+			jobStatus := &jobStatusOpts{
+				jobStatusVars: jobStatusVars{
+					jobName: tc.inputJob,
+					envName: tc.inputEnv,
+					appName: tc.inputApp,
+				},
+				sel:   m.sel,
+				store: m.store,
+			}
+
+			// WHEN
+			err := jobStatus.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedApp, jobStatus.appName, "expected app name to match")
+				require.Equal(t, tc.wantedJob, jobStatus.jobName, "expected job name to match")
+				require.Equal(t, tc.wantedEnv, jobStatus.envName, "expected environment name to match")
+			}
+		})
+	}
+}
+
+func TestJobStatus_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		shouldOutputJSON    bool
+		mockStatusDescriber func(m *mocks.MockstatusDescriber)
+		wantedError         error
+	}{
+		"errors if failed to describe the status of the job": {
+			mockStatusDescriber: func(m *mocks.MockstatusDescriber) {
+				m.EXPECT().Describe().Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("describe status of job mockJob: some error"),
+		},
+		"writes the status of the job": {
+			mockStatusDescriber: func(m *mocks.MockstatusDescriber) {
+				m.EXPECT().Describe().Return(&describe.ServiceEvents{}, nil)
+			},
+		},
+		"writes the status of the job in JSON": {
+			shouldOutputJSON: true,
+			mockStatusDescriber: func(m *mocks.MockstatusDescriber) {
+				m.EXPECT().Describe().Return(&describe.ServiceEvents{}, nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			b := &bytes.Buffer{}
+			mockStatusDescriber := mocks.NewMockstatusDescriber(ctrl)
+			tc.mockStatusDescriber(mockStatusDescriber)
+
+			jobStatus := &jobStatusOpts{
+				jobStatusVars: jobStatusVars{
+					jobName: "mockJob",
+					envName: "mockEnv",
+					appName: "mockApp",
+					outputVars: outputVars{
+						shouldOutputJSON: tc.shouldOutputJSON,
+					},
+				},
+				statusDescriber:     mockStatusDescriber,
+				initStatusDescriber: func(*jobStatusOpts) error { return nil },
+				w:                   b,
+			}
+
+			// WHEN
+			err := jobStatus.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.NotEmpty(t, b.String(), "expected output content to not be empty")
+			}
+		})
+	}
+}
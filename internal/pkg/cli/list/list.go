@@ -31,6 +31,17 @@ type Store interface {
 	GetApplication(appName string) (*config.Application, error)
 	ListJobs(appName string) ([]*config.Workload, error)
 	ListServices(appName string) ([]*config.Workload, error)
+	ListEnvironments(appName string) ([]*config.Environment, error)
+}
+
+// DeployStore wraps the methods required to retrieve the workloads deployed in an environment.
+type DeployStore interface {
+	ListDeployedServices(appName string, envName string) ([]string, error)
+}
+
+// StackStatusGetter wraps the method required to retrieve the status of a deployed workload's stack.
+type StackStatusGetter interface {
+	WorkloadStackStatus(appName, envName, wkldName string) (string, error)
 }
 
 // Workspace wraps the methods required to interact with a local workspace.
@@ -55,11 +66,14 @@ type JobListWriter struct {
 // workspace or app in a human- or machine-readable format.
 type SvcListWriter struct {
 	ShowLocalSvcs bool
+	ShowDeployed  bool
 	OutputJSON    bool
 
-	Store Store     // Client to retrieve application configuration and service metadata.
-	Ws    Workspace // Client to retrieve local jobs.
-	Out   io.Writer // The writer where output will be written.
+	Store       Store             // Client to retrieve application configuration and service metadata.
+	Ws          Workspace         // Client to retrieve local jobs.
+	DeployStore DeployStore       // Client to retrieve deployed services. Required only if ShowDeployed is true.
+	StackStatus StackStatusGetter // Client to retrieve the status of service stacks. Required only if ShowDeployed is true.
+	Out         io.Writer         // The writer where output will be written.
 }
 
 // ServiceJSONOutput is the output struct for service list.
@@ -67,6 +81,23 @@ type ServiceJSONOutput struct {
 	Services []*config.Workload `json:"services"`
 }
 
+// ServiceDeploymentsJSONOutput is the output struct for service list with deployments.
+type ServiceDeploymentsJSONOutput struct {
+	Services []*ServiceDeployments `json:"services"`
+}
+
+// ServiceDeployments holds a service and the environments it is deployed in.
+type ServiceDeployments struct {
+	*config.Workload
+	Deployments []EnvDeployment `json:"deployments"`
+}
+
+// EnvDeployment holds the status of a service's stack in an environment.
+type EnvDeployment struct {
+	Environment string `json:"environment"`
+	StackStatus string `json:"stackStatus"`
+}
+
 // JobJSONOutput is the output struct for job list.
 type JobJSONOutput struct {
 	Jobs []*config.Workload `json:"jobs"`
@@ -116,6 +147,9 @@ func (l *SvcListWriter) Write(appName string) error {
 		}
 		wklds = filterByName(wklds, localWklds)
 	}
+	if l.ShowDeployed {
+		return l.writeDeployments(appName, wklds)
+	}
 	if l.OutputJSON {
 		data, err := l.jsonOutputSvcs(wklds)
 		if err != nil {
@@ -128,6 +162,47 @@ func (l *SvcListWriter) Write(appName string) error {
 	return nil
 }
 
+func (l *SvcListWriter) writeDeployments(appName string, svcs []*config.Workload) error {
+	envs, err := l.Store.ListEnvironments(appName)
+	if err != nil {
+		return fmt.Errorf("list environments: %w", err)
+	}
+	deploymentsBySvc := make(map[string][]EnvDeployment)
+	for _, env := range envs {
+		deployed, err := l.DeployStore.ListDeployedServices(appName, env.Name)
+		if err != nil {
+			return fmt.Errorf("list deployed %ss in environment %s: %w", svcWorkloadType, env.Name, err)
+		}
+		for _, svc := range deployed {
+			status, err := l.StackStatus.WorkloadStackStatus(appName, env.Name, svc)
+			if err != nil {
+				return fmt.Errorf("get stack status of %s %s in environment %s: %w", svcWorkloadType, svc, env.Name, err)
+			}
+			deploymentsBySvc[svc] = append(deploymentsBySvc[svc], EnvDeployment{
+				Environment: env.Name,
+				StackStatus: status,
+			})
+		}
+	}
+	var out []*ServiceDeployments
+	for _, svc := range svcs {
+		out = append(out, &ServiceDeployments{
+			Workload:    svc,
+			Deployments: deploymentsBySvc[svc.Name],
+		})
+	}
+	if l.OutputJSON {
+		b, err := json.Marshal(ServiceDeploymentsJSONOutput{Services: out})
+		if err != nil {
+			return fmt.Errorf("marshal services: %w", err)
+		}
+		fmt.Fprintf(l.Out, "%s\n", b)
+		return nil
+	}
+	humanDeploymentsOutput(out, envs, l.Out)
+	return nil
+}
+
 func filterByName(wklds []*config.Workload, wantedNames []string) []*config.Workload {
 	isWanted := make(map[string]bool)
 	for _, name := range wantedNames {
@@ -162,6 +237,32 @@ func humanOutput(wklds []*config.Workload, w io.Writer) {
 	writer.Flush()
 }
 
+func humanDeploymentsOutput(svcs []*ServiceDeployments, envs []*config.Environment, w io.Writer) {
+	writer := tabwriter.NewWriter(w, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	headers := []string{"Name", "Type"}
+	for _, env := range envs {
+		headers = append(headers, env.Name)
+	}
+	fmt.Fprintf(writer, "%s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "%s\n", strings.Join(underline(headers), "\t"))
+	for _, svc := range svcs {
+		statusByEnv := make(map[string]string, len(svc.Deployments))
+		for _, deployment := range svc.Deployments {
+			statusByEnv[deployment.Environment] = deployment.StackStatus
+		}
+		row := []string{svc.Name, svc.Type}
+		for _, env := range envs {
+			status, ok := statusByEnv[env.Name]
+			if !ok {
+				status = "-"
+			}
+			row = append(row, status)
+		}
+		fmt.Fprintf(writer, "%s\n", strings.Join(row, "\t"))
+	}
+	writer.Flush()
+}
+
 func (l *SvcListWriter) jsonOutputSvcs(svcs []*config.Workload) (string, error) {
 	b, err := json.Marshal(ServiceJSONOutput{Services: svcs})
 	if err != nil {
@@ -339,3 +339,110 @@ func TestList_SvcListWriter(t *testing.T) {
 		})
 	}
 }
+
+func TestList_SvcListWriter_Deployed(t *testing.T) {
+	mockError := fmt.Errorf("error")
+	mockAppName := "barnyard"
+
+	testCases := map[string]struct {
+		inputWriteJSON bool
+
+		mocking func(m *svcListWriterMocks)
+
+		wantedError   error
+		wantedContent string
+	}{
+		"with failed call to ListEnvironments": {
+			mocking: func(m *svcListWriterMocks) {
+				m.store.EXPECT().ListEnvironments("barnyard").Return(nil, mockError)
+			},
+			wantedError: fmt.Errorf("list environments: error"),
+		},
+		"with failed call to ListDeployedServices": {
+			mocking: func(m *svcListWriterMocks) {
+				m.store.EXPECT().ListEnvironments("barnyard").Return([]*config.Environment{{Name: "test"}}, nil)
+				m.deployStore.EXPECT().ListDeployedServices("barnyard", "test").Return(nil, mockError)
+			},
+			wantedError: fmt.Errorf("list deployed services in environment test: error"),
+		},
+		"with failed call to WorkloadStackStatus": {
+			mocking: func(m *svcListWriterMocks) {
+				m.store.EXPECT().ListEnvironments("barnyard").Return([]*config.Environment{{Name: "test"}}, nil)
+				m.deployStore.EXPECT().ListDeployedServices("barnyard", "test").Return([]string{"trough"}, nil)
+				m.stackStatus.EXPECT().WorkloadStackStatus("barnyard", "test", "trough").Return("", mockError)
+			},
+			wantedError: fmt.Errorf("get stack status of service trough in environment test: error"),
+		},
+		"should succeed writing human readable": {
+			mocking: func(m *svcListWriterMocks) {
+				m.store.EXPECT().ListEnvironments("barnyard").Return([]*config.Environment{{Name: "test"}, {Name: "prod"}}, nil)
+				m.deployStore.EXPECT().ListDeployedServices("barnyard", "test").Return([]string{"trough", "gaggle"}, nil)
+				m.deployStore.EXPECT().ListDeployedServices("barnyard", "prod").Return([]string{"gaggle"}, nil)
+				m.stackStatus.EXPECT().WorkloadStackStatus("barnyard", "test", "trough").Return("CREATE_COMPLETE", nil)
+				m.stackStatus.EXPECT().WorkloadStackStatus("barnyard", "test", "gaggle").Return("UPDATE_COMPLETE", nil)
+				m.stackStatus.EXPECT().WorkloadStackStatus("barnyard", "prod", "gaggle").Return("UPDATE_IN_PROGRESS", nil)
+			},
+			wantedContent: `Name                Type                       test                prod
+----                ----                       ----                ----
+trough              Backend Service            CREATE_COMPLETE     -
+gaggle              Load Balanced Web Service  UPDATE_COMPLETE     UPDATE_IN_PROGRESS
+`,
+		},
+		"should succeed writing json": {
+			inputWriteJSON: true,
+			mocking: func(m *svcListWriterMocks) {
+				m.store.EXPECT().ListEnvironments("barnyard").Return([]*config.Environment{{Name: "test"}}, nil)
+				m.deployStore.EXPECT().ListDeployedServices("barnyard", "test").Return([]string{"gaggle"}, nil)
+				m.stackStatus.EXPECT().WorkloadStackStatus("barnyard", "test", "gaggle").Return("UPDATE_COMPLETE", nil)
+			},
+			wantedContent: `{"services":[{"app":"","name":"trough","type":"Backend Service","deployments":null},{"app":"","name":"gaggle","type":"Load Balanced Web Service","deployments":[{"environment":"test","stackStatus":"UPDATE_COMPLETE"}]}]}
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &svcListWriterMocks{
+				store:       mocks.NewMockStore(ctrl),
+				deployStore: mocks.NewMockDeployStore(ctrl),
+				stackStatus: mocks.NewMockStackStatusGetter(ctrl),
+			}
+			m.store.EXPECT().GetApplication("barnyard").Return(&config.Application{}, nil)
+			m.store.EXPECT().ListServices("barnyard").Return([]*config.Workload{
+				{Name: "trough", Type: "Backend Service"},
+				{Name: "gaggle", Type: "Load Balanced Web Service"},
+			}, nil)
+			tc.mocking(m)
+			b := &bytes.Buffer{}
+			list := &SvcListWriter{
+				Store:       m.store,
+				DeployStore: m.deployStore,
+				StackStatus: m.stackStatus,
+				Out:         b,
+
+				ShowDeployed: true,
+				OutputJSON:   tc.inputWriteJSON,
+			}
+
+			// WHEN
+			err := list.Write(mockAppName)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
+
+type svcListWriterMocks struct {
+	store       *mocks.MockStore
+	deployStore *mocks.MockDeployStore
+	stackStatus *mocks.MockStackStatusGetter
+}
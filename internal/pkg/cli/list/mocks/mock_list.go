@@ -49,6 +49,21 @@ func (mr *MockStoreMockRecorder) GetApplication(appName interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplication", reflect.TypeOf((*MockStore)(nil).GetApplication), appName)
 }
 
+// ListEnvironments mocks base method.
+func (m *MockStore) ListEnvironments(appName string) ([]*config.Environment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEnvironments", appName)
+	ret0, _ := ret[0].([]*config.Environment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEnvironments indicates an expected call of ListEnvironments.
+func (mr *MockStoreMockRecorder) ListEnvironments(appName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnvironments", reflect.TypeOf((*MockStore)(nil).ListEnvironments), appName)
+}
+
 // ListJobs mocks base method.
 func (m *MockStore) ListJobs(appName string) ([]*config.Workload, error) {
 	m.ctrl.T.Helper()
@@ -79,6 +94,82 @@ func (mr *MockStoreMockRecorder) ListServices(appName interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServices", reflect.TypeOf((*MockStore)(nil).ListServices), appName)
 }
 
+// MockDeployStore is a mock of DeployStore interface.
+type MockDeployStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeployStoreMockRecorder
+}
+
+// MockDeployStoreMockRecorder is the mock recorder for MockDeployStore.
+type MockDeployStoreMockRecorder struct {
+	mock *MockDeployStore
+}
+
+// NewMockDeployStore creates a new mock instance.
+func NewMockDeployStore(ctrl *gomock.Controller) *MockDeployStore {
+	mock := &MockDeployStore{ctrl: ctrl}
+	mock.recorder = &MockDeployStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeployStore) EXPECT() *MockDeployStoreMockRecorder {
+	return m.recorder
+}
+
+// ListDeployedServices mocks base method.
+func (m *MockDeployStore) ListDeployedServices(appName, envName string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeployedServices", appName, envName)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeployedServices indicates an expected call of ListDeployedServices.
+func (mr *MockDeployStoreMockRecorder) ListDeployedServices(appName, envName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeployedServices", reflect.TypeOf((*MockDeployStore)(nil).ListDeployedServices), appName, envName)
+}
+
+// MockStackStatusGetter is a mock of StackStatusGetter interface.
+type MockStackStatusGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockStackStatusGetterMockRecorder
+}
+
+// MockStackStatusGetterMockRecorder is the mock recorder for MockStackStatusGetter.
+type MockStackStatusGetterMockRecorder struct {
+	mock *MockStackStatusGetter
+}
+
+// NewMockStackStatusGetter creates a new mock instance.
+func NewMockStackStatusGetter(ctrl *gomock.Controller) *MockStackStatusGetter {
+	mock := &MockStackStatusGetter{ctrl: ctrl}
+	mock.recorder = &MockStackStatusGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStackStatusGetter) EXPECT() *MockStackStatusGetterMockRecorder {
+	return m.recorder
+}
+
+// WorkloadStackStatus mocks base method.
+func (m *MockStackStatusGetter) WorkloadStackStatus(appName, envName, wkldName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WorkloadStackStatus", appName, envName, wkldName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WorkloadStackStatus indicates an expected call of WorkloadStackStatus.
+func (mr *MockStackStatusGetterMockRecorder) WorkloadStackStatus(appName, envName, wkldName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkloadStackStatus", reflect.TypeOf((*MockStackStatusGetter)(nil).WorkloadStackStatus), appName, envName, wkldName)
+}
+
 // MockWorkspace is a mock of Workspace interface.
 type MockWorkspace struct {
 	ctrl     *gomock.Controller
@@ -12,11 +12,18 @@ import (
 
 	session "github.com/aws/aws-sdk-go/aws/session"
 	cloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	audit "github.com/aws/copilot-cli/internal/pkg/audit"
+	aas "github.com/aws/copilot-cli/internal/pkg/aws/aas"
+	budgets "github.com/aws/copilot-cli/internal/pkg/aws/budgets"
 	cloudformation0 "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	codepipeline "github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
 	ec2 "github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	efs "github.com/aws/copilot-cli/internal/pkg/aws/efs"
+	iam "github.com/aws/copilot-cli/internal/pkg/aws/iam"
+	rds "github.com/aws/copilot-cli/internal/pkg/aws/rds"
 	secretsmanager "github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
+	sqs "github.com/aws/copilot-cli/internal/pkg/aws/sqs"
 	ssm "github.com/aws/copilot-cli/internal/pkg/aws/ssm"
 	deploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
 	config "github.com/aws/copilot-cli/internal/pkg/config"
@@ -32,8 +39,10 @@ import (
 	initialize "github.com/aws/copilot-cli/internal/pkg/initialize"
 	logging "github.com/aws/copilot-cli/internal/pkg/logging"
 	manifest "github.com/aws/copilot-cli/internal/pkg/manifest"
+	plugin "github.com/aws/copilot-cli/internal/pkg/plugin"
 	task "github.com/aws/copilot-cli/internal/pkg/task"
 	template "github.com/aws/copilot-cli/internal/pkg/template"
+	diff "github.com/aws/copilot-cli/internal/pkg/template/diff"
 	prompt "github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	selector "github.com/aws/copilot-cli/internal/pkg/term/selector"
 	workspace "github.com/aws/copilot-cli/internal/pkg/workspace"
@@ -184,6 +193,85 @@ func (mr *MockactionCommandMockRecorder) Validate() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validate", reflect.TypeOf((*MockactionCommand)(nil).Validate))
 }
 
+// MockversionPinnedCommand is a mock of versionPinnedCommand interface.
+type MockversionPinnedCommand struct {
+	ctrl     *gomock.Controller
+	recorder *MockversionPinnedCommandMockRecorder
+}
+
+// MockversionPinnedCommandMockRecorder is the mock recorder for MockversionPinnedCommand.
+type MockversionPinnedCommandMockRecorder struct {
+	mock *MockversionPinnedCommand
+}
+
+// NewMockversionPinnedCommand creates a new mock instance.
+func NewMockversionPinnedCommand(ctrl *gomock.Controller) *MockversionPinnedCommand {
+	mock := &MockversionPinnedCommand{ctrl: ctrl}
+	mock.recorder = &MockversionPinnedCommandMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockversionPinnedCommand) EXPECT() *MockversionPinnedCommandMockRecorder {
+	return m.recorder
+}
+
+// Ask mocks base method.
+func (m *MockversionPinnedCommand) Ask() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ask")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Ask indicates an expected call of Ask.
+func (mr *MockversionPinnedCommandMockRecorder) Ask() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ask", reflect.TypeOf((*MockversionPinnedCommand)(nil).Ask))
+}
+
+// Execute mocks base method.
+func (m *MockversionPinnedCommand) Execute() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockversionPinnedCommandMockRecorder) Execute() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockversionPinnedCommand)(nil).Execute))
+}
+
+// Validate mocks base method.
+func (m *MockversionPinnedCommand) Validate() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Validate")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Validate indicates an expected call of Validate.
+func (mr *MockversionPinnedCommandMockRecorder) Validate() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validate", reflect.TypeOf((*MockversionPinnedCommand)(nil).Validate))
+}
+
+// ValidateVersionPin mocks base method.
+func (m *MockversionPinnedCommand) ValidateVersionPin() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateVersionPin")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateVersionPin indicates an expected call of ValidateVersionPin.
+func (mr *MockversionPinnedCommandMockRecorder) ValidateVersionPin() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateVersionPin", reflect.TypeOf((*MockversionPinnedCommand)(nil).ValidateVersionPin))
+}
+
 // MockserviceStore is a mock of serviceStore interface.
 type MockserviceStore struct {
 	ctrl     *gomock.Controller
@@ -1301,6 +1389,179 @@ func (mr *MockstoreMockRecorder) UpdateApplication(app interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateApplication", reflect.TypeOf((*Mockstore)(nil).UpdateApplication), app)
 }
 
+// MockopsConfigStore is a mock of opsConfigStore interface.
+type MockopsConfigStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockopsConfigStoreMockRecorder
+}
+
+// MockopsConfigStoreMockRecorder is the mock recorder for MockopsConfigStore.
+type MockopsConfigStoreMockRecorder struct {
+	mock *MockopsConfigStore
+}
+
+// NewMockopsConfigStore creates a new mock instance.
+func NewMockopsConfigStore(ctrl *gomock.Controller) *MockopsConfigStore {
+	mock := &MockopsConfigStore{ctrl: ctrl}
+	mock.recorder = &MockopsConfigStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockopsConfigStore) EXPECT() *MockopsConfigStoreMockRecorder {
+	return m.recorder
+}
+
+// GetApplication mocks base method.
+func (m *MockopsConfigStore) GetApplication(appName string) (*config.Application, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplication", appName)
+	ret0, _ := ret[0].(*config.Application)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplication indicates an expected call of GetApplication.
+func (mr *MockopsConfigStoreMockRecorder) GetApplication(appName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplication", reflect.TypeOf((*MockopsConfigStore)(nil).GetApplication), appName)
+}
+
+// GetEnvironment mocks base method.
+func (m *MockopsConfigStore) GetEnvironment(appName, environmentName string) (*config.Environment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEnvironment", appName, environmentName)
+	ret0, _ := ret[0].(*config.Environment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEnvironment indicates an expected call of GetEnvironment.
+func (mr *MockopsConfigStoreMockRecorder) GetEnvironment(appName, environmentName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEnvironment", reflect.TypeOf((*MockopsConfigStore)(nil).GetEnvironment), appName, environmentName)
+}
+
+// GetJob mocks base method.
+func (m *MockopsConfigStore) GetJob(appName, jobName string) (*config.Workload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJob", appName, jobName)
+	ret0, _ := ret[0].(*config.Workload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJob indicates an expected call of GetJob.
+func (mr *MockopsConfigStoreMockRecorder) GetJob(appName, jobName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJob", reflect.TypeOf((*MockopsConfigStore)(nil).GetJob), appName, jobName)
+}
+
+// GetService mocks base method.
+func (m *MockopsConfigStore) GetService(appName, svcName string) (*config.Workload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetService", appName, svcName)
+	ret0, _ := ret[0].(*config.Workload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetService indicates an expected call of GetService.
+func (mr *MockopsConfigStoreMockRecorder) GetService(appName, svcName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetService", reflect.TypeOf((*MockopsConfigStore)(nil).GetService), appName, svcName)
+}
+
+// GetWorkload mocks base method.
+func (m *MockopsConfigStore) GetWorkload(appName, name string) (*config.Workload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkload", appName, name)
+	ret0, _ := ret[0].(*config.Workload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkload indicates an expected call of GetWorkload.
+func (mr *MockopsConfigStoreMockRecorder) GetWorkload(appName, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkload", reflect.TypeOf((*MockopsConfigStore)(nil).GetWorkload), appName, name)
+}
+
+// ListApplications mocks base method.
+func (m *MockopsConfigStore) ListApplications() ([]*config.Application, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListApplications")
+	ret0, _ := ret[0].([]*config.Application)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListApplications indicates an expected call of ListApplications.
+func (mr *MockopsConfigStoreMockRecorder) ListApplications() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListApplications", reflect.TypeOf((*MockopsConfigStore)(nil).ListApplications))
+}
+
+// ListEnvironments mocks base method.
+func (m *MockopsConfigStore) ListEnvironments(appName string) ([]*config.Environment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEnvironments", appName)
+	ret0, _ := ret[0].([]*config.Environment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEnvironments indicates an expected call of ListEnvironments.
+func (mr *MockopsConfigStoreMockRecorder) ListEnvironments(appName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnvironments", reflect.TypeOf((*MockopsConfigStore)(nil).ListEnvironments), appName)
+}
+
+// ListJobs mocks base method.
+func (m *MockopsConfigStore) ListJobs(appName string) ([]*config.Workload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListJobs", appName)
+	ret0, _ := ret[0].([]*config.Workload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListJobs indicates an expected call of ListJobs.
+func (mr *MockopsConfigStoreMockRecorder) ListJobs(appName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJobs", reflect.TypeOf((*MockopsConfigStore)(nil).ListJobs), appName)
+}
+
+// ListServices mocks base method.
+func (m *MockopsConfigStore) ListServices(appName string) ([]*config.Workload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListServices", appName)
+	ret0, _ := ret[0].([]*config.Workload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListServices indicates an expected call of ListServices.
+func (mr *MockopsConfigStoreMockRecorder) ListServices(appName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServices", reflect.TypeOf((*MockopsConfigStore)(nil).ListServices), appName)
+}
+
+// ListWorkloads mocks base method.
+func (m *MockopsConfigStore) ListWorkloads(appName string) ([]*config.Workload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkloads", appName)
+	ret0, _ := ret[0].([]*config.Workload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkloads indicates an expected call of ListWorkloads.
+func (mr *MockopsConfigStoreMockRecorder) ListWorkloads(appName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkloads", reflect.TypeOf((*MockopsConfigStore)(nil).ListWorkloads), appName)
+}
+
 // MockdeployedEnvironmentLister is a mock of deployedEnvironmentLister interface.
 type MockdeployedEnvironmentLister struct {
 	ctrl     *gomock.Controller
@@ -3420,93 +3681,358 @@ func (mr *MockwsWlDirReaderMockRecorder) WorkloadOverridesPath(arg0 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkloadOverridesPath", reflect.TypeOf((*MockwsWlDirReader)(nil).WorkloadOverridesPath), arg0)
 }
 
-// MockwsEnvironmentReader is a mock of wsEnvironmentReader interface.
-type MockwsEnvironmentReader struct {
+// MockwsReleaseReader is a mock of wsReleaseReader interface.
+type MockwsReleaseReader struct {
 	ctrl     *gomock.Controller
-	recorder *MockwsEnvironmentReaderMockRecorder
+	recorder *MockwsReleaseReaderMockRecorder
 }
 
-// MockwsEnvironmentReaderMockRecorder is the mock recorder for MockwsEnvironmentReader.
-type MockwsEnvironmentReaderMockRecorder struct {
-	mock *MockwsEnvironmentReader
+// MockwsReleaseReaderMockRecorder is the mock recorder for MockwsReleaseReader.
+type MockwsReleaseReaderMockRecorder struct {
+	mock *MockwsReleaseReader
 }
 
-// NewMockwsEnvironmentReader creates a new mock instance.
-func NewMockwsEnvironmentReader(ctrl *gomock.Controller) *MockwsEnvironmentReader {
-	mock := &MockwsEnvironmentReader{ctrl: ctrl}
-	mock.recorder = &MockwsEnvironmentReaderMockRecorder{mock}
+// NewMockwsReleaseReader creates a new mock instance.
+func NewMockwsReleaseReader(ctrl *gomock.Controller) *MockwsReleaseReader {
+	mock := &MockwsReleaseReader{ctrl: ctrl}
+	mock.recorder = &MockwsReleaseReaderMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockwsEnvironmentReader) EXPECT() *MockwsEnvironmentReaderMockRecorder {
+func (m *MockwsReleaseReader) EXPECT() *MockwsReleaseReaderMockRecorder {
 	return m.recorder
 }
 
-// EnvAddonFileAbsPath mocks base method.
-func (m *MockwsEnvironmentReader) EnvAddonFileAbsPath(fName string) string {
+// ListEnvironments mocks base method.
+func (m *MockwsReleaseReader) ListEnvironments() ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EnvAddonFileAbsPath", fName)
-	ret0, _ := ret[0].(string)
-	return ret0
+	ret := m.ctrl.Call(m, "ListEnvironments")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EnvAddonFileAbsPath indicates an expected call of EnvAddonFileAbsPath.
-func (mr *MockwsEnvironmentReaderMockRecorder) EnvAddonFileAbsPath(fName interface{}) *gomock.Call {
+// ListEnvironments indicates an expected call of ListEnvironments.
+func (mr *MockwsReleaseReaderMockRecorder) ListEnvironments() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnvAddonFileAbsPath", reflect.TypeOf((*MockwsEnvironmentReader)(nil).EnvAddonFileAbsPath), fName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnvironments", reflect.TypeOf((*MockwsReleaseReader)(nil).ListEnvironments))
 }
 
-// EnvAddonFilePath mocks base method.
-func (m *MockwsEnvironmentReader) EnvAddonFilePath(fName string) string {
+// ListJobs mocks base method.
+func (m *MockwsReleaseReader) ListJobs() ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EnvAddonFilePath", fName)
-	ret0, _ := ret[0].(string)
-	return ret0
+	ret := m.ctrl.Call(m, "ListJobs")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EnvAddonFilePath indicates an expected call of EnvAddonFilePath.
-func (mr *MockwsEnvironmentReaderMockRecorder) EnvAddonFilePath(fName interface{}) *gomock.Call {
+// ListJobs indicates an expected call of ListJobs.
+func (mr *MockwsReleaseReaderMockRecorder) ListJobs() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnvAddonFilePath", reflect.TypeOf((*MockwsEnvironmentReader)(nil).EnvAddonFilePath), fName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJobs", reflect.TypeOf((*MockwsReleaseReader)(nil).ListJobs))
 }
 
-// EnvOverridesPath mocks base method.
-func (m *MockwsEnvironmentReader) EnvOverridesPath() string {
+// ListServices mocks base method.
+func (m *MockwsReleaseReader) ListServices() ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EnvOverridesPath")
-	ret0, _ := ret[0].(string)
-	return ret0
+	ret := m.ctrl.Call(m, "ListServices")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EnvOverridesPath indicates an expected call of EnvOverridesPath.
-func (mr *MockwsEnvironmentReaderMockRecorder) EnvOverridesPath() *gomock.Call {
+// ListServices indicates an expected call of ListServices.
+func (mr *MockwsReleaseReaderMockRecorder) ListServices() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnvOverridesPath", reflect.TypeOf((*MockwsEnvironmentReader)(nil).EnvOverridesPath))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServices", reflect.TypeOf((*MockwsReleaseReader)(nil).ListServices))
 }
 
-// HasEnvironments mocks base method.
-func (m *MockwsEnvironmentReader) HasEnvironments() (bool, error) {
+// ListWorkloads mocks base method.
+func (m *MockwsReleaseReader) ListWorkloads() ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "HasEnvironments")
-	ret0, _ := ret[0].(bool)
+	ret := m.ctrl.Call(m, "ListWorkloads")
+	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// HasEnvironments indicates an expected call of HasEnvironments.
-func (mr *MockwsEnvironmentReaderMockRecorder) HasEnvironments() *gomock.Call {
+// ListWorkloads indicates an expected call of ListWorkloads.
+func (mr *MockwsReleaseReaderMockRecorder) ListWorkloads() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasEnvironments", reflect.TypeOf((*MockwsEnvironmentReader)(nil).HasEnvironments))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkloads", reflect.TypeOf((*MockwsReleaseReader)(nil).ListWorkloads))
 }
 
-// ListEnvironments mocks base method.
-func (m *MockwsEnvironmentReader) ListEnvironments() ([]string, error) {
+// Path mocks base method.
+func (m *MockwsReleaseReader) Path() string {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListEnvironments")
-	ret0, _ := ret[0].([]string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Path")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Path indicates an expected call of Path.
+func (mr *MockwsReleaseReaderMockRecorder) Path() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Path", reflect.TypeOf((*MockwsReleaseReader)(nil).Path))
+}
+
+// ReadReleaseManifest mocks base method.
+func (m *MockwsReleaseReader) ReadReleaseManifest() (*manifest.Release, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadReleaseManifest")
+	ret0, _ := ret[0].(*manifest.Release)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadReleaseManifest indicates an expected call of ReadReleaseManifest.
+func (mr *MockwsReleaseReaderMockRecorder) ReadReleaseManifest() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadReleaseManifest", reflect.TypeOf((*MockwsReleaseReader)(nil).ReadReleaseManifest))
+}
+
+// ReadWorkloadManifest mocks base method.
+func (m *MockwsReleaseReader) ReadWorkloadManifest(name string) (workspace.WorkloadManifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadWorkloadManifest", name)
+	ret0, _ := ret[0].(workspace.WorkloadManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadWorkloadManifest indicates an expected call of ReadWorkloadManifest.
+func (mr *MockwsReleaseReaderMockRecorder) ReadWorkloadManifest(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWorkloadManifest", reflect.TypeOf((*MockwsReleaseReader)(nil).ReadWorkloadManifest), name)
+}
+
+// Summary mocks base method.
+func (m *MockwsReleaseReader) Summary() (*workspace.Summary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Summary")
+	ret0, _ := ret[0].(*workspace.Summary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Summary indicates an expected call of Summary.
+func (mr *MockwsReleaseReaderMockRecorder) Summary() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Summary", reflect.TypeOf((*MockwsReleaseReader)(nil).Summary))
+}
+
+// WorkloadOverridesPath mocks base method.
+func (m *MockwsReleaseReader) WorkloadOverridesPath(arg0 string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WorkloadOverridesPath", arg0)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// WorkloadOverridesPath indicates an expected call of WorkloadOverridesPath.
+func (mr *MockwsReleaseReaderMockRecorder) WorkloadOverridesPath(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkloadOverridesPath", reflect.TypeOf((*MockwsReleaseReader)(nil).WorkloadOverridesPath), arg0)
+}
+
+// MockdeploymentHistory is a mock of deploymentHistory interface.
+type MockdeploymentHistory struct {
+	ctrl     *gomock.Controller
+	recorder *MockdeploymentHistoryMockRecorder
+}
+
+// MockdeploymentHistoryMockRecorder is the mock recorder for MockdeploymentHistory.
+type MockdeploymentHistoryMockRecorder struct {
+	mock *MockdeploymentHistory
+}
+
+// NewMockdeploymentHistory creates a new mock instance.
+func NewMockdeploymentHistory(ctrl *gomock.Controller) *MockdeploymentHistory {
+	mock := &MockdeploymentHistory{ctrl: ctrl}
+	mock.recorder = &MockdeploymentHistoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdeploymentHistory) EXPECT() *MockdeploymentHistoryMockRecorder {
+	return m.recorder
+}
+
+// Revisions mocks base method.
+func (m *MockdeploymentHistory) Revisions(stackName string) ([]deploy0.DeploymentRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revisions", stackName)
+	ret0, _ := ret[0].([]deploy0.DeploymentRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Revisions indicates an expected call of Revisions.
+func (mr *MockdeploymentHistoryMockRecorder) Revisions(stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revisions", reflect.TypeOf((*MockdeploymentHistory)(nil).Revisions), stackName)
+}
+
+// Save mocks base method.
+func (m *MockdeploymentHistory) Save(stackName string, revisions []deploy0.DeploymentRevision) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", stackName, revisions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockdeploymentHistoryMockRecorder) Save(stackName, revisions interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockdeploymentHistory)(nil).Save), stackName, revisions)
+}
+
+// MockworkloadStackRestorer is a mock of workloadStackRestorer interface.
+type MockworkloadStackRestorer struct {
+	ctrl     *gomock.Controller
+	recorder *MockworkloadStackRestorerMockRecorder
+}
+
+// MockworkloadStackRestorerMockRecorder is the mock recorder for MockworkloadStackRestorer.
+type MockworkloadStackRestorerMockRecorder struct {
+	mock *MockworkloadStackRestorer
+}
+
+// NewMockworkloadStackRestorer creates a new mock instance.
+func NewMockworkloadStackRestorer(ctrl *gomock.Controller) *MockworkloadStackRestorer {
+	mock := &MockworkloadStackRestorer{ctrl: ctrl}
+	mock.recorder = &MockworkloadStackRestorerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockworkloadStackRestorer) EXPECT() *MockworkloadStackRestorerMockRecorder {
+	return m.recorder
+}
+
+// DeployService mocks base method.
+func (m *MockworkloadStackRestorer) DeployService(conf cloudformation1.StackConfiguration, bucketName string, detach bool, opts ...cloudformation0.StackOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{conf, bucketName, detach}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeployService", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeployService indicates an expected call of DeployService.
+func (mr *MockworkloadStackRestorerMockRecorder) DeployService(conf, bucketName, detach interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{conf, bucketName, detach}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployService", reflect.TypeOf((*MockworkloadStackRestorer)(nil).DeployService), varargs...)
+}
+
+// DeployedStack mocks base method.
+func (m *MockworkloadStackRestorer) DeployedStack(stackName string) (*cloudformation1.DeployedStack, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeployedStack", stackName)
+	ret0, _ := ret[0].(*cloudformation1.DeployedStack)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeployedStack indicates an expected call of DeployedStack.
+func (mr *MockworkloadStackRestorerMockRecorder) DeployedStack(stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployedStack", reflect.TypeOf((*MockworkloadStackRestorer)(nil).DeployedStack), stackName)
+}
+
+// MockwsEnvironmentReader is a mock of wsEnvironmentReader interface.
+type MockwsEnvironmentReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsEnvironmentReaderMockRecorder
+}
+
+// MockwsEnvironmentReaderMockRecorder is the mock recorder for MockwsEnvironmentReader.
+type MockwsEnvironmentReaderMockRecorder struct {
+	mock *MockwsEnvironmentReader
+}
+
+// NewMockwsEnvironmentReader creates a new mock instance.
+func NewMockwsEnvironmentReader(ctrl *gomock.Controller) *MockwsEnvironmentReader {
+	mock := &MockwsEnvironmentReader{ctrl: ctrl}
+	mock.recorder = &MockwsEnvironmentReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwsEnvironmentReader) EXPECT() *MockwsEnvironmentReaderMockRecorder {
+	return m.recorder
+}
+
+// EnvAddonFileAbsPath mocks base method.
+func (m *MockwsEnvironmentReader) EnvAddonFileAbsPath(fName string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnvAddonFileAbsPath", fName)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// EnvAddonFileAbsPath indicates an expected call of EnvAddonFileAbsPath.
+func (mr *MockwsEnvironmentReaderMockRecorder) EnvAddonFileAbsPath(fName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnvAddonFileAbsPath", reflect.TypeOf((*MockwsEnvironmentReader)(nil).EnvAddonFileAbsPath), fName)
+}
+
+// EnvAddonFilePath mocks base method.
+func (m *MockwsEnvironmentReader) EnvAddonFilePath(fName string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnvAddonFilePath", fName)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// EnvAddonFilePath indicates an expected call of EnvAddonFilePath.
+func (mr *MockwsEnvironmentReaderMockRecorder) EnvAddonFilePath(fName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnvAddonFilePath", reflect.TypeOf((*MockwsEnvironmentReader)(nil).EnvAddonFilePath), fName)
+}
+
+// EnvOverridesPath mocks base method.
+func (m *MockwsEnvironmentReader) EnvOverridesPath() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnvOverridesPath")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// EnvOverridesPath indicates an expected call of EnvOverridesPath.
+func (mr *MockwsEnvironmentReaderMockRecorder) EnvOverridesPath() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnvOverridesPath", reflect.TypeOf((*MockwsEnvironmentReader)(nil).EnvOverridesPath))
+}
+
+// HasEnvironments mocks base method.
+func (m *MockwsEnvironmentReader) HasEnvironments() (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasEnvironments")
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasEnvironments indicates an expected call of HasEnvironments.
+func (mr *MockwsEnvironmentReaderMockRecorder) HasEnvironments() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasEnvironments", reflect.TypeOf((*MockwsEnvironmentReader)(nil).HasEnvironments))
+}
+
+// ListEnvironments mocks base method.
+func (m *MockwsEnvironmentReader) ListEnvironments() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEnvironments")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // ListEnvironments indicates an expected call of ListEnvironments.
@@ -3936,6 +4462,59 @@ func (mr *MockstackDescriberMockRecorder) Resources() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resources", reflect.TypeOf((*MockstackDescriber)(nil).Resources))
 }
 
+// MockdeployedStackDescriber is a mock of deployedStackDescriber interface.
+type MockdeployedStackDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockdeployedStackDescriberMockRecorder
+}
+
+// MockdeployedStackDescriberMockRecorder is the mock recorder for MockdeployedStackDescriber.
+type MockdeployedStackDescriberMockRecorder struct {
+	mock *MockdeployedStackDescriber
+}
+
+// NewMockdeployedStackDescriber creates a new mock instance.
+func NewMockdeployedStackDescriber(ctrl *gomock.Controller) *MockdeployedStackDescriber {
+	mock := &MockdeployedStackDescriber{ctrl: ctrl}
+	mock.recorder = &MockdeployedStackDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdeployedStackDescriber) EXPECT() *MockdeployedStackDescriberMockRecorder {
+	return m.recorder
+}
+
+// Describe mocks base method.
+func (m *MockdeployedStackDescriber) Describe(name string) (*cloudformation0.StackDescription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Describe", name)
+	ret0, _ := ret[0].(*cloudformation0.StackDescription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Describe indicates an expected call of Describe.
+func (mr *MockdeployedStackDescriberMockRecorder) Describe(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockdeployedStackDescriber)(nil).Describe), name)
+}
+
+// TemplateBody mocks base method.
+func (m *MockdeployedStackDescriber) TemplateBody(name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TemplateBody", name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TemplateBody indicates an expected call of TemplateBody.
+func (mr *MockdeployedStackDescriberMockRecorder) TemplateBody(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TemplateBody", reflect.TypeOf((*MockdeployedStackDescriber)(nil).TemplateBody), name)
+}
+
 // MockenvironmentDeployer is a mock of environmentDeployer interface.
 type MockenvironmentDeployer struct {
 	ctrl     *gomock.Controller
@@ -3960,17 +4539,22 @@ func (m *MockenvironmentDeployer) EXPECT() *MockenvironmentDeployerMockRecorder
 }
 
 // CreateAndRenderEnvironment mocks base method.
-func (m *MockenvironmentDeployer) CreateAndRenderEnvironment(conf cloudformation1.StackConfiguration, bucketARN string) error {
+func (m *MockenvironmentDeployer) CreateAndRenderEnvironment(conf cloudformation1.StackConfiguration, bucketARN string, opts ...cloudformation0.StackOption) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateAndRenderEnvironment", conf, bucketARN)
+	varargs := []interface{}{conf, bucketARN}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateAndRenderEnvironment", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateAndRenderEnvironment indicates an expected call of CreateAndRenderEnvironment.
-func (mr *MockenvironmentDeployerMockRecorder) CreateAndRenderEnvironment(conf, bucketARN interface{}) *gomock.Call {
+func (mr *MockenvironmentDeployerMockRecorder) CreateAndRenderEnvironment(conf, bucketARN interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAndRenderEnvironment", reflect.TypeOf((*MockenvironmentDeployer)(nil).CreateAndRenderEnvironment), conf, bucketARN)
+	varargs := append([]interface{}{conf, bucketARN}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAndRenderEnvironment", reflect.TypeOf((*MockenvironmentDeployer)(nil).CreateAndRenderEnvironment), varargs...)
 }
 
 // DeleteEnvironment mocks base method.
@@ -4217,17 +4801,22 @@ func (mr *MockpipelineDeployerMockRecorder) AddPipelineResourcesToApp(app, regio
 }
 
 // CreatePipeline mocks base method.
-func (m *MockpipelineDeployer) CreatePipeline(bucketName string, stackConfig cloudformation1.StackConfiguration) error {
+func (m *MockpipelineDeployer) CreatePipeline(bucketName string, stackConfig cloudformation1.StackConfiguration, opts ...cloudformation0.StackOption) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreatePipeline", bucketName, stackConfig)
+	varargs := []interface{}{bucketName, stackConfig}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreatePipeline", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreatePipeline indicates an expected call of CreatePipeline.
-func (mr *MockpipelineDeployerMockRecorder) CreatePipeline(bucketName, stackConfig interface{}) *gomock.Call {
+func (mr *MockpipelineDeployerMockRecorder) CreatePipeline(bucketName, stackConfig interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePipeline", reflect.TypeOf((*MockpipelineDeployer)(nil).CreatePipeline), bucketName, stackConfig)
+	varargs := append([]interface{}{bucketName, stackConfig}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePipeline", reflect.TypeOf((*MockpipelineDeployer)(nil).CreatePipeline), varargs...)
 }
 
 // DeletePipeline mocks base method.
@@ -4305,57 +4894,99 @@ func (mr *MockpipelineDeployerMockRecorder) Template(stackName interface{}) *gom
 }
 
 // UpdatePipeline mocks base method.
-func (m *MockpipelineDeployer) UpdatePipeline(bucketName string, stackConfig cloudformation1.StackConfiguration) error {
+func (m *MockpipelineDeployer) UpdatePipeline(bucketName string, stackConfig cloudformation1.StackConfiguration, opts ...cloudformation0.StackOption) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdatePipeline", bucketName, stackConfig)
+	varargs := []interface{}{bucketName, stackConfig}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdatePipeline", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdatePipeline indicates an expected call of UpdatePipeline.
-func (mr *MockpipelineDeployerMockRecorder) UpdatePipeline(bucketName, stackConfig interface{}) *gomock.Call {
+func (mr *MockpipelineDeployerMockRecorder) UpdatePipeline(bucketName, stackConfig interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePipeline", reflect.TypeOf((*MockpipelineDeployer)(nil).UpdatePipeline), bucketName, stackConfig)
+	varargs := append([]interface{}{bucketName, stackConfig}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePipeline", reflect.TypeOf((*MockpipelineDeployer)(nil).UpdatePipeline), varargs...)
 }
 
-// MockappDeployer is a mock of appDeployer interface.
-type MockappDeployer struct {
+// MockartifactKeyGranter is a mock of artifactKeyGranter interface.
+type MockartifactKeyGranter struct {
 	ctrl     *gomock.Controller
-	recorder *MockappDeployerMockRecorder
+	recorder *MockartifactKeyGranterMockRecorder
 }
 
-// MockappDeployerMockRecorder is the mock recorder for MockappDeployer.
-type MockappDeployerMockRecorder struct {
-	mock *MockappDeployer
+// MockartifactKeyGranterMockRecorder is the mock recorder for MockartifactKeyGranter.
+type MockartifactKeyGranterMockRecorder struct {
+	mock *MockartifactKeyGranter
 }
 
-// NewMockappDeployer creates a new mock instance.
-func NewMockappDeployer(ctrl *gomock.Controller) *MockappDeployer {
-	mock := &MockappDeployer{ctrl: ctrl}
-	mock.recorder = &MockappDeployerMockRecorder{mock}
+// NewMockartifactKeyGranter creates a new mock instance.
+func NewMockartifactKeyGranter(ctrl *gomock.Controller) *MockartifactKeyGranter {
+	mock := &MockartifactKeyGranter{ctrl: ctrl}
+	mock.recorder = &MockartifactKeyGranterMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockappDeployer) EXPECT() *MockappDeployerMockRecorder {
+func (m *MockartifactKeyGranter) EXPECT() *MockartifactKeyGranterMockRecorder {
 	return m.recorder
 }
 
-// AddEnvToApp mocks base method.
-func (m *MockappDeployer) AddEnvToApp(opts *cloudformation1.AddEnvToAppOpts) error {
+// GrantDecrypt mocks base method.
+func (m *MockartifactKeyGranter) GrantDecrypt(keyARN, principalARN, name string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddEnvToApp", opts)
+	ret := m.ctrl.Call(m, "GrantDecrypt", keyARN, principalARN, name)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// AddEnvToApp indicates an expected call of AddEnvToApp.
-func (mr *MockappDeployerMockRecorder) AddEnvToApp(opts interface{}) *gomock.Call {
+// GrantDecrypt indicates an expected call of GrantDecrypt.
+func (mr *MockartifactKeyGranterMockRecorder) GrantDecrypt(keyARN, principalARN, name interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEnvToApp", reflect.TypeOf((*MockappDeployer)(nil).AddEnvToApp), opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantDecrypt", reflect.TypeOf((*MockartifactKeyGranter)(nil).GrantDecrypt), keyARN, principalARN, name)
 }
 
-// AddJobToApp mocks base method.
+// MockappDeployer is a mock of appDeployer interface.
+type MockappDeployer struct {
+	ctrl     *gomock.Controller
+	recorder *MockappDeployerMockRecorder
+}
+
+// MockappDeployerMockRecorder is the mock recorder for MockappDeployer.
+type MockappDeployerMockRecorder struct {
+	mock *MockappDeployer
+}
+
+// NewMockappDeployer creates a new mock instance.
+func NewMockappDeployer(ctrl *gomock.Controller) *MockappDeployer {
+	mock := &MockappDeployer{ctrl: ctrl}
+	mock.recorder = &MockappDeployerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockappDeployer) EXPECT() *MockappDeployerMockRecorder {
+	return m.recorder
+}
+
+// AddEnvToApp mocks base method.
+func (m *MockappDeployer) AddEnvToApp(opts *cloudformation1.AddEnvToAppOpts) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddEnvToApp", opts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddEnvToApp indicates an expected call of AddEnvToApp.
+func (mr *MockappDeployerMockRecorder) AddEnvToApp(opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEnvToApp", reflect.TypeOf((*MockappDeployer)(nil).AddEnvToApp), opts)
+}
+
+// AddJobToApp mocks base method.
 func (m *MockappDeployer) AddJobToApp(app *config.Application, jobName string, opts ...cloudformation1.AddWorkloadToAppOpt) error {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{app, jobName}
@@ -4716,6 +5347,81 @@ func (mr *MocktaskRunnerMockRecorder) Run() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MocktaskRunner)(nil).Run))
 }
 
+// MocktaskResultWaiter is a mock of taskResultWaiter interface.
+type MocktaskResultWaiter struct {
+	ctrl     *gomock.Controller
+	recorder *MocktaskResultWaiterMockRecorder
+}
+
+// MocktaskResultWaiterMockRecorder is the mock recorder for MocktaskResultWaiter.
+type MocktaskResultWaiterMockRecorder struct {
+	mock *MocktaskResultWaiter
+}
+
+// NewMocktaskResultWaiter creates a new mock instance.
+func NewMocktaskResultWaiter(ctrl *gomock.Controller) *MocktaskResultWaiter {
+	mock := &MocktaskResultWaiter{ctrl: ctrl}
+	mock.recorder = &MocktaskResultWaiterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktaskResultWaiter) EXPECT() *MocktaskResultWaiterMockRecorder {
+	return m.recorder
+}
+
+// Wait mocks base method.
+func (m *MocktaskResultWaiter) Wait(tasks []*task.Task) (*task.Results, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Wait", tasks)
+	ret0, _ := ret[0].(*task.Results)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Wait indicates an expected call of Wait.
+func (mr *MocktaskResultWaiterMockRecorder) Wait(tasks interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Wait", reflect.TypeOf((*MocktaskResultWaiter)(nil).Wait), tasks)
+}
+
+// MockremoteTaskRunner is a mock of remoteTaskRunner interface.
+type MockremoteTaskRunner struct {
+	ctrl     *gomock.Controller
+	recorder *MockremoteTaskRunnerMockRecorder
+}
+
+// MockremoteTaskRunnerMockRecorder is the mock recorder for MockremoteTaskRunner.
+type MockremoteTaskRunnerMockRecorder struct {
+	mock *MockremoteTaskRunner
+}
+
+// NewMockremoteTaskRunner creates a new mock instance.
+func NewMockremoteTaskRunner(ctrl *gomock.Controller) *MockremoteTaskRunner {
+	mock := &MockremoteTaskRunner{ctrl: ctrl}
+	mock.recorder = &MockremoteTaskRunnerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockremoteTaskRunner) EXPECT() *MockremoteTaskRunnerMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockremoteTaskRunner) Run() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockremoteTaskRunnerMockRecorder) Run() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockremoteTaskRunner)(nil).Run))
+}
+
 // MockdefaultClusterGetter is a mock of defaultClusterGetter interface.
 type MockdefaultClusterGetter struct {
 	ctrl     *gomock.Controller
@@ -4844,31 +5550,41 @@ func (mr *MockdeployerMockRecorder) AddServiceToApp(app, svcName interface{}, op
 }
 
 // CreateAndRenderEnvironment mocks base method.
-func (m *Mockdeployer) CreateAndRenderEnvironment(conf cloudformation1.StackConfiguration, bucketARN string) error {
+func (m *Mockdeployer) CreateAndRenderEnvironment(conf cloudformation1.StackConfiguration, bucketARN string, opts ...cloudformation0.StackOption) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateAndRenderEnvironment", conf, bucketARN)
+	varargs := []interface{}{conf, bucketARN}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateAndRenderEnvironment", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateAndRenderEnvironment indicates an expected call of CreateAndRenderEnvironment.
-func (mr *MockdeployerMockRecorder) CreateAndRenderEnvironment(conf, bucketARN interface{}) *gomock.Call {
+func (mr *MockdeployerMockRecorder) CreateAndRenderEnvironment(conf, bucketARN interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAndRenderEnvironment", reflect.TypeOf((*Mockdeployer)(nil).CreateAndRenderEnvironment), conf, bucketARN)
+	varargs := append([]interface{}{conf, bucketARN}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAndRenderEnvironment", reflect.TypeOf((*Mockdeployer)(nil).CreateAndRenderEnvironment), varargs...)
 }
 
 // CreatePipeline mocks base method.
-func (m *Mockdeployer) CreatePipeline(bucketName string, stackConfig cloudformation1.StackConfiguration) error {
+func (m *Mockdeployer) CreatePipeline(bucketName string, stackConfig cloudformation1.StackConfiguration, opts ...cloudformation0.StackOption) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreatePipeline", bucketName, stackConfig)
+	varargs := []interface{}{bucketName, stackConfig}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreatePipeline", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreatePipeline indicates an expected call of CreatePipeline.
-func (mr *MockdeployerMockRecorder) CreatePipeline(bucketName, stackConfig interface{}) *gomock.Call {
+func (mr *MockdeployerMockRecorder) CreatePipeline(bucketName, stackConfig interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePipeline", reflect.TypeOf((*Mockdeployer)(nil).CreatePipeline), bucketName, stackConfig)
+	varargs := append([]interface{}{bucketName, stackConfig}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePipeline", reflect.TypeOf((*Mockdeployer)(nil).CreatePipeline), varargs...)
 }
 
 // DelegateDNSPermissions mocks base method.
@@ -5046,17 +5762,22 @@ func (mr *MockdeployerMockRecorder) UpdateEnvironmentTemplate(appName, envName,
 }
 
 // UpdatePipeline mocks base method.
-func (m *Mockdeployer) UpdatePipeline(bucketName string, stackConfig cloudformation1.StackConfiguration) error {
+func (m *Mockdeployer) UpdatePipeline(bucketName string, stackConfig cloudformation1.StackConfiguration, opts ...cloudformation0.StackOption) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdatePipeline", bucketName, stackConfig)
+	varargs := []interface{}{bucketName, stackConfig}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdatePipeline", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdatePipeline indicates an expected call of UpdatePipeline.
-func (mr *MockdeployerMockRecorder) UpdatePipeline(bucketName, stackConfig interface{}) *gomock.Call {
+func (mr *MockdeployerMockRecorder) UpdatePipeline(bucketName, stackConfig interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePipeline", reflect.TypeOf((*Mockdeployer)(nil).UpdatePipeline), bucketName, stackConfig)
+	varargs := append([]interface{}{bucketName, stackConfig}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePipeline", reflect.TypeOf((*Mockdeployer)(nil).UpdatePipeline), varargs...)
 }
 
 // MockdomainHostedZoneGetter is a mock of domainHostedZoneGetter interface.
@@ -5249,24 +5970,452 @@ func (m *MockenvDescriber) Manifest() ([]byte, error) {
 	return ret0, ret1
 }
 
-// Manifest indicates an expected call of Manifest.
-func (mr *MockenvDescriberMockRecorder) Manifest() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Manifest", reflect.TypeOf((*MockenvDescriber)(nil).Manifest))
+// Manifest indicates an expected call of Manifest.
+func (mr *MockenvDescriberMockRecorder) Manifest() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Manifest", reflect.TypeOf((*MockenvDescriber)(nil).Manifest))
+}
+
+// ValidateCFServiceDomainAliases mocks base method.
+func (m *MockenvDescriber) ValidateCFServiceDomainAliases() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateCFServiceDomainAliases")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateCFServiceDomainAliases indicates an expected call of ValidateCFServiceDomainAliases.
+func (mr *MockenvDescriberMockRecorder) ValidateCFServiceDomainAliases() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateCFServiceDomainAliases", reflect.TypeOf((*MockenvDescriber)(nil).ValidateCFServiceDomainAliases))
+}
+
+// MockenvCertificatesDescriber is a mock of envCertificatesDescriber interface.
+type MockenvCertificatesDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockenvCertificatesDescriberMockRecorder
+}
+
+// MockenvCertificatesDescriberMockRecorder is the mock recorder for MockenvCertificatesDescriber.
+type MockenvCertificatesDescriberMockRecorder struct {
+	mock *MockenvCertificatesDescriber
+}
+
+// NewMockenvCertificatesDescriber creates a new mock instance.
+func NewMockenvCertificatesDescriber(ctrl *gomock.Controller) *MockenvCertificatesDescriber {
+	mock := &MockenvCertificatesDescriber{ctrl: ctrl}
+	mock.recorder = &MockenvCertificatesDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockenvCertificatesDescriber) EXPECT() *MockenvCertificatesDescriberMockRecorder {
+	return m.recorder
+}
+
+// Describe mocks base method.
+func (m *MockenvCertificatesDescriber) Describe() (*describe.EnvCertificates, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Describe")
+	ret0, _ := ret[0].(*describe.EnvCertificates)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Describe indicates an expected call of Describe.
+func (mr *MockenvCertificatesDescriberMockRecorder) Describe() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockenvCertificatesDescriber)(nil).Describe))
+}
+
+// MockdeadLetterQueuesDescriber is a mock of deadLetterQueuesDescriber interface.
+type MockdeadLetterQueuesDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockdeadLetterQueuesDescriberMockRecorder
+}
+
+// MockdeadLetterQueuesDescriberMockRecorder is the mock recorder for MockdeadLetterQueuesDescriber.
+type MockdeadLetterQueuesDescriberMockRecorder struct {
+	mock *MockdeadLetterQueuesDescriber
+}
+
+// NewMockdeadLetterQueuesDescriber creates a new mock instance.
+func NewMockdeadLetterQueuesDescriber(ctrl *gomock.Controller) *MockdeadLetterQueuesDescriber {
+	mock := &MockdeadLetterQueuesDescriber{ctrl: ctrl}
+	mock.recorder = &MockdeadLetterQueuesDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdeadLetterQueuesDescriber) EXPECT() *MockdeadLetterQueuesDescriberMockRecorder {
+	return m.recorder
+}
+
+// DeadLetterQueues mocks base method.
+func (m *MockdeadLetterQueuesDescriber) DeadLetterQueues() ([]*describe.DeadLetterQueue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeadLetterQueues")
+	ret0, _ := ret[0].([]*describe.DeadLetterQueue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeadLetterQueues indicates an expected call of DeadLetterQueues.
+func (mr *MockdeadLetterQueuesDescriberMockRecorder) DeadLetterQueues() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeadLetterQueues", reflect.TypeOf((*MockdeadLetterQueuesDescriber)(nil).DeadLetterQueues))
+}
+
+// MockmessageMover is a mock of messageMover interface.
+type MockmessageMover struct {
+	ctrl     *gomock.Controller
+	recorder *MockmessageMoverMockRecorder
+}
+
+// MockmessageMoverMockRecorder is the mock recorder for MockmessageMover.
+type MockmessageMoverMockRecorder struct {
+	mock *MockmessageMover
+}
+
+// NewMockmessageMover creates a new mock instance.
+func NewMockmessageMover(ctrl *gomock.Controller) *MockmessageMover {
+	mock := &MockmessageMover{ctrl: ctrl}
+	mock.recorder = &MockmessageMoverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockmessageMover) EXPECT() *MockmessageMoverMockRecorder {
+	return m.recorder
+}
+
+// MoveMessages mocks base method.
+func (m *MockmessageMover) MoveMessages(in sqs.MoveMessagesInput) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveMessages", in)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveMessages indicates an expected call of MoveMessages.
+func (mr *MockmessageMoverMockRecorder) MoveMessages(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveMessages", reflect.TypeOf((*MockmessageMover)(nil).MoveMessages), in)
+}
+
+// MockdeployedManifestGetter is a mock of deployedManifestGetter interface.
+type MockdeployedManifestGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockdeployedManifestGetterMockRecorder
+}
+
+// MockdeployedManifestGetterMockRecorder is the mock recorder for MockdeployedManifestGetter.
+type MockdeployedManifestGetterMockRecorder struct {
+	mock *MockdeployedManifestGetter
+}
+
+// NewMockdeployedManifestGetter creates a new mock instance.
+func NewMockdeployedManifestGetter(ctrl *gomock.Controller) *MockdeployedManifestGetter {
+	mock := &MockdeployedManifestGetter{ctrl: ctrl}
+	mock.recorder = &MockdeployedManifestGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdeployedManifestGetter) EXPECT() *MockdeployedManifestGetterMockRecorder {
+	return m.recorder
+}
+
+// Manifest mocks base method.
+func (m *MockdeployedManifestGetter) Manifest() ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Manifest")
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Manifest indicates an expected call of Manifest.
+func (mr *MockdeployedManifestGetterMockRecorder) Manifest() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Manifest", reflect.TypeOf((*MockdeployedManifestGetter)(nil).Manifest))
+}
+
+// MocktaskDefinitionCleaner is a mock of taskDefinitionCleaner interface.
+type MocktaskDefinitionCleaner struct {
+	ctrl     *gomock.Controller
+	recorder *MocktaskDefinitionCleanerMockRecorder
+}
+
+// MocktaskDefinitionCleanerMockRecorder is the mock recorder for MocktaskDefinitionCleaner.
+type MocktaskDefinitionCleanerMockRecorder struct {
+	mock *MocktaskDefinitionCleaner
+}
+
+// NewMocktaskDefinitionCleaner creates a new mock instance.
+func NewMocktaskDefinitionCleaner(ctrl *gomock.Controller) *MocktaskDefinitionCleaner {
+	mock := &MocktaskDefinitionCleaner{ctrl: ctrl}
+	mock.recorder = &MocktaskDefinitionCleanerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktaskDefinitionCleaner) EXPECT() *MocktaskDefinitionCleanerMockRecorder {
+	return m.recorder
+}
+
+// DeleteTaskDefinitions mocks base method.
+func (m *MocktaskDefinitionCleaner) DeleteTaskDefinitions(arns []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTaskDefinitions", arns)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTaskDefinitions indicates an expected call of DeleteTaskDefinitions.
+func (mr *MocktaskDefinitionCleanerMockRecorder) DeleteTaskDefinitions(arns interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTaskDefinitions", reflect.TypeOf((*MocktaskDefinitionCleaner)(nil).DeleteTaskDefinitions), arns)
+}
+
+// DeregisterTaskDefinitions mocks base method.
+func (m *MocktaskDefinitionCleaner) DeregisterTaskDefinitions(arns []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeregisterTaskDefinitions", arns)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeregisterTaskDefinitions indicates an expected call of DeregisterTaskDefinitions.
+func (mr *MocktaskDefinitionCleanerMockRecorder) DeregisterTaskDefinitions(arns interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterTaskDefinitions", reflect.TypeOf((*MocktaskDefinitionCleaner)(nil).DeregisterTaskDefinitions), arns)
+}
+
+// StaleTaskDefinitions mocks base method.
+func (m *MocktaskDefinitionCleaner) StaleTaskDefinitions(app, env, svc string, keep int) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StaleTaskDefinitions", app, env, svc, keep)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StaleTaskDefinitions indicates an expected call of StaleTaskDefinitions.
+func (mr *MocktaskDefinitionCleanerMockRecorder) StaleTaskDefinitions(app, env, svc, keep interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StaleTaskDefinitions", reflect.TypeOf((*MocktaskDefinitionCleaner)(nil).StaleTaskDefinitions), app, env, svc, keep)
+}
+
+// MockecsTasksDescriber is a mock of ecsTasksDescriber interface.
+type MockecsTasksDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockecsTasksDescriberMockRecorder
+}
+
+// MockecsTasksDescriberMockRecorder is the mock recorder for MockecsTasksDescriber.
+type MockecsTasksDescriberMockRecorder struct {
+	mock *MockecsTasksDescriber
+}
+
+// NewMockecsTasksDescriber creates a new mock instance.
+func NewMockecsTasksDescriber(ctrl *gomock.Controller) *MockecsTasksDescriber {
+	mock := &MockecsTasksDescriber{ctrl: ctrl}
+	mock.recorder = &MockecsTasksDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockecsTasksDescriber) EXPECT() *MockecsTasksDescriberMockRecorder {
+	return m.recorder
+}
+
+// Task mocks base method.
+func (m *MockecsTasksDescriber) Task(id string) (*describe.ECSTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Task", id)
+	ret0, _ := ret[0].(*describe.ECSTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Task indicates an expected call of Task.
+func (mr *MockecsTasksDescriberMockRecorder) Task(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Task", reflect.TypeOf((*MockecsTasksDescriber)(nil).Task), id)
+}
+
+// Tasks mocks base method.
+func (m *MockecsTasksDescriber) Tasks() (*describe.ECSTasks, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Tasks")
+	ret0, _ := ret[0].(*describe.ECSTasks)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Tasks indicates an expected call of Tasks.
+func (mr *MockecsTasksDescriberMockRecorder) Tasks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tasks", reflect.TypeOf((*MockecsTasksDescriber)(nil).Tasks))
+}
+
+// MockserviceCapacityDescriber is a mock of serviceCapacityDescriber interface.
+type MockserviceCapacityDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockserviceCapacityDescriberMockRecorder
+}
+
+// MockserviceCapacityDescriberMockRecorder is the mock recorder for MockserviceCapacityDescriber.
+type MockserviceCapacityDescriberMockRecorder struct {
+	mock *MockserviceCapacityDescriber
+}
+
+// NewMockserviceCapacityDescriber creates a new mock instance.
+func NewMockserviceCapacityDescriber(ctrl *gomock.Controller) *MockserviceCapacityDescriber {
+	mock := &MockserviceCapacityDescriber{ctrl: ctrl}
+	mock.recorder = &MockserviceCapacityDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockserviceCapacityDescriber) EXPECT() *MockserviceCapacityDescriberMockRecorder {
+	return m.recorder
+}
+
+// Describe mocks base method.
+func (m *MockserviceCapacityDescriber) Describe() (*describe.ServiceCapacity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Describe")
+	ret0, _ := ret[0].(*describe.ServiceCapacity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Describe indicates an expected call of Describe.
+func (mr *MockserviceCapacityDescriberMockRecorder) Describe() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockserviceCapacityDescriber)(nil).Describe))
+}
+
+// MockserviceCapacityOverrider is a mock of serviceCapacityOverrider interface.
+type MockserviceCapacityOverrider struct {
+	ctrl     *gomock.Controller
+	recorder *MockserviceCapacityOverriderMockRecorder
+}
+
+// MockserviceCapacityOverriderMockRecorder is the mock recorder for MockserviceCapacityOverrider.
+type MockserviceCapacityOverriderMockRecorder struct {
+	mock *MockserviceCapacityOverrider
+}
+
+// NewMockserviceCapacityOverrider creates a new mock instance.
+func NewMockserviceCapacityOverrider(ctrl *gomock.Controller) *MockserviceCapacityOverrider {
+	mock := &MockserviceCapacityOverrider{ctrl: ctrl}
+	mock.recorder = &MockserviceCapacityOverriderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockserviceCapacityOverrider) EXPECT() *MockserviceCapacityOverriderMockRecorder {
+	return m.recorder
+}
+
+// ECSServiceCapacity mocks base method.
+func (m *MockserviceCapacityOverrider) ECSServiceCapacity(cluster, service string) (*aas.Capacity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ECSServiceCapacity", cluster, service)
+	ret0, _ := ret[0].(*aas.Capacity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ECSServiceCapacity indicates an expected call of ECSServiceCapacity.
+func (mr *MockserviceCapacityOverriderMockRecorder) ECSServiceCapacity(cluster, service interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ECSServiceCapacity", reflect.TypeOf((*MockserviceCapacityOverrider)(nil).ECSServiceCapacity), cluster, service)
+}
+
+// ECSServiceScheduledAction mocks base method.
+func (m *MockserviceCapacityOverrider) ECSServiceScheduledAction(cluster, service, name string) (*aas.ScheduledAction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ECSServiceScheduledAction", cluster, service, name)
+	ret0, _ := ret[0].(*aas.ScheduledAction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ECSServiceScheduledAction indicates an expected call of ECSServiceScheduledAction.
+func (mr *MockserviceCapacityOverriderMockRecorder) ECSServiceScheduledAction(cluster, service, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ECSServiceScheduledAction", reflect.TypeOf((*MockserviceCapacityOverrider)(nil).ECSServiceScheduledAction), cluster, service, name)
+}
+
+// ScheduleECSServiceCapacity mocks base method.
+func (m *MockserviceCapacityOverrider) ScheduleECSServiceCapacity(cluster, service string, action aas.ScheduledAction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleECSServiceCapacity", cluster, service, action)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ScheduleECSServiceCapacity indicates an expected call of ScheduleECSServiceCapacity.
+func (mr *MockserviceCapacityOverriderMockRecorder) ScheduleECSServiceCapacity(cluster, service, action interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleECSServiceCapacity", reflect.TypeOf((*MockserviceCapacityOverrider)(nil).ScheduleECSServiceCapacity), cluster, service, action)
+}
+
+// SetECSServiceCapacity mocks base method.
+func (m *MockserviceCapacityOverrider) SetECSServiceCapacity(cluster, service string, capacity aas.Capacity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetECSServiceCapacity", cluster, service, capacity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetECSServiceCapacity indicates an expected call of SetECSServiceCapacity.
+func (mr *MockserviceCapacityOverriderMockRecorder) SetECSServiceCapacity(cluster, service, capacity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetECSServiceCapacity", reflect.TypeOf((*MockserviceCapacityOverrider)(nil).SetECSServiceCapacity), cluster, service, capacity)
+}
+
+// MockcapacityRevertGetter is a mock of capacityRevertGetter interface.
+type MockcapacityRevertGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockcapacityRevertGetterMockRecorder
+}
+
+// MockcapacityRevertGetterMockRecorder is the mock recorder for MockcapacityRevertGetter.
+type MockcapacityRevertGetterMockRecorder struct {
+	mock *MockcapacityRevertGetter
+}
+
+// NewMockcapacityRevertGetter creates a new mock instance.
+func NewMockcapacityRevertGetter(ctrl *gomock.Controller) *MockcapacityRevertGetter {
+	mock := &MockcapacityRevertGetter{ctrl: ctrl}
+	mock.recorder = &MockcapacityRevertGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcapacityRevertGetter) EXPECT() *MockcapacityRevertGetterMockRecorder {
+	return m.recorder
 }
 
-// ValidateCFServiceDomainAliases mocks base method.
-func (m *MockenvDescriber) ValidateCFServiceDomainAliases() error {
+// ECSServiceScheduledAction mocks base method.
+func (m *MockcapacityRevertGetter) ECSServiceScheduledAction(cluster, service, name string) (*aas.ScheduledAction, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ValidateCFServiceDomainAliases")
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ECSServiceScheduledAction", cluster, service, name)
+	ret0, _ := ret[0].(*aas.ScheduledAction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// ValidateCFServiceDomainAliases indicates an expected call of ValidateCFServiceDomainAliases.
-func (mr *MockenvDescriberMockRecorder) ValidateCFServiceDomainAliases() *gomock.Call {
+// ECSServiceScheduledAction indicates an expected call of ECSServiceScheduledAction.
+func (mr *MockcapacityRevertGetterMockRecorder) ECSServiceScheduledAction(cluster, service, name interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateCFServiceDomainAliases", reflect.TypeOf((*MockenvDescriber)(nil).ValidateCFServiceDomainAliases))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ECSServiceScheduledAction", reflect.TypeOf((*MockcapacityRevertGetter)(nil).ECSServiceScheduledAction), cluster, service, name)
 }
 
 // MockversionCompatibilityChecker is a mock of versionCompatibilityChecker interface.
@@ -5360,6 +6509,82 @@ func (mr *MockversionGetterMockRecorder) Version() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Version", reflect.TypeOf((*MockversionGetter)(nil).Version))
 }
 
+// MockenvOutputsGetter is a mock of envOutputsGetter interface.
+type MockenvOutputsGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockenvOutputsGetterMockRecorder
+}
+
+// MockenvOutputsGetterMockRecorder is the mock recorder for MockenvOutputsGetter.
+type MockenvOutputsGetterMockRecorder struct {
+	mock *MockenvOutputsGetter
+}
+
+// NewMockenvOutputsGetter creates a new mock instance.
+func NewMockenvOutputsGetter(ctrl *gomock.Controller) *MockenvOutputsGetter {
+	mock := &MockenvOutputsGetter{ctrl: ctrl}
+	mock.recorder = &MockenvOutputsGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockenvOutputsGetter) EXPECT() *MockenvOutputsGetterMockRecorder {
+	return m.recorder
+}
+
+// Outputs mocks base method.
+func (m *MockenvOutputsGetter) Outputs() (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Outputs")
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Outputs indicates an expected call of Outputs.
+func (mr *MockenvOutputsGetterMockRecorder) Outputs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Outputs", reflect.TypeOf((*MockenvOutputsGetter)(nil).Outputs))
+}
+
+// MockbudgetDescriber is a mock of budgetDescriber interface.
+type MockbudgetDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockbudgetDescriberMockRecorder
+}
+
+// MockbudgetDescriberMockRecorder is the mock recorder for MockbudgetDescriber.
+type MockbudgetDescriberMockRecorder struct {
+	mock *MockbudgetDescriber
+}
+
+// NewMockbudgetDescriber creates a new mock instance.
+func NewMockbudgetDescriber(ctrl *gomock.Controller) *MockbudgetDescriber {
+	mock := &MockbudgetDescriber{ctrl: ctrl}
+	mock.recorder = &MockbudgetDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockbudgetDescriber) EXPECT() *MockbudgetDescriberMockRecorder {
+	return m.recorder
+}
+
+// Budget mocks base method.
+func (m *MockbudgetDescriber) Budget(accountID, name string) (*budgets.Budget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Budget", accountID, name)
+	ret0, _ := ret[0].(*budgets.Budget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Budget indicates an expected call of Budget.
+func (mr *MockbudgetDescriberMockRecorder) Budget(accountID, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Budget", reflect.TypeOf((*MockbudgetDescriber)(nil).Budget), accountID, name)
+}
+
 // MockappUpgrader is a mock of appUpgrader interface.
 type MockappUpgrader struct {
 	ctrl     *gomock.Controller
@@ -6888,6 +8113,138 @@ func (mr *MockecsCommandExecutorMockRecorder) ExecuteCommand(in interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteCommand", reflect.TypeOf((*MockecsCommandExecutor)(nil).ExecuteCommand), in)
 }
 
+// MockdbEndpointDescriber is a mock of dbEndpointDescriber interface.
+type MockdbEndpointDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockdbEndpointDescriberMockRecorder
+}
+
+// MockdbEndpointDescriberMockRecorder is the mock recorder for MockdbEndpointDescriber.
+type MockdbEndpointDescriberMockRecorder struct {
+	mock *MockdbEndpointDescriber
+}
+
+// NewMockdbEndpointDescriber creates a new mock instance.
+func NewMockdbEndpointDescriber(ctrl *gomock.Controller) *MockdbEndpointDescriber {
+	mock := &MockdbEndpointDescriber{ctrl: ctrl}
+	mock.recorder = &MockdbEndpointDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdbEndpointDescriber) EXPECT() *MockdbEndpointDescriberMockRecorder {
+	return m.recorder
+}
+
+// Endpoint mocks base method.
+func (m *MockdbEndpointDescriber) Endpoint(identifier string) (*rds.Endpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Endpoint", identifier)
+	ret0, _ := ret[0].(*rds.Endpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Endpoint indicates an expected call of Endpoint.
+func (mr *MockdbEndpointDescriberMockRecorder) Endpoint(identifier interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Endpoint", reflect.TypeOf((*MockdbEndpointDescriber)(nil).Endpoint), identifier)
+}
+
+// MockportForwarder is a mock of portForwarder interface.
+type MockportForwarder struct {
+	ctrl     *gomock.Controller
+	recorder *MockportForwarderMockRecorder
+}
+
+// MockportForwarderMockRecorder is the mock recorder for MockportForwarder.
+type MockportForwarderMockRecorder struct {
+	mock *MockportForwarder
+}
+
+// NewMockportForwarder creates a new mock instance.
+func NewMockportForwarder(ctrl *gomock.Controller) *MockportForwarder {
+	mock := &MockportForwarder{ctrl: ctrl}
+	mock.recorder = &MockportForwarderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockportForwarder) EXPECT() *MockportForwarderMockRecorder {
+	return m.recorder
+}
+
+// StartPortForwardingSession mocks base method.
+func (m *MockportForwarder) StartPortForwardingSession(in ssm.PortForwardingSessionInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartPortForwardingSession", in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartPortForwardingSession indicates an expected call of StartPortForwardingSession.
+func (mr *MockportForwarderMockRecorder) StartPortForwardingSession(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartPortForwardingSession", reflect.TypeOf((*MockportForwarder)(nil).StartPortForwardingSession), in)
+}
+
+// MockjumpHostTaskManager is a mock of jumpHostTaskManager interface.
+type MockjumpHostTaskManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockjumpHostTaskManagerMockRecorder
+}
+
+// MockjumpHostTaskManagerMockRecorder is the mock recorder for MockjumpHostTaskManager.
+type MockjumpHostTaskManagerMockRecorder struct {
+	mock *MockjumpHostTaskManager
+}
+
+// NewMockjumpHostTaskManager creates a new mock instance.
+func NewMockjumpHostTaskManager(ctrl *gomock.Controller) *MockjumpHostTaskManager {
+	mock := &MockjumpHostTaskManager{ctrl: ctrl}
+	mock.recorder = &MockjumpHostTaskManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockjumpHostTaskManager) EXPECT() *MockjumpHostTaskManagerMockRecorder {
+	return m.recorder
+}
+
+// DescribeTasks mocks base method.
+func (m *MockjumpHostTaskManager) DescribeTasks(cluster string, taskARNs []string) ([]*ecs.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeTasks", cluster, taskARNs)
+	ret0, _ := ret[0].([]*ecs.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTasks indicates an expected call of DescribeTasks.
+func (mr *MockjumpHostTaskManagerMockRecorder) DescribeTasks(cluster, taskARNs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTasks", reflect.TypeOf((*MockjumpHostTaskManager)(nil).DescribeTasks), cluster, taskARNs)
+}
+
+// StopTasks mocks base method.
+func (m *MockjumpHostTaskManager) StopTasks(tasks []string, opts ...ecs.StopTasksOpts) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{tasks}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StopTasks", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StopTasks indicates an expected call of StopTasks.
+func (mr *MockjumpHostTaskManagerMockRecorder) StopTasks(tasks interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{tasks}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopTasks", reflect.TypeOf((*MockjumpHostTaskManager)(nil).StopTasks), varargs...)
+}
+
 // MockssmPluginManager is a mock of ssmPluginManager interface.
 type MockssmPluginManager struct {
 	ctrl     *gomock.Controller
@@ -7183,6 +8540,173 @@ func (mr *MockstackExistCheckerMockRecorder) Exists(arg0 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockstackExistChecker)(nil).Exists), arg0)
 }
 
+// MockpermissionSimulator is a mock of permissionSimulator interface.
+type MockpermissionSimulator struct {
+	ctrl     *gomock.Controller
+	recorder *MockpermissionSimulatorMockRecorder
+}
+
+// MockpermissionSimulatorMockRecorder is the mock recorder for MockpermissionSimulator.
+type MockpermissionSimulatorMockRecorder struct {
+	mock *MockpermissionSimulator
+}
+
+// NewMockpermissionSimulator creates a new mock instance.
+func NewMockpermissionSimulator(ctrl *gomock.Controller) *MockpermissionSimulator {
+	mock := &MockpermissionSimulator{ctrl: ctrl}
+	mock.recorder = &MockpermissionSimulatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpermissionSimulator) EXPECT() *MockpermissionSimulatorMockRecorder {
+	return m.recorder
+}
+
+// SimulatePolicy mocks base method.
+func (m *MockpermissionSimulator) SimulatePolicy(callerARN string, sim iam.PolicySimulation) ([]iam.DeniedAction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SimulatePolicy", callerARN, sim)
+	ret0, _ := ret[0].([]iam.DeniedAction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SimulatePolicy indicates an expected call of SimulatePolicy.
+func (mr *MockpermissionSimulatorMockRecorder) SimulatePolicy(callerARN, sim interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SimulatePolicy", reflect.TypeOf((*MockpermissionSimulator)(nil).SimulatePolicy), callerARN, sim)
+}
+
+// MockquotaGetter is a mock of quotaGetter interface.
+type MockquotaGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockquotaGetterMockRecorder
+}
+
+// MockquotaGetterMockRecorder is the mock recorder for MockquotaGetter.
+type MockquotaGetterMockRecorder struct {
+	mock *MockquotaGetter
+}
+
+// NewMockquotaGetter creates a new mock instance.
+func NewMockquotaGetter(ctrl *gomock.Controller) *MockquotaGetter {
+	mock := &MockquotaGetter{ctrl: ctrl}
+	mock.recorder = &MockquotaGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockquotaGetter) EXPECT() *MockquotaGetterMockRecorder {
+	return m.recorder
+}
+
+// Value mocks base method.
+func (m *MockquotaGetter) Value(serviceCode, quotaCode string) (float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Value", serviceCode, quotaCode)
+	ret0, _ := ret[0].(float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Value indicates an expected call of Value.
+func (mr *MockquotaGetterMockRecorder) Value(serviceCode, quotaCode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Value", reflect.TypeOf((*MockquotaGetter)(nil).Value), serviceCode, quotaCode)
+}
+
+// MockenvNetworkCounter is a mock of envNetworkCounter interface.
+type MockenvNetworkCounter struct {
+	ctrl     *gomock.Controller
+	recorder *MockenvNetworkCounterMockRecorder
+}
+
+// MockenvNetworkCounterMockRecorder is the mock recorder for MockenvNetworkCounter.
+type MockenvNetworkCounterMockRecorder struct {
+	mock *MockenvNetworkCounter
+}
+
+// NewMockenvNetworkCounter creates a new mock instance.
+func NewMockenvNetworkCounter(ctrl *gomock.Controller) *MockenvNetworkCounter {
+	mock := &MockenvNetworkCounter{ctrl: ctrl}
+	mock.recorder = &MockenvNetworkCounterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockenvNetworkCounter) EXPECT() *MockenvNetworkCounterMockRecorder {
+	return m.recorder
+}
+
+// ElasticIPCount mocks base method.
+func (m *MockenvNetworkCounter) ElasticIPCount() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ElasticIPCount")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ElasticIPCount indicates an expected call of ElasticIPCount.
+func (mr *MockenvNetworkCounterMockRecorder) ElasticIPCount() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ElasticIPCount", reflect.TypeOf((*MockenvNetworkCounter)(nil).ElasticIPCount))
+}
+
+// ListVPCs mocks base method.
+func (m *MockenvNetworkCounter) ListVPCs() ([]ec2.VPC, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVPCs")
+	ret0, _ := ret[0].([]ec2.VPC)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVPCs indicates an expected call of ListVPCs.
+func (mr *MockenvNetworkCounterMockRecorder) ListVPCs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVPCs", reflect.TypeOf((*MockenvNetworkCounter)(nil).ListVPCs))
+}
+
+// MockalbCounter is a mock of albCounter interface.
+type MockalbCounter struct {
+	ctrl     *gomock.Controller
+	recorder *MockalbCounterMockRecorder
+}
+
+// MockalbCounterMockRecorder is the mock recorder for MockalbCounter.
+type MockalbCounterMockRecorder struct {
+	mock *MockalbCounter
+}
+
+// NewMockalbCounter creates a new mock instance.
+func NewMockalbCounter(ctrl *gomock.Controller) *MockalbCounter {
+	mock := &MockalbCounter{ctrl: ctrl}
+	mock.recorder = &MockalbCounterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockalbCounter) EXPECT() *MockalbCounterMockRecorder {
+	return m.recorder
+}
+
+// ApplicationLoadBalancerCount mocks base method.
+func (m *MockalbCounter) ApplicationLoadBalancerCount() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplicationLoadBalancerCount")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplicationLoadBalancerCount indicates an expected call of ApplicationLoadBalancerCount.
+func (mr *MockalbCounterMockRecorder) ApplicationLoadBalancerCount() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplicationLoadBalancerCount", reflect.TypeOf((*MockalbCounter)(nil).ApplicationLoadBalancerCount))
+}
+
 // MockrunningTaskSelector is a mock of runningTaskSelector interface.
 type MockrunningTaskSelector struct {
 	ctrl     *gomock.Controller
@@ -7209,21 +8733,74 @@ func (m *MockrunningTaskSelector) EXPECT() *MockrunningTaskSelectorMockRecorder
 // RunningTask mocks base method.
 func (m *MockrunningTaskSelector) RunningTask(prompt, help string, opts ...selector.TaskOpts) (*ecs.Task, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{prompt, help}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "RunningTask", varargs...)
+	varargs := []interface{}{prompt, help}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunningTask", varargs...)
+	ret0, _ := ret[0].(*ecs.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunningTask indicates an expected call of RunningTask.
+func (mr *MockrunningTaskSelectorMockRecorder) RunningTask(prompt, help interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{prompt, help}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunningTask", reflect.TypeOf((*MockrunningTaskSelector)(nil).RunningTask), varargs...)
+}
+
+// MockcontainerSelector is a mock of containerSelector interface.
+type MockcontainerSelector struct {
+	ctrl     *gomock.Controller
+	recorder *MockcontainerSelectorMockRecorder
+}
+
+// MockcontainerSelectorMockRecorder is the mock recorder for MockcontainerSelector.
+type MockcontainerSelectorMockRecorder struct {
+	mock *MockcontainerSelector
+}
+
+// NewMockcontainerSelector creates a new mock instance.
+func NewMockcontainerSelector(ctrl *gomock.Controller) *MockcontainerSelector {
+	mock := &MockcontainerSelector{ctrl: ctrl}
+	mock.recorder = &MockcontainerSelectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcontainerSelector) EXPECT() *MockcontainerSelectorMockRecorder {
+	return m.recorder
+}
+
+// Container mocks base method.
+func (m *MockcontainerSelector) Container(prompt, help string, task *ecs.Task, mainContainer string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Container", prompt, help, task, mainContainer)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Container indicates an expected call of Container.
+func (mr *MockcontainerSelectorMockRecorder) Container(prompt, help, task, mainContainer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Container", reflect.TypeOf((*MockcontainerSelector)(nil).Container), prompt, help, task, mainContainer)
+}
+
+// Task mocks base method.
+func (m *MockcontainerSelector) Task(prompt, help string, tasks []*ecs.Task) (*ecs.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Task", prompt, help, tasks)
 	ret0, _ := ret[0].(*ecs.Task)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RunningTask indicates an expected call of RunningTask.
-func (mr *MockrunningTaskSelectorMockRecorder) RunningTask(prompt, help interface{}, opts ...interface{}) *gomock.Call {
+// Task indicates an expected call of Task.
+func (mr *MockcontainerSelectorMockRecorder) Task(prompt, help, tasks interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{prompt, help}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunningTask", reflect.TypeOf((*MockrunningTaskSelector)(nil).RunningTask), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Task", reflect.TypeOf((*MockcontainerSelector)(nil).Task), prompt, help, tasks)
 }
 
 // MockdockerEngine is a mock of dockerEngine interface.
@@ -7530,18 +9107,23 @@ func (m *MockworkloadDeployer) EXPECT() *MockworkloadDeployerMockRecorder {
 }
 
 // DeployDiff mocks base method.
-func (m *MockworkloadDeployer) DeployDiff(inTmpl string) (string, error) {
+func (m *MockworkloadDeployer) DeployDiff(inTmpl string, opts ...diff.WriteOption) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeployDiff", inTmpl)
+	varargs := []interface{}{inTmpl}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeployDiff", varargs...)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeployDiff indicates an expected call of DeployDiff.
-func (mr *MockworkloadDeployerMockRecorder) DeployDiff(inTmpl interface{}) *gomock.Call {
+func (mr *MockworkloadDeployerMockRecorder) DeployDiff(inTmpl interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployDiff", reflect.TypeOf((*MockworkloadDeployer)(nil).DeployDiff), inTmpl)
+	varargs := append([]interface{}{inTmpl}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployDiff", reflect.TypeOf((*MockworkloadDeployer)(nil).DeployDiff), varargs...)
 }
 
 // DeployWorkload mocks base method.
@@ -7628,18 +9210,23 @@ func (m *MocktemplateDiffer) EXPECT() *MocktemplateDifferMockRecorder {
 }
 
 // DeployDiff mocks base method.
-func (m *MocktemplateDiffer) DeployDiff(inTmpl string) (string, error) {
+func (m *MocktemplateDiffer) DeployDiff(inTmpl string, opts ...diff.WriteOption) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeployDiff", inTmpl)
+	varargs := []interface{}{inTmpl}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeployDiff", varargs...)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeployDiff indicates an expected call of DeployDiff.
-func (mr *MocktemplateDifferMockRecorder) DeployDiff(inTmpl interface{}) *gomock.Call {
+func (mr *MocktemplateDifferMockRecorder) DeployDiff(inTmpl interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployDiff", reflect.TypeOf((*MocktemplateDiffer)(nil).DeployDiff), inTmpl)
+	varargs := append([]interface{}{inTmpl}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployDiff", reflect.TypeOf((*MocktemplateDiffer)(nil).DeployDiff), varargs...)
 }
 
 // MockdockerEngineRunner is a mock of dockerEngineRunner interface.
@@ -7838,18 +9425,23 @@ func (mr *MockworkloadStackGeneratorMockRecorder) AddonsTemplate() *gomock.Call
 }
 
 // DeployDiff mocks base method.
-func (m *MockworkloadStackGenerator) DeployDiff(inTmpl string) (string, error) {
+func (m *MockworkloadStackGenerator) DeployDiff(inTmpl string, opts ...diff.WriteOption) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeployDiff", inTmpl)
+	varargs := []interface{}{inTmpl}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeployDiff", varargs...)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeployDiff indicates an expected call of DeployDiff.
-func (mr *MockworkloadStackGeneratorMockRecorder) DeployDiff(inTmpl interface{}) *gomock.Call {
+func (mr *MockworkloadStackGeneratorMockRecorder) DeployDiff(inTmpl interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployDiff", reflect.TypeOf((*MockworkloadStackGenerator)(nil).DeployDiff), inTmpl)
+	varargs := append([]interface{}{inTmpl}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployDiff", reflect.TypeOf((*MockworkloadStackGenerator)(nil).DeployDiff), varargs...)
 }
 
 // GenerateCloudFormationTemplate mocks base method.
@@ -7943,18 +9535,23 @@ func (m *MockenvDeployer) EXPECT() *MockenvDeployerMockRecorder {
 }
 
 // DeployDiff mocks base method.
-func (m *MockenvDeployer) DeployDiff(inTmpl string) (string, error) {
+func (m *MockenvDeployer) DeployDiff(inTmpl string, opts ...diff.WriteOption) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeployDiff", inTmpl)
+	varargs := []interface{}{inTmpl}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeployDiff", varargs...)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeployDiff indicates an expected call of DeployDiff.
-func (mr *MockenvDeployerMockRecorder) DeployDiff(inTmpl interface{}) *gomock.Call {
+func (mr *MockenvDeployerMockRecorder) DeployDiff(inTmpl interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployDiff", reflect.TypeOf((*MockenvDeployer)(nil).DeployDiff), inTmpl)
+	varargs := append([]interface{}{inTmpl}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployDiff", reflect.TypeOf((*MockenvDeployer)(nil).DeployDiff), varargs...)
 }
 
 // DeployEnvironment mocks base method.
@@ -7986,6 +9583,21 @@ func (mr *MockenvDeployerMockRecorder) GenerateCloudFormationTemplate(in interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateCloudFormationTemplate", reflect.TypeOf((*MockenvDeployer)(nil).GenerateCloudFormationTemplate), in)
 }
 
+// NetworkMigrationPlan mocks base method.
+func (m *MockenvDeployer) NetworkMigrationPlan(arg0 *manifest.Environment) (*deploy.NetworkMigrationPlan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NetworkMigrationPlan", arg0)
+	ret0, _ := ret[0].(*deploy.NetworkMigrationPlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NetworkMigrationPlan indicates an expected call of NetworkMigrationPlan.
+func (mr *MockenvDeployerMockRecorder) NetworkMigrationPlan(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NetworkMigrationPlan", reflect.TypeOf((*MockenvDeployer)(nil).NetworkMigrationPlan), arg0)
+}
+
 // UploadArtifacts mocks base method.
 func (m *MockenvDeployer) UploadArtifacts() (*deploy.UploadEnvArtifactsOutput, error) {
 	m.ctrl.T.Helper()
@@ -8054,18 +9666,23 @@ func (mr *MockenvPackagerMockRecorder) AddonsTemplate() *gomock.Call {
 }
 
 // DeployDiff mocks base method.
-func (m *MockenvPackager) DeployDiff(inTmpl string) (string, error) {
+func (m *MockenvPackager) DeployDiff(inTmpl string, opts ...diff.WriteOption) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeployDiff", inTmpl)
+	varargs := []interface{}{inTmpl}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeployDiff", varargs...)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeployDiff indicates an expected call of DeployDiff.
-func (mr *MockenvPackagerMockRecorder) DeployDiff(inTmpl interface{}) *gomock.Call {
+func (mr *MockenvPackagerMockRecorder) DeployDiff(inTmpl interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployDiff", reflect.TypeOf((*MockenvPackager)(nil).DeployDiff), inTmpl)
+	varargs := append([]interface{}{inTmpl}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployDiff", reflect.TypeOf((*MockenvPackager)(nil).DeployDiff), varargs...)
 }
 
 // GenerateCloudFormationTemplate mocks base method.
@@ -8282,3 +9899,388 @@ func (mr *MockdockerWorkloadMockRecorder) Dockerfile() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Dockerfile", reflect.TypeOf((*MockdockerWorkload)(nil).Dockerfile))
 }
+
+// MockauditLogWriter is a mock of auditLogWriter interface.
+type MockauditLogWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockauditLogWriterMockRecorder
+}
+
+// MockauditLogWriterMockRecorder is the mock recorder for MockauditLogWriter.
+type MockauditLogWriterMockRecorder struct {
+	mock *MockauditLogWriter
+}
+
+// NewMockauditLogWriter creates a new mock instance.
+func NewMockauditLogWriter(ctrl *gomock.Controller) *MockauditLogWriter {
+	mock := &MockauditLogWriter{ctrl: ctrl}
+	mock.recorder = &MockauditLogWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockauditLogWriter) EXPECT() *MockauditLogWriterMockRecorder {
+	return m.recorder
+}
+
+// Write mocks base method.
+func (m *MockauditLogWriter) Write(entry audit.Entry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Write", entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Write indicates an expected call of Write.
+func (mr *MockauditLogWriterMockRecorder) Write(entry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockauditLogWriter)(nil).Write), entry)
+}
+
+// MockauditLogReader is a mock of auditLogReader interface.
+type MockauditLogReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockauditLogReaderMockRecorder
+}
+
+// MockauditLogReaderMockRecorder is the mock recorder for MockauditLogReader.
+type MockauditLogReaderMockRecorder struct {
+	mock *MockauditLogReader
+}
+
+// NewMockauditLogReader creates a new mock instance.
+func NewMockauditLogReader(ctrl *gomock.Controller) *MockauditLogReader {
+	mock := &MockauditLogReader{ctrl: ctrl}
+	mock.recorder = &MockauditLogReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockauditLogReader) EXPECT() *MockauditLogReaderMockRecorder {
+	return m.recorder
+}
+
+// Entries mocks base method.
+func (m *MockauditLogReader) Entries(limit int) ([]audit.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Entries", limit)
+	ret0, _ := ret[0].([]audit.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Entries indicates an expected call of Entries.
+func (mr *MockauditLogReaderMockRecorder) Entries(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Entries", reflect.TypeOf((*MockauditLogReader)(nil).Entries), limit)
+}
+
+// MockauditLog is a mock of auditLog interface.
+type MockauditLog struct {
+	ctrl     *gomock.Controller
+	recorder *MockauditLogMockRecorder
+}
+
+// MockauditLogMockRecorder is the mock recorder for MockauditLog.
+type MockauditLogMockRecorder struct {
+	mock *MockauditLog
+}
+
+// NewMockauditLog creates a new mock instance.
+func NewMockauditLog(ctrl *gomock.Controller) *MockauditLog {
+	mock := &MockauditLog{ctrl: ctrl}
+	mock.recorder = &MockauditLogMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockauditLog) EXPECT() *MockauditLogMockRecorder {
+	return m.recorder
+}
+
+// Entries mocks base method.
+func (m *MockauditLog) Entries(limit int) ([]audit.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Entries", limit)
+	ret0, _ := ret[0].([]audit.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Entries indicates an expected call of Entries.
+func (mr *MockauditLogMockRecorder) Entries(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Entries", reflect.TypeOf((*MockauditLog)(nil).Entries), limit)
+}
+
+// Write mocks base method.
+func (m *MockauditLog) Write(entry audit.Entry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Write", entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Write indicates an expected call of Write.
+func (mr *MockauditLogMockRecorder) Write(entry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockauditLog)(nil).Write), entry)
+}
+
+// MockpluginFinder is a mock of pluginFinder interface.
+type MockpluginFinder struct {
+	ctrl     *gomock.Controller
+	recorder *MockpluginFinderMockRecorder
+}
+
+// MockpluginFinderMockRecorder is the mock recorder for MockpluginFinder.
+type MockpluginFinderMockRecorder struct {
+	mock *MockpluginFinder
+}
+
+// NewMockpluginFinder creates a new mock instance.
+func NewMockpluginFinder(ctrl *gomock.Controller) *MockpluginFinder {
+	mock := &MockpluginFinder{ctrl: ctrl}
+	mock.recorder = &MockpluginFinderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpluginFinder) EXPECT() *MockpluginFinderMockRecorder {
+	return m.recorder
+}
+
+// Find mocks base method.
+func (m *MockpluginFinder) Find(name string) (*plugin.Plugin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", name)
+	ret0, _ := ret[0].(*plugin.Plugin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockpluginFinderMockRecorder) Find(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockpluginFinder)(nil).Find), name)
+}
+
+// MockpluginLister is a mock of pluginLister interface.
+type MockpluginLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockpluginListerMockRecorder
+}
+
+// MockpluginListerMockRecorder is the mock recorder for MockpluginLister.
+type MockpluginListerMockRecorder struct {
+	mock *MockpluginLister
+}
+
+// NewMockpluginLister creates a new mock instance.
+func NewMockpluginLister(ctrl *gomock.Controller) *MockpluginLister {
+	mock := &MockpluginLister{ctrl: ctrl}
+	mock.recorder = &MockpluginListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpluginLister) EXPECT() *MockpluginListerMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockpluginLister) List() ([]plugin.Plugin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List")
+	ret0, _ := ret[0].([]plugin.Plugin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockpluginListerMockRecorder) List() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockpluginLister)(nil).List))
+}
+
+// MockrulePrioritiesDescriber is a mock of rulePrioritiesDescriber interface.
+type MockrulePrioritiesDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockrulePrioritiesDescriberMockRecorder
+}
+
+// MockrulePrioritiesDescriberMockRecorder is the mock recorder for MockrulePrioritiesDescriber.
+type MockrulePrioritiesDescriberMockRecorder struct {
+	mock *MockrulePrioritiesDescriber
+}
+
+// NewMockrulePrioritiesDescriber creates a new mock instance.
+func NewMockrulePrioritiesDescriber(ctrl *gomock.Controller) *MockrulePrioritiesDescriber {
+	mock := &MockrulePrioritiesDescriber{ctrl: ctrl}
+	mock.recorder = &MockrulePrioritiesDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockrulePrioritiesDescriber) EXPECT() *MockrulePrioritiesDescriberMockRecorder {
+	return m.recorder
+}
+
+// Describe mocks base method.
+func (m *MockrulePrioritiesDescriber) Describe() (*describe.RulePriorities, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Describe")
+	ret0, _ := ret[0].(*describe.RulePriorities)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Describe indicates an expected call of Describe.
+func (mr *MockrulePrioritiesDescriberMockRecorder) Describe() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockrulePrioritiesDescriber)(nil).Describe))
+}
+
+// MockwsWorkloadManifestOverwriter is a mock of wsWorkloadManifestOverwriter interface.
+type MockwsWorkloadManifestOverwriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsWorkloadManifestOverwriterMockRecorder
+}
+
+// MockwsWorkloadManifestOverwriterMockRecorder is the mock recorder for MockwsWorkloadManifestOverwriter.
+type MockwsWorkloadManifestOverwriterMockRecorder struct {
+	mock *MockwsWorkloadManifestOverwriter
+}
+
+// NewMockwsWorkloadManifestOverwriter creates a new mock instance.
+func NewMockwsWorkloadManifestOverwriter(ctrl *gomock.Controller) *MockwsWorkloadManifestOverwriter {
+	mock := &MockwsWorkloadManifestOverwriter{ctrl: ctrl}
+	mock.recorder = &MockwsWorkloadManifestOverwriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwsWorkloadManifestOverwriter) EXPECT() *MockwsWorkloadManifestOverwriterMockRecorder {
+	return m.recorder
+}
+
+// ListWorkloads mocks base method.
+func (m *MockwsWorkloadManifestOverwriter) ListWorkloads() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkloads")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkloads indicates an expected call of ListWorkloads.
+func (mr *MockwsWorkloadManifestOverwriterMockRecorder) ListWorkloads() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkloads", reflect.TypeOf((*MockwsWorkloadManifestOverwriter)(nil).ListWorkloads))
+}
+
+// OverwriteWorkloadManifest mocks base method.
+func (m *MockwsWorkloadManifestOverwriter) OverwriteWorkloadManifest(data []byte, name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OverwriteWorkloadManifest", data, name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OverwriteWorkloadManifest indicates an expected call of OverwriteWorkloadManifest.
+func (mr *MockwsWorkloadManifestOverwriterMockRecorder) OverwriteWorkloadManifest(data, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OverwriteWorkloadManifest", reflect.TypeOf((*MockwsWorkloadManifestOverwriter)(nil).OverwriteWorkloadManifest), data, name)
+}
+
+// ReadWorkloadManifest mocks base method.
+func (m *MockwsWorkloadManifestOverwriter) ReadWorkloadManifest(name string) (workspace.WorkloadManifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadWorkloadManifest", name)
+	ret0, _ := ret[0].(workspace.WorkloadManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadWorkloadManifest indicates an expected call of ReadWorkloadManifest.
+func (mr *MockwsWorkloadManifestOverwriterMockRecorder) ReadWorkloadManifest(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWorkloadManifest", reflect.TypeOf((*MockwsWorkloadManifestOverwriter)(nil).ReadWorkloadManifest), name)
+}
+
+// MockimageDigestResolver is a mock of imageDigestResolver interface.
+type MockimageDigestResolver struct {
+	ctrl     *gomock.Controller
+	recorder *MockimageDigestResolverMockRecorder
+}
+
+// MockimageDigestResolverMockRecorder is the mock recorder for MockimageDigestResolver.
+type MockimageDigestResolverMockRecorder struct {
+	mock *MockimageDigestResolver
+}
+
+// NewMockimageDigestResolver creates a new mock instance.
+func NewMockimageDigestResolver(ctrl *gomock.Controller) *MockimageDigestResolver {
+	mock := &MockimageDigestResolver{ctrl: ctrl}
+	mock.recorder = &MockimageDigestResolverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockimageDigestResolver) EXPECT() *MockimageDigestResolverMockRecorder {
+	return m.recorder
+}
+
+// ImageDigest mocks base method.
+func (m *MockimageDigestResolver) ImageDigest(ctx context.Context, ref string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImageDigest", ctx, ref)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImageDigest indicates an expected call of ImageDigest.
+func (mr *MockimageDigestResolverMockRecorder) ImageDigest(ctx, ref interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageDigest", reflect.TypeOf((*MockimageDigestResolver)(nil).ImageDigest), ctx, ref)
+}
+
+// MockefsFileSystemDescriber is a mock of efsFileSystemDescriber interface.
+type MockefsFileSystemDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockefsFileSystemDescriberMockRecorder
+}
+
+// MockefsFileSystemDescriberMockRecorder is the mock recorder for MockefsFileSystemDescriber.
+type MockefsFileSystemDescriberMockRecorder struct {
+	mock *MockefsFileSystemDescriber
+}
+
+// NewMockefsFileSystemDescriber creates a new mock instance.
+func NewMockefsFileSystemDescriber(ctrl *gomock.Controller) *MockefsFileSystemDescriber {
+	mock := &MockefsFileSystemDescriber{ctrl: ctrl}
+	mock.recorder = &MockefsFileSystemDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockefsFileSystemDescriber) EXPECT() *MockefsFileSystemDescriberMockRecorder {
+	return m.recorder
+}
+
+// FileSystemNetwork mocks base method.
+func (m *MockefsFileSystemDescriber) FileSystemNetwork(fsID string) (*efs.FileSystemNetwork, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FileSystemNetwork", fsID)
+	ret0, _ := ret[0].(*efs.FileSystemNetwork)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FileSystemNetwork indicates an expected call of FileSystemNetwork.
+func (mr *MockefsFileSystemDescriberMockRecorder) FileSystemNetwork(fsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FileSystemNetwork", reflect.TypeOf((*MockefsFileSystemDescriber)(nil).FileSystemNetwork), fsID)
+}
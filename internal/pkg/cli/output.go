@@ -0,0 +1,181 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/spf13/pflag"
+	"gopkg.in/yaml.v3"
+)
+
+// Output formats of read commands.
+const (
+	outputFormatTable = "table"
+	outputFormatJSON  = "json"
+	outputFormatYAML  = "yaml"
+)
+
+var outputFormats = []string{outputFormatTable, outputFormatJSON, outputFormatYAML}
+
+// outputSchemaVersion is the version of the documents written by read commands, added to each document as "schemaVersion".
+// Bump it when a change renames or removes a field, or changes its type.
+const outputSchemaVersion = 1
+
+// humanJSONStringer is implemented by the descriptions written by read commands.
+type humanJSONStringer interface {
+	HumanString() string
+	JSONString() (string, error)
+}
+
+// outputVars holds the flags that select the output format of a read command.
+type outputVars struct {
+	outputFormat     string
+	shouldOutputJSON bool // Set by the "--json" flag, an alias of "--output json".
+}
+
+// format returns the output format selected by the flags.
+func (v outputVars) format() string {
+	if v.shouldOutputJSON {
+		return outputFormatJSON
+	}
+	if v.outputFormat == "" {
+		return outputFormatTable
+	}
+	return v.outputFormat
+}
+
+// isStructured returns true if the output is meant to be read by programs.
+func (v outputVars) isStructured() bool {
+	return v.format() != outputFormatTable
+}
+
+// validateOutput returns an error if the output flags are invalid.
+func (v outputVars) validateOutput() error {
+	if v.outputFormat == "" {
+		return nil
+	}
+	if !slices.Contains(outputFormats, v.outputFormat) {
+		return fmt.Errorf("invalid `--%s` value %q: must be one of %s", outputFlag, v.outputFormat, strings.Join(outputFormats, ", "))
+	}
+	if v.shouldOutputJSON && v.outputFormat != outputFormatJSON {
+		return fmt.Errorf("cannot specify both `--%s` and `--%s %s`", jsonFlag, outputFlag, v.outputFormat)
+	}
+	return nil
+}
+
+// addOutputFlags adds the "--output" and "--json" flags to the flag set.
+func addOutputFlags(flags *pflag.FlagSet, vars *outputVars) {
+	flags.StringVar(&vars.outputFormat, outputFlag, "", outputFlagDescription)
+	flags.BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonOutputFlagDescription)
+}
+
+// writeOutput writes the description in the output format.
+func writeOutput(w io.Writer, format string, description humanJSONStringer) error {
+	if format == outputFormatTable {
+		fmt.Fprint(w, description.HumanString())
+		return nil
+	}
+	data, err := description.JSONString()
+	if err != nil {
+		return err
+	}
+	out := newOutputWriter(w, format)
+	if _, err := io.WriteString(out, data); err != nil {
+		return err
+	}
+	return out.Flush()
+}
+
+// outputWriter writes the output of a read command in its output format.
+// Tables are written as is, while JSON documents are buffered until Flush is called, which adds the schema version to each of them
+// and converts them to YAML if needed.
+type outputWriter struct {
+	w      io.Writer
+	format string
+	buf    bytes.Buffer
+}
+
+func newOutputWriter(w io.Writer, format string) *outputWriter {
+	return &outputWriter{
+		w:      w,
+		format: format,
+	}
+}
+
+// Write writes the table output to the underlying writer, or buffers the JSON documents until Flush is called.
+func (o *outputWriter) Write(p []byte) (int, error) {
+	if o.format == outputFormatTable {
+		return o.w.Write(p)
+	}
+	return o.buf.Write(p)
+}
+
+// Flush adds the schema version to the buffered JSON documents and writes them to the underlying writer
+// in the output format.
+func (o *outputWriter) Flush() error {
+	if o.buf.Len() == 0 {
+		return nil
+	}
+	defer o.buf.Reset()
+	dec := json.NewDecoder(&o.buf)
+	enc := yaml.NewEncoder(o.w)
+	enc.SetIndent(2)
+	for {
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err == io.EOF {
+			break
+		} else if err != nil {
+			return fmt.Errorf("decode JSON output: %w", err)
+		}
+		doc := withSchemaVersion(raw)
+		if o.format != outputFormatYAML {
+			if _, err := fmt.Fprintf(o.w, "%s\n", doc); err != nil {
+				return err
+			}
+			continue
+		}
+		var node yaml.Node
+		if err := yaml.Unmarshal(doc, &node); err != nil {
+			return fmt.Errorf("decode JSON output: %w", err)
+		}
+		resetNodeStyle(&node)
+		if err := enc.Encode(&node); err != nil {
+			return fmt.Errorf("encode YAML output: %w", err)
+		}
+	}
+	if o.format != outputFormatYAML {
+		return nil
+	}
+	return enc.Close()
+}
+
+// withSchemaVersion returns the JSON document with "schemaVersion" as its first field.
+// Documents that aren't JSON objects are returned as is.
+func withSchemaVersion(doc []byte) []byte {
+	doc = bytes.TrimSpace(doc)
+	if len(doc) == 0 || doc[0] != '{' {
+		return doc
+	}
+	fields := bytes.TrimSpace(doc[1:])
+	versioned := fmt.Sprintf(`{"schemaVersion":%d`, outputSchemaVersion)
+	if len(fields) == 0 || fields[0] == '}' {
+		return []byte(versioned + "}")
+	}
+	return append([]byte(versioned+","), fields...)
+}
+
+// resetNodeStyle removes the flow and quoting styles of a document decoded from JSON
+// so that it's encoded in block style, keeping the order of the fields.
+func resetNodeStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		resetNodeStyle(child)
+	}
+}
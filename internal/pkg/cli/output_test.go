@@ -0,0 +1,196 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type mockHumanJSONStringer struct {
+	human   string
+	json    string
+	jsonErr error
+}
+
+func (m mockHumanJSONStringer) HumanString() string {
+	return m.human
+}
+
+func (m mockHumanJSONStringer) JSONString() (string, error) {
+	return m.json, m.jsonErr
+}
+
+func TestOutputVars_validateOutput(t *testing.T) {
+	testCases := map[string]struct {
+		in outputVars
+
+		wantedFormat string
+		wantedErr    error
+	}{
+		"defaults to table": {
+			wantedFormat: outputFormatTable,
+		},
+		"json flag is an alias of --output json": {
+			in: outputVars{
+				shouldOutputJSON: true,
+			},
+			wantedFormat: outputFormatJSON,
+		},
+		"json flag with --output json": {
+			in: outputVars{
+				outputFormat:     outputFormatJSON,
+				shouldOutputJSON: true,
+			},
+			wantedFormat: outputFormatJSON,
+		},
+		"yaml": {
+			in: outputVars{
+				outputFormat: outputFormatYAML,
+			},
+			wantedFormat: outputFormatYAML,
+		},
+		"error if the format is not recognized": {
+			in: outputVars{
+				outputFormat: "xml",
+			},
+			wantedErr: errors.New("invalid `--output` value \"xml\": must be one of table, json, yaml"),
+		},
+		"error if json flag is set with another format": {
+			in: outputVars{
+				outputFormat:     outputFormatYAML,
+				shouldOutputJSON: true,
+			},
+			wantedErr: errors.New("cannot specify both `--json` and `--output yaml`"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.in.validateOutput()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedFormat, tc.in.format())
+		})
+	}
+}
+
+func TestWriteOutput(t *testing.T) {
+	description := mockHumanJSONStringer{
+		human: "About\n\n  Name    my-app\n",
+		json:  `{"name":"my-app","version":"v1.0.0","enabled":"true","environments":[{"name":"test","prod":false}],"tags":{}}` + "\n",
+	}
+	testCases := map[string]struct {
+		inFormat      string
+		inDescription mockHumanJSONStringer
+
+		wanted    string
+		wantedErr error
+	}{
+		"table": {
+			inFormat:      outputFormatTable,
+			inDescription: description,
+			wanted:        "About\n\n  Name    my-app\n",
+		},
+		"json": {
+			inFormat:      outputFormatJSON,
+			inDescription: description,
+			wanted:        `{"schemaVersion":1,"name":"my-app","version":"v1.0.0","enabled":"true","environments":[{"name":"test","prod":false}],"tags":{}}` + "\n",
+		},
+		"yaml keeps the order of the fields and the types of the values": {
+			inFormat:      outputFormatYAML,
+			inDescription: description,
+			wanted: `schemaVersion: 1
+name: my-app
+version: v1.0.0
+enabled: "true"
+environments:
+  - name: test
+    prod: false
+tags: {}
+`,
+		},
+		"return the error if the JSON string cannot be generated": {
+			inFormat: outputFormatYAML,
+			inDescription: mockHumanJSONStringer{
+				jsonErr: errors.New("some error"),
+			},
+			wantedErr: errors.New("some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			b := &strings.Builder{}
+
+			err := writeOutput(b, tc.inFormat, tc.inDescription)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, b.String())
+		})
+	}
+}
+
+func TestOutputWriter(t *testing.T) {
+	testCases := map[string]struct {
+		inFormat string
+		inWrites []string
+
+		wanted string
+	}{
+		"tables are written as is": {
+			inFormat: outputFormatTable,
+			inWrites: []string{"Name    Type\n", "api     Backend Service\n"},
+			wanted:   "Name    Type\napi     Backend Service\n",
+		},
+		"add the schema version to each JSON document": {
+			inFormat: outputFormatJSON,
+			inWrites: []string{`{"services":[]}`, "\n", `{}`},
+			wanted:   `{"schemaVersion":1,"services":[]}` + "\n" + `{"schemaVersion":1}` + "\n",
+		},
+		"documents that aren't objects are written as is": {
+			inFormat: outputFormatJSON,
+			inWrites: []string{`["api"]`},
+			wanted:   `["api"]` + "\n",
+		},
+		"add the schema version to each YAML document": {
+			inFormat: outputFormatYAML,
+			inWrites: []string{`{"services":[{"name":"api"}]}`, `{"jobs":[]}`},
+			wanted: `schemaVersion: 1
+services:
+  - name: api
+---
+schemaVersion: 1
+jobs: []
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			b := &strings.Builder{}
+			out := newOutputWriter(b, tc.inFormat)
+
+			for _, data := range tc.inWrites {
+				_, err := out.Write([]byte(data))
+				require.NoError(t, err)
+			}
+			err := out.Flush()
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, b.String())
+		})
+	}
+}
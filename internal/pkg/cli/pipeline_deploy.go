@@ -21,7 +21,9 @@ import (
 	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	cs "github.com/aws/copilot-cli/internal/pkg/aws/codestar"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/kms"
 	rg "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	clideploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/cli/list"
@@ -29,8 +31,10 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	deploycfn "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/upload/customresource"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/template"
 	templatediff "github.com/aws/copilot-cli/internal/pkg/template/diff"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
@@ -40,6 +44,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 
 	"github.com/spf13/cobra"
 
@@ -93,6 +98,9 @@ type deployPipelineOpts struct {
 	store                 store
 	ws                    wsPipelineReader
 	codestar              codestar
+	keyGranter            artifactKeyGranter
+	uploader              uploader
+	templateFS            template.Reader
 	diffWriter            io.Writer
 	sessProvider          *sessions.Provider
 	newSvcListCmd         func(io.Writer, string) cmd
@@ -147,6 +155,9 @@ func newDeployPipelineOpts(vars deployPipelineVars) (*deployPipelineOpts, error)
 		sessProvider:       sessProvider,
 		sel:                selector.NewWsPipelineSelector(prompter, ws),
 		codestar:           cs.New(defaultSession),
+		keyGranter:         kms.New(defaultSession),
+		uploader:           s3.New(defaultSession),
+		templateFS:         template.New(),
 		templateVersion:    version.LatestTemplateVersion(),
 		pipelineStackConfig: func(in *deploy.CreatePipelineInput) stackConfiguration {
 			return stack.NewPipelineStackConfig(in)
@@ -243,6 +254,11 @@ func validatePipelineVersion(vg versionGetter, name, templateVersion string) err
 	return nil
 }
 
+// ValidateVersionPin returns an error if the application doesn't allow this version of Copilot to deploy its pipelines.
+func (o *deployPipelineOpts) ValidateVersionPin() error {
+	return validateVersionPin(o.store, o.appName, o.templateVersion, "")
+}
+
 // Execute creates a new pipeline or updates the current pipeline if it already exists.
 func (o *deployPipelineOpts) Execute() error {
 	if !o.allowDowngrade {
@@ -281,6 +297,11 @@ func (o *deployPipelineOpts) Execute() error {
 	}
 	o.shouldPromptUpdateConnection = shouldPrompt
 
+	githubStatus, err := deploy.NewGitHubStatusReport(pipeline.Notifications, source)
+	if err != nil {
+		return fmt.Errorf("read notifications from manifest: %w", err)
+	}
+
 	// Convert full manifest path to relative path from workspace root.
 	relPath, err := o.ws.Rel(o.pipeline.Path)
 	if err != nil {
@@ -294,7 +315,7 @@ func (o *deployPipelineOpts) Execute() error {
 	}
 
 	// Get cross-regional resources.
-	artifactBuckets, err := o.getArtifactBuckets()
+	artifactBuckets, err := o.getArtifactBuckets(pipeline.ArtifactKMSKeyARN)
 	if err != nil {
 		return fmt.Errorf("get cross-regional resources: %w", err)
 	}
@@ -319,6 +340,10 @@ func (o *deployPipelineOpts) Execute() error {
 		AdditionalTags:      o.app.Tags,
 		Version:             o.templateVersion,
 		PermissionsBoundary: o.app.PermissionsBoundary,
+		GitHubStatus:        githubStatus,
+		Trigger:             deploy.NewPipelineTrigger(pipeline.Trigger),
+		ArtifactKMSKeyARN:   pipeline.ArtifactKMSKeyARN,
+		CopilotBinaryURL:    fmt.Sprintf("%s/copilot-linux-%s", binaryS3BucketPath, template.URLSafeVersion(version.Version)),
 	}
 
 	overrideOpts := newOverrideOpts{
@@ -335,11 +360,18 @@ func (o *deployPipelineOpts) Execute() error {
 	stackConfig := deploycfn.WrapWithTemplateOverrider(o.pipelineStackConfig(deployPipelineInput), overrider)
 
 	if o.showDiff {
+		if githubStatus != nil {
+			urls, err := o.customResourceLocations()
+			if err != nil {
+				return err
+			}
+			deployPipelineInput.CustomResourcesURLs = urls
+		}
 		tpl, err := stackConfig.Template()
 		if err != nil {
 			return fmt.Errorf("generate the new template for diff: %w", err)
 		}
-		if err = diff(o, tpl, o.diffWriter); err != nil {
+		if err = diff(o, tpl, o.diffWriter, templatediff.FormatHuman); err != nil {
 			var errHasDiff *errHasDiff
 			if !errors.As(err, &errHasDiff) {
 				return err
@@ -365,6 +397,16 @@ func (o *deployPipelineOpts) Execute() error {
 	}
 	o.prog.Stop(log.Ssuccessf(fmtPipelineDeployResourcesComplete, color.HighlightUserInput(o.appName)))
 
+	if err := o.grantArtifactKeyAccess(pipeline.Name, pipeline.ArtifactKMSKeyARN, stages); err != nil {
+		return err
+	}
+	if githubStatus != nil {
+		urls, err := o.uploadCustomResources()
+		if err != nil {
+			return err
+		}
+		deployPipelineInput.CustomResourcesURLs = urls
+	}
 	if err := o.deployPipeline(deployPipelineInput, stackConfig); err != nil {
 		return err
 	}
@@ -372,7 +414,7 @@ func (o *deployPipelineOpts) Execute() error {
 }
 
 // DeployDiff returns the stringified diff of the template against the deployed template of the pipeline.
-func (o *deployPipelineOpts) DeployDiff(template string) (string, error) {
+func (o *deployPipelineOpts) DeployDiff(template string, opts ...templatediff.WriteOption) (string, error) {
 	isLegacy, err := o.isLegacy(o.pipeline.Name)
 	if err != nil {
 		return "", err
@@ -391,7 +433,7 @@ func (o *deployPipelineOpts) DeployDiff(template string) (string, error) {
 		return "", fmt.Errorf("parse the diff against the deployed pipeline stack %q: %w", o.pipeline.Name, err)
 	}
 	buf := strings.Builder{}
-	if err := diffTree.Write(&buf); err != nil {
+	if err := diffTree.Write(&buf, opts...); err != nil {
 		return "", err
 	}
 	return buf.String(), nil
@@ -505,11 +547,22 @@ func (o deployPipelineOpts) getLocalWorkloads() ([]string, error) {
 	return localWklds, nil
 }
 
-func (o *deployPipelineOpts) getArtifactBuckets() ([]deploy.ArtifactBucket, error) {
+// getArtifactBuckets returns the artifact buckets of the application.
+// If the pipeline brings its own KMS key, the key replaces the application's key for the bucket in the region of the pipeline.
+func (o *deployPipelineOpts) getArtifactBuckets(customKeyARN string) ([]deploy.ArtifactBucket, error) {
 	regionalResources, err := o.pipelineDeployer.GetRegionalAppResources(o.app)
 	if err != nil {
 		return nil, err
 	}
+	if customKeyARN != "" {
+		keyARN, err := arn.Parse(customKeyARN)
+		if err != nil {
+			return nil, fmt.Errorf("parse artifact KMS key ARN %s: %w", customKeyARN, err)
+		}
+		if keyARN.Region != o.region {
+			return nil, fmt.Errorf("artifact KMS key %s must be in the region of the pipeline %s", customKeyARN, o.region)
+		}
+	}
 
 	var buckets []deploy.ArtifactBucket
 	for _, resource := range regionalResources {
@@ -517,12 +570,29 @@ func (o *deployPipelineOpts) getArtifactBuckets() ([]deploy.ArtifactBucket, erro
 			BucketName: resource.S3Bucket,
 			KeyArn:     resource.KMSKeyARN,
 		}
+		if customKeyARN != "" && resource.Region == o.region {
+			bucket.KeyArn = customKeyARN
+		}
 		buckets = append(buckets, bucket)
 	}
-
 	return buckets, nil
 }
 
+// grantArtifactKeyAccess allows the environment manager role of each stage to decrypt the artifacts
+// encrypted with the KMS key brought by the pipeline.
+func (o *deployPipelineOpts) grantArtifactKeyAccess(pipelineName, keyARN string, stages []deploy.PipelineStage) error {
+	if keyARN == "" {
+		return nil
+	}
+	for _, stage := range stages {
+		name := fmt.Sprintf("copilot-%s-%s-%s", o.appName, pipelineName, stage.Name())
+		if err := o.keyGranter.GrantDecrypt(keyARN, stage.EnvManagerRoleARN(), name); err != nil {
+			return fmt.Errorf("grant stage %s access to the artifact KMS key: %w", stage.Name(), err)
+		}
+	}
+	return nil
+}
+
 func (o *deployPipelineOpts) getBucketName() (string, error) {
 	resources, err := o.pipelineDeployer.GetAppResourcesByRegion(o.app, o.region)
 	if err != nil {
@@ -531,6 +601,43 @@ func (o *deployPipelineOpts) getBucketName() (string, error) {
 	return resources.S3Bucket, nil
 }
 
+// customResourceLocations returns the S3 URLs that the custom resources of the pipeline are uploaded to
+// in the artifact bucket of the pipeline region, without uploading them.
+func (o *deployPipelineOpts) customResourceLocations() (map[string]string, error) {
+	crs, err := customresource.Pipeline(o.templateFS)
+	if err != nil {
+		return nil, fmt.Errorf("read custom resources for pipeline %s: %w", o.pipeline.Name, err)
+	}
+	bucket, err := o.getBucketName()
+	if err != nil {
+		return nil, fmt.Errorf("get bucket name: %w", err)
+	}
+	urls := make(map[string]string, len(crs))
+	for _, cr := range crs {
+		urls[cr.Name()] = s3.Location(bucket, cr.ArtifactPath())
+	}
+	return urls, nil
+}
+
+// uploadCustomResources uploads the custom resources of the pipeline to the artifact bucket of the pipeline region.
+func (o *deployPipelineOpts) uploadCustomResources() (map[string]string, error) {
+	crs, err := customresource.Pipeline(o.templateFS)
+	if err != nil {
+		return nil, fmt.Errorf("read custom resources for pipeline %s: %w", o.pipeline.Name, err)
+	}
+	bucket, err := o.getBucketName()
+	if err != nil {
+		return nil, fmt.Errorf("get bucket name: %w", err)
+	}
+	urls, err := customresource.Upload(func(key string, dat io.Reader) (string, error) {
+		return o.uploader.Upload(bucket, key, dat)
+	}, crs)
+	if err != nil {
+		return nil, fmt.Errorf("upload custom resources to bucket %s: %w", bucket, err)
+	}
+	return urls, nil
+}
+
 func (o *deployPipelineOpts) shouldUpdate() (bool, error) {
 	if o.skipConfirmation {
 		return true, nil
@@ -573,7 +680,7 @@ func (o *deployPipelineOpts) deployPipeline(in *deploy.CreatePipelineInput, stac
 			log.Infof("%s Go to %s to update the status of connection %s from PENDING to AVAILABLE.", color.Emphasize("ACTION REQUIRED!"), color.HighlightResource(connectionsURL), color.HighlightUserInput(connectionName))
 			log.Infoln()
 		}
-		if err := o.pipelineDeployer.CreatePipeline(bucketName, stackConfig); err != nil {
+		if err := o.pipelineDeployer.CreatePipeline(bucketName, stackConfig, o.stackOpts()...); err != nil {
 			var alreadyExists *cloudformation.ErrStackAlreadyExists
 			if !errors.As(err, &alreadyExists) {
 				o.prog.Stop(log.Serrorf(fmtPipelineDeployFailed, color.HighlightUserInput(o.pipeline.Name)))
@@ -596,7 +703,7 @@ func (o *deployPipelineOpts) deployPipeline(in *deploy.CreatePipelineInput, stac
 	}
 
 	o.prog.Start(fmt.Sprintf(fmtPipelineDeployProposalStart, color.HighlightUserInput(o.pipeline.Name)))
-	if err := o.pipelineDeployer.UpdatePipeline(bucketName, stackConfig); err != nil {
+	if err := o.pipelineDeployer.UpdatePipeline(bucketName, stackConfig, o.stackOpts()...); err != nil {
 		o.prog.Stop(log.Serrorf(fmtPipelineDeployProposalFailed, color.HighlightUserInput(o.pipeline.Name)))
 		return fmt.Errorf("update pipeline: %w", err)
 	}
@@ -604,6 +711,14 @@ func (o *deployPipelineOpts) deployPipeline(in *deploy.CreatePipelineInput, stac
 	return nil
 }
 
+// stackOpts returns the options to deploy the pipeline stack with the CloudFormation execution role of the application, if any.
+func (o *deployPipelineOpts) stackOpts() []awscloudformation.StackOption {
+	if o.app.CFNExecutionRoleARN == "" {
+		return nil
+	}
+	return []awscloudformation.StackOption{awscloudformation.WithRoleARN(o.app.CFNExecutionRoleARN)}
+}
+
 // RecommendedActions returns follow-up actions the user can take after successfully executing the command.
 func (o *deployPipelineOpts) RecommendedActions() []string {
 	return []string{
@@ -637,5 +752,6 @@ func buildPipelineDeployCmd() *cobra.Command {
 	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
 	cmd.Flags().BoolVar(&vars.showDiff, diffFlag, false, diffFlagDescription)
 	cmd.Flags().BoolVar(&vars.allowDowngrade, allowDowngradeFlag, false, allowDowngradeFlagDescription)
+	markAudited(cmd)
 	return cmd
 }
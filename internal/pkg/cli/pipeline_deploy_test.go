@@ -16,6 +16,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/golang/mock/gomock"
@@ -33,6 +34,14 @@ type deployPipelineMocks struct {
 	actionCmd              *mocks.MockactionCommand
 	deployedPipelineLister *mocks.MockdeployedPipelineLister
 	versionGetter          *mocks.MockversionGetter
+	keyGranter             *mocks.MockartifactKeyGranter
+	uploader               *mocks.Mockuploader
+}
+
+type fakeTemplateReader struct{}
+
+func (fakeTemplateReader) Read(path string) (*template.Content, error) {
+	return &template.Content{Buffer: bytes.NewBufferString("fake content")}, nil
 }
 
 func TestDeployPipelineOpts_Ask(t *testing.T) {
@@ -205,6 +214,30 @@ func TestDeployPipelineOpts_Execute(t *testing.T) {
 			},
 		},
 	}
+	mockCustomKeyPipelineManifest := &manifest.Pipeline{
+		Name:              "pipepiper",
+		Version:           1,
+		Source:            mockPipelineManifest.Source,
+		Stages:            mockPipelineManifest.Stages,
+		ArtifactKMSKeyARN: "arn:aws:kms:us-west-2:123456789012:key/custom",
+	}
+	mockGitHubStatusPipelineManifest := &manifest.Pipeline{
+		Name:    "pipepiper",
+		Version: 1,
+		Source: &manifest.Source{
+			ProviderName: "GitHub",
+			Properties: map[string]interface{}{
+				"repository": "https://github.com/aws/somethingCool",
+				"branch":     "main",
+			},
+		},
+		Stages: mockPipelineManifest.Stages,
+		Notifications: &manifest.PipelineNotifications{
+			GitHub: &manifest.GitHubNotifications{
+				AccessTokenSecret: "github-token",
+			},
+		},
+	}
 	app := config.Application{
 		AccountID: accountID,
 		Name:      appName,
@@ -220,10 +253,11 @@ func TestDeployPipelineOpts_Execute(t *testing.T) {
 		S3Bucket: "someOtherBucket",
 	}
 	mockEnv := &config.Environment{
-		Name:      "test",
-		App:       appName,
-		Region:    region,
-		AccountID: accountID,
+		Name:           "test",
+		App:            appName,
+		Region:         region,
+		AccountID:      accountID,
+		ManagerRoleARN: "arn:aws:iam::123456789012:role/badgoose-test-EnvManagerRole",
 	}
 	testCases := map[string]struct {
 		inApp            *config.Application
@@ -272,6 +306,142 @@ func TestDeployPipelineOpts_Execute(t *testing.T) {
 			},
 			expectedError: nil,
 		},
+		"uploads the custom resources before deploying a pipeline that reports to GitHub": {
+			inApp:     &app,
+			inAppName: appName,
+			inRegion:  region,
+			callMocks: func(m deployPipelineMocks) {
+				gomock.InOrder(
+					m.deployedPipelineLister.EXPECT().ListDeployedPipelines(appName).Return([]deploy.Pipeline{}, nil),
+					m.versionGetter.EXPECT().Version().Return(mockTemplateVersion, nil),
+					m.ws.EXPECT().ReadPipelineManifest(pipelineManifestPath).Return(mockGitHubStatusPipelineManifest, nil),
+					m.ws.EXPECT().Rel(pipelineManifestPath).Return(relativePath, nil),
+					m.actionCmd.EXPECT().Execute().Times(2),
+
+					// convertStages
+					m.store.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
+					m.store.EXPECT().GetEnvironment(appName, "wings").Return(mockEnv, nil).Times(1),
+
+					// getArtifactBuckets
+					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
+
+					m.ws.EXPECT().PipelineOverridesPath(pipelineName).Return("path"),
+
+					// bootstrap pipeline resources
+					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineDeployResourcesStart, appName)).Times(1),
+					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
+					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineDeployResourcesComplete, appName)).Times(1),
+
+					// uploadCustomResources
+					m.deployer.EXPECT().GetAppResourcesByRegion(&app, region).Return(mockResource, nil),
+					m.uploader.EXPECT().Upload("someOtherBucket", gomock.Any(), gomock.Any()).Return("https://someOtherBucket.s3.us-west-2.amazonaws.com/github-status.zip", nil),
+
+					// deployPipeline
+					m.deployer.EXPECT().PipelineExists(gomock.Any()).Return(false, nil),
+					m.deployer.EXPECT().GetAppResourcesByRegion(&app, region).Return(mockResource, nil),
+					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineDeployStart, pipelineName)).Times(1),
+					m.deployer.EXPECT().CreatePipeline(gomock.Any(), gomock.Any()).Return(nil),
+					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineDeployComplete, pipelineName)).Times(1),
+				)
+			},
+		},
+		"returns an error if the custom resources cannot be uploaded": {
+			inApp:     &app,
+			inAppName: appName,
+			inRegion:  region,
+			callMocks: func(m deployPipelineMocks) {
+				gomock.InOrder(
+					m.deployedPipelineLister.EXPECT().ListDeployedPipelines(appName).Return([]deploy.Pipeline{}, nil),
+					m.versionGetter.EXPECT().Version().Return(mockTemplateVersion, nil),
+					m.ws.EXPECT().ReadPipelineManifest(pipelineManifestPath).Return(mockGitHubStatusPipelineManifest, nil),
+					m.ws.EXPECT().Rel(pipelineManifestPath).Return(relativePath, nil),
+					m.actionCmd.EXPECT().Execute().Times(2),
+
+					// convertStages
+					m.store.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
+					m.store.EXPECT().GetEnvironment(appName, "wings").Return(mockEnv, nil).Times(1),
+
+					// getArtifactBuckets
+					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
+
+					m.ws.EXPECT().PipelineOverridesPath(pipelineName).Return("path"),
+
+					// bootstrap pipeline resources
+					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineDeployResourcesStart, appName)).Times(1),
+					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
+					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineDeployResourcesComplete, appName)).Times(1),
+
+					// uploadCustomResources
+					m.deployer.EXPECT().GetAppResourcesByRegion(&app, region).Return(mockResource, nil),
+					m.uploader.EXPECT().Upload("someOtherBucket", gomock.Any(), gomock.Any()).Return("", errors.New("some error")),
+				)
+			},
+			expectedError: errors.New(`upload custom resources to bucket someOtherBucket: upload custom resource "GitHubStatusFunction": some error`),
+		},
+		"grants the stages access to the custom artifact key before deploying the pipeline": {
+			inApp:     &app,
+			inAppName: appName,
+			inRegion:  region,
+			callMocks: func(m deployPipelineMocks) {
+				gomock.InOrder(
+					m.deployedPipelineLister.EXPECT().ListDeployedPipelines(appName).Return([]deploy.Pipeline{}, nil),
+					m.versionGetter.EXPECT().Version().Return(mockTemplateVersion, nil),
+					m.ws.EXPECT().ReadPipelineManifest(pipelineManifestPath).Return(mockCustomKeyPipelineManifest, nil),
+					m.ws.EXPECT().Rel(pipelineManifestPath).Return(relativePath, nil),
+					m.actionCmd.EXPECT().Execute().Times(2),
+
+					// convertStages
+					m.store.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
+					m.store.EXPECT().GetEnvironment(appName, "wings").Return(mockEnv, nil).Times(1),
+
+					// getArtifactBuckets
+					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
+
+					m.ws.EXPECT().PipelineOverridesPath(pipelineName).Return("path"),
+
+					// bootstrap pipeline resources
+					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineDeployResourcesStart, appName)).Times(1),
+					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
+					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineDeployResourcesComplete, appName)).Times(1),
+
+					// grantArtifactKeyAccess
+					m.keyGranter.EXPECT().GrantDecrypt("arn:aws:kms:us-west-2:123456789012:key/custom",
+						"arn:aws:iam::123456789012:role/badgoose-test-EnvManagerRole", "copilot-badgoose-pipepiper-chicken").Return(nil),
+					m.keyGranter.EXPECT().GrantDecrypt("arn:aws:kms:us-west-2:123456789012:key/custom",
+						"arn:aws:iam::123456789012:role/badgoose-test-EnvManagerRole", "copilot-badgoose-pipepiper-wings").Return(nil),
+
+					// deployPipeline
+					m.deployer.EXPECT().PipelineExists(gomock.Any()).Return(false, nil),
+					m.deployer.EXPECT().GetAppResourcesByRegion(&app, region).Return(mockResource, nil),
+					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineDeployStart, pipelineName)).Times(1),
+					m.deployer.EXPECT().CreatePipeline(gomock.Any(), gomock.Any()).Return(nil),
+					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineDeployComplete, pipelineName)).Times(1),
+				)
+			},
+		},
+		"returns an error if the stages cannot be granted access to the custom artifact key": {
+			inApp:     &app,
+			inAppName: appName,
+			inRegion:  region,
+			callMocks: func(m deployPipelineMocks) {
+				gomock.InOrder(
+					m.deployedPipelineLister.EXPECT().ListDeployedPipelines(appName).Return([]deploy.Pipeline{}, nil),
+					m.versionGetter.EXPECT().Version().Return(mockTemplateVersion, nil),
+					m.ws.EXPECT().ReadPipelineManifest(pipelineManifestPath).Return(mockCustomKeyPipelineManifest, nil),
+					m.ws.EXPECT().Rel(pipelineManifestPath).Return(relativePath, nil),
+					m.actionCmd.EXPECT().Execute().Times(2),
+					m.store.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
+					m.store.EXPECT().GetEnvironment(appName, "wings").Return(mockEnv, nil).Times(1),
+					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
+					m.ws.EXPECT().PipelineOverridesPath(pipelineName).Return("path"),
+					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineDeployResourcesStart, appName)).Times(1),
+					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
+					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineDeployResourcesComplete, appName)).Times(1),
+					m.keyGranter.EXPECT().GrantDecrypt(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("some error")),
+				)
+			},
+			expectedError: errors.New("grant stage chicken access to the artifact KMS key: some error"),
+		},
 		"update and deploy pipeline with new naming": {
 			inApp:     &app,
 			inAppName: appName,
@@ -923,6 +1093,8 @@ func TestDeployPipelineOpts_Execute(t *testing.T) {
 				pipelineStackConfig:    mocks.NewMockstackConfiguration(ctrl),
 				deployedPipelineLister: mocks.NewMockdeployedPipelineLister(ctrl),
 				versionGetter:          mocks.NewMockversionGetter(ctrl),
+				keyGranter:             mocks.NewMockartifactKeyGranter(ctrl),
+				uploader:               mocks.NewMockuploader(ctrl),
 				mockDiffWriter:         &strings.Builder{},
 			}
 
@@ -945,6 +1117,9 @@ func TestDeployPipelineOpts_Execute(t *testing.T) {
 				store:           mocks.store,
 				prog:            mocks.prog,
 				prompt:          mocks.prompt,
+				keyGranter:      mocks.keyGranter,
+				uploader:        mocks.uploader,
+				templateFS:      fakeTemplateReader{},
 				templateVersion: mockTemplateVersion,
 				diffWriter:      &strings.Builder{},
 				newSvcListCmd: func(w io.Writer, app string) cmd {
@@ -983,7 +1158,8 @@ func TestDeployPipelineOpts_Execute(t *testing.T) {
 
 func TestDeployPipelineOpts_getArtifactBuckets(t *testing.T) {
 	testCases := map[string]struct {
-		mockDeployer func(m *mocks.MockpipelineDeployer)
+		inCustomKeyARN string
+		mockDeployer   func(m *mocks.MockpipelineDeployer)
 
 		expectedOut []deploy.ArtifactBucket
 
@@ -1006,6 +1182,40 @@ func TestDeployPipelineOpts_getArtifactBuckets(t *testing.T) {
 				},
 			},
 		},
+		"replaces the key of the bucket in the region of the pipeline with the custom key": {
+			inCustomKeyARN: "arn:aws:kms:us-west-2:123456789012:key/custom",
+			mockDeployer: func(m *mocks.MockpipelineDeployer) {
+				m.EXPECT().GetRegionalAppResources(gomock.Any()).Return([]*stack.AppRegionalResources{
+					{
+						Region:    "us-west-2",
+						S3Bucket:  "westBucket",
+						KMSKeyARN: "arn:aws:kms:us-west-2:123456789012:key/app",
+					},
+					{
+						Region:    "us-east-1",
+						S3Bucket:  "eastBucket",
+						KMSKeyARN: "arn:aws:kms:us-east-1:123456789012:key/app",
+					},
+				}, nil)
+			},
+			expectedOut: []deploy.ArtifactBucket{
+				{
+					BucketName: "westBucket",
+					KeyArn:     "arn:aws:kms:us-west-2:123456789012:key/custom",
+				},
+				{
+					BucketName: "eastBucket",
+					KeyArn:     "arn:aws:kms:us-east-1:123456789012:key/app",
+				},
+			},
+		},
+		"error if the custom key is not in the region of the pipeline": {
+			inCustomKeyARN: "arn:aws:kms:us-east-1:123456789012:key/custom",
+			mockDeployer: func(m *mocks.MockpipelineDeployer) {
+				m.EXPECT().GetRegionalAppResources(gomock.Any()).Return(nil, nil)
+			},
+			expectedError: errors.New("artifact KMS key arn:aws:kms:us-east-1:123456789012:key/custom must be in the region of the pipeline us-west-2"),
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -1018,14 +1228,15 @@ func TestDeployPipelineOpts_getArtifactBuckets(t *testing.T) {
 
 			opts := &deployPipelineOpts{
 				pipelineDeployer: mockPipelineDeployer,
+				region:           "us-west-2",
 			}
 
 			// WHEN
-			actual, err := opts.getArtifactBuckets()
+			actual, err := opts.getArtifactBuckets(tc.inCustomKeyARN)
 
 			// THEN
 			if tc.expectedError != nil {
-				require.Equal(t, tc.expectedError, err)
+				require.EqualError(t, err, tc.expectedError.Error())
 			} else {
 				require.NoError(t, err)
 				require.ElementsMatch(t, tc.expectedOut, actual)
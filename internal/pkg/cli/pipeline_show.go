@@ -37,8 +37,8 @@ const (
 type showPipelineVars struct {
 	appName               string
 	name                  string
-	shouldOutputJSON      bool
 	shouldOutputResources bool
+	outputVars
 }
 
 type showPipelineOpts struct {
@@ -101,7 +101,7 @@ func newShowPipelineOpts(vars showPipelineVars) (*showPipelineOpts, error) {
 
 // Validate returns an error if the optional flag values passed by the user are invalid.
 func (o *showPipelineOpts) Validate() error {
-	return nil
+	return o.validateOutput()
 }
 
 // Ask prompts for fields that are required but not passed in, and validates those that are.
@@ -142,17 +142,7 @@ func (o *showPipelineOpts) Execute() error {
 		return fmt.Errorf("describe pipeline %s: %w", o.name, err)
 	}
 
-	if o.shouldOutputJSON {
-		data, err := pipeline.JSONString()
-		if err != nil {
-			return err
-		}
-		fmt.Fprint(o.w, data)
-	} else {
-		fmt.Fprint(o.w, pipeline.HumanString())
-	}
-
-	return nil
+	return writeOutput(o.w, o.format(), pipeline)
 }
 
 func (o *showPipelineOpts) getTargetPipeline() (deploy.Pipeline, error) {
@@ -196,7 +186,7 @@ func buildPipelineShowCmd() *cobra.Command {
 	}
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", pipelineFlagDescription)
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
-	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	addOutputFlags(cmd.Flags(), &vars.outputVars)
 	cmd.Flags().BoolVar(&vars.shouldOutputResources, resourcesFlag, false, pipelineResourcesFlagDescription)
 
 	return cmd
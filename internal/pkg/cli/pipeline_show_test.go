@@ -246,8 +246,10 @@ func TestPipelineShow_Execute(t *testing.T) {
 
 			opts := &showPipelineOpts{
 				showPipelineVars: showPipelineVars{
-					shouldOutputJSON: tc.shouldOutputJSON,
-					name:             tc.inPipelineName,
+					outputVars: outputVars{
+						shouldOutputJSON: tc.shouldOutputJSON,
+					},
+					name: tc.inPipelineName,
 				},
 				describer:     mockDescriber,
 				initDescriber: func(bool) error { return nil },
@@ -36,9 +36,9 @@ const (
 )
 
 type pipelineStatusVars struct {
-	appName          string
-	shouldOutputJSON bool
-	name             string
+	appName string
+	name    string
+	outputVars
 }
 
 type pipelineStatusOpts struct {
@@ -98,7 +98,7 @@ func newPipelineStatusOpts(vars pipelineStatusVars) (*pipelineStatusOpts, error)
 
 // Validate returns an error if the optional flag values provided by the user are invalid.
 func (o *pipelineStatusOpts) Validate() error {
-	return nil
+	return o.validateOutput()
 }
 
 // Ask prompts for fields that are required but not passed in, and validates those that are.
@@ -138,17 +138,7 @@ func (o *pipelineStatusOpts) Execute() error {
 		return fmt.Errorf("describe status of pipeline: %w", err)
 	}
 
-	if o.shouldOutputJSON {
-		data, err := pipelineStatus.JSONString()
-		if err != nil {
-			return err
-		}
-		fmt.Fprint(o.w, data)
-	} else {
-		fmt.Fprint(o.w, pipelineStatus.HumanString())
-	}
-
-	return nil
+	return writeOutput(o.w, o.format(), pipelineStatus)
 }
 
 func (o *pipelineStatusOpts) getTargetPipeline() (deploy.Pipeline, error) {
@@ -193,7 +183,7 @@ Shows status of the pipeline "my-repo-my-branch".
 	}
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", pipelineFlagDescription)
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
-	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	addOutputFlags(cmd.Flags(), &vars.outputVars)
 
 	return cmd
 }
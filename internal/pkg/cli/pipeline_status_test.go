@@ -233,8 +233,10 @@ func TestPipelineStatus_Execute(t *testing.T) {
 
 			opts := &pipelineStatusOpts{
 				pipelineStatusVars: pipelineStatusVars{
-					shouldOutputJSON: tc.shouldOutputJSON,
-					name:             tc.pipelineName,
+					outputVars: outputVars{
+						shouldOutputJSON: tc.shouldOutputJSON,
+					},
+					name: tc.pipelineName,
 				},
 				describer:     mockDescriber,
 				initDescriber: func(o *pipelineStatusOpts) error { return nil },
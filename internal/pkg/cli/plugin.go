@@ -0,0 +1,78 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/aws/copilot-cli/internal/pkg/plugin"
+	"github.com/spf13/cobra"
+)
+
+type pluginRunner struct {
+	finder pluginFinder
+	run    func(p *plugin.Plugin, args []string) error
+}
+
+// RunPlugin runs the "copilot-<command>" executable found in $PATH with the rest of the arguments
+// if args don't start with a command built into Copilot. It returns false if no plugin ran.
+func RunPlugin(root *cobra.Command, args []string) (bool, error) {
+	r := &pluginRunner{
+		finder: plugin.NewFinder(),
+		run: func(p *plugin.Plugin, args []string) error {
+			return p.Run(args, os.Stdin, os.Stdout, os.Stderr)
+		},
+	}
+	return r.Run(root, args)
+}
+
+// Run runs the plugin for the first argument if it isn't a command built into Copilot.
+func (r *pluginRunner) Run(root *cobra.Command, args []string) (bool, error) {
+	// Skip flags and the hidden completion commands that cobra adds on execution.
+	if len(args) == 0 || strings.HasPrefix(args[0], "-") || strings.HasPrefix(args[0], "__") {
+		return false, nil
+	}
+	// Built-in commands always take precedence over plugins.
+	root.InitDefaultHelpCmd()
+	if cmd, _, err := root.Find(args); err == nil && cmd != root {
+		return false, nil
+	}
+	p, err := r.finder.Find(args[0])
+	if err != nil {
+		var notFound *plugin.ErrNotFound
+		if errors.As(err, &notFound) {
+			// Let cobra report the unknown command.
+			return false, nil
+		}
+		return false, fmt.Errorf("find plugin %s: %w", args[0], err)
+	}
+	return true, r.run(p, args[1:])
+}
+
+// BuildPluginCmd is the top level command for plugins.
+func BuildPluginCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "plugin",
+		Short: `Commands for plugins.
+Plugins are executables that add custom commands to Copilot.`,
+		Long: fmt.Sprintf(`Commands for plugins.
+Plugins are executables in your $PATH named "%[1]s<command>".
+Copilot runs them as "copilot <command>" with the remaining arguments,
+and sets the %[2]s environment variable to the path of the Copilot binary.
+Commands built into Copilot take precedence over plugins with the same name.`, plugin.Prefix, plugin.BinaryEnvVar),
+	}
+
+	cmd.AddCommand(buildPluginListCmd())
+
+	cmd.SetUsageTemplate(template.Usage)
+	cmd.Annotations = map[string]string{
+		"group": group.Extend,
+	}
+	return cmd
+}
@@ -0,0 +1,94 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aws/copilot-cli/internal/pkg/plugin"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/spf13/cobra"
+)
+
+type pluginListVars struct {
+	shouldOutputJSON bool
+}
+
+type pluginListOpts struct {
+	pluginListVars
+
+	w      io.Writer
+	lister pluginLister
+}
+
+func newPluginListOpts(vars pluginListVars) *pluginListOpts {
+	return &pluginListOpts{
+		pluginListVars: vars,
+		w:              log.OutputWriter,
+		lister:         plugin.NewFinder(),
+	}
+}
+
+// Validate is a no-op for this command.
+func (o *pluginListOpts) Validate() error {
+	return nil
+}
+
+// Ask is a no-op for this command.
+func (o *pluginListOpts) Ask() error {
+	return nil
+}
+
+// Execute writes the plugins found in $PATH.
+func (o *pluginListOpts) Execute() error {
+	plugins, err := o.lister.List()
+	if err != nil {
+		return fmt.Errorf("list plugins: %w", err)
+	}
+	if o.shouldOutputJSON {
+		data, err := json.Marshal(struct {
+			Plugins []plugin.Plugin `json:"plugins"`
+		}{
+			Plugins: plugins,
+		})
+		if err != nil {
+			return fmt.Errorf("marshal plugins: %w", err)
+		}
+		fmt.Fprintln(o.w, string(data))
+		return nil
+	}
+	if len(plugins) == 0 {
+		log.Infof("No plugins found. Add an executable named %s<command> to your $PATH to create one.\n", plugin.Prefix)
+		return nil
+	}
+	writer := tabwriter.NewWriter(o.w, auditTableMinCellWidth, auditTableTabWidth, auditTableCellPadding, ' ', 0)
+	headers := []string{"Command", "Path"}
+	fmt.Fprintf(writer, "%s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "%s\n", strings.Join([]string{"-------", "----"}, "\t"))
+	for _, p := range plugins {
+		fmt.Fprintf(writer, "copilot %s\t%s\n", p.Name, p.Path)
+	}
+	return writer.Flush()
+}
+
+// buildPluginListCmd builds the command to list the plugins found in $PATH.
+func buildPluginListCmd() *cobra.Command {
+	vars := pluginListVars{}
+	cmd := &cobra.Command{
+		Use:   "ls",
+		Short: "Lists the plugins found in your $PATH.",
+		Example: `
+  Lists the plugins that you can run as copilot commands.
+  /code $ copilot plugin ls`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			return run(newPluginListOpts(vars))
+		}),
+	}
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
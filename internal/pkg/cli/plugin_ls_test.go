@@ -0,0 +1,93 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/plugin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPluginListOpts_Execute(t *testing.T) {
+	mockPlugins := []plugin.Plugin{
+		{
+			Name: "hello",
+			Path: "/usr/local/bin/copilot-hello",
+		},
+		{
+			Name: "migrate",
+			Path: "/home/user/bin/copilot-migrate",
+		},
+	}
+	testCases := map[string]struct {
+		inJSON     bool
+		setupMocks func(m *mocks.MockpluginLister)
+
+		wantedContent string
+		wantedErr     error
+	}{
+		"return the error if the plugins cannot be listed": {
+			setupMocks: func(m *mocks.MockpluginLister) {
+				m.EXPECT().List().Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list plugins: some error"),
+		},
+		"write nothing if there are no plugins": {
+			setupMocks: func(m *mocks.MockpluginLister) {
+				m.EXPECT().List().Return(nil, nil)
+			},
+		},
+		"write the plugins in a table": {
+			setupMocks: func(m *mocks.MockpluginLister) {
+				m.EXPECT().List().Return(mockPlugins, nil)
+			},
+			wantedContent: `Command          Path
+-------          ----
+copilot hello    /usr/local/bin/copilot-hello
+copilot migrate  /home/user/bin/copilot-migrate
+`,
+		},
+		"write the plugins in JSON": {
+			inJSON: true,
+			setupMocks: func(m *mocks.MockpluginLister) {
+				m.EXPECT().List().Return(mockPlugins, nil)
+			},
+			wantedContent: `{"plugins":[{"name":"hello","path":"/usr/local/bin/copilot-hello"},{"name":"migrate","path":"/home/user/bin/copilot-migrate"}]}
+`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			lister := mocks.NewMockpluginLister(ctrl)
+			tc.setupMocks(lister)
+			b := &strings.Builder{}
+			opts := &pluginListOpts{
+				pluginListVars: pluginListVars{
+					shouldOutputJSON: tc.inJSON,
+				},
+				w:      b,
+				lister: lister,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
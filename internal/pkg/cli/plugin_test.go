@@ -0,0 +1,114 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/plugin"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPluginRunner_Run(t *testing.T) {
+	mockPlugin := &plugin.Plugin{
+		Name: "hello",
+		Path: "/usr/local/bin/copilot-hello",
+	}
+	testCases := map[string]struct {
+		inArgs     []string
+		setupMocks func(m *mocks.MockpluginFinder)
+		runErr     error
+
+		wantedRan  bool
+		wantedArgs []string
+		wantedErr  error
+	}{
+		"skip if there are no arguments": {},
+		"skip flags": {
+			inArgs: []string{"--help"},
+		},
+		"skip the hidden completion commands": {
+			inArgs: []string{"__complete", "svc"},
+		},
+		"skip built-in commands": {
+			inArgs: []string{"svc", "ls"},
+		},
+		"skip the help command": {
+			inArgs: []string{"help", "svc"},
+		},
+		"skip unknown commands without a plugin": {
+			inArgs: []string{"hello"},
+			setupMocks: func(m *mocks.MockpluginFinder) {
+				m.EXPECT().Find("hello").Return(nil, &plugin.ErrNotFound{Name: "hello"})
+			},
+		},
+		"return the error if the plugin cannot be looked up": {
+			inArgs: []string{"hello"},
+			setupMocks: func(m *mocks.MockpluginFinder) {
+				m.EXPECT().Find("hello").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("find plugin hello: some error"),
+		},
+		"run the plugin with the remaining arguments": {
+			inArgs: []string{"hello", "world", "--name", "copilot"},
+			setupMocks: func(m *mocks.MockpluginFinder) {
+				m.EXPECT().Find("hello").Return(mockPlugin, nil)
+			},
+			wantedRan:  true,
+			wantedArgs: []string{"world", "--name", "copilot"},
+		},
+		"return the error of the plugin": {
+			inArgs: []string{"hello"},
+			setupMocks: func(m *mocks.MockpluginFinder) {
+				m.EXPECT().Find("hello").Return(mockPlugin, nil)
+			},
+			runErr: errors.New("some error"),
+
+			wantedRan:  true,
+			wantedArgs: []string{},
+			wantedErr:  errors.New("some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			finder := mocks.NewMockpluginFinder(ctrl)
+			if tc.setupMocks != nil {
+				tc.setupMocks(finder)
+			}
+			root := &cobra.Command{Use: "copilot"}
+			svc := &cobra.Command{Use: "svc"}
+			svc.AddCommand(&cobra.Command{Use: "ls"})
+			root.AddCommand(svc)
+
+			var gotArgs []string
+			r := &pluginRunner{
+				finder: finder,
+				run: func(p *plugin.Plugin, args []string) error {
+					require.Equal(t, mockPlugin, p)
+					gotArgs = args
+					return tc.runErr
+				},
+			}
+
+			// WHEN
+			ran, err := r.Run(root, tc.inArgs)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedRan, ran)
+			require.Equal(t, tc.wantedArgs, gotArgs)
+		})
+	}
+}
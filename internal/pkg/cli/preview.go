@@ -0,0 +1,204 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// maxPreviewBranchLength keeps the names of the preview stack and its resources within their limits.
+	maxPreviewBranchLength = 28
+
+	previewTagKey = "copilot-preview-branch"
+)
+
+var (
+	errNoPreviewBranch        = fmt.Errorf("could not determine the current git branch: specify the branch with --%s", gitBranchFlag)
+	invalidBranchCharsRegexp  = regexp.MustCompile(`[^a-z0-9]+`)
+	errPreviewBranchNoSubject = errors.New("branch name must contain at least one letter or digit")
+)
+
+// Default sizes of the tasks of a preview.
+var previewDeployValues = map[string]string{
+	"count":  "1",
+	"cpu":    "256",
+	"memory": "512",
+}
+
+type previewVars struct {
+	appName string
+	name    string // Name of the service to preview.
+	envName string // Name of the environment that hosts previews.
+	branch  string
+}
+
+// previewOpts holds the fields shared by the preview commands.
+type previewOpts struct {
+	previewVars
+
+	store store
+	ws    wsWlDirReader
+	sel   wsSelector
+	cmd   execRunner
+}
+
+// validateBranch returns an error if the branch can't be used to name a preview.
+func (o *previewOpts) validateBranch() error {
+	if o.branch == "" {
+		return nil
+	}
+	if _, err := previewBranchSlug(o.branch); err != nil {
+		return fmt.Errorf("invalid branch %q: %w", o.branch, err)
+	}
+	return nil
+}
+
+func (o *previewOpts) ask() error {
+	if o.appName == "" {
+		// NOTE: This command is required to be executed under a workspace. We don't prompt for it.
+		return errNoAppInWorkspace
+	}
+	if _, err := o.store.GetApplication(o.appName); err != nil {
+		return fmt.Errorf("get application %s configuration: %w", o.appName, err)
+	}
+	if err := o.validateOrAskSvcName(); err != nil {
+		return err
+	}
+	if err := o.validateOrAskEnvName(); err != nil {
+		return err
+	}
+	if o.branch == "" {
+		o.branch = gitBranch(o.cmd)
+	}
+	if o.branch == "" {
+		return errNoPreviewBranch
+	}
+	return nil
+}
+
+func (o *previewOpts) validateOrAskSvcName() error {
+	if o.name == "" {
+		name, err := o.sel.Service("Select a service to preview", "")
+		if err != nil {
+			return fmt.Errorf("select service: %w", err)
+		}
+		o.name = name
+	}
+	svc, err := o.store.GetService(o.appName, o.name)
+	if err != nil {
+		return fmt.Errorf("get service %s configuration: %w", o.name, err)
+	}
+	if svc.Type != manifestinfo.LoadBalancedWebServiceType {
+		return fmt.Errorf("previews are only supported for services of type %q, but %s is a %q",
+			manifestinfo.LoadBalancedWebServiceType, color.HighlightUserInput(o.name), svc.Type)
+	}
+	return nil
+}
+
+func (o *previewOpts) validateOrAskEnvName() error {
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return fmt.Errorf("get environment %s configuration: %w", o.envName, err)
+		}
+		return nil
+	}
+	name, err := o.sel.Environment("Select the environment that hosts previews", "", o.appName)
+	if err != nil {
+		return fmt.Errorf("select environment: %w", err)
+	}
+	o.envName = name
+	return nil
+}
+
+// previewName returns the name of the workload deployed for the preview of the service.
+func (o *previewOpts) previewName() string {
+	slug, _ := previewBranchSlug(o.branch) // The branch is validated before.
+	return previewWorkloadName(o.name, slug)
+}
+
+// previewBranchSlug converts a git branch into a lowercase string of letters, digits and hyphens
+// that can be used in resource names and as a DNS label.
+// For example, "feature/Login_Page" becomes "feature-login-page".
+func previewBranchSlug(branch string) (string, error) {
+	slug := invalidBranchCharsRegexp.ReplaceAllString(strings.ToLower(branch), "-")
+	slug = strings.Trim(slug, "-")
+	if len(slug) > maxPreviewBranchLength {
+		slug = strings.TrimRight(slug[:maxPreviewBranchLength], "-")
+	}
+	if slug == "" {
+		return "", errPreviewBranchNoSubject
+	}
+	return slug, nil
+}
+
+// previewWorkloadName returns the name of the copy of a service deployed for a branch.
+func previewWorkloadName(svc, branchSlug string) string {
+	return fmt.Sprintf("%s-%s", svc, branchSlug)
+}
+
+// previewHost returns the host name that routes to the preview of a branch.
+// Unless a domain is provided, the host is a subdomain of the environment's domain, for example
+// "feature-login-page.preview.myapp.example.com".
+func previewHost(branchSlug, domain string, app *config.Application, env string) (string, error) {
+	if domain != "" {
+		return fmt.Sprintf("%s.%s", branchSlug, domain), nil
+	}
+	if app.Domain == "" {
+		return "", fmt.Errorf("application %s is not associated with a domain: specify the domain of the previews with --%s", app.Name, domainNameFlag)
+	}
+	return fmt.Sprintf("%s.%s.%s.%s", branchSlug, env, app.Name, app.Domain), nil
+}
+
+// applyPreviewConfig turns the manifest of a service into the manifest of its preview
+// that is only reachable through the host of the preview.
+func applyPreviewConfig(mft *manifest.LoadBalancedWebService, name, host string) error {
+	if mft.HTTPOrBool.Disabled() || mft.HTTPOrBool.Main.IsEmpty() {
+		return fmt.Errorf(`previews require "http" to be enabled for service %s`, aws.StringValue(mft.Name))
+	}
+	mft.Name = aws.String(name)
+	mft.HTTPOrBool.Main.Alias = manifest.Alias{
+		StringSliceOrString: manifest.StringSliceOrString{
+			String: aws.String(host),
+		},
+	}
+	mft.HTTPOrBool.Main.HostedZone = nil
+	// Only the main routing rule is copied so that the preview doesn't claim the hosts of the service.
+	mft.HTTPOrBool.AdditionalRoutingRules = nil
+	mft.HTTPOrBool.CDN = manifest.Union[*bool, manifest.ServiceCDNConfig]{}
+	mft.NLBConfig = manifest.NetworkLoadBalancerConfiguration{}
+	return nil
+}
+
+// BuildPreviewCmd is the top level command for previews.
+func BuildPreviewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "preview",
+		Short: `Commands for previews.
+Previews are copies of a service deployed for a git branch, for example to review a pull request.`,
+		Long: `Commands for previews.
+Previews are copies of a service deployed for a git branch, for example to review a pull request.`,
+	}
+
+	cmd.AddCommand(buildPreviewUpCmd())
+	cmd.AddCommand(buildPreviewDownCmd())
+
+	cmd.SetUsageTemplate(template.Usage)
+	cmd.Annotations = map[string]string{
+		"group": group.Develop,
+	}
+	return cmd
+}
@@ -0,0 +1,125 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awssession "github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/version"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+type previewDownOpts struct {
+	previewOpts
+
+	sess      sessionFromRoleProvider
+	getSvcCFN func(sess *awssession.Session) wlDeleter
+}
+
+func newPreviewDownOpts(vars previewVars) (*previewDownOpts, error) {
+	ws, err := workspace.Use(afero.NewOsFs())
+	if err != nil {
+		return nil, err
+	}
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("preview down"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, err
+	}
+	store := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	return &previewDownOpts{
+		previewOpts: previewOpts{
+			previewVars: vars,
+			store:       store,
+			ws:          ws,
+			sel:         selector.NewLocalWorkloadSelector(prompt.New(), store, ws, selector.OnlyInitializedWorkloads),
+			cmd:         exec.NewCmd(),
+		},
+		sess: sessProvider,
+		getSvcCFN: func(sess *awssession.Session) wlDeleter {
+			return cloudformation.New(sess, cloudformation.WithProgressTracker(os.Stderr))
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *previewDownOpts) Validate() error {
+	return o.validateBranch()
+}
+
+// Ask prompts for and validates any required flags.
+func (o *previewDownOpts) Ask() error {
+	return o.ask()
+}
+
+// ValidateVersionPin returns an error if the application doesn't allow this version of Copilot to delete its services.
+func (o *previewDownOpts) ValidateVersionPin() error {
+	return validateVersionPin(o.store, o.appName, version.Version, "")
+}
+
+// Execute deletes the stack of the preview of the branch.
+func (o *previewDownOpts) Execute() error {
+	env, err := o.store.GetEnvironment(o.appName, o.envName)
+	if err != nil {
+		return fmt.Errorf("get environment %s configuration: %w", o.envName, err)
+	}
+	sess, err := o.sess.FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return err
+	}
+	name := o.previewName()
+	if err := o.getSvcCFN(sess).DeleteWorkload(deploy.DeleteWorkloadInput{
+		Name:             name,
+		EnvName:          env.Name,
+		AppName:          o.appName,
+		ExecutionRoleARN: env.ExecutionRoleARN,
+	}); err != nil {
+		return fmt.Errorf("delete preview %s: %w", name, err)
+	}
+	log.Successf("Deleted preview %s of service %s for branch %s.\n",
+		color.HighlightUserInput(name), color.HighlightUserInput(o.name), color.HighlightUserInput(o.branch))
+	return nil
+}
+
+// buildPreviewDownCmd builds the command for deleting the preview of a service.
+func buildPreviewDownCmd() *cobra.Command {
+	vars := previewVars{}
+	cmd := &cobra.Command{
+		Use:   "down",
+		Short: "Deletes the copy of a service deployed for a git branch.",
+		Long:  "Deletes the copy of a service deployed for a git branch.",
+		Example: `
+  Delete the preview of the "frontend" service for the "feature/login" branch.
+  /code $ copilot preview down -n frontend -e preview --git-branch feature/login`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newPreviewDownOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", previewEnvFlagDescription)
+	cmd.Flags().StringVar(&vars.branch, gitBranchFlag, "", previewBranchFlagDescription)
+	markAudited(cmd)
+	return cmd
+}
@@ -0,0 +1,122 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPreviewDownOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inBranch string
+
+		wantedErr error
+	}{
+		"valid without a branch": {},
+		"valid branch": {
+			inBranch: "feature/login",
+		},
+		"error if the branch can't name a preview": {
+			inBranch:  "__",
+			wantedErr: errors.New(`invalid branch "__": branch name must contain at least one letter or digit`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := previewDownOpts{
+				previewOpts: previewOpts{
+					previewVars: previewVars{
+						branch: tc.inBranch,
+					},
+				},
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestPreviewDownOpts_Execute(t *testing.T) {
+	mockEnv := &config.Environment{
+		Name:             "preview",
+		ManagerRoleARN:   "arn:aws:iam::123456789012:role/myapp-preview-EnvManagerRole",
+		ExecutionRoleARN: "arn:aws:iam::123456789012:role/myapp-preview-CFNExecutionRole",
+		Region:           "us-west-2",
+	}
+	testCases := map[string]struct {
+		setup func(m *previewMocks)
+
+		wantedErr error
+	}{
+		"error if the preview can't be deleted": {
+			setup: func(m *previewMocks) {
+				m.store.EXPECT().GetEnvironment("myapp", "preview").Return(mockEnv, nil)
+				m.sess.EXPECT().FromRole(mockEnv.ManagerRoleARN, mockEnv.Region).Return(&session.Session{}, nil)
+				m.deleter.EXPECT().DeleteWorkload(gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedErr: errors.New("delete preview frontend-feature-login: some error"),
+		},
+		"deletes the stack of the preview": {
+			setup: func(m *previewMocks) {
+				m.store.EXPECT().GetEnvironment("myapp", "preview").Return(mockEnv, nil)
+				m.sess.EXPECT().FromRole(mockEnv.ManagerRoleARN, mockEnv.Region).Return(&session.Session{}, nil)
+				m.deleter.EXPECT().DeleteWorkload(deploy.DeleteWorkloadInput{
+					Name:             "frontend-feature-login",
+					EnvName:          "preview",
+					AppName:          "myapp",
+					ExecutionRoleARN: mockEnv.ExecutionRoleARN,
+				}).Return(nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &previewMocks{
+				store:   mocks.NewMockstore(ctrl),
+				sess:    mocks.NewMocksessionFromRoleProvider(ctrl),
+				deleter: mocks.NewMockwlDeleter(ctrl),
+			}
+			tc.setup(m)
+			opts := previewDownOpts{
+				previewOpts: previewOpts{
+					previewVars: previewVars{
+						appName: "myapp",
+						name:    "frontend",
+						envName: "preview",
+						branch:  "feature/login",
+					},
+					store: m.store,
+				},
+				sess: m.sess,
+				getSvcCFN: func(_ *session.Session) wlDeleter {
+					return m.deleter
+				},
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
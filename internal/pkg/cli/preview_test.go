@@ -0,0 +1,246 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPreviewBranchSlug(t *testing.T) {
+	testCases := map[string]struct {
+		inBranch string
+
+		wanted    string
+		wantedErr error
+	}{
+		"lowercase branch": {
+			inBranch: "main",
+			wanted:   "main",
+		},
+		"replaces invalid characters": {
+			inBranch: "feature/Login_Page",
+			wanted:   "feature-login-page",
+		},
+		"trims hyphens": {
+			inBranch: "--hotfix//",
+			wanted:   "hotfix",
+		},
+		"truncates long branches": {
+			inBranch: "dependabot/npm_and_yarn/lodash-4.17.21",
+			wanted:   "dependabot-npm-and-yarn-loda",
+		},
+		"error if the branch has no letter or digit": {
+			inBranch:  "///",
+			wantedErr: errors.New("branch name must contain at least one letter or digit"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := previewBranchSlug(tc.inBranch)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestPreviewHost(t *testing.T) {
+	testCases := map[string]struct {
+		inDomain string
+		inApp    *config.Application
+
+		wanted    string
+		wantedErr error
+	}{
+		"subdomain of the environment": {
+			inApp: &config.Application{
+				Name:   "myapp",
+				Domain: "example.com",
+			},
+			wanted: "feature-login.preview.myapp.example.com",
+		},
+		"subdomain of the provided domain": {
+			inDomain: "preview.example.com",
+			inApp: &config.Application{
+				Name: "myapp",
+			},
+			wanted: "feature-login.preview.example.com",
+		},
+		"error if there is no domain": {
+			inApp: &config.Application{
+				Name: "myapp",
+			},
+			wantedErr: errors.New("application myapp is not associated with a domain: specify the domain of the previews with --domain"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := previewHost("feature-login", tc.inDomain, tc.inApp, "preview")
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestApplyPreviewConfig(t *testing.T) {
+	t.Run("error if http is disabled", func(t *testing.T) {
+		mft := &manifest.LoadBalancedWebService{
+			Workload: manifest.Workload{
+				Name: aws.String("frontend"),
+			},
+		}
+		mft.HTTPOrBool.Enabled = aws.Bool(false)
+
+		err := applyPreviewConfig(mft, "frontend-feature", "feature.preview.myapp.example.com")
+
+		require.EqualError(t, err, `previews require "http" to be enabled for service frontend`)
+	})
+	t.Run("routes only the preview host to the copy", func(t *testing.T) {
+		mft := &manifest.LoadBalancedWebService{
+			Workload: manifest.Workload{
+				Name: aws.String("frontend"),
+			},
+		}
+		mft.HTTPOrBool.Main = manifest.RoutingRule{
+			Path: aws.String("/"),
+			Alias: manifest.Alias{
+				StringSliceOrString: manifest.StringSliceOrString{
+					String: aws.String("www.example.com"),
+				},
+			},
+			HostedZone: aws.String("Z0873220N255IR3MTNR4"),
+		}
+		mft.HTTPOrBool.AdditionalRoutingRules = []manifest.RoutingRule{
+			{
+				Path: aws.String("/admin"),
+			},
+		}
+		mft.NLBConfig.Listener.Port = aws.String("443/tls")
+
+		err := applyPreviewConfig(mft, "frontend-feature", "feature.preview.myapp.example.com")
+
+		require.NoError(t, err)
+		require.Equal(t, "frontend-feature", aws.StringValue(mft.Name))
+		require.Equal(t, "/", aws.StringValue(mft.HTTPOrBool.Main.Path))
+		require.Equal(t, manifest.Alias{
+			StringSliceOrString: manifest.StringSliceOrString{
+				String: aws.String("feature.preview.myapp.example.com"),
+			},
+		}, mft.HTTPOrBool.Main.Alias)
+		require.Nil(t, mft.HTTPOrBool.Main.HostedZone)
+		require.Nil(t, mft.HTTPOrBool.AdditionalRoutingRules)
+		require.True(t, mft.NLBConfig.IsEmpty())
+	})
+}
+
+func TestPreviewOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inVars previewVars
+		setup  func(m *previewMocks)
+
+		wantedVars previewVars
+		wantedErr  error
+	}{
+		"error if there is no application": {
+			setup:     func(m *previewMocks) {},
+			wantedErr: errNoAppInWorkspace,
+		},
+		"error if the service is not a load balanced web service": {
+			inVars: previewVars{
+				appName: "myapp",
+				name:    "api",
+			},
+			setup: func(m *previewMocks) {
+				m.store.EXPECT().GetApplication("myapp").Return(&config.Application{}, nil)
+				m.store.EXPECT().GetService("myapp", "api").Return(&config.Workload{Type: manifestinfo.BackendServiceType}, nil)
+			},
+			wantedErr: errors.New(`previews are only supported for services of type "Load Balanced Web Service", but api is a "Backend Service"`),
+		},
+		"error if the branch can't be determined": {
+			inVars: previewVars{
+				appName: "myapp",
+				name:    "frontend",
+				envName: "preview",
+			},
+			setup: func(m *previewMocks) {
+				m.store.EXPECT().GetApplication("myapp").Return(&config.Application{}, nil)
+				m.store.EXPECT().GetService("myapp", "frontend").Return(&config.Workload{Type: manifestinfo.LoadBalancedWebServiceType}, nil)
+				m.store.EXPECT().GetEnvironment("myapp", "preview").Return(&config.Environment{}, nil)
+				m.cmd.EXPECT().Run("git", []string{"rev-parse", "--abbrev-ref", "HEAD"}, gomock.Any(), gomock.Any()).Return(errors.New("not a git repository"))
+			},
+			wantedErr: errors.New("could not determine the current git branch: specify the branch with --git-branch"),
+		},
+		"prompts for the service and environment": {
+			inVars: previewVars{
+				appName: "myapp",
+				branch:  "feature/login",
+			},
+			setup: func(m *previewMocks) {
+				m.store.EXPECT().GetApplication("myapp").Return(&config.Application{}, nil)
+				m.sel.EXPECT().Service("Select a service to preview", "").Return("frontend", nil)
+				m.store.EXPECT().GetService("myapp", "frontend").Return(&config.Workload{Type: manifestinfo.LoadBalancedWebServiceType}, nil)
+				m.sel.EXPECT().Environment("Select the environment that hosts previews", "", "myapp").Return("preview", nil)
+			},
+			wantedVars: previewVars{
+				appName: "myapp",
+				name:    "frontend",
+				envName: "preview",
+				branch:  "feature/login",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &previewMocks{
+				store: mocks.NewMockstore(ctrl),
+				sel:   mocks.NewMockwsSelector(ctrl),
+				cmd:   mocks.NewMockexecRunner(ctrl),
+			}
+			tc.setup(m)
+			opts := previewOpts{
+				previewVars: tc.inVars,
+				store:       m.store,
+				sel:         m.sel,
+				cmd:         m.cmd,
+			}
+
+			err := opts.ask()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedVars, opts.previewVars)
+		})
+	}
+}
+
+type previewMocks struct {
+	store   *mocks.Mockstore
+	sel     *mocks.MockwsSelector
+	cmd     *mocks.MockexecRunner
+	sess    *mocks.MocksessionFromRoleProvider
+	deleter *mocks.MockwlDeleter
+}
@@ -0,0 +1,245 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	awscfn "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/tags"
+	clideploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/version"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+type previewUpVars struct {
+	previewVars
+	domain string
+}
+
+type previewUpOpts struct {
+	previewOpts
+	domain string
+
+	sessProvider       *sessions.Provider
+	identity           identityService
+	unmarshal          func([]byte) (manifest.DynamicWorkload, error)
+	newInterpolator    func(app, env string) interpolator
+	newEnvDescriber    func(app, env string) (versionCompatibilityChecker, error)
+	newPreviewDeployer func(in *clideploy.WorkloadDeployerInput) (workloadDeployer, error)
+	templateVersion    string
+}
+
+func newPreviewUpOpts(vars previewUpVars) (*previewUpOpts, error) {
+	ws, err := workspace.Use(afero.NewOsFs())
+	if err != nil {
+		return nil, err
+	}
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("preview up"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, err
+	}
+	store := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	return &previewUpOpts{
+		previewOpts: previewOpts{
+			previewVars: vars.previewVars,
+			store:       store,
+			ws:          ws,
+			sel:         selector.NewLocalWorkloadSelector(prompt.New(), store, ws, selector.OnlyInitializedWorkloads),
+			cmd:         exec.NewCmd(),
+		},
+		domain:          vars.domain,
+		sessProvider:    sessProvider,
+		identity:        identity.New(defaultSess),
+		unmarshal:       manifest.UnmarshalWorkload,
+		newInterpolator: newManifestInterpolator,
+		newEnvDescriber: func(app, env string) (versionCompatibilityChecker, error) {
+			return describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
+				App:         app,
+				Env:         env,
+				ConfigStore: store,
+			})
+		},
+		newPreviewDeployer: func(in *clideploy.WorkloadDeployerInput) (workloadDeployer, error) {
+			return clideploy.NewLBWSDeployer(in)
+		},
+		templateVersion: version.LatestTemplateVersion(),
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *previewUpOpts) Validate() error {
+	return o.validateBranch()
+}
+
+// Ask prompts for and validates any required flags.
+func (o *previewUpOpts) Ask() error {
+	return o.ask()
+}
+
+// ValidateVersionPin returns an error if the application doesn't allow this version of Copilot to deploy its services.
+func (o *previewUpOpts) ValidateVersionPin() error {
+	return validateVersionPin(o.store, o.appName, o.templateVersion, "")
+}
+
+// Execute deploys a copy of the service that is routed to by the host of the branch.
+func (o *previewUpOpts) Execute() error {
+	app, err := o.store.GetApplication(o.appName)
+	if err != nil {
+		return fmt.Errorf("get application %s configuration: %w", o.appName, err)
+	}
+	env, err := o.store.GetEnvironment(o.appName, o.envName)
+	if err != nil {
+		return fmt.Errorf("get environment %s configuration: %w", o.envName, err)
+	}
+	slug, err := previewBranchSlug(o.branch)
+	if err != nil {
+		return fmt.Errorf("invalid branch %q: %w", o.branch, err)
+	}
+	host, err := previewHost(slug, o.domain, app, env.Name)
+	if err != nil {
+		return err
+	}
+	envSess, err := o.sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return err
+	}
+	mft, interpolated, err := workloadManifest(&workloadManifestInput{
+		name:         o.name,
+		appName:      o.appName,
+		envName:      o.envName,
+		ws:           o.ws,
+		interpolator: o.newInterpolator(o.appName, o.envName),
+		unmarshal:    o.unmarshal,
+		sess:         envSess,
+		deployValues: previewDeployValues,
+	})
+	if err != nil {
+		return err
+	}
+	lbMft, ok := mft.Manifest().(*manifest.LoadBalancedWebService)
+	if !ok {
+		return fmt.Errorf("manifest of service %s is not a Load Balanced Web Service manifest", o.name)
+	}
+	name := previewWorkloadName(o.name, slug)
+	if err := applyPreviewConfig(lbMft, name, host); err != nil {
+		return err
+	}
+	envDescriber, err := o.newEnvDescriber(o.appName, o.envName)
+	if err != nil {
+		return err
+	}
+	if err := validateWorkloadManifestCompatibilityWithEnv(o.ws, envDescriber, mft, o.envName); err != nil {
+		return err
+	}
+	ovrdr, err := clideploy.NewOverrider(o.ws.WorkloadOverridesPath(o.name), o.appName, o.envName, afero.NewOsFs(), o.sessProvider)
+	if err != nil {
+		return err
+	}
+	deployer, err := o.newPreviewDeployer(&clideploy.WorkloadDeployerInput{
+		SessionProvider: o.sessProvider,
+		Name:            name,
+		Source:          o.name,
+		App:             app,
+		Env:             env,
+		Image: clideploy.ContainerImageIdentifier{
+			GitShortCommitTag: imageTagFromGit(o.cmd),
+			GitBranch:         o.branch,
+		},
+		Mft:              lbMft,
+		RawMft:           interpolated,
+		EnvVersionGetter: envDescriber,
+		Overrider:        ovrdr,
+	})
+	if err != nil {
+		return fmt.Errorf("initiate workload deployer: %w", err)
+	}
+	caller, err := o.identity.Get()
+	if err != nil {
+		return fmt.Errorf("get identity: %w", err)
+	}
+	uploadOut, err := deployer.UploadArtifacts()
+	if err != nil {
+		return fmt.Errorf("upload deploy resources for preview %s: %w", name, err)
+	}
+	_, err = deployer.DeployWorkload(&clideploy.DeployWorkloadInput{
+		StackRuntimeConfiguration: clideploy.StackRuntimeConfiguration{
+			ImageDigests:       uploadOut.ImageDigests,
+			EnvFileARNs:        uploadOut.EnvFileARNs,
+			AddonsURL:          uploadOut.AddonsURL,
+			RootUserARN:        caller.RootUserARN,
+			Tags:               tags.Merge(app.Tags, map[string]string{previewTagKey: slug}),
+			CustomResourceURLs: uploadOut.CustomResourceURLs,
+			Version:            o.templateVersion,
+			DeployValues:       previewDeployValues,
+		},
+	})
+	if err != nil {
+		var errEmptyChangeSet *awscfn.ErrChangeSetEmpty
+		if !errors.As(err, &errEmptyChangeSet) {
+			return fmt.Errorf("deploy preview %s to environment %s: %w", name, o.envName, err)
+		}
+		log.Infof("Preview %s is already up to date.\n", color.HighlightUserInput(name))
+	} else {
+		log.Successf("Deployed preview %s of service %s for branch %s.\n",
+			color.HighlightUserInput(name), color.HighlightUserInput(o.name), color.HighlightUserInput(o.branch))
+	}
+	log.Infof("You can access the preview at %s.\n", color.HighlightResource("https://"+host))
+	return nil
+}
+
+// RecommendActions returns follow-up actions the user can take after successfully executing the command.
+func (o *previewUpOpts) RecommendActions() error {
+	logRecommendedActions([]string{
+		fmt.Sprintf("Run %s to delete the preview once the branch is merged.",
+			color.HighlightCode(fmt.Sprintf("copilot preview down -n %s -e %s --%s %s", o.name, o.envName, gitBranchFlag, o.branch))),
+	})
+	return nil
+}
+
+// buildPreviewUpCmd builds the command for deploying the preview of a service.
+func buildPreviewUpCmd() *cobra.Command {
+	vars := previewUpVars{}
+	cmd := &cobra.Command{
+		Use:   "up",
+		Short: "Deploys a copy of a service for a git branch.",
+		Long: `Deploys a copy of a Load Balanced Web Service for a git branch to the environment that hosts previews.
+The copy runs a single small task, and is only reachable through the host "<branch>.<env>.<app>.<domain>".`,
+		Example: `
+  Deploy the preview of the "frontend" service for the current branch to the "preview" environment.
+  /code $ copilot preview up -n frontend -e preview
+  Deploy the preview of a pull request branch under a domain of imported certificates.
+  /code $ copilot preview up -n frontend -e preview --git-branch feature/login --domain preview.example.com`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newPreviewUpOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", previewEnvFlagDescription)
+	cmd.Flags().StringVar(&vars.branch, gitBranchFlag, "", previewBranchFlagDescription)
+	cmd.Flags().StringVar(&vars.domain, domainNameFlag, "", previewDomainFlagDescription)
+	markAudited(cmd)
+	return cmd
+}
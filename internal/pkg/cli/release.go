@@ -0,0 +1,29 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/spf13/cobra"
+)
+
+// BuildReleaseCmd is the top level command for releases.
+func BuildReleaseCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "release",
+		Short: `Commands for releases.
+Deploy several workloads together with the versions described in copilot/release.yml.`,
+		Long: `Commands for releases.
+Deploy several workloads together with the versions described in copilot/release.yml.`,
+	}
+
+	cmd.AddCommand(buildReleaseDeployCmd())
+
+	cmd.SetUsageTemplate(template.Usage)
+	cmd.Annotations = map[string]string{
+		"group": group.Release,
+	}
+	return cmd
+}
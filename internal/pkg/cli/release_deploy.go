@@ -0,0 +1,324 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/version"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/dustin/go-humanize/english"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const (
+	releaseDeployEnvPrompt     = "Select an environment to deploy the release to"
+	releaseDeployEnvHelpPrompt = "The workloads of the release are deployed to this environment."
+)
+
+type releaseDeployVars struct {
+	appName string
+	envName string
+}
+
+type releaseDeployOpts struct {
+	releaseDeployVars
+
+	store  store
+	ws     wsReleaseReader
+	sel    appEnvSelector
+	runner execRunner
+
+	// Sets up the command to deploy a workload of the release, reading its manifest from ws.
+	newWkldDeployCmd func(wkld manifest.ReleaseWorkload, wkldType string, ws wsWlDirReader) (actionCommand, error)
+
+	// Sets up the clients to snapshot and restore the stacks of the workloads.
+	configureStacks func() error
+	stacks          workloadStackRestorer
+	bucket          string
+	targetEnv       *config.Environment
+}
+
+func newReleaseDeployOpts(vars releaseDeployVars) (*releaseDeployOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("release deploy"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+	store := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	ws, err := workspace.Use(afero.NewOsFs())
+	if err != nil {
+		return nil, err
+	}
+	wkldDeployOpts, err := newDeployOpts(deployVars{
+		deployWkldVars: deployWkldVars{
+			appName: vars.appName,
+			envName: vars.envName,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	opts := &releaseDeployOpts{
+		releaseDeployVars: vars,
+		store:             store,
+		ws:                ws,
+		sel:               selector.NewAppEnvSelector(prompt.New(), store),
+		runner:            exec.NewCmd(),
+	}
+	opts.newWkldDeployCmd = func(wkld manifest.ReleaseWorkload, wkldType string, ws wsWlDirReader) (actionCommand, error) {
+		wkldDeployOpts.envName = opts.envName
+		wkldDeployOpts.imageTag = aws.StringValue(wkld.ImageTag)
+		wkldDeployOpts.ws = ws
+		return wkldDeployOpts.setupDeployCmd(wkldDeployOpts, wkld.Name, wkldType)
+	}
+	opts.configureStacks = func() error {
+		env, err := opts.store.GetEnvironment(opts.appName, opts.envName)
+		if err != nil {
+			return fmt.Errorf("get environment %s configuration: %w", opts.envName, err)
+		}
+		app, err := opts.store.GetApplication(opts.appName)
+		if err != nil {
+			return fmt.Errorf("get application %s configuration: %w", opts.appName, err)
+		}
+		resources, err := cloudformation.New(defaultSess).GetAppResourcesByRegion(app, env.Region)
+		if err != nil {
+			return fmt.Errorf("get application %s resources from region %s: %w", app.Name, env.Region, err)
+		}
+		envSess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+		if err != nil {
+			return fmt.Errorf("create session with environment manager role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+		}
+		opts.targetEnv = env
+		opts.bucket = resources.S3Bucket
+		opts.stacks = cloudformation.New(envSess, cloudformation.WithProgressTracker(os.Stderr))
+		return nil
+	}
+	return opts, nil
+}
+
+// Validate returns an error if the application or environment doesn't exist.
+func (o *releaseDeployOpts) Validate() error {
+	if o.appName == "" {
+		return errNoAppInWorkspace
+	}
+	if _, err := o.store.GetApplication(o.appName); err != nil {
+		return fmt.Errorf("get application %s configuration: %w", o.appName, err)
+	}
+	if o.envName == "" {
+		return nil
+	}
+	if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+		return fmt.Errorf("get environment %s configuration: %w", o.envName, err)
+	}
+	return nil
+}
+
+// Ask prompts for the environment to deploy the release to if it's not provided.
+func (o *releaseDeployOpts) Ask() error {
+	if o.envName != "" {
+		return nil
+	}
+	name, err := o.sel.Environment(releaseDeployEnvPrompt, releaseDeployEnvHelpPrompt, o.appName)
+	if err != nil {
+		return fmt.Errorf("select environment: %w", err)
+	}
+	o.envName = name
+	return nil
+}
+
+// ValidateVersionPin returns an error if the application doesn't allow this version of Copilot to deploy its workloads.
+func (o *releaseDeployOpts) ValidateVersionPin() error {
+	return validateVersionPin(o.store, o.appName, version.Version, "")
+}
+
+// Execute deploys the workloads of the release manifest in order.
+// If a workload fails to deploy, the workloads deployed before it are restored to their previous version.
+func (o *releaseDeployOpts) Execute() error {
+	release, err := o.ws.ReadReleaseManifest()
+	if err != nil {
+		return fmt.Errorf("read release manifest: %w", err)
+	}
+	if err := release.Validate(); err != nil {
+		return fmt.Errorf("validate release manifest: %w", err)
+	}
+
+	log.Infoln("Checking for all required information. We may ask you some questions.")
+	cmds := make([]workloadCommand, len(release.Workloads))
+	for i, wkld := range release.Workloads {
+		cmd, err := o.loadWkldCmd(wkld)
+		if err != nil {
+			return err
+		}
+		if err := cmd.Ask(); err != nil {
+			return fmt.Errorf("ask %s deploy: %w", wkld.Name, err)
+		}
+		if err := cmd.Validate(); err != nil {
+			return fmt.Errorf("validate %s deploy: %w", wkld.Name, err)
+		}
+		cmds[i] = workloadCommand{
+			actionCommand: cmd,
+			name:          wkld.Name,
+		}
+	}
+
+	if err := o.configureStacks(); err != nil {
+		return err
+	}
+	snapshots := make([]*cloudformation.DeployedStack, len(cmds))
+	for i, cmd := range cmds {
+		snapshot, err := o.stacks.DeployedStack(stack.NameForWorkload(o.appName, o.envName, cmd.name))
+		var errNotFound *awscloudformation.ErrStackNotFound
+		if err != nil && !errors.As(err, &errNotFound) {
+			return fmt.Errorf("get deployed stack of %s: %w", cmd.name, err)
+		}
+		snapshots[i] = snapshot
+	}
+
+	logReleaseInfo(release, o.envName)
+	for i, cmd := range cmds {
+		err := cmd.Execute()
+		var errNoInfraChanges *errNoInfrastructureChanges
+		if err == nil || errors.As(err, &errNoInfraChanges) {
+			continue
+		}
+		deployErr := fmt.Errorf("deploy workload %d of %d %s: %w", i+1, len(cmds), cmd.name, err)
+		if i == 0 {
+			return deployErr
+		}
+		log.Errorf("Failed to deploy %s. Rolling back the workloads deployed before it.\n", cmd.name)
+		if err := o.rollback(cmds[:i], snapshots[:i]); err != nil {
+			return fmt.Errorf("%w\n%v", deployErr, err)
+		}
+		return deployErr
+	}
+	log.Successf("Deployed release of %d %s to environment %s.\n", len(cmds), english.PluralWord(len(cmds), "workload", ""), color.HighlightUserInput(o.envName))
+	return nil
+}
+
+func (o *releaseDeployOpts) loadWkldCmd(wkld manifest.ReleaseWorkload) (actionCommand, error) {
+	wl, err := o.store.GetWorkload(o.appName, wkld.Name)
+	if err != nil {
+		return nil, fmt.Errorf("retrieve %s from application %s: %w", wkld.Name, o.appName, err)
+	}
+	var ws wsWlDirReader = o.ws
+	if wkld.ManifestRevision != nil {
+		ws = &revisionWorkspace{
+			wsWlDirReader: o.ws,
+			name:          wkld.Name,
+			revision:      aws.StringValue(wkld.ManifestRevision),
+			runner:        o.runner,
+		}
+	}
+	return o.newWkldDeployCmd(wkld, wl.Type, ws)
+}
+
+// rollback restores the stacks of the deployed workloads to their snapshots, in the reverse order of the release.
+func (o *releaseDeployOpts) rollback(cmds []workloadCommand, snapshots []*cloudformation.DeployedStack) error {
+	var errs []error
+	for i := len(cmds) - 1; i >= 0; i-- {
+		name := cmds[i].name
+		if snapshots[i] == nil {
+			log.Warningf("%s wasn't deployed to environment %s before the release, so it can't be rolled back. Delete it to remove it from the environment.\n", name, o.envName)
+			continue
+		}
+		log.Infof("Rolling back %s to its previous version.\n", color.HighlightUserInput(name))
+		err := o.stacks.DeployService(snapshots[i], o.bucket, false, awscloudformation.WithRoleARN(o.targetEnv.ExecutionRoleARN))
+		var errEmptyCS *awscloudformation.ErrChangeSetEmpty
+		if err != nil && !errors.As(err, &errEmptyCS) {
+			errs = append(errs, fmt.Errorf("roll back %s: %w", name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// RecommendActions is a no-op for this command.
+func (o *releaseDeployOpts) RecommendActions() error {
+	return nil
+}
+
+func logReleaseInfo(release *manifest.Release, env string) {
+	log.Infof("Will deploy %d %s to environment %s in the following order.\n", len(release.Workloads), english.PluralWord(len(release.Workloads), "workload", ""), env)
+	for i, wkld := range release.Workloads {
+		var versions []string
+		if wkld.ImageTag != nil {
+			versions = append(versions, fmt.Sprintf("image tag %s", aws.StringValue(wkld.ImageTag)))
+		}
+		if wkld.ManifestRevision != nil {
+			versions = append(versions, fmt.Sprintf("manifest at %s", aws.StringValue(wkld.ManifestRevision)))
+		}
+		if len(versions) == 0 {
+			log.Infof("%d. %s\n", i+1, wkld.Name)
+			continue
+		}
+		log.Infof("%d. %s (%s)\n", i+1, wkld.Name, strings.Join(versions, ", "))
+	}
+}
+
+// revisionWorkspace reads the manifest of a workload from a git revision instead of the working tree.
+type revisionWorkspace struct {
+	wsWlDirReader
+	name     string
+	revision string
+	runner   execRunner
+}
+
+// ReadWorkloadManifest returns the contents of the workload's manifest at the git revision.
+func (ws *revisionWorkspace) ReadWorkloadManifest(name string) (workspace.WorkloadManifest, error) {
+	if name != ws.name {
+		return ws.wsWlDirReader.ReadWorkloadManifest(name)
+	}
+	var buf bytes.Buffer
+	path := fmt.Sprintf("%s:./%s/%s/manifest.yml", ws.revision, workspace.CopilotDirName, name)
+	if err := ws.runner.Run("git", []string{"-C", ws.Path(), "show", path}, exec.Stdout(&buf)); err != nil {
+		return nil, fmt.Errorf("read manifest of %s at git revision %s: %w", name, ws.revision, err)
+	}
+	return workspace.WorkloadManifest(buf.Bytes()), nil
+}
+
+// buildReleaseDeployCmd builds the command to deploy a release.
+func buildReleaseDeployCmd() *cobra.Command {
+	vars := releaseDeployVars{}
+	cmd := &cobra.Command{
+		Use:   "deploy",
+		Short: "Deploys the workloads of copilot/release.yml to an environment.",
+		Long: `Deploys the workloads of copilot/release.yml to an environment.
+The workloads are deployed one at a time in the order of the release manifest, with their image tag
+and manifest revision. If a workload fails to deploy, the workloads deployed before it are rolled back
+to the version they had before the release.`,
+		Example: `
+  Deploy the release to the "prod" environment.
+  /code $ copilot release deploy -e prod`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newReleaseDeployOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	markAudited(cmd)
+	return cmd
+}
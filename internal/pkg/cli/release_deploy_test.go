@@ -0,0 +1,270 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	osexec "os/exec"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReleaseDeployOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inEnv   string
+		mockSel func(m *mocks.MockappEnvSelector)
+		wantEnv string
+		wantErr string
+	}{
+		"does not prompt if the environment is provided": {
+			inEnv:   "prod",
+			mockSel: func(m *mocks.MockappEnvSelector) {},
+			wantEnv: "prod",
+		},
+		"prompts for the environment": {
+			mockSel: func(m *mocks.MockappEnvSelector) {
+				m.EXPECT().Environment(releaseDeployEnvPrompt, releaseDeployEnvHelpPrompt, "phonetool").Return("prod", nil)
+			},
+			wantEnv: "prod",
+		},
+		"wraps the selector error": {
+			mockSel: func(m *mocks.MockappEnvSelector) {
+				m.EXPECT().Environment(gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantErr: "select environment: some error",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			sel := mocks.NewMockappEnvSelector(ctrl)
+			tc.mockSel(sel)
+			opts := &releaseDeployOpts{
+				releaseDeployVars: releaseDeployVars{
+					appName: "phonetool",
+					envName: tc.inEnv,
+				},
+				sel: sel,
+			}
+
+			err := opts.Ask()
+
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantEnv, opts.envName)
+		})
+	}
+}
+
+type releaseDeployMocks struct {
+	store  *mocks.Mockstore
+	ws     *mocks.MockwsReleaseReader
+	stacks *mocks.MockworkloadStackRestorer
+	api    *mocks.MockactionCommand
+	worker *mocks.MockactionCommand
+}
+
+func TestReleaseDeployOpts_Execute(t *testing.T) {
+	apiSnapshot := &cloudformation.DeployedStack{}
+	release := &manifest.Release{
+		Workloads: []manifest.ReleaseWorkload{
+			{Name: "api", ImageTag: aws.String("v2")},
+			{Name: "worker"},
+		},
+	}
+	mockWorkloads := func(m *releaseDeployMocks) {
+		m.ws.EXPECT().ReadReleaseManifest().Return(release, nil)
+		m.store.EXPECT().GetWorkload("phonetool", "api").Return(&config.Workload{Name: "api", Type: manifestinfo.LoadBalancedWebServiceType}, nil)
+		m.store.EXPECT().GetWorkload("phonetool", "worker").Return(&config.Workload{Name: "worker", Type: manifestinfo.WorkerServiceType}, nil)
+		m.api.EXPECT().Ask().Return(nil)
+		m.api.EXPECT().Validate().Return(nil)
+		m.worker.EXPECT().Ask().Return(nil)
+		m.worker.EXPECT().Validate().Return(nil)
+	}
+	testCases := map[string]struct {
+		setupMocks func(m *releaseDeployMocks)
+		wantErr    string
+	}{
+		"error if the release manifest is invalid": {
+			setupMocks: func(m *releaseDeployMocks) {
+				m.ws.EXPECT().ReadReleaseManifest().Return(&manifest.Release{}, nil)
+			},
+			wantErr: `validate release manifest: "workloads" must contain at least one workload`,
+		},
+		"error if a workload fails validation": {
+			setupMocks: func(m *releaseDeployMocks) {
+				m.ws.EXPECT().ReadReleaseManifest().Return(release, nil)
+				m.store.EXPECT().GetWorkload("phonetool", "api").Return(&config.Workload{Name: "api", Type: manifestinfo.LoadBalancedWebServiceType}, nil)
+				m.api.EXPECT().Ask().Return(nil)
+				m.api.EXPECT().Validate().Return(errors.New("some error"))
+			},
+			wantErr: "validate api deploy: some error",
+		},
+		"error if a deployed stack can't be snapshotted": {
+			setupMocks: func(m *releaseDeployMocks) {
+				mockWorkloads(m)
+				m.stacks.EXPECT().DeployedStack("phonetool-prod-api").Return(nil, errors.New("some error"))
+			},
+			wantErr: "get deployed stack of api: some error",
+		},
+		"deploys the workloads in order": {
+			setupMocks: func(m *releaseDeployMocks) {
+				mockWorkloads(m)
+				m.stacks.EXPECT().DeployedStack("phonetool-prod-api").Return(apiSnapshot, nil)
+				m.stacks.EXPECT().DeployedStack("phonetool-prod-worker").Return(nil, &awscloudformation.ErrStackNotFound{})
+				gomock.InOrder(
+					m.api.EXPECT().Execute().Return(nil),
+					m.worker.EXPECT().Execute().Return(&errNoInfrastructureChanges{}),
+				)
+			},
+		},
+		"does not roll back if the first workload fails": {
+			setupMocks: func(m *releaseDeployMocks) {
+				mockWorkloads(m)
+				m.stacks.EXPECT().DeployedStack(gomock.Any()).Return(apiSnapshot, nil).Times(2)
+				m.api.EXPECT().Execute().Return(errors.New("some error"))
+			},
+			wantErr: "deploy workload 1 of 2 api: some error",
+		},
+		"rolls back the deployed workloads if a later workload fails": {
+			setupMocks: func(m *releaseDeployMocks) {
+				mockWorkloads(m)
+				m.stacks.EXPECT().DeployedStack("phonetool-prod-api").Return(apiSnapshot, nil)
+				m.stacks.EXPECT().DeployedStack("phonetool-prod-worker").Return(nil, &awscloudformation.ErrStackNotFound{})
+				m.api.EXPECT().Execute().Return(nil)
+				m.worker.EXPECT().Execute().Return(errors.New("some error"))
+				m.stacks.EXPECT().DeployService(apiSnapshot, "bucket", false, gomock.Any()).Return(nil)
+			},
+			wantErr: "deploy workload 2 of 2 worker: some error",
+		},
+		"surfaces rollback errors": {
+			setupMocks: func(m *releaseDeployMocks) {
+				mockWorkloads(m)
+				m.stacks.EXPECT().DeployedStack(gomock.Any()).Return(apiSnapshot, nil).Times(2)
+				m.api.EXPECT().Execute().Return(nil)
+				m.worker.EXPECT().Execute().Return(errors.New("some error"))
+				m.stacks.EXPECT().DeployService(apiSnapshot, "bucket", false, gomock.Any()).Return(errors.New("rollback error"))
+			},
+			wantErr: "deploy workload 2 of 2 worker: some error\nroll back api: rollback error",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &releaseDeployMocks{
+				store:  mocks.NewMockstore(ctrl),
+				ws:     mocks.NewMockwsReleaseReader(ctrl),
+				stacks: mocks.NewMockworkloadStackRestorer(ctrl),
+				api:    mocks.NewMockactionCommand(ctrl),
+				worker: mocks.NewMockactionCommand(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &releaseDeployOpts{
+				releaseDeployVars: releaseDeployVars{
+					appName: "phonetool",
+					envName: "prod",
+				},
+				store: m.store,
+				ws:    m.ws,
+				newWkldDeployCmd: func(wkld manifest.ReleaseWorkload, _ string, _ wsWlDirReader) (actionCommand, error) {
+					if wkld.Name == "api" {
+						return m.api, nil
+					}
+					return m.worker, nil
+				},
+				configureStacks: func() error { return nil },
+				stacks:          m.stacks,
+				bucket:          "bucket",
+				targetEnv:       &config.Environment{ExecutionRoleARN: "arn:aws:iam::123456789012:role/exec"},
+			}
+
+			err := opts.Execute()
+
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRevisionWorkspace_ReadWorkloadManifest(t *testing.T) {
+	t.Run("reads the manifest of the workload from the git revision", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ws := mocks.NewMockwsReleaseReader(ctrl)
+		runner := mocks.NewMockexecRunner(ctrl)
+		ws.EXPECT().Path().Return("/code")
+		runner.EXPECT().Run("git", []string{"-C", "/code", "show", "3f2a1c9:./copilot/api/manifest.yml"}, gomock.Any()).
+			DoAndReturn(func(_ string, _ []string, opts ...exec.CmdOption) error {
+				cmd := &osexec.Cmd{}
+				for _, opt := range opts {
+					opt(cmd)
+				}
+				_, err := cmd.Stdout.Write([]byte("name: api"))
+				return err
+			})
+		rws := &revisionWorkspace{
+			wsWlDirReader: ws,
+			name:          "api",
+			revision:      "3f2a1c9",
+			runner:        runner,
+		}
+
+		got, err := rws.ReadWorkloadManifest("api")
+
+		require.NoError(t, err)
+		require.Equal(t, "name: api", string(got))
+	})
+	t.Run("wraps the git error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ws := mocks.NewMockwsReleaseReader(ctrl)
+		runner := mocks.NewMockexecRunner(ctrl)
+		ws.EXPECT().Path().Return("/code")
+		runner.EXPECT().Run("git", gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+		rws := &revisionWorkspace{
+			wsWlDirReader: ws,
+			name:          "api",
+			revision:      "3f2a1c9",
+			runner:        runner,
+		}
+
+		_, err := rws.ReadWorkloadManifest("api")
+
+		require.EqualError(t, err, "read manifest of api at git revision 3f2a1c9: some error")
+	})
+	t.Run("reads other workloads from the working tree", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ws := mocks.NewMockwsReleaseReader(ctrl)
+		ws.EXPECT().ReadWorkloadManifest("worker").Return([]byte("name: worker"), nil)
+		rws := &revisionWorkspace{
+			wsWlDirReader: ws,
+			name:          "api",
+			revision:      "3f2a1c9",
+		}
+
+		got, err := rws.ReadWorkloadManifest("worker")
+
+		require.NoError(t, err)
+		require.Equal(t, "name: worker", string(got))
+	})
+}
@@ -112,6 +112,7 @@ type runLocalVars struct {
 	portOverrides portOverrides
 	proxy         bool
 	proxyNetwork  net.IPNet
+	withWklds     []string
 }
 
 type runLocalOpts struct {
@@ -131,7 +132,7 @@ type runLocalOpts struct {
 	ws             wsWlDirReader
 	cmd            execRunner
 	dockerEngine   dockerEngineRunner
-	repository     repositoryService
+	repositories   map[string]repositoryService
 	prog           progress
 	orchestrator   containerOrchestrator
 	hostFinder     hostFinder
@@ -140,7 +141,7 @@ type runLocalOpts struct {
 	dockerExcludes []string
 
 	newRecursiveWatcher  func() (recursiveWatcher, error)
-	buildContainerImages func(mft manifest.DynamicWorkload) (map[string]string, error)
+	buildContainerImages func(name string, mft manifest.DynamicWorkload) (map[string]string, error)
 	configureClients     func() error
 	labeledTermPrinter   func(fw syncbuffer.FileWriter, bufs []*syncbuffer.LabeledSyncBuffer, opts ...syncbuffer.LabeledTermPrinterOption) clideploy.LabeledTermPrinter
 	unmarshal            func([]byte) (manifest.DynamicWorkload, error)
@@ -206,8 +207,11 @@ func newRunLocalOpts(vars runLocalVars) (*runLocalOpts, error) {
 		if err != nil {
 			return fmt.Errorf("get application %s resources from region %s: %w", o.appName, o.envName, err)
 		}
-		repoName := clideploy.RepoName(o.appName, o.wkldName)
-		o.repository = repository.NewWithURI(ecr.New(defaultSessEnvRegion), repoName, resources.RepositoryURLs[o.wkldName])
+		o.repositories = make(map[string]repositoryService)
+		for _, name := range o.workloads() {
+			repoName := clideploy.RepoName(o.appName, name)
+			o.repositories[name] = repository.NewWithURI(ecr.New(defaultSessEnvRegion), repoName, resources.RepositoryURLs[name])
+		}
 
 		idPrefix := fmt.Sprintf("%s-%s-%s-", o.appName, o.envName, o.wkldName)
 		colorGen := termcolor.ColorGenerator()
@@ -238,8 +242,9 @@ func newRunLocalOpts(vars runLocalVars) (*runLocalOpts, error) {
 		o.envChecker = envDesc
 		return nil
 	}
-	o.buildContainerImages = func(mft manifest.DynamicWorkload) (map[string]string, error) {
-		if dockerWkld, ok := mft.Manifest().(dockerWorkload); ok {
+	o.buildContainerImages = func(name string, mft manifest.DynamicWorkload) (map[string]string, error) {
+		// Only the .dockerignore file of the main workload is used to filter watched files.
+		if dockerWkld, ok := mft.Manifest().(dockerWorkload); ok && name == o.wkldName {
 			dfDir := filepath.Dir(dockerWkld.Dockerfile())
 			o.dockerExcludes, err = dockerfile.ReadDockerignore(afero.NewOsFs(), filepath.Join(ws.Path(), dfDir))
 			if err != nil {
@@ -254,13 +259,13 @@ func newRunLocalOpts(vars runLocalVars) (*runLocalOpts, error) {
 		}
 		out := &clideploy.UploadArtifactsOutput{}
 		if err := clideploy.BuildContainerImages(&clideploy.ImageActionInput{
-			Name:               o.wkldName,
+			Name:               name,
 			WorkspacePath:      o.ws.Path(),
 			Image:              image,
 			Mft:                mft.Manifest(),
 			GitShortCommitTag:  gitShortCommit,
-			Builder:            o.repository,
-			Login:              o.repository.Login,
+			Builder:            o.repositories[name],
+			Login:              o.repositories[name].Login,
 			CheckDockerEngine:  o.dockerEngine.CheckDockerEngineRunning,
 			LabeledTermPrinter: o.labeledTermPrinter,
 		}, out); err != nil {
@@ -326,7 +331,22 @@ func (o *runLocalOpts) Validate() error {
 
 // Ask prompts the user for any unprovided required fields and validates them.
 func (o *runLocalOpts) Ask() error {
-	return o.validateAndAskWkldEnvName()
+	if err := o.validateAndAskWkldEnvName(); err != nil {
+		return err
+	}
+	return o.validateWithWklds()
+}
+
+func (o *runLocalOpts) validateWithWklds() error {
+	for _, name := range o.withWklds {
+		if name == o.wkldName {
+			return fmt.Errorf("workload %q cannot be run with itself", name)
+		}
+		if _, err := o.store.GetWorkload(o.appName, name); err != nil {
+			return fmt.Errorf("get workload %q: %w", name, err)
+		}
+	}
+	return nil
 }
 
 func (o *runLocalOpts) validateAndAskWkldEnvName() error {
@@ -385,6 +405,10 @@ func (o *runLocalOpts) Execute() error {
 		if err != nil {
 			return fmt.Errorf("find hosts to connect to: %w", err)
 		}
+		// Workloads that run locally are reached through their local containers instead of the proxy.
+		hosts = slices.DeleteFunc(hosts, func(h orchestrator.Host) bool {
+			return slices.Contains(task.LocalHosts, h.Name)
+		})
 
 		ssmTarget, err = o.getSSMTarget(ctx)
 		if err != nil {
@@ -487,6 +511,15 @@ func (o *runLocalOpts) getTask(ctx context.Context) (orchestrator.Task, error) {
 	if err != nil {
 		return orchestrator.Task{}, fmt.Errorf("get task definition: %w", err)
 	}
+	wkldCtrs := make(map[string]bool, len(td.ContainerDefinitions))
+	for _, ctr := range td.ContainerDefinitions {
+		wkldCtrs[aws.StringValue(ctr.Name)] = true
+	}
+
+	td, err = o.withWkldsTaskDefinition(td)
+	if err != nil {
+		return orchestrator.Task{}, err
+	}
 
 	envVars, err := o.getEnvVars(ctx, td)
 	if err != nil {
@@ -499,8 +532,12 @@ func (o *runLocalOpts) getTask(ctx context.Context) (orchestrator.Task, error) {
 			return orchestrator.Task{}, fmt.Errorf("retrieve task role credentials: %w", err)
 		}
 
-		// overwrite environment variables
+		// overwrite environment variables of the workload's containers,
+		// the containers of the other workloads keep the session credentials.
 		for ctr := range envVars {
+			if !wkldCtrs[ctr] {
+				continue
+			}
 			for k, v := range taskRoleCredsVars {
 				envVars[ctr][k] = envVarValue{
 					Value:  v,
@@ -515,6 +552,9 @@ func (o *runLocalOpts) getTask(ctx context.Context) (orchestrator.Task, error) {
 	task := orchestrator.Task{
 		Containers: make(map[string]orchestrator.ContainerDefinition, len(td.ContainerDefinitions)),
 	}
+	for _, name := range o.withWklds {
+		task.LocalHosts = append(task.LocalHosts, name, fmt.Sprintf("%s.%s.%s.local", name, o.envName, o.appName))
+	}
 
 	if o.proxy {
 		pauseSecrets, err := sessionEnvVars(ctx, o.envManagerSess)
@@ -564,47 +604,107 @@ func (o *runLocalOpts) prepareTask(ctx context.Context) (orchestrator.Task, erro
 		return orchestrator.Task{}, fmt.Errorf("get task: %w", err)
 	}
 
-	mft, _, err := workloadManifest(&workloadManifestInput{
-		name:         o.wkldName,
-		appName:      o.appName,
-		envName:      o.envName,
-		ws:           o.ws,
-		interpolator: o.newInterpolator(o.appName, o.envName),
-		unmarshal:    o.unmarshal,
-		sess:         o.envManagerSess,
-	})
-	if err != nil {
-		return orchestrator.Task{}, err
-	}
+	for _, name := range o.workloads() {
+		mft, _, err := workloadManifest(&workloadManifestInput{
+			name:         name,
+			appName:      o.appName,
+			envName:      o.envName,
+			ws:           o.ws,
+			interpolator: o.newInterpolator(o.appName, o.envName),
+			unmarshal:    o.unmarshal,
+			sess:         o.envManagerSess,
+		})
+		if err != nil {
+			return orchestrator.Task{}, err
+		}
 
-	containerURIs, err := o.buildContainerImages(mft)
-	if err != nil {
-		return orchestrator.Task{}, fmt.Errorf("build images: %w", err)
-	}
+		containerURIs, err := o.buildContainerImages(name, mft)
+		if err != nil {
+			if name != o.wkldName {
+				return orchestrator.Task{}, fmt.Errorf("build images of workload %q: %w", name, err)
+			}
+			return orchestrator.Task{}, fmt.Errorf("build images: %w", err)
+		}
 
-	// replace built images with the local built URI
-	for name, uri := range containerURIs {
-		ctr, ok := task.Containers[name]
-		if !ok {
-			return orchestrator.Task{}, fmt.Errorf("built an image for %q, which doesn't exist in the task", name)
+		// replace built images with the local built URI
+		for ctrName, uri := range containerURIs {
+			ctr, ok := task.Containers[ctrName]
+			if !ok {
+				return orchestrator.Task{}, fmt.Errorf("built an image for %q, which doesn't exist in the task", ctrName)
+			}
+
+			ctr.ImageURI = uri
+			task.Containers[ctrName] = ctr
 		}
 
-		ctr.ImageURI = uri
-		task.Containers[name] = ctr
+		containerDeps := manifest.ContainerDependencies(mft.Manifest())
+		for ctrName, dep := range containerDeps {
+			ctr, ok := task.Containers[ctrName]
+			if !ok {
+				return orchestrator.Task{}, fmt.Errorf("missing container: %q is listed as a dependency, which doesn't exist in the task", ctrName)
+			}
+			ctr.IsEssential = dep.IsEssential
+			ctr.DependsOn = dep.DependsOn
+			task.Containers[ctrName] = ctr
+		}
 	}
 
-	containerDeps := manifest.ContainerDependencies(mft.Manifest())
-	for name, dep := range containerDeps {
-		ctr, ok := task.Containers[name]
-		if !ok {
-			return orchestrator.Task{}, fmt.Errorf("missing container: %q is listed as a dependency, which doesn't exist in the task", name)
+	return task, nil
+}
+
+// workloads returns the name of the workload to run locally followed by the names of the workloads run with it.
+func (o *runLocalOpts) workloads() []string {
+	return append([]string{o.wkldName}, o.withWklds...)
+}
+
+// withWkldsTaskDefinition returns a task definition that holds the containers of td
+// and the containers of the deployed task definitions of the workloads run with it.
+// Since all the containers share the network namespace of the task,
+// their names and ports must not collide.
+func (o *runLocalOpts) withWkldsTaskDefinition(td *awsecs.TaskDefinition) (*awsecs.TaskDefinition, error) {
+	if len(o.withWklds) == 0 {
+		return td, nil
+	}
+
+	merged := *td
+	merged.ContainerDefinitions = nil
+	wkldOfCtr := make(map[string]string)
+	ctrOfPort := make(map[int64]string)
+	add := func(wkld string, ctrs []*sdkecs.ContainerDefinition) error {
+		for _, ctr := range ctrs {
+			name := aws.StringValue(ctr.Name)
+			if other, ok := wkldOfCtr[name]; ok {
+				return fmt.Errorf("container %q of workload %q has the same name as a container of workload %q", name, wkld, other)
+			}
+			wkldOfCtr[name] = wkld
+			for _, port := range ctr.PortMappings {
+				ctrPort := aws.Int64Value(port.HostPort)
+				if port.ContainerPort != nil {
+					ctrPort = aws.Int64Value(port.ContainerPort)
+				}
+				if other, ok := ctrOfPort[ctrPort]; ok && other != name {
+					return fmt.Errorf("port %d of container %q in workload %q is also used by container %q in workload %q", ctrPort, name, wkld, other, wkldOfCtr[other])
+				}
+				ctrOfPort[ctrPort] = name
+			}
+			merged.ContainerDefinitions = append(merged.ContainerDefinitions, ctr)
 		}
-		ctr.IsEssential = dep.IsEssential
-		ctr.DependsOn = dep.DependsOn
-		task.Containers[name] = ctr
+		return nil
 	}
 
-	return task, nil
+	if err := add(o.wkldName, td.ContainerDefinitions); err != nil {
+		return nil, err
+	}
+	for _, name := range o.withWklds {
+		withTD, err := o.ecsClient.TaskDefinition(o.appName, o.envName, name)
+		if err != nil {
+			return nil, fmt.Errorf("get task definition of workload %q: %w", name, err)
+		}
+		if err := add(name, withTD.ContainerDefinitions); err != nil {
+			return nil, err
+		}
+	}
+	return &merged, nil
 }
 
 func (o *runLocalOpts) filterDockerExcludes() {
@@ -1254,5 +1354,6 @@ func BuildRunLocalCmd() *cobra.Command {
 		IP:   net.IPv4(172, 20, 0, 0),
 		Mask: net.CIDRMask(16, 32),
 	}, proxyNetworkFlag)
+	cmd.Flags().StringSliceVar(&vars.withWklds, withFlag, nil, withFlagDescription)
 	return cmd
 }
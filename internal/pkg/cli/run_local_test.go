@@ -97,9 +97,10 @@ func TestRunLocalOpts_Ask(t *testing.T) {
 		testWkldType = "testWkldType"
 	)
 	testCases := map[string]struct {
-		inputAppName  string
-		inputEnvName  string
-		inputWkldName string
+		inputAppName   string
+		inputEnvName   string
+		inputWkldName  string
+		inputWithWklds []string
 
 		setupMocks     func(m *runLocalAskMocks)
 		wantedWkldName string
@@ -141,6 +142,39 @@ func TestRunLocalOpts_Ask(t *testing.T) {
 			wantedWkldName: testWkldName,
 			wantedWkldType: testWkldType,
 		},
+		"error if a workload is run with itself": {
+			inputAppName:   testAppName,
+			inputWkldName:  testWkldName,
+			inputEnvName:   testEnvName,
+			inputWithWklds: []string{testWkldName},
+			setupMocks: func(m *runLocalAskMocks) {
+				m.store.EXPECT().GetEnvironment(testAppName, testEnvName).Return(&config.Environment{Name: "testEnv"}, nil)
+				m.store.EXPECT().GetWorkload(testAppName, testWkldName).Return(&config.Workload{Name: "testWkld"}, nil)
+				m.sel.EXPECT().DeployedWorkload(workloadAskPrompt, "", testAppName, gomock.Any()).Return(&selector.DeployedWorkload{
+					Env:  "testEnv",
+					Name: "testWkld",
+					Type: "testWkldType",
+				}, nil)
+			},
+			wantedError: errors.New(`workload "testWkld" cannot be run with itself`),
+		},
+		"error if a workload run with it does not exist": {
+			inputAppName:   testAppName,
+			inputWkldName:  testWkldName,
+			inputEnvName:   testEnvName,
+			inputWithWklds: []string{"back-end"},
+			setupMocks: func(m *runLocalAskMocks) {
+				m.store.EXPECT().GetEnvironment(testAppName, testEnvName).Return(&config.Environment{Name: "testEnv"}, nil)
+				m.store.EXPECT().GetWorkload(testAppName, testWkldName).Return(&config.Workload{Name: "testWkld"}, nil)
+				m.sel.EXPECT().DeployedWorkload(workloadAskPrompt, "", testAppName, gomock.Any()).Return(&selector.DeployedWorkload{
+					Env:  "testEnv",
+					Name: "testWkld",
+					Type: "testWkldType",
+				}, nil)
+				m.store.EXPECT().GetWorkload(testAppName, "back-end").Return(nil, testError)
+			},
+			wantedError: fmt.Errorf(`get workload "back-end": %w`, testError),
+		},
 		"prompt for workload and environment": {
 			inputAppName: testAppName,
 			setupMocks: func(m *runLocalAskMocks) {
@@ -178,9 +212,10 @@ func TestRunLocalOpts_Ask(t *testing.T) {
 			tc.setupMocks(m)
 			opts := runLocalOpts{
 				runLocalVars: runLocalVars{
-					appName:  tc.inputAppName,
-					wkldName: tc.inputWkldName,
-					envName:  tc.inputEnvName,
+					appName:   tc.inputAppName,
+					wkldName:  tc.inputWkldName,
+					envName:   tc.inputEnvName,
+					withWklds: tc.inputWithWklds,
 				},
 				store: m.store,
 				sel:   m.sel,
@@ -427,6 +462,49 @@ func TestRunLocalOpts_Execute(t *testing.T) {
 			},
 		},
 	}
+	backendTaskDef := &awsecs.TaskDefinition{
+		ContainerDefinitions: []*sdkecs.ContainerDefinition{
+			{
+				Name:      aws.String("back-end"),
+				Image:     aws.String("back-end-image"),
+				Essential: aws.Bool(true),
+				Environment: []*sdkecs.KeyValuePair{
+					{
+						Name:  aws.String("BACKEND_VAR"),
+						Value: aws.String("backend-value"),
+					},
+				},
+				PortMappings: []*sdkecs.PortMapping{
+					{
+						HostPort: aws.Int64(3000),
+					},
+				},
+			},
+		},
+	}
+	expectedTaskWithBackend := orchestrator.Task{
+		Containers: map[string]orchestrator.ContainerDefinition{
+			"foo": expectedTask.Containers["foo"],
+			"bar": expectedTask.Containers["bar"],
+			"back-end": {
+				ImageURI: "back-end-image",
+				EnvVars: map[string]string{
+					"BACKEND_VAR": "backend-value",
+				},
+				Secrets: map[string]string{
+					"AWS_ACCESS_KEY_ID":     "myID",
+					"AWS_SECRET_ACCESS_KEY": "mySecret",
+					"AWS_SESSION_TOKEN":     "myToken",
+				},
+				Ports: map[string]string{
+					"3000": "3000",
+				},
+				IsEssential: true,
+				DependsOn:   map[string]string{},
+			},
+		},
+		LocalHosts: []string{"back-end", "back-end.testEnv.testApp.local"},
+	}
 	expectedProxyTask := orchestrator.Task{
 		Containers: expectedTask.Containers,
 		PauseSecrets: map[string]string{
@@ -492,6 +570,7 @@ func TestRunLocalOpts_Execute(t *testing.T) {
 		inputWatch          bool
 		inputTaskRole       bool
 		inputProxy          bool
+		inputWithWklds      []string
 		inputReader         io.Reader
 		buildImagesError    error
 
@@ -595,6 +674,57 @@ ecs exec: all containers failed to retrieve credentials`),
 			},
 			wantedError: errors.New(`build images: some error`),
 		},
+		"error getting the task definition of a workload run with it": {
+			inputAppName:   testAppName,
+			inputWkldName:  testWkldName,
+			inputEnvName:   testEnvName,
+			inputWithWklds: []string{"back-end"},
+			setupMocks: func(t *testing.T, m *runLocalExecuteMocks) {
+				m.ecsClient.EXPECT().TaskDefinition(testAppName, testEnvName, testWkldName).Return(taskDef, nil)
+				m.ecsClient.EXPECT().TaskDefinition(testAppName, testEnvName, "back-end").Return(nil, testError)
+			},
+			wantedError: fmt.Errorf(`get task: get task definition of workload "back-end": %w`, testError),
+		},
+		"error if a workload run with it has a container with the same name": {
+			inputAppName:   testAppName,
+			inputWkldName:  testWkldName,
+			inputEnvName:   testEnvName,
+			inputWithWklds: []string{"back-end"},
+			setupMocks: func(t *testing.T, m *runLocalExecuteMocks) {
+				m.ecsClient.EXPECT().TaskDefinition(testAppName, testEnvName, testWkldName).Return(taskDef, nil)
+				m.ecsClient.EXPECT().TaskDefinition(testAppName, testEnvName, "back-end").Return(&awsecs.TaskDefinition{
+					ContainerDefinitions: []*sdkecs.ContainerDefinition{
+						{
+							Name: aws.String("bar"),
+						},
+					},
+				}, nil)
+			},
+			wantedError: errors.New(`get task: container "bar" of workload "back-end" has the same name as a container of workload "testWkld"`),
+		},
+		"error if a workload run with it uses the same port": {
+			inputAppName:   testAppName,
+			inputWkldName:  testWkldName,
+			inputEnvName:   testEnvName,
+			inputWithWklds: []string{"back-end"},
+			setupMocks: func(t *testing.T, m *runLocalExecuteMocks) {
+				m.ecsClient.EXPECT().TaskDefinition(testAppName, testEnvName, testWkldName).Return(taskDef, nil)
+				m.ecsClient.EXPECT().TaskDefinition(testAppName, testEnvName, "back-end").Return(&awsecs.TaskDefinition{
+					ContainerDefinitions: []*sdkecs.ContainerDefinition{
+						{
+							Name: aws.String("back-end"),
+							PortMappings: []*sdkecs.PortMapping{
+								{
+									HostPort:      aws.Int64(8080),
+									ContainerPort: aws.Int64(8080),
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+			wantedError: errors.New(`get task: port 8080 of container "back-end" in workload "back-end" is also used by container "foo" in workload "testWkld"`),
+		},
 		"error getting env version": {
 			inputAppName:  testAppName,
 			inputWkldName: testWkldName,
@@ -780,6 +910,33 @@ ecs exec: all containers failed to retrieve credentials`),
 				}
 			},
 		},
+		"success, one run task call, with other workloads": {
+			inputAppName:   testAppName,
+			inputWkldName:  testWkldName,
+			inputEnvName:   testEnvName,
+			inputWithWklds: []string{"back-end"},
+			setupMocks: func(t *testing.T, m *runLocalExecuteMocks) {
+				m.ecsClient.EXPECT().TaskDefinition(testAppName, testEnvName, testWkldName).Return(taskDef, nil)
+				m.ecsClient.EXPECT().TaskDefinition(testAppName, testEnvName, "back-end").Return(backendTaskDef, nil)
+				m.ssm.EXPECT().GetSecretValue(gomock.Any(), "mysecret").Return("secretvalue", nil)
+				m.ws.EXPECT().ReadWorkloadManifest(testWkldName).Return([]byte(""), nil)
+				m.ws.EXPECT().ReadWorkloadManifest("back-end").Return([]byte(""), nil)
+				m.interpolator.EXPECT().Interpolate("").Return("", nil).Times(2)
+
+				errCh := make(chan error, 1)
+				m.orchestrator.StartFn = func() <-chan error {
+					errCh <- errors.New("some error")
+					return errCh
+				}
+				m.orchestrator.RunTaskFn = func(task orchestrator.Task, opts ...orchestrator.RunTaskOption) {
+					require.Equal(t, expectedTaskWithBackend, task)
+				}
+				m.orchestrator.StopFn = func() {
+					require.Len(t, errCh, 0)
+					close(errCh)
+				}
+			},
+		},
 		"success, one run task call, proxy": {
 			inputAppName:  testAppName,
 			inputWkldName: testWkldName,
@@ -1167,7 +1324,8 @@ ecs exec: all containers failed to retrieve credentials`),
 							container: "9999",
 						},
 					},
-					proxy: tc.inputProxy,
+					proxy:     tc.inputProxy,
+					withWklds: tc.inputWithWklds,
 				},
 				newInterpolator: func(app, env string) interpolator {
 					return m.interpolator
@@ -1178,7 +1336,10 @@ ecs exec: all containers failed to retrieve credentials`),
 				configureClients: func() error {
 					return nil
 				},
-				buildContainerImages: func(mft manifest.DynamicWorkload) (map[string]string, error) {
+				buildContainerImages: func(name string, mft manifest.DynamicWorkload) (map[string]string, error) {
+					if name != tc.inputWkldName {
+						return nil, nil
+					}
 					return mockContainerURIs, tc.buildImagesError
 				},
 				ws:             m.ws,
@@ -1200,7 +1361,7 @@ ecs exec: all containers failed to retrieve credentials`),
 				},
 				cmd:            m.mockRunner,
 				dockerEngine:   m.dockerEngine,
-				repository:     m.repository,
+				repositories:   map[string]repositoryService{testWkldName: m.repository},
 				targetEnv:      &mockEnv,
 				targetApp:      &mockApp,
 				prog:           m.prog,
@@ -4,6 +4,7 @@
 package cli
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"sort"
@@ -17,11 +18,13 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/version"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/dustin/go-humanize/english"
 	"github.com/spf13/afero"
@@ -34,6 +37,10 @@ import (
 const (
 	fmtSecretParameterName           = "/copilot/%s/%s/secrets/%s"
 	fmtSecretParameterNameMftExample = "/copilot/${COPILOT_APPLICATION_NAME}/${COPILOT_ENVIRONMENT_NAME}/secrets/%s"
+
+	// secretInitDefaultValueKey is the key in the input file for the value of a secret in environments without a value.
+	// It can't collide with an environment name since environment names must start with a letter.
+	secretInitDefaultValueKey = "_default"
 )
 
 const (
@@ -45,15 +52,22 @@ const (
 
 	fmtSecretInitSecretValuePrompt     = "What is the value of secret %s in environment %s?"
 	fmtSecretInitSecretValuePromptHelp = "If you do not wish to add the secret %s to environment %s, you can leave this blank by pressing 'Enter' without entering any value."
+
+	fmtSecretInitDefaultValuePrompt     = "What is the default value of secret %s in all environments?"
+	fmtSecretInitDefaultValuePromptHelp = "The default value is used in every environment that you leave blank in the next prompts. If you do not wish to have a default value for the secret %s, you can leave this blank by pressing 'Enter' without entering any value."
+
+	fmtSecretInitSecretValueWithDefaultPromptHelp = "If you leave this blank by pressing 'Enter' without entering any value, the default value of the secret %s is used in environment %s."
 )
 
 type secretInitVars struct {
 	appName string
 
-	name          string
-	values        map[string]string
-	inputFilePath string
-	overwrite     bool
+	name                 string
+	values               map[string]string
+	defaultValue         string
+	inputFilePath        string
+	overwrite            bool
+	overwriteOnlyChanged bool
 }
 
 type secretInitOpts struct {
@@ -68,6 +82,8 @@ type secretInitOpts struct {
 	ws                      wsEnvironmentsLister
 	envCompatibilityChecker map[string]versionCompatibilityChecker
 	secretPutters           map[string]secretPutter
+	secretGetters           map[string]secretGetter
+	envKMSKeys              map[string]string // Customer managed KMS key configured in each environment's manifest.
 
 	configureClientsForEnv func(envName string) error
 	readFile               func() ([]byte, error)
@@ -95,6 +111,8 @@ func newSecretInitOpts(vars secretInitVars) (*secretInitOpts, error) {
 
 		envCompatibilityChecker: make(map[string]versionCompatibilityChecker),
 		secretPutters:           make(map[string]secretPutter),
+		secretGetters:           make(map[string]secretGetter),
+		envKMSKeys:              make(map[string]string),
 
 		prompter: prompter,
 		selector: selector.NewAppEnvSelector(prompter, store),
@@ -110,6 +128,15 @@ func newSecretInitOpts(vars secretInitVars) (*secretInitOpts, error) {
 			return fmt.Errorf("new environment compatibility checker: %v", err)
 		}
 		opts.envCompatibilityChecker[envName] = checker
+		raw, err := checker.Manifest()
+		if err != nil {
+			return fmt.Errorf("read manifest of environment %s: %w", envName, err)
+		}
+		mft, err := manifest.UnmarshalEnvironment(raw)
+		if err != nil {
+			return fmt.Errorf("unmarshal manifest of environment %s: %w", envName, err)
+		}
+		opts.envKMSKeys[envName] = mft.KMSKeyARN()
 
 		env, err := opts.targetEnv(envName)
 		if err != nil {
@@ -119,7 +146,9 @@ func newSecretInitOpts(vars secretInitVars) (*secretInitOpts, error) {
 		if err != nil {
 			return fmt.Errorf("create session from environment manager role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
 		}
-		opts.secretPutters[envName] = ssm.New(sess)
+		client := ssm.New(sess)
+		opts.secretPutters[envName] = client
+		opts.secretGetters[envName] = client
 
 		return nil
 	}
@@ -150,6 +179,14 @@ func (o *secretInitOpts) Validate() error {
 		return errors.New("cannot specify `--cli-input-yaml` with `--values`")
 	}
 
+	if o.inputFilePath != "" && o.defaultValue != "" {
+		return errors.New("cannot specify `--cli-input-yaml` with `--default-value`")
+	}
+
+	if o.overwrite && o.overwriteOnlyChanged {
+		return errors.New("cannot specify `--overwrite` with `--overwrite-only-changed`")
+	}
+
 	if o.appName != "" {
 		_, err := o.store.GetApplication(o.appName)
 		if err != nil {
@@ -183,6 +220,9 @@ func (o *secretInitOpts) Ask() error {
 	if o.overwrite {
 		log.Warningf("You have specified %s flag. Please note that overwriting an existing secret may break your deployed service.\n", color.HighlightCode(fmt.Sprintf("--%s", overwriteFlag)))
 	}
+	if o.overwriteOnlyChanged {
+		log.Warningf("You have specified %s flag. Please note that overwriting an existing secret may break your deployed service.\n", color.HighlightCode(fmt.Sprintf("--%s", overwriteOnlyChangedFlag)))
+	}
 
 	if o.inputFilePath != "" {
 		return nil
@@ -200,6 +240,11 @@ func (o *secretInitOpts) Ask() error {
 	return nil
 }
 
+// ValidateVersionPin returns an error if the application doesn't allow this version of Copilot to create its secrets.
+func (o *secretInitOpts) ValidateVersionPin() error {
+	return validateVersionPin(o.store, o.appName, version.Version, "")
+}
+
 // Execute creates or updates the secrets.
 func (o *secretInitOpts) Execute() error {
 	if o.inputFilePath != "" {
@@ -207,6 +252,16 @@ func (o *secretInitOpts) Execute() error {
 		if err != nil {
 			return err
 		}
+		for secretName, values := range secrets {
+			defaultValue, ok := values[secretInitDefaultValueKey]
+			if !ok {
+				continue
+			}
+			delete(values, secretInitDefaultValueKey)
+			if secrets[secretName], err = o.valuesWithDefault(values, defaultValue); err != nil {
+				return err
+			}
+		}
 
 		o.secretValues = secrets
 
@@ -230,13 +285,37 @@ func (o *secretInitOpts) Execute() error {
 		return nil
 	}
 
+	values, err := o.valuesWithDefault(o.values, o.defaultValue)
+	if err != nil {
+		return err
+	}
 	o.secretValues = map[string]map[string]string{
-		o.name: o.values,
+		o.name: values,
 	}
 	if err := o.configureClientsAndUpgradeForEnvironments(o.secretValues); err != nil {
 		return err
 	}
-	return o.putSecret(o.name, o.values)
+	return o.putSecret(o.name, values)
+}
+
+// valuesWithDefault returns the values of a secret in every environment of the application,
+// where environments without a value use the default value.
+func (o *secretInitOpts) valuesWithDefault(values map[string]string, defaultValue string) (map[string]string, error) {
+	if defaultValue == "" {
+		return values, nil
+	}
+	envs, err := o.store.ListEnvironments(o.appName)
+	if err != nil {
+		return nil, fmt.Errorf("list environments in app %s: %w", o.appName, err)
+	}
+	out := make(map[string]string, len(envs))
+	for _, env := range envs {
+		out[env.Name] = defaultValue
+	}
+	for env, value := range values {
+		out[env] = value
+	}
+	return out, nil
 }
 
 func (o *secretInitOpts) configureClientsAndUpgradeForEnvironments(secrets map[string]map[string]string) error {
@@ -295,14 +374,26 @@ func (o *secretInitOpts) putSecret(secretName string, values map[string]string)
 
 func (o *secretInitOpts) putSecretInEnv(secretName, envName, value string) error {
 	name := fmt.Sprintf(fmtSecretParameterName, o.appName, envName, secretName)
+	if o.overwriteOnlyChanged {
+		current, err := o.secretGetters[envName].GetSecretValue(context.Background(), name)
+		var errNotFound *ssm.ErrParameterNotFound
+		switch {
+		case err == nil && current == value:
+			log.Successf("Secret %s in environment %s is unchanged. Did not overwrite.\n", color.HighlightUserInput(secretName), color.HighlightUserInput(envName))
+			return nil
+		case err != nil && !errors.As(err, &errNotFound):
+			return fmt.Errorf("get current value of secret %s: %w", name, err)
+		}
+	}
 	in := ssm.PutSecretInput{
 		Name:      name,
 		Value:     value,
-		Overwrite: o.overwrite,
+		Overwrite: o.overwrite || o.overwriteOnlyChanged,
 		Tags: map[string]string{
 			deploy.AppTagKey: o.appName,
 			deploy.EnvTagKey: envName,
 		},
+		KeyID: o.envKMSKeys[envName],
 	}
 
 	out, err := o.secretPutters[envName].PutSecret(in)
@@ -373,7 +464,7 @@ func (o *secretInitOpts) askForSecretName() error {
 }
 
 func (o *secretInitOpts) askForSecretValues() error {
-	if o.values != nil {
+	if o.values != nil || o.defaultValue != "" {
 		return nil
 	}
 
@@ -389,11 +480,27 @@ func (o *secretInitOpts) askForSecretValues() error {
 		return fmt.Errorf("no environment is found in app %s", o.appName)
 	}
 
+	if len(envs) > 1 {
+		defaultValue, err := o.prompter.GetSecret(
+			fmt.Sprintf(fmtSecretInitDefaultValuePrompt, color.HighlightUserInput(o.name)),
+			fmt.Sprintf(fmtSecretInitDefaultValuePromptHelp, color.HighlightUserInput(o.name)),
+			prompt.WithFinalMessage("Default secret value:"),
+		)
+		if err != nil {
+			return fmt.Errorf("get default secret value for %s: %w", color.HighlightUserInput(o.name), err)
+		}
+		o.defaultValue = defaultValue
+	}
+
+	helpPrompt := fmtSecretInitSecretValuePromptHelp
+	if o.defaultValue != "" {
+		helpPrompt = fmtSecretInitSecretValueWithDefaultPromptHelp
+	}
 	values := make(map[string]string)
 	for _, env := range envs {
 		value, err := o.prompter.GetSecret(
 			fmt.Sprintf(fmtSecretInitSecretValuePrompt, color.HighlightUserInput(o.name), env.Name),
-			fmt.Sprintf(fmtSecretInitSecretValuePromptHelp, color.HighlightUserInput(o.name), env.Name),
+			fmt.Sprintf(helpPrompt, color.HighlightUserInput(o.name), env.Name),
 			prompt.WithFinalMessage(fmt.Sprintf("%s secret value:", cases.Title(language.English).String(env.Name))),
 		)
 		if err != nil {
@@ -481,6 +588,10 @@ Create a secret with prompts.
 /code $ copilot secret init
 Create a secret named db-password in multiple environments.
 /code $ copilot secret init --name db-password
+Create a secret with the same value in every environment, except for prod.
+/code $ copilot secret init --name db-host --default-value db.example.com --values prod=prod.db.example.com
+Update only the secrets whose values changed in input.yml.
+/code $ copilot secret init --cli-input-yaml input.yml --overwrite-only-changed
 Create secrets from input.yml. For the format of the YAML file, please see https://aws.github.io/copilot-cli/docs/commands/secret-init/.
 /code $ copilot secret init --cli-input-yaml input.yml`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
@@ -513,7 +624,10 @@ Create secrets from input.yml. For the format of the YAML file, please see https
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", secretNameFlagDescription)
 	cmd.Flags().StringToStringVar(&vars.values, valuesFlag, nil, secretValuesFlagDescription)
+	cmd.Flags().StringVar(&vars.defaultValue, defaultValueFlag, "", secretDefaultValueFlagDescription)
 	cmd.Flags().BoolVar(&vars.overwrite, overwriteFlag, false, secretOverwriteFlagDescription)
+	cmd.Flags().BoolVar(&vars.overwriteOnlyChanged, overwriteOnlyChangedFlag, false, secretOverwriteOnlyChangedFlagDescription)
 	cmd.Flags().StringVar(&vars.inputFilePath, inputFilePathFlag, "", secretInputFilePathFlagDescription)
+	markAudited(cmd)
 	return cmd
 }
@@ -30,11 +30,13 @@ type secretInitMocks struct {
 
 func TestSecretInitOpts_Validate(t *testing.T) {
 	testCases := map[string]struct {
-		inApp           string
-		inName          string
-		inValues        map[string]string
-		inOverwrite     bool
-		inInputFilePath string
+		inApp                  string
+		inName                 string
+		inValues               map[string]string
+		inDefaultValue         string
+		inOverwrite            bool
+		inOverwriteOnlyChanged bool
+		inInputFilePath        string
 
 		setupMocks func(m secretInitMocks)
 
@@ -101,6 +103,18 @@ func TestSecretInitOpts_Validate(t *testing.T) {
 			setupMocks:      func(m secretInitMocks) {},
 			wantedError:     errors.New("cannot specify `--cli-input-yaml` with `--name`"),
 		},
+		"error if input file name is specified with default value": {
+			inDefaultValue:  "db",
+			inInputFilePath: "path/to/file",
+			setupMocks:      func(m secretInitMocks) {},
+			wantedError:     errors.New("cannot specify `--cli-input-yaml` with `--default-value`"),
+		},
+		"error if overwrite is specified with overwrite only changed": {
+			inOverwrite:            true,
+			inOverwriteOnlyChanged: true,
+			setupMocks:             func(m secretInitMocks) {},
+			wantedError:            errors.New("cannot specify `--overwrite` with `--overwrite-only-changed`"),
+		},
 		"error if input file name is specified with values": {
 			inValues: map[string]string{
 				"test": "test-db",
@@ -121,11 +135,13 @@ func TestSecretInitOpts_Validate(t *testing.T) {
 
 			opts := secretInitOpts{
 				secretInitVars: secretInitVars{
-					appName:       tc.inApp,
-					name:          tc.inName,
-					values:        tc.inValues,
-					inputFilePath: tc.inInputFilePath,
-					overwrite:     tc.inOverwrite,
+					appName:              tc.inApp,
+					name:                 tc.inName,
+					values:               tc.inValues,
+					defaultValue:         tc.inDefaultValue,
+					inputFilePath:        tc.inInputFilePath,
+					overwrite:            tc.inOverwrite,
+					overwriteOnlyChanged: tc.inOverwriteOnlyChanged,
 				},
 				fs:    &afero.Afero{Fs: afero.NewMemMapFs()},
 				store: mockStore,
@@ -172,9 +188,10 @@ func TestSecretInitOpts_Ask(t *testing.T) {
 		}
 	)
 	testCases := map[string]struct {
-		inAppName string
-		inName    string
-		inValues  map[string]string
+		inAppName      string
+		inName         string
+		inValues       map[string]string
+		inDefaultValue string
 
 		setupMocks func(m secretInitAskMocks)
 
@@ -250,6 +267,8 @@ func TestSecretInitOpts_Ask(t *testing.T) {
 						Name: "prod",
 					},
 				}, nil)
+				m.mockPrompter.EXPECT().GetSecret(fmt.Sprintf(fmtSecretInitDefaultValuePrompt, "db-password"), gomock.Any(), gomock.Any()).
+					Return("", nil)
 				m.mockPrompter.EXPECT().GetSecret(fmt.Sprintf(fmtSecretInitSecretValuePrompt, "db-password", "test"), gomock.Any(), gomock.Any()).
 					Return("test-password", nil)
 				m.mockPrompter.EXPECT().GetSecret(fmt.Sprintf(fmtSecretInitSecretValuePrompt, "db-password", "dev"), gomock.Any(), gomock.Any()).
@@ -259,6 +278,62 @@ func TestSecretInitOpts_Ask(t *testing.T) {
 			},
 			wantedVars: wantedVars,
 		},
+		"ask for a default value and the values that override it": {
+			inAppName: wantedApp,
+			inName:    wantedName,
+			setupMocks: func(m secretInitAskMocks) {
+				m.mockStore.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+					{
+						Name: "test",
+					},
+					{
+						Name: "prod",
+					},
+				}, nil)
+				m.mockPrompter.EXPECT().GetSecret(fmt.Sprintf(fmtSecretInitDefaultValuePrompt, "db-password"), gomock.Any(), gomock.Any()).
+					Return("default-password", nil)
+				m.mockPrompter.EXPECT().GetSecret(fmt.Sprintf(fmtSecretInitSecretValuePrompt, "db-password", "test"), fmt.Sprintf(fmtSecretInitSecretValueWithDefaultPromptHelp, "db-password", "test"), gomock.Any()).
+					Return("", nil)
+				m.mockPrompter.EXPECT().GetSecret(fmt.Sprintf(fmtSecretInitSecretValuePrompt, "db-password", "prod"), fmt.Sprintf(fmtSecretInitSecretValueWithDefaultPromptHelp, "db-password", "prod"), gomock.Any()).
+					Return("prod-password", nil)
+			},
+			wantedVars: secretInitVars{
+				appName:      wantedApp,
+				name:         wantedName,
+				defaultValue: "default-password",
+				values: map[string]string{
+					"prod": "prod-password",
+				},
+			},
+		},
+		"error prompting for the default value": {
+			inAppName: wantedApp,
+			inName:    wantedName,
+			setupMocks: func(m secretInitAskMocks) {
+				m.mockStore.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+					{
+						Name: "test",
+					},
+					{
+						Name: "prod",
+					},
+				}, nil)
+				m.mockPrompter.EXPECT().GetSecret(fmt.Sprintf(fmtSecretInitDefaultValuePrompt, "db-password"), gomock.Any(), gomock.Any()).
+					Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("get default secret value for db-password: some error"),
+		},
+		"do not ask for values if a default value is specified": {
+			inAppName:      wantedApp,
+			inName:         wantedName,
+			inDefaultValue: "default-password",
+			setupMocks:     func(m secretInitAskMocks) {},
+			wantedVars: secretInitVars{
+				appName:      wantedApp,
+				name:         wantedName,
+				defaultValue: "default-password",
+			},
+		},
 		"error listing environments": {
 			inAppName: wantedApp,
 			inName:    wantedName,
@@ -282,6 +357,8 @@ func TestSecretInitOpts_Ask(t *testing.T) {
 						Name: "prod",
 					},
 				}, nil)
+				m.mockPrompter.EXPECT().GetSecret(fmt.Sprintf(fmtSecretInitDefaultValuePrompt, "db-password"), gomock.Any(), gomock.Any()).
+					Return("", nil)
 				m.mockPrompter.EXPECT().GetSecret(fmt.Sprintf(fmtSecretInitSecretValuePrompt, "db-password", "test"), gomock.Any(), gomock.Any()).
 					Return("", errors.New("some error"))
 				m.mockPrompter.EXPECT().GetSecret(fmt.Sprintf(fmtSecretInitSecretValuePrompt, "db-password", "dev"), gomock.Any(), gomock.Any()).MinTimes(0).MaxTimes(1)
@@ -319,9 +396,10 @@ func TestSecretInitOpts_Ask(t *testing.T) {
 
 			opts := secretInitOpts{
 				secretInitVars: secretInitVars{
-					appName: tc.inAppName,
-					name:    tc.inName,
-					values:  tc.inValues,
+					appName:      tc.inAppName,
+					name:         tc.inName,
+					values:       tc.inValues,
+					defaultValue: tc.inDefaultValue,
 				},
 				prompter: m.mockPrompter,
 				store:    m.mockStore,
@@ -344,6 +422,7 @@ func TestSecretInitOpts_Ask(t *testing.T) {
 type secretInitExecuteMocks struct {
 	mockStore                   *mocks.Mockstore
 	mockSecretPutter            *mocks.MocksecretPutter
+	mockSecretGetter            *mocks.MocksecretGetter
 	mockEnvCompatibilityChecker *mocks.MockversionCompatibilityChecker
 }
 
@@ -359,13 +438,16 @@ func TestSecretInitOpts_Execute(t *testing.T) {
 	testCases := map[string]struct {
 		inAppName string
 
-		inName   string
-		inValues map[string]string
+		inName         string
+		inValues       map[string]string
+		inDefaultValue string
 
 		inInputFilePath string
 
-		inOverwrite bool
+		inOverwrite            bool
+		inOverwriteOnlyChanged bool
 
+		mockEnvKMSKeys       map[string]string
 		mockInputFileContent []byte
 		setupMocks           func(m secretInitExecuteMocks)
 
@@ -402,6 +484,144 @@ func TestSecretInitOpts_Execute(t *testing.T) {
 				m.mockEnvCompatibilityChecker.EXPECT().Version().Return("v1.10.0", nil).Times(2)
 			},
 		},
+		"encrypt secrets with the kms key of the environment": {
+			inAppName: testApp,
+			inName:    testName,
+			inValues: map[string]string{
+				"prod": "prod-password",
+			},
+			mockEnvKMSKeys: map[string]string{
+				"prod": "arn:aws:kms:us-west-2:123456789012:key/mrk-1234abcd",
+			},
+
+			setupMocks: func(m secretInitExecuteMocks) {
+				m.mockSecretPutter.EXPECT().PutSecret(ssm.PutSecretInput{
+					Name:      "/copilot/test-app/prod/secrets/db-password",
+					Value:     "prod-password",
+					Overwrite: false,
+					Tags: map[string]string{
+						deploy.AppTagKey: "test-app",
+						deploy.EnvTagKey: "prod",
+					},
+					KeyID: "arn:aws:kms:us-west-2:123456789012:key/mrk-1234abcd",
+				}).Return(&ssm.PutSecretOutput{
+					Version: aws.Int64(1),
+				}, nil)
+				m.mockEnvCompatibilityChecker.EXPECT().Version().Return("v1.10.0", nil)
+			},
+		},
+		"use the default value in environments without a value": {
+			inAppName: testApp,
+			inName:    testName,
+			inValues: map[string]string{
+				"prod": "prod-password",
+			},
+			inDefaultValue: "default-password",
+
+			setupMocks: func(m secretInitExecuteMocks) {
+				m.mockStore.EXPECT().ListEnvironments(testApp).Return([]*config.Environment{
+					{Name: "test"},
+					{Name: "prod"},
+				}, nil)
+				m.mockSecretPutter.EXPECT().PutSecret(ssm.PutSecretInput{
+					Name:  "/copilot/test-app/test/secrets/db-password",
+					Value: "default-password",
+					Tags: map[string]string{
+						deploy.AppTagKey: "test-app",
+						deploy.EnvTagKey: "test",
+					},
+				}).Return(&ssm.PutSecretOutput{
+					Version: aws.Int64(1),
+				}, nil)
+				m.mockSecretPutter.EXPECT().PutSecret(ssm.PutSecretInput{
+					Name:  "/copilot/test-app/prod/secrets/db-password",
+					Value: "prod-password",
+					Tags: map[string]string{
+						deploy.AppTagKey: "test-app",
+						deploy.EnvTagKey: "prod",
+					},
+				}).Return(&ssm.PutSecretOutput{
+					Version: aws.Int64(1),
+				}, nil)
+				m.mockEnvCompatibilityChecker.EXPECT().Version().Return("v1.10.0", nil).Times(2)
+			},
+		},
+		"error if fail to list environments for the default value": {
+			inAppName:      testApp,
+			inName:         testName,
+			inDefaultValue: "default-password",
+
+			setupMocks: func(m secretInitExecuteMocks) {
+				m.mockStore.EXPECT().ListEnvironments(testApp).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list environments in app test-app: some error"),
+		},
+		"only overwrite the secrets whose value changed": {
+			inAppName:              testApp,
+			inName:                 testName,
+			inValues:               testValues,
+			inOverwriteOnlyChanged: true,
+
+			setupMocks: func(m secretInitExecuteMocks) {
+				m.mockSecretGetter.EXPECT().GetSecretValue(gomock.Any(), "/copilot/test-app/test/secrets/db-password").Return("test-password", nil)
+				m.mockSecretGetter.EXPECT().GetSecretValue(gomock.Any(), "/copilot/test-app/prod/secrets/db-password").Return("old-password", nil)
+				m.mockSecretPutter.EXPECT().PutSecret(ssm.PutSecretInput{
+					Name:      "/copilot/test-app/prod/secrets/db-password",
+					Value:     "prod-password",
+					Overwrite: true,
+					Tags: map[string]string{
+						deploy.AppTagKey: "test-app",
+						deploy.EnvTagKey: "prod",
+					},
+				}).Return(&ssm.PutSecretOutput{
+					Version: aws.Int64(2),
+				}, nil)
+				m.mockEnvCompatibilityChecker.EXPECT().Version().Return("v1.10.0", nil).Times(2)
+			},
+		},
+		"create the secret if it does not exist when overwriting only changed secrets": {
+			inAppName: testApp,
+			inName:    testName,
+			inValues: map[string]string{
+				"prod": "prod-password",
+			},
+			inOverwriteOnlyChanged: true,
+
+			setupMocks: func(m secretInitExecuteMocks) {
+				m.mockSecretGetter.EXPECT().GetSecretValue(gomock.Any(), "/copilot/test-app/prod/secrets/db-password").Return("", &ssm.ErrParameterNotFound{})
+				m.mockSecretPutter.EXPECT().PutSecret(ssm.PutSecretInput{
+					Name:      "/copilot/test-app/prod/secrets/db-password",
+					Value:     "prod-password",
+					Overwrite: true,
+					Tags: map[string]string{
+						deploy.AppTagKey: "test-app",
+						deploy.EnvTagKey: "prod",
+					},
+				}).Return(&ssm.PutSecretOutput{
+					Version: aws.Int64(1),
+				}, nil)
+				m.mockEnvCompatibilityChecker.EXPECT().Version().Return("v1.10.0", nil)
+			},
+		},
+		"error if fail to get the current value of a secret": {
+			inAppName: testApp,
+			inName:    testName,
+			inValues: map[string]string{
+				"prod": "prod-password",
+			},
+			inOverwriteOnlyChanged: true,
+
+			setupMocks: func(m secretInitExecuteMocks) {
+				m.mockSecretGetter.EXPECT().GetSecretValue(gomock.Any(), "/copilot/test-app/prod/secrets/db-password").Return("", errors.New("some error"))
+				m.mockEnvCompatibilityChecker.EXPECT().Version().Return("v1.10.0", nil)
+			},
+			wantedError: &errSecretFailedInSomeEnvironments{
+				secretName: testName,
+				errorsForEnvironments: map[string]error{
+					"prod": errors.New("get current value of secret /copilot/test-app/prod/secrets/db-password: some error"),
+				},
+			},
+		},
 		"should make calls to overwrite if overwrite is specified": {
 			inAppName:   testApp,
 			inName:      testName,
@@ -499,6 +719,41 @@ func TestSecretInitOpts_Execute(t *testing.T) {
 				},
 			},
 		},
+		"use the default value of the input file in environments without a value": {
+			inAppName:       testApp,
+			inInputFilePath: "some/file",
+
+			mockInputFileContent: []byte(`db-host:
+    _default: db.example.com
+    prod: prod.db.example.com`),
+			setupMocks: func(m secretInitExecuteMocks) {
+				m.mockStore.EXPECT().ListEnvironments(testApp).Return([]*config.Environment{
+					{Name: "test"},
+					{Name: "prod"},
+				}, nil)
+				m.mockSecretPutter.EXPECT().PutSecret(ssm.PutSecretInput{
+					Name:  "/copilot/test-app/test/secrets/db-host",
+					Value: "db.example.com",
+					Tags: map[string]string{
+						deploy.AppTagKey: "test-app",
+						deploy.EnvTagKey: "test",
+					},
+				}).Return(&ssm.PutSecretOutput{
+					Version: aws.Int64(1),
+				}, nil)
+				m.mockSecretPutter.EXPECT().PutSecret(ssm.PutSecretInput{
+					Name:  "/copilot/test-app/prod/secrets/db-host",
+					Value: "prod.db.example.com",
+					Tags: map[string]string{
+						deploy.AppTagKey: "test-app",
+						deploy.EnvTagKey: "prod",
+					},
+				}).Return(&ssm.PutSecretOutput{
+					Version: aws.Int64(1),
+				}, nil)
+				m.mockEnvCompatibilityChecker.EXPECT().Version().Return("v1.10.0", nil).Times(2)
+			},
+		},
 		"some secrets fail to create during a batch operation": {
 			inAppName:       testApp,
 			inInputFilePath: "some/file",
@@ -568,22 +823,27 @@ db-host:
 			m := secretInitExecuteMocks{
 				mockStore:                   mocks.NewMockstore(ctrl),
 				mockSecretPutter:            mocks.NewMocksecretPutter(ctrl),
+				mockSecretGetter:            mocks.NewMocksecretGetter(ctrl),
 				mockEnvCompatibilityChecker: mocks.NewMockversionCompatibilityChecker(ctrl),
 			}
 			tc.setupMocks(m)
 
 			opts := secretInitOpts{
 				secretInitVars: secretInitVars{
-					appName:       tc.inAppName,
-					name:          tc.inName,
-					values:        tc.inValues,
-					overwrite:     tc.inOverwrite,
-					inputFilePath: tc.inInputFilePath,
+					appName:              tc.inAppName,
+					name:                 tc.inName,
+					values:               tc.inValues,
+					defaultValue:         tc.inDefaultValue,
+					overwrite:            tc.inOverwrite,
+					overwriteOnlyChanged: tc.inOverwriteOnlyChanged,
+					inputFilePath:        tc.inInputFilePath,
 				},
 				store: m.mockStore,
 
 				secretPutters:           make(map[string]secretPutter),
+				secretGetters:           make(map[string]secretGetter),
 				envCompatibilityChecker: make(map[string]versionCompatibilityChecker),
+				envKMSKeys:              make(map[string]string),
 				readFile: func() ([]byte, error) {
 					return tc.mockInputFileContent, nil
 				},
@@ -591,7 +851,9 @@ db-host:
 
 			opts.configureClientsForEnv = func(envName string) error {
 				opts.secretPutters[envName] = m.mockSecretPutter
+				opts.secretGetters[envName] = m.mockSecretGetter
 				opts.envCompatibilityChecker[envName] = m.mockEnvCompatibilityChecker
+				opts.envKMSKeys[envName] = tc.mockEnvKMSKeys[envName]
 				return nil
 			}
 
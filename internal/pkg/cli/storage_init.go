@@ -7,12 +7,16 @@ import (
 	"encoding"
 	"errors"
 	"fmt"
+	"math"
+	"slices"
 	"strconv"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
 	"github.com/dustin/go-humanize/english"
 
@@ -130,6 +134,20 @@ const (
 
 	fmtRDSStorageNameDefault = "%s-cluster"
 
+	// Aurora Serverless v2 capacity limits and defaults, in ACUs.
+	minAuroraServerlessV2Capacity        = 0.5
+	maxAuroraServerlessV2Capacity        = 128
+	defaultAuroraServerlessV2MaxCapacity = 8
+	maxAuroraReaderInstances             = 15
+
+	// Aurora Serverless v2 requires Aurora MySQL version 3 and Aurora PostgreSQL version 13 or later.
+	auroraMySQLV2EngineVersionPrefix  = "8.0.mysql_aurora.3."
+	minAuroraPostgreSQLV2MajorVersion = 13
+
+	// Minimum number of days that objects stay in S3 Standard before moving to Standard-IA,
+	// and in Standard-IA before moving to Glacier.
+	minS3TransitionToIADays = 30
+
 	engineTypeMySQL      = addon.RDSEngineTypeMySQL
 	engineTypePostgreSQL = addon.RDSEngineTypePostgreSQL
 )
@@ -170,6 +188,19 @@ type initStorageVars struct {
 	rdsEngine               string
 	rdsParameterGroup       string
 	rdsInitialDBName        string
+	rdsMinCapacity          float64
+	rdsMaxCapacity          float64
+	rdsReaderInstances      int
+	rdsProxy                bool
+	rdsEngineVersion        string
+	rdsSubnetGroup          string
+
+	// S3 specific values collected via flags.
+	s3TransitionToIADays      int
+	s3TransitionToGlacierDays int
+	s3ReplicationBucket       string
+	s3EventBridge             bool
+	s3PolicyPresets           []string
 }
 
 type initStorageOpts struct {
@@ -240,6 +271,15 @@ func (o *initStorageOpts) Validate() error {
 			return err
 		}
 	}
+	if err := o.validateServerlessV2Config(); err != nil {
+		return err
+	}
+	if err := o.validateRDSSubnetGroup(); err != nil {
+		return err
+	}
+	if err := o.validateS3Config(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -277,6 +317,149 @@ func (o *initStorageOpts) validateServerlessVersion() error {
 	return fmt.Errorf(fmtErrInvalidServerlessVersion, o.auroraServerlessVersion, prettify(auroraServerlessVersions))
 }
 
+func (o *initStorageOpts) validateServerlessV2Config() error {
+	var v2Flags []string
+	if o.rdsMinCapacity != 0 {
+		v2Flags = append(v2Flags, storageRDSMinCapacityFlag)
+	}
+	if o.rdsMaxCapacity != 0 {
+		v2Flags = append(v2Flags, storageRDSMaxCapacityFlag)
+	}
+	if o.rdsReaderInstances != 0 {
+		v2Flags = append(v2Flags, storageRDSReaderInstancesFlag)
+	}
+	if o.rdsProxy {
+		v2Flags = append(v2Flags, storageRDSProxyFlag)
+	}
+	if o.rdsEngineVersion != "" {
+		v2Flags = append(v2Flags, storageRDSEngineVersionFlag)
+	}
+	if len(v2Flags) == 0 {
+		return nil
+	}
+	if o.storageType != "" && o.storageType != rdsStorageType {
+		return fmt.Errorf("--%s can only be specified with --%s %s", v2Flags[0], storageTypeFlag, rdsStorageType)
+	}
+	if o.auroraServerlessVersion == auroraServerlessVersionV1 {
+		return fmt.Errorf("--%s cannot be specified with Aurora Serverless %s", v2Flags[0], auroraServerlessVersionV1)
+	}
+	minCapacity, maxCapacity := float64(minAuroraServerlessV2Capacity), float64(defaultAuroraServerlessV2MaxCapacity)
+	if o.rdsMinCapacity != 0 {
+		if err := validateAuroraCapacity(o.rdsMinCapacity); err != nil {
+			return fmt.Errorf("validate --%s: %w", storageRDSMinCapacityFlag, err)
+		}
+		minCapacity = o.rdsMinCapacity
+	}
+	if o.rdsMaxCapacity != 0 {
+		if err := validateAuroraCapacity(o.rdsMaxCapacity); err != nil {
+			return fmt.Errorf("validate --%s: %w", storageRDSMaxCapacityFlag, err)
+		}
+		maxCapacity = o.rdsMaxCapacity
+	}
+	if minCapacity > maxCapacity {
+		return fmt.Errorf("minimum capacity %v ACUs cannot be greater than maximum capacity %v ACUs", minCapacity, maxCapacity)
+	}
+	if o.rdsReaderInstances < 0 || o.rdsReaderInstances > maxAuroraReaderInstances {
+		return fmt.Errorf("--%s must be between 0 and %d", storageRDSReaderInstancesFlag, maxAuroraReaderInstances)
+	}
+	return nil
+}
+
+func (o *initStorageOpts) validateRDSSubnetGroup() error {
+	if o.rdsSubnetGroup == "" {
+		return nil
+	}
+	if o.storageType != "" && o.storageType != rdsStorageType {
+		return fmt.Errorf("--%s can only be specified with --%s %s", storageRDSSubnetGroupFlag, storageTypeFlag, rdsStorageType)
+	}
+	if !subnetGroupNameRegexp.MatchString(o.rdsSubnetGroup) {
+		return fmt.Errorf("--%s %q must contain only alphanumeric characters", storageRDSSubnetGroupFlag, o.rdsSubnetGroup)
+	}
+	if strings.EqualFold(o.rdsSubnetGroup, string(manifest.PublicSubnetPlacement)) || strings.EqualFold(o.rdsSubnetGroup, string(manifest.PrivateSubnetPlacement)) {
+		return fmt.Errorf("--%s %q must be the name of a subnet group, not of the public or private subnets", storageRDSSubnetGroupFlag, o.rdsSubnetGroup)
+	}
+	return nil
+}
+
+func (o *initStorageOpts) validateS3Config() error {
+	var s3Flags []string
+	if o.s3TransitionToIADays != 0 {
+		s3Flags = append(s3Flags, storageS3TransitionToIAFlag)
+	}
+	if o.s3TransitionToGlacierDays != 0 {
+		s3Flags = append(s3Flags, storageS3TransitionToGlacierFlag)
+	}
+	if o.s3ReplicationBucket != "" {
+		s3Flags = append(s3Flags, storageS3ReplicationBucketFlag)
+	}
+	if o.s3EventBridge {
+		s3Flags = append(s3Flags, storageS3EventBridgeFlag)
+	}
+	if len(o.s3PolicyPresets) != 0 {
+		s3Flags = append(s3Flags, storageS3PolicyPresetsFlag)
+	}
+	if len(s3Flags) == 0 {
+		return nil
+	}
+	if o.storageType != "" && o.storageType != s3StorageType {
+		return fmt.Errorf("--%s can only be specified with --%s %s", s3Flags[0], storageTypeFlag, s3StorageType)
+	}
+	if o.s3TransitionToIADays < 0 || (o.s3TransitionToIADays != 0 && o.s3TransitionToIADays < minS3TransitionToIADays) {
+		return fmt.Errorf("--%s must be at least %d days", storageS3TransitionToIAFlag, minS3TransitionToIADays)
+	}
+	if o.s3TransitionToGlacierDays < 0 {
+		return fmt.Errorf("--%s must be a positive number of days", storageS3TransitionToGlacierFlag)
+	}
+	if o.s3TransitionToIADays != 0 && o.s3TransitionToGlacierDays != 0 &&
+		o.s3TransitionToGlacierDays < o.s3TransitionToIADays+minS3TransitionToIADays {
+		return fmt.Errorf("--%s must be at least %d days greater than --%s", storageS3TransitionToGlacierFlag, minS3TransitionToIADays, storageS3TransitionToIAFlag)
+	}
+	if o.s3ReplicationBucket != "" {
+		if err := s3BucketNameValidation(o.s3ReplicationBucket); err != nil {
+			return fmt.Errorf("validate --%s: %w", storageS3ReplicationBucketFlag, err)
+		}
+	}
+	for _, preset := range o.s3PolicyPresets {
+		if !slices.Contains(addon.S3PolicyPresets, preset) {
+			return fmt.Errorf("invalid --%s value %q: must be one of %s", storageS3PolicyPresetsFlag, preset, prettify(addon.S3PolicyPresets))
+		}
+	}
+	return nil
+}
+
+// validateAuroraCapacity returns an error if the capacity is not a multiple of 0.5 from 0.5 through 128.
+// validateAuroraEngineVersion returns an error if the version is not an Aurora Serverless v2 compatible version of the engine.
+func validateAuroraEngineVersion(engine, version string) error {
+	if version == "" {
+		return nil
+	}
+	if engine == engineTypeMySQL {
+		if !strings.HasPrefix(version, auroraMySQLV2EngineVersionPrefix) {
+			return fmt.Errorf("engine version %s of %s must start with %q to support Aurora Serverless v2", version, engine, auroraMySQLV2EngineVersionPrefix)
+		}
+		return nil
+	}
+	major, minor, ok := strings.Cut(version, ".")
+	majorVersion, err := strconv.Atoi(major)
+	if _, minorErr := strconv.Atoi(minor); !ok || err != nil || minorErr != nil {
+		return fmt.Errorf("engine version %s of %s must be in the format <major>.<minor>", version, engine)
+	}
+	if majorVersion < minAuroraPostgreSQLV2MajorVersion {
+		return fmt.Errorf("engine version %s of %s must be %d or later to support Aurora Serverless v2", version, engine, minAuroraPostgreSQLV2MajorVersion)
+	}
+	return nil
+}
+
+func validateAuroraCapacity(capacity float64) error {
+	if capacity < minAuroraServerlessV2Capacity || capacity > maxAuroraServerlessV2Capacity {
+		return fmt.Errorf("capacity %v must be from %v through %v ACUs", capacity, minAuroraServerlessV2Capacity, maxAuroraServerlessV2Capacity)
+	}
+	if math.Mod(capacity, minAuroraServerlessV2Capacity) != 0 {
+		return fmt.Errorf("capacity %v must be a multiple of %v ACUs", capacity, minAuroraServerlessV2Capacity)
+	}
+	return nil
+}
+
 // Ask asks for fields that are required but not passed in.
 func (o *initStorageOpts) Ask() error {
 	if o.addIngressFrom != "" {
@@ -700,7 +883,10 @@ func (o *initStorageOpts) validateOrAskDynamoLSIConfig() error {
 
 func (o *initStorageOpts) validateOrAskAuroraEngineType() error {
 	if o.rdsEngine != "" {
-		return validateEngine(o.rdsEngine)
+		if err := validateEngine(o.rdsEngine); err != nil {
+			return err
+		}
+		return validateAuroraEngineVersion(o.rdsEngine, o.rdsEngineVersion)
 	}
 	engine, err := o.prompt.SelectOne(storageInitRDSDBEnginePrompt,
 		"",
@@ -710,7 +896,7 @@ func (o *initStorageOpts) validateOrAskAuroraEngineType() error {
 		return fmt.Errorf("select database engine: %w", err)
 	}
 	o.rdsEngine = engine
-	return nil
+	return validateAuroraEngineVersion(o.rdsEngine, o.rdsEngineVersion)
 }
 
 func (o *initStorageOpts) validateOrAskAuroraInitialDBName() error {
@@ -953,6 +1139,11 @@ func (o *initStorageOpts) s3Props() *addon.S3Props {
 		StorageProps: &addon.StorageProps{
 			Name: o.storageName,
 		},
+		TransitionToIADays:      o.s3TransitionToIADays,
+		TransitionToGlacierDays: o.s3TransitionToGlacierDays,
+		ReplicationBucket:       o.s3ReplicationBucket,
+		EventBridge:             o.s3EventBridge,
+		PolicyPresets:           o.s3PolicyPresets,
 	}
 }
 
@@ -1008,7 +1199,7 @@ func (o *initStorageOpts) envRDSAddonBlobs() ([]addonBlob, error) {
 	paramBlob := addonBlob{
 		path:        o.ws.EnvAddonFilePath(workspace.AddonsParametersFileName),
 		description: blobDescriptionParameters,
-		blob:        addon.EnvParamsForRDS(),
+		blob:        addon.EnvParamsForRDS(o.rdsSubnetGroup),
 	}
 	return []addonBlob{tmplBlob, paramBlob}, nil
 }
@@ -1042,7 +1233,7 @@ func (o *initStorageOpts) envRDSForRDWSAddonBlobs() ([]addonBlob, error) {
 	paramBlob := addonBlob{
 		path:        o.ws.EnvAddonFilePath(workspace.AddonsParametersFileName),
 		description: blobDescriptionParameters,
-		blob:        addon.EnvParamsForRDS(),
+		blob:        addon.EnvParamsForRDS(o.rdsSubnetGroup),
 	}
 	if o.workloadExists {
 		return []addonBlob{tmplBlob, paramBlob, rdwsIngressTmplBlob, rdwsIngressParamBlob}, nil
@@ -1056,11 +1247,17 @@ func (o *initStorageOpts) rdsProps() (addon.RDSProps, error) {
 		return addon.RDSProps{}, err
 	}
 	return addon.RDSProps{
-		ClusterName:    o.storageName,
-		Engine:         o.rdsEngine,
-		InitialDBName:  o.rdsInitialDBName,
-		ParameterGroup: o.rdsParameterGroup,
-		Envs:           envs,
+		ClusterName:     o.storageName,
+		Engine:          o.rdsEngine,
+		InitialDBName:   o.rdsInitialDBName,
+		ParameterGroup:  o.rdsParameterGroup,
+		Envs:            envs,
+		MinCapacity:     o.rdsMinCapacity,
+		MaxCapacity:     o.rdsMaxCapacity,
+		ReaderInstances: o.rdsReaderInstances,
+		RDSProxy:        o.rdsProxy,
+		EngineVersion:   o.rdsEngineVersion,
+		SubnetGroup:     o.rdsSubnetGroup,
 	}, nil
 }
 
@@ -1219,10 +1416,14 @@ Storage resources are addons, either for a workload or the environments.`,
   /code $ copilot storage init -n my-bucket -t S3 -w frontend -l workload
   Create an environment S3 bucket fronted by the "api" service.
   /code $ copilot storage init -n my-bucket -t S3 -w api -l environment
+  Create an S3 bucket that moves objects to Standard-IA after 30 days and replicates them to another bucket.
+  /code $ copilot storage init -n my-bucket -t S3 -w frontend --transition-ia-days 30 --replication-bucket my-replica-bucket --policy-presets tls-1.2
   Create a DynamoDB table with a sort key.
   /code $ copilot storage init -n my-table -t DynamoDB -w frontend --partition-key Email:S --sort-key UserId:N --no-lsi
   Create an RDS Aurora Serverless v2 cluster using PostgreSQL.
-  /code $ copilot storage init -n my-cluster -t Aurora -w frontend --engine PostgreSQL --initial-db testdb`,
+  /code $ copilot storage init -n my-cluster -t Aurora -w frontend --engine PostgreSQL --initial-db testdb
+  Create an RDS Aurora Serverless v2 cluster with two reader instances and an RDS Proxy.
+  /code $ copilot storage init -n my-cluster -t Aurora -w frontend --min-capacity 1 --max-capacity 16 --reader-instances 2 --rds-proxy`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newStorageInitOpts(vars)
 			if err != nil {
@@ -1247,10 +1448,26 @@ Storage resources are addons, either for a workload or the environments.`,
 	cmd.Flags().StringVar(&vars.rdsEngine, storageRDSEngineFlag, "", storageRDSEngineFlagDescription)
 	cmd.Flags().StringVar(&vars.rdsInitialDBName, storageRDSInitialDBFlag, "", storageRDSInitialDBFlagDescription)
 	cmd.Flags().StringVar(&vars.rdsParameterGroup, storageRDSParameterGroupFlag, "", storageRDSParameterGroupFlagDescription)
+	cmd.Flags().Float64Var(&vars.rdsMinCapacity, storageRDSMinCapacityFlag, 0, storageRDSMinCapacityFlagDescription)
+	cmd.Flags().Float64Var(&vars.rdsMaxCapacity, storageRDSMaxCapacityFlag, 0, storageRDSMaxCapacityFlagDescription)
+	cmd.Flags().IntVar(&vars.rdsReaderInstances, storageRDSReaderInstancesFlag, 0, storageRDSReaderInstancesFlagDescription)
+	cmd.Flags().BoolVar(&vars.rdsProxy, storageRDSProxyFlag, false, storageRDSProxyFlagDescription)
+	cmd.Flags().StringVar(&vars.rdsEngineVersion, storageRDSEngineVersionFlag, "", storageRDSEngineVersionFlagDescription)
+	cmd.Flags().StringVar(&vars.rdsSubnetGroup, storageRDSSubnetGroupFlag, "", storageRDSSubnetGroupFlagDescription)
+
+	cmd.Flags().IntVar(&vars.s3TransitionToIADays, storageS3TransitionToIAFlag, 0, storageS3TransitionToIAFlagDescription)
+	cmd.Flags().IntVar(&vars.s3TransitionToGlacierDays, storageS3TransitionToGlacierFlag, 0, storageS3TransitionToGlacierFlagDescription)
+	cmd.Flags().StringVar(&vars.s3ReplicationBucket, storageS3ReplicationBucketFlag, "", storageS3ReplicationBucketFlagDescription)
+	cmd.Flags().BoolVar(&vars.s3EventBridge, storageS3EventBridgeFlag, false, storageS3EventBridgeFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.s3PolicyPresets, storageS3PolicyPresetsFlag, nil, storageS3PolicyPresetsFlagDescription)
 
 	ddbFlags := []string{storagePartitionKeyFlag, storageSortKeyFlag, storageNoSortFlag, storageLSIConfigFlag, storageNoLSIFlag}
-	rdsFlags := []string{storageAuroraServerlessVersionFlag, storageRDSEngineFlag, storageRDSInitialDBFlag, storageRDSParameterGroupFlag}
-	for _, f := range append(ddbFlags, storageAuroraServerlessVersionFlag, storageRDSInitialDBFlag, storageRDSParameterGroupFlag) {
+	rdsFlags := []string{storageAuroraServerlessVersionFlag, storageRDSEngineFlag, storageRDSInitialDBFlag, storageRDSParameterGroupFlag,
+		storageRDSMinCapacityFlag, storageRDSMaxCapacityFlag, storageRDSReaderInstancesFlag, storageRDSProxyFlag, storageRDSEngineVersionFlag, storageRDSSubnetGroupFlag}
+	s3Flags := []string{storageS3TransitionToIAFlag, storageS3TransitionToGlacierFlag, storageS3ReplicationBucketFlag,
+		storageS3EventBridgeFlag, storageS3PolicyPresetsFlag}
+	for _, f := range append(append(ddbFlags, storageAuroraServerlessVersionFlag, storageRDSInitialDBFlag, storageRDSParameterGroupFlag,
+		storageRDSMinCapacityFlag, storageRDSMaxCapacityFlag, storageRDSReaderInstancesFlag, storageRDSProxyFlag, storageRDSEngineVersionFlag, storageRDSSubnetGroupFlag), s3Flags...) {
 		cmd.MarkFlagsMutuallyExclusive(storageAddIngressFromFlag, f)
 	}
 	requiredFlags := pflag.NewFlagSet("Required", pflag.ContinueOnError)
@@ -1267,14 +1484,19 @@ Storage resources are addons, either for a workload or the environments.`,
 	for _, f := range rdsFlags {
 		auroraFlagSet.AddFlag(cmd.Flags().Lookup(f))
 	}
+	s3FlagSet := pflag.NewFlagSet("S3", pflag.ContinueOnError)
+	for _, f := range s3Flags {
+		s3FlagSet.AddFlag(cmd.Flags().Lookup(f))
+	}
 
 	optionalFlagSet := pflag.NewFlagSet("Optional", pflag.ContinueOnError)
 	optionalFlagSet.AddFlag(cmd.Flags().Lookup(storageAddIngressFromFlag))
 
 	cmd.Annotations = map[string]string{
 		// The order of the sections we want to display.
-		"sections":          `Required,DynamoDB,Aurora Serverless,Optional`,
+		"sections":          `Required,S3,DynamoDB,Aurora Serverless,Optional`,
 		"Required":          requiredFlags.FlagUsages(),
+		"S3":                s3FlagSet.FlagUsages(),
 		"DynamoDB":          ddbFlagSet.FlagUsages(),
 		"Aurora Serverless": auroraFlagSet.FlagUsages(),
 		"Optional":          optionalFlagSet.FlagUsages(),
@@ -36,6 +36,17 @@ func TestStorageInitOpts_Validate(t *testing.T) {
 		inNoLSI             bool
 		inServerlessVersion string
 		inEngine            string
+		inMinCapacity       float64
+		inMaxCapacity       float64
+		inReaderInstances   int
+		inRDSProxy          bool
+		inEngineVersion     string
+		inSubnetGroup       string
+		inIADays            int
+		inGlacierDays       int
+		inReplicationBucket string
+		inEventBridge       bool
+		inPolicyPresets     []string
 
 		mock      func(m *mockStorageInitValidate)
 		wantedErr error
@@ -126,6 +137,138 @@ func TestStorageInitOpts_Validate(t *testing.T) {
 			mock:                func(m *mockStorageInitValidate) {},
 			wantedErr:           errors.New("invalid Aurora Serverless version weird-serverless-version: must be one of \"v1\", \"v2\""),
 		},
+		"fails when aurora serverless v2 flags are used with another storage type": {
+			inAppName:     "bowie",
+			inStorageType: s3StorageType,
+			inRDSProxy:    true,
+			mock:          func(m *mockStorageInitValidate) {},
+			wantedErr:     errors.New("--rds-proxy can only be specified with --storage-type Aurora"),
+		},
+		"fails when aurora serverless v2 flags are used with serverless v1": {
+			inAppName:           "bowie",
+			inStorageType:       rdsStorageType,
+			inServerlessVersion: auroraServerlessVersionV1,
+			inReaderInstances:   1,
+			mock:                func(m *mockStorageInitValidate) {},
+			wantedErr:           errors.New("--reader-instances cannot be specified with Aurora Serverless v1"),
+		},
+		"fails when the engine version is specified with serverless v1": {
+			inAppName:           "bowie",
+			inStorageType:       rdsStorageType,
+			inServerlessVersion: auroraServerlessVersionV1,
+			inEngineVersion:     "15.4",
+			mock:                func(m *mockStorageInitValidate) {},
+			wantedErr:           errors.New("--engine-version cannot be specified with Aurora Serverless v1"),
+		},
+		"fails when the subnet group is specified for a non-RDS storage": {
+			inAppName:     "bowie",
+			inStorageType: s3StorageType,
+			inSubnetGroup: "data",
+			mock:          func(m *mockStorageInitValidate) {},
+			wantedErr:     errors.New("--subnet-group can only be specified with --storage-type Aurora"),
+		},
+		"fails when the subnet group name is not alphanumeric": {
+			inAppName:     "bowie",
+			inStorageType: rdsStorageType,
+			inSubnetGroup: "data-tier",
+			mock:          func(m *mockStorageInitValidate) {},
+			wantedErr:     errors.New(`--subnet-group "data-tier" must contain only alphanumeric characters`),
+		},
+		"fails when the subnet group is named after the private subnets": {
+			inAppName:     "bowie",
+			inStorageType: rdsStorageType,
+			inSubnetGroup: "private",
+			mock:          func(m *mockStorageInitValidate) {},
+			wantedErr:     errors.New(`--subnet-group "private" must be the name of a subnet group, not of the public or private subnets`),
+		},
+		"fails when the minimum capacity is out of range": {
+			inAppName:           "bowie",
+			inStorageType:       rdsStorageType,
+			inServerlessVersion: auroraServerlessVersionV2,
+			inMinCapacity:       0.25,
+			mock:                func(m *mockStorageInitValidate) {},
+			wantedErr:           errors.New("validate --min-capacity: capacity 0.25 must be from 0.5 through 128 ACUs"),
+		},
+		"fails when the maximum capacity is not a multiple of 0.5": {
+			inAppName:           "bowie",
+			inStorageType:       rdsStorageType,
+			inServerlessVersion: auroraServerlessVersionV2,
+			inMaxCapacity:       10.2,
+			mock:                func(m *mockStorageInitValidate) {},
+			wantedErr:           errors.New("validate --max-capacity: capacity 10.2 must be a multiple of 0.5 ACUs"),
+		},
+		"fails when the minimum capacity is greater than the default maximum capacity": {
+			inAppName:           "bowie",
+			inStorageType:       rdsStorageType,
+			inServerlessVersion: auroraServerlessVersionV2,
+			inMinCapacity:       16,
+			mock:                func(m *mockStorageInitValidate) {},
+			wantedErr:           errors.New("minimum capacity 16 ACUs cannot be greater than maximum capacity 8 ACUs"),
+		},
+		"fails when there are too many reader instances": {
+			inAppName:           "bowie",
+			inStorageType:       rdsStorageType,
+			inServerlessVersion: auroraServerlessVersionV2,
+			inReaderInstances:   16,
+			mock:                func(m *mockStorageInitValidate) {},
+			wantedErr:           errors.New("--reader-instances must be between 0 and 15"),
+		},
+		"successfully validates aurora serverless v2 scaling, reader instances and rds proxy": {
+			inAppName:           "bowie",
+			inStorageType:       rdsStorageType,
+			inServerlessVersion: auroraServerlessVersionV2,
+			inMinCapacity:       1,
+			inMaxCapacity:       16.5,
+			inReaderInstances:   2,
+			inRDSProxy:          true,
+			mock:                func(m *mockStorageInitValidate) {},
+		},
+		"fails when s3 flags are specified with another storage type": {
+			inAppName:     "bowie",
+			inStorageType: dynamoDBStorageType,
+			inEventBridge: true,
+			mock:          func(m *mockStorageInitValidate) {},
+			wantedErr:     errors.New("--eventbridge can only be specified with --storage-type S3"),
+		},
+		"fails when objects move to standard-ia too early": {
+			inAppName:     "bowie",
+			inStorageType: s3StorageType,
+			inIADays:      7,
+			mock:          func(m *mockStorageInitValidate) {},
+			wantedErr:     errors.New("--transition-ia-days must be at least 30 days"),
+		},
+		"fails when objects move to glacier too soon after standard-ia": {
+			inAppName:     "bowie",
+			inStorageType: s3StorageType,
+			inIADays:      30,
+			inGlacierDays: 45,
+			mock:          func(m *mockStorageInitValidate) {},
+			wantedErr:     errors.New("--transition-glacier-days must be at least 30 days greater than --transition-ia-days"),
+		},
+		"fails when the replication bucket name is invalid": {
+			inAppName:           "bowie",
+			inStorageType:       s3StorageType,
+			inReplicationBucket: "My_Bucket",
+			mock:                func(m *mockStorageInitValidate) {},
+			wantedErr:           fmt.Errorf("validate --replication-bucket: %w", errValueBadFormatWithPeriod),
+		},
+		"fails on unknown policy preset": {
+			inAppName:       "bowie",
+			inStorageType:   s3StorageType,
+			inPolicyPresets: []string{"tls-1.2", "public-read"},
+			mock:            func(m *mockStorageInitValidate) {},
+			wantedErr:       errors.New(`invalid --policy-presets value "public-read": must be one of "tls-1.2", "same-account"`),
+		},
+		"successfully validates s3 lifecycle transitions, replication and policy presets": {
+			inAppName:           "bowie",
+			inStorageType:       s3StorageType,
+			inIADays:            30,
+			inGlacierDays:       90,
+			inReplicationBucket: "my-replica-bucket",
+			inEventBridge:       true,
+			inPolicyPresets:     []string{"tls-1.2", "same-account"},
+			mock:                func(m *mockStorageInitValidate) {},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -139,18 +282,29 @@ func TestStorageInitOpts_Validate(t *testing.T) {
 			tc.mock(&m)
 			opts := initStorageOpts{
 				initStorageVars: initStorageVars{
-					storageType:             tc.inStorageType,
-					storageName:             tc.inStorageName,
-					workloadName:            tc.inSvcName,
-					lifecycle:               tc.inLifecycle,
-					addIngressFrom:          tc.inAddIngressFrom,
-					partitionKey:            tc.inPartition,
-					sortKey:                 tc.inSort,
-					lsiSorts:                tc.inLSISorts,
-					noLSI:                   tc.inNoLSI,
-					noSort:                  tc.inNoSort,
-					auroraServerlessVersion: tc.inServerlessVersion,
-					rdsEngine:               tc.inEngine,
+					storageType:               tc.inStorageType,
+					storageName:               tc.inStorageName,
+					workloadName:              tc.inSvcName,
+					lifecycle:                 tc.inLifecycle,
+					addIngressFrom:            tc.inAddIngressFrom,
+					partitionKey:              tc.inPartition,
+					sortKey:                   tc.inSort,
+					lsiSorts:                  tc.inLSISorts,
+					noLSI:                     tc.inNoLSI,
+					noSort:                    tc.inNoSort,
+					auroraServerlessVersion:   tc.inServerlessVersion,
+					rdsEngine:                 tc.inEngine,
+					rdsMinCapacity:            tc.inMinCapacity,
+					rdsMaxCapacity:            tc.inMaxCapacity,
+					rdsReaderInstances:        tc.inReaderInstances,
+					rdsProxy:                  tc.inRDSProxy,
+					rdsEngineVersion:          tc.inEngineVersion,
+					rdsSubnetGroup:            tc.inSubnetGroup,
+					s3TransitionToIADays:      tc.inIADays,
+					s3TransitionToGlacierDays: tc.inGlacierDays,
+					s3ReplicationBucket:       tc.inReplicationBucket,
+					s3EventBridge:             tc.inEventBridge,
+					s3PolicyPresets:           tc.inPolicyPresets,
 				},
 				appName: tc.inAppName,
 				ws:      m.ws,
@@ -962,6 +1116,7 @@ func TestStorageInitOpts_AskRDS(t *testing.T) {
 
 		inServerlessVersion string
 		inDBEngine          string
+		inEngineVersion     string
 		inInitialDBName     string
 
 		mock func(m *mockStorageInitAsk)
@@ -1024,6 +1179,40 @@ func TestStorageInitOpts_AskRDS(t *testing.T) {
 			},
 			wantedErr: errors.New("invalid engine type mysql: must be one of \"MySQL\", \"PostgreSQL\""),
 		},
+		"invalid mysql engine version": {
+			inStorageName:   wantedClusterName,
+			inDBEngine:      engineTypeMySQL,
+			inEngineVersion: "5.7.mysql_aurora.2.11.2",
+			mock: func(m *mockStorageInitAsk) {
+				m.ws.EXPECT().ReadWorkloadManifest(wantedSvcName).Return(workspace.WorkloadManifest("type: Load Balanced Web Service"), nil)
+				m.ws.EXPECT().HasEnvironments().Return(true, nil).AnyTimes()
+				m.ws.EXPECT().WorkloadExists(gomock.Any()).Return(true, nil).AnyTimes()
+			},
+			wantedErr: errors.New(`engine version 5.7.mysql_aurora.2.11.2 of MySQL must start with "8.0.mysql_aurora.3." to support Aurora Serverless v2`),
+		},
+		"malformed postgresql engine version": {
+			inStorageName:   wantedClusterName,
+			inDBEngine:      engineTypePostgreSQL,
+			inEngineVersion: "15",
+			mock: func(m *mockStorageInitAsk) {
+				m.ws.EXPECT().ReadWorkloadManifest(wantedSvcName).Return(workspace.WorkloadManifest("type: Load Balanced Web Service"), nil)
+				m.ws.EXPECT().HasEnvironments().Return(true, nil).AnyTimes()
+				m.ws.EXPECT().WorkloadExists(gomock.Any()).Return(true, nil).AnyTimes()
+			},
+			wantedErr: errors.New("engine version 15 of PostgreSQL must be in the format <major>.<minor>"),
+		},
+		"validates the engine version against the selected engine": {
+			inStorageName:   wantedClusterName,
+			inEngineVersion: "11.9",
+			mock: func(m *mockStorageInitAsk) {
+				m.ws.EXPECT().ReadWorkloadManifest(wantedSvcName).Return(workspace.WorkloadManifest("type: Load Balanced Web Service"), nil)
+				m.ws.EXPECT().HasEnvironments().Return(true, nil).AnyTimes()
+				m.ws.EXPECT().WorkloadExists(gomock.Any()).Return(true, nil).AnyTimes()
+				m.prompt.EXPECT().SelectOne(gomock.Eq(storageInitRDSDBEnginePrompt), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(engineTypePostgreSQL, nil)
+			},
+			wantedErr: errors.New("engine version 11.9 of PostgreSQL must be 13 or later to support Aurora Serverless v2"),
+		},
 		"asks for engine if not specified": {
 			inStorageName:   wantedClusterName,
 			inInitialDBName: wantedInitialDBName,
@@ -1136,6 +1325,7 @@ func TestStorageInitOpts_AskRDS(t *testing.T) {
 
 					auroraServerlessVersion: wantedServerlessVersion,
 					rdsEngine:               tc.inDBEngine,
+					rdsEngineVersion:        tc.inEngineVersion,
 					rdsInitialDBName:        tc.inInitialDBName,
 				},
 				appName: "ddos",
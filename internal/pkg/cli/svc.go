@@ -26,6 +26,7 @@ Services are long-running ECS or App Runner services.`,
 	cmd.AddCommand(buildSvcInitCmd())
 	cmd.AddCommand(buildSvcListCmd())
 	cmd.AddCommand(buildSvcPackageCmd())
+	cmd.AddCommand(buildSvcDiffCmd())
 	cmd.AddCommand(buildSvcOverrideCmd())
 	cmd.AddCommand(buildSvcDeployCmd())
 	cmd.AddCommand(buildSvcDeleteCmd())
@@ -35,6 +36,10 @@ Services are long-running ECS or App Runner services.`,
 	cmd.AddCommand(buildSvcExecCmd())
 	cmd.AddCommand(buildSvcPauseCmd())
 	cmd.AddCommand(buildSvcResumeCmd())
+	cmd.AddCommand(buildSvcRedriveCmd())
+	cmd.AddCommand(buildSvcAutoscaleCmd())
+	cmd.AddCommand(buildSvcRollbackCmd())
+	cmd.AddCommand(buildSvcCleanupCmd())
 
 	cmd.SetUsageTemplate(template.Usage)
 
@@ -0,0 +1,25 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/spf13/cobra"
+)
+
+// buildSvcAutoscaleCmd builds the command group for the auto scaling capacity of a service.
+func buildSvcAutoscaleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "autoscale",
+		Short: "Commands for the auto scaling capacity of a deployed service.",
+		Long: `Commands for the auto scaling capacity of a deployed service.
+View or temporarily override the minimum and maximum number of tasks without a redeployment.`,
+	}
+
+	cmd.AddCommand(buildSvcAutoscaleStatusCmd())
+	cmd.AddCommand(buildSvcAutoscaleOverrideCmd())
+
+	cmd.SetUsageTemplate(template.Usage)
+	return cmd
+}
@@ -0,0 +1,285 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/aas"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/version"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcAutoscaleOverrideNamePrompt     = "Which service's auto scaling capacity would you like to override?"
+	svcAutoscaleOverrideNameHelpPrompt = "The minimum and maximum number of tasks of the service will be overridden until the override reverts."
+
+	defaultCapacityRevertAfter = time.Hour
+)
+
+type svcAutoscaleOverrideVars struct {
+	appName     string
+	envName     string
+	svcName     string
+	min         *int
+	max         *int
+	revertAfter time.Duration
+}
+
+type svcAutoscaleOverrideOpts struct {
+	svcAutoscaleOverrideVars
+
+	store        store
+	ws           wsEnvironmentsLister
+	sel          deploySelector
+	svcDescriber serviceDescriber
+	overrider    serviceCapacityOverrider
+	envChecker   versionCompatibilityChecker
+	initClients  func() error
+	now          func() time.Time
+}
+
+func newSvcAutoscaleOverrideOpts(vars svcAutoscaleOverrideVars) (*svcAutoscaleOverrideOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("svc autoscale override"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+	configStore := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	deployStore, err := deploy.NewStore(sessProvider, configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	ws, err := workspace.Use(afero.NewOsFs())
+	if err != nil {
+		return nil, err
+	}
+	opts := &svcAutoscaleOverrideOpts{
+		svcAutoscaleOverrideVars: vars,
+		store:                    configStore,
+		ws:                       ws,
+		sel:                      selector.NewDeploySelect(prompt.New(), configStore, deployStore),
+		now:                      time.Now,
+	}
+	opts.initClients = func() error {
+		env, err := configStore.GetEnvironment(opts.appName, opts.envName)
+		if err != nil {
+			return fmt.Errorf("get environment %s: %w", opts.envName, err)
+		}
+		sess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+		if err != nil {
+			return fmt.Errorf("session for role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
+		}
+		opts.svcDescriber = ecs.New(sess)
+		opts.overrider = aas.New(sess)
+		envDescriber, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
+			App:         opts.appName,
+			Env:         opts.envName,
+			ConfigStore: configStore,
+		})
+		if err != nil {
+			return fmt.Errorf("new environment compatibility checker: %v", err)
+		}
+		opts.envChecker = envDescriber
+		return nil
+	}
+	return opts, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *svcAutoscaleOverrideOpts) Validate() error {
+	if o.min == nil && o.max == nil {
+		return fmt.Errorf("at least one of --%s or --%s must be specified", minCapacityFlag, maxCapacityFlag)
+	}
+	if o.min != nil && aws.IntValue(o.min) < 0 {
+		return fmt.Errorf("--%s must not be negative", minCapacityFlag)
+	}
+	if o.max != nil && aws.IntValue(o.max) < 0 {
+		return fmt.Errorf("--%s must not be negative", maxCapacityFlag)
+	}
+	if o.min != nil && o.max != nil && aws.IntValue(o.min) > aws.IntValue(o.max) {
+		return fmt.Errorf("--%s %d must not be greater than --%s %d", minCapacityFlag, aws.IntValue(o.min), maxCapacityFlag, aws.IntValue(o.max))
+	}
+	if o.revertAfter <= 0 {
+		return fmt.Errorf("--%s must be a positive duration", revertAfterFlag)
+	}
+	return nil
+}
+
+// Ask prompts for and validates any required flags.
+func (o *svcAutoscaleOverrideOpts) Ask() error {
+	if err := o.validateOrAskApp(); err != nil {
+		return err
+	}
+	return o.validateAndAskSvcEnvName()
+}
+
+// ValidateVersionPin returns an error if the application doesn't allow this version of Copilot to override the capacity of its services.
+func (o *svcAutoscaleOverrideOpts) ValidateVersionPin() error {
+	return validateVersionPin(o.store, o.appName, version.Version, "")
+}
+
+// Execute overrides the minimum and maximum number of tasks of the service,
+// and schedules the capacity to revert to its value prior to the override.
+func (o *svcAutoscaleOverrideOpts) Execute() error {
+	if err := o.initClients(); err != nil {
+		return err
+	}
+	if err := validateMinEnvVersion(o.ws, o.envChecker, o.appName, o.envName, template.SvcAutoscaleMinEnvVersion, "svc autoscale override"); err != nil {
+		return err
+	}
+	svc, err := o.svcDescriber.DescribeService(o.appName, o.envName, o.svcName)
+	if err != nil {
+		return fmt.Errorf("describe service %s: %w", o.svcName, err)
+	}
+	current, err := o.overrider.ECSServiceCapacity(svc.ClusterName, svc.Name)
+	if err != nil {
+		return errNotAutoscaled(err, o.svcName, o.envName)
+	}
+	now := o.now()
+	original := *current
+	// Overriding an override that hasn't reverted yet keeps reverting to the capacity prior to the first override.
+	pending, err := o.overrider.ECSServiceScheduledAction(svc.ClusterName, svc.Name, describe.CapacityRevertActionName)
+	if err != nil {
+		return fmt.Errorf("get capacity revert of service %s: %w", o.svcName, err)
+	}
+	if pending != nil && pending.At.After(now) {
+		original = pending.Capacity
+	}
+	override := aas.Capacity{
+		Min: current.Min,
+		Max: current.Max,
+	}
+	if o.min != nil {
+		override.Min = aws.IntValue(o.min)
+	}
+	if o.max != nil {
+		override.Max = aws.IntValue(o.max)
+	}
+	if override.Min > override.Max {
+		return fmt.Errorf("minimum number of tasks %d must not be greater than maximum number of tasks %d", override.Min, override.Max)
+	}
+	// Schedule the revert first so that the capacity never stays overridden if the override fails halfway.
+	revertAt := now.Add(o.revertAfter).Truncate(time.Second)
+	if err := o.overrider.ScheduleECSServiceCapacity(svc.ClusterName, svc.Name, aas.ScheduledAction{
+		Name:     describe.CapacityRevertActionName,
+		At:       revertAt,
+		Capacity: original,
+	}); err != nil {
+		return fmt.Errorf("schedule capacity revert of service %s: %w", o.svcName, err)
+	}
+	if err := o.overrider.SetECSServiceCapacity(svc.ClusterName, svc.Name, override); err != nil {
+		return fmt.Errorf("override capacity of service %s: %w", o.svcName, err)
+	}
+	log.Successf("Overrode the capacity of service %s in environment %s to %d-%d tasks.\n",
+		o.svcName, o.envName, override.Min, override.Max)
+	log.Infof("The capacity reverts to %d-%d tasks at %s.\n", original.Min, original.Max, revertAt.Local().Format(time.RFC1123))
+	return nil
+}
+
+// RecommendActions returns follow-up actions the user can take after successfully executing the command.
+func (o *svcAutoscaleOverrideOpts) RecommendActions() error {
+	logRecommendedActions([]string{
+		fmt.Sprintf("Run %s to follow the number of running tasks and the pending revert.",
+			color.HighlightCode(fmt.Sprintf("copilot svc autoscale status -n %s -e %s", o.svcName, o.envName))),
+		fmt.Sprintf("Update %s in the manifest instead to change the capacity permanently.",
+			color.HighlightCode("count.range")),
+	})
+	return nil
+}
+
+func (o *svcAutoscaleOverrideOpts) validateOrAskApp() error {
+	if o.appName != "" {
+		_, err := o.store.GetApplication(o.appName)
+		return err
+	}
+	app, err := o.sel.Application(svcAppNamePrompt, wkldAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *svcAutoscaleOverrideOpts) validateAndAskSvcEnvName() error {
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	if o.svcName != "" {
+		svc, err := o.store.GetService(o.appName, o.svcName)
+		if err != nil {
+			return err
+		}
+		if !isECSServiceType(svc.Type) {
+			return fmt.Errorf("service %s is a %s that does not run on Amazon ECS", o.svcName, svc.Type)
+		}
+	}
+	// Note: we let prompter handle the case when there is only option for user to choose from.
+	// This is naturally the case when `o.envName != "" && o.svcName != ""`.
+	deployedService, err := o.sel.DeployedService(svcAutoscaleOverrideNamePrompt, svcAutoscaleOverrideNameHelpPrompt, o.appName,
+		selector.WithEnv(o.envName), selector.WithName(o.svcName), selector.WithServiceTypesFilter(ecsServiceTypes))
+	if err != nil {
+		return fmt.Errorf("select deployed services for application %s: %w", o.appName, err)
+	}
+	o.svcName = deployedService.Name
+	o.envName = deployedService.Env
+	return nil
+}
+
+// buildSvcAutoscaleOverrideCmd builds the command for temporarily overriding the auto scaling capacity of a service.
+func buildSvcAutoscaleOverrideCmd() *cobra.Command {
+	vars := svcAutoscaleOverrideVars{}
+	var minCapacity, maxCapacity int
+	cmd := &cobra.Command{
+		Use:   "override",
+		Short: "Temporarily overrides the auto scaling capacity of a deployed service.",
+		Long: `Temporarily overrides the minimum and maximum number of tasks of a deployed service,
+for example ahead of a planned event. The capacity reverts automatically after the --revert-after duration.`,
+
+		Example: `
+  Keeps at least 10 tasks of the service "api" in the "prod" environment running for the next 3 hours.
+  /code $ copilot svc autoscale override -n api -e prod --min 10 --revert-after 3h
+  Allows the service to scale between 5 and 50 tasks for the next hour.
+  /code $ copilot svc autoscale override -n api -e prod --min 5 --max 50`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			if cmd.Flags().Changed(minCapacityFlag) {
+				vars.min = aws.Int(minCapacity)
+			}
+			if cmd.Flags().Changed(maxCapacityFlag) {
+				vars.max = aws.Int(maxCapacity)
+			}
+			opts, err := newSvcAutoscaleOverrideOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().IntVar(&minCapacity, minCapacityFlag, 0, minCapacityFlagDescription)
+	cmd.Flags().IntVar(&maxCapacity, maxCapacityFlag, 0, maxCapacityFlagDescription)
+	cmd.Flags().DurationVar(&vars.revertAfter, revertAfterFlag, defaultCapacityRevertAfter, revertAfterFlagDescription)
+	markAudited(cmd)
+	return cmd
+}
@@ -0,0 +1,246 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/aas"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSvcAutoscaleOverride_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inMin         *int
+		inMax         *int
+		inRevertAfter time.Duration
+
+		wantedError error
+	}{
+		"error if neither min nor max is specified": {
+			inRevertAfter: time.Hour,
+			wantedError:   errors.New("at least one of --min or --max must be specified"),
+		},
+		"error if min is negative": {
+			inMin:         aws.Int(-1),
+			inRevertAfter: time.Hour,
+			wantedError:   errors.New("--min must not be negative"),
+		},
+		"error if max is negative": {
+			inMax:         aws.Int(-1),
+			inRevertAfter: time.Hour,
+			wantedError:   errors.New("--max must not be negative"),
+		},
+		"error if min is greater than max": {
+			inMin:         aws.Int(10),
+			inMax:         aws.Int(5),
+			inRevertAfter: time.Hour,
+			wantedError:   errors.New("--min 10 must not be greater than --max 5"),
+		},
+		"error if revert after is not positive": {
+			inMin:       aws.Int(10),
+			wantedError: errors.New("--revert-after must be a positive duration"),
+		},
+		"valid": {
+			inMin:         aws.Int(5),
+			inMax:         aws.Int(50),
+			inRevertAfter: 3 * time.Hour,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			opts := &svcAutoscaleOverrideOpts{
+				svcAutoscaleOverrideVars: svcAutoscaleOverrideVars{
+					min:         tc.inMin,
+					max:         tc.inMax,
+					revertAfter: tc.inRevertAfter,
+				},
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+type svcAutoscaleOverrideMocks struct {
+	svcDescriber *mocks.MockserviceDescriber
+	overrider    *mocks.MockserviceCapacityOverrider
+	envChecker   *mocks.MockversionCompatibilityChecker
+}
+
+func TestSvcAutoscaleOverride_Execute(t *testing.T) {
+	now := time.Date(2024, time.March, 1, 10, 0, 0, 0, time.UTC)
+	const (
+		mockCluster = "phonetool-prod-Cluster"
+		mockService = "phonetool-prod-api-Service"
+	)
+	mockSvcDesc := &ecs.ServiceDesc{
+		ClusterName: mockCluster,
+		Name:        mockService,
+	}
+	testCases := map[string]struct {
+		inMin *int
+		inMax *int
+
+		setupMocks func(m svcAutoscaleOverrideMocks)
+
+		wantedError error
+	}{
+		"error if the environment does not support overriding the capacity": {
+			inMin: aws.Int(5),
+			setupMocks: func(m svcAutoscaleOverrideMocks) {
+				m.envChecker.EXPECT().Version().Return("v1.33.0", nil)
+			},
+			wantedError: errors.New(`environment "prod" is on version "v1.33.0" which does not support the "svc autoscale override" feature`),
+		},
+		"error if fail to describe the service": {
+			inMin: aws.Int(5),
+			setupMocks: func(m svcAutoscaleOverrideMocks) {
+				m.envChecker.EXPECT().Version().Return("v1.34.0", nil)
+				m.svcDescriber.EXPECT().DescribeService("phonetool", "prod", "api").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe service api: some error"),
+		},
+		"error if the service does not autoscale": {
+			inMin: aws.Int(5),
+			setupMocks: func(m svcAutoscaleOverrideMocks) {
+				m.envChecker.EXPECT().Version().Return("v1.34.0", nil)
+				m.svcDescriber.EXPECT().DescribeService("phonetool", "prod", "api").Return(mockSvcDesc, nil)
+				m.overrider.EXPECT().ECSServiceCapacity(mockCluster, mockService).Return(nil, &aas.ErrScalableTargetNotFound{})
+			},
+			wantedError: errors.New("service api does not autoscale in environment prod, configure `count.range` in the manifest to set its capacity"),
+		},
+		"error if the new minimum is greater than the current maximum": {
+			inMin: aws.Int(20),
+			setupMocks: func(m svcAutoscaleOverrideMocks) {
+				m.envChecker.EXPECT().Version().Return("v1.34.0", nil)
+				m.svcDescriber.EXPECT().DescribeService("phonetool", "prod", "api").Return(mockSvcDesc, nil)
+				m.overrider.EXPECT().ECSServiceCapacity(mockCluster, mockService).Return(&aas.Capacity{Min: 1, Max: 10}, nil)
+				m.overrider.EXPECT().ECSServiceScheduledAction(mockCluster, mockService, describe.CapacityRevertActionName).Return(nil, nil)
+			},
+			wantedError: errors.New("minimum number of tasks 20 must not be greater than maximum number of tasks 10"),
+		},
+		"error if fail to schedule the revert": {
+			inMin: aws.Int(5),
+			setupMocks: func(m svcAutoscaleOverrideMocks) {
+				m.envChecker.EXPECT().Version().Return("v1.34.0", nil)
+				m.svcDescriber.EXPECT().DescribeService("phonetool", "prod", "api").Return(mockSvcDesc, nil)
+				m.overrider.EXPECT().ECSServiceCapacity(mockCluster, mockService).Return(&aas.Capacity{Min: 1, Max: 10}, nil)
+				m.overrider.EXPECT().ECSServiceScheduledAction(mockCluster, mockService, describe.CapacityRevertActionName).Return(nil, nil)
+				m.overrider.EXPECT().ScheduleECSServiceCapacity(mockCluster, mockService, gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("schedule capacity revert of service api: some error"),
+		},
+		"error if fail to override the capacity": {
+			inMin: aws.Int(5),
+			setupMocks: func(m svcAutoscaleOverrideMocks) {
+				m.envChecker.EXPECT().Version().Return("v1.34.0", nil)
+				m.svcDescriber.EXPECT().DescribeService("phonetool", "prod", "api").Return(mockSvcDesc, nil)
+				m.overrider.EXPECT().ECSServiceCapacity(mockCluster, mockService).Return(&aas.Capacity{Min: 1, Max: 10}, nil)
+				m.overrider.EXPECT().ECSServiceScheduledAction(mockCluster, mockService, describe.CapacityRevertActionName).Return(nil, nil)
+				m.overrider.EXPECT().ScheduleECSServiceCapacity(mockCluster, mockService, gomock.Any()).Return(nil)
+				m.overrider.EXPECT().SetECSServiceCapacity(mockCluster, mockService, gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("override capacity of service api: some error"),
+		},
+		"schedules the revert to the current capacity before overriding it": {
+			inMin: aws.Int(5),
+			setupMocks: func(m svcAutoscaleOverrideMocks) {
+				m.envChecker.EXPECT().Version().Return("v1.34.0", nil)
+				m.svcDescriber.EXPECT().DescribeService("phonetool", "prod", "api").Return(mockSvcDesc, nil)
+				m.overrider.EXPECT().ECSServiceCapacity(mockCluster, mockService).Return(&aas.Capacity{Min: 1, Max: 10}, nil)
+				m.overrider.EXPECT().ECSServiceScheduledAction(mockCluster, mockService, describe.CapacityRevertActionName).Return(&aas.ScheduledAction{
+					Name:     describe.CapacityRevertActionName,
+					At:       now.Add(-24 * time.Hour),
+					Capacity: aas.Capacity{Min: 2, Max: 4},
+				}, nil)
+				gomock.InOrder(
+					m.overrider.EXPECT().ScheduleECSServiceCapacity(mockCluster, mockService, aas.ScheduledAction{
+						Name:     describe.CapacityRevertActionName,
+						At:       now.Add(time.Hour),
+						Capacity: aas.Capacity{Min: 1, Max: 10},
+					}).Return(nil),
+					m.overrider.EXPECT().SetECSServiceCapacity(mockCluster, mockService, aas.Capacity{Min: 5, Max: 10}).Return(nil),
+				)
+			},
+		},
+		"keeps reverting to the capacity prior to a pending override": {
+			inMin: aws.Int(10),
+			inMax: aws.Int(50),
+			setupMocks: func(m svcAutoscaleOverrideMocks) {
+				m.envChecker.EXPECT().Version().Return("v1.34.0", nil)
+				m.svcDescriber.EXPECT().DescribeService("phonetool", "prod", "api").Return(mockSvcDesc, nil)
+				m.overrider.EXPECT().ECSServiceCapacity(mockCluster, mockService).Return(&aas.Capacity{Min: 5, Max: 10}, nil)
+				m.overrider.EXPECT().ECSServiceScheduledAction(mockCluster, mockService, describe.CapacityRevertActionName).Return(&aas.ScheduledAction{
+					Name:     describe.CapacityRevertActionName,
+					At:       now.Add(30 * time.Minute),
+					Capacity: aas.Capacity{Min: 1, Max: 10},
+				}, nil)
+				m.overrider.EXPECT().ScheduleECSServiceCapacity(mockCluster, mockService, aas.ScheduledAction{
+					Name:     describe.CapacityRevertActionName,
+					At:       now.Add(time.Hour),
+					Capacity: aas.Capacity{Min: 1, Max: 10},
+				}).Return(nil)
+				m.overrider.EXPECT().SetECSServiceCapacity(mockCluster, mockService, aas.Capacity{Min: 10, Max: 50}).Return(nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := svcAutoscaleOverrideMocks{
+				svcDescriber: mocks.NewMockserviceDescriber(ctrl),
+				overrider:    mocks.NewMockserviceCapacityOverrider(ctrl),
+				envChecker:   mocks.NewMockversionCompatibilityChecker(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &svcAutoscaleOverrideOpts{
+				svcAutoscaleOverrideVars: svcAutoscaleOverrideVars{
+					appName:     "phonetool",
+					envName:     "prod",
+					svcName:     "api",
+					min:         tc.inMin,
+					max:         tc.inMax,
+					revertAfter: time.Hour,
+				},
+				ws:           mocks.NewMockwsEnvironmentsLister(ctrl),
+				svcDescriber: m.svcDescriber,
+				overrider:    m.overrider,
+				envChecker:   m.envChecker,
+				initClients:  func() error { return nil },
+				now: func() time.Time {
+					return now
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,212 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/aas"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcAutoscaleStatusNamePrompt     = "Which service's auto scaling capacity would you like to show?"
+	svcAutoscaleStatusNameHelpPrompt = "The minimum, maximum and running number of tasks of the service will be shown."
+)
+
+type svcAutoscaleStatusVars struct {
+	appName          string
+	envName          string
+	svcName          string
+	shouldOutputJSON bool
+}
+
+type svcAutoscaleStatusOpts struct {
+	svcAutoscaleStatusVars
+
+	w             io.Writer
+	store         store
+	sel           deploySelector
+	ws            wsEnvironmentsLister
+	describer     serviceCapacityDescriber
+	envChecker    versionCompatibilityChecker
+	initDescriber func() error
+}
+
+func newSvcAutoscaleStatusOpts(vars svcAutoscaleStatusVars) (*svcAutoscaleStatusOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("svc autoscale status"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+	configStore := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	deployStore, err := deploy.NewStore(sessProvider, configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	ws, err := workspace.Use(afero.NewOsFs())
+	if err != nil {
+		return nil, err
+	}
+	opts := &svcAutoscaleStatusOpts{
+		svcAutoscaleStatusVars: vars,
+		w:                      log.OutputWriter,
+		store:                  configStore,
+		ws:                     ws,
+		sel:                    selector.NewDeploySelect(prompt.New(), configStore, deployStore),
+	}
+	opts.initDescriber = func() error {
+		d, err := describe.NewServiceCapacityDescriber(&describe.NewServiceStatusConfig{
+			App:         opts.appName,
+			Env:         opts.envName,
+			Svc:         opts.svcName,
+			ConfigStore: configStore,
+		})
+		if err != nil {
+			return fmt.Errorf("create capacity describer for service %s in application %s: %w", opts.svcName, opts.appName, err)
+		}
+		opts.describer = d
+		envDescriber, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
+			App:         opts.appName,
+			Env:         opts.envName,
+			ConfigStore: configStore,
+		})
+		if err != nil {
+			return fmt.Errorf("new environment compatibility checker: %v", err)
+		}
+		opts.envChecker = envDescriber
+		return nil
+	}
+	return opts, nil
+}
+
+// Validate is a no-op for this command.
+func (o *svcAutoscaleStatusOpts) Validate() error {
+	return nil
+}
+
+// Ask prompts for and validates any required flags.
+func (o *svcAutoscaleStatusOpts) Ask() error {
+	if err := o.validateOrAskApp(); err != nil {
+		return err
+	}
+	return o.validateAndAskSvcEnvName()
+}
+
+// Execute shows the auto scaling capacity of the service.
+func (o *svcAutoscaleStatusOpts) Execute() error {
+	if err := o.initDescriber(); err != nil {
+		return err
+	}
+	if err := validateMinEnvVersion(o.ws, o.envChecker, o.appName, o.envName, template.SvcAutoscaleMinEnvVersion, "svc autoscale status"); err != nil {
+		return err
+	}
+	capacity, err := o.describer.Describe()
+	if err != nil {
+		return errNotAutoscaled(err, o.svcName, o.envName)
+	}
+	if o.shouldOutputJSON {
+		data, err := capacity.JSONString()
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+		return nil
+	}
+	fmt.Fprint(o.w, capacity.HumanString())
+	return nil
+}
+
+func (o *svcAutoscaleStatusOpts) validateOrAskApp() error {
+	if o.appName != "" {
+		_, err := o.store.GetApplication(o.appName)
+		return err
+	}
+	app, err := o.sel.Application(svcAppNamePrompt, wkldAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *svcAutoscaleStatusOpts) validateAndAskSvcEnvName() error {
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	if o.svcName != "" {
+		svc, err := o.store.GetService(o.appName, o.svcName)
+		if err != nil {
+			return err
+		}
+		if !isECSServiceType(svc.Type) {
+			return fmt.Errorf("service %s is a %s that does not run on Amazon ECS", o.svcName, svc.Type)
+		}
+	}
+	// Note: we let prompter handle the case when there is only option for user to choose from.
+	// This is naturally the case when `o.envName != "" && o.svcName != ""`.
+	deployedService, err := o.sel.DeployedService(svcAutoscaleStatusNamePrompt, svcAutoscaleStatusNameHelpPrompt, o.appName,
+		selector.WithEnv(o.envName), selector.WithName(o.svcName), selector.WithServiceTypesFilter(ecsServiceTypes))
+	if err != nil {
+		return fmt.Errorf("select deployed services for application %s: %w", o.appName, err)
+	}
+	o.svcName = deployedService.Name
+	o.envName = deployedService.Env
+	return nil
+}
+
+// errNotAutoscaled explains how to enable auto scaling if err occurred because the service doesn't autoscale.
+func errNotAutoscaled(err error, svc, env string) error {
+	var errNotFound *aas.ErrScalableTargetNotFound
+	if errors.As(err, &errNotFound) {
+		return fmt.Errorf("service %s does not autoscale in environment %s, configure %s in the manifest to set its capacity",
+			svc, env, color.HighlightCode("count.range"))
+	}
+	return err
+}
+
+// buildSvcAutoscaleStatusCmd builds the command for showing the auto scaling capacity of a service.
+func buildSvcAutoscaleStatusCmd() *cobra.Command {
+	vars := svcAutoscaleStatusVars{}
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Shows the auto scaling capacity of a deployed service.",
+		Long: `Shows the minimum, maximum and running number of tasks of a deployed service,
+and when a manual override of its capacity reverts.`,
+
+		Example: `
+  Shows the auto scaling capacity of the service "api" in the "prod" environment.
+  /code $ copilot svc autoscale status -n api -e prod`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcAutoscaleStatusOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
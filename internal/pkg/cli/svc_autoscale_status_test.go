@@ -0,0 +1,184 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/aas"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSvcAutoscaleStatus_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inApp string
+		inEnv string
+		inSvc string
+
+		setupMocks func(store *mocks.Mockstore, sel *mocks.MockdeploySelector)
+
+		wantedApp   string
+		wantedEnv   string
+		wantedSvc   string
+		wantedError error
+	}{
+		"error if the service does not run on Amazon ECS": {
+			inApp: "phonetool",
+			inSvc: "frontend",
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockdeploySelector) {
+				store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				store.EXPECT().GetService("phonetool", "frontend").Return(&config.Workload{
+					Type: manifestinfo.RequestDrivenWebServiceType,
+				}, nil)
+			},
+			wantedError: errors.New("service frontend is a Request-Driven Web Service that does not run on Amazon ECS"),
+		},
+		"success": {
+			inApp: "phonetool",
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockdeploySelector) {
+				store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				sel.EXPECT().DeployedService(svcAutoscaleStatusNamePrompt, svcAutoscaleStatusNameHelpPrompt, "phonetool", gomock.Any()).
+					Return(&selector.DeployedService{
+						Env:  "prod",
+						Name: "api",
+					}, nil)
+			},
+			wantedApp: "phonetool",
+			wantedEnv: "prod",
+			wantedSvc: "api",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mocks.NewMockstore(ctrl)
+			sel := mocks.NewMockdeploySelector(ctrl)
+			tc.setupMocks(store, sel)
+			opts := &svcAutoscaleStatusOpts{
+				svcAutoscaleStatusVars: svcAutoscaleStatusVars{
+					appName: tc.inApp,
+					envName: tc.inEnv,
+					svcName: tc.inSvc,
+				},
+				store: store,
+				sel:   sel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedApp, opts.appName)
+			require.Equal(t, tc.wantedEnv, opts.envName)
+			require.Equal(t, tc.wantedSvc, opts.svcName)
+		})
+	}
+}
+
+func TestSvcAutoscaleStatus_Execute(t *testing.T) {
+	mockCapacity := &describe.ServiceCapacity{
+		Service:      "api",
+		Environment:  "prod",
+		Min:          1,
+		Max:          10,
+		RunningTasks: 2,
+	}
+	testCases := map[string]struct {
+		shouldOutputJSON bool
+		inEnvVersion     string
+
+		setupMocks func(m *mocks.MockserviceCapacityDescriber)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"return error if the environment does not support the capacity status": {
+			inEnvVersion: "v1.33.0",
+			setupMocks:   func(m *mocks.MockserviceCapacityDescriber) {},
+			wantedError:  errors.New(`environment "prod" is on version "v1.33.0" which does not support the "svc autoscale status" feature`),
+		},
+		"return error if fail to describe the capacity": {
+			setupMocks: func(m *mocks.MockserviceCapacityDescriber) {
+				m.EXPECT().Describe().Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"return a helpful error if the service does not autoscale": {
+			setupMocks: func(m *mocks.MockserviceCapacityDescriber) {
+				m.EXPECT().Describe().Return(nil, fmt.Errorf("get capacity of service api: %w", &aas.ErrScalableTargetNotFound{}))
+			},
+			wantedError: errors.New("service api does not autoscale in environment prod, configure `count.range` in the manifest to set its capacity"),
+		},
+		"success in JSON": {
+			shouldOutputJSON: true,
+			setupMocks: func(m *mocks.MockserviceCapacityDescriber) {
+				m.EXPECT().Describe().Return(mockCapacity, nil)
+			},
+			wantedContent: `{"service":"api","environment":"prod","min":1,"max":10,"runningTasks":2}` + "\n",
+		},
+		"success in human format": {
+			setupMocks: func(m *mocks.MockserviceCapacityDescriber) {
+				m.EXPECT().Describe().Return(mockCapacity, nil)
+			},
+			wantedContent: mockCapacity.HumanString(),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockserviceCapacityDescriber(ctrl)
+			tc.setupMocks(m)
+			envChecker := mocks.NewMockversionCompatibilityChecker(ctrl)
+			envVersion := "v1.34.0"
+			if tc.inEnvVersion != "" {
+				envVersion = tc.inEnvVersion
+			}
+			envChecker.EXPECT().Version().Return(envVersion, nil)
+			b := &bytes.Buffer{}
+			opts := &svcAutoscaleStatusOpts{
+				svcAutoscaleStatusVars: svcAutoscaleStatusVars{
+					appName:          "phonetool",
+					envName:          "prod",
+					svcName:          "api",
+					shouldOutputJSON: tc.shouldOutputJSON,
+				},
+				w:             b,
+				ws:            mocks.NewMockwsEnvironmentsLister(ctrl),
+				describer:     m,
+				envChecker:    envChecker,
+				initDescriber: func() error { return nil },
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
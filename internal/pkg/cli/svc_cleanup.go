@@ -0,0 +1,311 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/version"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/dustin/go-humanize/english"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcCleanupNamePrompt     = "Which service of %s would you like to clean up?"
+	svcCleanupNameHelpPrompt = "The old task definition revisions of the service will be deregistered."
+
+	fmtSvcCleanupConfirmPrompt = "Are you sure you want to deregister %s of service %s in environment %s?"
+	svcCleanupConfirmHelp      = "The revisions will no longer be usable to run tasks or to roll back the service."
+
+	defaultKeepTaskDefinitions = 10
+)
+
+var errSvcCleanupCancelled = errors.New("svc cleanup cancelled - no changes made")
+
+type svcCleanupVars struct {
+	appName          string
+	svcName          string
+	envName          string
+	taskDefinitions  bool
+	keep             int
+	keepSet          bool // Whether --keep was specified, otherwise the count is read from the deployed manifest.
+	delete           bool
+	skipConfirmation bool
+}
+
+type svcCleanupOpts struct {
+	svcCleanupVars
+
+	store      store
+	ws         wsEnvironmentsLister
+	sel        deploySelector
+	prompt     prompter
+	cleaner    taskDefinitionCleaner
+	envChecker versionCompatibilityChecker
+	svcMft     deployedManifestGetter
+	initClient func() error
+}
+
+func newSvcCleanupOpts(vars svcCleanupVars) (*svcCleanupOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("svc cleanup"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+	configStore := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	deployStore, err := deploy.NewStore(sessProvider, configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	ws, err := workspace.Use(afero.NewOsFs())
+	if err != nil {
+		return nil, err
+	}
+	prompter := prompt.New()
+	opts := &svcCleanupOpts{
+		svcCleanupVars: vars,
+		store:          configStore,
+		ws:             ws,
+		sel:            selector.NewDeploySelect(prompter, configStore, deployStore),
+		prompt:         prompter,
+	}
+	opts.initClient = func() error {
+		env, err := configStore.GetEnvironment(opts.appName, opts.envName)
+		if err != nil {
+			return fmt.Errorf("get environment %s: %w", opts.envName, err)
+		}
+		sess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+		if err != nil {
+			return fmt.Errorf("session for role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
+		}
+		opts.cleaner = ecs.New(sess)
+		envDescriber, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
+			App:         opts.appName,
+			Env:         opts.envName,
+			ConfigStore: configStore,
+		})
+		if err != nil {
+			return fmt.Errorf("new environment compatibility checker: %v", err)
+		}
+		opts.envChecker = envDescriber
+		svcDescriber, err := describe.NewWorkloadStackDescriber(describe.NewWorkloadConfig{
+			App:         opts.appName,
+			Env:         opts.envName,
+			Name:        opts.svcName,
+			ConfigStore: configStore,
+		})
+		if err != nil {
+			return fmt.Errorf("new describer for service %s: %w", opts.svcName, err)
+		}
+		opts.svcMft = svcDescriber
+		return nil
+	}
+	return opts, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *svcCleanupOpts) Validate() error {
+	if !o.taskDefinitions {
+		return fmt.Errorf("specify what to clean up with --%s", taskDefinitionsFlag)
+	}
+	if o.keep < 1 {
+		return fmt.Errorf("--%s must be at least 1", keepFlag)
+	}
+	return nil
+}
+
+// Ask prompts for and validates any required flags.
+func (o *svcCleanupOpts) Ask() error {
+	if err := o.validateOrAskApp(); err != nil {
+		return err
+	}
+	return o.validateAndAskSvcEnvName()
+}
+
+// ValidateVersionPin returns an error if the application doesn't allow this version of Copilot to clean up the task definitions of its services.
+func (o *svcCleanupOpts) ValidateVersionPin() error {
+	return validateVersionPin(o.store, o.appName, version.Version, "")
+}
+
+// Execute deregisters, and optionally deletes, the task definition revisions of the service beyond the retention count.
+func (o *svcCleanupOpts) Execute() error {
+	if err := o.initClient(); err != nil {
+		return err
+	}
+	if err := validateMinEnvVersion(o.ws, o.envChecker, o.appName, o.envName, template.SvcCleanupMinEnvVersion, "svc cleanup"); err != nil {
+		return err
+	}
+	if !o.keepSet {
+		keep, err := o.keepFromManifest()
+		if err != nil {
+			return err
+		}
+		o.keep = keep
+	}
+	stale, err := o.cleaner.StaleTaskDefinitions(o.appName, o.envName, o.svcName, o.keep)
+	if err != nil {
+		return fmt.Errorf("get task definition revisions of service %s: %w", o.svcName, err)
+	}
+	if len(stale) == 0 {
+		log.Infof("Service %s has no task definition revisions to clean up in environment %s.\n", o.svcName, o.envName)
+		return nil
+	}
+	revisions := english.Plural(len(stale), "task definition revision", "task definition revisions")
+	if !o.skipConfirmation {
+		confirmed, err := o.prompt.Confirm(
+			fmt.Sprintf(fmtSvcCleanupConfirmPrompt, revisions, color.HighlightUserInput(o.svcName), color.HighlightUserInput(o.envName)),
+			svcCleanupConfirmHelp,
+			prompt.WithConfirmFinalMessage())
+		if err != nil {
+			return fmt.Errorf("svc cleanup confirmation prompt: %w", err)
+		}
+		if !confirmed {
+			return errSvcCleanupCancelled
+		}
+	}
+	if err := o.cleaner.DeregisterTaskDefinitions(stale); err != nil {
+		return err
+	}
+	log.Successf("Deregistered %s of service %s.\n", revisions, o.svcName)
+	if !o.delete {
+		return nil
+	}
+	if err := o.cleaner.DeleteTaskDefinitions(stale); err != nil {
+		return err
+	}
+	log.Successf("Deleted %s of service %s.\n", revisions, o.svcName)
+	return nil
+}
+
+// keepFromManifest returns the number of revisions to keep set by "deployment.keep_task_definitions"
+// in the deployed manifest of the service, or the default if it's not set.
+func (o *svcCleanupOpts) keepFromManifest() (int, error) {
+	raw, err := o.svcMft.Manifest()
+	if err != nil {
+		var errNotFound *describe.ErrManifestNotFoundInTemplate
+		if errors.As(err, &errNotFound) {
+			return o.keep, nil
+		}
+		return 0, fmt.Errorf("read deployed manifest of service %s: %w", o.svcName, err)
+	}
+	mft, err := manifest.UnmarshalWorkload(raw)
+	if err != nil {
+		return 0, fmt.Errorf("unmarshal deployed manifest of service %s: %w", o.svcName, err)
+	}
+	envMft, err := mft.ApplyEnv(o.envName)
+	if err != nil {
+		return 0, fmt.Errorf("apply environment %s override: %w", o.envName, err)
+	}
+	var keep *int
+	switch m := envMft.Manifest().(type) {
+	case *manifest.LoadBalancedWebService:
+		keep = m.DeployConfig.KeepTaskDefinitions
+	case *manifest.BackendService:
+		keep = m.DeployConfig.KeepTaskDefinitions
+	case *manifest.WorkerService:
+		keep = m.DeployConfig.KeepTaskDefinitions
+	}
+	if keep == nil {
+		return o.keep, nil
+	}
+	return aws.IntValue(keep), nil
+}
+
+func (o *svcCleanupOpts) validateOrAskApp() error {
+	if o.appName != "" {
+		_, err := o.store.GetApplication(o.appName)
+		return err
+	}
+	app, err := o.sel.Application(svcAppNamePrompt, wkldAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *svcCleanupOpts) validateAndAskSvcEnvName() error {
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	if o.svcName != "" {
+		svc, err := o.store.GetService(o.appName, o.svcName)
+		if err != nil {
+			return err
+		}
+		if !slices.Contains(ecsServiceTypes, svc.Type) {
+			return fmt.Errorf("service %s is a %s, only services deployed to ECS have task definitions", o.svcName, svc.Type)
+		}
+	}
+	// Note: we let prompter handle the case when there is only option for user to choose from.
+	// This is naturally the case when `o.envName != "" && o.svcName != ""`.
+	deployedService, err := o.sel.DeployedService(
+		fmt.Sprintf(svcCleanupNamePrompt, color.HighlightUserInput(o.appName)),
+		svcCleanupNameHelpPrompt,
+		o.appName,
+		selector.WithEnv(o.envName),
+		selector.WithName(o.svcName),
+		selector.WithServiceTypesFilter(ecsServiceTypes),
+	)
+	if err != nil {
+		return fmt.Errorf("select deployed service for application %s: %w", o.appName, err)
+	}
+	o.svcName = deployedService.Name
+	o.envName = deployedService.Env
+	return nil
+}
+
+// buildSvcCleanupCmd builds the command for cleaning up the old resources of a service.
+func buildSvcCleanupCmd() *cobra.Command {
+	vars := svcCleanupVars{}
+	cmd := &cobra.Command{
+		Use:   "cleanup",
+		Short: "Cleans up the old task definition revisions of a service.",
+		Long: `Cleans up the old task definition revisions of a service.
+Deregisters the revisions beyond the most recent ones, except for the revisions still used by the service or by running tasks.`,
+
+		Example: `
+  Deregisters all but the 10 most recent task definition revisions of the "api" service in the "prod" environment.
+  /code $ copilot svc cleanup -n api -e prod --task-definitions
+  Keeps the 3 most recent revisions, and deletes the other ones instead of leaving them inactive.
+  /code $ copilot svc cleanup -n api -e prod --task-definitions --keep 3 --delete`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			vars.keepSet = cmd.Flags().Changed(keepFlag)
+			opts, err := newSvcCleanupOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().BoolVar(&vars.taskDefinitions, taskDefinitionsFlag, false, taskDefinitionsFlagDescription)
+	cmd.Flags().IntVar(&vars.keep, keepFlag, defaultKeepTaskDefinitions, keepTaskDefinitionsFlagDescription)
+	cmd.Flags().BoolVar(&vars.delete, deleteFlag, false, deleteTaskDefinitionsFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	markAudited(cmd)
+	return cmd
+}
@@ -0,0 +1,303 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSvcCleanupOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inTaskDefinitions bool
+		inKeep            int
+
+		wantedError error
+	}{
+		"error if nothing to clean up is specified": {
+			inKeep:      10,
+			wantedError: errors.New("specify what to clean up with --task-definitions"),
+		},
+		"error if the retention count is below 1": {
+			inTaskDefinitions: true,
+			wantedError:       errors.New("--keep must be at least 1"),
+		},
+		"success": {
+			inTaskDefinitions: true,
+			inKeep:            3,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &svcCleanupOpts{
+				svcCleanupVars: svcCleanupVars{
+					taskDefinitions: tc.inTaskDefinitions,
+					keep:            tc.inKeep,
+				},
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSvcCleanupOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inApp string
+		inEnv string
+		inSvc string
+
+		setupMocks func(store *mocks.Mockstore, sel *mocks.MockdeploySelector)
+
+		wantedEnv   string
+		wantedSvc   string
+		wantedError error
+	}{
+		"error if the service does not run on ECS": {
+			inApp: "phonetool",
+			inSvc: "frontend",
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockdeploySelector) {
+				store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				store.EXPECT().GetService("phonetool", "frontend").Return(&config.Workload{
+					Type: manifestinfo.StaticSiteType,
+				}, nil)
+			},
+			wantedError: errors.New("service frontend is a Static Site, only services deployed to ECS have task definitions"),
+		},
+		"error if fail to select a deployed service": {
+			inApp: "phonetool",
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockdeploySelector) {
+				store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				sel.EXPECT().DeployedService(gomock.Any(), svcCleanupNameHelpPrompt, "phonetool", gomock.Any()).
+					Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("select deployed service for application phonetool: some error"),
+		},
+		"success": {
+			inApp: "phonetool",
+			inEnv: "prod",
+			inSvc: "api",
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockdeploySelector) {
+				store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				store.EXPECT().GetEnvironment("phonetool", "prod").Return(&config.Environment{}, nil)
+				store.EXPECT().GetService("phonetool", "api").Return(&config.Workload{
+					Type: manifestinfo.LoadBalancedWebServiceType,
+				}, nil)
+				sel.EXPECT().DeployedService(gomock.Any(), svcCleanupNameHelpPrompt, "phonetool", gomock.Any()).
+					Return(&selector.DeployedService{
+						Env:  "prod",
+						Name: "api",
+					}, nil)
+			},
+			wantedEnv: "prod",
+			wantedSvc: "api",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mocks.NewMockstore(ctrl)
+			sel := mocks.NewMockdeploySelector(ctrl)
+			tc.setupMocks(store, sel)
+			opts := &svcCleanupOpts{
+				svcCleanupVars: svcCleanupVars{
+					appName: tc.inApp,
+					envName: tc.inEnv,
+					svcName: tc.inSvc,
+				},
+				store: store,
+				sel:   sel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedEnv, opts.envName)
+			require.Equal(t, tc.wantedSvc, opts.svcName)
+		})
+	}
+}
+
+type svcCleanupMocks struct {
+	cleaner    *mocks.MocktaskDefinitionCleaner
+	prompt     *mocks.Mockprompter
+	envChecker *mocks.MockversionCompatibilityChecker
+	svcMft     *mocks.MockdeployedManifestGetter
+}
+
+func TestSvcCleanupOpts_Execute(t *testing.T) {
+	stale := []string{
+		"arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-prod-api:2",
+		"arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-prod-api:1",
+	}
+	testCases := map[string]struct {
+		inDelete  bool
+		inYes     bool
+		inKeepSet bool
+
+		setupMocks func(m svcCleanupMocks)
+
+		wantedError error
+	}{
+		"error if the environment does not support the cleanup": {
+			setupMocks: func(m svcCleanupMocks) {
+				m.envChecker.EXPECT().Version().Return("v1.33.0", nil)
+			},
+			wantedError: errors.New(`environment "prod" is on version "v1.33.0" which does not support the "svc cleanup" feature`),
+		},
+		"error if fail to read the deployed manifest": {
+			setupMocks: func(m svcCleanupMocks) {
+				m.envChecker.EXPECT().Version().Return("v1.34.0", nil)
+				m.svcMft.EXPECT().Manifest().Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("read deployed manifest of service api: some error"),
+		},
+		"keep the number of revisions set in the manifest of the environment": {
+			setupMocks: func(m svcCleanupMocks) {
+				m.envChecker.EXPECT().Version().Return("v1.34.0", nil)
+				m.svcMft.EXPECT().Manifest().Return([]byte(`name: api
+type: Backend Service
+image:
+  location: nginx
+deployment:
+  keep_task_definitions: 5
+environments:
+  prod:
+    deployment:
+      keep_task_definitions: 3
+`), nil)
+				m.cleaner.EXPECT().StaleTaskDefinitions("phonetool", "prod", "api", 3).Return(nil, nil)
+			},
+		},
+		"ignore the manifest if --keep is set": {
+			inKeepSet: true,
+			setupMocks: func(m svcCleanupMocks) {
+				m.envChecker.EXPECT().Version().Return("v1.34.0", nil)
+				m.svcMft.EXPECT().Manifest().Times(0)
+				m.cleaner.EXPECT().StaleTaskDefinitions("phonetool", "prod", "api", 10).Return(nil, nil)
+			},
+		},
+		"error if fail to get the stale revisions": {
+			setupMocks: func(m svcCleanupMocks) {
+				m.envChecker.EXPECT().Version().Return("v1.34.0", nil)
+				m.svcMft.EXPECT().Manifest().Return(nil, &describe.ErrManifestNotFoundInTemplate{})
+				m.cleaner.EXPECT().StaleTaskDefinitions("phonetool", "prod", "api", 10).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get task definition revisions of service api: some error"),
+		},
+		"no-op if there are no stale revisions": {
+			setupMocks: func(m svcCleanupMocks) {
+				m.envChecker.EXPECT().Version().Return("v1.34.0", nil)
+				m.svcMft.EXPECT().Manifest().Return(nil, &describe.ErrManifestNotFoundInTemplate{})
+				m.cleaner.EXPECT().StaleTaskDefinitions("phonetool", "prod", "api", 10).Return(nil, nil)
+				m.prompt.EXPECT().Confirm(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		"error if the cleanup is not confirmed": {
+			setupMocks: func(m svcCleanupMocks) {
+				m.envChecker.EXPECT().Version().Return("v1.34.0", nil)
+				m.svcMft.EXPECT().Manifest().Return(nil, &describe.ErrManifestNotFoundInTemplate{})
+				m.cleaner.EXPECT().StaleTaskDefinitions("phonetool", "prod", "api", 10).Return(stale, nil)
+				m.prompt.EXPECT().Confirm(gomock.Any(), svcCleanupConfirmHelp, gomock.Any()).Return(false, nil)
+			},
+			wantedError: errSvcCleanupCancelled,
+		},
+		"deregisters the stale revisions once confirmed": {
+			setupMocks: func(m svcCleanupMocks) {
+				m.envChecker.EXPECT().Version().Return("v1.34.0", nil)
+				m.svcMft.EXPECT().Manifest().Return(nil, &describe.ErrManifestNotFoundInTemplate{})
+				m.cleaner.EXPECT().StaleTaskDefinitions("phonetool", "prod", "api", 10).Return(stale, nil)
+				m.prompt.EXPECT().Confirm(gomock.Any(), svcCleanupConfirmHelp, gomock.Any()).Return(true, nil)
+				m.cleaner.EXPECT().DeregisterTaskDefinitions(stale).Return(nil)
+				m.cleaner.EXPECT().DeleteTaskDefinitions(gomock.Any()).Times(0)
+			},
+		},
+		"error if fail to delete the deregistered revisions": {
+			inDelete: true,
+			inYes:    true,
+			setupMocks: func(m svcCleanupMocks) {
+				m.envChecker.EXPECT().Version().Return("v1.34.0", nil)
+				m.svcMft.EXPECT().Manifest().Return(nil, &describe.ErrManifestNotFoundInTemplate{})
+				m.cleaner.EXPECT().StaleTaskDefinitions("phonetool", "prod", "api", 10).Return(stale, nil)
+				m.cleaner.EXPECT().DeregisterTaskDefinitions(stale).Return(nil)
+				m.cleaner.EXPECT().DeleteTaskDefinitions(stale).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"deregisters and deletes the stale revisions without confirmation": {
+			inDelete: true,
+			inYes:    true,
+			setupMocks: func(m svcCleanupMocks) {
+				m.envChecker.EXPECT().Version().Return("v1.34.0", nil)
+				m.svcMft.EXPECT().Manifest().Return(nil, &describe.ErrManifestNotFoundInTemplate{})
+				m.cleaner.EXPECT().StaleTaskDefinitions("phonetool", "prod", "api", 10).Return(stale, nil)
+				m.cleaner.EXPECT().DeregisterTaskDefinitions(stale).Return(nil)
+				m.cleaner.EXPECT().DeleteTaskDefinitions(stale).Return(nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := svcCleanupMocks{
+				cleaner:    mocks.NewMocktaskDefinitionCleaner(ctrl),
+				prompt:     mocks.NewMockprompter(ctrl),
+				envChecker: mocks.NewMockversionCompatibilityChecker(ctrl),
+				svcMft:     mocks.NewMockdeployedManifestGetter(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &svcCleanupOpts{
+				svcCleanupVars: svcCleanupVars{
+					appName:          "phonetool",
+					envName:          "prod",
+					svcName:          "api",
+					taskDefinitions:  true,
+					keep:             10,
+					keepSet:          tc.inKeepSet,
+					delete:           tc.inDelete,
+					skipConfirmation: tc.inYes,
+				},
+				cleaner:    m.cleaner,
+				prompt:     m.prompt,
+				envChecker: m.envChecker,
+				svcMft:     m.svcMft,
+				initClient: func() error { return nil },
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
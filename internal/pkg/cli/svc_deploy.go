@@ -9,11 +9,16 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"slices"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/aas"
+	"github.com/aws/copilot-cli/internal/pkg/aws/budgets"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	awscfn "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
@@ -22,6 +27,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
 	"github.com/aws/copilot-cli/internal/pkg/template"
+	templatediff "github.com/aws/copilot-cli/internal/pkg/template/diff"
 	"github.com/aws/copilot-cli/internal/pkg/version"
 	"github.com/spf13/afero"
 	"golang.org/x/mod/semver"
@@ -32,7 +38,9 @@ import (
 	clideploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/governance"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
@@ -42,18 +50,28 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
 )
 
+// deployValueImageTag is the key of --values that overrides the image tag instead of a manifest field.
+const deployValueImageTag = "tag"
+
+// envOutputBudgetGuardrailName is the output of an environment stack with the name of the budget that deployments are checked against.
+const envOutputBudgetGuardrailName = "BudgetGuardrailName"
+
 type deployWkldVars struct {
 	appName            string
 	name               string
 	envName            string
 	imageTag           string
 	resourceTags       map[string]string
-	forceNewUpdate     bool // NOTE: this variable is not applicable for a job workload currently.
+	values             map[string]string // Manifest fields and image tag overridden at deploy time.
+	forceNewUpdate     bool              // NOTE: this variable is not applicable for a job workload currently.
 	disableRollback    bool
 	showDiff           bool
 	skipDiffPrompt     bool
+	diffFormat         string
 	allowWkldDowngrade bool
 	detach             bool
+	handoff            string // Kind of governed template to render the inputs of instead of deploying.
+	governedTemplate   string
 
 	// To facilitate unit tests.
 	clientConfigured bool
@@ -71,12 +89,21 @@ type deploySvcOpts struct {
 	newSvcDeployer       func() (workloadDeployer, error)
 	svcVersionGetter     versionGetter
 	envFeaturesDescriber versionCompatibilityChecker
+	envOutputs           envOutputsGetter
+	budgets              budgetDescriber
+	svcDescriber         serviceDescriber
+	capacityReverts      capacityRevertGetter
 	diffWriter           io.Writer
+	handoffWriter        io.Writer
+	fs                   afero.Fs
+	builtImages          *clideploy.BuiltImages // Images built by the other workloads of the same deployment.
+	inParallel           bool                   // Whether other workloads of the same deployment deploy at the same time.
 
 	spinner        progress
 	sel            wsSelector
 	prompt         prompter
 	gitShortCommit string
+	gitBranch      string
 
 	// cached variables
 	targetApp         *config.Application
@@ -121,6 +148,8 @@ func newSvcDeployOpts(vars deployWkldVars) (*deploySvcOpts, error) {
 		cmd:             exec.NewCmd(),
 		sessProvider:    sessProvider,
 		diffWriter:      os.Stdout,
+		handoffWriter:   os.Stdout,
+		fs:              afero.NewOsFs(),
 		templateVersion: version.LatestTemplateVersion(),
 	}
 	opts.newSvcDeployer = func() (workloadDeployer, error) {
@@ -150,11 +179,14 @@ func newSvcDeployer(o *deploySvcOpts) (workloadDeployer, error) {
 		Image: clideploy.ContainerImageIdentifier{
 			CustomTag:         o.imageTag,
 			GitShortCommitTag: o.gitShortCommit,
+			GitBranch:         o.gitBranch,
 		},
 		Mft:              content,
 		RawMft:           o.rawMft,
 		EnvVersionGetter: o.envFeaturesDescriber,
 		Overrider:        ovrdr,
+		BuiltImages:      o.builtImages,
+		InParallel:       o.inParallel,
 	}
 	switch t := content.(type) {
 	case *manifest.LoadBalancedWebService:
@@ -182,9 +214,82 @@ func newManifestInterpolator(app, env string) interpolator {
 
 // Validate returns an error for any invalid optional flags.
 func (o *deploySvcOpts) Validate() error {
+	if err := validateDiffFormat(o.diffFormat, o.showDiff); err != nil {
+		return err
+	}
+	if err := validateHandoff(o.handoff, o.governedTemplate, o.showDiff); err != nil {
+		return err
+	}
+	return validateDeployValues(o.values, o.imageTag)
+}
+
+// validateHandoff returns an error if the --handoff flag has an unsupported value or is not paired with --governed-template.
+func validateHandoff(handoff, governedTemplate string, showDiff bool) error {
+	if handoff == "" {
+		if governedTemplate != "" {
+			return fmt.Errorf("--%s must be specified with --%s", governedTemplateFlag, handoffFlag)
+		}
+		return nil
+	}
+	if !slices.Contains(governance.Kinds, handoff) {
+		return fmt.Errorf("invalid `--%s` value %q: must be one of %s", handoffFlag, handoff, strings.Join(governance.Kinds, ", "))
+	}
+	if governedTemplate == "" {
+		return fmt.Errorf("--%s must be specified with --%s", handoffFlag, governedTemplateFlag)
+	}
+	if showDiff {
+		return fmt.Errorf("--%s cannot be specified with --%s", handoffFlag, diffFlag)
+	}
+	return nil
+}
+
+// validateDiffFormat returns an error if the --diff-format flag has an unsupported value or is used without --diff.
+func validateDiffFormat(format string, showDiff bool) error {
+	if format == "" {
+		return nil
+	}
+	if !showDiff {
+		return fmt.Errorf("--%s must be specified with --%s", diffFormatFlag, diffFlag)
+	}
+	if !slices.Contains(templatediff.StructuredFormats, format) {
+		return fmt.Errorf("invalid `--%s` value %q: must be one of %s", diffFormatFlag, format, strings.Join(templatediff.StructuredFormats, ", "))
+	}
+	return nil
+}
+
+// validateDeployValues returns an error if a value passed with --values can't be overridden at deploy time.
+func validateDeployValues(values map[string]string, imageTag string) error {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if key == deployValueImageTag {
+			if imageTag != "" {
+				return fmt.Errorf(`--%s "%s" cannot be specified with --%s`, valuesFlag, deployValueImageTag, imageTagFlag)
+			}
+			continue
+		}
+		if err := manifest.ValidateDeployValue(key, values[key]); err != nil {
+			return fmt.Errorf("validate --%s: %w", valuesFlag, err)
+		}
+	}
 	return nil
 }
 
+// manifestDeployValues returns the values passed with --values that override manifest fields.
+func manifestDeployValues(values map[string]string) map[string]string {
+	mftValues := make(map[string]string, len(values))
+	for key, value := range values {
+		if key == deployValueImageTag {
+			continue
+		}
+		mftValues[key] = value
+	}
+	return mftValues
+}
+
 // Ask prompts for and validates any required flags.
 func (o *deploySvcOpts) Ask() error {
 	if o.appName != "" {
@@ -206,6 +311,11 @@ func (o *deploySvcOpts) Ask() error {
 	return nil
 }
 
+// ValidateVersionPin returns an error if the application doesn't allow this version of Copilot to deploy its services.
+func (o *deploySvcOpts) ValidateVersionPin() error {
+	return validateVersionPin(o.store, o.appName, o.templateVersion, "")
+}
+
 // Execute builds and pushes the container image for the service,
 func (o *deploySvcOpts) Execute() error {
 	if !o.clientConfigured {
@@ -218,6 +328,12 @@ func (o *deploySvcOpts) Execute() error {
 			return err
 		}
 	}
+	if len(o.values) > 0 && o.svcType == manifestinfo.StaticSiteType {
+		return fmt.Errorf("--%s is not supported for service type %q", valuesFlag, manifestinfo.StaticSiteType)
+	}
+	if tag, ok := o.values[deployValueImageTag]; ok {
+		o.imageTag = tag
+	}
 	mft, interpolated, err := workloadManifest(&workloadManifestInput{
 		name:         o.name,
 		appName:      o.appName,
@@ -226,6 +342,7 @@ func (o *deploySvcOpts) Execute() error {
 		interpolator: o.newInterpolator(o.appName, o.envName),
 		unmarshal:    o.unmarshal,
 		sess:         o.envSess,
+		deployValues: manifestDeployValues(o.values),
 	})
 	if err != nil {
 		return err
@@ -238,6 +355,10 @@ func (o *deploySvcOpts) Execute() error {
 	if err := validateWorkloadManifestCompatibilityWithEnv(o.ws, o.envFeaturesDescriber, mft, o.envName); err != nil {
 		return err
 	}
+	if o.handoff != "" {
+		o.noDeploy = true
+		return o.writeHandoff(interpolated)
+	}
 	deployer, err := o.newSvcDeployer()
 	if err != nil {
 		return err
@@ -269,12 +390,13 @@ func (o *deploySvcOpts) Execute() error {
 				CustomResourceURLs:        uploadOut.CustomResourceURLs,
 				StaticSiteAssetMappingURL: uploadOut.StaticSiteAssetMappingLocation,
 				Version:                   o.templateVersion,
+				DeployValues:              o.values,
 			},
 		})
 		if err != nil {
 			return fmt.Errorf("generate the template for workload %q against environment %q: %w", o.name, o.envName, err)
 		}
-		if err := diff(deployer, output.Template, o.diffWriter); err != nil {
+		if err := diff(deployer, output.Template, o.diffWriter, templatediff.Format(o.diffFormat)); err != nil {
 			var errHasDiff *errHasDiff
 			if !errors.As(err, &errHasDiff) {
 				return err
@@ -292,6 +414,7 @@ func (o *deploySvcOpts) Execute() error {
 			return nil
 		}
 	}
+	o.warnIfOverBudget()
 	deployRecs, err := deployer.DeployWorkload(&clideploy.DeployWorkloadInput{
 		StackRuntimeConfiguration: clideploy.StackRuntimeConfiguration{
 			ImageDigests:              uploadOut.ImageDigests,
@@ -302,6 +425,7 @@ func (o *deploySvcOpts) Execute() error {
 			CustomResourceURLs:        uploadOut.CustomResourceURLs,
 			StaticSiteAssetMappingURL: uploadOut.StaticSiteAssetMappingLocation,
 			Version:                   o.templateVersion,
+			DeployValues:              o.values,
 		},
 		Options: clideploy.Options{
 			ForceNewUpdate:  o.forceNewUpdate,
@@ -342,10 +466,86 @@ After fixing the deployment, you can:
 		return nil
 	}
 	log.Successf("Deployed service %s.\n", color.HighlightUserInput(o.name))
+	o.warnIfCapacityOverridden()
 	o.deployRecs = deployRecs
 	return nil
 }
 
+// warnIfCapacityOverridden warns if an override of the capacity of the service with "copilot svc autoscale override"
+// hasn't reverted yet, since the revert restores the capacity prior to the override instead of the deployed count.range.
+// The check is best effort and never fails the deployment.
+func (o *deploySvcOpts) warnIfCapacityOverridden() {
+	if !isECSServiceType(o.svcType) {
+		return
+	}
+	svc, err := o.svcDescriber.DescribeService(o.appName, o.envName, o.name)
+	if err != nil {
+		log.Warningf("Unable to check for an override of the capacity of service %s: %v\n", o.name, err)
+		return
+	}
+	revert, err := o.capacityReverts.ECSServiceScheduledAction(svc.ClusterName, svc.Name, describe.CapacityRevertActionName)
+	if err != nil {
+		log.Warningf("Unable to check for an override of the capacity of service %s: %v\n", o.name, err)
+		return
+	}
+	if revert == nil || !revert.At.After(time.Now()) {
+		return
+	}
+	log.Warningf(`The capacity of service %s is overridden with "copilot svc autoscale override" and reverts to %d-%d tasks at %s,
+regardless of the count.range of this deployment. Run "copilot svc deploy" again after the revert to apply count.range.
+`, o.name, revert.Capacity.Min, revert.Capacity.Max, revert.At.Local().Format(time.RFC1123))
+}
+
+// warnIfOverBudget warns if the spend of the environment is forecasted to exceed the budget of its guardrail.
+// The check is best effort and never blocks the deployment.
+func (o *deploySvcOpts) warnIfOverBudget() {
+	outputs, err := o.envOutputs.Outputs()
+	if err != nil {
+		log.Warningf("Unable to check the budget guardrail of environment %s: get the outputs of the environment: %v\n", o.envName, err)
+		return
+	}
+	name, ok := outputs[envOutputBudgetGuardrailName]
+	if !ok {
+		return
+	}
+	budget, err := o.budgets.Budget(o.targetEnv.AccountID, name)
+	if err != nil {
+		log.Warningf("Unable to check the budget guardrail of environment %s: %v\n", o.envName, err)
+		return
+	}
+	if !budget.ExceedsLimit() {
+		return
+	}
+	log.Warningf("The spend of environment %s is forecasted to reach %.2f %s this month, which exceeds its budget of %.2f %s.\n",
+		o.envName, budget.ForecastedSpend, budget.Unit, budget.Limit, budget.Unit)
+}
+
+// writeHandoff writes the inputs of the governed template rendered from the manifest instead of deploying the service.
+func (o *deploySvcOpts) writeHandoff(rawMft string) error {
+	content, err := afero.ReadFile(o.fs, o.governedTemplate)
+	if err != nil {
+		return fmt.Errorf("read governed template %s: %w", o.governedTemplate, err)
+	}
+	tpl, err := governance.ParseTemplate(o.handoff, content)
+	if err != nil {
+		return fmt.Errorf("parse governed template %s: %w", o.governedTemplate, err)
+	}
+	handoff, err := tpl.Render(governance.RenderInput{
+		Name:     o.name,
+		Env:      o.envName,
+		Manifest: []byte(rawMft),
+	})
+	if err != nil {
+		return fmt.Errorf("render inputs of governed template %s for service %s: %w", o.governedTemplate, o.name, err)
+	}
+	if err := handoff.Write(o.handoffWriter); err != nil {
+		return err
+	}
+	log.Successf("Rendered the inputs of governed template %s for service %s in environment %s instead of deploying it.\n",
+		color.HighlightUserInput(o.governedTemplate), color.HighlightUserInput(o.name), color.HighlightUserInput(o.envName))
+	return nil
+}
+
 // RecommendActions returns follow-up actions the user can take after successfully executing the command.
 func (o *deploySvcOpts) RecommendActions() error {
 	if lbMft, ok := o.appliedDynamicMft.Manifest().(*manifest.LoadBalancedWebService); ok {
@@ -435,6 +635,7 @@ func (o *deploySvcOpts) validateOrAskEnvName() error {
 
 func (o *deploySvcOpts) configureClients() error {
 	o.gitShortCommit = imageTagFromGit(o.cmd) // Best effort assign git tag.
+	o.gitBranch = gitBranch(o.cmd)
 	env, err := o.store.GetEnvironment(o.appName, o.envName)
 	if err != nil {
 		return fmt.Errorf("get environment %s configuration: %w", o.envName, err)
@@ -468,6 +669,10 @@ func (o *deploySvcOpts) configureClients() error {
 		return err
 	}
 	o.envFeaturesDescriber = envDescriber
+	o.envOutputs = envDescriber
+	o.budgets = budgets.New(envSess)
+	o.svcDescriber = ecs.New(envSess)
+	o.capacityReverts = aas.New(envSess)
 
 	wkldDescriber, err := describe.NewWorkloadStackDescriber(describe.NewWorkloadConfig{
 		App:         o.appName,
@@ -490,6 +695,7 @@ type workloadManifestInput struct {
 	interpolator interpolator
 	sess         *session.Session
 	unmarshal    func([]byte) (manifest.DynamicWorkload, error)
+	deployValues map[string]string // Optional. Manifest fields overridden at deploy time.
 }
 
 func workloadManifest(in *workloadManifestInput) (manifest.DynamicWorkload, string, error) {
@@ -501,6 +707,10 @@ func workloadManifest(in *workloadManifestInput) (manifest.DynamicWorkload, stri
 	if err != nil {
 		return nil, "", fmt.Errorf("interpolate environment variables for %s manifest: %w", in.name, err)
 	}
+	interpolated, err = manifest.ApplyDeployValues(interpolated, in.envName, in.deployValues)
+	if err != nil {
+		return nil, "", fmt.Errorf("apply --%s to %s manifest: %w", valuesFlag, in.name, err)
+	}
 	mft, err := in.unmarshal([]byte(interpolated))
 	if err != nil {
 		return nil, "", fmt.Errorf("unmarshal service %s manifest: %w", in.name, err)
@@ -685,8 +895,12 @@ func (e *errHasDiff) ExitCode() int {
 	return 1
 }
 
-func diff(differ templateDiffer, tmpl string, writer io.Writer) error {
-	if out, err := differ.DeployDiff(tmpl); err != nil {
+func diff(differ templateDiffer, tmpl string, writer io.Writer, format templatediff.Format) error {
+	var opts []templatediff.WriteOption
+	if format != templatediff.FormatHuman {
+		opts = append(opts, templatediff.WithFormat(format))
+	}
+	if out, err := differ.DeployDiff(tmpl, opts...); err != nil {
 		return err
 	} else if out != "" {
 		if _, err := writer.Write([]byte(out)); err != nil {
@@ -694,10 +908,7 @@ func diff(differ templateDiffer, tmpl string, writer io.Writer) error {
 		}
 		return &errHasDiff{}
 	}
-	if _, err := writer.Write([]byte("No changes.\n")); err != nil {
-		return err
-	}
-	return nil
+	return templatediff.WriteNoChanges(writer, format)
 }
 
 // buildSvcDeployCmd builds the `svc deploy` subcommand.
@@ -711,7 +922,11 @@ func buildSvcDeployCmd() *cobra.Command {
   Deploys a service named "frontend" to a "test" environment.
   /code $ copilot svc deploy --name frontend --env test
   Deploys a service with additional resource tags.
-  /code $ copilot svc deploy --resource-tags source/revision=bb133e7,deployment/initiator=manual`,
+  /code $ copilot svc deploy --resource-tags source/revision=bb133e7,deployment/initiator=manual
+  Deploys a service with 3 tasks and a debug log level without editing the manifest.
+  /code $ copilot svc deploy --values count=3,variables.LOG_LEVEL=debug
+  Renders the spec of an AWS Proton service template from the manifest instead of deploying the service.
+  /code $ copilot svc deploy --name frontend --env prod --handoff proton --governed-template proton/schema/schema.yaml > spec.yaml`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newSvcDeployOpts(vars)
 			if err != nil {
@@ -725,11 +940,16 @@ func buildSvcDeployCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
 	cmd.Flags().StringVar(&vars.imageTag, imageTagFlag, "", imageTagFlagDescription)
 	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
+	cmd.Flags().StringToStringVar(&vars.values, valuesFlag, nil, svcDeployValuesFlagDescription)
 	cmd.Flags().BoolVar(&vars.forceNewUpdate, forceFlag, false, forceFlagDescription)
 	cmd.Flags().BoolVar(&vars.disableRollback, noRollbackFlag, false, noRollbackFlagDescription)
 	cmd.Flags().BoolVar(&vars.showDiff, diffFlag, false, diffFlagDescription)
 	cmd.Flags().BoolVar(&vars.skipDiffPrompt, diffAutoApproveFlag, false, diffAutoApproveFlagDescription)
+	cmd.Flags().StringVar(&vars.diffFormat, diffFormatFlag, "", diffFormatFlagDescription)
 	cmd.Flags().BoolVar(&vars.allowWkldDowngrade, allowDowngradeFlag, false, allowDowngradeFlagDescription)
 	cmd.Flags().BoolVar(&vars.detach, detachFlag, false, detachFlagDescription)
+	cmd.Flags().StringVar(&vars.handoff, handoffFlag, "", handoffFlagDescription)
+	cmd.Flags().StringVar(&vars.governedTemplate, governedTemplateFlag, "", governedTemplateFlagDescription)
+	markAudited(cmd)
 	return cmd
 }
@@ -4,27 +4,230 @@
 package cli
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/aas"
+	"github.com/aws/copilot-cli/internal/pkg/aws/budgets"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/version"
 	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/require"
 
 	clideploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
 )
 
 func TestSvcDeployOpts_Validate(t *testing.T) {
-	// There is no validation for svc deploy.
+	testCases := map[string]struct {
+		inImageTag         string
+		inValues           map[string]string
+		inShowDiff         bool
+		inDiffFormat       string
+		inHandoff          string
+		inGovernedTemplate string
+
+		wantedError error
+	}{
+		"valid without values": {},
+		"valid handoff": {
+			inHandoff:          "proton",
+			inGovernedTemplate: "proton/schema/schema.yaml",
+		},
+		"error if the handoff is not supported": {
+			inHandoff:          "terraform",
+			inGovernedTemplate: "main.tf",
+			wantedError:        errors.New("invalid `--handoff` value \"terraform\": must be one of proton, servicecatalog"),
+		},
+		"error if the handoff is specified without a governed template": {
+			inHandoff:   "servicecatalog",
+			wantedError: errors.New("--handoff must be specified with --governed-template"),
+		},
+		"error if the governed template is specified without a handoff": {
+			inGovernedTemplate: "product.yml",
+			wantedError:        errors.New("--governed-template must be specified with --handoff"),
+		},
+		"error if the handoff is specified with --diff": {
+			inHandoff:          "proton",
+			inGovernedTemplate: "proton/schema/schema.yaml",
+			inShowDiff:         true,
+			wantedError:        errors.New("--handoff cannot be specified with --diff"),
+		},
+		"valid diff format": {
+			inShowDiff:   true,
+			inDiffFormat: "sarif",
+		},
+		"error if the diff format is specified without --diff": {
+			inDiffFormat: "json",
+			wantedError:  errors.New("--diff-format must be specified with --diff"),
+		},
+		"error if the diff format is not supported": {
+			inShowDiff:   true,
+			inDiffFormat: "xml",
+			wantedError:  errors.New("invalid `--diff-format` value \"xml\": must be one of json, sarif"),
+		},
+		"valid values": {
+			inValues: map[string]string{
+				"count":               "3",
+				"cpu":                 "512",
+				"memory":              "1024",
+				"tag":                 "v1.2.0",
+				"variables.LOG_LEVEL": "debug",
+			},
+		},
+		"error if the image tag is also specified with --tag": {
+			inImageTag: "v1.1.0",
+			inValues: map[string]string{
+				"tag": "v1.2.0",
+			},
+			wantedError: errors.New(`--values "tag" cannot be specified with --tag`),
+		},
+		"error if a field is not in the allowlist": {
+			inValues: map[string]string{
+				"count":          "3",
+				"image.location": "nginx",
+			},
+			wantedError: errors.New(`validate --values: "image.location" is not a manifest field that can be overridden at deploy time: must be one of count, cpu, memory, variables.<NAME>`),
+		},
+		"error if a value is invalid": {
+			inValues: map[string]string{
+				"cpu": "half",
+			},
+			wantedError: errors.New(`validate --values: value "half" for "cpu" must be a positive integer`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := deploySvcOpts{
+				deployWkldVars: deployWkldVars{
+					imageTag:         tc.inImageTag,
+					values:           tc.inValues,
+					showDiff:         tc.inShowDiff,
+					diffFormat:       tc.inDiffFormat,
+					handoff:          tc.inHandoff,
+					governedTemplate: tc.inGovernedTemplate,
+				},
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSvcDeployOpts_writeHandoff(t *testing.T) {
+	const schema = `
+schema:
+  format:
+    openapi: "3.0.0"
+  service_input_type: "WebServiceInput"
+  types:
+    WebServiceInput:
+      type: object
+      properties:
+        port:
+          type: number
+          x-copilot-path: image.port
+        desired_count:
+          type: number
+          x-copilot-path: count
+      required:
+        - port
+`
+	testCases := map[string]struct {
+		inRawMft string
+		inFS     func() afero.Fs
+
+		wanted      string
+		wantedError error
+	}{
+		"error if the governed template can't be read": {
+			inFS:        afero.NewMemMapFs,
+			wantedError: errors.New("read governed template schema.yaml: open schema.yaml: file does not exist"),
+		},
+		"error if the manifest drifts from the governed template": {
+			inRawMft: `
+name: frontend
+type: Load Balanced Web Service
+image:
+  port: 80
+cpu: 256
+`,
+			inFS: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				_ = afero.WriteFile(fs, "schema.yaml", []byte(schema), 0644)
+				return fs
+			},
+			wantedError: errors.New(`render inputs of governed template schema.yaml for service frontend: manifest drifts from the governed template: fields "cpu" are not supported by the template`),
+		},
+		"writes the Proton service spec": {
+			inRawMft: `
+name: frontend
+type: Load Balanced Web Service
+image:
+  port: 80
+count: 1
+environments:
+  prod:
+    count: 3
+`,
+			inFS: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				_ = afero.WriteFile(fs, "schema.yaml", []byte(schema), 0644)
+				return fs
+			},
+			wanted: `proton: ServiceSpec
+instances:
+  - name: frontend-prod
+    environment: prod
+    spec:
+      desired_count: 3
+      port: 80
+`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			buf := new(strings.Builder)
+			opts := deploySvcOpts{
+				deployWkldVars: deployWkldVars{
+					name:             "frontend",
+					envName:          "prod",
+					handoff:          "proton",
+					governedTemplate: "schema.yaml",
+				},
+				fs:            tc.inFS(),
+				handoffWriter: buf,
+			}
+
+			err := opts.writeHandoff(tc.inRawMft)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, buf.String())
+		})
+	}
 }
 
 type svcDeployAskMocks struct {
@@ -139,6 +342,8 @@ type deployMocks struct {
 	mockDiffWriter           *strings.Builder
 	mockPrompter             *mocks.Mockprompter
 	mockVersionGetter        *mocks.MockversionGetter
+	mockEnvOutputs           *mocks.MockenvOutputsGetter
+	mockBudgets              *mocks.MockbudgetDescriber
 }
 
 func TestSvcDeployOpts_Execute(t *testing.T) {
@@ -153,12 +358,16 @@ func TestSvcDeployOpts_Execute(t *testing.T) {
 	mockErrStackNotFound := cloudformation.ErrStackNotFound{}
 	testCases := map[string]struct {
 		inShowDiff       bool
+		inDiffFormat     string
 		inSkipDiffPrompt bool
 		inForceFlag      bool
 		inAllowDowngrade bool
 		inSvcType        string
+		inValues         map[string]string
 		mock             func(m *deployMocks)
 		wantedDiff       string
+		wantedRawMft     string
+		wantedImageTag   string
 		wantedError      error
 	}{
 		"error out if fail to get version": {
@@ -214,6 +423,54 @@ func TestSvcDeployOpts_Execute(t *testing.T) {
 
 			wantedError: fmt.Errorf(`--force is not supported for service type "Static Site"`),
 		},
+		"error out if deploy values are used for static site service": {
+			inValues:  map[string]string{"count": "2"},
+			inSvcType: manifestinfo.StaticSiteType,
+			mock: func(m *deployMocks) {
+				m.mockVersionGetter.EXPECT().Version().Return(mockVersion, nil)
+			},
+
+			wantedError: fmt.Errorf(`--values is not supported for service type "Static Site"`),
+		},
+		"success with deploy values recorded in the stack": {
+			inValues: map[string]string{
+				"count":               "3",
+				"tag":                 "v1.2.0",
+				"variables.LOG_LEVEL": "debug",
+			},
+			mock: func(m *deployMocks) {
+				m.mockVersionGetter.EXPECT().Version().Return(mockVersion, nil)
+				m.mockWsReader.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte("name: frontend\n"), nil)
+				m.mockInterpolator.EXPECT().Interpolate("name: frontend\n").Return("name: frontend\n", nil)
+				m.mockMft = &mockWorkloadMft{
+					mockRequiredEnvironmentFeatures: func() []string {
+						return nil
+					},
+				}
+				m.mockEnvFeaturesDescriber.EXPECT().Version().Return("v1.mock", nil)
+				m.mockEnvFeaturesDescriber.EXPECT().AvailableFeatures().Return(nil, nil)
+				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(true, nil)
+				m.mockDeployer.EXPECT().UploadArtifacts().Return(&clideploy.UploadArtifactsOutput{}, nil)
+				m.mockEnvOutputs.EXPECT().Outputs().Return(nil, nil)
+				m.mockDeployer.EXPECT().DeployWorkload(gomock.Any()).DoAndReturn(func(in *clideploy.DeployWorkloadInput) (clideploy.ActionRecommender, error) {
+					require.Equal(t, map[string]string{
+						"count":               "3",
+						"tag":                 "v1.2.0",
+						"variables.LOG_LEVEL": "debug",
+					}, in.DeployValues)
+					return nil, nil
+				})
+			},
+
+			wantedRawMft: `name: frontend
+environments:
+  prod-iad:
+    count: 3
+    variables:
+      LOG_LEVEL: "debug"
+`,
+			wantedImageTag: "v1.2.0",
+		},
 		"error if some required features are not available in the environment": {
 			mock: func(m *deployMocks) {
 				m.mockVersionGetter.EXPECT().Version().Return(mockVersion, nil)
@@ -309,6 +566,29 @@ func TestSvcDeployOpts_Execute(t *testing.T) {
 			},
 			wantedDiff: "No changes.\n",
 		},
+		"write an empty structured diff when there are no changes": {
+			inShowDiff:   true,
+			inDiffFormat: "json",
+			mock: func(m *deployMocks) {
+				m.mockVersionGetter.EXPECT().Version().Return(mockVersion, nil)
+				m.mockWsReader.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte(""), nil)
+				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
+				m.mockMft = &mockWorkloadMft{
+					mockRequiredEnvironmentFeatures: func() []string {
+						return []string{"mockFeature1"}
+					},
+				}
+				m.mockEnvFeaturesDescriber.EXPECT().Version().Return("v1.mock", nil)
+				m.mockEnvFeaturesDescriber.EXPECT().AvailableFeatures().Return([]string{"mockFeature1", "mockFeature2"}, nil)
+				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(false, nil)
+				m.mockDeployer.EXPECT().UploadArtifacts().Return(&clideploy.UploadArtifactsOutput{}, nil)
+				m.mockDeployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&clideploy.GenerateCloudFormationTemplateOutput{}, nil)
+				m.mockDeployer.EXPECT().DeployDiff(gomock.Any(), gomock.Any()).Return("", nil)
+				m.mockDiffWriter = &strings.Builder{}
+				m.mockPrompter.EXPECT().Confirm(gomock.Eq("Continue with the deployment?"), gomock.Any(), gomock.Any()).Return(false, nil)
+			},
+			wantedDiff: "{\n  \"changes\": []\n}\n",
+		},
 		"write the correct diff": {
 			inShowDiff: true,
 			mock: func(m *deployMocks) {
@@ -394,6 +674,7 @@ func TestSvcDeployOpts_Execute(t *testing.T) {
 				m.mockDeployer.EXPECT().DeployDiff(gomock.Any()).Return("mock diff", nil)
 				m.mockDiffWriter = &strings.Builder{}
 				m.mockPrompter.EXPECT().Confirm(gomock.Eq("Continue with the deployment?"), gomock.Any(), gomock.Any()).Return(true, nil)
+				m.mockEnvOutputs.EXPECT().Outputs().Return(nil, nil)
 				m.mockDeployer.EXPECT().DeployWorkload(gomock.Any()).Times(1)
 			},
 		},
@@ -417,6 +698,7 @@ func TestSvcDeployOpts_Execute(t *testing.T) {
 				m.mockDeployer.EXPECT().DeployDiff(gomock.Any()).Return("mock diff", nil)
 				m.mockDiffWriter = &strings.Builder{}
 				m.mockPrompter.EXPECT().Confirm(gomock.Eq("Continue with the deployment?"), gomock.Any(), gomock.Any()).Times(0)
+				m.mockEnvOutputs.EXPECT().Outputs().Return(nil, nil)
 				m.mockDeployer.EXPECT().DeployWorkload(gomock.Any()).Times(1)
 			},
 		},
@@ -433,6 +715,7 @@ func TestSvcDeployOpts_Execute(t *testing.T) {
 				m.mockEnvFeaturesDescriber.EXPECT().Version().Return("v1.mock", nil)
 				m.mockEnvFeaturesDescriber.EXPECT().AvailableFeatures().Return([]string{"mockFeature1", "mockFeature2"}, nil)
 				m.mockDeployer.EXPECT().UploadArtifacts().Return(&clideploy.UploadArtifactsOutput{}, nil)
+				m.mockEnvOutputs.EXPECT().Outputs().Return(nil, nil)
 				m.mockDeployer.EXPECT().DeployWorkload(gomock.Any()).Return(nil, mockError)
 				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(false, nil)
 			},
@@ -453,6 +736,7 @@ func TestSvcDeployOpts_Execute(t *testing.T) {
 				m.mockEnvFeaturesDescriber.EXPECT().Version().Return("v1.mock", nil)
 				m.mockEnvFeaturesDescriber.EXPECT().AvailableFeatures().Return([]string{"mockFeature1", "mockFeature2"}, nil)
 				m.mockDeployer.EXPECT().UploadArtifacts().Return(&clideploy.UploadArtifactsOutput{}, nil)
+				m.mockEnvOutputs.EXPECT().Outputs().Return(nil, nil)
 				m.mockDeployer.EXPECT().DeployWorkload(gomock.Any()).Return(nil, nil)
 				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(false, nil)
 			},
@@ -470,6 +754,7 @@ func TestSvcDeployOpts_Execute(t *testing.T) {
 				m.mockEnvFeaturesDescriber.EXPECT().Version().Return("v1.mock", nil)
 				m.mockEnvFeaturesDescriber.EXPECT().AvailableFeatures().Return([]string{"mockFeature1", "mockFeature2"}, nil)
 				m.mockDeployer.EXPECT().UploadArtifacts().Return(&clideploy.UploadArtifactsOutput{}, nil)
+				m.mockEnvOutputs.EXPECT().Outputs().Return(nil, nil)
 				m.mockDeployer.EXPECT().DeployWorkload(gomock.Any()).Return(nil, nil)
 				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(false, nil)
 			},
@@ -489,6 +774,8 @@ func TestSvcDeployOpts_Execute(t *testing.T) {
 				mockEnvFeaturesDescriber: mocks.NewMockversionCompatibilityChecker(ctrl),
 				mockPrompter:             mocks.NewMockprompter(ctrl),
 				mockVersionGetter:        mocks.NewMockversionGetter(ctrl),
+				mockEnvOutputs:           mocks.NewMockenvOutputsGetter(ctrl),
+				mockBudgets:              mocks.NewMockbudgetDescriber(ctrl),
 			}
 			tc.mock(m)
 
@@ -499,8 +786,10 @@ func TestSvcDeployOpts_Execute(t *testing.T) {
 					envName:            mockEnvName,
 					showDiff:           tc.inShowDiff,
 					skipDiffPrompt:     tc.inSkipDiffPrompt,
+					diffFormat:         tc.inDiffFormat,
 					forceNewUpdate:     tc.inForceFlag,
 					allowWkldDowngrade: tc.inAllowDowngrade,
+					values:             tc.inValues,
 					clientConfigured:   true,
 				},
 				svcType: tc.inSvcType,
@@ -515,6 +804,8 @@ func TestSvcDeployOpts_Execute(t *testing.T) {
 					return m.mockMft, nil
 				},
 				envFeaturesDescriber: m.mockEnvFeaturesDescriber,
+				envOutputs:           m.mockEnvOutputs,
+				budgets:              m.mockBudgets,
 				prompt:               m.mockPrompter,
 				diffWriter:           m.mockDiffWriter,
 				svcVersionGetter:     m.mockVersionGetter,
@@ -535,6 +826,159 @@ func TestSvcDeployOpts_Execute(t *testing.T) {
 			if tc.wantedDiff != "" {
 				require.Equal(t, tc.wantedDiff, m.mockDiffWriter.String())
 			}
+			if tc.wantedRawMft != "" {
+				require.Equal(t, tc.wantedRawMft, opts.rawMft)
+			}
+			require.Equal(t, tc.wantedImageTag, opts.imageTag)
+		})
+	}
+}
+
+func TestSvcDeployOpts_warnIfOverBudget(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(outputs *mocks.MockenvOutputsGetter, budgets *mocks.MockbudgetDescriber)
+
+		wantedLog string
+	}{
+		"no warning if the environment has no budget guardrail": {
+			setupMocks: func(outputs *mocks.MockenvOutputsGetter, _ *mocks.MockbudgetDescriber) {
+				outputs.EXPECT().Outputs().Return(map[string]string{"ClusterId": "cluster"}, nil)
+			},
+		},
+		"no warning if the forecasted spend is within the budget": {
+			setupMocks: func(outputs *mocks.MockenvOutputsGetter, b *mocks.MockbudgetDescriber) {
+				outputs.EXPECT().Outputs().Return(map[string]string{"BudgetGuardrailName": "phonetool-prod"}, nil)
+				b.EXPECT().Budget("123456789012", "phonetool-prod").Return(&budgets.Budget{
+					Limit:           100,
+					ForecastedSpend: 80,
+					Unit:            "USD",
+				}, nil)
+			},
+		},
+		"warn if the forecasted spend exceeds the budget": {
+			setupMocks: func(outputs *mocks.MockenvOutputsGetter, b *mocks.MockbudgetDescriber) {
+				outputs.EXPECT().Outputs().Return(map[string]string{"BudgetGuardrailName": "phonetool-prod"}, nil)
+				b.EXPECT().Budget("123456789012", "phonetool-prod").Return(&budgets.Budget{
+					Limit:           100,
+					ForecastedSpend: 123.456,
+					Unit:            "USD",
+				}, nil)
+			},
+			wantedLog: "The spend of environment prod is forecasted to reach 123.46 USD this month, which exceeds its budget of 100.00 USD.",
+		},
+		"warn without failing if the budget cannot be described": {
+			setupMocks: func(outputs *mocks.MockenvOutputsGetter, b *mocks.MockbudgetDescriber) {
+				outputs.EXPECT().Outputs().Return(map[string]string{"BudgetGuardrailName": "phonetool-prod"}, nil)
+				b.EXPECT().Budget("123456789012", "phonetool-prod").Return(nil, errors.New("some error"))
+			},
+			wantedLog: "Unable to check the budget guardrail of environment prod: some error",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			outputs := mocks.NewMockenvOutputsGetter(ctrl)
+			budgetDescriber := mocks.NewMockbudgetDescriber(ctrl)
+			tc.setupMocks(outputs, budgetDescriber)
+			buf := &bytes.Buffer{}
+			log.DiagnosticWriter = buf
+			opts := deploySvcOpts{
+				deployWkldVars: deployWkldVars{
+					envName: "prod",
+				},
+				envOutputs: outputs,
+				budgets:    budgetDescriber,
+				targetEnv: &config.Environment{
+					AccountID: "123456789012",
+				},
+			}
+
+			opts.warnIfOverBudget()
+
+			if tc.wantedLog == "" {
+				require.Empty(t, buf.String())
+				return
+			}
+			require.Contains(t, buf.String(), tc.wantedLog)
+		})
+	}
+}
+
+func TestSvcDeployOpts_warnIfCapacityOverridden(t *testing.T) {
+	testCases := map[string]struct {
+		inSvcType  string
+		setupMocks func(svc *mocks.MockserviceDescriber, reverts *mocks.MockcapacityRevertGetter)
+
+		wantedLog string
+	}{
+		"no check for services that don't run on Amazon ECS": {
+			inSvcType:  manifestinfo.StaticSiteType,
+			setupMocks: func(_ *mocks.MockserviceDescriber, _ *mocks.MockcapacityRevertGetter) {},
+		},
+		"no warning if the capacity is not overridden": {
+			inSvcType: manifestinfo.BackendServiceType,
+			setupMocks: func(svc *mocks.MockserviceDescriber, reverts *mocks.MockcapacityRevertGetter) {
+				svc.EXPECT().DescribeService("phonetool", "prod", "api").Return(&ecs.ServiceDesc{ClusterName: "cluster", Name: "svc"}, nil)
+				reverts.EXPECT().ECSServiceScheduledAction("cluster", "svc", describe.CapacityRevertActionName).Return(nil, nil)
+			},
+		},
+		"no warning if the override already reverted": {
+			inSvcType: manifestinfo.BackendServiceType,
+			setupMocks: func(svc *mocks.MockserviceDescriber, reverts *mocks.MockcapacityRevertGetter) {
+				svc.EXPECT().DescribeService("phonetool", "prod", "api").Return(&ecs.ServiceDesc{ClusterName: "cluster", Name: "svc"}, nil)
+				reverts.EXPECT().ECSServiceScheduledAction("cluster", "svc", describe.CapacityRevertActionName).Return(&aas.ScheduledAction{
+					At: time.Now().Add(-time.Hour),
+				}, nil)
+			},
+		},
+		"warn if the override reverts after the deployment": {
+			inSvcType: manifestinfo.LoadBalancedWebServiceType,
+			setupMocks: func(svc *mocks.MockserviceDescriber, reverts *mocks.MockcapacityRevertGetter) {
+				svc.EXPECT().DescribeService("phonetool", "prod", "api").Return(&ecs.ServiceDesc{ClusterName: "cluster", Name: "svc"}, nil)
+				reverts.EXPECT().ECSServiceScheduledAction("cluster", "svc", describe.CapacityRevertActionName).Return(&aas.ScheduledAction{
+					At:       time.Now().Add(time.Hour),
+					Capacity: aas.Capacity{Min: 2, Max: 4},
+				}, nil)
+			},
+			wantedLog: `The capacity of service api is overridden with "copilot svc autoscale override" and reverts to 2-4 tasks at`,
+		},
+		"warn without failing if the revert cannot be described": {
+			inSvcType: manifestinfo.WorkerServiceType,
+			setupMocks: func(svc *mocks.MockserviceDescriber, reverts *mocks.MockcapacityRevertGetter) {
+				svc.EXPECT().DescribeService("phonetool", "prod", "api").Return(&ecs.ServiceDesc{ClusterName: "cluster", Name: "svc"}, nil)
+				reverts.EXPECT().ECSServiceScheduledAction("cluster", "svc", describe.CapacityRevertActionName).Return(nil, errors.New("some error"))
+			},
+			wantedLog: "Unable to check for an override of the capacity of service api: some error",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			svcDescriber := mocks.NewMockserviceDescriber(ctrl)
+			reverts := mocks.NewMockcapacityRevertGetter(ctrl)
+			tc.setupMocks(svcDescriber, reverts)
+			buf := &bytes.Buffer{}
+			log.DiagnosticWriter = buf
+			opts := deploySvcOpts{
+				deployWkldVars: deployWkldVars{
+					appName: "phonetool",
+					envName: "prod",
+					name:    "api",
+				},
+				svcType:         tc.inSvcType,
+				svcDescriber:    svcDescriber,
+				capacityReverts: reverts,
+			}
+
+			opts.warnIfCapacityOverridden()
+
+			if tc.wantedLog == "" {
+				require.Empty(t, buf.String())
+				return
+			}
+			require.Contains(t, buf.String(), tc.wantedLog)
 		})
 	}
 }
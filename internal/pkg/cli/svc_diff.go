@@ -0,0 +1,408 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	templatediff "github.com/aws/copilot-cli/internal/pkg/template/diff"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/version"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	svcDiffSvcNamePrompt = "Which service would you like to compare with its deployed stack?"
+	svcDiffEnvNamePrompt = "Which environment is the service deployed to?"
+)
+
+type diffSvcOpts struct {
+	// Generates the template and parameters of the service like "svc package".
+	*packageSvcOpts
+
+	stackDescriber deployedStackDescriber
+}
+
+func newDiffSvcOpts(vars packageSvcVars) (*diffSvcOpts, error) {
+	fs := afero.NewOsFs()
+	ws, err := workspace.Use(fs)
+	if err != nil {
+		return nil, err
+	}
+
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("svc diff"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+
+	store := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	prompter := prompt.New()
+	return &diffSvcOpts{
+		packageSvcOpts: &packageSvcOpts{
+			packageSvcVars:    vars,
+			store:             store,
+			ws:                ws,
+			fs:                fs,
+			unmarshal:         manifest.UnmarshalWorkload,
+			runner:            exec.NewCmd(),
+			sel:               selector.NewLocalWorkloadSelector(prompter, store, ws, selector.OnlyInitializedWorkloads),
+			templateWriter:    discardFile{},
+			paramsWriter:      discardFile{},
+			addonsWriter:      discardFile{},
+			diffWriter:        os.Stdout,
+			templateVersion:   version.LatestTemplateVersion(),
+			newInterpolator:   newManifestInterpolator,
+			sessProvider:      sessProvider,
+			newStackGenerator: newWorkloadStackGenerator,
+		},
+	}, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *diffSvcOpts) Validate() error {
+	return nil
+}
+
+// Ask prompts for and validates any required flags.
+func (o *diffSvcOpts) Ask() error {
+	if o.appName == "" {
+		// NOTE: This command is required to be executed under a workspace. We don't prompt for it.
+		return errNoAppInWorkspace
+	}
+	if _, err := o.getTargetApp(); err != nil {
+		return err
+	}
+	if err := o.askSvcName(); err != nil {
+		return err
+	}
+	return o.askEnvName()
+}
+
+// Execute writes the differences between the deployed template and parameters of the service
+// and the ones generated from the workspace.
+// It returns an error with exit code 1 if they differ, and 2 if they can't be compared.
+func (o *diffSvcOpts) Execute() error {
+	err := o.compare()
+	var hasDiff *errHasDiff
+	if err == nil || errors.As(err, &hasDiff) {
+		return err
+	}
+	return &errDiffNotAvailable{
+		parentErr: err,
+	}
+}
+
+func (o *diffSvcOpts) compare() error {
+	if !o.clientConfigured {
+		if err := o.configureClients(); err != nil {
+			return err
+		}
+		o.stackDescriber = awscloudformation.New(o.envSess)
+	}
+	if !o.allowWkldDowngrade {
+		if err := validateWkldVersion(o.svcVersionGetter, o.name, o.templateVersion); err != nil {
+			return err
+		}
+	}
+	targetEnv, err := o.getTargetEnv()
+	if err != nil {
+		return err
+	}
+	gen, err := o.getStackGenerator(targetEnv)
+	if err != nil {
+		return err
+	}
+	local, err := o.getWorkloadStack(gen)
+	if err != nil {
+		return err
+	}
+
+	tmpl, err := o.resolveDeployedValues(local.template)
+	if err != nil {
+		return err
+	}
+
+	w := o.diffWriter
+	fmt.Fprintln(w, "Template:")
+	tmplErr := diff(gen, tmpl, w, templatediff.FormatHuman)
+	var hasDiff *errHasDiff
+	if tmplErr != nil && !errors.As(tmplErr, &hasDiff) {
+		return tmplErr
+	}
+	fmt.Fprintln(w, "\nParameters:")
+	paramsDiff, err := o.parametersDiff(local.parameters)
+	if err != nil {
+		return err
+	}
+	if paramsDiff == "" {
+		if err := templatediff.WriteNoChanges(w, templatediff.FormatHuman); err != nil {
+			return err
+		}
+		return tmplErr
+	}
+	if _, err := w.Write([]byte(paramsDiff)); err != nil {
+		return err
+	}
+	return &errHasDiff{}
+}
+
+// resolveDeployedValues replaces the values of the local template that are only known once the artifacts of the service
+// are uploaded, such as the S3 keys of custom resources and the digests of the images built by Copilot, with the deployed ones.
+func (o *diffSvcOpts) resolveDeployedValues(local string) (string, error) {
+	stackName := stack.NameForWorkload(o.appName, o.envName, o.name)
+	deployed, err := o.stackDescriber.TemplateBody(stackName)
+	if err != nil {
+		var errNotFound *awscloudformation.ErrStackNotFound
+		if errors.As(err, &errNotFound) {
+			return local, nil
+		}
+		return "", fmt.Errorf("get the deployed template of stack %q: %w", stackName, err)
+	}
+	var localDoc, deployedDoc yaml.Node
+	if err := yaml.Unmarshal([]byte(local), &localDoc); err != nil {
+		return "", fmt.Errorf("unmarshal the template of service %q: %w", o.name, err)
+	}
+	if err := yaml.Unmarshal([]byte(deployed), &deployedDoc); err != nil {
+		return "", fmt.Errorf("unmarshal the deployed template of stack %q: %w", stackName, err)
+	}
+	localResources, deployedResources := mappingValue(documentRoot(&localDoc), "Resources"), mappingValue(documentRoot(&deployedDoc), "Resources")
+	if localResources == nil || deployedResources == nil {
+		return local, nil
+	}
+	for i := 0; i+1 < len(localResources.Content); i += 2 {
+		localResource := localResources.Content[i+1]
+		deployedResource := mappingValue(deployedResources, localResources.Content[i].Value)
+		if deployedResource == nil {
+			continue
+		}
+		resourceType := mappingValue(localResource, "Type")
+		if resourceType == nil || !equalScalars(resourceType, mappingValue(deployedResource, "Type")) {
+			continue
+		}
+		localProps, deployedProps := mappingValue(localResource, "Properties"), mappingValue(deployedResource, "Properties")
+		switch resourceType.Value {
+		case "AWS::Lambda::Function":
+			localCode, deployedCode := mappingValue(localProps, "Code"), mappingValue(deployedProps, "Code")
+			for _, key := range []string{"S3Bucket", "S3Key"} {
+				copyScalar(mappingValue(localCode, key), mappingValue(deployedCode, key))
+			}
+		case "AWS::ECS::TaskDefinition":
+			if o.tag != "" {
+				continue
+			}
+			deployedContainers := make(map[string]*yaml.Node)
+			for _, container := range sequenceItems(mappingValue(deployedProps, "ContainerDefinitions")) {
+				if name := mappingValue(container, "Name"); name != nil {
+					deployedContainers[name.Value] = container
+				}
+			}
+			for _, container := range sequenceItems(mappingValue(localProps, "ContainerDefinitions")) {
+				name := mappingValue(container, "Name")
+				if name == nil || deployedContainers[name.Value] == nil {
+					continue
+				}
+				localImage, deployedImage := mappingValue(container, "Image"), mappingValue(deployedContainers[name.Value], "Image")
+				if localImage != nil && deployedImage != nil && isUnpushedImage(localImage.Value, deployedImage.Value) {
+					copyScalar(localImage, deployedImage)
+				}
+			}
+		}
+	}
+	out, err := yaml.Marshal(&localDoc)
+	if err != nil {
+		return "", fmt.Errorf("marshal the template of service %q: %w", o.name, err)
+	}
+	return string(out), nil
+}
+
+// isUnpushedImage returns true if the local image is the placeholder of an image built by Copilot
+// that isn't pushed yet, while the deployed one refers to the same repository by digest.
+func isUnpushedImage(local, deployed string) bool {
+	repo, digest, ok := strings.Cut(deployed, "@")
+	if !ok || !strings.HasPrefix(digest, "sha256:") {
+		return false
+	}
+	return strings.HasPrefix(local, repo+":") && strings.HasSuffix(local, "latest")
+}
+
+func documentRoot(doc *yaml.Node) *yaml.Node {
+	if doc.Kind == yaml.DocumentNode && len(doc.Content) == 1 {
+		return doc.Content[0]
+	}
+	return nil
+}
+
+// mappingValue returns the value of the key in a mapping node, or nil if the node is not a mapping or doesn't have the key.
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func sequenceItems(node *yaml.Node) []*yaml.Node {
+	if node == nil || node.Kind != yaml.SequenceNode {
+		return nil
+	}
+	return node.Content
+}
+
+func equalScalars(a, b *yaml.Node) bool {
+	return a != nil && b != nil && a.Kind == yaml.ScalarNode && b.Kind == yaml.ScalarNode && a.Value == b.Value
+}
+
+// copyScalar overwrites the scalar node dst with the scalar node src.
+func copyScalar(dst, src *yaml.Node) {
+	if dst == nil || src == nil || dst.Kind != yaml.ScalarNode || src.Kind != yaml.ScalarNode {
+		return
+	}
+	dst.Value, dst.Tag, dst.Style = src.Value, src.Tag, src.Style
+}
+
+// parametersDiff returns the stringified diff of the serialized parameters against the parameters of the deployed stack.
+// Parameters that are empty locally, such as the URLs and ARNs of artifacts that are only known once uploaded, are not compared.
+// Neither are the images built by Copilot that are only referred to by digest once pushed.
+func (o *diffSvcOpts) parametersDiff(serialized string) (string, error) {
+	var config struct {
+		Parameters map[string]*string `json:"Parameters"`
+	}
+	if err := json.Unmarshal([]byte(serialized), &config); err != nil {
+		return "", fmt.Errorf("unmarshal the parameters of service %q: %w", o.name, err)
+	}
+	local := make(map[string]string)
+	for key, value := range config.Parameters {
+		if aws.StringValue(value) != "" {
+			local[key] = aws.StringValue(value)
+		}
+	}
+
+	stackName := stack.NameForWorkload(o.appName, o.envName, o.name)
+	deployed := make(map[string]string)
+	descr, err := o.stackDescriber.Describe(stackName)
+	if err != nil {
+		var errNotFound *awscloudformation.ErrStackNotFound
+		if !errors.As(err, &errNotFound) {
+			return "", fmt.Errorf("describe the deployed stack %q: %w", stackName, err)
+		}
+		descr = &awscloudformation.StackDescription{}
+	}
+	for _, param := range descr.Parameters {
+		key, value := aws.StringValue(param.ParameterKey), aws.StringValue(param.ParameterValue)
+		if _, ok := local[key]; !ok {
+			continue
+		}
+		deployed[key] = value
+		if o.tag == "" && isUnpushedImage(local[key], value) {
+			local[key] = value
+		}
+	}
+
+	from, err := yaml.Marshal(deployed)
+	if err != nil {
+		return "", fmt.Errorf("marshal the deployed parameters: %w", err)
+	}
+	to, err := yaml.Marshal(local)
+	if err != nil {
+		return "", fmt.Errorf("marshal the local parameters: %w", err)
+	}
+	tree, err := templatediff.From(string(from)).Parse(to)
+	if err != nil {
+		return "", fmt.Errorf("parse the diff of the parameters against the deployed %q in environment %q: %w", o.name, o.envName, err)
+	}
+	buf := strings.Builder{}
+	if err := tree.Write(&buf); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+func (o *diffSvcOpts) askSvcName() error {
+	if o.name != "" {
+		names, err := o.ws.ListServices()
+		if err != nil {
+			return fmt.Errorf("list services in the workspace: %w", err)
+		}
+		if !slices.Contains(names, o.name) {
+			return fmt.Errorf("service '%s' does not exist in the workspace", o.name)
+		}
+		return nil
+	}
+	name, err := o.sel.Service(svcDiffSvcNamePrompt, "")
+	if err != nil {
+		return fmt.Errorf("select service: %w", err)
+	}
+	o.name = name
+	return nil
+}
+
+func (o *diffSvcOpts) askEnvName() error {
+	if o.envName != "" {
+		_, err := o.getTargetEnv()
+		return err
+	}
+	name, err := o.sel.Environment(svcDiffEnvNamePrompt, "", o.appName)
+	if err != nil {
+		return fmt.Errorf("select environment: %w", err)
+	}
+	o.envName = name
+	return nil
+}
+
+// RecommendActions is a no-op.
+func (o *diffSvcOpts) RecommendActions() error {
+	return nil
+}
+
+// buildSvcDiffCmd builds the command for comparing a service with its deployed stack.
+func buildSvcDiffCmd() *cobra.Command {
+	vars := packageSvcVars{}
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Compare the deployed stack of a service with the workspace.",
+		Long: `Compare the deployed CloudFormation template and parameters of a service with the ones generated from the workspace.
+Exits with code 1 if they differ, and with code 2 if they can't be compared.`,
+		Example: `
+  Compare the "frontend" service deployed in the "prod" environment with the workspace.
+  /code $ copilot svc diff -n frontend -e prod
+  Compare the service as if it was deployed with the "v1.2.0" image tag.
+  /code $ copilot svc diff -n frontend -e prod --tag v1.2.0`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newDiffSvcOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVar(&vars.tag, imageTagFlag, "", imageTagFlagDescription)
+	cmd.Flags().BoolVar(&vars.allowWkldDowngrade, allowDowngradeFlag, false, allowDowngradeFlagDescription)
+	return cmd
+}
@@ -0,0 +1,278 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/cli/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	templatediff "github.com/aws/copilot-cli/internal/pkg/template/diff"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type svcDiffExecuteMock struct {
+	ws                   *mocks.MockwsWlDirReader
+	generator            *mocks.MockworkloadStackGenerator
+	interpolator         *mocks.Mockinterpolator
+	envFeaturesDescriber *mocks.MockversionCompatibilityChecker
+	stackDescriber       *mocks.MockdeployedStackDescriber
+}
+
+func TestDiffSvcOpts_Execute(t *testing.T) {
+	const (
+		mft = `name: api
+type: Backend Service
+image:
+  build: ./Dockerfile
+count: 3`
+		params = `{
+  "Parameters": {
+    "AddonsTemplateURL": "",
+    "TaskCount": "3"
+  }
+}`
+	)
+	deployedStack := func(count string) *awscloudformation.StackDescription {
+		return &awscloudformation.StackDescription{
+			Parameters: []*sdkcloudformation.Parameter{
+				{
+					ParameterKey:   aws.String("AddonsTemplateURL"),
+					ParameterValue: aws.String("https://bucket.s3.amazonaws.com/addons.yml"),
+				},
+				{
+					ParameterKey:   aws.String("TaskCount"),
+					ParameterValue: aws.String(count),
+				},
+			},
+		}
+	}
+	testCases := map[string]struct {
+		inTemplate string
+		inParams   string
+		inTag      string
+		setupMocks func(m *svcDiffExecuteMock)
+
+		wantedDiff string
+		wantedErr  error
+	}{
+		"error if the deployed template can't be read": {
+			setupMocks: func(m *svcDiffExecuteMock) {
+				m.stackDescriber.EXPECT().TemplateBody("phonetool-test-api").Return("", errors.New("some error"))
+			},
+			wantedErr: &errDiffNotAvailable{parentErr: errors.New(`get the deployed template of stack "phonetool-test-api": some error`)},
+		},
+		"compares with the deployed custom resource keys and image digests": {
+			inTemplate: `Resources:
+  EnvControllerFunction:
+    Type: AWS::Lambda::Function
+    Properties:
+      Code:
+        S3Bucket: stackset-bucket
+        S3Key: manual/scripts/custom-resources/envcontrollerfunction/local.zip
+  TaskDefinition:
+    Type: AWS::ECS::TaskDefinition
+    Properties:
+      ContainerDefinitions:
+        - Name: api
+          Image: !Ref ContainerImage
+        - Name: nginx
+          Image: 1234.dkr.ecr.us-west-2.amazonaws.com/phonetool/api:nginx-latest
+`,
+			inParams: `{
+  "Parameters": {
+    "ContainerImage": "1234.dkr.ecr.us-west-2.amazonaws.com/phonetool/api:latest",
+    "TaskCount": "3"
+  }
+}`,
+			setupMocks: func(m *svcDiffExecuteMock) {
+				m.stackDescriber.EXPECT().TemplateBody("phonetool-test-api").Return(`Resources:
+  EnvControllerFunction:
+    Type: AWS::Lambda::Function
+    Properties:
+      Code:
+        S3Bucket: stackset-bucket
+        S3Key: manual/scripts/custom-resources/envcontrollerfunction/deployed.zip
+  TaskDefinition:
+    Type: AWS::ECS::TaskDefinition
+    Properties:
+      ContainerDefinitions:
+        - Name: api
+          Image: !Ref ContainerImage
+        - Name: nginx
+          Image: 1234.dkr.ecr.us-west-2.amazonaws.com/phonetool/api@sha256:nginx
+`, nil)
+				m.generator.EXPECT().DeployDiff(gomock.Any()).DoAndReturn(func(tmpl string, _ ...templatediff.WriteOption) (string, error) {
+					require.Contains(t, tmpl, "S3Key: manual/scripts/custom-resources/envcontrollerfunction/deployed.zip")
+					require.Contains(t, tmpl, "Image: 1234.dkr.ecr.us-west-2.amazonaws.com/phonetool/api@sha256:nginx")
+					require.Contains(t, tmpl, "Image: !Ref ContainerImage")
+					return "", nil
+				})
+				m.stackDescriber.EXPECT().Describe("phonetool-test-api").Return(&awscloudformation.StackDescription{
+					Parameters: []*sdkcloudformation.Parameter{
+						{
+							ParameterKey:   aws.String("ContainerImage"),
+							ParameterValue: aws.String("1234.dkr.ecr.us-west-2.amazonaws.com/phonetool/api@sha256:api"),
+						},
+						{
+							ParameterKey:   aws.String("TaskCount"),
+							ParameterValue: aws.String("3"),
+						},
+					},
+				}, nil)
+			},
+			wantedDiff: "Template:\nNo changes.\n\nParameters:\nNo changes.\n",
+		},
+		"compares the images if a tag is given": {
+			inTag: "v1",
+			inParams: `{
+  "Parameters": {
+    "ContainerImage": "1234.dkr.ecr.us-west-2.amazonaws.com/phonetool/api:v1"
+  }
+}`,
+			setupMocks: func(m *svcDiffExecuteMock) {
+				m.generator.EXPECT().DeployDiff("mystack").Return("", nil)
+				m.stackDescriber.EXPECT().Describe("phonetool-test-api").Return(&awscloudformation.StackDescription{
+					Parameters: []*sdkcloudformation.Parameter{
+						{
+							ParameterKey:   aws.String("ContainerImage"),
+							ParameterValue: aws.String("1234.dkr.ecr.us-west-2.amazonaws.com/phonetool/api@sha256:api"),
+						},
+					},
+				}, nil)
+			},
+			wantedDiff: "Template:\nNo changes.\n\nParameters:\n~ ContainerImage: 1234.dkr.ecr.us-west-2.amazonaws.com/phonetool/api@sha256:api -> 1234.dkr.ecr.us-west-2.amazonaws.com/phonetool/api:v1\n",
+			wantedErr:  &errHasDiff{},
+		},
+		"error if the deployed template can't be retrieved": {
+			setupMocks: func(m *svcDiffExecuteMock) {
+				m.generator.EXPECT().DeployDiff("mystack").Return("", errors.New("some error"))
+			},
+			wantedDiff: "Template:\n",
+			wantedErr:  &errDiffNotAvailable{parentErr: errors.New("some error")},
+		},
+		"error if the deployed stack can't be described": {
+			setupMocks: func(m *svcDiffExecuteMock) {
+				m.generator.EXPECT().DeployDiff("mystack").Return("", nil)
+				m.stackDescriber.EXPECT().Describe("phonetool-test-api").Return(nil, errors.New("some error"))
+			},
+			wantedDiff: "Template:\nNo changes.\n\nParameters:\n",
+			wantedErr:  &errDiffNotAvailable{parentErr: errors.New(`describe the deployed stack "phonetool-test-api": some error`)},
+		},
+		"no changes when parameters that are empty locally differ": {
+			setupMocks: func(m *svcDiffExecuteMock) {
+				m.generator.EXPECT().DeployDiff("mystack").Return("", nil)
+				m.stackDescriber.EXPECT().Describe("phonetool-test-api").Return(deployedStack("3"), nil)
+			},
+			wantedDiff: "Template:\nNo changes.\n\nParameters:\nNo changes.\n",
+		},
+		"exit with a diff if only the template differs": {
+			setupMocks: func(m *svcDiffExecuteMock) {
+				m.generator.EXPECT().DeployDiff("mystack").Return("mock diff\n", nil)
+				m.stackDescriber.EXPECT().Describe("phonetool-test-api").Return(deployedStack("3"), nil)
+			},
+			wantedDiff: "Template:\nmock diff\n\nParameters:\nNo changes.\n",
+			wantedErr:  &errHasDiff{},
+		},
+		"exit with a diff if the parameters differ": {
+			setupMocks: func(m *svcDiffExecuteMock) {
+				m.generator.EXPECT().DeployDiff("mystack").Return("", nil)
+				m.stackDescriber.EXPECT().Describe("phonetool-test-api").Return(deployedStack("1"), nil)
+			},
+			wantedDiff: "Template:\nNo changes.\n\nParameters:\n~ TaskCount: \"1\" -> \"3\"\n",
+			wantedErr:  &errHasDiff{},
+		},
+		"every parameter is new if the service is not deployed": {
+			setupMocks: func(m *svcDiffExecuteMock) {
+				m.generator.EXPECT().DeployDiff("mystack").Return("mock diff\n", nil)
+				m.stackDescriber.EXPECT().Describe("phonetool-test-api").Return(nil, &awscloudformation.ErrStackNotFound{})
+			},
+			wantedDiff: "Template:\nmock diff\n\nParameters:\n+ TaskCount: \"3\"\n",
+			wantedErr:  &errHasDiff{},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &svcDiffExecuteMock{
+				ws:                   mocks.NewMockwsWlDirReader(ctrl),
+				generator:            mocks.NewMockworkloadStackGenerator(ctrl),
+				interpolator:         mocks.NewMockinterpolator(ctrl),
+				envFeaturesDescriber: mocks.NewMockversionCompatibilityChecker(ctrl),
+				stackDescriber:       mocks.NewMockdeployedStackDescriber(ctrl),
+			}
+			m.ws.EXPECT().ReadWorkloadManifest("api").Return([]byte(mft), nil)
+			m.interpolator.EXPECT().Interpolate(mft).Return(mft, nil)
+			m.envFeaturesDescriber.EXPECT().Version().Return("v1.mock", nil)
+			m.envFeaturesDescriber.EXPECT().AvailableFeatures().Return([]string{}, nil)
+			template, parameters := "mystack", params
+			if tc.inTemplate != "" {
+				template = tc.inTemplate
+			}
+			if tc.inParams != "" {
+				parameters = tc.inParams
+			}
+			m.generator.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{
+				Template:   template,
+				Parameters: parameters,
+			}, nil)
+			tc.setupMocks(m)
+			m.stackDescriber.EXPECT().TemplateBody("phonetool-test-api").Return("mystack", nil).AnyTimes()
+			buf := new(bytes.Buffer)
+			opts := &diffSvcOpts{
+				packageSvcOpts: &packageSvcOpts{
+					packageSvcVars: packageSvcVars{
+						appName:            "phonetool",
+						name:               "api",
+						envName:            "test",
+						tag:                tc.inTag,
+						allowWkldDowngrade: true,
+						clientConfigured:   true,
+					},
+					diffWriter: buf,
+					ws:         m.ws,
+					unmarshal: func(b []byte) (manifest.DynamicWorkload, error) {
+						return &mockWorkloadMft{
+							mockRequiredEnvironmentFeatures: func() []string {
+								return []string{}
+							},
+						}, nil
+					},
+					newInterpolator: func(_, _ string) interpolator {
+						return m.interpolator
+					},
+					newStackGenerator: func(_ *packageSvcOpts) (workloadStackGenerator, error) {
+						return m.generator, nil
+					},
+					envFeaturesDescriber: m.envFeaturesDescriber,
+					targetApp:            &config.Application{},
+					targetEnv:            &config.Environment{},
+				},
+				stackDescriber: m.stackDescriber,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedDiff, buf.String())
+		})
+	}
+}
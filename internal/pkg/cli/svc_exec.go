@@ -6,11 +6,9 @@ package cli
 import (
 	"errors"
 	"fmt"
-	"math/rand"
+	"os"
 	"strings"
 
-	"github.com/aws/aws-sdk-go/service/ssm"
-	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -18,7 +16,6 @@ import (
 	"github.com/aws/copilot-cli/cmd/copilot/template"
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
-	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/exec"
@@ -27,12 +24,19 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 	"github.com/spf13/cobra"
+	"golang.org/x/term"
 )
 
 const (
 	svcExecNamePrompt     = "Into which service would you like to execute?"
 	svcExecNameHelpPrompt = `Copilot runs your command in one of your chosen service's tasks.
-The task is chosen at random, and the first essential container is used.`
+You will be asked to choose a task and a container if the service runs more than one,
+otherwise the first running task and the main container are used.`
+	svcExecTaskPrompt          = "Into which task would you like to execute?"
+	svcExecTaskHelpPrompt      = "Copilot runs your command in the task you select among the running tasks of the service."
+	svcExecContainerPrompt     = "Into which container would you like to execute?"
+	svcExecContainerHelpPrompt = `Copilot runs your command in the container you select.
+The main container of the service is listed first, followed by its sidecars.`
 
 	ssmPluginInstallPrompt = `Looks like the Session Manager plugin is not installed yet.
 Would you like to install the plugin to execute into the container?`
@@ -48,15 +52,15 @@ var (
 
 type svcExecOpts struct {
 	execVars
-	store              store
+	store              opsConfigStore
 	sel                deploySelector
 	newSvcDescriber    func(*session.Session) serviceDescriber
 	newCommandExecutor func(*session.Session) ecsCommandExecutor
 	ssmPluginManager   ssmPluginManager
 	prompter           prompter
 	sessProvider       sessionProvider
-	// Override in unit test
-	randInt func(int) int
+	containerSel       containerSelector
+	isTerminal         func() bool // Override in unit test.
 }
 
 func newSvcExecOpts(vars execVars) (*svcExecOpts, error) {
@@ -65,27 +69,31 @@ func newSvcExecOpts(vars execVars) (*svcExecOpts, error) {
 	if err != nil {
 		return nil, err
 	}
-	ssmStore := config.NewSSMStore(identity.New(defaultSession), ssm.New(defaultSession), aws.StringValue(defaultSession.Config.Region))
-	deployStore, err := deploy.NewStore(sessProvider, ssmStore)
+	configStore, err := newOpsConfigStore(vars.discover, defaultSession)
+	if err != nil {
+		return nil, err
+	}
+	deployStore, err := deploy.NewStore(sessProvider, configStore)
 	if err != nil {
 		return nil, fmt.Errorf("connect to deploy store: %w", err)
 	}
 	return &svcExecOpts{
 		execVars: vars,
-		store:    ssmStore,
-		sel:      selector.NewDeploySelect(prompt.New(), ssmStore, deployStore),
+		store:    configStore,
+		sel:      selector.NewDeploySelect(prompt.New(), configStore, deployStore),
 		newSvcDescriber: func(s *session.Session) serviceDescriber {
 			return ecs.New(s)
 		},
 		newCommandExecutor: func(s *session.Session) ecsCommandExecutor {
 			return awsecs.New(s)
 		},
-		randInt: func(x int) int {
-			return rand.Intn(x)
-		},
 		ssmPluginManager: exec.NewSSMPluginCommand(nil),
 		prompter:         prompt.New(),
 		sessProvider:     sessProvider,
+		containerSel:     selector.NewContainerSelector(prompt.New()),
+		isTerminal: func() bool {
+			return term.IsTerminal(int(os.Stdin.Fd()))
+		},
 	}, nil
 }
 
@@ -122,11 +130,18 @@ func (o *svcExecOpts) Execute() error {
 	if err != nil {
 		return fmt.Errorf("describe ECS service for %s in environment %s: %w", o.name, o.envName, err)
 	}
-	taskID, err := o.selectTask(awsecs.FilterRunningTasks(svcDesc.Tasks))
+	task, err := o.selectTask(awsecs.FilterRunningTasks(svcDesc.Tasks))
+	if err != nil {
+		return err
+	}
+	taskID, err := awsecs.TaskID(aws.StringValue(task.TaskArn))
+	if err != nil {
+		return err
+	}
+	container, err := o.selectContainer(task)
 	if err != nil {
 		return err
 	}
-	container := o.selectContainer()
 	log.Infof("Execute %s in container %s in task %s.\n", color.HighlightCode(o.command),
 		color.HighlightUserInput(container), color.HighlightResource(taskID))
 	if err = o.newCommandExecutor(sess).ExecuteCommand(awsecs.ExecuteCommandInput{
@@ -189,35 +204,51 @@ func (o *svcExecOpts) envSession() (*session.Session, error) {
 	return o.sessProvider.FromRole(env.ManagerRoleARN, env.Region)
 }
 
-func (o *svcExecOpts) selectTask(tasks []*awsecs.Task) (string, error) {
+func (o *svcExecOpts) selectTask(tasks []*awsecs.Task) (*awsecs.Task, error) {
 	if len(tasks) == 0 {
-		return "", fmt.Errorf("found no running task for service %s in environment %s", o.name, o.envName)
+		return nil, fmt.Errorf("found no running task for service %s in environment %s", o.name, o.envName)
 	}
 	if o.taskID != "" {
 		for _, task := range tasks {
 			taskID, err := awsecs.TaskID(aws.StringValue(task.TaskArn))
 			if err != nil {
-				return "", err
+				return nil, err
 			}
 			if strings.HasPrefix(taskID, o.taskID) {
-				return taskID, nil
+				return task, nil
 			}
 		}
-		return "", fmt.Errorf("found no running task whose ID is prefixed with %s", o.taskID)
+		return nil, fmt.Errorf("found no running task whose ID is prefixed with %s", o.taskID)
 	}
-	taskID, err := awsecs.TaskID(aws.StringValue(tasks[o.randInt(len(tasks))].TaskArn))
+	if !o.shouldPrompt() {
+		return tasks[0], nil
+	}
+	task, err := o.containerSel.Task(svcExecTaskPrompt, svcExecTaskHelpPrompt, tasks)
 	if err != nil {
-		return "", err
+		return nil, fmt.Errorf("select task: %w", err)
 	}
-	return taskID, nil
+	return task, nil
 }
 
-func (o *svcExecOpts) selectContainer() string {
+func (o *svcExecOpts) selectContainer(task *awsecs.Task) (string, error) {
 	if o.containerName != "" {
-		return o.containerName
+		return o.containerName, nil
 	}
 	// The first essential container is named with the workload name.
-	return o.name
+	if !o.shouldPrompt() {
+		return o.name, nil
+	}
+	container, err := o.containerSel.Container(svcExecContainerPrompt, svcExecContainerHelpPrompt, task, o.name)
+	if err != nil {
+		return "", fmt.Errorf("select container: %w", err)
+	}
+	return container, nil
+}
+
+// shouldPrompt returns true if the task and the container can be selected interactively:
+// neither of them is specified with a flag and stdin is a terminal.
+func (o *svcExecOpts) shouldPrompt() bool {
+	return o.taskID == "" && o.containerName == "" && o.isTerminal()
 }
 
 func validateSSMBinary(prompt prompter, manager ssmPluginManager, skipConfirmation *bool) error {
@@ -282,7 +313,9 @@ func buildSvcExecCmd() *cobra.Command {
   Start an interactive bash session with a task part of the "frontend" service.
   /code $ copilot svc exec -a my-app -e test -n frontend
   Runs the 'ls' command in the task prefixed with ID "8c38184" within the "backend" service.
-  /code $ copilot svc exec -a my-app -e test --name backend --task-id 8c38184 --command "ls"`,
+  /code $ copilot svc exec -a my-app -e test --name backend --task-id 8c38184 --command "ls"
+  Start an interactive bash session with the "nginx" sidecar of a task part of the "frontend" service.
+  /code $ copilot svc exec -a my-app -e test -n frontend --container nginx`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newSvcExecOpts(vars)
 			if err != nil {
@@ -303,8 +336,10 @@ func buildSvcExecCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.command, commandFlag, commandFlagShort, defaultCommand, execCommandFlagDescription)
 	cmd.Flags().StringVar(&vars.taskID, taskIDFlag, "", taskIDFlagDescription)
 	cmd.Flags().StringVar(&vars.containerName, containerFlag, "", containerFlagDescription)
+	cmd.Flags().StringVar(&vars.discover, discoverFlag, discoverSSM, discoverFlagDescription)
 	cmd.Flags().BoolVar(&skipPrompt, yesFlag, false, execYesFlagDescription)
 
 	cmd.SetUsageTemplate(template.Usage)
+	markAudited(cmd)
 	return cmd
 }
@@ -28,6 +28,7 @@ type execSvcMocks struct {
 	ecsCommandExecutor *mocks.MockecsCommandExecutor
 	ssmPluginManager   *mocks.MockssmPluginManager
 	prompter           *mocks.Mockprompter
+	containerSel       *mocks.MockcontainerSelector
 }
 
 func TestSvcExec_Validate(t *testing.T) {
@@ -412,9 +413,18 @@ func TestSvcExec_Execute(t *testing.T) {
 		Type: "Request-Driven Web Service",
 	}
 	mockError := errors.New("some error")
+	mockTask := &awsecs.Task{
+		TaskArn:    aws.String(mockTaskARN),
+		LastStatus: aws.String("RUNNING"),
+	}
+	mockOtherTask := &awsecs.Task{
+		TaskArn:    aws.String(mockOtherTaskARN),
+		LastStatus: aws.String("RUNNING"),
+	}
 	testCases := map[string]struct {
 		containerName string
 		taskID        string
+		inNoTerminal  bool
 		setupMocks    func(mocks execSvcMocks)
 
 		wantedError error
@@ -505,6 +515,126 @@ func TestSvcExec_Execute(t *testing.T) {
 			},
 			wantedError: fmt.Errorf("found no running task whose ID is prefixed with mockTaskID1"),
 		},
+		"return error if fail to select task": {
+			setupMocks: func(m execSvcMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().GetWorkload("mockApp", "mockSvc").Return(&mockWl, nil),
+					m.storeSvc.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{
+						Name: "my-env",
+					}, nil),
+					m.sessProvider.EXPECT().FromRole(gomock.Any(), gomock.Any()).Return(&session.Session{
+						Config: &aws.Config{
+							Region: aws.String("mockRegion"),
+						},
+					}, nil),
+					m.ecsSvcDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(&ecs.ServiceDesc{
+						Tasks: []*awsecs.Task{mockTask, mockOtherTask},
+					}, nil),
+					m.containerSel.EXPECT().Task(svcExecTaskPrompt, svcExecTaskHelpPrompt, []*awsecs.Task{mockTask, mockOtherTask}).Return(nil, mockError),
+				)
+			},
+			wantedError: fmt.Errorf("select task: some error"),
+		},
+		"return error if fail to select container": {
+			setupMocks: func(m execSvcMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().GetWorkload("mockApp", "mockSvc").Return(&mockWl, nil),
+					m.storeSvc.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{
+						Name: "my-env",
+					}, nil),
+					m.sessProvider.EXPECT().FromRole(gomock.Any(), gomock.Any()).Return(&session.Session{
+						Config: &aws.Config{
+							Region: aws.String("mockRegion"),
+						},
+					}, nil),
+					m.ecsSvcDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(&ecs.ServiceDesc{
+						Tasks: []*awsecs.Task{mockTask},
+					}, nil),
+					m.containerSel.EXPECT().Task(svcExecTaskPrompt, svcExecTaskHelpPrompt, []*awsecs.Task{mockTask}).Return(mockTask, nil),
+					m.containerSel.EXPECT().Container(svcExecContainerPrompt, svcExecContainerHelpPrompt, mockTask, "mockSvc").Return("", mockError),
+				)
+			},
+			wantedError: fmt.Errorf("select container: some error"),
+		},
+		"skip the prompts if the task and container are provided": {
+			taskID:        "mockTaskID1",
+			containerName: "nginx",
+			setupMocks: func(m execSvcMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().GetWorkload("mockApp", "mockSvc").Return(&mockWl, nil),
+					m.storeSvc.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{
+						Name: "my-env",
+					}, nil),
+					m.sessProvider.EXPECT().FromRole(gomock.Any(), gomock.Any()).Return(&session.Session{
+						Config: &aws.Config{
+							Region: aws.String("mockRegion"),
+						},
+					}, nil),
+					m.ecsSvcDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(&ecs.ServiceDesc{
+						ClusterName: "mockCluster",
+						Tasks:       []*awsecs.Task{mockTask, mockOtherTask},
+					}, nil),
+					m.ecsCommandExecutor.EXPECT().ExecuteCommand(awsecs.ExecuteCommandInput{
+						Cluster:   "mockCluster",
+						Container: "nginx",
+						Task:      "mockTaskID1",
+						Command:   "mockCommand",
+					}).Return(nil),
+				)
+			},
+		},
+		"default to the first running task and the main container if stdin is not a terminal": {
+			inNoTerminal: true,
+			setupMocks: func(m execSvcMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().GetWorkload("mockApp", "mockSvc").Return(&mockWl, nil),
+					m.storeSvc.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{
+						Name: "my-env",
+					}, nil),
+					m.sessProvider.EXPECT().FromRole(gomock.Any(), gomock.Any()).Return(&session.Session{
+						Config: &aws.Config{
+							Region: aws.String("mockRegion"),
+						},
+					}, nil),
+					m.ecsSvcDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(&ecs.ServiceDesc{
+						ClusterName: "mockCluster",
+						Tasks:       []*awsecs.Task{mockTask, mockOtherTask},
+					}, nil),
+					m.ecsCommandExecutor.EXPECT().ExecuteCommand(awsecs.ExecuteCommandInput{
+						Cluster:   "mockCluster",
+						Container: "mockSvc",
+						Task:      "mockTaskID",
+						Command:   "mockCommand",
+					}).Return(nil),
+				)
+			},
+		},
+		"default to the main container if only the task is provided": {
+			taskID: "mockTaskID1",
+			setupMocks: func(m execSvcMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().GetWorkload("mockApp", "mockSvc").Return(&mockWl, nil),
+					m.storeSvc.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{
+						Name: "my-env",
+					}, nil),
+					m.sessProvider.EXPECT().FromRole(gomock.Any(), gomock.Any()).Return(&session.Session{
+						Config: &aws.Config{
+							Region: aws.String("mockRegion"),
+						},
+					}, nil),
+					m.ecsSvcDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(&ecs.ServiceDesc{
+						ClusterName: "mockCluster",
+						Tasks:       []*awsecs.Task{mockTask, mockOtherTask},
+					}, nil),
+					m.ecsCommandExecutor.EXPECT().ExecuteCommand(awsecs.ExecuteCommandInput{
+						Cluster:   "mockCluster",
+						Container: "mockSvc",
+						Task:      "mockTaskID1",
+						Command:   "mockCommand",
+					}).Return(nil),
+				)
+			},
+		},
 		"return error if fail to execute command": {
 			containerName: "hello",
 			setupMocks: func(m execSvcMocks) {
@@ -520,12 +650,7 @@ func TestSvcExec_Execute(t *testing.T) {
 					}, nil),
 					m.ecsSvcDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(&ecs.ServiceDesc{
 						ClusterName: "mockCluster",
-						Tasks: []*awsecs.Task{
-							{
-								TaskArn:    aws.String(mockTaskARN),
-								LastStatus: aws.String("RUNNING"),
-							},
-						},
+						Tasks:       []*awsecs.Task{mockTask},
 					}, nil),
 					m.ecsCommandExecutor.EXPECT().ExecuteCommand(awsecs.ExecuteCommandInput{
 						Cluster:   "mockCluster",
@@ -551,21 +676,14 @@ func TestSvcExec_Execute(t *testing.T) {
 					}, nil),
 					m.ecsSvcDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(&ecs.ServiceDesc{
 						ClusterName: "mockCluster",
-						Tasks: []*awsecs.Task{
-							{
-								TaskArn:    aws.String(mockTaskARN),
-								LastStatus: aws.String("RUNNING"),
-							},
-							{
-								TaskArn:    aws.String(mockOtherTaskARN),
-								LastStatus: aws.String("RUNNING"),
-							},
-						},
+						Tasks:       []*awsecs.Task{mockTask, mockOtherTask},
 					}, nil),
+					m.containerSel.EXPECT().Task(svcExecTaskPrompt, svcExecTaskHelpPrompt, []*awsecs.Task{mockTask, mockOtherTask}).Return(mockOtherTask, nil),
+					m.containerSel.EXPECT().Container(svcExecContainerPrompt, svcExecContainerHelpPrompt, mockOtherTask, "mockSvc").Return("nginx", nil),
 					m.ecsCommandExecutor.EXPECT().ExecuteCommand(awsecs.ExecuteCommandInput{
 						Cluster:   "mockCluster",
-						Container: "mockSvc",
-						Task:      "mockTaskID",
+						Container: "nginx",
+						Task:      "mockTaskID1",
 						Command:   "mockCommand",
 					}).Return(nil),
 				)
@@ -582,6 +700,7 @@ func TestSvcExec_Execute(t *testing.T) {
 			mockSvcDescriber := mocks.NewMockserviceDescriber(ctrl)
 			mockCommandExecutor := mocks.NewMockecsCommandExecutor(ctrl)
 			mockSessionProvider := mocks.NewMocksessionProvider(ctrl)
+			mockContainerSel := mocks.NewMockcontainerSelector(ctrl)
 			mockNewSvcDescriber := func(_ *session.Session) serviceDescriber {
 				return mockSvcDescriber
 			}
@@ -594,6 +713,7 @@ func TestSvcExec_Execute(t *testing.T) {
 				ecsCommandExecutor: mockCommandExecutor,
 				ecsSvcDescriber:    mockSvcDescriber,
 				sessProvider:       mockSessionProvider,
+				containerSel:       mockContainerSel,
 			}
 
 			tc.setupMocks(mocks)
@@ -610,8 +730,11 @@ func TestSvcExec_Execute(t *testing.T) {
 				store:              mockStoreReader,
 				newSvcDescriber:    mockNewSvcDescriber,
 				newCommandExecutor: mockNewCommandExecutor,
-				randInt:            func(i int) int { return 0 },
 				sessProvider:       mockSessionProvider,
+				containerSel:       mockContainerSel,
+				isTerminal: func() bool {
+					return !tc.inNoTerminal
+				},
 			}
 
 			// WHEN
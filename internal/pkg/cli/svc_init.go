@@ -369,6 +369,11 @@ func (o *initSvcOpts) Ask() error {
 	return o.askSvcDetails()
 }
 
+// ValidateVersionPin returns an error if the application doesn't allow this version of Copilot to add services to it.
+func (o *initSvcOpts) ValidateVersionPin() error {
+	return validateVersionPin(o.store, o.appName, o.templateVersion, "")
+}
+
 // Execute writes the service's manifest file and stores the service in SSM.
 func (o *initSvcOpts) Execute() error {
 	if !o.allowAppDowngrade {
@@ -965,5 +970,6 @@ This command is also run as part of "copilot init".`,
 	cmd.Flags().StringArrayVar(&vars.sourcePaths, sourcesFlag, nil, sourcesFlagDescription)
 	cmd.Flags().BoolVar(&vars.allowAppDowngrade, allowDowngradeFlag, false, allowDowngradeFlagDescription)
 
+	markAudited(cmd)
 	return cmd
 }
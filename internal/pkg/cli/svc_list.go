@@ -9,12 +9,15 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
+	awscfn "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/spf13/afero"
 
 	"github.com/aws/copilot-cli/internal/pkg/cli/list"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
@@ -22,9 +25,10 @@ import (
 )
 
 type listWkldVars struct {
+	outputVars
 	appName                  string
-	shouldOutputJSON         bool
 	shouldShowLocalWorkloads bool
+	shouldShowDeployed       bool
 }
 
 type listSvcOpts struct {
@@ -33,6 +37,7 @@ type listSvcOpts struct {
 	// Interfaces to dependencies.
 	sel  appSelector
 	list workloadListWriter
+	out  *outputWriter // Converts the JSON output of the list writer to the output format. Nil if unused.
 }
 
 func newListSvcOpts(vars listWkldVars) (*listSvcOpts, error) {
@@ -41,32 +46,45 @@ func newListSvcOpts(vars listWkldVars) (*listSvcOpts, error) {
 		return nil, err
 	}
 
-	sess, err := sessions.ImmutableProvider(sessions.UserAgentExtras("svc ls")).Default()
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("svc ls"))
+	sess, err := sessProvider.Default()
 	if err != nil {
 		return nil, fmt.Errorf("default session: %v", err)
 	}
 
 	store := config.NewSSMStore(identity.New(sess), ssm.New(sess), aws.StringValue(sess.Config.Region))
+	deployStore, err := deploy.NewStore(sessProvider, store)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	out := newOutputWriter(os.Stdout, vars.format())
 	svcLister := &list.SvcListWriter{
-		Ws:    ws,
-		Store: store,
-		Out:   os.Stdout,
+		Ws:          ws,
+		Store:       store,
+		DeployStore: deployStore,
+		StackStatus: &wkldStackStatusGetter{
+			store:        store,
+			sessProvider: sessProvider,
+		},
+		Out: out,
 
 		ShowLocalSvcs: vars.shouldShowLocalWorkloads,
-		OutputJSON:    vars.shouldOutputJSON,
+		ShowDeployed:  vars.shouldShowDeployed,
+		OutputJSON:    vars.isStructured(),
 	}
 
 	return &listSvcOpts{
 		listWkldVars: vars,
 
 		list: svcLister,
+		out:  out,
 		sel:  selector.NewAppEnvSelector(prompt.New(), store),
 	}, nil
 }
 
-// Validate is a no-op for this command.
+// Validate returns an error if the output flags are invalid.
 func (o *listSvcOpts) Validate() error {
-	return nil
+	return o.validateOutput()
 }
 
 // Ask prompts for and validates any required flags.
@@ -90,10 +108,35 @@ func (o *listSvcOpts) Execute() error {
 	if err := o.list.Write(o.appName); err != nil {
 		return err
 	}
-
+	if o.out != nil {
+		return o.out.Flush()
+	}
 	return nil
 }
 
+// wkldStackStatusGetter retrieves the status of a workload stack with the environment manager role.
+type wkldStackStatusGetter struct {
+	store        environmentGetter
+	sessProvider sessionFromRoleProvider
+}
+
+// WorkloadStackStatus returns the status of the workload's stack in the environment.
+func (g *wkldStackStatusGetter) WorkloadStackStatus(appName, envName, wkldName string) (string, error) {
+	env, err := g.store.GetEnvironment(appName, envName)
+	if err != nil {
+		return "", fmt.Errorf("get environment %s configuration: %w", envName, err)
+	}
+	sess, err := g.sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return "", fmt.Errorf("create session from environment manager role: %w", err)
+	}
+	descr, err := awscfn.New(sess).Describe(stack.NameForWorkload(appName, envName, wkldName))
+	if err != nil {
+		return "", err
+	}
+	return aws.StringValue(descr.StackStatus), nil
+}
+
 // buildSvcListCmd builds the command for listing services in an appication.
 func buildSvcListCmd() *cobra.Command {
 	vars := listWkldVars{}
@@ -102,12 +145,17 @@ func buildSvcListCmd() *cobra.Command {
 		Short: "Lists all the services in an application.",
 		Example: `
   Lists all the services for the "myapp" application.
-  /code $ copilot svc ls --app myapp`,
+  /code $ copilot svc ls --app myapp
+  Shows the environments each service is deployed in, with the status of its stack.
+  /code $ copilot svc ls --deployed`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newListSvcOpts(vars)
 			if err != nil {
 				return err
 			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
 			if err := opts.Ask(); err != nil {
 				return err
 			}
@@ -115,7 +163,8 @@ func buildSvcListCmd() *cobra.Command {
 		}),
 	}
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
-	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	addOutputFlags(cmd.Flags(), &vars.outputVars)
 	cmd.Flags().BoolVar(&vars.shouldShowLocalWorkloads, localFlag, false, localSvcFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldShowDeployed, deployedFlag, false, deployedSvcFlagDescription)
 	return cmd
 }
@@ -26,8 +26,8 @@ func TestListSvcOpts_Execute(t *testing.T) {
 		"with successful call to list.Services": {
 			opts: listSvcOpts{
 				listWkldVars: listWkldVars{
-					shouldOutputJSON: true,
-					appName:          "coolapp",
+					outputVars: outputVars{shouldOutputJSON: true},
+					appName:    "coolapp",
 				},
 				list: mockLister,
 			},
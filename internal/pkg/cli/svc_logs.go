@@ -13,9 +13,6 @@ import (
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
 
-	"github.com/aws/aws-sdk-go/service/ssm"
-	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
@@ -25,6 +22,8 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/dustin/go-humanize/english"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 )
 
@@ -61,17 +60,64 @@ type svcLogsVars struct {
 	logGroup      string
 	containerName string
 	previous      bool
+
+	sinceTime  string        // Set instead of since if --since is a date.
+	until      time.Duration // Set instead of untilTime if --until is a relative duration.
+	untilTime  string
+	outputFile string
+	jsonFilter string
+	discover   string
 }
 
 type svcLogsOpts struct {
 	svcLogsVars
 	wkldLogOpts
 
+	fs afero.Fs
+
+	// Internal states.
+	filter *logging.JSONFilter
+
 	// Cached variables.
 	targetEnv     *config.Environment
 	targetSvcType string
 }
 
+// durationOrTimeValue is the value of a flag that accepts either a duration relative to now or a date in RFC3339 format.
+type durationOrTimeValue struct {
+	duration *time.Duration
+	time     *string
+}
+
+// String implements the pflag.Value interface.
+func (v *durationOrTimeValue) String() string {
+	if v.time != nil && *v.time != "" {
+		return *v.time
+	}
+	if v.duration != nil && *v.duration != 0 {
+		return v.duration.String()
+	}
+	return ""
+}
+
+// Set implements the pflag.Value interface.
+func (v *durationOrTimeValue) Set(value string) error {
+	if duration, err := time.ParseDuration(value); err == nil {
+		*v.duration, *v.time = duration, ""
+		return nil
+	}
+	if _, err := time.Parse(time.RFC3339, value); err != nil {
+		return fmt.Errorf("%q must be a duration like 5s, 2m, or 3h, or a date in RFC3339 format", value)
+	}
+	*v.duration, *v.time = 0, value
+	return nil
+}
+
+// Type implements the pflag.Value interface.
+func (v *durationOrTimeValue) Type() string {
+	return "string"
+}
+
 type wkldLogOpts struct {
 	// Internal states.
 	startTime *int64
@@ -79,7 +125,7 @@ type wkldLogOpts struct {
 
 	// Dependencies.
 	w                  io.Writer
-	configStore        store
+	configStore        opsConfigStore
 	sessProvider       sessionProvider
 	deployStore        deployedEnvironmentLister
 	sel                deploySelector
@@ -95,7 +141,10 @@ func newSvcLogOpts(vars svcLogsVars) (*svcLogsOpts, error) {
 		return nil, fmt.Errorf("default session: %v", err)
 	}
 
-	configStore := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	configStore, err := newOpsConfigStore(vars.discover, defaultSess)
+	if err != nil {
+		return nil, err
+	}
 	deployStore, err := deploy.NewStore(sessProvider, configStore)
 	if err != nil {
 		return nil, fmt.Errorf("connect to deploy store: %w", err)
@@ -108,6 +157,7 @@ func newSvcLogOpts(vars svcLogsVars) (*svcLogsOpts, error) {
 			deployStore: deployStore,
 			sel:         selector.NewDeploySelect(prompt.New(), configStore, deployStore),
 		},
+		fs: afero.NewOsFs(),
 	}
 	opts.initRuntimeClients = func() error {
 		env, err := opts.getTargetEnv()
@@ -144,7 +194,7 @@ func newSvcLogOpts(vars svcLogsVars) (*svcLogsOpts, error) {
 
 // Validate returns an error for any invalid optional flags.
 func (o *svcLogsOpts) Validate() error {
-	if o.since != 0 && o.humanStartTime != "" {
+	if (o.since != 0 || o.sinceTime != "") && o.humanStartTime != "" {
 		return errors.New("only one of --since or --start-time may be used")
 	}
 
@@ -152,6 +202,19 @@ func (o *svcLogsOpts) Validate() error {
 		return errors.New("only one of --follow or --end-time may be used")
 	}
 
+	hasUntil := o.until != 0 || o.untilTime != ""
+	if hasUntil && o.humanEndTime != "" {
+		return errors.New("only one of --until or --end-time may be used")
+	}
+
+	if hasUntil && o.follow {
+		return errors.New("only one of --follow or --until may be used")
+	}
+
+	if o.outputFile != "" && o.follow {
+		return errors.New("only one of --follow or --output-file may be used")
+	}
+
 	if o.since != 0 {
 		if o.since < 0 {
 			return fmt.Errorf("--since must be greater than 0")
@@ -160,6 +223,14 @@ func (o *svcLogsOpts) Validate() error {
 		o.startTime = parseSince(o.since)
 	}
 
+	if o.sinceTime != "" {
+		startTime, err := parseRFC3339(o.sinceTime)
+		if err != nil {
+			return fmt.Errorf(`invalid argument %s for "--since" flag: %w`, o.sinceTime, err)
+		}
+		o.startTime = aws.Int64(startTime)
+	}
+
 	if o.humanStartTime != "" {
 		startTime, err := parseRFC3339(o.humanStartTime)
 		if err != nil {
@@ -176,6 +247,25 @@ func (o *svcLogsOpts) Validate() error {
 		o.endTime = aws.Int64(endTime)
 	}
 
+	if o.until != 0 {
+		if o.until < 0 {
+			return fmt.Errorf("--until must be greater than 0")
+		}
+		o.endTime = parseSince(o.until)
+	}
+
+	if o.untilTime != "" {
+		endTime, err := parseRFC3339(o.untilTime)
+		if err != nil {
+			return fmt.Errorf(`invalid argument %s for "--until" flag: %w`, o.untilTime, err)
+		}
+		o.endTime = aws.Int64(endTime)
+	}
+
+	if o.startTime != nil && o.endTime != nil && *o.startTime > *o.endTime {
+		return errors.New("the start of the time range must be before its end")
+	}
+
 	if o.limit != 0 && (o.limit < cwGetLogEventsLimitMin || o.limit > cwGetLogEventsLimitMax) {
 		return fmt.Errorf("--limit %d is out-of-bounds, value must be between %d and %d", o.limit, cwGetLogEventsLimitMin, cwGetLogEventsLimitMax)
 	}
@@ -185,6 +275,14 @@ func (o *svcLogsOpts) Validate() error {
 			return err
 		}
 	}
+
+	if o.jsonFilter != "" {
+		filter, err := logging.ParseJSONFilter(o.jsonFilter)
+		if err != nil {
+			return fmt.Errorf(`invalid argument for "--%s" flag: %w`, jsonFilterFlag, err)
+		}
+		o.filter = filter
+	}
 	return nil
 }
 
@@ -221,6 +319,18 @@ func (o *svcLogsOpts) Execute() error {
 		o.taskIDs = []string{taskID}
 		log.Infoln("previously stopped task:", taskID)
 	}
+	var exported int
+	if o.outputFile != "" {
+		file, err := o.fs.Create(o.outputFile)
+		if err != nil {
+			return fmt.Errorf("create file %s: %w", o.outputFile, err)
+		}
+		defer file.Close()
+		eventsWriter = func(_ io.Writer, logs []logging.HumanJSONStringer) error {
+			exported += len(logs)
+			return logging.WriteJSONLogs(file, logs)
+		}
+	}
 	err := o.logsSvc.WriteLogEvents(logging.WriteLogEventsOpts{
 		Follow:        o.follow,
 		Limit:         limit,
@@ -228,12 +338,18 @@ func (o *svcLogsOpts) Execute() error {
 		StartTime:     o.startTime,
 		TaskIDs:       o.taskIDs,
 		OnEvents:      eventsWriter,
+		Filter:        o.filter,
 		ContainerName: o.containerName,
 		LogGroup:      o.logGroup,
+		// Export every log event in the time range unless the number of events is limited.
+		AllPages: o.outputFile != "" && limit == nil,
 	})
 	if err != nil {
 		return fmt.Errorf("write log events for service %s: %w", o.name, err)
 	}
+	if o.outputFile != "" {
+		log.Successf("Wrote %s of service %s to %s.\n", english.Plural(exported, "log event", ""), o.name, o.outputFile)
+	}
 	return nil
 }
 
@@ -344,11 +460,15 @@ func buildSvcLogsCmd() *cobra.Command {
   Displays logs in the last hour.
   /code $ copilot svc logs --since 1h
   Displays logs from 2006-01-02T15:04:05 to 2006-01-02T15:05:05.
-  /code $ copilot svc logs --start-time 2006-01-02T15:04:05+00:00 --end-time 2006-01-02T15:05:05+00:00
+  /code $ copilot svc logs --since 2006-01-02T15:04:05+00:00 --until 2006-01-02T15:05:05+00:00
+  Writes all the logs from two hours ago to one hour ago to a file.
+  /code $ copilot svc logs --since 2h --until 1h --output-file incident.jsonl
   Displays logs from specific task IDs.
   /code $ copilot svc logs --tasks 709c7eae05f947f6861b150372ddc443,1de57fd63c6a4920ac416d02add891b9
   Displays logs in real time.
   /code $ copilot svc logs --follow
+  Displays only the structured JSON logs of failed requests in real time.
+  /code $ copilot svc logs --follow --json-filter 'level=="error" && request.status>=500'
   Display logs from specific log group.
   /code $ copilot svc logs --log-group system`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
@@ -366,11 +486,15 @@ func buildSvcLogsCmd() *cobra.Command {
 	cmd.Flags().StringVar(&vars.humanEndTime, endTimeFlag, "", endTimeFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
 	cmd.Flags().BoolVar(&vars.follow, followFlag, false, followFlagDescription)
-	cmd.Flags().DurationVar(&vars.since, sinceFlag, 0, sinceFlagDescription)
+	cmd.Flags().Var(&durationOrTimeValue{duration: &vars.since, time: &vars.sinceTime}, sinceFlag, svcLogsSinceFlagDescription)
+	cmd.Flags().Var(&durationOrTimeValue{duration: &vars.until, time: &vars.untilTime}, untilFlag, untilFlagDescription)
+	cmd.Flags().StringVar(&vars.outputFile, outputFileFlag, "", outputFileFlagDescription)
 	cmd.Flags().IntVar(&vars.limit, limitFlag, 0, limitFlagDescription)
 	cmd.Flags().StringSliceVar(&vars.taskIDs, tasksFlag, nil, tasksLogsFlagDescription)
 	cmd.Flags().StringVar(&vars.logGroup, logGroupFlag, "", logGroupFlagDescription)
 	cmd.Flags().BoolVarP(&vars.previous, previousFlag, previousFlagShort, false, previousFlagDescription)
 	cmd.Flags().StringVar(&vars.containerName, containerLogFlag, "", containerLogFlagDescription)
+	cmd.Flags().StringVar(&vars.jsonFilter, jsonFilterFlag, "", jsonFilterFlagDescription)
+	cmd.Flags().StringVar(&vars.discover, discoverFlag, discoverSSM, discoverFlagDescription)
 	return cmd
 }
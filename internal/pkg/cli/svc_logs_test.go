@@ -6,10 +6,12 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"io"
 	"testing"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
@@ -20,6 +22,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 
 	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/require"
 )
 
@@ -48,8 +51,13 @@ func TestSvcLogs_Validate(t *testing.T) {
 		inputStartTime string
 		inputEndTime   string
 		inputSince     time.Duration
+		inputSinceTime string
+		inputUntil     time.Duration
+		inputUntilTime string
+		inputOutput    string
 		inputPrevious  bool
 		inputTaskIDs   []string
+		inputFilter    string
 
 		mockstore func(m *mocks.Mockstore)
 
@@ -58,6 +66,18 @@ func TestSvcLogs_Validate(t *testing.T) {
 		"with no flag set": {
 			mockstore: func(m *mocks.Mockstore) {},
 		},
+		"with a valid JSON filter": {
+			inputFilter: `level=="error" && request.status>=500`,
+
+			mockstore: func(m *mocks.Mockstore) {},
+		},
+		"returns error if the JSON filter is invalid": {
+			inputFilter: `level = "error"`,
+
+			mockstore: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf(`invalid argument for "--json-filter" flag: parse JSON filter "level = \"error\"": unexpected character '=' at position 7`),
+		},
 		"returns error if since and startTime flags are set together": {
 			inputSince:     mockSince,
 			inputStartTime: mockStartTime,
@@ -66,6 +86,60 @@ func TestSvcLogs_Validate(t *testing.T) {
 
 			wantedError: fmt.Errorf("only one of --since or --start-time may be used"),
 		},
+		"returns error if a since date and startTime flags are set together": {
+			inputSinceTime: mockStartTime,
+			inputStartTime: mockStartTime,
+
+			mockstore: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("only one of --since or --start-time may be used"),
+		},
+		"returns error if until and endTime flags are set together": {
+			inputUntil:   mockSince,
+			inputEndTime: mockEndTime,
+
+			mockstore: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("only one of --until or --end-time may be used"),
+		},
+		"returns error if follow and until flags are set together": {
+			inputFollow:    true,
+			inputUntilTime: mockEndTime,
+
+			mockstore: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("only one of --follow or --until may be used"),
+		},
+		"returns error if follow and output flags are set together": {
+			inputFollow: true,
+			inputOutput: "logs.jsonl",
+
+			mockstore: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("only one of --follow or --output-file may be used"),
+		},
+		"returns error if invalid until flag value": {
+			inputUntil: -mockSince,
+
+			mockstore: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("--until must be greater than 0"),
+		},
+		"returns error if the time range ends before it starts": {
+			inputSinceTime: mockEndTime,
+			inputUntilTime: mockStartTime,
+
+			mockstore: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("the start of the time range must be before its end"),
+		},
+		"with an absolute time range exported to a file": {
+			inputSinceTime: mockStartTime,
+			inputUntilTime: mockEndTime,
+			inputOutput:    "logs.jsonl",
+
+			mockstore: func(m *mocks.Mockstore) {},
+		},
 		"returns error if follow and endTime flags are set together": {
 			inputFollow:  true,
 			inputEndTime: mockEndTime,
@@ -140,7 +214,12 @@ func TestSvcLogs_Validate(t *testing.T) {
 						appName:        tc.inputApp,
 						taskIDs:        tc.inputTaskIDs,
 					},
-					previous: tc.inputPrevious,
+					previous:   tc.inputPrevious,
+					sinceTime:  tc.inputSinceTime,
+					until:      tc.inputUntil,
+					untilTime:  tc.inputUntilTime,
+					outputFile: tc.inputOutput,
+					jsonFilter: tc.inputFilter,
 				},
 				wkldLogOpts: wkldLogOpts{
 					configStore: mockstore,
@@ -155,6 +234,7 @@ func TestSvcLogs_Validate(t *testing.T) {
 				require.EqualError(t, err, tc.wantedError.Error())
 			} else {
 				require.NoError(t, err)
+				require.Equal(t, tc.inputFilter != "", svcLogs.filter != nil)
 			}
 		})
 	}
@@ -366,11 +446,33 @@ func TestSvcLogs_Execute(t *testing.T) {
 		inputPreviousTask bool
 		container         string
 		logGroup          string
+		outputFile        string
 
 		setupMocks func(mocks wkldLogsMock)
 
-		wantedError error
+		wantedError       error
+		wantedFileContent string
 	}{
+		"write all the log events in the time range to the output file": {
+			inputSvc:   "mockSvc",
+			endTime:    mockEndTime,
+			startTime:  mockStartTime,
+			outputFile: "logs.jsonl",
+			setupMocks: func(m wkldLogsMock) {
+				m.logSvcWriter.EXPECT().WriteLogEvents(gomock.Any()).DoAndReturn(func(param logging.WriteLogEventsOpts) error {
+					require.True(t, param.AllPages)
+					require.Nil(t, param.Limit)
+					return param.OnEvents(io.Discard, []logging.HumanJSONStringer{
+						&cloudwatchlogs.Event{
+							LogStreamName: "copilot/mockSvc/mockTaskID",
+							Message:       "hello",
+							Timestamp:     123456789,
+						},
+					})
+				})
+			},
+			wantedFileContent: `{"logStreamName":"copilot/mockSvc/mockTaskID","ingestionTime":0,"message":"hello","timestamp":123456789}` + "\n",
+		},
 		"success": {
 			inputSvc:  "mockSvc",
 			endTime:   mockEndTime,
@@ -534,6 +636,7 @@ func TestSvcLogs_Execute(t *testing.T) {
 			}
 
 			tc.setupMocks(mocks)
+			fs := afero.NewMemMapFs()
 
 			svcLogs := &svcLogsOpts{
 				svcLogsVars: svcLogsVars{
@@ -548,7 +651,9 @@ func TestSvcLogs_Execute(t *testing.T) {
 					previous:      tc.inputPreviousTask,
 					containerName: tc.container,
 					logGroup:      tc.logGroup,
+					outputFile:    tc.outputFile,
 				},
+				fs: fs,
 
 				wkldLogOpts: wkldLogOpts{
 					startTime:          &tc.startTime,
@@ -571,6 +676,51 @@ func TestSvcLogs_Execute(t *testing.T) {
 			} else {
 				require.NoError(t, err)
 			}
+			if tc.wantedFileContent != "" {
+				content, err := afero.ReadFile(fs, tc.outputFile)
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedFileContent, string(content))
+			}
+		})
+	}
+}
+
+func TestDurationOrTimeValue_Set(t *testing.T) {
+	testCases := map[string]struct {
+		in string
+
+		wantedDuration time.Duration
+		wantedTime     string
+		wantedError    error
+	}{
+		"relative duration": {
+			in:             "2h",
+			wantedDuration: 2 * time.Hour,
+		},
+		"date in RFC3339 format": {
+			in:         "2006-01-02T15:04:05+00:00",
+			wantedTime: "2006-01-02T15:04:05+00:00",
+		},
+		"error if neither a duration nor a date": {
+			in:          "yesterday",
+			wantedError: errors.New(`"yesterday" must be a duration like 5s, 2m, or 3h, or a date in RFC3339 format`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var duration time.Duration
+			var date string
+			v := &durationOrTimeValue{duration: &duration, time: &date}
+
+			err := v.Set(tc.in)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDuration, duration)
+			require.Equal(t, tc.wantedTime, date)
 		})
 	}
 }
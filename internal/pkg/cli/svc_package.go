@@ -26,6 +26,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	templatediff "github.com/aws/copilot-cli/internal/pkg/template/diff"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
@@ -43,6 +44,7 @@ type packageSvcVars struct {
 	tag                string
 	outputDir          string
 	uploadAssets       bool
+	registryCache      bool
 	showDiff           bool
 	allowWkldDowngrade bool
 
@@ -70,6 +72,7 @@ type packageSvcOpts struct {
 	newStackGenerator    func(*packageSvcOpts) (workloadStackGenerator, error)
 	envFeaturesDescriber versionCompatibilityChecker
 	gitShortCommit       string
+	gitBranch            string
 
 	// cached variables
 	targetApp         *config.Application
@@ -142,11 +145,13 @@ func newWorkloadStackGenerator(o *packageSvcOpts) (workloadStackGenerator, error
 		Image: clideploy.ContainerImageIdentifier{
 			CustomTag:         o.tag,
 			GitShortCommitTag: o.gitShortCommit,
+			GitBranch:         o.gitBranch,
 		},
 		Mft:              content,
 		RawMft:           o.rawMft,
 		EnvVersionGetter: o.envFeaturesDescriber,
 		Overrider:        ovrdr,
+		RegistryCache:    o.registryCache,
 	}
 	switch t := content.(type) {
 	case *manifest.LoadBalancedWebService:
@@ -172,6 +177,9 @@ func newWorkloadStackGenerator(o *packageSvcOpts) (workloadStackGenerator, error
 
 // Validate returns an error for any invalid optional flags.
 func (o *packageSvcOpts) Validate() error {
+	if o.registryCache && !o.uploadAssets {
+		return fmt.Errorf("--%s must be used with --%s", registryCacheFlag, uploadAssetsFlag)
+	}
 	return nil
 }
 
@@ -224,7 +232,7 @@ func (o *packageSvcOpts) Execute() error {
 		return err
 	}
 	if o.showDiff {
-		if err := diff(gen, stack.template, o.diffWriter); err != nil {
+		if err := diff(gen, stack.template, o.diffWriter, templatediff.FormatHuman); err != nil {
 			var errHasDiff *errHasDiff
 			if errors.As(err, &errHasDiff) {
 				return err
@@ -293,6 +301,7 @@ func (o *packageSvcOpts) validateOrAskEnvName() error {
 
 func (o *packageSvcOpts) configureClients() error {
 	o.gitShortCommit = imageTagFromGit(o.runner) // Best effort assign git tag.
+	o.gitBranch = gitBranch(o.runner)
 	// client to retrieve an application's resources created with CloudFormation.
 	defaultSess, err := o.sessProvider.Default()
 	if err != nil {
@@ -519,6 +528,7 @@ func buildSvcPackageCmd() *cobra.Command {
 	cmd.Flags().StringVar(&vars.tag, imageTagFlag, "", imageTagFlagDescription)
 	cmd.Flags().StringVar(&vars.outputDir, stackOutputDirFlag, "", stackOutputDirFlagDescription)
 	cmd.Flags().BoolVar(&vars.uploadAssets, uploadAssetsFlag, false, uploadAssetsFlagDescription)
+	cmd.Flags().BoolVar(&vars.registryCache, registryCacheFlag, false, registryCacheFlagDescription)
 	cmd.Flags().BoolVar(&vars.showDiff, diffFlag, false, diffFlagDescription)
 	cmd.Flags().BoolVar(&vars.allowWkldDowngrade, allowDowngradeFlag, false, allowDowngradeFlagDescription)
 
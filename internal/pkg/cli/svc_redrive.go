@@ -0,0 +1,251 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sqs"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/version"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/dustin/go-humanize/english"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcRedriveNamePrompt     = "Which worker service of %s would you like to redrive the dead-letter queues of?"
+	svcRedriveNameHelpPrompt = "The messages in the dead-letter queues of the service will be moved back to the queues they came from."
+
+	// Limit of the MaxNumberOfMessagesPerSecond parameter of StartMessageMoveTask.
+	maxRedriveRate = 500
+)
+
+type svcRedriveVars struct {
+	appName string
+	svcName string
+	envName string
+	rate    int
+	rateSet bool // Whether --rate was specified, otherwise Amazon SQS optimizes the rate.
+}
+
+type svcRedriveOpts struct {
+	svcRedriveVars
+
+	store        store
+	ws           wsEnvironmentsLister
+	sel          deploySelector
+	dlqDescriber deadLetterQueuesDescriber
+	mover        messageMover
+	envChecker   versionCompatibilityChecker
+	initClients  func() error
+}
+
+func newSvcRedriveOpts(vars svcRedriveVars) (*svcRedriveOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("svc redrive"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+	configStore := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	deployStore, err := deploy.NewStore(sessProvider, configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	ws, err := workspace.Use(afero.NewOsFs())
+	if err != nil {
+		return nil, err
+	}
+	opts := &svcRedriveOpts{
+		svcRedriveVars: vars,
+		store:          configStore,
+		ws:             ws,
+		sel:            selector.NewDeploySelect(prompt.New(), configStore, deployStore),
+	}
+	opts.initClients = func() error {
+		env, err := configStore.GetEnvironment(opts.appName, opts.envName)
+		if err != nil {
+			return fmt.Errorf("get environment %s: %w", opts.envName, err)
+		}
+		sess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+		if err != nil {
+			return fmt.Errorf("session for role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
+		}
+		d, err := describe.NewWorkerQueuesDescriber(&describe.NewServiceStatusConfig{
+			App:         opts.appName,
+			Env:         opts.envName,
+			Svc:         opts.svcName,
+			ConfigStore: configStore,
+		})
+		if err != nil {
+			return fmt.Errorf("create queues describer for service %s in application %s: %w", opts.svcName, opts.appName, err)
+		}
+		opts.dlqDescriber = d
+		opts.mover = sqs.New(sess)
+		envDescriber, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
+			App:         opts.appName,
+			Env:         opts.envName,
+			ConfigStore: configStore,
+		})
+		if err != nil {
+			return fmt.Errorf("new environment compatibility checker: %v", err)
+		}
+		opts.envChecker = envDescriber
+		return nil
+	}
+	return opts, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *svcRedriveOpts) Validate() error {
+	if o.rateSet && (o.rate < 1 || o.rate > maxRedriveRate) {
+		return fmt.Errorf("rate %d must be between 1 and %d messages per second", o.rate, maxRedriveRate)
+	}
+	return nil
+}
+
+// Ask prompts for and validates any required flags.
+func (o *svcRedriveOpts) Ask() error {
+	if err := o.validateOrAskApp(); err != nil {
+		return err
+	}
+	return o.validateAndAskSvcEnvName()
+}
+
+// ValidateVersionPin returns an error if the application doesn't allow this version of Copilot to redrive the messages of its worker services.
+func (o *svcRedriveOpts) ValidateVersionPin() error {
+	return validateVersionPin(o.store, o.appName, version.Version, "")
+}
+
+// Execute moves the messages of the dead-letter queues of the worker service back to their source queues.
+func (o *svcRedriveOpts) Execute() error {
+	if err := o.initClients(); err != nil {
+		return err
+	}
+	if err := validateMinEnvVersion(o.ws, o.envChecker, o.appName, o.envName, template.SvcRedriveMinEnvVersion, "svc redrive"); err != nil {
+		return err
+	}
+	queues, err := o.dlqDescriber.DeadLetterQueues()
+	if err != nil {
+		return fmt.Errorf("get dead-letter queues of service %s: %w", o.svcName, err)
+	}
+	if len(queues) == 0 {
+		return fmt.Errorf("service %s does not have a dead-letter queue in environment %s, configure %s in the manifest to create one",
+			o.svcName, o.envName, color.HighlightCode("subscribe.queue.dead_letter"))
+	}
+	for _, queue := range queues {
+		if queue.Messages == 0 {
+			log.Infof("Dead-letter queue %s is empty.\n", queue.Name)
+			continue
+		}
+		if _, err := o.mover.MoveMessages(sqs.MoveMessagesInput{
+			SourceARN:            queue.ARN,
+			MaxMessagesPerSecond: o.rate,
+		}); err != nil {
+			return fmt.Errorf("redrive dead-letter queue %s: %w", queue.Name, err)
+		}
+		log.Successf("Started moving %s from dead-letter queue %s back to its source queue.\n",
+			english.Plural(queue.Messages, "message", "messages"), queue.Name)
+	}
+	return nil
+}
+
+// RecommendActions returns follow-up actions the user can take after successfully executing the command.
+func (o *svcRedriveOpts) RecommendActions() error {
+	logRecommendedActions([]string{
+		fmt.Sprintf("Run %s to follow the number of messages left in the dead-letter queues.",
+			color.HighlightCode(fmt.Sprintf("copilot svc status -n %s -e %s", o.svcName, o.envName))),
+	})
+	return nil
+}
+
+func (o *svcRedriveOpts) validateOrAskApp() error {
+	if o.appName != "" {
+		_, err := o.store.GetApplication(o.appName)
+		return err
+	}
+	app, err := o.sel.Application(svcAppNamePrompt, wkldAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *svcRedriveOpts) validateAndAskSvcEnvName() error {
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	if o.svcName != "" {
+		svc, err := o.store.GetService(o.appName, o.svcName)
+		if err != nil {
+			return err
+		}
+		if svc.Type != manifestinfo.WorkerServiceType {
+			return fmt.Errorf("service %s is a %s, only a %s has dead-letter queues", o.svcName, svc.Type, manifestinfo.WorkerServiceType)
+		}
+	}
+	// Note: we let prompter handle the case when there is only option for user to choose from.
+	// This is naturally the case when `o.envName != "" && o.svcName != ""`.
+	deployedService, err := o.sel.DeployedService(
+		fmt.Sprintf(svcRedriveNamePrompt, color.HighlightUserInput(o.appName)),
+		svcRedriveNameHelpPrompt,
+		o.appName,
+		selector.WithEnv(o.envName),
+		selector.WithName(o.svcName),
+		selector.WithServiceTypesFilter([]string{manifestinfo.WorkerServiceType}),
+	)
+	if err != nil {
+		return fmt.Errorf("select deployed service for application %s: %w", o.appName, err)
+	}
+	o.svcName = deployedService.Name
+	o.envName = deployedService.Env
+	return nil
+}
+
+// buildSvcRedriveCmd builds the command for moving the messages of the dead-letter queues of a worker service back to their source queues.
+func buildSvcRedriveCmd() *cobra.Command {
+	vars := svcRedriveVars{}
+	cmd := &cobra.Command{
+		Use:   "redrive",
+		Short: "Moves the messages of a worker service's dead-letter queues back to their source queues.",
+		Long: `Moves the messages of a worker service's dead-letter queues back to their source queues,
+so that the service processes them again.`,
+
+		Example: `
+  Redrives the dead-letter queues of the "worker" service in the "prod" environment.
+  /code $ copilot svc redrive -n worker -e prod
+  Redrives at most 10 messages per second.
+  /code $ copilot svc redrive -n worker -e prod --rate 10`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			vars.rateSet = cmd.Flags().Changed(redriveRateFlag)
+			opts, err := newSvcRedriveOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().IntVar(&vars.rate, redriveRateFlag, 0, redriveRateFlagDescription)
+	markAudited(cmd)
+	return cmd
+}
@@ -0,0 +1,261 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/sqs"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSvcRedriveOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inRate    int
+		inRateSet bool
+
+		wantedError error
+	}{
+		"error if the rate is negative": {
+			inRate:      -1,
+			inRateSet:   true,
+			wantedError: errors.New("rate -1 must be between 1 and 500 messages per second"),
+		},
+		"error if the rate is zero": {
+			inRate:      0,
+			inRateSet:   true,
+			wantedError: errors.New("rate 0 must be between 1 and 500 messages per second"),
+		},
+		"error if the rate is above the limit": {
+			inRate:      501,
+			inRateSet:   true,
+			wantedError: errors.New("rate 501 must be between 1 and 500 messages per second"),
+		},
+		"success without a rate": {},
+		"success with a rate": {
+			inRate:    10,
+			inRateSet: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &svcRedriveOpts{
+				svcRedriveVars: svcRedriveVars{
+					rate:    tc.inRate,
+					rateSet: tc.inRateSet,
+				},
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSvcRedriveOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inApp string
+		inEnv string
+		inSvc string
+
+		setupMocks func(store *mocks.Mockstore, sel *mocks.MockdeploySelector)
+
+		wantedApp   string
+		wantedEnv   string
+		wantedSvc   string
+		wantedError error
+	}{
+		"error if the service is not a worker service": {
+			inApp: "phonetool",
+			inSvc: "api",
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockdeploySelector) {
+				store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				store.EXPECT().GetService("phonetool", "api").Return(&config.Workload{
+					Type: manifestinfo.BackendServiceType,
+				}, nil)
+			},
+			wantedError: errors.New("service api is a Backend Service, only a Worker Service has dead-letter queues"),
+		},
+		"error if fail to select a deployed service": {
+			inApp: "phonetool",
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockdeploySelector) {
+				store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				sel.EXPECT().DeployedService(gomock.Any(), svcRedriveNameHelpPrompt, "phonetool", gomock.Any()).
+					Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("select deployed service for application phonetool: some error"),
+		},
+		"success": {
+			inApp: "phonetool",
+			inEnv: "prod",
+			inSvc: "worker",
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockdeploySelector) {
+				store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				store.EXPECT().GetEnvironment("phonetool", "prod").Return(&config.Environment{}, nil)
+				store.EXPECT().GetService("phonetool", "worker").Return(&config.Workload{
+					Type: manifestinfo.WorkerServiceType,
+				}, nil)
+				sel.EXPECT().DeployedService(gomock.Any(), svcRedriveNameHelpPrompt, "phonetool", gomock.Any()).
+					Return(&selector.DeployedService{
+						Env:  "prod",
+						Name: "worker",
+					}, nil)
+			},
+			wantedApp: "phonetool",
+			wantedEnv: "prod",
+			wantedSvc: "worker",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mocks.NewMockstore(ctrl)
+			sel := mocks.NewMockdeploySelector(ctrl)
+			tc.setupMocks(store, sel)
+			opts := &svcRedriveOpts{
+				svcRedriveVars: svcRedriveVars{
+					appName: tc.inApp,
+					envName: tc.inEnv,
+					svcName: tc.inSvc,
+				},
+				store: store,
+				sel:   sel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedApp, opts.appName)
+			require.Equal(t, tc.wantedEnv, opts.envName)
+			require.Equal(t, tc.wantedSvc, opts.svcName)
+		})
+	}
+}
+
+type svcRedriveMocks struct {
+	dlqDescriber *mocks.MockdeadLetterQueuesDescriber
+	mover        *mocks.MockmessageMover
+	envChecker   *mocks.MockversionCompatibilityChecker
+}
+
+func TestSvcRedriveOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		inRate     int
+		setupMocks func(m svcRedriveMocks)
+
+		wantedError error
+	}{
+		"error if the environment does not support the redrive": {
+			setupMocks: func(m svcRedriveMocks) {
+				m.envChecker.EXPECT().Version().Return("v1.33.0", nil)
+				m.dlqDescriber.EXPECT().DeadLetterQueues().Times(0)
+			},
+			wantedError: errors.New(`environment "prod" is on version "v1.33.0" which does not support the "svc redrive" feature`),
+		},
+		"error if fail to get the dead-letter queues": {
+			setupMocks: func(m svcRedriveMocks) {
+				m.envChecker.EXPECT().Version().Return("v1.34.0", nil)
+				m.dlqDescriber.EXPECT().DeadLetterQueues().Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get dead-letter queues of service worker: some error"),
+		},
+		"error if the service does not have a dead-letter queue": {
+			setupMocks: func(m svcRedriveMocks) {
+				m.envChecker.EXPECT().Version().Return("v1.34.0", nil)
+				m.dlqDescriber.EXPECT().DeadLetterQueues().Return(nil, nil)
+			},
+			wantedError: errors.New("service worker does not have a dead-letter queue in environment prod, configure `subscribe.queue.dead_letter` in the manifest to create one"),
+		},
+		"error if fail to move the messages": {
+			setupMocks: func(m svcRedriveMocks) {
+				m.envChecker.EXPECT().Version().Return("v1.34.0", nil)
+				m.dlqDescriber.EXPECT().DeadLetterQueues().Return([]*describe.DeadLetterQueue{
+					{
+						Name:     "phonetool-prod-worker-DeadLetterQueue",
+						ARN:      "mockDLQARN",
+						Messages: 3,
+					},
+				}, nil)
+				m.mover.EXPECT().MoveMessages(gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("redrive dead-letter queue phonetool-prod-worker-DeadLetterQueue: some error"),
+		},
+		"skips empty dead-letter queues and applies the rate": {
+			inRate: 10,
+			setupMocks: func(m svcRedriveMocks) {
+				m.envChecker.EXPECT().Version().Return("v1.34.0", nil)
+				m.dlqDescriber.EXPECT().DeadLetterQueues().Return([]*describe.DeadLetterQueue{
+					{
+						Name: "phonetool-prod-worker-DeadLetterQueue",
+						ARN:  "mockDLQARN",
+					},
+					{
+						Name:     "phonetool-prod-worker-apiordersDeadLetterQueue",
+						ARN:      "mockTopicDLQARN",
+						Messages: 3,
+					},
+				}, nil)
+				m.mover.EXPECT().MoveMessages(sqs.MoveMessagesInput{
+					SourceARN:            "mockTopicDLQARN",
+					MaxMessagesPerSecond: 10,
+				}).Return("mockHandle", nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := svcRedriveMocks{
+				dlqDescriber: mocks.NewMockdeadLetterQueuesDescriber(ctrl),
+				mover:        mocks.NewMockmessageMover(ctrl),
+				envChecker:   mocks.NewMockversionCompatibilityChecker(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &svcRedriveOpts{
+				svcRedriveVars: svcRedriveVars{
+					appName: "phonetool",
+					envName: "prod",
+					svcName: "worker",
+					rate:    tc.inRate,
+				},
+				dlqDescriber: m.dlqDescriber,
+				mover:        m.mover,
+				envChecker:   m.envChecker,
+				initClients:  func() error { return nil },
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
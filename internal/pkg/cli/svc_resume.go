@@ -21,6 +21,7 @@ import (
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/version"
 	"github.com/spf13/cobra"
 )
 
@@ -64,6 +65,11 @@ func (o *resumeSvcOpts) Ask() error {
 	return o.validateAndAskSvcEnvName()
 }
 
+// ValidateVersionPin returns an error if the application doesn't allow this version of Copilot to resume its services.
+func (o *resumeSvcOpts) ValidateVersionPin() error {
+	return validateVersionPin(o.store, o.appName, version.Version, "")
+}
+
 // Execute resumes the service through the prompt.
 func (o *resumeSvcOpts) Execute() error {
 	if o.svcName == "" {
@@ -210,5 +216,6 @@ func buildSvcResumeCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
 	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	markAudited(cmd)
 	return cmd
 }
@@ -0,0 +1,273 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/version"
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcRollbackNamePrompt     = "Which service of %s would you like to roll back?"
+	svcRollbackNameHelpPrompt = "The service will be redeployed with the template and parameters of its previous deployment."
+
+	fmtSvcRollbackConfirmPrompt = "Are you sure you want to roll back %s in environment %s to its deployment from %s?"
+)
+
+type svcRollbackVars struct {
+	appName          string
+	svcName          string
+	envName          string
+	skipConfirmation bool
+}
+
+type svcRollbackOpts struct {
+	svcRollbackVars
+
+	store  store
+	sel    deploySelector
+	prompt prompter
+
+	// Sets up the clients to read the deployment history of the service and redeploy its stack.
+	initClients func() error
+	history     deploymentHistory
+	stacks      workloadStackRestorer
+	bucket      string
+	targetEnv   *config.Environment
+}
+
+func newSvcRollbackOpts(vars svcRollbackVars) (*svcRollbackOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("svc rollback"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+	configStore := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	deployStore, err := deploy.NewStore(sessProvider, configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	prompter := prompt.New()
+	opts := &svcRollbackOpts{
+		svcRollbackVars: vars,
+		store:           configStore,
+		sel:             selector.NewDeploySelect(prompter, configStore, deployStore),
+		prompt:          prompter,
+	}
+	opts.initClients = func() error {
+		env, err := configStore.GetEnvironment(opts.appName, opts.envName)
+		if err != nil {
+			return fmt.Errorf("get environment %s configuration: %w", opts.envName, err)
+		}
+		app, err := configStore.GetApplication(opts.appName)
+		if err != nil {
+			return fmt.Errorf("get application %s configuration: %w", opts.appName, err)
+		}
+		resources, err := cloudformation.New(defaultSess).GetAppResourcesByRegion(app, env.Region)
+		if err != nil {
+			return fmt.Errorf("get application %s resources from region %s: %w", app.Name, env.Region, err)
+		}
+		envSess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+		if err != nil {
+			return fmt.Errorf("create session with environment manager role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+		}
+		opts.targetEnv = env
+		opts.bucket = resources.S3Bucket
+		opts.history = deploy.NewDeploymentHistory(s3.New(envSess), resources.S3Bucket)
+		opts.stacks = cloudformation.New(envSess, cloudformation.WithProgressTracker(os.Stderr))
+		return nil
+	}
+	return opts, nil
+}
+
+// Validate is a no-op for this command.
+func (o *svcRollbackOpts) Validate() error {
+	return nil
+}
+
+// Ask prompts for and validates any required flags.
+func (o *svcRollbackOpts) Ask() error {
+	if err := o.validateOrAskApp(); err != nil {
+		return err
+	}
+	return o.validateAndAskSvcEnvName()
+}
+
+// ValidateVersionPin returns an error if the application doesn't allow this version of Copilot to roll back its services.
+func (o *svcRollbackOpts) ValidateVersionPin() error {
+	return validateVersionPin(o.store, o.appName, version.Version, "")
+}
+
+// Execute redeploys the service with the template and parameters of its previous deployment.
+func (o *svcRollbackOpts) Execute() error {
+	if err := o.initClients(); err != nil {
+		return err
+	}
+	stackName := stack.NameForWorkload(o.appName, o.envName, o.svcName)
+	revisions, err := o.history.Revisions(stackName)
+	if err != nil {
+		return err
+	}
+	if len(revisions) < 2 {
+		return fmt.Errorf("service %s has no previous deployment recorded in environment %s to roll back to", o.svcName, o.envName)
+	}
+	if err := o.validateDeployedRevision(stackName, revisions[len(revisions)-1]); err != nil {
+		return err
+	}
+	previous := revisions[len(revisions)-2]
+	if !o.skipConfirmation {
+		confirmed, err := o.prompt.Confirm(fmt.Sprintf(fmtSvcRollbackConfirmPrompt,
+			color.HighlightUserInput(o.svcName), color.HighlightUserInput(o.envName), humanize.Time(previous.DeployedAt)), "")
+		if err != nil {
+			return fmt.Errorf("confirm rollback of service %s: %w", o.svcName, err)
+		}
+		if !confirmed {
+			return nil
+		}
+	}
+	err = o.stacks.DeployService(cloudformation.NewDeployedStack(stackName, previous), o.bucket, false,
+		awscloudformation.WithRoleARN(o.targetEnv.ExecutionRoleARN))
+	var errEmptyCS *awscloudformation.ErrChangeSetEmpty
+	if err != nil && !errors.As(err, &errEmptyCS) {
+		return fmt.Errorf("roll back service %s: %w", o.svcName, err)
+	}
+	// Drop the rolled back deployment, so that rolling back again goes one deployment further back.
+	if err := o.history.Save(stackName, revisions[:len(revisions)-1]); err != nil {
+		log.Warningf("Failed to update the deployment history of service %s: %v\n", o.svcName, err)
+	}
+	log.Successf("Rolled back service %s in environment %s to its deployment from %s.\n",
+		color.HighlightUserInput(o.svcName), color.HighlightUserInput(o.envName), humanize.Time(previous.DeployedAt))
+	return nil
+}
+
+// validateDeployedRevision returns an error if the deployed stack isn't the latest deployment recorded in the history,
+// for example because the service was deployed by a pipeline, so that rolling back doesn't revert unrecorded changes.
+func (o *svcRollbackOpts) validateDeployedRevision(stackName string, latest deploy.DeploymentRevision) error {
+	deployed, err := o.stacks.DeployedStack(stackName)
+	if err != nil {
+		return fmt.Errorf("get deployed stack of service %s: %w", o.svcName, err)
+	}
+	tpl, err := deployed.Template()
+	if err != nil {
+		return fmt.Errorf("get deployed template of service %s: %w", o.svcName, err)
+	}
+	params, err := deployed.Parameters()
+	if err != nil {
+		return fmt.Errorf("get deployed parameters of service %s: %w", o.svcName, err)
+	}
+	deployedParams := make(map[string]string, len(params))
+	for _, param := range params {
+		deployedParams[aws.StringValue(param.ParameterKey)] = aws.StringValue(param.ParameterValue)
+	}
+	matches := tpl == latest.Template
+	for key, value := range latest.Parameters {
+		if deployedParams[key] != value {
+			matches = false
+		}
+	}
+	if !matches {
+		return fmt.Errorf(`service %s in environment %s was updated after its latest deployment recorded by "copilot svc deploy", for example by a pipeline or a deployment with --detach: run "copilot svc deploy -n %s -e %s" to deploy it again instead`,
+			o.svcName, o.envName, o.svcName, o.envName)
+	}
+	return nil
+}
+
+// RecommendActions returns follow-up actions the user can take after successfully executing the command.
+func (o *svcRollbackOpts) RecommendActions() error {
+	logRecommendedActions([]string{
+		fmt.Sprintf("Run %s to check the status of the service.",
+			color.HighlightCode(fmt.Sprintf("copilot svc status -n %s -e %s", o.svcName, o.envName))),
+		fmt.Sprintf("Fix the issue and run %s to deploy the service again.",
+			color.HighlightCode(fmt.Sprintf("copilot svc deploy -n %s -e %s", o.svcName, o.envName))),
+	})
+	return nil
+}
+
+func (o *svcRollbackOpts) validateOrAskApp() error {
+	if o.appName != "" {
+		_, err := o.store.GetApplication(o.appName)
+		return err
+	}
+	app, err := o.sel.Application(svcAppNamePrompt, wkldAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *svcRollbackOpts) validateAndAskSvcEnvName() error {
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	if o.svcName != "" {
+		if _, err := o.store.GetService(o.appName, o.svcName); err != nil {
+			return err
+		}
+	}
+	// Note: we let prompter handle the case when there is only option for user to choose from.
+	// This is naturally the case when `o.envName != "" && o.svcName != ""`.
+	deployedService, err := o.sel.DeployedService(
+		fmt.Sprintf(svcRollbackNamePrompt, color.HighlightUserInput(o.appName)),
+		svcRollbackNameHelpPrompt,
+		o.appName,
+		selector.WithEnv(o.envName),
+		selector.WithName(o.svcName),
+	)
+	if err != nil {
+		return fmt.Errorf("select deployed service for application %s: %w", o.appName, err)
+	}
+	o.svcName = deployedService.Name
+	o.envName = deployedService.Env
+	return nil
+}
+
+// buildSvcRollbackCmd builds the command for rolling back a service to its previous deployment.
+func buildSvcRollbackCmd() *cobra.Command {
+	vars := svcRollbackVars{}
+	cmd := &cobra.Command{
+		Use:   "rollback",
+		Short: "Rolls back a service to its previous deployment.",
+		Long: `Rolls back a service to its previous deployment.
+The service is redeployed with the template and parameters recorded by its previous "copilot svc deploy".
+Deployments by pipelines or with --detach are not recorded, and the service can't be rolled back after them.`,
+		Example: `
+  Rolls back the service named "api" in the "prod" environment.
+  /code $ copilot svc rollback --name api --env prod`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcRollbackOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	markAudited(cmd)
+	return cmd
+}
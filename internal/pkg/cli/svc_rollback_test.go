@@ -0,0 +1,239 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSvcRollbackOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inApp string
+		inEnv string
+		inSvc string
+
+		setupMocks func(store *mocks.Mockstore, sel *mocks.MockdeploySelector)
+
+		wantedApp   string
+		wantedEnv   string
+		wantedSvc   string
+		wantedError error
+	}{
+		"error if fail to select a deployed service": {
+			inApp: "phonetool",
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockdeploySelector) {
+				store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				sel.EXPECT().DeployedService(gomock.Any(), svcRollbackNameHelpPrompt, "phonetool", gomock.Any()).
+					Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("select deployed service for application phonetool: some error"),
+		},
+		"success": {
+			inApp: "phonetool",
+			inEnv: "prod",
+			inSvc: "api",
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockdeploySelector) {
+				store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				store.EXPECT().GetEnvironment("phonetool", "prod").Return(&config.Environment{}, nil)
+				store.EXPECT().GetService("phonetool", "api").Return(&config.Workload{}, nil)
+				sel.EXPECT().DeployedService(gomock.Any(), svcRollbackNameHelpPrompt, "phonetool", gomock.Any()).
+					Return(&selector.DeployedService{
+						Env:  "prod",
+						Name: "api",
+					}, nil)
+			},
+			wantedApp: "phonetool",
+			wantedEnv: "prod",
+			wantedSvc: "api",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mocks.NewMockstore(ctrl)
+			sel := mocks.NewMockdeploySelector(ctrl)
+			tc.setupMocks(store, sel)
+			opts := &svcRollbackOpts{
+				svcRollbackVars: svcRollbackVars{
+					appName: tc.inApp,
+					envName: tc.inEnv,
+					svcName: tc.inSvc,
+				},
+				store: store,
+				sel:   sel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedApp, opts.appName)
+			require.Equal(t, tc.wantedEnv, opts.envName)
+			require.Equal(t, tc.wantedSvc, opts.svcName)
+		})
+	}
+}
+
+type svcRollbackMocks struct {
+	history *mocks.MockdeploymentHistory
+	stacks  *mocks.MockworkloadStackRestorer
+	prompt  *mocks.Mockprompter
+}
+
+func TestSvcRollbackOpts_Execute(t *testing.T) {
+	v1 := deploy.DeploymentRevision{
+		Template:   "v1",
+		Parameters: map[string]string{"ContainerImage": "nginx:1"},
+		DeployedAt: time.Now().Add(-time.Hour),
+	}
+	v2 := deploy.DeploymentRevision{
+		Template:   "v2",
+		Parameters: map[string]string{"ContainerImage": "nginx:2"},
+		DeployedAt: time.Now(),
+	}
+	testCases := map[string]struct {
+		inSkipConfirmation bool
+		setupMocks         func(m svcRollbackMocks)
+
+		wantedError error
+	}{
+		"error if fail to get the deployment history": {
+			setupMocks: func(m svcRollbackMocks) {
+				m.history.EXPECT().Revisions("phonetool-prod-api").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"error if there is no previous deployment": {
+			setupMocks: func(m svcRollbackMocks) {
+				m.history.EXPECT().Revisions("phonetool-prod-api").Return([]deploy.DeploymentRevision{v2}, nil)
+			},
+			wantedError: errors.New("service api has no previous deployment recorded in environment prod to roll back to"),
+		},
+		"error if fail to get the deployed stack": {
+			setupMocks: func(m svcRollbackMocks) {
+				m.history.EXPECT().Revisions("phonetool-prod-api").Return([]deploy.DeploymentRevision{v1, v2}, nil)
+				m.stacks.EXPECT().DeployedStack("phonetool-prod-api").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get deployed stack of service api: some error"),
+		},
+		"error if the deployed template is not the latest recorded deployment": {
+			inSkipConfirmation: true,
+			setupMocks: func(m svcRollbackMocks) {
+				m.history.EXPECT().Revisions("phonetool-prod-api").Return([]deploy.DeploymentRevision{v1, v2}, nil)
+				m.stacks.EXPECT().DeployedStack("phonetool-prod-api").Return(cloudformation.NewDeployedStack("phonetool-prod-api", deploy.DeploymentRevision{
+					Template:   "v3",
+					Parameters: v2.Parameters,
+				}), nil)
+				m.stacks.EXPECT().DeployService(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedError: errors.New(`service api in environment prod was updated after its latest deployment recorded by "copilot svc deploy", for example by a pipeline or a deployment with --detach: run "copilot svc deploy -n api -e prod" to deploy it again instead`),
+		},
+		"error if the deployed parameters are not the latest recorded deployment": {
+			inSkipConfirmation: true,
+			setupMocks: func(m svcRollbackMocks) {
+				m.history.EXPECT().Revisions("phonetool-prod-api").Return([]deploy.DeploymentRevision{v1, v2}, nil)
+				m.stacks.EXPECT().DeployedStack("phonetool-prod-api").Return(cloudformation.NewDeployedStack("phonetool-prod-api", deploy.DeploymentRevision{
+					Template:   v2.Template,
+					Parameters: map[string]string{"ContainerImage": "nginx:3"},
+				}), nil)
+				m.stacks.EXPECT().DeployService(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedError: errors.New(`service api in environment prod was updated after its latest deployment recorded by "copilot svc deploy", for example by a pipeline or a deployment with --detach: run "copilot svc deploy -n api -e prod" to deploy it again instead`),
+		},
+		"does not roll back if the user declines": {
+			setupMocks: func(m svcRollbackMocks) {
+				m.history.EXPECT().Revisions("phonetool-prod-api").Return([]deploy.DeploymentRevision{v1, v2}, nil)
+				m.stacks.EXPECT().DeployedStack("phonetool-prod-api").Return(cloudformation.NewDeployedStack("phonetool-prod-api", v2), nil)
+				m.prompt.EXPECT().Confirm(gomock.Any(), "").Return(false, nil)
+				m.stacks.EXPECT().DeployService(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		"error if fail to redeploy the previous deployment": {
+			inSkipConfirmation: true,
+			setupMocks: func(m svcRollbackMocks) {
+				m.history.EXPECT().Revisions("phonetool-prod-api").Return([]deploy.DeploymentRevision{v1, v2}, nil)
+				m.stacks.EXPECT().DeployedStack("phonetool-prod-api").Return(cloudformation.NewDeployedStack("phonetool-prod-api", v2), nil)
+				m.stacks.EXPECT().DeployService(gomock.Any(), "mockBucket", false, gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("roll back service api: some error"),
+		},
+		"redeploys the previous deployment and drops the latest one from the history": {
+			setupMocks: func(m svcRollbackMocks) {
+				m.history.EXPECT().Revisions("phonetool-prod-api").Return([]deploy.DeploymentRevision{v1, v2}, nil)
+				m.stacks.EXPECT().DeployedStack("phonetool-prod-api").Return(cloudformation.NewDeployedStack("phonetool-prod-api", v2), nil)
+				m.prompt.EXPECT().Confirm(gomock.Any(), "").Return(true, nil)
+				m.stacks.EXPECT().DeployService(cloudformation.NewDeployedStack("phonetool-prod-api", v1), "mockBucket", false, gomock.Any()).Return(nil)
+				m.history.EXPECT().Save("phonetool-prod-api", []deploy.DeploymentRevision{v1}).Return(nil)
+			},
+		},
+		"drops the latest deployment if the stack is already at the previous deployment": {
+			inSkipConfirmation: true,
+			setupMocks: func(m svcRollbackMocks) {
+				m.history.EXPECT().Revisions("phonetool-prod-api").Return([]deploy.DeploymentRevision{v1, v2}, nil)
+				m.stacks.EXPECT().DeployedStack("phonetool-prod-api").Return(cloudformation.NewDeployedStack("phonetool-prod-api", v2), nil)
+				m.stacks.EXPECT().DeployService(gomock.Any(), "mockBucket", false, gomock.Any()).Return(awscloudformation.NewMockErrChangeSetEmpty())
+				m.history.EXPECT().Save("phonetool-prod-api", []deploy.DeploymentRevision{v1}).Return(nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := svcRollbackMocks{
+				history: mocks.NewMockdeploymentHistory(ctrl),
+				stacks:  mocks.NewMockworkloadStackRestorer(ctrl),
+				prompt:  mocks.NewMockprompter(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &svcRollbackOpts{
+				svcRollbackVars: svcRollbackVars{
+					appName:          "phonetool",
+					envName:          "prod",
+					svcName:          "api",
+					skipConfirmation: tc.inSkipConfirmation,
+				},
+				prompt: m.prompt,
+				initClients: func() error {
+					return nil
+				},
+				history: m.history,
+				stacks:  m.stacks,
+				bucket:  "mockBucket",
+				targetEnv: &config.Environment{
+					ExecutionRoleARN: "mockExecRole",
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
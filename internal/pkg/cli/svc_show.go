@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"path/filepath"
 	"strings"
 	"unicode"
 
@@ -23,31 +24,45 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 )
 
 const (
 	svcShowSvcNamePrompt     = "Which service of %s would you like to show?"
 	svcShowSvcNameHelpPrompt = "The details of a service will be shown (e.g., endpoint URL, CPU, Memory)."
+
+	defaultSvcEventsLimit = 20
+	maxSvcEventsLimit     = 100 // ECS keeps at most the last 100 events of a service.
 )
 
+var svcShowDiagramExtensions = []string{".mmd", ".mermaid", ".md"}
+
 type showSvcVars struct {
+	outputVars
 	appName               string
 	svcName               string
 	shouldOutputJSON      bool
 	shouldOutputResources bool
 	outputManifestForEnv  string
+	outputEventsForEnv    string
+	eventsLimit           int
+	diagramPath           string
 }
 
 type showSvcOpts struct {
 	showSvcVars
 
 	w             io.Writer
+	fs            afero.Fs
 	store         store
 	describer     workloadDescriber
 	sel           configSelector
 	initDescriber func() error // Overridden in tests.
 
+	eventsDescriber     statusDescriber
+	initEventsDescriber func() error // Overridden in tests.
+
 	// Cached variables.
 	targetSvc *config.Workload
 }
@@ -69,6 +84,7 @@ func newShowSvcOpts(vars showSvcVars) (*showSvcOpts, error) {
 		showSvcVars: vars,
 		store:       ssmStore,
 		w:           log.OutputWriter,
+		fs:          afero.NewOsFs(),
 		sel:         selector.NewConfigSelector(prompt.New(), ssmStore),
 	}
 	opts.initDescriber = func() error {
@@ -82,7 +98,7 @@ func newShowSvcOpts(vars showSvcVars) (*showSvcOpts, error) {
 			Svc:             opts.svcName,
 			ConfigStore:     ssmStore,
 			DeployStore:     deployStore,
-			EnableResources: opts.shouldOutputResources,
+			EnableResources: opts.shouldOutputResources || opts.diagramPath != "",
 		}
 		switch svc.Type {
 		case manifestinfo.LoadBalancedWebServiceType:
@@ -105,12 +121,46 @@ func newShowSvcOpts(vars showSvcVars) (*showSvcOpts, error) {
 		opts.describer = d
 		return nil
 	}
+	opts.initEventsDescriber = func() error {
+		svc, err := opts.getTargetSvc()
+		if err != nil {
+			return err
+		}
+		d, err := describe.NewServiceEventsDescriber(&describe.NewServiceEventsConfig{
+			App:          opts.appName,
+			Env:          opts.outputEventsForEnv,
+			Svc:          opts.svcName,
+			WorkloadType: svc.Type,
+			Limit:        opts.eventsLimit,
+			ConfigStore:  ssmStore,
+		})
+		if err != nil {
+			return fmt.Errorf("create events describer for service %s in application %s: %w", opts.svcName, opts.appName, err)
+		}
+		opts.eventsDescriber = d
+		return nil
+	}
 	return opts, nil
 }
 
 // Validate returns an error for any invalid optional flags.
 func (o *showSvcOpts) Validate() error {
-	return nil
+	if err := o.validateOutput(); err != nil {
+		return err
+	}
+	if o.outputEventsForEnv != "" && (o.eventsLimit <= 0 || o.eventsLimit > maxSvcEventsLimit) {
+		return fmt.Errorf("--%s must be between 1 and %d", limitFlag, maxSvcEventsLimit)
+	}
+	if o.diagramPath == "" {
+		return nil
+	}
+	ext := filepath.Ext(o.diagramPath)
+	for _, allowed := range svcShowDiagramExtensions {
+		if ext == allowed {
+			return nil
+		}
+	}
+	return fmt.Errorf("file %s passed to --%s must end with one of %s", o.diagramPath, diagramFlag, strings.Join(svcShowDiagramExtensions, ", "))
 }
 
 // Ask prompts for and validates any required flags.
@@ -118,7 +168,15 @@ func (o *showSvcOpts) Ask() error {
 	if err := o.validateOrAskApp(); err != nil {
 		return err
 	}
-	return o.validateOrAskSvcName()
+	if err := o.validateOrAskSvcName(); err != nil {
+		return err
+	}
+	if o.outputEventsForEnv != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.outputEventsForEnv); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // Execute shows the services through the prompt.
@@ -126,6 +184,9 @@ func (o *showSvcOpts) Execute() error {
 	if o.svcName == "" {
 		return nil
 	}
+	if o.outputEventsForEnv != "" {
+		return o.writeEvents()
+	}
 	if err := o.initDescriber(); err != nil {
 		return err
 	}
@@ -138,17 +199,10 @@ func (o *showSvcOpts) Execute() error {
 		return fmt.Errorf("describe service %s: %w", o.svcName, err)
 	}
 
-	if o.shouldOutputJSON {
-		data, err := svc.JSONString()
-		if err != nil {
-			return err
-		}
-		fmt.Fprint(o.w, data)
-	} else {
-		fmt.Fprint(o.w, svc.HumanString())
+	if o.diagramPath != "" {
+		return o.writeDiagram(svc)
 	}
-
-	return nil
+	return writeOutput(o.w, o.format(), svc)
 }
 
 func (o *showSvcOpts) validateOrAskApp() error {
@@ -205,6 +259,33 @@ func (o *showSvcOpts) writeManifest() error {
 	return nil
 }
 
+func (o *showSvcOpts) writeEvents() error {
+	if err := o.initEventsDescriber(); err != nil {
+		return err
+	}
+	events, err := o.eventsDescriber.Describe()
+	if err != nil {
+		return fmt.Errorf("describe events of service %s in environment %s: %w", o.svcName, o.outputEventsForEnv, err)
+	}
+	return writeOutput(o.w, o.format(), events)
+}
+
+func (o *showSvcOpts) writeDiagram(svc describe.HumanJSONStringer) error {
+	d, ok := svc.(describe.Diagrammer)
+	if !ok {
+		return fmt.Errorf("service %s does not support diagrams", o.svcName)
+	}
+	content := d.Mermaid()
+	if filepath.Ext(o.diagramPath) == ".md" {
+		content = fmt.Sprintf("```mermaid\n%s```\n", content)
+	}
+	if err := afero.WriteFile(o.fs, o.diagramPath, []byte(content), 0644); err != nil {
+		return fmt.Errorf("write diagram to %s: %w", o.diagramPath, err)
+	}
+	log.Successf("Wrote the diagram of service %s to %s.\n", color.HighlightUserInput(o.svcName), color.HighlightResource(o.diagramPath))
+	return nil
+}
+
 // buildSvcShowCmd builds the command for showing services in an application.
 func buildSvcShowCmd() *cobra.Command {
 	vars := showSvcVars{}
@@ -217,7 +298,11 @@ func buildSvcShowCmd() *cobra.Command {
   Print service configuration in deployed environments.
   /code $ copilot svc show -n api
   Print manifest file used for deploying service "api" in the "prod" environment.
-  /code $ copilot svc show -n api --manifest prod`,
+  /code $ copilot svc show -n api --manifest prod
+  Write a Mermaid diagram of the infrastructure of service "api" to a Markdown file.
+  /code $ copilot svc show -n api --diagram api.md
+  Print the last 50 stack, ECS service and alarm events of service "api" in the "prod" environment.
+  /code $ copilot svc show -n api --events prod --limit 50`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newShowSvcOpts(vars)
 			if err != nil {
@@ -228,11 +313,21 @@ func buildSvcShowCmd() *cobra.Command {
 	}
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
-	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	addOutputFlags(cmd.Flags(), &vars.outputVars)
 	cmd.Flags().BoolVar(&vars.shouldOutputResources, resourcesFlag, false, svcResourcesFlagDescription)
 	cmd.Flags().StringVar(&vars.outputManifestForEnv, manifestFlag, "", svcManifestFlagDescription)
+	cmd.Flags().StringVar(&vars.diagramPath, diagramFlag, "", svcDiagramFlagDescription)
+	cmd.Flags().StringVar(&vars.outputEventsForEnv, eventsFlag, "", svcEventsFlagDescription)
+	cmd.Flags().IntVar(&vars.eventsLimit, limitFlag, defaultSvcEventsLimit, svcEventsLimitFlagDescription)
 
 	cmd.MarkFlagsMutuallyExclusive(jsonFlag, manifestFlag)
 	cmd.MarkFlagsMutuallyExclusive(resourcesFlag, manifestFlag)
+	cmd.MarkFlagsMutuallyExclusive(jsonFlag, diagramFlag)
+	cmd.MarkFlagsMutuallyExclusive(outputFlag, manifestFlag)
+	cmd.MarkFlagsMutuallyExclusive(outputFlag, diagramFlag)
+	cmd.MarkFlagsMutuallyExclusive(manifestFlag, diagramFlag)
+	cmd.MarkFlagsMutuallyExclusive(eventsFlag, manifestFlag)
+	cmd.MarkFlagsMutuallyExclusive(eventsFlag, diagramFlag)
+	cmd.MarkFlagsMutuallyExclusive(eventsFlag, resourcesFlag)
 	return cmd
 }
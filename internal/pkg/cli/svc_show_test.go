@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/require"
 
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
@@ -17,10 +18,11 @@ import (
 )
 
 type showSvcMocks struct {
-	storeSvc  *mocks.Mockstore
-	describer *mocks.MockworkloadDescriber
-	ws        *mocks.MockwsSvcReader
-	sel       *mocks.MockconfigSelector
+	storeSvc        *mocks.Mockstore
+	describer       *mocks.MockworkloadDescriber
+	eventsDescriber *mocks.MockstatusDescriber
+	ws              *mocks.MockwsSvcReader
+	sel             *mocks.MockconfigSelector
 }
 
 type mockDescribeData struct {
@@ -36,14 +38,74 @@ func (m *mockDescribeData) JSONString() (string, error) {
 	return m.data, m.err
 }
 
+type mockDiagramData struct {
+	mockDescribeData
+	diagram string
+}
+
+func (m *mockDiagramData) Mermaid() string {
+	return m.diagram
+}
+
 func TestSvcShow_Validate(t *testing.T) {
-	// NOTE: no optional flag needs to be validated for this command.
+	testCases := map[string]struct {
+		inputDiagram     string
+		inputEventsEnv   string
+		inputEventsLimit int
+		wantedError      error
+	}{
+		"valid without a diagram": {},
+		"valid limit of events": {
+			inputEventsEnv:   "test",
+			inputEventsLimit: 100,
+		},
+		"error if the limit of events is not positive": {
+			inputEventsEnv:   "test",
+			inputEventsLimit: 0,
+			wantedError:      errors.New("--limit must be between 1 and 100"),
+		},
+		"error if the limit of events is greater than 100": {
+			inputEventsEnv:   "test",
+			inputEventsLimit: 101,
+			wantedError:      errors.New("--limit must be between 1 and 100"),
+		},
+		"valid with a mermaid diagram": {
+			inputDiagram: "docs/api.mmd",
+		},
+		"valid with a markdown diagram": {
+			inputDiagram: "docs/api.md",
+		},
+		"error if the diagram file has an unsupported extension": {
+			inputDiagram: "api.svg",
+			wantedError:  errors.New("file api.svg passed to --diagram must end with one of .mmd, .mermaid, .md"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &showSvcOpts{
+				showSvcVars: showSvcVars{
+					diagramPath:        tc.inputDiagram,
+					outputEventsForEnv: tc.inputEventsEnv,
+					eventsLimit:        tc.inputEventsLimit,
+				},
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
 }
 
 func TestSvcShow_Ask(t *testing.T) {
 	testCases := map[string]struct {
-		inputApp string
-		inputSvc string
+		inputApp       string
+		inputSvc       string
+		inputEventsEnv string
 
 		setupMocks func(mocks showSvcMocks)
 
@@ -61,6 +123,17 @@ func TestSvcShow_Ask(t *testing.T) {
 			wantedApp: "my-app",
 			wantedSvc: "my-svc",
 		},
+		"validate the environment of the events": {
+			inputApp:       "my-app",
+			inputSvc:       "my-svc",
+			inputEventsEnv: "test",
+			setupMocks: func(m showSvcMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{}, nil)
+				m.storeSvc.EXPECT().GetService("my-app", "my-svc").Return(&config.Workload{}, nil)
+				m.storeSvc.EXPECT().GetEnvironment("my-app", "test").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
 		"prompt for app name": {
 			inputSvc: "my-svc",
 			setupMocks: func(m showSvcMocks) {
@@ -119,8 +192,9 @@ func TestSvcShow_Ask(t *testing.T) {
 
 			showSvcs := &showSvcOpts{
 				showSvcVars: showSvcVars{
-					svcName: tc.inputSvc,
-					appName: tc.inputApp,
+					svcName:            tc.inputSvc,
+					appName:            tc.inputApp,
+					outputEventsForEnv: tc.inputEventsEnv,
 				},
 				store: mockStoreReader,
 				sel:   mockSelector,
@@ -147,16 +221,49 @@ func TestSvcShow_Execute(t *testing.T) {
 		data: "mockData",
 		err:  errors.New("some error"),
 	}
+	diagramSvc := mockDiagramData{
+		diagram: "flowchart LR\n",
+	}
 	testCases := map[string]struct {
 		inputSvc             string
 		shouldOutputJSON     bool
 		outputManifestForEnv string
+		outputEventsForEnv   string
+		inputDiagram         string
 
 		setupMocks func(mocks showSvcMocks)
 
 		wantedContent string
+		wantedDiagram string
 		wantedError   error
 	}{
+		"write the mermaid diagram if --diagram is provided": {
+			inputSvc:     "my-svc",
+			inputDiagram: "api.mmd",
+			setupMocks: func(m showSvcMocks) {
+				m.describer.EXPECT().Describe().Return(&diagramSvc, nil)
+			},
+
+			wantedDiagram: "flowchart LR\n",
+		},
+		"wrap the diagram in a code block for markdown files": {
+			inputSvc:     "my-svc",
+			inputDiagram: "api.md",
+			setupMocks: func(m showSvcMocks) {
+				m.describer.EXPECT().Describe().Return(&diagramSvc, nil)
+			},
+
+			wantedDiagram: "```mermaid\nflowchart LR\n```\n",
+		},
+		"return error if the service does not support diagrams": {
+			inputSvc:     "my-svc",
+			inputDiagram: "api.mmd",
+			setupMocks: func(m showSvcMocks) {
+				m.describer.EXPECT().Describe().Return(&webSvc, nil)
+			},
+
+			wantedError: errors.New("service my-svc does not support diagrams"),
+		},
 		"noop if service name is empty": {
 			setupMocks: func(m showSvcMocks) {
 				m.describer.EXPECT().Describe().Times(0)
@@ -182,6 +289,25 @@ func TestSvcShow_Execute(t *testing.T) {
 
 			wantedContent: "name: my-svc\n",
 		},
+		"print the timeline of events if --events is provided": {
+			inputSvc:           "my-svc",
+			outputEventsForEnv: "test",
+			setupMocks: func(m showSvcMocks) {
+				m.describer.EXPECT().Describe().Times(0)
+				m.eventsDescriber.EXPECT().Describe().Return(&mockDescribeData{data: "mockEvents"}, nil)
+			},
+
+			wantedContent: "mockEvents",
+		},
+		"return error if fail to describe the events of the service": {
+			inputSvc:           "my-svc",
+			outputEventsForEnv: "test",
+			setupMocks: func(m showSvcMocks) {
+				m.eventsDescriber.EXPECT().Describe().Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("describe events of service my-svc in environment test: some error"),
+		},
 		"return error if fail to generate JSON output": {
 			inputSvc:         "my-svc",
 			shouldOutputJSON: true,
@@ -224,8 +350,11 @@ func TestSvcShow_Execute(t *testing.T) {
 			b := &bytes.Buffer{}
 			mockSvcDescriber := mocks.NewMockworkloadDescriber(ctrl)
 
+			mockEventsDescriber := mocks.NewMockstatusDescriber(ctrl)
+
 			mocks := showSvcMocks{
-				describer: mockSvcDescriber,
+				describer:       mockSvcDescriber,
+				eventsDescriber: mockEventsDescriber,
 			}
 
 			tc.setupMocks(mocks)
@@ -234,12 +363,17 @@ func TestSvcShow_Execute(t *testing.T) {
 				showSvcVars: showSvcVars{
 					appName:              appName,
 					svcName:              tc.inputSvc,
-					shouldOutputJSON:     tc.shouldOutputJSON,
+					outputVars:           outputVars{shouldOutputJSON: tc.shouldOutputJSON},
 					outputManifestForEnv: tc.outputManifestForEnv,
+					outputEventsForEnv:   tc.outputEventsForEnv,
+					diagramPath:          tc.inputDiagram,
 				},
-				describer:     mockSvcDescriber,
-				initDescriber: func() error { return nil },
-				w:             b,
+				describer:           mockSvcDescriber,
+				initDescriber:       func() error { return nil },
+				eventsDescriber:     mockEventsDescriber,
+				initEventsDescriber: func() error { return nil },
+				w:                   b,
+				fs:                  afero.NewMemMapFs(),
 			}
 
 			// WHEN
@@ -251,6 +385,11 @@ func TestSvcShow_Execute(t *testing.T) {
 			} else {
 				require.NoError(t, err)
 				require.Equal(t, tc.wantedContent, b.String(), "expected output content match")
+				if tc.wantedDiagram != "" {
+					diagram, err := afero.ReadFile(showSvcs.fs, tc.inputDiagram)
+					require.NoError(t, err)
+					require.Equal(t, tc.wantedDiagram, string(diagram))
+				}
 			}
 		})
 	}
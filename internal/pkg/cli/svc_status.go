@@ -4,19 +4,23 @@
 package cli
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"time"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/ssm"
-	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
 
-	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 	"github.com/spf13/cobra"
@@ -25,20 +29,26 @@ import (
 const (
 	svcStatusNamePrompt     = "Which service's status would you like to show?"
 	svcStatusNameHelpPrompt = "Displays the service's task status, most recent deployment and alarm statuses."
+
+	defaultSvcStatusWatchInterval = 5 * time.Second
 )
 
 type svcStatusVars struct {
-	shouldOutputJSON bool
-	svcName          string
-	envName          string
-	appName          string
+	outputVars
+	svcName  string
+	envName  string
+	appName  string
+	discover string
+	watch    bool
 }
 
 type svcStatusOpts struct {
 	svcStatusVars
 
 	w                   io.Writer
-	store               store
+	watchOut            termprogress.FileWriter // Terminal where the status is refreshed in-place with --watch.
+	watchInterval       time.Duration
+	store               opsConfigStore
 	statusDescriber     statusDescriber
 	sel                 deploySelector
 	initStatusDescriber func(*svcStatusOpts) error
@@ -51,7 +61,10 @@ func newSvcStatusOpts(vars svcStatusVars) (*svcStatusOpts, error) {
 		return nil, fmt.Errorf("default session: %v", err)
 	}
 
-	configStore := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	configStore, err := newOpsConfigStore(vars.discover, defaultSess)
+	if err != nil {
+		return nil, err
+	}
 	deployStore, err := deploy.NewStore(sessProvider, configStore)
 	if err != nil {
 		return nil, fmt.Errorf("connect to deploy store: %w", err)
@@ -60,6 +73,8 @@ func newSvcStatusOpts(vars svcStatusVars) (*svcStatusOpts, error) {
 		svcStatusVars: vars,
 		store:         configStore,
 		w:             log.OutputWriter,
+		watchOut:      os.Stdout,
+		watchInterval: defaultSvcStatusWatchInterval,
 		sel:           selector.NewDeploySelect(prompt.New(), configStore, deployStore),
 		initStatusDescriber: func(o *svcStatusOpts) error {
 			wkld, err := configStore.GetWorkload(o.appName, o.svcName)
@@ -89,6 +104,17 @@ func newSvcStatusOpts(vars svcStatusVars) (*svcStatusOpts, error) {
 					return fmt.Errorf("create status describer for Static Site service %s in application %s: %w", o.svcName, o.appName, err)
 				}
 				o.statusDescriber = d
+			case manifestinfo.WorkerServiceType:
+				d, err := describe.NewWorkerStatusDescriber(&describe.NewServiceStatusConfig{
+					App:         o.appName,
+					Env:         o.envName,
+					Svc:         o.svcName,
+					ConfigStore: configStore,
+				})
+				if err != nil {
+					return fmt.Errorf("create status describer for Worker Service %s in application %s: %w", o.svcName, o.appName, err)
+				}
+				o.statusDescriber = d
 			default:
 				d, err := describe.NewECSStatusDescriber(&describe.NewServiceStatusConfig{
 					App:         o.appName,
@@ -108,6 +134,15 @@ func newSvcStatusOpts(vars svcStatusVars) (*svcStatusOpts, error) {
 
 // Validate returns an error for any invalid optional flags.
 func (o *svcStatusOpts) Validate() error {
+	if err := o.validateOutput(); err != nil {
+		return err
+	}
+	if !o.watch {
+		return nil
+	}
+	if o.isStructured() {
+		return fmt.Errorf("--%s cannot be specified with the %s output", watchFlag, o.format())
+	}
 	return nil
 }
 
@@ -125,20 +160,32 @@ func (o *svcStatusOpts) Execute() error {
 	if err != nil {
 		return err
 	}
+	if o.watch {
+		return o.watchStatus()
+	}
 	svcStatus, err := o.statusDescriber.Describe()
 	if err != nil {
 		return fmt.Errorf("describe status of service %s: %w", o.svcName, err)
 	}
-	if o.shouldOutputJSON {
-		data, err := svcStatus.JSONString()
-		if err != nil {
-			return err
+	return writeOutput(o.w, o.format(), svcStatus)
+}
+
+// watchStatus refreshes the status of the service in-place until the user interrupts the command.
+func (o *svcStatusOpts) watchStatus() error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	r := newSvcStatusRenderer(o.statusDescriber, o.watchInterval)
+	go r.watch(ctx)
+	if _, err := termprogress.Render(ctx, termprogress.NewTabbedFileWriter(o.watchOut), r); err != nil {
+		if errors.Is(err, context.Canceled) {
+			return nil
 		}
-		fmt.Fprint(o.w, data)
-	} else {
-		fmt.Fprint(o.w, svcStatus.HumanString())
+		return fmt.Errorf("render status of service %s: %w", o.svcName, err)
+	}
+	if err := r.Err(); err != nil {
+		return fmt.Errorf("describe status of service %s: %w", o.svcName, err)
 	}
-
 	return nil
 }
 
@@ -178,17 +225,92 @@ func (o *svcStatusOpts) validateAndAskSvcEnvName() error {
 	return nil
 }
 
+// svcStatusRenderer is a termprogress.DynamicRenderer that describes the status of a service at every interval
+// and renders the latest status.
+type svcStatusRenderer struct {
+	describer statusDescriber
+	interval  time.Duration
+	now       func() time.Time
+
+	mu          sync.Mutex
+	status      string
+	refreshedAt time.Time
+	err         error
+	done        chan struct{}
+}
+
+func newSvcStatusRenderer(describer statusDescriber, interval time.Duration) *svcStatusRenderer {
+	return &svcStatusRenderer{
+		describer: describer,
+		interval:  interval,
+		now:       time.Now,
+		done:      make(chan struct{}),
+	}
+}
+
+// watch describes the status of the service until ctx is canceled or a description fails.
+func (r *svcStatusRenderer) watch(ctx context.Context) {
+	defer close(r.done)
+	for {
+		status, err := r.describer.Describe()
+		r.mu.Lock()
+		if err != nil {
+			r.err = err
+			r.mu.Unlock()
+			return
+		}
+		r.status = status.HumanString()
+		r.refreshedAt = r.now()
+		r.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(r.interval):
+		}
+	}
+}
+
+// Render writes the latest status of the service to out and returns the number of lines written.
+func (r *svcStatusRenderer) Render(out io.Writer) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.refreshedAt.IsZero() {
+		return 0, nil
+	}
+	text := fmt.Sprintf("Refreshed every %s, last at %s. Press Ctrl-C to stop.\n\n%s", r.interval, r.refreshedAt.Format(time.TimeOnly), r.status)
+	if _, err := fmt.Fprint(out, text); err != nil {
+		return 0, err
+	}
+	return strings.Count(text, "\n"), nil
+}
+
+// Done returns a channel that's closed once the renderer stops describing the service.
+func (r *svcStatusRenderer) Done() <-chan struct{} {
+	return r.done
+}
+
+// Err returns the error that stopped the renderer, if any.
+func (r *svcStatusRenderer) Err() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.err
+}
+
 // buildSvcStatusCmd builds the command for showing the status of a deployed service.
 func buildSvcStatusCmd() *cobra.Command {
 	vars := svcStatusVars{}
 	cmd := &cobra.Command{
 		Use:   "status",
 		Short: "Shows status of a deployed service.",
-		Long:  "Shows status of a deployed service's task status, most recent deployment and alarm statuses.",
+		Long: `Shows status of a deployed service's task status, most recent deployment and alarm statuses.
+With --watch, refreshes the status every few seconds until interrupted.`,
 
 		Example: `
   Shows status of the deployed service "my-svc"
-  /code $ copilot svc status -n my-svc`,
+  /code $ copilot svc status -n my-svc
+  Refreshes the status of the service "my-svc" in the "prod" environment until interrupted
+  /code $ copilot svc status -n my-svc -e prod --watch`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newSvcStatusOpts(vars)
 			if err != nil {
@@ -200,6 +322,8 @@ func buildSvcStatusCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
 	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
-	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	addOutputFlags(cmd.Flags(), &vars.outputVars)
+	cmd.Flags().StringVar(&vars.discover, discoverFlag, discoverSSM, discoverFlagDescription)
+	cmd.Flags().BoolVar(&vars.watch, watchFlag, false, svcStatusWatchFlagDescription)
 	return cmd
 }
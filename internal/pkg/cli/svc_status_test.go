@@ -8,17 +8,52 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 )
 
 func TestSvcStatus_Validate(t *testing.T) {
-	// NOTE: No optional flag to `copilot svc pause` needs to be validated.
+	testCases := map[string]struct {
+		watch  bool
+		output outputVars
+
+		wantedError error
+	}{
+		"errors if --watch is specified with a structured output": {
+			watch:  true,
+			output: outputVars{shouldOutputJSON: true},
+
+			wantedError: fmt.Errorf("--watch cannot be specified with the json output"),
+		},
+		"valid --watch": {
+			watch: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &svcStatusOpts{
+				svcStatusVars: svcStatusVars{
+					outputVars: tc.output,
+					watch:      tc.watch,
+				},
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
 }
 
 type svcStatusAskMock struct {
@@ -179,10 +214,10 @@ func TestSvcStatus_Execute(t *testing.T) {
 
 			svcStatus := &svcStatusOpts{
 				svcStatusVars: svcStatusVars{
-					svcName:          "mockSvc",
-					envName:          "mockEnv",
-					shouldOutputJSON: tc.shouldOutputJSON,
-					appName:          "mockApp",
+					svcName:    "mockSvc",
+					envName:    "mockEnv",
+					outputVars: outputVars{shouldOutputJSON: tc.shouldOutputJSON},
+					appName:    "mockApp",
 				},
 				statusDescriber:     mockStatusDescriber,
 				initStatusDescriber: func(*svcStatusOpts) error { return nil },
@@ -202,3 +237,42 @@ func TestSvcStatus_Execute(t *testing.T) {
 		})
 	}
 }
+
+type svcStatusMockTerminal struct {
+	bytes.Buffer
+}
+
+func (t *svcStatusMockTerminal) Fd() uintptr { return 0 }
+
+func TestSvcStatus_ExecuteWatch(t *testing.T) {
+	t.Run("refreshes the status until the service can't be described", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := mocks.NewMockstatusDescriber(ctrl)
+		gomock.InOrder(
+			m.EXPECT().Describe().Return(&describe.ServiceEvents{}, nil).Times(2),
+			m.EXPECT().Describe().Return(nil, errors.New("some error")),
+		)
+		term := &svcStatusMockTerminal{}
+		opts := &svcStatusOpts{
+			svcStatusVars: svcStatusVars{
+				svcName: "mockSvc",
+				envName: "mockEnv",
+				appName: "mockApp",
+				watch:   true,
+			},
+			statusDescriber:     m,
+			initStatusDescriber: func(*svcStatusOpts) error { return nil },
+			watchOut:            term,
+			watchInterval:       time.Millisecond,
+		}
+
+		// WHEN
+		err := opts.Execute()
+
+		// THEN
+		require.EqualError(t, err, "describe status of service mockSvc: some error")
+		require.Contains(t, term.String(), "Refreshed every 1ms, last at ")
+	})
+}
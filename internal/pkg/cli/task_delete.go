@@ -28,6 +28,7 @@ import (
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/version"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
 
 	"github.com/spf13/cobra"
@@ -336,6 +337,15 @@ func (o *deleteTaskOpts) askTaskName() error {
 	return nil
 }
 
+// ValidateVersionPin returns an error if the application doesn't allow this version of Copilot to delete tasks from its environments.
+// Tasks that aren't run in an application's environment aren't pinned.
+func (o *deleteTaskOpts) ValidateVersionPin() error {
+	if o.app == "" {
+		return nil
+	}
+	return validateVersionPin(o.store, o.app, version.Version, "")
+}
+
 func (o *deleteTaskOpts) Execute() error {
 	if err := o.stopTasks(); err != nil {
 		return err
@@ -504,5 +514,6 @@ func BuildTaskDeleteCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.env, envFlag, envFlagShort, "", envFlagDescription)
 	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
 	cmd.Flags().BoolVar(&vars.defaultCluster, taskDefaultFlag, false, taskDeleteDefaultFlagDescription)
+	markAudited(cmd)
 	return cmd
 }
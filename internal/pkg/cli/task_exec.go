@@ -42,6 +42,9 @@ Select %s to execute in a task running in your default cluster instead of any ex
 type taskExecVars struct {
 	execVars
 	useDefault bool
+	tags       map[string]string
+	startedBy  string
+	latest     bool
 }
 
 type taskExecOpts struct {
@@ -87,6 +90,9 @@ func (o *taskExecOpts) Validate() error {
 	if o.useDefault && (o.appName != tryReadingAppName() || o.envName != "") {
 		return fmt.Errorf("cannot specify both default flag and app or env flags")
 	}
+	if o.latest && o.taskID != "" {
+		return fmt.Errorf("cannot specify both --%s and --%s", latestFlag, taskIDFlag)
+	}
 	if o.appName != "" {
 		if _, err := o.store.GetApplication(o.appName); err != nil {
 			return err
@@ -160,7 +166,7 @@ func (o *taskExecOpts) selectTaskInDefaultCluster() error {
 		return fmt.Errorf("create default session: %w", err)
 	}
 	task, err := o.newTaskSel(sess).RunningTask(taskExecTaskPrompt, taskExecTaskHelpPrompt,
-		selector.WithDefault(), selector.WithTaskGroup(o.name), selector.WithTaskID(o.taskID))
+		append([]selector.TaskOpts{selector.WithDefault()}, o.taskFilterOpts()...)...)
 	if err != nil {
 		return fmt.Errorf("select running task in default cluster: %w", err)
 	}
@@ -178,7 +184,7 @@ func (o *taskExecOpts) selectTaskInAppEnvCluster() error {
 		return fmt.Errorf("get session from role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
 	}
 	task, err := o.newTaskSel(sess).RunningTask(taskExecTaskPrompt, taskExecTaskHelpPrompt,
-		selector.WithAppEnv(o.appName, o.envName), selector.WithTaskGroup(o.name), selector.WithTaskID(o.taskID))
+		append([]selector.TaskOpts{selector.WithAppEnv(o.appName, o.envName)}, o.taskFilterOpts()...)...)
 	if err != nil {
 		return fmt.Errorf("select running task in environment %s: %w", o.envName, err)
 	}
@@ -186,6 +192,21 @@ func (o *taskExecOpts) selectTaskInAppEnvCluster() error {
 	return nil
 }
 
+// taskFilterOpts returns the options to narrow down the running tasks to select from.
+func (o *taskExecOpts) taskFilterOpts() []selector.TaskOpts {
+	opts := []selector.TaskOpts{selector.WithTaskGroup(o.name), selector.WithTaskID(o.taskID)}
+	if len(o.tags) != 0 {
+		opts = append(opts, selector.WithTaskTags(o.tags))
+	}
+	if o.startedBy != "" {
+		opts = append(opts, selector.WithStartedBy(o.startedBy))
+	}
+	if o.latest {
+		opts = append(opts, selector.WithLatest())
+	}
+	return opts
+}
+
 func (o *taskExecOpts) configSession() (*session.Session, error) {
 	if o.useDefault {
 		return o.provider.Default()
@@ -210,7 +231,9 @@ func buildTaskExecCmd() *cobra.Command {
   Runs the 'cat progress.csv' command in the task prefixed with ID "1848c38" part of the "db-migrate" task group.
   /code $ copilot task exec --name db-migrate --task-id 1848c38 --command "cat progress.csv"
   Start an interactive bash session with a task prefixed with ID "38c3818" in the default cluster.
-  /code $ copilot task exec --default --task-id 38c3818`,
+  /code $ copilot task exec --default --task-id 38c3818
+  Start an interactive bash session with the most recently started task tagged with "team=payments".
+  /code $ copilot task exec -e test --resource-tags team=payments --latest`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newTaskExecOpts(vars)
 			if err != nil {
@@ -231,8 +254,12 @@ func buildTaskExecCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.command, commandFlag, commandFlagShort, defaultCommand, execCommandFlagDescription)
 	cmd.Flags().StringVar(&vars.taskID, taskIDFlag, "", taskIDFlagDescription)
 	cmd.Flags().BoolVar(&vars.useDefault, taskDefaultFlag, false, taskExecDefaultFlagDescription)
+	cmd.Flags().StringToStringVar(&vars.tags, resourceTagsFlag, nil, taskExecResourceTagsFlagDescription)
+	cmd.Flags().StringVar(&vars.startedBy, startedByFlag, "", taskExecStartedByFlagDescription)
+	cmd.Flags().BoolVar(&vars.latest, latestFlag, false, taskExecLatestFlagDescription)
 	cmd.Flags().BoolVar(&skipPrompt, yesFlag, false, execYesFlagDescription)
 
 	cmd.SetUsageTemplate(template.Usage)
+	markAudited(cmd)
 	return cmd
 }
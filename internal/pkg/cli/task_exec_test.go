@@ -38,7 +38,9 @@ func TestTaskExec_Validate(t *testing.T) {
 		inApp       string
 		inEnv       string
 		inTaskGroup string
+		inTaskID    string
 		useDefault  bool
+		latest      bool
 		setupMocks  func(mocks execTaskMocks)
 
 		wantedError error
@@ -57,6 +59,13 @@ func TestTaskExec_Validate(t *testing.T) {
 
 			wantedError: fmt.Errorf("cannot specify both default flag and app or env flags"),
 		},
+		"should bubble error if specify both latest and task id": {
+			inTaskID:   "1848c38",
+			latest:     true,
+			setupMocks: func(m execTaskMocks) {},
+
+			wantedError: fmt.Errorf("cannot specify both --latest and --task-id"),
+		},
 		"should bubble error if failed to get app": {
 			inApp: mockApp,
 			setupMocks: func(m execTaskMocks) {
@@ -112,8 +121,10 @@ func TestTaskExec_Validate(t *testing.T) {
 						name:    tc.inTaskGroup,
 						appName: tc.inApp,
 						envName: tc.inEnv,
+						taskID:  tc.inTaskID,
 					},
 					useDefault: tc.useDefault,
+					latest:     tc.latest,
 				},
 				store:            mockStoreReader,
 				ssmPluginManager: mockSSMValidator,
@@ -147,6 +158,9 @@ func TestTaskExec_Ask(t *testing.T) {
 		inTaskGroup string
 		inTaskID    string
 		useDefault  bool
+		inTags      map[string]string
+		inStartedBy string
+		latest      bool
 		setupMocks  func(mocks execTaskMocks)
 
 		wantedError      error
@@ -236,6 +250,22 @@ func TestTaskExec_Ask(t *testing.T) {
 					gomock.Any(), gomock.Any(), gomock.Any()).Return(mockTask, nil)
 			},
 
+			wantedTask:       mockTask,
+			wantedUseDefault: false,
+		},
+		"success with tag, started by, and latest selectors": {
+			inApp:       mockApp,
+			inEnv:       mockEnv,
+			inTags:      map[string]string{"team": "payments"},
+			inStartedBy: "ci",
+			latest:      true,
+			setupMocks: func(m execTaskMocks) {
+				m.storeSvc.EXPECT().GetEnvironment(mockApp, mockEnv).Return(&config.Environment{}, nil)
+				m.provider.EXPECT().FromRole(gomock.Any(), gomock.Any())
+				m.taskSel.EXPECT().RunningTask(taskExecTaskPrompt, taskExecTaskHelpPrompt,
+					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockTask, nil)
+			},
+
 			wantedTask:       mockTask,
 			wantedUseDefault: false,
 		},
@@ -271,6 +301,9 @@ func TestTaskExec_Ask(t *testing.T) {
 						taskID:  tc.inTaskID,
 					},
 					useDefault: tc.useDefault,
+					tags:       tc.inTags,
+					startedBy:  tc.inStartedBy,
+					latest:     tc.latest,
 				},
 				store:      mockStoreReader,
 				newTaskSel: mockNewTaskSel,
@@ -10,8 +10,15 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codebuild"
 	"github.com/aws/copilot-cli/internal/pkg/aws/partitions"
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/exec"
@@ -26,6 +33,7 @@ import (
 
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
 
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
 
@@ -40,10 +48,12 @@ import (
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/efs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/repository"
 	"github.com/aws/copilot-cli/internal/pkg/task"
@@ -51,6 +61,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/version"
 
 	"github.com/dustin/go-humanize/english"
 	"github.com/google/shlex"
@@ -83,6 +94,11 @@ const (
 	fmtImageURI = "%s:%s"
 )
 
+var (
+	efsVolumeNameRegexp   = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,255}$`)
+	efsFileSystemIDRegexp = regexp.MustCompile(`^fs-[0-9a-f]+$`)
+)
+
 var (
 	errNumNotPositive = errors.New("number of tasks must be positive")
 	errCPUNotPositive = errors.New("CPU units must be positive")
@@ -134,12 +150,26 @@ type runTaskVars struct {
 	command                  string
 	entrypoint               string
 	resourceTags             map[string]string
+	efsVolumes               []string
+	efsFrom                  string
 
 	follow                bool
+	output                string
+	timeout               time.Duration
 	generateCommandTarget string
+	remote                bool
 
-	os   string
-	arch string
+	// Set by the task runner of an environment to restrict the IAM roles that the task can create.
+	permissionsBoundary string
+	roleNamePrefix      string
+
+	// Set by the task actions of a pipeline to run the task with the run-task role of the environment.
+	envRoleARN string
+
+	os               string
+	arch             string
+	launchType       string
+	capacityProvider string
 }
 
 type runTaskOpts struct {
@@ -161,14 +191,20 @@ type runTaskOpts struct {
 	eventsWriter         eventsWriter
 	defaultClusterGetter defaultClusterGetter
 	publicIPGetter       publicIPGetter
+	remoteRunner         remoteTaskRunner
+	resultWaiter         taskResultWaiter
+	efsDescriber         efsFileSystemDescriber
+	mftReader            manifestReader
 
 	provider          sessionProvider
 	sess              *session.Session
 	targetEnvironment *config.Environment
 
 	// Configurer functions.
-	configureRuntimeOpts func() error
-	configureRepository  func() error
+	configureRuntimeOpts  func() error
+	configureRepository   func() error
+	configureMftReader    func() error
+	configureRemoteRunner func(args []string)
 	// NOTE: configureEventsWriter is only called when tailing logs (i.e. --follow is specified)
 	configureEventsWriter func(tasks []*task.Task)
 
@@ -186,6 +222,7 @@ type runTaskOpts struct {
 	ssmParamSecrets         map[string]string
 	secretsManagerSecrets   map[string]string
 	envFileARN              string
+	efs                     *taskEFSConfig
 	envCompatibilityChecker func(app, env string) (versionCompatibilityChecker, error)
 }
 
@@ -219,6 +256,13 @@ func newTaskRunOpts(vars runTaskVars) (*runTaskOpts, error) {
 		opts.deployer = cloudformation.New(opts.sess, cloudformation.WithProgressTracker(os.Stderr))
 		opts.defaultClusterGetter = awsecs.New(opts.sess)
 		opts.publicIPGetter = ec2.New(opts.sess)
+		opts.efsDescriber = efs.New(opts.sess)
+		opts.resultWaiter = &task.ResultWaiter{
+			GroupName: opts.groupName,
+			Region:    aws.StringValue(opts.sess.Config.Region),
+			Describer: awsecs.New(opts.sess),
+			Timeout:   opts.timeout,
+		}
 		return nil
 	}
 
@@ -228,6 +272,25 @@ func newTaskRunOpts(vars runTaskVars) (*runTaskOpts, error) {
 		return nil
 	}
 
+	opts.configureMftReader = func() error {
+		ws, err := workspace.Use(afero.NewOsFs())
+		if err != nil {
+			return err
+		}
+		opts.mftReader = ws
+		return nil
+	}
+
+	opts.configureRemoteRunner = func(args []string) {
+		opts.remoteRunner = &task.RemoteRunner{
+			Project: stack.NameForTaskRunner(opts.appName, opts.env),
+			Args:    args,
+			Builds:  codebuild.New(opts.sess),
+			Logs:    cloudwatchlogs.New(opts.sess),
+			Writer:  log.OutputWriter,
+		}
+	}
+
 	opts.configureEventsWriter = func(tasks []*task.Task) {
 		opts.eventsWriter = logging.NewTaskClient(opts.sess, opts.groupName, tasks)
 	}
@@ -293,7 +356,9 @@ func (o *runTaskOpts) configureRunner() (taskRunner, error) {
 
 			SecurityGroups: o.securityGroups,
 
-			OS: o.os,
+			OS:               o.os,
+			LaunchType:       o.launchType,
+			CapacityProvider: o.capacityProvider,
 
 			VPCGetter:             vpcGetter,
 			ClusterGetter:         ecsClient,
@@ -311,6 +376,9 @@ func (o *runTaskOpts) configureRunner() (taskRunner, error) {
 		SecurityGroups: o.securityGroups,
 		OS:             o.os,
 
+		LaunchType:       o.launchType,
+		CapacityProvider: o.capacityProvider,
+
 		VPCGetter:             vpcGetter,
 		ClusterGetter:         ecsService,
 		Starter:               ecsService,
@@ -330,9 +398,13 @@ func (o *runTaskOpts) configureSessAndEnv() error {
 			return err
 		}
 
-		sess, err = o.provider.FromRole(env.ManagerRoleARN, env.Region)
+		roleARN := env.ManagerRoleARN
+		if o.envRoleARN != "" {
+			roleARN = o.envRoleARN
+		}
+		sess, err = o.provider.FromRole(roleARN, env.Region)
 		if err != nil {
-			return fmt.Errorf("get session from role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
+			return fmt.Errorf("get session from role %s and region %s: %w", roleARN, env.Region, err)
 		}
 	} else {
 		var err error
@@ -359,6 +431,14 @@ func (o *runTaskOpts) Validate() error {
 		return errNumNotPositive
 	}
 
+	if err := o.validateFlagsWithRemote(); err != nil {
+		return err
+	}
+
+	if err := o.validateOutput(); err != nil {
+		return err
+	}
+
 	if o.groupName != "" {
 		if err := basicNameValidation(o.groupName); err != nil {
 			return err
@@ -420,6 +500,10 @@ func (o *runTaskOpts) Validate() error {
 		return err
 	}
 
+	if err := o.validateLaunchType(); err != nil {
+		return err
+	}
+
 	if o.appName != "" {
 		if err := o.validateAppName(); err != nil {
 			return err
@@ -444,9 +528,48 @@ func (o *runTaskOpts) Validate() error {
 		}
 	}
 
+	if _, err := parseEFSVolumes(o.efsVolumes); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// parseEFSVolumes parses the values of the --efs flag formatted as "volume-name:fs-id:/container/path".
+func parseEFSVolumes(values []string) ([]deploy.TaskEFSVolume, error) {
+	var volumes []deploy.TaskEFSVolume
+	names, paths := make(map[string]bool), make(map[string]bool)
+	for _, value := range values {
+		parts := strings.SplitN(value, ":", 3)
+		if len(parts) != 3 {
+			return nil, fmt.Errorf("value %q of --%s must be formatted as volume-name:fs-id:/container/path", value, efsFlag)
+		}
+		vol := deploy.TaskEFSVolume{
+			Name:          parts[0],
+			FileSystemID:  parts[1],
+			ContainerPath: parts[2],
+		}
+		if !efsVolumeNameRegexp.MatchString(vol.Name) {
+			return nil, fmt.Errorf("volume name %q of --%s can only contain letters, numbers, hyphens and underscores", vol.Name, efsFlag)
+		}
+		if !efsFileSystemIDRegexp.MatchString(vol.FileSystemID) {
+			return nil, fmt.Errorf("file system ID %q of --%s must be formatted as fs-<id>", vol.FileSystemID, efsFlag)
+		}
+		if !strings.HasPrefix(vol.ContainerPath, "/") {
+			return nil, fmt.Errorf("container path %q of --%s must be an absolute path", vol.ContainerPath, efsFlag)
+		}
+		if names[vol.Name] {
+			return nil, fmt.Errorf("volume name %q is specified more than once in --%s", vol.Name, efsFlag)
+		}
+		if paths[vol.ContainerPath] {
+			return nil, fmt.Errorf("container path %q is specified more than once in --%s", vol.ContainerPath, efsFlag)
+		}
+		names[vol.Name], paths[vol.ContainerPath] = true, true
+		volumes = append(volumes, vol)
+	}
+	return volumes, nil
+}
+
 func isSSM(value string) bool {
 	// For SSM parameter you can specify it as ARN or name if it exists in the same Region as the task you are launching.
 	return !template.IsARNFunc(value) || strings.Contains(value, ":ssm:")
@@ -615,6 +738,68 @@ func (o *runTaskOpts) validateFlagsWithSubnets() error {
 	return nil
 }
 
+func (o *runTaskOpts) validateFlagsWithRemote() error {
+	if !o.remote {
+		return nil
+	}
+	if o.appName == "" || o.env == "" {
+		return errors.New("must specify both `--app` and `--env` with `--remote`")
+	}
+	if o.image == "" {
+		return errors.New("must specify `--image` with `--remote`")
+	}
+	incompatible := []struct {
+		flag  string
+		isSet bool
+	}{
+		{dockerFileFlag, o.isDockerfileSet},
+		{dockerFileContextFlag, o.dockerfileContextPath != ""},
+		{envFileFlag, o.envFile != ""},
+		{clusterFlag, o.cluster != ""},
+		{subnetsFlag, o.subnets != nil},
+		{securityGroupsFlag, o.securityGroups != nil},
+		{taskDefaultFlag, o.useDefaultSubnetsAndCluster},
+		{generateCommandFlag, o.generateCommandTarget != ""},
+		{launchTypeFlag, o.launchType != ""},
+		{capacityProviderFlag, o.capacityProvider != ""},
+	}
+	for _, f := range incompatible {
+		if f.isSet {
+			return fmt.Errorf("cannot specify both `--%s` and `--%s`", remoteFlag, f.flag)
+		}
+	}
+	return nil
+}
+
+func (o *runTaskOpts) validateOutput() error {
+	if o.timeout < 0 {
+		return fmt.Errorf("`--%s` must not be negative", timeoutFlag)
+	}
+	if o.output == "" {
+		if o.timeout != 0 {
+			return fmt.Errorf("`--%s` can only be specified with `--%s`", timeoutFlag, outputFlag)
+		}
+		return nil
+	}
+	if o.output != outputFormatJSON {
+		return fmt.Errorf("invalid `--%s` value %q: must be %q", outputFlag, o.output, outputFormatJSON)
+	}
+	incompatible := []struct {
+		flag  string
+		isSet bool
+	}{
+		{followFlag, o.follow},
+		{remoteFlag, o.remote},
+		{generateCommandFlag, o.generateCommandTarget != ""},
+	}
+	for _, f := range incompatible {
+		if f.isSet {
+			return fmt.Errorf("cannot specify both `--%s` and `--%s`", outputFlag, f.flag)
+		}
+	}
+	return nil
+}
+
 func (o *runTaskOpts) validateFlagsWithWindows() error {
 	if !isWindowsOS(o.os) {
 		return nil
@@ -628,6 +813,16 @@ func (o *runTaskOpts) validateFlagsWithWindows() error {
 	return nil
 }
 
+func (o *runTaskOpts) validateLaunchType() error {
+	if o.launchType != "" && !slices.Contains(task.LaunchTypes, o.launchType) {
+		return fmt.Errorf("invalid `--%s` value %q: must be one of %s", launchTypeFlag, o.launchType, strings.Join(task.LaunchTypes, ", "))
+	}
+	if o.launchType == task.LaunchTypeEC2 && o.capacityProvider != "" {
+		return fmt.Errorf("cannot specify both `--%s` and `--%s`", launchTypeFlag, capacityProviderFlag)
+	}
+	return nil
+}
+
 func isWindowsOS(os string) bool {
 	return task.IsValidWindowsOS(os)
 }
@@ -664,6 +859,15 @@ func (o *runTaskOpts) shouldPromptForAppEnv() bool {
 	return !useDefault && !useConfig
 }
 
+// ValidateVersionPin returns an error if the application doesn't allow this version of Copilot to run tasks in its environments.
+// Tasks that aren't run in an application's environment aren't pinned.
+func (o *runTaskOpts) ValidateVersionPin() error {
+	if o.appName == "" {
+		return nil
+	}
+	return validateVersionPin(o.store, o.appName, version.Version, "")
+}
+
 // Execute deploys and runs the task.
 func (o *runTaskOpts) Execute() error {
 	if o.generateCommandTarget != "" {
@@ -684,6 +888,16 @@ func (o *runTaskOpts) Execute() error {
 		return err
 	}
 
+	if o.efsFrom != "" {
+		if err := o.addEFSVolumesFromManifest(); err != nil {
+			return err
+		}
+	}
+
+	if o.remote {
+		return o.runRemote()
+	}
+
 	if err := o.configureRuntimeOpts(); err != nil {
 		return err
 	}
@@ -703,10 +917,22 @@ func (o *runTaskOpts) Execute() error {
 		}
 	}
 
+	if len(o.efsVolumes) > 0 {
+		if err := o.configureEFS(); err != nil {
+			return err
+		}
+	}
+
 	if err := o.deployTaskResources(); err != nil {
 		return err
 	}
 
+	if o.efs != nil {
+		if err := o.useEFSSecurityGroup(); err != nil {
+			return err
+		}
+	}
+
 	// NOTE: repository has to be configured only after task resources are deployed
 	if err := o.configureRepository(); err != nil {
 		return err
@@ -771,9 +997,107 @@ Did you tag your secrets with the "copilot-application" and "copilot-environment
 			return err
 		}
 	}
+	if o.output == outputFormatJSON {
+		return o.writeResults(tasks)
+	}
+	return nil
+}
+
+// writeResults waits for the tasks to stop, writes their results to stdout,
+// and returns an error with the exit code of the first essential container that exited with a non-zero code.
+func (o *runTaskOpts) writeResults(tasks []*task.Task) error {
+	o.spinner.Start(fmt.Sprintf("Waiting for %s to stop.", english.Plural(o.count, "task", "")))
+	results, err := o.resultWaiter.Wait(tasks)
+	if err != nil {
+		o.spinner.Stop(log.Serrorf("Failed to wait for %s to stop.\n", english.Plural(o.count, "task", "")))
+		return fmt.Errorf("wait for tasks in group %s to stop: %w", o.groupName, err)
+	}
+	o.spinner.Stop(log.Ssuccessf("%s %s stopped.\n", english.PluralWord(o.count, "Task", ""), english.PluralWord(o.count, "has", "have")))
+	data, err := results.JSONString()
+	if err != nil {
+		return err
+	}
+	fmt.Fprint(log.OutputWriter, data)
+	return o.runner.CheckNonZeroExitCode(tasks)
+}
+
+// runRemote runs "copilot task run" from the task runner of the environment, which deploys the task resources
+// and runs the task in the environment with its own permissions.
+func (o *runTaskOpts) runRemote() error {
+	o.configureRemoteRunner(o.remoteArgs())
+	log.Infof("Running task %s from the task runner of environment %s.\n", color.HighlightUserInput(o.groupName), color.HighlightUserInput(o.env))
+	if err := o.remoteRunner.Run(); err != nil {
+		var errProjectNotFound *codebuild.ErrProjectNotFound
+		if errors.As(err, &errProjectNotFound) {
+			return &errTaskRunnerNotFound{
+				app:       o.appName,
+				env:       o.env,
+				parentErr: err,
+			}
+		}
+		return fmt.Errorf("run task %s remotely: %w", o.groupName, err)
+	}
 	return nil
 }
 
+// taskRunnerBinaryURL returns the URL of the Linux binary of this version of Copilot, which the task runner of an environment is pinned to.
+func taskRunnerBinaryURL() string {
+	return fmt.Sprintf("%s/copilot-linux-%s", binaryS3BucketPath, template.URLSafeVersion(version.Version))
+}
+
+// remoteArgs returns the arguments of "copilot task run" for the task runner.
+// The task runner sets the cluster, subnets and security groups of the environment.
+func (o *runTaskOpts) remoteArgs() []string {
+	args := []string{
+		"--" + taskGroupNameFlag, o.groupName,
+		"--" + imageFlag, o.image,
+		"--" + countFlag, strconv.Itoa(o.count),
+		"--" + cpuFlag, strconv.Itoa(o.cpu),
+		"--" + memoryFlag, strconv.Itoa(o.memory),
+	}
+	optional := []struct {
+		flag  string
+		value string
+	}{
+		{taskRoleFlag, o.taskRole},
+		{executionRoleFlag, o.executionRole},
+		{osFlag, o.os},
+		{archFlag, o.arch},
+		{commandFlag, o.command},
+		{entrypointFlag, o.entrypoint},
+	}
+	for _, f := range optional {
+		if f.value != "" {
+			args = append(args, "--"+f.flag, f.value)
+		}
+	}
+	for _, f := range []struct {
+		flag   string
+		values map[string]string
+	}{
+		{envVarsFlag, o.envVars},
+		{secretsFlag, o.secrets},
+		{resourceTagsFlag, o.resourceTags},
+	} {
+		keys := make([]string, 0, len(f.values))
+		for key := range f.values {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			args = append(args, "--"+f.flag, fmt.Sprintf("%s=%s", key, f.values[key]))
+		}
+	}
+	for _, vol := range o.efsVolumes {
+		args = append(args, "--"+efsFlag, vol)
+	}
+	if len(o.secrets) > 0 {
+		// The task runner can't prompt, and the caller already has access to the environment.
+		args = append(args, "--"+acknowledgeSecretsAccessFlag)
+	}
+	return args
+}
+
 func (o *runTaskOpts) generateCommand() error {
 	command, err := o.runTaskCommand()
 	if err != nil {
@@ -981,6 +1305,152 @@ func (o *runTaskOpts) buildAndPushImage(uri string) error {
 	return nil
 }
 
+// taskEFSConfig holds the EFS file systems to mount into the task and the network configuration of their mount targets.
+type taskEFSConfig struct {
+	volumes        []deploy.TaskEFSVolume
+	vpcID          string
+	securityGroups []string
+}
+
+// configureEFS looks up the mount targets of the file systems to mount, so that the task stack
+// can create a security group for the task in their VPC.
+func (o *runTaskOpts) configureEFS() error {
+	volumes, err := parseEFSVolumes(o.efsVolumes)
+	if err != nil {
+		return err
+	}
+	cfg := &taskEFSConfig{
+		volumes: volumes,
+	}
+	seen := make(map[string]bool)
+	for _, vol := range volumes {
+		if seen[vol.FileSystemID] {
+			continue
+		}
+		seen[vol.FileSystemID] = true
+		network, err := o.efsDescriber.FileSystemNetwork(vol.FileSystemID)
+		if err != nil {
+			return fmt.Errorf("get network configuration of EFS file system %s: %w", vol.FileSystemID, err)
+		}
+		if cfg.vpcID != "" && cfg.vpcID != network.VPCID {
+			return fmt.Errorf("EFS file systems mounted into a task must be in the same VPC: file system %s is in %s instead of %s", vol.FileSystemID, network.VPCID, cfg.vpcID)
+		}
+		cfg.vpcID = network.VPCID
+		for _, sg := range network.SecurityGroups {
+			if !slices.Contains(cfg.securityGroups, sg) {
+				cfg.securityGroups = append(cfg.securityGroups, sg)
+			}
+		}
+	}
+	o.efs = cfg
+	return nil
+}
+
+// addEFSVolumesFromManifest mounts the EFS volumes that the workload manifest of --efs-from brings.
+func (o *runTaskOpts) addEFSVolumesFromManifest() error {
+	if err := o.configureMftReader(); err != nil {
+		return fmt.Errorf("read the workspace: %w", err)
+	}
+	raw, err := o.mftReader.ReadWorkloadManifest(o.efsFrom)
+	if err != nil {
+		return fmt.Errorf("read manifest file for %s: %w", o.efsFrom, err)
+	}
+	interpolated, err := manifest.NewInterpolator(o.appName, o.env).Interpolate(string(raw))
+	if err != nil {
+		return fmt.Errorf("interpolate environment variables for %s manifest: %w", o.efsFrom, err)
+	}
+	mft, err := manifest.UnmarshalWorkload([]byte(interpolated))
+	if err != nil {
+		return fmt.Errorf("unmarshal manifest for %s: %w", o.efsFrom, err)
+	}
+	if o.env != "" {
+		if mft, err = mft.ApplyEnv(o.env); err != nil {
+			return fmt.Errorf("apply environment %s override: %w", o.env, err)
+		}
+	}
+	var storage manifest.Storage
+	switch m := mft.Manifest().(type) {
+	case *manifest.LoadBalancedWebService:
+		storage = m.Storage
+	case *manifest.BackendService:
+		storage = m.Storage
+	case *manifest.WorkerService:
+		storage = m.Storage
+	case *manifest.ScheduledJob:
+		storage = m.Storage
+	default:
+		return fmt.Errorf("workload %s of --%s does not support EFS volumes", o.efsFrom, efsFromFlag)
+	}
+	values, err := efsVolumesFromStorage(o.efsFrom, storage)
+	if err != nil {
+		return err
+	}
+	volumes, err := parseEFSVolumes(append(o.efsVolumes, values...))
+	if err != nil {
+		return err
+	}
+	o.efsVolumes = nil
+	for _, vol := range volumes {
+		o.efsVolumes = append(o.efsVolumes, fmt.Sprintf("%s:%s:%s", vol.Name, vol.FileSystemID, vol.ContainerPath))
+	}
+	return nil
+}
+
+// efsVolumesFromStorage converts the EFS volumes of a workload into values of the --efs flag.
+// Only volumes that mount the root directory of an existing file system without an access point can be mounted by a task.
+func efsVolumesFromStorage(wkld string, storage manifest.Storage) ([]string, error) {
+	names := make([]string, 0, len(storage.Volumes))
+	for name := range storage.Volumes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var values []string
+	for _, name := range names {
+		vol := storage.Volumes[name]
+		if vol == nil || vol.EmptyVolume() {
+			continue
+		}
+		cfg := vol.EFS.Advanced
+		switch {
+		case vol.EFS.UseManagedFS():
+			log.Warningf("Skipping volume %s of %s: Copilot-managed EFS volumes can't be mounted by a task.\n", name, wkld)
+			continue
+		case cfg.FileSystemID.Plain == nil:
+			log.Warningf("Skipping volume %s of %s: file systems imported from a CloudFormation stack can't be mounted by a task.\n", name, wkld)
+			continue
+		case aws.StringValue(cfg.RootDirectory) != "" && aws.StringValue(cfg.RootDirectory) != "/",
+			aws.StringValue(cfg.AuthConfig.AccessPointID) != "":
+			log.Warningf("Skipping volume %s of %s: only the root directory of a file system can be mounted by a task.\n", name, wkld)
+			continue
+		}
+		if vol.ContainerPath == nil {
+			return nil, fmt.Errorf("volume %s of %s must specify a container path", name, wkld)
+		}
+		values = append(values, fmt.Sprintf("%s:%s:%s", name, aws.StringValue(cfg.FileSystemID.Plain), aws.StringValue(vol.ContainerPath)))
+	}
+	return values, nil
+}
+
+// useEFSSecurityGroup runs the task with the security group created for it by the task stack.
+// Copilot doesn't modify the security groups of the mount targets, which must allow NFS traffic from it.
+func (o *runTaskOpts) useEFSSecurityGroup() error {
+	info, err := o.deployer.GetTaskStack(o.groupName)
+	if err != nil {
+		return fmt.Errorf("get resources of task %s: %w", o.groupName, err)
+	}
+	if info.EFSSecurityGroup == "" || slices.Contains(o.securityGroups, info.EFSSecurityGroup) {
+		return nil
+	}
+	log.Infof("The security groups %s of the EFS mount targets must allow NFS traffic on port 2049 from the security group %s of the task.\n",
+		strings.Join(o.efs.securityGroups, ", "), info.EFSSecurityGroup)
+	o.securityGroups = append(o.securityGroups, info.EFSSecurityGroup)
+	// Reconfigure the runner now that the security group is known.
+	if err := o.configureRuntimeOpts(); err != nil {
+		return err
+	}
+	return nil
+}
+
 func (o *runTaskOpts) deployTaskResources() error {
 	if err := o.deploy(); err != nil {
 		return fmt.Errorf("provision resources for task %s: %w", o.groupName, err)
@@ -1009,6 +1479,9 @@ func (o *runTaskOpts) deploy() error {
 		}
 		boundaryPolicy = app.PermissionsBoundary
 	}
+	if o.permissionsBoundary != "" {
+		boundaryPolicy = o.permissionsBoundary
+	}
 
 	secretsManagerSecrets, ssmParamSecrets := o.getCategorizedSecrets()
 
@@ -1028,6 +1501,7 @@ func (o *runTaskOpts) deploy() error {
 		Memory:                o.memory,
 		Image:                 o.image,
 		PermissionsBoundary:   boundaryPolicy,
+		RoleNamePrefix:        o.roleNamePrefix,
 		TaskRole:              o.taskRole,
 		ExecutionRole:         o.executionRole,
 		Command:               command,
@@ -1038,10 +1512,15 @@ func (o *runTaskOpts) deploy() error {
 		SecretsManagerSecrets: secretsManagerSecrets,
 		OS:                    o.os,
 		Arch:                  o.arch,
+		EC2Compatible:         task.RunsOnEC2(o.launchType, o.capacityProvider),
 		App:                   o.appName,
 		Env:                   o.env,
 		AdditionalTags:        o.resourceTags,
 	}
+	if o.efs != nil {
+		input.EFSVolumes = o.efs.volumes
+		input.EFSVPCID = o.efs.vpcID
+	}
 	return o.deployer.DeployTask(input, deployOpts...)
 }
 
@@ -1183,7 +1662,17 @@ func BuildTaskRunCmd() *cobra.Command {
   Run a task with a command.
   /code $ copilot task run --command "python migrate-script.py"
   Run a task with Docker build args.
-  /code $ copilot task run --build-args GO_VERSION=1.19"`,
+  /code $ copilot task run --build-args GO_VERSION=1.19"
+  Run a task with an EFS file system mounted at /data.
+  /code $ copilot task run -n backfill --env test --image backfill:v1 --efs data:fs-1234abcd:/data
+  Run a GPU task on the EC2 instances of a capacity provider of the cluster.
+  /code $ copilot task run -n train --cluster ml --image train:v1 --subnets subnet-123 --capacity-provider gpu-instances
+  Run a task in CI and print its exit codes, stop reason and log stream URL as JSON.
+  /code $ copilot task run -n integ-tests --env test --image tests:v1 --output json
+  Fail the CI job if the tasks haven't stopped after 30 minutes.
+  /code $ copilot task run -n integ-tests --env test --image tests:v1 --output json --timeout 30m
+  Run a database migration from the task runner of the "prod" environment, and exit with the exit code of the task.
+  /code $ copilot task run -n db-migrate --app my-app --env prod --image migrate:v2 --command "./migrate up" --remote`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newTaskRunOpts(vars)
 			if err != nil {
@@ -1221,15 +1710,30 @@ func BuildTaskRunCmd() *cobra.Command {
 	cmd.Flags().StringVar(&vars.executionRole, executionRoleFlag, "", executionRoleFlagDescription)
 	cmd.Flags().StringVar(&vars.os, osFlag, "", osFlagDescription)
 	cmd.Flags().StringVar(&vars.arch, archFlag, "", archFlagDescription)
+	cmd.Flags().StringVar(&vars.launchType, launchTypeFlag, "", launchTypeFlagDescription)
+	cmd.Flags().StringVar(&vars.capacityProvider, capacityProviderFlag, "", capacityProviderFlagDescription)
 	cmd.Flags().StringToStringVar(&vars.envVars, envVarsFlag, nil, envVarsFlagDescription)
 	cmd.Flags().StringVar(&vars.envFile, envFileFlag, "", envFileFlagDescription)
 	cmd.Flags().StringToStringVar(&vars.secrets, secretsFlag, nil, secretsFlagDescription)
 	cmd.Flags().StringVar(&vars.command, commandFlag, "", runCommandFlagDescription)
 	cmd.Flags().StringVar(&vars.entrypoint, entrypointFlag, "", entrypointFlagDescription)
 	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
+	cmd.Flags().StringArrayVar(&vars.efsVolumes, efsFlag, nil, efsFlagDescription)
+	cmd.Flags().StringVar(&vars.efsFrom, efsFromFlag, "", efsFromFlagDescription)
 
 	cmd.Flags().BoolVar(&vars.follow, followFlag, false, followFlagDescription)
+	cmd.Flags().StringVar(&vars.output, outputFlag, "", taskOutputFlagDescription)
+	cmd.Flags().DurationVar(&vars.timeout, timeoutFlag, 0, taskRunTimeoutFlagDescription)
 	cmd.Flags().StringVar(&vars.generateCommandTarget, generateCommandFlag, "", generateCommandFlagDescription)
+	cmd.Flags().BoolVar(&vars.remote, remoteFlag, false, remoteFlagDescription)
+	cmd.Flags().StringVar(&vars.permissionsBoundary, permissionsBoundaryFlag, "", taskPermissionsBoundaryFlagDescription)
+	cmd.Flags().StringVar(&vars.roleNamePrefix, roleNamePrefixFlag, "", roleNamePrefixFlagDescription)
+	cmd.Flags().StringVar(&vars.envRoleARN, envRoleFlag, "", envRoleFlagDescription)
+	// Only used by the task runner of an environment.
+	_ = cmd.Flags().MarkHidden(permissionsBoundaryFlag)
+	_ = cmd.Flags().MarkHidden(roleNamePrefixFlag)
+	// Only used by the task actions of a pipeline.
+	_ = cmd.Flags().MarkHidden(envRoleFlag)
 
 	// group flags.
 	nameFlags := pflag.NewFlagSet("Name", pflag.ContinueOnError)
@@ -1258,17 +1762,24 @@ func BuildTaskRunCmd() *cobra.Command {
 	taskFlags.AddFlag(cmd.Flags().Lookup(executionRoleFlag))
 	taskFlags.AddFlag(cmd.Flags().Lookup(osFlag))
 	taskFlags.AddFlag(cmd.Flags().Lookup(archFlag))
+	taskFlags.AddFlag(cmd.Flags().Lookup(launchTypeFlag))
+	taskFlags.AddFlag(cmd.Flags().Lookup(capacityProviderFlag))
 	taskFlags.AddFlag(cmd.Flags().Lookup(envVarsFlag))
 	taskFlags.AddFlag(cmd.Flags().Lookup(envFileFlag))
 	taskFlags.AddFlag(cmd.Flags().Lookup(secretsFlag))
 	taskFlags.AddFlag(cmd.Flags().Lookup(commandFlag))
 	taskFlags.AddFlag(cmd.Flags().Lookup(entrypointFlag))
 	taskFlags.AddFlag(cmd.Flags().Lookup(resourceTagsFlag))
+	taskFlags.AddFlag(cmd.Flags().Lookup(efsFlag))
+	taskFlags.AddFlag(cmd.Flags().Lookup(efsFromFlag))
 
 	utilityFlags := pflag.NewFlagSet("Utility", pflag.ContinueOnError)
 	utilityFlags.AddFlag(cmd.Flags().Lookup(followFlag))
+	utilityFlags.AddFlag(cmd.Flags().Lookup(outputFlag))
+	utilityFlags.AddFlag(cmd.Flags().Lookup(timeoutFlag))
 	utilityFlags.AddFlag(cmd.Flags().Lookup(generateCommandFlag))
 	utilityFlags.AddFlag(cmd.Flags().Lookup(acknowledgeSecretsAccessFlag))
+	utilityFlags.AddFlag(cmd.Flags().Lookup(remoteFlag))
 
 	// prettify help menu.
 	cmd.Annotations = map[string]string{
@@ -1303,5 +1814,6 @@ func BuildTaskRunCmd() *cobra.Command {
 {{- code .Example}}
 {{- end}}
 `)
+	markAudited(cmd)
 	return cmd
 }
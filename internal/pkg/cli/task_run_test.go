@@ -11,9 +11,13 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codebuild"
+	"github.com/aws/copilot-cli/internal/pkg/aws/efs"
 
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
@@ -85,9 +89,17 @@ func TestTaskRunOpts_Validate(t *testing.T) {
 		inEntryPoint string
 		inOS         string
 		inArch       string
+		inEFSVolumes []string
+
+		inLaunchType       string
+		inCapacityProvider string
 
 		inDefault               bool
 		inGenerateCommandTarget string
+		inRemote                bool
+		inFollow                bool
+		inOutput                string
+		inTimeout               time.Duration
 
 		appName         string
 		isDockerfileSet bool
@@ -184,6 +196,27 @@ func TestTaskRunOpts_Validate(t *testing.T) {
 			inArch:      "x86_64",
 			wantedError: nil,
 		},
+		"valid with the EC2 launch type": {
+			basicOpts:    defaultOpts,
+			inLaunchType: "ec2",
+			wantedError:  nil,
+		},
+		"valid with a capacity provider": {
+			basicOpts:          defaultOpts,
+			inCapacityProvider: "gpu-instances",
+			wantedError:        nil,
+		},
+		"invalid launch type": {
+			basicOpts:    defaultOpts,
+			inLaunchType: "external",
+			wantedError:  errors.New("invalid `--launch-type` value \"external\": must be one of fargate, ec2"),
+		},
+		"invalid with both the EC2 launch type and a capacity provider": {
+			basicOpts:          defaultOpts,
+			inLaunchType:       "ec2",
+			inCapacityProvider: "gpu-instances",
+			wantedError:        errors.New("cannot specify both `--launch-type` and `--capacity-provider`"),
+		},
 		"invalid number of tasks": {
 			basicOpts: basicOpts{
 				inCount:  -1,
@@ -405,6 +438,151 @@ func TestTaskRunOpts_Validate(t *testing.T) {
 
 			wantedError: nil,
 		},
+		"remote without an environment": {
+			basicOpts: defaultOpts,
+
+			inImage:  "migrate:v2",
+			appName:  "my-app",
+			inRemote: true,
+
+			wantedError: errors.New("must specify both `--app` and `--env` with `--remote`"),
+		},
+		"remote without an image": {
+			basicOpts: defaultOpts,
+
+			appName:  "my-app",
+			inEnv:    "prod",
+			inRemote: true,
+
+			wantedError: errors.New("must specify `--image` with `--remote`"),
+		},
+		"remote with an env file": {
+			basicOpts: defaultOpts,
+
+			inImage:   "migrate:v2",
+			appName:   "my-app",
+			inEnv:     "prod",
+			inEnvFile: "test.env",
+			inRemote:  true,
+
+			wantedError: errors.New("cannot specify both `--remote` and `--env-file`"),
+		},
+		"remote with security groups": {
+			basicOpts: defaultOpts,
+
+			inImage:          "migrate:v2",
+			appName:          "my-app",
+			inEnv:            "prod",
+			inSecurityGroups: []string{"sg-1"},
+			inRemote:         true,
+
+			wantedError: errors.New("cannot specify both `--remote` and `--security-groups`"),
+		},
+		"remote with a capacity provider": {
+			basicOpts: defaultOpts,
+
+			inImage:            "migrate:v2",
+			appName:            "my-app",
+			inEnv:              "prod",
+			inCapacityProvider: "gpu-instances",
+			inRemote:           true,
+
+			wantedError: errors.New("cannot specify both `--remote` and `--capacity-provider`"),
+		},
+		"invalid output format": {
+			basicOpts: defaultOpts,
+
+			inOutput: "yaml",
+
+			wantedError: errors.New("invalid `--output` value \"yaml\": must be \"json\""),
+		},
+		"output with follow": {
+			basicOpts: defaultOpts,
+
+			inOutput: "json",
+			inFollow: true,
+
+			wantedError: errors.New("cannot specify both `--output` and `--follow`"),
+		},
+		"valid json output": {
+			basicOpts: defaultOpts,
+
+			inOutput: "json",
+		},
+		"negative timeout": {
+			basicOpts: defaultOpts,
+
+			inOutput:  "json",
+			inTimeout: -time.Minute,
+
+			wantedError: errors.New("`--timeout` must not be negative"),
+		},
+		"timeout without output": {
+			basicOpts: defaultOpts,
+
+			inTimeout: 30 * time.Minute,
+
+			wantedError: errors.New("`--timeout` can only be specified with `--output`"),
+		},
+		"valid json output with timeout": {
+			basicOpts: defaultOpts,
+
+			inOutput:  "json",
+			inTimeout: 30 * time.Minute,
+		},
+		"invalid efs volume format": {
+			basicOpts: defaultOpts,
+
+			inEFSVolumes: []string{"data:fs-1234abcd"},
+
+			wantedError: errors.New(`value "data:fs-1234abcd" of --efs must be formatted as volume-name:fs-id:/container/path`),
+		},
+		"invalid efs volume name": {
+			basicOpts: defaultOpts,
+
+			inEFSVolumes: []string{"my data:fs-1234abcd:/data"},
+
+			wantedError: errors.New(`volume name "my data" of --efs can only contain letters, numbers, hyphens and underscores`),
+		},
+		"invalid efs file system id": {
+			basicOpts: defaultOpts,
+
+			inEFSVolumes: []string{"data:my-fs:/data"},
+
+			wantedError: errors.New(`file system ID "my-fs" of --efs must be formatted as fs-<id>`),
+		},
+		"relative efs container path": {
+			basicOpts: defaultOpts,
+
+			inEFSVolumes: []string{"data:fs-1234abcd:data"},
+
+			wantedError: errors.New(`container path "data" of --efs must be an absolute path`),
+		},
+		"duplicate efs container path": {
+			basicOpts: defaultOpts,
+
+			inEFSVolumes: []string{"data:fs-1234abcd:/data", "other:fs-5678abcd:/data"},
+
+			wantedError: errors.New(`container path "/data" is specified more than once in --efs`),
+		},
+		"valid efs volumes": {
+			basicOpts: defaultOpts,
+
+			inEFSVolumes: []string{"data:fs-1234abcd:/data", "logs:fs-1234abcd:/var/log/app"},
+		},
+		"valid remote": {
+			basicOpts: defaultOpts,
+
+			inImage:  "migrate:v2",
+			appName:  "my-app",
+			inEnv:    "prod",
+			inRemote: true,
+
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				m.EXPECT().GetEnvironment("my-app", "prod").Return(&config.Environment{Name: "prod"}, nil)
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -437,8 +615,15 @@ func TestTaskRunOpts_Validate(t *testing.T) {
 					entrypoint:                  tc.inEntryPoint,
 					useDefaultSubnetsAndCluster: tc.inDefault,
 					generateCommandTarget:       tc.inGenerateCommandTarget,
+					remote:                      tc.inRemote,
+					follow:                      tc.inFollow,
+					output:                      tc.inOutput,
+					timeout:                     tc.inTimeout,
 					os:                          tc.inOS,
 					arch:                        tc.inArch,
+					efsVolumes:                  tc.inEFSVolumes,
+					launchType:                  tc.inLaunchType,
+					capacityProvider:            tc.inCapacityProvider,
 				},
 				isDockerfileSet: tc.isDockerfileSet,
 				nFlag:           2,
@@ -767,6 +952,9 @@ type runTaskMocks struct {
 	publicIPGetter       *mocks.MockpublicIPGetter
 	provider             *mocks.MocksessionProvider
 	uploader             *mocks.Mockuploader
+	efsDescriber         *mocks.MockefsFileSystemDescriber
+	resultWaiter         *mocks.MocktaskResultWaiter
+	mftReader            *mocks.MockmanifestReader
 }
 
 func mockHasDefaultCluster(m runTaskMocks) {
@@ -797,9 +985,12 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 		inTag        string
 		inDockerCtx  string
 		inFollow     bool
+		inOutput     string
 		inCommand    string
 		inEntryPoint string
 		inEnvFile    string
+		inEFSVolumes []string
+		inEFSFrom    string
 
 		inApp string
 		inEnv string
@@ -807,7 +998,8 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 		setupFs    func(fs *afero.Afero)
 		setupMocks func(m runTaskMocks)
 
-		wantedError error
+		wantedSecurityGroups []string
+		wantedError          error
 	}{
 		"check if default cluster exists if deploying to default cluster": {
 			setupMocks: func(m runTaskMocks) {
@@ -1036,6 +1228,53 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 			},
 			wantedError: errors.New("write events: error writing events"),
 		},
+		"fail to wait for the results of the tasks": {
+			inOutput: "json",
+			inImage:  "image",
+			setupMocks: func(m runTaskMocks) {
+				m.provider.EXPECT().Default().Return(&session.Session{}, nil)
+				m.deployer.EXPECT().DeployTask(gomock.Any()).AnyTimes()
+				m.runner.EXPECT().Run().Return([]*task.Task{
+					{
+						TaskARN: "task-1",
+					},
+				}, nil)
+				m.resultWaiter.EXPECT().Wait([]*task.Task{{TaskARN: "task-1"}}).Return(nil, errors.New("some error"))
+				mockHasDefaultCluster(m)
+			},
+			wantedError: errors.New("wait for tasks in group my-task to stop: some error"),
+		},
+		"write the results of the tasks and return the non-zero exit code": {
+			inOutput: "json",
+			inImage:  "image",
+			setupMocks: func(m runTaskMocks) {
+				tasks := []*task.Task{
+					{
+						TaskARN: "task-1",
+					},
+				}
+				m.provider.EXPECT().Default().Return(&session.Session{}, nil)
+				m.deployer.EXPECT().DeployTask(gomock.Any()).AnyTimes()
+				m.runner.EXPECT().Run().Return(tasks, nil)
+				m.resultWaiter.EXPECT().Wait(tasks).Return(&task.Results{
+					Group: inGroupName,
+					Tasks: []task.TaskResult{
+						{
+							TaskARN: "task-1",
+							Containers: []task.ContainerResult{
+								{
+									Name:     inGroupName,
+									ExitCode: aws.Int64(1),
+								},
+							},
+						},
+					},
+				}, nil)
+				m.runner.EXPECT().CheckNonZeroExitCode(tasks).Return(errors.New("container my-task in task task-1 exited with status code 1"))
+				mockHasDefaultCluster(m)
+			},
+			wantedError: errors.New("container my-task in task task-1 exited with status code 1"),
+		},
 		"error getting app config (to look for permissions boundary policy)": {
 			inApp: "my-app",
 			inEnv: "test",
@@ -1053,6 +1292,101 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 			},
 			wantedError: fmt.Errorf("provision resources for task %s: get application: some error", "my-task"),
 		},
+		"error if fail to get the network configuration of an EFS file system": {
+			inImage:      "image",
+			inEFSVolumes: []string{"data:fs-1234abcd:/data"},
+			setupMocks: func(m runTaskMocks) {
+				m.provider.EXPECT().Default().Return(&session.Session{}, nil)
+				mockHasDefaultCluster(m)
+				m.efsDescriber.EXPECT().FileSystemNetwork("fs-1234abcd").Return(nil, errors.New("some error"))
+				m.deployer.EXPECT().DeployTask(gomock.Any()).Times(0)
+			},
+			wantedError: errors.New("get network configuration of EFS file system fs-1234abcd: some error"),
+		},
+		"error if EFS file systems are in different VPCs": {
+			inImage:      "image",
+			inEFSVolumes: []string{"data:fs-1234abcd:/data", "logs:fs-5678abcd:/logs"},
+			setupMocks: func(m runTaskMocks) {
+				m.provider.EXPECT().Default().Return(&session.Session{}, nil)
+				mockHasDefaultCluster(m)
+				m.efsDescriber.EXPECT().FileSystemNetwork("fs-1234abcd").Return(&efs.FileSystemNetwork{VPCID: "vpc-1"}, nil)
+				m.efsDescriber.EXPECT().FileSystemNetwork("fs-5678abcd").Return(&efs.FileSystemNetwork{VPCID: "vpc-2"}, nil)
+			},
+			wantedError: errors.New("EFS file systems mounted into a task must be in the same VPC: file system fs-5678abcd is in vpc-2 instead of vpc-1"),
+		},
+		"mount EFS volumes and run the task with the security group allowed to access them": {
+			inImage:      "image",
+			inEFSVolumes: []string{"data:fs-1234abcd:/data", "logs:fs-1234abcd:/logs", "shared:fs-5678abcd:/shared"},
+			setupMocks: func(m runTaskMocks) {
+				m.provider.EXPECT().Default().Return(&session.Session{}, nil)
+				mockHasDefaultCluster(m)
+				m.efsDescriber.EXPECT().FileSystemNetwork("fs-1234abcd").Return(&efs.FileSystemNetwork{
+					VPCID:          "vpc-1",
+					SecurityGroups: []string{"sg-mt-1", "sg-mt-2"},
+				}, nil)
+				m.efsDescriber.EXPECT().FileSystemNetwork("fs-5678abcd").Return(&efs.FileSystemNetwork{
+					VPCID:          "vpc-1",
+					SecurityGroups: []string{"sg-mt-2", "sg-mt-3"},
+				}, nil)
+				m.deployer.EXPECT().DeployTask(gomock.Any()).DoAndReturn(func(in *deploy.CreateTaskResourcesInput, _ ...awscloudformation.StackOption) error {
+					require.Equal(t, []deploy.TaskEFSVolume{
+						{Name: "data", FileSystemID: "fs-1234abcd", ContainerPath: "/data"},
+						{Name: "logs", FileSystemID: "fs-1234abcd", ContainerPath: "/logs"},
+						{Name: "shared", FileSystemID: "fs-5678abcd", ContainerPath: "/shared"},
+					}, in.EFSVolumes)
+					require.Equal(t, "vpc-1", in.EFSVPCID)
+					return nil
+				})
+				m.deployer.EXPECT().GetTaskStack(inGroupName).Return(&deploy.TaskStackInfo{EFSSecurityGroup: "sg-efs"}, nil)
+				m.runner.EXPECT().Run().Return([]*task.Task{}, nil)
+			},
+			wantedSecurityGroups: []string{"sg-efs"},
+		},
+		"error if the manifest of --efs-from cannot be read": {
+			inImage:   "image",
+			inEFSFrom: "api",
+			setupMocks: func(m runTaskMocks) {
+				m.provider.EXPECT().Default().Return(&session.Session{}, nil)
+				m.mftReader.EXPECT().ReadWorkloadManifest("api").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("read manifest file for api: some error"),
+		},
+		"mount the EFS volumes of the workload in --efs-from": {
+			inImage:      "image",
+			inEFSVolumes: []string{"logs:fs-5678abcd:/logs"},
+			inEFSFrom:    "api",
+			setupMocks: func(m runTaskMocks) {
+				m.provider.EXPECT().Default().Return(&session.Session{}, nil)
+				m.mftReader.EXPECT().ReadWorkloadManifest("api").Return([]byte(`
+name: api
+type: Backend Service
+image:
+  location: nginx
+storage:
+  volumes:
+    data:
+      path: /data
+      efs:
+        id: fs-1234abcd
+    managed:
+      path: /managed
+      efs: true
+`), nil)
+				mockHasDefaultCluster(m)
+				m.efsDescriber.EXPECT().FileSystemNetwork("fs-5678abcd").Return(&efs.FileSystemNetwork{VPCID: "vpc-1"}, nil)
+				m.efsDescriber.EXPECT().FileSystemNetwork("fs-1234abcd").Return(&efs.FileSystemNetwork{VPCID: "vpc-1"}, nil)
+				m.deployer.EXPECT().DeployTask(gomock.Any()).DoAndReturn(func(in *deploy.CreateTaskResourcesInput, _ ...awscloudformation.StackOption) error {
+					require.Equal(t, []deploy.TaskEFSVolume{
+						{Name: "logs", FileSystemID: "fs-5678abcd", ContainerPath: "/logs"},
+						{Name: "data", FileSystemID: "fs-1234abcd", ContainerPath: "/data"},
+					}, in.EFSVolumes)
+					return nil
+				})
+				m.deployer.EXPECT().GetTaskStack(inGroupName).Return(&deploy.TaskStackInfo{EFSSecurityGroup: "sg-efs"}, nil)
+				m.runner.EXPECT().Run().Return([]*task.Task{}, nil)
+			},
+			wantedSecurityGroups: []string{"sg-efs"},
+		},
 		"env file happy path": {
 			inEnvFile: "testdir/../magic.env",
 			inApp:     "my-app",
@@ -1178,6 +1512,9 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 				publicIPGetter:       mocks.NewMockpublicIPGetter(ctrl),
 				provider:             mocks.NewMocksessionProvider(ctrl),
 				uploader:             mocks.NewMockuploader(ctrl),
+				efsDescriber:         mocks.NewMockefsFileSystemDescriber(ctrl),
+				resultWaiter:         mocks.NewMocktaskResultWaiter(ctrl),
+				mftReader:            mocks.NewMockmanifestReader(ctrl),
 			}
 			tc.setupMocks(mocks)
 
@@ -1192,10 +1529,13 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 					appName:    tc.inApp,
 					env:        tc.inEnv,
 					follow:     tc.inFollow,
+					output:     tc.inOutput,
 					secrets:    tc.inSecrets,
 					command:    tc.inCommand,
 					entrypoint: tc.inEntryPoint,
 					envFile:    tc.inEnvFile,
+					efsVolumes: tc.inEFSVolumes,
+					efsFrom:    tc.inEFSFrom,
 				},
 				spinner:  &spinnerTestDouble{},
 				store:    mocks.store,
@@ -1207,12 +1547,18 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 				opts.deployer = mocks.deployer
 				opts.defaultClusterGetter = mocks.defaultClusterGetter
 				opts.publicIPGetter = mocks.publicIPGetter
+				opts.efsDescriber = mocks.efsDescriber
+				opts.resultWaiter = mocks.resultWaiter
 				return nil
 			}
 			opts.configureRepository = func() error {
 				opts.repository = mocks.repository
 				return nil
 			}
+			opts.configureMftReader = func() error {
+				opts.mftReader = mocks.mftReader
+				return nil
+			}
 			opts.configureEventsWriter = func(tasks []*task.Task) {
 				opts.eventsWriter = mocks.eventsWriter
 			}
@@ -1226,6 +1572,133 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 			} else {
 				require.NoError(t, err)
 			}
+			if tc.wantedSecurityGroups != nil {
+				require.Equal(t, tc.wantedSecurityGroups, opts.securityGroups)
+			}
+		})
+	}
+}
+
+func TestTaskRunOpts_ExecuteRemote(t *testing.T) {
+	testCases := map[string]struct {
+		inVars runTaskVars
+
+		setupMocks func(m *mocks.MockremoteTaskRunner)
+
+		wantedArgs  []string
+		wantedError error
+	}{
+		"forward the task configuration to the task runner": {
+			inVars: runTaskVars{
+				count:      2,
+				cpu:        512,
+				memory:     1024,
+				image:      "migrate:v2",
+				taskRole:   "migrate-role",
+				command:    `./migrate up --to "v2"`,
+				envVars:    map[string]string{"STAGE": "prod", "LOG_LEVEL": "debug"},
+				secrets:    map[string]string{"DB_PASSWORD": "/copilot/db/password"},
+				efsVolumes: []string{"data:fs-1234abcd:/data"},
+			},
+			setupMocks: func(m *mocks.MockremoteTaskRunner) {
+				m.EXPECT().Run().Return(nil)
+			},
+			wantedArgs: []string{
+				"--task-group-name", "db-migrate",
+				"--image", "migrate:v2",
+				"--count", "2",
+				"--cpu", "512",
+				"--memory", "1024",
+				"--task-role", "migrate-role",
+				"--command", `./migrate up --to "v2"`,
+				"--env-vars", "LOG_LEVEL=debug",
+				"--env-vars", "STAGE=prod",
+				"--secrets", "DB_PASSWORD=/copilot/db/password",
+				"--efs", "data:fs-1234abcd:/data",
+				"--acknowledge-secrets-access",
+			},
+		},
+		"recommend enabling the task runner if it doesn't exist": {
+			inVars: runTaskVars{
+				count:  1,
+				cpu:    256,
+				memory: 512,
+				image:  "migrate:v2",
+			},
+			setupMocks: func(m *mocks.MockremoteTaskRunner) {
+				m.EXPECT().Run().Return(&codebuild.ErrProjectNotFound{})
+			},
+			wantedArgs: []string{
+				"--task-group-name", "db-migrate",
+				"--image", "migrate:v2",
+				"--count", "1",
+				"--cpu", "256",
+				"--memory", "512",
+			},
+			wantedError: errors.New("environment prod of application my-app does not have a task runner: CodeBuild project  not found"),
+		},
+		"keep the exit code of the task": {
+			inVars: runTaskVars{
+				count:  1,
+				cpu:    256,
+				memory: 512,
+				image:  "migrate:v2",
+			},
+			setupMocks: func(m *mocks.MockremoteTaskRunner) {
+				m.EXPECT().Run().Return(&task.ErrRemoteExitCode{BuildID: "build-1"})
+			},
+			wantedArgs: []string{
+				"--task-group-name", "db-migrate",
+				"--image", "migrate:v2",
+				"--count", "1",
+				"--cpu", "256",
+				"--memory", "512",
+			},
+			wantedError: errors.New("run task db-migrate remotely: task run by build build-1 exited with code 0"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockstore(ctrl)
+			mockStore.EXPECT().GetEnvironment("my-app", "prod").Return(&config.Environment{
+				ManagerRoleARN: "arn:aws:iam::123456789012:role/my-app-prod-EnvManagerRole",
+				Region:         "us-west-2",
+			}, nil)
+			mockProvider := mocks.NewMocksessionProvider(ctrl)
+			mockProvider.EXPECT().FromRole("arn:aws:iam::123456789012:role/my-app-prod-EnvManagerRole", "us-west-2").Return(&session.Session{}, nil)
+			mockRunner := mocks.NewMockremoteTaskRunner(ctrl)
+			tc.setupMocks(mockRunner)
+
+			vars := tc.inVars
+			vars.groupName = "db-migrate"
+			vars.appName = "my-app"
+			vars.env = "prod"
+			vars.remote = true
+			var gotArgs []string
+			opts := &runTaskOpts{
+				runTaskVars: vars,
+				store:       mockStore,
+				provider:    mockProvider,
+			}
+			opts.configureRemoteRunner = func(args []string) {
+				gotArgs = args
+				opts.remoteRunner = mockRunner
+			}
+			opts.configureRuntimeOpts = func() error {
+				return errors.New("remote runs must not deploy task resources")
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedArgs, gotArgs)
 		})
 	}
 }
@@ -1236,6 +1709,48 @@ type mockRunTaskRequester struct {
 	mockRunTaskRequestFromJob        func(client ecs.JobDescriber, app, env, job string) (*ecs.RunTaskRequest, error)
 }
 
+func TestTaskRunOpts_configureSessAndEnv(t *testing.T) {
+	testCases := map[string]struct {
+		inEnvRoleARN string
+
+		wantedRoleARN string
+	}{
+		"assumes the environment manager role by default": {
+			wantedRoleARN: "arn:aws:iam::123456789012:role/my-app-prod-EnvManagerRole",
+		},
+		"assumes the role passed by the task action of a pipeline": {
+			inEnvRoleARN:  "arn:aws:iam::123456789012:role/my-app-prod-RunTaskRole",
+			wantedRoleARN: "arn:aws:iam::123456789012:role/my-app-prod-RunTaskRole",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockstore(ctrl)
+			mockStore.EXPECT().GetEnvironment("my-app", "prod").Return(&config.Environment{
+				ManagerRoleARN: "arn:aws:iam::123456789012:role/my-app-prod-EnvManagerRole",
+				Region:         "us-west-2",
+			}, nil)
+			mockProvider := mocks.NewMocksessionProvider(ctrl)
+			mockProvider.EXPECT().FromRole(tc.wantedRoleARN, "us-west-2").Return(&session.Session{}, nil)
+
+			opts := &runTaskOpts{
+				runTaskVars: runTaskVars{
+					appName:    "my-app",
+					env:        "prod",
+					envRoleARN: tc.inEnvRoleARN,
+				},
+				store:    mockStore,
+				provider: mockProvider,
+			}
+
+			require.NoError(t, opts.configureSessAndEnv())
+		})
+	}
+}
+
 type taskRunMocks struct {
 	store                   *mocks.Mockstore
 	provider                *mocks.MocksessionProvider
@@ -20,6 +20,7 @@ import (
 	"github.com/spf13/afero"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/copilot-cli/internal/pkg/addon"
 	"github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
@@ -38,6 +39,9 @@ var (
 	errValueNotAStringSlice = errors.New("value must be a string slice")
 	errValueNotAValidPath   = errors.New("value must be a valid path")
 	errValueNotAnIPNet      = errors.New("value must be a valid IP address range (example: 10.0.0.0/16)")
+	errValueNotAKMSKeyARN   = errors.New("value must be the ARN of a KMS key (example: arn:aws:kms:us-west-2:111122223333:key/1234abcd-12ab-34cd-56ef-1234567890ab)")
+	errValueNotAnIAMRoleARN = errors.New("value must be the ARN of an IAM role (example: arn:aws:iam::111122223333:role/CloudFormationServiceRole)")
+	errValueNotAnAccountID  = errors.New("value must be a 12-digit AWS account ID (example: 111122223333)")
 	errValueNotIPNetSlice   = errors.New("value must be a valid slice of IP address range (example: 10.0.0.0/16,10.0.1.0/16)")
 	errPortInvalid          = errors.New("value must be in range 1-65535")
 	errDomainInvalid        = errors.New("value must contain at least one '.' character")
@@ -126,6 +130,8 @@ var (
 	domainNameRegexp = regexp.MustCompile(`\.`) // Check for at least one dot in domain name.
 
 	awsScheduleRegexp = regexp.MustCompile(`(?:rate|cron)\(.*\)`) // Check for strings of the form rate(*) or cron(*).
+
+	accountIDRegexp = regexp.MustCompile(`^\d{12}$`)
 )
 
 // RDS Aurora Serverless validation expressions.
@@ -141,6 +147,9 @@ var (
 		"$", // End of string.
 	)
 
+	// subnetGroupNameRegexp matches the names of the subnet groups of an environment, which are part of export names.
+	subnetGroupNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9]+$`)
+
 	// The storage name for RDS storage type is used as the logical ID of the Aurora Serverless DB cluster in the CFN template.
 	// When creating the DB cluster, CFN will use the logical ID to generate a DB cluster identifier.
 	// Since the logical ID has stricter character restrictions than cluster identifier, we only need to check if the
@@ -760,6 +769,16 @@ func validateSubscribe(noSubscription bool, subscribeTags []string) error {
 	return nil
 }
 
+func validateImageLifecycle(keepImages, expireUntaggedDays int) error {
+	if keepImages < 0 {
+		return fmt.Errorf("--%s must be a non-negative integer", ecrKeepImagesFlag)
+	}
+	if expireUntaggedDays < 0 {
+		return fmt.Errorf("--%s must be a non-negative integer", ecrExpireUntaggedDaysFlag)
+	}
+	return nil
+}
+
 func validateSubscriptions(val interface{}) error {
 	s, ok := val.([]string)
 	if !ok {
@@ -812,6 +831,41 @@ func prettify(inputStrings []string) string {
 	return strings.Join(prettyTypes, ", ")
 }
 
+func validateKMSKeyARN(val interface{}) error {
+	s, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	parsed, err := arn.Parse(s)
+	if err != nil || parsed.Service != "kms" || !strings.HasPrefix(parsed.Resource, "key/") {
+		return errValueNotAKMSKeyARN
+	}
+	return nil
+}
+
+func validateIAMRoleARN(val interface{}) error {
+	s, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	parsed, err := arn.Parse(s)
+	if err != nil || parsed.Service != "iam" || !strings.HasPrefix(parsed.Resource, "role/") {
+		return errValueNotAnIAMRoleARN
+	}
+	return nil
+}
+
+func validateAccountID(val interface{}) error {
+	s, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	if !accountIDRegexp.MatchString(s) {
+		return errValueNotAnAccountID
+	}
+	return nil
+}
+
 func validateCIDR(val interface{}) error {
 	s, ok := val.(string)
 	if !ok {
@@ -0,0 +1,284 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"sort"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	cmdtemplate "github.com/aws/copilot-cli/cmd/copilot/template"
+)
+
+// Layout of the manifests under the copilot/ directory.
+const (
+	validateManifestFileName       = "manifest.yml"
+	validateLegacyPipelineFileName = "pipeline.yml"
+	validateEnvironmentsDirName    = "environments"
+	validatePipelinesDirName       = "pipelines"
+)
+
+// Directories under the copilot/ directory that don't hold manifests.
+var validateSkippedDirNames = map[string]bool{
+	"addons":       true,
+	"overrides":    true,
+	"node_modules": true,
+}
+
+type validateVars struct {
+	path    string
+	appName string
+}
+
+type validateOpts struct {
+	validateVars
+
+	fs         afero.Fs
+	ws         wsEnvironmentsLister // Nil if the command doesn't run in a workspace.
+	copilotDir string               // Empty if the command doesn't run in a workspace.
+}
+
+func newValidateOpts(vars validateVars) (*validateOpts, error) {
+	fs := afero.NewOsFs()
+	opts := &validateOpts{
+		validateVars: vars,
+		fs:           fs,
+	}
+	ws, err := workspace.Use(fs)
+	if err != nil {
+		// Manifests can be validated outside a workspace, for example in a CI job, as long as a path is provided.
+		var errNoWorkspace *workspace.ErrWorkspaceNotFound
+		var errNoApp *workspace.ErrNoAssociatedApplication
+		if errors.As(err, &errNoWorkspace) || errors.As(err, &errNoApp) {
+			return opts, nil
+		}
+		return nil, err
+	}
+	opts.ws = ws
+	opts.copilotDir = ws.CopilotDirAbs
+	return opts, nil
+}
+
+// Validate returns an error if the path to validate does not exist.
+func (o *validateOpts) Validate() error {
+	if o.path == "" {
+		if o.copilotDir == "" {
+			return errors.New("a path to a manifest or to a directory of manifests is required outside of a workspace")
+		}
+		return nil
+	}
+	if _, err := o.fs.Stat(o.path); err != nil {
+		return fmt.Errorf("check path %s: %w", o.path, err)
+	}
+	return nil
+}
+
+// Ask is a no-op for this command.
+func (o *validateOpts) Ask() error {
+	return nil
+}
+
+// Execute validates every workload, environment and pipeline manifest under the path without calling AWS.
+func (o *validateOpts) Execute() error {
+	root := o.path
+	if root == "" {
+		root = o.copilotDir
+	}
+	paths, err := o.manifestPaths(root)
+	if err != nil {
+		return err
+	}
+	if len(paths) == 0 {
+		return fmt.Errorf("no manifest found under %s", root)
+	}
+	var invalid int
+	for _, path := range paths {
+		if err := o.validateManifest(path); err != nil {
+			invalid++
+			log.Errorf("Manifest %s is invalid: %v\n", path, err)
+			continue
+		}
+		log.Successf("Manifest %s is valid.\n", path)
+	}
+	if invalid != 0 {
+		return fmt.Errorf("%d of %d manifests are invalid", invalid, len(paths))
+	}
+	return nil
+}
+
+func (o *validateOpts) manifestPaths(root string) ([]string, error) {
+	info, err := o.fs.Stat(root)
+	if err != nil {
+		return nil, fmt.Errorf("check path %s: %w", root, err)
+	}
+	if !info.IsDir() {
+		return []string{root}, nil
+	}
+	var paths []string
+	err = afero.Walk(o.fs, root, func(path string, info fs.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if validateSkippedDirNames[info.Name()] {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if info.Name() == validateManifestFileName || info.Name() == validateLegacyPipelineFileName {
+			paths = append(paths, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list manifests under %s: %w", root, err)
+	}
+	return paths, nil
+}
+
+func (o *validateOpts) validateManifest(path string) error {
+	raw, err := afero.ReadFile(o.fs, path)
+	if err != nil {
+		return fmt.Errorf("read manifest: %w", err)
+	}
+	parentDir := filepath.Base(filepath.Dir(filepath.Dir(path)))
+	switch {
+	case filepath.Base(path) == validateLegacyPipelineFileName || parentDir == validatePipelinesDirName:
+		return validatePipelineManifest(raw)
+	case parentDir == validateEnvironmentsDirName:
+		return o.validateEnvironmentManifest(raw, filepath.Base(filepath.Dir(path)))
+	default:
+		return o.validateWorkloadManifest(raw)
+	}
+}
+
+// validateWorkloadManifest validates the manifest with the overrides of each environment applied.
+func (o *validateOpts) validateWorkloadManifest(raw []byte) error {
+	envs, err := o.workloadEnvironments(raw)
+	if err != nil {
+		return err
+	}
+	if len(envs) == 0 {
+		mft, err := unmarshalInterpolatedWorkload(raw, o.appName, "")
+		if err != nil {
+			return err
+		}
+		return mft.Validate()
+	}
+	for _, env := range envs {
+		mft, err := unmarshalInterpolatedWorkload(raw, o.appName, env)
+		if err != nil {
+			return fmt.Errorf("environment %q: %w", env, err)
+		}
+		envMft, err := mft.ApplyEnv(env)
+		if err != nil {
+			return fmt.Errorf("apply environment %s override: %w", env, err)
+		}
+		if err := envMft.Validate(); err != nil {
+			return fmt.Errorf("validate manifest against environment %q: %w", env, err)
+		}
+	}
+	return nil
+}
+
+// workloadEnvironments returns the environments of the workspace and the ones overridden in the manifest.
+func (o *validateOpts) workloadEnvironments(raw []byte) ([]string, error) {
+	var mft struct {
+		Environments map[string]yaml.Node `yaml:"environments"`
+	}
+	if err := yaml.Unmarshal(raw, &mft); err != nil {
+		return nil, fmt.Errorf("unmarshal environment overrides: %w", err)
+	}
+	envs := make(map[string]bool)
+	for env := range mft.Environments {
+		envs[env] = true
+	}
+	if o.ws != nil {
+		wsEnvs, err := o.ws.ListEnvironments()
+		if err != nil {
+			return nil, fmt.Errorf("list environments in workspace: %w", err)
+		}
+		for _, env := range wsEnvs {
+			envs[env] = true
+		}
+	}
+	names := make([]string, 0, len(envs))
+	for env := range envs {
+		names = append(names, env)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func unmarshalInterpolatedWorkload(raw []byte, app, env string) (manifest.DynamicWorkload, error) {
+	interpolated, err := manifest.NewInterpolator(app, env).Interpolate(string(raw))
+	if err != nil {
+		return nil, fmt.Errorf("interpolate environment variables: %w", err)
+	}
+	return manifest.UnmarshalWorkload([]byte(interpolated))
+}
+
+func (o *validateOpts) validateEnvironmentManifest(raw []byte, env string) error {
+	interpolated, err := manifest.NewInterpolator(o.appName, env).Interpolate(string(raw))
+	if err != nil {
+		return fmt.Errorf("interpolate environment variables: %w", err)
+	}
+	mft, err := manifest.UnmarshalEnvironment([]byte(interpolated))
+	if err != nil {
+		return err
+	}
+	return mft.Validate()
+}
+
+func validatePipelineManifest(raw []byte) error {
+	mft, err := manifest.UnmarshalPipeline(raw)
+	if err != nil {
+		return fmt.Errorf("unmarshal pipeline manifest: %w", err)
+	}
+	return mft.Validate()
+}
+
+// BuildValidateCmd builds the command for validating manifests locally.
+func BuildValidateCmd() *cobra.Command {
+	vars := validateVars{}
+	cmd := &cobra.Command{
+		Use:   "validate [path]",
+		Short: "Validate manifests without deploying them.",
+		Long: `Validate the workload, environment and pipeline manifests under a path without calling AWS.
+Workload manifests are validated with the overrides of each of their environments applied.
+Defaults to the manifests of the current workspace.`,
+		Example: `
+  Validate all the manifests of the workspace.
+  /code $ copilot validate
+  Validate a single manifest.
+  /code $ copilot validate copilot/api/manifest.yml`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			if len(args) == 1 {
+				vars.path = args[0]
+			}
+			opts, err := newValidateOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+		Annotations: map[string]string{
+			"group": group.Release,
+		},
+	}
+	cmd.SetUsageTemplate(cmdtemplate.Usage)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	return cmd
+}
@@ -0,0 +1,185 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	validateMockWorkloadManifest = `name: api
+type: Backend Service
+image:
+  build: Dockerfile
+  port: 8080
+cpu: 256
+memory: 512
+count: 1
+`
+	validateMockEnvManifest = `name: test
+type: Environment
+`
+	validateMockPipelineManifest = `name: main
+version: 1
+source:
+  provider: GitHub
+  properties:
+    branch: main
+    repository: https://github.com/phonetool/api
+stages:
+  - name: test
+`
+)
+
+func TestValidateOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inPath       string
+		inCopilotDir string
+		setupFs      func(fs afero.Fs)
+
+		wantedError error
+	}{
+		"error if no path is provided outside a workspace": {
+			wantedError: errors.New("a path to a manifest or to a directory of manifests is required outside of a workspace"),
+		},
+		"error if the path does not exist": {
+			inPath:      "copilot/api/manifest.yml",
+			wantedError: errors.New("check path copilot/api/manifest.yml: open copilot/api/manifest.yml: file does not exist"),
+		},
+		"success in a workspace": {
+			inCopilotDir: "/ws/copilot",
+		},
+		"success with a path": {
+			inPath: "copilot/api/manifest.yml",
+			setupFs: func(fs afero.Fs) {
+				_ = afero.WriteFile(fs, "copilot/api/manifest.yml", []byte(validateMockWorkloadManifest), 0644)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			if tc.setupFs != nil {
+				tc.setupFs(fs)
+			}
+			opts := &validateOpts{
+				validateVars: validateVars{
+					path: tc.inPath,
+				},
+				fs:         fs,
+				copilotDir: tc.inCopilotDir,
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		inPath     string
+		setupFs    func(fs afero.Fs)
+		setupMocks func(m *mocks.MockwsEnvironmentsLister)
+
+		wantedError error
+	}{
+		"error if there is no manifest under the path": {
+			inPath: "copilot",
+			setupFs: func(fs afero.Fs) {
+				_ = fs.MkdirAll("copilot/api", 0755)
+			},
+			wantedError: errors.New("no manifest found under copilot"),
+		},
+		"error if a manifest is invalid against the override of an environment of the workspace": {
+			inPath: "copilot",
+			setupFs: func(fs afero.Fs) {
+				_ = afero.WriteFile(fs, "copilot/api/manifest.yml", []byte(validateMockWorkloadManifest+`environments:
+  prod:
+    count:
+      range: 1-10
+`), 0644)
+				_ = afero.WriteFile(fs, "copilot/environments/test/manifest.yml", []byte(validateMockEnvManifest), 0644)
+			},
+			setupMocks: func(m *mocks.MockwsEnvironmentsLister) {
+				m.EXPECT().ListEnvironments().Return([]string{"test"}, nil)
+			},
+			wantedError: errors.New("1 of 2 manifests are invalid"),
+		},
+		"error if the environments of the workspace cannot be listed": {
+			inPath: "copilot/api/manifest.yml",
+			setupFs: func(fs afero.Fs) {
+				_ = afero.WriteFile(fs, "copilot/api/manifest.yml", []byte(validateMockWorkloadManifest), 0644)
+			},
+			setupMocks: func(m *mocks.MockwsEnvironmentsLister) {
+				m.EXPECT().ListEnvironments().Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("1 of 1 manifests are invalid"),
+		},
+		"error if a pipeline manifest has an unsupported version": {
+			inPath: "copilot/pipelines/main/manifest.yml",
+			setupFs: func(fs afero.Fs) {
+				_ = afero.WriteFile(fs, "copilot/pipelines/main/manifest.yml", []byte("name: main\nversion: 2\n"), 0644)
+			},
+			wantedError: errors.New("1 of 1 manifests are invalid"),
+		},
+		"validates the workload, environment and pipeline manifests and skips addons": {
+			inPath: "copilot",
+			setupFs: func(fs afero.Fs) {
+				_ = afero.WriteFile(fs, "copilot/api/manifest.yml", []byte(validateMockWorkloadManifest+`environments:
+  test:
+    count: 2
+`), 0644)
+				_ = afero.WriteFile(fs, "copilot/api/addons/manifest.yml", []byte("not a manifest"), 0644)
+				_ = afero.WriteFile(fs, "copilot/environments/test/manifest.yml", []byte(validateMockEnvManifest), 0644)
+				_ = afero.WriteFile(fs, "copilot/pipelines/main/manifest.yml", []byte(validateMockPipelineManifest), 0644)
+			},
+			setupMocks: func(m *mocks.MockwsEnvironmentsLister) {
+				m.EXPECT().ListEnvironments().Return([]string{"test"}, nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ws := mocks.NewMockwsEnvironmentsLister(ctrl)
+			if tc.setupMocks != nil {
+				tc.setupMocks(ws)
+			}
+			fs := afero.NewMemMapFs()
+			tc.setupFs(fs)
+			opts := &validateOpts{
+				validateVars: validateVars{
+					path:    tc.inPath,
+					appName: "phonetool",
+				},
+				fs: fs,
+				ws: ws,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
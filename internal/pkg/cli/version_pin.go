@@ -0,0 +1,136 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"golang.org/x/mod/semver"
+)
+
+const (
+	versionPinComponentCLI         = "Copilot CLI"
+	versionPinComponentEnvTemplate = "environment template"
+)
+
+// versionPinVars holds the flag values that pin the versions of Copilot allowed to update an application.
+type versionPinVars struct {
+	minCLIVersion         string
+	maxCLIVersion         string
+	minEnvTemplateVersion string
+	maxEnvTemplateVersion string
+}
+
+// isSet returns true if any bound of the version pin is specified.
+func (v versionPinVars) isSet() bool {
+	return v.minCLIVersion != "" || v.maxCLIVersion != "" || v.minEnvTemplateVersion != "" || v.maxEnvTemplateVersion != ""
+}
+
+// validate returns an error if a bound is not a semantic version or if a minimum is greater than its maximum.
+func (v versionPinVars) validate() error {
+	bounds := []struct {
+		flag  string
+		value string
+	}{
+		{minCLIVersionFlag, v.minCLIVersion},
+		{maxCLIVersionFlag, v.maxCLIVersion},
+		{minEnvTemplateVersionFlag, v.minEnvTemplateVersion},
+		{maxEnvTemplateVersionFlag, v.maxEnvTemplateVersion},
+	}
+	for _, b := range bounds {
+		if b.value != "" && !semver.IsValid(canonicalVersion(b.value)) {
+			return fmt.Errorf("--%s: %q is not a valid semantic version, such as v1.32.0", b.flag, b.value)
+		}
+	}
+	if err := validateVersionRange(v.minCLIVersion, v.maxCLIVersion); err != nil {
+		return fmt.Errorf("--%s and --%s: %w", minCLIVersionFlag, maxCLIVersionFlag, err)
+	}
+	if err := validateVersionRange(v.minEnvTemplateVersion, v.maxEnvTemplateVersion); err != nil {
+		return fmt.Errorf("--%s and --%s: %w", minEnvTemplateVersionFlag, maxEnvTemplateVersionFlag, err)
+	}
+	return nil
+}
+
+// applyTo returns the version pin with the bounds specified by the flags overridden.
+func (v versionPinVars) applyTo(pin *config.VersionPin) *config.VersionPin {
+	out := &config.VersionPin{}
+	if pin != nil {
+		*out = *pin
+	}
+	if v.minCLIVersion != "" {
+		out.MinCLIVersion = canonicalVersion(v.minCLIVersion)
+	}
+	if v.maxCLIVersion != "" {
+		out.MaxCLIVersion = canonicalVersion(v.maxCLIVersion)
+	}
+	if v.minEnvTemplateVersion != "" {
+		out.MinEnvTemplateVersion = canonicalVersion(v.minEnvTemplateVersion)
+	}
+	if v.maxEnvTemplateVersion != "" {
+		out.MaxEnvTemplateVersion = canonicalVersion(v.maxEnvTemplateVersion)
+	}
+	if out.IsEmpty() {
+		return nil
+	}
+	return out
+}
+
+func validateVersionRange(minVersion, maxVersion string) error {
+	if minVersion == "" || maxVersion == "" {
+		return nil
+	}
+	if semver.Compare(canonicalVersion(minVersion), canonicalVersion(maxVersion)) > 0 {
+		return fmt.Errorf("minimum version %s is greater than maximum version %s", minVersion, maxVersion)
+	}
+	return nil
+}
+
+// canonicalVersion adds the "v" prefix expected by the semver package to a version.
+func canonicalVersion(v string) string {
+	if v == "" || strings.HasPrefix(v, "v") {
+		return v
+	}
+	return "v" + v
+}
+
+// validateVersionPin returns an error if the application pins the Copilot CLI versions, or the environment template
+// versions when envTemplateVersion is not empty, to a range that doesn't include the versions used by the command.
+func validateVersionPin(getter applicationGetter, appName, cliVersion, envTemplateVersion string) error {
+	app, err := getter.GetApplication(appName)
+	if err != nil {
+		return fmt.Errorf("get application %s: %w", appName, err)
+	}
+	pin := app.VersionPin
+	if pin.IsEmpty() {
+		return nil
+	}
+	if !semver.IsValid(cliVersion) {
+		// Development builds aren't versioned, so they can't be compared against the pin.
+		log.Warningf("Skip checking the version pin of application %s since this Copilot CLI has no release version.\n", appName)
+		return nil
+	}
+	if err := checkVersionPin(appName, versionPinComponentCLI, cliVersion, pin.MinCLIVersion, pin.MaxCLIVersion); err != nil {
+		return err
+	}
+	if envTemplateVersion == "" {
+		return nil
+	}
+	return checkVersionPin(appName, versionPinComponentEnvTemplate, envTemplateVersion, pin.MinEnvTemplateVersion, pin.MaxEnvTemplateVersion)
+}
+
+func checkVersionPin(app, component, version, minVersion, maxVersion string) error {
+	if (minVersion == "" || semver.Compare(version, minVersion) >= 0) && (maxVersion == "" || semver.Compare(version, maxVersion) <= 0) {
+		return nil
+	}
+	return &errVersionNotAllowed{
+		app:       app,
+		component: component,
+		version:   version,
+		min:       minVersion,
+		max:       maxVersion,
+	}
+}
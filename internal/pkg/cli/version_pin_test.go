@@ -0,0 +1,294 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionPinVars_validate(t *testing.T) {
+	testCases := map[string]struct {
+		in          versionPinVars
+		wantedError error
+	}{
+		"valid without a pin": {},
+		"valid with versions with or without the v prefix": {
+			in: versionPinVars{
+				minCLIVersion:         "1.32.0",
+				maxCLIVersion:         "v1.33.0",
+				minEnvTemplateVersion: "v1.32.0",
+				maxEnvTemplateVersion: "v1.32.0",
+			},
+		},
+		"error if a version is not a semantic version": {
+			in: versionPinVars{
+				maxEnvTemplateVersion: "latest",
+			},
+			wantedError: errors.New(`--max-env-template-version: "latest" is not a valid semantic version, such as v1.32.0`),
+		},
+		"error if the minimum CLI version is greater than the maximum": {
+			in: versionPinVars{
+				minCLIVersion: "v1.33.0",
+				maxCLIVersion: "1.32.0",
+			},
+			wantedError: errors.New("--min-cli-version and --max-cli-version: minimum version v1.33.0 is greater than maximum version 1.32.0"),
+		},
+		"error if the minimum env template version is greater than the maximum": {
+			in: versionPinVars{
+				minEnvTemplateVersion: "v1.33.0",
+				maxEnvTemplateVersion: "v1.32.0",
+			},
+			wantedError: errors.New("--min-env-template-version and --max-env-template-version: minimum version v1.33.0 is greater than maximum version v1.32.0"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.in.validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestVersionPinVars_applyTo(t *testing.T) {
+	testCases := map[string]struct {
+		in    versionPinVars
+		inPin *config.VersionPin
+
+		wanted *config.VersionPin
+	}{
+		"no pin": {},
+		"creates a new pin": {
+			in: versionPinVars{
+				minCLIVersion: "1.32.0",
+			},
+			wanted: &config.VersionPin{
+				MinCLIVersion: "v1.32.0",
+			},
+		},
+		"overrides the specified bounds of an existing pin": {
+			in: versionPinVars{
+				maxCLIVersion:         "v1.34.0",
+				minEnvTemplateVersion: "v1.33.0",
+			},
+			inPin: &config.VersionPin{
+				MinCLIVersion: "v1.32.0",
+				MaxCLIVersion: "v1.33.0",
+			},
+			wanted: &config.VersionPin{
+				MinCLIVersion:         "v1.32.0",
+				MaxCLIVersion:         "v1.34.0",
+				MinEnvTemplateVersion: "v1.33.0",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.applyTo(tc.inPin))
+		})
+	}
+}
+
+func Test_validateVersionPin(t *testing.T) {
+	testCases := map[string]struct {
+		inCLIVersion         string
+		inEnvTemplateVersion string
+		mockStore            func(m *mocks.MockapplicationGetter)
+
+		wantedError error
+	}{
+		"error if fail to get the application": {
+			inCLIVersion: "v1.32.0",
+			mockStore: func(m *mocks.MockapplicationGetter) {
+				m.EXPECT().GetApplication("phonetool").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get application phonetool: some error"),
+		},
+		"any version is allowed if the application is not pinned": {
+			inCLIVersion: "v1.20.0",
+			mockStore: func(m *mocks.MockapplicationGetter) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+			},
+		},
+		"skip the check for development builds": {
+			inCLIVersion: "dev",
+			mockStore: func(m *mocks.MockapplicationGetter) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{
+					Name: "phonetool",
+					VersionPin: &config.VersionPin{
+						MinCLIVersion: "v1.32.0",
+					},
+				}, nil)
+			},
+		},
+		"error if the CLI is older than the minimum version": {
+			inCLIVersion: "v1.31.1",
+			mockStore: func(m *mocks.MockapplicationGetter) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{
+					Name: "phonetool",
+					VersionPin: &config.VersionPin{
+						MinCLIVersion: "v1.32.0",
+					},
+				}, nil)
+			},
+			wantedError: errors.New(`application "phonetool" only allows Copilot CLI versions v1.32.0 or later, but this command uses version v1.31.1`),
+		},
+		"error if the CLI is newer than the maximum version": {
+			inCLIVersion: "v1.34.0",
+			mockStore: func(m *mocks.MockapplicationGetter) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{
+					Name: "phonetool",
+					VersionPin: &config.VersionPin{
+						MinCLIVersion: "v1.32.0",
+						MaxCLIVersion: "v1.33.0",
+					},
+				}, nil)
+			},
+			wantedError: errors.New(`application "phonetool" only allows Copilot CLI versions between v1.32.0 and v1.33.0, but this command uses version v1.34.0`),
+		},
+		"error if the environment template is older than the minimum version": {
+			inCLIVersion:         "v1.32.0",
+			inEnvTemplateVersion: "v1.32.0",
+			mockStore: func(m *mocks.MockapplicationGetter) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{
+					Name: "phonetool",
+					VersionPin: &config.VersionPin{
+						MinCLIVersion:         "v1.31.0",
+						MinEnvTemplateVersion: "v1.33.0",
+					},
+				}, nil)
+			},
+			wantedError: errors.New(`application "phonetool" only allows environment template versions v1.33.0 or later, but this command uses version v1.32.0`),
+		},
+		"environment template versions are not checked by commands that don't deploy environments": {
+			inCLIVersion: "v1.32.0",
+			mockStore: func(m *mocks.MockapplicationGetter) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{
+					Name: "phonetool",
+					VersionPin: &config.VersionPin{
+						MaxEnvTemplateVersion: "v1.31.0",
+					},
+				}, nil)
+			},
+		},
+		"versions within the pin are allowed": {
+			inCLIVersion:         "v1.32.0",
+			inEnvTemplateVersion: "v1.32.0",
+			mockStore: func(m *mocks.MockapplicationGetter) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{
+					Name: "phonetool",
+					VersionPin: &config.VersionPin{
+						MinCLIVersion:         "v1.32.0",
+						MaxCLIVersion:         "v1.32.0",
+						MaxEnvTemplateVersion: "v1.33.0",
+					},
+				}, nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapplicationGetter(ctrl)
+			tc.mockStore(m)
+
+			err := validateVersionPin(m, "phonetool", tc.inCLIVersion, tc.inEnvTemplateVersion)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestVersionPinnedCommands(t *testing.T) {
+	testCases := map[string]cmd{
+		"svc pause":              &svcPauseOpts{},
+		"svc resume":             &resumeSvcOpts{},
+		"svc rollback":           &svcRollbackOpts{},
+		"svc autoscale override": &svcAutoscaleOverrideOpts{},
+		"svc cleanup":            &svcCleanupOpts{},
+		"svc redrive":            &svcRedriveOpts{},
+		"task run":               &runTaskOpts{},
+		"job run":                &jobRunOpts{},
+		"secret init":            &secretInitOpts{},
+		"preview down":           &previewDownOpts{},
+		"task delete":            &deleteTaskOpts{},
+		"env tunnel":             &envTunnelOpts{},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, ok := tc.(versionPinnedCommand)
+			require.True(t, ok, "command must validate the version pin of the application")
+		})
+	}
+}
+
+func TestRunTaskOpts_ValidateVersionPin(t *testing.T) {
+	t.Run("skip tasks that don't run in an application", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		opts := &runTaskOpts{
+			store: mocks.NewMockstore(ctrl),
+		}
+
+		require.NoError(t, opts.ValidateVersionPin())
+	})
+	t.Run("validate the version pin of the application", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := mocks.NewMockstore(ctrl)
+		m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+		opts := &runTaskOpts{
+			runTaskVars: runTaskVars{
+				appName: "phonetool",
+			},
+			store: m,
+		}
+
+		require.NoError(t, opts.ValidateVersionPin())
+	})
+}
+
+func TestDeleteTaskOpts_ValidateVersionPin(t *testing.T) {
+	t.Run("skip tasks that don't run in an application", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		opts := &deleteTaskOpts{
+			deleteTaskVars: deleteTaskVars{
+				defaultCluster: true,
+			},
+			store: mocks.NewMockstore(ctrl),
+		}
+
+		require.NoError(t, opts.ValidateVersionPin())
+	})
+	t.Run("validate the version pin of the application", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := mocks.NewMockstore(ctrl)
+		m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+		opts := &deleteTaskOpts{
+			deleteTaskVars: deleteTaskVars{
+				app: "phonetool",
+			},
+			store: m,
+		}
+
+		require.NoError(t, opts.ValidateVersionPin())
+	})
+}
@@ -21,6 +21,35 @@ type Application struct {
 	DomainHostedZoneID  string            `json:"domainHostedZoneID"`            // Existing domain hosted zone in Route53. An empty domain name means the user does not have one.
 	Version             string            `json:"version"`                       // The version of the app layout in the underlying datastore (e.g. SSM).
 	Tags                map[string]string `json:"tags,omitempty"`                // Labels to apply to resources created within the app.
+	ImageLifecycle      *ImageLifecycle   `json:"imageLifecycle,omitempty"`      // Lifecycle policy for the ECR repositories of the app's workloads.
+	CFNExecutionRoleARN string            `json:"cfnExecutionRoleARN,omitempty"` // Existing IAM role assumed by CloudFormation to deploy the app's stacks.
+	CIRoleARNs          []string          `json:"ciRoleARNs,omitempty"`          // IAM roles of external CI systems allowed to push images and read artifacts.
+	VersionPin          *VersionPin       `json:"versionPin,omitempty"`          // Versions of Copilot allowed to update the app's resources.
+}
+
+// VersionPin holds the inclusive ranges of Copilot CLI and environment template versions allowed to update an application.
+// An empty bound doesn't restrict the versions.
+type VersionPin struct {
+	MinCLIVersion         string `json:"minCLIVersion,omitempty"`
+	MaxCLIVersion         string `json:"maxCLIVersion,omitempty"`
+	MinEnvTemplateVersion string `json:"minEnvTemplateVersion,omitempty"`
+	MaxEnvTemplateVersion string `json:"maxEnvTemplateVersion,omitempty"`
+}
+
+// IsEmpty returns true if the pin doesn't restrict any version.
+func (p *VersionPin) IsEmpty() bool {
+	return p == nil || (p.MinCLIVersion == "" && p.MaxCLIVersion == "" && p.MinEnvTemplateVersion == "" && p.MaxEnvTemplateVersion == "")
+}
+
+// ImageLifecycle holds the lifecycle policy applied to the ECR repositories created by Copilot.
+type ImageLifecycle struct {
+	KeepLastImages          int `json:"keepLastImages,omitempty" yaml:"KeepLastImages,omitempty"`                   // Number of most recent images to keep.
+	ExpireUntaggedAfterDays int `json:"expireUntaggedAfterDays,omitempty" yaml:"ExpireUntaggedAfterDays,omitempty"` // Days after which untagged images expire.
+}
+
+// IsEmpty returns true if the lifecycle policy does not expire any images.
+func (l *ImageLifecycle) IsEmpty() bool {
+	return l == nil || (l.KeepLastImages == 0 && l.ExpireUntaggedAfterDays == 0)
 }
 
 // CreateApplication instantiates a new application, validates its uniqueness and stores it in SSM.
@@ -23,6 +23,8 @@ type Environment struct {
 	ExecutionRoleARN string `json:"executionRoleARN"` // ARN used by CloudFormation to make modification to the environment stack.
 	ManagerRoleARN   string `json:"managerRoleARN"`   // ARN for the manager role assumed to manipulate the environment and its services.
 
+	CustomExecutionRole bool `json:"customExecutionRole,omitempty"` // True means ExecutionRoleARN is an existing role provided by the user instead of the one created by Copilot.
+
 	// Fields that store user configuration is no longer updated, but kept for retrofitting purpose.
 	CustomConfig *CustomizeEnv `json:"customConfig,omitempty"` // Deprecated. Custom environment configuration by users. This configuration is now available in the env manifest.
 	Telemetry    *Telemetry    `json:"telemetry,omitempty"`    // Deprecated. Optional environment telemetry features. This configuration is now available in the env manifest.
@@ -9,20 +9,24 @@ import (
 	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/copilot-cli/internal/pkg/config"
 )
 
 const appDNSDelegationRoleName = "DNSDelegationRole"
 
 // CreateAppInput holds the fields required to create an application stack set.
 type CreateAppInput struct {
-	Name                  string            // Name of the application that needs to be created.
-	AccountID             string            // AWS account ID to administrate the application.
-	DNSDelegationAccounts []string          // Accounts to grant DNS access to for this application.
-	DomainName            string            // DNS Name used for this application.
-	DomainHostedZoneID    string            // Hosted Zone ID for the domain.
-	PermissionsBoundary   string            // Name of the IAM Managed Policy to set a permissions boundary.
-	AdditionalTags        map[string]string // AdditionalTags are labels applied to resources under the application.
-	Version               string            // The version of the application template to create the stack/stackset. If empty, creates the legacy stack/stackset.
+	Name                  string                 // Name of the application that needs to be created.
+	AccountID             string                 // AWS account ID to administrate the application.
+	DNSDelegationAccounts []string               // Accounts to grant DNS access to for this application.
+	DomainName            string                 // DNS Name used for this application.
+	DomainHostedZoneID    string                 // Hosted Zone ID for the domain.
+	PermissionsBoundary   string                 // Name of the IAM Managed Policy to set a permissions boundary.
+	AdditionalTags        map[string]string      // AdditionalTags are labels applied to resources under the application.
+	Version               string                 // The version of the application template to create the stack/stackset. If empty, creates the legacy stack/stackset.
+	ImageLifecycle        *config.ImageLifecycle // Lifecycle policy for the ECR repositories of the application's workloads.
+	CFNExecutionRoleARN   string                 // Existing IAM role assumed by CloudFormation to deploy the application stack. If empty, uses the caller's credentials.
+	CIRoleARNs            []string               // IAM roles of external CI systems allowed to push to the ECR repositories and read the artifact buckets.
 }
 
 // AppInformation holds information about the application that need to be propagated to the env stacks and workload stacks.
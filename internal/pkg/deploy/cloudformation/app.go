@@ -42,7 +42,7 @@ func (e *errNoRegionalResources) Error() string {
 // template that we update and all regional stacks are updated.
 func (cf CloudFormation) DeployApp(in *deploy.CreateAppInput) error {
 	appConfig := stack.NewAppStackConfig(in)
-	s, err := toStack(appConfig)
+	s, err := toAppStack(appConfig)
 	if err != nil {
 		return err
 	}
@@ -55,7 +55,9 @@ func (cf CloudFormation) DeployApp(in *deploy.CreateAppInput) error {
 	}
 
 	blankAppTemplate, err := appConfig.ResourceTemplate(&stack.AppResourcesConfig{
-		App: appConfig.Name,
+		App:            appConfig.Name,
+		ImageLifecycle: in.ImageLifecycle,
+		CIRoleARNs:     in.CIRoleARNs,
 	})
 	if err != nil {
 		return err
@@ -101,6 +103,13 @@ func (cf CloudFormation) upgradeAppStackSet(config *stack.AppStackConfig) error
 			return err
 		}
 		previouslyDeployedConfig.Version += 1
+		if config.ImageLifecycle != nil {
+			// Backfill the lifecycle policy onto the existing repositories.
+			previouslyDeployedConfig.ImageLifecycle = config.ImageLifecycle
+		}
+		if config.CIRoleARNs != nil {
+			previouslyDeployedConfig.CIRoleARNs = config.CIRoleARNs
+		}
 		err = cf.deployAppConfig(config, previouslyDeployedConfig, true /* updating template resources should update all instances*/)
 		if err == nil {
 			return nil
@@ -114,7 +123,7 @@ func (cf CloudFormation) upgradeAppStackSet(config *stack.AppStackConfig) error
 }
 
 func (cf CloudFormation) upgradeAppStack(conf *stack.AppStackConfig) error {
-	s, err := toStack(conf)
+	s, err := toAppStack(conf)
 	if err != nil {
 		return err
 	}
@@ -138,6 +147,18 @@ func (cf CloudFormation) upgradeAppStack(conf *stack.AppStackConfig) error {
 	return cf.executeAndRenderChangeSet(in)
 }
 
+// toAppStack returns the application stack, deployed with the CloudFormation execution role of the application if any.
+func toAppStack(conf *stack.AppStackConfig) (*cloudformation.Stack, error) {
+	s, err := toStack(conf)
+	if err != nil {
+		return nil, err
+	}
+	if conf.CFNExecutionRoleARN != "" {
+		cloudformation.WithRoleARN(conf.CFNExecutionRoleARN)(s)
+	}
+	return s, nil
+}
+
 // removeDNSDelegationAndCrossAccountAccess removes the provided account ID from the list of accounts that can write to the
 // application's DNS HostedZone. It does this by creating the new list of DNS delegated accounts, updating the app
 // infrastructure roles stack, then redeploying all the stackset instances with the new list of accounts.
@@ -172,9 +193,10 @@ func (cf CloudFormation) removeDNSDelegationAndCrossAccountAccess(appStack *stac
 		PermissionsBoundary:   appStack.PermissionsBoundary,
 		AdditionalTags:        appStack.AdditionalTags,
 		Version:               appStack.Version,
+		CFNExecutionRoleARN:   appStack.CFNExecutionRoleARN,
 	})
 	// Redeploy the infrastructure roles stack.
-	s, err := toStack(newCfg)
+	s, err := toAppStack(newCfg)
 	if err != nil {
 		return err
 	}
@@ -197,6 +219,9 @@ func (cf CloudFormation) removeDNSDelegationAndCrossAccountAccess(appStack *stac
 		Workloads: appResourcesConfig.Workloads,
 		Accounts:  newAccountList,
 		App:       appResourcesConfig.App,
+
+		ImageLifecycle: appResourcesConfig.ImageLifecycle,
+		CIRoleARNs:     appResourcesConfig.CIRoleARNs,
 	}
 	if err := cf.deployAppConfig(newCfg, newDeploymentConfig, true); err != nil {
 		return err
@@ -208,11 +233,12 @@ func (cf CloudFormation) removeDNSDelegationAndCrossAccountAccess(appStack *stac
 // DNS HostedZone. This allows us to perform cross account DNS delegation.
 func (cf CloudFormation) DelegateDNSPermissions(app *config.Application, accountID string) error {
 	deployApp := deploy.CreateAppInput{
-		Name:               app.Name,
-		AccountID:          app.AccountID,
-		DomainName:         app.Domain,
-		DomainHostedZoneID: app.DomainHostedZoneID,
-		Version:            version.LatestTemplateVersion(),
+		Name:                app.Name,
+		AccountID:           app.AccountID,
+		DomainName:          app.Domain,
+		DomainHostedZoneID:  app.DomainHostedZoneID,
+		Version:             version.LatestTemplateVersion(),
+		CFNExecutionRoleARN: app.CFNExecutionRoleARN,
 	}
 
 	appConfig := stack.NewAppStackConfig(&deployApp)
@@ -224,7 +250,7 @@ func (cf CloudFormation) DelegateDNSPermissions(app *config.Application, account
 	dnsDelegatedAccounts := stack.DNSDelegatedAccountsForStack(appStack.SDK())
 	deployApp.DNSDelegationAccounts = append(dnsDelegatedAccounts, accountID)
 
-	s, err := toStack(stack.NewAppStackConfig(&deployApp))
+	s, err := toAppStack(stack.NewAppStackConfig(&deployApp))
 	if err != nil {
 		return err
 	}
@@ -364,6 +390,9 @@ func (cf CloudFormation) addWorkloadToApp(app *config.Application, wlName string
 		Workloads: wlList,
 		Accounts:  previouslyDeployedConfig.Accounts,
 		App:       appConfig.Name,
+
+		ImageLifecycle: previouslyDeployedConfig.ImageLifecycle,
+		CIRoleARNs:     previouslyDeployedConfig.CIRoleARNs,
 	}
 	if err := cf.deployAppConfig(appConfig, &newDeploymentConfig, shouldAddNewWl); err != nil {
 		return err
@@ -372,6 +401,50 @@ func (cf CloudFormation) addWorkloadToApp(app *config.Application, wlName string
 	return nil
 }
 
+// UpdateWorkloadImageLifecycle sets the lifecycle policy of the ECR repository of a workload.
+// If the lifecycle is empty, the repository falls back to the lifecycle policy of the application.
+func (cf CloudFormation) UpdateWorkloadImageLifecycle(app *config.Application, wlName string, lifecycle *config.ImageLifecycle) error {
+	appConfig := stack.NewAppStackConfig(&deploy.CreateAppInput{
+		Name:           app.Name,
+		AccountID:      app.AccountID,
+		AdditionalTags: app.Tags,
+		Version:        version.LatestTemplateVersion(),
+	})
+	previouslyDeployedConfig, err := cf.getLastDeployedAppConfig(appConfig)
+	if err != nil {
+		return fmt.Errorf("get previous application %s config: %w", app.Name, err)
+	}
+	if lifecycle.IsEmpty() {
+		lifecycle = nil
+	}
+	updated := false
+	for i, wl := range previouslyDeployedConfig.Workloads {
+		if wl.Name != wlName || !wl.WithECR {
+			continue
+		}
+		if sameImageLifecycle(wl.ImageLifecycle, lifecycle) {
+			return nil
+		}
+		previouslyDeployedConfig.Workloads[i].ImageLifecycle = lifecycle
+		updated = true
+	}
+	if !updated {
+		return nil
+	}
+	previouslyDeployedConfig.Version += 1
+	if err := cf.deployAppConfig(appConfig, previouslyDeployedConfig, true /* updating template resources should update all instances*/); err != nil {
+		return fmt.Errorf("update image lifecycle of %s in application %s: %w", wlName, app.Name, err)
+	}
+	return nil
+}
+
+func sameImageLifecycle(a, b *config.ImageLifecycle) bool {
+	if a.IsEmpty() || b.IsEmpty() {
+		return a.IsEmpty() == b.IsEmpty()
+	}
+	return *a == *b
+}
+
 // RemoveServiceFromApp attempts to remove service-specific resources (ECR repositories) from the application resource stack.
 func (cf CloudFormation) RemoveServiceFromApp(app *config.Application, svcName string) error {
 	if err := cf.removeWorkloadFromApp(app, svcName); err != nil {
@@ -417,11 +490,12 @@ func (cf CloudFormation) RemoveEnvFromApp(opts *RemoveEnvFromAppOpts) error {
 	}
 
 	appConfig := stack.NewAppStackConfig(&deploy.CreateAppInput{
-		Name:               opts.App.Name,
-		AccountID:          opts.App.AccountID,
-		DomainName:         opts.App.Domain,
-		DomainHostedZoneID: opts.App.DomainHostedZoneID,
-		Version:            opts.App.Version,
+		Name:                opts.App.Name,
+		AccountID:           opts.App.AccountID,
+		DomainName:          opts.App.Domain,
+		DomainHostedZoneID:  opts.App.DomainHostedZoneID,
+		Version:             opts.App.Version,
+		CFNExecutionRoleARN: opts.App.CFNExecutionRoleARN,
 	})
 
 	if !regionHasOtherEnvs {
@@ -501,6 +575,9 @@ func (cf CloudFormation) removeWorkloadFromApp(app *config.Application, wlName s
 		Workloads: wlList,
 		Accounts:  previouslyDeployedConfig.Accounts,
 		App:       appConfig.Name,
+
+		ImageLifecycle: previouslyDeployedConfig.ImageLifecycle,
+		CIRoleARNs:     previouslyDeployedConfig.CIRoleARNs,
 	}
 	if err := cf.deployAppConfig(appConfig, &newDeploymentConfig, shouldRemoveWl); err != nil {
 		return err
@@ -553,6 +630,9 @@ func (cf CloudFormation) AddEnvToApp(opts *AddEnvToAppOpts) error {
 		Workloads: previouslyDeployedConfig.Workloads,
 		Accounts:  accountList,
 		App:       appConfig.Name,
+
+		ImageLifecycle: previouslyDeployedConfig.ImageLifecycle,
+		CIRoleARNs:     previouslyDeployedConfig.CIRoleARNs,
 	}
 
 	if err := cf.deployAppConfig(appConfig, &newDeploymentConfig, shouldAddNewAccountID); err != nil {
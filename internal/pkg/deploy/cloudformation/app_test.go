@@ -119,6 +119,36 @@ func TestCloudFormation_DeployApp(t *testing.T) {
 	}
 }
 
+func TestToAppStack(t *testing.T) {
+	testCases := map[string]struct {
+		inRoleARN string
+
+		wantedRoleARN *string
+	}{
+		"deploys with the caller's credentials by default": {},
+		"deploys with the CloudFormation execution role of the application": {
+			inRoleARN:     "arn:aws:iam::1234:role/CloudFormationServiceRole",
+			wantedRoleARN: aws.String("arn:aws:iam::1234:role/CloudFormationServiceRole"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			conf := stack.NewAppStackConfig(&deploy.CreateAppInput{
+				Name:                "testapp",
+				AccountID:           "1234",
+				Version:             "v1.29.0",
+				CFNExecutionRoleARN: tc.inRoleARN,
+			})
+
+			s, err := toAppStack(conf)
+
+			require.NoError(t, err)
+			require.Equal(t, "testapp-infrastructure-roles", s.Name)
+			require.Equal(t, tc.wantedRoleARN, s.RoleARN)
+		})
+	}
+}
+
 func TestCloudFormation_UpgradeApplication(t *testing.T) {
 	testCases := map[string]struct {
 		mockDeployer func(t *testing.T, ctrl *gomock.Controller) *CloudFormation
@@ -1919,3 +1949,120 @@ func TestCloudFormation_RemoveEnvFromApp(t *testing.T) {
 		})
 	}
 }
+
+func TestCloudFormation_UpdateWorkloadImageLifecycle(t *testing.T) {
+	mockApp := &config.Application{
+		Name:      "testapp",
+		AccountID: "1234",
+	}
+	testCases := map[string]struct {
+		lifecycle    *config.ImageLifecycle
+		mockStackSet func(t *testing.T, ctrl *gomock.Controller) stackSetClient
+		wantedError  error
+	}{
+		"skip the update if the workload doesn't have a repository": {
+			lifecycle: &config.ImageLifecycle{KeepLastImages: 5},
+			mockStackSet: func(t *testing.T, ctrl *gomock.Controller) stackSetClient {
+				m := mocks.NewMockstackSetClient(ctrl)
+				m.EXPECT().Describe(gomock.Any()).Return(stackset.Description{
+					Template: `Metadata:
+  Version: 1
+  Workloads:
+  - Name: api
+    WithECR: false`,
+				}, nil)
+				m.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				return m
+			},
+		},
+		"skip the update if the lifecycle did not change": {
+			lifecycle: &config.ImageLifecycle{KeepLastImages: 5},
+			mockStackSet: func(t *testing.T, ctrl *gomock.Controller) stackSetClient {
+				m := mocks.NewMockstackSetClient(ctrl)
+				m.EXPECT().Describe(gomock.Any()).Return(stackset.Description{
+					Template: `Metadata:
+  Version: 1
+  Workloads:
+  - Name: api
+    WithECR: true
+    ImageLifecycle:
+      KeepLastImages: 5`,
+				}, nil)
+				m.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				return m
+			},
+		},
+		"set the lifecycle of the workload": {
+			lifecycle: &config.ImageLifecycle{KeepLastImages: 5},
+			mockStackSet: func(t *testing.T, ctrl *gomock.Controller) stackSetClient {
+				m := mocks.NewMockstackSetClient(ctrl)
+				m.EXPECT().Describe(gomock.Any()).Return(stackset.Description{
+					Template: `Metadata:
+  Version: 1
+  Workloads:
+  - Name: api
+    WithECR: true
+  - Name: worker
+    WithECR: true`,
+				}, nil)
+				m.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return("", nil).
+					Do(func(_, template string, _ ...stackset.CreateOrUpdateOption) {
+						configToDeploy, err := stack.AppConfigFrom(&template)
+						require.NoError(t, err)
+						require.ElementsMatch(t, []stack.AppResourcesWorkload{
+							{Name: "api", WithECR: true, ImageLifecycle: &config.ImageLifecycle{KeepLastImages: 5}},
+							{Name: "worker", WithECR: true},
+						}, configToDeploy.Workloads)
+						require.Equal(t, 2, configToDeploy.Version)
+					})
+				return m
+			},
+		},
+		"remove the lifecycle of the workload": {
+			mockStackSet: func(t *testing.T, ctrl *gomock.Controller) stackSetClient {
+				m := mocks.NewMockstackSetClient(ctrl)
+				m.EXPECT().Describe(gomock.Any()).Return(stackset.Description{
+					Template: `Metadata:
+  Version: 1
+  Workloads:
+  - Name: api
+    WithECR: true
+    ImageLifecycle:
+      ExpireUntaggedAfterDays: 7`,
+				}, nil)
+				m.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return("", nil).
+					Do(func(_, template string, _ ...stackset.CreateOrUpdateOption) {
+						configToDeploy, err := stack.AppConfigFrom(&template)
+						require.NoError(t, err)
+						require.Equal(t, []stack.AppResourcesWorkload{{Name: "api", WithECR: true}}, configToDeploy.Workloads)
+					})
+				return m
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			cf := CloudFormation{
+				appStackSet: tc.mockStackSet(t, ctrl),
+				region:      "us-west-2",
+				renderStackSet: func(input renderStackSetInput) error {
+					_, err := input.createOpFn()
+					return err
+				},
+			}
+
+			err := cf.UpdateWorkloadImageLifecycle(mockApp, "api", tc.lifecycle)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
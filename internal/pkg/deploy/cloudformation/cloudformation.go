@@ -15,6 +15,7 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/aws/copilot-cli/internal/pkg/audit"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 
@@ -360,7 +361,14 @@ func (cf CloudFormation) newUpsertChangeSetInput(w progress.FileWriter, stack *c
 	return in
 }
 
-func (cf CloudFormation) executeAndRenderChangeSet(in *executeAndRenderChangeSetInput) error {
+func (cf CloudFormation) executeAndRenderChangeSet(in *executeAndRenderChangeSetInput) (err error) {
+	defer func() {
+		audit.RecordStack(audit.StackSummary{
+			Name:      in.stackName,
+			Operation: audit.StackOperationDeploy,
+			Status:    deployStackStatus(err, in.detach),
+		})
+	}()
 	changeSetID, err := in.createChangeSet()
 	if err != nil {
 		return err
@@ -404,6 +412,21 @@ func (cf CloudFormation) executeAndRenderChangeSet(in *executeAndRenderChangeSet
 	return g.Wait()
 }
 
+// deployStackStatus returns the status of the deployment of a stack for the audit log.
+func deployStackStatus(err error, detach bool) string {
+	var errChangeSetEmpty *cloudformation.ErrChangeSetEmpty
+	switch {
+	case errors.As(err, &errChangeSetEmpty):
+		return audit.StackStatusNoChanges
+	case err != nil:
+		return audit.StackStatusFailed
+	case detach:
+		return audit.StackStatusInProgress
+	default:
+		return audit.StackStatusSucceeded
+	}
+}
+
 // renderChangeSet renders and executes a CloudFormation change set, providing progress updates if necessary.
 // It returns the number of rendered lines and any encountered error.
 func (cf CloudFormation) renderChangeSet(ctx context.Context, changeSetID string, in *executeAndRenderChangeSetInput) (int, error) {
@@ -775,6 +798,15 @@ func (cf CloudFormation) deleteAndRenderStack(in deleteAndRenderInput) error {
 		return nil // stack already deleted.
 	}
 
+	status := audit.StackStatusFailed
+	defer func() {
+		audit.RecordStack(audit.StackSummary{
+			Name:      in.stackName,
+			Operation: audit.StackOperationDelete,
+			Status:    status,
+		})
+	}()
+
 	waitCtx, cancelWait := context.WithTimeout(context.Background(), waitForStackTimeout)
 	defer cancelWait()
 	g, ctx := errgroup.WithContext(waitCtx)
@@ -808,6 +840,7 @@ func (cf CloudFormation) deleteAndRenderStack(in deleteAndRenderInput) error {
 			return err
 		}
 	}
+	status = audit.StackStatusSucceeded
 	return nil
 }
 
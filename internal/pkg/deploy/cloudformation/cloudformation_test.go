@@ -12,6 +12,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/aws/copilot-cli/internal/pkg/audit"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation/stackset"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 
@@ -911,3 +912,33 @@ func TestCloudFormation_Template(t *testing.T) {
 		})
 	}
 }
+
+func TestDeployStackStatus(t *testing.T) {
+	testCases := map[string]struct {
+		inErr    error
+		inDetach bool
+
+		wanted string
+	}{
+		"no changes if the change set is empty": {
+			inErr:  fmt.Errorf("wrapped: %w", &cloudformation.ErrChangeSetEmpty{}),
+			wanted: audit.StackStatusNoChanges,
+		},
+		"failed on any other error": {
+			inErr:  errors.New("some error"),
+			wanted: audit.StackStatusFailed,
+		},
+		"in progress if the deployment is detached": {
+			inDetach: true,
+			wanted:   audit.StackStatusInProgress,
+		},
+		"succeeded otherwise": {
+			wanted: audit.StackStatusSucceeded,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, deployStackStatus(tc.inErr, tc.inDetach))
+		})
+	}
+}
@@ -23,11 +23,14 @@ import (
 )
 
 // CreateAndRenderEnvironment creates the CloudFormation stack for an environment, and render the stack creation to out.
-func (cf CloudFormation) CreateAndRenderEnvironment(conf StackConfiguration, bucketARN string) error {
+func (cf CloudFormation) CreateAndRenderEnvironment(conf StackConfiguration, bucketARN string, opts ...cloudformation.StackOption) error {
 	cfnStack, err := cf.toUploadedStack(bucketARN, conf)
 	if err != nil {
 		return err
 	}
+	for _, opt := range opts {
+		opt(cfnStack)
+	}
 	in := newRenderEnvironmentInput(cfnStack)
 	in.createChangeSet = func() (changeSetID string, err error) {
 		spinner := progress.NewSpinner(cf.console)
@@ -41,7 +41,7 @@ func (cf CloudFormation) PipelineExists(stackConfig StackConfiguration) (bool, e
 }
 
 // CreatePipeline sets up a new CodePipeline for deploying services.
-func (cf CloudFormation) CreatePipeline(bucketName string, stackConfig StackConfiguration) error {
+func (cf CloudFormation) CreatePipeline(bucketName string, stackConfig StackConfiguration, opts ...cloudformation.StackOption) error {
 	templateURL, err := cf.pushTemplateToS3Bucket(bucketName, stackConfig)
 	if err != nil {
 		return err
@@ -50,6 +50,9 @@ func (cf CloudFormation) CreatePipeline(bucketName string, stackConfig StackConf
 	if err != nil {
 		return err
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
 	err = cf.cfnClient.CreateAndWait(s)
 	if err != nil {
 		return err
@@ -93,7 +96,7 @@ func (cf CloudFormation) pipelinePhysicalResourceID(stackName string) (string, e
 }
 
 // UpdatePipeline updates an existing CodePipeline for deploying services.
-func (cf CloudFormation) UpdatePipeline(bucketName string, stackConfig StackConfiguration) error {
+func (cf CloudFormation) UpdatePipeline(bucketName string, stackConfig StackConfiguration, opts ...cloudformation.StackOption) error {
 	templateURL, err := cf.pushTemplateToS3Bucket(bucketName, stackConfig)
 	if err != nil {
 		return err
@@ -102,6 +105,9 @@ func (cf CloudFormation) UpdatePipeline(bucketName string, stackConfig StackConf
 	if err != nil {
 		return err
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
 	if err := cf.cfnClient.UpdateAndWait(s); err != nil {
 		var errNoUpdates *cloudformation.ErrChangeSetEmpty
 		if errors.As(err, &errNoUpdates) {
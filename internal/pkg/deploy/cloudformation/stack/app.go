@@ -4,6 +4,7 @@
 package stack
 
 import (
+	"encoding/json"
 	"fmt"
 	"sort"
 	"strings"
@@ -14,6 +15,7 @@ import (
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	"github.com/aws/copilot-cli/internal/pkg/aws/partitions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 )
@@ -35,12 +37,17 @@ type AppResourcesConfig struct {
 	Workloads []AppResourcesWorkload `yaml:"Workloads"`
 	App       string                 `yaml:"App"`
 	Version   int                    `yaml:"Version"`
+
+	ImageLifecycle *config.ImageLifecycle `yaml:"ImageLifecycle,omitempty"`
+	CIRoleARNs     []string               `yaml:"CIRoleARNs,omitempty"`
 }
 
 // AppResourcesWorkload is a workload configuration for a deployed Application StackSet
 type AppResourcesWorkload struct {
 	Name    string `yaml:"Name"`
 	WithECR bool   `yaml:"WithECR"`
+
+	ImageLifecycle *config.ImageLifecycle `yaml:"ImageLifecycle,omitempty"` // Overrides the lifecycle policy of the application for the repository of the workload.
 }
 
 // UnmarshalYAML overrides the default YAML unmarshaling logic for the Image
@@ -85,6 +92,7 @@ type AppRegionalResources struct {
 const (
 	appTemplatePath               = "app/app.yml"
 	appResourcesTemplatePath      = "app/cf.yml"
+	appEnvRolesTemplatePath       = "app/env-roles.yml"
 	appAdminRoleParamName         = "AdminRoleName"
 	appExecutionRoleParamName     = "ExecutionRoleName"
 	appDNSDelegationRoleParamName = "DNSDelegationRoleName"
@@ -144,6 +152,32 @@ func (c *AppStackConfig) Template() (string, error) {
 	return content.String(), nil
 }
 
+// EnvRolesTemplate returns a CloudFormation template that creates, in an environment account, the roles to create the
+// environments of the application: the role assumed by CloudFormation, and the role assumed from the application account
+// so that the environment can be created without credentials of the environment account.
+// The template has no parameters so that it can be deployed to many accounts at once with a StackSet.
+func (c *AppStackConfig) EnvRolesTemplate() (string, error) {
+	content, err := c.parser.Parse(appEnvRolesTemplatePath, struct {
+		TemplateVersion      string
+		Name                 string
+		AppAccountID         string
+		CFNExecutionRoleName string
+		ManagerRoleName      string
+		PermissionsBoundary  string
+	}{
+		c.Version,
+		c.Name,
+		c.AccountID,
+		NameForAppEnvCFNExecutionRole(c.Name),
+		NameForAppEnvManagerRole(c.Name),
+		c.PermissionsBoundary,
+	})
+	if err != nil {
+		return "", err
+	}
+	return content.String(), nil
+}
+
 // ResourceTemplate generates a StackSet template with all the Application-wide resources (ECR Repos, KMS keys, S3 buckets)
 func (c *AppStackConfig) ResourceTemplate(config *AppResourcesConfig) (string, error) {
 	// Sort the account IDs and Services so that the template we generate is deterministic
@@ -152,14 +186,28 @@ func (c *AppStackConfig) ResourceTemplate(config *AppResourcesConfig) (string, e
 		return config.Workloads[i].Name < config.Workloads[j].Name
 	})
 
+	lifecyclePolicies := make(map[string]string)
+	for _, wl := range config.Workloads {
+		lifecycle := config.ImageLifecycle
+		if !wl.ImageLifecycle.IsEmpty() {
+			lifecycle = wl.ImageLifecycle
+		}
+		policy, err := imageLifecyclePolicy(lifecycle)
+		if err != nil {
+			return "", err
+		}
+		lifecyclePolicies[wl.Name] = policy
+	}
 	content, err := c.parser.Parse(appResourcesTemplatePath, struct {
 		*AppResourcesConfig
-		ServiceTagKey   string
-		TemplateVersion string
+		ServiceTagKey          string
+		TemplateVersion        string
+		ImageLifecyclePolicies map[string]string
 	}{
 		config,
 		deploy.ServiceTagKey,
 		c.Version,
+		lifecyclePolicies,
 	}, template.WithFuncs(cfTemplateFunctions))
 	if err != nil {
 		return "", err
@@ -167,6 +215,62 @@ func (c *AppStackConfig) ResourceTemplate(config *AppResourcesConfig) (string, e
 	return content.String(), err
 }
 
+type ecrLifecycleRule struct {
+	RulePriority int    `json:"rulePriority"`
+	Description  string `json:"description"`
+	Selection    struct {
+		TagStatus   string `json:"tagStatus"`
+		CountType   string `json:"countType"`
+		CountUnit   string `json:"countUnit,omitempty"`
+		CountNumber int    `json:"countNumber"`
+	} `json:"selection"`
+	Action struct {
+		Type string `json:"type"`
+	} `json:"action"`
+}
+
+// imageLifecyclePolicy returns the JSON lifecycle policy text for ECR repositories.
+// It returns an empty string if the lifecycle does not expire any images.
+func imageLifecyclePolicy(lifecycle *config.ImageLifecycle) (string, error) {
+	if lifecycle.IsEmpty() {
+		return "", nil
+	}
+	var rules []ecrLifecycleRule
+	if lifecycle.ExpireUntaggedAfterDays > 0 {
+		rule := ecrLifecycleRule{
+			RulePriority: len(rules) + 1,
+			Description:  fmt.Sprintf("Expire untagged images after %d days", lifecycle.ExpireUntaggedAfterDays),
+		}
+		rule.Selection.TagStatus = "untagged"
+		rule.Selection.CountType = "sinceImagePushed"
+		rule.Selection.CountUnit = "days"
+		rule.Selection.CountNumber = lifecycle.ExpireUntaggedAfterDays
+		rule.Action.Type = "expire"
+		rules = append(rules, rule)
+	}
+	if lifecycle.KeepLastImages > 0 {
+		// A rule that selects "any" tag status must have the lowest priority.
+		rule := ecrLifecycleRule{
+			RulePriority: len(rules) + 1,
+			Description:  fmt.Sprintf("Keep the last %d images", lifecycle.KeepLastImages),
+		}
+		rule.Selection.TagStatus = "any"
+		rule.Selection.CountType = "imageCountMoreThan"
+		rule.Selection.CountNumber = lifecycle.KeepLastImages
+		rule.Action.Type = "expire"
+		rules = append(rules, rule)
+	}
+	out, err := json.Marshal(struct {
+		Rules []ecrLifecycleRule `json:"rules"`
+	}{
+		Rules: rules,
+	})
+	if err != nil {
+		return "", fmt.Errorf("marshal image lifecycle policy: %w", err)
+	}
+	return string(out), nil
+}
+
 // Parameters returns a list of parameters which accompany the app CloudFormation template.
 func (c *AppStackConfig) Parameters() ([]*cloudformation.Parameter, error) {
 	return []*cloudformation.Parameter{
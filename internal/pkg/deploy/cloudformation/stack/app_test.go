@@ -11,6 +11,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/template/mocks"
@@ -92,6 +93,85 @@ func TestAppTemplate(t *testing.T) {
 	}
 }
 
+func TestAppEnvRolesTemplate(t *testing.T) {
+	testCases := map[string]struct {
+		inPermissionsBoundary string
+
+		wantedContent    []string
+		notWantedContent []string
+	}{
+		"without a permissions boundary": {
+			wantedContent: []string{
+				"RoleName: demo-EnvCFNExecutionRole",
+				"RoleName: demo-EnvManagerRole",
+				"AWS: !Sub 'arn:${AWS::Partition}:iam::123456:root'",
+				"Resource: !GetAtt EnvironmentCFNExecutionRole.Arn",
+				"Value: demo",
+			},
+			notWantedContent: []string{"PermissionsBoundary:"},
+		},
+		"with a permissions boundary": {
+			inPermissionsBoundary: "mockPolicy",
+			wantedContent: []string{
+				"RoleName: demo-EnvCFNExecutionRole",
+				"RoleName: demo-EnvManagerRole",
+				"PermissionsBoundary: !Sub 'arn:${AWS::Partition}:iam::${AWS::AccountId}:policy/mockPolicy'",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			appStack := NewAppStackConfig(&deploy.CreateAppInput{
+				Version:             "v1.0.0",
+				AccountID:           "123456",
+				Name:                "demo",
+				PermissionsBoundary: tc.inPermissionsBoundary,
+			})
+
+			// WHEN
+			got, err := appStack.EnvRolesTemplate()
+
+			// THEN
+			require.NoError(t, err)
+			for _, content := range tc.wantedContent {
+				require.Contains(t, got, content)
+			}
+			for _, content := range tc.notWantedContent {
+				require.NotContains(t, got, content)
+			}
+			var tpl map[string]any
+			require.NoError(t, yaml.Unmarshal([]byte(got), &tpl), "template must be valid YAML")
+		})
+	}
+}
+
+func TestAppResourceTemplate_CIRoles(t *testing.T) {
+	// GIVEN
+	appStack := NewAppStackConfig(&deploy.CreateAppInput{Name: "testapp", AccountID: "1234"})
+	in := &AppResourcesConfig{
+		App:       "testapp",
+		Version:   1,
+		Workloads: []AppResourcesWorkload{{Name: "api", WithECR: true}},
+		CIRoleARNs: []string{
+			"arn:aws:iam::1234:role/github-actions",
+			"arn:aws:iam::5678:role/jenkins",
+		},
+	}
+
+	// WHEN
+	got, err := appStack.ResourceTemplate(in)
+
+	// THEN
+	require.NoError(t, err)
+	require.Contains(t, got, "Sid: AllowCIPushPull")
+	require.Contains(t, got, "Sid: AllowCIRead")
+	require.Contains(t, got, "- arn:aws:iam::5678:role/jenkins")
+	deployed, err := AppConfigFrom(&got)
+	require.NoError(t, err)
+	require.Equal(t, in.CIRoleARNs, deployed.CIRoleARNs, "CI roles must be kept in the metadata of the stack set")
+}
+
 func TestDNSDelegationAccounts(t *testing.T) {
 	testCases := map[string]struct {
 		given *deploy.CreateAppInput
@@ -162,8 +242,9 @@ func TestAppResourceTemplate(t *testing.T) {
 				m := mocks.NewMockReadParser(ctrl)
 				m.EXPECT().Parse(appResourcesTemplatePath, struct {
 					*AppResourcesConfig
-					ServiceTagKey   string
-					TemplateVersion string
+					ServiceTagKey          string
+					TemplateVersion        string
+					ImageLifecyclePolicies map[string]string
 				}{
 					&AppResourcesConfig{
 						Accounts: []string{"1234", "4567"},
@@ -176,6 +257,67 @@ func TestAppResourceTemplate(t *testing.T) {
 					},
 					deploy.ServiceTagKey,
 					"",
+					map[string]string{
+						"svc-1": "",
+						"svc-2": "",
+					},
+				}, gomock.Any()).Return(&template.Content{
+					Buffer: bytes.NewBufferString("template"),
+				}, nil)
+				c.parser = m
+			},
+
+			wantedTemplate: "template",
+		},
+		"should render template with image lifecycle policy": {
+			given: &AppResourcesConfig{
+				App: "testapp",
+				Workloads: []AppResourcesWorkload{
+					{Name: "api", WithECR: true},
+					{
+						Name:    "worker",
+						WithECR: true,
+						ImageLifecycle: &config.ImageLifecycle{
+							KeepLastImages: 3,
+						},
+					},
+				},
+				ImageLifecycle: &config.ImageLifecycle{
+					KeepLastImages:          10,
+					ExpireUntaggedAfterDays: 7,
+				},
+			},
+			mockDependencies: func(ctrl *gomock.Controller, c *AppStackConfig) {
+				m := mocks.NewMockReadParser(ctrl)
+				m.EXPECT().Parse(appResourcesTemplatePath, struct {
+					*AppResourcesConfig
+					ServiceTagKey          string
+					TemplateVersion        string
+					ImageLifecyclePolicies map[string]string
+				}{
+					&AppResourcesConfig{
+						App: "testapp",
+						Workloads: []AppResourcesWorkload{
+							{Name: "api", WithECR: true},
+							{
+								Name:    "worker",
+								WithECR: true,
+								ImageLifecycle: &config.ImageLifecycle{
+									KeepLastImages: 3,
+								},
+							},
+						},
+						ImageLifecycle: &config.ImageLifecycle{
+							KeepLastImages:          10,
+							ExpireUntaggedAfterDays: 7,
+						},
+					},
+					deploy.ServiceTagKey,
+					"",
+					map[string]string{
+						"api":    `{"rules":[{"rulePriority":1,"description":"Expire untagged images after 7 days","selection":{"tagStatus":"untagged","countType":"sinceImagePushed","countUnit":"days","countNumber":7},"action":{"type":"expire"}},{"rulePriority":2,"description":"Keep the last 10 images","selection":{"tagStatus":"any","countType":"imageCountMoreThan","countNumber":10},"action":{"type":"expire"}}]}`,
+						"worker": `{"rules":[{"rulePriority":1,"description":"Keep the last 3 images","selection":{"tagStatus":"any","countType":"imageCountMoreThan","countNumber":3},"action":{"type":"expire"}}]}`,
+					},
 				}, gomock.Any()).Return(&template.Content{
 					Buffer: bytes.NewBufferString("template"),
 				}, nil)
@@ -98,7 +98,7 @@ func (s *BackendService) Template() (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("parse exposed ports in service manifest %s: %w", s.name, err)
 	}
-	sidecars, err := convertSidecars(s.manifest.Sidecars, exposedPorts.PortsForContainer, s.rc)
+	sidecars, err := convertSidecars(s.manifest.Sidecars, s.manifest.TaskConfig, exposedPorts.PortsForContainer, s.rc)
 	if err != nil {
 		return "", fmt.Errorf("convert the sidecar configuration for service %s: %w", s.name, err)
 	}
@@ -131,8 +131,11 @@ func (s *BackendService) Template() (string, error) {
 	}
 	scTarget := s.manifest.ServiceConnectTarget(exposedPorts)
 	scOpts := template.ServiceConnectOpts{
-		Server: convertServiceConnectServer(s.manifest.Network.Connect, scTarget),
-		Client: s.manifest.Network.Connect.Enabled(),
+		Server:            convertServiceConnectServer(s.manifest.Network.Connect, scTarget),
+		AdditionalServers: convertServiceConnectAdditionalServers(s.manifest.Network.Connect, exposedPorts),
+		Client:            s.manifest.Network.Connect.Enabled(),
+		Namespace:         s.rc.EnvConnectNamespace,
+		Timeout:           convertServiceConnectTimeout(s.manifest.Network.Connect.Timeout),
 	}
 
 	albListenerConfig, err := s.convertALBListener()
@@ -145,8 +148,10 @@ func (s *BackendService) Template() (string, error) {
 		AppName:            s.app,
 		EnvName:            s.env,
 		EnvVersion:         s.rc.EnvVersion,
+		EnvKMSKeyARN:       s.rc.EnvKMSKeyARN,
 		Version:            s.rc.Version,
 		SerializedManifest: string(s.rawManifest),
+		DeployValues:       s.rc.DeployValues,
 		WorkloadType:       manifestinfo.BackendServiceType,
 		WorkloadName:       s.name,
 
@@ -165,15 +170,16 @@ func (s *BackendService) Template() (string, error) {
 		CredentialsParameter:    aws.StringValue(s.manifest.ImageConfig.Image.Credentials),
 		DeploymentConfiguration: convertDeploymentConfig(s.manifest.DeployConfig),
 		DesiredCountOnSpot:      desiredCountOnSpot,
-		DependsOn:               convertDependsOn(s.manifest.ImageConfig.Image.DependsOn),
+		DependsOn:               convertDependsOn(s.manifest.ImageConfig.Image.DependsOn.WithInitContainers(s.manifest.InitContainers)),
 		DockerLabels:            s.manifest.ImageConfig.Image.DockerLabels,
 		ExecuteCommand:          convertExecuteCommand(&s.manifest.ExecuteCommand),
 		LogConfig:               convertLogging(s.manifest.Logging),
 		NestedStack:             addonsOutputs,
-		Network:                 convertNetworkConfig(s.manifest.Network),
+		Network:                 convertNetworkConfig(s.manifest.Network, s.rc.EnvDeniesIntraEnvTraffic),
 		Publish:                 publishers,
 		PermissionsBoundary:     s.permBound,
 		Platform:                convertPlatform(s.manifest.Platform),
+		CredentialSpec:          convertCredentialSpec(s.manifest.Windows),
 		Storage:                 convertStorageOpts(s.manifest.Name, s.manifest.Storage),
 
 		// ALB configs.
@@ -193,8 +199,12 @@ func (s *BackendService) Template() (string, error) {
 
 		// Additional options for request driven web service templates.
 		Observability: template.ObservabilityOpts{
-			Tracing: strings.ToUpper(aws.StringValue(s.manifest.Observability.Tracing)),
+			Tracing:         strings.ToUpper(s.manifest.Observability.TracingVendor()),
+			SamplingRate:    s.manifest.Observability.TracingSamplingRate(),
+			CollectorConfig: s.rc.TracingConfig,
 		},
+		Cost:      convertCost(s.manifest.BackendServiceConfig.Cost),
+		AppConfig: convertAppConfig(s.manifest.BackendServiceConfig.AppConfig),
 	})
 	if err != nil {
 		return "", fmt.Errorf("parse backend service template: %w", err)
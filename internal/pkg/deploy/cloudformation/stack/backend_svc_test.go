@@ -408,7 +408,7 @@ Outputs:
 						GracePeriod:        (*time.Duration)(aws.Int64(int64(1 * time.Minute))),
 					}),
 				},
-				Stickiness:          aws.Bool(true),
+				Stickiness:          manifest.BasicToUnion[*bool, manifest.StickinessConfig](aws.Bool(true)),
 				DeregistrationDelay: (*time.Duration)(aws.Int64(int64(59 * time.Second))),
 				AllowedSourceIps:    []manifest.IPNet{"10.0.1.0/24"},
 				TargetContainer:     aws.String("envoy"),
@@ -5,6 +5,7 @@ package stack
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 
@@ -70,6 +71,15 @@ const (
 	DefaultVPCCIDR = "10.0.0.0/16"
 )
 
+// Default backup plan of the stateful resources of an environment.
+const (
+	defaultBackupSchedule      = "cron(0 5 ? * * *)" // Every day at 05:00 UTC.
+	defaultBackupRetentionDays = 35
+)
+
+// defaultBudgetAlertThreshold is the percentage of the monthly limit of an environment's budget over which subscribers are alerted.
+const defaultBudgetAlertThreshold = 100
+
 var (
 	// DefaultPublicSubnetCIDRs contains two default CIDRs for the two managed public subnets.
 	DefaultPublicSubnetCIDRs = []string{"10.0.0.0/24", "10.0.1.0/24"}
@@ -100,6 +110,7 @@ type EnvConfig struct {
 	// Runtime configurations.
 	Addons              *Addons
 	CustomResourcesURLs map[string]string //  Mapping of Custom Resource Function Name to the S3 URL where the function zip file is stored.
+	TaskRunnerBinaryURL string            // URL of the Copilot binary run by the task runner of the environment.
 
 	// User inputs.
 	ImportVPCConfig     *config.ImportVPC     // Optional configuration if users have an existing VPC.
@@ -214,6 +225,12 @@ func (e *Env) Template() (string, error) {
 		PrivateHTTPConfig:    e.privateHTTPConfig(),
 		Telemetry:            e.telemetryConfig(),
 		CDNConfig:            e.cdnConfig(),
+		KMSKeyARN:            e.in.Mft.KMSKeyARN(),
+		ImportedCluster:      e.in.Mft.ImportedClusterName(),
+		RemoteTaskRunner:     e.remoteTaskRunnerConfig(),
+		Backups:              e.backupConfig(),
+		Budget:               e.budgetConfig(),
+		ELBAccessLogsBucket:  e.elbAccessLogsBucketConfig(),
 
 		LatestVersion:      e.in.Version,
 		SerializedManifest: string(e.in.RawMft),
@@ -489,6 +506,7 @@ func (e *Env) cdnConfig() *template.CDNConfig {
 	config := &template.CDNConfig{
 		ImportedCertificate: mftConfig.Certificate,
 		TerminateTLS:        aws.BoolValue(mftConfig.TerminateTLS),
+		ResponseHeaders:     convertResponseHeadersConfig(e.in.Mft.EnvironmentConfig.HTTPConfig.Public.ResponseHeaders),
 	}
 	if !mftConfig.Static.IsEmpty() {
 		config.Static = &template.CDNStaticAssetConfig{
@@ -500,11 +518,69 @@ func (e *Env) cdnConfig() *template.CDNConfig {
 	return config
 }
 
+func (e *Env) backupConfig() *template.BackupConfig {
+	if e.in.Mft == nil || !e.in.Mft.BackupsEnabled() {
+		return nil
+	}
+	config := &template.BackupConfig{
+		Schedule:      defaultBackupSchedule,
+		RetentionDays: defaultBackupRetentionDays,
+	}
+	if e.in.Mft.Backups.Schedule != nil {
+		config.Schedule = aws.StringValue(e.in.Mft.Backups.Schedule)
+	}
+	if e.in.Mft.Backups.Retention != nil {
+		config.RetentionDays = aws.IntValue(e.in.Mft.Backups.Retention)
+	}
+	return config
+}
+
+func (e *Env) remoteTaskRunnerConfig() *template.RemoteTaskRunnerConfig {
+	if e.in.Mft == nil || !e.in.Mft.RemoteTaskRunnerEnabled() {
+		return nil
+	}
+	return &template.RemoteTaskRunnerConfig{
+		BinaryURL: e.in.TaskRunnerBinaryURL,
+	}
+}
+
+func (e *Env) budgetConfig() *template.BudgetConfig {
+	if e.in.Mft == nil || e.in.Mft.Budget.IsEmpty() {
+		return nil
+	}
+	config := &template.BudgetConfig{
+		MonthlyLimit:   aws.Float64Value(e.in.Mft.Budget.MonthlyLimit),
+		AlertThreshold: defaultBudgetAlertThreshold,
+		Emails:         e.in.Mft.Budget.Emails,
+		Guardrail:      e.in.Mft.BudgetGuardrailEnabled(),
+	}
+	if e.in.Mft.Budget.AlertThreshold != nil {
+		config.AlertThreshold = aws.IntValue(e.in.Mft.Budget.AlertThreshold)
+	}
+	return config
+}
+
+func (e *Env) elbAccessLogsBucketConfig() *template.ELBAccessLogsBucketConfig {
+	if e.in.Mft == nil || e.in.Mft.ELBAccessLogsBucket.IsEmpty() {
+		return nil
+	}
+	buckets := e.in.Mft.ELBAccessLogsBucket
+	return &template.ELBAccessLogsBucketConfig{
+		AccessLogsBucket: aws.StringValue(buckets.AccessLogs.BucketName),
+		AccessLogsPrefix: aws.StringValue(buckets.AccessLogs.Prefix),
+		ObjectLockMode:   strings.ToUpper(aws.StringValue(buckets.ObjectLock.Mode)),
+		ObjectLockDays:   aws.IntValue(buckets.ObjectLock.Retention),
+		TLSOnly:          aws.BoolValue(buckets.TLSOnly),
+	}
+}
+
 func (e *Env) publicHTTPConfig() template.PublicHTTPConfig {
 	return template.PublicHTTPConfig{
 		HTTPConfig: template.HTTPConfig{
 			ImportedCertARNs: e.importPublicCertARNs(),
 			SSLPolicy:        e.getPublicSSLPolicy(),
+			MutualTLS:        convertMutualTLSConfig(e.in.Mft.EnvironmentConfig.HTTPConfig.Public.MutualTLS),
+			ResponseHeaders:  convertResponseHeadersConfig(e.in.Mft.EnvironmentConfig.HTTPConfig.Public.ResponseHeaders),
 		},
 		PublicALBSourceIPs: e.in.PublicALBSourceIPs,
 		CIDRPrefixListIDs:  e.in.CIDRPrefixListIDs,
@@ -517,6 +593,8 @@ func (e *Env) privateHTTPConfig() template.PrivateHTTPConfig {
 		HTTPConfig: template.HTTPConfig{
 			ImportedCertARNs: e.importPrivateCertARNs(),
 			SSLPolicy:        e.getPrivateSSLPolicy(),
+			MutualTLS:        convertMutualTLSConfig(e.in.Mft.EnvironmentConfig.HTTPConfig.Private.MutualTLS),
+			ResponseHeaders:  convertResponseHeadersConfig(e.in.Mft.EnvironmentConfig.HTTPConfig.Private.ResponseHeaders),
 		},
 		CustomALBSubnets: e.internalALBSubnets(),
 	}
@@ -531,12 +609,19 @@ func (e *Env) vpcConfig() (template.VPCConfig, error) {
 	if err != nil {
 		return template.VPCConfig{}, err
 	}
+	dnsResolver, err := convertDNSResolver(e.in.Mft.Network.VPC.DNSResolver)
+	if err != nil {
+		return template.VPCConfig{}, err
+	}
 	return template.VPCConfig{
 		Imported:            e.importVPC(),
 		Managed:             e.managedVPC(),
 		AllowVPCIngress:     e.in.Mft.HTTPConfig.Private.HasVPCIngress(),
 		SecurityGroupConfig: securityGroupConfig,
 		FlowLogs:            flowLogs,
+		AppRunnerConnectors: convertAppRunnerConnectors(e.in.Mft.Network.VPC.AppRunnerConnectors),
+		DHCPOptions:         convertDHCPOptions(e.in.Mft.Network.VPC.DHCPOptions),
+		DNSResolver:         dnsResolver,
 	}, nil
 }
 
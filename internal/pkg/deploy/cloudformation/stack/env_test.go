@@ -1048,6 +1048,151 @@ func TestEnv_Tags(t *testing.T) {
 	require.ElementsMatch(t, expectedTags, env.Tags())
 }
 
+func TestEnv_backupConfig(t *testing.T) {
+	testCases := map[string]struct {
+		inManifest string
+
+		wanted *template.BackupConfig
+	}{
+		"no backups": {
+			inManifest: `name: test
+type: Environment`,
+		},
+		"default schedule": {
+			inManifest: `name: prod
+type: Environment
+backups:
+  retention: 7`,
+			wanted: &template.BackupConfig{
+				Schedule:      "cron(0 5 ? * * *)",
+				RetentionDays: 7,
+			},
+		},
+		"default retention": {
+			inManifest: `name: prod
+type: Environment
+backups:
+  schedule: cron(0 12 ? * * *)`,
+			wanted: &template.BackupConfig{
+				Schedule:      "cron(0 12 ? * * *)",
+				RetentionDays: 35,
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mft, err := manifest.UnmarshalEnvironment([]byte(tc.inManifest))
+			require.NoError(t, err)
+			env := &Env{
+				in: &EnvConfig{
+					Mft: mft,
+				},
+			}
+
+			require.Equal(t, tc.wanted, env.backupConfig())
+		})
+	}
+}
+
+func TestEnv_budgetConfig(t *testing.T) {
+	testCases := map[string]struct {
+		inManifest string
+
+		wanted *template.BudgetConfig
+	}{
+		"no budget": {
+			inManifest: `name: test
+type: Environment`,
+		},
+		"default alert threshold": {
+			inManifest: `name: prod
+type: Environment
+budget:
+  monthly_limit: 500`,
+			wanted: &template.BudgetConfig{
+				MonthlyLimit:   500,
+				AlertThreshold: 100,
+			},
+		},
+		"with alerts and guardrail": {
+			inManifest: `name: prod
+type: Environment
+budget:
+  monthly_limit: 99.5
+  alert_threshold: 80
+  emails: [ops@example.com]
+  guardrail: true`,
+			wanted: &template.BudgetConfig{
+				MonthlyLimit:   99.5,
+				AlertThreshold: 80,
+				Emails:         []string{"ops@example.com"},
+				Guardrail:      true,
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mft, err := manifest.UnmarshalEnvironment([]byte(tc.inManifest))
+			require.NoError(t, err)
+			env := &Env{
+				in: &EnvConfig{
+					Mft: mft,
+				},
+			}
+
+			require.Equal(t, tc.wanted, env.budgetConfig())
+		})
+	}
+}
+
+func TestEnv_elbAccessLogsBucketConfig(t *testing.T) {
+	testCases := map[string]struct {
+		inManifest string
+
+		wanted *template.ELBAccessLogsBucketConfig
+	}{
+		"no hardening of the access logs bucket": {
+			inManifest: `name: test
+type: Environment`,
+		},
+		"hardened access logs bucket": {
+			inManifest: `name: prod
+type: Environment
+http:
+  public:
+    access_logs: true
+elb_access_logs_bucket:
+  access_logs:
+    bucket_name: central-access-logs
+    prefix: copilot/
+  object_lock:
+    mode: governance
+    retention: 30
+  tls_only: true`,
+			wanted: &template.ELBAccessLogsBucketConfig{
+				AccessLogsBucket: "central-access-logs",
+				AccessLogsPrefix: "copilot/",
+				ObjectLockMode:   "GOVERNANCE",
+				ObjectLockDays:   30,
+				TLSOnly:          true,
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mft, err := manifest.UnmarshalEnvironment([]byte(tc.inManifest))
+			require.NoError(t, err)
+			env := &Env{
+				in: &EnvConfig{
+					Mft: mft,
+				},
+			}
+
+			require.Equal(t, tc.wanted, env.elbAccessLogsBucketConfig())
+		})
+	}
+}
+
 func TestStackName(t *testing.T) {
 	deploymentInput := mockDeployEnvironmentInput()
 	env := &Env{
@@ -75,6 +75,14 @@ func TestGHPipeline_Template(t *testing.T) {
 		},
 		AdditionalTags: nil,
 		Version:        "v1.28.0",
+		GitHubStatus: &deploy.GitHubStatusReport{
+			Repository:        "aws/phonetool",
+			AccessTokenSecret: "github-token",
+			Deployments:       true,
+		},
+		CustomResourcesURLs: map[string]string{
+			"GitHubStatusFunction": "https://fancy-bucket.s3.us-west-2.amazonaws.com/manual/scripts/custom-resources/githubstatusfunction/abc.zip",
+		},
 	})
 
 	actual, err := ps.Template()
@@ -143,7 +143,7 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("parse exposed ports in service manifest %s: %w", s.name, err)
 	}
-	sidecars, err := convertSidecars(s.manifest.Sidecars, exposedPorts.PortsForContainer, s.rc)
+	sidecars, err := convertSidecars(s.manifest.Sidecars, s.manifest.TaskConfig, exposedPorts.PortsForContainer, s.rc)
 	if err != nil {
 		return "", fmt.Errorf("convert the sidecar configuration for service %s: %w", s.name, err)
 	}
@@ -187,10 +187,21 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 	if err != nil {
 		return "", err
 	}
+	cdnConfig, err := s.convertCDN()
+	if err != nil {
+		return "", err
+	}
+	appDNSDelegationRole, appDNSName := nlbConfig.appDNSDelegationRole, nlbConfig.appDNSName
+	if cdnConfig != nil && s.dnsDelegationEnabled {
+		appDNSDelegationRole, appDNSName = convertAppInformation(s.appInfo)
+	}
 	scTarget := s.manifest.ServiceConnectTarget(exposedPorts)
 	scOpts := template.ServiceConnectOpts{
-		Server: convertServiceConnectServer(s.manifest.Network.Connect, scTarget),
-		Client: s.manifest.Network.Connect.Enabled(),
+		Server:            convertServiceConnectServer(s.manifest.Network.Connect, scTarget),
+		AdditionalServers: convertServiceConnectAdditionalServers(s.manifest.Network.Connect, exposedPorts),
+		Client:            s.manifest.Network.Connect.Enabled(),
+		Namespace:         s.rc.EnvConnectNamespace,
+		Timeout:           convertServiceConnectTimeout(s.manifest.Network.Connect.Timeout),
 	}
 
 	// Set container-level feature flag.
@@ -200,8 +211,10 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 		AppName:            s.app,
 		EnvName:            s.env,
 		EnvVersion:         s.rc.EnvVersion,
+		EnvKMSKeyARN:       s.rc.EnvKMSKeyARN,
 		Version:            s.rc.Version,
 		SerializedManifest: string(s.rawManifest),
+		DeployValues:       s.rc.DeployValues,
 		WorkloadName:       s.name,
 		WorkloadType:       manifestinfo.LoadBalancedWebServiceType,
 
@@ -220,15 +233,16 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 		CredentialsParameter:    aws.StringValue(s.manifest.ImageConfig.Image.Credentials),
 		DesiredCountOnSpot:      desiredCountOnSpot,
 		DeploymentConfiguration: convertDeploymentConfig(s.manifest.DeployConfig),
-		DependsOn:               convertDependsOn(s.manifest.ImageConfig.Image.DependsOn),
+		DependsOn:               convertDependsOn(s.manifest.ImageConfig.Image.DependsOn.WithInitContainers(s.manifest.InitContainers)),
 		DockerLabels:            s.manifest.ImageConfig.Image.DockerLabels,
 		ExecuteCommand:          convertExecuteCommand(&s.manifest.ExecuteCommand),
 		LogConfig:               logConfig,
 		NestedStack:             addonsOutputs,
-		Network:                 convertNetworkConfig(s.manifest.Network),
+		Network:                 convertNetworkConfig(s.manifest.Network, s.rc.EnvDeniesIntraEnvTraffic),
 		Publish:                 publishers,
 		PermissionsBoundary:     s.permBound,
 		Platform:                convertPlatform(s.manifest.Platform),
+		CredentialSpec:          convertCredentialSpec(s.manifest.Windows),
 		Storage:                 convertStorageOpts(s.manifest.Name, s.manifest.Storage),
 
 		// ALB configs.
@@ -236,10 +250,11 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 		GracePeriod: s.convertGracePeriod(),
 		ALBListener: albListenerConfig,
 		ImportedALB: importedALBConfig,
+		CDN:         cdnConfig,
 
 		// NLB configs.
-		AppDNSName:           nlbConfig.appDNSName,
-		AppDNSDelegationRole: nlbConfig.appDNSDelegationRole,
+		AppDNSName:           appDNSName,
+		AppDNSDelegationRole: appDNSDelegationRole,
 		NLB:                  nlbConfig.settings,
 
 		// service connect and service discovery options.
@@ -248,8 +263,12 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 
 		// Additional options for request driven web service templates.
 		Observability: template.ObservabilityOpts{
-			Tracing: strings.ToUpper(aws.StringValue(s.manifest.Observability.Tracing)),
+			Tracing:         strings.ToUpper(s.manifest.Observability.TracingVendor()),
+			SamplingRate:    s.manifest.Observability.TracingSamplingRate(),
+			CollectorConfig: s.rc.TracingConfig,
 		},
+		Cost:      convertCost(s.manifest.LoadBalancedWebServiceConfig.Cost),
+		AppConfig: convertAppConfig(s.manifest.LoadBalancedWebServiceConfig.AppConfig),
 
 		// Sidecar configs.
 		Sidecars: sidecars,
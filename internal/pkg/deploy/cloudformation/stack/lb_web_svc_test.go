@@ -494,6 +494,132 @@ Outputs:
 			Client: true,
 		}, actual.ServiceConnectOpts)
 	})
+
+	t.Run("should join the Service Connect namespace shared by the environment", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mft := manifest.NewLoadBalancedWebService(&manifest.LoadBalancedWebServiceProps{
+			WorkloadProps: &manifest.WorkloadProps{
+				Name:       "frontend",
+				Dockerfile: "frontend/Dockerfile",
+			},
+			Path: "frontend",
+			Port: 80,
+		})
+		mft.Network.Connect.Alias = aws.String("web")
+
+		var actual template.WorkloadOpts
+		parser := mocks.NewMockloadBalancedWebSvcReadParser(ctrl)
+		parser.EXPECT().ParseLoadBalancedWebService(gomock.Any()).DoAndReturn(func(in template.WorkloadOpts) (*template.Content, error) {
+			actual = in // Capture the translated object.
+			return &template.Content{Buffer: bytes.NewBufferString("template")}, nil
+		})
+
+		lbws, err := NewLoadBalancedWebService(LoadBalancedWebServiceConfig{
+			App: &config.Application{
+				Name: "phonetool",
+			},
+			EnvManifest: &manifest.Environment{
+				Workload: manifest.Workload{
+					Name: aws.String("test"),
+				},
+			},
+			ArtifactBucketName: "mockBucket",
+			Manifest:           mft,
+			RuntimeConfig: RuntimeConfig{
+				EnvConnectNamespace: "arn:aws:servicediscovery:us-west-2:123456789012:namespace/ns-1234",
+			},
+			Addons: mockAddons{},
+		}, func(s *LoadBalancedWebService) {
+			s.parser = parser
+		})
+		require.NoError(t, err)
+
+		// WHEN
+		_, err = lbws.Template()
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, template.ServiceConnectOpts{
+			Server: &template.ServiceConnectServer{
+				Name:  "frontend",
+				Port:  "80",
+				Alias: "web",
+			},
+			Client:    true,
+			Namespace: "arn:aws:servicediscovery:us-west-2:123456789012:namespace/ns-1234",
+		}, actual.ServiceConnectOpts)
+	})
+
+	t.Run("should expose additional ports to Service Connect with their own aliases", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mft := manifest.NewLoadBalancedWebService(&manifest.LoadBalancedWebServiceProps{
+			WorkloadProps: &manifest.WorkloadProps{
+				Name:       "frontend",
+				Dockerfile: "frontend/Dockerfile",
+			},
+			Path: "frontend",
+			Port: 80,
+		})
+		mft.Sidecars = map[string]*manifest.SidecarConfig{
+			"grpc": {
+				Port: aws.String("50051"),
+			},
+		}
+		mft.Network.Connect.Ports = []manifest.ServiceConnectPort{
+			{Port: aws.Uint16(50051), Alias: aws.String("frontend-grpc"), ClientPort: aws.Uint16(443)},
+		}
+
+		var actual template.WorkloadOpts
+		parser := mocks.NewMockloadBalancedWebSvcReadParser(ctrl)
+		parser.EXPECT().ParseLoadBalancedWebService(gomock.Any()).DoAndReturn(func(in template.WorkloadOpts) (*template.Content, error) {
+			actual = in // Capture the translated object.
+			return &template.Content{Buffer: bytes.NewBufferString("template")}, nil
+		})
+
+		lbws, err := NewLoadBalancedWebService(LoadBalancedWebServiceConfig{
+			App: &config.Application{
+				Name: "phonetool",
+			},
+			EnvManifest: &manifest.Environment{
+				Workload: manifest.Workload{
+					Name: aws.String("test"),
+				},
+			},
+			ArtifactBucketName: "mockBucket",
+			Manifest:           mft,
+			Addons:             mockAddons{},
+		}, func(s *LoadBalancedWebService) {
+			s.parser = parser
+		})
+		require.NoError(t, err)
+
+		// WHEN
+		_, err = lbws.Template()
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, template.ServiceConnectOpts{
+			Server: &template.ServiceConnectServer{
+				Name: "frontend",
+				Port: "80",
+			},
+			AdditionalServers: []template.ServiceConnectServer{
+				{
+					Name:       "grpc",
+					Port:       "50051",
+					Alias:      "frontend-grpc",
+					ClientPort: "443",
+				},
+			},
+			Client: true,
+		}, actual.ServiceConnectOpts)
+	})
 }
 
 func TestLoadBalancedWebService_Parameters(t *testing.T) {
@@ -41,6 +41,11 @@ func NameForEnv(app, env string) string {
 	return fmt.Sprintf("%s-%s", app, env)
 }
 
+// NameForTaskRunner returns the name of the CodeBuild project that runs one-off tasks in an environment.
+func NameForTaskRunner(app, env string) string {
+	return fmt.Sprintf("%s-TaskRunner", NameForEnv(app, env))
+}
+
 // NameForTask returns the stack name for a task.
 func NameForTask(task string) TaskStackName {
 	return TaskStackName(taskStackPrefix + task)
@@ -56,6 +61,18 @@ func NameForAppStackSet(app string) string {
 	return fmt.Sprintf("%s-infrastructure", app)
 }
 
+// NameForAppEnvCFNExecutionRole returns the name of the role assumed by CloudFormation to create the environments of an app
+// in the accounts provisioned with the environment roles template.
+func NameForAppEnvCFNExecutionRole(app string) string {
+	return fmt.Sprintf("%s-EnvCFNExecutionRole", app)
+}
+
+// NameForAppEnvManagerRole returns the name of the role assumed from the app account to create the environments of an app
+// in the accounts provisioned with the environment roles template.
+func NameForAppEnvManagerRole(app string) string {
+	return fmt.Sprintf("%s-EnvManagerRole", app)
+}
+
 // NameForPipeline returns the stack name for a pipeline, depending on whether it has been deployed using the legacy scheme.
 // Note that it doesn't cut name to length of 128 like service stack name. It expects CloudFormation to error out
 // when the name is to long.
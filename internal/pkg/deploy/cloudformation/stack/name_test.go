@@ -22,6 +22,12 @@ func TestNameForEnv(t *testing.T) {
 	require.Equal(t, name, "foo-bar")
 }
 
+func TestNameForTaskRunner(t *testing.T) {
+	name := NameForTaskRunner("foo", "bar")
+
+	require.Equal(t, name, "foo-bar-TaskRunner")
+}
+
 func TestNameForTask(t *testing.T) {
 	name := NameForTask("foo")
 
@@ -39,3 +45,15 @@ func TestNameForAppStackSet(t *testing.T) {
 
 	require.Equal(t, name, "foo-infrastructure")
 }
+
+func TestNameForAppEnvCFNExecutionRole(t *testing.T) {
+	name := NameForAppEnvCFNExecutionRole("foo")
+
+	require.Equal(t, name, "foo-EnvCFNExecutionRole")
+}
+
+func TestNameForAppEnvManagerRole(t *testing.T) {
+	name := NameForAppEnvManagerRole("foo")
+
+	require.Equal(t, name, "foo-EnvManagerRole")
+}
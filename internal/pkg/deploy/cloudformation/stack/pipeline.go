@@ -4,6 +4,8 @@
 package stack
 
 import (
+	"fmt"
+
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 
@@ -13,6 +15,9 @@ import (
 type pipelineStackConfig struct {
 	*deploy.CreatePipelineInput
 	parser pipelineParser
+
+	scheduleExpression string
+	customResources    map[string]template.S3ObjectLocation
 }
 
 // NewPipelineStackConfig sets up a struct which can provide values to CloudFormation for
@@ -31,6 +36,18 @@ func (p *pipelineStackConfig) StackName() string {
 
 // Template returns the CloudFormation template for the service parametrized for the environment.
 func (p *pipelineStackConfig) Template() (string, error) {
+	if p.Trigger != nil && p.Trigger.Schedule != "" {
+		expr, err := toAWSSchedule(p.Trigger.Schedule)
+		if err != nil {
+			return "", fmt.Errorf(`convert "trigger.schedule" %q: %w`, p.Trigger.Schedule, err)
+		}
+		p.scheduleExpression = expr
+	}
+	crs, err := convertCustomResources(p.CustomResourcesURLs)
+	if err != nil {
+		return "", err
+	}
+	p.customResources = crs
 	content, err := p.parser.ParsePipeline(p)
 	if err != nil {
 		return "", err
@@ -38,6 +55,17 @@ func (p *pipelineStackConfig) Template() (string, error) {
 	return content.String(), nil
 }
 
+// ScheduleExpression returns the CloudWatch Events schedule expression that releases the pipeline,
+// or an empty string if the pipeline isn't scheduled.
+func (p *pipelineStackConfig) ScheduleExpression() string {
+	return p.scheduleExpression
+}
+
+// CustomResources returns the S3 locations of the custom resources used by the pipeline keyed by function name.
+func (p *pipelineStackConfig) CustomResources() map[string]template.S3ObjectLocation {
+	return p.customResources
+}
+
 // SerializedParameters returns the CloudFormation stack's parameters serialized to a JSON document.
 func (p *pipelineStackConfig) SerializedParameters() (string, error) {
 	// No-op for now.
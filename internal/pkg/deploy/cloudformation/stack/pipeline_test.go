@@ -7,11 +7,14 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -162,6 +165,164 @@ func TestPipelineStackConfig_Template(t *testing.T) {
 	}
 }
 
+func TestPipelineStackConfig_Trigger(t *testing.T) {
+	testCases := map[string]struct {
+		inTrigger *deploy.PipelineTrigger
+
+		wantedScheduleExpression string
+		wantedContains           []string
+		wantedNotContains        []string
+		wantedError              string
+	}{
+		"detects source changes by default": {
+			wantedNotContains: []string{"DetectChanges: false", "PipelineSchedule"},
+		},
+		"manual pipelines do not detect source changes": {
+			inTrigger:         &deploy.PipelineTrigger{},
+			wantedContains:    []string{"DetectChanges: false"},
+			wantedNotContains: []string{"PipelineSchedule"},
+		},
+		"scheduled pipelines are released by an events rule": {
+			inTrigger:                &deploy.PipelineTrigger{Schedule: "0 9 * * 1"},
+			wantedScheduleExpression: "cron(0 9 ? * 2 *)",
+			wantedContains: []string{
+				"DetectChanges: false",
+				"ScheduleExpression: cron(0 9 ? * 2 *)",
+				"RoleArn: !GetAtt PipelineScheduleRole.Arn",
+			},
+		},
+		"error if the schedule is invalid": {
+			inTrigger:   &deploy.PipelineTrigger{Schedule: "every monday"},
+			wantedError: `convert "trigger.schedule" "every monday": schedule is not valid cron, rate, or preset: expected exactly 5 fields, found 2: [every monday]`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			in := mockCreatePipelineInput()
+			in.Build = &deploy.Build{}
+			in.Trigger = tc.inTrigger
+			c := NewPipelineStackConfig(in)
+
+			// WHEN
+			tpl, err := c.Template()
+
+			// THEN
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedScheduleExpression, c.ScheduleExpression())
+			for _, want := range tc.wantedContains {
+				require.Contains(t, tpl, want)
+			}
+			for _, notWant := range tc.wantedNotContains {
+				require.NotContains(t, tpl, notWant)
+			}
+		})
+	}
+}
+
+func TestPipelineStackConfig_ArtifactKMSKey(t *testing.T) {
+	testCases := map[string]struct {
+		inKeyARN string
+
+		wantedContains    []string
+		wantedNotContains []string
+	}{
+		"encrypts the build artifacts with the key of the application by default": {
+			wantedContains: []string{"EncryptionKey: !ImportValue chickenProject-ArtifactKey"},
+		},
+		"encrypts the build artifacts with the custom key": {
+			inKeyARN:          "arn:aws:kms:us-west-2:123456789012:key/custom",
+			wantedContains:    []string{"EncryptionKey: arn:aws:kms:us-west-2:123456789012:key/custom"},
+			wantedNotContains: []string{"!ImportValue chickenProject-ArtifactKey"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			in := mockCreatePipelineInput()
+			in.Build = &deploy.Build{}
+			in.ArtifactKMSKeyARN = tc.inKeyARN
+			c := NewPipelineStackConfig(in)
+
+			// WHEN
+			tpl, err := c.Template()
+
+			// THEN
+			require.NoError(t, err)
+			for _, want := range tc.wantedContains {
+				require.Contains(t, tpl, want)
+			}
+			for _, notWant := range tc.wantedNotContains {
+				require.NotContains(t, tpl, notWant)
+			}
+		})
+	}
+}
+
+func TestPipelineStackConfig_RunTaskAction(t *testing.T) {
+	// GIVEN
+	var stage deploy.PipelineStage
+	stage.Init(&config.Environment{
+		App:       projectName,
+		Name:      "test",
+		Region:    "us-west-2",
+		AccountID: "1111",
+	}, &manifest.PipelineStage{
+		Name: "test",
+		PreDeployments: manifest.PrePostDeployments{
+			"db-migrate": {
+				RunTask: &manifest.PrePostDeployTask{
+					Image:   "migrate:v2",
+					Command: "./migrate up",
+					Variables: map[string]string{
+						"LOG_LEVEL": "debug",
+						"DRY_RUN":   "false",
+					},
+				},
+			},
+			"seed": {
+				BuildspecPath: "copilot/pipelines/wingspipeline/seed.yml",
+			},
+		},
+	}, []string{"api"})
+	in := mockCreatePipelineInput()
+	in.Build = &deploy.Build{}
+	in.Stages = []deploy.PipelineStage{stage}
+	in.CopilotBinaryURL = "https://example.com/copilot-linux-v1.33.0"
+	c := NewPipelineStackConfig(in)
+
+	// WHEN
+	tpl, err := c.Template()
+
+	// THEN
+	require.NoError(t, err)
+	for _, want := range []string{
+		"PretestDeploymentActiondbmigrateBuildProjectRole:",
+		"PolicyName: run-task",
+		"parameter/copilot/applications/chickenProject/*",
+		"Resource: 'arn:aws:iam::1111:role/chickenProject-test-RunTaskRole'",
+		"Value: 'arn:aws:iam::1111:role/chickenProject-test-RunTaskRole'",
+		`Value: "migrate:v2"`,
+		`Value: "./migrate up"`,
+		`Value: "DRY_RUN=false,LOG_LEVEL=debug"`,
+		"wget -q https://example.com/copilot-linux-v1.33.0 -O copilot-linux",
+		`./copilot-linux task run --task-group-name "$COPILOT_TASK_GROUP_NAME"`,
+		`--env-role "$COPILOT_TASK_ENV_ROLE_ARN" --follow`,
+		"BuildSpec: copilot/pipelines/wingspipeline/seed.yml",
+	} {
+		require.Contains(t, tpl, want)
+	}
+	taskRole := tpl[strings.Index(tpl, "PretestDeploymentActiondbmigrateBuildProjectRole:"):strings.Index(tpl, "PretestDeploymentActiondbmigrate:")]
+	require.NotContains(t, taskRole, "AmazonSSMReadOnlyAccess", "the task action's role should not have the pipeline build role's managed policies")
+	require.NotContains(t, taskRole, "EnvManagerRole", "the task action's role should not assume the environment manager role")
+}
+
 func mockCreatePipelineInput() *deploy.CreatePipelineInput {
 	return &deploy.CreatePipelineInput{
 		AppName: projectName,
@@ -122,10 +122,11 @@ func (s *RequestDrivenWebService) Template() (string, error) {
 		EnvName:            s.env,
 		WorkloadName:       s.name,
 		SerializedManifest: string(s.rawManifest),
+		DeployValues:       s.rc.DeployValues,
 		EnvVersion:         s.rc.EnvVersion,
 		Version:            s.rc.Version,
 
-		Variables:            convertEnvVars(s.manifest.Variables),
+		Variables:            s.variables(),
 		StartCommand:         s.manifest.StartCommand,
 		Tags:                 s.manifest.Tags,
 		NestedStack:          addonsOutputs,
@@ -143,8 +144,10 @@ func (s *RequestDrivenWebService) Template() (string, error) {
 		ServiceDiscoveryEndpoint: s.rc.ServiceDiscoveryEndpoint,
 
 		Observability: template.ObservabilityOpts{
-			Tracing: strings.ToUpper(aws.StringValue(s.manifest.Observability.Tracing)),
+			Tracing:      strings.ToUpper(s.manifest.Observability.TracingVendor()),
+			SamplingRate: s.manifest.Observability.TracingSamplingRate(),
 		},
+		Cost:                 convertCost(s.manifest.RequestDrivenWebServiceConfig.Cost),
 		PermissionsBoundary:  s.permBound,
 		Private:              aws.BoolValue(s.manifest.Private.Basic) || s.manifest.Private.Advanced.Endpoint != nil,
 		AppRunnerVPCEndpoint: s.manifest.Private.Advanced.Endpoint,
@@ -157,6 +160,25 @@ func (s *RequestDrivenWebService) Template() (string, error) {
 	return content.String(), nil
 }
 
+// variables returns the environment variables of the service.
+// Variables defined in the manifest take precedence over the ones read from the env file.
+func (s *RequestDrivenWebService) variables() map[string]template.Variable {
+	vars := convertEnvVars(s.manifest.Variables)
+	if len(s.rc.EnvFileVariables) == 0 {
+		return vars
+	}
+	if vars == nil {
+		vars = make(map[string]template.Variable, len(s.rc.EnvFileVariables))
+	}
+	for name, value := range s.rc.EnvFileVariables {
+		if _, ok := vars[name]; ok {
+			continue
+		}
+		vars[name] = template.PlainVariable(value)
+	}
+	return vars
+}
+
 // SerializedParameters returns the CloudFormation stack's parameters serialized to a JSON document.
 func (s *RequestDrivenWebService) SerializedParameters() (string, error) {
 	return serializeTemplateConfig(s.wkld.parser, s)
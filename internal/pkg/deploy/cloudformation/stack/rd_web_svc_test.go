@@ -156,6 +156,7 @@ func TestRequestDrivenWebService_Template(t *testing.T) {
 	const mockSD = "app.env.svc.local.com"
 	testCases := map[string]struct {
 		inCustomResourceURLs map[string]string
+		inEnvFileVariables   map[string]string
 		inManifest           func(manifest manifest.RequestDrivenWebService) manifest.RequestDrivenWebService
 		mockDependencies     func(t *testing.T, ctrl *gomock.Controller, c *RequestDrivenWebService)
 		wantedTemplate       string
@@ -242,6 +243,26 @@ func TestRequestDrivenWebService_Template(t *testing.T) {
 			},
 			wantedTemplate: "template",
 		},
+		"should merge variables from the env file without overriding the manifest variables": {
+			inEnvFileVariables: map[string]string{
+				"LOG_LEVEL": "debug",
+				"REGION":    "us-west-2",
+			},
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *RequestDrivenWebService) {
+				mockParser := mocks.NewMockrequestDrivenWebSvcReadParser(ctrl)
+				mockParser.EXPECT().ParseRequestDrivenWebService(gomock.Any()).DoAndReturn(func(actual template.WorkloadOpts) (*template.Content, error) {
+					require.Equal(t, map[string]template.Variable{
+						"LOG_LEVEL": template.PlainVariable(""),
+						"NODE_ENV":  template.PlainVariable(""),
+						"REGION":    template.PlainVariable("us-west-2"),
+					}, actual.Variables)
+					return &template.Content{Buffer: bytes.NewBufferString("template")}, nil
+				})
+				c.parser = mockParser
+				c.addons = mockAddons{}
+			},
+			wantedTemplate: "template",
+		},
 		"should parse template with addons": {
 			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *RequestDrivenWebService) {
 				mockParser := mocks.NewMockrequestDrivenWebSvcReadParser(ctrl)
@@ -345,6 +366,7 @@ Outputs:
 							AccountID:                "0123456789012",
 							Region:                   "us-west-2",
 							CustomResourcesURL:       tc.inCustomResourceURLs,
+							EnvFileVariables:         tc.inEnvFileVariables,
 						},
 					},
 					healthCheckConfig: mft.HealthCheckConfiguration,
@@ -146,7 +146,7 @@ func (j *ScheduledJob) Template() (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("parse exposed ports in service manifest %s: %w", j.name, err)
 	}
-	sidecars, err := convertSidecars(j.manifest.Sidecars, exposedPorts.PortsForContainer, j.rc)
+	sidecars, err := convertSidecars(j.manifest.Sidecars, j.manifest.TaskConfig, exposedPorts.PortsForContainer, j.rc)
 	if err != nil {
 		return "", fmt.Errorf("convert the sidecar configuration for job %s: %w", j.name, err)
 	}
@@ -158,6 +158,10 @@ func (j *ScheduledJob) Template() (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("convert schedule for job %s: %w", j.name, err)
 	}
+	alerts, err := convertJobAlerts(j.manifest.Alerts, schedule)
+	if err != nil {
+		return "", fmt.Errorf("convert alerts for job %s: %w", j.name, err)
+	}
 	stateMachine, err := j.stateMachineOpts()
 	if err != nil {
 		return "", fmt.Errorf("convert retry/timeout config for job %s: %w", j.name, err)
@@ -185,19 +189,23 @@ func (j *ScheduledJob) Template() (string, error) {
 		Sidecars:                 sidecars,
 		ScheduleExpression:       schedule,
 		StateMachine:             stateMachine,
+		QueueTrigger:             convertJobQueueTrigger(j.manifest.On.Queue),
+		JobAlerts:                alerts,
 		HealthCheck:              convertContainerHealthCheck(j.manifest.ImageConfig.HealthCheck),
 		LogConfig:                convertLogging(j.manifest.Logging),
 		DockerLabels:             j.manifest.ImageConfig.Image.DockerLabels,
 		Storage:                  convertStorageOpts(j.manifest.Name, j.manifest.Storage),
-		Network:                  convertNetworkConfig(j.manifest.Network),
+		Network:                  convertNetworkConfig(j.manifest.Network, j.rc.EnvDeniesIntraEnvTraffic),
 		EntryPoint:               entrypoint,
 		Command:                  command,
-		DependsOn:                convertDependsOn(j.manifest.ImageConfig.Image.DependsOn),
+		DependsOn:                convertDependsOn(j.manifest.ImageConfig.Image.DependsOn.WithInitContainers(j.manifest.InitContainers)),
 		CredentialsParameter:     aws.StringValue(j.manifest.ImageConfig.Image.Credentials),
 		ServiceDiscoveryEndpoint: j.rc.ServiceDiscoveryEndpoint,
 		Publish:                  publishers,
 		Platform:                 convertPlatform(j.manifest.Platform),
+		CredentialSpec:           convertCredentialSpec(j.manifest.Windows),
 		EnvVersion:               j.rc.EnvVersion,
+		EnvKMSKeyARN:             j.rc.EnvKMSKeyARN,
 		Version:                  j.rc.Version,
 
 		CustomResources:     crs,
@@ -247,12 +255,20 @@ func (j *ScheduledJob) SerializedParameters() (string, error) {
 // validated server-side by CloudFormation.
 func (j *ScheduledJob) awsSchedule() (string, error) {
 	schedule := aws.StringValue(j.manifest.On.Schedule)
+	if schedule == "" && !j.manifest.On.Queue.IsEmpty() {
+		return "none", nil // The job is only triggered by its queue, so the schedule rule stays disabled.
+	}
 	if schedule == "" {
 		return "", fmt.Errorf(`missing required field "schedule" in manifest for job %s`, j.name)
 	}
+	return toAWSSchedule(schedule)
+}
+
+// toAWSSchedule converts a schedule written in a manifest to a CloudWatch Events schedule expression.
+func toAWSSchedule(schedule string) (string, error) {
 	// If the schedule uses default CloudWatch Events syntax, pass it through for server-side validation.
 	if match := awsScheduleRegexp.FindStringSubmatch(schedule); match != nil {
-		return aws.StringValue(j.manifest.On.Schedule), nil
+		return schedule, nil
 	}
 	// Try parsing the string as a cron expression to validate it.
 	if _, err := cron.ParseStandard(schedule); err != nil {
@@ -87,6 +87,19 @@ func TestScheduledJob_Template(t *testing.T) {
 			},
 			wantedTemplate: "template",
 		},
+		"render template with the environment's kms key": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, j *ScheduledJob) {
+				m := mocks.NewMockscheduledJobReadParser(ctrl)
+				m.EXPECT().ParseScheduledJob(gomock.Any()).DoAndReturn(func(actual template.WorkloadOpts) (*template.Content, error) {
+					require.Equal(t, "arn:aws:kms:us-west-2:0123456789012:key/mrk-1234abcd", actual.EnvKMSKeyARN)
+					return &template.Content{Buffer: bytes.NewBufferString("template")}, nil
+				})
+				j.parser = m
+				j.wkld.addons = mockAddons{}
+				j.wkld.rc.EnvKMSKeyARN = "arn:aws:kms:us-west-2:0123456789012:key/mrk-1234abcd"
+			},
+			wantedTemplate: "template",
+		},
 		"render template with addons": {
 			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, j *ScheduledJob) {
 				m := mocks.NewMockscheduledJobReadParser(ctrl)
@@ -213,6 +226,7 @@ DiscoveryServiceArn: !GetAtt DiscoveryService.Arn`,
 func TestScheduledJob_awsSchedule(t *testing.T) {
 	testCases := map[string]struct {
 		inputSchedule   string
+		inputQueue      manifest.JobQueueTrigger
 		wantedSchedule  string
 		wantedError     error
 		wantedErrorType interface{}
@@ -225,6 +239,11 @@ func TestScheduledJob_awsSchedule(t *testing.T) {
 			inputSchedule: "",
 			wantedError:   errors.New(`missing required field "schedule" in manifest for job mailer`),
 		},
+		"disabled schedule if the job is only triggered by a queue": {
+			inputSchedule:  "",
+			inputQueue:     manifest.JobQueueTrigger{Name: aws.String("orders")},
+			wantedSchedule: "none",
+		},
 		"one minute rate": {
 			inputSchedule:  "@every 1m",
 			wantedSchedule: "rate(1 minute)",
@@ -339,6 +358,7 @@ func TestScheduledJob_awsSchedule(t *testing.T) {
 					ScheduledJobConfig: manifest.ScheduledJobConfig{
 						On: manifest.JobTriggerConfig{
 							Schedule: aws.String(tc.inputSchedule),
+							Queue:    tc.inputQueue,
 						},
 					},
 				},
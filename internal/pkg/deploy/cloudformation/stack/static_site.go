@@ -133,12 +133,14 @@ func (s *StaticSite) Template() (string, error) {
 		// Custom Resource Config.
 		CustomResources: crs,
 
-		AppDNSName:             dnsName,
-		AppDNSDelegationRole:   dnsDelegationRole,
-		AssetMappingFileBucket: bucket,
-		AssetMappingFilePath:   path,
-		StaticSiteAlias:        staticSiteAlias,
-		StaticSiteCert:         s.manifest.HTTP.Certificate,
+		AppDNSName:               dnsName,
+		AppDNSDelegationRole:     dnsDelegationRole,
+		AssetMappingFileBucket:   bucket,
+		AssetMappingFilePath:     path,
+		StaticSiteAlias:          staticSiteAlias,
+		StaticSiteCert:           s.manifest.HTTP.Certificate,
+		StaticSiteErrorResponses: convertStaticSiteErrorResponses(s.manifest.HTTP),
+		StaticSiteRedirects:      convertStaticSiteRedirects(s.manifest.HTTP.Redirects),
 	})
 	if err != nil {
 		return "", err
@@ -4,6 +4,7 @@
 package stack
 
 import (
+	"crypto/sha256"
 	"fmt"
 	"strconv"
 	"strings"
@@ -33,8 +34,12 @@ const (
 
 	// TaskOutputS3Bucket is the CFN stack output logical ID for a task's S3 bucket.
 	TaskOutputS3Bucket = "S3Bucket"
+	// TaskOutputEFSSecurityGroup is the CFN stack output logical ID for the security group that can access a task's EFS volumes.
+	TaskOutputEFSSecurityGroup = "EFSSecurityGroup"
 
 	taskLogRetentionInDays = "1"
+
+	maxIAMRoleNameLength = 64
 )
 
 type taskStackConfig struct {
@@ -63,6 +68,14 @@ var cfnFuntion = map[string]interface{}{
 
 // Template returns the task CloudFormation template.
 func (t *taskStackConfig) Template() (string, error) {
+	executionRoleName, err := t.roleName("ExecutionRole")
+	if err != nil {
+		return "", err
+	}
+	taskRoleName, err := t.roleName("TaskRole")
+	if err != nil {
+		return "", err
+	}
 	content, err := t.parser.Parse(taskTemplatePath, struct {
 		EnvVars               map[string]string
 		SSMParamSecrets       map[string]string
@@ -70,7 +83,12 @@ func (t *taskStackConfig) Template() (string, error) {
 		App                   string
 		Env                   string
 		ExecutionRole         string
+		ExecutionRoleName     string
+		TaskRoleName          string
 		PermissionsBoundary   string
+		EFSVolumes            []deploy.TaskEFSVolume
+		EFSVPCID              string
+		EC2Compatible         bool
 	}{
 		EnvVars:               t.EnvVars,
 		SSMParamSecrets:       t.SSMParamSecrets,
@@ -78,7 +96,12 @@ func (t *taskStackConfig) Template() (string, error) {
 		App:                   t.App,
 		Env:                   t.Env,
 		ExecutionRole:         t.ExecutionRole,
+		ExecutionRoleName:     executionRoleName,
+		TaskRoleName:          taskRoleName,
 		PermissionsBoundary:   t.PermissionsBoundary,
+		EFSVolumes:            t.EFSVolumes,
+		EFSVPCID:              t.EFSVPCID,
+		EC2Compatible:         t.EC2Compatible,
 	}, template.WithFuncs(cfnFuntion))
 	if err != nil {
 		return "", fmt.Errorf("read template for task stack: %w", err)
@@ -86,6 +109,24 @@ func (t *taskStackConfig) Template() (string, error) {
 	return content.String(), nil
 }
 
+// roleName returns the name of a default IAM role of the task if the roles must be named with a prefix.
+// The name of the task is replaced with its hash if the role name would exceed the IAM limit.
+func (t *taskStackConfig) roleName(role string) (string, error) {
+	if t.RoleNamePrefix == "" {
+		return "", nil
+	}
+	name := fmt.Sprintf("%s-%s-%s", t.RoleNamePrefix, t.Name, role)
+	if len(name) <= maxIAMRoleNameLength {
+		return name, nil
+	}
+	hash := fmt.Sprintf("%x", sha256.Sum256([]byte(t.Name)))[:8]
+	name = fmt.Sprintf("%s-%s-%s", t.RoleNamePrefix, hash, role)
+	if len(name) > maxIAMRoleNameLength {
+		return "", fmt.Errorf("name %s of the %s of task %s exceeds %d characters", name, role, t.Name, maxIAMRoleNameLength)
+	}
+	return name, nil
+}
+
 // Parameters returns the parameter values to be passed to the task CloudFormation template.
 func (t *taskStackConfig) Parameters() ([]*cloudformation.Parameter, error) {
 	return []*cloudformation.Parameter{
@@ -208,3 +208,51 @@ func TestTaskStackConfig_Tags(t *testing.T) {
 		})
 	}
 }
+
+func TestTaskStackConfig_roleName(t *testing.T) {
+	testCases := map[string]struct {
+		prefix string
+		task   string
+
+		wantedName  string
+		wantedError error
+	}{
+		"no name without a prefix": {
+			task: "db-migrate",
+		},
+		"name prefixed with the application and environment": {
+			prefix:     "phonetool-test",
+			task:       "db-migrate",
+			wantedName: "phonetool-test-db-migrate-ExecutionRole",
+		},
+		"hash the name of the task if the name is too long": {
+			prefix:     "phonetool-test",
+			task:       "a-very-long-name-of-a-task-that-migrates-the-database",
+			wantedName: "phonetool-test-e8363b4a-ExecutionRole",
+		},
+		"error if the prefix is too long": {
+			prefix:      "a-very-long-name-of-an-application-with-an-environment",
+			task:        "db-migrate",
+			wantedError: errors.New("name a-very-long-name-of-an-application-with-an-environment-d671bafe-ExecutionRole of the ExecutionRole of task db-migrate exceeds 64 characters"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			stack := &taskStackConfig{
+				CreateTaskResourcesInput: &deploy.CreateTaskResourcesInput{
+					Name:           tc.task,
+					RoleNamePrefix: tc.prefix,
+				},
+			}
+
+			got, err := stack.roleName("ExecutionRole")
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedName, got)
+		})
+	}
+}
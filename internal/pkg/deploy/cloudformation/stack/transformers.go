@@ -7,6 +7,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"hash/crc32"
+	"math"
+	"net/http"
 	"sort"
 	"strconv"
 	"strings"
@@ -21,6 +23,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/template"
@@ -35,6 +38,14 @@ const (
 const (
 	sqsDedupeScopeMessageGroup              = "messageGroup"
 	sqsFIFOThroughputLimitPerMessageGroupId = "perMessageGroupId"
+
+	defaultJobQueueBatchSize = 10 // Same default as the SQS source of EventBridge Pipes.
+)
+
+// CloudWatch alarm limits for job alerts.
+const (
+	maxJobAlertsPeriod          = 24 * time.Hour
+	maxJobAlertsEvaluationRange = 7 * 24 * time.Hour
 )
 
 // Default values for EFS options
@@ -81,7 +92,7 @@ func convertPortMappings(exposedPorts []manifest.ExposedPort) []*template.PortMa
 }
 
 // convertSidecars converts the manifest sidecar configuration into a format parsable by the templates pkg.
-func convertSidecars(s map[string]*manifest.SidecarConfig, exposedPorts map[string][]manifest.ExposedPort, rc RuntimeConfig) ([]*template.SidecarOpts, error) {
+func convertSidecars(s map[string]*manifest.SidecarConfig, tc manifest.TaskConfig, exposedPorts map[string][]manifest.ExposedPort, rc RuntimeConfig) ([]*template.SidecarOpts, error) {
 	var sidecars []*template.SidecarOpts
 	if s == nil {
 		return nil, nil
@@ -111,16 +122,22 @@ func convertSidecars(s map[string]*manifest.SidecarConfig, exposedPorts map[stri
 			return nil, err
 		}
 		mp := convertSidecarMountPoints(config.MountPoints)
+		essential := config.Essential
+		if tc.IsInitContainer(name) {
+			// Init containers run to completion, so they can't be essential.
+			essential = aws.Bool(false)
+		}
 		sidecars = append(sidecars, &template.SidecarOpts{
 			Name:       name,
 			Image:      aws.String(imageURI),
-			Essential:  config.Essential,
+			Essential:  essential,
 			CredsParam: config.CredsParam,
 			Secrets:    convertSecrets(config.Secrets),
 			Variables:  convertEnvVars(config.Variables),
 			Storage: template.SidecarStorageOpts{
 				MountPoints: mp,
 			},
+			VolumesFrom:  config.VolumesFrom,
 			DockerLabels: config.DockerLabels,
 			DependsOn:    convertDependsOn(config.DependsOn),
 			EntryPoint:   entrypoint,
@@ -442,20 +459,158 @@ func convertELBAccessLogsConfig(mft *manifest.Environment) *template.ELBAccessLo
 	}
 }
 
+// convertMutualTLSConfig converts the mutual TLS configuration of a load balancer into a format parsable by the templates pkg.
+func convertMutualTLSConfig(cfg manifest.MutualTLSConfig) *template.MutualTLS {
+	if cfg.IsEmpty() {
+		return nil
+	}
+	if cfg.IsPassthrough() {
+		return &template.MutualTLS{
+			Mode: manifest.MutualTLSModePassthrough,
+		}
+	}
+	mtls := &template.MutualTLS{
+		Mode:                          manifest.MutualTLSModeVerify,
+		TrustStoreARN:                 aws.StringValue(cfg.TrustStore),
+		IgnoreClientCertificateExpiry: aws.BoolValue(cfg.IgnoreClientCertificateExpiry),
+	}
+	if cfg.CABundle != nil {
+		// The bundle is validated to be a s3://bucket/key URI by the manifest.
+		mtls.CABundleBucket, mtls.CABundleKey, _ = s3.ParseURL(aws.StringValue(cfg.CABundle))
+	}
+	return mtls
+}
+
+// convertResponseHeadersConfig converts the response headers of a load balancer into a format parsable by the templates pkg.
+func convertResponseHeadersConfig(cfg manifest.ResponseHeadersConfig) *template.ResponseHeaders {
+	if cfg.IsEmpty() {
+		return nil
+	}
+	headers := &template.ResponseHeaders{
+		ContentSecurityPolicy: aws.StringValue(cfg.ContentSecurityPolicy),
+	}
+	if hsts := cfg.StrictTransportSecurity; !hsts.IsEmpty() {
+		headers.StrictTransportSecurity = &template.StrictTransportSecurity{
+			MaxAgeSeconds:     int64(hsts.MaxAge.Seconds()), // The max age is required by the manifest.
+			IncludeSubdomains: aws.BoolValue(hsts.IncludeSubdomains),
+			Preload:           aws.BoolValue(hsts.Preload),
+		}
+	}
+	names := make([]string, 0, len(cfg.Custom))
+	for name := range cfg.Custom {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		headers.Custom = append(headers.Custom, template.CustomHeader{
+			Name:  name,
+			Value: cfg.Custom[name],
+		})
+	}
+	return headers
+}
+
 // convertFlowLogsConfig converts the VPC FlowLog configuration into a format parsable by the templates pkg.
 func convertFlowLogsConfig(mft *manifest.Environment) (*template.VPCFlowLogs, error) {
 	vpcFlowLogs := mft.EnvironmentConfig.Network.VPC.FlowLogs
 	if vpcFlowLogs.IsZero() {
 		return nil, nil
 	}
-	retentionInDays := aws.Int(14)
-	if vpcFlowLogs.Advanced.Retention != nil {
-		retentionInDays = vpcFlowLogs.Advanced.Retention
+	if vpcFlowLogs.IsBasic() && !aws.BoolValue(vpcFlowLogs.Basic) {
+		return nil, nil
 	}
-	return &template.VPCFlowLogs{
-		Retention: retentionInDays,
-	}, nil
+	args := vpcFlowLogs.Advanced
+	flowLogs := &template.VPCFlowLogs{
+		TrafficType:            "ALL",
+		MaxAggregationInterval: 60,
+	}
+	if args.TrafficType != nil {
+		flowLogs.TrafficType = strings.ToUpper(aws.StringValue(args.TrafficType))
+	}
+	if args.AggregationInterval != nil {
+		flowLogs.MaxAggregationInterval = int(args.AggregationInterval.Seconds())
+	}
+	if args.IsS3Destination() {
+		flowLogs.S3BucketName = aws.StringValue(args.BucketName)
+		flowLogs.S3Prefix = aws.StringValue(args.Prefix)
+		return flowLogs, nil
+	}
+	flowLogs.Retention = aws.Int(14)
+	if args.Retention != nil {
+		flowLogs.Retention = args.Retention
+	}
+	return flowLogs, nil
+}
+
+// convertAppRunnerConnectors converts the shared App Runner VPC connectors of an environment into a format parsable by the templates pkg.
+func convertAppRunnerConnectors(connectors map[string]manifest.AppRunnerConnector) []template.AppRunnerConnector {
+	if len(connectors) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(connectors))
+	for name := range connectors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	out := make([]template.AppRunnerConnector, len(names))
+	for i, name := range names {
+		conn := connectors[name]
+		out[i] = template.AppRunnerConnector{
+			Name:           name,
+			SubnetIDs:      conn.Subnets,
+			SecurityGroups: conn.SecurityGroups,
+		}
+		if len(conn.Subnets) == 0 {
+			out[i].SubnetsType = subnetPlacementForTemplate[conn.PlacementOrDefault()]
+		}
+	}
+	return out
+}
 
+// convertDHCPOptions converts the DHCP options set of an environment's VPC into a format parsable by the templates pkg.
+func convertDHCPOptions(opts manifest.DHCPOptions) *template.DHCPOptions {
+	if opts.IsEmpty() {
+		return nil
+	}
+	servers := opts.DomainNameServers
+	if len(servers) == 0 {
+		// Without domain name servers, the instances in the VPC can't resolve any domain.
+		servers = []string{"AmazonProvidedDNS"}
+	}
+	return &template.DHCPOptions{
+		DomainName:        aws.StringValue(opts.DomainName),
+		DomainNameServers: servers,
+		NTPServers:        opts.NTPServers,
+	}
+}
+
+// convertDNSResolver converts the Route 53 Resolver rules of an environment's VPC into a format parsable by the templates pkg.
+func convertDNSResolver(cfg manifest.DNSResolverConfig) (*template.DNSResolver, error) {
+	if cfg.IsEmpty() {
+		return nil, nil
+	}
+	rules := make([]template.DNSForwardingRule, len(cfg.ForwardingRules))
+	for i, rule := range cfg.ForwardingRules {
+		targets := make([]template.DNSTargetIP, len(rule.TargetIPs))
+		for j, target := range rule.TargetIPs {
+			ip, port, err := target.Parse()
+			if err != nil {
+				return nil, err
+			}
+			targets[j] = template.DNSTargetIP{
+				IP:   ip,
+				Port: port,
+			}
+		}
+		rules[i] = template.DNSForwardingRule{
+			Domain:    aws.StringValue(rule.Domain),
+			TargetIPs: targets,
+		}
+	}
+	return &template.DNSResolver{
+		ForwardingRules: rules,
+		RuleIDs:         cfg.RuleIDs,
+	}, nil
 }
 
 func convertEnvSecurityGroupCfg(mft *manifest.Environment) (*template.SecurityGroupConfig, error) {
@@ -486,8 +641,9 @@ func convertEnvSecurityGroupCfg(mft *manifest.Environment) (*template.SecurityGr
 		}
 	}
 	return &template.SecurityGroupConfig{
-		Ingress: ingress,
-		Egress:  egress,
+		Ingress:             ingress,
+		Egress:              egress,
+		DenyIntraEnvTraffic: aws.BoolValue(securityGroupConfig.DenyIntraEnvTraffic),
 	}, nil
 }
 
@@ -620,7 +776,8 @@ func (conv routingRuleConfigConverter) convert() (*template.ALBListenerRule, err
 		Aliases:             aliases,
 		HTTPHealthCheck:     convertHTTPHealthCheck(&conv.rule.HealthCheck),
 		AllowedSourceIps:    convertAllowedSourceIPs(conv.rule.AllowedSourceIps),
-		Stickiness:          strconv.FormatBool(aws.BoolValue(conv.rule.Stickiness)),
+		Stickiness:          strconv.FormatBool(conv.rule.StickinessEnabled()),
+		StickinessOpts:      convertStickiness(conv.rule),
 		HTTPVersion:         aws.StringValue(convertHTTPVersion(conv.rule.ProtocolVersion)),
 		RedirectToHTTPS:     conv.redirectToHTTPS,
 		DeregistrationDelay: convertDeregistrationDelay(conv.rule.DeregistrationDelay),
@@ -628,6 +785,20 @@ func (conv routingRuleConfigConverter) convert() (*template.ALBListenerRule, err
 	return config, nil
 }
 
+func convertStickiness(rule manifest.RoutingRule) *template.StickinessOpts {
+	if !rule.Stickiness.IsAdvanced() {
+		return nil
+	}
+	opts := &template.StickinessOpts{
+		Type:       rule.StickinessType(),
+		CookieName: aws.StringValue(rule.Stickiness.Advanced.CookieName),
+	}
+	if duration := rule.Stickiness.Advanced.Duration; duration != nil {
+		opts.Duration = aws.Int64(int64(duration.Seconds()))
+	}
+	return opts
+}
+
 func convertDeregistrationDelay(delay *time.Duration) *int64 {
 	if delay == nil {
 		return aws.Int64(int64(manifest.DefaultDeregistrationDelay))
@@ -680,6 +851,7 @@ func (s *LoadBalancedWebService) convertNetworkLoadBalancer() (networkLoadBalanc
 			TargetContainer:     targetContainer,
 			TargetPort:          targetPort,
 			SSLPolicy:           listener.SSLPolicy,
+			ALPN:                listener.ALPN,
 			HealthCheck:         convertNLBHealthCheck(&listener.HealthCheck),
 			Stickiness:          listener.Stickiness,
 			DeregistrationDelay: convertDeregistrationDelay(listener.DeregistrationDelay),
@@ -708,6 +880,42 @@ func (s *LoadBalancedWebService) convertNetworkLoadBalancer() (networkLoadBalanc
 	return config, nil
 }
 
+// convertCDN converts the "http.cdn" configuration of the service into a format parsable by the templates pkg.
+func (s *LoadBalancedWebService) convertCDN() (*template.ServiceCDNOpts, error) {
+	rrConfig := s.manifest.HTTPOrBool
+	if rrConfig.Disabled() || !rrConfig.CDNEnabled() {
+		return nil, nil
+	}
+	cdn := rrConfig.CDN.Advanced
+	opts := &template.ServiceCDNOpts{
+		OriginShieldRegion: aws.StringValue(cdn.OriginShield),
+		Aliases:            cdn.Aliases,
+		Certificate:        aws.StringValue(cdn.Certificate),
+	}
+	for _, policy := range cdn.CachePolicies {
+		opts.CachePolicies = append(opts.CachePolicies, template.CDNCachePolicy{
+			PathPattern: aws.StringValue(policy.Path),
+			PolicyID:    policy.PolicyID(),
+		})
+	}
+	if !s.httpsEnabled {
+		return opts, nil
+	}
+	// HTTP requests are redirected to HTTPS by the load balancer, so CloudFront must reach it
+	// through a domain name that is covered by the certificates of the HTTPS listener.
+	aliases, err := convertAlias(rrConfig.Main.Alias)
+	if err != nil {
+		return nil, fmt.Errorf(`convert "http.alias" to string slice: %w`, err)
+	}
+	switch {
+	case len(aliases) > 0:
+		opts.OriginDomain = aliases[0]
+	case s.appInfo.Domain != "":
+		opts.OriginDomain = fmt.Sprintf("%s.%s.%s.%s", s.name, s.env, s.app, s.appInfo.Domain)
+	}
+	return opts, nil
+}
+
 func (s *LoadBalancedWebService) convertGracePeriod() *int64 {
 	if s.manifest.HTTPOrBool.Main.HealthCheck.Advanced.GracePeriod != nil {
 		return aws.Int64(int64(s.manifest.HTTPOrBool.Main.HealthCheck.Advanced.GracePeriod.Seconds()))
@@ -773,6 +981,36 @@ func convertServiceConnectServer(s manifest.ServiceConnectBoolOrArgs, target *ma
 	}
 }
 
+func convertServiceConnectAdditionalServers(s manifest.ServiceConnectBoolOrArgs, exposedPorts manifest.ExposedPortsIndex) []template.ServiceConnectServer {
+	var servers []template.ServiceConnectServer
+	for _, port := range s.Ports {
+		server := template.ServiceConnectServer{
+			Name:  exposedPorts.ContainerForPort[aws.Uint16Value(port.Port)],
+			Port:  strconv.Itoa(int(aws.Uint16Value(port.Port))),
+			Alias: aws.StringValue(port.Alias),
+		}
+		if port.ClientPort != nil {
+			server.ClientPort = strconv.Itoa(int(aws.Uint16Value(port.ClientPort)))
+		}
+		servers = append(servers, server)
+	}
+	return servers
+}
+
+func convertServiceConnectTimeout(t manifest.ServiceConnectTimeout) *template.ServiceConnectTimeoutOpts {
+	if t.IsEmpty() {
+		return nil
+	}
+	opts := &template.ServiceConnectTimeoutOpts{}
+	if t.Idle != nil {
+		opts.IdleTimeoutSeconds = aws.Int64(int64(t.Idle.Seconds()))
+	}
+	if t.PerRequest != nil {
+		opts.PerRequestTimeoutSeconds = aws.Int64(int64(t.PerRequest.Seconds()))
+	}
+	return opts
+}
+
 func convertLogging(lc manifest.Logging) *template.LogConfigOpts {
 	if lc.IsEmpty() {
 		return nil
@@ -825,6 +1063,42 @@ func convertEphemeral(in *int) *int {
 	return in
 }
 
+// defaultCostAlertThreshold is the percentage of the monthly budget that triggers an alert if none is specified.
+const defaultCostAlertThreshold = 100
+
+// convertCost converts the cost configuration of a service to template options.
+func convertCost(in manifest.Cost) *template.CostOpts {
+	if in.IsEmpty() {
+		return nil
+	}
+	threshold := defaultCostAlertThreshold
+	if in.AlertThreshold != nil {
+		threshold = aws.IntValue(in.AlertThreshold)
+	}
+	return &template.CostOpts{
+		MonthlyBudget:    in.MonthlyBudget,
+		AlertThreshold:   threshold,
+		AnomalyDetection: aws.BoolValue(in.AnomalyDetection),
+		Emails:           in.Emails,
+	}
+}
+
+// convertAppConfig converts the AppConfig feature flags configuration of a service to template options.
+func convertAppConfig(in manifest.AppConfig) *template.AppConfigOpts {
+	if in.IsEmpty() {
+		return nil
+	}
+	var interval *int64
+	if in.PollInterval != nil {
+		interval = aws.Int64(int64(in.PollInterval.Seconds()))
+	}
+	return &template.AppConfigOpts{
+		Profiles:            in.Profiles,
+		PollIntervalSeconds: interval,
+		Port:                in.AgentPort(),
+	}
+}
+
 // convertSidecarMountPoints is used to convert from manifest to template objects.
 func convertSidecarMountPoints(in []manifest.SidecarMountPoint) []*template.MountPoint {
 	if len(in) == 0 {
@@ -1026,17 +1300,18 @@ func convertFileSystemID(in manifest.EFSVolumeConfiguration) template.FileSystem
 	return template.ImportedFileSystemID(aws.StringValue(in.FileSystemID.FromCFN.Name))
 }
 
-func convertNetworkConfig(network manifest.NetworkConfig) template.NetworkOpts {
-	if network.IsEmpty() {
-		return template.NetworkOpts{
-			AssignPublicIP: template.EnablePublicIP,
-			SubnetsType:    template.PublicSubnetsPlacement,
-		}
-	}
+func convertNetworkConfig(network manifest.NetworkConfig, envDeniesIntraEnvTraffic bool) template.NetworkOpts {
 	opts := template.NetworkOpts{
 		AssignPublicIP: template.EnablePublicIP,
 		SubnetsType:    template.PublicSubnetsPlacement,
 	}
+	if envDeniesIntraEnvTraffic {
+		opts.ServiceSecurityGroup = true
+		opts.IngressFromServices = network.Ingress.FromServices
+	}
+	if network.IsEmpty() {
+		return opts
+	}
 	inSGs := network.VPC.SecurityGroups.GetIDs()
 	outSGs := make([]template.SecurityGroup, len(inSGs))
 	for i, sg := range inSGs {
@@ -1054,10 +1329,13 @@ func convertNetworkConfig(network manifest.NetworkConfig) template.NetworkOpts {
 		return opts
 	}
 	if placement.PlacementString != nil {
-		if *placement.PlacementString == manifest.PrivateSubnetPlacement {
+		if *placement.PlacementString != manifest.PublicSubnetPlacement {
 			opts.AssignPublicIP = template.DisablePublicIP
 		}
 		opts.SubnetsType = subnetPlacementForTemplate[*placement.PlacementString]
+		if group, ok := placement.PlacementString.SubnetGroup(); ok {
+			opts.SubnetsType = template.SubnetGroupPlacement(group)
+		}
 		return opts
 	}
 	opts.AssignPublicIP = template.DisablePublicIP
@@ -1071,18 +1349,126 @@ func convertRDWSNetworkConfig(network manifest.RequestDrivenWebServiceNetworkCon
 	if network.IsEmpty() {
 		return opts
 	}
+	if network.VPC.Connector != nil {
+		opts.VPCConnector = aws.StringValue(network.VPC.Connector)
+		return opts
+	}
 	placement := network.VPC.Placement
 	if placement.IsEmpty() {
 		return opts
 	}
 	if placement.PlacementString != nil {
 		opts.SubnetsType = subnetPlacementForTemplate[*placement.PlacementString]
+		if group, ok := placement.PlacementString.SubnetGroup(); ok {
+			opts.SubnetsType = template.SubnetGroupPlacement(group)
+		}
 		return opts
 	}
 	opts.SubnetIDs = placement.PlacementArgs.Subnets.IDs
 	return opts
 }
 
+// convertStaticSiteErrorResponses converts the error pages of a static site into a format parsable by the templates pkg.
+func convertStaticSiteErrorResponses(http manifest.StaticSiteHTTP) []template.CloudFrontErrorResponse {
+	if aws.BoolValue(http.SPA) {
+		// Single-page apps handle the routing client-side, so every unknown path serves the index with a successful status.
+		return []template.CloudFrontErrorResponse{
+			{ErrorCode: 403, ResponseCode: 200, PagePath: "/index.html"},
+			{ErrorCode: 404, ResponseCode: 200, PagePath: "/index.html"},
+		}
+	}
+	var responses []template.CloudFrontErrorResponse
+	if http.ErrorPages.Forbidden != "" {
+		responses = append(responses, template.CloudFrontErrorResponse{
+			ErrorCode:    403,
+			ResponseCode: 403,
+			PagePath:     http.ErrorPages.Forbidden,
+		})
+	}
+	if http.ErrorPages.NotFound != "" {
+		responses = append(responses, template.CloudFrontErrorResponse{
+			ErrorCode:    404,
+			ResponseCode: 404,
+			PagePath:     http.ErrorPages.NotFound,
+		})
+	}
+	return responses
+}
+
+// convertStaticSiteRedirects converts the redirects of a static site into a format parsable by the templates pkg.
+func convertStaticSiteRedirects(redirects []manifest.StaticSiteRedirect) []template.CloudFrontRedirect {
+	var out []template.CloudFrontRedirect
+	for _, r := range redirects {
+		status := http.StatusMovedPermanently
+		if r.Status != nil {
+			status = aws.IntValue(r.Status)
+		}
+		out = append(out, template.CloudFrontRedirect{
+			Path:              strings.TrimSuffix(r.From, "*"),
+			MatchPrefix:       strings.HasSuffix(r.From, "*"),
+			Location:          strings.TrimSuffix(r.To, "*"),
+			AppendSuffix:      strings.HasSuffix(r.To, "*"),
+			StatusCode:        status,
+			StatusDescription: http.StatusText(status),
+		})
+	}
+	return out
+}
+
+// convertJobQueueTrigger returns the options to start executions of a job from an SQS queue, or nil if the job isn't triggered by a queue.
+func convertJobQueueTrigger(q manifest.JobQueueTrigger) *template.JobQueueTriggerOpts {
+	if q.IsEmpty() {
+		return nil
+	}
+	opts := &template.JobQueueTriggerOpts{
+		BatchSize:      defaultJobQueueBatchSize,
+		MaxConcurrency: q.MaxConcurrency,
+	}
+	if q.BatchSize != nil {
+		opts.BatchSize = aws.IntValue(q.BatchSize)
+	}
+	if name := aws.StringValue(q.Name); strings.HasPrefix(name, "arn:") {
+		opts.QueueARN = name
+	} else {
+		opts.QueueName = name
+	}
+	return opts
+}
+
+// convertJobAlerts converts the alerts of a job invoked with the schedule expression into template options.
+// Each alarm period spans the longest interval between two scheduled invocations so that it always expects a run.
+// If the interval is longer than the maximum period, each failure is evaluated over as many periods as the interval spans.
+func convertJobAlerts(alerts manifest.JobAlerts, schedule string) (*template.JobAlertsOpts, error) {
+	if alerts.IsEmpty() {
+		return nil, nil
+	}
+	interval, err := deploy.LongestScheduleInterval(schedule)
+	if err != nil {
+		return nil, fmt.Errorf("compute the interval between invocations: %w", err)
+	}
+	period := interval.Truncate(time.Minute)
+	if period < interval {
+		period += time.Minute
+	}
+	periodsPerRun := 1
+	if period > maxJobAlertsPeriod {
+		periodsPerRun = int((period + maxJobAlertsPeriod - 1) / maxJobAlertsPeriod)
+		period = maxJobAlertsPeriod
+	}
+	threshold := aws.IntValue(alerts.FailuresThreshold)
+	evaluationPeriods := threshold * periodsPerRun
+	if evaluationRange := period * time.Duration(evaluationPeriods); evaluationRange > maxJobAlertsEvaluationRange {
+		return nil, fmt.Errorf(`"alerts.failures_threshold" of %d runs every %s exceeds the maximum evaluation range of %s for CloudWatch alarms`,
+			threshold, interval, maxJobAlertsEvaluationRange)
+	}
+	return &template.JobAlertsOpts{
+		FailuresThreshold: threshold,
+		EvaluationPeriods: evaluationPeriods,
+		Period:            int(period.Seconds()),
+		Emails:            alerts.Emails,
+	}, nil
+}
+
 func convertAlias(alias manifest.Alias) ([]string, error) {
 	out, err := alias.ToStringSlice()
 	if err != nil {
@@ -1104,6 +1490,12 @@ func convertDeploymentControllerConfig(in manifest.DeploymentControllerConfig) t
 		MinHealthyPercent: minHealthyPercentDefault,
 		MaxPercent:        maxPercentDefault,
 	}
+	if in.AZRebalancing != nil {
+		out.AZRebalancing = disabled
+		if aws.BoolValue(in.AZRebalancing) {
+			out.AZRebalancing = enabled
+		}
+	}
 	if strings.EqualFold(aws.StringValue(in.Rolling), manifest.ECSRecreateRollingUpdateStrategy) {
 		out.MinHealthyPercent = minHealthyPercentRecreate
 		out.MaxPercent = maxPercentRecreate
@@ -1118,6 +1510,7 @@ func convertDeploymentConfig(in manifest.DeploymentConfig) template.DeploymentCo
 		CPUUtilization:    in.RollbackAlarms.Advanced.CPUUtilization,
 		MemoryUtilization: in.RollbackAlarms.Advanced.MemoryUtilization,
 	}
+	out.Rollback.DeploymentValidation = convertDeploymentValidation(in.Validation)
 	return out
 }
 
@@ -1129,9 +1522,23 @@ func convertWorkerDeploymentConfig(in manifest.WorkerDeploymentConfig) template.
 		MemoryUtilization: in.WorkerRollbackAlarms.Advanced.MemoryUtilization,
 		MessagesDelayed:   in.WorkerRollbackAlarms.Advanced.MessagesDelayed,
 	}
+	out.Rollback.DeploymentValidation = convertDeploymentValidation(in.Validation)
 	return out
 }
 
+// convertDeploymentValidation returns the configuration of the alarm set when the validation of a deployment fails.
+// The alarm evaluates failures for one more minute than the validation can last so that ECS rolls back
+// a deployment whose validation fails right before the timeout.
+func convertDeploymentValidation(in manifest.DeploymentValidation) *template.DeploymentValidationAlarmOpts {
+	if in.IsEmpty() {
+		return nil
+	}
+	minutes := int(math.Ceil(in.TimeoutOrDefault().Minutes()))
+	return &template.DeploymentValidationAlarmOpts{
+		EvaluationPeriods: minutes + 1,
+	}
+}
+
 func convertCommand(command manifest.CommandOverride) ([]string, error) {
 	out, err := command.ToStringSlice()
 	if err != nil {
@@ -1176,7 +1583,7 @@ func convertPublish(topics []manifest.Topic, accountID, region, app, env, svc st
 }
 
 func convertSubscribe(s *manifest.WorkerService) (*template.SubscribeOpts, error) {
-	if s.Subscribe.Topics == nil {
+	if s.Subscribe.Topics == nil && s.Subscribe.Buckets == nil && s.Subscribe.Kafka.IsEmpty() {
 		return nil, nil
 	}
 	var subscriptions template.SubscribeOpts
@@ -1187,10 +1594,44 @@ func convertSubscribe(s *manifest.WorkerService) (*template.SubscribeOpts, error
 		}
 		subscriptions.Topics = append(subscriptions.Topics, ts)
 	}
+	for _, b := range s.Subscribe.Buckets {
+		subscriptions.Buckets = append(subscriptions.Buckets, &template.BucketSubscription{
+			Name:    b.Name,
+			Service: b.Service,
+			Prefix:  b.Prefix,
+		})
+	}
 	subscriptions.Queue = convertQueue(s.Subscribe.Queue)
+	subscriptions.Kafka = convertKafkaSubscription(s.Subscribe.Kafka)
 	return &subscriptions, nil
 }
 
+func convertKafkaSubscription(in manifest.KafkaSubscription) *template.KafkaSubscription {
+	if in.IsEmpty() {
+		return nil
+	}
+	out := &template.KafkaSubscription{
+		ClusterARN:       convertStringOrFromCFNVariable(in.ClusterARN),
+		BootstrapBrokers: convertStringOrFromCFNVariable(in.BootstrapBrokers),
+		Topics:           in.Topics,
+		ConsumerGroup:    aws.StringValue(in.ConsumerGroup),
+	}
+	switch {
+	case in.SecurityGroup.Plain != nil:
+		out.SecurityGroup = template.PlainSecurityGroup(aws.StringValue(in.SecurityGroup.Plain))
+	case in.SecurityGroup.FromCFN.Name != nil:
+		out.SecurityGroup = template.ImportedSecurityGroup(aws.StringValue(in.SecurityGroup.FromCFN.Name))
+	}
+	return out
+}
+
+func convertStringOrFromCFNVariable(in manifest.StringOrFromCFN) template.Variable {
+	if in.FromCFN.Name != nil {
+		return template.ImportedVariable(aws.StringValue(in.FromCFN.Name))
+	}
+	return template.PlainVariable(aws.StringValue(in.Plain))
+}
+
 func convertTopicSubscription(t manifest.TopicSubscription) (
 	*template.TopicSubscription, error) {
 	filterPolicy, err := convertFilterPolicy(t.FilterPolicy)
@@ -1284,6 +1725,7 @@ func convertDeadLetter(d manifest.DeadLetterQueue) *template.DeadLetterQueue {
 	}
 	return &template.DeadLetterQueue{
 		Tries: d.Tries,
+		ARN:   aws.StringValue(d.ARN),
 	}
 }
 
@@ -1298,6 +1740,19 @@ func convertAppInformation(app deploy.AppInformation) (delegationRole *string, d
 	return
 }
 
+// convertCredentialSpec returns the location of the gMSA credential spec of a Windows container, or nil if there is none.
+func convertCredentialSpec(windows manifest.WindowsConfig) *template.CredentialSpecOpts {
+	if windows.CredentialSpec == nil {
+		return nil
+	}
+	credSpecARN := aws.StringValue(windows.CredentialSpec)
+	parsed, err := arn.Parse(credSpecARN)
+	return &template.CredentialSpecOpts{
+		ARN:  credSpecARN,
+		InS3: err == nil && parsed.Service == "s3",
+	}
+}
+
 func convertPlatform(platform manifest.PlatformArgsOrString) template.RuntimePlatformOpts {
 	if platform.IsEmpty() {
 		return template.RuntimePlatformOpts{}
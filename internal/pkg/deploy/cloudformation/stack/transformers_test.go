@@ -11,6 +11,7 @@ import (
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/template/templatetest"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -52,6 +53,8 @@ func Test_convertSidecar(t *testing.T) {
 		inDependsOn       map[string]string
 		inImageOverride   manifest.ImageOverride
 		inHealthCheck     manifest.ContainerHealthCheck
+		inVolumesFrom     []string
+		inTaskConfig      manifest.TaskConfig
 		circDepContainers []string
 
 		wanted    *template.SidecarOpts
@@ -256,6 +259,30 @@ func Test_convertSidecar(t *testing.T) {
 				},
 			},
 		},
+		"init container that shares volumes with the main container": {
+			inEssential:   true,
+			inVolumesFrom: []string{"frontend"},
+			inTaskConfig: manifest.TaskConfig{
+				InitContainers: []string{"foo"},
+			},
+
+			wanted: &template.SidecarOpts{
+				Name:        "foo",
+				CredsParam:  mockCredsParam,
+				Image:       mockImage,
+				Secrets:     mockSecrets,
+				Variables:   mockMap,
+				Essential:   aws.Bool(false),
+				VolumesFrom: []string{"frontend"},
+				PortMappings: []*template.PortMapping{
+					{
+						Protocol:      "tcp",
+						ContainerName: "foo",
+						ContainerPort: uint16(2000),
+					},
+				},
+			},
+		},
 		"with health check": {
 			inHealthCheck: manifest.ContainerHealthCheck{
 				Command: []string{"foo", "bar"},
@@ -304,9 +331,10 @@ func Test_convertSidecar(t *testing.T) {
 					DependsOn:     tc.inDependsOn,
 					ImageOverride: tc.inImageOverride,
 					HealthCheck:   tc.inHealthCheck,
+					VolumesFrom:   tc.inVolumesFrom,
 				},
 			}
-			got, err := convertSidecars(sidecar, mockExposedPorts, mockRunTimeConfig)
+			got, err := convertSidecars(sidecar, tc.inTaskConfig, mockExposedPorts, mockRunTimeConfig)
 
 			if tc.wantedErr != nil {
 				require.EqualError(t, err, tc.wantedErr.Error())
@@ -745,6 +773,496 @@ func Test_convertPath(t *testing.T) {
 	}
 }
 
+func Test_convertStickiness(t *testing.T) {
+	twoHours := 2 * time.Hour
+	testCases := map[string]struct {
+		in     manifest.RoutingRule
+		wanted *template.StickinessOpts
+	}{
+		"nil if stickiness is not configured": {},
+		"nil if stickiness is a boolean": {
+			in: manifest.RoutingRule{
+				Stickiness: manifest.BasicToUnion[*bool, manifest.StickinessConfig](aws.Bool(true)),
+			},
+		},
+		"defaults to load balancer generated cookies": {
+			in: manifest.RoutingRule{
+				Stickiness: manifest.AdvancedToUnion[*bool](manifest.StickinessConfig{
+					Duration: &twoHours,
+				}),
+			},
+			wanted: &template.StickinessOpts{
+				Type:     "lb_cookie",
+				Duration: aws.Int64(7200),
+			},
+		},
+		"application cookie": {
+			in: manifest.RoutingRule{
+				Stickiness: manifest.AdvancedToUnion[*bool](manifest.StickinessConfig{
+					Type:       aws.String("app_cookie"),
+					CookieName: aws.String("SESSIONID"),
+				}),
+			},
+			wanted: &template.StickinessOpts{
+				Type:       "app_cookie",
+				CookieName: "SESSIONID",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, convertStickiness(tc.in))
+		})
+	}
+}
+
+func Test_convertMutualTLSConfig(t *testing.T) {
+	testCases := map[string]struct {
+		in     manifest.MutualTLSConfig
+		wanted *template.MutualTLS
+	}{
+		"nil if mutual tls is not configured": {},
+		"passthrough": {
+			in: manifest.MutualTLSConfig{
+				Mode: aws.String("passthrough"),
+			},
+			wanted: &template.MutualTLS{
+				Mode: "passthrough",
+			},
+		},
+		"defaults to verifying clients against an imported trust store": {
+			in: manifest.MutualTLSConfig{
+				TrustStore:                    aws.String("arn:aws:elasticloadbalancing:us-west-2:123456789012:truststore/clients/abc"),
+				IgnoreClientCertificateExpiry: aws.Bool(true),
+			},
+			wanted: &template.MutualTLS{
+				Mode:                          "verify",
+				TrustStoreARN:                 "arn:aws:elasticloadbalancing:us-west-2:123456789012:truststore/clients/abc",
+				IgnoreClientCertificateExpiry: true,
+			},
+		},
+		"verifies clients against a trust store created from a ca bundle": {
+			in: manifest.MutualTLSConfig{
+				Mode:     aws.String("verify"),
+				CABundle: aws.String("s3://my-bucket/certs/ca.pem"),
+			},
+			wanted: &template.MutualTLS{
+				Mode:           "verify",
+				CABundleBucket: "my-bucket",
+				CABundleKey:    "certs/ca.pem",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, convertMutualTLSConfig(tc.in))
+		})
+	}
+}
+
+func Test_convertFlowLogsConfig(t *testing.T) {
+	testCases := map[string]struct {
+		in     manifest.Union[*bool, manifest.VPCFlowLogsArgs]
+		wanted *template.VPCFlowLogs
+	}{
+		"nil if flow logs are not configured": {},
+		"nil if flow logs are disabled": {
+			in: manifest.BasicToUnion[*bool, manifest.VPCFlowLogsArgs](aws.Bool(false)),
+		},
+		"defaults to all the traffic published to CloudWatch Logs": {
+			in: manifest.BasicToUnion[*bool, manifest.VPCFlowLogsArgs](aws.Bool(true)),
+			wanted: &template.VPCFlowLogs{
+				Retention:              aws.Int(14),
+				TrafficType:            "ALL",
+				MaxAggregationInterval: 60,
+			},
+		},
+		"customized CloudWatch Logs destination": {
+			in: manifest.AdvancedToUnion[*bool](manifest.VPCFlowLogsArgs{
+				Retention:           aws.Int(30),
+				TrafficType:         aws.String("reject"),
+				AggregationInterval: (*time.Duration)(aws.Int64(int64(10 * time.Minute))),
+			}),
+			wanted: &template.VPCFlowLogs{
+				Retention:              aws.Int(30),
+				TrafficType:            "REJECT",
+				MaxAggregationInterval: 600,
+			},
+		},
+		"S3 destination": {
+			in: manifest.AdvancedToUnion[*bool](manifest.VPCFlowLogsArgs{
+				Destination: aws.String("s3"),
+				BucketName:  aws.String("my-bucket"),
+				Prefix:      aws.String("flow-logs"),
+			}),
+			wanted: &template.VPCFlowLogs{
+				TrafficType:            "ALL",
+				MaxAggregationInterval: 60,
+				S3BucketName:           "my-bucket",
+				S3Prefix:               "flow-logs",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mft := &manifest.Environment{}
+			mft.Network.VPC.FlowLogs = tc.in
+
+			got, err := convertFlowLogsConfig(mft)
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func Test_convertAppRunnerConnectors(t *testing.T) {
+	testCases := map[string]struct {
+		in     map[string]manifest.AppRunnerConnector
+		wanted []template.AppRunnerConnector
+	}{
+		"nil if there are no connectors": {},
+		"connectors sorted by name": {
+			in: map[string]manifest.AppRunnerConnector{
+				"public": {
+					Placement: (*manifest.PlacementString)(aws.String("public")),
+				},
+				"db": {
+					SecurityGroups: []string{"sg-1234"},
+				},
+				"imported": {
+					Subnets: []string{"subnet-1", "subnet-2"},
+				},
+			},
+			wanted: []template.AppRunnerConnector{
+				{
+					Name:           "db",
+					SubnetsType:    template.PrivateSubnetsPlacement,
+					SecurityGroups: []string{"sg-1234"},
+				},
+				{
+					Name:      "imported",
+					SubnetIDs: []string{"subnet-1", "subnet-2"},
+				},
+				{
+					Name:        "public",
+					SubnetsType: template.PublicSubnetsPlacement,
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, convertAppRunnerConnectors(tc.in))
+		})
+	}
+}
+
+func Test_convertDHCPOptions(t *testing.T) {
+	testCases := map[string]struct {
+		in     manifest.DHCPOptions
+		wanted *template.DHCPOptions
+	}{
+		"nil if the default options are used": {},
+		"defaults the domain name servers to the Amazon provided DNS": {
+			in: manifest.DHCPOptions{
+				DomainName: aws.String("corp.example.com"),
+			},
+			wanted: &template.DHCPOptions{
+				DomainName:        "corp.example.com",
+				DomainNameServers: []string{"AmazonProvidedDNS"},
+			},
+		},
+		"custom servers": {
+			in: manifest.DHCPOptions{
+				DomainNameServers: []string{"10.0.0.2", "AmazonProvidedDNS"},
+				NTPServers:        []string{"169.254.169.123"},
+			},
+			wanted: &template.DHCPOptions{
+				DomainNameServers: []string{"10.0.0.2", "AmazonProvidedDNS"},
+				NTPServers:        []string{"169.254.169.123"},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, convertDHCPOptions(tc.in))
+		})
+	}
+}
+
+func Test_convertDNSResolver(t *testing.T) {
+	testCases := map[string]struct {
+		in          manifest.DNSResolverConfig
+		wanted      *template.DNSResolver
+		wantedError string
+	}{
+		"nil if there are no rules": {},
+		"forwarding rules with default and custom ports": {
+			in: manifest.DNSResolverConfig{
+				ForwardingRules: []manifest.DNSForwardingRule{
+					{
+						Domain:    aws.String("corp.example.com"),
+						TargetIPs: []manifest.DNSTargetIP{"10.10.0.2", "10.10.0.3:5353"},
+					},
+				},
+				RuleIDs: []string{"rslvr-rr-1234567890abcdef0"},
+			},
+			wanted: &template.DNSResolver{
+				ForwardingRules: []template.DNSForwardingRule{
+					{
+						Domain: "corp.example.com",
+						TargetIPs: []template.DNSTargetIP{
+							{IP: "10.10.0.2", Port: 53},
+							{IP: "10.10.0.3", Port: 5353},
+						},
+					},
+				},
+				RuleIDs: []string{"rslvr-rr-1234567890abcdef0"},
+			},
+		},
+		"error if a target IP is invalid": {
+			in: manifest.DNSResolverConfig{
+				ForwardingRules: []manifest.DNSForwardingRule{
+					{
+						Domain:    aws.String("corp.example.com"),
+						TargetIPs: []manifest.DNSTargetIP{"corp-dns"},
+					},
+				},
+			},
+			wantedError: `parse "corp-dns": address corp-dns: missing port in address`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := convertDNSResolver(tc.in)
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func Test_convertStaticSiteErrorResponses(t *testing.T) {
+	testCases := map[string]struct {
+		in     manifest.StaticSiteHTTP
+		wanted []template.CloudFrontErrorResponse
+	}{
+		"nil if no error page is configured": {},
+		"serve the index with a successful status for single-page apps": {
+			in: manifest.StaticSiteHTTP{
+				SPA: aws.Bool(true),
+			},
+			wanted: []template.CloudFrontErrorResponse{
+				{ErrorCode: 403, ResponseCode: 200, PagePath: "/index.html"},
+				{ErrorCode: 404, ResponseCode: 200, PagePath: "/index.html"},
+			},
+		},
+		"serve the error pages with the error status": {
+			in: manifest.StaticSiteHTTP{
+				ErrorPages: manifest.StaticSiteErrorPages{
+					NotFound: "/404.html",
+				},
+			},
+			wanted: []template.CloudFrontErrorResponse{
+				{ErrorCode: 404, ResponseCode: 404, PagePath: "/404.html"},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, convertStaticSiteErrorResponses(tc.in))
+		})
+	}
+}
+
+func Test_convertStaticSiteRedirects(t *testing.T) {
+	testCases := map[string]struct {
+		in     []manifest.StaticSiteRedirect
+		wanted []template.CloudFrontRedirect
+	}{
+		"nil if no redirect is configured": {},
+		"permanent redirect of a path by default": {
+			in: []manifest.StaticSiteRedirect{
+				{From: "/old.html", To: "/new.html"},
+			},
+			wanted: []template.CloudFrontRedirect{
+				{
+					Path:              "/old.html",
+					Location:          "/new.html",
+					StatusCode:        301,
+					StatusDescription: "Moved Permanently",
+				},
+			},
+		},
+		"redirect the paths under a prefix": {
+			in: []manifest.StaticSiteRedirect{
+				{From: "/blog/*", To: "/posts/*", Status: aws.Int(302)},
+				{From: "/docs/*", To: "https://docs.example.com"},
+			},
+			wanted: []template.CloudFrontRedirect{
+				{
+					Path:              "/blog/",
+					MatchPrefix:       true,
+					Location:          "/posts/",
+					AppendSuffix:      true,
+					StatusCode:        302,
+					StatusDescription: "Found",
+				},
+				{
+					Path:              "/docs/",
+					MatchPrefix:       true,
+					Location:          "https://docs.example.com",
+					StatusCode:        301,
+					StatusDescription: "Moved Permanently",
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, convertStaticSiteRedirects(tc.in))
+		})
+	}
+}
+
+func Test_convertNetworkConfig(t *testing.T) {
+	testCases := map[string]struct {
+		network                  manifest.NetworkConfig
+		envDeniesIntraEnvTraffic bool
+		wanted                   template.NetworkOpts
+	}{
+		"no service security group if the environment allows intra-env traffic": {
+			network: manifest.NetworkConfig{
+				Ingress: manifest.NetworkIngressConfig{
+					FromServices: []string{"frontend"},
+				},
+			},
+			wanted: template.NetworkOpts{
+				AssignPublicIP: template.EnablePublicIP,
+				SubnetsType:    template.PublicSubnetsPlacement,
+			},
+		},
+		"service security group with ingress from services if the environment denies intra-env traffic": {
+			network: manifest.NetworkConfig{
+				Ingress: manifest.NetworkIngressConfig{
+					FromServices: []string{"frontend"},
+				},
+			},
+			envDeniesIntraEnvTraffic: true,
+			wanted: template.NetworkOpts{
+				AssignPublicIP:       template.EnablePublicIP,
+				SubnetsType:          template.PublicSubnetsPlacement,
+				ServiceSecurityGroup: true,
+				IngressFromServices:  []string{"frontend"},
+			},
+		},
+		"subnet group placement by name": {
+			network: func() manifest.NetworkConfig {
+				var network manifest.NetworkConfig
+				network.VPC.Placement.PlacementString = (*manifest.PlacementString)(aws.String("data"))
+				return network
+			}(),
+			wanted: template.NetworkOpts{
+				AssignPublicIP: template.DisablePublicIP,
+				SubnetsType:    "SubnetGroupdataSubnets",
+				SecurityGroups: []template.SecurityGroup{},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, convertNetworkConfig(tc.network, tc.envDeniesIntraEnvTraffic))
+		})
+	}
+}
+
+func Test_convertRDWSNetworkConfig(t *testing.T) {
+	testCases := map[string]struct {
+		setup  func(network *manifest.RequestDrivenWebServiceNetworkConfig)
+		wanted template.NetworkOpts
+	}{
+		"empty if the network is not configured": {
+			setup: func(network *manifest.RequestDrivenWebServiceNetworkConfig) {},
+		},
+		"subnets placement": {
+			setup: func(network *manifest.RequestDrivenWebServiceNetworkConfig) {
+				network.VPC.Placement = manifest.PlacementArgOrString{
+					PlacementString: (*manifest.PlacementString)(aws.String("private")),
+				}
+			},
+			wanted: template.NetworkOpts{
+				SubnetsType: template.PrivateSubnetsPlacement,
+			},
+		},
+		"shared VPC connector of the environment": {
+			setup: func(network *manifest.RequestDrivenWebServiceNetworkConfig) {
+				network.VPC.Connector = aws.String("shared")
+			},
+			wanted: template.NetworkOpts{
+				VPCConnector: "shared",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var network manifest.RequestDrivenWebServiceNetworkConfig
+			tc.setup(&network)
+
+			require.Equal(t, tc.wanted, convertRDWSNetworkConfig(network))
+		})
+	}
+}
+
+func Test_convertResponseHeadersConfig(t *testing.T) {
+	testCases := map[string]struct {
+		in     manifest.ResponseHeadersConfig
+		wanted *template.ResponseHeaders
+	}{
+		"nil if response headers are not configured": {},
+		"converts the security headers": {
+			in: manifest.ResponseHeadersConfig{
+				StrictTransportSecurity: manifest.StrictTransportSecurityConfig{
+					MaxAge:  (*time.Duration)(aws.Int64(int64(365 * 24 * time.Hour))),
+					Preload: aws.Bool(true),
+				},
+				ContentSecurityPolicy: aws.String("default-src 'self'"),
+			},
+			wanted: &template.ResponseHeaders{
+				StrictTransportSecurity: &template.StrictTransportSecurity{
+					MaxAgeSeconds: 31536000,
+					Preload:       true,
+				},
+				ContentSecurityPolicy: "default-src 'self'",
+			},
+		},
+		"sorts the custom headers by name": {
+			in: manifest.ResponseHeadersConfig{
+				Custom: map[string]string{
+					"X-Frame-Options":        "DENY",
+					"Permissions-Policy":     "camera=()",
+					"X-Content-Type-Options": "nosniff",
+				},
+			},
+			wanted: &template.ResponseHeaders{
+				Custom: []template.CustomHeader{
+					{Name: "Permissions-Policy", Value: "camera=()"},
+					{Name: "X-Content-Type-Options", Value: "nosniff"},
+					{Name: "X-Frame-Options", Value: "DENY"},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, convertResponseHeadersConfig(tc.in))
+		})
+	}
+}
+
 func Test_convertTaskDefOverrideRules(t *testing.T) {
 	testCases := map[string]struct {
 		inRule []manifest.OverrideRule
@@ -1010,6 +1528,122 @@ func Test_convertImportedALB(t *testing.T) {
 	})
 }
 
+func Test_convertCDN(t *testing.T) {
+	testCases := map[string]struct {
+		inHTTP         manifest.HTTPOrBool
+		inHTTPSEnabled bool
+		inDomain       string
+
+		wanted *template.ServiceCDNOpts
+	}{
+		"return nil if the cdn is not enabled": {
+			inHTTP: manifest.HTTPOrBool{
+				HTTP: manifest.HTTP{
+					Main: manifest.RoutingRule{Path: aws.String("/")},
+				},
+			},
+		},
+		"return nil if http is disabled": {
+			inHTTP: manifest.HTTPOrBool{
+				Enabled: aws.Bool(false),
+				HTTP: manifest.HTTP{
+					CDN: manifest.BasicToUnion[*bool, manifest.ServiceCDNConfig](aws.Bool(true)),
+				},
+			},
+		},
+		"connect to the environment load balancer over HTTP": {
+			inHTTP: manifest.HTTPOrBool{
+				HTTP: manifest.HTTP{
+					Main: manifest.RoutingRule{Path: aws.String("/")},
+					CDN:  manifest.BasicToUnion[*bool, manifest.ServiceCDNConfig](aws.Bool(true)),
+				},
+			},
+			wanted: &template.ServiceCDNOpts{},
+		},
+		"connect to the default domain of the service over HTTPS": {
+			inHTTP: manifest.HTTPOrBool{
+				HTTP: manifest.HTTP{
+					Main: manifest.RoutingRule{Path: aws.String("/")},
+					CDN: manifest.AdvancedToUnion[*bool](manifest.ServiceCDNConfig{
+						CachePolicies: []manifest.CDNCachePolicy{
+							{
+								Path:   aws.String("/static/*"),
+								Policy: aws.String("CachingOptimized"),
+							},
+						},
+						OriginShield: aws.String("us-west-2"),
+						Aliases:      []string{"cdn.example.com"},
+					}),
+				},
+			},
+			inHTTPSEnabled: true,
+			inDomain:       "example.com",
+			wanted: &template.ServiceCDNOpts{
+				OriginDomain:       "frontend.test.phonetool.example.com",
+				OriginShieldRegion: "us-west-2",
+				CachePolicies: []template.CDNCachePolicy{
+					{
+						PathPattern: "/static/*",
+						PolicyID:    "658327ea-f89d-4fab-a63d-7e88639e58f6",
+					},
+				},
+				Aliases: []string{"cdn.example.com"},
+			},
+		},
+		"connect to the first alias of the service over HTTPS": {
+			inHTTP: manifest.HTTPOrBool{
+				HTTP: manifest.HTTP{
+					Main: manifest.RoutingRule{
+						Path: aws.String("/"),
+						Alias: manifest.Alias{
+							StringSliceOrString: manifest.StringSliceOrString{
+								StringSlice: []string{"api.example.com", "www.example.com"},
+							},
+						},
+					},
+					CDN: manifest.AdvancedToUnion[*bool](manifest.ServiceCDNConfig{
+						Aliases:     []string{"cdn.example.com"},
+						Certificate: aws.String("arn:aws:acm:us-east-1:123456789012:certificate/abc"),
+					}),
+				},
+			},
+			inHTTPSEnabled: true,
+			wanted: &template.ServiceCDNOpts{
+				OriginDomain: "api.example.com",
+				Aliases:      []string{"cdn.example.com"},
+				Certificate:  "arn:aws:acm:us-east-1:123456789012:certificate/abc",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			svc := &LoadBalancedWebService{
+				ecsWkld: &ecsWkld{
+					wkld: &wkld{
+						name: "frontend",
+						env:  "test",
+						app:  "phonetool",
+					},
+				},
+				manifest: &manifest.LoadBalancedWebService{
+					LoadBalancedWebServiceConfig: manifest.LoadBalancedWebServiceConfig{
+						HTTPOrBool: tc.inHTTP,
+					},
+				},
+				httpsEnabled: tc.inHTTPSEnabled,
+				appInfo: deploy.AppInformation{
+					Domain: tc.inDomain,
+				},
+			}
+
+			got, err := svc.convertCDN()
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
 func Test_convertManagedFSInfo(t *testing.T) {
 	testCases := map[string]struct {
 		inVolumes         map[string]*manifest.Volume
@@ -1564,6 +2198,199 @@ func Test_convertEphemeral(t *testing.T) {
 	}
 }
 
+func Test_convertJobQueueTrigger(t *testing.T) {
+	testCases := map[string]struct {
+		in     manifest.JobQueueTrigger
+		wanted *template.JobQueueTriggerOpts
+	}{
+		"nil if the job isn't triggered by a queue": {},
+		"queue referenced by name with the default batch size": {
+			in: manifest.JobQueueTrigger{
+				Name: aws.String("orders"),
+			},
+			wanted: &template.JobQueueTriggerOpts{
+				QueueName: "orders",
+				BatchSize: 10,
+			},
+		},
+		"queue referenced by ARN with batch size and max concurrency": {
+			in: manifest.JobQueueTrigger{
+				Name:           aws.String("arn:aws:sqs:us-west-2:123456789012:orders"),
+				BatchSize:      aws.Int(1),
+				MaxConcurrency: aws.Int(3),
+			},
+			wanted: &template.JobQueueTriggerOpts{
+				QueueARN:       "arn:aws:sqs:us-west-2:123456789012:orders",
+				BatchSize:      1,
+				MaxConcurrency: aws.Int(3),
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, convertJobQueueTrigger(tc.in))
+		})
+	}
+}
+
+func Test_convertJobAlerts(t *testing.T) {
+	testCases := map[string]struct {
+		inAlerts   manifest.JobAlerts
+		inSchedule string
+
+		wanted      *template.JobAlertsOpts
+		wantedError string
+	}{
+		"no alerts": {
+			inSchedule: "rate(1 hour)",
+		},
+		"alarm period matches a rate schedule": {
+			inAlerts: manifest.JobAlerts{
+				FailuresThreshold: aws.Int(3),
+				Emails:            []string{"ops@example.com"},
+			},
+			inSchedule: "rate(15 minutes)",
+			wanted: &template.JobAlertsOpts{
+				FailuresThreshold: 3,
+				EvaluationPeriods: 3,
+				Period:            900,
+				Emails:            []string{"ops@example.com"},
+			},
+		},
+		"alarm period is one day for a daily schedule": {
+			inAlerts: manifest.JobAlerts{
+				FailuresThreshold: aws.Int(2),
+			},
+			inSchedule: "cron(0 9 * * ? *)",
+			wanted: &template.JobAlertsOpts{
+				FailuresThreshold: 2,
+				EvaluationPeriods: 2,
+				Period:            86400,
+			},
+		},
+		"each run is evaluated over the periods of the longest interval when it exceeds one day": {
+			inAlerts: manifest.JobAlerts{
+				FailuresThreshold: aws.Int(2),
+			},
+			inSchedule: "cron(0 9 ? * MON-FRI *)", // 3 days between Friday and Monday.
+			wanted: &template.JobAlertsOpts{
+				FailuresThreshold: 2,
+				EvaluationPeriods: 6,
+				Period:            86400,
+			},
+		},
+		"error if the evaluation range is too long": {
+			inAlerts: manifest.JobAlerts{
+				FailuresThreshold: aws.Int(8),
+			},
+			inSchedule:  "cron(0 0 * * ? *)",
+			wantedError: `"alerts.failures_threshold" of 8 runs every 24h0m0s exceeds the maximum evaluation range of 168h0m0s for CloudWatch alarms`,
+		},
+		"error if the runs of a weekly job exceed the evaluation range": {
+			inAlerts: manifest.JobAlerts{
+				FailuresThreshold: aws.Int(2),
+			},
+			inSchedule:  "rate(7 days)",
+			wantedError: `"alerts.failures_threshold" of 2 runs every 168h0m0s exceeds the maximum evaluation range of 168h0m0s for CloudWatch alarms`,
+		},
+		"error if the schedule can't be parsed": {
+			inAlerts: manifest.JobAlerts{
+				FailuresThreshold: aws.Int(1),
+			},
+			inSchedule:  "cron(0 0 L * ? *)",
+			wantedError: `compute the interval between invocations: cron expression "cron(0 0 L * ? *)" uses the L, W or # wildcards which are not supported`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := convertJobAlerts(tc.inAlerts, tc.inSchedule)
+
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func Test_convertCost(t *testing.T) {
+	testCases := map[string]struct {
+		in     manifest.Cost
+		wanted *template.CostOpts
+	}{
+		"empty cost config": {
+			wanted: nil,
+		},
+		"budget with default threshold": {
+			in: manifest.Cost{
+				MonthlyBudget: aws.Float64(50),
+				Emails:        []string{"team@example.com"},
+			},
+			wanted: &template.CostOpts{
+				MonthlyBudget:  aws.Float64(50),
+				AlertThreshold: 100,
+				Emails:         []string{"team@example.com"},
+			},
+		},
+		"budget with custom threshold and anomaly detection": {
+			in: manifest.Cost{
+				MonthlyBudget:    aws.Float64(50),
+				AlertThreshold:   aws.Int(80),
+				AnomalyDetection: aws.Bool(true),
+			},
+			wanted: &template.CostOpts{
+				MonthlyBudget:    aws.Float64(50),
+				AlertThreshold:   80,
+				AnomalyDetection: true,
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, convertCost(tc.in))
+		})
+	}
+}
+
+func Test_convertAppConfig(t *testing.T) {
+	testCases := map[string]struct {
+		in     manifest.AppConfig
+		wanted *template.AppConfigOpts
+	}{
+		"empty appconfig": {
+			wanted: nil,
+		},
+		"profiles with the default port": {
+			in: manifest.AppConfig{
+				Profiles: []string{"checkout"},
+			},
+			wanted: &template.AppConfigOpts{
+				Profiles: []string{"checkout"},
+				Port:     2772,
+			},
+		},
+		"poll interval and port": {
+			in: manifest.AppConfig{
+				Profiles:     []string{"checkout", "search"},
+				PollInterval: (*time.Duration)(aws.Int64(int64(time.Minute))),
+				Port:         aws.Uint16(2773),
+			},
+			wanted: &template.AppConfigOpts{
+				Profiles:            []string{"checkout", "search"},
+				PollIntervalSeconds: aws.Int64(60),
+				Port:                2773,
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, convertAppConfig(tc.in))
+		})
+	}
+}
+
 func Test_convertPublish(t *testing.T) {
 	accountId := "123456789123"
 	partition := "aws"
@@ -1858,6 +2685,40 @@ func Test_convertSubscribe(t *testing.T) {
 				Queue: nil,
 			},
 		},
+		"valid subscribe with an existing dead-letter queue": {
+			inSubscribe: &manifest.WorkerService{
+				WorkerServiceConfig: manifest.WorkerServiceConfig{
+					Subscribe: manifest.SubscribeConfig{
+						Topics: []manifest.TopicSubscription{
+							{
+								Name:    aws.String("name"),
+								Service: aws.String("svc"),
+							},
+						},
+						Queue: manifest.SQSQueue{
+							DeadLetter: manifest.DeadLetterQueue{
+								Tries: aws.Uint16(5),
+								ARN:   aws.String("arn:aws:sqs:us-west-2:123456789012:dead-letters"),
+							},
+						},
+					},
+				},
+			},
+			wanted: &template.SubscribeOpts{
+				Topics: []*template.TopicSubscription{
+					{
+						Name:    aws.String("name"),
+						Service: aws.String("svc"),
+					},
+				},
+				Queue: &template.SQSQueue{
+					DeadLetter: &template.DeadLetterQueue{
+						Tries: aws.Uint16(5),
+						ARN:   "arn:aws:sqs:us-west-2:123456789012:dead-letters",
+					},
+				},
+			},
+		},
 		"valid subscribe with high throughput fifo sqs": { // 6
 			inSubscribe: &manifest.WorkerService{
 				WorkerServiceConfig: manifest.WorkerServiceConfig{
@@ -2265,6 +3126,60 @@ func Test_convertSubscribe(t *testing.T) {
 				},
 			},
 		},
+		"valid subscribe with only buckets": { // 12
+			inSubscribe: &manifest.WorkerService{
+				WorkerServiceConfig: manifest.WorkerServiceConfig{
+					Subscribe: manifest.SubscribeConfig{
+						Buckets: []manifest.BucketSubscription{
+							{
+								Name:    aws.String("uploads"),
+								Service: aws.String("api"),
+								Prefix:  aws.String("images/"),
+							},
+						},
+					},
+				},
+			},
+			wanted: &template.SubscribeOpts{
+				Buckets: []*template.BucketSubscription{
+					{
+						Name:    aws.String("uploads"),
+						Service: aws.String("api"),
+						Prefix:  aws.String("images/"),
+					},
+				},
+			},
+		},
+		"valid subscribe with only kafka": { // 13
+			inSubscribe: &manifest.WorkerService{
+				WorkerServiceConfig: manifest.WorkerServiceConfig{
+					Subscribe: manifest.SubscribeConfig{
+						Kafka: manifest.KafkaSubscription{
+							ClusterARN: manifest.StringOrFromCFN{
+								Plain: aws.String("arn:aws:kafka:us-west-2:123456789012:cluster/orders/1a2b3c4d-5e6f-7a8b-9c0d-1e2f3a4b5c6d-2"),
+							},
+							BootstrapBrokers: manifest.StringOrFromCFN{
+								Plain: aws.String("b-1.orders.abc123.c2.kafka.us-west-2.amazonaws.com:9098"),
+							},
+							SecurityGroup: manifest.StringOrFromCFN{
+								Plain: aws.String("sg-0123456789abcdef0"),
+							},
+							Topics:        []string{"orders", "payments"},
+							ConsumerGroup: aws.String("billing"),
+						},
+					},
+				},
+			},
+			wanted: &template.SubscribeOpts{
+				Kafka: &template.KafkaSubscription{
+					ClusterARN:       template.PlainVariable("arn:aws:kafka:us-west-2:123456789012:cluster/orders/1a2b3c4d-5e6f-7a8b-9c0d-1e2f3a4b5c6d-2"),
+					BootstrapBrokers: template.PlainVariable("b-1.orders.abc123.c2.kafka.us-west-2.amazonaws.com:9098"),
+					SecurityGroup:    template.PlainSecurityGroup("sg-0123456789abcdef0"),
+					Topics:           []string{"orders", "payments"},
+					ConsumerGroup:    "billing",
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -2300,6 +3215,17 @@ func Test_convertDeploymentConfig(t *testing.T) {
 				MaxPercent:        maxPercentDefault,
 			},
 		},
+		"if availability zone rebalancing indicated, populate it": {
+			in: manifest.DeploymentConfig{
+				DeploymentControllerConfig: manifest.DeploymentControllerConfig{
+					AZRebalancing: aws.Bool(false),
+				}},
+			out: template.DeploymentConfigurationOpts{
+				MinHealthyPercent: minHealthyPercentDefault,
+				MaxPercent:        maxPercentDefault,
+				AZRebalancing:     "DISABLED",
+			},
+		},
 		"if nothing indicated, populate with rolling defaults": {
 			in: manifest.DeploymentConfig{},
 			out: template.DeploymentConfigurationOpts{
@@ -2337,6 +3263,44 @@ func Test_convertDeploymentConfig(t *testing.T) {
 				},
 			},
 		},
+		"if validation entered, evaluate the alarm for one minute past the default timeout": {
+			in: manifest.DeploymentConfig{
+				Validation: manifest.DeploymentValidation{
+					Path: aws.String("/healthz"),
+				},
+			},
+			out: template.DeploymentConfigurationOpts{
+				MinHealthyPercent: minHealthyPercentDefault,
+				MaxPercent:        maxPercentDefault,
+				Rollback: template.RollingUpdateRollbackConfig{
+					DeploymentValidation: &template.DeploymentValidationAlarmOpts{
+						EvaluationPeriods: 3,
+					},
+				},
+			},
+		},
+		"if validation entered with a timeout, round the timeout up to the minute": {
+			in: manifest.DeploymentConfig{
+				RollbackAlarms: manifest.BasicToUnion[[]string, manifest.AlarmArgs](
+					[]string{"alarmName1"}),
+				Validation: manifest.DeploymentValidation{
+					Command: manifest.CommandOverride{
+						StringSlice: []string{"./smoke-test.sh"},
+					},
+					Timeout: (*time.Duration)(aws.Int64(int64(90 * time.Second))),
+				},
+			},
+			out: template.DeploymentConfigurationOpts{
+				MinHealthyPercent: minHealthyPercentDefault,
+				MaxPercent:        maxPercentDefault,
+				Rollback: template.RollingUpdateRollbackConfig{
+					AlarmNames: []string{"alarmName1"},
+					DeploymentValidation: &template.DeploymentValidationAlarmOpts{
+						EvaluationPeriods: 3,
+					},
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -2392,6 +3356,37 @@ func Test_convertWorkerDeploymentConfig(t *testing.T) {
 	}
 }
 
+func Test_convertCredentialSpec(t *testing.T) {
+	testCases := map[string]struct {
+		in  manifest.WindowsConfig
+		out *template.CredentialSpecOpts
+	}{
+		"should return nil if there is no credential spec": {},
+		"should return a credential spec stored in S3": {
+			in: manifest.WindowsConfig{
+				CredentialSpec: aws.String("arn:aws:s3:::my-bucket/gmsa-credspec.json"),
+			},
+			out: &template.CredentialSpecOpts{
+				ARN:  "arn:aws:s3:::my-bucket/gmsa-credspec.json",
+				InS3: true,
+			},
+		},
+		"should return a credential spec stored in SSM": {
+			in: manifest.WindowsConfig{
+				CredentialSpec: aws.String("arn:aws:ssm:us-west-2:123456789012:parameter/gmsa-credspec"),
+			},
+			out: &template.CredentialSpecOpts{
+				ARN: "arn:aws:ssm:us-west-2:123456789012:parameter/gmsa-credspec",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.out, convertCredentialSpec(tc.in))
+		})
+	}
+}
+
 func Test_convertPlatform(t *testing.T) {
 	testCases := map[string]struct {
 		in  manifest.PlatformArgsOrString
@@ -2500,6 +3495,31 @@ func Test_convertHTTPVersion(t *testing.T) {
 	}
 }
 
+func Test_convertServiceConnectTimeout(t *testing.T) {
+	idle, perRequest := 5*time.Minute, time.Duration(0)
+	testCases := map[string]struct {
+		in     manifest.ServiceConnectTimeout
+		wanted *template.ServiceConnectTimeoutOpts
+	}{
+		"should return nil if there is no user input": {},
+		"should convert the timeouts to seconds": {
+			in: manifest.ServiceConnectTimeout{
+				Idle:       &idle,
+				PerRequest: &perRequest,
+			},
+			wanted: &template.ServiceConnectTimeoutOpts{
+				IdleTimeoutSeconds:       aws.Int64(300),
+				PerRequestTimeoutSeconds: aws.Int64(0),
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, convertServiceConnectTimeout(tc.in))
+		})
+	}
+}
+
 func Test_convertCustomResources(t *testing.T) {
 	testCases := map[string]struct {
 		in        map[string]string
@@ -89,7 +89,7 @@ func (s *WorkerService) Template() (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("parse exposed ports in service manifest %s: %w", s.name, err)
 	}
-	sidecars, err := convertSidecars(s.manifest.Sidecars, exposedPorts.PortsForContainer, s.rc)
+	sidecars, err := convertSidecars(s.manifest.Sidecars, s.manifest.TaskConfig, exposedPorts.PortsForContainer, s.rc)
 	if err != nil {
 		return "", fmt.Errorf("convert the sidecar configuration for service %s: %w", s.name, err)
 	}
@@ -124,16 +124,24 @@ func (s *WorkerService) Template() (string, error) {
 	if err != nil {
 		return "", fmt.Errorf(`convert "publish" field for service %s: %w`, s.name, err)
 	}
+	network := convertNetworkConfig(s.manifest.Network, s.rc.EnvDeniesIntraEnvTraffic)
+	if subscribe != nil && subscribe.Kafka != nil && subscribe.Kafka.SecurityGroup != nil {
+		// The tasks need their own security group to be allowed in the security group of the MSK cluster.
+		network.ServiceSecurityGroup = true
+	}
 	scOpts := template.ServiceConnectOpts{
-		Server: convertServiceConnectServer(s.manifest.Network.Connect, nil),
-		Client: s.manifest.Network.Connect.Enabled(),
+		Server:    convertServiceConnectServer(s.manifest.Network.Connect, nil),
+		Client:    s.manifest.Network.Connect.Enabled(),
+		Namespace: s.rc.EnvConnectNamespace,
 	}
 	content, err := s.parser.ParseWorkerService(template.WorkloadOpts{
 		AppName:                  s.app,
 		EnvName:                  s.env,
 		WorkloadName:             s.name,
 		SerializedManifest:       string(s.rawManifest),
+		DeployValues:             s.rc.DeployValues,
 		EnvVersion:               s.rc.EnvVersion,
+		EnvKMSKeyARN:             s.rc.EnvKMSKeyARN,
 		Version:                  s.rc.Version,
 		Variables:                convertEnvVars(s.manifest.WorkerServiceConfig.Variables),
 		Secrets:                  convertSecrets(s.manifest.WorkerServiceConfig.Secrets),
@@ -150,20 +158,26 @@ func (s *WorkerService) Template() (string, error) {
 		DockerLabels:             s.manifest.ImageConfig.Image.DockerLabels,
 		CustomResources:          crs,
 		Storage:                  convertStorageOpts(s.manifest.Name, s.manifest.Storage),
-		Network:                  convertNetworkConfig(s.manifest.Network),
+		Network:                  network,
 		DeploymentConfiguration:  convertWorkerDeploymentConfig(s.manifest.WorkerServiceConfig.DeployConfig),
 		EntryPoint:               entrypoint,
 		ServiceConnectOpts:       scOpts,
 		Command:                  command,
-		DependsOn:                convertDependsOn(s.manifest.ImageConfig.Image.DependsOn),
+		DependsOn:                convertDependsOn(s.manifest.ImageConfig.Image.DependsOn.WithInitContainers(s.manifest.InitContainers)),
 		CredentialsParameter:     aws.StringValue(s.manifest.ImageConfig.Image.Credentials),
 		ServiceDiscoveryEndpoint: s.rc.ServiceDiscoveryEndpoint,
 		Subscribe:                subscribe,
 		Publish:                  publishers,
 		Platform:                 convertPlatform(s.manifest.Platform),
+		CredentialSpec:           convertCredentialSpec(s.manifest.Windows),
 		Observability: template.ObservabilityOpts{
-			Tracing: strings.ToUpper(aws.StringValue(s.manifest.Observability.Tracing)),
+			Tracing:         strings.ToUpper(s.manifest.Observability.TracingVendor()),
+			SamplingRate:    s.manifest.Observability.TracingSamplingRate(),
+			CollectorConfig: s.rc.TracingConfig,
+			Dashboard:       aws.BoolValue(s.manifest.Observability.Dashboard),
 		},
+		Cost:                convertCost(s.manifest.WorkerServiceConfig.Cost),
+		AppConfig:           convertAppConfig(s.manifest.WorkerServiceConfig.AppConfig),
 		PermissionsBoundary: s.permBound,
 	})
 	if err != nil {
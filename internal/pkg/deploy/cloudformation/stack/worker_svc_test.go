@@ -196,6 +196,49 @@ Outputs:
 			},
 			wantedTemplate: "template",
 		},
+		"render template with a security group for the tasks if they reach an MSK cluster": {
+			setUpManifest: func(svc *WorkerService) {
+				svc.manifest = manifest.NewWorkerService(baseProps)
+				svc.manifest.Subscribe.Kafka = manifest.KafkaSubscription{
+					ClusterARN:       manifest.StringOrFromCFN{Plain: aws.String("arn:aws:kafka:us-west-2:123456789012:cluster/orders/1a2b3c4d-5e6f-7a8b-9c0d-1e2f3a4b5c6d-2")},
+					BootstrapBrokers: manifest.StringOrFromCFN{Plain: aws.String("b-1.orders.abc123.c2.kafka.us-west-2.amazonaws.com:9098")},
+					SecurityGroup:    manifest.StringOrFromCFN{Plain: aws.String("sg-0123456789abcdef0")},
+					Topics:           []string{"orders"},
+				}
+			},
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *WorkerService) {
+				m := mocks.NewMockworkerSvcReadParser(ctrl)
+				m.EXPECT().ParseWorkerService(gomock.Any()).DoAndReturn(func(actual template.WorkloadOpts) (*template.Content, error) {
+					require.True(t, actual.Network.ServiceSecurityGroup)
+					require.Equal(t, &template.KafkaSubscription{
+						ClusterARN:       template.PlainVariable("arn:aws:kafka:us-west-2:123456789012:cluster/orders/1a2b3c4d-5e6f-7a8b-9c0d-1e2f3a4b5c6d-2"),
+						BootstrapBrokers: template.PlainVariable("b-1.orders.abc123.c2.kafka.us-west-2.amazonaws.com:9098"),
+						SecurityGroup:    template.PlainSecurityGroup("sg-0123456789abcdef0"),
+						Topics:           []string{"orders"},
+					}, actual.Subscribe.Kafka)
+					return &template.Content{Buffer: bytes.NewBufferString("template")}, nil
+				})
+				svc.parser = m
+				svc.addons = mockAddons{}
+			},
+			wantedTemplate: "template",
+		},
+		"render template with a dashboard": {
+			setUpManifest: func(svc *WorkerService) {
+				svc.manifest = manifest.NewWorkerService(baseProps)
+				svc.manifest.Observability.Dashboard = aws.Bool(true)
+			},
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *WorkerService) {
+				m := mocks.NewMockworkerSvcReadParser(ctrl)
+				m.EXPECT().ParseWorkerService(gomock.Any()).DoAndReturn(func(actual template.WorkloadOpts) (*template.Content, error) {
+					require.True(t, actual.Observability.Dashboard)
+					return &template.Content{Buffer: bytes.NewBufferString("template")}, nil
+				})
+				svc.parser = m
+				svc.addons = mockAddons{}
+			},
+			wantedTemplate: "template",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -75,6 +75,9 @@ type RuntimeConfig struct {
 	PushedImages       map[string]ECRImage // Optional. Image location in an ECR repository.
 	AddonsTemplateURL  string              // Optional. S3 object URL for the addons template.
 	EnvFileARNs        map[string]string   // Optional. S3 object ARNs for any env files. Map keys are container names.
+	EnvFileVariables   map[string]string   // Optional. Variables read from the env file of services that can't reference it from S3.
+	TracingConfig      string              // Optional. Content of the configuration file of the OpenTelemetry collector sidecar.
+	DeployValues       map[string]string   // Optional. Manifest fields overridden at deploy time with the --values flag.
 	AdditionalTags     map[string]string   // AdditionalTags are labels applied to resources in the workload stack.
 	CustomResourcesURL map[string]string   // Mapping of Custom Resource Function Name to the S3 URL where the function zip file is stored.
 
@@ -83,6 +86,9 @@ type RuntimeConfig struct {
 	AccountID                string
 	Region                   string
 	EnvVersion               string
+	EnvKMSKeyARN             string // Customer managed key used to encrypt the environment's resources.
+	EnvDeniesIntraEnvTraffic bool   // Whether the environment security group denies the traffic between workloads.
+	EnvConnectNamespace      string // Cloud Map namespace shared with other applications that services join for Service Connect.
 	Version                  string
 }
 
@@ -89,6 +89,8 @@ func (cf CloudFormation) GetTaskStack(taskName string) (*deploy.TaskStackInfo, e
 		switch aws.StringValue(out.OutputKey) {
 		case stack.TaskOutputS3Bucket:
 			info.BucketName = aws.StringValue(out.OutputValue)
+		case stack.TaskOutputEFSSecurityGroup:
+			info.EFSSecurityGroup = aws.StringValue(out.OutputValue)
 		}
 	}
 	if !isTask {
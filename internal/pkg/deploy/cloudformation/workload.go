@@ -4,9 +4,13 @@
 package cloudformation
 
 import (
+	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/template/artifactpath"
@@ -30,6 +34,106 @@ func (cf CloudFormation) DeployService(conf StackConfiguration, bucketName strin
 	return cf.executeAndRenderChangeSet(cf.newUpsertChangeSetInput(cf.console, stack, withEnableInterrupt(), withDetach(detach)))
 }
 
+// DeployedStack is the template, parameters and tags of a deployed stack.
+// It can be redeployed with DeployService to restore the stack to this configuration.
+type DeployedStack struct {
+	name       string
+	template   string
+	parameters []*sdkcloudformation.Parameter
+	tags       []*sdkcloudformation.Tag
+}
+
+// StackName returns the name of the stack.
+func (s *DeployedStack) StackName() string {
+	return s.name
+}
+
+// Template returns the deployed template of the stack.
+func (s *DeployedStack) Template() (string, error) {
+	return s.template, nil
+}
+
+// Parameters returns the deployed parameter values of the stack.
+func (s *DeployedStack) Parameters() ([]*sdkcloudformation.Parameter, error) {
+	return s.parameters, nil
+}
+
+// Tags returns the tags of the stack.
+func (s *DeployedStack) Tags() []*sdkcloudformation.Tag {
+	return s.tags
+}
+
+// SerializedParameters returns the deployed parameter values of the stack as a JSON string.
+func (s *DeployedStack) SerializedParameters() (string, error) {
+	params := make(map[string]string, len(s.parameters))
+	for _, param := range s.parameters {
+		params[aws.StringValue(param.ParameterKey)] = aws.StringValue(param.ParameterValue)
+	}
+	out, err := json.MarshalIndent(struct {
+		Parameters map[string]string `json:"Parameters"`
+	}{Parameters: params}, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("marshal parameters of stack %s: %w", s.name, err)
+	}
+	return string(out), nil
+}
+
+// NewDeployedStack returns the configuration of a stack from a deployment recorded in its history.
+func NewDeployedStack(name string, revision deploy.DeploymentRevision) *DeployedStack {
+	stack := &DeployedStack{
+		name:     name,
+		template: revision.Template,
+	}
+	for _, key := range sortedKeys(revision.Parameters) {
+		stack.parameters = append(stack.parameters, &sdkcloudformation.Parameter{
+			ParameterKey:   aws.String(key),
+			ParameterValue: aws.String(revision.Parameters[key]),
+		})
+	}
+	for _, key := range sortedKeys(revision.Tags) {
+		stack.tags = append(stack.tags, &sdkcloudformation.Tag{
+			Key:   aws.String(key),
+			Value: aws.String(revision.Tags[key]),
+		})
+	}
+	return stack
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// DeployedStack returns the currently deployed configuration of a stack.
+// If the stack doesn't exist, it returns a *cloudformation.ErrStackNotFound error.
+func (cf CloudFormation) DeployedStack(stackName string) (*DeployedStack, error) {
+	descr, err := cf.cfnClient.Describe(stackName)
+	if err != nil {
+		return nil, err
+	}
+	tpl, err := cf.cfnClient.TemplateBody(stackName)
+	if err != nil {
+		return nil, fmt.Errorf("get template of stack %s: %w", stackName, err)
+	}
+	params := make([]*sdkcloudformation.Parameter, len(descr.Parameters))
+	for i, param := range descr.Parameters {
+		params[i] = &sdkcloudformation.Parameter{
+			ParameterKey:   param.ParameterKey,
+			ParameterValue: param.ParameterValue,
+		}
+	}
+	return &DeployedStack{
+		name:       stackName,
+		template:   tpl,
+		parameters: params,
+		tags:       descr.Tags,
+	}, nil
+}
+
 type uploadableStack interface {
 	StackName() string
 	Template() (string, error)
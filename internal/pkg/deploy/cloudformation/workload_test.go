@@ -106,6 +106,132 @@ func TestCloudFormation_DeployService(t *testing.T) {
 	})
 }
 
+func TestCloudFormation_DeployedStack(t *testing.T) {
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) cfnClient
+
+		wantedStack *DeployedStack
+		wantedErr   error
+	}{
+		"should return the error as is if the stack can't be described": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("kudos-test-api").Return(nil, &cloudformation.ErrStackNotFound{})
+				return m
+			},
+			wantedErr: &cloudformation.ErrStackNotFound{},
+		},
+		"should return a wrapped error if the template can't be retrieved": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("kudos-test-api").Return(&cloudformation.StackDescription{}, nil)
+				m.EXPECT().TemplateBody("kudos-test-api").Return("", errors.New("some error"))
+				return m
+			},
+			wantedErr: errors.New("get template of stack kudos-test-api: some error"),
+		},
+		"should return the deployed template, parameters and tags": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("kudos-test-api").Return(&cloudformation.StackDescription{
+					Parameters: []*sdkcloudformation.Parameter{
+						{
+							ParameterKey:     aws.String("ContainerImage"),
+							ParameterValue:   aws.String("aws_account_id.dkr.ecr.region.amazonaws.com/kudos/api:v1"),
+							ResolvedValue:    aws.String("ignored"),
+							UsePreviousValue: aws.Bool(false),
+						},
+					},
+					Tags: []*sdkcloudformation.Tag{
+						{Key: aws.String("copilot-application"), Value: aws.String("kudos")},
+					},
+				}, nil)
+				m.EXPECT().TemplateBody("kudos-test-api").Return("template", nil)
+				return m
+			},
+			wantedStack: &DeployedStack{
+				name:     "kudos-test-api",
+				template: "template",
+				parameters: []*sdkcloudformation.Parameter{
+					{
+						ParameterKey:   aws.String("ContainerImage"),
+						ParameterValue: aws.String("aws_account_id.dkr.ecr.region.amazonaws.com/kudos/api:v1"),
+					},
+				},
+				tags: []*sdkcloudformation.Tag{
+					{Key: aws.String("copilot-application"), Value: aws.String("kudos")},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			c := CloudFormation{
+				cfnClient: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			got, err := c.DeployedStack("kudos-test-api")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedStack, got)
+		})
+	}
+}
+
+func TestDeployedStack_SerializedParameters(t *testing.T) {
+	s := &DeployedStack{
+		name: "kudos-test-api",
+		parameters: []*sdkcloudformation.Parameter{
+			{ParameterKey: aws.String("TaskCount"), ParameterValue: aws.String("2")},
+		},
+	}
+
+	got, err := s.SerializedParameters()
+
+	require.NoError(t, err)
+	require.Equal(t, `{
+  "Parameters": {
+    "TaskCount": "2"
+  }
+}`, got)
+}
+
+func TestNewDeployedStack(t *testing.T) {
+	got := NewDeployedStack("kudos-test-api", deploy.DeploymentRevision{
+		Template: "template",
+		Parameters: map[string]string{
+			"TaskCount":      "2",
+			"ContainerImage": "nginx",
+		},
+		Tags: map[string]string{
+			"copilot-application": "kudos",
+		},
+	})
+
+	require.Equal(t, &DeployedStack{
+		name:     "kudos-test-api",
+		template: "template",
+		parameters: []*sdkcloudformation.Parameter{
+			{ParameterKey: aws.String("ContainerImage"), ParameterValue: aws.String("nginx")},
+			{ParameterKey: aws.String("TaskCount"), ParameterValue: aws.String("2")},
+		},
+		tags: []*sdkcloudformation.Tag{
+			{Key: aws.String("copilot-application"), Value: aws.String("kudos")},
+		},
+	}, got)
+}
+
 func TestCloudFormation_DeleteWorkload(t *testing.T) {
 	in := deploy.DeleteWorkloadInput{
 		Name:    "webhook",
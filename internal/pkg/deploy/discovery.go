@@ -0,0 +1,306 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"gopkg.in/yaml.v3"
+
+	awscfn "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+)
+
+const (
+	fmtAppRolesStackName = "%s-infrastructure-roles"
+	fmtEnvStackName      = "%s-%s"
+	fmtWorkloadStackName = "%s-%s-%s"
+
+	envOutputManagerRoleKey   = "EnvironmentManagerRoleARN"
+	envOutputExecutionRoleKey = "CFNExecutionRoleARN"
+)
+
+// StackLister wraps the CloudFormation methods to discover the stacks deployed by Copilot.
+type StackLister interface {
+	ListStacksWithTags(tags map[string]string) ([]awscfn.StackDescription, error)
+	Metadata(opt awscfn.MetadataOpts) (string, error)
+}
+
+// StackConfigStore is a read-only config store that discovers applications, environments and workloads
+// from the tags of their CloudFormation stacks instead of the SSM parameters of the config store.
+// Only the stacks in the account and region of the CloudFormation client are discovered.
+type StackConfigStore struct {
+	cfn StackLister
+}
+
+// NewStackConfigStore returns a config store that discovers resources from the CloudFormation stacks of the client.
+func NewStackConfigStore(cfn StackLister) *StackConfigStore {
+	return &StackConfigStore{
+		cfn: cfn,
+	}
+}
+
+// ListApplications returns the applications whose infrastructure roles stack is deployed.
+func (s *StackConfigStore) ListApplications() ([]*config.Application, error) {
+	stacks, err := s.cfn.ListStacksWithTags(map[string]string{
+		AppTagKey: "",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list application stacks: %w", err)
+	}
+	var apps []*config.Application
+	for _, stack := range stacks {
+		name := tagValue(stack, AppTagKey)
+		if aws.StringValue(stack.StackName) != fmt.Sprintf(fmtAppRolesStackName, name) {
+			continue
+		}
+		apps = append(apps, &config.Application{
+			Name:      name,
+			AccountID: stackAccountID(stack),
+		})
+	}
+	sort.Slice(apps, func(i, j int) bool { return apps[i].Name < apps[j].Name })
+	return apps, nil
+}
+
+// GetApplication returns the application whose infrastructure roles stack is deployed.
+func (s *StackConfigStore) GetApplication(appName string) (*config.Application, error) {
+	apps, err := s.ListApplications()
+	if err != nil {
+		return nil, err
+	}
+	for _, app := range apps {
+		if app.Name == appName {
+			return app, nil
+		}
+	}
+	return nil, &config.ErrNoSuchApplication{
+		ApplicationName: appName,
+	}
+}
+
+// ListEnvironments returns the environments of the application whose stack is deployed.
+func (s *StackConfigStore) ListEnvironments(appName string) ([]*config.Environment, error) {
+	return s.listEnvironments(appName, "")
+}
+
+// GetEnvironment returns the environment of the application from the outputs of its stack.
+func (s *StackConfigStore) GetEnvironment(appName, envName string) (*config.Environment, error) {
+	envs, err := s.listEnvironments(appName, envName)
+	if err != nil {
+		return nil, err
+	}
+	if len(envs) == 0 {
+		return nil, &config.ErrNoSuchEnvironment{
+			ApplicationName: appName,
+			EnvironmentName: envName,
+		}
+	}
+	return envs[0], nil
+}
+
+func (s *StackConfigStore) listEnvironments(appName, envName string) ([]*config.Environment, error) {
+	stacks, err := s.cfn.ListStacksWithTags(map[string]string{
+		AppTagKey: appName,
+		EnvTagKey: envName,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list environment stacks of application %s: %w", appName, err)
+	}
+	var envs []*config.Environment
+	for _, stack := range stacks {
+		name := tagValue(stack, EnvTagKey)
+		if tagValue(stack, ServiceTagKey) != "" || aws.StringValue(stack.StackName) != fmt.Sprintf(fmtEnvStackName, appName, name) {
+			// Skip the stacks of workloads and the nested stacks of the environment.
+			continue
+		}
+		env, err := envFromStack(appName, name, stack)
+		if err != nil {
+			return nil, err
+		}
+		envs = append(envs, env)
+	}
+	sort.Slice(envs, func(i, j int) bool { return envs[i].Name < envs[j].Name })
+	return envs, nil
+}
+
+// ListWorkloads returns the workloads of the application deployed in at least one environment.
+func (s *StackConfigStore) ListWorkloads(appName string) ([]*config.Workload, error) {
+	return s.listWorkloads(appName, "")
+}
+
+// ListServices returns the services of the application deployed in at least one environment.
+func (s *StackConfigStore) ListServices(appName string) ([]*config.Workload, error) {
+	wklds, err := s.ListWorkloads(appName)
+	if err != nil {
+		return nil, err
+	}
+	return filterWorkloads(wklds, manifestinfo.ServiceTypes()), nil
+}
+
+// ListJobs returns the jobs of the application deployed in at least one environment.
+func (s *StackConfigStore) ListJobs(appName string) ([]*config.Workload, error) {
+	wklds, err := s.ListWorkloads(appName)
+	if err != nil {
+		return nil, err
+	}
+	return filterWorkloads(wklds, manifestinfo.JobTypes()), nil
+}
+
+// GetWorkload returns the workload of the application deployed in at least one environment.
+func (s *StackConfigStore) GetWorkload(appName, name string) (*config.Workload, error) {
+	wklds, err := s.listWorkloads(appName, name)
+	if err != nil {
+		return nil, err
+	}
+	if len(wklds) == 0 {
+		return nil, &config.ErrNoSuchService{
+			App:  appName,
+			Name: name,
+		}
+	}
+	return wklds[0], nil
+}
+
+// GetService returns the service of the application deployed in at least one environment.
+func (s *StackConfigStore) GetService(appName, svcName string) (*config.Workload, error) {
+	wkld, err := s.GetWorkload(appName, svcName)
+	if err != nil {
+		return nil, err
+	}
+	if len(filterWorkloads([]*config.Workload{wkld}, manifestinfo.ServiceTypes())) == 0 {
+		return nil, fmt.Errorf("%s is a %s, not a service", svcName, wkld.Type)
+	}
+	return wkld, nil
+}
+
+// GetJob returns the job of the application deployed in at least one environment.
+func (s *StackConfigStore) GetJob(appName, jobName string) (*config.Workload, error) {
+	wkld, err := s.GetWorkload(appName, jobName)
+	if err != nil {
+		var errNoSuchSvc *config.ErrNoSuchService
+		if errors.As(err, &errNoSuchSvc) {
+			return nil, &config.ErrNoSuchJob{
+				App:  appName,
+				Name: jobName,
+			}
+		}
+		return nil, err
+	}
+	if len(filterWorkloads([]*config.Workload{wkld}, manifestinfo.JobTypes())) == 0 {
+		return nil, fmt.Errorf("%s is a %s, not a job", jobName, wkld.Type)
+	}
+	return wkld, nil
+}
+
+func (s *StackConfigStore) listWorkloads(appName, name string) ([]*config.Workload, error) {
+	stacks, err := s.cfn.ListStacksWithTags(map[string]string{
+		AppTagKey:     appName,
+		EnvTagKey:     "",
+		ServiceTagKey: name,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list workload stacks of application %s: %w", appName, err)
+	}
+	seen := make(map[string]bool)
+	var wklds []*config.Workload
+	for _, stack := range stacks {
+		wkldName, envName := tagValue(stack, ServiceTagKey), tagValue(stack, EnvTagKey)
+		if seen[wkldName] || aws.StringValue(stack.StackName) != fmt.Sprintf(fmtWorkloadStackName, appName, envName, wkldName) {
+			// Skip the workloads deployed to multiple environments and the addons stacks.
+			continue
+		}
+		seen[wkldName] = true
+		wkldType, err := s.workloadType(aws.StringValue(stack.StackName))
+		if err != nil {
+			return nil, err
+		}
+		wklds = append(wklds, &config.Workload{
+			App:  appName,
+			Name: wkldName,
+			Type: wkldType,
+		})
+	}
+	sort.Slice(wklds, func(i, j int) bool { return wklds[i].Name < wklds[j].Name })
+	return wklds, nil
+}
+
+// workloadType returns the type of a workload from the manifest in the metadata of its stack.
+func (s *StackConfigStore) workloadType(stackName string) (string, error) {
+	raw, err := s.cfn.Metadata(awscfn.MetadataWithStackName(stackName))
+	if err != nil {
+		return "", fmt.Errorf("get metadata of stack %s: %w", stackName, err)
+	}
+	var metadata struct {
+		Manifest string `json:"Manifest"`
+	}
+	if err := json.Unmarshal([]byte(raw), &metadata); err != nil {
+		return "", fmt.Errorf("unmarshal metadata of stack %s: %w", stackName, err)
+	}
+	var mft struct {
+		Type string `yaml:"type"`
+	}
+	if err := yaml.Unmarshal([]byte(metadata.Manifest), &mft); err != nil {
+		return "", fmt.Errorf("unmarshal manifest in the metadata of stack %s: %w", stackName, err)
+	}
+	if mft.Type == "" {
+		return "", fmt.Errorf("stack %s does not record the type of its workload: redeploy it with a recent version of Copilot", stackName)
+	}
+	return mft.Type, nil
+}
+
+func envFromStack(appName, envName string, stack awscfn.StackDescription) (*config.Environment, error) {
+	stackARN, err := arn.Parse(aws.StringValue(stack.StackId))
+	if err != nil {
+		return nil, fmt.Errorf("parse ID of stack %s: %w", aws.StringValue(stack.StackName), err)
+	}
+	outputs := make(map[string]string)
+	for _, output := range stack.Outputs {
+		outputs[aws.StringValue(output.OutputKey)] = aws.StringValue(output.OutputValue)
+	}
+	return &config.Environment{
+		App:              appName,
+		Name:             envName,
+		Region:           stackARN.Region,
+		AccountID:        stackARN.AccountID,
+		ManagerRoleARN:   outputs[envOutputManagerRoleKey],
+		ExecutionRoleARN: outputs[envOutputExecutionRoleKey],
+	}, nil
+}
+
+func stackAccountID(stack awscfn.StackDescription) string {
+	stackARN, err := arn.Parse(aws.StringValue(stack.StackId))
+	if err != nil {
+		return ""
+	}
+	return stackARN.AccountID
+}
+
+func tagValue(stack awscfn.StackDescription, key string) string {
+	for _, tag := range stack.Tags {
+		if aws.StringValue(tag.Key) == key {
+			return aws.StringValue(tag.Value)
+		}
+	}
+	return ""
+}
+
+func filterWorkloads(wklds []*config.Workload, types []string) []*config.Workload {
+	var filtered []*config.Workload
+	for _, wkld := range wklds {
+		for _, t := range types {
+			if wkld.Type == t {
+				filtered = append(filtered, wkld)
+				break
+			}
+		}
+	}
+	return filtered
+}
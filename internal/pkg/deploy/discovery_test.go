@@ -0,0 +1,272 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	awscfn "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func mockStack(name, id string, tags map[string]string, outputs map[string]string) awscfn.StackDescription {
+	stack := awscfn.StackDescription{
+		StackName: aws.String(name),
+		StackId:   aws.String(id),
+	}
+	for k, v := range tags {
+		stack.Tags = append(stack.Tags, &cloudformation.Tag{Key: aws.String(k), Value: aws.String(v)})
+	}
+	for k, v := range outputs {
+		stack.Outputs = append(stack.Outputs, &cloudformation.Output{OutputKey: aws.String(k), OutputValue: aws.String(v)})
+	}
+	return stack
+}
+
+func TestStackConfigStore_GetApplication(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MockStackLister)
+
+		wanted    *config.Application
+		wantedErr error
+	}{
+		"error if stacks can't be listed": {
+			setupMocks: func(m *mocks.MockStackLister) {
+				m.EXPECT().ListStacksWithTags(map[string]string{AppTagKey: ""}).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list application stacks: some error"),
+		},
+		"error if the application has no roles stack": {
+			setupMocks: func(m *mocks.MockStackLister) {
+				m.EXPECT().ListStacksWithTags(gomock.Any()).Return([]awscfn.StackDescription{
+					mockStack("phonetool-test", "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test/1",
+						map[string]string{AppTagKey: "phonetool", EnvTagKey: "test"}, nil),
+				}, nil)
+			},
+			wantedErr: &config.ErrNoSuchApplication{ApplicationName: "phonetool"},
+		},
+		"discover the application from its roles stack": {
+			setupMocks: func(m *mocks.MockStackLister) {
+				m.EXPECT().ListStacksWithTags(gomock.Any()).Return([]awscfn.StackDescription{
+					mockStack("phonetool-infrastructure-roles", "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-infrastructure-roles/1",
+						map[string]string{AppTagKey: "phonetool"}, nil),
+				}, nil)
+			},
+			wanted: &config.Application{
+				Name:      "phonetool",
+				AccountID: "123456789012",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockStackLister(ctrl)
+			tc.setupMocks(m)
+
+			got, err := NewStackConfigStore(m).GetApplication("phonetool")
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestStackConfigStore_GetEnvironment(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MockStackLister)
+
+		wanted    *config.Environment
+		wantedErr error
+	}{
+		"error if the environment stack doesn't exist": {
+			setupMocks: func(m *mocks.MockStackLister) {
+				m.EXPECT().ListStacksWithTags(map[string]string{AppTagKey: "phonetool", EnvTagKey: "test"}).Return([]awscfn.StackDescription{
+					mockStack("phonetool-test-frontend", "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-frontend/1",
+						map[string]string{AppTagKey: "phonetool", EnvTagKey: "test", ServiceTagKey: "frontend"}, nil),
+				}, nil)
+			},
+			wantedErr: &config.ErrNoSuchEnvironment{ApplicationName: "phonetool", EnvironmentName: "test"},
+		},
+		"discover the environment from the outputs of its stack": {
+			setupMocks: func(m *mocks.MockStackLister) {
+				m.EXPECT().ListStacksWithTags(map[string]string{AppTagKey: "phonetool", EnvTagKey: "test"}).Return([]awscfn.StackDescription{
+					mockStack("phonetool-test-AddonsStack-1", "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-AddonsStack-1/1",
+						map[string]string{AppTagKey: "phonetool", EnvTagKey: "test"}, nil),
+					mockStack("phonetool-test", "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test/1",
+						map[string]string{AppTagKey: "phonetool", EnvTagKey: "test"},
+						map[string]string{
+							"EnvironmentManagerRoleARN": "arn:aws:iam::123456789012:role/phonetool-test-EnvManagerRole",
+							"CFNExecutionRoleARN":       "arn:aws:iam::123456789012:role/phonetool-test-CFNExecutionRole",
+						}),
+				}, nil)
+			},
+			wanted: &config.Environment{
+				App:              "phonetool",
+				Name:             "test",
+				Region:           "us-west-2",
+				AccountID:        "123456789012",
+				ManagerRoleARN:   "arn:aws:iam::123456789012:role/phonetool-test-EnvManagerRole",
+				ExecutionRoleARN: "arn:aws:iam::123456789012:role/phonetool-test-CFNExecutionRole",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockStackLister(ctrl)
+			tc.setupMocks(m)
+
+			got, err := NewStackConfigStore(m).GetEnvironment("phonetool", "test")
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestStackConfigStore_ListServices(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MockStackLister)
+
+		wanted    []*config.Workload
+		wantedErr error
+	}{
+		"error if the metadata of a stack can't be retrieved": {
+			setupMocks: func(m *mocks.MockStackLister) {
+				m.EXPECT().ListStacksWithTags(gomock.Any()).Return([]awscfn.StackDescription{
+					mockStack("phonetool-test-frontend", "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-frontend/1",
+						map[string]string{AppTagKey: "phonetool", EnvTagKey: "test", ServiceTagKey: "frontend"}, nil),
+				}, nil)
+				m.EXPECT().Metadata(gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("get metadata of stack phonetool-test-frontend: some error"),
+		},
+		"error if the stack doesn't record the type of the workload": {
+			setupMocks: func(m *mocks.MockStackLister) {
+				m.EXPECT().ListStacksWithTags(gomock.Any()).Return([]awscfn.StackDescription{
+					mockStack("phonetool-test-frontend", "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-frontend/1",
+						map[string]string{AppTagKey: "phonetool", EnvTagKey: "test", ServiceTagKey: "frontend"}, nil),
+				}, nil)
+				m.EXPECT().Metadata(gomock.Any()).Return(`{"Version":"v1.29.0"}`, nil)
+			},
+			wantedErr: errors.New("stack phonetool-test-frontend does not record the type of its workload: redeploy it with a recent version of Copilot"),
+		},
+		"discover the services deployed to any environment": {
+			setupMocks: func(m *mocks.MockStackLister) {
+				m.EXPECT().ListStacksWithTags(map[string]string{AppTagKey: "phonetool", EnvTagKey: "", ServiceTagKey: ""}).Return([]awscfn.StackDescription{
+					mockStack("phonetool-test-frontend", "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-frontend/1",
+						map[string]string{AppTagKey: "phonetool", EnvTagKey: "test", ServiceTagKey: "frontend"}, nil),
+					mockStack("phonetool-test-frontend-AddonsStack-1", "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-frontend-AddonsStack-1/1",
+						map[string]string{AppTagKey: "phonetool", EnvTagKey: "test", ServiceTagKey: "frontend"}, nil),
+					mockStack("phonetool-prod-frontend", "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-prod-frontend/1",
+						map[string]string{AppTagKey: "phonetool", EnvTagKey: "prod", ServiceTagKey: "frontend"}, nil),
+					mockStack("phonetool-test-backup", "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-backup/1",
+						map[string]string{AppTagKey: "phonetool", EnvTagKey: "test", ServiceTagKey: "backup"}, nil),
+				}, nil)
+				m.EXPECT().Metadata(awscfn.MetadataWithStackName("phonetool-test-frontend")).
+					Return(`{"Version":"v1.29.0","Manifest":"name: frontend\ntype: Load Balanced Web Service\n"}`, nil)
+				m.EXPECT().Metadata(awscfn.MetadataWithStackName("phonetool-test-backup")).
+					Return(`{"Version":"v1.29.0","Manifest":"name: backup\ntype: Scheduled Job\n"}`, nil)
+			},
+			wanted: []*config.Workload{
+				{
+					App:  "phonetool",
+					Name: "frontend",
+					Type: "Load Balanced Web Service",
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockStackLister(ctrl)
+			tc.setupMocks(m)
+
+			got, err := NewStackConfigStore(m).ListServices("phonetool")
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestStackConfigStore_GetService(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MockStackLister)
+
+		wanted    *config.Workload
+		wantedErr error
+	}{
+		"error if the service isn't deployed": {
+			setupMocks: func(m *mocks.MockStackLister) {
+				m.EXPECT().ListStacksWithTags(map[string]string{AppTagKey: "phonetool", EnvTagKey: "", ServiceTagKey: "frontend"}).Return(nil, nil)
+			},
+			wantedErr: &config.ErrNoSuchService{App: "phonetool", Name: "frontend"},
+		},
+		"error if the workload is a job": {
+			setupMocks: func(m *mocks.MockStackLister) {
+				m.EXPECT().ListStacksWithTags(gomock.Any()).Return([]awscfn.StackDescription{
+					mockStack("phonetool-test-frontend", "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-frontend/1",
+						map[string]string{AppTagKey: "phonetool", EnvTagKey: "test", ServiceTagKey: "frontend"}, nil),
+				}, nil)
+				m.EXPECT().Metadata(gomock.Any()).Return(`{"Manifest":"type: Scheduled Job"}`, nil)
+			},
+			wantedErr: errors.New("frontend is a Scheduled Job, not a service"),
+		},
+		"discover the service": {
+			setupMocks: func(m *mocks.MockStackLister) {
+				m.EXPECT().ListStacksWithTags(gomock.Any()).Return([]awscfn.StackDescription{
+					mockStack("phonetool-test-frontend", "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-frontend/1",
+						map[string]string{AppTagKey: "phonetool", EnvTagKey: "test", ServiceTagKey: "frontend"}, nil),
+				}, nil)
+				m.EXPECT().Metadata(gomock.Any()).Return(`{"Manifest":"type: Request-Driven Web Service"}`, nil)
+			},
+			wanted: &config.Workload{
+				App:  "phonetool",
+				Name: "frontend",
+				Type: "Request-Driven Web Service",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockStackLister(ctrl)
+			tc.setupMocks(m)
+
+			got, err := NewStackConfigStore(m).GetService("phonetool", "frontend")
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/aws/copilot-cli/internal/pkg/template/artifactpath"
+)
+
+// maxDeploymentRevisions is the number of deployments kept in the history of a stack.
+const maxDeploymentRevisions = 10
+
+// HistoryStorage wraps the S3 methods to read and write the deployment history of stacks.
+type HistoryStorage interface {
+	Upload(bucket, key string, data io.Reader) (string, error)
+	Download(bucket, key string) ([]byte, error)
+}
+
+// DeploymentRevision is the template, parameters and tags of a stack at the time it was deployed.
+type DeploymentRevision struct {
+	Template   string            `json:"template"`
+	Parameters map[string]string `json:"parameters,omitempty"`
+	Tags       map[string]string `json:"tags,omitempty"`
+	DeployedAt time.Time         `json:"deployedAt"`
+}
+
+// DeploymentHistory keeps the last deployments of the stacks of workloads in an S3 bucket,
+// so that a workload can be rolled back to a previous deployment.
+type DeploymentHistory struct {
+	s3     HistoryStorage
+	bucket string
+}
+
+// NewDeploymentHistory returns a deployment history stored in the bucket.
+func NewDeploymentHistory(s3 HistoryStorage, bucket string) *DeploymentHistory {
+	return &DeploymentHistory{
+		s3:     s3,
+		bucket: bucket,
+	}
+}
+
+// Revisions returns the recorded deployments of a stack, from the oldest to the latest.
+func (h *DeploymentHistory) Revisions(stackName string) ([]DeploymentRevision, error) {
+	content, err := h.s3.Download(h.bucket, artifactpath.DeploymentHistory(stackName))
+	if err != nil {
+		var errNotFound *s3.ErrObjectNotFound
+		if errors.As(err, &errNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get deployment history of stack %s: %w", stackName, err)
+	}
+	var revisions []DeploymentRevision
+	if err := json.Unmarshal(content, &revisions); err != nil {
+		return nil, fmt.Errorf("unmarshal deployment history of stack %s: %w", stackName, err)
+	}
+	return revisions, nil
+}
+
+// Record appends a deployment to the history of a stack, and drops the oldest deployments beyond the last ten.
+func (h *DeploymentHistory) Record(stackName string, revision DeploymentRevision) error {
+	revisions, err := h.Revisions(stackName)
+	if err != nil {
+		return err
+	}
+	revisions = append(revisions, revision)
+	if len(revisions) > maxDeploymentRevisions {
+		revisions = revisions[len(revisions)-maxDeploymentRevisions:]
+	}
+	return h.Save(stackName, revisions)
+}
+
+// Save replaces the deployment history of a stack.
+func (h *DeploymentHistory) Save(stackName string, revisions []DeploymentRevision) error {
+	content, err := json.Marshal(revisions)
+	if err != nil {
+		return fmt.Errorf("marshal deployment history of stack %s: %w", stackName, err)
+	}
+	if _, err := h.s3.Upload(h.bucket, artifactpath.DeploymentHistory(stackName), bytes.NewReader(content)); err != nil {
+		return fmt.Errorf("save deployment history of stack %s: %w", stackName, err)
+	}
+	return nil
+}
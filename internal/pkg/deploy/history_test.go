@@ -0,0 +1,120 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeploymentHistory_Revisions(t *testing.T) {
+	deployedAt := time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MockHistoryStorage)
+
+		wanted    []DeploymentRevision
+		wantedErr error
+	}{
+		"no revisions if the stack has no history": {
+			setupMocks: func(m *mocks.MockHistoryStorage) {
+				m.EXPECT().Download("bucket", "manual/deployments/phonetool-test-api.json").Return(nil, s3.NewMockErrObjectNotFound())
+			},
+		},
+		"error if the history cannot be downloaded": {
+			setupMocks: func(m *mocks.MockHistoryStorage) {
+				m.EXPECT().Download("bucket", "manual/deployments/phonetool-test-api.json").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get deployment history of stack phonetool-test-api: some error"),
+		},
+		"returns the revisions in the history": {
+			setupMocks: func(m *mocks.MockHistoryStorage) {
+				m.EXPECT().Download("bucket", "manual/deployments/phonetool-test-api.json").
+					Return([]byte(`[{"template":"v1","parameters":{"ContainerImage":"nginx:1"},"deployedAt":"2023-03-01T12:00:00Z"}]`), nil)
+			},
+			wanted: []DeploymentRevision{
+				{
+					Template:   "v1",
+					Parameters: map[string]string{"ContainerImage": "nginx:1"},
+					DeployedAt: deployedAt,
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockHistoryStorage(ctrl)
+			tc.setupMocks(m)
+			history := NewDeploymentHistory(m, "bucket")
+
+			// WHEN
+			got, err := history.Revisions("phonetool-test-api")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestDeploymentHistory_Record(t *testing.T) {
+	var full []DeploymentRevision
+	for i := 0; i < maxDeploymentRevisions; i++ {
+		full = append(full, DeploymentRevision{Template: fmt.Sprintf("v%d", i)})
+	}
+	testCases := map[string]struct {
+		inHistory []DeploymentRevision
+
+		wanted []DeploymentRevision
+	}{
+		"appends the revision to the history": {
+			inHistory: []DeploymentRevision{{Template: "v0"}},
+			wanted:    []DeploymentRevision{{Template: "v0"}, {Template: "new"}},
+		},
+		"drops the oldest revision when the history is full": {
+			inHistory: full,
+			wanted:    append(append([]DeploymentRevision{}, full[1:]...), DeploymentRevision{Template: "new"}),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockHistoryStorage(ctrl)
+			content, err := json.Marshal(tc.inHistory)
+			require.NoError(t, err)
+			m.EXPECT().Download("bucket", "manual/deployments/phonetool-test-api.json").Return(content, nil)
+			m.EXPECT().Upload("bucket", "manual/deployments/phonetool-test-api.json", gomock.Any()).
+				DoAndReturn(func(_, _ string, data io.Reader) (string, error) {
+					var got []DeploymentRevision
+					require.NoError(t, json.NewDecoder(data).Decode(&got))
+					require.Equal(t, tc.wanted, got)
+					return "url", nil
+				})
+			history := NewDeploymentHistory(m, "bucket")
+
+			// WHEN
+			err = history.Record("phonetool-test-api", DeploymentRevision{Template: "new"})
+
+			// THEN
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/deploy/discovery.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	cloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockStackLister is a mock of StackLister interface.
+type MockStackLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockStackListerMockRecorder
+}
+
+// MockStackListerMockRecorder is the mock recorder for MockStackLister.
+type MockStackListerMockRecorder struct {
+	mock *MockStackLister
+}
+
+// NewMockStackLister creates a new mock instance.
+func NewMockStackLister(ctrl *gomock.Controller) *MockStackLister {
+	mock := &MockStackLister{ctrl: ctrl}
+	mock.recorder = &MockStackListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStackLister) EXPECT() *MockStackListerMockRecorder {
+	return m.recorder
+}
+
+// ListStacksWithTags mocks base method.
+func (m *MockStackLister) ListStacksWithTags(tags map[string]string) ([]cloudformation.StackDescription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStacksWithTags", tags)
+	ret0, _ := ret[0].([]cloudformation.StackDescription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStacksWithTags indicates an expected call of ListStacksWithTags.
+func (mr *MockStackListerMockRecorder) ListStacksWithTags(tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStacksWithTags", reflect.TypeOf((*MockStackLister)(nil).ListStacksWithTags), tags)
+}
+
+// Metadata mocks base method.
+func (m *MockStackLister) Metadata(opt cloudformation.MetadataOpts) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Metadata", opt)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Metadata indicates an expected call of Metadata.
+func (mr *MockStackListerMockRecorder) Metadata(opt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Metadata", reflect.TypeOf((*MockStackLister)(nil).Metadata), opt)
+}
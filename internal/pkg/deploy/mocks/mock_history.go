@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/deploy/history.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	io "io"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockHistoryStorage is a mock of HistoryStorage interface.
+type MockHistoryStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockHistoryStorageMockRecorder
+}
+
+// MockHistoryStorageMockRecorder is the mock recorder for MockHistoryStorage.
+type MockHistoryStorageMockRecorder struct {
+	mock *MockHistoryStorage
+}
+
+// NewMockHistoryStorage creates a new mock instance.
+func NewMockHistoryStorage(ctrl *gomock.Controller) *MockHistoryStorage {
+	mock := &MockHistoryStorage{ctrl: ctrl}
+	mock.recorder = &MockHistoryStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHistoryStorage) EXPECT() *MockHistoryStorageMockRecorder {
+	return m.recorder
+}
+
+// Download mocks base method.
+func (m *MockHistoryStorage) Download(bucket, key string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Download", bucket, key)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Download indicates an expected call of Download.
+func (mr *MockHistoryStorageMockRecorder) Download(bucket, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Download", reflect.TypeOf((*MockHistoryStorage)(nil).Download), bucket, key)
+}
+
+// Upload mocks base method.
+func (m *MockHistoryStorage) Upload(bucket, key string, data io.Reader) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upload", bucket, key, data)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upload indicates an expected call of Upload.
+func (mr *MockHistoryStorageMockRecorder) Upload(bucket, key, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*MockHistoryStorage)(nil).Upload), bucket, key, data)
+}
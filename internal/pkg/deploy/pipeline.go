@@ -79,6 +79,10 @@ type CreatePipelineInput struct {
 	// be used in this pipeline.
 	ArtifactBuckets []ArtifactBucket
 
+	// ArtifactKMSKeyARN is the ARN of the customer managed KMS key that encrypts the artifacts in the region of the pipeline.
+	// It is empty if the artifacts are encrypted with the key created with the application.
+	ArtifactKMSKeyARN string
+
 	// AdditionalTags are labels applied to resources under the application.
 	AdditionalTags map[string]string
 
@@ -87,6 +91,94 @@ type CreatePipelineInput struct {
 
 	// Version is the pipeline template version.
 	Version string
+
+	// GitHubStatus is set if the results of the pipeline stages are reported to GitHub.
+	GitHubStatus *GitHubStatusReport
+
+	// Trigger is set if the pipeline is not released on changes to the source.
+	Trigger *PipelineTrigger
+
+	// CopilotBinaryURL is the URL of the Copilot linux binary that pre- and post-deployment actions download to run one-off tasks.
+	CopilotBinaryURL string
+
+	// CustomResourcesURLs is the mapping of custom resource function names to the S3 URLs where the function zip files are stored.
+	CustomResourcesURLs map[string]string
+}
+
+// PipelineTrigger represents what releases the pipeline instead of changes to the source.
+type PipelineTrigger struct {
+	// Schedule is the schedule to release the pipeline on as written in the manifest.
+	// It is empty if the pipeline is only released manually.
+	Schedule string
+}
+
+// NewPipelineTrigger returns the trigger of the pipeline.
+// It returns nil if the pipeline is released on changes to the source.
+func NewPipelineTrigger(mft manifest.PipelineTrigger) *PipelineTrigger {
+	switch {
+	case mft.IsManual():
+		return &PipelineTrigger{}
+	case mft.Schedule() != "":
+		return &PipelineTrigger{
+			Schedule: mft.Schedule(),
+		}
+	}
+	return nil
+}
+
+// GitHubStatusReport represents the configuration to report the results of
+// the pipeline stages as GitHub commit statuses and deployments.
+type GitHubStatusReport struct {
+	// Repository is the GitHub repository in the format "owner/repo".
+	Repository string
+
+	// AccessTokenSecret is the name or ARN of the Secrets Manager secret that holds a GitHub token.
+	AccessTokenSecret string
+
+	// Deployments is true if deployment stages are also reported as GitHub Deployments.
+	Deployments bool
+}
+
+// NewGitHubStatusReport returns the configuration to report the results of the pipeline stages to GitHub.
+// It returns nil if the manifest does not configure GitHub notifications.
+func NewGitHubStatusReport(mft *manifest.PipelineNotifications, source interface{}) (*GitHubStatusReport, error) {
+	if mft == nil || mft.GitHub == nil {
+		return nil, nil
+	}
+	secret := mft.GitHub.AccessTokenSecret
+	var url GitHubURL
+	switch src := source.(type) {
+	case *GitHubSource:
+		url = src.RepositoryURL
+	case *GitHubV1Source:
+		url = src.RepositoryURL
+		if secret == "" {
+			secret = src.PersonalAccessTokenSecretID
+		}
+	default:
+		return nil, errors.New(`"notifications.github" requires a GitHub source`)
+	}
+	if secret == "" {
+		return nil, errors.New(`"notifications.github.access_token_secret" must be specified`)
+	}
+	owner, repo, err := url.parse()
+	if err != nil {
+		return nil, err
+	}
+	return &GitHubStatusReport{
+		Repository:        fmt.Sprintf("%s/%s", owner, repo),
+		AccessTokenSecret: secret,
+		Deployments:       mft.GitHub.Deployments,
+	}, nil
+}
+
+// AccessTokenSecretARN returns the ARN of the access token secret,
+// or an empty string if the secret is referred to by its name.
+func (r *GitHubStatusReport) AccessTokenSecretARN() string {
+	if arn.IsARN(r.AccessTokenSecret) {
+		return r.AccessTokenSecret
+	}
+	return ""
 }
 
 // Build represents CodeBuild project used in the CodePipeline
@@ -606,6 +698,7 @@ func (stg *PipelineStage) PreDeployments() ([]PrePostDeployAction, error) {
 					envVarNameEnvironmentName: stg.associatedEnvironment.Name,
 				},
 			},
+			Task:   newPrePostDeployTask(conf.RunTask),
 			ranker: topo,
 		})
 	}
@@ -727,6 +820,7 @@ func (stg *PipelineStage) PostDeployments() ([]PrePostDeployAction, error) {
 					envVarNameEnvironmentName: stg.associatedEnvironment.Name,
 				},
 			},
+			Task:   newPrePostDeployTask(conf.RunTask),
 			ranker: topo,
 		})
 	}
@@ -904,10 +998,39 @@ func (a *TestCommandsAction) Commands() []string {
 type PrePostDeployAction struct {
 	action
 	Build
+	Task   *PrePostDeployTask // Set if the action runs a one-off task instead of a buildspec.
 	name   string
 	ranker ranker // Interface to rank this deployment action against others in the same stage.
 }
 
+// PrePostDeployTask represents a one-off task that a pre- or post-deployment action runs in the stage's environment.
+type PrePostDeployTask struct {
+	Image     string
+	Command   string
+	Variables map[string]string
+}
+
+func newPrePostDeployTask(mft *manifest.PrePostDeployTask) *PrePostDeployTask {
+	if mft == nil {
+		return nil
+	}
+	return &PrePostDeployTask{
+		Image:     mft.Image,
+		Command:   mft.Command,
+		Variables: mft.Variables,
+	}
+}
+
+// EnvVars returns the variables of the task in the "KEY=VALUE,KEY2=VALUE2" format of the "--env-vars" flag of "copilot task run".
+func (t *PrePostDeployTask) EnvVars() string {
+	vars := make([]string, 0, len(t.Variables))
+	for name, value := range t.Variables {
+		vars = append(vars, fmt.Sprintf("%s=%s", name, value))
+	}
+	sort.Strings(vars)
+	return strings.Join(vars, ",")
+}
+
 // Name returns the name of the action.
 func (p *PrePostDeployAction) Name() string {
 	return p.name
@@ -353,6 +353,123 @@ func TestParseOwnerAndRepo(t *testing.T) {
 	}
 }
 
+func TestNewGitHubStatusReport(t *testing.T) {
+	testCases := map[string]struct {
+		mft    *manifest.PipelineNotifications
+		source interface{}
+
+		wanted    *GitHubStatusReport
+		wantedErr error
+	}{
+		"nil if notifications are not configured": {
+			mft: &manifest.PipelineNotifications{},
+			source: &GitHubSource{
+				RepositoryURL: "https://github.com/badgoose/chaOS",
+			},
+		},
+		"error if the source is not GitHub": {
+			mft: &manifest.PipelineNotifications{
+				GitHub: &manifest.GitHubNotifications{
+					AccessTokenSecret: "github-token",
+				},
+			},
+			source: &CodeCommitSource{
+				RepositoryURL: "https://us-west-2.console.aws.amazon.com/codesuite/codecommit/repositories/wings/browse",
+			},
+			wantedErr: errors.New(`"notifications.github" requires a GitHub source`),
+		},
+		"error if the access token secret is missing": {
+			mft: &manifest.PipelineNotifications{
+				GitHub: &manifest.GitHubNotifications{},
+			},
+			source: &GitHubSource{
+				RepositoryURL: "https://github.com/badgoose/chaOS",
+			},
+			wantedErr: errors.New(`"notifications.github.access_token_secret" must be specified`),
+		},
+		"uses the access token of a GitHubV1 source by default": {
+			mft: &manifest.PipelineNotifications{
+				GitHub: &manifest.GitHubNotifications{
+					Deployments: true,
+				},
+			},
+			source: &GitHubV1Source{
+				RepositoryURL:               "https://github.com/badgoose/chaOS",
+				PersonalAccessTokenSecretID: "personal-token",
+			},
+			wanted: &GitHubStatusReport{
+				Repository:        "badgoose/chaOS",
+				AccessTokenSecret: "personal-token",
+				Deployments:       true,
+			},
+		},
+		"success with a GitHub source": {
+			mft: &manifest.PipelineNotifications{
+				GitHub: &manifest.GitHubNotifications{
+					AccessTokenSecret: "arn:aws:secretsmanager:us-west-2:1111:secret:github-token-abcdef",
+				},
+			},
+			source: &GitHubSource{
+				RepositoryURL: "https://github.com/badgoose/chaOS",
+			},
+			wanted: &GitHubStatusReport{
+				Repository:        "badgoose/chaOS",
+				AccessTokenSecret: "arn:aws:secretsmanager:us-west-2:1111:secret:github-token-abcdef",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := NewGitHubStatusReport(tc.mft, tc.source)
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestNewPipelineTrigger(t *testing.T) {
+	testCases := map[string]struct {
+		mft manifest.PipelineTrigger
+
+		wanted *PipelineTrigger
+	}{
+		"nil if the pipeline is released on source changes": {},
+		"manual trigger": {
+			mft: manifest.PipelineTrigger{
+				Union: manifest.BasicToUnion[string, manifest.PipelineTriggerConfig](manifest.PipelineTriggerManual),
+			},
+			wanted: &PipelineTrigger{},
+		},
+		"scheduled trigger": {
+			mft: manifest.PipelineTrigger{
+				Union: manifest.AdvancedToUnion[string](manifest.PipelineTriggerConfig{
+					Schedule: "@weekly",
+				}),
+			},
+			wanted: &PipelineTrigger{
+				Schedule: "@weekly",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, NewPipelineTrigger(tc.mft))
+		})
+	}
+}
+
+func TestGitHubStatusReport_AccessTokenSecretARN(t *testing.T) {
+	require.Equal(t, "", (&GitHubStatusReport{AccessTokenSecret: "github-token"}).AccessTokenSecretARN())
+	require.Equal(t, "arn:aws:secretsmanager:us-west-2:1111:secret:github-token-abcdef",
+		(&GitHubStatusReport{AccessTokenSecret: "arn:aws:secretsmanager:us-west-2:1111:secret:github-token-abcdef"}).AccessTokenSecretARN())
+}
+
 func TestPipelineStage_Init(t *testing.T) {
 	var stg PipelineStage
 	stg.Init(&config.Environment{
@@ -509,6 +626,43 @@ func TestPipelineStage_PreDeployments(t *testing.T) {
 	}
 }
 
+func TestPipelineStage_PreDeploymentsTask(t *testing.T) {
+	var stg PipelineStage
+	stg.Init(&config.Environment{Name: "test"}, &manifest.PipelineStage{
+		Name: "test",
+		PreDeployments: map[string]*manifest.PrePostDeployment{
+			"migrate": {
+				RunTask: &manifest.PrePostDeployTask{
+					Image:   "migrate:v1",
+					Command: "./migrate up",
+					Variables: map[string]string{
+						"LOG_LEVEL": "debug",
+						"DRY_RUN":   "false",
+					},
+				},
+			},
+			"seed": {
+				BuildspecPath: "seed.yml",
+			},
+		},
+	}, nil)
+
+	actions, err := stg.PreDeployments()
+
+	require.NoError(t, err)
+	require.Len(t, actions, 2)
+	require.Equal(t, &PrePostDeployTask{
+		Image:   "migrate:v1",
+		Command: "./migrate up",
+		Variables: map[string]string{
+			"LOG_LEVEL": "debug",
+			"DRY_RUN":   "false",
+		},
+	}, actions[0].Task)
+	require.Equal(t, "DRY_RUN=false,LOG_LEVEL=debug", actions[0].Task.EnvVars())
+	require.Nil(t, actions[1].Task, "actions with a buildspec should not run a task")
+}
+
 func TestPipelineStage_Deployments(t *testing.T) {
 	testCases := map[string]struct {
 		stg *PipelineStage
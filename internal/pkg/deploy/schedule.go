@@ -0,0 +1,104 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// ScheduleNone is the schedule expression of jobs that are not invoked on a schedule.
+const ScheduleNone = "none"
+
+const scheduleIntervalSamples = 500 // Number of consecutive invocations inspected to find the longest interval of a schedule.
+
+var (
+	rateExpressionRegexp  = regexp.MustCompile(`^rate\(\s*(\d+)\s+(minutes?|hours?|days?)\s*\)$`)
+	cronExpressionRegexp  = regexp.MustCompile(`^cron\((.*)\)$`)
+	dayOfWeekNumberRegexp = regexp.MustCompile(`\d+`)
+
+	cronParser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+)
+
+// ParseSchedule parses an EventBridge schedule expression of the form "rate(value unit)" or "cron(fields)".
+func ParseSchedule(expression string) (cron.Schedule, error) {
+	if m := rateExpressionRegexp.FindStringSubmatch(expression); m != nil {
+		value, err := strconv.Atoi(m[1])
+		if err != nil || value < 1 {
+			return nil, fmt.Errorf("rate value in %q must be a positive integer", expression)
+		}
+		unit := time.Minute
+		switch strings.TrimSuffix(m[2], "s") {
+		case "hour":
+			unit = time.Hour
+		case "day":
+			unit = 24 * time.Hour
+		}
+		return cron.Every(time.Duration(value) * unit), nil
+	}
+	m := cronExpressionRegexp.FindStringSubmatch(expression)
+	if m == nil {
+		return nil, fmt.Errorf("schedule %q is not a rate or cron expression", expression)
+	}
+	fields := strings.Fields(m[1])
+	if len(fields) != 6 {
+		return nil, fmt.Errorf("cron expression %q must have 6 fields", expression)
+	}
+	if strings.ContainsAny(strings.Join(fields[:5], " "), "LW#") {
+		return nil, fmt.Errorf("cron expression %q uses the L, W or # wildcards which are not supported", expression)
+	}
+	fields[4] = zeroIndexedDaysOfWeek(fields[4])
+	sched, err := cronParser.Parse(strings.Join(fields[:5], " "))
+	if err != nil {
+		return nil, fmt.Errorf("parse cron expression %q: %w", expression, err)
+	}
+	return sched, nil
+}
+
+// zeroIndexedDaysOfWeek converts the day-of-week numbers of a cron field from EventBridge, which run 1-7,
+// to standard cron, which run 0-6. The step of a range, such as the 2 in "*/2", is left as is.
+func zeroIndexedDaysOfWeek(field string) string {
+	items := strings.Split(field, ",")
+	for i, item := range items {
+		days, step, hasStep := strings.Cut(item, "/")
+		days = dayOfWeekNumberRegexp.ReplaceAllStringFunc(days, func(n string) string {
+			day, _ := strconv.Atoi(n)
+			return strconv.Itoa(day - 1)
+		})
+		if hasStep {
+			days = days + "/" + step
+		}
+		items[i] = days
+	}
+	return strings.Join(items, ",")
+}
+
+// LongestScheduleInterval returns the longest time between two consecutive invocations of an EventBridge schedule expression.
+func LongestScheduleInterval(expression string) (time.Duration, error) {
+	sched, err := ParseSchedule(expression)
+	if err != nil {
+		return 0, err
+	}
+	if every, ok := sched.(cron.ConstantDelaySchedule); ok {
+		return every.Delay, nil
+	}
+	var longest time.Duration
+	prev := sched.Next(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC))
+	for i := 0; i < scheduleIntervalSamples; i++ {
+		next := sched.Next(prev)
+		if next.IsZero() {
+			break
+		}
+		if gap := next.Sub(prev); gap > longest {
+			longest = gap
+		}
+		prev = next
+	}
+	return longest, nil
+}
@@ -0,0 +1,107 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSchedule(t *testing.T) {
+	from := time.Date(2024, time.March, 1, 10, 30, 0, 0, time.UTC) // A Friday.
+	testCases := map[string]struct {
+		inExpression string
+
+		wantedNext  time.Time
+		wantedError string
+	}{
+		"rate in minutes": {
+			inExpression: "rate(5 minutes)",
+			wantedNext:   from.Add(5 * time.Minute),
+		},
+		"rate in days": {
+			inExpression: "rate(1 day)",
+			wantedNext:   from.Add(24 * time.Hour),
+		},
+		"cron with a question mark": {
+			inExpression: "cron(0 * * * ? *)",
+			wantedNext:   time.Date(2024, time.March, 1, 11, 0, 0, 0, time.UTC),
+		},
+		"cron with one-indexed days of the week": {
+			inExpression: "cron(0 9 ? * 2-6 *)", // Monday to Friday.
+			wantedNext:   time.Date(2024, time.March, 4, 9, 0, 0, 0, time.UTC),
+		},
+		"cron with a step over the days of the week": {
+			inExpression: "cron(0 9 ? * */2 *)", // Sunday, Tuesday, Thursday and Saturday.
+			wantedNext:   time.Date(2024, time.March, 2, 9, 0, 0, 0, time.UTC),
+		},
+		"cron with a step from a one-indexed day of the week": {
+			inExpression: "cron(0 9 ? * 2/2 *)", // Monday, Wednesday and Friday.
+			wantedNext:   time.Date(2024, time.March, 4, 9, 0, 0, 0, time.UTC),
+		},
+		"cron with a list of one-indexed days of the week": {
+			inExpression: "cron(0 9 ? * 1,7 *)", // Sunday and Saturday.
+			wantedNext:   time.Date(2024, time.March, 2, 9, 0, 0, 0, time.UTC),
+		},
+		"cron with named days of the week": {
+			inExpression: "cron(0 9 ? * MON-FRI *)",
+			wantedNext:   time.Date(2024, time.March, 4, 9, 0, 0, 0, time.UTC),
+		},
+		"error on unsupported wildcards": {
+			inExpression: "cron(0 9 L * ? *)",
+			wantedError:  `cron expression "cron(0 9 L * ? *)" uses the L, W or # wildcards which are not supported`,
+		},
+		"error on missing fields": {
+			inExpression: "cron(0 9 * * ?)",
+			wantedError:  `cron expression "cron(0 9 * * ?)" must have 6 fields`,
+		},
+		"error on disabled schedule": {
+			inExpression: "none",
+			wantedError:  `schedule "none" is not a rate or cron expression`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			sched, err := ParseSchedule(tc.inExpression)
+
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedNext, sched.Next(from).UTC())
+		})
+	}
+}
+
+func TestLongestScheduleInterval(t *testing.T) {
+	testCases := map[string]struct {
+		inExpression string
+
+		wanted time.Duration
+	}{
+		"rate": {
+			inExpression: "rate(90 minutes)",
+			wanted:       90 * time.Minute,
+		},
+		"regular cron": {
+			inExpression: "cron(0 0 * * ? *)",
+			wanted:       24 * time.Hour,
+		},
+		"cron that skips the weekend": {
+			inExpression: "cron(0 9 ? * MON-FRI *)",
+			wanted:       72 * time.Hour,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := LongestScheduleInterval(tc.inExpression)
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
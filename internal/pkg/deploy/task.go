@@ -21,6 +21,7 @@ type CreateTaskResourcesInput struct {
 
 	Image                 string
 	PermissionsBoundary   string
+	RoleNamePrefix        string // Optional. Prefix of the names of the IAM roles created for the task.
 	TaskRole              string
 	ExecutionRole         string
 	Command               []string
@@ -30,15 +31,27 @@ type CreateTaskResourcesInput struct {
 	SSMParamSecrets       map[string]string
 	SecretsManagerSecrets map[string]string
 
+	EFSVolumes []TaskEFSVolume
+	EFSVPCID   string // VPC of the mount targets of the file systems.
+
 	OS   string
 	Arch string
 
+	EC2Compatible bool // Whether the task definition can also run on EC2 container instances.
+
 	App string
 	Env string
 
 	AdditionalTags map[string]string
 }
 
+// TaskEFSVolume is an EFS file system mounted into the container of a task.
+type TaskEFSVolume struct {
+	Name          string
+	FileSystemID  string
+	ContainerPath string
+}
+
 // TaskStackInfo contains essential information about a Copilot task stack
 type TaskStackInfo struct {
 	StackName string
@@ -47,7 +60,8 @@ type TaskStackInfo struct {
 
 	RoleARN string
 
-	BucketName string
+	BucketName       string
+	EFSSecurityGroup string // Security group allowed to access the mount targets of the EFS file systems, if any.
 }
 
 // TaskName returns the name of the one-off task. This is the same as the value of the
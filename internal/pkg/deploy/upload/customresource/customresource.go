@@ -31,6 +31,8 @@ const (
 	rulePriorityFnName        = "RulePriorityFunction"
 	nlbCustomDomainFnName     = "NLBCustomDomainFunction"
 	nlbCertValidatorFnName    = "NLBCertValidatorFunction"
+	cdnCustomDomainFnName     = "CDNCustomDomainFunction"
+	cdnCertValidatorFnName    = "CDNCertValidatorFunction"
 	customDomainFnName        = "CustomDomainFunction"
 	certValidationFnName      = "CertificateValidationFunction"
 	dnsDelegationFnName       = "DNSDelegationFunction"
@@ -38,6 +40,7 @@ const (
 	certReplicatorFnName      = "CertificateReplicatorFunction"
 	uniqueJsonValuesFnName    = "UniqueJSONValuesFunction"
 	triggerStateMachineFnName = "TriggerStateMachineFunction"
+	githubStatusFnName        = "GitHubStatusFunction"
 )
 
 // Function source file locations.
@@ -56,6 +59,7 @@ var (
 	wkldCustomDomainFilePath         = path.Join(customResourcesDir, "wkld-custom-domain.js")
 	uniqueJSONValuesFilePath         = path.Join(customResourcesDir, "unique-json-values.js")
 	triggerStateMachineFilePath      = path.Join(customResourcesDir, "trigger-state-machine.js")
+	githubStatusFilePath             = path.Join(customResourcesDir, "github-status.js")
 )
 
 // CustomResource represents a CloudFormation custom resource backed by a Lambda function.
@@ -123,6 +127,8 @@ func LBWS(fs template.Reader) ([]*CustomResource, error) {
 		rulePriorityFnName:        albRulePriorityGeneratorFilePath,
 		nlbCustomDomainFnName:     wkldCustomDomainFilePath,
 		nlbCertValidatorFnName:    wkldCertValidatorFilePath,
+		cdnCustomDomainFnName:     wkldCustomDomainFilePath,
+		cdnCertValidatorFnName:    wkldCertValidatorFilePath,
 	})
 }
 
@@ -172,6 +178,13 @@ func Env(fs template.Reader) ([]*CustomResource, error) {
 	})
 }
 
+// Pipeline returns the custom resources for a pipeline.
+func Pipeline(fs template.Reader) ([]*CustomResource, error) {
+	return buildCustomResources(fs, map[string]string{
+		githubStatusFnName: githubStatusFilePath,
+	})
+}
+
 // UploadFunc is the function signature to upload contents under a key within a S3 bucket.
 type UploadFunc func(key string, contents io.Reader) (url string, err error)
 
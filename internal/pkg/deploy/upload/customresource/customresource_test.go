@@ -107,6 +107,8 @@ func TestLBWS(t *testing.T) {
 		"RulePriorityFunction":        "manual/scripts/custom-resources/rulepriorityfunction/1385d258950a50faf4b5cd7deeecbc4bcc79a0d41d631e3977cffa0332e6f0c6.zip",
 		"NLBCustomDomainFunction":     "manual/scripts/custom-resources/nlbcustomdomainfunction/ac1c96e7f0823f3167b4e74c8b286ffe8f9d43279dc232d9478837327e57905e.zip",
 		"NLBCertValidatorFunction":    "manual/scripts/custom-resources/nlbcertvalidatorfunction/41aeafc64f18f82c452432a214ae83d8c8de4aba2d5df6a752b7e9a2c86833f1.zip",
+		"CDNCustomDomainFunction":     "manual/scripts/custom-resources/cdncustomdomainfunction/ac1c96e7f0823f3167b4e74c8b286ffe8f9d43279dc232d9478837327e57905e.zip",
+		"CDNCertValidatorFunction":    "manual/scripts/custom-resources/cdncertvalidatorfunction/41aeafc64f18f82c452432a214ae83d8c8de4aba2d5df6a752b7e9a2c86833f1.zip",
 	}
 
 	// WHEN
@@ -114,14 +116,15 @@ func TestLBWS(t *testing.T) {
 
 	// THEN
 	require.NoError(t, err)
-	require.Equal(t, fakeFS.matchCount, 5, "expected path calls do not match")
+	require.Equal(t, fakeFS.matchCount, 7, "expected path calls do not match")
 
 	actualFnNames := make([]string, len(crs))
 	for i, cr := range crs {
 		actualFnNames[i] = cr.Name()
 	}
 	require.ElementsMatch(t,
-		[]string{"DynamicDesiredCountFunction", "EnvControllerFunction", "RulePriorityFunction", "NLBCustomDomainFunction", "NLBCertValidatorFunction"},
+		[]string{"DynamicDesiredCountFunction", "EnvControllerFunction", "RulePriorityFunction", "NLBCustomDomainFunction", "NLBCertValidatorFunction",
+			"CDNCustomDomainFunction", "CDNCertValidatorFunction"},
 		actualFnNames, "function names must match")
 
 	// ensure the zip files contain an index.js file.
@@ -416,6 +419,50 @@ func TestEnv(t *testing.T) {
 	}
 }
 
+func TestPipeline(t *testing.T) {
+	// GIVEN
+	fakeFS := &fakeTemplateReader{
+		files: map[string]*template.Content{
+			"custom-resources/github-status.js": {
+				Buffer: bytes.NewBufferString("github status"),
+			},
+		},
+	}
+	fakePaths := map[string]string{
+		"GitHubStatusFunction": "manual/scripts/custom-resources/githubstatusfunction/8131e4e6b9e581a1a69c9470acefa6a19dc5e09e02f9924e58a4fdb8b661154a.zip",
+	}
+
+	// WHEN
+	crs, err := Pipeline(fakeFS)
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, fakeFS.matchCount, 1, "expected path calls do not match")
+
+	actualFnNames := make([]string, len(crs))
+	for i, cr := range crs {
+		actualFnNames[i] = cr.Name()
+	}
+	require.ElementsMatch(t, []string{"GitHubStatusFunction"}, actualFnNames, "function names must match")
+
+	// ensure the zip files contain an index.js file.
+	for _, cr := range crs {
+		buf := new(bytes.Buffer)
+		size, err := buf.ReadFrom(cr.zipReader())
+		require.NoError(t, err)
+		r, err := zip.NewReader(bytes.NewReader(buf.Bytes()), size)
+		require.NoError(t, err)
+
+		_, err = r.Open("index.js")
+		require.NoError(t, err, "an index.js file must be present in all custom resources")
+	}
+
+	// ensure artifact paths match.
+	for _, cr := range crs {
+		require.Equal(t, fakePaths[cr.Name()], cr.ArtifactPath())
+	}
+}
+
 type fakeS3 struct {
 	objects map[string]string
 	err     error
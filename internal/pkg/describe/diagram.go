@@ -0,0 +1,224 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
+)
+
+// CloudFormation resource types drawn in service diagrams.
+const (
+	cfnLoadBalancerType = "AWS::ElasticLoadBalancingV2::LoadBalancer"
+	cfnListenerType     = "AWS::ElasticLoadBalancingV2::Listener"
+	cfnListenerRuleType = "AWS::ElasticLoadBalancingV2::ListenerRule"
+	cfnTargetGroupType  = "AWS::ElasticLoadBalancingV2::TargetGroup"
+	cfnECSServiceType   = "AWS::ECS::Service"
+	cfnAppRunnerType    = "AWS::AppRunner::Service"
+	cfnQueueType        = "AWS::SQS::Queue"
+	cfnTopicType        = "AWS::SNS::Topic"
+	cfnNestedStackType  = "AWS::CloudFormation::Stack"
+	cfnDistributionType = "AWS::CloudFront::Distribution"
+	cfnBucketType       = "AWS::S3::Bucket"
+)
+
+var diagramNodeKinds = map[string]string{
+	cfnLoadBalancerType: "load balancer",
+	cfnListenerType:     "listener",
+	cfnListenerRuleType: "listener rule",
+	cfnTargetGroupType:  "target group",
+	cfnECSServiceType:   "ECS service",
+	cfnAppRunnerType:    "App Runner service",
+	cfnQueueType:        "SQS queue",
+	cfnTopicType:        "SNS topic",
+	cfnNestedStackType:  "addons",
+	cfnDistributionType: "CloudFront distribution",
+	cfnBucketType:       "S3 bucket",
+}
+
+// Diagrammer is the interface for service descriptions that can render their deployed infrastructure as a diagram.
+type Diagrammer interface {
+	Mermaid() string
+}
+
+// Mermaid returns a Mermaid flowchart of the infrastructure of the service in each environment.
+func (w *ecsSvcDesc) Mermaid() string {
+	return mermaidDiagram(w.environments, w.Resources, w.Variables.containersByEnv(w.Service))
+}
+
+// Mermaid returns a Mermaid flowchart of the infrastructure of the service in each environment.
+func (w *workerSvcDesc) Mermaid() string {
+	return mermaidDiagram(w.environments, w.Resources, w.Variables.containersByEnv(w.Service))
+}
+
+// Mermaid returns a Mermaid flowchart of the infrastructure of the service in each environment.
+func (w *rdWebSvcDesc) Mermaid() string {
+	return mermaidDiagram(w.environments, w.Resources, nil)
+}
+
+// Mermaid returns a Mermaid flowchart of the infrastructure of the service in each environment.
+func (w *staticSiteDesc) Mermaid() string {
+	return mermaidDiagram(w.environments, w.Resources, nil)
+}
+
+// containersByEnv returns the names of the containers of the service in each environment, with the main container first.
+func (c containerEnvVars) containersByEnv(mainContainer string) map[string][]string {
+	seen := make(map[string]map[string]struct{})
+	for _, v := range c {
+		if seen[v.Environment] == nil {
+			seen[v.Environment] = make(map[string]struct{})
+		}
+		seen[v.Environment][v.Container] = struct{}{}
+	}
+	out := make(map[string][]string, len(seen))
+	for env, containers := range seen {
+		var names []string
+		for name := range containers {
+			names = append(names, name)
+		}
+		sort.Slice(names, func(i, j int) bool {
+			if names[i] == mainContainer || names[j] == mainContainer {
+				return names[i] == mainContainer
+			}
+			return names[i] < names[j]
+		})
+		out[env] = names
+	}
+	return out
+}
+
+// mermaidDiagram draws the resources of each environment as a subgraph, linking the resources in the order a request flows through them.
+func mermaidDiagram(envs []string, resources deployedSvcResources, containers map[string][]string) string {
+	var b strings.Builder
+	b.WriteString("flowchart LR\n")
+	for _, env := range envs {
+		envID := "env_" + mermaidID(env)
+		byType := make(map[string][]*stack.Resource)
+		for _, r := range resources[env] {
+			if _, ok := diagramNodeKinds[r.Type]; ok {
+				byType[r.Type] = append(byType[r.Type], r)
+			}
+		}
+		fmt.Fprintf(&b, "  subgraph %s[\"Environment: %s\"]\n", envID, env)
+		for _, typ := range []string{cfnLoadBalancerType, cfnListenerType, cfnListenerRuleType, cfnTargetGroupType, cfnQueueType, cfnTopicType, cfnNestedStackType, cfnDistributionType, cfnBucketType, cfnAppRunnerType} {
+			for _, r := range byType[typ] {
+				fmt.Fprintf(&b, "    %s[\"%s (%s)\"]\n", nodeID(envID, r), r.LogicalID, diagramNodeKinds[typ])
+			}
+		}
+		for _, r := range byType[cfnECSServiceType] {
+			fmt.Fprintf(&b, "    subgraph %s[\"%s (%s)\"]\n", nodeID(envID, r), r.LogicalID, diagramNodeKinds[cfnECSServiceType])
+			for i, name := range containers[env] {
+				kind := "sidecar"
+				if i == 0 {
+					kind = "main container"
+				}
+				fmt.Fprintf(&b, "      %s_container_%s[\"%s (%s)\"]\n", envID, mermaidID(name), name, kind)
+			}
+			b.WriteString("    end\n")
+		}
+		if len(byType[cfnListenerRuleType]) > 0 && len(byType[cfnListenerType]) == 0 {
+			// The rules are attached to the listeners of the environment's load balancer.
+			fmt.Fprintf(&b, "    %s_EnvironmentLoadBalancer[\"Environment load balancer\"]\n", envID)
+			for _, rule := range byType[cfnListenerRuleType] {
+				fmt.Fprintf(&b, "    %s_EnvironmentLoadBalancer --> %s\n", envID, nodeID(envID, rule))
+			}
+		}
+		// A service stack has at most one load balancer, ECS service, App Runner service and distribution,
+		// so every resource is linked to the one it belongs to.
+		link(&b, envID, byType[cfnLoadBalancerType], byType[cfnListenerType], always)
+		link(&b, envID, byType[cfnListenerType], byType[cfnTargetGroupType], listenerForwardsTo)
+		link(&b, envID, byType[cfnListenerRuleType], byType[cfnTargetGroupType], ruleForwardsTo)
+		link(&b, envID, byType[cfnTargetGroupType], byType[cfnECSServiceType], always)
+		link(&b, envID, byType[cfnQueueType], byType[cfnQueueType], redrivesTo)
+		link(&b, envID, byType[cfnQueueType], byType[cfnECSServiceType], isConsumedQueue)
+		link(&b, envID, byType[cfnECSServiceType], byType[cfnTopicType], always)
+		link(&b, envID, byType[cfnECSServiceType], byType[cfnNestedStackType], always)
+		link(&b, envID, byType[cfnAppRunnerType], byType[cfnNestedStackType], always)
+		link(&b, envID, byType[cfnDistributionType], byType[cfnBucketType], always)
+		b.WriteString("  end\n")
+	}
+	return b.String()
+}
+
+// link draws an edge from each resource in from to each resource in to that it sends traffic or messages to.
+func link(b *strings.Builder, envID string, from, to []*stack.Resource, sendsTo func(src, dst *stack.Resource) bool) {
+	for _, src := range from {
+		for _, dst := range to {
+			if sendsTo(src, dst) {
+				fmt.Fprintf(b, "    %s --> %s\n", nodeID(envID, src), nodeID(envID, dst))
+			}
+		}
+	}
+}
+
+func always(_, _ *stack.Resource) bool {
+	return true
+}
+
+// listenerForwardsTo returns true if the NLB listener forwards to the target group.
+// The listener and target group of the i-th port of a Network Load Balancer share the same index suffix.
+func listenerForwardsTo(listener, tg *stack.Resource) bool {
+	return isNLBTargetGroup(tg) && logicalIDIndex(listener) == logicalIDIndex(tg)
+}
+
+// ruleForwardsTo returns true if the listener rule forwards to the target group.
+// The listener rules and target group of the i-th routing rule share the same index suffix,
+// and the resources for an imported ALB are suffixed with "ForImportedALB".
+func ruleForwardsTo(rule, tg *stack.Resource) bool {
+	if strings.Contains(rule.LogicalID, "Redirect") || isNLBTargetGroup(tg) {
+		return false
+	}
+	return logicalIDIndex(rule) == logicalIDIndex(tg) &&
+		strings.HasSuffix(trimIndex(rule), "ForImportedALB") == strings.HasSuffix(trimIndex(tg), "ForImportedALB")
+}
+
+// redrivesTo returns true if the messages that fail to be processed from queue are moved to the dead-letter queue dlq.
+// The queue of a topic subscription and its dead-letter queue share the prefix of the topic, for example
+// "apiordersEventsQueue" and "apiordersDeadLetterQueue".
+func redrivesTo(queue, dlq *stack.Resource) bool {
+	if !isDeadLetterQueue(dlq) || isDeadLetterQueue(queue) {
+		return false
+	}
+	return strings.TrimSuffix(queue.LogicalID, "EventsQueue") == strings.TrimSuffix(dlq.LogicalID, "DeadLetterQueue")
+}
+
+// isConsumedQueue returns true if the service polls the queue, which is the case of all the queues but the dead-letter queues.
+func isConsumedQueue(queue, _ *stack.Resource) bool {
+	return !isDeadLetterQueue(queue)
+}
+
+func isDeadLetterQueue(queue *stack.Resource) bool {
+	return strings.HasSuffix(queue.LogicalID, "DeadLetterQueue")
+}
+
+func isNLBTargetGroup(tg *stack.Resource) bool {
+	return strings.HasPrefix(tg.LogicalID, "NetworkLoadBalancerTargetGroup")
+}
+
+// logicalIDIndex returns the numeric suffix of the logical ID of a resource, for example "1" for "TargetGroup1".
+func logicalIDIndex(r *stack.Resource) string {
+	return strings.TrimPrefix(r.LogicalID, trimIndex(r))
+}
+
+func trimIndex(r *stack.Resource) string {
+	return strings.TrimRightFunc(r.LogicalID, unicode.IsDigit)
+}
+
+func nodeID(envID string, r *stack.Resource) string {
+	return fmt.Sprintf("%s_%s", envID, mermaidID(r.LogicalID))
+}
+
+// mermaidID returns an identifier that is safe to use as a Mermaid node ID.
+func mermaidID(name string) string {
+	return strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return '_'
+	}, name)
+}
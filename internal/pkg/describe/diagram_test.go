@@ -0,0 +1,140 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEcsSvcDesc_Mermaid(t *testing.T) {
+	desc := &ecsSvcDesc{
+		Service: "api",
+		Variables: containerEnvVars{
+			{envVar: &envVar{Environment: "test", Name: "COPILOT_SERVICE_NAME", Value: "api"}, Container: "nginx"},
+			{envVar: &envVar{Environment: "test", Name: "COPILOT_SERVICE_NAME", Value: "api"}, Container: "api"},
+			{envVar: &envVar{Environment: "test", Name: "LOG_LEVEL", Value: "debug"}, Container: "api"},
+		},
+		Resources: map[string][]*stack.Resource{
+			"test": {
+				{Type: "AWS::ECS::Service", LogicalID: "Service"},
+				{Type: "AWS::ElasticLoadBalancingV2::ListenerRule", LogicalID: "HTTPListenerRuleWithDomain"},
+				{Type: "AWS::ElasticLoadBalancingV2::TargetGroup", LogicalID: "TargetGroup"},
+				{Type: "AWS::SNS::Topic", LogicalID: "ordersSNSTopic"},
+				{Type: "AWS::CloudFormation::Stack", LogicalID: "AddonsStack"},
+				{Type: "AWS::IAM::Role", LogicalID: "ExecutionRole"},
+			},
+		},
+		environments: []string{"test"},
+	}
+
+	require.Equal(t, `flowchart LR
+  subgraph env_test["Environment: test"]
+    env_test_HTTPListenerRuleWithDomain["HTTPListenerRuleWithDomain (listener rule)"]
+    env_test_TargetGroup["TargetGroup (target group)"]
+    env_test_ordersSNSTopic["ordersSNSTopic (SNS topic)"]
+    env_test_AddonsStack["AddonsStack (addons)"]
+    subgraph env_test_Service["Service (ECS service)"]
+      env_test_container_api["api (main container)"]
+      env_test_container_nginx["nginx (sidecar)"]
+    end
+    env_test_EnvironmentLoadBalancer["Environment load balancer"]
+    env_test_EnvironmentLoadBalancer --> env_test_HTTPListenerRuleWithDomain
+    env_test_HTTPListenerRuleWithDomain --> env_test_TargetGroup
+    env_test_TargetGroup --> env_test_Service
+    env_test_Service --> env_test_ordersSNSTopic
+    env_test_Service --> env_test_AddonsStack
+  end
+`, desc.Mermaid())
+}
+
+func TestEcsSvcDesc_Mermaid_LinksOnlyForwardingPairs(t *testing.T) {
+	desc := &ecsSvcDesc{
+		Service: "api",
+		Variables: containerEnvVars{
+			{envVar: &envVar{Environment: "test", Name: "COPILOT_SERVICE_NAME", Value: "api"}, Container: "api"},
+		},
+		Resources: map[string][]*stack.Resource{
+			"test": {
+				{Type: "AWS::ECS::Service", LogicalID: "Service"},
+				{Type: "AWS::ElasticLoadBalancingV2::ListenerRule", LogicalID: "HTTPListenerRedirectRuleForImportedALB"},
+				{Type: "AWS::ElasticLoadBalancingV2::ListenerRule", LogicalID: "HTTPSListenerRuleForImportedALB"},
+				{Type: "AWS::ElasticLoadBalancingV2::ListenerRule", LogicalID: "HTTPSListenerRuleForImportedALB1"},
+				{Type: "AWS::ElasticLoadBalancingV2::TargetGroup", LogicalID: "TargetGroupForImportedALB"},
+				{Type: "AWS::ElasticLoadBalancingV2::TargetGroup", LogicalID: "TargetGroupForImportedALB1"},
+				{Type: "AWS::ElasticLoadBalancingV2::LoadBalancer", LogicalID: "PublicNetworkLoadBalancerV2"},
+				{Type: "AWS::ElasticLoadBalancingV2::Listener", LogicalID: "NLBListener"},
+				{Type: "AWS::ElasticLoadBalancingV2::Listener", LogicalID: "NLBListener1"},
+				{Type: "AWS::ElasticLoadBalancingV2::TargetGroup", LogicalID: "NetworkLoadBalancerTargetGroup"},
+				{Type: "AWS::ElasticLoadBalancingV2::TargetGroup", LogicalID: "NetworkLoadBalancerTargetGroup1"},
+			},
+		},
+		environments: []string{"test"},
+	}
+
+	require.Equal(t, `flowchart LR
+  subgraph env_test["Environment: test"]
+    env_test_PublicNetworkLoadBalancerV2["PublicNetworkLoadBalancerV2 (load balancer)"]
+    env_test_NLBListener["NLBListener (listener)"]
+    env_test_NLBListener1["NLBListener1 (listener)"]
+    env_test_HTTPListenerRedirectRuleForImportedALB["HTTPListenerRedirectRuleForImportedALB (listener rule)"]
+    env_test_HTTPSListenerRuleForImportedALB["HTTPSListenerRuleForImportedALB (listener rule)"]
+    env_test_HTTPSListenerRuleForImportedALB1["HTTPSListenerRuleForImportedALB1 (listener rule)"]
+    env_test_TargetGroupForImportedALB["TargetGroupForImportedALB (target group)"]
+    env_test_TargetGroupForImportedALB1["TargetGroupForImportedALB1 (target group)"]
+    env_test_NetworkLoadBalancerTargetGroup["NetworkLoadBalancerTargetGroup (target group)"]
+    env_test_NetworkLoadBalancerTargetGroup1["NetworkLoadBalancerTargetGroup1 (target group)"]
+    subgraph env_test_Service["Service (ECS service)"]
+      env_test_container_api["api (main container)"]
+    end
+    env_test_PublicNetworkLoadBalancerV2 --> env_test_NLBListener
+    env_test_PublicNetworkLoadBalancerV2 --> env_test_NLBListener1
+    env_test_NLBListener --> env_test_NetworkLoadBalancerTargetGroup
+    env_test_NLBListener1 --> env_test_NetworkLoadBalancerTargetGroup1
+    env_test_HTTPSListenerRuleForImportedALB --> env_test_TargetGroupForImportedALB
+    env_test_HTTPSListenerRuleForImportedALB1 --> env_test_TargetGroupForImportedALB1
+    env_test_TargetGroupForImportedALB --> env_test_Service
+    env_test_TargetGroupForImportedALB1 --> env_test_Service
+    env_test_NetworkLoadBalancerTargetGroup --> env_test_Service
+    env_test_NetworkLoadBalancerTargetGroup1 --> env_test_Service
+  end
+`, desc.Mermaid())
+}
+
+func TestWorkerSvcDesc_Mermaid(t *testing.T) {
+	desc := &workerSvcDesc{
+		Service: "worker",
+		Variables: containerEnvVars{
+			{envVar: &envVar{Environment: "prod", Name: "COPILOT_SERVICE_NAME", Value: "worker"}, Container: "worker"},
+		},
+		Resources: map[string][]*stack.Resource{
+			"prod": {
+				{Type: "AWS::SQS::Queue", LogicalID: "EventsQueue"},
+				{Type: "AWS::SQS::Queue", LogicalID: "DeadLetterQueue"},
+				{Type: "AWS::SQS::Queue", LogicalID: "apiordersEventsQueue"},
+				{Type: "AWS::SQS::Queue", LogicalID: "apiordersDeadLetterQueue"},
+				{Type: "AWS::ECS::Service", LogicalID: "Service"},
+			},
+		},
+		environments: []string{"prod"},
+	}
+
+	require.Equal(t, `flowchart LR
+  subgraph env_prod["Environment: prod"]
+    env_prod_EventsQueue["EventsQueue (SQS queue)"]
+    env_prod_DeadLetterQueue["DeadLetterQueue (SQS queue)"]
+    env_prod_apiordersEventsQueue["apiordersEventsQueue (SQS queue)"]
+    env_prod_apiordersDeadLetterQueue["apiordersDeadLetterQueue (SQS queue)"]
+    subgraph env_prod_Service["Service (ECS service)"]
+      env_prod_container_worker["worker (main container)"]
+    end
+    env_prod_EventsQueue --> env_prod_DeadLetterQueue
+    env_prod_apiordersEventsQueue --> env_prod_apiordersDeadLetterQueue
+    env_prod_EventsQueue --> env_prod_Service
+    env_prod_apiordersEventsQueue --> env_prod_Service
+  end
+`, desc.Mermaid())
+}
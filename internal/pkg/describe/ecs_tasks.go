@@ -0,0 +1,260 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+// ECSTasks contains the running and recently stopped tasks of a service.
+type ECSTasks struct {
+	Service string     `json:"service"`
+	Running []*ECSTask `json:"running"`
+	Stopped []*ECSTask `json:"stopped"`
+}
+
+// ECSTask contains the low-level information of an Amazon ECS task.
+type ECSTask struct {
+	ID               string              `json:"id"`
+	TaskDefinition   string              `json:"taskDefinitionARN"`
+	Revision         string              `json:"revision"`
+	LastStatus       string              `json:"lastStatus"`
+	Health           string              `json:"health"`
+	PrivateIP        string              `json:"privateIP,omitempty"`
+	AvailabilityZone string              `json:"availabilityZone,omitempty"`
+	CapacityProvider string              `json:"capacityProvider,omitempty"`
+	StartedAt        time.Time           `json:"startedAt"`
+	StoppedAt        time.Time           `json:"stoppedAt"`
+	StoppedReason    string              `json:"stoppedReason,omitempty"`
+	Containers       []*ECSTaskContainer `json:"containers"`
+}
+
+// ECSTaskContainer contains the status of a container in an Amazon ECS task.
+type ECSTaskContainer struct {
+	Name         string `json:"name"`
+	Image        string `json:"image"`
+	LastStatus   string `json:"lastStatus"`
+	HealthStatus string `json:"healthStatus"`
+	ExitCode     *int64 `json:"exitCode,omitempty"` // Nil if the container hasn't exited.
+	Reason       string `json:"reason,omitempty"`
+}
+
+// ECSTasksDescriber retrieves the Amazon ECS tasks of a service.
+type ECSTasksDescriber struct {
+	app string
+	env string
+	svc string
+
+	svcDescriber serviceDescriber
+}
+
+// NewECSTasksDescriber instantiates a new ECSTasksDescriber struct.
+func NewECSTasksDescriber(opt *NewServiceStatusConfig) (*ECSTasksDescriber, error) {
+	env, err := opt.ConfigStore.GetEnvironment(opt.App, opt.Env)
+	if err != nil {
+		return nil, fmt.Errorf("get environment %s: %w", opt.Env, err)
+	}
+	sess, err := sessions.ImmutableProvider().FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return nil, fmt.Errorf("session for role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
+	}
+	return &ECSTasksDescriber{
+		app:          opt.App,
+		env:          opt.Env,
+		svc:          opt.Svc,
+		svcDescriber: ecs.New(sess),
+	}, nil
+}
+
+// Tasks returns the running and recently stopped tasks of the service.
+func (d *ECSTasksDescriber) Tasks() (*ECSTasks, error) {
+	svcDesc, err := d.svcDescriber.DescribeService(d.app, d.env, d.svc)
+	if err != nil {
+		return nil, fmt.Errorf("get tasks of service %s: %w", d.svc, err)
+	}
+	out := &ECSTasks{
+		Service: d.svc,
+	}
+	if out.Running, err = newECSTasks(svcDesc.Tasks); err != nil {
+		return nil, err
+	}
+	if out.Stopped, err = newECSTasks(svcDesc.StoppedTasks); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Task returns the running or recently stopped task of the service whose ID starts with id.
+func (d *ECSTasksDescriber) Task(id string) (*ECSTask, error) {
+	tasks, err := d.Tasks()
+	if err != nil {
+		return nil, err
+	}
+	var matches []*ECSTask
+	for _, task := range append(tasks.Running, tasks.Stopped...) {
+		if strings.HasPrefix(task.ID, id) {
+			matches = append(matches, task)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("task %s not found in the running or recently stopped tasks of service %s", id, d.svc)
+	case 1:
+		return matches[0], nil
+	default:
+		ids := make([]string, len(matches))
+		for i, match := range matches {
+			ids[i] = match.ID
+		}
+		return nil, fmt.Errorf("task ID %s is ambiguous, it matches tasks %s", id, strings.Join(ids, ", "))
+	}
+}
+
+func newECSTasks(tasks []*awsecs.Task) ([]*ECSTask, error) {
+	out := make([]*ECSTask, 0, len(tasks))
+	for _, task := range tasks {
+		status, err := task.TaskStatus()
+		if err != nil {
+			return nil, fmt.Errorf("get status of task %s: %w", aws.StringValue(task.TaskArn), err)
+		}
+		revision, _ := awsecs.TaskDefinitionVersion(status.TaskDefinition)
+		// Tasks using the bridge or host network modes don't have an ENI.
+		privateIP, _ := task.PrivateIP()
+		ecsTask := &ECSTask{
+			ID:               status.ID,
+			TaskDefinition:   status.TaskDefinition,
+			LastStatus:       status.LastStatus,
+			Health:           status.Health,
+			PrivateIP:        privateIP,
+			AvailabilityZone: aws.StringValue(task.AvailabilityZone),
+			CapacityProvider: status.CapacityProvider,
+			StartedAt:        status.StartedAt,
+			StoppedAt:        status.StoppedAt,
+			StoppedReason:    status.StoppedReason,
+			Containers:       make([]*ECSTaskContainer, 0, len(task.Containers)),
+		}
+		if revision != 0 {
+			ecsTask.Revision = fmt.Sprintf("%d", revision)
+		}
+		for _, container := range task.Containers {
+			ecsTask.Containers = append(ecsTask.Containers, &ECSTaskContainer{
+				Name:         aws.StringValue(container.Name),
+				Image:        aws.StringValue(container.Image),
+				LastStatus:   aws.StringValue(container.LastStatus),
+				HealthStatus: aws.StringValue(container.HealthStatus),
+				ExitCode:     container.ExitCode,
+				Reason:       aws.StringValue(container.Reason),
+			})
+		}
+		out = append(out, ecsTask)
+	}
+	return out, nil
+}
+
+// JSONString returns the stringified ECSTasks struct with json format.
+func (t *ECSTasks) JSONString() (string, error) {
+	b, err := json.Marshal(t)
+	if err != nil {
+		return "", fmt.Errorf("marshal tasks: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified ECSTasks struct with human readable format.
+func (t *ECSTasks) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprint(writer, color.Bold.Sprint("Running Tasks\n\n"))
+	writer.Flush()
+	headers := []string{"ID", "Revision", "Status", "Health", "Private IP", "Capacity Provider", "Started At"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, task := range t.Running {
+		fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\t%s\t%s\t%s\n", awsecs.ShortTaskID(task.ID), dashIfEmpty(task.Revision),
+			task.LastStatus, dashIfEmpty(task.Health), dashIfEmpty(task.PrivateIP), dashIfEmpty(task.CapacityProvider),
+			humanizeTimeOrDash(task.StartedAt))
+	}
+	writer.Flush()
+	fmt.Fprint(writer, color.Bold.Sprint("\nStopped Tasks\n\n"))
+	writer.Flush()
+	headers = []string{"ID", "Revision", "Stopped At", "Reason"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, task := range t.Stopped {
+		fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\n", awsecs.ShortTaskID(task.ID), dashIfEmpty(task.Revision),
+			humanizeTimeOrDash(task.StoppedAt), dashIfEmpty(task.StoppedReason))
+	}
+	writer.Flush()
+	return b.String()
+}
+
+// JSONString returns the stringified ECSTask struct with json format.
+func (t *ECSTask) JSONString() (string, error) {
+	b, err := json.Marshal(t)
+	if err != nil {
+		return "", fmt.Errorf("marshal task: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified ECSTask struct with human readable format.
+func (t *ECSTask) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprint(writer, color.Bold.Sprint("Task\n\n"))
+	writer.Flush()
+	fmt.Fprintf(writer, "  %s\t%s\n", "ID", t.ID)
+	fmt.Fprintf(writer, "  %s\t%s\n", "Task Definition", t.TaskDefinition)
+	fmt.Fprintf(writer, "  %s\t%s\n", "Status", t.LastStatus)
+	fmt.Fprintf(writer, "  %s\t%s\n", "Health", dashIfEmpty(t.Health))
+	fmt.Fprintf(writer, "  %s\t%s\n", "Private IP", dashIfEmpty(t.PrivateIP))
+	fmt.Fprintf(writer, "  %s\t%s\n", "Availability Zone", dashIfEmpty(t.AvailabilityZone))
+	fmt.Fprintf(writer, "  %s\t%s\n", "Capacity Provider", dashIfEmpty(t.CapacityProvider))
+	fmt.Fprintf(writer, "  %s\t%s\n", "Started At", humanizeTimeOrDash(t.StartedAt))
+	if !t.StoppedAt.IsZero() {
+		fmt.Fprintf(writer, "  %s\t%s\n", "Stopped At", humanizeTimeOrDash(t.StoppedAt))
+		fmt.Fprintf(writer, "  %s\t%s\n", "Stopped Reason", dashIfEmpty(t.StoppedReason))
+	}
+	writer.Flush()
+	fmt.Fprint(writer, color.Bold.Sprint("\nContainers\n\n"))
+	writer.Flush()
+	headers := []string{"Name", "Status", "Health", "Exit Code", "Reason"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, container := range t.Containers {
+		exitCode := "-"
+		if container.ExitCode != nil {
+			exitCode = fmt.Sprintf("%d", aws.Int64Value(container.ExitCode))
+		}
+		fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\t%s\n", container.Name, container.LastStatus,
+			dashIfEmpty(container.HealthStatus), exitCode, dashIfEmpty(container.Reason))
+	}
+	writer.Flush()
+	return b.String()
+}
+
+func humanizeTimeOrDash(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+	return humanizeTime(t)
+}
+
+func dashIfEmpty(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
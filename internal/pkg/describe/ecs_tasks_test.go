@@ -0,0 +1,307 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsecsapi "github.com/aws/aws-sdk-go/service/ecs"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/dustin/go-humanize"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestECSTasksDescriber_Task(t *testing.T) {
+	startedAt := time.Date(2023, time.January, 1, 12, 0, 0, 0, time.UTC)
+	stoppedAt := time.Date(2023, time.January, 1, 13, 0, 0, 0, time.UTC)
+	runningTask := &awsecs.Task{
+		TaskArn:              aws.String("arn:aws:ecs:us-west-2:123456789012:task/cluster/4082490ee6c245e09d2145010aa1ba8d"),
+		TaskDefinitionArn:    aws.String("arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-api:12"),
+		LastStatus:           aws.String("RUNNING"),
+		HealthStatus:         aws.String("HEALTHY"),
+		CapacityProviderName: aws.String("FARGATE"),
+		AvailabilityZone:     aws.String("us-west-2a"),
+		StartedAt:            &startedAt,
+		Attachments: []*awsecsapi.Attachment{
+			{
+				Type: aws.String("ElasticNetworkInterface"),
+				Details: []*awsecsapi.KeyValuePair{
+					{
+						Name:  aws.String("privateIPv4Address"),
+						Value: aws.String("10.0.1.23"),
+					},
+				},
+			},
+		},
+		Containers: []*awsecsapi.Container{
+			{
+				Name:         aws.String("api"),
+				Image:        aws.String("api:latest"),
+				LastStatus:   aws.String("RUNNING"),
+				HealthStatus: aws.String("HEALTHY"),
+			},
+		},
+	}
+	stoppedTask := &awsecs.Task{
+		TaskArn:           aws.String("arn:aws:ecs:us-west-2:123456789012:task/cluster/40ae6ef3a2ea4d9f8c1f3e4c0a1b2c3d"),
+		TaskDefinitionArn: aws.String("arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-api:11"),
+		LastStatus:        aws.String("STOPPED"),
+		StartedAt:         &startedAt,
+		StoppedAt:         &stoppedAt,
+		StoppedReason:     aws.String("Essential container in task exited"),
+		Containers: []*awsecsapi.Container{
+			{
+				Name:       aws.String("api"),
+				Image:      aws.String("api:previous"),
+				LastStatus: aws.String("STOPPED"),
+				ExitCode:   aws.Int64(137),
+				Reason:     aws.String("OutOfMemoryError: Container killed due to memory usage"),
+			},
+		},
+	}
+	wantedRunningTask := &ECSTask{
+		ID:               "4082490ee6c245e09d2145010aa1ba8d",
+		TaskDefinition:   "arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-api:12",
+		Revision:         "12",
+		LastStatus:       "RUNNING",
+		Health:           "HEALTHY",
+		PrivateIP:        "10.0.1.23",
+		AvailabilityZone: "us-west-2a",
+		CapacityProvider: "FARGATE",
+		StartedAt:        startedAt,
+		Containers: []*ECSTaskContainer{
+			{
+				Name:         "api",
+				Image:        "api:latest",
+				LastStatus:   "RUNNING",
+				HealthStatus: "HEALTHY",
+			},
+		},
+	}
+	wantedStoppedTask := &ECSTask{
+		ID:             "40ae6ef3a2ea4d9f8c1f3e4c0a1b2c3d",
+		TaskDefinition: "arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-api:11",
+		Revision:       "11",
+		LastStatus:     "STOPPED",
+		StartedAt:      startedAt,
+		StoppedAt:      stoppedAt,
+		StoppedReason:  "Essential container in task exited",
+		Containers: []*ECSTaskContainer{
+			{
+				Name:       "api",
+				Image:      "api:previous",
+				LastStatus: "STOPPED",
+				ExitCode:   aws.Int64(137),
+				Reason:     "OutOfMemoryError: Container killed due to memory usage",
+			},
+		},
+	}
+	testCases := map[string]struct {
+		inID       string
+		setupMocks func(m *mocks.MockserviceDescriber)
+
+		wanted    *ECSTask
+		wantedErr error
+	}{
+		"error if fail to describe the service": {
+			inID: "4082",
+			setupMocks: func(m *mocks.MockserviceDescriber) {
+				m.EXPECT().DescribeService("phonetool", "test", "api").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get tasks of service api: some error"),
+		},
+		"error if fail to get the status of a task": {
+			inID: "4082",
+			setupMocks: func(m *mocks.MockserviceDescriber) {
+				m.EXPECT().DescribeService("phonetool", "test", "api").Return(&ecs.ServiceDesc{
+					Tasks: []*awsecs.Task{
+						{
+							TaskArn: aws.String("badTaskArn"),
+						},
+					},
+				}, nil)
+			},
+			wantedErr: errors.New("get status of task badTaskArn: parse ECS task ARN: arn: invalid prefix"),
+		},
+		"error if no task matches the ID": {
+			inID: "abcd",
+			setupMocks: func(m *mocks.MockserviceDescriber) {
+				m.EXPECT().DescribeService("phonetool", "test", "api").Return(&ecs.ServiceDesc{
+					Tasks:        []*awsecs.Task{runningTask},
+					StoppedTasks: []*awsecs.Task{stoppedTask},
+				}, nil)
+			},
+			wantedErr: errors.New("task abcd not found in the running or recently stopped tasks of service api"),
+		},
+		"error if the ID matches several tasks": {
+			inID: "40",
+			setupMocks: func(m *mocks.MockserviceDescriber) {
+				m.EXPECT().DescribeService("phonetool", "test", "api").Return(&ecs.ServiceDesc{
+					Tasks:        []*awsecs.Task{runningTask},
+					StoppedTasks: []*awsecs.Task{stoppedTask},
+				}, nil)
+			},
+			wantedErr: errors.New("task ID 40 is ambiguous, it matches tasks 4082490ee6c245e09d2145010aa1ba8d, 40ae6ef3a2ea4d9f8c1f3e4c0a1b2c3d"),
+		},
+		"returns a running task from its short ID": {
+			inID: "4082490e",
+			setupMocks: func(m *mocks.MockserviceDescriber) {
+				m.EXPECT().DescribeService("phonetool", "test", "api").Return(&ecs.ServiceDesc{
+					Tasks:        []*awsecs.Task{runningTask},
+					StoppedTasks: []*awsecs.Task{stoppedTask},
+				}, nil)
+			},
+			wanted: wantedRunningTask,
+		},
+		"returns a stopped task with the exit codes of its containers": {
+			inID: "40ae6ef3a2ea4d9f8c1f3e4c0a1b2c3d",
+			setupMocks: func(m *mocks.MockserviceDescriber) {
+				m.EXPECT().DescribeService("phonetool", "test", "api").Return(&ecs.ServiceDesc{
+					Tasks:        []*awsecs.Task{runningTask},
+					StoppedTasks: []*awsecs.Task{stoppedTask},
+				}, nil)
+			},
+			wanted: wantedStoppedTask,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockserviceDescriber(ctrl)
+			tc.setupMocks(m)
+			d := &ECSTasksDescriber{
+				app:          "phonetool",
+				env:          "test",
+				svc:          "api",
+				svcDescriber: m,
+			}
+
+			// WHEN
+			got, err := d.Task(tc.inID)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestECSTasks_HumanString(t *testing.T) {
+	oldHumanize := humanizeTime
+	humanizeTime = func(then time.Time) string {
+		now, _ := time.Parse(time.RFC3339, "2023-01-01T14:00:00+00:00")
+		return humanize.RelTime(then, now, "ago", "from now")
+	}
+	defer func() {
+		humanizeTime = oldHumanize
+	}()
+	startedAt := time.Date(2023, time.January, 1, 12, 0, 0, 0, time.UTC)
+	stoppedAt := time.Date(2023, time.January, 1, 13, 0, 0, 0, time.UTC)
+	tasks := &ECSTasks{
+		Service: "api",
+		Running: []*ECSTask{
+			{
+				ID:               "4082490ee6c245e09d2145010aa1ba8d",
+				Revision:         "12",
+				LastStatus:       "RUNNING",
+				Health:           "HEALTHY",
+				PrivateIP:        "10.0.1.23",
+				CapacityProvider: "FARGATE",
+				StartedAt:        startedAt,
+			},
+		},
+		Stopped: []*ECSTask{
+			{
+				ID:            "40ae6ef3a2ea4d9f8c1f3e4c0a1b2c3d",
+				Revision:      "11",
+				LastStatus:    "STOPPED",
+				StoppedAt:     stoppedAt,
+				StoppedReason: "Essential container in task exited",
+			},
+		},
+	}
+	wanted := `Running Tasks
+
+  ID        Revision  Status    Health    Private IP  Capacity Provider  Started At
+  --        --------  ------    ------    ----------  -----------------  ----------
+  4082490e  12        RUNNING   HEALTHY   10.0.1.23   FARGATE            2 hours ago
+
+Stopped Tasks
+
+  ID        Revision  Stopped At  Reason
+  --        --------  ----------  ------
+  40ae6ef3  11        1 hour ago  Essential container in task exited
+`
+
+	require.Equal(t, wanted, tasks.HumanString())
+}
+
+func TestECSTask_HumanString(t *testing.T) {
+	oldHumanize := humanizeTime
+	humanizeTime = func(then time.Time) string {
+		now, _ := time.Parse(time.RFC3339, "2023-01-01T14:00:00+00:00")
+		return humanize.RelTime(then, now, "ago", "from now")
+	}
+	defer func() {
+		humanizeTime = oldHumanize
+	}()
+	task := &ECSTask{
+		ID:             "40ae6ef3a2ea4d9f8c1f3e4c0a1b2c3d",
+		TaskDefinition: "arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-api:11",
+		Revision:       "11",
+		LastStatus:     "STOPPED",
+		StartedAt:      time.Date(2023, time.January, 1, 12, 0, 0, 0, time.UTC),
+		StoppedAt:      time.Date(2023, time.January, 1, 13, 0, 0, 0, time.UTC),
+		StoppedReason:  "Essential container in task exited",
+		Containers: []*ECSTaskContainer{
+			{
+				Name:       "api",
+				Image:      "api:previous",
+				LastStatus: "STOPPED",
+				ExitCode:   aws.Int64(137),
+				Reason:     "OutOfMemoryError",
+			},
+			{
+				Name:       "firelens_log_router",
+				Image:      "fluent-bit",
+				LastStatus: "STOPPED",
+				ExitCode:   aws.Int64(0),
+			},
+		},
+	}
+	wanted := `Task
+
+  ID                 40ae6ef3a2ea4d9f8c1f3e4c0a1b2c3d
+  Task Definition    arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-api:11
+  Status             STOPPED
+  Health             -
+  Private IP         -
+  Availability Zone  -
+  Capacity Provider  -
+  Started At         2 hours ago
+  Stopped At         1 hour ago
+  Stopped Reason     Essential container in task exited
+
+Containers
+
+  Name                 Status    Health    Exit Code  Reason
+  ----                 ------    ------    ---------  ------
+  api                  STOPPED   -         137        OutOfMemoryError
+  firelens_log_router  STOPPED   -         0          -
+`
+
+	require.Equal(t, wanted, task.HumanString())
+}
@@ -296,12 +296,9 @@ func (d *EnvDescriber) ValidateCFServiceDomainAliases() error {
 		return fmt.Errorf("cannot find %s in env stack parameter set", cfnstack.EnvParamAliasesKey)
 	}
 
-	var aliases map[string][]string
-	if jsonOutput != "" {
-		err = json.Unmarshal([]byte(jsonOutput), &aliases)
-		if err != nil {
-			return fmt.Errorf("unmarshal %q: %w", jsonOutput, err)
-		}
+	aliases, err := parseServiceAliases(jsonOutput)
+	if err != nil {
+		return err
 	}
 
 	var lbSvcsWithoutAlias []string
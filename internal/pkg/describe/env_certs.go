@@ -0,0 +1,194 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/acm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	cfnstack "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+const missingCertificate = "missing"
+
+type envManifestParamsGetter interface {
+	Manifest() ([]byte, error)
+	Params() (map[string]string, error)
+}
+
+type certDomainsGetter interface {
+	CertificateDomains(certs []string) (map[string][]string, error)
+}
+
+// EnvCertificates contains the certificates imported in an environment and the service aliases they protect.
+type EnvCertificates struct {
+	Environment  string            `json:"environment"`
+	Certificates []*EnvCertificate `json:"certificates"`
+	Aliases      []*ServiceAlias   `json:"aliases"`
+}
+
+// EnvCertificate is a certificate attached to the HTTPS listener of an environment's public load balancer.
+type EnvCertificate struct {
+	ARN     string   `json:"arn"`
+	Domains []string `json:"domains"`
+}
+
+// ServiceAlias is an alias of a service and the certificate that protects it.
+type ServiceAlias struct {
+	Service        string `json:"service"`
+	Alias          string `json:"alias"`
+	CertificateARN string `json:"certificateARN,omitempty"` // Empty if none of the certificates protect the alias.
+}
+
+// EnvCertificatesDescriber retrieves the certificates of an environment and matches them against the aliases of its services.
+type EnvCertificatesDescriber struct {
+	env string
+
+	envDescriber envManifestParamsGetter
+	acm          certDomainsGetter
+}
+
+// NewEnvCertificatesDescriber instantiates an environment certificates describer.
+func NewEnvCertificatesDescriber(opt NewEnvDescriberConfig) (*EnvCertificatesDescriber, error) {
+	envDescriber, err := NewEnvDescriber(opt)
+	if err != nil {
+		return nil, err
+	}
+	sess, err := sessions.ImmutableProvider().FromRole(envDescriber.env.ManagerRoleARN, envDescriber.env.Region)
+	if err != nil {
+		return nil, fmt.Errorf("assume role for environment %s: %w", envDescriber.env.ManagerRoleARN, err)
+	}
+	return &EnvCertificatesDescriber{
+		env:          opt.Env,
+		envDescriber: envDescriber,
+		acm:          acm.New(sess),
+	}, nil
+}
+
+// Describe returns the certificates imported in the environment and the certificate protecting each service alias.
+func (d *EnvCertificatesDescriber) Describe() (*EnvCertificates, error) {
+	raw, err := d.envDescriber.Manifest()
+	if err != nil {
+		return nil, fmt.Errorf("get manifest of environment %s: %w", d.env, err)
+	}
+	mft, err := manifest.UnmarshalEnvironment(raw)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal manifest of environment %s: %w", d.env, err)
+	}
+	params, err := d.envDescriber.Params()
+	if err != nil {
+		return nil, fmt.Errorf("get parameters of environment %s: %w", d.env, err)
+	}
+	aliasesBySvc, err := parseServiceAliases(params[cfnstack.EnvParamAliasesKey])
+	if err != nil {
+		return nil, err
+	}
+	certs := mft.HTTPConfig.Public.Certificates
+	out := &EnvCertificates{
+		Environment: d.env,
+	}
+	if len(certs) == 0 {
+		return out, nil
+	}
+	domainsOfCert, err := d.acm.CertificateDomains(certs)
+	if err != nil {
+		return nil, err
+	}
+	for _, cert := range certs {
+		out.Certificates = append(out.Certificates, &EnvCertificate{
+			ARN:     cert,
+			Domains: domainsOfCert[cert],
+		})
+	}
+	svcs := make([]string, 0, len(aliasesBySvc))
+	for svc := range aliasesBySvc {
+		svcs = append(svcs, svc)
+	}
+	sort.Strings(svcs)
+	for _, svc := range svcs {
+		for _, alias := range aliasesBySvc[svc] {
+			svcAlias := &ServiceAlias{
+				Service: svc,
+				Alias:   alias,
+			}
+			for _, cert := range out.Certificates {
+				if acm.IsAliasProtected(alias, cert.Domains) {
+					svcAlias.CertificateARN = cert.ARN
+					break
+				}
+			}
+			out.Aliases = append(out.Aliases, svcAlias)
+		}
+	}
+	return out, nil
+}
+
+// MissingAliases returns the aliases that are not protected by any of the certificates.
+func (c *EnvCertificates) MissingAliases() []*ServiceAlias {
+	var missing []*ServiceAlias
+	for _, alias := range c.Aliases {
+		if alias.CertificateARN == "" {
+			missing = append(missing, alias)
+		}
+	}
+	return missing
+}
+
+// JSONString returns the stringified EnvCertificates struct with json format.
+func (c *EnvCertificates) JSONString() (string, error) {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return "", fmt.Errorf("marshal environment certificates: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified EnvCertificates struct with human readable format.
+func (c *EnvCertificates) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprint(writer, color.Bold.Sprint("Certificates\n\n"))
+	writer.Flush()
+	headers := []string{"ARN", "Domains"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, cert := range c.Certificates {
+		fmt.Fprintf(writer, "  %s\t%s\n", cert.ARN, strings.Join(cert.Domains, ", "))
+	}
+	writer.Flush()
+	fmt.Fprint(writer, color.Bold.Sprint("\nAliases\n\n"))
+	writer.Flush()
+	headers = []string{"Service", "Alias", "Certificate"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, alias := range c.Aliases {
+		cert := alias.CertificateARN
+		if cert == "" {
+			cert = missingCertificate
+		}
+		fmt.Fprintf(writer, "  %s\t%s\t%s\n", alias.Service, alias.Alias, cert)
+	}
+	writer.Flush()
+	return b.String()
+}
+
+// parseServiceAliases parses the aliases of the services stored in the environment stack parameters.
+func parseServiceAliases(jsonOutput string) (map[string][]string, error) {
+	var aliases map[string][]string
+	if jsonOutput == "" {
+		return aliases, nil
+	}
+	if err := json.Unmarshal([]byte(jsonOutput), &aliases); err != nil {
+		return nil, fmt.Errorf("unmarshal %q: %w", jsonOutput, err)
+	}
+	return aliases, nil
+}
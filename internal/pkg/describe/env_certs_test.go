@@ -0,0 +1,182 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type envCertsDescriberMocks struct {
+	envDescriber *mocks.MockenvManifestParamsGetter
+	acm          *mocks.MockcertDomainsGetter
+}
+
+func TestEnvCertificatesDescriber_Describe(t *testing.T) {
+	const mftWithCerts = `name: test
+type: Environment
+http:
+  public:
+    certificates:
+      - arn:aws:acm:us-west-2:123456789012:certificate/1
+      - arn:aws:acm:us-west-2:123456789012:certificate/2
+`
+	testCases := map[string]struct {
+		setupMocks func(m envCertsDescriberMocks)
+
+		wanted    *EnvCertificates
+		wantedErr error
+	}{
+		"error if fail to get the manifest": {
+			setupMocks: func(m envCertsDescriberMocks) {
+				m.envDescriber.EXPECT().Manifest().Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get manifest of environment test: some error"),
+		},
+		"error if fail to get the stack parameters": {
+			setupMocks: func(m envCertsDescriberMocks) {
+				m.envDescriber.EXPECT().Manifest().Return([]byte(mftWithCerts), nil)
+				m.envDescriber.EXPECT().Params().Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get parameters of environment test: some error"),
+		},
+		"error if fail to describe the certificates": {
+			setupMocks: func(m envCertsDescriberMocks) {
+				m.envDescriber.EXPECT().Manifest().Return([]byte(mftWithCerts), nil)
+				m.envDescriber.EXPECT().Params().Return(map[string]string{}, nil)
+				m.acm.EXPECT().CertificateDomains(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"no certificates imported": {
+			setupMocks: func(m envCertsDescriberMocks) {
+				m.envDescriber.EXPECT().Manifest().Return([]byte("name: test\ntype: Environment\n"), nil)
+				m.envDescriber.EXPECT().Params().Return(map[string]string{
+					"Aliases": `{"frontend":["www.example.com"]}`,
+				}, nil)
+				m.acm.EXPECT().CertificateDomains(gomock.Any()).Times(0)
+			},
+			wanted: &EnvCertificates{
+				Environment: "test",
+			},
+		},
+		"matches aliases against the certificates": {
+			setupMocks: func(m envCertsDescriberMocks) {
+				m.envDescriber.EXPECT().Manifest().Return([]byte(mftWithCerts), nil)
+				m.envDescriber.EXPECT().Params().Return(map[string]string{
+					"Aliases": `{"frontend":["www.example.com","example.com"],"api":["api.other.com"]}`,
+				}, nil)
+				m.acm.EXPECT().CertificateDomains([]string{
+					"arn:aws:acm:us-west-2:123456789012:certificate/1",
+					"arn:aws:acm:us-west-2:123456789012:certificate/2",
+				}).Return(map[string][]string{
+					"arn:aws:acm:us-west-2:123456789012:certificate/1": {"*.example.com"},
+					"arn:aws:acm:us-west-2:123456789012:certificate/2": {"example.com"},
+				}, nil)
+			},
+			wanted: &EnvCertificates{
+				Environment: "test",
+				Certificates: []*EnvCertificate{
+					{
+						ARN:     "arn:aws:acm:us-west-2:123456789012:certificate/1",
+						Domains: []string{"*.example.com"},
+					},
+					{
+						ARN:     "arn:aws:acm:us-west-2:123456789012:certificate/2",
+						Domains: []string{"example.com"},
+					},
+				},
+				Aliases: []*ServiceAlias{
+					{
+						Service: "api",
+						Alias:   "api.other.com",
+					},
+					{
+						Service:        "frontend",
+						Alias:          "www.example.com",
+						CertificateARN: "arn:aws:acm:us-west-2:123456789012:certificate/1",
+					},
+					{
+						Service:        "frontend",
+						Alias:          "example.com",
+						CertificateARN: "arn:aws:acm:us-west-2:123456789012:certificate/2",
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := envCertsDescriberMocks{
+				envDescriber: mocks.NewMockenvManifestParamsGetter(ctrl),
+				acm:          mocks.NewMockcertDomainsGetter(ctrl),
+			}
+			tc.setupMocks(m)
+			d := &EnvCertificatesDescriber{
+				env:          "test",
+				envDescriber: m.envDescriber,
+				acm:          m.acm,
+			}
+
+			// WHEN
+			got, err := d.Describe()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestEnvCertificates_HumanString(t *testing.T) {
+	certs := &EnvCertificates{
+		Environment: "test",
+		Certificates: []*EnvCertificate{
+			{
+				ARN:     "arn:aws:acm:us-west-2:123456789012:certificate/1",
+				Domains: []string{"example.com", "*.example.com"},
+			},
+		},
+		Aliases: []*ServiceAlias{
+			{
+				Service: "api",
+				Alias:   "api.other.com",
+			},
+			{
+				Service:        "frontend",
+				Alias:          "www.example.com",
+				CertificateARN: "arn:aws:acm:us-west-2:123456789012:certificate/1",
+			},
+		},
+	}
+
+	wanted := fmt.Sprint(`Certificates
+
+  ARN                                               Domains
+  ---                                               -------
+  arn:aws:acm:us-west-2:123456789012:certificate/1  example.com, *.example.com
+
+Aliases
+
+  Service   Alias            Certificate
+  -------   -----            -----------
+  api       api.other.com    missing
+  frontend  www.example.com  arn:aws:acm:us-west-2:123456789012:certificate/1
+`)
+	require.Equal(t, wanted, certs.HumanString())
+	require.Equal(t, []*ServiceAlias{certs.Aliases[0]}, certs.MissingAliases())
+}
@@ -0,0 +1,262 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
+	"github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	cfnstack "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+const (
+	stateMachineResourceType = "AWS::StepFunctions::StateMachine"
+
+	jobStatusExecutionsLimit = 20
+	// EventBridge can start an execution slightly after its scheduled time.
+	jobScheduleGracePeriod = time.Minute
+	maxMissedInvocations   = 100
+)
+
+type jobStackDescriber interface {
+	Params() (map[string]string, error)
+	StackResources() ([]*stack.Resource, error)
+}
+
+type executionsLister interface {
+	Executions(stateMachineARN string, limit int) ([]stepfunctions.Execution, error)
+}
+
+// NewJobStatusConfig contains fields that initiates a JobStatusDescriber struct.
+type NewJobStatusConfig struct {
+	App         string
+	Env         string
+	Job         string
+	ConfigStore ConfigStoreSvc
+}
+
+// JobStatusDescriber retrieves the status of a job's recent executions.
+type JobStatusDescriber struct {
+	app string
+	env string
+	job string
+
+	stack  jobStackDescriber
+	sfn    executionsLister
+	alarms alarmStatusGetter
+	now    func() time.Time
+}
+
+// NewJobStatusDescriber instantiates a new JobStatusDescriber struct.
+func NewJobStatusDescriber(opt *NewJobStatusConfig) (*JobStatusDescriber, error) {
+	stackDescriber, err := NewWorkloadStackDescriber(NewWorkloadConfig{
+		App:         opt.App,
+		Env:         opt.Env,
+		Name:        opt.Job,
+		ConfigStore: opt.ConfigStore,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &JobStatusDescriber{
+		app:    opt.App,
+		env:    opt.Env,
+		job:    opt.Job,
+		stack:  stackDescriber,
+		sfn:    stepfunctions.New(stackDescriber.sess),
+		alarms: cloudwatch.New(stackDescriber.sess),
+		now:    time.Now,
+	}, nil
+}
+
+// Describe returns the recent executions of the job, the scheduled invocations that did not start an execution,
+// and the statuses of the job's alarms.
+func (d *JobStatusDescriber) Describe() (HumanJSONStringer, error) {
+	resources, err := d.stack.StackResources()
+	if err != nil {
+		return nil, fmt.Errorf("get stack resources of job %s: %w", d.job, err)
+	}
+	var stateMachineARN string
+	for _, r := range resources {
+		if r.Type == stateMachineResourceType {
+			stateMachineARN = r.PhysicalID
+			break
+		}
+	}
+	if stateMachineARN == "" {
+		return nil, fmt.Errorf("state machine for job %s is not found in environment %s", d.job, d.env)
+	}
+	params, err := d.stack.Params()
+	if err != nil {
+		return nil, fmt.Errorf("get stack parameters of job %s: %w", d.job, err)
+	}
+	executions, err := d.sfn.Executions(stateMachineARN, jobStatusExecutionsLimit)
+	if err != nil {
+		return nil, fmt.Errorf("get executions of job %s: %w", d.job, err)
+	}
+	alarms, err := d.alarms.AlarmsWithTags(map[string]string{
+		deploy.AppTagKey:     d.app,
+		deploy.EnvTagKey:     d.env,
+		deploy.ServiceTagKey: d.job,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get alarms of job %s: %w", d.job, err)
+	}
+	status := &jobStatus{
+		Schedule:            params[cfnstack.ScheduledJobScheduleParamKey],
+		Executions:          executions,
+		ConsecutiveFailures: consecutiveFailures(executions),
+		Alarms:              alarms,
+	}
+	if status.Schedule == "" || status.Schedule == deploy.ScheduleNone {
+		return status, nil
+	}
+	sched, err := deploy.ParseSchedule(status.Schedule)
+	if err != nil {
+		// The job can still be described without computing its invocations.
+		return status, nil
+	}
+	now := d.now().UTC() // EventBridge schedules are evaluated in UTC.
+	next := sched.Next(now)
+	status.NextInvocation = &next
+	if len(executions) > 0 {
+		missed := 0
+		for next := sched.Next(executions[0].StartDate.UTC().Add(jobScheduleGracePeriod)); !next.After(now.Add(-jobScheduleGracePeriod)) && missed < maxMissedInvocations; next = sched.Next(next) {
+			missed++
+		}
+		status.MissedInvocations = &missed
+	}
+	return status, nil
+}
+
+// consecutiveFailures returns the number of the most recent executions that did not succeed, ignoring running executions.
+func consecutiveFailures(executions []stepfunctions.Execution) int {
+	var failures int
+	for _, execution := range executions {
+		switch execution.Status {
+		case stepfunctions.ExecutionStatusRunning:
+			continue
+		case stepfunctions.ExecutionStatusSucceeded:
+			return failures
+		default:
+			failures++
+		}
+	}
+	return failures
+}
+
+// jobStatus contains the status of a job.
+type jobStatus struct {
+	Schedule            string                    `json:"schedule,omitempty"`
+	NextInvocation      *time.Time                `json:"nextInvocation,omitempty"`
+	MissedInvocations   *int                      `json:"missedInvocations,omitempty"` // Nil if the invocations of the schedule can't be computed.
+	ConsecutiveFailures int                       `json:"consecutiveFailures"`
+	Executions          []stepfunctions.Execution `json:"executions"`
+	Alarms              []cloudwatch.AlarmStatus  `json:"alarms"`
+}
+
+// JSONString returns the stringified jobStatus struct with json format.
+func (s *jobStatus) JSONString() (string, error) {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return "", fmt.Errorf("marshal job status: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified jobStatus struct in human-readable format.
+func (s *jobStatus) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, statusMinCellWidth, tabWidth, statusCellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprint(writer, color.Bold.Sprint("Job Summary\n\n"))
+	writer.Flush()
+	s.writeSummary(writer)
+	writer.Flush()
+
+	if len(s.Executions) > 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nRecent Executions\n\n"))
+		writer.Flush()
+		s.writeExecutions(writer)
+		writer.Flush()
+	}
+
+	if len(s.Alarms) > 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nAlarms\n\n"))
+		writer.Flush()
+		s.writeAlarms(writer)
+		writer.Flush()
+	}
+	return b.String()
+}
+
+func (s *jobStatus) writeSummary(writer io.Writer) {
+	schedule := dashIfEmpty(s.Schedule)
+	if s.Schedule == deploy.ScheduleNone {
+		schedule = "none (the job is not invoked on a schedule)"
+	}
+	fmt.Fprintf(writer, "  %s\t%s\n", "Schedule", schedule)
+	next := "-"
+	if s.NextInvocation != nil {
+		next = humanizeTime(*s.NextInvocation)
+	}
+	fmt.Fprintf(writer, "  %s\t%s\n", "Next Invocation", next)
+	missed := "-"
+	if s.MissedInvocations != nil {
+		missed = strconv.Itoa(*s.MissedInvocations)
+		if *s.MissedInvocations > 0 {
+			missed = color.Red.Sprint(missed)
+		}
+	}
+	fmt.Fprintf(writer, "  %s\t%s\n", "Missed Invocations", missed)
+	failures := strconv.Itoa(s.ConsecutiveFailures)
+	if s.ConsecutiveFailures > 0 {
+		failures = color.Red.Sprint(failures)
+	}
+	fmt.Fprintf(writer, "  %s\t%s\n", "Consecutive Failures", failures)
+}
+
+func (s *jobStatus) writeExecutions(writer io.Writer) {
+	headers := []string{"Name", "Status", "Started", "Duration"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, execution := range s.Executions {
+		duration := "-"
+		if !execution.StopDate.IsZero() {
+			duration = execution.StopDate.Sub(execution.StartDate).Round(time.Second).String()
+		}
+		fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\n", execution.Name, executionStatusColor(execution.Status), humanizeTimeOrDash(execution.StartDate), duration)
+	}
+}
+
+func (s *jobStatus) writeAlarms(writer io.Writer) {
+	headers := []string{"Name", "Condition", "Last Updated", "Health"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, alarm := range s.Alarms {
+		printWithMaxWidth(writer, "  %s\t%s\t%s\t%s\n", maxAlarmStatusColumnWidth, alarm.Name, alarm.Condition, humanizeTime(alarm.UpdatedTimes), alarmHealthColor(alarm.Status))
+		fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\n", "", "", "", "")
+	}
+}
+
+func executionStatusColor(status string) string {
+	switch status {
+	case stepfunctions.ExecutionStatusSucceeded:
+		return color.Green.Sprint(status)
+	case stepfunctions.ExecutionStatusRunning:
+		return color.Yellow.Sprint(status)
+	default:
+		return color.Red.Sprint(status)
+	}
+}
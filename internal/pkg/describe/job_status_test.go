@@ -0,0 +1,270 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
+	"github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	"github.com/dustin/go-humanize"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type jobStatusDescriberMocks struct {
+	stack  *mocks.MockjobStackDescriber
+	sfn    *mocks.MockexecutionsLister
+	alarms *mocks.MockalarmStatusGetter
+}
+
+func TestJobStatusDescriber_Describe(t *testing.T) {
+	const mockStateMachineARN = "arn:aws:states:us-west-2:123456789012:stateMachine:phonetool-test-report"
+	now := time.Date(2024, time.March, 1, 10, 30, 0, 0, time.UTC)
+	resources := []*stack.Resource{
+		{
+			Type:       "AWS::Events::Rule",
+			LogicalID:  "Rule",
+			PhysicalID: "phonetool-test-report-Rule",
+		},
+		{
+			Type:       "AWS::StepFunctions::StateMachine",
+			LogicalID:  "StateMachine",
+			PhysicalID: mockStateMachineARN,
+		},
+	}
+	executions := []stepfunctions.Execution{
+		{
+			Name:      "running",
+			Status:    stepfunctions.ExecutionStatusRunning,
+			StartDate: time.Date(2024, time.March, 1, 7, 0, 10, 0, time.UTC),
+		},
+		{
+			Name:      "failed",
+			Status:    stepfunctions.ExecutionStatusFailed,
+			StartDate: time.Date(2024, time.March, 1, 6, 0, 10, 0, time.UTC),
+		},
+		{
+			Name:      "timed-out",
+			Status:    stepfunctions.ExecutionStatusTimedOut,
+			StartDate: time.Date(2024, time.March, 1, 5, 0, 10, 0, time.UTC),
+		},
+		{
+			Name:      "succeeded",
+			Status:    stepfunctions.ExecutionStatusSucceeded,
+			StartDate: time.Date(2024, time.March, 1, 4, 0, 10, 0, time.UTC),
+		},
+		{
+			Name:      "older-failure",
+			Status:    stepfunctions.ExecutionStatusFailed,
+			StartDate: time.Date(2024, time.March, 1, 3, 0, 10, 0, time.UTC),
+		},
+	}
+	alarms := []cloudwatch.AlarmStatus{
+		{
+			Name:   "JobFailuresAlarm",
+			Status: "ALARM",
+		},
+	}
+	testCases := map[string]struct {
+		setupMocks func(m jobStatusDescriberMocks)
+
+		wantedStatus *jobStatus
+		wantedError  error
+	}{
+		"error if fail to get the stack resources": {
+			setupMocks: func(m jobStatusDescriberMocks) {
+				m.stack.EXPECT().StackResources().Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get stack resources of job report: some error"),
+		},
+		"error if the state machine is not found": {
+			setupMocks: func(m jobStatusDescriberMocks) {
+				m.stack.EXPECT().StackResources().Return(resources[:1], nil)
+			},
+			wantedError: errors.New("state machine for job report is not found in environment test"),
+		},
+		"error if fail to list the executions": {
+			setupMocks: func(m jobStatusDescriberMocks) {
+				m.stack.EXPECT().StackResources().Return(resources, nil)
+				m.stack.EXPECT().Params().Return(map[string]string{"Schedule": "rate(1 hour)"}, nil)
+				m.sfn.EXPECT().Executions(mockStateMachineARN, jobStatusExecutionsLimit).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get executions of job report: some error"),
+		},
+		"error if fail to get the alarms": {
+			setupMocks: func(m jobStatusDescriberMocks) {
+				m.stack.EXPECT().StackResources().Return(resources, nil)
+				m.stack.EXPECT().Params().Return(map[string]string{"Schedule": "rate(1 hour)"}, nil)
+				m.sfn.EXPECT().Executions(mockStateMachineARN, jobStatusExecutionsLimit).Return(executions, nil)
+				m.alarms.EXPECT().AlarmsWithTags(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get alarms of job report: some error"),
+		},
+		"counts the scheduled invocations missed since the last execution": {
+			setupMocks: func(m jobStatusDescriberMocks) {
+				m.stack.EXPECT().StackResources().Return(resources, nil)
+				m.stack.EXPECT().Params().Return(map[string]string{"Schedule": "cron(0 * * * ? *)"}, nil)
+				m.sfn.EXPECT().Executions(mockStateMachineARN, jobStatusExecutionsLimit).Return(executions, nil)
+				m.alarms.EXPECT().AlarmsWithTags(map[string]string{
+					"copilot-application": "phonetool",
+					"copilot-environment": "test",
+					"copilot-service":     "report",
+				}).Return(alarms, nil)
+			},
+			wantedStatus: &jobStatus{
+				Schedule:            "cron(0 * * * ? *)",
+				NextInvocation:      aws.Time(time.Date(2024, time.March, 1, 11, 0, 0, 0, time.UTC)),
+				MissedInvocations:   aws.Int(3), // 08:00, 09:00 and 10:00.
+				ConsecutiveFailures: 2,
+				Executions:          executions,
+				Alarms:              alarms,
+			},
+		},
+		"does not compute invocations if the job is not scheduled": {
+			setupMocks: func(m jobStatusDescriberMocks) {
+				m.stack.EXPECT().StackResources().Return(resources, nil)
+				m.stack.EXPECT().Params().Return(map[string]string{"Schedule": "none"}, nil)
+				m.sfn.EXPECT().Executions(mockStateMachineARN, jobStatusExecutionsLimit).Return(executions[3:], nil)
+				m.alarms.EXPECT().AlarmsWithTags(gomock.Any()).Return(nil, nil)
+			},
+			wantedStatus: &jobStatus{
+				Schedule:   "none",
+				Executions: executions[3:],
+			},
+		},
+		"does not count missed invocations if the job never ran": {
+			setupMocks: func(m jobStatusDescriberMocks) {
+				m.stack.EXPECT().StackResources().Return(resources, nil)
+				m.stack.EXPECT().Params().Return(map[string]string{"Schedule": "rate(15 minutes)"}, nil)
+				m.sfn.EXPECT().Executions(mockStateMachineARN, jobStatusExecutionsLimit).Return(nil, nil)
+				m.alarms.EXPECT().AlarmsWithTags(gomock.Any()).Return(nil, nil)
+			},
+			wantedStatus: &jobStatus{
+				Schedule:       "rate(15 minutes)",
+				NextInvocation: aws.Time(now.Add(15 * time.Minute)),
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := jobStatusDescriberMocks{
+				stack:  mocks.NewMockjobStackDescriber(ctrl),
+				sfn:    mocks.NewMockexecutionsLister(ctrl),
+				alarms: mocks.NewMockalarmStatusGetter(ctrl),
+			}
+			tc.setupMocks(m)
+			d := &JobStatusDescriber{
+				app:    "phonetool",
+				env:    "test",
+				job:    "report",
+				stack:  m.stack,
+				sfn:    m.sfn,
+				alarms: m.alarms,
+				now:    func() time.Time { return now },
+			}
+
+			// WHEN
+			got, err := d.Describe()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedStatus, got)
+		})
+	}
+}
+
+func TestJobStatus_String(t *testing.T) {
+	oldHumanize := humanizeTime
+	humanizeTime = func(then time.Time) string {
+		now, _ := time.Parse(time.RFC3339, "2024-03-01T10:30:00+00:00")
+		return humanize.RelTime(then, now, "ago", "from now")
+	}
+	defer func() {
+		humanizeTime = oldHumanize
+	}()
+	startDate, _ := time.Parse(time.RFC3339, "2024-03-01T07:00:10+00:00")
+	stopDate, _ := time.Parse(time.RFC3339, "2024-03-01T07:02:40+00:00")
+	nextInvocation, _ := time.Parse(time.RFC3339, "2024-03-01T11:00:00+00:00")
+
+	status := &jobStatus{
+		Schedule:            "cron(0 * * * ? *)",
+		NextInvocation:      &nextInvocation,
+		MissedInvocations:   aws.Int(3),
+		ConsecutiveFailures: 1,
+		Executions: []stepfunctions.Execution{
+			{
+				Name:      "running",
+				Status:    stepfunctions.ExecutionStatusRunning,
+				StartDate: stopDate,
+			},
+			{
+				Name:      "failed",
+				Status:    stepfunctions.ExecutionStatusFailed,
+				StartDate: startDate,
+				StopDate:  stopDate,
+			},
+		},
+		Alarms: []cloudwatch.AlarmStatus{
+			{
+				Name:         "JobFailuresAlarm",
+				Condition:    "unsuccessful >= 1.00 for 3 datapoints within 3 hours",
+				Status:       "ALARM",
+				UpdatedTimes: stopDate,
+			},
+		},
+	}
+
+	human := status.HumanString()
+	json, err := status.JSONString()
+
+	require.NoError(t, err)
+	require.Equal(t, `Job Summary
+
+  Schedule              cron(0 * * * ? *)
+  Next Invocation       30 minutes from now
+  Missed Invocations    3
+  Consecutive Failures  1
+
+Recent Executions
+
+  Name      Status      Started      Duration
+  ----      ------      -------      --------
+  running   RUNNING     3 hours ago  -
+  failed    FAILED      3 hours ago  2m30s
+
+Alarms
+
+  Name              Condition                       Last Updated  Health
+  ----              ---------                       ------------  ------
+  JobFailuresAlarm  unsuccessful >= 1.00 for 3 dat  3 hours ago   ALARM
+                    apoints within 3 hours                        
+                                                                  
+`, human)
+	require.JSONEq(t, `{
+  "schedule": "cron(0 * * * ? *)",
+  "nextInvocation": "2024-03-01T11:00:00Z",
+  "missedInvocations": 3,
+  "consecutiveFailures": 1,
+  "executions": [
+    {"name": "running", "status": "RUNNING", "startDate": "2024-03-01T07:02:40Z", "stopDate": "0001-01-01T00:00:00Z"},
+    {"name": "failed", "status": "FAILED", "startDate": "2024-03-01T07:00:10Z", "stopDate": "2024-03-01T07:02:40Z"}
+  ],
+  "alarms": [
+    {"arn": "", "name": "JobFailuresAlarm", "condition": "unsuccessful >= 1.00 for 3 datapoints within 3 hours", "status": "ALARM", "type": "", "updatedTimes": "2024-03-01T07:02:40Z"}
+  ]
+}`, json)
+}
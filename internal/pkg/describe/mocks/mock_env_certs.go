@@ -0,0 +1,102 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/describe/env_certs.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockenvManifestParamsGetter is a mock of envManifestParamsGetter interface.
+type MockenvManifestParamsGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockenvManifestParamsGetterMockRecorder
+}
+
+// MockenvManifestParamsGetterMockRecorder is the mock recorder for MockenvManifestParamsGetter.
+type MockenvManifestParamsGetterMockRecorder struct {
+	mock *MockenvManifestParamsGetter
+}
+
+// NewMockenvManifestParamsGetter creates a new mock instance.
+func NewMockenvManifestParamsGetter(ctrl *gomock.Controller) *MockenvManifestParamsGetter {
+	mock := &MockenvManifestParamsGetter{ctrl: ctrl}
+	mock.recorder = &MockenvManifestParamsGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockenvManifestParamsGetter) EXPECT() *MockenvManifestParamsGetterMockRecorder {
+	return m.recorder
+}
+
+// Manifest mocks base method.
+func (m *MockenvManifestParamsGetter) Manifest() ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Manifest")
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Manifest indicates an expected call of Manifest.
+func (mr *MockenvManifestParamsGetterMockRecorder) Manifest() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Manifest", reflect.TypeOf((*MockenvManifestParamsGetter)(nil).Manifest))
+}
+
+// Params mocks base method.
+func (m *MockenvManifestParamsGetter) Params() (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Params")
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Params indicates an expected call of Params.
+func (mr *MockenvManifestParamsGetterMockRecorder) Params() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Params", reflect.TypeOf((*MockenvManifestParamsGetter)(nil).Params))
+}
+
+// MockcertDomainsGetter is a mock of certDomainsGetter interface.
+type MockcertDomainsGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockcertDomainsGetterMockRecorder
+}
+
+// MockcertDomainsGetterMockRecorder is the mock recorder for MockcertDomainsGetter.
+type MockcertDomainsGetterMockRecorder struct {
+	mock *MockcertDomainsGetter
+}
+
+// NewMockcertDomainsGetter creates a new mock instance.
+func NewMockcertDomainsGetter(ctrl *gomock.Controller) *MockcertDomainsGetter {
+	mock := &MockcertDomainsGetter{ctrl: ctrl}
+	mock.recorder = &MockcertDomainsGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcertDomainsGetter) EXPECT() *MockcertDomainsGetterMockRecorder {
+	return m.recorder
+}
+
+// CertificateDomains mocks base method.
+func (m *MockcertDomainsGetter) CertificateDomains(certs []string) (map[string][]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CertificateDomains", certs)
+	ret0, _ := ret[0].(map[string][]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CertificateDomains indicates an expected call of CertificateDomains.
+func (mr *MockcertDomainsGetterMockRecorder) CertificateDomains(certs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CertificateDomains", reflect.TypeOf((*MockcertDomainsGetter)(nil).CertificateDomains), certs)
+}
@@ -0,0 +1,104 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/describe/job_status.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	stepfunctions "github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions"
+	stack "github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockjobStackDescriber is a mock of jobStackDescriber interface.
+type MockjobStackDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockjobStackDescriberMockRecorder
+}
+
+// MockjobStackDescriberMockRecorder is the mock recorder for MockjobStackDescriber.
+type MockjobStackDescriberMockRecorder struct {
+	mock *MockjobStackDescriber
+}
+
+// NewMockjobStackDescriber creates a new mock instance.
+func NewMockjobStackDescriber(ctrl *gomock.Controller) *MockjobStackDescriber {
+	mock := &MockjobStackDescriber{ctrl: ctrl}
+	mock.recorder = &MockjobStackDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockjobStackDescriber) EXPECT() *MockjobStackDescriberMockRecorder {
+	return m.recorder
+}
+
+// Params mocks base method.
+func (m *MockjobStackDescriber) Params() (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Params")
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Params indicates an expected call of Params.
+func (mr *MockjobStackDescriberMockRecorder) Params() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Params", reflect.TypeOf((*MockjobStackDescriber)(nil).Params))
+}
+
+// StackResources mocks base method.
+func (m *MockjobStackDescriber) StackResources() ([]*stack.Resource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StackResources")
+	ret0, _ := ret[0].([]*stack.Resource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StackResources indicates an expected call of StackResources.
+func (mr *MockjobStackDescriberMockRecorder) StackResources() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StackResources", reflect.TypeOf((*MockjobStackDescriber)(nil).StackResources))
+}
+
+// MockexecutionsLister is a mock of executionsLister interface.
+type MockexecutionsLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockexecutionsListerMockRecorder
+}
+
+// MockexecutionsListerMockRecorder is the mock recorder for MockexecutionsLister.
+type MockexecutionsListerMockRecorder struct {
+	mock *MockexecutionsLister
+}
+
+// NewMockexecutionsLister creates a new mock instance.
+func NewMockexecutionsLister(ctrl *gomock.Controller) *MockexecutionsLister {
+	mock := &MockexecutionsLister{ctrl: ctrl}
+	mock.recorder = &MockexecutionsListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockexecutionsLister) EXPECT() *MockexecutionsListerMockRecorder {
+	return m.recorder
+}
+
+// Executions mocks base method.
+func (m *MockexecutionsLister) Executions(stateMachineARN string, limit int) ([]stepfunctions.Execution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Executions", stateMachineARN, limit)
+	ret0, _ := ret[0].([]stepfunctions.Execution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Executions indicates an expected call of Executions.
+func (mr *MockexecutionsListerMockRecorder) Executions(stateMachineARN, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Executions", reflect.TypeOf((*MockexecutionsLister)(nil).Executions), stateMachineARN, limit)
+}
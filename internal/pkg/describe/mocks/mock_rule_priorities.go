@@ -0,0 +1,103 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/describe/rule_priorities.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	elbv2 "github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockenvOutputsGetter is a mock of envOutputsGetter interface.
+type MockenvOutputsGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockenvOutputsGetterMockRecorder
+}
+
+// MockenvOutputsGetterMockRecorder is the mock recorder for MockenvOutputsGetter.
+type MockenvOutputsGetterMockRecorder struct {
+	mock *MockenvOutputsGetter
+}
+
+// NewMockenvOutputsGetter creates a new mock instance.
+func NewMockenvOutputsGetter(ctrl *gomock.Controller) *MockenvOutputsGetter {
+	mock := &MockenvOutputsGetter{ctrl: ctrl}
+	mock.recorder = &MockenvOutputsGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockenvOutputsGetter) EXPECT() *MockenvOutputsGetterMockRecorder {
+	return m.recorder
+}
+
+// Outputs mocks base method.
+func (m *MockenvOutputsGetter) Outputs() (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Outputs")
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Outputs indicates an expected call of Outputs.
+func (mr *MockenvOutputsGetterMockRecorder) Outputs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Outputs", reflect.TypeOf((*MockenvOutputsGetter)(nil).Outputs))
+}
+
+// MocklistenerRulesGetter is a mock of listenerRulesGetter interface.
+type MocklistenerRulesGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MocklistenerRulesGetterMockRecorder
+}
+
+// MocklistenerRulesGetterMockRecorder is the mock recorder for MocklistenerRulesGetter.
+type MocklistenerRulesGetterMockRecorder struct {
+	mock *MocklistenerRulesGetter
+}
+
+// NewMocklistenerRulesGetter creates a new mock instance.
+func NewMocklistenerRulesGetter(ctrl *gomock.Controller) *MocklistenerRulesGetter {
+	mock := &MocklistenerRulesGetter{ctrl: ctrl}
+	mock.recorder = &MocklistenerRulesGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocklistenerRulesGetter) EXPECT() *MocklistenerRulesGetterMockRecorder {
+	return m.recorder
+}
+
+// ListenerRules mocks base method.
+func (m *MocklistenerRulesGetter) ListenerRules(listenerARN string) ([]elbv2.ListenerRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListenerRules", listenerARN)
+	ret0, _ := ret[0].([]elbv2.ListenerRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListenerRules indicates an expected call of ListenerRules.
+func (mr *MocklistenerRulesGetterMockRecorder) ListenerRules(listenerARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListenerRules", reflect.TypeOf((*MocklistenerRulesGetter)(nil).ListenerRules), listenerARN)
+}
+
+// ResourceTags mocks base method.
+func (m *MocklistenerRulesGetter) ResourceTags(resourceARNs []string) (map[string]map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResourceTags", resourceARNs)
+	ret0, _ := ret[0].(map[string]map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResourceTags indicates an expected call of ResourceTags.
+func (mr *MocklistenerRulesGetterMockRecorder) ResourceTags(resourceARNs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResourceTags", reflect.TypeOf((*MocklistenerRulesGetter)(nil).ResourceTags), resourceARNs)
+}
@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/describe/service_capacity.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	aas "github.com/aws/copilot-cli/internal/pkg/aws/aas"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockecsServiceCapacityGetter is a mock of ecsServiceCapacityGetter interface.
+type MockecsServiceCapacityGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockecsServiceCapacityGetterMockRecorder
+}
+
+// MockecsServiceCapacityGetterMockRecorder is the mock recorder for MockecsServiceCapacityGetter.
+type MockecsServiceCapacityGetterMockRecorder struct {
+	mock *MockecsServiceCapacityGetter
+}
+
+// NewMockecsServiceCapacityGetter creates a new mock instance.
+func NewMockecsServiceCapacityGetter(ctrl *gomock.Controller) *MockecsServiceCapacityGetter {
+	mock := &MockecsServiceCapacityGetter{ctrl: ctrl}
+	mock.recorder = &MockecsServiceCapacityGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockecsServiceCapacityGetter) EXPECT() *MockecsServiceCapacityGetterMockRecorder {
+	return m.recorder
+}
+
+// ECSServiceCapacity mocks base method.
+func (m *MockecsServiceCapacityGetter) ECSServiceCapacity(cluster, service string) (*aas.Capacity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ECSServiceCapacity", cluster, service)
+	ret0, _ := ret[0].(*aas.Capacity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ECSServiceCapacity indicates an expected call of ECSServiceCapacity.
+func (mr *MockecsServiceCapacityGetterMockRecorder) ECSServiceCapacity(cluster, service interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ECSServiceCapacity", reflect.TypeOf((*MockecsServiceCapacityGetter)(nil).ECSServiceCapacity), cluster, service)
+}
+
+// ECSServiceScheduledAction mocks base method.
+func (m *MockecsServiceCapacityGetter) ECSServiceScheduledAction(cluster, service, name string) (*aas.ScheduledAction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ECSServiceScheduledAction", cluster, service, name)
+	ret0, _ := ret[0].(*aas.ScheduledAction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ECSServiceScheduledAction indicates an expected call of ECSServiceScheduledAction.
+func (mr *MockecsServiceCapacityGetterMockRecorder) ECSServiceScheduledAction(cluster, service, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ECSServiceScheduledAction", reflect.TypeOf((*MockecsServiceCapacityGetter)(nil).ECSServiceScheduledAction), cluster, service, name)
+}
@@ -0,0 +1,89 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/describe/service_events.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	cloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	cloudwatch "github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockstackEventsGetter is a mock of stackEventsGetter interface.
+type MockstackEventsGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockstackEventsGetterMockRecorder
+}
+
+// MockstackEventsGetterMockRecorder is the mock recorder for MockstackEventsGetter.
+type MockstackEventsGetterMockRecorder struct {
+	mock *MockstackEventsGetter
+}
+
+// NewMockstackEventsGetter creates a new mock instance.
+func NewMockstackEventsGetter(ctrl *gomock.Controller) *MockstackEventsGetter {
+	mock := &MockstackEventsGetter{ctrl: ctrl}
+	mock.recorder = &MockstackEventsGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockstackEventsGetter) EXPECT() *MockstackEventsGetterMockRecorder {
+	return m.recorder
+}
+
+// LatestEvents mocks base method.
+func (m *MockstackEventsGetter) LatestEvents(stackName string, limit int) ([]cloudformation.StackEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LatestEvents", stackName, limit)
+	ret0, _ := ret[0].([]cloudformation.StackEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LatestEvents indicates an expected call of LatestEvents.
+func (mr *MockstackEventsGetterMockRecorder) LatestEvents(stackName, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestEvents", reflect.TypeOf((*MockstackEventsGetter)(nil).LatestEvents), stackName, limit)
+}
+
+// MockalarmStateChangesGetter is a mock of alarmStateChangesGetter interface.
+type MockalarmStateChangesGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockalarmStateChangesGetterMockRecorder
+}
+
+// MockalarmStateChangesGetterMockRecorder is the mock recorder for MockalarmStateChangesGetter.
+type MockalarmStateChangesGetterMockRecorder struct {
+	mock *MockalarmStateChangesGetter
+}
+
+// NewMockalarmStateChangesGetter creates a new mock instance.
+func NewMockalarmStateChangesGetter(ctrl *gomock.Controller) *MockalarmStateChangesGetter {
+	mock := &MockalarmStateChangesGetter{ctrl: ctrl}
+	mock.recorder = &MockalarmStateChangesGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockalarmStateChangesGetter) EXPECT() *MockalarmStateChangesGetterMockRecorder {
+	return m.recorder
+}
+
+// AlarmStateChanges mocks base method.
+func (m *MockalarmStateChangesGetter) AlarmStateChanges(alarmName string, limit int) ([]cloudwatch.AlarmStateChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AlarmStateChanges", alarmName, limit)
+	ret0, _ := ret[0].([]cloudwatch.AlarmStateChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AlarmStateChanges indicates an expected call of AlarmStateChanges.
+func (mr *MockalarmStateChangesGetterMockRecorder) AlarmStateChanges(alarmName, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AlarmStateChanges", reflect.TypeOf((*MockalarmStateChangesGetter)(nil).AlarmStateChanges), alarmName, limit)
+}
@@ -0,0 +1,89 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/describe/worker_queues.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	sqs "github.com/aws/copilot-cli/internal/pkg/aws/sqs"
+	stack "github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockstackResourcesGetter is a mock of stackResourcesGetter interface.
+type MockstackResourcesGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockstackResourcesGetterMockRecorder
+}
+
+// MockstackResourcesGetterMockRecorder is the mock recorder for MockstackResourcesGetter.
+type MockstackResourcesGetterMockRecorder struct {
+	mock *MockstackResourcesGetter
+}
+
+// NewMockstackResourcesGetter creates a new mock instance.
+func NewMockstackResourcesGetter(ctrl *gomock.Controller) *MockstackResourcesGetter {
+	mock := &MockstackResourcesGetter{ctrl: ctrl}
+	mock.recorder = &MockstackResourcesGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockstackResourcesGetter) EXPECT() *MockstackResourcesGetterMockRecorder {
+	return m.recorder
+}
+
+// StackResources mocks base method.
+func (m *MockstackResourcesGetter) StackResources() ([]*stack.Resource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StackResources")
+	ret0, _ := ret[0].([]*stack.Resource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StackResources indicates an expected call of StackResources.
+func (mr *MockstackResourcesGetterMockRecorder) StackResources() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StackResources", reflect.TypeOf((*MockstackResourcesGetter)(nil).StackResources))
+}
+
+// MockqueueDescriber is a mock of queueDescriber interface.
+type MockqueueDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockqueueDescriberMockRecorder
+}
+
+// MockqueueDescriberMockRecorder is the mock recorder for MockqueueDescriber.
+type MockqueueDescriberMockRecorder struct {
+	mock *MockqueueDescriber
+}
+
+// NewMockqueueDescriber creates a new mock instance.
+func NewMockqueueDescriber(ctrl *gomock.Controller) *MockqueueDescriber {
+	mock := &MockqueueDescriber{ctrl: ctrl}
+	mock.recorder = &MockqueueDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockqueueDescriber) EXPECT() *MockqueueDescriberMockRecorder {
+	return m.recorder
+}
+
+// Queue mocks base method.
+func (m *MockqueueDescriber) Queue(url string) (*sqs.Queue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Queue", url)
+	ret0, _ := ret[0].(*sqs.Queue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Queue indicates an expected call of Queue.
+func (mr *MockqueueDescriberMockRecorder) Queue(url interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Queue", reflect.TypeOf((*MockqueueDescriber)(nil).Queue), url)
+}
@@ -0,0 +1,257 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	cfnstack "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+// Outputs of the environment stack with the ARNs of the listeners of its load balancers.
+var envListenerOutputs = []struct {
+	key  string
+	name string
+}{
+	{key: "HTTPListenerArn", name: "public HTTP"},
+	{key: "HTTPSListenerArn", name: "public HTTPS"},
+	{key: "InternalHTTPListenerArn", name: "internal HTTP"},
+	{key: "InternalHTTPSListenerArn", name: "internal HTTPS"},
+}
+
+type envOutputsGetter interface {
+	Outputs() (map[string]string, error)
+}
+
+type listenerRulesGetter interface {
+	ListenerRules(listenerARN string) ([]elbv2.ListenerRule, error)
+	ResourceTags(resourceARNs []string) (map[string]map[string]string, error)
+}
+
+// NewRulePrioritiesConfig contains fields that initiate a RulePrioritiesDescriber.
+type NewRulePrioritiesConfig struct {
+	App         string
+	Env         string
+	ConfigStore ConfigStoreSvc
+}
+
+// RulePrioritiesDescriber retrieves the priorities of the listener rules of the load balancers in an environment.
+type RulePrioritiesDescriber struct {
+	env string
+
+	envOutputs envOutputsGetter
+	elb        listenerRulesGetter
+}
+
+// NewRulePrioritiesDescriber instantiates a new RulePrioritiesDescriber.
+func NewRulePrioritiesDescriber(opt *NewRulePrioritiesConfig) (*RulePrioritiesDescriber, error) {
+	env, err := opt.ConfigStore.GetEnvironment(opt.App, opt.Env)
+	if err != nil {
+		return nil, fmt.Errorf("get environment %s: %w", opt.Env, err)
+	}
+	sess, err := sessions.ImmutableProvider().FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return nil, fmt.Errorf("session for role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
+	}
+	return &RulePrioritiesDescriber{
+		env: opt.Env,
+		envOutputs: &EnvDescriber{
+			cfn: stack.NewStackDescriber(cfnstack.NameForEnv(opt.App, opt.Env), sess),
+		},
+		elb: elbv2.New(sess),
+	}, nil
+}
+
+// ListenerRulePriority is a rule of a listener of an environment's load balancer.
+type ListenerRulePriority struct {
+	Listener string   `json:"listener"`
+	Priority string   `json:"priority"`
+	Service  string   `json:"service,omitempty"` // Empty if the rule doesn't forward to a Copilot service.
+	Hosts    []string `json:"hosts,omitempty"`
+	Paths    []string `json:"paths,omitempty"`
+}
+
+// RulePriorityConflict is a rule that never matches any request because a rule of another service
+// with the same conditions has a lower priority number on the same listener.
+type RulePriorityConflict struct {
+	Listener         string   `json:"listener"`
+	Hosts            []string `json:"hosts,omitempty"`
+	Paths            []string `json:"paths,omitempty"`
+	Service          string   `json:"service"`
+	Priority         string   `json:"priority"`
+	ShadowedService  string   `json:"shadowedService"`
+	ShadowedPriority string   `json:"shadowedPriority"`
+}
+
+// String returns a human-readable description of the conflict.
+func (c RulePriorityConflict) String() string {
+	hosts, paths := "any host", "any path"
+	if len(c.Hosts) > 0 {
+		hosts = strings.Join(c.Hosts, ", ")
+	}
+	if len(c.Paths) > 0 {
+		paths = strings.Join(c.Paths, ", ")
+	}
+	return fmt.Sprintf("service %s (priority %s) shadows service %s (priority %s) on the %s listener for %s and %s",
+		c.Service, c.Priority, c.ShadowedService, c.ShadowedPriority, c.Listener, hosts, paths)
+}
+
+// RulePriorities contains the listener rules of the load balancers of an environment, and the conflicts between them.
+type RulePriorities struct {
+	Rules     []ListenerRulePriority `json:"rules"`
+	Conflicts []RulePriorityConflict `json:"conflicts"`
+}
+
+// Describe returns the non-default rules of the listeners of the environment's load balancers,
+// along with the rules of different services that match the same requests.
+func (d *RulePrioritiesDescriber) Describe() (*RulePriorities, error) {
+	outputs, err := d.envOutputs.Outputs()
+	if err != nil {
+		return nil, fmt.Errorf("get stack outputs of environment %s: %w", d.env, err)
+	}
+	out := &RulePriorities{
+		Rules:     []ListenerRulePriority{},
+		Conflicts: []RulePriorityConflict{},
+	}
+	for _, listener := range envListenerOutputs {
+		arn, ok := outputs[listener.key]
+		if !ok {
+			continue
+		}
+		rules, err := d.listenerRules(listener.name, arn)
+		if err != nil {
+			return nil, err
+		}
+		out.Rules = append(out.Rules, rules...)
+		out.Conflicts = append(out.Conflicts, rulePriorityConflicts(rules)...)
+	}
+	return out, nil
+}
+
+func (d *RulePrioritiesDescriber) listenerRules(listenerName, listenerARN string) ([]ListenerRulePriority, error) {
+	rules, err := d.elb.ListenerRules(listenerARN)
+	if err != nil {
+		return nil, fmt.Errorf("get rules of the %s listener: %w", listenerName, err)
+	}
+	var tgARNs []string
+	for _, rule := range rules {
+		tgARNs = append(tgARNs, rule.TargetGroupARNs...)
+	}
+	tags := make(map[string]map[string]string)
+	if len(tgARNs) > 0 {
+		if tags, err = d.elb.ResourceTags(tgARNs); err != nil {
+			return nil, fmt.Errorf("get tags of the target groups of the %s listener: %w", listenerName, err)
+		}
+	}
+	var out []ListenerRulePriority
+	for _, rule := range rules {
+		if rule.IsDefault {
+			continue
+		}
+		var svc string
+		for _, arn := range rule.TargetGroupARNs {
+			if name, ok := tags[arn][deploy.ServiceTagKey]; ok {
+				svc = name
+				break
+			}
+		}
+		out = append(out, ListenerRulePriority{
+			Listener: listenerName,
+			Priority: rule.Priority,
+			Service:  svc,
+			Hosts:    rule.Hosts,
+			Paths:    rule.Paths,
+		})
+	}
+	sort.SliceStable(out, func(i, j int) bool { return priorityNumber(out[i].Priority) < priorityNumber(out[j].Priority) })
+	return out, nil
+}
+
+// rulePriorityConflicts returns the rules of a listener, ordered by priority, that are shadowed by the rule of another service.
+func rulePriorityConflicts(rules []ListenerRulePriority) []RulePriorityConflict {
+	var conflicts []RulePriorityConflict
+	winners := make(map[string]ListenerRulePriority)
+	for _, rule := range rules {
+		if rule.Service == "" {
+			continue
+		}
+		key := strings.Join(rule.Hosts, ",") + "|" + strings.Join(rule.Paths, ",")
+		winner, ok := winners[key]
+		if !ok {
+			winners[key] = rule
+			continue
+		}
+		if winner.Service == rule.Service {
+			continue
+		}
+		conflicts = append(conflicts, RulePriorityConflict{
+			Listener:         rule.Listener,
+			Hosts:            rule.Hosts,
+			Paths:            rule.Paths,
+			Service:          winner.Service,
+			Priority:         winner.Priority,
+			ShadowedService:  rule.Service,
+			ShadowedPriority: rule.Priority,
+		})
+	}
+	return conflicts
+}
+
+func priorityNumber(priority string) int {
+	n, err := strconv.Atoi(priority)
+	if err != nil {
+		return 0
+	}
+	return n
+}
+
+// JSONString returns the stringified RulePriorities struct with json format.
+func (p *RulePriorities) JSONString() (string, error) {
+	b, err := json.Marshal(p)
+	if err != nil {
+		return "", fmt.Errorf("marshal rule priorities: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified RulePriorities struct in human-readable format.
+func (p *RulePriorities) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, statusMinCellWidth, tabWidth, statusCellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprint(writer, color.Bold.Sprint("Listener Rules\n\n"))
+	writer.Flush()
+	if len(p.Rules) == 0 {
+		fmt.Fprintln(writer, "  No listener rules found.")
+		writer.Flush()
+		return b.String()
+	}
+	headers := []string{"Listener", "Priority", "Service", "Hosts", "Paths"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, rule := range p.Rules {
+		fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\t%s\n", rule.Listener, rule.Priority, dashIfEmpty(rule.Service),
+			dashIfEmpty(strings.Join(rule.Hosts, ", ")), dashIfEmpty(strings.Join(rule.Paths, ", ")))
+	}
+	writer.Flush()
+	if len(p.Conflicts) == 0 {
+		return b.String()
+	}
+	fmt.Fprint(writer, color.Bold.Sprint("\nConflicts\n\n"))
+	for _, conflict := range p.Conflicts {
+		fmt.Fprintf(writer, "  - %s\n", conflict)
+	}
+	writer.Flush()
+	return b.String()
+}
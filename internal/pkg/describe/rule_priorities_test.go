@@ -0,0 +1,164 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRulePrioritiesDescriber_Describe(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(outputs *mocks.MockenvOutputsGetter, elb *mocks.MocklistenerRulesGetter)
+
+		wanted    *RulePriorities
+		wantedErr error
+	}{
+		"errors if failed to get the outputs of the environment stack": {
+			setupMocks: func(outputs *mocks.MockenvOutputsGetter, _ *mocks.MocklistenerRulesGetter) {
+				outputs.EXPECT().Outputs().Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get stack outputs of environment test: some error"),
+		},
+		"errors if failed to get the rules of a listener": {
+			setupMocks: func(outputs *mocks.MockenvOutputsGetter, elb *mocks.MocklistenerRulesGetter) {
+				outputs.EXPECT().Outputs().Return(map[string]string{"HTTPListenerArn": "http"}, nil)
+				elb.EXPECT().ListenerRules("http").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get rules of the public HTTP listener: some error"),
+		},
+		"errors if failed to get the tags of the target groups": {
+			setupMocks: func(outputs *mocks.MockenvOutputsGetter, elb *mocks.MocklistenerRulesGetter) {
+				outputs.EXPECT().Outputs().Return(map[string]string{"HTTPListenerArn": "http"}, nil)
+				elb.EXPECT().ListenerRules("http").Return([]elbv2.ListenerRule{{Priority: "1", TargetGroupARNs: []string{"tg"}}}, nil)
+				elb.EXPECT().ResourceTags([]string{"tg"}).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get tags of the target groups of the public HTTP listener: some error"),
+		},
+		"no rules if the environment doesn't have a load balancer": {
+			setupMocks: func(outputs *mocks.MockenvOutputsGetter, _ *mocks.MocklistenerRulesGetter) {
+				outputs.EXPECT().Outputs().Return(map[string]string{"VpcId": "vpc-1234"}, nil)
+			},
+			wanted: &RulePriorities{
+				Rules:     []ListenerRulePriority{},
+				Conflicts: []RulePriorityConflict{},
+			},
+		},
+		"returns the rules by priority and the conflicts between services": {
+			setupMocks: func(outputs *mocks.MockenvOutputsGetter, elb *mocks.MocklistenerRulesGetter) {
+				outputs.EXPECT().Outputs().Return(map[string]string{
+					"HTTPListenerArn":         "http",
+					"InternalHTTPListenerArn": "internal",
+				}, nil)
+				elb.EXPECT().ListenerRules("http").Return([]elbv2.ListenerRule{
+					{Priority: "10", Paths: []string{"/api"}, TargetGroupARNs: []string{"tg-orders"}},
+					{Priority: "2", Paths: []string{"/api"}, TargetGroupARNs: []string{"tg-api"}},
+					{Priority: "3", Paths: []string{"/api"}, TargetGroupARNs: []string{"tg-api-2"}},
+					{Priority: "48000", Paths: []string{"/"}, TargetGroupARNs: []string{"tg-fe"}},
+					{Priority: "default", IsDefault: true},
+				}, nil)
+				elb.EXPECT().ResourceTags([]string{"tg-orders", "tg-api", "tg-api-2", "tg-fe"}).Return(map[string]map[string]string{
+					"tg-orders": {"copilot-service": "orders"},
+					"tg-api":    {"copilot-service": "api"},
+					"tg-api-2":  {"copilot-service": "api"},
+					"tg-fe":     {"copilot-service": "frontend"},
+				}, nil)
+				elb.EXPECT().ListenerRules("internal").Return([]elbv2.ListenerRule{
+					{Priority: "1", Hosts: []string{"example.com"}},
+				}, nil)
+			},
+			wanted: &RulePriorities{
+				Rules: []ListenerRulePriority{
+					{Listener: "public HTTP", Priority: "2", Service: "api", Paths: []string{"/api"}},
+					{Listener: "public HTTP", Priority: "3", Service: "api", Paths: []string{"/api"}},
+					{Listener: "public HTTP", Priority: "10", Service: "orders", Paths: []string{"/api"}},
+					{Listener: "public HTTP", Priority: "48000", Service: "frontend", Paths: []string{"/"}},
+					{Listener: "internal HTTP", Priority: "1", Hosts: []string{"example.com"}},
+				},
+				Conflicts: []RulePriorityConflict{
+					{
+						Listener:         "public HTTP",
+						Paths:            []string{"/api"},
+						Service:          "api",
+						Priority:         "2",
+						ShadowedService:  "orders",
+						ShadowedPriority: "10",
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			outputs := mocks.NewMockenvOutputsGetter(ctrl)
+			elb := mocks.NewMocklistenerRulesGetter(ctrl)
+			tc.setupMocks(outputs, elb)
+			d := &RulePrioritiesDescriber{
+				env:        "test",
+				envOutputs: outputs,
+				elb:        elb,
+			}
+
+			// WHEN
+			got, err := d.Describe()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestRulePriorities_String(t *testing.T) {
+	priorities := &RulePriorities{
+		Rules: []ListenerRulePriority{
+			{Listener: "public HTTPS", Priority: "1", Service: "api", Hosts: []string{"example.com"}, Paths: []string{"/api"}},
+			{Listener: "public HTTPS", Priority: "2", Service: "orders", Hosts: []string{"example.com"}, Paths: []string{"/api"}},
+			{Listener: "public HTTP", Priority: "1", Hosts: []string{"example.com"}},
+		},
+		Conflicts: []RulePriorityConflict{
+			{
+				Listener:         "public HTTPS",
+				Hosts:            []string{"example.com"},
+				Paths:            []string{"/api"},
+				Service:          "api",
+				Priority:         "1",
+				ShadowedService:  "orders",
+				ShadowedPriority: "2",
+			},
+		},
+	}
+	wantedHuman := `Listener Rules
+
+  Listener      Priority    Service     Hosts        Paths
+  --------      --------    -------     -----        -----
+  public HTTPS  1           api         example.com  /api
+  public HTTPS  2           orders      example.com  /api
+  public HTTP   1           -           example.com  -
+
+Conflicts
+
+  - service api (priority 1) shadows service orders (priority 2) on the public HTTPS listener for example.com and /api
+`
+	wantedJSON := `{"rules":[{"listener":"public HTTPS","priority":"1","service":"api","hosts":["example.com"],"paths":["/api"]},{"listener":"public HTTPS","priority":"2","service":"orders","hosts":["example.com"],"paths":["/api"]},{"listener":"public HTTP","priority":"1","hosts":["example.com"]}],"conflicts":[{"listener":"public HTTPS","hosts":["example.com"],"paths":["/api"],"service":"api","priority":"1","shadowedService":"orders","shadowedPriority":"2"}]}
+`
+
+	json, err := priorities.JSONString()
+	require.NoError(t, err)
+	require.Equal(t, wantedJSON, json)
+	require.Equal(t, wantedHuman, priorities.HumanString())
+	require.Equal(t, "Listener Rules\n\n  No listener rules found.\n", (&RulePriorities{}).HumanString())
+}
@@ -0,0 +1,139 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/aas"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+// CapacityRevertActionName is the name of the Application Auto Scaling scheduled action
+// that reverts a manual override of the capacity of a service.
+const CapacityRevertActionName = "copilot-capacity-override-revert"
+
+type ecsServiceCapacityGetter interface {
+	ECSServiceCapacity(cluster, service string) (*aas.Capacity, error)
+	ECSServiceScheduledAction(cluster, service, name string) (*aas.ScheduledAction, error)
+}
+
+// ServiceCapacity contains the auto scaling capacity of a service.
+type ServiceCapacity struct {
+	Service      string          `json:"service"`
+	Environment  string          `json:"environment"`
+	Min          int             `json:"min"`
+	Max          int             `json:"max"`
+	RunningTasks int             `json:"runningTasks"`
+	Revert       *CapacityRevert `json:"revert,omitempty"`
+}
+
+// CapacityRevert contains the capacity a manual override of the capacity of a service reverts to.
+type CapacityRevert struct {
+	Min int       `json:"min"`
+	Max int       `json:"max"`
+	At  time.Time `json:"at"`
+}
+
+// ServiceCapacityDescriber retrieves the auto scaling capacity of a service.
+type ServiceCapacityDescriber struct {
+	app string
+	env string
+	svc string
+
+	svcDescriber   serviceDescriber
+	capacityGetter ecsServiceCapacityGetter
+	now            func() time.Time
+}
+
+// NewServiceCapacityDescriber instantiates a new ServiceCapacityDescriber struct.
+func NewServiceCapacityDescriber(opt *NewServiceStatusConfig) (*ServiceCapacityDescriber, error) {
+	env, err := opt.ConfigStore.GetEnvironment(opt.App, opt.Env)
+	if err != nil {
+		return nil, fmt.Errorf("get environment %s: %w", opt.Env, err)
+	}
+	sess, err := sessions.ImmutableProvider().FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return nil, fmt.Errorf("session for role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
+	}
+	return &ServiceCapacityDescriber{
+		app:            opt.App,
+		env:            opt.Env,
+		svc:            opt.Svc,
+		svcDescriber:   ecs.New(sess),
+		capacityGetter: aas.New(sess),
+		now:            time.Now,
+	}, nil
+}
+
+// Describe returns the auto scaling capacity of the service and the pending revert of its manual override, if any.
+func (d *ServiceCapacityDescriber) Describe() (*ServiceCapacity, error) {
+	svcDesc, err := d.svcDescriber.DescribeService(d.app, d.env, d.svc)
+	if err != nil {
+		return nil, fmt.Errorf("describe service %s: %w", d.svc, err)
+	}
+	capacity, err := d.capacityGetter.ECSServiceCapacity(svcDesc.ClusterName, svcDesc.Name)
+	if err != nil {
+		return nil, fmt.Errorf("get capacity of service %s: %w", d.svc, err)
+	}
+	action, err := d.capacityGetter.ECSServiceScheduledAction(svcDesc.ClusterName, svcDesc.Name, CapacityRevertActionName)
+	if err != nil {
+		return nil, fmt.Errorf("get capacity revert of service %s: %w", d.svc, err)
+	}
+	out := &ServiceCapacity{
+		Service:      d.svc,
+		Environment:  d.env,
+		Min:          capacity.Min,
+		Max:          capacity.Max,
+		RunningTasks: len(svcDesc.Tasks),
+	}
+	// The scheduled action is kept after it runs, so only a revert in the future is pending.
+	if action != nil && action.At.After(d.now()) {
+		out.Revert = &CapacityRevert{
+			Min: action.Capacity.Min,
+			Max: action.Capacity.Max,
+			At:  action.At,
+		}
+	}
+	return out, nil
+}
+
+// JSONString returns the stringified ServiceCapacity struct with json format.
+func (c *ServiceCapacity) JSONString() (string, error) {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return "", fmt.Errorf("marshal service capacity: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified ServiceCapacity struct with human readable format.
+func (c *ServiceCapacity) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprint(writer, color.Bold.Sprint("Capacity\n\n"))
+	writer.Flush()
+	fmt.Fprintf(writer, "  %s\t%d\n", "Minimum Tasks", c.Min)
+	fmt.Fprintf(writer, "  %s\t%d\n", "Maximum Tasks", c.Max)
+	fmt.Fprintf(writer, "  %s\t%d\n", "Running Tasks", c.RunningTasks)
+	writer.Flush()
+	fmt.Fprint(writer, color.Bold.Sprint("\nOverride\n\n"))
+	writer.Flush()
+	if c.Revert == nil {
+		fmt.Fprint(writer, "  No manual override of the capacity is pending a revert.\n")
+		writer.Flush()
+		return b.String()
+	}
+	fmt.Fprintf(writer, "  %s\t%d\n", "Reverts To Minimum", c.Revert.Min)
+	fmt.Fprintf(writer, "  %s\t%d\n", "Reverts To Maximum", c.Revert.Max)
+	fmt.Fprintf(writer, "  %s\t%s\n", "Reverts At", humanizeTime(c.Revert.At))
+	writer.Flush()
+	return b.String()
+}
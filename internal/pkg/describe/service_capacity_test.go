@@ -0,0 +1,210 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/aas"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/dustin/go-humanize"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type serviceCapacityDescriberMocks struct {
+	svcDescriber   *mocks.MockserviceDescriber
+	capacityGetter *mocks.MockecsServiceCapacityGetter
+}
+
+func TestServiceCapacityDescriber_Describe(t *testing.T) {
+	now := time.Date(2024, time.March, 1, 10, 0, 0, 0, time.UTC)
+	svcDesc := &ecs.ServiceDesc{
+		Name:        "phonetool-test-api-Service-abc",
+		ClusterName: "phonetool-test-Cluster-def",
+		Tasks:       []*awsecs.Task{{}, {}, {}},
+	}
+	testCases := map[string]struct {
+		setupMocks func(m serviceCapacityDescriberMocks)
+
+		wanted    *ServiceCapacity
+		wantedErr error
+	}{
+		"error if fail to describe the service": {
+			setupMocks: func(m serviceCapacityDescriberMocks) {
+				m.svcDescriber.EXPECT().DescribeService("phonetool", "test", "api").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("describe service api: some error"),
+		},
+		"error if fail to get the capacity": {
+			setupMocks: func(m serviceCapacityDescriberMocks) {
+				m.svcDescriber.EXPECT().DescribeService("phonetool", "test", "api").Return(svcDesc, nil)
+				m.capacityGetter.EXPECT().ECSServiceCapacity("phonetool-test-Cluster-def", "phonetool-test-api-Service-abc").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get capacity of service api: some error"),
+		},
+		"error if fail to get the revert action": {
+			setupMocks: func(m serviceCapacityDescriberMocks) {
+				m.svcDescriber.EXPECT().DescribeService("phonetool", "test", "api").Return(svcDesc, nil)
+				m.capacityGetter.EXPECT().ECSServiceCapacity(gomock.Any(), gomock.Any()).Return(&aas.Capacity{Min: 1, Max: 10}, nil)
+				m.capacityGetter.EXPECT().ECSServiceScheduledAction("phonetool-test-Cluster-def", "phonetool-test-api-Service-abc", CapacityRevertActionName).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get capacity revert of service api: some error"),
+		},
+		"ignores a revert that already happened": {
+			setupMocks: func(m serviceCapacityDescriberMocks) {
+				m.svcDescriber.EXPECT().DescribeService("phonetool", "test", "api").Return(svcDesc, nil)
+				m.capacityGetter.EXPECT().ECSServiceCapacity(gomock.Any(), gomock.Any()).Return(&aas.Capacity{Min: 1, Max: 10}, nil)
+				m.capacityGetter.EXPECT().ECSServiceScheduledAction(gomock.Any(), gomock.Any(), gomock.Any()).Return(&aas.ScheduledAction{
+					Name:     CapacityRevertActionName,
+					At:       now.Add(-time.Hour),
+					Capacity: aas.Capacity{Min: 1, Max: 10},
+				}, nil)
+			},
+			wanted: &ServiceCapacity{
+				Service:      "api",
+				Environment:  "test",
+				Min:          1,
+				Max:          10,
+				RunningTasks: 3,
+			},
+		},
+		"returns the pending revert of an override": {
+			setupMocks: func(m serviceCapacityDescriberMocks) {
+				m.svcDescriber.EXPECT().DescribeService("phonetool", "test", "api").Return(svcDesc, nil)
+				m.capacityGetter.EXPECT().ECSServiceCapacity(gomock.Any(), gomock.Any()).Return(&aas.Capacity{Min: 5, Max: 20}, nil)
+				m.capacityGetter.EXPECT().ECSServiceScheduledAction(gomock.Any(), gomock.Any(), gomock.Any()).Return(&aas.ScheduledAction{
+					Name:     CapacityRevertActionName,
+					At:       now.Add(time.Hour),
+					Capacity: aas.Capacity{Min: 1, Max: 10},
+				}, nil)
+			},
+			wanted: &ServiceCapacity{
+				Service:      "api",
+				Environment:  "test",
+				Min:          5,
+				Max:          20,
+				RunningTasks: 3,
+				Revert: &CapacityRevert{
+					Min: 1,
+					Max: 10,
+					At:  now.Add(time.Hour),
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := serviceCapacityDescriberMocks{
+				svcDescriber:   mocks.NewMockserviceDescriber(ctrl),
+				capacityGetter: mocks.NewMockecsServiceCapacityGetter(ctrl),
+			}
+			tc.setupMocks(m)
+			d := &ServiceCapacityDescriber{
+				app:            "phonetool",
+				env:            "test",
+				svc:            "api",
+				svcDescriber:   m.svcDescriber,
+				capacityGetter: m.capacityGetter,
+				now: func() time.Time {
+					return now
+				},
+			}
+
+			// WHEN
+			got, err := d.Describe()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestServiceCapacity_String(t *testing.T) {
+	oldHumanize := humanizeTime
+	humanizeTime = func(then time.Time) string {
+		now, _ := time.Parse(time.RFC3339, "2024-03-01T10:00:00+00:00")
+		return humanize.RelTime(then, now, "ago", "from now")
+	}
+	defer func() {
+		humanizeTime = oldHumanize
+	}()
+	revertAt, _ := time.Parse(time.RFC3339, "2024-03-01T12:00:00+00:00")
+	testCases := map[string]struct {
+		in *ServiceCapacity
+
+		wantedHumanString string
+		wantedJSONString  string
+	}{
+		"without an override": {
+			in: &ServiceCapacity{
+				Service:      "api",
+				Environment:  "test",
+				Min:          1,
+				Max:          10,
+				RunningTasks: 3,
+			},
+			wantedHumanString: `Capacity
+
+  Minimum Tasks  1
+  Maximum Tasks  10
+  Running Tasks  3
+
+Override
+
+  No manual override of the capacity is pending a revert.
+`,
+			wantedJSONString: "{\"service\":\"api\",\"environment\":\"test\",\"min\":1,\"max\":10,\"runningTasks\":3}\n",
+		},
+		"with an override pending a revert": {
+			in: &ServiceCapacity{
+				Service:      "api",
+				Environment:  "test",
+				Min:          5,
+				Max:          20,
+				RunningTasks: 5,
+				Revert: &CapacityRevert{
+					Min: 1,
+					Max: 10,
+					At:  revertAt,
+				},
+			},
+			wantedHumanString: `Capacity
+
+  Minimum Tasks  5
+  Maximum Tasks  20
+  Running Tasks  5
+
+Override
+
+  Reverts To Minimum  1
+  Reverts To Maximum  10
+  Reverts At          2 hours from now
+`,
+			wantedJSONString: "{\"service\":\"api\",\"environment\":\"test\",\"min\":5,\"max\":20,\"runningTasks\":5,\"revert\":{\"min\":1,\"max\":10,\"at\":\"2024-03-01T12:00:00Z\"}}\n",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			human := tc.in.HumanString()
+			json, err := tc.in.JSONString()
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedHumanString, human)
+			require.Equal(t, tc.wantedJSONString, json)
+		})
+	}
+}
@@ -0,0 +1,260 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/aas"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	cfnstack "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+// Sources of the events in the timeline of a service.
+const (
+	ServiceEventSourceCloudFormation = "CloudFormation"
+	ServiceEventSourceECS            = "ECS"
+	ServiceEventSourceAlarm          = "CloudWatch"
+)
+
+type stackEventsGetter interface {
+	LatestEvents(stackName string, limit int) ([]cloudformation.StackEvent, error)
+}
+
+type alarmStateChangesGetter interface {
+	AlarmStateChanges(alarmName string, limit int) ([]cloudwatch.AlarmStateChange, error)
+}
+
+// NewServiceEventsConfig contains fields that initiate a ServiceEventsDescriber.
+type NewServiceEventsConfig struct {
+	App          string
+	Env          string
+	Svc          string
+	WorkloadType string // The type of the service, used to know whether it runs on Amazon ECS.
+	Limit        int    // The maximum number of events in the timeline.
+	ConfigStore  ConfigStoreSvc
+}
+
+// ServiceEventsDescriber retrieves the latest events of a service in an environment.
+type ServiceEventsDescriber struct {
+	app   string
+	env   string
+	svc   string
+	limit int
+
+	stackEventsGetter  stackEventsGetter
+	alarmHistoryGetter alarmStateChangesGetter
+	ecs                *ecsStatusDescriber // Nil if the service doesn't run on Amazon ECS.
+}
+
+// NewServiceEventsDescriber instantiates a new ServiceEventsDescriber.
+func NewServiceEventsDescriber(opt *NewServiceEventsConfig) (*ServiceEventsDescriber, error) {
+	env, err := opt.ConfigStore.GetEnvironment(opt.App, opt.Env)
+	if err != nil {
+		return nil, fmt.Errorf("get environment %s: %w", opt.Env, err)
+	}
+	sess, err := sessions.ImmutableProvider().FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return nil, fmt.Errorf("session for role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
+	}
+	cw := cloudwatch.New(sess)
+	d := &ServiceEventsDescriber{
+		app:                opt.App,
+		env:                opt.Env,
+		svc:                opt.Svc,
+		limit:              opt.Limit,
+		stackEventsGetter:  cloudformation.New(sess),
+		alarmHistoryGetter: cw,
+	}
+	if opt.WorkloadType != manifestinfo.RequestDrivenWebServiceType && opt.WorkloadType != manifestinfo.StaticSiteType {
+		d.ecs = &ecsStatusDescriber{
+			app:          opt.App,
+			env:          opt.Env,
+			svc:          opt.Svc,
+			svcDescriber: ecs.New(sess),
+			ecsSvcGetter: awsecs.New(sess),
+			cwSvcGetter:  cw,
+			aasSvcGetter: aas.New(sess),
+		}
+	}
+	return d, nil
+}
+
+// ServiceEvent is an event in the timeline of a service.
+type ServiceEvent struct {
+	Time     time.Time `json:"time"`
+	Source   string    `json:"source"`
+	Resource string    `json:"resource"`
+	Message  string    `json:"message"`
+}
+
+// ServiceEvents is the chronological timeline of the latest events of a service.
+type ServiceEvents struct {
+	Events []ServiceEvent `json:"events"`
+}
+
+// Describe returns the latest stack events, ECS service events and alarm state changes of the service
+// merged in a single chronological timeline.
+func (d *ServiceEventsDescriber) Describe() (HumanJSONStringer, error) {
+	events, err := d.stackEvents()
+	if err != nil {
+		return nil, err
+	}
+	if d.ecs != nil {
+		svcDesc, err := d.ecs.svcDescriber.DescribeService(d.app, d.env, d.svc)
+		if err != nil {
+			return nil, fmt.Errorf("get ECS service description for %s: %w", d.svc, err)
+		}
+		ecsEvents, err := d.ecsServiceEvents(svcDesc.ClusterName, svcDesc.Name)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, ecsEvents...)
+		alarmEvents, err := d.alarmEvents(svcDesc.ClusterName, svcDesc.Name)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, alarmEvents...)
+	}
+	sort.SliceStable(events, func(i, j int) bool { return events[i].Time.Before(events[j].Time) })
+	if len(events) > d.limit {
+		events = events[len(events)-d.limit:]
+	}
+	return &ServiceEvents{
+		Events: events,
+	}, nil
+}
+
+func (d *ServiceEventsDescriber) stackEvents() ([]ServiceEvent, error) {
+	stackName := cfnstack.NameForWorkload(d.app, d.env, d.svc)
+	stackEvents, err := d.stackEventsGetter.LatestEvents(string(stackName), d.limit)
+	if err != nil {
+		return nil, fmt.Errorf("get events of stack %s: %w", stackName, err)
+	}
+	events := make([]ServiceEvent, len(stackEvents))
+	for i, e := range stackEvents {
+		msg := aws.StringValue(e.ResourceStatus)
+		if reason := aws.StringValue(e.ResourceStatusReason); reason != "" {
+			msg = fmt.Sprintf("%s: %s", msg, reason)
+		}
+		events[i] = ServiceEvent{
+			Time:     aws.TimeValue(e.Timestamp),
+			Source:   ServiceEventSourceCloudFormation,
+			Resource: aws.StringValue(e.LogicalResourceId),
+			Message:  msg,
+		}
+	}
+	return events, nil
+}
+
+func (d *ServiceEventsDescriber) ecsServiceEvents(cluster, serviceName string) ([]ServiceEvent, error) {
+	service, err := d.ecs.ecsSvcGetter.Service(cluster, serviceName)
+	if err != nil {
+		return nil, fmt.Errorf("get service %s: %w", serviceName, err)
+	}
+	// ECS returns the service events from the most recent to the oldest.
+	svcEvents := service.Events
+	if len(svcEvents) > d.limit {
+		svcEvents = svcEvents[:d.limit]
+	}
+	events := make([]ServiceEvent, len(svcEvents))
+	for i, e := range svcEvents {
+		events[i] = ServiceEvent{
+			Time:     aws.TimeValue(e.CreatedAt),
+			Source:   ServiceEventSourceECS,
+			Resource: serviceName,
+			Message:  aws.StringValue(e.Message),
+		}
+	}
+	return events, nil
+}
+
+func (d *ServiceEventsDescriber) alarmEvents(cluster, serviceName string) ([]ServiceEvent, error) {
+	alarmNames := make(map[string]struct{})
+	taggedAlarms, err := d.ecs.cwSvcGetter.AlarmsWithTags(map[string]string{
+		deploy.AppTagKey:     d.app,
+		deploy.EnvTagKey:     d.env,
+		deploy.ServiceTagKey: d.svc,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get tagged CloudWatch alarms: %w", err)
+	}
+	autoscalingAlarms, err := d.ecs.ecsServiceAutoscalingAlarms(cluster, serviceName)
+	if err != nil {
+		return nil, err
+	}
+	rollbackAlarms, err := d.ecs.ecsServiceRollbackAlarms(d.app, d.env, d.svc)
+	if err != nil {
+		return nil, err
+	}
+	alarms := append(taggedAlarms, autoscalingAlarms...)
+	for _, alarm := range append(alarms, rollbackAlarms...) {
+		alarmNames[alarm.Name] = struct{}{}
+	}
+	names := make([]string, 0, len(alarmNames))
+	for name := range alarmNames {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var events []ServiceEvent
+	for _, name := range names {
+		changes, err := d.alarmHistoryGetter.AlarmStateChanges(name, d.limit)
+		if err != nil {
+			return nil, fmt.Errorf("get state changes of alarm %s: %w", name, err)
+		}
+		for _, change := range changes {
+			events = append(events, ServiceEvent{
+				Time:     change.Timestamp,
+				Source:   ServiceEventSourceAlarm,
+				Resource: change.AlarmName,
+				Message:  change.Summary,
+			})
+		}
+	}
+	return events, nil
+}
+
+// JSONString returns the stringified ServiceEvents struct with json format.
+func (e *ServiceEvents) JSONString() (string, error) {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return "", fmt.Errorf("marshal service events: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified ServiceEvents struct in human-readable format.
+func (e *ServiceEvents) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, statusMinCellWidth, tabWidth, statusCellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprint(writer, color.Bold.Sprint("Events\n\n"))
+	writer.Flush()
+	if len(e.Events) == 0 {
+		fmt.Fprintln(writer, "  No events found.")
+		writer.Flush()
+		return b.String()
+	}
+	headers := []string{"Time", "Source", "Resource", "Message"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, event := range e.Events {
+		fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\n", event.Time.UTC().Format(time.RFC3339), event.Source, event.Resource, event.Message)
+	}
+	writer.Flush()
+	return b.String()
+}
@@ -0,0 +1,247 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	ecsapi "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type serviceEventsDescriberMocks struct {
+	stackEvents  *mocks.MockstackEventsGetter
+	alarmHistory *mocks.MockalarmStateChangesGetter
+	svcDescriber *mocks.MockserviceDescriber
+	ecsSvcGetter *mocks.MockecsServiceGetter
+	alarms       *mocks.MockalarmStatusGetter
+	aas          *mocks.MockautoscalingAlarmNamesGetter
+}
+
+func TestServiceEventsDescriber_Describe(t *testing.T) {
+	const (
+		mockCluster = "mockCluster"
+		mockService = "mockService"
+	)
+	at := func(minute int) time.Time {
+		return time.Date(2023, time.June, 1, 10, minute, 0, 0, time.UTC)
+	}
+	mockServiceDesc := &ecs.ServiceDesc{
+		ClusterName: mockCluster,
+		Name:        mockService,
+	}
+	testCases := map[string]struct {
+		onECS      bool
+		setupMocks func(m serviceEventsDescriberMocks)
+
+		wantedEvents []ServiceEvent
+		wantedErr    error
+	}{
+		"errors if failed to get the stack events": {
+			setupMocks: func(m serviceEventsDescriberMocks) {
+				m.stackEvents.EXPECT().LatestEvents("mockApp-mockEnv-mockSvc", 3).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get events of stack mockApp-mockEnv-mockSvc: some error"),
+		},
+		"errors if failed to get the ECS service": {
+			onECS: true,
+			setupMocks: func(m serviceEventsDescriberMocks) {
+				m.stackEvents.EXPECT().LatestEvents(gomock.Any(), gomock.Any()).Return(nil, nil)
+				m.svcDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(mockServiceDesc, nil)
+				m.ecsSvcGetter.EXPECT().Service(mockCluster, mockService).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get service mockService: some error"),
+		},
+		"errors if failed to get the state changes of an alarm": {
+			onECS: true,
+			setupMocks: func(m serviceEventsDescriberMocks) {
+				m.stackEvents.EXPECT().LatestEvents(gomock.Any(), gomock.Any()).Return(nil, nil)
+				m.svcDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(mockServiceDesc, nil)
+				m.ecsSvcGetter.EXPECT().Service(mockCluster, mockService).Return(&awsecs.Service{}, nil)
+				m.alarms.EXPECT().AlarmsWithTags(gomock.Any()).Return([]cloudwatch.AlarmStatus{{Name: "mockAlarm"}}, nil)
+				m.aas.EXPECT().ECSServiceAlarmNames(mockCluster, mockService).Return(nil, nil)
+				m.alarms.EXPECT().AlarmStatuses(gomock.Any()).Return(nil, nil)
+				m.alarmHistory.EXPECT().AlarmStateChanges("mockAlarm", 3).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get state changes of alarm mockAlarm: some error"),
+		},
+		"only return the stack events of a service that doesn't run on ECS": {
+			setupMocks: func(m serviceEventsDescriberMocks) {
+				m.stackEvents.EXPECT().LatestEvents("mockApp-mockEnv-mockSvc", 3).Return([]cloudformation.StackEvent{
+					{
+						LogicalResourceId: aws.String("Service"),
+						ResourceStatus:    aws.String("UPDATE_COMPLETE"),
+						Timestamp:         aws.Time(at(1)),
+					},
+				}, nil)
+			},
+			wantedEvents: []ServiceEvent{
+				{
+					Time:     at(1),
+					Source:   "CloudFormation",
+					Resource: "Service",
+					Message:  "UPDATE_COMPLETE",
+				},
+			},
+		},
+		"merge the latest events in a chronological timeline": {
+			onECS: true,
+			setupMocks: func(m serviceEventsDescriberMocks) {
+				m.stackEvents.EXPECT().LatestEvents("mockApp-mockEnv-mockSvc", 3).Return([]cloudformation.StackEvent{
+					{
+						LogicalResourceId:    aws.String("Service"),
+						ResourceStatus:       aws.String("UPDATE_FAILED"),
+						ResourceStatusReason: aws.String("circuit breaker triggered"),
+						Timestamp:            aws.Time(at(5)),
+					},
+				}, nil)
+				m.svcDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(mockServiceDesc, nil)
+				m.ecsSvcGetter.EXPECT().Service(mockCluster, mockService).Return(&awsecs.Service{
+					Events: []*ecsapi.ServiceEvent{
+						{
+							CreatedAt: aws.Time(at(4)),
+							Message:   aws.String("(service mockService) has started 1 tasks"),
+						},
+						{
+							CreatedAt: aws.Time(at(2)),
+							Message:   aws.String("(service mockService) has reached a steady state."),
+						},
+						{
+							CreatedAt: aws.Time(at(0)),
+							Message:   aws.String("(service mockService) has stopped 1 running tasks"),
+						},
+						{
+							CreatedAt: aws.Time(at(0)),
+							Message:   aws.String("(service mockService) was dropped since it's over the limit"),
+						},
+					},
+				}, nil)
+				m.alarms.EXPECT().AlarmsWithTags(map[string]string{
+					"copilot-application": "mockApp",
+					"copilot-environment": "mockEnv",
+					"copilot-service":     "mockSvc",
+				}).Return([]cloudwatch.AlarmStatus{{Name: "mockAlarm"}}, nil)
+				m.aas.EXPECT().ECSServiceAlarmNames(mockCluster, mockService).Return([]string{"mockAlarm"}, nil)
+				m.alarms.EXPECT().AlarmStatuses(gomock.Any()).Return([]cloudwatch.AlarmStatus{{Name: "mockAlarm"}}, nil)
+				m.alarms.EXPECT().AlarmStatuses(gomock.Any()).Return(nil, nil)
+				m.alarmHistory.EXPECT().AlarmStateChanges("mockAlarm", 3).Return([]cloudwatch.AlarmStateChange{
+					{
+						AlarmName: "mockAlarm",
+						Summary:   "Alarm updated from OK to ALARM",
+						Timestamp: at(3),
+					},
+				}, nil)
+			},
+			wantedEvents: []ServiceEvent{
+				{
+					Time:     at(3),
+					Source:   "CloudWatch",
+					Resource: "mockAlarm",
+					Message:  "Alarm updated from OK to ALARM",
+				},
+				{
+					Time:     at(4),
+					Source:   "ECS",
+					Resource: mockService,
+					Message:  "(service mockService) has started 1 tasks",
+				},
+				{
+					Time:     at(5),
+					Source:   "CloudFormation",
+					Resource: "Service",
+					Message:  "UPDATE_FAILED: circuit breaker triggered",
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := serviceEventsDescriberMocks{
+				stackEvents:  mocks.NewMockstackEventsGetter(ctrl),
+				alarmHistory: mocks.NewMockalarmStateChangesGetter(ctrl),
+				svcDescriber: mocks.NewMockserviceDescriber(ctrl),
+				ecsSvcGetter: mocks.NewMockecsServiceGetter(ctrl),
+				alarms:       mocks.NewMockalarmStatusGetter(ctrl),
+				aas:          mocks.NewMockautoscalingAlarmNamesGetter(ctrl),
+			}
+			tc.setupMocks(m)
+			d := &ServiceEventsDescriber{
+				app:                "mockApp",
+				env:                "mockEnv",
+				svc:                "mockSvc",
+				limit:              3,
+				stackEventsGetter:  m.stackEvents,
+				alarmHistoryGetter: m.alarmHistory,
+			}
+			if tc.onECS {
+				d.ecs = &ecsStatusDescriber{
+					app:          "mockApp",
+					env:          "mockEnv",
+					svc:          "mockSvc",
+					svcDescriber: m.svcDescriber,
+					ecsSvcGetter: m.ecsSvcGetter,
+					cwSvcGetter:  m.alarms,
+					aasSvcGetter: m.aas,
+				}
+			}
+
+			// WHEN
+			got, err := d.Describe()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, &ServiceEvents{Events: tc.wantedEvents}, got)
+		})
+	}
+}
+
+func TestServiceEvents_String(t *testing.T) {
+	events := &ServiceEvents{
+		Events: []ServiceEvent{
+			{
+				Time:     time.Date(2023, time.June, 1, 10, 0, 0, 0, time.UTC),
+				Source:   "ECS",
+				Resource: "mockService",
+				Message:  "(service mockService) has reached a steady state.",
+			},
+			{
+				Time:     time.Date(2023, time.June, 1, 10, 5, 0, 0, time.UTC),
+				Source:   "CloudFormation",
+				Resource: "Service",
+				Message:  "UPDATE_FAILED: circuit breaker triggered",
+			},
+		},
+	}
+	wantedHuman := `Events
+
+  Time                  Source          Resource     Message
+  ----                  ------          --------     -------
+  2023-06-01T10:00:00Z  ECS             mockService  (service mockService) has reached a steady state.
+  2023-06-01T10:05:00Z  CloudFormation  Service      UPDATE_FAILED: circuit breaker triggered
+`
+	wantedJSON := `{"events":[{"time":"2023-06-01T10:00:00Z","source":"ECS","resource":"mockService","message":"(service mockService) has reached a steady state."},{"time":"2023-06-01T10:05:00Z","source":"CloudFormation","resource":"Service","message":"UPDATE_FAILED: circuit breaker triggered"}]}
+`
+
+	json, err := events.JSONString()
+	require.NoError(t, err)
+	require.Equal(t, wantedJSON, json)
+	require.Equal(t, wantedHuman, events.HumanString())
+	require.Equal(t, "Events\n\n  No events found.\n", (&ServiceEvents{}).HumanString())
+}
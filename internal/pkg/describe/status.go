@@ -41,11 +41,12 @@ var (
 
 // ecsServiceStatus contains the status for an ECS service.
 type ecsServiceStatus struct {
-	Service                  awsecs.ServiceStatus
+	Service                  awsecs.ServiceStatus     `json:"service"`
 	DesiredRunningTasks      []awsecs.TaskStatus      `json:"tasks"`
 	Alarms                   []cloudwatch.AlarmStatus `json:"alarms"`
 	StoppedTasks             []awsecs.TaskStatus      `json:"stoppedTasks"`
 	TargetHealthDescriptions []taskTargetHealth       `json:"targetHealthDescriptions"`
+	DeadLetterQueues         []*DeadLetterQueue       `json:"deadLetterQueues,omitempty"`
 }
 
 // appRunnerServiceStatus contains the status for an App Runner service.
@@ -143,6 +144,13 @@ func (s *ecsServiceStatus) HumanString() string {
 		s.writeAlarms(writer)
 		writer.Flush()
 	}
+
+	if len(s.DeadLetterQueues) > 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nDead-Letter Queues\n\n"))
+		writer.Flush()
+		s.writeDeadLetterQueues(writer)
+		writer.Flush()
+	}
 	return b.String()
 }
 
@@ -452,6 +460,15 @@ func (s *ecsServiceStatus) writeAlarms(writer io.Writer) {
 	}
 }
 
+func (s *ecsServiceStatus) writeDeadLetterQueues(writer io.Writer) {
+	headers := []string{"Name", "Messages"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, queue := range s.DeadLetterQueues {
+		fmt.Fprintf(writer, "  %s\t%d\n", queue.Name, queue.Messages)
+	}
+}
+
 type ecsTaskStatus awsecs.TaskStatus
 
 // Example output:
@@ -63,6 +63,7 @@ type ecsStatusDescriber struct {
 	cwSvcGetter        alarmStatusGetter
 	aasSvcGetter       autoscalingAlarmNamesGetter
 	targetHealthGetter targetHealthGetter
+	dlqDescriber       *WorkerQueuesDescriber // Nil if the service is not a worker service.
 }
 
 type appRunnerStatusDescriber struct {
@@ -112,6 +113,20 @@ func NewECSStatusDescriber(opt *NewServiceStatusConfig) (*ecsStatusDescriber, er
 	}, nil
 }
 
+// NewWorkerStatusDescriber instantiates a new ecsStatusDescriber struct that also describes the dead-letter queues of a worker service.
+func NewWorkerStatusDescriber(opt *NewServiceStatusConfig) (*ecsStatusDescriber, error) {
+	describer, err := NewECSStatusDescriber(opt)
+	if err != nil {
+		return nil, err
+	}
+	dlqDescriber, err := NewWorkerQueuesDescriber(opt)
+	if err != nil {
+		return nil, err
+	}
+	describer.dlqDescriber = dlqDescriber
+	return describer, nil
+}
+
 // NewAppRunnerStatusDescriber instantiates a new appRunnerStatusDescriber struct.
 func NewAppRunnerStatusDescriber(opt *NewServiceStatusConfig) (*appRunnerStatusDescriber, error) {
 	appRunnerSvcDescriber, err := newAppRunnerServiceDescriber(NewServiceConfig{
@@ -233,6 +248,13 @@ func (s *ecsStatusDescriber) Describe() (HumanJSONStringer, error) {
 		}
 		return tasksTargetHealth[i].TargetGroupARN < tasksTargetHealth[j].TargetGroupARN
 	})
+	var deadLetterQueues []*DeadLetterQueue
+	if s.dlqDescriber != nil {
+		deadLetterQueues, err = s.dlqDescriber.DeadLetterQueues()
+		if err != nil {
+			return nil, fmt.Errorf("get dead-letter queues: %w", err)
+		}
+	}
 
 	return &ecsServiceStatus{
 		Service:                  service.ServiceStatus(),
@@ -240,6 +262,7 @@ func (s *ecsStatusDescriber) Describe() (HumanJSONStringer, error) {
 		Alarms:                   alarmList,
 		StoppedTasks:             stoppedTaskStatus,
 		TargetHealthDescriptions: tasksTargetHealth,
+		DeadLetterQueues:         deadLetterQueues,
 	}, nil
 }
 
@@ -17,7 +17,9 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sqs"
 	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
 	"github.com/aws/copilot-cli/internal/pkg/ecs"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -992,3 +994,95 @@ func Test_targetHealthForTasks(t *testing.T) {
 		})
 	}
 }
+
+func TestECSStatusDescriber_DescribeDeadLetterQueues(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m workerQueuesDescriberMocks)
+
+		wantedQueues []*DeadLetterQueue
+		wantedError  error
+	}{
+		"errors if failed to get the dead-letter queues": {
+			setupMocks: func(m workerQueuesDescriberMocks) {
+				m.stack.EXPECT().StackResources().Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get dead-letter queues: get stack resources of service mockSvc: some error"),
+		},
+		"success": {
+			setupMocks: func(m workerQueuesDescriberMocks) {
+				m.stack.EXPECT().StackResources().Return([]*stack.Resource{
+					{
+						Type:       "AWS::SQS::Queue",
+						LogicalID:  "DeadLetterQueue",
+						PhysicalID: "https://sqs.us-west-2.amazonaws.com/123456789012/phonetool-test-worker-DeadLetterQueue-1A2B3C",
+					},
+				}, nil)
+				m.sqs.EXPECT().Queue(gomock.Any()).Return(&sqs.Queue{
+					URL:      "https://sqs.us-west-2.amazonaws.com/123456789012/phonetool-test-worker-DeadLetterQueue-1A2B3C",
+					ARN:      "arn:aws:sqs:us-west-2:123456789012:phonetool-test-worker-DeadLetterQueue-1A2B3C",
+					Messages: 12,
+				}, nil)
+			},
+			wantedQueues: []*DeadLetterQueue{
+				{
+					Name:     "phonetool-test-worker-DeadLetterQueue-1A2B3C",
+					URL:      "https://sqs.us-west-2.amazonaws.com/123456789012/phonetool-test-worker-DeadLetterQueue-1A2B3C",
+					ARN:      "arn:aws:sqs:us-west-2:123456789012:phonetool-test-worker-DeadLetterQueue-1A2B3C",
+					Messages: 12,
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockecsSvc := mocks.NewMockecsServiceGetter(ctrl)
+			mockcwSvc := mocks.NewMockalarmStatusGetter(ctrl)
+			mockSvcDescriber := mocks.NewMockserviceDescriber(ctrl)
+			mockaasClient := mocks.NewMockautoscalingAlarmNamesGetter(ctrl)
+			m := workerQueuesDescriberMocks{
+				stack: mocks.NewMockstackResourcesGetter(ctrl),
+				sqs:   mocks.NewMockqueueDescriber(ctrl),
+			}
+			mockSvcDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(&ecs.ServiceDesc{
+				ClusterName: "mockCluster",
+				Name:        "mockService",
+			}, nil)
+			mockecsSvc.EXPECT().Service("mockCluster", "mockService").Return(&awsecs.Service{
+				Deployments: []*ecsapi.Deployment{{}},
+			}, nil)
+			mockcwSvc.EXPECT().AlarmsWithTags(gomock.Any()).Return(nil, nil)
+			mockaasClient.EXPECT().ECSServiceAlarmNames("mockCluster", "mockService").Return(nil, nil)
+			mockcwSvc.EXPECT().AlarmStatuses(gomock.Any()).Return(nil, nil)
+			tc.setupMocks(m)
+
+			svcStatus := &ecsStatusDescriber{
+				svc:          "mockSvc",
+				env:          "mockEnv",
+				app:          "mockApp",
+				cwSvcGetter:  mockcwSvc,
+				ecsSvcGetter: mockecsSvc,
+				svcDescriber: mockSvcDescriber,
+				aasSvcGetter: mockaasClient,
+				dlqDescriber: &WorkerQueuesDescriber{
+					svc:   "mockSvc",
+					stack: m.stack,
+					sqs:   m.sqs,
+				},
+			}
+
+			// WHEN
+			statusDesc, err := svcStatus.Describe()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedQueues, statusDesc.(*ecsServiceStatus).DeadLetterQueues)
+		})
+	}
+}
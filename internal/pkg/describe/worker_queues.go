@@ -0,0 +1,89 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/sqs"
+	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
+)
+
+const (
+	sqsQueueResourceType           = "AWS::SQS::Queue"
+	deadLetterQueueLogicalIDSuffix = "DeadLetterQueue"
+)
+
+type stackResourcesGetter interface {
+	StackResources() ([]*stack.Resource, error)
+}
+
+type queueDescriber interface {
+	Queue(url string) (*sqs.Queue, error)
+}
+
+// DeadLetterQueue is a dead-letter queue created by Copilot for a worker service.
+type DeadLetterQueue struct {
+	Name     string `json:"name"`
+	URL      string `json:"url"`
+	ARN      string `json:"arn"`
+	Messages int    `json:"messages"` // Approximate number of messages in the queue.
+}
+
+// WorkerQueuesDescriber retrieves the queues of a worker service.
+type WorkerQueuesDescriber struct {
+	svc string
+
+	stack stackResourcesGetter
+	sqs   queueDescriber
+}
+
+// NewWorkerQueuesDescriber instantiates a new WorkerQueuesDescriber struct.
+func NewWorkerQueuesDescriber(opt *NewServiceStatusConfig) (*WorkerQueuesDescriber, error) {
+	stackDescriber, err := NewWorkloadStackDescriber(NewWorkloadConfig{
+		App:         opt.App,
+		Env:         opt.Env,
+		Name:        opt.Svc,
+		ConfigStore: opt.ConfigStore,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &WorkerQueuesDescriber{
+		svc:   opt.Svc,
+		stack: stackDescriber,
+		sqs:   sqs.New(stackDescriber.sess),
+	}, nil
+}
+
+// DeadLetterQueues returns the dead-letter queues of the worker service and their approximate number of messages.
+func (d *WorkerQueuesDescriber) DeadLetterQueues() ([]*DeadLetterQueue, error) {
+	resources, err := d.stack.StackResources()
+	if err != nil {
+		return nil, fmt.Errorf("get stack resources of service %s: %w", d.svc, err)
+	}
+	var queues []*DeadLetterQueue
+	for _, resource := range resources {
+		if resource.Type != sqsQueueResourceType || !strings.HasSuffix(resource.LogicalID, deadLetterQueueLogicalIDSuffix) {
+			continue
+		}
+		queue, err := d.sqs.Queue(resource.PhysicalID)
+		if err != nil {
+			return nil, err
+		}
+		queues = append(queues, &DeadLetterQueue{
+			Name:     queueNameFromURL(queue.URL),
+			URL:      queue.URL,
+			ARN:      queue.ARN,
+			Messages: queue.Messages,
+		})
+	}
+	return queues, nil
+}
+
+// queueNameFromURL returns the name of a queue from its URL, such as "https://sqs.us-west-2.amazonaws.com/123456789012/name".
+func queueNameFromURL(url string) string {
+	return url[strings.LastIndex(url, "/")+1:]
+}
@@ -0,0 +1,140 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/sqs"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type workerQueuesDescriberMocks struct {
+	stack *mocks.MockstackResourcesGetter
+	sqs   *mocks.MockqueueDescriber
+}
+
+func TestWorkerQueuesDescriber_DeadLetterQueues(t *testing.T) {
+	const (
+		mockDLQURL      = "https://sqs.us-west-2.amazonaws.com/123456789012/phonetool-test-worker-DeadLetterQueue-1A2B3C"
+		mockTopicDLQURL = "https://sqs.us-west-2.amazonaws.com/123456789012/phonetool-test-worker-apiordersDeadLetterQueue-4D5E6F"
+	)
+	testCases := map[string]struct {
+		setupMocks func(m workerQueuesDescriberMocks)
+
+		wanted    []*DeadLetterQueue
+		wantedErr error
+	}{
+		"error if fail to get the stack resources": {
+			setupMocks: func(m workerQueuesDescriberMocks) {
+				m.stack.EXPECT().StackResources().Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get stack resources of service worker: some error"),
+		},
+		"error if fail to describe a queue": {
+			setupMocks: func(m workerQueuesDescriberMocks) {
+				m.stack.EXPECT().StackResources().Return([]*stack.Resource{
+					{
+						Type:       "AWS::SQS::Queue",
+						LogicalID:  "DeadLetterQueue",
+						PhysicalID: mockDLQURL,
+					},
+				}, nil)
+				m.sqs.EXPECT().Queue(mockDLQURL).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"no dead-letter queues": {
+			setupMocks: func(m workerQueuesDescriberMocks) {
+				m.stack.EXPECT().StackResources().Return([]*stack.Resource{
+					{
+						Type:       "AWS::SQS::Queue",
+						LogicalID:  "EventsQueue",
+						PhysicalID: "https://sqs.us-west-2.amazonaws.com/123456789012/phonetool-test-worker-EventsQueue-7G8H9I",
+					},
+				}, nil)
+			},
+		},
+		"returns the dead-letter queues of the default and topic-specific queues": {
+			setupMocks: func(m workerQueuesDescriberMocks) {
+				m.stack.EXPECT().StackResources().Return([]*stack.Resource{
+					{
+						Type:       "AWS::SQS::Queue",
+						LogicalID:  "EventsQueue",
+						PhysicalID: "https://sqs.us-west-2.amazonaws.com/123456789012/phonetool-test-worker-EventsQueue-7G8H9I",
+					},
+					{
+						Type:       "AWS::SQS::Queue",
+						LogicalID:  "DeadLetterQueue",
+						PhysicalID: mockDLQURL,
+					},
+					{
+						Type:       "AWS::SQS::QueuePolicy",
+						LogicalID:  "DeadLetterPolicy",
+						PhysicalID: "mockPolicy",
+					},
+					{
+						Type:       "AWS::SQS::Queue",
+						LogicalID:  "apiordersDeadLetterQueue",
+						PhysicalID: mockTopicDLQURL,
+					},
+				}, nil)
+				m.sqs.EXPECT().Queue(mockDLQURL).Return(&sqs.Queue{
+					URL:      mockDLQURL,
+					ARN:      "arn:aws:sqs:us-west-2:123456789012:phonetool-test-worker-DeadLetterQueue-1A2B3C",
+					Messages: 3,
+				}, nil)
+				m.sqs.EXPECT().Queue(mockTopicDLQURL).Return(&sqs.Queue{
+					URL: mockTopicDLQURL,
+					ARN: "arn:aws:sqs:us-west-2:123456789012:phonetool-test-worker-apiordersDeadLetterQueue-4D5E6F",
+				}, nil)
+			},
+			wanted: []*DeadLetterQueue{
+				{
+					Name:     "phonetool-test-worker-DeadLetterQueue-1A2B3C",
+					URL:      mockDLQURL,
+					ARN:      "arn:aws:sqs:us-west-2:123456789012:phonetool-test-worker-DeadLetterQueue-1A2B3C",
+					Messages: 3,
+				},
+				{
+					Name: "phonetool-test-worker-apiordersDeadLetterQueue-4D5E6F",
+					URL:  mockTopicDLQURL,
+					ARN:  "arn:aws:sqs:us-west-2:123456789012:phonetool-test-worker-apiordersDeadLetterQueue-4D5E6F",
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := workerQueuesDescriberMocks{
+				stack: mocks.NewMockstackResourcesGetter(ctrl),
+				sqs:   mocks.NewMockqueueDescriber(ctrl),
+			}
+			tc.setupMocks(m)
+			d := &WorkerQueuesDescriber{
+				svc:   "worker",
+				stack: m.stack,
+				sqs:   m.sqs,
+			}
+
+			// WHEN
+			got, err := d.DeadLetterQueues()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
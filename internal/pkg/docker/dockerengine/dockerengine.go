@@ -44,8 +44,14 @@ const (
 
 const (
 	credStoreECRLogin = "ecr-login" // set on `credStore` attribute in docker configuration file
+
+	cacheExportBuilderName = "copilot-cache" // buildx builder used to export build caches.
 )
 
+// cacheExportBuilderMu serializes the creation of the cache export builder,
+// so that images built in parallel share the same builder and its cache.
+var cacheExportBuilderMu sync.Mutex
+
 // Health states of a Container.
 const (
 	noHealthcheck = "none"      // Indicates there is no healthcheck
@@ -87,6 +93,7 @@ type BuildArguments struct {
 	Context           string            // Optional. Build context directory to pass to `docker build`.
 	Target            string            // Optional. The target build stage to pass to `docker build`.
 	CacheFrom         []string          // Optional. Images to consider as cache sources to pass to `docker build`
+	CacheTo           []string          // Optional. Cache export destinations. If set, the image is built with `docker buildx build`.
 	Platform          string            // Optional. OS/Arch to pass to `docker build`.
 	Args              map[string]string // Optional. Build args to pass via `--build-arg` flags. Equivalent to ARG directives in dockerfile.
 	Labels            map[string]string // Required. Set metadata for an image.
@@ -104,6 +111,7 @@ type RunOptions struct {
 	LogOptions           RunLogOptions     // Optional. Configure logging for output from the container
 	AddLinuxCapabilities []string          // Optional. Adds linux capabilities to the container.
 	Init                 bool              // Optional. Adds an init process as an entrypoint.
+	Hosts                map[string]string // Optional. Maps hostnames to IP addresses in the container's /etc/hosts.
 }
 
 // RunLogOptions holds the logging configuration for Run().
@@ -129,6 +137,11 @@ func (in *BuildArguments) GenerateDockerBuildArgs(c DockerCmdClient) ([]string,
 	}
 
 	args := []string{"build"}
+	if len(in.CacheTo) > 0 {
+		// Exporting the cache requires a builder with a driver other than the default "docker" one.
+		// Load the image in the local image store so that it can be pushed afterwards.
+		args = []string{"buildx", "build", "--builder", cacheExportBuilderName, "--load"}
+	}
 
 	// Add additional image tags to the docker build call.
 	for _, tag := range in.Tags {
@@ -140,6 +153,11 @@ func (in *BuildArguments) GenerateDockerBuildArgs(c DockerCmdClient) ([]string,
 		args = append(args, "--cache-from", imageFrom)
 	}
 
+	// Add cache to options.
+	for _, cacheTo := range in.CacheTo {
+		args = append(args, "--cache-to", ecrCacheExport(cacheTo))
+	}
+
 	// Add target option.
 	if in.Target != "" {
 		args = append(args, "--target", in.Target)
@@ -196,6 +214,11 @@ func (c DockerCmdClient) Build(ctx context.Context, in *BuildArguments, w io.Wri
 	if err != nil {
 		return fmt.Errorf("generate docker build args: %w", err)
 	}
+	if len(in.CacheTo) > 0 {
+		if err := c.ensureCacheExportBuilder(ctx); err != nil {
+			return err
+		}
+	}
 	opts := []exec.CmdOption{
 		exec.Stdout(w),
 		exec.Stderr(w),
@@ -209,6 +232,47 @@ func (c DockerCmdClient) Build(ctx context.Context, in *BuildArguments, w io.Wri
 	return nil
 }
 
+// ecrCacheExport returns the cache export destination with the options that Amazon ECR requires
+// to store a registry cache, "image-manifest=true" and "oci-mediatypes=true", if it exports to an ECR repository.
+// Other destinations are returned unchanged.
+func ecrCacheExport(cacheTo string) string {
+	opts := strings.Split(cacheTo, ",")
+	var isRegistry, isECR bool
+	set := make(map[string]bool)
+	for _, opt := range opts {
+		key, value, _ := strings.Cut(opt, "=")
+		switch key {
+		case "type":
+			isRegistry = value == "registry"
+		case "ref":
+			isECR = strings.Contains(value, ".dkr.ecr.")
+		}
+		set[key] = true
+	}
+	if !isRegistry || !isECR {
+		return cacheTo
+	}
+	for _, opt := range []string{"image-manifest", "oci-mediatypes"} {
+		if !set[opt] {
+			opts = append(opts, opt+"=true")
+		}
+	}
+	return strings.Join(opts, ",")
+}
+
+// ensureCacheExportBuilder creates the buildx builder used to export build caches if it doesn't exist yet.
+func (c DockerCmdClient) ensureCacheExportBuilder(ctx context.Context) error {
+	cacheExportBuilderMu.Lock()
+	defer cacheExportBuilderMu.Unlock()
+	if err := c.runner.RunWithContext(ctx, "docker", []string{"buildx", "inspect", cacheExportBuilderName}, exec.Stdout(io.Discard), exec.Stderr(io.Discard)); err == nil {
+		return nil
+	}
+	if err := c.runner.RunWithContext(ctx, "docker", []string{"buildx", "create", "--name", cacheExportBuilderName, "--driver", "docker-container"}, exec.Stdout(io.Discard), exec.Stderr(os.Stderr)); err != nil {
+		return fmt.Errorf("create buildx builder %s: %w", cacheExportBuilderName, err)
+	}
+	return nil
+}
+
 // Login will run a `docker login` command against the Service repository URI with the input uri and auth data.
 func (c DockerCmdClient) Login(uri, username, password string) error {
 	err := c.runner.Run("docker",
@@ -294,6 +358,10 @@ func (in *RunOptions) generateRunArguments() []string {
 		args = append(args, "--init")
 	}
 
+	for host, ip := range in.Hosts {
+		args = append(args, "--add-host", fmt.Sprintf("%s:%s", host, ip))
+	}
+
 	args = append(args, in.ImageURI)
 
 	if in.Command != nil && len(in.Command) > 0 {
@@ -526,6 +594,25 @@ func (c DockerCmdClient) GetPlatform() (os, arch string, err error) {
 	return platform.OS, platform.Arch, nil
 }
 
+// ImageDigest returns the digest of the manifest that the image reference currently points to in its registry.
+// For multi-platform images, this is the digest of the image index.
+func (c DockerCmdClient) ImageDigest(ctx context.Context, ref string) (string, error) {
+	buf := &bytes.Buffer{}
+	if err := c.runner.RunWithContext(ctx, "docker", []string{"buildx", "imagetools", "inspect", ref, "--format", "{{json .Manifest}}"}, exec.Stdout(buf)); err != nil {
+		return "", fmt.Errorf("inspect image %s: %w", ref, err)
+	}
+	var manifest struct {
+		Digest string `json:"digest"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &manifest); err != nil {
+		return "", fmt.Errorf("unmarshal manifest of image %s: %w", ref, err)
+	}
+	if manifest.Digest == "" {
+		return "", fmt.Errorf("no digest found for image %s", ref)
+	}
+	return manifest.Digest, nil
+}
+
 func imageName(uri, tag string) string {
 	return fmt.Sprintf("%s:%s", uri, tag)
 }
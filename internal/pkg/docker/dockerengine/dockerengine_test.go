@@ -45,6 +45,7 @@ func TestDockerCommand_Build(t *testing.T) {
 		args              map[string]string
 		target            string
 		cacheFrom         []string
+		cacheTo           []string
 		envVars           map[string]string
 		labels            map[string]string
 		setupMocks        func(controller *gomock.Controller)
@@ -193,6 +194,68 @@ func TestDockerCommand_Build(t *testing.T) {
 					"-f", "mockPath/to/mockDockerfile"}, gomock.Any(), gomock.Any()).Return(nil)
 			},
 		},
+		"builds with buildx to export the cache": {
+			path:      mockPath,
+			tags:      []string{"latest"},
+			cacheFrom: []string{"type=registry,ref=mockURI:cache"},
+			cacheTo:   []string{"type=registry,ref=mockURI:cache,mode=max,image-manifest=true,oci-mediatypes=true"},
+			setupMocks: func(c *gomock.Controller) {
+				mockCmd = NewMockCmd(c)
+				mockCmd.EXPECT().RunWithContext(ctx, "docker", []string{"buildx", "inspect", "copilot-cache"}, gomock.Any(), gomock.Any()).Return(nil)
+				mockCmd.EXPECT().RunWithContext(ctx, "docker", []string{"buildx", "build", "--builder", "copilot-cache", "--load",
+					"-t", fmt.Sprintf("%s:%s", mockURI, "latest"),
+					"--cache-from", "type=registry,ref=mockURI:cache",
+					"--cache-to", "type=registry,ref=mockURI:cache,mode=max,image-manifest=true,oci-mediatypes=true",
+					filepath.FromSlash("mockPath/to"),
+					"-f", "mockPath/to/mockDockerfile"}, gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		"adds the options required by ECR to export the cache to an ECR repository": {
+			path:      mockPath,
+			tags:      []string{"latest"},
+			cacheFrom: []string{"type=registry,ref=123456789012.dkr.ecr.us-west-2.amazonaws.com/app/svc:cache"},
+			cacheTo: []string{
+				"type=registry,ref=123456789012.dkr.ecr.us-west-2.amazonaws.com/app/svc:cache,mode=max",
+				"type=registry,ref=123456789012.dkr.ecr.us-west-2.amazonaws.com/app/svc:other,oci-mediatypes=false",
+			},
+			setupMocks: func(c *gomock.Controller) {
+				mockCmd = NewMockCmd(c)
+				mockCmd.EXPECT().RunWithContext(ctx, "docker", []string{"buildx", "inspect", "copilot-cache"}, gomock.Any(), gomock.Any()).Return(nil)
+				mockCmd.EXPECT().RunWithContext(ctx, "docker", []string{"buildx", "build", "--builder", "copilot-cache", "--load",
+					"-t", fmt.Sprintf("%s:%s", mockURI, "latest"),
+					"--cache-from", "type=registry,ref=123456789012.dkr.ecr.us-west-2.amazonaws.com/app/svc:cache",
+					"--cache-to", "type=registry,ref=123456789012.dkr.ecr.us-west-2.amazonaws.com/app/svc:cache,mode=max,image-manifest=true,oci-mediatypes=true",
+					"--cache-to", "type=registry,ref=123456789012.dkr.ecr.us-west-2.amazonaws.com/app/svc:other,oci-mediatypes=false,image-manifest=true",
+					filepath.FromSlash("mockPath/to"),
+					"-f", "mockPath/to/mockDockerfile"}, gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		"creates the builder to export the cache if it does not exist": {
+			path:    mockPath,
+			tags:    []string{"latest"},
+			cacheTo: []string{"type=inline"},
+			setupMocks: func(c *gomock.Controller) {
+				mockCmd = NewMockCmd(c)
+				mockCmd.EXPECT().RunWithContext(ctx, "docker", []string{"buildx", "inspect", "copilot-cache"}, gomock.Any(), gomock.Any()).Return(mockError)
+				mockCmd.EXPECT().RunWithContext(ctx, "docker", []string{"buildx", "create", "--name", "copilot-cache", "--driver", "docker-container"}, gomock.Any(), gomock.Any()).Return(nil)
+				mockCmd.EXPECT().RunWithContext(ctx, "docker", []string{"buildx", "build", "--builder", "copilot-cache", "--load",
+					"-t", fmt.Sprintf("%s:%s", mockURI, "latest"),
+					"--cache-to", "type=inline",
+					filepath.FromSlash("mockPath/to"),
+					"-f", "mockPath/to/mockDockerfile"}, gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		"should error if the builder to export the cache cannot be created": {
+			path:    mockPath,
+			tags:    []string{"latest"},
+			cacheTo: []string{"type=inline"},
+			setupMocks: func(c *gomock.Controller) {
+				mockCmd = NewMockCmd(c)
+				mockCmd.EXPECT().RunWithContext(ctx, "docker", []string{"buildx", "inspect", "copilot-cache"}, gomock.Any(), gomock.Any()).Return(mockError)
+				mockCmd.EXPECT().RunWithContext(ctx, "docker", []string{"buildx", "create", "--name", "copilot-cache", "--driver", "docker-container"}, gomock.Any(), gomock.Any()).Return(mockError)
+			},
+			wantedError: fmt.Errorf("create buildx builder copilot-cache: mockError"),
+		},
 		"success with dockerfile content": {
 			dockerfileContent: "FROM scratch",
 			tags:              []string{"latest"},
@@ -226,6 +289,7 @@ func TestDockerCommand_Build(t *testing.T) {
 				Args:              tc.args,
 				Target:            tc.target,
 				CacheFrom:         tc.cacheFrom,
+				CacheTo:           tc.cacheTo,
 				Tags:              tc.tags,
 				Labels:            tc.labels,
 			}
@@ -669,6 +733,7 @@ func TestDockerCommand_Run(t *testing.T) {
 		ports            map[string]string
 		command          []string
 		containerNetwork string
+		hosts            map[string]string
 		logPrefix        string
 		setupMocks       func(controller *gomock.Controller)
 
@@ -726,6 +791,24 @@ func TestDockerCommand_Run(t *testing.T) {
 					"sleep", "infinity"}), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 			},
 		},
+		"success with run options for extra hosts": {
+			containerName: mockPauseContainer,
+			command:       mockCommand,
+			uri:           mockImageURI,
+			hosts: map[string]string{
+				"back-end":                  "127.0.0.1",
+				"back-end.test.myapp.local": "127.0.0.1",
+			},
+			setupMocks: func(controller *gomock.Controller) {
+				mockCmd = NewMockCmd(controller)
+				mockCmd.EXPECT().RunWithContext(gomock.Any(), "docker", gomock.InAnyOrder([]string{"run",
+					"--name", mockPauseContainer,
+					"--add-host", "back-end:127.0.0.1",
+					"--add-host", "back-end.test.myapp.local:127.0.0.1",
+					mockImageURI,
+					"sleep", "infinity"}), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
 		"success with run options for service containers": {
 			containerName:    mockContainerName,
 			containerNetwork: mockPauseContainer,
@@ -803,6 +886,7 @@ func TestDockerCommand_Run(t *testing.T) {
 				ContainerNetwork: tc.containerNetwork,
 				Command:          tc.command,
 				ContainerPorts:   tc.ports,
+				Hosts:            tc.hosts,
 				LogOptions: RunLogOptions{
 					LinePrefix: tc.logPrefix,
 					Output:     out,
@@ -912,6 +996,81 @@ func TestDockerCommand_IsContainerRunning(t *testing.T) {
 	}
 }
 
+func TestDockerCommand_ImageDigest(t *testing.T) {
+	mockRef := "public.ecr.aws/nginx/nginx:1.25"
+	mockArgs := []string{"buildx", "imagetools", "inspect", mockRef, "--format", "{{json .Manifest}}"}
+	writeStdout := func(out string) func(ctx context.Context, name string, args []string, opts ...exec.CmdOption) error {
+		return func(ctx context.Context, name string, args []string, opts ...exec.CmdOption) error {
+			cmd := &osexec.Cmd{}
+			for _, opt := range opts {
+				opt(cmd)
+			}
+			cmd.Stdout.Write([]byte(out))
+			return nil
+		}
+	}
+	tests := map[string]struct {
+		setupMocks func(controller *gomock.Controller) *MockCmd
+
+		wantedDigest string
+		wantedErr    string
+	}{
+		"error running docker buildx imagetools inspect": {
+			setupMocks: func(controller *gomock.Controller) *MockCmd {
+				mockCmd := NewMockCmd(controller)
+				mockCmd.EXPECT().RunWithContext(gomock.Any(), "docker", mockArgs, gomock.Any()).Return(errors.New("some error"))
+				return mockCmd
+			},
+			wantedErr: "inspect image public.ecr.aws/nginx/nginx:1.25: some error",
+		},
+		"error if the output is not valid json": {
+			setupMocks: func(controller *gomock.Controller) *MockCmd {
+				mockCmd := NewMockCmd(controller)
+				mockCmd.EXPECT().RunWithContext(gomock.Any(), "docker", mockArgs, gomock.Any()).DoAndReturn(writeStdout("not json"))
+				return mockCmd
+			},
+			wantedErr: "unmarshal manifest of image public.ecr.aws/nginx/nginx:1.25: invalid character 'o' in literal null (expecting 'u')",
+		},
+		"error if the manifest has no digest": {
+			setupMocks: func(controller *gomock.Controller) *MockCmd {
+				mockCmd := NewMockCmd(controller)
+				mockCmd.EXPECT().RunWithContext(gomock.Any(), "docker", mockArgs, gomock.Any()).DoAndReturn(writeStdout(`{}`))
+				return mockCmd
+			},
+			wantedErr: "no digest found for image public.ecr.aws/nginx/nginx:1.25",
+		},
+		"return the digest of the manifest": {
+			setupMocks: func(controller *gomock.Controller) *MockCmd {
+				mockCmd := NewMockCmd(controller)
+				mockCmd.EXPECT().RunWithContext(gomock.Any(), "docker", mockArgs, gomock.Any()).DoAndReturn(writeStdout(`{
+  "schemaVersion": 2,
+  "mediaType": "application/vnd.oci.image.index.v1+json",
+  "digest": "sha256:4c0fdaa8b6341bfdeca5f18f7837462c80cff90527ee35ef185571e1c327beac",
+  "size": 1862
+}`))
+				return mockCmd
+			},
+			wantedDigest: "sha256:4c0fdaa8b6341bfdeca5f18f7837462c80cff90527ee35ef185571e1c327beac",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			s := DockerCmdClient{
+				runner: tc.setupMocks(controller),
+			}
+
+			digest, err := s.ImageDigest(context.Background(), mockRef)
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDigest, digest)
+		})
+	}
+}
+
 func TestDockerCommand_Exec(t *testing.T) {
 	tests := map[string]struct {
 		setupMocks func(controller *gomock.Controller) *MockCmd
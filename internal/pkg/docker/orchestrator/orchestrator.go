@@ -73,6 +73,7 @@ const (
 
 const (
 	proxyPortStart = uint16(50000)
+	localhostIP    = "127.0.0.1"
 )
 
 const (
@@ -227,7 +228,8 @@ func (a *runTaskAction) Do(o *Orchestrator) error {
 		newOpts := o.pauseRunOptions(a.task)
 		if !maps.Equal(prevOpts.EnvVars, newOpts.EnvVars) ||
 			!maps.Equal(prevOpts.Secrets, newOpts.Secrets) ||
-			!maps.Equal(prevOpts.ContainerPorts, newOpts.ContainerPorts) {
+			!maps.Equal(prevOpts.ContainerPorts, newOpts.ContainerPorts) ||
+			!maps.Equal(prevOpts.Hosts, newOpts.Hosts) {
 			return errors.New("new task requires recreating pause container")
 		}
 
@@ -562,6 +564,10 @@ func (o *Orchestrator) containerID(name string) string {
 type Task struct {
 	Containers   map[string]ContainerDefinition
 	PauseSecrets map[string]string
+
+	// LocalHosts are hostnames that resolve to the task itself,
+	// so that containers can reach each other by those names.
+	LocalHosts []string
 }
 
 // ContainerDefinition defines information necessary to run a container.
@@ -588,6 +594,13 @@ func (o *Orchestrator) pauseRunOptions(t Task) dockerengine.RunOptions {
 		Init:                 true,
 	}
 
+	if len(t.LocalHosts) > 0 {
+		opts.Hosts = make(map[string]string, len(t.LocalHosts))
+		for _, host := range t.LocalHosts {
+			opts.Hosts[host] = localhostIP
+		}
+	}
+
 	for _, ctr := range t.Containers {
 		for hostPort, ctrPort := range ctr.Ports {
 			// TODO some error if host port is already defined?
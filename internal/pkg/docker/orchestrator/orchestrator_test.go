@@ -197,6 +197,10 @@ func TestOrchestrator(t *testing.T) {
 							require.Equal(t, map[string]string{
 								"A_SECRET": "very secret",
 							}, opts.Secrets)
+							require.Equal(t, map[string]string{
+								"bar":                  "127.0.0.1",
+								"bar.test.myapp.local": "127.0.0.1",
+							}, opts.Hosts)
 						}
 						return nil
 					},
@@ -209,6 +213,7 @@ func TestOrchestrator(t *testing.T) {
 						PauseSecrets: map[string]string{
 							"A_SECRET": "very secret",
 						},
+						LocalHosts: []string{"bar", "bar.test.myapp.local"},
 						Containers: map[string]ContainerDefinition{
 							"foo": {
 								Ports: map[string]string{
@@ -15,10 +15,12 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
 	"github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 )
 
 const (
@@ -26,6 +28,7 @@ const (
 	fmtTaskTaskDefinitionFamily     = "copilot-%s"
 	clusterResourceType             = "ecs:cluster"
 	serviceResourceType             = "ecs:service"
+	envClusterOutputKey             = "ClusterId"
 
 	taskStopReason = "Task stopped because the underlying CloudFormation stack was deleted."
 )
@@ -44,6 +47,9 @@ type ecsClient interface {
 	StoppedServiceTasks(cluster, service string) ([]*ecs.Task, error)
 	StopTasks(tasks []string, opts ...ecs.StopTasksOpts) error
 	TaskDefinition(taskDefName string) (*ecs.TaskDefinition, error)
+	ActiveTaskDefinitions(family string) ([]string, error)
+	DeregisterTaskDefinition(arn string) error
+	DeleteTaskDefinitions(arns []string) error
 	UpdateService(clusterName, serviceName string, opts ...ecs.UpdateServiceOpts) error
 	DescribeTasks(cluster string, taskARNs []string) ([]*ecs.Task, error)
 	ActiveClusters(arns ...string) ([]string, error)
@@ -52,6 +58,10 @@ type ecsClient interface {
 	Services(cluster string, services ...string) ([]*ecs.Service, error)
 }
 
+type stackDescriber interface {
+	Describe(name string) (*cloudformation.StackDescription, error)
+}
+
 type stepFunctionsClient interface {
 	StateMachineDefinition(stateMachineARN string) (string, error)
 }
@@ -74,6 +84,7 @@ type ServiceDesc struct {
 type Client struct {
 	rgGetter       resourceGetter
 	ecsClient      ecsClient
+	stackDescriber stackDescriber
 	StepFuncClient stepFunctionsClient
 }
 
@@ -82,6 +93,7 @@ func New(sess *session.Session) *Client {
 	return &Client{
 		rgGetter:       resourcegroups.New(sess),
 		ecsClient:      ecs.New(sess),
+		stackDescriber: cloudformation.New(sess),
 		StepFuncClient: stepfunctions.New(sess),
 	}
 }
@@ -182,9 +194,11 @@ type ListActiveAppEnvTasksOpts struct {
 
 // ListTasksFilter contains the filtering parameters for listing Copilot tasks.
 type ListTasksFilter struct {
-	TaskGroup   string // Returns only tasks with the given TaskGroup name.
-	TaskID      string // Returns only tasks with the given ID.
-	CopilotOnly bool   // Returns only tasks with the `copilot-task` tag.
+	TaskGroup   string            // Returns only tasks with the given TaskGroup name.
+	TaskID      string            // Returns only tasks with the given ID.
+	CopilotOnly bool              // Returns only tasks with the `copilot-task` tag.
+	Tags        map[string]string // Returns only tasks with all of the given tags.
+	StartedBy   string            // Returns only tasks started by the given value.
 }
 
 type listActiveCopilotTasksOpts struct {
@@ -279,6 +293,62 @@ func (c Client) TaskDefinition(app, env, svc string) (*ecs.TaskDefinition, error
 	return taskDefinition, nil
 }
 
+// StaleTaskDefinitions returns the active task definition revisions of the service, from the newest to the oldest,
+// that are older than the latest keep revisions and that are neither used by the service nor by its running tasks.
+func (c Client) StaleTaskDefinitions(app, env, svc string, keep int) ([]string, error) {
+	family := fmt.Sprintf("%s-%s-%s", app, env, svc)
+	revisions, err := c.ecsClient.ActiveTaskDefinitions(family)
+	if err != nil {
+		return nil, err
+	}
+	if len(revisions) <= keep {
+		return nil, nil
+	}
+	clusterName, serviceName, err := c.fetchAndParseServiceARN(app, env, svc)
+	if err != nil {
+		return nil, err
+	}
+	service, err := c.ecsClient.Service(clusterName, serviceName)
+	if err != nil {
+		return nil, fmt.Errorf("get ECS service %s: %w", serviceName, err)
+	}
+	inUse := map[string]bool{
+		aws.StringValue(service.TaskDefinition): true,
+	}
+	for _, deployment := range service.Deployments {
+		inUse[aws.StringValue(deployment.TaskDefinition)] = true
+	}
+	tasks, err := c.ecsClient.RunningTasksInFamily(clusterName, family)
+	if err != nil {
+		return nil, fmt.Errorf("get running tasks of family %s: %w", family, err)
+	}
+	for _, task := range tasks {
+		inUse[aws.StringValue(task.TaskDefinitionArn)] = true
+	}
+	var stale []string
+	for _, revision := range revisions[keep:] {
+		if !inUse[revision] {
+			stale = append(stale, revision)
+		}
+	}
+	return stale, nil
+}
+
+// DeregisterTaskDefinitions marks the task definition revisions as inactive.
+func (c Client) DeregisterTaskDefinitions(arns []string) error {
+	for _, arn := range arns {
+		if err := c.ecsClient.DeregisterTaskDefinition(arn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteTaskDefinitions deletes inactive task definition revisions.
+func (c Client) DeleteTaskDefinitions(arns []string) error {
+	return c.ecsClient.DeleteTaskDefinitions(arns)
+}
+
 // NetworkConfiguration returns the network configuration of the service.
 func (c Client) NetworkConfiguration(app, env, svc string) (*ecs.NetworkConfiguration, error) {
 	clusterARN, err := c.clusterARN(app, env)
@@ -385,12 +455,50 @@ func (c Client) listActiveCopilotTasks(opts listActiveCopilotTasksOpts) ([]*ecs.
 		}
 		tasks = resp
 	}
+	tasks = filterTasksByStartedBy(filterTasksByTags(tasks, opts.Tags), opts.StartedBy)
 	if opts.CopilotOnly {
 		return filterCopilotTasks(tasks, opts.TaskID), nil
 	}
 	return filterTasksByID(tasks, opts.TaskID), nil
 }
 
+func filterTasksByTags(tasks []*ecs.Task, tags map[string]string) []*ecs.Task {
+	if len(tags) == 0 {
+		return tasks
+	}
+	var filteredTasks []*ecs.Task
+	for _, task := range tasks {
+		taskTags := make(map[string]string)
+		for _, tag := range task.Tags {
+			taskTags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+		}
+		matched := true
+		for k, v := range tags {
+			if val, ok := taskTags[k]; !ok || val != v {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			filteredTasks = append(filteredTasks, task)
+		}
+	}
+	return filteredTasks
+}
+
+func filterTasksByStartedBy(tasks []*ecs.Task, startedBy string) []*ecs.Task {
+	if startedBy == "" {
+		return tasks
+	}
+	var filteredTasks []*ecs.Task
+	for _, task := range tasks {
+		if aws.StringValue(task.StartedBy) == startedBy {
+			filteredTasks = append(filteredTasks, task)
+		}
+	}
+	return filteredTasks
+}
+
 func filterTasksByID(tasks []*ecs.Task, taskID string) []*ecs.Task {
 	var filteredTasks []*ecs.Task
 	for _, task := range tasks {
@@ -431,7 +539,7 @@ func (c Client) clusterARN(app, env string) (string, error) {
 	case err != nil:
 		return "", fmt.Errorf("get ECS cluster with tags %s: %w", tags.String(), err)
 	case len(clusters) == 0:
-		return "", fmt.Errorf("no ECS cluster found with tags %s", tags.String())
+		return c.importedClusterARN(app, env, tags)
 	}
 
 	arns := make([]string, len(clusters))
@@ -450,6 +558,34 @@ func (c Client) clusterARN(app, env string) (string, error) {
 	return active[0], nil
 }
 
+// importedClusterARN returns the ARN of the existing cluster imported by an environment.
+// Imported clusters aren't tagged by Copilot, so the cluster is read from the outputs of the environment stack instead.
+func (c Client) importedClusterARN(app, env string, envTags tags) (string, error) {
+	envStack := stack.NameForEnv(app, env)
+	desc, err := c.stackDescriber.Describe(envStack)
+	if err != nil {
+		return "", fmt.Errorf("describe environment stack %s: %w", envStack, err)
+	}
+	var cluster string
+	for _, output := range desc.Outputs {
+		if aws.StringValue(output.OutputKey) == envClusterOutputKey {
+			cluster = aws.StringValue(output.OutputValue)
+			break
+		}
+	}
+	if cluster == "" {
+		return "", fmt.Errorf("no ECS cluster found with tags %s", envTags.String())
+	}
+	active, err := c.ecsClient.ActiveClusters(cluster)
+	switch {
+	case err != nil:
+		return "", fmt.Errorf("check if cluster %s is active: %w", cluster, err)
+	case len(active) == 0:
+		return "", fmt.Errorf("imported ECS cluster %s is not active", cluster)
+	}
+	return active[0], nil
+}
+
 func (c Client) fetchAndParseServiceARN(app, env, svc string) (cluster, service string, err error) {
 	svcARN, err := c.serviceARN(app, env, svc)
 	if err != nil {
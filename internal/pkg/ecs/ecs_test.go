@@ -10,7 +10,9 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	awscfn "github.com/aws/aws-sdk-go/service/cloudformation"
 	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
@@ -22,6 +24,7 @@ import (
 type clientMocks struct {
 	resourceGetter *mocks.MockresourceGetter
 	ecsClient      *mocks.MockecsClient
+	stackDescriber *mocks.MockstackDescriber
 	StepFuncClient *mocks.MockstepFunctionsClient
 }
 
@@ -56,10 +59,52 @@ func TestClient_ClusterARN(t *testing.T) {
 				gomock.InOrder(
 					m.resourceGetter.EXPECT().GetResourcesByTags(clusterResourceType, getRgInput).
 						Return([]*resourcegroups.Resource{}, nil),
+					m.stackDescriber.EXPECT().Describe("mockApp-mockEnv").Return(&cloudformation.StackDescription{}, nil),
 				)
 			},
 			wantedError: fmt.Errorf(`no ECS cluster found with tags "copilot-application"="mockApp","copilot-environment"="mockEnv"`),
 		},
+		"errors if fail to describe the environment stack when no cluster is tagged": {
+			setupMocks: func(m clientMocks) {
+				gomock.InOrder(
+					m.resourceGetter.EXPECT().GetResourcesByTags(clusterResourceType, getRgInput).
+						Return([]*resourcegroups.Resource{}, nil),
+					m.stackDescriber.EXPECT().Describe("mockApp-mockEnv").Return(nil, testError),
+				)
+			},
+			wantedError: fmt.Errorf(`describe environment stack mockApp-mockEnv: some error`),
+		},
+		"errors if the imported cluster is not active": {
+			setupMocks: func(m clientMocks) {
+				gomock.InOrder(
+					m.resourceGetter.EXPECT().GetResourcesByTags(clusterResourceType, getRgInput).
+						Return([]*resourcegroups.Resource{}, nil),
+					m.stackDescriber.EXPECT().Describe("mockApp-mockEnv").Return(&cloudformation.StackDescription{
+						Outputs: []*awscfn.Output{
+							{OutputKey: aws.String("ClusterId"), OutputValue: aws.String("shared")},
+						},
+					}, nil),
+					m.ecsClient.EXPECT().ActiveClusters("shared").Return(nil, nil),
+				)
+			},
+			wantedError: fmt.Errorf(`imported ECS cluster shared is not active`),
+		},
+		"success with the cluster imported by the environment": {
+			setupMocks: func(m clientMocks) {
+				gomock.InOrder(
+					m.resourceGetter.EXPECT().GetResourcesByTags(clusterResourceType, getRgInput).
+						Return([]*resourcegroups.Resource{}, nil),
+					m.stackDescriber.EXPECT().Describe("mockApp-mockEnv").Return(&cloudformation.StackDescription{
+						Outputs: []*awscfn.Output{
+							{OutputKey: aws.String("VpcId"), OutputValue: aws.String("vpc-1234")},
+							{OutputKey: aws.String("ClusterId"), OutputValue: aws.String("shared")},
+						},
+					}, nil),
+					m.ecsClient.EXPECT().ActiveClusters("shared").Return([]string{"arn:aws:ecs:us-west-2:123456789012:cluster/shared"}, nil),
+				)
+			},
+			wantedCluster: "arn:aws:ecs:us-west-2:123456789012:cluster/shared",
+		},
 		"errors if fail to get active clusters": {
 			setupMocks: func(m clientMocks) {
 				gomock.InOrder(
@@ -107,13 +152,15 @@ func TestClient_ClusterARN(t *testing.T) {
 			mocks := clientMocks{
 				resourceGetter: mocks.NewMockresourceGetter(ctrl),
 				ecsClient:      mocks.NewMockecsClient(ctrl),
+				stackDescriber: mocks.NewMockstackDescriber(ctrl),
 			}
 
 			test.setupMocks(mocks)
 
 			client := Client{
-				rgGetter:  mocks.resourceGetter,
-				ecsClient: mocks.ecsClient,
+				rgGetter:       mocks.resourceGetter,
+				ecsClient:      mocks.ecsClient,
+				stackDescriber: mocks.stackDescriber,
 			}
 
 			// WHEN
@@ -944,6 +991,8 @@ func TestClient_listActiveCopilotTasks(t *testing.T) {
 		inTaskGroup string
 		inTaskID    string
 		inOneOff    bool
+		inTags      map[string]string
+		inStartedBy string
 		setupMocks  func(mocks clientMocks)
 
 		wantedError error
@@ -1008,6 +1057,49 @@ func TestClient_listActiveCopilotTasks(t *testing.T) {
 				},
 			},
 		},
+		"success filtering by tags and started by": {
+			inTags: map[string]string{
+				"team": "payments",
+			},
+			inStartedBy: "ci",
+			setupMocks: func(m clientMocks) {
+				gomock.InOrder(
+					m.ecsClient.EXPECT().RunningTasks(mockCluster).
+						Return([]*ecs.Task{
+							{
+								TaskArn:   aws.String("arn:aws:ecs:us-west-2:123456789:task/123456789"),
+								StartedBy: aws.String("ci"),
+								Tags: []*awsecs.Tag{
+									{Key: aws.String("team"), Value: aws.String("payments")},
+								},
+							},
+							{
+								TaskArn:   aws.String("arn:aws:ecs:us-west-2:123456789:task/123456788"),
+								StartedBy: aws.String("copilot-task"),
+								Tags: []*awsecs.Tag{
+									{Key: aws.String("team"), Value: aws.String("payments")},
+								},
+							},
+							{
+								TaskArn:   aws.String("arn:aws:ecs:us-west-2:123456789:task/987765654"),
+								StartedBy: aws.String("ci"),
+								Tags: []*awsecs.Tag{
+									{Key: aws.String("team"), Value: aws.String("orders")},
+								},
+							},
+						}, nil),
+				)
+			},
+			wanted: []*ecs.Task{
+				{
+					TaskArn:   aws.String("arn:aws:ecs:us-west-2:123456789:task/123456789"),
+					StartedBy: aws.String("ci"),
+					Tags: []*awsecs.Tag{
+						{Key: aws.String("team"), Value: aws.String("payments")},
+					},
+				},
+			},
+		},
 		"success with oneOff disabled": {
 			inTaskID: "123456",
 			inOneOff: false,
@@ -1071,6 +1163,8 @@ func TestClient_listActiveCopilotTasks(t *testing.T) {
 					TaskGroup:   test.inTaskGroup,
 					TaskID:      test.inTaskID,
 					CopilotOnly: test.inOneOff,
+					Tags:        test.inTags,
+					StartedBy:   test.inStartedBy,
 				},
 			})
 
@@ -1541,6 +1635,102 @@ func TestServiceDescriber_TaskDefinition(t *testing.T) {
 	}
 }
 
+func TestClient_StaleTaskDefinitions(t *testing.T) {
+	const (
+		mockSvcARN = "arn:aws:ecs:us-west-2:1234567890:service/mockCluster/mockService"
+		fmtRev     = "arn:aws:ecs:us-west-2:1234567890:task-definition/mockApp-mockEnv-mockSvc:%d"
+	)
+	revisions := []string{fmt.Sprintf(fmtRev, 5), fmt.Sprintf(fmtRev, 4), fmt.Sprintf(fmtRev, 3), fmt.Sprintf(fmtRev, 2), fmt.Sprintf(fmtRev, 1)}
+	mockServiceARN := func(m clientMocks) {
+		m.resourceGetter.EXPECT().GetResourcesByTags(serviceResourceType, gomock.Any()).
+			Return([]*resourcegroups.Resource{{ARN: mockSvcARN}}, nil)
+		m.resourceGetter.EXPECT().GetResourcesByTags(clusterResourceType, gomock.Any()).
+			Return([]*resourcegroups.Resource{{ARN: "mockARN1"}}, nil)
+		m.ecsClient.EXPECT().ActiveClusters("mockARN1").Return([]string{"mockARN1"}, nil)
+		m.ecsClient.EXPECT().ActiveServices("mockARN1", []string{mockSvcARN}).Return([]string{mockSvcARN}, nil)
+	}
+	tests := map[string]struct {
+		inKeep     int
+		setupMocks func(mocks clientMocks)
+
+		wanted      []string
+		wantedError error
+	}{
+		"return error if failed to list the revisions": {
+			inKeep: 2,
+			setupMocks: func(m clientMocks) {
+				m.ecsClient.EXPECT().ActiveTaskDefinitions("mockApp-mockEnv-mockSvc").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"nothing to clean up if there are fewer revisions than the retention count": {
+			inKeep: 10,
+			setupMocks: func(m clientMocks) {
+				m.ecsClient.EXPECT().ActiveTaskDefinitions("mockApp-mockEnv-mockSvc").Return(revisions, nil)
+			},
+		},
+		"return error if failed to get the running tasks": {
+			inKeep: 2,
+			setupMocks: func(m clientMocks) {
+				m.ecsClient.EXPECT().ActiveTaskDefinitions("mockApp-mockEnv-mockSvc").Return(revisions, nil)
+				mockServiceARN(m)
+				m.ecsClient.EXPECT().Service("mockCluster", "mockService").Return(&ecs.Service{}, nil)
+				m.ecsClient.EXPECT().RunningTasksInFamily("mockCluster", "mockApp-mockEnv-mockSvc").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get running tasks of family mockApp-mockEnv-mockSvc: some error"),
+		},
+		"exclude the revisions used by the service and its running tasks": {
+			inKeep: 2,
+			setupMocks: func(m clientMocks) {
+				m.ecsClient.EXPECT().ActiveTaskDefinitions("mockApp-mockEnv-mockSvc").Return(revisions, nil)
+				mockServiceARN(m)
+				m.ecsClient.EXPECT().Service("mockCluster", "mockService").Return(&ecs.Service{
+					TaskDefinition: aws.String(revisions[0]),
+					Deployments: []*awsecs.Deployment{
+						{TaskDefinition: aws.String(revisions[0])},
+						{TaskDefinition: aws.String(revisions[2])},
+					},
+				}, nil)
+				m.ecsClient.EXPECT().RunningTasksInFamily("mockCluster", "mockApp-mockEnv-mockSvc").Return([]*ecs.Task{
+					{TaskDefinitionArn: aws.String(revisions[4])},
+				}, nil)
+			},
+			wanted: []string{revisions[3]},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// GIVEN
+			mockRgGetter := mocks.NewMockresourceGetter(ctrl)
+			mockECSClient := mocks.NewMockecsClient(ctrl)
+			mocks := clientMocks{
+				resourceGetter: mockRgGetter,
+				ecsClient:      mockECSClient,
+			}
+			test.setupMocks(mocks)
+			client := Client{
+				rgGetter:  mockRgGetter,
+				ecsClient: mockECSClient,
+			}
+
+			// WHEN
+			got, err := client.StaleTaskDefinitions("mockApp", "mockEnv", "mockSvc", test.inKeep)
+
+			// THEN
+			if test.wantedError != nil {
+				require.EqualError(t, err, test.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.wanted, got)
+		})
+	}
+}
+
 func Test_NetworkConfiguration(t *testing.T) {
 	const (
 		testApp        = "phonetool"
@@ -1574,6 +1764,7 @@ func Test_NetworkConfiguration(t *testing.T) {
 				gomock.InOrder(
 					m.resourceGetter.EXPECT().GetResourcesByTags(clusterResourceType, getRgInput).
 						Return([]*resourcegroups.Resource{}, nil),
+					m.stackDescriber.EXPECT().Describe("phonetool-test").Return(&cloudformation.StackDescription{}, nil),
 				)
 			},
 			wantedError: fmt.Errorf(`no ECS cluster found with tags "copilot-application"="phonetool","copilot-environment"="test"`),
@@ -1637,13 +1828,15 @@ func Test_NetworkConfiguration(t *testing.T) {
 			m := clientMocks{
 				resourceGetter: mocks.NewMockresourceGetter(ctrl),
 				ecsClient:      mocks.NewMockecsClient(ctrl),
+				stackDescriber: mocks.NewMockstackDescriber(ctrl),
 			}
 
 			tc.setupMocks(m)
 
 			client := Client{
-				rgGetter:  m.resourceGetter,
-				ecsClient: m.ecsClient,
+				rgGetter:       m.resourceGetter,
+				ecsClient:      m.ecsClient,
+				stackDescriber: m.stackDescriber,
 			}
 
 			// WHEN
@@ -7,6 +7,7 @@ package mocks
 import (
 	reflect "reflect"
 
+	cloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	resourcegroups "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
 	gomock "github.com/golang/mock/gomock"
@@ -112,6 +113,21 @@ func (mr *MockecsClientMockRecorder) ActiveServices(clusterName interface{}, ser
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActiveServices", reflect.TypeOf((*MockecsClient)(nil).ActiveServices), varargs...)
 }
 
+// ActiveTaskDefinitions mocks base method.
+func (m *MockecsClient) ActiveTaskDefinitions(family string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActiveTaskDefinitions", family)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActiveTaskDefinitions indicates an expected call of ActiveTaskDefinitions.
+func (mr *MockecsClientMockRecorder) ActiveTaskDefinitions(family interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActiveTaskDefinitions", reflect.TypeOf((*MockecsClient)(nil).ActiveTaskDefinitions), family)
+}
+
 // DefaultCluster mocks base method.
 func (m *MockecsClient) DefaultCluster() (string, error) {
 	m.ctrl.T.Helper()
@@ -127,6 +143,34 @@ func (mr *MockecsClientMockRecorder) DefaultCluster() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultCluster", reflect.TypeOf((*MockecsClient)(nil).DefaultCluster))
 }
 
+// DeleteTaskDefinitions mocks base method.
+func (m *MockecsClient) DeleteTaskDefinitions(arns []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTaskDefinitions", arns)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTaskDefinitions indicates an expected call of DeleteTaskDefinitions.
+func (mr *MockecsClientMockRecorder) DeleteTaskDefinitions(arns interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTaskDefinitions", reflect.TypeOf((*MockecsClient)(nil).DeleteTaskDefinitions), arns)
+}
+
+// DeregisterTaskDefinition mocks base method.
+func (m *MockecsClient) DeregisterTaskDefinition(arn string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeregisterTaskDefinition", arn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeregisterTaskDefinition indicates an expected call of DeregisterTaskDefinition.
+func (mr *MockecsClientMockRecorder) DeregisterTaskDefinition(arn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterTaskDefinition", reflect.TypeOf((*MockecsClient)(nil).DeregisterTaskDefinition), arn)
+}
+
 // DescribeTasks mocks base method.
 func (m *MockecsClient) DescribeTasks(cluster string, taskARNs []string) ([]*ecs.Task, error) {
 	m.ctrl.T.Helper()
@@ -320,6 +364,44 @@ func (mr *MockecsClientMockRecorder) UpdateService(clusterName, serviceName inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateService", reflect.TypeOf((*MockecsClient)(nil).UpdateService), varargs...)
 }
 
+// MockstackDescriber is a mock of stackDescriber interface.
+type MockstackDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockstackDescriberMockRecorder
+}
+
+// MockstackDescriberMockRecorder is the mock recorder for MockstackDescriber.
+type MockstackDescriberMockRecorder struct {
+	mock *MockstackDescriber
+}
+
+// NewMockstackDescriber creates a new mock instance.
+func NewMockstackDescriber(ctrl *gomock.Controller) *MockstackDescriber {
+	mock := &MockstackDescriber{ctrl: ctrl}
+	mock.recorder = &MockstackDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockstackDescriber) EXPECT() *MockstackDescriberMockRecorder {
+	return m.recorder
+}
+
+// Describe mocks base method.
+func (m *MockstackDescriber) Describe(name string) (*cloudformation.StackDescription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Describe", name)
+	ret0, _ := ret[0].(*cloudformation.StackDescription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Describe indicates an expected call of Describe.
+func (mr *MockstackDescriberMockRecorder) Describe(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockstackDescriber)(nil).Describe), name)
+}
+
 // MockstepFunctionsClient is a mock of stepFunctionsClient interface.
 type MockstepFunctionsClient struct {
 	ctrl     *gomock.Controller
@@ -58,6 +58,7 @@ type containerInfo struct {
 	command    []string
 	envVars    map[string]string
 	secrets    map[string]string
+	efsVolumes []string // Formatted as "name:fs-id:/container/path".
 }
 
 // RunTaskRequestFromECSService populates a RunTaskRequest with information from an ECS service.
@@ -206,6 +207,10 @@ func (r RunTaskRequest) CLIString() (string, error) {
 		output = append(output, fmt.Sprintf("--secrets %s", secrets))
 	}
 
+	for _, vol := range r.efsVolumes {
+		output = append(output, fmt.Sprintf("--efs %s", vol))
+	}
+
 	if r.networkConfiguration.Subnets != nil && len(r.networkConfiguration.Subnets) != 0 {
 		output = append(output, fmt.Sprintf("--subnets %s", strings.Join(r.networkConfiguration.Subnets, ",")))
 	}
@@ -259,12 +264,22 @@ func containerInformation(taskDef *awsecs.TaskDefinition, containerName string)
 		}
 	}
 
+	volumes, err := taskDef.EFSVolumes(containerName)
+	if err != nil {
+		return nil, err
+	}
+	var efsVolumes []string
+	for _, vol := range volumes {
+		efsVolumes = append(efsVolumes, fmt.Sprintf("%s:%s:%s", vol.Name, vol.FileSystemID, vol.ContainerPath))
+	}
+
 	return &containerInfo{
 		image:      image,
 		entryPoint: entrypoint,
 		command:    command,
 		envVars:    envVars,
 		secrets:    secrets,
+		efsVolumes: efsVolumes,
 	}, nil
 }
 
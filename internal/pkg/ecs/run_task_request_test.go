@@ -438,6 +438,25 @@ func TestRunTaskRequest_CLIString(t *testing.T) {
 				fmt.Sprintf("--env %s", testEnv),
 			}, " \\\n"),
 		},
+		"generates copilot service cmd with --efs": {
+			in: RunTaskRequest{
+				appName: testApp,
+				envName: testEnv,
+
+				containerInfo: containerInfo{
+					image:      "beautiful-image",
+					efsVolumes: []string{"data:fs-1:/data", "logs:fs-2:/var/logs"},
+				},
+			},
+			wanted: strings.Join([]string{
+				"copilot task run",
+				"--image beautiful-image",
+				"--efs data:fs-1:/data",
+				"--efs logs:fs-2:/var/logs",
+				fmt.Sprintf("--app %s", testApp),
+				fmt.Sprintf("--env %s", testEnv),
+			}, " \\\n"),
+		},
 	}
 
 	for name, tc := range testCases {
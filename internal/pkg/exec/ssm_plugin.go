@@ -15,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ssm"
 )
 
 const (
@@ -59,6 +60,25 @@ func (s SSMPluginCommand) StartSession(ssmSess *ecs.Session) error {
 	return nil
 }
 
+// StartPortForwardingSession starts a port forwarding session opened with the input against the SSM endpoint
+// using the ssm plugin.
+func (s SSMPluginCommand) StartPortForwardingSession(ssmSess *ssm.StartSessionOutput, in *ssm.StartSessionInput, endpoint string) error {
+	response, err := json.Marshal(ssmSess)
+	if err != nil {
+		return fmt.Errorf("marshal session response: %w", err)
+	}
+	request, err := json.Marshal(in)
+	if err != nil {
+		return fmt.Errorf("marshal session request: %w", err)
+	}
+	// The plugin needs the request to reconnect to the target if the session drops.
+	if err := s.runner.InteractiveRun(ssmPluginBinaryName,
+		[]string{string(response), aws.StringValue(s.sess.Config.Region), startSessionAction, "", string(request), endpoint}); err != nil {
+		return fmt.Errorf("start session: %w", err)
+	}
+	return nil
+}
+
 func download(client httpClient, filepath string, url string) error {
 	resp, err := client.Get(url)
 	if err != nil {
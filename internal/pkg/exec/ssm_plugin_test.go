@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -83,3 +84,62 @@ func TestSSMPluginCommand_StartSession(t *testing.T) {
 		})
 	}
 }
+
+func TestSSMPluginCommand_StartPortForwardingSession(t *testing.T) {
+	mockSession := &ssm.StartSessionOutput{
+		SessionId:  aws.String("mockSessionID"),
+		StreamUrl:  aws.String("mockStreamURL"),
+		TokenValue: aws.String("mockTokenValue"),
+	}
+	mockInput := &ssm.StartSessionInput{
+		DocumentName: aws.String("AWS-StartPortForwardingSessionToRemoteHost"),
+		Target:       aws.String("ecs:cluster_task_runtime"),
+		Parameters: map[string][]*string{
+			"host": aws.StringSlice([]string{"mydb.abc.us-west-2.rds.amazonaws.com"}),
+		},
+	}
+	const (
+		wantedResponse = `{"SessionId":"mockSessionID","StreamUrl":"mockStreamURL","TokenValue":"mockTokenValue"}`
+		wantedRequest  = `{"DocumentName":"AWS-StartPortForwardingSessionToRemoteHost","Parameters":{"host":["mydb.abc.us-west-2.rds.amazonaws.com"]},"Reason":null,"Target":"ecs:cluster_task_runtime"}`
+		mockEndpoint   = "https://ssm.us-west-2.amazonaws.com"
+	)
+	tests := map[string]struct {
+		setupMocks  func(m *Mockrunner)
+		wantedError error
+	}{
+		"return error if fail to start session": {
+			setupMocks: func(m *Mockrunner) {
+				m.EXPECT().InteractiveRun(ssmPluginBinaryName,
+					[]string{wantedResponse, "us-west-2", "StartSession", "", wantedRequest, mockEndpoint}).Return(errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("start session: some error"),
+		},
+		"success": {
+			setupMocks: func(m *Mockrunner) {
+				m.EXPECT().InteractiveRun(ssmPluginBinaryName,
+					[]string{wantedResponse, "us-west-2", "StartSession", "", wantedRequest, mockEndpoint}).Return(nil)
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			m := NewMockrunner(ctrl)
+			tc.setupMocks(m)
+			s := SSMPluginCommand{
+				runner: m,
+				sess: &session.Session{
+					Config: &aws.Config{
+						Region: aws.String("us-west-2"),
+					},
+				},
+			}
+			err := s.StartPortForwardingSession(mockSession, mockInput, mockEndpoint)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
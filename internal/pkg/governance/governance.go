@@ -0,0 +1,329 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package governance renders the inputs of centrally governed templates, such as AWS Proton service templates
+// and AWS Service Catalog products, from the manifest of a service.
+package governance
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Kinds of governed templates.
+const (
+	Proton         = "proton"
+	ServiceCatalog = "servicecatalog"
+)
+
+// Kinds are the supported kinds of governed templates.
+var Kinds = []string{Proton, ServiceCatalog}
+
+const (
+	protonSpecKind = "ServiceSpec"
+
+	// protonPathExtension is the OpenAPI extension of a Proton schema property that names the manifest field of its value.
+	// Service Catalog product templates map their parameters to manifest fields under "Metadata.Copilot::ManifestPaths" instead.
+	protonPathExtension = "x-copilot-path"
+)
+
+// Field is an input of a governed template.
+type Field struct {
+	Name     string
+	Path     string // Path of the manifest field of the input, if set by the template. Otherwise, the field is matched by name.
+	Required bool
+}
+
+// Template is a governed template and the inputs that it supports.
+type Template struct {
+	Kind   string
+	Fields []Field
+}
+
+// ParseTemplate returns the inputs of a Proton schema file or of a Service Catalog product template.
+func ParseTemplate(kind string, content []byte) (*Template, error) {
+	var fields []Field
+	var err error
+	switch kind {
+	case Proton:
+		fields, err = protonFields(content)
+	case ServiceCatalog:
+		fields, err = productFields(content)
+	default:
+		return nil, fmt.Errorf("unsupported governed template kind %q: must be one of %s", kind, strings.Join(Kinds, ", "))
+	}
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(fields, func(i, j int) bool { return fields[i].Name < fields[j].Name })
+	return &Template{
+		Kind:   kind,
+		Fields: fields,
+	}, nil
+}
+
+func protonFields(content []byte) ([]Field, error) {
+	var schema struct {
+		Schema struct {
+			ServiceInputType string `yaml:"service_input_type"`
+			Types            map[string]struct {
+				Properties map[string]map[string]interface{} `yaml:"properties"`
+				Required   []string                          `yaml:"required"`
+			} `yaml:"types"`
+		} `yaml:"schema"`
+	}
+	if err := yaml.Unmarshal(content, &schema); err != nil {
+		return nil, fmt.Errorf("unmarshal Proton schema: %w", err)
+	}
+	inputType := schema.Schema.ServiceInputType
+	if inputType == "" {
+		return nil, fmt.Errorf(`Proton schema does not define "service_input_type"`)
+	}
+	typ, ok := schema.Schema.Types[inputType]
+	if !ok {
+		return nil, fmt.Errorf("service input type %q is not defined in the types of the Proton schema", inputType)
+	}
+	required := make(map[string]bool)
+	for _, name := range typ.Required {
+		required[name] = true
+	}
+	var fields []Field
+	for name, prop := range typ.Properties {
+		path, _ := prop[protonPathExtension].(string)
+		fields = append(fields, Field{
+			Name:     name,
+			Path:     path,
+			Required: required[name],
+		})
+	}
+	return fields, nil
+}
+
+func productFields(content []byte) ([]Field, error) {
+	var tpl struct {
+		Metadata struct {
+			Paths map[string]string `yaml:"Copilot::ManifestPaths"`
+		} `yaml:"Metadata"`
+		Parameters map[string]struct {
+			Default interface{} `yaml:"Default"`
+		} `yaml:"Parameters"`
+	}
+	if err := yaml.Unmarshal(content, &tpl); err != nil {
+		return nil, fmt.Errorf("unmarshal Service Catalog product template: %w", err)
+	}
+	var fields []Field
+	for name, param := range tpl.Parameters {
+		fields = append(fields, Field{
+			Name:     name,
+			Path:     tpl.Metadata.Paths[name],
+			Required: param.Default == nil,
+		})
+	}
+	return fields, nil
+}
+
+// RenderInput holds the manifest to render the inputs of a governed template from.
+type RenderInput struct {
+	Name     string // Name of the service.
+	Env      string // Name of the environment whose override is applied to the manifest.
+	Manifest []byte // Interpolated manifest of the service.
+}
+
+// Handoff holds the inputs of a governed template rendered from the manifest of a service.
+type Handoff struct {
+	Kind   string
+	Name   string
+	Env    string
+	Inputs map[string]interface{}
+}
+
+// ErrDrift occurs when the manifest of a service uses fields that the governed template doesn't support,
+// or lacks values for inputs that the template requires.
+type ErrDrift struct {
+	Unsupported []string // Manifest fields without a matching input.
+	Missing     []string // Required inputs without a value in the manifest.
+}
+
+func (e *ErrDrift) Error() string {
+	var reasons []string
+	if len(e.Unsupported) != 0 {
+		reasons = append(reasons, fmt.Sprintf("fields %s are not supported by the template", quoteAll(e.Unsupported)))
+	}
+	if len(e.Missing) != 0 {
+		reasons = append(reasons, fmt.Sprintf("required inputs %s have no value in the manifest", quoteAll(e.Missing)))
+	}
+	return fmt.Sprintf("manifest drifts from the governed template: %s", strings.Join(reasons, "; "))
+}
+
+// Render returns the inputs of the template from the values of the manifest.
+// It returns an ErrDrift if the manifest and the fields supported by the template don't match.
+func (t *Template) Render(in RenderInput) (*Handoff, error) {
+	values, err := manifestValues(in.Manifest, in.Env)
+	if err != nil {
+		return nil, err
+	}
+	paths := nodePaths(values, "")
+	inputs := make(map[string]interface{})
+	var covered, missing []string
+	for _, field := range t.Fields {
+		path := field.Path
+		if path == "" {
+			path = matchPath(field.Name, paths)
+		}
+		value := lookup(values, path)
+		if path == "" || value == nil {
+			if field.Required {
+				missing = append(missing, field.Name)
+			}
+			continue
+		}
+		covered = append(covered, path)
+		inputs[field.Name] = value
+	}
+	var unsupported []string
+	for _, leaf := range leafPaths(values, "") {
+		if !isCovered(leaf, covered) {
+			unsupported = append(unsupported, leaf)
+		}
+	}
+	if len(unsupported) != 0 || len(missing) != 0 {
+		return nil, &ErrDrift{
+			Unsupported: unsupported,
+			Missing:     missing,
+		}
+	}
+	return &Handoff{
+		Kind:   t.Kind,
+		Name:   in.Name,
+		Env:    in.Env,
+		Inputs: inputs,
+	}, nil
+}
+
+// Write writes the inputs in the format expected by the governed template:
+// a service spec for Proton, and provisioning parameters for Service Catalog.
+func (h *Handoff) Write(w io.Writer) error {
+	switch h.Kind {
+	case Proton:
+		return h.writeProtonSpec(w)
+	case ServiceCatalog:
+		return h.writeProvisioningParameters(w)
+	default:
+		return fmt.Errorf("unsupported governed template kind %q", h.Kind)
+	}
+}
+
+func (h *Handoff) writeProtonSpec(w io.Writer) error {
+	type instance struct {
+		Name        string                 `yaml:"name"`
+		Environment string                 `yaml:"environment"`
+		Spec        map[string]interface{} `yaml:"spec"`
+	}
+	spec := struct {
+		Proton    string     `yaml:"proton"`
+		Instances []instance `yaml:"instances"`
+	}{
+		Proton: protonSpecKind,
+		Instances: []instance{
+			{
+				Name:        fmt.Sprintf("%s-%s", h.Name, h.Env),
+				Environment: h.Env,
+				Spec:        h.Inputs,
+			},
+		},
+	}
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(spec); err != nil {
+		return fmt.Errorf("write Proton service spec: %w", err)
+	}
+	return enc.Close()
+}
+
+func (h *Handoff) writeProvisioningParameters(w io.Writer) error {
+	type parameter struct {
+		Key   string `json:"Key"`
+		Value string `json:"Value"`
+	}
+	keys := make([]string, 0, len(h.Inputs))
+	for key := range h.Inputs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	params := make([]parameter, 0, len(keys))
+	for _, key := range keys {
+		value, err := parameterValue(h.Inputs[key])
+		if err != nil {
+			return fmt.Errorf("convert the value of parameter %s: %w", key, err)
+		}
+		params = append(params, parameter{
+			Key:   key,
+			Value: value,
+		})
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(params); err != nil {
+		return fmt.Errorf("write Service Catalog provisioning parameters: %w", err)
+	}
+	return nil
+}
+
+// parameterValue returns the string value of a CloudFormation parameter: scalars as is, and lists and maps in JSON.
+func parameterValue(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case []interface{}, map[string]interface{}:
+		out, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		return string(out), nil
+	default:
+		return fmt.Sprint(v), nil
+	}
+}
+
+// matchPath returns the manifest path whose letters and digits match the name of an input, such as
+// "image.port" for "image_port" or "ImagePort".
+func matchPath(name string, paths []string) string {
+	for _, path := range paths {
+		if normalize(path) == normalize(name) {
+			return path
+		}
+	}
+	return ""
+}
+
+func normalize(s string) string {
+	var sb strings.Builder
+	for _, r := range strings.ToLower(s) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
+
+func isCovered(leaf string, covered []string) bool {
+	for _, path := range covered {
+		if leaf == path || strings.HasPrefix(leaf, path+".") {
+			return true
+		}
+	}
+	return false
+}
+
+func quoteAll(elems []string) string {
+	quoted := make([]string, len(elems))
+	for i, elem := range elems {
+		quoted[i] = fmt.Sprintf("%q", elem)
+	}
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,274 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package governance
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTemplate(t *testing.T) {
+	testCases := map[string]struct {
+		inKind    string
+		inContent string
+
+		wanted    *Template
+		wantedErr error
+	}{
+		"error on unsupported kind": {
+			inKind:    "terraform",
+			wantedErr: errors.New(`unsupported governed template kind "terraform": must be one of proton, servicecatalog`),
+		},
+		"error if the Proton schema has no service input type": {
+			inKind: Proton,
+			inContent: `
+schema:
+  format:
+    openapi: "3.0.0"
+`,
+			wantedErr: errors.New(`Proton schema does not define "service_input_type"`),
+		},
+		"error if the service input type is not defined": {
+			inKind: Proton,
+			inContent: `
+schema:
+  service_input_type: "WebServiceInput"
+  types:
+    PipelineInput:
+      type: object
+`,
+			wantedErr: errors.New(`service input type "WebServiceInput" is not defined in the types of the Proton schema`),
+		},
+		"fields of the service input type of a Proton schema": {
+			inKind: Proton,
+			inContent: `
+schema:
+  format:
+    openapi: "3.0.0"
+  service_input_type: "WebServiceInput"
+  types:
+    WebServiceInput:
+      type: object
+      properties:
+        port:
+          type: number
+          x-copilot-path: image.port
+        desired_count:
+          type: number
+          x-copilot-path: count
+        cpu:
+          type: number
+          default: 256
+      required:
+        - port
+`,
+			wanted: &Template{
+				Kind: Proton,
+				Fields: []Field{
+					{Name: "cpu"},
+					{Name: "desired_count", Path: "count"},
+					{Name: "port", Path: "image.port", Required: true},
+				},
+			},
+		},
+		"parameters of a Service Catalog product template": {
+			inKind: ServiceCatalog,
+			inContent: `
+Metadata:
+  Copilot::ManifestPaths:
+    ContainerPort: image.port
+Parameters:
+  ContainerPort:
+    Type: Number
+  Cpu:
+    Type: Number
+    Default: 256
+Resources:
+  Service:
+    Type: AWS::ECS::Service
+`,
+			wanted: &Template{
+				Kind: ServiceCatalog,
+				Fields: []Field{
+					{Name: "ContainerPort", Path: "image.port", Required: true},
+					{Name: "Cpu"},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseTemplate(tc.inKind, []byte(tc.inContent))
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestTemplate_Render(t *testing.T) {
+	const mft = `
+name: api
+type: Load Balanced Web Service
+image:
+  build: Dockerfile
+  port: 8080
+cpu: 256
+count: 1
+variables:
+  LOG_LEVEL: info
+environments:
+  prod:
+    count: 4
+    variables:
+      REGION: us-west-2
+`
+	testCases := map[string]struct {
+		inTemplate *Template
+
+		wanted    *Handoff
+		wantedErr error
+	}{
+		"renders the inputs with the environment override applied": {
+			inTemplate: &Template{
+				Kind: Proton,
+				Fields: []Field{
+					{Name: "build", Path: "image.build"},
+					{Name: "cpu"},
+					{Name: "desired_count", Path: "count"},
+					{Name: "environment_variables", Path: "variables"},
+					{Name: "image_port", Required: true},
+					{Name: "memory"},
+				},
+			},
+			wanted: &Handoff{
+				Kind: Proton,
+				Name: "api",
+				Env:  "prod",
+				Inputs: map[string]interface{}{
+					"build":         "Dockerfile",
+					"cpu":           256,
+					"desired_count": 4,
+					"environment_variables": map[string]interface{}{
+						"LOG_LEVEL": "info",
+						"REGION":    "us-west-2",
+					},
+					"image_port": 8080,
+				},
+			},
+		},
+		"error if the manifest drifts from the template": {
+			inTemplate: &Template{
+				Kind: ServiceCatalog,
+				Fields: []Field{
+					{Name: "ImageBuild"},
+					{Name: "ImagePort"},
+					{Name: "Cpu"},
+					{Name: "Memory", Required: true},
+				},
+			},
+			wantedErr: &ErrDrift{
+				Unsupported: []string{"count", "variables.LOG_LEVEL", "variables.REGION"},
+				Missing:     []string{"Memory"},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.inTemplate.Render(RenderInput{
+				Name:     "api",
+				Env:      "prod",
+				Manifest: []byte(mft),
+			})
+
+			if tc.wantedErr != nil {
+				require.Equal(t, tc.wantedErr, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestErrDrift_Error(t *testing.T) {
+	err := &ErrDrift{
+		Unsupported: []string{"count", "http.path"},
+		Missing:     []string{"Memory"},
+	}
+
+	require.EqualError(t, err, `manifest drifts from the governed template: fields "count", "http.path" are not supported by the template; required inputs "Memory" have no value in the manifest`)
+}
+
+func TestHandoff_Write(t *testing.T) {
+	testCases := map[string]struct {
+		inHandoff *Handoff
+
+		wanted string
+	}{
+		"Proton service spec": {
+			inHandoff: &Handoff{
+				Kind: Proton,
+				Name: "api",
+				Env:  "prod",
+				Inputs: map[string]interface{}{
+					"port":          8080,
+					"desired_count": 4,
+				},
+			},
+			wanted: `proton: ServiceSpec
+instances:
+  - name: api-prod
+    environment: prod
+    spec:
+      desired_count: 4
+      port: 8080
+`,
+		},
+		"Service Catalog provisioning parameters": {
+			inHandoff: &Handoff{
+				Kind: ServiceCatalog,
+				Name: "api",
+				Env:  "prod",
+				Inputs: map[string]interface{}{
+					"ContainerPort": 8080,
+					"Subnets":       []interface{}{"subnet-1", "subnet-2"},
+					"Image":         "nginx",
+				},
+			},
+			wanted: `[
+  {
+    "Key": "ContainerPort",
+    "Value": "8080"
+  },
+  {
+    "Key": "Image",
+    "Value": "nginx"
+  },
+  {
+    "Key": "Subnets",
+    "Value": "[\"subnet-1\",\"subnet-2\"]"
+  }
+]
+`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			buf := new(bytes.Buffer)
+
+			err := tc.inHandoff.Write(buf)
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, buf.String())
+		})
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package governance
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const environmentsKey = "environments"
+
+// identityKeys are the manifest fields that identify the service rather than configure it, and never drift.
+var identityKeys = map[string]bool{
+	"name": true,
+	"type": true,
+}
+
+// manifestValues returns the fields of the manifest with the override of the environment applied.
+// Maps are merged with the override, while lists and scalars are replaced by it.
+func manifestValues(raw []byte, env string) (map[string]interface{}, error) {
+	var mft map[string]interface{}
+	if err := yaml.Unmarshal(raw, &mft); err != nil {
+		return nil, fmt.Errorf("unmarshal manifest: %w", err)
+	}
+	envs, _ := mft[environmentsKey].(map[string]interface{})
+	delete(mft, environmentsKey)
+	for key := range identityKeys {
+		delete(mft, key)
+	}
+	if override, ok := envs[env].(map[string]interface{}); ok {
+		merge(mft, override)
+	}
+	return mft, nil
+}
+
+func merge(dst, src map[string]interface{}) {
+	for key, value := range src {
+		if value == nil {
+			continue
+		}
+		srcMap, srcIsMap := value.(map[string]interface{})
+		dstMap, dstIsMap := dst[key].(map[string]interface{})
+		if srcIsMap && dstIsMap {
+			merge(dstMap, srcMap)
+			continue
+		}
+		dst[key] = value
+	}
+}
+
+// nodePaths returns the sorted paths of every non-empty field of the manifest, including the fields that hold maps.
+func nodePaths(values map[string]interface{}, prefix string) []string {
+	var paths []string
+	for key, value := range values {
+		if isEmpty(value) {
+			continue
+		}
+		path := prefix + key
+		paths = append(paths, path)
+		if m, ok := value.(map[string]interface{}); ok {
+			paths = append(paths, nodePaths(m, path+".")...)
+		}
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// leafPaths returns the sorted paths of the non-empty fields of the manifest that hold lists or scalars.
+func leafPaths(values map[string]interface{}, prefix string) []string {
+	var paths []string
+	for key, value := range values {
+		if isEmpty(value) {
+			continue
+		}
+		if m, ok := value.(map[string]interface{}); ok {
+			paths = append(paths, leafPaths(m, prefix+key+".")...)
+			continue
+		}
+		paths = append(paths, prefix+key)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// lookup returns the value of the field at the dotted path, or nil if the manifest doesn't set it.
+func lookup(values map[string]interface{}, path string) interface{} {
+	if path == "" {
+		return nil
+	}
+	var curr interface{} = values
+	for _, key := range strings.Split(path, ".") {
+		m, ok := curr.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		curr = m[key]
+	}
+	if isEmpty(curr) {
+		return nil
+	}
+	return curr
+}
+
+func isEmpty(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case map[string]interface{}:
+		return len(v) == 0
+	case []interface{}:
+		return len(v) == 0
+	}
+	return false
+}
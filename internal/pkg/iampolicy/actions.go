@@ -0,0 +1,155 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package iampolicy
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const customResourceTypePrefix = "Custom::"
+
+// resourceActions are the IAM actions that CloudFormation calls with the caller's credentials
+// to create, update and delete a resource of each type.
+var resourceActions = map[string][]string{
+	"AWS::CodeBuild::Project": {
+		"codebuild:BatchGetProjects",
+		"codebuild:CreateProject",
+		"codebuild:DeleteProject",
+		"codebuild:UpdateProject",
+	},
+	"AWS::CodePipeline::Pipeline": {
+		"codepipeline:CreatePipeline",
+		"codepipeline:DeletePipeline",
+		"codepipeline:GetPipeline",
+		"codepipeline:GetPipelineState",
+		"codepipeline:ListTagsForResource",
+		"codepipeline:TagResource",
+		"codepipeline:UntagResource",
+		"codepipeline:UpdatePipeline",
+	},
+	"AWS::CodeStarConnections::Connection": {
+		"codestar-connections:CreateConnection",
+		"codestar-connections:DeleteConnection",
+		"codestar-connections:GetConnection",
+		"codestar-connections:ListTagsForResource",
+		"codestar-connections:TagResource",
+		"codestar-connections:UntagResource",
+	},
+	"AWS::Events::Rule": {
+		"events:DeleteRule",
+		"events:DescribeRule",
+		"events:PutRule",
+		"events:PutTargets",
+		"events:RemoveTargets",
+	},
+	"AWS::IAM::Policy": {
+		"iam:DeleteRolePolicy",
+		"iam:GetRolePolicy",
+		"iam:PutRolePolicy",
+	},
+	"AWS::IAM::Role": {
+		"iam:AttachRolePolicy",
+		"iam:CreateRole",
+		"iam:DeleteRole",
+		"iam:DeleteRolePermissionsBoundary",
+		"iam:DeleteRolePolicy",
+		"iam:DetachRolePolicy",
+		"iam:GetRole",
+		"iam:GetRolePolicy",
+		"iam:PassRole",
+		"iam:PutRolePermissionsBoundary",
+		"iam:PutRolePolicy",
+		"iam:TagRole",
+		"iam:UntagRole",
+		"iam:UpdateAssumeRolePolicy",
+		"iam:UpdateRole",
+	},
+	"AWS::Lambda::Function": {
+		"lambda:CreateFunction",
+		"lambda:DeleteFunction",
+		"lambda:GetFunction",
+		"lambda:GetFunctionConfiguration",
+		"lambda:InvokeFunction",
+		"lambda:TagResource",
+		"lambda:UntagResource",
+		"lambda:UpdateFunctionCode",
+		"lambda:UpdateFunctionConfiguration",
+	},
+	"AWS::Lambda::Permission": {
+		"lambda:AddPermission",
+		"lambda:RemovePermission",
+	},
+	"AWS::Route53::HostedZone": {
+		"route53:ChangeTagsForResource",
+		"route53:CreateHostedZone",
+		"route53:DeleteHostedZone",
+		"route53:GetChange",
+		"route53:GetHostedZone",
+		"route53:ListTagsForResource",
+	},
+	"AWS::Route53::RecordSet": {
+		"route53:ChangeResourceRecordSets",
+		"route53:GetChange",
+		"route53:GetHostedZone",
+		"route53:ListResourceRecordSets",
+	},
+}
+
+// ResourceTypes returns the sorted unique CloudFormation resource types declared in a template.
+func ResourceTypes(tpl string) ([]string, error) {
+	var body struct {
+		Resources map[string]struct {
+			Type string `yaml:"Type"`
+		} `yaml:"Resources"`
+	}
+	if err := yaml.Unmarshal([]byte(tpl), &body); err != nil {
+		return nil, fmt.Errorf("unmarshal template: %w", err)
+	}
+	seen := make(map[string]bool)
+	var types []string
+	for _, resource := range body.Resources {
+		if resource.Type == "" || seen[resource.Type] {
+			continue
+		}
+		seen[resource.Type] = true
+		types = append(types, resource.Type)
+	}
+	sort.Strings(types)
+	return types, nil
+}
+
+// actionsForResourceTypes returns the sorted unique IAM actions needed to manage resources of the given types.
+func actionsForResourceTypes(types []string) ([]string, error) {
+	var actions []string
+	for _, typ := range types {
+		if strings.HasPrefix(typ, customResourceTypePrefix) {
+			// Custom resources invoke a Lambda function declared in the same template.
+			continue
+		}
+		typActions, ok := resourceActions[typ]
+		if !ok {
+			return nil, fmt.Errorf("no IAM actions known for resource type %s", typ)
+		}
+		actions = append(actions, typActions...)
+	}
+	return uniqueSorted(actions), nil
+}
+
+func uniqueSorted(in []string) []string {
+	seen := make(map[string]bool)
+	var out []string
+	for _, s := range in {
+		if seen[s] {
+			continue
+		}
+		seen[s] = true
+		out = append(out, s)
+	}
+	sort.Strings(out)
+	return out
+}
@@ -0,0 +1,95 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package iampolicy
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceTypes(t *testing.T) {
+	testCases := map[string]struct {
+		inTemplate string
+
+		wantedTypes []string
+		wantedError error
+	}{
+		"returns the sorted unique types of the resources": {
+			inTemplate: `
+Parameters:
+  AppName:
+    Type: String
+Resources:
+  Pipeline:
+    Type: AWS::CodePipeline::Pipeline
+    Properties:
+      Name: !Ref AppName
+  BuildRole:
+    Type: AWS::IAM::Role
+  DeployRole:
+    Type: AWS::IAM::Role
+  Trigger:
+    Type: Custom::Trigger
+`,
+			wantedTypes: []string{"AWS::CodePipeline::Pipeline", "AWS::IAM::Role", "Custom::Trigger"},
+		},
+		"returns nothing if there are no resources": {
+			inTemplate: `Description: empty`,
+		},
+		"errors if the template is not valid YAML": {
+			inTemplate:  `Resources: [`,
+			wantedError: errors.New("unmarshal template: yaml: line 1: did not find expected node content"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ResourceTypes(tc.inTemplate)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedTypes, got)
+		})
+	}
+}
+
+func TestActionsForResourceTypes(t *testing.T) {
+	testCases := map[string]struct {
+		inTypes []string
+
+		wantedActions []string
+		wantedError   error
+	}{
+		"returns the sorted unique actions and skips custom resources": {
+			inTypes: []string{"AWS::Lambda::Permission", "Custom::Trigger", "AWS::IAM::Policy"},
+			wantedActions: []string{
+				"iam:DeleteRolePolicy",
+				"iam:GetRolePolicy",
+				"iam:PutRolePolicy",
+				"lambda:AddPermission",
+				"lambda:RemovePermission",
+			},
+		},
+		"errors if the resource type is unknown": {
+			inTypes:     []string{"AWS::IAM::Policy", "AWS::Foo::Bar"},
+			wantedError: errors.New("no IAM actions known for resource type AWS::Foo::Bar"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := actionsForResourceTypes(tc.inTypes)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedActions, got)
+		})
+	}
+}
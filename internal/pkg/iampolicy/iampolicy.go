@@ -0,0 +1,460 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package iampolicy generates the least-privilege IAM policies needed to run Copilot operations.
+package iampolicy
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/version"
+)
+
+// Operations that a policy can be generated for.
+const (
+	OperationInit           = "init"
+	OperationEnvInit        = "env-init"
+	OperationSvcDeploy      = "svc-deploy"
+	OperationPipelineDeploy = "pipeline-deploy"
+)
+
+// Operations is the list of operations that a policy can be generated for.
+var Operations = []string{OperationInit, OperationEnvInit, OperationSvcDeploy, OperationPipelineDeploy}
+
+const (
+	policyVersion = "2012-10-17"
+	effectAllow   = "Allow"
+
+	// anyApp matches the resources of every application.
+	anyApp = "*"
+	// Placeholder values used to render the templates deployed with the caller's credentials.
+	// Only the types of the resources in the rendered templates are used.
+	placeholderAppName      = "placeholder"
+	placeholderEnvName      = "placeholder"
+	placeholderPipelineName = "placeholder"
+	placeholderAccountID    = "111111111111"
+
+	// appBuckets matches the objects in the regional buckets of the applications.
+	// The bucket names are generated by CloudFormation and truncate the application name.
+	appBuckets = "arn:*:s3:::stackset-*/*"
+)
+
+// Document is an IAM policy document.
+type Document struct {
+	Version   string      `json:"Version"`
+	Statement []Statement `json:"Statement"`
+}
+
+// Statement is a statement in an IAM policy document.
+type Statement struct {
+	Sid       string                         `json:"Sid"`
+	Effect    string                         `json:"Effect"`
+	Action    []string                       `json:"Action"`
+	Resource  []string                       `json:"Resource"`
+	Condition map[string]map[string][]string `json:"Condition,omitempty"`
+}
+
+// JSONString returns the indented JSON representation of the policy document.
+func (d *Document) JSONString() (string, error) {
+	b, err := json.MarshalIndent(d, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("marshal policy document: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// Generate returns the IAM policy that grants the permissions to run the operation.
+// The resources of the policy are scoped to the application, or to every application if app is empty.
+//
+// CloudFormation deploys the environment and workload stacks with the environment's execution role.
+// The policy therefore only grants the actions to manage the resources of the stacks that are deployed with the
+// caller's credentials, the actions called by Copilot directly, and the permission to assume the environment manager role.
+func Generate(operation, app string) (*Document, error) {
+	if app == "" {
+		app = anyApp
+	}
+	var operations []string
+	switch operation {
+	case OperationInit:
+		// "copilot init" creates the application, an environment, and deploys a service.
+		operations = []string{operationAppInit, OperationEnvInit, OperationSvcDeploy}
+	case OperationEnvInit, OperationSvcDeploy, OperationPipelineDeploy:
+		operations = []string{operation}
+	default:
+		return nil, fmt.Errorf("unknown operation %q, must be one of %v", operation, Operations)
+	}
+	doc := &Document{
+		Version: policyVersion,
+	}
+	seen := make(map[string]bool)
+	var templates []string
+	for _, op := range operations {
+		statements, opTemplates, err := statementsFor(op, app)
+		if err != nil {
+			return nil, err
+		}
+		for _, statement := range statements {
+			if seen[statement.Sid] {
+				continue
+			}
+			seen[statement.Sid] = true
+			doc.Statement = append(doc.Statement, statement)
+		}
+		templates = append(templates, opTemplates...)
+	}
+	if len(templates) == 0 {
+		return doc, nil
+	}
+	statements, err := templateResourcesStatements(templates, resources{app: app})
+	if err != nil {
+		return nil, fmt.Errorf("generate %s policy: %w", operation, err)
+	}
+	doc.Statement = append(doc.Statement, statements...)
+	return doc, nil
+}
+
+const operationAppInit = "app-init"
+
+// statementsFor returns the statements needed by the operation,
+// and the templates that the operation deploys with the caller's credentials.
+func statementsFor(operation, app string) ([]Statement, []string, error) {
+	r := resources{app: app}
+	statements := []Statement{
+		{
+			Sid:      "CopilotCallerIdentity",
+			Action:   []string{"sts:GetCallerIdentity"},
+			Resource: []string{"*"},
+		},
+		{
+			Sid:      "CopilotReadMetadata",
+			Action:   []string{"ssm:GetParameter", "ssm:GetParameters", "ssm:GetParametersByPath"},
+			Resource: r.ssmParameters(),
+		},
+	}
+	var templates []string
+	switch operation {
+	case operationAppInit:
+		tpl, err := stack.NewAppStackConfig(&deploy.CreateAppInput{
+			Name:                  placeholderAppName,
+			AccountID:             placeholderAccountID,
+			DNSDelegationAccounts: []string{placeholderAccountID},
+			DomainName:            "example.com",
+			Version:               version.LatestTemplateVersion(),
+		}).Template()
+		if err != nil {
+			return nil, nil, fmt.Errorf("render application template: %w", err)
+		}
+		templates = append(templates, tpl)
+		statements = append(statements,
+			Statement{
+				Sid:      "CopilotWriteMetadata",
+				Action:   []string{"ssm:PutParameter", "ssm:AddTagsToResource"},
+				Resource: r.ssmParameters(),
+			},
+			Statement{
+				Sid:      "CopilotAppStack",
+				Action:   stackActions,
+				Resource: []string{r.appStack()},
+			},
+			Statement{
+				Sid:      "CopilotLookupDomain",
+				Action:   []string{"route53:ListHostedZonesByName"},
+				Resource: []string{"*"},
+			},
+			stackSetStatement(r),
+			passStackSetAdminRoleStatement(r),
+		)
+	case OperationEnvInit:
+		tpl, err := stack.NewBootstrapEnvStackConfig(&stack.EnvConfig{
+			Name: placeholderEnvName,
+			App: deploy.AppInformation{
+				Name: placeholderAppName,
+			},
+		}).Template()
+		if err != nil {
+			return nil, nil, fmt.Errorf("render environment bootstrap template: %w", err)
+		}
+		templates = append(templates, tpl)
+		statements = append(statements,
+			Statement{
+				Sid:      "CopilotWriteMetadata",
+				Action:   []string{"ssm:PutParameter", "ssm:AddTagsToResource"},
+				Resource: r.ssmParameters(),
+			},
+			Statement{
+				Sid:      "CopilotEnvStacks",
+				Action:   stackActions,
+				Resource: []string{r.envStacks()},
+			},
+			Statement{
+				Sid:      "CopilotDescribeNetwork",
+				Action:   []string{"ec2:DescribeAvailabilityZones", "ec2:DescribeSubnets", "ec2:DescribeVpcs"},
+				Resource: []string{"*"},
+			},
+			stackSetStatement(r),
+			passStackSetAdminRoleStatement(r),
+			assumeEnvManagerRoleStatement(r),
+		)
+	case OperationSvcDeploy:
+		statements = append(statements,
+			Statement{
+				Sid:      "CopilotWriteMetadata",
+				Action:   []string{"ssm:PutParameter", "ssm:AddTagsToResource"},
+				Resource: r.ssmParameters(),
+			},
+			Statement{
+				Sid:      "CopilotECRLogin",
+				Action:   []string{"ecr:GetAuthorizationToken"},
+				Resource: []string{"*"},
+			},
+			Statement{
+				Sid: "CopilotPushImages",
+				Action: []string{
+					"ecr:BatchCheckLayerAvailability",
+					"ecr:BatchGetImage",
+					"ecr:CompleteLayerUpload",
+					"ecr:DescribeImages",
+					"ecr:DescribeRepositories",
+					"ecr:InitiateLayerUpload",
+					"ecr:PutImage",
+					"ecr:UploadLayerPart",
+				},
+				Resource: []string{r.ecrRepositories()},
+			},
+			stackSetStatement(r),
+			passStackSetAdminRoleStatement(r),
+			assumeEnvManagerRoleStatement(r),
+		)
+	case OperationPipelineDeploy:
+		tpl, err := stack.NewPipelineStackConfig(&deploy.CreatePipelineInput{
+			AppName: placeholderAppName,
+			Name:    placeholderPipelineName,
+			Source: &deploy.GitHubSource{
+				ProviderName:  "GitHub",
+				Branch:        "main",
+				RepositoryURL: "https://github.com/aws/copilot-cli",
+			},
+			Build: &deploy.Build{
+				Image:           "aws/codebuild/amazonlinux2-x86_64-standard:4.0",
+				EnvironmentType: "LINUX_CONTAINER",
+				BuildspecPath:   "copilot/pipelines/placeholder/buildspec.yml",
+			},
+			ArtifactBuckets: []deploy.ArtifactBucket{
+				{
+					BucketName: "placeholder",
+					KeyArn:     fmt.Sprintf("arn:aws:kms:us-west-2:%s:key/placeholder", placeholderAccountID),
+				},
+			},
+			GitHubStatus: &deploy.GitHubStatusReport{
+				Repository:        "aws/copilot-cli",
+				AccessTokenSecret: "placeholder",
+			},
+			Trigger: &deploy.PipelineTrigger{
+				Schedule: "@daily",
+			},
+		}).Template()
+		if err != nil {
+			return nil, nil, fmt.Errorf("render pipeline template: %w", err)
+		}
+		templates = append(templates, tpl)
+		statements = append(statements,
+			Statement{
+				Sid:      "CopilotPipelineStacks",
+				Action:   stackActions,
+				Resource: []string{r.pipelineStacks()},
+			},
+			Statement{
+				Sid:      "CopilotUploadPipelineTemplate",
+				Action:   []string{"s3:GetObject", "s3:PutObject"},
+				Resource: []string{appBuckets},
+			},
+			Statement{
+				Sid:      "CopilotEncryptPipelineTemplate",
+				Action:   []string{"kms:Decrypt", "kms:GenerateDataKey"},
+				Resource: []string{"*"}, // The application's KMS keys don't have aliases.
+			},
+			Statement{
+				Sid:      "CopilotReadAppResources",
+				Action:   []string{"cloudformation:DescribeStackSet", "cloudformation:ListStackInstances"},
+				Resource: []string{r.appStackSet()},
+			},
+		)
+	}
+	for i := range statements {
+		statements[i].Effect = effectAllow
+	}
+	return statements, templates, nil
+}
+
+// passedToServices are the services that assume the roles created by the templates deployed with the caller's credentials.
+var passedToServices = []string{
+	"cloudformation.amazonaws.com",
+	"codebuild.amazonaws.com",
+	"codepipeline.amazonaws.com",
+	"events.amazonaws.com",
+	"lambda.amazonaws.com",
+}
+
+// templateResourcesStatements grants the actions to manage the resources of templates deployed with the caller's credentials.
+// The IAM role actions are scoped to the roles of the application, and the roles can only be passed to the services of the templates.
+func templateResourcesStatements(templates []string, r resources) ([]Statement, error) {
+	var types []string
+	for _, tpl := range templates {
+		tplTypes, err := ResourceTypes(tpl)
+		if err != nil {
+			return nil, err
+		}
+		types = append(types, tplTypes...)
+	}
+	actions, err := actionsForResourceTypes(types)
+	if err != nil {
+		return nil, err
+	}
+	var resourceActions, roleActions []string
+	var passRole bool
+	for _, action := range actions {
+		switch {
+		case action == "iam:PassRole":
+			passRole = true
+		case strings.HasPrefix(action, "iam:"):
+			roleActions = append(roleActions, action)
+		default:
+			resourceActions = append(resourceActions, action)
+		}
+	}
+	statements := []Statement{
+		{
+			Sid:      "CopilotStackResources",
+			Effect:   effectAllow,
+			Action:   resourceActions,
+			Resource: []string{"*"}, // The names of most resources are generated by CloudFormation.
+		},
+	}
+	if len(roleActions) > 0 {
+		statements = append(statements, Statement{
+			Sid:      "CopilotStackRoles",
+			Effect:   effectAllow,
+			Action:   roleActions,
+			Resource: r.stackRoles(),
+		})
+	}
+	if passRole {
+		statements = append(statements, Statement{
+			Sid:      "CopilotPassStackRoles",
+			Effect:   effectAllow,
+			Action:   []string{"iam:PassRole"},
+			Resource: r.stackRoles(),
+			Condition: map[string]map[string][]string{
+				"StringEquals": {
+					"iam:PassedToService": passedToServices,
+				},
+			},
+		})
+	}
+	return statements, nil
+}
+
+var stackActions = []string{
+	"cloudformation:CreateChangeSet",
+	"cloudformation:CreateStack",
+	"cloudformation:DeleteChangeSet",
+	"cloudformation:DescribeChangeSet",
+	"cloudformation:DescribeStackEvents",
+	"cloudformation:DescribeStackResources",
+	"cloudformation:DescribeStacks",
+	"cloudformation:ExecuteChangeSet",
+	"cloudformation:GetTemplate",
+	"cloudformation:GetTemplateSummary",
+	"cloudformation:ListStackResources",
+	"cloudformation:TagResource",
+	"cloudformation:UpdateStack",
+}
+
+func stackSetStatement(r resources) Statement {
+	return Statement{
+		Sid: "CopilotAppStackSet",
+		Action: []string{
+			"cloudformation:CreateStackInstances",
+			"cloudformation:CreateStackSet",
+			"cloudformation:DescribeStackSet",
+			"cloudformation:DescribeStackSetOperation",
+			"cloudformation:ListStackInstances",
+			"cloudformation:ListStackSetOperationResults",
+			"cloudformation:ListStackSetOperations",
+			"cloudformation:UpdateStackSet",
+		},
+		Resource: []string{r.appStackSet()},
+	}
+}
+
+func passStackSetAdminRoleStatement(r resources) Statement {
+	return Statement{
+		Sid:      "CopilotPassStackSetAdminRole",
+		Action:   []string{"iam:PassRole"},
+		Resource: []string{r.stackSetAdminRole()},
+	}
+}
+
+func assumeEnvManagerRoleStatement(r resources) Statement {
+	return Statement{
+		Sid:      "CopilotAssumeEnvManagerRole",
+		Action:   []string{"sts:AssumeRole"},
+		Resource: []string{r.envManagerRoles()},
+	}
+}
+
+// resources builds the ARNs of the resources of an application.
+type resources struct {
+	app string
+}
+
+func (r resources) ssmParameters() []string {
+	if r.app == anyApp {
+		return []string{"arn:*:ssm:*:*:parameter/copilot/applications/*"}
+	}
+	return []string{
+		fmt.Sprintf("arn:*:ssm:*:*:parameter/copilot/applications/%s", r.app),
+		fmt.Sprintf("arn:*:ssm:*:*:parameter/copilot/applications/%s/*", r.app),
+	}
+}
+
+func (r resources) appStack() string {
+	return fmt.Sprintf("arn:*:cloudformation:*:*:stack/%s/*", stack.NameForAppStack(r.app))
+}
+
+func (r resources) appStackSet() string {
+	return fmt.Sprintf("arn:*:cloudformation:*:*:stackset/%s:*", stack.NameForAppStackSet(r.app))
+}
+
+func (r resources) envStacks() string {
+	return fmt.Sprintf("arn:*:cloudformation:*:*:stack/%s-*/*", r.app)
+}
+
+func (r resources) pipelineStacks() string {
+	return fmt.Sprintf("arn:*:cloudformation:*:*:stack/pipeline-%s-*/*", r.app)
+}
+
+func (r resources) ecrRepositories() string {
+	return fmt.Sprintf("arn:*:ecr:*:*:repository/%s/*", r.app)
+}
+
+func (r resources) stackSetAdminRole() string {
+	return fmt.Sprintf("arn:*:iam::*:role/%s-adminrole", r.app)
+}
+
+// stackRoles matches the roles of the application and environment stacks, named after the application,
+// and the roles of the pipeline stacks, named after the pipeline stacks by CloudFormation.
+func (r resources) stackRoles() []string {
+	return []string{
+		fmt.Sprintf("arn:*:iam::*:role/%s-*", r.app),
+		fmt.Sprintf("arn:*:iam::*:role/pipeline-%s-*", r.app),
+	}
+}
+
+func (r resources) envManagerRoles() string {
+	return fmt.Sprintf("arn:*:iam::*:role/%s-*-EnvManagerRole", r.app)
+}
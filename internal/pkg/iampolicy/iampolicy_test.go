@@ -0,0 +1,201 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package iampolicy
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate(t *testing.T) {
+	testCases := map[string]struct {
+		inOperation string
+		inApp       string
+
+		wantedSids      []string
+		wantedActions   []string
+		wantedResource  map[string][]string
+		wantedCondition map[string]map[string]map[string][]string
+		wantedError     error
+	}{
+		"errors if the operation is unknown": {
+			inOperation: "svc-delete",
+			wantedError: errors.New(`unknown operation "svc-delete", must be one of [init env-init svc-deploy pipeline-deploy]`),
+		},
+		"init grants the permissions to create the app, an environment and deploy a service": {
+			inOperation: OperationInit,
+			inApp:       "phonetool",
+			wantedSids: []string{
+				"CopilotCallerIdentity",
+				"CopilotReadMetadata",
+				"CopilotWriteMetadata",
+				"CopilotAppStack",
+				"CopilotLookupDomain",
+				"CopilotAppStackSet",
+				"CopilotPassStackSetAdminRole",
+				"CopilotEnvStacks",
+				"CopilotDescribeNetwork",
+				"CopilotAssumeEnvManagerRole",
+				"CopilotECRLogin",
+				"CopilotPushImages",
+				"CopilotStackResources",
+				"CopilotStackRoles",
+				"CopilotPassStackRoles",
+			},
+			wantedActions: []string{"iam:CreateRole", "route53:CreateHostedZone", "ecr:PutImage", "sts:AssumeRole"},
+			wantedResource: map[string][]string{
+				"CopilotAppStack":       {"arn:*:cloudformation:*:*:stack/phonetool-infrastructure-roles/*"},
+				"CopilotPushImages":     {"arn:*:ecr:*:*:repository/phonetool/*"},
+				"CopilotStackResources": {"*"},
+				"CopilotStackRoles": {
+					"arn:*:iam::*:role/phonetool-*",
+					"arn:*:iam::*:role/pipeline-phonetool-*",
+				},
+			},
+		},
+		"env-init grants the permissions to create the bootstrap roles and assume the manager role": {
+			inOperation: OperationEnvInit,
+			inApp:       "phonetool",
+			wantedSids: []string{
+				"CopilotCallerIdentity",
+				"CopilotReadMetadata",
+				"CopilotWriteMetadata",
+				"CopilotEnvStacks",
+				"CopilotDescribeNetwork",
+				"CopilotAppStackSet",
+				"CopilotPassStackSetAdminRole",
+				"CopilotAssumeEnvManagerRole",
+				"CopilotStackResources",
+				"CopilotStackRoles",
+				"CopilotPassStackRoles",
+			},
+			wantedActions: []string{"iam:CreateRole", "iam:PutRolePolicy", "cloudformation:UpdateStackSet"},
+			wantedResource: map[string][]string{
+				"CopilotReadMetadata": {
+					"arn:*:ssm:*:*:parameter/copilot/applications/phonetool",
+					"arn:*:ssm:*:*:parameter/copilot/applications/phonetool/*",
+				},
+				"CopilotAssumeEnvManagerRole": {"arn:*:iam::*:role/phonetool-*-EnvManagerRole"},
+			},
+		},
+		"svc-deploy does not create resources with the caller's credentials": {
+			inOperation: OperationSvcDeploy,
+			wantedSids: []string{
+				"CopilotCallerIdentity",
+				"CopilotReadMetadata",
+				"CopilotWriteMetadata",
+				"CopilotECRLogin",
+				"CopilotPushImages",
+				"CopilotAppStackSet",
+				"CopilotPassStackSetAdminRole",
+				"CopilotAssumeEnvManagerRole",
+			},
+			wantedResource: map[string][]string{
+				"CopilotReadMetadata":         {"arn:*:ssm:*:*:parameter/copilot/applications/*"},
+				"CopilotAssumeEnvManagerRole": {"arn:*:iam::*:role/*-*-EnvManagerRole"},
+			},
+		},
+		"pipeline-deploy grants the permissions to create the pipeline resources": {
+			inOperation: OperationPipelineDeploy,
+			inApp:       "phonetool",
+			wantedSids: []string{
+				"CopilotCallerIdentity",
+				"CopilotReadMetadata",
+				"CopilotPipelineStacks",
+				"CopilotUploadPipelineTemplate",
+				"CopilotEncryptPipelineTemplate",
+				"CopilotReadAppResources",
+				"CopilotStackResources",
+				"CopilotStackRoles",
+				"CopilotPassStackRoles",
+			},
+			wantedActions: []string{
+				"codepipeline:CreatePipeline",
+				"codebuild:CreateProject",
+				"codestar-connections:CreateConnection",
+				"events:PutRule",
+				"lambda:CreateFunction",
+				"iam:PassRole",
+			},
+			wantedResource: map[string][]string{
+				"CopilotPipelineStacks": {"arn:*:cloudformation:*:*:stack/pipeline-phonetool-*/*"},
+				"CopilotPassStackRoles": {
+					"arn:*:iam::*:role/phonetool-*",
+					"arn:*:iam::*:role/pipeline-phonetool-*",
+				},
+			},
+			wantedCondition: map[string]map[string]map[string][]string{
+				"CopilotPassStackRoles": {
+					"StringEquals": {
+						"iam:PassedToService": {
+							"cloudformation.amazonaws.com",
+							"codebuild.amazonaws.com",
+							"codepipeline.amazonaws.com",
+							"events.amazonaws.com",
+							"lambda.amazonaws.com",
+						},
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := Generate(tc.inOperation, tc.inApp)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, policyVersion, got.Version)
+			var sids, actions []string
+			resources := make(map[string][]string)
+			conditions := make(map[string]map[string]map[string][]string)
+			for _, statement := range got.Statement {
+				require.Equal(t, effectAllow, statement.Effect)
+				sids = append(sids, statement.Sid)
+				actions = append(actions, statement.Action...)
+				resources[statement.Sid] = statement.Resource
+				conditions[statement.Sid] = statement.Condition
+			}
+			require.Equal(t, tc.wantedSids, sids)
+			require.Subset(t, actions, tc.wantedActions)
+			for sid, wanted := range tc.wantedResource {
+				require.Equal(t, wanted, resources[sid], "unexpected resources for statement %s", sid)
+			}
+			for sid, wanted := range tc.wantedCondition {
+				require.Equal(t, wanted, conditions[sid], "unexpected condition for statement %s", sid)
+			}
+		})
+	}
+}
+
+func TestDocument_JSONString(t *testing.T) {
+	doc := &Document{
+		Version: policyVersion,
+		Statement: []Statement{
+			{
+				Sid:      "CopilotCallerIdentity",
+				Effect:   effectAllow,
+				Action:   []string{"sts:GetCallerIdentity"},
+				Resource: []string{"*"},
+			},
+		},
+	}
+
+	got, err := doc.JSONString()
+
+	require.NoError(t, err)
+	require.True(t, json.Valid([]byte(got)))
+	require.JSONEq(t, `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {"Sid": "CopilotCallerIdentity", "Effect": "Allow", "Action": ["sts:GetCallerIdentity"], "Resource": ["*"]}
+  ]
+}`, got)
+}
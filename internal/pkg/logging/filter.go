@@ -0,0 +1,440 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package logging
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+)
+
+// JSONFilter selects the log events whose message is a JSON object matching an expression.
+//
+// An expression compares fields of the message with literals, for example:
+//
+//	level == "error" && (request.status >= 500 || retries[0].timeout)
+//
+// Fields are referenced by their path, with "." for nested objects and "[n]" for array elements.
+// Literals are double-quoted strings, numbers, true, false and null.
+// The comparison operators are ==, !=, <, <=, > and >=, and can be combined with &&, || and !.
+// A field on its own is true if it's present and is neither false nor null.
+type JSONFilter struct {
+	expr string
+	root filterNode
+}
+
+// ParseJSONFilter parses a filter expression.
+func ParseJSONFilter(expr string) (*JSONFilter, error) {
+	tokens, err := lexFilter(expr)
+	if err != nil {
+		return nil, fmt.Errorf("parse JSON filter %q: %w", expr, err)
+	}
+	p := &filterParser{tokens: tokens}
+	root, err := p.parseOr()
+	if err == nil && !p.done() {
+		err = fmt.Errorf("unexpected %s at position %d", p.peek(), p.peek().pos+1)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parse JSON filter %q: %w", expr, err)
+	}
+	return &JSONFilter{
+		expr: expr,
+		root: root,
+	}, nil
+}
+
+// String returns the expression of the filter.
+func (f *JSONFilter) String() string {
+	return f.expr
+}
+
+// Match returns true if the message is a JSON object that satisfies the filter.
+func (f *JSONFilter) Match(message string) bool {
+	var doc map[string]any
+	if err := json.Unmarshal([]byte(message), &doc); err != nil {
+		return false
+	}
+	return truthy(f.root.eval(doc))
+}
+
+func (f *JSONFilter) filter(events []*cloudwatchlogs.Event) []*cloudwatchlogs.Event {
+	if f == nil {
+		return events
+	}
+	var matched []*cloudwatchlogs.Event
+	for _, event := range events {
+		if f.Match(event.Message) {
+			matched = append(matched, event)
+		}
+	}
+	return matched
+}
+
+type filterTokenKind int
+
+const (
+	tokenIdent filterTokenKind = iota
+	tokenString
+	tokenNumber
+	tokenOperator
+	tokenEOF
+)
+
+type filterToken struct {
+	kind filterTokenKind
+	text string
+	pos  int
+}
+
+// String returns a description of the token for error messages.
+func (t filterToken) String() string {
+	if t.kind == tokenEOF {
+		return "end of expression"
+	}
+	return fmt.Sprintf("%q", t.text)
+}
+
+// filterOperators are sorted so that the longest operators are matched first.
+var filterOperators = []string{"==", "!=", "<=", ">=", "&&", "||", "<", ">", "!", "(", ")", "[", "]", "."}
+
+func lexFilter(expr string) ([]filterToken, error) {
+	var tokens []filterToken
+	for i := 0; i < len(expr); {
+		c := rune(expr[i])
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c == '"':
+			end := i + 1
+			for ; end < len(expr) && expr[end] != '"'; end++ {
+				if expr[end] == '\\' {
+					end++
+				}
+			}
+			if end >= len(expr) {
+				return nil, fmt.Errorf("unterminated string at position %d", i+1)
+			}
+			tokens = append(tokens, filterToken{kind: tokenString, text: expr[i : end+1], pos: i})
+			i = end + 1
+		case c == '-' || unicode.IsDigit(c):
+			end := i + 1
+			for end < len(expr) && (unicode.IsDigit(rune(expr[end])) || strings.ContainsRune(".eE+-", rune(expr[end]))) {
+				end++
+			}
+			tokens = append(tokens, filterToken{kind: tokenNumber, text: expr[i:end], pos: i})
+			i = end
+		case c == '_' || unicode.IsLetter(c):
+			end := i + 1
+			for end < len(expr) && (expr[end] == '_' || expr[end] == '-' || unicode.IsLetter(rune(expr[end])) || unicode.IsDigit(rune(expr[end]))) {
+				end++
+			}
+			tokens = append(tokens, filterToken{kind: tokenIdent, text: expr[i:end], pos: i})
+			i = end
+		default:
+			var op string
+			for _, candidate := range filterOperators {
+				if strings.HasPrefix(expr[i:], candidate) {
+					op = candidate
+					break
+				}
+			}
+			if op == "" {
+				return nil, fmt.Errorf("unexpected character %q at position %d", c, i+1)
+			}
+			tokens = append(tokens, filterToken{kind: tokenOperator, text: op, pos: i})
+			i += len(op)
+		}
+	}
+	return append(tokens, filterToken{kind: tokenEOF, pos: len(expr)}), nil
+}
+
+type filterParser struct {
+	tokens []filterToken
+	next   int
+}
+
+func (p *filterParser) peek() filterToken {
+	return p.tokens[p.next]
+}
+
+func (p *filterParser) done() bool {
+	return p.peek().kind == tokenEOF
+}
+
+func (p *filterParser) accept(op string) bool {
+	if t := p.peek(); t.kind == tokenOperator && t.text == op {
+		p.next++
+		return true
+	}
+	return false
+}
+
+func (p *filterParser) parseOr() (filterNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.accept("||") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &orNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseAnd() (filterNode, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.accept("&&") {
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &andNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseUnary() (filterNode, error) {
+	if p.accept("!") {
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &notNode{operand: operand}, nil
+	}
+	if p.accept("(") {
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if !p.accept(")") {
+			return nil, fmt.Errorf("expected \")\" instead of %s at position %d", p.peek(), p.peek().pos+1)
+		}
+		return expr, nil
+	}
+	return p.parseComparison()
+}
+
+func (p *filterParser) parseComparison() (filterNode, error) {
+	left, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	t := p.peek()
+	switch {
+	case t.kind == tokenOperator && (t.text == "==" || t.text == "!=" || t.text == "<" || t.text == "<=" || t.text == ">" || t.text == ">="):
+		p.next++
+		right, err := p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		return &comparisonNode{op: t.text, left: left, right: right}, nil
+	default:
+		return left, nil
+	}
+}
+
+func (p *filterParser) parseOperand() (filterNode, error) {
+	t := p.peek()
+	switch t.kind {
+	case tokenString:
+		p.next++
+		s, err := strconv.Unquote(t.text)
+		if err != nil {
+			return nil, fmt.Errorf("invalid string %s at position %d", t, t.pos+1)
+		}
+		return &literalNode{value: s}, nil
+	case tokenNumber:
+		p.next++
+		n, err := strconv.ParseFloat(t.text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %s at position %d", t, t.pos+1)
+		}
+		return &literalNode{value: n}, nil
+	case tokenIdent:
+		switch t.text {
+		case "true", "false":
+			p.next++
+			return &literalNode{value: t.text == "true"}, nil
+		case "null":
+			p.next++
+			return &literalNode{value: nil}, nil
+		}
+		return p.parsePath()
+	}
+	return nil, fmt.Errorf("expected a field or a value instead of %s at position %d", t, t.pos+1)
+}
+
+func (p *filterParser) parsePath() (filterNode, error) {
+	path := &pathNode{}
+	path.segments = append(path.segments, p.peek().text)
+	p.next++
+	for {
+		switch {
+		case p.accept("."):
+			t := p.peek()
+			if t.kind != tokenIdent {
+				return nil, fmt.Errorf("expected a field name instead of %s at position %d", t, t.pos+1)
+			}
+			p.next++
+			path.segments = append(path.segments, t.text)
+		case p.accept("["):
+			t := p.peek()
+			index, err := strconv.Atoi(t.text)
+			if t.kind != tokenNumber || err != nil || index < 0 {
+				return nil, fmt.Errorf("expected an array index instead of %s at position %d", t, t.pos+1)
+			}
+			p.next++
+			if !p.accept("]") {
+				return nil, fmt.Errorf("expected \"]\" instead of %s at position %d", p.peek(), p.peek().pos+1)
+			}
+			path.segments = append(path.segments, index)
+		default:
+			return path, nil
+		}
+	}
+}
+
+type filterNode interface {
+	eval(doc map[string]any) any
+}
+
+type literalNode struct {
+	value any
+}
+
+func (n *literalNode) eval(_ map[string]any) any {
+	return n.value
+}
+
+// pathNode is a reference to a field, where each segment is either an object key or an array index.
+type pathNode struct {
+	segments []any
+}
+
+func (n *pathNode) eval(doc map[string]any) any {
+	var cur any = doc
+	for _, segment := range n.segments {
+		switch key := segment.(type) {
+		case string:
+			obj, ok := cur.(map[string]any)
+			if !ok {
+				return nil
+			}
+			cur = obj[key]
+		case int:
+			arr, ok := cur.([]any)
+			if !ok || key >= len(arr) {
+				return nil
+			}
+			cur = arr[key]
+		}
+	}
+	return cur
+}
+
+type notNode struct {
+	operand filterNode
+}
+
+func (n *notNode) eval(doc map[string]any) any {
+	return !truthy(n.operand.eval(doc))
+}
+
+type andNode struct {
+	left, right filterNode
+}
+
+func (n *andNode) eval(doc map[string]any) any {
+	return truthy(n.left.eval(doc)) && truthy(n.right.eval(doc))
+}
+
+type orNode struct {
+	left, right filterNode
+}
+
+func (n *orNode) eval(doc map[string]any) any {
+	return truthy(n.left.eval(doc)) || truthy(n.right.eval(doc))
+}
+
+type comparisonNode struct {
+	op          string
+	left, right filterNode
+}
+
+func (n *comparisonNode) eval(doc map[string]any) any {
+	left, right := n.left.eval(doc), n.right.eval(doc)
+	switch n.op {
+	case "==":
+		return equal(left, right)
+	case "!=":
+		return !equal(left, right)
+	}
+	// Values can only be ordered if they're both numbers or both strings.
+	var cmp int
+	switch l := left.(type) {
+	case float64:
+		r, ok := right.(float64)
+		if !ok {
+			return false
+		}
+		switch {
+		case l < r:
+			cmp = -1
+		case l > r:
+			cmp = 1
+		}
+	case string:
+		r, ok := right.(string)
+		if !ok {
+			return false
+		}
+		cmp = strings.Compare(l, r)
+	default:
+		return false
+	}
+	switch n.op {
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	default:
+		return cmp >= 0
+	}
+}
+
+// equal returns true if two scalar values are equal. Objects and arrays are never equal to a literal.
+func equal(left, right any) bool {
+	switch left.(type) {
+	case map[string]any, []any:
+		return false
+	}
+	switch right.(type) {
+	case map[string]any, []any:
+		return false
+	}
+	return left == right
+}
+
+// truthy returns false for missing fields, null and false, and true for any other value.
+func truthy(v any) bool {
+	switch v := v.(type) {
+	case nil:
+		return false
+	case bool:
+		return v
+	}
+	return true
+}
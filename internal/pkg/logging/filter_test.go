@@ -0,0 +1,145 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package logging
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseJSONFilter(t *testing.T) {
+	testCases := map[string]struct {
+		inExpr string
+
+		wantedErr error
+	}{
+		"valid expression": {
+			inExpr: `level=="error" && (request.status>=500 || !retried) && tags[0] != null`,
+		},
+		"error on an unterminated string": {
+			inExpr:    `level == "error`,
+			wantedErr: errors.New(`parse JSON filter "level == \"error": unterminated string at position 10`),
+		},
+		"error on an unknown character": {
+			inExpr:    `level = "error"`,
+			wantedErr: errors.New(`parse JSON filter "level = \"error\"": unexpected character '=' at position 7`),
+		},
+		"error on a missing operand": {
+			inExpr:    `level == `,
+			wantedErr: errors.New(`parse JSON filter "level == ": expected a field or a value instead of end of expression at position 10`),
+		},
+		"error on a missing closing parenthesis": {
+			inExpr:    `(level == "error"`,
+			wantedErr: errors.New(`parse JSON filter "(level == \"error\"": expected ")" instead of end of expression at position 18`),
+		},
+		"error on an invalid array index": {
+			inExpr:    `tags[a] == "x"`,
+			wantedErr: errors.New(`parse JSON filter "tags[a] == \"x\"": expected an array index instead of "a" at position 6`),
+		},
+		"error on trailing tokens": {
+			inExpr:    `level == "error" "warn"`,
+			wantedErr: errors.New(`parse JSON filter "level == \"error\" \"warn\"": unexpected "\"warn\"" at position 18`),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			filter, err := ParseJSONFilter(tc.inExpr)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.inExpr, filter.String())
+		})
+	}
+}
+
+func TestJSONFilter_Match(t *testing.T) {
+	const message = `{"level":"error","request":{"status":503,"path":"/api"},"retried":false,"tags":["db","timeout"],"user":null}`
+	testCases := map[string]struct {
+		inExpr    string
+		inMessage string
+
+		wanted bool
+	}{
+		"string equality": {
+			inExpr: `level == "error"`,
+			wanted: true,
+		},
+		"string inequality": {
+			inExpr: `level != "error"`,
+			wanted: false,
+		},
+		"nested number comparison": {
+			inExpr: `request.status >= 500`,
+			wanted: true,
+		},
+		"combined comparisons": {
+			inExpr: `level=="error" && request.status>=500`,
+			wanted: true,
+		},
+		"or with a false left side": {
+			inExpr: `request.status < 500 || request.path == "/api"`,
+			wanted: true,
+		},
+		"negation of a false field": {
+			inExpr: `!retried`,
+			wanted: true,
+		},
+		"array element": {
+			inExpr: `tags[1] == "timeout"`,
+			wanted: true,
+		},
+		"array index out of range is missing": {
+			inExpr: `tags[5]`,
+			wanted: false,
+		},
+		"null field equals null": {
+			inExpr: `user == null`,
+			wanted: true,
+		},
+		"missing field equals null": {
+			inExpr: `trace.id == null`,
+			wanted: true,
+		},
+		"missing field is not equal to a value": {
+			inExpr: `trace.id != "abc"`,
+			wanted: true,
+		},
+		"values of different types cannot be ordered": {
+			inExpr: `level > 1`,
+			wanted: false,
+		},
+		"objects are not equal to literals": {
+			inExpr: `request == "/api"`,
+			wanted: false,
+		},
+		"and binds tighter than or": {
+			inExpr: `level == "info" && retried || request.status == 503`,
+			wanted: true,
+		},
+		"messages that aren't JSON objects never match": {
+			inExpr:    `level == "error"`,
+			inMessage: `ERROR something went wrong`,
+			wanted:    false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			filter, err := ParseJSONFilter(tc.inExpr)
+			require.NoError(t, err)
+			msg := message
+			if tc.inMessage != "" {
+				msg = tc.inMessage
+			}
+
+			require.Equal(t, tc.wanted, filter.Match(msg))
+		})
+	}
+}
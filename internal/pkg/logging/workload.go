@@ -66,16 +66,26 @@ type workloadLogger struct {
 }
 
 // WriteLogEvents writes service logs.
-func (s *workloadLogger) writeEventLogs(logEventsOpts cloudwatchlogs.LogEventsOpts, onEvent func(io.Writer, []HumanJSONStringer) error, follow bool) error {
+func (s *workloadLogger) writeEventLogs(logEventsOpts cloudwatchlogs.LogEventsOpts, opts WriteLogEventsOpts) error {
+	if opts.AllPages {
+		// Write each page as soon as it's retrieved so that the log events in the time range aren't all held in memory.
+		logEventsOpts.OnPage = func(events []*cloudwatchlogs.Event) error {
+			return opts.OnEvents(s.w, cwEventsToHumanJSONStringers(opts.Filter.filter(events)))
+		}
+		if _, err := s.eventsGetter.LogEvents(logEventsOpts); err != nil {
+			return fmt.Errorf("get log events for log group %s: %w", logEventsOpts.LogGroup, err)
+		}
+		return nil
+	}
 	for {
 		logEventsOutput, err := s.eventsGetter.LogEvents(logEventsOpts)
 		if err != nil {
 			return fmt.Errorf("get log events for log group %s: %w", logEventsOpts.LogGroup, err)
 		}
-		if err := onEvent(s.w, cwEventsToHumanJSONStringers(logEventsOutput.Events)); err != nil {
+		if err := opts.OnEvents(s.w, cwEventsToHumanJSONStringers(opts.Filter.filter(logEventsOutput.Events))); err != nil {
 			return err
 		}
-		if !follow {
+		if !opts.Follow {
 			return nil
 		}
 		// For unit test.
@@ -131,7 +141,7 @@ func (s *ECSServiceLogger) WriteLogEvents(opts WriteLogEventsOpts) error {
 		LogStreamLimit:         opts.LogStreamLimit,
 		LogStreamPrefixFilters: s.logStreamPrefixes(opts.TaskIDs, opts.ContainerName),
 	}
-	return s.workloadLogger.writeEventLogs(logEventsOpts, opts.OnEvents, opts.Follow)
+	return s.workloadLogger.writeEventLogs(logEventsOpts, opts)
 }
 
 func (s *ECSServiceLogger) logStreamPrefixes(taskIDs []string, container string) []string {
@@ -199,7 +209,7 @@ func (s *AppRunnerServiceLogger) WriteLogEvents(opts WriteLogEventsOpts) error {
 		StreamLastEventTime: nil,
 		LogStreamLimit:      opts.LogStreamLimit,
 	}
-	return s.workloadLogger.writeEventLogs(logEventsOpts, opts.OnEvents, opts.Follow)
+	return s.workloadLogger.writeEventLogs(logEventsOpts, opts)
 }
 
 // NewJobLogger returns an JobLogger for the job under env and app.
@@ -233,7 +243,7 @@ func (s *JobLogger) WriteLogEvents(opts WriteLogEventsOpts) error {
 		LogStreamLimit:         logStreamLimit,
 		LogStreamPrefixFilters: s.logStreamPrefixes(opts.TaskIDs, opts.IncludeStateMachineLogs),
 	}
-	return s.workloadLogger.writeEventLogs(logEventsOpts, opts.OnEvents, opts.Follow)
+	return s.workloadLogger.writeEventLogs(logEventsOpts, opts)
 }
 
 //  The log stream prefixes for a job should be:
@@ -257,7 +267,12 @@ type WriteLogEventsOpts struct {
 	EndTime   *int64
 	// OnEvents is a handler that's invoked when logs are retrieved from the service.
 	OnEvents func(w io.Writer, logs []HumanJSONStringer) error
+	// Filter is an optional filter on the JSON messages of the log events. Events that don't match are not passed to OnEvents.
+	Filter   *JSONFilter
 	LogGroup string
+	// AllPages retrieves all the log events in the time range, instead of only the latest page of each log stream,
+	// and invokes OnEvents with each page as it's retrieved. It can't be used with Follow.
+	AllPages bool
 
 	// Job specific options.
 	IncludeStateMachineLogs bool
@@ -274,8 +289,8 @@ func (o WriteLogEventsOpts) limit() *int64 {
 	if o.Limit != nil {
 		return o.Limit
 	}
-	if o.hasTimeFilters() {
-		// If time filtering is set, then set limit to be maximum number.
+	if o.AllPages || o.hasTimeFilters() {
+		// If time filtering is set or all the log events are retrieved, then set limit to be maximum number.
 		// https://docs.aws.amazon.com/AmazonCloudWatchLogs/latest/APIReference/API_GetLogEvents.html#CWL-GetLogEvents-request-limit
 		return nil
 	}
@@ -59,6 +59,8 @@ firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "WARN some warnin
 		jsonOutput    bool
 		taskIDs       []string
 		containerName string
+		allPages      bool
+		jsonFilter    string
 		setupMocks    func(mocks workloadLogsMocks)
 
 		wantedError   error
@@ -97,6 +99,48 @@ firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "WARN some warnin
 			},
 			wantedContent: logEventsJSONString,
 		},
+		"success writing each page of log events as it's retrieved": {
+			jsonOutput: true,
+			allPages:   true,
+			setupMocks: func(m workloadLogsMocks) {
+				m.logGetter.EXPECT().LogEvents(gomock.Any()).
+					DoAndReturn(func(param cloudwatchlogs.LogEventsOpts) (*cloudwatchlogs.LogEventsOutput, error) {
+						require.Equal(t, (*int64)(nil), param.Limit)
+						require.NotNil(t, param.OnPage)
+						for _, event := range mockLogEvents {
+							if err := param.OnPage([]*cloudwatchlogs.Event{event}); err != nil {
+								return nil, err
+							}
+						}
+						return &cloudwatchlogs.LogEventsOutput{}, nil
+					})
+			},
+			wantedContent: logEventsJSONString,
+		},
+		"success writing only the log events that match the JSON filter": {
+			jsonFilter: `level == "error" && status >= 500`,
+			setupMocks: func(m workloadLogsMocks) {
+				m.logGetter.EXPECT().LogEvents(gomock.Any()).
+					Return(&cloudwatchlogs.LogEventsOutput{
+						Events: []*cloudwatchlogs.Event{
+							{
+								LogStreamName: "copilot/mockSvc/fcfe4ab8043841c08162318e5ad805f1",
+								Message:       `{"level":"error","status":503,"msg":"upstream timeout"}`,
+							},
+							{
+								LogStreamName: "copilot/mockSvc/fcfe4ab8043841c08162318e5ad805f1",
+								Message:       `{"level":"info","status":200,"msg":"ok"}`,
+							},
+							{
+								LogStreamName: "copilot/mockSvc/fcfe4ab8043841c08162318e5ad805f1",
+								Message:       `level=error status=503`,
+							},
+						},
+					}, nil)
+			},
+			wantedContent: `copilot/mockSvc/fcfe4ab80 {"level":"error","status":503,"msg":"upstream timeout"}
+`,
+		},
 		"success with follow flag": {
 			follow: true,
 			setupMocks: func(m workloadLogsMocks) {
@@ -224,6 +268,12 @@ firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "GET / HTTP/1.1"
 			if tc.jsonOutput {
 				logWriter = WriteJSONLogs
 			}
+			var filter *JSONFilter
+			if tc.jsonFilter != "" {
+				var err error
+				filter, err = ParseJSONFilter(tc.jsonFilter)
+				require.NoError(t, err)
+			}
 			err := svcLogs.WriteLogEvents(WriteLogEventsOpts{
 				Follow:        tc.follow,
 				TaskIDs:       tc.taskIDs,
@@ -232,6 +282,8 @@ firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "GET / HTTP/1.1"
 				OnEvents:      logWriter,
 				ContainerName: tc.containerName,
 				LogGroup:      mockLogGroupName,
+				AllPages:      tc.allPages,
+				Filter:        filter,
 			})
 
 			// THEN
@@ -25,28 +25,28 @@ func TestApplyEnv_Bool(t *testing.T) {
 	}{
 		"bool value overridden": {
 			inSvc: func(svc *LoadBalancedWebService) {
-				svc.HTTPOrBool.Main.Stickiness = aws.Bool(false)
-				svc.Environments["test"].HTTPOrBool.Main.Stickiness = aws.Bool(true)
+				svc.HTTPOrBool.Main.Stickiness = BasicToUnion[*bool, StickinessConfig](aws.Bool(false))
+				svc.Environments["test"].HTTPOrBool.Main.Stickiness = BasicToUnion[*bool, StickinessConfig](aws.Bool(true))
 			},
 			wanted: func(svc *LoadBalancedWebService) {
-				svc.HTTPOrBool.Main.Stickiness = aws.Bool(true)
+				svc.HTTPOrBool.Main.Stickiness = BasicToUnion[*bool, StickinessConfig](aws.Bool(true))
 			},
 		},
 		"bool value overridden by zero value": {
 			inSvc: func(svc *LoadBalancedWebService) {
-				svc.HTTPOrBool.Main.Stickiness = aws.Bool(true)
-				svc.Environments["test"].HTTPOrBool.Main.Stickiness = aws.Bool(false)
+				svc.HTTPOrBool.Main.Stickiness = BasicToUnion[*bool, StickinessConfig](aws.Bool(true))
+				svc.Environments["test"].HTTPOrBool.Main.Stickiness = BasicToUnion[*bool, StickinessConfig](aws.Bool(false))
 			},
 			wanted: func(svc *LoadBalancedWebService) {
-				svc.HTTPOrBool.Main.Stickiness = aws.Bool(false)
+				svc.HTTPOrBool.Main.Stickiness = BasicToUnion[*bool, StickinessConfig](aws.Bool(false))
 			},
 		},
 		"bool value not overridden": {
 			inSvc: func(svc *LoadBalancedWebService) {
-				svc.HTTPOrBool.Main.Stickiness = aws.Bool(true)
+				svc.HTTPOrBool.Main.Stickiness = BasicToUnion[*bool, StickinessConfig](aws.Bool(true))
 			},
 			wanted: func(svc *LoadBalancedWebService) {
-				svc.HTTPOrBool.Main.Stickiness = aws.Bool(true)
+				svc.HTTPOrBool.Main.Stickiness = BasicToUnion[*bool, StickinessConfig](aws.Bool(true))
 			},
 		},
 	}
@@ -20,6 +20,7 @@ const (
 type BackendService struct {
 	Workload             `yaml:",inline"`
 	BackendServiceConfig `yaml:",inline"`
+	Deploy               WorkloadDeploy `yaml:"deploy"`
 	// Use *BackendServiceConfig because of https://github.com/imdario/mergo/issues/146
 	Environments map[string]*BackendServiceConfig `yaml:",flow"`
 	parser       template.Parser
@@ -38,6 +39,8 @@ type BackendServiceConfig struct {
 	TaskDefOverrides []OverrideRule            `yaml:"taskdef_overrides"`
 	DeployConfig     DeploymentConfig          `yaml:"deployment"`
 	Observability    Observability             `yaml:"observability"`
+	Cost             Cost                      `yaml:"cost"`
+	AppConfig        AppConfig                 `yaml:"appconfig"`
 }
 
 // BackendServiceProps represents the configuration needed to create a backend service.
@@ -123,6 +126,21 @@ func (s *BackendService) Publish() []Topic {
 	return s.BackendServiceConfig.PublishConfig.publishedTopics()
 }
 
+// ImageTags returns the additional tag templates of the main container image.
+func (s *BackendService) ImageTags() []string {
+	return s.ImageConfig.Image.Tags
+}
+
+// ImageLifecycle returns the lifecycle policy of the ECR repository of the workload.
+func (s *BackendService) ImageLifecycle() ImageLifecycle {
+	return s.ImageConfig.Image.Lifecycle
+}
+
+// TracingConfigFile returns the path to the configuration of the OpenTelemetry collector sidecar, if any.
+func (s *BackendService) TracingConfigFile() string {
+	return s.Observability.TracingConfigFile()
+}
+
 // BuildArgs returns a docker.BuildArguments object for the service given a context directory.
 func (s *BackendService) BuildArgs(contextDir string) (map[string]*DockerBuildArgs, error) {
 	required, err := requiresBuild(s.ImageConfig.Image)
@@ -147,7 +165,7 @@ func (s *BackendService) EnvFiles() map[string]string {
 // ContainerDependencies returns a map of ContainerDependency objects for the BackendService
 // including dependencies for its main container, any logging sidecar, and additional sidecars.
 func (s *BackendService) ContainerDependencies() map[string]ContainerDependency {
-	return containerDependencies(aws.StringValue(s.Name), s.ImageConfig.Image, s.Logging, s.Sidecars)
+	return containerDependencies(aws.StringValue(s.Name), s.ImageConfig.Image, s.ImageConfig.HealthCheck, s.Logging, s.Sidecars, s.TaskConfig)
 }
 
 func (s *BackendService) subnets() *SubnetListOrArgs {
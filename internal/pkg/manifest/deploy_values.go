@@ -0,0 +1,147 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Keys of the manifest fields that can be overridden at deploy time.
+const (
+	DeployValueCount           = "count"
+	DeployValueCPU             = "cpu"
+	DeployValueMemory          = "memory"
+	DeployValueVariablesPrefix = "variables."
+)
+
+const (
+	environmentsKey = "environments"
+
+	// maxDeployValueCount is the quota of Amazon ECS on the number of tasks of a service.
+	maxDeployValueCount = 5000
+)
+
+var (
+	// DeployValueKeys is the allowlist of keys of the manifest fields that can be overridden at deploy time.
+	DeployValueKeys = []string{DeployValueCount, DeployValueCPU, DeployValueMemory, DeployValueVariablesPrefix + "<NAME>"}
+
+	deployValueVariableRegExp = regexp.MustCompile(`^[_a-zA-Z][_a-zA-Z0-9]*$`)
+)
+
+// ValidateDeployValue returns an error if the manifest field can't be overridden at deploy time,
+// or if the value is invalid for the field.
+func ValidateDeployValue(key, value string) error {
+	switch {
+	case key == DeployValueCount:
+		if strings.HasPrefix(value, "arn:") {
+			return nil // Request-Driven Web Services reference an auto scaling configuration instead of a number of tasks.
+		}
+		if v, err := strconv.Atoi(value); err != nil || v < 0 || v > maxDeployValueCount {
+			return fmt.Errorf("value %q for %q must be an integer between 0 and %d, or the ARN of an App Runner auto scaling configuration", value, key, maxDeployValueCount)
+		}
+		return nil
+	case key == DeployValueCPU || key == DeployValueMemory:
+		if v, err := strconv.Atoi(value); err != nil || v <= 0 {
+			return fmt.Errorf("value %q for %q must be a positive integer", value, key)
+		}
+		return nil
+	case strings.HasPrefix(key, DeployValueVariablesPrefix):
+		name := strings.TrimPrefix(key, DeployValueVariablesPrefix)
+		if !deployValueVariableRegExp.MatchString(name) {
+			return fmt.Errorf("variable name %q in %q must only contain letters, digits and underscores, and not start with a digit", name, key)
+		}
+		return nil
+	}
+	return fmt.Errorf("%q is not a manifest field that can be overridden at deploy time: must be one of %s", key, strings.Join(DeployValueKeys, ", "))
+}
+
+// ApplyDeployValues overrides the fields of the manifest for the environment with the values provided at deploy time.
+// The values are written under the environment's section of "environments" so that they take precedence
+// over both the default and the environment-specific configuration.
+func ApplyDeployValues(mft, env string, values map[string]string) (string, error) {
+	if len(values) == 0 {
+		return mft, nil
+	}
+	doc, err := unmarshalYAML([]byte(mft))
+	if err != nil {
+		return "", err
+	}
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return "", errors.New("manifest must be a YAML mapping")
+	}
+	envNode, err := mappingValue(doc.Content[0], environmentsKey)
+	if err != nil {
+		return "", err
+	}
+	envNode, err = mappingValue(envNode, env)
+	if err != nil {
+		return "", fmt.Errorf("%s.%s: %w", environmentsKey, env, err)
+	}
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if err := ValidateDeployValue(key, values[key]); err != nil {
+			return "", err
+		}
+		parent, field := envNode, key
+		scalar := &yaml.Node{
+			Kind:  yaml.ScalarNode,
+			Value: values[key],
+		}
+		if strings.HasPrefix(key, DeployValueVariablesPrefix) {
+			if parent, err = mappingValue(envNode, strings.TrimSuffix(DeployValueVariablesPrefix, ".")); err != nil {
+				return "", fmt.Errorf("%s: %w", key, err)
+			}
+			field = strings.TrimPrefix(key, DeployValueVariablesPrefix)
+			scalar.Style = yaml.DoubleQuotedStyle // Variables are always strings.
+		}
+		setMappingValue(parent, field, scalar)
+	}
+	out, err := marshalYAML(doc)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+// mappingValue returns the mapping node stored under key, and adds an empty one if the key doesn't exist.
+func mappingValue(node *yaml.Node, key string) (*yaml.Node, error) {
+	for i := 0; i < len(node.Content); i += 2 {
+		if node.Content[i].Value != key {
+			continue
+		}
+		value := node.Content[i+1]
+		if value.Kind == yaml.ScalarNode && value.Tag == "!!null" {
+			*value = yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+		}
+		if value.Kind != yaml.MappingNode {
+			return nil, fmt.Errorf("%q must be a mapping", key)
+		}
+		return value, nil
+	}
+	value := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: key}, value)
+	return value, nil
+}
+
+// setMappingValue stores value under key in the mapping node, replacing any existing value.
+func setMappingValue(node *yaml.Node, key string, value *yaml.Node) {
+	for i := 0; i < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			node.Content[i+1] = value
+			return
+		}
+	}
+	node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: key}, value)
+}
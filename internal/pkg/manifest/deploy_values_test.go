@@ -0,0 +1,229 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateDeployValue(t *testing.T) {
+	testCases := map[string]struct {
+		inKey   string
+		inValue string
+
+		wantedError error
+	}{
+		"count": {
+			inKey:   "count",
+			inValue: "3",
+		},
+		"count of zero": {
+			inKey:   "count",
+			inValue: "0",
+		},
+		"count of an auto scaling configuration": {
+			inKey:   "count",
+			inValue: "arn:aws:apprunner:us-east-1:123456789012:autoscalingconfiguration/high-availability/1/3f4c1b2e",
+		},
+		"error if count is negative": {
+			inKey:       "count",
+			inValue:     "-1",
+			wantedError: errors.New(`value "-1" for "count" must be an integer between 0 and 5000, or the ARN of an App Runner auto scaling configuration`),
+		},
+		"error if count is above the number of tasks of a service": {
+			inKey:       "count",
+			inValue:     "5001",
+			wantedError: errors.New(`value "5001" for "count" must be an integer between 0 and 5000, or the ARN of an App Runner auto scaling configuration`),
+		},
+		"error if count is not a number": {
+			inKey:       "count",
+			inValue:     "three",
+			wantedError: errors.New(`value "three" for "count" must be an integer between 0 and 5000, or the ARN of an App Runner auto scaling configuration`),
+		},
+		"cpu": {
+			inKey:   "cpu",
+			inValue: "512",
+		},
+		"variable": {
+			inKey:   "variables.LOG_LEVEL",
+			inValue: "debug",
+		},
+		"error if memory is not a number": {
+			inKey:       "memory",
+			inValue:     "1GB",
+			wantedError: errors.New(`value "1GB" for "memory" must be a positive integer`),
+		},
+		"error if the variable name is invalid": {
+			inKey:       "variables.1-LEVEL",
+			inValue:     "debug",
+			wantedError: errors.New(`variable name "1-LEVEL" in "variables.1-LEVEL" must only contain letters, digits and underscores, and not start with a digit`),
+		},
+		"error if the field is not in the allowlist": {
+			inKey:       "secrets.DB_PASSWORD",
+			inValue:     "/db/password",
+			wantedError: errors.New(`"secrets.DB_PASSWORD" is not a manifest field that can be overridden at deploy time: must be one of count, cpu, memory, variables.<NAME>`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateDeployValue(tc.inKey, tc.inValue)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestApplyDeployValues(t *testing.T) {
+	testCases := map[string]struct {
+		inManifest string
+		inValues   map[string]string
+
+		wantedManifest string
+		wantedError    error
+	}{
+		"returns the manifest as is without values": {
+			inManifest:     "name: api\n",
+			wantedManifest: "name: api\n",
+		},
+		"adds the environment section": {
+			inManifest: `name: api
+type: Backend Service
+count: 1
+`,
+			inValues: map[string]string{
+				"count":               "3",
+				"variables.LOG_LEVEL": "debug",
+			},
+			wantedManifest: `name: api
+type: Backend Service
+count: 1
+environments:
+  test:
+    count: 3
+    variables:
+      LOG_LEVEL: "debug"
+`,
+		},
+		"overrides the existing environment configuration": {
+			inManifest: `name: api
+environments:
+  prod:
+    count: 5
+  test:
+    cpu: 256
+    variables:
+      LOG_LEVEL: info
+      REGION: us-west-2
+`,
+			inValues: map[string]string{
+				"cpu":                 "1024",
+				"variables.LOG_LEVEL": "true",
+			},
+			wantedManifest: `name: api
+environments:
+  prod:
+    count: 5
+  test:
+    cpu: 1024
+    variables:
+      LOG_LEVEL: "true"
+      REGION: us-west-2
+`,
+		},
+		"replaces an empty environment section": {
+			inManifest: `name: api
+environments:
+  test:
+`,
+			inValues: map[string]string{
+				"memory": "2048",
+			},
+			wantedManifest: `name: api
+environments:
+  test:
+    memory: 2048
+`,
+		},
+		"error if the field is not in the allowlist": {
+			inManifest: "name: api\n",
+			inValues: map[string]string{
+				"image.location": "nginx",
+			},
+			wantedError: errors.New(`"image.location" is not a manifest field that can be overridden at deploy time: must be one of count, cpu, memory, variables.<NAME>`),
+		},
+		"error if the environment section is not a mapping": {
+			inManifest: `name: api
+environments:
+  test: []
+`,
+			inValues: map[string]string{
+				"count": "1",
+			},
+			wantedError: errors.New(`environments.test: "test" must be a mapping`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ApplyDeployValues(tc.inManifest, "test", tc.inValues)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedManifest, got)
+		})
+	}
+}
+
+func TestApplyDeployValues_TakePrecedence(t *testing.T) {
+	// GIVEN
+	mft := `name: api
+type: Backend Service
+image:
+  location: nginx
+cpu: 256
+memory: 512
+count:
+  range: 1-10
+  cpu_percentage: 70
+variables:
+  LOG_LEVEL: info
+environments:
+  test:
+    cpu: 512
+    variables:
+      LOG_LEVEL: warn
+`
+
+	// WHEN
+	overridden, err := ApplyDeployValues(mft, "test", map[string]string{
+		"count":               "2",
+		"cpu":                 "1024",
+		"memory":              "2048",
+		"variables.LOG_LEVEL": "debug",
+	})
+	require.NoError(t, err)
+	wkld, err := UnmarshalWorkload([]byte(overridden))
+	require.NoError(t, err)
+	envWkld, err := wkld.ApplyEnv("test")
+	require.NoError(t, err)
+
+	// THEN
+	svc := envWkld.Manifest().(*BackendService)
+	require.Equal(t, aws.Int(1024), svc.CPU)
+	require.Equal(t, aws.Int(2048), svc.Memory)
+	require.Equal(t, aws.Int(2), svc.Count.Value)
+	require.True(t, svc.Count.AdvancedCount.IsEmpty())
+	logLevel := svc.Variables["LOG_LEVEL"]
+	require.Equal(t, "debug", logLevel.Value())
+}
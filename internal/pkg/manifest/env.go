@@ -6,7 +6,11 @@ package manifest
 import (
 	"errors"
 	"fmt"
+	"net"
+	"slices"
 	"sort"
+	"strconv"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/config"
@@ -39,15 +43,24 @@ type EnvironmentProps struct {
 	Name         string
 	CustomConfig *config.CustomizeEnv
 	Telemetry    *config.Telemetry
+	KMSKeyARN    string
+	ClusterName  string // Name of an existing ECS cluster to use instead of creating one.
 }
 
 // NewEnvironment creates a new environment manifest object.
 func NewEnvironment(props *EnvironmentProps) *Environment {
-	return FromEnvConfig(&config.Environment{
+	mft := FromEnvConfig(&config.Environment{
 		Name:         props.Name,
 		CustomConfig: props.CustomConfig,
 		Telemetry:    props.Telemetry,
 	}, template.New())
+	if props.KMSKeyARN != "" {
+		mft.Encryption.KMSKey = stringP(props.KMSKeyARN)
+	}
+	if props.ClusterName != "" {
+		mft.Cluster.Name = stringP(props.ClusterName)
+	}
+	return mft
 }
 
 // FromEnvConfig transforms an environment configuration into a manifest.
@@ -91,10 +104,16 @@ func (e *Environment) MarshalBinary() ([]byte, error) {
 
 // EnvironmentConfig defines the configuration settings for an environment manifest
 type EnvironmentConfig struct {
-	Network       environmentNetworkConfig `yaml:"network,omitempty,flow"`
-	Observability environmentObservability `yaml:"observability,omitempty,flow"`
-	HTTPConfig    EnvironmentHTTPConfig    `yaml:"http,omitempty,flow"`
-	CDNConfig     EnvironmentCDNConfig     `yaml:"cdn,omitempty,flow"`
+	Network             environmentNetworkConfig       `yaml:"network,omitempty,flow"`
+	Observability       environmentObservability       `yaml:"observability,omitempty,flow"`
+	HTTPConfig          EnvironmentHTTPConfig          `yaml:"http,omitempty,flow"`
+	CDNConfig           EnvironmentCDNConfig           `yaml:"cdn,omitempty,flow"`
+	Encryption          environmentEncryption          `yaml:"encryption,omitempty,flow"`
+	Tasks               environmentTasks               `yaml:"tasks,omitempty,flow"`
+	Backups             environmentBackups             `yaml:"backups,omitempty,flow"`
+	Cluster             environmentCluster             `yaml:"cluster,omitempty,flow"`
+	Budget              environmentBudget              `yaml:"budget,omitempty,flow"`
+	ELBAccessLogsBucket environmentELBAccessLogsBucket `yaml:"elb_access_logs_bucket,omitempty,flow"`
 }
 
 // IsPublicLBIngressRestrictedToCDN returns whether an environment has its
@@ -115,7 +134,18 @@ func (mft *EnvironmentConfig) GetPublicALBSourceIPs() []IPNet {
 }
 
 type environmentNetworkConfig struct {
-	VPC environmentVPCConfig `yaml:"vpc,omitempty"`
+	VPC     environmentVPCConfig     `yaml:"vpc,omitempty"`
+	Connect environmentConnectConfig `yaml:"connect,omitempty"`
+}
+
+// environmentConnectConfig holds the Service Connect configuration of the environment.
+type environmentConnectConfig struct {
+	Namespace *string `yaml:"namespace,omitempty"` // ARN of a Cloud Map namespace shared with other applications.
+}
+
+// IsEmpty returns true if the environment uses its own namespace for Service Connect.
+func (cfg environmentConnectConfig) IsEmpty() bool {
+	return cfg.Namespace == nil
 }
 
 type environmentVPCConfig struct {
@@ -124,15 +154,92 @@ type environmentVPCConfig struct {
 	Subnets             subnetsConfiguration          `yaml:"subnets,omitempty"`
 	SecurityGroupConfig securityGroupConfig           `yaml:"security_group,omitempty"`
 	FlowLogs            Union[*bool, VPCFlowLogsArgs] `yaml:"flow_logs,omitempty"`
+	AppRunnerConnectors map[string]AppRunnerConnector `yaml:"app_runner_connectors,omitempty"`
+	DHCPOptions         DHCPOptions                   `yaml:"dhcp_options,omitempty"`
+	DNSResolver         DNSResolverConfig             `yaml:"dns_resolver,omitempty"`
+}
+
+// DHCPOptions represents a custom DHCP options set associated with the VPC created by Copilot.
+type DHCPOptions struct {
+	DomainName        *string  `yaml:"domain_name,omitempty"`
+	DomainNameServers []string `yaml:"domain_name_servers,omitempty"`
+	NTPServers        []string `yaml:"ntp_servers,omitempty"`
+}
+
+// IsEmpty returns true if the VPC uses the default DHCP options set.
+func (o DHCPOptions) IsEmpty() bool {
+	return o.DomainName == nil && len(o.DomainNameServers) == 0 && len(o.NTPServers) == 0
+}
+
+// DNSResolverConfig represents the Route 53 Resolver rules associated with the VPC of the environment.
+type DNSResolverConfig struct {
+	ForwardingRules []DNSForwardingRule `yaml:"forwarding_rules,omitempty"` // Rules created with an outbound endpoint in the VPC.
+	RuleIDs         []string            `yaml:"rule_ids,omitempty"`         // Existing rules, for example shared with AWS RAM.
+}
+
+// IsEmpty returns true if no Route 53 Resolver rule is associated with the VPC.
+func (cfg DNSResolverConfig) IsEmpty() bool {
+	return len(cfg.ForwardingRules) == 0 && len(cfg.RuleIDs) == 0
+}
+
+// DNSForwardingRule represents a Route 53 Resolver rule that forwards the DNS queries for a domain to other DNS resolvers.
+type DNSForwardingRule struct {
+	Domain    *string       `yaml:"domain,omitempty"`
+	TargetIPs []DNSTargetIP `yaml:"target_ips,omitempty"`
+}
+
+// defaultDNSPort is the port of the target DNS resolvers if it's not specified.
+const defaultDNSPort = 53
+
+// DNSTargetIP is the IPv4 address of a DNS resolver, optionally followed by a port.
+// For example: 10.0.0.2 or 10.0.0.2:5353.
+type DNSTargetIP string
+
+// Parse parses the DNSTargetIP string and returns the IP address and the port, which defaults to 53.
+func (t DNSTargetIP) Parse() (ip string, port uint16, err error) {
+	host, portStr := string(t), ""
+	if net.ParseIP(host) == nil {
+		host, portStr, err = net.SplitHostPort(string(t))
+		if err != nil {
+			return "", 0, fmt.Errorf("parse %q: %w", string(t), err)
+		}
+	}
+	if parsed := net.ParseIP(host); parsed == nil || parsed.To4() == nil {
+		return "", 0, fmt.Errorf("%q must be an IPv4 address", host)
+	}
+	if portStr == "" {
+		return host, defaultDNSPort, nil
+	}
+	p, err := strconv.ParseUint(portStr, 10, 16)
+	if err != nil || p == 0 {
+		return "", 0, fmt.Errorf("port %q must be a number between 1 and 65535", portStr)
+	}
+	return host, uint16(p), nil
+}
+
+// AppRunnerConnector represents an App Runner VPC connector shared by the Request-Driven Web Services of an environment.
+type AppRunnerConnector struct {
+	Placement      *PlacementString `yaml:"placement,omitempty"`
+	Subnets        []string         `yaml:"subnets,omitempty"`
+	SecurityGroups []string         `yaml:"security_groups,omitempty"`
+}
+
+// PlacementOrDefault returns the placement of the connector, which defaults to the private subnets.
+func (c AppRunnerConnector) PlacementOrDefault() PlacementString {
+	if c.Placement == nil {
+		return PrivateSubnetPlacement
+	}
+	return *c.Placement
 }
 
 type securityGroupConfig struct {
-	Ingress []securityGroupRule `yaml:"ingress,omitempty"`
-	Egress  []securityGroupRule `yaml:"egress,omitempty"`
+	Ingress             []securityGroupRule `yaml:"ingress,omitempty"`
+	Egress              []securityGroupRule `yaml:"egress,omitempty"`
+	DenyIntraEnvTraffic *bool               `yaml:"deny_intra_env_traffic,omitempty"`
 }
 
 func (cfg securityGroupConfig) isEmpty() bool {
-	return len(cfg.Ingress) == 0 && len(cfg.Egress) == 0
+	return len(cfg.Ingress) == 0 && len(cfg.Egress) == 0 && cfg.DenyIntraEnvTraffic == nil
 }
 
 // securityGroupRule holds the security group ingress and egress configs.
@@ -187,14 +294,38 @@ func (cfg *portsConfig) UnmarshalYAML(value *yaml.Node) error {
 	return nil
 }
 
+// Destinations of the VPC flow logs.
+const (
+	FlowLogsDestinationCloudWatch = "cloudwatch"
+	FlowLogsDestinationS3         = "s3"
+)
+
+// Types of traffic captured by the VPC flow logs.
+const (
+	FlowLogsTrafficTypeAll    = "all"
+	FlowLogsTrafficTypeAccept = "accept"
+	FlowLogsTrafficTypeReject = "reject"
+)
+
 // VPCFlowLogsArgs holds the flow logs configuration.
 type VPCFlowLogsArgs struct {
-	Retention *int `yaml:"retention,omitempty"`
+	Retention           *int           `yaml:"retention,omitempty"` // Only for the cloudwatch destination.
+	Destination         *string        `yaml:"destination,omitempty"`
+	BucketName          *string        `yaml:"bucket_name,omitempty"` // Only for the s3 destination.
+	Prefix              *string        `yaml:"prefix,omitempty"`      // Only for the s3 destination.
+	TrafficType         *string        `yaml:"traffic_type,omitempty"`
+	AggregationInterval *time.Duration `yaml:"aggregation_interval,omitempty"`
 }
 
 // IsZero implements yaml.IsZeroer.
 func (fl *VPCFlowLogsArgs) IsZero() bool {
-	return fl.Retention == nil
+	return fl.Retention == nil && fl.Destination == nil && fl.BucketName == nil && fl.Prefix == nil &&
+		fl.TrafficType == nil && fl.AggregationInterval == nil
+}
+
+// IsS3Destination returns true if the flow logs are published to an S3 bucket instead of CloudWatch Logs.
+func (fl *VPCFlowLogsArgs) IsS3Destination() bool {
+	return aws.StringValue(fl.Destination) == FlowLogsDestinationS3
 }
 
 // EnvSecurityGroup returns the security group config if the user has set any values.
@@ -206,6 +337,18 @@ func (cfg *EnvironmentConfig) EnvSecurityGroup() (*securityGroupConfig, bool) {
 	return nil, false
 }
 
+// DeniesIntraEnvTraffic returns true if the environment security group doesn't allow the traffic between workloads,
+// so that workloads only accept the traffic of the services listed in their "network.ingress.from_services".
+func (cfg *EnvironmentConfig) DeniesIntraEnvTraffic() bool {
+	return aws.BoolValue(cfg.Network.VPC.SecurityGroupConfig.DenyIntraEnvTraffic)
+}
+
+// ServiceConnectNamespace returns the ARN of the Cloud Map namespace shared with other applications that
+// services in the environment join for Service Connect, or an empty string if they use the environment's namespace.
+func (cfg *EnvironmentConfig) ServiceConnectNamespace() string {
+	return aws.StringValue(cfg.Network.Connect.Namespace)
+}
+
 // EnvironmentCDNConfig represents configuration of a CDN.
 type EnvironmentCDNConfig struct {
 	Enabled *bool
@@ -285,7 +428,8 @@ func (cfg CDNStaticConfig) IsEmpty() bool {
 
 // IsEmpty returns true if environmentVPCConfig is not configured.
 func (cfg environmentVPCConfig) IsEmpty() bool {
-	return cfg.ID == nil && cfg.CIDR == nil && cfg.Subnets.IsEmpty() && cfg.FlowLogs.IsZero()
+	return cfg.ID == nil && cfg.CIDR == nil && cfg.Subnets.IsEmpty() && cfg.FlowLogs.IsZero() && len(cfg.AppRunnerConnectors) == 0 &&
+		cfg.DHCPOptions.IsEmpty() && cfg.DNSResolver.IsEmpty()
 }
 
 func (cfg *environmentVPCConfig) loadVPCConfig(env *config.CustomizeEnv) {
@@ -403,17 +547,72 @@ func (cfg *environmentVPCConfig) ManagedVPC() *template.ManagedVPC {
 		AZs:                azs,
 		PublicSubnetCIDRs:  publicSubnetCIDRs,
 		PrivateSubnetCIDRs: privateSubnetCIDRs,
+		SubnetGroups:       cfg.Subnets.subnetGroups(),
 	}
 }
 
 type subnetsConfiguration struct {
-	Public  []subnetConfiguration `yaml:"public,omitempty"`
-	Private []subnetConfiguration `yaml:"private,omitempty"`
+	Public  []subnetConfiguration  `yaml:"public,omitempty"`
+	Private []subnetConfiguration  `yaml:"private,omitempty"`
+	Groups  map[string]subnetGroup `yaml:"groups,omitempty"`
 }
 
-// IsEmpty returns true if neither public subnets nor private subnets are configured.
+// IsEmpty returns true if neither public subnets, private subnets nor subnet groups are configured.
 func (cs subnetsConfiguration) IsEmpty() bool {
-	return len(cs.Public) == 0 && len(cs.Private) == 0
+	return len(cs.Public) == 0 && len(cs.Private) == 0 && len(cs.Groups) == 0
+}
+
+// subnetGroups returns the subnet groups sorted by name, with their subnets sorted by az
+// so that the subnet at index i shares the az of the public or private subnet at index i.
+func (cs subnetsConfiguration) subnetGroups() []template.SubnetGroup {
+	if len(cs.Groups) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(cs.Groups))
+	for name := range cs.Groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	groups := make([]template.SubnetGroup, len(names))
+	for i, name := range names {
+		subnets := slices.Clone(subnetGroupSubnets(cs.Groups[name]))
+		sort.SliceStable(subnets, func(i, j int) bool {
+			return aws.StringValue(subnets[i].AZ) < aws.StringValue(subnets[j].AZ)
+		})
+		cidrs := make([]string, len(subnets))
+		for j, subnet := range subnets {
+			cidrs[j] = aws.StringValue((*string)(subnet.CIDR))
+		}
+		groups[i] = template.SubnetGroup{
+			Name:    name,
+			Routing: subnetGroupRouting(cs.Groups[name]),
+			CIDRs:   cidrs,
+		}
+	}
+	return groups
+}
+
+// subnetGroup is a tier of subnets. It is either a list of subnets routed like the private subnets,
+// or the subnets along with how they are routed.
+type subnetGroup = Union[[]subnetConfiguration, subnetGroupConfiguration]
+
+type subnetGroupConfiguration struct {
+	Routing *string               `yaml:"routing,omitempty"`
+	Subnets []subnetConfiguration `yaml:"subnets,omitempty"`
+}
+
+func subnetGroupSubnets(g subnetGroup) []subnetConfiguration {
+	if g.IsAdvanced() {
+		return g.Advanced.Subnets
+	}
+	return g.Basic
+}
+
+func subnetGroupRouting(g subnetGroup) string {
+	if g.IsAdvanced() && g.Advanced.Routing != nil {
+		return aws.StringValue(g.Advanced.Routing)
+	}
+	return template.SubnetGroupRoutingPrivate
 }
 
 type subnetConfiguration struct {
@@ -438,6 +637,68 @@ func (o *environmentObservability) loadObsConfig(tele *config.Telemetry) {
 	o.ContainerInsights = &tele.EnableContainerInsights
 }
 
+type environmentEncryption struct {
+	KMSKey *string `yaml:"kms_key,omitempty"`
+}
+
+// IsEmpty returns true if the environment resources are encrypted with AWS managed keys.
+func (e *environmentEncryption) IsEmpty() bool {
+	return e == nil || e.KMSKey == nil
+}
+
+// KMSKeyARN returns the ARN of the customer managed key used to encrypt the environment's resources,
+// or an empty string if AWS managed keys are used.
+func (mft *EnvironmentConfig) KMSKeyARN() string {
+	return aws.StringValue(mft.Encryption.KMSKey)
+}
+
+type environmentCluster struct {
+	Name *string `yaml:"name,omitempty"`
+}
+
+// IsEmpty returns true if the environment creates its own ECS cluster.
+func (c *environmentCluster) IsEmpty() bool {
+	return c == nil || c.Name == nil
+}
+
+// ImportedClusterName returns the name of the existing ECS cluster that the environment uses,
+// or an empty string if the environment creates its own cluster.
+func (mft *EnvironmentConfig) ImportedClusterName() string {
+	return aws.StringValue(mft.Cluster.Name)
+}
+
+type environmentTasks struct {
+	RemoteRunner *bool `yaml:"remote_runner,omitempty"`
+}
+
+// IsEmpty returns true if there is no configuration for the one-off tasks of the environment.
+func (t *environmentTasks) IsEmpty() bool {
+	return t == nil || t.RemoteRunner == nil
+}
+
+// RemoteTaskRunnerEnabled returns true if the environment deploys a task runner that launches
+// one-off tasks on behalf of callers of "copilot task run --remote".
+func (mft *EnvironmentConfig) RemoteTaskRunnerEnabled() bool {
+	return aws.BoolValue(mft.Tasks.RemoteRunner)
+}
+
+// environmentBackups holds the backup plan of the stateful resources created by the addons of the environment.
+type environmentBackups struct {
+	Retention *int    `yaml:"retention,omitempty"` // Number of days to keep the recovery points.
+	Schedule  *string `yaml:"schedule,omitempty"`  // Cron expression of the AWS Backup plan.
+}
+
+// IsEmpty returns true if the environment doesn't back up its stateful resources.
+func (b *environmentBackups) IsEmpty() bool {
+	return b == nil || (b.Retention == nil && b.Schedule == nil)
+}
+
+// BackupsEnabled returns true if the environment creates an AWS Backup plan for the EFS file systems,
+// DynamoDB tables and Aurora clusters of its workloads.
+func (mft *EnvironmentConfig) BackupsEnabled() bool {
+	return !mft.Backups.IsEmpty()
+}
+
 // EnvironmentHTTPConfig defines the configuration settings for an environment group's HTTP connections.
 type EnvironmentHTTPConfig struct {
 	Public  PublicHTTPConfig  `yaml:"public,omitempty"`
@@ -467,11 +728,13 @@ func (cfg *EnvironmentHTTPConfig) loadLBConfig(env *config.CustomizeEnv) {
 
 // PublicHTTPConfig represents the configuration settings for an environment public ALB.
 type PublicHTTPConfig struct {
-	DeprecatedSG  DeprecatedALBSecurityGroupsConfig `yaml:"security_groups,omitempty"` // Deprecated. This configuration is now available inside Ingress field.
-	Certificates  []string                          `yaml:"certificates,omitempty"`
-	ELBAccessLogs ELBAccessLogsArgsOrBool           `yaml:"access_logs,omitempty"`
-	Ingress       RestrictiveIngress                `yaml:"ingress,omitempty"`
-	SSLPolicy     *string                           `yaml:"ssl_policy,omitempty"`
+	DeprecatedSG    DeprecatedALBSecurityGroupsConfig `yaml:"security_groups,omitempty"` // Deprecated. This configuration is now available inside Ingress field.
+	Certificates    []string                          `yaml:"certificates,omitempty"`
+	ELBAccessLogs   ELBAccessLogsArgsOrBool           `yaml:"access_logs,omitempty"`
+	Ingress         RestrictiveIngress                `yaml:"ingress,omitempty"`
+	SSLPolicy       *string                           `yaml:"ssl_policy,omitempty"`
+	MutualTLS       MutualTLSConfig                   `yaml:"mutual_tls,omitempty"`
+	ResponseHeaders ResponseHeadersConfig             `yaml:"response_headers,omitempty"`
 }
 
 // ELBAccessLogsArgsOrBool is a custom type which supports unmarshaling yaml which
@@ -557,7 +820,57 @@ func (i RestrictiveIngress) IsEmpty() bool {
 
 // IsEmpty returns true if there is no customization to the public ALB.
 func (cfg PublicHTTPConfig) IsEmpty() bool {
-	return len(cfg.Certificates) == 0 && cfg.DeprecatedSG.IsEmpty() && cfg.ELBAccessLogs.isEmpty() && cfg.Ingress.IsEmpty() && cfg.SSLPolicy == nil
+	return len(cfg.Certificates) == 0 && cfg.DeprecatedSG.IsEmpty() && cfg.ELBAccessLogs.isEmpty() && cfg.Ingress.IsEmpty() && cfg.SSLPolicy == nil && cfg.MutualTLS.IsEmpty() &&
+		cfg.ResponseHeaders.IsEmpty()
+}
+
+// Mutual TLS modes supported by the HTTPS listener of an Application Load Balancer.
+const (
+	MutualTLSModeVerify      = "verify"
+	MutualTLSModePassthrough = "passthrough"
+)
+
+// MutualTLSConfig represents the mutual TLS authentication of clients by the HTTPS listener of a load balancer.
+type MutualTLSConfig struct {
+	Mode                          *string `yaml:"mode,omitempty"`
+	TrustStore                    *string `yaml:"trust_store,omitempty"`
+	CABundle                      *string `yaml:"ca_bundle,omitempty"`
+	IgnoreClientCertificateExpiry *bool   `yaml:"ignore_client_certificate_expiry,omitempty"`
+}
+
+// IsEmpty returns true if mutual TLS is not configured.
+func (cfg MutualTLSConfig) IsEmpty() bool {
+	return cfg.Mode == nil && cfg.TrustStore == nil && cfg.CABundle == nil && cfg.IgnoreClientCertificateExpiry == nil
+}
+
+// IsPassthrough returns true if the load balancer forwards the client certificate chain to the targets without verifying it.
+func (cfg MutualTLSConfig) IsPassthrough() bool {
+	return aws.StringValue(cfg.Mode) == MutualTLSModePassthrough
+}
+
+// ResponseHeadersConfig represents the headers added to every response of a load balancer,
+// and of the CDN in front of the public load balancer.
+type ResponseHeadersConfig struct {
+	StrictTransportSecurity StrictTransportSecurityConfig `yaml:"strict_transport_security,omitempty"`
+	ContentSecurityPolicy   *string                       `yaml:"content_security_policy,omitempty"`
+	Custom                  map[string]string             `yaml:"custom,omitempty"` // Only supported by the CDN.
+}
+
+// IsEmpty returns true if no response header is configured.
+func (cfg ResponseHeadersConfig) IsEmpty() bool {
+	return cfg.StrictTransportSecurity.IsEmpty() && cfg.ContentSecurityPolicy == nil && len(cfg.Custom) == 0
+}
+
+// StrictTransportSecurityConfig represents the value of the Strict-Transport-Security (HSTS) response header.
+type StrictTransportSecurityConfig struct {
+	MaxAge            *time.Duration `yaml:"max_age,omitempty"`
+	IncludeSubdomains *bool          `yaml:"include_subdomains,omitempty"`
+	Preload           *bool          `yaml:"preload,omitempty"`
+}
+
+// IsEmpty returns true if the Strict-Transport-Security header is not configured.
+func (cfg StrictTransportSecurityConfig) IsEmpty() bool {
+	return cfg.MaxAge == nil && cfg.IncludeSubdomains == nil && cfg.Preload == nil
 }
 
 type privateHTTPConfig struct {
@@ -566,14 +879,69 @@ type privateHTTPConfig struct {
 	DeprecatedSG       DeprecatedALBSecurityGroupsConfig `yaml:"security_groups,omitempty"` // Deprecated. This field is now available in Ingress.
 	Ingress            RelaxedIngress                    `yaml:"ingress,omitempty"`
 	SSLPolicy          *string                           `yaml:"ssl_policy,omitempty"`
+	MutualTLS          MutualTLSConfig                   `yaml:"mutual_tls,omitempty"`
+	ResponseHeaders    ResponseHeadersConfig             `yaml:"response_headers,omitempty"`
 }
 
 // IsEmpty returns true if there is no customization to the internal ALB.
 func (cfg privateHTTPConfig) IsEmpty() bool {
-	return len(cfg.InternalALBSubnets) == 0 && len(cfg.Certificates) == 0 && cfg.DeprecatedSG.IsEmpty() && cfg.Ingress.IsEmpty() && cfg.SSLPolicy == nil
+	return len(cfg.InternalALBSubnets) == 0 && len(cfg.Certificates) == 0 && cfg.DeprecatedSG.IsEmpty() && cfg.Ingress.IsEmpty() && cfg.SSLPolicy == nil && cfg.MutualTLS.IsEmpty() &&
+		cfg.ResponseHeaders.IsEmpty()
 }
 
 // HasVPCIngress returns true if the private ALB allows ingress from within the VPC.
 func (cfg privateHTTPConfig) HasVPCIngress() bool {
 	return aws.BoolValue(cfg.Ingress.VPCIngress) || aws.BoolValue(cfg.DeprecatedSG.DeprecatedIngress.VPCIngress)
 }
+
+type environmentBudget struct {
+	MonthlyLimit   *float64 `yaml:"monthly_limit,omitempty"`
+	AlertThreshold *int     `yaml:"alert_threshold,omitempty"`
+	Emails         []string `yaml:"emails,omitempty"`
+	Guardrail      *bool    `yaml:"guardrail,omitempty"`
+}
+
+// IsEmpty returns true if the environment has no budget.
+func (b *environmentBudget) IsEmpty() bool {
+	return b == nil || (b.MonthlyLimit == nil && b.AlertThreshold == nil && len(b.Emails) == 0 && b.Guardrail == nil)
+}
+
+// environmentELBAccessLogsBucket holds the hardening options of the bucket that Copilot creates
+// for the access logs of the public load balancer.
+type environmentELBAccessLogsBucket struct {
+	AccessLogs bucketAccessLogs `yaml:"access_logs,omitempty"`
+	ObjectLock bucketObjectLock `yaml:"object_lock,omitempty"`
+	TLSOnly    *bool            `yaml:"tls_only,omitempty"`
+}
+
+// IsEmpty returns true if the bucket for the access logs of the public load balancer isn't customized.
+func (b *environmentELBAccessLogsBucket) IsEmpty() bool {
+	return b == nil || (b.AccessLogs.IsEmpty() && b.ObjectLock.IsEmpty() && b.TLSOnly == nil)
+}
+
+// bucketAccessLogs holds the destination of the S3 server access logs of a bucket.
+type bucketAccessLogs struct {
+	BucketName *string `yaml:"bucket_name,omitempty"`
+	Prefix     *string `yaml:"prefix,omitempty"`
+}
+
+// IsEmpty returns true if server access logging is not configured.
+func (l *bucketAccessLogs) IsEmpty() bool {
+	return l.BucketName == nil && l.Prefix == nil
+}
+
+// bucketObjectLock holds the default retention of the objects of a bucket with S3 Object Lock.
+type bucketObjectLock struct {
+	Mode      *string `yaml:"mode,omitempty"`
+	Retention *int    `yaml:"retention,omitempty"` // In days.
+}
+
+// IsEmpty returns true if object lock is not configured.
+func (o *bucketObjectLock) IsEmpty() bool {
+	return o.Mode == nil && o.Retention == nil
+}
+
+// BudgetGuardrailEnabled returns true if deployments to the environment warn when its forecasted spend exceeds its budget.
+func (mft *EnvironmentConfig) BudgetGuardrailEnabled() bool {
+	return !mft.Budget.IsEmpty() && aws.BoolValue(mft.Budget.Guardrail)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
 )
 
 func TestFromEnvConfig(t *testing.T) {
@@ -381,6 +382,77 @@ network:
 				},
 			},
 		},
+		"unmarshal with subnet groups": {
+			inContent: `name: test
+type: Environment
+
+network:
+  vpc:
+    subnets:
+      groups:
+        app:
+          - cidr: '10.0.20.0/24'
+            az: 'us-east-2a'
+        data:
+          routing: isolated
+          subnets:
+            - cidr: '10.0.10.0/24'
+              az: 'us-east-2a'
+`,
+			wantedStruct: &Environment{
+				Workload: Workload{
+					Name: aws.String("test"),
+					Type: aws.String("Environment"),
+				},
+				EnvironmentConfig: EnvironmentConfig{
+					Network: environmentNetworkConfig{
+						VPC: environmentVPCConfig{
+							Subnets: subnetsConfiguration{
+								Groups: map[string]subnetGroup{
+									"app": BasicToUnion[[]subnetConfiguration, subnetGroupConfiguration]([]subnetConfiguration{
+										{
+											CIDR: ipNetP("10.0.20.0/24"),
+											AZ:   aws.String("us-east-2a"),
+										},
+									}),
+									"data": AdvancedToUnion[[]subnetConfiguration](subnetGroupConfiguration{
+										Routing: aws.String("isolated"),
+										Subnets: []subnetConfiguration{
+											{
+												CIDR: ipNetP("10.0.10.0/24"),
+												AZ:   aws.String("us-east-2a"),
+											},
+										},
+									}),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"unmarshal with a shared Service Connect namespace": {
+			inContent: `name: test
+type: Environment
+
+network:
+  connect:
+    namespace: arn:aws:servicediscovery:us-west-2:123456789012:namespace/ns-1234
+`,
+			wantedStruct: &Environment{
+				Workload: Workload{
+					Name: aws.String("test"),
+					Type: aws.String("Environment"),
+				},
+				EnvironmentConfig: EnvironmentConfig{
+					Network: environmentNetworkConfig{
+						Connect: environmentConnectConfig{
+							Namespace: aws.String("arn:aws:servicediscovery:us-west-2:123456789012:namespace/ns-1234"),
+						},
+					},
+				},
+			},
+		},
 		"unmarshal with enable access logs": {
 			inContent: `name: prod
 type: Environment
@@ -451,6 +523,143 @@ observability:
 				},
 			},
 		},
+		"unmarshal with encryption": {
+			inContent: `name: prod
+type: Environment
+
+encryption:
+    kms_key: arn:aws:kms:us-west-2:123456789012:key/mrk-1234abcd
+`,
+			wantedStruct: &Environment{
+				Workload: Workload{
+					Name: aws.String("prod"),
+					Type: aws.String("Environment"),
+				},
+				EnvironmentConfig: EnvironmentConfig{
+					Encryption: environmentEncryption{
+						KMSKey: aws.String("arn:aws:kms:us-west-2:123456789012:key/mrk-1234abcd"),
+					},
+				},
+			},
+		},
+		"unmarshal with an imported cluster": {
+			inContent: `name: prod
+type: Environment
+
+cluster:
+    name: shared
+`,
+			wantedStruct: &Environment{
+				Workload: Workload{
+					Name: aws.String("prod"),
+					Type: aws.String("Environment"),
+				},
+				EnvironmentConfig: EnvironmentConfig{
+					Cluster: environmentCluster{
+						Name: aws.String("shared"),
+					},
+				},
+			},
+		},
+		"unmarshal with remote task runner": {
+			inContent: `name: prod
+type: Environment
+
+tasks:
+    remote_runner: true
+`,
+			wantedStruct: &Environment{
+				Workload: Workload{
+					Name: aws.String("prod"),
+					Type: aws.String("Environment"),
+				},
+				EnvironmentConfig: EnvironmentConfig{
+					Tasks: environmentTasks{
+						RemoteRunner: aws.Bool(true),
+					},
+				},
+			},
+		},
+		"unmarshal with backups": {
+			inContent: `name: prod
+type: Environment
+
+backups:
+    retention: 35
+    schedule: cron(0 5 ? * * *)
+`,
+			wantedStruct: &Environment{
+				Workload: Workload{
+					Name: aws.String("prod"),
+					Type: aws.String("Environment"),
+				},
+				EnvironmentConfig: EnvironmentConfig{
+					Backups: environmentBackups{
+						Retention: aws.Int(35),
+						Schedule:  aws.String("cron(0 5 ? * * *)"),
+					},
+				},
+			},
+		},
+		"unmarshal with budget": {
+			inContent: `name: prod
+type: Environment
+
+budget:
+    monthly_limit: 250.5
+    alert_threshold: 80
+    emails:
+      - ops@example.com
+    guardrail: true
+`,
+			wantedStruct: &Environment{
+				Workload: Workload{
+					Name: aws.String("prod"),
+					Type: aws.String("Environment"),
+				},
+				EnvironmentConfig: EnvironmentConfig{
+					Budget: environmentBudget{
+						MonthlyLimit:   aws.Float64(250.5),
+						AlertThreshold: aws.Int(80),
+						Emails:         []string{"ops@example.com"},
+						Guardrail:      aws.Bool(true),
+					},
+				},
+			},
+		},
+		"unmarshal with elb access logs bucket": {
+			inContent: `name: prod
+type: Environment
+
+elb_access_logs_bucket:
+    access_logs:
+      bucket_name: central-access-logs
+      prefix: copilot/
+    object_lock:
+      mode: GOVERNANCE
+      retention: 30
+    tls_only: true
+`,
+			wantedStruct: &Environment{
+				Workload: Workload{
+					Name: aws.String("prod"),
+					Type: aws.String("Environment"),
+				},
+				EnvironmentConfig: EnvironmentConfig{
+					ELBAccessLogsBucket: environmentELBAccessLogsBucket{
+						AccessLogs: bucketAccessLogs{
+							BucketName: aws.String("central-access-logs"),
+							Prefix:     aws.String("copilot/"),
+						},
+						ObjectLock: bucketObjectLock{
+							Mode:      aws.String("GOVERNANCE"),
+							Retention: aws.Int(30),
+						},
+						TLSOnly: aws.Bool(true),
+					},
+				},
+			},
+		},
 		"unmarshal with content delivery network bool": {
 			inContent: `name: prod
 type: Environment
@@ -800,6 +1009,76 @@ func TestEnvironmentVPCConfig_ManagedVPC(t *testing.T) {
 				PrivateSubnetCIDRs: []string{string(mockPrivateSubnet1CIDR), string(mockPrivateSubnet2CIDR), string(mockPrivateSubnet3CIDR)},
 			},
 		},
+		"subnet groups are sorted by name and their subnets by AZ": {
+			inVPCConfig: environmentVPCConfig{
+				CIDR: &mockVPCCIDR,
+				Subnets: subnetsConfiguration{
+					Public: []subnetConfiguration{
+						{
+							CIDR: &mockPublicSubnet2CIDR,
+							AZ:   aws.String("us-east-2b"),
+						},
+						{
+							CIDR: &mockPublicSubnet1CIDR,
+							AZ:   aws.String("us-east-2a"),
+						},
+					},
+					Private: []subnetConfiguration{
+						{
+							CIDR: &mockPrivateSubnet1CIDR,
+							AZ:   aws.String("us-east-2a"),
+						},
+						{
+							CIDR: &mockPrivateSubnet2CIDR,
+							AZ:   aws.String("us-east-2b"),
+						},
+					},
+					Groups: map[string]subnetGroup{
+						"data": AdvancedToUnion[[]subnetConfiguration](subnetGroupConfiguration{
+							Routing: aws.String("isolated"),
+							Subnets: []subnetConfiguration{
+								{
+									CIDR: ipNetP("10.0.11.0/24"),
+									AZ:   aws.String("us-east-2b"),
+								},
+								{
+									CIDR: ipNetP("10.0.10.0/24"),
+									AZ:   aws.String("us-east-2a"),
+								},
+							},
+						}),
+						"app": BasicToUnion[[]subnetConfiguration, subnetGroupConfiguration]([]subnetConfiguration{
+							{
+								CIDR: ipNetP("10.0.20.0/24"),
+								AZ:   aws.String("us-east-2a"),
+							},
+							{
+								CIDR: ipNetP("10.0.21.0/24"),
+								AZ:   aws.String("us-east-2b"),
+							},
+						}),
+					},
+				},
+			},
+			wanted: &template.ManagedVPC{
+				CIDR:               string(mockVPCCIDR),
+				AZs:                []string{"us-east-2a", "us-east-2b"},
+				PublicSubnetCIDRs:  []string{string(mockPublicSubnet1CIDR), string(mockPublicSubnet2CIDR)},
+				PrivateSubnetCIDRs: []string{string(mockPrivateSubnet1CIDR), string(mockPrivateSubnet2CIDR)},
+				SubnetGroups: []template.SubnetGroup{
+					{
+						Name:    "app",
+						Routing: "private",
+						CIDRs:   []string{"10.0.20.0/24", "10.0.21.0/24"},
+					},
+					{
+						Name:    "data",
+						Routing: "isolated",
+						CIDRs:   []string{"10.0.10.0/24", "10.0.11.0/24"},
+					},
+				},
+			},
+		},
 		"managed vpc without explicitly configured azs": {
 			inVPCConfig: environmentVPCConfig{
 				CIDR: &mockVPCCIDR,
@@ -1113,6 +1392,40 @@ func TestEnvironmentConfig_CDNEnabled(t *testing.T) {
 	}
 }
 
+func TestEnvironmentConfig_DeniesIntraEnvTraffic(t *testing.T) {
+	testCases := map[string]struct {
+		in     string
+		wanted bool
+	}{
+		"allows intra-env traffic by default": {
+			in: `
+network:
+  vpc:
+    security_group:
+      ingress:
+        - ip_protocol: tcp
+          ports: 80
+          cidr: 0.0.0.0/0`,
+		},
+		"denies intra-env traffic": {
+			in: `
+network:
+  vpc:
+    security_group:
+      deny_intra_env_traffic: true`,
+			wanted: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var cfg EnvironmentConfig
+			require.NoError(t, yaml.Unmarshal([]byte(tc.in), &cfg))
+			require.Equal(t, tc.wanted, cfg.DeniesIntraEnvTraffic())
+		})
+	}
+}
+
 func TestEnvironmentConfig_ELBAccessLogs(t *testing.T) {
 	testCases := map[string]struct {
 		in            EnvironmentConfig
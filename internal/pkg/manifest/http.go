@@ -59,10 +59,12 @@ func (r *HTTPOrBool) UnmarshalYAML(value *yaml.Node) error {
 
 // HTTP holds options for application load balancer.
 type HTTP struct {
-	ImportedALB              *string       `yaml:"alb"`
-	Main                     RoutingRule   `yaml:",inline"`
-	TargetContainerCamelCase *string       `yaml:"targetContainer"` // Deprecated. Maintained for backwards compatibility, use [RoutingRule.TargetContainer] instead.
-	AdditionalRoutingRules   []RoutingRule `yaml:"additional_rules"`
+	ImportedALB              *string                        `yaml:"alb"`
+	MutualTLS                *bool                          `yaml:"mutual_tls"` // Requires the environment's load balancer to authenticate clients with certificates.
+	Main                     RoutingRule                    `yaml:",inline"`
+	TargetContainerCamelCase *string                        `yaml:"targetContainer"` // Deprecated. Maintained for backwards compatibility, use [RoutingRule.TargetContainer] instead.
+	AdditionalRoutingRules   []RoutingRule                  `yaml:"additional_rules"`
+	CDN                      Union[*bool, ServiceCDNConfig] `yaml:"cdn"` // Provisions a CloudFront distribution in front of the service.
 }
 
 // RoutingRules returns main as well as additional routing rules as a list of RoutingRule.
@@ -75,17 +77,64 @@ func (cfg HTTP) RoutingRules() []RoutingRule {
 
 // IsEmpty returns true if HTTP has empty configuration.
 func (r *HTTP) IsEmpty() bool {
-	return r.Main.IsEmpty() && r.TargetContainerCamelCase == nil && len(r.AdditionalRoutingRules) == 0
+	return r.Main.IsEmpty() && r.MutualTLS == nil && r.TargetContainerCamelCase == nil && len(r.AdditionalRoutingRules) == 0 &&
+		r.CDN.IsZero()
+}
+
+// CDNEnabled returns true if a CloudFront distribution should be provisioned in front of the service.
+func (r *HTTP) CDNEnabled() bool {
+	if r.CDN.IsAdvanced() {
+		return true
+	}
+	return aws.BoolValue(r.CDN.Basic)
+}
+
+// Managed CloudFront cache policies that can be referred to by name in "http.cdn.cache_policies".
+// See https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/using-managed-cache-policies.html
+var managedCachePolicyIDs = map[string]string{
+	"CachingOptimized":                          "658327ea-f89d-4fab-a63d-7e88639e58f6",
+	"CachingOptimizedForUncompressedObjects":    "b2884449-e4de-46a7-ac36-70bc7f1ddd6d",
+	"CachingDisabled":                           "4135ea2d-6df8-44a3-9df3-4b5a84be39ad",
+	"UseOriginCacheControlHeaders":              "83da9c7e-98b4-4e11-a168-04f0df8e2c65",
+	"UseOriginCacheControlHeaders-QueryStrings": "4cc15a8a-d715-48a4-82b8-cc0b614638fe",
+}
+
+// ServiceCDNConfig holds the configuration for the CloudFront distribution in front of a service.
+type ServiceCDNConfig struct {
+	CachePolicies []CDNCachePolicy `yaml:"cache_policies"`
+	OriginShield  *string          `yaml:"origin_shield"` // Region of the CloudFront Origin Shield.
+	Aliases       []string         `yaml:"aliases"`
+	Certificate   *string          `yaml:"certificate"` // ARN of an imported certificate in us-east-1.
+}
+
+// IsZero implements yaml.IsZeroer.
+func (c ServiceCDNConfig) IsZero() bool {
+	return len(c.CachePolicies) == 0 && c.OriginShield == nil && len(c.Aliases) == 0 && c.Certificate == nil
+}
+
+// CDNCachePolicy associates a cache policy with the requests that match a path pattern.
+type CDNCachePolicy struct {
+	Path   *string `yaml:"path"`
+	Policy *string `yaml:"policy"` // Name of a managed cache policy or ID of a custom one.
+}
+
+// PolicyID returns the ID of the cache policy, resolving the names of managed cache policies.
+func (c CDNCachePolicy) PolicyID() string {
+	policy := aws.StringValue(c.Policy)
+	if id, ok := managedCachePolicyIDs[policy]; ok {
+		return id
+	}
+	return policy
 }
 
 // RoutingRule holds listener rule configuration for ALB.
 type RoutingRule struct {
-	Path                *string                 `yaml:"path"`
-	ProtocolVersion     *string                 `yaml:"version"`
-	HealthCheck         HealthCheckArgsOrString `yaml:"healthcheck"`
-	Stickiness          *bool                   `yaml:"stickiness"`
-	Alias               Alias                   `yaml:"alias"`
-	DeregistrationDelay *time.Duration          `yaml:"deregistration_delay"`
+	Path                *string                        `yaml:"path"`
+	ProtocolVersion     *string                        `yaml:"version"`
+	HealthCheck         HealthCheckArgsOrString        `yaml:"healthcheck"`
+	Stickiness          Union[*bool, StickinessConfig] `yaml:"stickiness"`
+	Alias               Alias                          `yaml:"alias"`
+	DeregistrationDelay *time.Duration                 `yaml:"deregistration_delay"`
 	// TargetContainer is the container load balancer routes traffic to.
 	TargetContainer  *string `yaml:"target_container"`
 	TargetPort       *uint16 `yaml:"target_port"`
@@ -97,11 +146,49 @@ type RoutingRule struct {
 
 // IsEmpty returns true if RoutingRule has empty configuration.
 func (r *RoutingRule) IsEmpty() bool {
-	return r.Path == nil && r.ProtocolVersion == nil && r.HealthCheck.IsZero() && r.Stickiness == nil && r.Alias.IsEmpty() &&
+	return r.Path == nil && r.ProtocolVersion == nil && r.HealthCheck.IsZero() && r.Stickiness.IsZero() && r.Alias.IsEmpty() &&
 		r.DeregistrationDelay == nil && r.TargetContainer == nil && r.TargetPort == nil && r.AllowedSourceIps == nil &&
 		r.HostedZone == nil && r.RedirectToHTTPS == nil
 }
 
+// Stickiness types supported by an Application Load Balancer target group.
+const (
+	StickinessTypeLBCookie  = "lb_cookie"
+	StickinessTypeAppCookie = "app_cookie"
+)
+
+// StickinessConfig holds the sticky sessions configuration for the target group of a routing rule.
+type StickinessConfig struct {
+	Type       *string        `yaml:"type"`
+	Duration   *time.Duration `yaml:"duration"`
+	CookieName *string        `yaml:"cookie_name"`
+}
+
+// IsZero implements yaml.IsZeroer.
+func (s StickinessConfig) IsZero() bool {
+	return s.Type == nil && s.Duration == nil && s.CookieName == nil
+}
+
+// StickinessEnabled returns true if sticky sessions are turned on for the routing rule.
+func (r *RoutingRule) StickinessEnabled() bool {
+	if r.Stickiness.IsAdvanced() {
+		return true
+	}
+	return aws.BoolValue(r.Stickiness.Basic)
+}
+
+// StickinessType returns the type of sticky sessions for the routing rule.
+// It returns an empty string if sticky sessions are not configured with advanced settings.
+func (r *RoutingRule) StickinessType() string {
+	if !r.Stickiness.IsAdvanced() {
+		return ""
+	}
+	if r.Stickiness.Advanced.Type == nil {
+		return StickinessTypeLBCookie
+	}
+	return aws.StringValue(r.Stickiness.Advanced.Type)
+}
+
 // HealthCheckPort returns the port a HealthCheck is set to for a RoutingRule.
 func (r *RoutingRule) HealthCheckPort(mainContainerPort *uint16) uint16 {
 	// healthCheckPort is defined by RoutingRule.HealthCheck.Port, with fallback on RoutingRule.TargetPort, then image.port.
@@ -287,3 +287,92 @@ func TestAlias_ToStringSlice(t *testing.T) {
 		})
 	}
 }
+
+func TestRoutingRule_Stickiness(t *testing.T) {
+	testCases := map[string]struct {
+		inContent []byte
+
+		wantedEnabled bool
+		wantedType    string
+	}{
+		"stickiness not specified": {
+			inContent: []byte(`path: /`),
+		},
+		"stickiness specified as a boolean": {
+			inContent:     []byte(`stickiness: true`),
+			wantedEnabled: true,
+		},
+		"stickiness specified with advanced configuration and default type": {
+			inContent: []byte(`stickiness:
+  duration: 1h`),
+			wantedEnabled: true,
+			wantedType:    StickinessTypeLBCookie,
+		},
+		"stickiness specified with application cookie": {
+			inContent: []byte(`stickiness:
+  type: app_cookie
+  cookie_name: SESSIONID`),
+			wantedEnabled: true,
+			wantedType:    StickinessTypeAppCookie,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var r RoutingRule
+
+			err := yaml.Unmarshal(tc.inContent, &r)
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedEnabled, r.StickinessEnabled())
+			require.Equal(t, tc.wantedType, r.StickinessType())
+		})
+	}
+}
+
+func TestHTTP_CDN(t *testing.T) {
+	testCases := map[string]struct {
+		inContent []byte
+
+		wantedEnabled   bool
+		wantedPolicyIDs []string
+	}{
+		"cdn not specified": {
+			inContent: []byte(`path: /`),
+		},
+		"cdn disabled": {
+			inContent: []byte(`cdn: false`),
+		},
+		"cdn specified as a boolean": {
+			inContent:     []byte(`cdn: true`),
+			wantedEnabled: true,
+		},
+		"cdn specified with cache policies": {
+			inContent: []byte(`cdn:
+  cache_policies:
+    - path: /static/*
+      policy: CachingOptimized
+    - path: /api/*
+      policy: 4cc15a8a-d715-48a4-82b8-cc0b614638fe
+  origin_shield: us-west-2`),
+			wantedEnabled:   true,
+			wantedPolicyIDs: []string{"658327ea-f89d-4fab-a63d-7e88639e58f6", "4cc15a8a-d715-48a4-82b8-cc0b614638fe"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var r HTTP
+
+			err := yaml.Unmarshal(tc.inContent, &r)
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedEnabled, r.CDNEnabled())
+			var ids []string
+			for _, policy := range r.CDN.Advanced.CachePolicies {
+				ids = append(ids, policy.PolicyID())
+			}
+			require.Equal(t, tc.wantedPolicyIDs, ids)
+		})
+	}
+}
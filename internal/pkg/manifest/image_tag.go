@@ -0,0 +1,92 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Placeholders that can be used in "image.tags". They are substituted when the image is pushed
+// instead of when the manifest is interpolated.
+const (
+	imageTagVarApp       = "app"
+	imageTagVarEnv       = "env"
+	imageTagVarName      = "name"
+	imageTagVarTag       = "tag"
+	imageTagVarGitBranch = "git_branch"
+	imageTagVarGitCommit = "git_commit"
+)
+
+const maxImageTagLength = 128
+
+var (
+	imageTagVars = map[string]bool{
+		imageTagVarApp:       true,
+		imageTagVarEnv:       true,
+		imageTagVarName:      true,
+		imageTagVarTag:       true,
+		imageTagVarGitBranch: true,
+		imageTagVarGitCommit: true,
+	}
+
+	imageTagVarRegExp       = regexp.MustCompile(`\${([_a-zA-Z][_a-zA-Z0-9]*)}`)
+	imageTagRegExp          = regexp.MustCompile(`^[a-zA-Z0-9_][a-zA-Z0-9_.-]*$`)
+	imageTagInvalidCharsExp = regexp.MustCompile(`[^a-zA-Z0-9_.-]`)
+)
+
+// ImageTagVars holds the values of the placeholders in the additional tags of an image.
+type ImageTagVars struct {
+	App       string
+	Env       string
+	Name      string // Name of the workload.
+	Tag       string // Tag provided with the --tag flag.
+	GitBranch string
+	GitCommit string
+}
+
+// ImageTag substitutes the placeholders in the tag template and returns a valid Docker tag.
+// It returns false if a placeholder in the template has no value, for example "${git_branch}" outside a git repository.
+func (v ImageTagVars) ImageTag(template string) (string, bool) {
+	values := map[string]string{
+		imageTagVarApp:       v.App,
+		imageTagVarEnv:       v.Env,
+		imageTagVarName:      v.Name,
+		imageTagVarTag:       v.Tag,
+		imageTagVarGitBranch: v.GitBranch,
+		imageTagVarGitCommit: v.GitCommit,
+	}
+	ok := true
+	tag := imageTagVarRegExp.ReplaceAllStringFunc(template, func(placeholder string) string {
+		val := values[imageTagVarRegExp.FindStringSubmatch(placeholder)[1]]
+		if val == "" {
+			ok = false
+		}
+		// Values such as branch names can contain characters that are not allowed in tags, like "feature/login".
+		return imageTagInvalidCharsExp.ReplaceAllString(val, "-")
+	})
+	if !ok {
+		return "", false
+	}
+	tag = strings.TrimLeft(tag, ".-")
+	if len(tag) > maxImageTagLength {
+		tag = tag[:maxImageTagLength]
+	}
+	return tag, tag != ""
+}
+
+// validateImageTag returns nil if the tag template only uses known placeholders and forms a valid Docker tag.
+func validateImageTag(template string) error {
+	for _, match := range imageTagVarRegExp.FindAllStringSubmatch(template, -1) {
+		if !imageTagVars[match[1]] {
+			return fmt.Errorf(`unknown placeholder "${%s}"`, match[1])
+		}
+	}
+	tag := imageTagVarRegExp.ReplaceAllString(template, "x")
+	if !imageTagRegExp.MatchString(tag) || len(tag) > maxImageTagLength {
+		return fmt.Errorf(`%q is not a valid tag: tags must be at most %d characters among letters, digits, "_", "." and "-", and must not start with "." or "-"`, template, maxImageTagLength)
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestImageTagVars_ImageTag(t *testing.T) {
+	vars := ImageTagVars{
+		App:       "phonetool",
+		Env:       "test",
+		Name:      "api",
+		GitBranch: "feature/login",
+		GitCommit: "8a1b2c3",
+	}
+	testCases := map[string]struct {
+		inTemplate string
+
+		wantedTag string
+		wantedOK  bool
+	}{
+		"static tag": {
+			inTemplate: "latest",
+			wantedTag:  "latest",
+			wantedOK:   true,
+		},
+		"substitutes placeholders": {
+			inTemplate: "${app}-${env}-${name}-${git_commit}",
+			wantedTag:  "phonetool-test-api-8a1b2c3",
+			wantedOK:   true,
+		},
+		"replaces the characters that are not allowed in tags": {
+			inTemplate: "${git_branch}",
+			wantedTag:  "feature-login",
+			wantedOK:   true,
+		},
+		"skips the tag if a placeholder has no value": {
+			inTemplate: "release-${tag}",
+		},
+		"truncates long tags": {
+			inTemplate: strings.Repeat("a", 120) + "-${git_branch}",
+			wantedTag:  strings.Repeat("a", 120) + "-feature",
+			wantedOK:   true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tag, ok := vars.ImageTag(tc.inTemplate)
+
+			require.Equal(t, tc.wantedOK, ok)
+			require.Equal(t, tc.wantedTag, tag)
+		})
+	}
+}
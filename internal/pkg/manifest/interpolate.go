@@ -29,6 +29,7 @@ var (
 // Interpolator substitutes variables in a manifest.
 type Interpolator struct {
 	predefinedEnvVars map[string]string
+	skippedVars       map[string]bool // Variables that are kept as is.
 }
 
 // NewInterpolator initiates a new Interpolator.
@@ -64,6 +65,12 @@ func (i *Interpolator) applyInterpolation(node *yaml.Node) error {
 		// Note that the rest of code massively uses yaml node tree.
 		// Please refer to https://www.efekarakus.com/2020/05/30/deep-dive-go-yaml-cfn.html
 		for idx := 0; idx < len(node.Content); idx += 2 {
+			if node.Content[idx].Value == "image" && node.Content[idx+1].Kind == yaml.MappingNode {
+				if err := i.applyImageInterpolation(node.Content[idx+1]); err != nil {
+					return err
+				}
+				continue
+			}
 			if err := i.applyInterpolation(node.Content[idx+1]); err != nil {
 				return err
 			}
@@ -98,6 +105,25 @@ func (i *Interpolator) applyInterpolation(node *yaml.Node) error {
 	return nil
 }
 
+// applyImageInterpolation substitutes environment variables in an image configuration, but keeps the
+// placeholders of "image.tags" that are substituted when the image is pushed.
+func (i *Interpolator) applyImageInterpolation(node *yaml.Node) error {
+	tagsInterpolator := &Interpolator{
+		predefinedEnvVars: i.predefinedEnvVars,
+		skippedVars:       imageTagVars,
+	}
+	for idx := 0; idx < len(node.Content); idx += 2 {
+		interpolator := i
+		if node.Content[idx].Value == "tags" {
+			interpolator = tagsInterpolator
+		}
+		if err := interpolator.applyInterpolation(node.Content[idx+1]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (i *Interpolator) interpolatePart(s string) (string, error) {
 	matches := interpolatorEnvVarRegExp.FindAllStringSubmatch(s, -1)
 	if len(matches) == 0 {
@@ -114,6 +140,9 @@ func (i *Interpolator) interpolatePart(s string) (string, error) {
 			continue
 		}
 
+		if i.skippedVars[key] {
+			continue
+		}
 		currSegment := fmt.Sprintf("${%s}", key)
 		predefinedVal, isPredefined := i.predefinedEnvVars[key]
 		osVal, isEnvVarSet := os.LookupEnv(key)
@@ -117,6 +117,36 @@ network:
       - sg-3
 `,
 		},
+		"should keep the placeholders of image tags": {
+			inputStr: `image:
+  build: Dockerfile
+  tags:
+    - ${env}
+    - ${git_branch}-${BUILD_NUMBER}
+environments:
+  test:
+    image:
+      tags: ["${COPILOT_ENVIRONMENT_NAME}-${git_commit}"]
+`,
+			inputEnvVar: map[string]string{
+				"BUILD_NUMBER": "42",
+			},
+			wanted: `image:
+  build: Dockerfile
+  tags:
+    - ${env}
+    - ${git_branch}-42
+environments:
+  test:
+    image:
+      tags: ["test-${git_commit}"]
+`,
+		},
+		"should not keep the placeholders of image tags outside of image tags": {
+			inputStr: "name: ${git_branch}",
+
+			wantedErr: fmt.Errorf(`environment variable "git_branch" is not defined`),
+		},
 		"should not substitute escaped dollar signs": {
 			inputStr: "echo \\${name}",
 			inputEnvVar: map[string]string{
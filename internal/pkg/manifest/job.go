@@ -21,6 +21,7 @@ const (
 type ScheduledJob struct {
 	Workload           `yaml:",inline"`
 	ScheduledJobConfig `yaml:",inline"`
+	Deploy             WorkloadDeploy                 `yaml:"deploy"`
 	Environments       map[string]*ScheduledJobConfig `yaml:",flow"`
 	parser             template.Parser
 }
@@ -38,6 +39,7 @@ type ScheduledJobConfig struct {
 	Sidecars                map[string]*SidecarConfig `yaml:"sidecars"` // NOTE: keep the pointers because `mergo` doesn't automatically deep merge map's value unless it's a pointer type.
 	On                      JobTriggerConfig          `yaml:"on,flow"`
 	JobFailureHandlerConfig `yaml:",inline"`
+	Alerts                  JobAlerts      `yaml:"alerts"`
 	Network                 NetworkConfig  `yaml:"network"`
 	PublishConfig           PublishConfig  `yaml:"publish"`
 	TaskDefOverrides        []OverrideRule `yaml:"taskdef_overrides"`
@@ -45,7 +47,20 @@ type ScheduledJobConfig struct {
 
 // JobTriggerConfig represents the configuration for the event that triggers the job.
 type JobTriggerConfig struct {
-	Schedule *string `yaml:"schedule"`
+	Schedule *string         `yaml:"schedule"`
+	Queue    JobQueueTrigger `yaml:"queue"`
+}
+
+// JobQueueTrigger represents the configuration of an SQS queue whose messages trigger executions of the job.
+type JobQueueTrigger struct {
+	Name           *string `yaml:"name"` // Name or ARN of the queue.
+	BatchSize      *int    `yaml:"batch_size"`
+	MaxConcurrency *int    `yaml:"max_concurrency"`
+}
+
+// IsEmpty returns empty if the queue trigger is not configured.
+func (q JobQueueTrigger) IsEmpty() bool {
+	return q.Name == nil && q.BatchSize == nil && q.MaxConcurrency == nil
 }
 
 // JobFailureHandlerConfig represents the error handling configuration for the job.
@@ -54,6 +69,18 @@ type JobFailureHandlerConfig struct {
 	Retries *int    `yaml:"retries"`
 }
 
+// JobAlerts represents the configuration to get notified when consecutive scheduled executions of the job
+// fail or do not start.
+type JobAlerts struct {
+	FailuresThreshold *int     `yaml:"failures_threshold"`
+	Emails            []string `yaml:"emails"`
+}
+
+// IsEmpty returns true if alerts are not configured.
+func (a JobAlerts) IsEmpty() bool {
+	return a.FailuresThreshold == nil && len(a.Emails) == 0
+}
+
 // ScheduledJobProps contains properties for creating a new scheduled job manifest.
 type ScheduledJobProps struct {
 	*WorkloadProps
@@ -143,6 +170,16 @@ func (j *ScheduledJob) Publish() []Topic {
 	return j.ScheduledJobConfig.PublishConfig.publishedTopics()
 }
 
+// ImageTags returns the additional tag templates of the main container image.
+func (j *ScheduledJob) ImageTags() []string {
+	return j.ImageConfig.Image.Tags
+}
+
+// ImageLifecycle returns the lifecycle policy of the ECR repository of the workload.
+func (j *ScheduledJob) ImageLifecycle() ImageLifecycle {
+	return j.ImageConfig.Image.Lifecycle
+}
+
 // BuildArgs returns a docker.BuildArguments object for the job given a context directory.
 func (j *ScheduledJob) BuildArgs(contextDir string) (map[string]*DockerBuildArgs, error) {
 	required, err := requiresBuild(j.ImageConfig.Image)
@@ -167,7 +204,7 @@ func (j *ScheduledJob) EnvFiles() map[string]string {
 // ContainerDependencies returns a map of ContainerDependency objects for ScheduledJob
 // including dependencies for its main container, any logging sidecar, and additional sidecars.
 func (s *ScheduledJob) ContainerDependencies() map[string]ContainerDependency {
-	return containerDependencies(aws.StringValue(s.Name), s.ImageConfig.Image, s.Logging, s.Sidecars)
+	return containerDependencies(aws.StringValue(s.Name), s.ImageConfig.Image, s.ImageConfig.HealthCheck, s.Logging, s.Sidecars, s.TaskConfig)
 }
 
 // newDefaultScheduledJob returns an empty ScheduledJob with only the default values set.
@@ -46,6 +46,7 @@ func durationp(v time.Duration) *time.Duration {
 type LoadBalancedWebService struct {
 	Workload                     `yaml:",inline"`
 	LoadBalancedWebServiceConfig `yaml:",inline"`
+	Deploy                       WorkloadDeploy `yaml:"deploy"`
 	// Use *LoadBalancedWebServiceConfig because of https://github.com/imdario/mergo/issues/146
 	Environments map[string]*LoadBalancedWebServiceConfig `yaml:",flow"` // Fields to override per environment.
 	parser       template.Parser
@@ -65,6 +66,8 @@ type LoadBalancedWebServiceConfig struct {
 	NLBConfig        NetworkLoadBalancerConfiguration `yaml:"nlb"`
 	DeployConfig     DeploymentConfig                 `yaml:"deployment"`
 	Observability    Observability                    `yaml:"observability"`
+	Cost             Cost                             `yaml:"cost"`
+	AppConfig        AppConfig                        `yaml:"appconfig"`
 }
 
 // LoadBalancedWebServiceProps contains properties for creating a new load balanced fargate service manifest.
@@ -198,6 +201,21 @@ func (s *LoadBalancedWebService) Publish() []Topic {
 	return s.LoadBalancedWebServiceConfig.PublishConfig.publishedTopics()
 }
 
+// ImageTags returns the additional tag templates of the main container image.
+func (s *LoadBalancedWebService) ImageTags() []string {
+	return s.ImageConfig.Image.Tags
+}
+
+// ImageLifecycle returns the lifecycle policy of the ECR repository of the workload.
+func (s *LoadBalancedWebService) ImageLifecycle() ImageLifecycle {
+	return s.ImageConfig.Image.Lifecycle
+}
+
+// TracingConfigFile returns the path to the configuration of the OpenTelemetry collector sidecar, if any.
+func (s *LoadBalancedWebService) TracingConfigFile() string {
+	return s.Observability.TracingConfigFile()
+}
+
 // BuildArgs returns a docker.BuildArguments object given a context directory.
 func (s *LoadBalancedWebService) BuildArgs(contextDir string) (map[string]*DockerBuildArgs, error) {
 	required, err := requiresBuild(s.ImageConfig.Image)
@@ -222,7 +240,7 @@ func (s *LoadBalancedWebService) EnvFiles() map[string]string {
 // ContainerDependencies returns a map of ContainerDependency objects for the LoadBalancedWebService
 // including dependencies for its main container, any logging sidecar, and additional sidecars.
 func (s *LoadBalancedWebService) ContainerDependencies() map[string]ContainerDependency {
-	return containerDependencies(aws.StringValue(s.Name), s.ImageConfig.Image, s.Logging, s.Sidecars)
+	return containerDependencies(aws.StringValue(s.Name), s.ImageConfig.Image, s.ImageConfig.HealthCheck, s.Logging, s.Sidecars, s.TaskConfig)
 }
 
 func (s *LoadBalancedWebService) subnets() *SubnetListOrArgs {
@@ -267,6 +285,7 @@ type NetworkLoadBalancerListener struct {
 	TargetContainer     *string            `yaml:"target_container"`
 	TargetPort          *int               `yaml:"target_port"`
 	SSLPolicy           *string            `yaml:"ssl_policy"`
+	ALPN                *string            `yaml:"alpn"`
 	Stickiness          *bool              `yaml:"stickiness"`
 	DeregistrationDelay *time.Duration     `yaml:"deregistration_delay"`
 }
@@ -279,7 +298,7 @@ func (c *NetworkLoadBalancerConfiguration) IsEmpty() bool {
 // IsEmpty returns true if NetworkLoadBalancerListener is empty.
 func (c *NetworkLoadBalancerListener) IsEmpty() bool {
 	return c.Port == nil && c.HealthCheck.isEmpty() && c.TargetContainer == nil && c.TargetPort == nil &&
-		c.SSLPolicy == nil && c.Stickiness == nil && c.DeregistrationDelay == nil
+		c.SSLPolicy == nil && c.ALPN == nil && c.Stickiness == nil && c.DeregistrationDelay == nil
 }
 
 // HealthCheckPort returns the port a HealthCheck is set to for a NetworkLoadBalancerListener.
@@ -2823,6 +2823,89 @@ func TestLoadBalancedWebService_BuildArgs(t *testing.T) {
 				},
 			},
 		},
+		"error if both build and location are set for a sidecar": {
+			in: &LoadBalancedWebService{
+				Workload: Workload{
+					Name: aws.String("mock-svc"),
+					Type: aws.String(manifestinfo.LoadBalancedWebServiceType),
+				},
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: ImageWithPortAndHealthcheck{
+						ImageWithPort: ImageWithPort{
+							Image: Image{
+								ImageLocationOrBuild: ImageLocationOrBuild{
+									Location: aws.String("mockURI"),
+								},
+							},
+						},
+					},
+					Sidecars: map[string]*SidecarConfig{
+						"nginx": {
+							Image: Union[*string, ImageLocationOrBuild]{
+								Advanced: ImageLocationOrBuild{
+									Build: BuildArgsOrString{
+										BuildString: aws.String("nginx/Dockerfile"),
+									},
+									Location: aws.String("nginx:latest"),
+								},
+							},
+						},
+					},
+				},
+			},
+			wantedErr: fmt.Errorf(`either "image.build" or "image.location" needs to be specified for sidecar "nginx"`),
+		},
+		"return build args and cache settings of sidecars only": {
+			in: &LoadBalancedWebService{
+				Workload: Workload{
+					Name: aws.String("mock-svc"),
+					Type: aws.String(manifestinfo.LoadBalancedWebServiceType),
+				},
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: ImageWithPortAndHealthcheck{
+						ImageWithPort: ImageWithPort{
+							Image: Image{
+								ImageLocationOrBuild: ImageLocationOrBuild{
+									Location: aws.String("mockURI"),
+								},
+							},
+						},
+					},
+					Sidecars: map[string]*SidecarConfig{
+						"nginx": {
+							Image: Union[*string, ImageLocationOrBuild]{
+								Advanced: ImageLocationOrBuild{
+									Build: BuildArgsOrString{
+										BuildArgs: DockerBuildArgs{
+											Dockerfile: aws.String("nginx/Dockerfile"),
+											Args: map[string]string{
+												"NGINX_VERSION": "1.25",
+											},
+											CacheFrom: []string{"nginx-cache:latest"},
+										},
+									},
+								},
+							},
+						},
+						"xray": {
+							Image: Union[*string, ImageLocationOrBuild]{
+								Basic: aws.String("amazon/aws-xray-daemon"),
+							},
+						},
+					},
+				},
+			},
+			wantedBuildArgs: map[string]*DockerBuildArgs{
+				"nginx": {
+					Dockerfile: aws.String(filepath.Join(mockContextDir, "nginx/Dockerfile")),
+					Context:    aws.String(filepath.Join(mockContextDir, "nginx")),
+					Args: map[string]string{
+						"NGINX_VERSION": "1.25",
+					},
+					CacheFrom: []string{"nginx-cache:latest"},
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -2863,6 +2946,9 @@ func TestLoadBalancedWebService_ContainerDependencies(t *testing.T) {
 					Sidecars: map[string]*SidecarConfig{
 						"nginx": {
 							Essential: aws.Bool(true),
+							HealthCheck: ContainerHealthCheck{
+								Command: []string{"CMD-SHELL", "curl -f http://localhost/ || exit 1"},
+							},
 						},
 						"nginx1": {
 							DependsOn: DependsOn{
@@ -2884,7 +2970,8 @@ func TestLoadBalancedWebService_ContainerDependencies(t *testing.T) {
 					},
 				},
 				"nginx": {
-					IsEssential: true,
+					IsEssential:    true,
+					HasHealthCheck: true,
 				},
 				"nginx1": {
 					IsEssential: true,
@@ -47,6 +47,15 @@ func TestDynamicWorkloadManifest_Load(t *testing.T) {
 
 			wantedError: fmt.Errorf("get subnet IDs: some error"),
 		},
+		"error if no subnets match the tags": {
+			inMft: newMockMftWithTags(),
+
+			setupMocks: func(m dynamicManifestMock) {
+				m.mockSubnetGetter.EXPECT().SubnetIDs(ec2.FilterForTags("foo", "bar")).Return(nil, nil)
+			},
+
+			wantedError: errors.New(`no subnets match the tags in "network.vpc.placement.subnets.from_tags"`),
+		},
 		"success with subnet IDs from tags": {
 			inMft: newMockMftWithTags(),
 
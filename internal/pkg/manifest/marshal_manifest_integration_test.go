@@ -385,6 +385,20 @@ func TestEnvironment_InitialManifestIntegration(t *testing.T) {
 			},
 			wantedTestData: "environment-default.yml",
 		},
+		"with a customer managed kms key": {
+			inProps: EnvironmentProps{
+				Name:      "test",
+				KMSKeyARN: "arn:aws:kms:us-west-2:123456789012:key/mrk-1234abcd",
+			},
+			wantedTestData: "environment-kms-key.yml",
+		},
+		"with an imported cluster": {
+			inProps: EnvironmentProps{
+				Name:        "test",
+				ClusterName: "shared",
+			},
+			wantedTestData: "environment-import-cluster.yml",
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -22,6 +22,9 @@ const (
 
 const pipelineManifestPath = "cicd/pipeline.yml"
 
+// PipelineTriggerManual is the trigger of pipelines that are only released manually.
+const PipelineTriggerManual = "manual"
+
 // PipelineProviders is the list of all available source integrations.
 var PipelineProviders = []string{
 	GithubProviderName,
@@ -168,9 +171,54 @@ type Pipeline struct {
 	Build   *Build                     `yaml:"build"`
 	Stages  []PipelineStage            `yaml:"stages"`
 
+	Trigger       PipelineTrigger        `yaml:"trigger,omitempty"`
+	Notifications *PipelineNotifications `yaml:"notifications,omitempty"`
+
+	// ArtifactKMSKeyARN is the ARN of a customer managed KMS key to encrypt the artifacts of the pipeline
+	// instead of the key created with the application.
+	ArtifactKMSKeyARN string `yaml:"artifact_kms_key_arn,omitempty"`
+
 	parser template.Parser
 }
 
+// PipelineTrigger configures what releases the pipeline instead of changes to the source.
+// It is either "manual" or a schedule.
+type PipelineTrigger struct {
+	Union[string, PipelineTriggerConfig]
+}
+
+// PipelineTriggerConfig releases the pipeline on a schedule instead of on changes to the source.
+type PipelineTriggerConfig struct {
+	// Schedule is a cron expression, a preset such as "@weekly", or a CloudWatch Events schedule expression.
+	Schedule string `yaml:"schedule"`
+}
+
+// IsManual returns true if the pipeline is only released manually.
+func (t PipelineTrigger) IsManual() bool {
+	return t.IsBasic() && t.Basic == PipelineTriggerManual
+}
+
+// Schedule returns the schedule to release the pipeline on, or an empty string if the pipeline isn't scheduled.
+func (t PipelineTrigger) Schedule() string {
+	if !t.IsAdvanced() {
+		return ""
+	}
+	return t.Advanced.Schedule
+}
+
+// PipelineNotifications configures where the pipeline reports the results of its stages.
+type PipelineNotifications struct {
+	GitHub *GitHubNotifications `yaml:"github,omitempty"`
+}
+
+// GitHubNotifications reports the results of the pipeline stages to the GitHub repository of the source.
+type GitHubNotifications struct {
+	// Name or ARN of the Secrets Manager secret that holds a GitHub token.
+	AccessTokenSecret string `yaml:"access_token_secret,omitempty"`
+	// Deployments is true if deployment stages are also reported as GitHub Deployments.
+	Deployments bool `yaml:"deployments,omitempty"`
+}
+
 // Source defines the source of the artifacts to be built and deployed.
 type Source struct {
 	ProviderName string                 `yaml:"provider"`
@@ -212,8 +260,16 @@ type PrePostDeployments map[string]*PrePostDeployment
 
 // PrePostDeployment is the config for a pre- or post-deployment action backed by CodeBuild.
 type PrePostDeployment struct {
-	BuildspecPath string   `yaml:"buildspec"`
-	DependsOn     []string `yaml:"depends_on"`
+	BuildspecPath string             `yaml:"buildspec"`
+	RunTask       *PrePostDeployTask `yaml:"run_task,omitempty"`
+	DependsOn     []string           `yaml:"depends_on"`
+}
+
+// PrePostDeployTask is the config for a one-off task run in the stage's environment as a pre- or post-deployment action.
+type PrePostDeployTask struct {
+	Image     string            `yaml:"image"`
+	Command   string            `yaml:"command,omitempty"`
+	Variables map[string]string `yaml:"variables,omitempty"`
 }
 
 // NewPipeline returns a pipeline manifest object.
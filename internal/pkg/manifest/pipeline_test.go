@@ -303,6 +303,122 @@ stages:
 				},
 			},
 		},
+		"valid pipeline.yml with github notifications": {
+			inContent: `
+name: pipepiper
+version: 1
+
+source:
+  provider: GitHub
+  properties:
+    repository: aws/somethingCool
+    branch: main
+
+stages:
+    -
+      name: chicken
+
+notifications:
+  github:
+    access_token_secret: github-token
+    deployments: true
+`,
+			expectedManifest: &Pipeline{
+				Name:    "pipepiper",
+				Version: Ver1,
+				Source: &Source{
+					ProviderName: "GitHub",
+					Properties: map[string]interface{}{
+						"repository": "aws/somethingCool",
+						"branch":     defaultGHBranch,
+					},
+				},
+				Stages: []PipelineStage{
+					{
+						Name: "chicken",
+					},
+				},
+				Notifications: &PipelineNotifications{
+					GitHub: &GitHubNotifications{
+						AccessTokenSecret: "github-token",
+						Deployments:       true,
+					},
+				},
+			},
+		},
+		"valid pipeline.yml with a manual trigger": {
+			inContent: `
+name: pipepiper
+version: 1
+
+source:
+  provider: CodeCommit
+  properties:
+    repository: https://us-west-2.console.aws.amazon.com/codesuite/codecommit/repositories/wings/browse
+
+trigger: manual
+
+stages:
+    -
+      name: chicken
+`,
+			expectedManifest: &Pipeline{
+				Name:    "pipepiper",
+				Version: Ver1,
+				Source: &Source{
+					ProviderName: "CodeCommit",
+					Properties: map[string]interface{}{
+						"repository": "https://us-west-2.console.aws.amazon.com/codesuite/codecommit/repositories/wings/browse",
+					},
+				},
+				Stages: []PipelineStage{
+					{
+						Name: "chicken",
+					},
+				},
+				Trigger: PipelineTrigger{
+					Union: BasicToUnion[string, PipelineTriggerConfig](PipelineTriggerManual),
+				},
+			},
+		},
+		"valid pipeline.yml with a scheduled trigger": {
+			inContent: `
+name: pipepiper
+version: 1
+
+source:
+  provider: CodeCommit
+  properties:
+    repository: https://us-west-2.console.aws.amazon.com/codesuite/codecommit/repositories/wings/browse
+
+trigger:
+  schedule: "0 9 * * 1"
+
+stages:
+    -
+      name: chicken
+`,
+			expectedManifest: &Pipeline{
+				Name:    "pipepiper",
+				Version: Ver1,
+				Source: &Source{
+					ProviderName: "CodeCommit",
+					Properties: map[string]interface{}{
+						"repository": "https://us-west-2.console.aws.amazon.com/codesuite/codecommit/repositories/wings/browse",
+					},
+				},
+				Stages: []PipelineStage{
+					{
+						Name: "chicken",
+					},
+				},
+				Trigger: PipelineTrigger{
+					Union: AdvancedToUnion[string](PipelineTriggerConfig{
+						Schedule: "0 9 * * 1",
+					}),
+				},
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -18,6 +18,7 @@ const (
 type RequestDrivenWebService struct {
 	Workload                      `yaml:",inline"`
 	RequestDrivenWebServiceConfig `yaml:",inline"`
+	Deploy                        WorkloadDeploy                            `yaml:"deploy"`
 	Environments                  map[string]*RequestDrivenWebServiceConfig `yaml:",flow"` // Fields to override per environment.
 
 	parser template.Parser
@@ -34,21 +35,61 @@ type RequestDrivenWebServiceConfig struct {
 	ImageConfig                       ImageWithPort                        `yaml:"image"`
 	Variables                         map[string]Variable                  `yaml:"variables"`
 	Secrets                           map[string]Secret                    `yaml:"secrets"`
+	EnvFile                           *string                              `yaml:"env_file"`
 	StartCommand                      *string                              `yaml:"command"`
 	Tags                              map[string]string                    `yaml:"tags"`
 	PublishConfig                     PublishConfig                        `yaml:"publish"`
 	Network                           RequestDrivenWebServiceNetworkConfig `yaml:"network"`
 	Observability                     Observability                        `yaml:"observability"`
 	Count                             *string                              `yaml:"count"`
+	Cost                              Cost                                 `yaml:"cost"`
 }
 
 // Observability holds configuration for observability to the service.
 type Observability struct {
-	Tracing *string `yaml:"tracing"`
+	Tracing   Union[*string, TracingConfig] `yaml:"tracing"`
+	Dashboard *bool                         `yaml:"dashboard"` // Only supported by Worker Services.
+}
+
+// TracingConfig represents the advanced configuration for tracing.
+type TracingConfig struct {
+	Vendor       *string  `yaml:"vendor"`
+	SamplingRate *float64 `yaml:"sampling_rate"`
+	ConfigFile   *string  `yaml:"config_file"` // Path to the configuration of the OpenTelemetry collector, relative to the workspace root.
+}
+
+// IsZero implements yaml.IsZeroer.
+func (t TracingConfig) IsZero() bool {
+	return t.Vendor == nil && t.SamplingRate == nil && t.ConfigFile == nil
 }
 
 func (o *Observability) isEmpty() bool {
-	return o.Tracing == nil
+	return o.Tracing.IsZero() && o.Dashboard == nil
+}
+
+// TracingVendor returns the name of the vendor used for tracing, or an empty string if tracing is disabled.
+func (o *Observability) TracingVendor() string {
+	if o.Tracing.IsAdvanced() {
+		return aws.StringValue(o.Tracing.Advanced.Vendor)
+	}
+	return aws.StringValue(o.Tracing.Basic)
+}
+
+// TracingSamplingRate returns the fraction of requests to trace, or nil if the vendor's default applies.
+func (o *Observability) TracingSamplingRate() *float64 {
+	if o.Tracing.IsAdvanced() {
+		return o.Tracing.Advanced.SamplingRate
+	}
+	return nil
+}
+
+// TracingConfigFile returns the path to the configuration of the OpenTelemetry collector,
+// or an empty string if the collector uses its default configuration.
+func (o *Observability) TracingConfigFile() string {
+	if o.Tracing.IsAdvanced() {
+		return aws.StringValue(o.Tracing.Advanced.ConfigFile)
+	}
+	return ""
 }
 
 // ImageWithPort represents a container image with an exposed port.
@@ -76,10 +117,11 @@ func (c *RequestDrivenWebServiceNetworkConfig) requiredEnvFeatures() []string {
 
 type rdwsVpcConfig struct {
 	Placement PlacementArgOrString `yaml:"placement"`
+	Connector *string              `yaml:"connector"` // Name of an App Runner VPC connector shared by the environment.
 }
 
 func (c *rdwsVpcConfig) isEmpty() bool {
-	return c.Placement.IsEmpty()
+	return c.Placement.IsEmpty() && c.Connector == nil
 }
 
 // RequestDrivenWebServiceHttpConfig represents options for configuring http.
@@ -159,6 +201,16 @@ func (s *RequestDrivenWebService) ContainerPlatform() string {
 	return platformString(s.InstanceConfig.Platform.OS(), s.InstanceConfig.Platform.Arch())
 }
 
+// ImageTags returns the additional tag templates of the main container image.
+func (s *RequestDrivenWebService) ImageTags() []string {
+	return s.ImageConfig.Image.Tags
+}
+
+// ImageLifecycle returns the lifecycle policy of the ECR repository of the workload.
+func (s *RequestDrivenWebService) ImageLifecycle() ImageLifecycle {
+	return s.ImageConfig.Image.Lifecycle
+}
+
 // BuildArgs returns a docker.BuildArguments object given a context directory.
 func (s *RequestDrivenWebService) BuildArgs(contextDir string) (map[string]*DockerBuildArgs, error) {
 	required, err := requiresBuild(s.ImageConfig.Image)
@@ -254,6 +254,39 @@ func TestRequestDrivenWebService_UnmarshalYaml(t *testing.T) {
 				},
 			},
 		},
+		"should unmarshal tracing shorthand": {
+			inContent: []byte(
+				"observability:\n" +
+					"  tracing: awsxray\n",
+			),
+
+			wantedStruct: RequestDrivenWebService{
+				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
+					Observability: Observability{
+						Tracing: BasicToUnion[*string, TracingConfig](aws.String("awsxray")),
+					},
+				},
+			},
+		},
+		"should unmarshal tracing with a sampling rate": {
+			inContent: []byte(
+				"observability:\n" +
+					"  tracing:\n" +
+					"    vendor: awsxray\n" +
+					"    sampling_rate: 0.1\n",
+			),
+
+			wantedStruct: RequestDrivenWebService{
+				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
+					Observability: Observability{
+						Tracing: AdvancedToUnion[*string](TracingConfig{
+							Vendor:       aws.String("awsxray"),
+							SamplingRate: aws.Float64(0.1),
+						}),
+					},
+				},
+			},
+		},
 	}
 
 	for name, tc := range testCases {
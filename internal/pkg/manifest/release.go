@@ -0,0 +1,66 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"errors"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Release represents a release of several workloads of the workspace, deployed together in order.
+type Release struct {
+	Workloads []ReleaseWorkload `yaml:"workloads"`
+}
+
+// ReleaseWorkload represents the version of a workload to deploy as part of a release.
+type ReleaseWorkload struct {
+	Name             string  `yaml:"name"`
+	ImageTag         *string `yaml:"image_tag"`
+	ManifestRevision *string `yaml:"manifest_revision"` // Git revision to read the manifest of the workload from.
+}
+
+// UnmarshalRelease deserializes the YAML input stream into a release manifest object.
+// If an error occurs during deserialization, then returns the error.
+func UnmarshalRelease(in []byte) (*Release, error) {
+	var r Release
+	if err := yaml.Unmarshal(in, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// Validate returns nil if the release manifest is configured correctly.
+func (r Release) Validate() error {
+	if len(r.Workloads) == 0 {
+		return errors.New(`"workloads" must contain at least one workload`)
+	}
+	seen := make(map[string]struct{}, len(r.Workloads))
+	for i, wkld := range r.Workloads {
+		if err := wkld.validate(); err != nil {
+			return fmt.Errorf(`validate "workloads[%d]": %w`, i, err)
+		}
+		if _, ok := seen[wkld.Name]; ok {
+			return fmt.Errorf("workload %s is listed more than once", wkld.Name)
+		}
+		seen[wkld.Name] = struct{}{}
+	}
+	return nil
+}
+
+func (w ReleaseWorkload) validate() error {
+	if w.Name == "" {
+		return &errFieldMustBeSpecified{
+			missingField: "name",
+		}
+	}
+	if w.ImageTag != nil && *w.ImageTag == "" {
+		return errors.New(`"image_tag" cannot be empty`)
+	}
+	if w.ManifestRevision != nil && *w.ManifestRevision == "" {
+		return errors.New(`"manifest_revision" cannot be empty`)
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnmarshalRelease(t *testing.T) {
+	in := `
+workloads:
+  - name: api
+    image_tag: v1.4.0
+    manifest_revision: 3f2a1c9
+  - name: frontend
+`
+	got, err := UnmarshalRelease([]byte(in))
+
+	require.NoError(t, err)
+	require.Equal(t, &Release{
+		Workloads: []ReleaseWorkload{
+			{
+				Name:             "api",
+				ImageTag:         aws.String("v1.4.0"),
+				ManifestRevision: aws.String("3f2a1c9"),
+			},
+			{
+				Name: "frontend",
+			},
+		},
+	}, got)
+}
+
+func TestRelease_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		in        Release
+		wantedErr string
+	}{
+		"error if there are no workloads": {
+			wantedErr: `"workloads" must contain at least one workload`,
+		},
+		"error if a workload has no name": {
+			in: Release{
+				Workloads: []ReleaseWorkload{{Name: "api"}, {ImageTag: aws.String("v1")}},
+			},
+			wantedErr: `validate "workloads[1]": "name" must be specified`,
+		},
+		"error if the image tag is empty": {
+			in: Release{
+				Workloads: []ReleaseWorkload{{Name: "api", ImageTag: aws.String("")}},
+			},
+			wantedErr: `validate "workloads[0]": "image_tag" cannot be empty`,
+		},
+		"error if the manifest revision is empty": {
+			in: Release{
+				Workloads: []ReleaseWorkload{{Name: "api", ManifestRevision: aws.String("")}},
+			},
+			wantedErr: `validate "workloads[0]": "manifest_revision" cannot be empty`,
+		},
+		"error if a workload is listed twice": {
+			in: Release{
+				Workloads: []ReleaseWorkload{{Name: "api"}, {Name: "frontend"}, {Name: "api"}},
+			},
+			wantedErr: "workload api is listed more than once",
+		},
+		"success": {
+			in: Release{
+				Workloads: []ReleaseWorkload{
+					{Name: "api", ImageTag: aws.String("v1"), ManifestRevision: aws.String("main")},
+					{Name: "frontend"},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.in.Validate()
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
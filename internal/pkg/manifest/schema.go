@@ -0,0 +1,295 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"gopkg.in/yaml.v3"
+)
+
+//go:generate go run ./schemagen ../../../site/content/schemas
+
+const (
+	jsonSchemaDraft   = "http://json-schema.org/draft-07/schema#"
+	jsonSchemaBaseURL = "https://aws.github.io/copilot-cli/schemas/"
+)
+
+// File names of the published JSON schemas.
+const (
+	WorkloadSchemaFileName    = "workload.json"
+	EnvironmentSchemaFileName = "environment.json"
+	PipelineSchemaFileName    = "pipeline.json"
+)
+
+var (
+	yamlUnmarshalerType = reflect.TypeOf((*yaml.Unmarshaler)(nil)).Elem()
+	yamlNodeType        = reflect.TypeOf(yaml.Node{})
+	durationType        = reflect.TypeOf(time.Duration(0))
+)
+
+// JSONSchemas returns the JSON schemas of all manifest kinds keyed by the name of the file they are published under.
+func JSONSchemas() (map[string][]byte, error) {
+	schemas := make(map[string][]byte)
+	for fname, generate := range map[string]func() ([]byte, error){
+		WorkloadSchemaFileName:    WorkloadJSONSchema,
+		EnvironmentSchemaFileName: EnvironmentJSONSchema,
+		PipelineSchemaFileName:    PipelineJSONSchema,
+	} {
+		schema, err := generate()
+		if err != nil {
+			return nil, fmt.Errorf("generate schema %s: %w", fname, err)
+		}
+		schemas[fname] = schema
+	}
+	return schemas, nil
+}
+
+// WorkloadJSONSchema returns the JSON schema of the manifest of a service or a job.
+// The schema selects the set of fields to validate against from the "type" of the workload.
+func WorkloadJSONSchema() ([]byte, error) {
+	g := newSchemaGenerator()
+	var workloads []any
+	for _, wkld := range []struct {
+		typ string
+		mft any
+	}{
+		{typ: manifestinfo.LoadBalancedWebServiceType, mft: LoadBalancedWebService{}},
+		{typ: manifestinfo.RequestDrivenWebServiceType, mft: RequestDrivenWebService{}},
+		{typ: manifestinfo.BackendServiceType, mft: BackendService{}},
+		{typ: manifestinfo.WorkerServiceType, mft: WorkerService{}},
+		{typ: manifestinfo.StaticSiteType, mft: StaticSite{}},
+		{typ: manifestinfo.ScheduledJobType, mft: ScheduledJob{}},
+	} {
+		workloads = append(workloads, typedSchema(g.schema(reflect.TypeOf(wkld.mft)), wkld.typ))
+	}
+	return g.document(WorkloadSchemaFileName, "AWS Copilot workload manifest", map[string]any{
+		"oneOf": workloads,
+	})
+}
+
+// EnvironmentJSONSchema returns the JSON schema of the manifest of an environment.
+func EnvironmentJSONSchema() ([]byte, error) {
+	g := newSchemaGenerator()
+	return g.document(EnvironmentSchemaFileName, "AWS Copilot environment manifest",
+		typedSchema(g.schema(reflect.TypeOf(Environment{})), Environmentmanifestinfo))
+}
+
+// PipelineJSONSchema returns the JSON schema of the manifest of a pipeline.
+func PipelineJSONSchema() ([]byte, error) {
+	g := newSchemaGenerator()
+	return g.document(PipelineSchemaFileName, "AWS Copilot pipeline manifest", map[string]any{
+		"allOf": []any{g.schema(reflect.TypeOf(Pipeline{}))},
+		"properties": map[string]any{
+			"version": map[string]any{"const": Ver1},
+		},
+		"required": []string{"name", "version"},
+	})
+}
+
+// typedSchema restricts the "type" field of the manifest described by schema to typ.
+func typedSchema(schema map[string]any, typ string) map[string]any {
+	return map[string]any{
+		"allOf": []any{schema},
+		"properties": map[string]any{
+			"type": map[string]any{"const": typ},
+		},
+		"required": []string{"name", "type"},
+	}
+}
+
+// schemaGenerator generates JSON schemas from the yaml tags of the manifest structs.
+// Named structs are described once under "definitions" and referenced everywhere else.
+type schemaGenerator struct {
+	definitions map[string]any
+	names       map[reflect.Type]string
+}
+
+func newSchemaGenerator() *schemaGenerator {
+	return &schemaGenerator{
+		definitions: make(map[string]any),
+		names:       make(map[reflect.Type]string),
+	}
+}
+
+func (g *schemaGenerator) document(fname, title string, root map[string]any) ([]byte, error) {
+	root["$schema"] = jsonSchemaDraft
+	root["$id"] = jsonSchemaBaseURL + fname
+	root["title"] = title
+	if len(g.definitions) != 0 {
+		root["definitions"] = g.definitions
+	}
+	out, err := json.MarshalIndent(root, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal schema: %w", err)
+	}
+	return append(out, '\n'), nil
+}
+
+func (g *schemaGenerator) schema(t reflect.Type) map[string]any {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch t {
+	case yamlNodeType:
+		return map[string]any{}
+	case durationType:
+		return map[string]any{"type": "string"}
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]any{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+	case reflect.String:
+		// Any scalar, like the port "8080", decodes into a string.
+		return map[string]any{"type": []string{"string", "number", "boolean"}}
+	case reflect.Slice, reflect.Array:
+		return map[string]any{
+			"type":  "array",
+			"items": g.schema(t.Elem()),
+		}
+	case reflect.Map:
+		return map[string]any{
+			"type":                 "object",
+			"additionalProperties": g.schema(t.Elem()),
+		}
+	case reflect.Struct:
+		return g.structSchema(t)
+	default:
+		// Interfaces accept any value.
+		return map[string]any{}
+	}
+}
+
+func (g *schemaGenerator) structSchema(t reflect.Type) map[string]any {
+	if t.Name() == "" || strings.Contains(t.Name(), "[") {
+		// Anonymous and generic structs are described inline.
+		return g.unionOrObject(t)
+	}
+	name, ok := g.names[t]
+	if !ok {
+		name = g.definitionName(t)
+		g.names[t] = name
+		g.definitions[name] = map[string]any{} // Placeholder in case the struct refers to itself.
+		g.definitions[name] = g.unionOrObject(t)
+	}
+	return map[string]any{"$ref": "#/definitions/" + name}
+}
+
+func (g *schemaGenerator) definitionName(t reflect.Type) string {
+	name := t.Name()
+	if _, taken := g.definitions[name]; !taken {
+		return name
+	}
+	pkg := t.PkgPath()[strings.LastIndex(t.PkgPath(), "/")+1:]
+	return pkg + "." + name
+}
+
+func (g *schemaGenerator) unionOrObject(t reflect.Type) map[string]any {
+	if isUnion(t) {
+		return g.union(t)
+	}
+	return g.object(t)
+}
+
+// union describes a struct that unmarshals a yaml value into one of its fields.
+func (g *schemaGenerator) union(t reflect.Type) map[string]any {
+	var alternatives []any
+	for _, f := range unionFields(t) {
+		alternatives = append(alternatives, g.schema(f.Type))
+	}
+	if len(alternatives) == 1 {
+		return alternatives[0].(map[string]any)
+	}
+	return map[string]any{"anyOf": alternatives}
+}
+
+func (g *schemaGenerator) object(t reflect.Type) map[string]any {
+	obj := map[string]any{
+		"type":                 "object",
+		"additionalProperties": false,
+	}
+	properties := make(map[string]any)
+	g.addProperties(obj, properties, t)
+	if len(properties) != 0 {
+		obj["properties"] = properties
+	}
+	return obj
+}
+
+func (g *schemaGenerator) addProperties(obj, properties map[string]any, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name, inline := parseYAMLTag(f.Tag.Get("yaml"))
+		if name == "-" {
+			continue
+		}
+		if inline {
+			ft := f.Type
+			for ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Map {
+				obj["additionalProperties"] = g.schema(ft.Elem())
+				continue
+			}
+			g.addProperties(obj, properties, ft)
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = strings.ToLower(f.Name)
+		}
+		properties[name] = g.schema(f.Type)
+	}
+}
+
+// isUnion returns true if the struct implements its own yaml unmarshaling into one of its untagged fields,
+// like "BuildArgsOrString", instead of decoding a map into its tagged fields like "Image".
+func isUnion(t reflect.Type) bool {
+	if !reflect.PointerTo(t).Implements(yamlUnmarshalerType) {
+		return false
+	}
+	for i := 0; i < t.NumField(); i++ {
+		if _, ok := t.Field(i).Tag.Lookup("yaml"); ok {
+			return false
+		}
+	}
+	return true
+}
+
+func unionFields(t reflect.Type) []reflect.StructField {
+	if strings.HasPrefix(t.Name(), "Union[") {
+		// Union keeps track of which of its values is set with unexported booleans.
+		basic, _ := t.FieldByName("Basic")
+		advanced, _ := t.FieldByName("Advanced")
+		return []reflect.StructField{basic, advanced}
+	}
+	fields := make([]reflect.StructField, t.NumField())
+	for i := range fields {
+		fields[i] = t.Field(i)
+	}
+	return fields
+}
+
+func parseYAMLTag(tag string) (name string, inline bool) {
+	parts := strings.Split(tag, ",")
+	for _, opt := range parts[1:] {
+		if opt == "inline" {
+			inline = true
+		}
+	}
+	return parts[0], inline
+}
@@ -0,0 +1,211 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestSchemaGenerator_Schema(t *testing.T) {
+	testCases := map[string]struct {
+		in any
+
+		wantedSchema      string
+		wantedDefinitions string
+	}{
+		"scalars": {
+			in: struct {
+				Enabled  *bool         `yaml:"enabled"`
+				Count    int           `yaml:"count"`
+				Name     string        `yaml:"name"`
+				Interval time.Duration `yaml:"interval"`
+				Value    yaml.Node     `yaml:"value"`
+				Anything interface{}   `yaml:"anything"`
+			}{},
+			wantedSchema: `{
+  "additionalProperties": false,
+  "properties": {
+    "anything": {},
+    "count": {"type": "integer"},
+    "enabled": {"type": "boolean"},
+    "interval": {"type": "string"},
+    "name": {"type": ["string", "number", "boolean"]},
+    "value": {}
+  },
+  "type": "object"
+}`,
+			wantedDefinitions: `{}`,
+		},
+		"inlines fields, uses the lowercase field name without a tag and skips unexported fields": {
+			in: struct {
+				Workload `yaml:",inline"`
+				Labels   map[string]string `yaml:",flow"`
+				Skipped  *string           `yaml:"-"`
+				ignored  *string
+			}{},
+			wantedSchema: `{
+  "additionalProperties": false,
+  "properties": {
+    "labels": {"additionalProperties": {"type": ["string", "number", "boolean"]}, "type": "object"},
+    "name": {"type": ["string", "number", "boolean"]},
+    "type": {"type": ["string", "number", "boolean"]}
+  },
+  "type": "object"
+}`,
+			wantedDefinitions: `{}`,
+		},
+		"describes a type that unmarshals into one of its fields as alternatives": {
+			in:           BuildArgsOrString{},
+			wantedSchema: `{"$ref": "#/definitions/BuildArgsOrString"}`,
+			wantedDefinitions: `{
+  "BuildArgsOrString": {
+    "anyOf": [
+      {"type": ["string", "number", "boolean"]},
+      {"$ref": "#/definitions/DockerBuildArgs"}
+    ]
+  },
+  "DockerBuildArgs": {
+    "additionalProperties": false,
+    "properties": {
+      "args": {"additionalProperties": {"type": ["string", "number", "boolean"]}, "type": "object"},
+      "cache_from": {"items": {"type": ["string", "number", "boolean"]}, "type": "array"},
+      "cache_to": {"items": {"type": ["string", "number", "boolean"]}, "type": "array"},
+      "context": {"type": ["string", "number", "boolean"]},
+      "dockerfile": {"type": ["string", "number", "boolean"]},
+      "target": {"type": ["string", "number", "boolean"]}
+    },
+    "type": "object"
+  }
+}`,
+		},
+		"describes a generic union inline": {
+			in: Union[*bool, ExecuteCommandConfig]{},
+			wantedSchema: `{
+  "anyOf": [
+    {"type": "boolean"},
+    {"$ref": "#/definitions/ExecuteCommandConfig"}
+  ]
+}`,
+			wantedDefinitions: `{
+  "ExecuteCommandConfig": {
+    "additionalProperties": false,
+    "properties": {
+      "enable": {"type": "boolean"}
+    },
+    "type": "object"
+  }
+}`,
+		},
+		"describes a type with its own unmarshaling into tagged fields as an object": {
+			in:           Image{},
+			wantedSchema: `{"$ref": "#/definitions/Image"}`,
+			wantedDefinitions: `{
+  "BuildArgsOrString": {
+    "anyOf": [
+      {"type": ["string", "number", "boolean"]},
+      {"$ref": "#/definitions/DockerBuildArgs"}
+    ]
+  },
+  "DockerBuildArgs": {
+    "additionalProperties": false,
+    "properties": {
+      "args": {"additionalProperties": {"type": ["string", "number", "boolean"]}, "type": "object"},
+      "cache_from": {"items": {"type": ["string", "number", "boolean"]}, "type": "array"},
+      "cache_to": {"items": {"type": ["string", "number", "boolean"]}, "type": "array"},
+      "context": {"type": ["string", "number", "boolean"]},
+      "dockerfile": {"type": ["string", "number", "boolean"]},
+      "target": {"type": ["string", "number", "boolean"]}
+    },
+    "type": "object"
+  },
+  "Image": {
+    "additionalProperties": false,
+    "properties": {
+      "build": {"$ref": "#/definitions/BuildArgsOrString"},
+      "credentials": {"type": ["string", "number", "boolean"]},
+      "depends_on": {"additionalProperties": {"type": ["string", "number", "boolean"]}, "type": "object"},
+      "labels": {"additionalProperties": {"type": ["string", "number", "boolean"]}, "type": "object"},
+      "lifecycle": {"$ref": "#/definitions/ImageLifecycle"},
+      "location": {"type": ["string", "number", "boolean"]},
+      "tags": {"items": {"type": ["string", "number", "boolean"]}, "type": "array"}
+    },
+    "type": "object"
+  },
+  "ImageLifecycle": {
+    "additionalProperties": false,
+    "properties": {
+      "expire_untagged_days": {"type": "integer"},
+      "keep_images": {"type": "integer"}
+    },
+    "type": "object"
+  }
+}`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			g := newSchemaGenerator()
+
+			schema := g.schema(reflect.TypeOf(tc.in))
+
+			actual, err := json.Marshal(schema)
+			require.NoError(t, err)
+			require.JSONEq(t, tc.wantedSchema, string(actual))
+			actual, err = json.Marshal(g.definitions)
+			require.NoError(t, err)
+			require.JSONEq(t, tc.wantedDefinitions, string(actual))
+		})
+	}
+}
+
+func TestWorkloadJSONSchema(t *testing.T) {
+	// WHEN
+	out, err := WorkloadJSONSchema()
+
+	// THEN
+	require.NoError(t, err)
+	var schema struct {
+		OneOf []struct {
+			Properties struct {
+				Type struct {
+					Const string `json:"const"`
+				} `json:"type"`
+			} `json:"properties"`
+			Required []string `json:"required"`
+		} `json:"oneOf"`
+		Definitions map[string]any `json:"definitions"`
+	}
+	require.NoError(t, json.Unmarshal(out, &schema))
+	var types []string
+	for _, wkld := range schema.OneOf {
+		types = append(types, wkld.Properties.Type.Const)
+		require.Equal(t, []string{"name", "type"}, wkld.Required)
+	}
+	require.Equal(t, []string{"Load Balanced Web Service", "Request-Driven Web Service", "Backend Service", "Worker Service", "Static Site", "Scheduled Job"}, types)
+	require.Contains(t, schema.Definitions, "LoadBalancedWebServiceConfig", "environment overrides are described by the config of the workload")
+}
+
+func TestJSONSchemas_Published(t *testing.T) {
+	// GIVEN
+	dir := filepath.Join("..", "..", "..", "site", "content", "schemas")
+
+	// WHEN
+	schemas, err := JSONSchemas()
+
+	// THEN
+	require.NoError(t, err)
+	for fname, schema := range schemas {
+		published, err := os.ReadFile(filepath.Join(dir, fname))
+		require.NoError(t, err)
+		require.Equal(t, string(schema), string(published), "schema %s is out of date, run `make gen-schemas`", fname)
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Command schemagen writes the JSON schemas of the manifests to the directory passed as its only argument.
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+)
+
+func main() {
+	if len(os.Args) != 2 {
+		fmt.Fprintln(os.Stderr, "usage: schemagen <output directory>")
+		os.Exit(1)
+	}
+	if err := run(os.Args[1]); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(dir string) error {
+	schemas, err := manifest.JSONSchemas()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("create directory %s: %w", dir, err)
+	}
+	for fname, schema := range schemas {
+		if err := os.WriteFile(filepath.Join(dir, fname), schema, 0644); err != nil {
+			return fmt.Errorf("write schema %s: %w", fname, err)
+		}
+	}
+	return nil
+}
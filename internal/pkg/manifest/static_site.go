@@ -18,6 +18,7 @@ const (
 type StaticSite struct {
 	Workload         `yaml:",inline"`
 	StaticSiteConfig `yaml:",inline"`
+	Deploy           WorkloadDeploy `yaml:"deploy"`
 	// Use *StaticSiteConfig because of https://github.com/imdario/mergo/issues/146
 	Environments map[string]*StaticSiteConfig `yaml:",flow"` // Fields to override per environment.
 
@@ -32,8 +33,30 @@ type StaticSiteConfig struct {
 
 // StaticSiteHTTP defines the http configuration for the static site.
 type StaticSiteHTTP struct {
-	Alias       string `yaml:"alias"`
-	Certificate string `yaml:"certificate"`
+	Alias       string               `yaml:"alias"`
+	Certificate string               `yaml:"certificate"`
+	ErrorPages  StaticSiteErrorPages `yaml:"error_pages"`
+	SPA         *bool                `yaml:"spa"` // Serve index.html for the paths that don't match a file.
+	Redirects   []StaticSiteRedirect `yaml:"redirects"`
+}
+
+// StaticSiteRedirect redirects the requests for a path of the static site to another location.
+// A path ending with "*" matches every path with the same prefix.
+type StaticSiteRedirect struct {
+	From   string `yaml:"from"`
+	To     string `yaml:"to"`
+	Status *int   `yaml:"status"` // Defaults to 301.
+}
+
+// StaticSiteErrorPages holds the paths of the pages served by the static site instead of the default error responses.
+type StaticSiteErrorPages struct {
+	NotFound  string `yaml:"not_found"`
+	Forbidden string `yaml:"forbidden"`
+}
+
+// IsEmpty returns true if no error page is configured.
+func (p StaticSiteErrorPages) IsEmpty() bool {
+	return p.NotFound == "" && p.Forbidden == ""
 }
 
 // FileUpload represents the options for file uploading.
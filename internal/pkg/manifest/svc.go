@@ -358,6 +358,44 @@ func (qs *QueueScaling) AcceptableBacklogPerTask() (int, error) {
 	return int(v), nil
 }
 
+// Cost holds the configuration to alert on the spend of a service.
+type Cost struct {
+	MonthlyBudget    *float64 `yaml:"monthly_budget"`    // Monthly budget of the service in USD.
+	AlertThreshold   *int     `yaml:"alert_threshold"`   // Percentage of the budget that triggers an alert.
+	AnomalyDetection *bool    `yaml:"anomaly_detection"` // Whether to alert on cost anomalies of the service.
+	Emails           []string `yaml:"emails"`            // Email addresses subscribed to the alerts.
+}
+
+// IsEmpty returns true if no cost alerts are configured.
+func (c *Cost) IsEmpty() bool {
+	return c.MonthlyBudget == nil && c.AlertThreshold == nil && c.AnomalyDetection == nil && len(c.Emails) == 0
+}
+
+// AppConfigAgentContainerName is the name of the sidecar container that runs the AWS AppConfig agent.
+const AppConfigAgentContainerName = "appconfig-agent"
+
+const defaultAppConfigAgentPort = 2772
+
+// AppConfig holds the configuration of the AWS AppConfig feature flags of a service.
+type AppConfig struct {
+	Profiles     []string       `yaml:"profiles"`      // Names of the feature flags configuration profiles.
+	PollInterval *time.Duration `yaml:"poll_interval"` // Interval at which the agent polls AppConfig for updates.
+	Port         *uint16        `yaml:"port"`          // Port that the agent serves the feature flags on.
+}
+
+// IsEmpty returns true if no AppConfig feature flags are configured.
+func (a *AppConfig) IsEmpty() bool {
+	return len(a.Profiles) == 0 && a.PollInterval == nil && a.Port == nil
+}
+
+// AgentPort returns the port that the AppConfig agent listens on.
+func (a *AppConfig) AgentPort() uint16 {
+	if a.Port == nil {
+		return defaultAppConfigAgentPort
+	}
+	return aws.Uint16Value(a.Port)
+}
+
 // HTTPHealthCheckArgs holds the configuration to determine if the load balanced web service is healthy.
 // These options are specifiable under the "healthcheck" field.
 // See https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-resource-elasticloadbalancingv2-targetgroup.html.
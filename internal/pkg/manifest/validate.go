@@ -13,12 +13,14 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudfront"
 	"github.com/aws/copilot-cli/internal/pkg/graph"
 	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/dustin/go-humanize/english"
 )
@@ -49,6 +51,7 @@ const (
 
 	// Tracing vendors.
 	awsXRAY = "awsxray"
+	otel    = "otel"
 )
 
 const (
@@ -60,6 +63,9 @@ const (
 	// Please refer to https://docs.aws.amazon.com/elasticloadbalancing/latest/application/load-balancer-limits.html.
 	maxConditionsPerRule = 5
 	rootPath             = "/"
+
+	// Batches of more than 10 SQS messages require a batching window, which jobs don't expose.
+	maxJobQueueBatchSize = 10
 )
 
 var (
@@ -69,16 +75,23 @@ var (
 	awsNameRegexp       = regexp.MustCompile(`^[a-z][a-z0-9\-]+$`) // Validates that an expression starts with a letter and only contains letters, numbers, and hyphens.
 	punctuationRegExp   = regexp.MustCompile(`[\.\-]{2,}`)         // Check for consecutive periods or dashes.
 	trailingPunctRegExp = regexp.MustCompile(`[\-\.]$`)            // Check for trailing dash or dot.
+	cachePolicyIDRegexp = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+	kafkaTopicRegexp    = regexp.MustCompile(`^[a-zA-Z0-9._-]{1,249}$`) // Validates the name of a Kafka topic or consumer group.
 
 	essentialContainerDependsOnValidStatuses = []string{dependsOnStart, dependsOnHealthy}
 	dependsOnValidStatuses                   = []string{dependsOnStart, dependsOnComplete, dependsOnSuccess, dependsOnHealthy}
 	nlbValidProtocols                        = []string{TCP, UDP, TLS}
+	nlbValidALPNPolicies                     = []string{"HTTP1Only", "HTTP2Only", "HTTP2Optional", "HTTP2Preferred", "None"}
 	validContainerProtocols                  = []string{TCP, UDP}
 	validHealthCheckProtocols                = []string{TCP}
-	tracingValidVendors                      = []string{awsXRAY}
+	tracingValidVendors                      = []string{awsXRAY, otel}
 	ecsRollingUpdateStrategies               = []string{ECSDefaultRollingUpdateStrategy, ECSRecreateRollingUpdateStrategy}
 
 	httpProtocolVersions = []string{"GRPC", "HTTP1", "HTTP2"}
+	stickinessTypes      = []string{StickinessTypeLBCookie, StickinessTypeAppCookie}
+	mutualTLSModes       = []string{MutualTLSModeVerify, MutualTLSModePassthrough}
+	flowLogsDestinations = []string{FlowLogsDestinationCloudWatch, FlowLogsDestinationS3}
+	flowLogsTrafficTypes = []string{FlowLogsTrafficTypeAll, FlowLogsTrafficTypeAccept, FlowLogsTrafficTypeReject}
 
 	invalidTaskDefOverridePathRegexp  = []string{`Family`, `ContainerDefinitions\[\d+\].Name`}
 	validSQSDeduplicationScopeValues  = []string{sqsDeduplicationScopeMessageGroup, sqsDeduplicationScopeQueue}
@@ -99,6 +112,9 @@ func (l LoadBalancedWebService) validate() error {
 	if err = l.Workload.validate(); err != nil {
 		return err
 	}
+	if err = l.Deploy.validate(); err != nil {
+		return fmt.Errorf(`validate "deploy": %w`, err)
+	}
 	if err = validateTargetContainer(validateTargetContainerOpts{
 		mainContainerName: aws.StringValue(l.Name),
 		mainContainerPort: l.ImageConfig.Port,
@@ -138,8 +154,10 @@ func (l LoadBalancedWebService) validate() error {
 	if err = validateContainerDeps(validateDependenciesOpts{
 		sidecarConfig:     l.Sidecars,
 		imageConfig:       l.ImageConfig.Image,
+		imageHealthCheck:  l.ImageConfig.HealthCheck,
 		mainContainerName: aws.StringValue(l.Name),
 		logging:           l.Logging,
+		taskConfig:        l.TaskConfig,
 	}); err != nil {
 		return fmt.Errorf("validate container dependencies: %w", err)
 	}
@@ -156,6 +174,9 @@ func (l LoadBalancedWebService) validate() error {
 	if err != nil {
 		return err
 	}
+	if err = validateServiceConnectPorts(l.Network.Connect, ports, l.ServiceConnectTarget(ports)); err != nil {
+		return err
+	}
 	if err = validateHealthCheckPorts(validateHealthCheckPortsOpts{
 		exposedPorts:      ports,
 		mainContainerPort: l.ImageConfig.Port,
@@ -177,6 +198,9 @@ func (d DeploymentConfig) validate() error {
 	if err := d.DeploymentControllerConfig.validate(); err != nil {
 		return fmt.Errorf(`validate "rolling": %w`, err)
 	}
+	if err := d.Validation.validate(); err != nil {
+		return fmt.Errorf(`validate "validation": %w`, err)
+	}
 	return nil
 }
 
@@ -190,10 +214,52 @@ func (w WorkerDeploymentConfig) validate() error {
 	if err := w.DeploymentControllerConfig.validate(); err != nil {
 		return fmt.Errorf(`validate "deployment controller strategy": %w`, err)
 	}
+	if err := w.Validation.validate(); err != nil {
+		return fmt.Errorf(`validate "validation": %w`, err)
+	}
+	return nil
+}
+
+// validate returns nil if DeploymentValidation is configured correctly.
+func (v DeploymentValidation) validate() error {
+	if v.IsEmpty() {
+		return nil
+	}
+	hasCommand := !(*StringSliceOrString)(&v.Command).isEmpty()
+	if (v.Path != nil) == hasCommand {
+		return &errFieldMutualExclusive{
+			firstField:  "path",
+			secondField: "command",
+			mustExist:   !hasCommand,
+		}
+	}
+	if v.Status != nil && v.Path == nil {
+		return &errFieldMustBeSpecified{
+			missingField:      "path",
+			conditionalFields: []string{"status"},
+		}
+	}
+	if v.Path != nil && !strings.HasPrefix(aws.StringValue(v.Path), "/") {
+		return fmt.Errorf(`"path" must start with "/"`)
+	}
+	if status := aws.IntValue(v.Status); v.Status != nil && (status < 100 || status > 599) {
+		return fmt.Errorf(`"status" must be an HTTP status code between 100 and 599`)
+	}
+	if v.Timeout != nil && *v.Timeout <= 0 {
+		return fmt.Errorf(`"timeout" must be greater than 0s`)
+	}
+	if hasCommand {
+		if _, err := v.Command.ToStringSlice(); err != nil {
+			return fmt.Errorf(`convert "command" to string slice: %w`, err)
+		}
+	}
 	return nil
 }
 
 func (d DeploymentControllerConfig) validate() error {
+	if d.KeepTaskDefinitions != nil && aws.IntValue(d.KeepTaskDefinitions) < 1 {
+		return errors.New(`"keep_task_definitions" must be at least 1`)
+	}
 	if d.Rolling != nil {
 		for _, validStrategy := range ecsRollingUpdateStrategies {
 			if strings.EqualFold(aws.StringValue(d.Rolling), validStrategy) {
@@ -260,6 +326,18 @@ func (l LoadBalancedWebServiceConfig) validate() error {
 	if err = l.PublishConfig.validate(); err != nil {
 		return fmt.Errorf(`validate "publish": %w`, err)
 	}
+	if err = l.Cost.validate(); err != nil {
+		return fmt.Errorf(`validate "cost": %w`, err)
+	}
+	if err = validateNoDashboard(l.Observability); err != nil {
+		return fmt.Errorf(`validate "observability": %w`, err)
+	}
+	if err = l.AppConfig.validate(); err != nil {
+		return fmt.Errorf(`validate "appconfig": %w`, err)
+	}
+	if err = validateAppConfigAgent(l.AppConfig, l.ImageConfig.Port, l.Sidecars); err != nil {
+		return err
+	}
 	for ind, taskDefOverride := range l.TaskDefOverrides {
 		if err = taskDefOverride.validate(); err != nil {
 			return fmt.Errorf(`validate "taskdef_overrides[%d]": %w`, ind, err)
@@ -290,6 +368,17 @@ func (l LoadBalancedWebServiceConfig) validate() error {
 	if err = l.DeployConfig.validate(); err != nil {
 		return fmt.Errorf(`validate "deployment": %w`, err)
 	}
+	if l.DeployConfig.Validation.Path != nil {
+		if l.HTTPOrBool.Disabled() {
+			return &errFieldMustBeSpecified{
+				missingField:      "http",
+				conditionalFields: []string{"deployment.validation.path"},
+			}
+		}
+		if aws.BoolValue(l.HTTPOrBool.MutualTLS) {
+			return errors.New(`"deployment.validation.path" cannot be requested when "http.mutual_tls" is enabled: use "deployment.validation.command" instead`)
+		}
+	}
 	return nil
 }
 
@@ -299,12 +388,18 @@ func (b BackendService) validate() error {
 	if err = b.DeployConfig.validate(); err != nil {
 		return fmt.Errorf(`validate "deployment": %w`, err)
 	}
+	if b.DeployConfig.Validation.Path != nil {
+		return errors.New(`"deployment.validation.path" is not supported for Backend Services because they are not reachable from outside the VPC: use "deployment.validation.command" instead`)
+	}
 	if err = b.BackendServiceConfig.validate(); err != nil {
 		return err
 	}
 	if err = b.Workload.validate(); err != nil {
 		return err
 	}
+	if err = b.Deploy.validate(); err != nil {
+		return fmt.Errorf(`validate "deploy": %w`, err)
+	}
 	if err = validateTargetContainer(validateTargetContainerOpts{
 		mainContainerName: aws.StringValue(b.Name),
 		mainContainerPort: b.ImageConfig.Port,
@@ -326,8 +421,10 @@ func (b BackendService) validate() error {
 	if err = validateContainerDeps(validateDependenciesOpts{
 		sidecarConfig:     b.Sidecars,
 		imageConfig:       b.ImageConfig.Image,
+		imageHealthCheck:  b.ImageConfig.HealthCheck,
 		mainContainerName: aws.StringValue(b.Name),
 		logging:           b.Logging,
+		taskConfig:        b.TaskConfig,
 	}); err != nil {
 		return fmt.Errorf("validate container dependencies: %w", err)
 	}
@@ -343,6 +440,9 @@ func (b BackendService) validate() error {
 	if err != nil {
 		return err
 	}
+	if err = validateServiceConnectPorts(b.Network.Connect, exposedPortsIndex, b.ServiceConnectTarget(exposedPortsIndex)); err != nil {
+		return err
+	}
 	if err = validateHealthCheckPorts(validateHealthCheckPortsOpts{
 		exposedPorts:      exposedPortsIndex,
 		mainContainerPort: b.ImageConfig.Port,
@@ -365,6 +465,9 @@ func (b BackendServiceConfig) validate() error {
 	if err = b.HTTP.validate(); err != nil {
 		return fmt.Errorf(`validate "http": %w`, err)
 	}
+	if !b.HTTP.CDN.IsZero() {
+		return errors.New(`"http.cdn" is not supported for Backend Services because they are not reachable from the internet`)
+	}
 	if b.HTTP.IsEmpty() && (!b.Count.AdvancedCount.Requests.IsEmpty() || !b.Count.AdvancedCount.ResponseTime.IsEmpty()) {
 		return &errFieldMustBeSpecified{
 			missingField:      "http",
@@ -393,6 +496,18 @@ func (b BackendServiceConfig) validate() error {
 	if err = b.PublishConfig.validate(); err != nil {
 		return fmt.Errorf(`validate "publish": %w`, err)
 	}
+	if err = b.Cost.validate(); err != nil {
+		return fmt.Errorf(`validate "cost": %w`, err)
+	}
+	if err = validateNoDashboard(b.Observability); err != nil {
+		return fmt.Errorf(`validate "observability": %w`, err)
+	}
+	if err = b.AppConfig.validate(); err != nil {
+		return fmt.Errorf(`validate "appconfig": %w`, err)
+	}
+	if err = validateAppConfigAgent(b.AppConfig, b.ImageConfig.Port, b.Sidecars); err != nil {
+		return err
+	}
 	for ind, taskDefOverride := range b.TaskDefOverrides {
 		if err = taskDefOverride.validate(); err != nil {
 			return fmt.Errorf(`validate "taskdef_overrides[%d]": %w`, ind, err)
@@ -425,7 +540,13 @@ func (r RequestDrivenWebService) validate() error {
 	if err := r.RequestDrivenWebServiceConfig.validate(); err != nil {
 		return err
 	}
-	return r.Workload.validate()
+	if err := r.Workload.validate(); err != nil {
+		return err
+	}
+	if err := r.Deploy.validate(); err != nil {
+		return fmt.Errorf(`validate "deploy": %w`, err)
+	}
+	return nil
 }
 
 // validate returns nil if RequestDrivenWebServiceConfig is configured correctly.
@@ -443,17 +564,59 @@ func (r RequestDrivenWebServiceConfig) validate() error {
 	if err = r.PublishConfig.validate(); err != nil {
 		return fmt.Errorf(`validate "publish": %w`, err)
 	}
+	if err = r.Cost.validate(); err != nil {
+		return fmt.Errorf(`validate "cost": %w`, err)
+	}
 	if err = r.Network.validate(); err != nil {
 		return fmt.Errorf(`validate "network": %w`, err)
 	}
 	if r.Network.VPC.Placement.PlacementString != nil &&
-		*r.Network.VPC.Placement.PlacementString != PrivateSubnetPlacement {
+		*r.Network.VPC.Placement.PlacementString == PublicSubnetPlacement {
 		return fmt.Errorf(`placement %q is not supported for %s`,
 			*r.Network.VPC.Placement.PlacementString, manifestinfo.RequestDrivenWebServiceType)
 	}
 	if err = r.Observability.validate(); err != nil {
 		return fmt.Errorf(`validate "observability": %w`, err)
 	}
+	if strings.EqualFold(r.Observability.TracingVendor(), otel) {
+		return fmt.Errorf(`tracing vendor %q is not supported for %s`, otel, manifestinfo.RequestDrivenWebServiceType)
+	}
+	if err = validateNoDashboard(r.Observability); err != nil {
+		return fmt.Errorf(`validate "observability": %w`, err)
+	}
+	if err = validateRDWSSecrets(r.Secrets, r.Variables); err != nil {
+		return err
+	}
+	if r.EnvFile != nil {
+		envFile := aws.StringValue(r.EnvFile)
+		if filepath.Ext(envFile) != envFileExt {
+			return fmt.Errorf("environment file %s must have a %s file extension", envFile, envFileExt)
+		}
+	}
+	return nil
+}
+
+// validateRDWSSecrets returns nil if the secrets can be referenced by an App Runner service.
+func validateRDWSSecrets(secrets map[string]Secret, variables map[string]Variable) error {
+	for name, secret := range secrets {
+		if _, ok := variables[name]; ok {
+			return fmt.Errorf(`secret %q cannot also be defined in "variables"`, name)
+		}
+		if secret.IsSecretsManagerName() || secret.RequiresImport() {
+			continue
+		}
+		value := secret.Value()
+		if !arn.IsARN(value) {
+			continue // An SSM parameter name in the same region.
+		}
+		parsed, err := arn.Parse(value)
+		if err != nil {
+			return fmt.Errorf(`validate secret %q: %w`, name, err)
+		}
+		if parsed.Service != "ssm" && parsed.Service != "secretsmanager" {
+			return fmt.Errorf(`secret %q must be the ARN of an SSM parameter or a Secrets Manager secret, got %q`, name, value)
+		}
+	}
 	return nil
 }
 
@@ -466,11 +629,16 @@ func (w WorkerService) validate() error {
 	if err = w.Workload.validate(); err != nil {
 		return err
 	}
+	if err = w.Deploy.validate(); err != nil {
+		return fmt.Errorf(`validate "deploy": %w`, err)
+	}
 	if err = validateContainerDeps(validateDependenciesOpts{
 		sidecarConfig:     w.Sidecars,
 		imageConfig:       w.ImageConfig.Image,
+		imageHealthCheck:  w.ImageConfig.HealthCheck,
 		mainContainerName: aws.StringValue(w.Name),
 		logging:           w.Logging,
+		taskConfig:        w.TaskConfig,
 	}); err != nil {
 		return fmt.Errorf("validate container dependencies: %w", err)
 	}
@@ -488,6 +656,9 @@ func (w WorkerServiceConfig) validate() error {
 	if err = w.DeployConfig.validate(); err != nil {
 		return fmt.Errorf(`validate "deployment": %w`, err)
 	}
+	if w.DeployConfig.Validation.Path != nil {
+		return errors.New(`"deployment.validation.path" is not supported for Worker Services because they don't serve HTTP traffic: use "deployment.validation.command" instead`)
+	}
 	if err = w.ImageConfig.validate(); err != nil {
 		return fmt.Errorf(`validate "image": %w`, err)
 	}
@@ -511,12 +682,30 @@ func (w WorkerServiceConfig) validate() error {
 	if w.Network.Connect.Alias != nil {
 		return fmt.Errorf(`cannot set "network.connect.alias" when no ports are exposed`)
 	}
+	if len(w.Network.Connect.Ports) > 0 {
+		return fmt.Errorf(`cannot set "network.connect.ports" when no ports are exposed`)
+	}
+	if !w.Network.Connect.Timeout.IsEmpty() {
+		return fmt.Errorf(`cannot set "network.connect.timeout" when no ports are exposed`)
+	}
 	if err = w.Subscribe.validate(); err != nil {
 		return fmt.Errorf(`validate "subscribe": %w`, err)
 	}
 	if err = w.PublishConfig.validate(); err != nil {
 		return fmt.Errorf(`validate "publish": %w`, err)
 	}
+	if err = w.Cost.validate(); err != nil {
+		return fmt.Errorf(`validate "cost": %w`, err)
+	}
+	if err = w.Observability.validate(); err != nil {
+		return fmt.Errorf(`validate "observability": %w`, err)
+	}
+	if err = w.AppConfig.validate(); err != nil {
+		return fmt.Errorf(`validate "appconfig": %w`, err)
+	}
+	if err = validateAppConfigAgent(w.AppConfig, nil, w.Sidecars); err != nil {
+		return err
+	}
 	for ind, taskDefOverride := range w.TaskDefOverrides {
 		if err = taskDefOverride.validate(); err != nil {
 			return fmt.Errorf(`validate "taskdef_overrides[%d]": %w`, ind, err)
@@ -550,11 +739,16 @@ func (s ScheduledJob) validate() error {
 	if err = s.Workload.validate(); err != nil {
 		return err
 	}
+	if err = s.Deploy.validate(); err != nil {
+		return fmt.Errorf(`validate "deploy": %w`, err)
+	}
 	if err = validateContainerDeps(validateDependenciesOpts{
 		sidecarConfig:     s.Sidecars,
 		imageConfig:       s.ImageConfig.Image,
+		imageHealthCheck:  s.ImageConfig.HealthCheck,
 		mainContainerName: aws.StringValue(s.Name),
 		logging:           s.Logging,
+		taskConfig:        s.TaskConfig,
 	}); err != nil {
 		return fmt.Errorf("validate container dependencies: %w", err)
 	}
@@ -595,6 +789,12 @@ func (s ScheduledJobConfig) validate() error {
 	if err = s.JobFailureHandlerConfig.validate(); err != nil {
 		return err
 	}
+	if err = s.Alerts.validate(); err != nil {
+		return fmt.Errorf(`validate "alerts": %w`, err)
+	}
+	if schedule := aws.StringValue(s.On.Schedule); !s.Alerts.IsEmpty() && (schedule == "" || schedule == "none") {
+		return errors.New(`"alerts" can only be specified when the job runs on a schedule with "on.schedule"`)
+	}
 	if err = s.PublishConfig.validate(); err != nil {
 		return fmt.Errorf(`validate "publish": %w`, err)
 	}
@@ -627,7 +827,13 @@ func (s StaticSite) validate() error {
 	if err := s.StaticSiteConfig.validate(); err != nil {
 		return err
 	}
-	return s.Workload.validate()
+	if err := s.Workload.validate(); err != nil {
+		return err
+	}
+	if err := s.Deploy.validate(); err != nil {
+		return fmt.Errorf(`validate "deploy": %w`, err)
+	}
+	return nil
 }
 
 func (s StaticSiteConfig) validate() error {
@@ -662,6 +868,68 @@ func (s StaticSiteHTTP) validate() error {
 			return &errInvalidCloudFrontRegion{}
 		}
 	}
+	if aws.BoolValue(s.SPA) && !s.ErrorPages.IsEmpty() {
+		return &errFieldMutualExclusive{
+			firstField:  "spa",
+			secondField: "error_pages",
+		}
+	}
+	if err := s.ErrorPages.validate(); err != nil {
+		return fmt.Errorf(`validate "error_pages": %w`, err)
+	}
+	froms := make(map[string]bool, len(s.Redirects))
+	for idx, redirect := range s.Redirects {
+		if err := redirect.validate(); err != nil {
+			return fmt.Errorf(`validate "redirects[%d]": %w`, idx, err)
+		}
+		if froms[redirect.From] {
+			return fmt.Errorf(`validate "redirects[%d]": "from" %q is already redirected`, idx, redirect.From)
+		}
+		froms[redirect.From] = true
+	}
+	return nil
+}
+
+func (r StaticSiteRedirect) validate() error {
+	if r.From == "" {
+		return &errFieldMustBeSpecified{
+			missingField: "from",
+		}
+	}
+	if r.To == "" {
+		return &errFieldMustBeSpecified{
+			missingField: "to",
+		}
+	}
+	if !strings.HasPrefix(r.From, "/") {
+		return fmt.Errorf(`"from" %q must start with "/"`, r.From)
+	}
+	if strings.Contains(strings.TrimSuffix(r.From, "*"), "*") {
+		return fmt.Errorf(`"from" %q can only contain "*" at the end`, r.From)
+	}
+	if strings.Contains(strings.TrimSuffix(r.To, "*"), "*") {
+		return fmt.Errorf(`"to" %q can only contain "*" at the end`, r.To)
+	}
+	if strings.HasSuffix(r.To, "*") && !strings.HasSuffix(r.From, "*") {
+		return fmt.Errorf(`"to" %q can only end with "*" if "from" ends with "*"`, r.To)
+	}
+	if r.Status != nil {
+		switch aws.IntValue(r.Status) {
+		case 301, 302, 307, 308:
+		default:
+			return fmt.Errorf(`"status" must be one of 301, 302, 307 or 308, got %d`, aws.IntValue(r.Status))
+		}
+	}
+	return nil
+}
+
+func (p StaticSiteErrorPages) validate() error {
+	if p.NotFound != "" && !strings.HasPrefix(p.NotFound, "/") {
+		return fmt.Errorf(`"not_found" %q must start with "/"`, p.NotFound)
+	}
+	if p.Forbidden != "" && !strings.HasPrefix(p.Forbidden, "/") {
+		return fmt.Errorf(`"forbidden" %q must start with "/"`, p.Forbidden)
+	}
 	return nil
 }
 
@@ -680,6 +948,15 @@ func (p Pipeline) Validate() error {
 	if len(p.Name) > 100 {
 		return fmt.Errorf(`pipeline name '%s' must be shorter than 100 characters`, p.Name)
 	}
+	if err := p.Trigger.validate(); err != nil {
+		return fmt.Errorf(`validate "trigger": %w`, err)
+	}
+	if p.ArtifactKMSKeyARN != "" {
+		parsed, err := arn.Parse(p.ArtifactKMSKeyARN)
+		if err != nil || parsed.Service != "kms" || !strings.HasPrefix(parsed.Resource, "key/") {
+			return fmt.Errorf(`"artifact_kms_key_arn" must be the ARN of a KMS key, got %q`, p.ArtifactKMSKeyARN)
+		}
+	}
 	for _, stg := range p.Stages {
 		if err := stg.validate(); err != nil {
 			return fmt.Errorf(`validate stage %q for pipeline %q: %w`, stg.Name, p.Name, err)
@@ -691,6 +968,14 @@ func (p Pipeline) Validate() error {
 	return nil
 }
 
+// validate returns nil if PipelineTrigger is configured correctly.
+func (t PipelineTrigger) validate() error {
+	if t.IsBasic() && t.Basic != PipelineTriggerManual {
+		return fmt.Errorf(`value must be %q or specify a "schedule"`, PipelineTriggerManual)
+	}
+	return nil
+}
+
 // validate returns nil if stages are configured correctly.
 func (s PipelineStage) validate() error {
 	if len(s.TestCommands) != 0 && s.PostDeployments != nil {
@@ -700,25 +985,66 @@ func (s PipelineStage) validate() error {
 			mustExist:   false,
 		}
 	}
-	if s.PreDeployments != nil {
-		for _, preDep := range s.PreDeployments {
-			if preDep.BuildspecPath == "" {
-				return &errFieldMustBeSpecified{
-					missingField: "buildspec",
-				}
+	if err := s.PreDeployments.validate(); err != nil {
+		return err
+	}
+	if err := s.PostDeployments.validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// validate returns nil if pre- or post-deployments are configured correctly.
+func (d PrePostDeployments) validate() error {
+	for _, action := range d {
+		if action == nil {
+			return &errFieldMustBeSpecified{
+				missingField: "buildspec",
 			}
 		}
+		if err := action.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
+// validate returns nil if a pre- or post-deployment is configured correctly.
+func (d PrePostDeployment) validate() error {
+	if d.BuildspecPath != "" && d.RunTask != nil {
+		return &errFieldMutualExclusive{
+			firstField:  "buildspec",
+			secondField: "run_task",
+		}
 	}
-	if s.PostDeployments != nil {
-		for _, postDep := range s.PostDeployments {
-			if postDep.BuildspecPath == "" {
-				return &errFieldMustBeSpecified{
-					missingField: "buildspec",
-				}
-			}
+	if d.RunTask != nil {
+		if err := d.RunTask.validate(); err != nil {
+			return fmt.Errorf(`validate "run_task": %w`, err)
 		}
+		return nil
+	}
+	if d.BuildspecPath == "" {
+		return &errFieldMustBeSpecified{
+			missingField: "buildspec",
+		}
+	}
+	return nil
+}
 
+// validate returns nil if PrePostDeployTask is configured correctly.
+func (t PrePostDeployTask) validate() error {
+	if t.Image == "" {
+		return &errFieldMustBeSpecified{
+			missingField: "image",
+		}
+	}
+	for name, value := range t.Variables {
+		if strings.ContainsAny(name, ",=") {
+			return fmt.Errorf(`variable name %q cannot contain "," or "="`, name)
+		}
+		if strings.Contains(value, ",") {
+			return fmt.Errorf(`value of variable %q cannot contain ","`, name)
+		}
 	}
 	return nil
 }
@@ -753,6 +1079,16 @@ func (w Workload) validate() error {
 	return nil
 }
 
+// validate returns nil if WorkloadDeploy is configured correctly.
+func (d WorkloadDeploy) validate() error {
+	for idx, name := range d.DependsOn {
+		if name == "" {
+			return fmt.Errorf(`"depends_on[%d]" must not be empty`, idx)
+		}
+	}
+	return nil
+}
+
 // validate returns nil if ImageWithPortAndHealthcheck is configured correctly.
 func (i ImageWithPortAndHealthcheck) validate() error {
 	var err error
@@ -815,6 +1151,31 @@ func (i Image) validate() error {
 	if err = i.DependsOn.validate(); err != nil {
 		return fmt.Errorf(`validate "depends_on": %w`, err)
 	}
+	if len(i.Tags) != 0 && i.Build.isEmpty() {
+		return &errFieldMustBeSpecified{
+			missingField:      "build",
+			conditionalFields: []string{"tags"},
+		}
+	}
+	for idx, tag := range i.Tags {
+		if err := validateImageTag(tag); err != nil {
+			return fmt.Errorf(`validate "tags[%d]": %w`, idx, err)
+		}
+	}
+	if err := i.Lifecycle.validate(); err != nil {
+		return fmt.Errorf(`validate "lifecycle": %w`, err)
+	}
+	return nil
+}
+
+// validate returns nil if ImageLifecycle is configured correctly.
+func (l ImageLifecycle) validate() error {
+	if l.KeepImages != nil && aws.IntValue(l.KeepImages) <= 0 {
+		return fmt.Errorf(`"keep_images" must be greater than 0`)
+	}
+	if l.ExpireUntaggedDays != nil && aws.IntValue(l.ExpireUntaggedDays) <= 0 {
+		return fmt.Errorf(`"expire_untagged_days" must be greater than 0`)
+	}
 	return nil
 }
 
@@ -897,12 +1258,109 @@ func (r HTTP) validate() error {
 			secondField: "targetContainer",
 		}
 	}
+	if r.ImportedALB != nil && aws.BoolValue(r.MutualTLS) {
+		return fmt.Errorf(`"mutual_tls" cannot be enabled with an imported load balancer "alb": configure mutual TLS on the listeners of %s instead`, aws.StringValue(r.ImportedALB))
+	}
+	if err := r.CDN.validate(); err != nil {
+		return fmt.Errorf(`validate "cdn": %w`, err)
+	}
+	if r.ImportedALB != nil && r.CDNEnabled() {
+		return fmt.Errorf(`"cdn" cannot be enabled with an imported load balancer "alb": place a CloudFront distribution in front of %s instead`, aws.StringValue(r.ImportedALB))
+	}
 
 	for idx, rule := range r.AdditionalRoutingRules {
 		if err := rule.validate(); err != nil {
 			return fmt.Errorf(`validate "additional_rules[%d]": %w`, idx, err)
 		}
 	}
+	return r.validateStickinessAcrossRules()
+}
+
+// validateStickinessAcrossRules returns an error if two routing rules that route to the same
+// target container and port enable sticky sessions with different settings.
+func (r HTTP) validateStickinessAcrossRules() error {
+	type target struct {
+		container string
+		port      uint16
+	}
+	type stickiness struct {
+		typ        string
+		cookieName string
+		duration   time.Duration
+	}
+	type ruleStickiness struct {
+		field string
+		stickiness
+	}
+	seen := make(map[target]ruleStickiness)
+	for idx, rule := range r.RoutingRules() {
+		if !rule.StickinessEnabled() {
+			continue
+		}
+		field := "http"
+		if idx > 0 {
+			field = fmt.Sprintf("http.additional_rules[%d]", idx-1)
+		}
+		curr := stickiness{
+			typ: StickinessTypeLBCookie,
+		}
+		if rule.Stickiness.IsAdvanced() {
+			curr = stickiness{
+				typ:        rule.StickinessType(),
+				cookieName: aws.StringValue(rule.Stickiness.Advanced.CookieName),
+			}
+			if rule.Stickiness.Advanced.Duration != nil {
+				curr.duration = *rule.Stickiness.Advanced.Duration
+			}
+		}
+		key := target{
+			container: aws.StringValue(rule.TargetContainer),
+			port:      aws.Uint16Value(rule.TargetPort),
+		}
+		prev, ok := seen[key]
+		if !ok {
+			seen[key] = ruleStickiness{field: field, stickiness: curr}
+			continue
+		}
+		if prev.stickiness != curr {
+			return fmt.Errorf(`"%s.stickiness" conflicts with "%s.stickiness": rules that route to the same target container and port must use the same sticky sessions configuration`, field, prev.field)
+		}
+	}
+	return nil
+}
+
+// validate returns nil if ServiceCDNConfig is configured correctly.
+func (c ServiceCDNConfig) validate() error {
+	for idx, policy := range c.CachePolicies {
+		if err := policy.validate(); err != nil {
+			return fmt.Errorf(`validate "cache_policies[%d]": %w`, idx, err)
+		}
+	}
+	if c.Certificate != nil && len(c.Aliases) == 0 {
+		return &errFieldMustBeSpecified{
+			missingField:      "aliases",
+			conditionalFields: []string{"certificate"},
+		}
+	}
+	return nil
+}
+
+// validate returns nil if CDNCachePolicy is configured correctly.
+func (c CDNCachePolicy) validate() error {
+	if c.Path == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "path",
+		}
+	}
+	if c.Policy == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "policy",
+		}
+	}
+	policy := aws.StringValue(c.Policy)
+	if _, ok := managedCachePolicyIDs[policy]; !ok && !cachePolicyIDRegexp.MatchString(policy) {
+		return fmt.Errorf(`"policy" %q must be the name of a managed cache policy or the ID of a cache policy`, policy)
+	}
 	return nil
 }
 
@@ -997,12 +1455,41 @@ func (r RoutingRule) validate() error {
 			conditionalFields: []string{"hosted_zone"},
 		}
 	}
+	if err := r.Stickiness.validate(); err != nil {
+		return fmt.Errorf(`validate "stickiness": %w`, err)
+	}
 	if err := r.validateConditionValuesPerRule(); err != nil {
 		return fmt.Errorf("validate condition values per listener rule: %w", err)
 	}
 	return nil
 }
 
+// validate returns nil if StickinessConfig is configured correctly.
+func (s StickinessConfig) validate() error {
+	typ := StickinessTypeLBCookie
+	if s.Type != nil {
+		typ = aws.StringValue(s.Type)
+		if !slices.Contains(stickinessTypes, typ) {
+			return fmt.Errorf(`"type" field value '%s' must be one of %s`, typ, english.WordSeries(stickinessTypes, "or"))
+		}
+	}
+	if typ == StickinessTypeAppCookie && s.CookieName == nil {
+		return fmt.Errorf(`"cookie_name" must be specified if "type" is %q`, StickinessTypeAppCookie)
+	}
+	if typ != StickinessTypeAppCookie && s.CookieName != nil {
+		return fmt.Errorf(`"cookie_name" can only be specified if "type" is %q`, StickinessTypeAppCookie)
+	}
+	if s.CookieName != nil && strings.HasPrefix(strings.ToUpper(aws.StringValue(s.CookieName)), "AWSALB") {
+		return fmt.Errorf(`"cookie_name" %q cannot start with the reserved prefix "AWSALB"`, aws.StringValue(s.CookieName))
+	}
+	if s.Duration != nil {
+		if d := *s.Duration; d < time.Second || d > 7*24*time.Hour {
+			return fmt.Errorf(`"duration" %s must be between 1s and 168h`, d)
+		}
+	}
+	return nil
+}
+
 // validate returns nil if HTTPHealthCheckArgs is configured correctly.
 func (h HTTPHealthCheckArgs) validate() error {
 	return nil
@@ -1093,6 +1580,26 @@ func (c NetworkLoadBalancerListener) validate() error {
 	if err := c.HealthCheck.validate(); err != nil {
 		return fmt.Errorf(`validate "healthcheck": %w`, err)
 	}
+	if err := c.validateALPN(); err != nil {
+		return fmt.Errorf(`validate "alpn": %w`, err)
+	}
+	return nil
+}
+
+func (c NetworkLoadBalancerListener) validateALPN() error {
+	if c.ALPN == nil {
+		return nil
+	}
+	if !slices.Contains(nlbValidALPNPolicies, aws.StringValue(c.ALPN)) {
+		return fmt.Errorf(`value '%s' must be one of %s`, aws.StringValue(c.ALPN), english.WordSeries(nlbValidALPNPolicies, "or"))
+	}
+	_, protocol, err := ParsePortMapping(c.Port)
+	if err != nil {
+		return err
+	}
+	if !strings.EqualFold(aws.StringValue(protocol), TLS) {
+		return errors.New(`"alpn" can only be specified if the protocol of "port" is TLS`)
+	}
 	return nil
 }
 
@@ -1124,6 +1631,12 @@ func (t TaskConfig) validate() error {
 	if err = t.Platform.validate(); err != nil {
 		return fmt.Errorf(`validate "platform": %w`, err)
 	}
+	if err = t.Windows.validate(); err != nil {
+		return fmt.Errorf(`validate "windows": %w`, err)
+	}
+	if !t.Windows.IsEmpty() && !t.IsWindows() {
+		return errors.New(`"windows" can only be specified with a Windows "platform"`)
+	}
 	if err = t.Count.validate(); err != nil {
 		return fmt.Errorf(`validate "count": %w`, err)
 	}
@@ -1152,6 +1665,18 @@ func (t TaskConfig) validate() error {
 	return nil
 }
 
+// validate returns nil if WindowsConfig is configured correctly.
+func (w WindowsConfig) validate() error {
+	if w.CredentialSpec == nil {
+		return nil
+	}
+	parsed, err := arn.Parse(aws.StringValue(w.CredentialSpec))
+	if err != nil || !(parsed.Service == "s3" || parsed.Service == "ssm" && strings.HasPrefix(parsed.Resource, "parameter/")) {
+		return errors.New(`"credential_spec" must be the ARN of an S3 object or an SSM parameter, such as "arn:aws:s3:::my-bucket/gmsa-credspec.json"`)
+	}
+	return nil
+}
+
 // validate returns nil if PlatformArgsOrString is configured correctly.
 func (p PlatformArgsOrString) validate() error {
 	if p.IsEmpty() {
@@ -1203,6 +1728,10 @@ func (p PlatformString) validate() error {
 
 // validate returns nil if Count is configured correctly.
 func (c Count) validate() error {
+	// A count of 0 is valid: the service is registered with its load balancer rules and DNS, but runs no task until it's scaled up.
+	if c.Value != nil && aws.IntValue(c.Value) < 0 {
+		return fmt.Errorf("count must be greater than or equal to 0, got %d", aws.IntValue(c.Value))
+	}
 	return c.AdvancedCount.validate()
 }
 
@@ -1223,6 +1752,10 @@ func (a AdvancedCount) validate() error {
 		}
 	}
 
+	if a.Spot != nil && aws.IntValue(a.Spot) < 0 {
+		return fmt.Errorf(`"spot" must be greater than or equal to 0, got %d`, aws.IntValue(a.Spot))
+	}
+
 	// validate spot and remaining autoscaling fields.
 	if a.Spot != nil && a.hasAutoscaling() {
 		return &errFieldMutualExclusive{
@@ -1614,14 +2147,32 @@ func (s SidecarMountPoint) validate() error {
 
 // validate returns nil if NetworkConfig is configured correctly.
 func (n NetworkConfig) validate() error {
+	if err := n.Ingress.validate(); err != nil {
+		return fmt.Errorf(`validate "ingress": %w`, err)
+	}
+	if err := n.Connect.validate(); err != nil {
+		return fmt.Errorf(`validate "connect": %w`, err)
+	}
 	if n.IsEmpty() {
 		return nil
 	}
 	if err := n.VPC.validate(); err != nil {
 		return fmt.Errorf(`validate "vpc": %w`, err)
 	}
-	if err := n.Connect.validate(); err != nil {
-		return fmt.Errorf(`validate "connect": %w`, err)
+	return nil
+}
+
+// validate returns nil if NetworkIngressConfig is configured correctly.
+func (i NetworkIngressConfig) validate() error {
+	seen := make(map[string]struct{}, len(i.FromServices))
+	for _, name := range i.FromServices {
+		if name == "" {
+			return errors.New(`"from_services" cannot contain empty names`)
+		}
+		if _, ok := seen[name]; ok {
+			return fmt.Errorf(`"from_services" contains duplicate service %s`, name)
+		}
+		seen[name] = struct{}{}
 	}
 	return nil
 }
@@ -1631,8 +2182,88 @@ func (s ServiceConnectBoolOrArgs) validate() error {
 	return s.ServiceConnectArgs.validate()
 }
 
-// validate is a no-op for ServiceConnectArgs.
-func (ServiceConnectArgs) validate() error {
+// validate returns nil if ServiceConnectArgs is configured correctly.
+func (s ServiceConnectArgs) validate() error {
+	seenPorts := make(map[uint16]struct{}, len(s.Ports))
+	seenAliases := make(map[string]struct{}, len(s.Ports))
+	if s.Alias != nil {
+		seenAliases[aws.StringValue(s.Alias)] = struct{}{}
+	}
+	for idx, port := range s.Ports {
+		if err := port.validate(); err != nil {
+			return fmt.Errorf(`validate "ports[%d]": %w`, idx, err)
+		}
+		if _, ok := seenPorts[aws.Uint16Value(port.Port)]; ok {
+			return fmt.Errorf(`validate "ports[%d]": port %d is already exposed to Service Connect`, idx, aws.Uint16Value(port.Port))
+		}
+		seenPorts[aws.Uint16Value(port.Port)] = struct{}{}
+		if _, ok := seenAliases[aws.StringValue(port.Alias)]; ok {
+			return fmt.Errorf(`validate "ports[%d]": alias %q is already used`, idx, aws.StringValue(port.Alias))
+		}
+		seenAliases[aws.StringValue(port.Alias)] = struct{}{}
+	}
+	if err := s.Timeout.validate(); err != nil {
+		return fmt.Errorf(`validate "timeout": %w`, err)
+	}
+	return nil
+}
+
+// validate returns nil if ServiceConnectTimeout is configured correctly.
+func (t ServiceConnectTimeout) validate() error {
+	timeouts := []struct {
+		field string
+		value *time.Duration
+	}{
+		{"idle", t.Idle},
+		{"per_request", t.PerRequest},
+	}
+	for _, timeout := range timeouts {
+		if timeout.value == nil {
+			continue
+		}
+		if d := *timeout.value; d < 0 || d%time.Second != 0 {
+			return fmt.Errorf(`%q %s must be a non-negative whole number of seconds`, timeout.field, d)
+		}
+	}
+	return nil
+}
+
+// validate returns nil if ServiceConnectPort is configured correctly.
+func (p ServiceConnectPort) validate() error {
+	if p.Port == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "port",
+		}
+	}
+	if aws.StringValue(p.Alias) == "" {
+		return &errFieldMustBeSpecified{
+			missingField: "alias",
+		}
+	}
+	if p.ClientPort != nil && aws.Uint16Value(p.ClientPort) == 0 {
+		return errors.New(`"client_port" must be greater than 0`)
+	}
+	return nil
+}
+
+// validateServiceConnectPorts returns nil if the ports exposed to Service Connect with "network.connect.ports"
+// are exposed by a container of the task over TCP, and are not already the target of "network.connect".
+func validateServiceConnectPorts(connect ServiceConnectBoolOrArgs, exposedPorts ExposedPortsIndex, target *ServiceConnectTargetContainer) error {
+	for idx, scPort := range connect.Ports {
+		port := aws.Uint16Value(scPort.Port)
+		container, ok := exposedPorts.ContainerForPort[port]
+		if !ok {
+			return fmt.Errorf(`validate "network.connect.ports[%d]": port %d is not exposed by any container`, idx, port)
+		}
+		for _, exposedPort := range exposedPorts.PortsForContainer[container] {
+			if exposedPort.Port == port && strings.EqualFold(exposedPort.Protocol, UDP) {
+				return fmt.Errorf(`validate "network.connect.ports[%d]": port %d is exposed over UDP, which Service Connect doesn't support`, idx, port)
+			}
+		}
+		if target != nil && target.Port == strconv.Itoa(int(port)) {
+			return fmt.Errorf(`validate "network.connect.ports[%d]": port %d is already exposed to Service Connect by "network.connect.alias"`, idx, port)
+		}
+	}
 	return nil
 }
 
@@ -1652,6 +2283,18 @@ func (v rdwsVpcConfig) validate() error {
 	if v.isEmpty() {
 		return nil
 	}
+	if v.Connector != nil {
+		if !v.Placement.IsEmpty() {
+			return &errFieldMutualExclusive{
+				firstField:  "connector",
+				secondField: "placement",
+			}
+		}
+		if aws.StringValue(v.Connector) == "" {
+			return errors.New(`"connector" cannot be empty`)
+		}
+		return nil
+	}
 	if err := v.Placement.validate(); err != nil {
 		return fmt.Errorf(`validate "placement": %w`, err)
 	}
@@ -1714,12 +2357,10 @@ func (p PlacementString) validate() error {
 	if string(p) == "" {
 		return fmt.Errorf(`"placement" cannot be empty`)
 	}
-	for _, allowed := range subnetPlacements {
-		if string(p) == allowed {
-			return nil
-		}
+	if _, ok := p.SubnetGroup(); !ok || subnetGroupNameRegexp.MatchString(string(p)) {
+		return nil
 	}
-	return fmt.Errorf(`"placement" %s must be one of %s`, string(p), strings.Join(subnetPlacements, ", "))
+	return fmt.Errorf(`"placement" %s must be one of %s, or the name of a subnet group`, string(p), strings.Join(subnetPlacements, ", "))
 }
 
 // validate is a no-op for SecurityGroupsIDsOrConfig.
@@ -1770,22 +2411,163 @@ func (o Observability) validate() error {
 	if o.isEmpty() {
 		return nil
 	}
+	if o.Tracing.IsAdvanced() {
+		if err := o.Tracing.Advanced.validate(); err != nil {
+			return fmt.Errorf(`validate "tracing": %w`, err)
+		}
+	}
+	return validateTracingVendor(o.TracingVendor())
+}
+
+// validateNoDashboard returns an error if a dashboard is requested for a service other than a Worker Service.
+func validateNoDashboard(o Observability) error {
+	if aws.BoolValue(o.Dashboard) {
+		return fmt.Errorf(`"dashboard" is only supported for %s`, manifestinfo.WorkerServiceType)
+	}
+	return nil
+}
+
+// validate returns nil if Cost is configured correctly.
+func (c Cost) validate() error {
+	if c.IsEmpty() {
+		return nil
+	}
+	if c.MonthlyBudget == nil && !aws.BoolValue(c.AnomalyDetection) {
+		return &errAtLeastOneFieldMustBeSpecified{
+			missingFields: []string{"monthly_budget", "anomaly_detection"},
+		}
+	}
+	if c.MonthlyBudget != nil && aws.Float64Value(c.MonthlyBudget) <= 0 {
+		return fmt.Errorf(`"monthly_budget" must be greater than 0, got %v`, aws.Float64Value(c.MonthlyBudget))
+	}
+	if c.AlertThreshold == nil {
+		return nil
+	}
+	if c.MonthlyBudget == nil {
+		return &errFieldMustBeSpecified{
+			missingField:      "monthly_budget",
+			conditionalFields: []string{"alert_threshold"},
+		}
+	}
+	if threshold := aws.IntValue(c.AlertThreshold); threshold <= 0 {
+		return fmt.Errorf(`"alert_threshold" must be greater than 0, got %d`, threshold)
+	}
+	return nil
+}
+
+// validate returns nil if AppConfig is configured correctly.
+func (a AppConfig) validate() error {
+	if a.IsEmpty() {
+		return nil
+	}
+	if len(a.Profiles) == 0 {
+		return &errFieldMustBeSpecified{
+			missingField: "profiles",
+		}
+	}
+	logicalIDs := make(map[string]string)
+	for _, profile := range a.Profiles {
+		if profile == "" || len(profile) > 128 {
+			return fmt.Errorf(`"profiles" must contain names between 1 and 128 characters, got %q`, profile)
+		}
+		id := template.StripNonAlphaNumFunc(profile)
+		if other, ok := logicalIDs[id]; ok {
+			return fmt.Errorf(`"profiles" %q and %q must differ by at least one letter or digit`, other, profile)
+		}
+		logicalIDs[id] = profile
+	}
+	if a.PollInterval != nil {
+		interval := *a.PollInterval
+		if interval < time.Second || interval%time.Second != 0 {
+			return fmt.Errorf(`"poll_interval" must be a whole number of seconds greater than 0, got %s`, interval)
+		}
+	}
+	if a.Port != nil && aws.Uint16Value(a.Port) == 0 {
+		return errors.New(`"port" must be greater than 0`)
+	}
+	return nil
+}
+
+// validateAppConfigAgent returns nil if the AppConfig agent sidecar doesn't conflict with the other containers of the task.
+func validateAppConfigAgent(appConfig AppConfig, mainContainerPort *uint16, sidecars map[string]*SidecarConfig) error {
+	if appConfig.IsEmpty() {
+		return nil
+	}
+	if _, ok := sidecars[AppConfigAgentContainerName]; ok {
+		return fmt.Errorf(`sidecar name %q is reserved for the AppConfig agent when "appconfig" is configured`, AppConfigAgentContainerName)
+	}
+	if mainContainerPort != nil && aws.Uint16Value(mainContainerPort) == appConfig.AgentPort() {
+		return fmt.Errorf(`"appconfig.port" %d is already used by the main container: set "appconfig.port" to a different port`, appConfig.AgentPort())
+	}
+	return nil
+}
+
+// validate returns nil if TracingConfig is configured correctly.
+func (t TracingConfig) validate() error {
+	if t.Vendor == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "vendor",
+		}
+	}
+	isOTel := strings.EqualFold(aws.StringValue(t.Vendor), otel)
+	if t.ConfigFile != nil && !isOTel {
+		return fmt.Errorf(`"config_file" can only be specified when "vendor" is %q`, otel)
+	}
+	if t.SamplingRate == nil {
+		return nil
+	}
+	if isOTel {
+		return fmt.Errorf(`"sampling_rate" cannot be specified when "vendor" is %q: configure sampling in the collector instead`, otel)
+	}
+	if rate := aws.Float64Value(t.SamplingRate); rate < 0 || rate > 1 {
+		return fmt.Errorf(`"sampling_rate" must be between 0 and 1, got %v`, rate)
+	}
+	return nil
+}
+
+func validateTracingVendor(vendor string) error {
 	for _, validVendor := range tracingValidVendors {
-		if strings.EqualFold(aws.StringValue(o.Tracing), validVendor) {
+		if strings.EqualFold(vendor, validVendor) {
 			return nil
 		}
 	}
 	return fmt.Errorf("invalid tracing vendor %s: %s %s",
-		aws.StringValue(o.Tracing),
+		vendor,
 		english.PluralWord(len(tracingValidVendors), "the valid vendor is", "valid vendors are"),
 		english.WordSeries(tracingValidVendors, "and"))
 }
 
 // validate returns nil if JobTriggerConfig is configured correctly.
 func (c JobTriggerConfig) validate() error {
-	if c.Schedule == nil {
+	if c.Schedule == nil && c.Queue.IsEmpty() {
+		return &errAtLeastOneFieldMustBeSpecified{
+			missingFields: []string{"schedule", "queue"},
+		}
+	}
+	if err := c.Queue.validate(); err != nil {
+		return fmt.Errorf(`validate "queue": %w`, err)
+	}
+	return nil
+}
+
+// validate returns nil if JobQueueTrigger is configured correctly.
+func (q JobQueueTrigger) validate() error {
+	if q.IsEmpty() {
+		return nil
+	}
+	if aws.StringValue(q.Name) == "" {
 		return &errFieldMustBeSpecified{
-			missingField: "schedule",
+			missingField: "name",
+		}
+	}
+	if q.BatchSize != nil {
+		if size := aws.IntValue(q.BatchSize); size < 1 || size > maxJobQueueBatchSize {
+			return fmt.Errorf(`"batch_size" must be between 1 and %d, got %d`, maxJobQueueBatchSize, size)
+		}
+	}
+	if q.MaxConcurrency != nil {
+		if concurrency := aws.IntValue(q.MaxConcurrency); concurrency < 1 {
+			return fmt.Errorf(`"max_concurrency" must be greater than 0, got %d`, concurrency)
 		}
 	}
 	return nil
@@ -1796,6 +2578,27 @@ func (JobFailureHandlerConfig) validate() error {
 	return nil
 }
 
+// validate returns nil if JobAlerts is configured correctly.
+func (a JobAlerts) validate() error {
+	if a.IsEmpty() {
+		return nil
+	}
+	if a.FailuresThreshold == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "failures_threshold",
+		}
+	}
+	if threshold := aws.IntValue(a.FailuresThreshold); threshold < 1 {
+		return fmt.Errorf(`"failures_threshold" must be greater than 0, got %d`, threshold)
+	}
+	for i, email := range a.Emails {
+		if !strings.Contains(email, "@") {
+			return fmt.Errorf(`"emails[%d]" must be an email address, got %q`, i, email)
+		}
+	}
+	return nil
+}
+
 // validate returns nil if PublishConfig is configured correctly.
 func (p PublishConfig) validate() error {
 	for ind, topic := range p.Topics {
@@ -1837,9 +2640,91 @@ func (s SubscribeConfig) validate() error {
 			return fmt.Errorf(`validate "topics[%d]": %w`, ind, err)
 		}
 	}
+	for ind, bucket := range s.Buckets {
+		if err := bucket.validate(); err != nil {
+			return fmt.Errorf(`validate "buckets[%d]": %w`, ind, err)
+		}
+	}
 	if err := s.Queue.validate(); err != nil {
 		return fmt.Errorf(`validate "queue": %w`, err)
 	}
+	if len(s.Buckets) != 0 && s.Queue.FIFO.IsEnabled() {
+		return &errFieldMutualExclusive{
+			firstField:  "buckets",
+			secondField: "queue.fifo",
+		}
+	}
+	if err := s.Kafka.validate(); err != nil {
+		return fmt.Errorf(`validate "kafka": %w`, err)
+	}
+	return nil
+}
+
+// validate returns nil if KafkaSubscription is configured correctly.
+func (k KafkaSubscription) validate() error {
+	if k.IsEmpty() {
+		return nil
+	}
+	if k.ClusterARN.isEmpty() {
+		return &errFieldMustBeSpecified{
+			missingField: "cluster_arn",
+		}
+	}
+	if err := k.ClusterARN.validate(); err != nil {
+		return fmt.Errorf(`validate "cluster_arn": %w`, err)
+	}
+	if k.ClusterARN.Plain != nil {
+		parsed, err := arn.Parse(aws.StringValue(k.ClusterARN.Plain))
+		if err != nil || parsed.Service != "kafka" || !strings.HasPrefix(parsed.Resource, "cluster/") {
+			return fmt.Errorf(`"cluster_arn" must be the ARN of an Amazon MSK cluster`)
+		}
+	}
+	if k.BootstrapBrokers.isEmpty() {
+		return &errFieldMustBeSpecified{
+			missingField: "bootstrap_brokers",
+		}
+	}
+	if err := k.BootstrapBrokers.validate(); err != nil {
+		return fmt.Errorf(`validate "bootstrap_brokers": %w`, err)
+	}
+	if k.BootstrapBrokers.Plain != nil && aws.StringValue(k.BootstrapBrokers.Plain) == "" {
+		return errors.New(`"bootstrap_brokers" cannot be an empty string`)
+	}
+	if err := k.SecurityGroup.validate(); err != nil {
+		return fmt.Errorf(`validate "security_group": %w`, err)
+	}
+	if len(k.Topics) == 0 {
+		return &errFieldMustBeSpecified{
+			missingField: "topics",
+		}
+	}
+	for ind, topic := range k.Topics {
+		if !kafkaTopicRegexp.MatchString(topic) {
+			return fmt.Errorf(`validate "topics[%d]": topic name %q must be at most 249 characters long and contain only letters, numbers, periods, underscores, and hyphens`, ind, topic)
+		}
+	}
+	if k.ConsumerGroup != nil && !kafkaTopicRegexp.MatchString(aws.StringValue(k.ConsumerGroup)) {
+		return fmt.Errorf(`consumer group %q must be at most 249 characters long and contain only letters, numbers, periods, underscores, and hyphens`, aws.StringValue(k.ConsumerGroup))
+	}
+	return nil
+}
+
+// validate returns nil if BucketSubscription is configured correctly.
+func (b BucketSubscription) validate() error {
+	if aws.StringValue(b.Name) == "" {
+		return &errFieldMustBeSpecified{
+			missingField: "name",
+		}
+	}
+	svcName := aws.StringValue(b.Service)
+	if svcName == "" {
+		return &errFieldMustBeSpecified{
+			missingField: "service",
+		}
+	}
+	if !isValidSubSvcName(svcName) {
+		return fmt.Errorf("service name must start with a letter, contain only lower-case letters, numbers, and hyphens, and have no consecutive or trailing hyphen")
+	}
 	return nil
 }
 
@@ -1879,6 +2764,16 @@ func (q SQSQueue) validate() error {
 	if err := q.DeadLetter.validate(); err != nil {
 		return fmt.Errorf(`validate "dead_letter": %w`, err)
 	}
+	if q.DeadLetter.ARN != nil {
+		// A FIFO queue can only redrive messages to a FIFO dead-letter queue, and a standard queue to a standard one.
+		isFIFODeadLetter := strings.HasSuffix(aws.StringValue(q.DeadLetter.ARN), sqsFIFOQueueSuffix)
+		if q.FIFO.IsEnabled() && !isFIFODeadLetter {
+			return fmt.Errorf(`validate "dead_letter": "arn" must be the ARN of a FIFO queue when "fifo" is enabled`)
+		}
+		if !q.FIFO.IsEnabled() && isFIFODeadLetter {
+			return fmt.Errorf(`validate "dead_letter": "arn" must be the ARN of a standard queue when "fifo" is not enabled`)
+		}
+	}
 	return q.FIFO.validate()
 }
 
@@ -1952,6 +2847,19 @@ func (d DeadLetterQueue) validate() error {
 	if d.IsEmpty() {
 		return nil
 	}
+	if d.ARN == nil {
+		return nil
+	}
+	if d.Tries == nil {
+		return &errFieldMustBeSpecified{
+			missingField:      "tries",
+			conditionalFields: []string{"arn"},
+		}
+	}
+	parsed, err := arn.Parse(aws.StringValue(d.ARN))
+	if err != nil || parsed.Service != "sqs" {
+		return fmt.Errorf(`"arn" must be the ARN of an SQS queue, such as "arn:aws:sqs:us-west-2:123456789012:dead-letters"`)
+	}
 	return nil
 }
 
@@ -2017,7 +2925,9 @@ type validateDependenciesOpts struct {
 	mainContainerName string
 	sidecarConfig     map[string]*SidecarConfig
 	imageConfig       Image
+	imageHealthCheck  ContainerHealthCheck
 	logging           Logging
+	taskConfig        TaskConfig
 }
 
 type validateTargetContainerOpts struct {
@@ -2098,11 +3008,47 @@ func validateTargetContainer(opts validateTargetContainerOpts) error {
 }
 
 func validateContainerDeps(opts validateDependenciesOpts) error {
-	containerDependencies := containerDependencies(opts.mainContainerName, opts.imageConfig, opts.logging, opts.sidecarConfig)
+	if err := validateInitContainers(opts); err != nil {
+		return err
+	}
+	if err := validateVolumesFrom(opts); err != nil {
+		return err
+	}
+	containerDependencies := containerDependencies(opts.mainContainerName, opts.imageConfig, opts.imageHealthCheck, opts.logging, opts.sidecarConfig, opts.taskConfig)
 	if err := validateDepsForEssentialContainers(containerDependencies); err != nil {
 		return err
 	}
-	return validateNoCircularDependencies(containerDependencies)
+	if err := validateNoCircularDependencies(containerDependencies); err != nil {
+		return err
+	}
+	return validateHealthyDependencies(containerDependencies)
+}
+
+func validateInitContainers(opts validateDependenciesOpts) error {
+	for _, name := range opts.taskConfig.InitContainers {
+		sidecar, ok := opts.sidecarConfig[name]
+		if !ok {
+			return fmt.Errorf(`init container %q must be one of the sidecars`, name)
+		}
+		if aws.BoolValue(sidecar.Essential) {
+			return fmt.Errorf(`init container %q cannot be marked as essential`, name)
+		}
+	}
+	return nil
+}
+
+func validateVolumesFrom(opts validateDependenciesOpts) error {
+	for name, sidecar := range opts.sidecarConfig {
+		for _, source := range sidecar.VolumesFrom {
+			if source == name {
+				return fmt.Errorf(`sidecar %q cannot mount volumes from itself`, name)
+			}
+			if _, ok := opts.sidecarConfig[source]; !ok && source != opts.mainContainerName {
+				return fmt.Errorf(`sidecar %q mounts volumes from container %q that does not exist`, name, source)
+			}
+		}
+	}
+	return nil
 }
 
 func validateDepsForEssentialContainers(deps map[string]ContainerDependency) error {
@@ -2299,6 +3245,20 @@ func validateAndPopulateExposedPortMapping(portExposedTo map[uint16]containerNam
 	return nil
 }
 
+// validateHealthyDependencies returns an error if a container waits for another container to be HEALTHY,
+// but the other container doesn't define a health check.
+func validateHealthyDependencies(deps map[string]ContainerDependency) error {
+	for name, containerDep := range deps {
+		for dep, status := range containerDep.DependsOn {
+			if strings.ToUpper(status) != dependsOnHealthy || deps[dep].HasHealthCheck {
+				continue
+			}
+			return fmt.Errorf(`container %s depends on container %s being %s, but %s does not define a "healthcheck"`, name, dep, dependsOnHealthy, dep)
+		}
+	}
+	return nil
+}
+
 func validateEssentialContainerDependency(name, status string) error {
 	for _, allowed := range essentialContainerDependsOnValidStatuses {
 		if status == allowed {
@@ -6,16 +6,51 @@ package manifest
 import (
 	"errors"
 	"fmt"
+	"net"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudfront"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/dustin/go-humanize/english"
+)
+
+const (
+	amazonProvidedDNS    = "AmazonProvidedDNS"
+	resolverRuleIDPrefix = "rslvr-rr-"
 )
 
 var (
 	errAZsNotEqual = errors.New("public subnets and private subnets do not span the same availability zones")
 
 	minAZs = 2
+
+	// objectLockModes are the retention modes of S3 Object Lock.
+	objectLockModes = []string{"GOVERNANCE", "COMPLIANCE"}
+
+	// httpHeaderNameRegexp matches the tokens allowed in a HTTP header name, see RFC 9110.
+	httpHeaderNameRegexp = regexp.MustCompile("^[A-Za-z0-9!#$%&'*+.^_`|~-]+$")
+
+	// subnetGroupNameRegexp matches the names of subnet groups, which are part of logical IDs and export names.
+	subnetGroupNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9]+$`)
+
+	// appRunnerConnectorNameRegexp matches the names of App Runner VPC connectors, which are part of logical IDs and export names.
+	appRunnerConnectorNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9]+$`)
+
+	// maxAppRunnerConnectorSecurityGroups is the number of security groups that can be added to the environment security group of a connector.
+	maxAppRunnerConnectorSecurityGroups = 4
+
+	// maxDHCPServers is the number of domain name servers or NTP servers that a DHCP options set can have.
+	maxDHCPServers = 4
+
+	// maxDNSTargetIPs is the number of IP addresses that a Route 53 Resolver forwarding rule can forward queries to.
+	maxDNSTargetIPs = 6
 )
 
 // Validate returns nil if Environment is configured correctly.
@@ -43,9 +78,41 @@ func (e EnvironmentConfig) validate() error {
 	if err := e.CDNConfig.validate(); err != nil {
 		return fmt.Errorf(`validate "cdn": %w`, err)
 	}
+	if err := e.Encryption.validate(); err != nil {
+		return fmt.Errorf(`validate "encryption": %w`, err)
+	}
+	if err := e.Tasks.validate(); err != nil {
+		return fmt.Errorf(`validate "tasks": %w`, err)
+	}
+	if err := e.Backups.validate(); err != nil {
+		return fmt.Errorf(`validate "backups": %w`, err)
+	}
+	if err := e.Cluster.validate(); err != nil {
+		return fmt.Errorf(`validate "cluster": %w`, err)
+	}
+	if err := e.Budget.validate(); err != nil {
+		return fmt.Errorf(`validate "budget": %w`, err)
+	}
+	if err := e.ELBAccessLogsBucket.validate(); err != nil {
+		return fmt.Errorf(`validate "elb_access_logs_bucket": %w`, err)
+	}
+	if !e.ELBAccessLogsBucket.IsEmpty() {
+		if accessLogs, ok := e.ELBAccessLogs(); !ok || (accessLogs != nil && aws.StringValue(accessLogs.BucketName) != "") {
+			return errors.New(`"elb_access_logs_bucket" can only be specified if Copilot creates the bucket for the access logs of the public load balancer with "http.public.access_logs"`)
+		}
+	}
+	if !e.Cluster.IsEmpty() && aws.BoolValue(e.Observability.ContainerInsights) {
+		return errors.New(`"observability.container_insights" cannot be configured with an imported "cluster": enable Container Insights on the cluster instead`)
+	}
+	if e.RemoteTaskRunnerEnabled() && e.Network.VPC.imported() && len(e.Network.VPC.Subnets.Public) == 0 {
+		return errors.New(`"tasks.remote_runner" requires public subnets to launch tasks in, but the imported VPC has none`)
+	}
 	if e.IsPublicLBIngressRestrictedToCDN() && !e.CDNEnabled() {
 		return errors.New("CDN must be enabled to limit security group ingress to CloudFront")
 	}
+	if len(e.HTTPConfig.Public.ResponseHeaders.Custom) != 0 && !e.CDNEnabled() {
+		return errors.New(`CDN must be enabled to add the "http.public.response_headers.custom" headers to responses`)
+	}
 	if e.CDNEnabled() {
 		cdnCert := e.CDNConfig.Config.Certificate
 		if e.HTTPConfig.Public.Certificates == nil {
@@ -79,6 +146,24 @@ func (n environmentNetworkConfig) validate() error {
 	if err := n.VPC.validate(); err != nil {
 		return fmt.Errorf(`validate "vpc": %w`, err)
 	}
+	if err := n.Connect.validate(); err != nil {
+		return fmt.Errorf(`validate "connect": %w`, err)
+	}
+	return nil
+}
+
+// validate returns nil if environmentConnectConfig is configured correctly.
+func (cfg environmentConnectConfig) validate() error {
+	if cfg.IsEmpty() {
+		return nil
+	}
+	parsed, err := arn.Parse(aws.StringValue(cfg.Namespace))
+	if err != nil {
+		return fmt.Errorf(`parse "namespace": %w`, err)
+	}
+	if parsed.Service != "servicediscovery" || !strings.HasPrefix(parsed.Resource, "namespace/") {
+		return fmt.Errorf(`"namespace" must be the ARN of a Cloud Map namespace, got %q`, aws.StringValue(cfg.Namespace))
+	}
 	return nil
 }
 
@@ -99,10 +184,171 @@ func (cfg environmentVPCConfig) validate() error {
 		if err := cfg.validateManagedVPC(); err != nil {
 			return fmt.Errorf(`validate "subnets" for an adjusted VPC: %w`, err)
 		}
+		if err := cfg.validateSubnetGroups(); err != nil {
+			return fmt.Errorf(`validate "subnets" for an adjusted VPC: %w`, err)
+		}
+	}
+	if len(cfg.Subnets.Groups) != 0 && !cfg.managedVPCCustomized() {
+		return errors.New(`"subnets.groups" can only be specified with a VPC whose "cidr" and subnets are configured`)
 	}
 	if err := cfg.FlowLogs.validate(); err != nil {
 		return fmt.Errorf(`validate vpc "flowlogs": %w`, err)
 	}
+	names := make([]string, 0, len(cfg.AppRunnerConnectors))
+	for name := range cfg.AppRunnerConnectors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := cfg.validateAppRunnerConnector(name, cfg.AppRunnerConnectors[name]); err != nil {
+			return fmt.Errorf(`validate "app_runner_connectors[%s]": %w`, name, err)
+		}
+	}
+	if !cfg.DHCPOptions.IsEmpty() {
+		if cfg.imported() {
+			return errors.New(`"dhcp_options" cannot be specified with an imported VPC`)
+		}
+		if err := cfg.DHCPOptions.validate(); err != nil {
+			return fmt.Errorf(`validate "dhcp_options": %w`, err)
+		}
+	}
+	if err := cfg.DNSResolver.validate(); err != nil {
+		return fmt.Errorf(`validate "dns_resolver": %w`, err)
+	}
+	return nil
+}
+
+// validate returns nil if DHCPOptions is configured correctly.
+func (o DHCPOptions) validate() error {
+	if o.DomainName != nil && aws.StringValue(o.DomainName) == "" {
+		return errors.New(`"domain_name" cannot be empty`)
+	}
+	if len(o.DomainNameServers) > maxDHCPServers {
+		return fmt.Errorf(`"domain_name_servers" can have at most %d servers`, maxDHCPServers)
+	}
+	for _, server := range o.DomainNameServers {
+		if server != amazonProvidedDNS && net.ParseIP(server).To4() == nil {
+			return fmt.Errorf(`domain name server %q must be an IPv4 address or %s`, server, amazonProvidedDNS)
+		}
+	}
+	if len(o.NTPServers) > maxDHCPServers {
+		return fmt.Errorf(`"ntp_servers" can have at most %d servers`, maxDHCPServers)
+	}
+	for _, server := range o.NTPServers {
+		if net.ParseIP(server).To4() == nil {
+			return fmt.Errorf(`NTP server %q must be an IPv4 address`, server)
+		}
+	}
+	return nil
+}
+
+// validate returns nil if DNSResolverConfig is configured correctly.
+func (cfg DNSResolverConfig) validate() error {
+	domains := make(map[string]bool)
+	for idx, rule := range cfg.ForwardingRules {
+		if err := rule.validate(); err != nil {
+			return fmt.Errorf(`validate "forwarding_rules[%d]": %w`, idx, err)
+		}
+		domain := strings.TrimSuffix(strings.ToLower(aws.StringValue(rule.Domain)), ".")
+		if domains[domain] {
+			return fmt.Errorf(`domain %s is forwarded by more than one rule`, aws.StringValue(rule.Domain))
+		}
+		domains[domain] = true
+	}
+	ids := make(map[string]bool)
+	for _, id := range cfg.RuleIDs {
+		if !strings.HasPrefix(id, resolverRuleIDPrefix) {
+			return fmt.Errorf(`rule ID %q must start with %q`, id, resolverRuleIDPrefix)
+		}
+		if ids[id] {
+			return fmt.Errorf(`rule ID %s is specified more than once`, id)
+		}
+		ids[id] = true
+	}
+	return nil
+}
+
+// validate returns nil if DNSForwardingRule is configured correctly.
+func (r DNSForwardingRule) validate() error {
+	if aws.StringValue(r.Domain) == "" {
+		return &errFieldMustBeSpecified{
+			missingField: "domain",
+		}
+	}
+	if len(r.TargetIPs) == 0 {
+		return &errFieldMustBeSpecified{
+			missingField: "target_ips",
+		}
+	}
+	if len(r.TargetIPs) > maxDNSTargetIPs {
+		return fmt.Errorf(`"target_ips" can have at most %d addresses`, maxDNSTargetIPs)
+	}
+	for _, target := range r.TargetIPs {
+		if _, _, err := target.Parse(); err != nil {
+			return fmt.Errorf(`validate "target_ips": %w`, err)
+		}
+	}
+	return nil
+}
+
+// validateAppRunnerConnector returns nil if the subnets and security groups of the connector are compatible with the VPC.
+func (cfg environmentVPCConfig) validateAppRunnerConnector(name string, conn AppRunnerConnector) error {
+	if !appRunnerConnectorNameRegexp.MatchString(name) {
+		return errors.New("name must only contain letters and numbers")
+	}
+	if conn.Placement != nil && len(conn.Subnets) != 0 {
+		return &errFieldMutualExclusive{
+			firstField:  "placement",
+			secondField: "subnets",
+		}
+	}
+	if conn.Placement != nil {
+		if err := conn.Placement.validate(); err != nil {
+			return fmt.Errorf(`validate "placement": %w`, err)
+		}
+		if _, ok := conn.Placement.SubnetGroup(); ok {
+			return fmt.Errorf(`validate "placement": "placement" %s must be one of %s`, *conn.Placement, strings.Join(subnetPlacements, ", "))
+		}
+	}
+	if len(conn.Subnets) != 0 && !cfg.imported() {
+		return errors.New(`"subnets" can only be specified when the VPC is imported, use "placement" instead`)
+	}
+	if cfg.imported() {
+		public, private := make(map[string]bool), make(map[string]bool)
+		for _, subnet := range cfg.Subnets.Public {
+			public[aws.StringValue(subnet.SubnetID)] = true
+		}
+		for _, subnet := range cfg.Subnets.Private {
+			private[aws.StringValue(subnet.SubnetID)] = true
+		}
+		for _, id := range conn.Subnets {
+			if !public[id] && !private[id] {
+				return fmt.Errorf(`subnet %s is not one of the subnets imported in the environment`, id)
+			}
+		}
+		if len(conn.Subnets) == 0 {
+			placement := conn.PlacementOrDefault()
+			if placement == PrivateSubnetPlacement && len(private) == 0 {
+				return errors.New(`"placement" private requires private subnets to be imported in the environment`)
+			}
+			if placement == PublicSubnetPlacement && len(public) == 0 {
+				return errors.New(`"placement" public requires public subnets to be imported in the environment`)
+			}
+		}
+	}
+	if len(conn.SecurityGroups) > maxAppRunnerConnectorSecurityGroups {
+		return fmt.Errorf(`"security_groups" can have at most %d security groups in addition to the environment security group`, maxAppRunnerConnectorSecurityGroups)
+	}
+	seen := make(map[string]bool)
+	for _, id := range conn.SecurityGroups {
+		if !strings.HasPrefix(id, "sg-") {
+			return fmt.Errorf(`security group %q must be the ID of a security group`, id)
+		}
+		if seen[id] {
+			return fmt.Errorf(`security group %s is specified more than once`, id)
+		}
+		seen[id] = true
+	}
 	return nil
 }
 
@@ -238,6 +484,56 @@ func (cfg environmentVPCConfig) validateManagedVPC() error {
 	return nil
 }
 
+// validateSubnetGroups returns nil if each subnet group has one subnet for each public or private subnet, in the same azs.
+func (cfg environmentVPCConfig) validateSubnetGroups() error {
+	names := make([]string, 0, len(cfg.Subnets.Groups))
+	for name := range cfg.Subnets.Groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if !subnetGroupNameRegexp.MatchString(name) {
+			return fmt.Errorf(`validate "groups[%s]": name must contain only alphanumeric characters`, name)
+		}
+		if slices.ContainsFunc(subnetPlacements, func(placement string) bool { return strings.EqualFold(name, placement) }) {
+			return fmt.Errorf(`validate "groups[%s]": name is reserved for the %s subnets of the environment`, name, strings.ToLower(name))
+		}
+		routing := subnetGroupRouting(cfg.Subnets.Groups[name])
+		if !slices.Contains(template.SubnetGroupRoutings, routing) {
+			return fmt.Errorf(`validate "groups[%s]": "routing" %s must be one of %s`, name, routing, strings.Join(template.SubnetGroupRoutings, ", "))
+		}
+		tier, tierName := cfg.Subnets.Private, "private"
+		if routing == template.SubnetGroupRoutingPublic {
+			tier, tierName = cfg.Subnets.Public, "public"
+		}
+		tierAZs := make(map[string]struct{})
+		for _, subnet := range tier {
+			if az := aws.StringValue(subnet.AZ); az != "" {
+				tierAZs[az] = struct{}{}
+			}
+		}
+		subnets := subnetGroupSubnets(cfg.Subnets.Groups[name])
+		if len(subnets) != len(tier) {
+			return fmt.Errorf(`validate "groups[%s]": number of subnets (%d) does not match number of %s subnets (%d)`, name, len(subnets), tierName, len(tier))
+		}
+		azs := make(map[string]struct{})
+		for idx, subnet := range subnets {
+			if aws.StringValue((*string)(subnet.CIDR)) == "" {
+				return fmt.Errorf(`validate "groups[%s][%d]": %w`, name, idx, &errFieldMustBeSpecified{
+					missingField: "cidr",
+				})
+			}
+			if az := aws.StringValue(subnet.AZ); az != "" {
+				azs[az] = struct{}{}
+			}
+		}
+		if !areSetsEqual(azs, tierAZs) {
+			return fmt.Errorf(`validate "groups[%s]": subnets do not span the same availability zones as the %s subnets`, name, tierName)
+		}
+	}
+	return nil
+}
+
 // validate returns nil if subnetsConfiguration is configured correctly.
 func (cs subnetsConfiguration) validate() error {
 	for idx, subnet := range cs.Public {
@@ -250,6 +546,13 @@ func (cs subnetsConfiguration) validate() error {
 			return fmt.Errorf(`validate "private[%d]": %w`, idx, err)
 		}
 	}
+	for name, group := range cs.Groups {
+		for idx, subnet := range subnetGroupSubnets(group) {
+			if subnet.SubnetID != nil {
+				return fmt.Errorf(`validate "groups[%s][%d]": "id" cannot be specified for a subnet group`, name, idx)
+			}
+		}
+	}
 	return nil
 }
 
@@ -272,8 +575,32 @@ func (c subnetConfiguration) validate() error {
 	return nil
 }
 
-// validate is a no-op for VPCFlowLogsArgs.
+// validate returns nil if VPCFlowLogsArgs is configured correctly.
 func (fl VPCFlowLogsArgs) validate() error {
+	if dest := aws.StringValue(fl.Destination); dest != "" && !slices.Contains(flowLogsDestinations, dest) {
+		return fmt.Errorf(`"destination" field value '%s' must be one of %s`, dest, english.WordSeries(flowLogsDestinations, "or"))
+	}
+	if fl.IsS3Destination() {
+		if fl.BucketName == nil {
+			return fmt.Errorf(`"bucket_name" must be specified when "destination" is %s`, FlowLogsDestinationS3)
+		}
+		if fl.Retention != nil {
+			return fmt.Errorf(`"retention" cannot be specified when "destination" is %s`, FlowLogsDestinationS3)
+		}
+	} else {
+		switch {
+		case fl.BucketName != nil:
+			return fmt.Errorf(`"bucket_name" can only be specified when "destination" is %s`, FlowLogsDestinationS3)
+		case fl.Prefix != nil:
+			return fmt.Errorf(`"prefix" can only be specified when "destination" is %s`, FlowLogsDestinationS3)
+		}
+	}
+	if traffic := aws.StringValue(fl.TrafficType); traffic != "" && !slices.Contains(flowLogsTrafficTypes, traffic) {
+		return fmt.Errorf(`"traffic_type" field value '%s' must be one of %s`, traffic, english.WordSeries(flowLogsTrafficTypes, "or"))
+	}
+	if interval := fl.AggregationInterval; interval != nil && *interval != time.Minute && *interval != 10*time.Minute {
+		return fmt.Errorf(`"aggregation_interval" must be 1m or 10m, got %s`, *interval)
+	}
 	return nil
 }
 
@@ -282,6 +609,136 @@ func (o environmentObservability) validate() error {
 	return nil
 }
 
+// validate returns nil if environmentEncryption is configured correctly.
+func (e environmentEncryption) validate() error {
+	if e.IsEmpty() {
+		return nil
+	}
+	parsed, err := arn.Parse(aws.StringValue(e.KMSKey))
+	if err != nil {
+		return fmt.Errorf(`parse "kms_key": %w`, err)
+	}
+	if parsed.Service != "kms" || !strings.HasPrefix(parsed.Resource, "key/") {
+		return fmt.Errorf(`"kms_key" must be the ARN of a KMS key, got %q`, aws.StringValue(e.KMSKey))
+	}
+	return nil
+}
+
+// validate returns nil if environmentCluster is configured correctly.
+func (c environmentCluster) validate() error {
+	if c.IsEmpty() {
+		return nil
+	}
+	name := aws.StringValue(c.Name)
+	if name == "" {
+		return errors.New(`"name" cannot be empty`)
+	}
+	if strings.HasPrefix(name, "arn:") {
+		return fmt.Errorf(`"name" must be the name of an ECS cluster, not an ARN, got %q`, name)
+	}
+	return nil
+}
+
+// validate returns nil if environmentBudget is configured correctly.
+func (b environmentBudget) validate() error {
+	if b.IsEmpty() {
+		return nil
+	}
+	if b.MonthlyLimit == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "monthly_limit",
+		}
+	}
+	if limit := aws.Float64Value(b.MonthlyLimit); limit <= 0 {
+		return fmt.Errorf(`"monthly_limit" must be greater than 0, got %v`, limit)
+	}
+	if b.AlertThreshold != nil && aws.IntValue(b.AlertThreshold) <= 0 {
+		return fmt.Errorf(`"alert_threshold" must be a percentage greater than 0, got %d`, aws.IntValue(b.AlertThreshold))
+	}
+	for _, email := range b.Emails {
+		if !strings.Contains(email, "@") {
+			return fmt.Errorf(`"emails" must contain email addresses, got %q`, email)
+		}
+	}
+	return nil
+}
+
+// validate returns nil if environmentELBAccessLogsBucket is configured correctly.
+func (b environmentELBAccessLogsBucket) validate() error {
+	if b.IsEmpty() {
+		return nil
+	}
+	if err := b.AccessLogs.validate(); err != nil {
+		return fmt.Errorf(`validate "access_logs": %w`, err)
+	}
+	if err := b.ObjectLock.validate(); err != nil {
+		return fmt.Errorf(`validate "object_lock": %w`, err)
+	}
+	return nil
+}
+
+// validate returns nil if bucketAccessLogs is configured correctly.
+func (l bucketAccessLogs) validate() error {
+	if l.IsEmpty() {
+		return nil
+	}
+	if aws.StringValue(l.BucketName) == "" {
+		return &errFieldMustBeSpecified{
+			missingField:      "bucket_name",
+			conditionalFields: []string{"prefix"},
+		}
+	}
+	return nil
+}
+
+// validate returns nil if bucketObjectLock is configured correctly.
+func (o bucketObjectLock) validate() error {
+	if o.IsEmpty() {
+		return nil
+	}
+	if o.Mode == nil {
+		return &errFieldMustBeSpecified{
+			missingField:      "mode",
+			conditionalFields: []string{"retention"},
+		}
+	}
+	if !slices.Contains(objectLockModes, strings.ToUpper(aws.StringValue(o.Mode))) {
+		return fmt.Errorf(`"mode" must be one of %s, got %q`, english.WordSeries(objectLockModes, "or"), aws.StringValue(o.Mode))
+	}
+	if o.Retention == nil {
+		return &errFieldMustBeSpecified{
+			missingField:      "retention",
+			conditionalFields: []string{"mode"},
+		}
+	}
+	if aws.IntValue(o.Retention) < 1 {
+		return fmt.Errorf(`"retention" must be at least 1 day, got %d`, aws.IntValue(o.Retention))
+	}
+	return nil
+}
+
+// validate returns nil if environmentBackups is configured correctly.
+func (b environmentBackups) validate() error {
+	if b.IsEmpty() {
+		return nil
+	}
+	if b.Retention != nil && aws.IntValue(b.Retention) < 1 {
+		return fmt.Errorf(`"retention" must be at least 1 day, got %d`, aws.IntValue(b.Retention))
+	}
+	if b.Schedule != nil {
+		schedule := aws.StringValue(b.Schedule)
+		if !strings.HasPrefix(schedule, "cron(") || !strings.HasSuffix(schedule, ")") {
+			return fmt.Errorf(`"schedule" must be a cron expression such as "cron(0 5 ? * * *)", got %q`, schedule)
+		}
+	}
+	return nil
+}
+
+// validate is a no-op for environmentTasks.
+func (t environmentTasks) validate() error {
+	return nil
+}
+
 // validate returns nil if EnvironmentHTTPConfig is configured correctly.
 func (cfg EnvironmentHTTPConfig) validate() error {
 	if err := cfg.Public.validate(); err != nil {
@@ -315,6 +772,12 @@ func (cfg PublicHTTPConfig) validate() error {
 	if err := cfg.DeprecatedSG.validate(); err != nil {
 		return err
 	}
+	if err := cfg.MutualTLS.validate(); err != nil {
+		return fmt.Errorf(`validate "mutual_tls": %w`, err)
+	}
+	if err := cfg.ResponseHeaders.validate(); err != nil {
+		return fmt.Errorf(`validate "response_headers": %w`, err)
+	}
 	return cfg.Ingress.validate()
 }
 
@@ -355,9 +818,102 @@ func (cfg privateHTTPConfig) validate() error {
 	if err := cfg.DeprecatedSG.validate(); err != nil {
 		return fmt.Errorf(`validate "security_groups: %w`, err)
 	}
+	if !cfg.MutualTLS.IsEmpty() && len(cfg.Certificates) == 0 {
+		return &errFieldMustBeSpecified{
+			missingField:      "certificates",
+			conditionalFields: []string{"mutual_tls"},
+		}
+	}
+	if err := cfg.MutualTLS.validate(); err != nil {
+		return fmt.Errorf(`validate "mutual_tls": %w`, err)
+	}
+	if len(cfg.ResponseHeaders.Custom) != 0 {
+		return errors.New(`"response_headers.custom" is only supported by the CDN in front of the public load balancer`)
+	}
+	if err := cfg.ResponseHeaders.validate(); err != nil {
+		return fmt.Errorf(`validate "response_headers": %w`, err)
+	}
 	return cfg.Ingress.validate()
 }
 
+// validate returns nil if ResponseHeadersConfig is configured correctly.
+func (cfg ResponseHeadersConfig) validate() error {
+	if err := cfg.StrictTransportSecurity.validate(); err != nil {
+		return fmt.Errorf(`validate "strict_transport_security": %w`, err)
+	}
+	if cfg.ContentSecurityPolicy != nil && aws.StringValue(cfg.ContentSecurityPolicy) == "" {
+		return errors.New(`"content_security_policy" cannot be empty`)
+	}
+	for name, value := range cfg.Custom {
+		if !httpHeaderNameRegexp.MatchString(name) {
+			return fmt.Errorf(`custom header name %q is not a valid HTTP header name`, name)
+		}
+		switch strings.ToLower(name) {
+		case "strict-transport-security", "content-security-policy":
+			return fmt.Errorf(`custom header %q must be configured with its own field instead of "custom"`, name)
+		}
+		if value == "" {
+			return fmt.Errorf(`value of custom header %q cannot be empty`, name)
+		}
+	}
+	return nil
+}
+
+// validate returns nil if StrictTransportSecurityConfig is configured correctly.
+func (cfg StrictTransportSecurityConfig) validate() error {
+	if cfg.IsEmpty() {
+		return nil
+	}
+	if cfg.MaxAge == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "max_age",
+		}
+	}
+	if maxAge := *cfg.MaxAge; maxAge < 0 || maxAge%time.Second != 0 {
+		return fmt.Errorf(`"max_age" must be a non-negative whole number of seconds, got %s`, maxAge)
+	}
+	return nil
+}
+
+// validate returns nil if MutualTLSConfig is configured correctly.
+func (cfg MutualTLSConfig) validate() error {
+	if cfg.IsEmpty() {
+		return nil
+	}
+	if cfg.IsPassthrough() {
+		switch {
+		case cfg.TrustStore != nil:
+			return fmt.Errorf(`"trust_store" cannot be specified when "mode" is %s`, MutualTLSModePassthrough)
+		case cfg.CABundle != nil:
+			return fmt.Errorf(`"ca_bundle" cannot be specified when "mode" is %s`, MutualTLSModePassthrough)
+		case cfg.IgnoreClientCertificateExpiry != nil:
+			return fmt.Errorf(`"ignore_client_certificate_expiry" cannot be specified when "mode" is %s`, MutualTLSModePassthrough)
+		}
+		return nil
+	}
+	if mode := aws.StringValue(cfg.Mode); mode != "" && mode != MutualTLSModeVerify {
+		return fmt.Errorf(`"mode" field value '%s' must be one of %s`, mode, english.WordSeries(mutualTLSModes, "or"))
+	}
+	if (cfg.TrustStore == nil) == (cfg.CABundle == nil) {
+		return &errFieldMutualExclusive{
+			firstField:  "trust_store",
+			secondField: "ca_bundle",
+			mustExist:   cfg.TrustStore == nil,
+		}
+	}
+	if cfg.TrustStore != nil {
+		if _, err := arn.Parse(aws.StringValue(cfg.TrustStore)); err != nil {
+			return fmt.Errorf(`parse "trust_store": %w`, err)
+		}
+		return nil
+	}
+	bundle := aws.StringValue(cfg.CABundle)
+	if _, key, err := s3.ParseURL(bundle); !strings.HasPrefix(bundle, "s3://") || err != nil || key == "" {
+		return fmt.Errorf(`"ca_bundle" must be an S3 URI in the format s3://bucket/key`)
+	}
+	return nil
+}
+
 // validate returns nil if environmentCDNConfig is configured correctly.
 func (cfg EnvironmentCDNConfig) validate() error {
 	if cfg.Config.isEmpty() {
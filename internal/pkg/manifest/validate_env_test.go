@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/stretchr/testify/require"
@@ -187,6 +188,18 @@ func TestEnvironmentConfig_validate(t *testing.T) {
 			},
 			wantedError: "CDN must be enabled to limit security group ingress to CloudFront",
 		},
+		"error if custom response headers are specified without a cdn distribution": {
+			in: EnvironmentConfig{
+				HTTPConfig: EnvironmentHTTPConfig{
+					Public: PublicHTTPConfig{
+						ResponseHeaders: ResponseHeadersConfig{
+							Custom: map[string]string{"X-Frame-Options": "DENY"},
+						},
+					},
+				},
+			},
+			wantedError: `CDN must be enabled to add the "http.public.response_headers.custom" headers to responses`,
+		},
 		"valid vpc flowlogs with default retention": {
 			in: EnvironmentConfig{
 				Network: environmentNetworkConfig{
@@ -211,6 +224,95 @@ func TestEnvironmentConfig_validate(t *testing.T) {
 				},
 			},
 		},
+		"error if vpc flowlogs have an invalid destination": {
+			in: EnvironmentConfig{
+				Network: environmentNetworkConfig{
+					VPC: environmentVPCConfig{
+						FlowLogs: AdvancedToUnion[*bool](VPCFlowLogsArgs{
+							Destination: aws.String("firehose"),
+						}),
+					},
+				},
+			},
+			wantedError: `validate "network": validate "vpc": validate vpc "flowlogs": "destination" field value 'firehose' must be one of cloudwatch or s3`,
+		},
+		"error if vpc flowlogs are sent to s3 without a bucket": {
+			in: EnvironmentConfig{
+				Network: environmentNetworkConfig{
+					VPC: environmentVPCConfig{
+						FlowLogs: AdvancedToUnion[*bool](VPCFlowLogsArgs{
+							Destination: aws.String("s3"),
+						}),
+					},
+				},
+			},
+			wantedError: `validate "network": validate "vpc": validate vpc "flowlogs": "bucket_name" must be specified when "destination" is s3`,
+		},
+		"error if vpc flowlogs sent to s3 have a retention": {
+			in: EnvironmentConfig{
+				Network: environmentNetworkConfig{
+					VPC: environmentVPCConfig{
+						FlowLogs: AdvancedToUnion[*bool](VPCFlowLogsArgs{
+							Destination: aws.String("s3"),
+							BucketName:  aws.String("my-bucket"),
+							Retention:   aws.Int(30),
+						}),
+					},
+				},
+			},
+			wantedError: `validate "network": validate "vpc": validate vpc "flowlogs": "retention" cannot be specified when "destination" is s3`,
+		},
+		"error if vpc flowlogs sent to cloudwatch have a bucket": {
+			in: EnvironmentConfig{
+				Network: environmentNetworkConfig{
+					VPC: environmentVPCConfig{
+						FlowLogs: AdvancedToUnion[*bool](VPCFlowLogsArgs{
+							BucketName: aws.String("my-bucket"),
+						}),
+					},
+				},
+			},
+			wantedError: `validate "network": validate "vpc": validate vpc "flowlogs": "bucket_name" can only be specified when "destination" is s3`,
+		},
+		"error if vpc flowlogs have an invalid traffic type": {
+			in: EnvironmentConfig{
+				Network: environmentNetworkConfig{
+					VPC: environmentVPCConfig{
+						FlowLogs: AdvancedToUnion[*bool](VPCFlowLogsArgs{
+							TrafficType: aws.String("dropped"),
+						}),
+					},
+				},
+			},
+			wantedError: `validate "network": validate "vpc": validate vpc "flowlogs": "traffic_type" field value 'dropped' must be one of all, accept or reject`,
+		},
+		"error if vpc flowlogs have an invalid aggregation interval": {
+			in: EnvironmentConfig{
+				Network: environmentNetworkConfig{
+					VPC: environmentVPCConfig{
+						FlowLogs: AdvancedToUnion[*bool](VPCFlowLogsArgs{
+							AggregationInterval: durationp(5 * time.Minute),
+						}),
+					},
+				},
+			},
+			wantedError: `validate "network": validate "vpc": validate vpc "flowlogs": "aggregation_interval" must be 1m or 10m, got 5m0s`,
+		},
+		"valid vpc flowlogs sent to s3": {
+			in: EnvironmentConfig{
+				Network: environmentNetworkConfig{
+					VPC: environmentVPCConfig{
+						FlowLogs: AdvancedToUnion[*bool](VPCFlowLogsArgs{
+							Destination:         aws.String("s3"),
+							BucketName:          aws.String("my-bucket"),
+							Prefix:              aws.String("flow-logs"),
+							TrafficType:         aws.String("reject"),
+							AggregationInterval: durationp(10 * time.Minute),
+						}),
+					},
+				},
+			},
+		},
 		"valid elb access logs config with bucket_prefix": {
 			in: EnvironmentConfig{
 				HTTPConfig: EnvironmentHTTPConfig{
@@ -238,6 +340,230 @@ func TestEnvironmentConfig_validate(t *testing.T) {
 				},
 			},
 		},
+		"error if kms key is not an arn": {
+			in: EnvironmentConfig{
+				Encryption: environmentEncryption{
+					KMSKey: aws.String("my-key"),
+				},
+			},
+			wantedError: `validate "encryption": parse "kms_key": arn: invalid prefix`,
+		},
+		"error if kms key is not the arn of a key": {
+			in: EnvironmentConfig{
+				Encryption: environmentEncryption{
+					KMSKey: aws.String("arn:aws:kms:us-west-2:123456789012:alias/my-key"),
+				},
+			},
+			wantedError: `validate "encryption": "kms_key" must be the ARN of a KMS key, got "arn:aws:kms:us-west-2:123456789012:alias/my-key"`,
+		},
+		"success with a kms key": {
+			in: EnvironmentConfig{
+				Encryption: environmentEncryption{
+					KMSKey: aws.String("arn:aws:kms:us-west-2:123456789012:key/mrk-1234abcd"),
+				},
+			},
+		},
+		"error if the imported cluster name is empty": {
+			in: EnvironmentConfig{
+				Cluster: environmentCluster{
+					Name: aws.String(""),
+				},
+			},
+			wantedError: `validate "cluster": "name" cannot be empty`,
+		},
+		"error if the imported cluster is an arn": {
+			in: EnvironmentConfig{
+				Cluster: environmentCluster{
+					Name: aws.String("arn:aws:ecs:us-west-2:123456789012:cluster/shared"),
+				},
+			},
+			wantedError: `validate "cluster": "name" must be the name of an ECS cluster, not an ARN, got "arn:aws:ecs:us-west-2:123456789012:cluster/shared"`,
+		},
+		"error if container insights is enabled with an imported cluster": {
+			in: EnvironmentConfig{
+				Cluster: environmentCluster{
+					Name: aws.String("shared"),
+				},
+				Observability: environmentObservability{
+					ContainerInsights: aws.Bool(true),
+				},
+			},
+			wantedError: `"observability.container_insights" cannot be configured with an imported "cluster": enable Container Insights on the cluster instead`,
+		},
+		"success with an imported cluster": {
+			in: EnvironmentConfig{
+				Cluster: environmentCluster{
+					Name: aws.String("shared"),
+				},
+				Observability: environmentObservability{
+					ContainerInsights: aws.Bool(false),
+				},
+			},
+		},
+		"error if remote task runner is enabled in an imported vpc without public subnets": {
+			in: EnvironmentConfig{
+				Network: environmentNetworkConfig{
+					VPC: environmentVPCConfig{
+						ID: aws.String("mockID"),
+						Subnets: subnetsConfiguration{
+							Private: []subnetConfiguration{
+								{SubnetID: aws.String("existentSubnet")},
+								{SubnetID: aws.String("anotherExistentSubnet")},
+							},
+						},
+					},
+				},
+				Tasks: environmentTasks{
+					RemoteRunner: aws.Bool(true),
+				},
+			},
+			wantedError: `"tasks.remote_runner" requires public subnets to launch tasks in, but the imported VPC has none`,
+		},
+		"success with a remote task runner": {
+			in: EnvironmentConfig{
+				Tasks: environmentTasks{
+					RemoteRunner: aws.Bool(true),
+				},
+			},
+		},
+		"error if backups are kept for less than a day": {
+			in: EnvironmentConfig{
+				Backups: environmentBackups{
+					Retention: aws.Int(0),
+				},
+			},
+			wantedError: `validate "backups": "retention" must be at least 1 day, got 0`,
+		},
+		"error if the backup schedule is not a cron expression": {
+			in: EnvironmentConfig{
+				Backups: environmentBackups{
+					Schedule: aws.String("rate(1 day)"),
+				},
+			},
+			wantedError: `validate "backups": "schedule" must be a cron expression such as "cron(0 5 ? * * *)", got "rate(1 day)"`,
+		},
+		"success with backups": {
+			in: EnvironmentConfig{
+				Backups: environmentBackups{
+					Retention: aws.Int(7),
+					Schedule:  aws.String("cron(0 5 ? * * *)"),
+				},
+			},
+		},
+		"error if the budget has no monthly limit": {
+			in: EnvironmentConfig{
+				Budget: environmentBudget{
+					Emails: []string{"ops@example.com"},
+				},
+			},
+			wantedError: `validate "budget": "monthly_limit" must be specified`,
+		},
+		"error if the monthly limit of the budget is not positive": {
+			in: EnvironmentConfig{
+				Budget: environmentBudget{
+					MonthlyLimit: aws.Float64(0),
+				},
+			},
+			wantedError: `validate "budget": "monthly_limit" must be greater than 0, got 0`,
+		},
+		"error if the alert threshold of the budget is not positive": {
+			in: EnvironmentConfig{
+				Budget: environmentBudget{
+					MonthlyLimit:   aws.Float64(100),
+					AlertThreshold: aws.Int(-10),
+				},
+			},
+			wantedError: `validate "budget": "alert_threshold" must be a percentage greater than 0, got -10`,
+		},
+		"error if a budget subscriber is not an email address": {
+			in: EnvironmentConfig{
+				Budget: environmentBudget{
+					MonthlyLimit: aws.Float64(100),
+					Emails:       []string{"ops"},
+				},
+			},
+			wantedError: `validate "budget": "emails" must contain email addresses, got "ops"`,
+		},
+		"success with budget": {
+			in: EnvironmentConfig{
+				Budget: environmentBudget{
+					MonthlyLimit:   aws.Float64(100),
+					AlertThreshold: aws.Int(80),
+					Emails:         []string{"ops@example.com"},
+					Guardrail:      aws.Bool(true),
+				},
+			},
+		},
+		"error if the object lock of the elb access logs bucket has no mode": {
+			in: EnvironmentConfig{
+				ELBAccessLogsBucket: environmentELBAccessLogsBucket{
+					ObjectLock: bucketObjectLock{
+						Retention: aws.Int(30),
+					},
+				},
+			},
+			wantedError: `validate "elb_access_logs_bucket": validate "object_lock": "mode" must be specified if "retention" is specified`,
+		},
+		"error if the object lock mode of the elb access logs bucket is invalid": {
+			in: EnvironmentConfig{
+				ELBAccessLogsBucket: environmentELBAccessLogsBucket{
+					ObjectLock: bucketObjectLock{
+						Mode:      aws.String("legal-hold"),
+						Retention: aws.Int(30),
+					},
+				},
+			},
+			wantedError: `validate "elb_access_logs_bucket": validate "object_lock": "mode" must be one of GOVERNANCE or COMPLIANCE, got "legal-hold"`,
+		},
+		"error if the server access logs of the elb access logs bucket have no destination bucket": {
+			in: EnvironmentConfig{
+				ELBAccessLogsBucket: environmentELBAccessLogsBucket{
+					AccessLogs: bucketAccessLogs{
+						Prefix: aws.String("copilot/"),
+					},
+				},
+			},
+			wantedError: `validate "elb_access_logs_bucket": validate "access_logs": "bucket_name" must be specified if "prefix" is specified`,
+		},
+		"error if the elb access logs bucket is configured but Copilot doesn't create it": {
+			in: EnvironmentConfig{
+				HTTPConfig: EnvironmentHTTPConfig{
+					Public: PublicHTTPConfig{
+						ELBAccessLogs: ELBAccessLogsArgsOrBool{
+							AdvancedConfig: ELBAccessLogsArgs{
+								BucketName: aws.String("my-logs"),
+							},
+						},
+					},
+				},
+				ELBAccessLogsBucket: environmentELBAccessLogsBucket{
+					TLSOnly: aws.Bool(true),
+				},
+			},
+			wantedError: `"elb_access_logs_bucket" can only be specified if Copilot creates the bucket for the access logs of the public load balancer with "http.public.access_logs"`,
+		},
+		"success with elb access logs bucket": {
+			in: EnvironmentConfig{
+				HTTPConfig: EnvironmentHTTPConfig{
+					Public: PublicHTTPConfig{
+						ELBAccessLogs: ELBAccessLogsArgsOrBool{
+							Enabled: aws.Bool(true),
+						},
+					},
+				},
+				ELBAccessLogsBucket: environmentELBAccessLogsBucket{
+					AccessLogs: bucketAccessLogs{
+						BucketName: aws.String("central-access-logs"),
+						Prefix:     aws.String("copilot/"),
+					},
+					ObjectLock: bucketObjectLock{
+						Mode:      aws.String("governance"),
+						Retention: aws.Int(30),
+					},
+					TLSOnly: aws.Bool(true),
+				},
+			},
+		},
 		"error if cdn cert specified, cdn not terminating tls, and public certs not specified": {
 			in: EnvironmentConfig{
 				CDNConfig: EnvironmentCDNConfig{
@@ -406,6 +732,29 @@ func TestEnvironmentNetworkConfig_validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "vpc": `,
 		},
+		"error if the shared namespace is not an ARN": {
+			in: environmentNetworkConfig{
+				Connect: environmentConnectConfig{
+					Namespace: stringP("ns-1234"),
+				},
+			},
+			wantedErrorMsgPrefix: `validate "connect": parse "namespace": `,
+		},
+		"error if the shared namespace is not the ARN of a Cloud Map namespace": {
+			in: environmentNetworkConfig{
+				Connect: environmentConnectConfig{
+					Namespace: stringP("arn:aws:servicediscovery:us-west-2:123456789012:service/srv-1234"),
+				},
+			},
+			wantedErrorMsgPrefix: `validate "connect": "namespace" must be the ARN of a Cloud Map namespace`,
+		},
+		"succeed with a shared namespace": {
+			in: environmentNetworkConfig{
+				Connect: environmentConnectConfig{
+					Namespace: stringP("arn:aws:servicediscovery:us-west-2:123456789012:namespace/ns-1234"),
+				},
+			},
+		},
 		"succeed on empty config": {},
 	}
 	for name, tc := range testCases {
@@ -747,63 +1096,729 @@ func TestEnvironmentVPCConfig_validate(t *testing.T) {
 				},
 			},
 		},
-		"succeed on empty config": {},
-	}
-	for name, tc := range testCases {
-		t.Run(name, func(t *testing.T) {
-			gotErr := tc.in.validate()
-			if tc.wantedErr == nil && tc.wantedErrorMsgPrefix == "" {
-				require.NoError(t, gotErr)
-			}
-			if tc.wantedErr != nil {
-				require.EqualError(t, tc.wantedErr, gotErr.Error())
-			}
-			if tc.wantedErrorMsgPrefix != "" {
-				require.Error(t, gotErr)
-				require.Contains(t, gotErr.Error(), tc.wantedErrorMsgPrefix)
-			}
-		})
-	}
-}
-
-func TestSubnetsConfiguration_validate(t *testing.T) {
-	var (
-		mockPublicSubnet1CIDR  = IPNet("10.0.0.0/24")
-		mockPrivateSubnet1CIDR = IPNet("10.0.3.0/24")
-	)
-	testCases := map[string]struct {
-		in                   subnetsConfiguration
-		wantedErrorMsgPrefix string
-	}{
-		"malformed public subnets": {
-			in: subnetsConfiguration{
-				Public: []subnetConfiguration{
-					{
-						CIDR:     &mockPublicSubnet1CIDR,
-						SubnetID: aws.String("mock-public-subnet-1"),
-					},
-				},
-			},
-			wantedErrorMsgPrefix: `validate "public[0]": `,
-		},
-		"malformed private subnets": {
-			in: subnetsConfiguration{
-				Private: []subnetConfiguration{
-					{
-						CIDR:     &mockPrivateSubnet1CIDR,
-						SubnetID: aws.String("mock-private-subnet-1"),
+		"error if subnet groups are configured without a managed vpc": {
+			in: environmentVPCConfig{
+				Subnets: subnetsConfiguration{
+					Groups: map[string]subnetGroup{
+						"data": BasicToUnion[[]subnetConfiguration, subnetGroupConfiguration]([]subnetConfiguration{
+							{
+								CIDR: ipNetP("10.0.10.0/24"),
+							},
+						}),
 					},
 				},
 			},
-			wantedErrorMsgPrefix: `validate "private[0]": `,
+			wantedErr: errors.New(`"subnets.groups" can only be specified with a VPC whose "cidr" and subnets are configured`),
 		},
-		"success": {
-			in: subnetsConfiguration{
-				Public: []subnetConfiguration{
-					{
-						SubnetID: aws.String("mock-public-subnet-1"),
+		"error if a subnet group name is not alphanumeric": {
+			in: environmentVPCConfig{
+				CIDR: &mockVPCCIDR,
+				Subnets: subnetsConfiguration{
+					Public: []subnetConfiguration{
+						{
+							CIDR: &mockPublicSubnet1CIDR,
+							AZ:   aws.String("us-east-2a"),
+						},
+						{
+							CIDR: &mockPublicSubnet2CIDR,
+							AZ:   aws.String("us-east-2b"),
+						},
 					},
-				},
+					Private: []subnetConfiguration{
+						{
+							CIDR: &mockPrivateSubnet1CIDR,
+							AZ:   aws.String("us-east-2a"),
+						},
+						{
+							CIDR: &mockPrivateSubnet2CIDR,
+							AZ:   aws.String("us-east-2b"),
+						},
+					},
+					Groups: map[string]subnetGroup{
+						"data-tier": BasicToUnion[[]subnetConfiguration, subnetGroupConfiguration]([]subnetConfiguration{
+							{
+								CIDR: ipNetP("10.0.10.0/24"),
+								AZ:   aws.String("us-east-2a"),
+							},
+							{
+								CIDR: ipNetP("10.0.11.0/24"),
+								AZ:   aws.String("us-east-2b"),
+							},
+						}),
+					},
+				},
+			},
+			wantedErr: errors.New(`validate "subnets" for an adjusted VPC: validate "groups[data-tier]": name must contain only alphanumeric characters`),
+		},
+		"error if a subnet group is named after the public or private subnets": {
+			in: environmentVPCConfig{
+				CIDR: &mockVPCCIDR,
+				Subnets: subnetsConfiguration{
+					Public: []subnetConfiguration{
+						{
+							CIDR: &mockPublicSubnet1CIDR,
+							AZ:   aws.String("us-east-2a"),
+						},
+						{
+							CIDR: &mockPublicSubnet2CIDR,
+							AZ:   aws.String("us-east-2b"),
+						},
+					},
+					Private: []subnetConfiguration{
+						{
+							CIDR: &mockPrivateSubnet1CIDR,
+							AZ:   aws.String("us-east-2a"),
+						},
+						{
+							CIDR: &mockPrivateSubnet2CIDR,
+							AZ:   aws.String("us-east-2b"),
+						},
+					},
+					Groups: map[string]subnetGroup{
+						"Private": BasicToUnion[[]subnetConfiguration, subnetGroupConfiguration]([]subnetConfiguration{
+							{
+								CIDR: ipNetP("10.0.10.0/24"),
+								AZ:   aws.String("us-east-2a"),
+							},
+							{
+								CIDR: ipNetP("10.0.11.0/24"),
+								AZ:   aws.String("us-east-2b"),
+							},
+						}),
+					},
+				},
+			},
+			wantedErr: errors.New(`validate "subnets" for an adjusted VPC: validate "groups[Private]": name is reserved for the private subnets of the environment`),
+		},
+		"error if a subnet group does not have one subnet per private subnet": {
+			in: environmentVPCConfig{
+				CIDR: &mockVPCCIDR,
+				Subnets: subnetsConfiguration{
+					Public: []subnetConfiguration{
+						{
+							CIDR: &mockPublicSubnet1CIDR,
+							AZ:   aws.String("us-east-2a"),
+						},
+						{
+							CIDR: &mockPublicSubnet2CIDR,
+							AZ:   aws.String("us-east-2b"),
+						},
+					},
+					Private: []subnetConfiguration{
+						{
+							CIDR: &mockPrivateSubnet1CIDR,
+							AZ:   aws.String("us-east-2a"),
+						},
+						{
+							CIDR: &mockPrivateSubnet2CIDR,
+							AZ:   aws.String("us-east-2b"),
+						},
+					},
+					Groups: map[string]subnetGroup{
+						"data": BasicToUnion[[]subnetConfiguration, subnetGroupConfiguration]([]subnetConfiguration{
+							{
+								CIDR: ipNetP("10.0.10.0/24"),
+								AZ:   aws.String("us-east-2a"),
+							},
+						}),
+					},
+				},
+			},
+			wantedErr: errors.New(`validate "subnets" for an adjusted VPC: validate "groups[data]": number of subnets (1) does not match number of private subnets (2)`),
+		},
+		"error if a subnet group does not span the azs of the private subnets": {
+			in: environmentVPCConfig{
+				CIDR: &mockVPCCIDR,
+				Subnets: subnetsConfiguration{
+					Public: []subnetConfiguration{
+						{
+							CIDR: &mockPublicSubnet1CIDR,
+							AZ:   aws.String("us-east-2a"),
+						},
+						{
+							CIDR: &mockPublicSubnet2CIDR,
+							AZ:   aws.String("us-east-2b"),
+						},
+					},
+					Private: []subnetConfiguration{
+						{
+							CIDR: &mockPrivateSubnet1CIDR,
+							AZ:   aws.String("us-east-2a"),
+						},
+						{
+							CIDR: &mockPrivateSubnet2CIDR,
+							AZ:   aws.String("us-east-2b"),
+						},
+					},
+					Groups: map[string]subnetGroup{
+						"data": BasicToUnion[[]subnetConfiguration, subnetGroupConfiguration]([]subnetConfiguration{
+							{
+								CIDR: ipNetP("10.0.10.0/24"),
+								AZ:   aws.String("us-east-2a"),
+							},
+							{
+								CIDR: ipNetP("10.0.11.0/24"),
+								AZ:   aws.String("us-east-2c"),
+							},
+						}),
+					},
+				},
+			},
+			wantedErr: errors.New(`validate "subnets" for an adjusted VPC: validate "groups[data]": subnets do not span the same availability zones as the private subnets`),
+		},
+		"error if a subnet group imports a subnet": {
+			in: environmentVPCConfig{
+				CIDR: &mockVPCCIDR,
+				Subnets: subnetsConfiguration{
+					Public: []subnetConfiguration{
+						{
+							CIDR: &mockPublicSubnet1CIDR,
+							AZ:   aws.String("us-east-2a"),
+						},
+						{
+							CIDR: &mockPublicSubnet2CIDR,
+							AZ:   aws.String("us-east-2b"),
+						},
+					},
+					Private: []subnetConfiguration{
+						{
+							CIDR: &mockPrivateSubnet1CIDR,
+							AZ:   aws.String("us-east-2a"),
+						},
+						{
+							CIDR: &mockPrivateSubnet2CIDR,
+							AZ:   aws.String("us-east-2b"),
+						},
+					},
+					Groups: map[string]subnetGroup{
+						"data": BasicToUnion[[]subnetConfiguration, subnetGroupConfiguration]([]subnetConfiguration{
+							{
+								SubnetID: aws.String("subnet-1234"),
+							},
+						}),
+					},
+				},
+			},
+			wantedErr: errors.New(`validate "subnets": validate "groups[data][0]": "id" cannot be specified for a subnet group`),
+		},
+		"error if the routing of a subnet group is invalid": {
+			in: environmentVPCConfig{
+				CIDR: &mockVPCCIDR,
+				Subnets: subnetsConfiguration{
+					Public: []subnetConfiguration{
+						{
+							CIDR: &mockPublicSubnet1CIDR,
+							AZ:   aws.String("us-east-2a"),
+						},
+						{
+							CIDR: &mockPublicSubnet2CIDR,
+							AZ:   aws.String("us-east-2b"),
+						},
+					},
+					Private: []subnetConfiguration{
+						{
+							CIDR: &mockPrivateSubnet1CIDR,
+							AZ:   aws.String("us-east-2a"),
+						},
+						{
+							CIDR: &mockPrivateSubnet2CIDR,
+							AZ:   aws.String("us-east-2b"),
+						},
+					},
+					Groups: map[string]subnetGroup{
+						"data": AdvancedToUnion[[]subnetConfiguration](subnetGroupConfiguration{
+							Routing: aws.String("internet"),
+							Subnets: []subnetConfiguration{
+								{
+									CIDR: ipNetP("10.0.10.0/24"),
+									AZ:   aws.String("us-east-2a"),
+								},
+								{
+									CIDR: ipNetP("10.0.11.0/24"),
+									AZ:   aws.String("us-east-2b"),
+								},
+							},
+						}),
+					},
+				},
+			},
+			wantedErr: errors.New(`validate "subnets" for an adjusted VPC: validate "groups[data]": "routing" internet must be one of private, isolated, public`),
+		},
+		"error if a publicly routed subnet group does not have one subnet per public subnet": {
+			in: environmentVPCConfig{
+				CIDR: &mockVPCCIDR,
+				Subnets: subnetsConfiguration{
+					Public: []subnetConfiguration{
+						{
+							CIDR: &mockPublicSubnet1CIDR,
+							AZ:   aws.String("us-east-2a"),
+						},
+						{
+							CIDR: &mockPublicSubnet2CIDR,
+							AZ:   aws.String("us-east-2b"),
+						},
+					},
+					Private: []subnetConfiguration{
+						{
+							CIDR: &mockPrivateSubnet1CIDR,
+							AZ:   aws.String("us-east-2a"),
+						},
+						{
+							CIDR: &mockPrivateSubnet2CIDR,
+							AZ:   aws.String("us-east-2b"),
+						},
+					},
+					Groups: map[string]subnetGroup{
+						"edge": AdvancedToUnion[[]subnetConfiguration](subnetGroupConfiguration{
+							Routing: aws.String("public"),
+							Subnets: []subnetConfiguration{
+								{
+									CIDR: ipNetP("10.0.10.0/24"),
+									AZ:   aws.String("us-east-2a"),
+								},
+							},
+						}),
+					},
+				},
+			},
+			wantedErr: errors.New(`validate "subnets" for an adjusted VPC: validate "groups[edge]": number of subnets (1) does not match number of public subnets (2)`),
+		},
+		"succeed with isolated and public subnet groups": {
+			in: environmentVPCConfig{
+				CIDR: &mockVPCCIDR,
+				Subnets: subnetsConfiguration{
+					Public: []subnetConfiguration{
+						{
+							CIDR: &mockPublicSubnet1CIDR,
+							AZ:   aws.String("us-east-2a"),
+						},
+						{
+							CIDR: &mockPublicSubnet2CIDR,
+							AZ:   aws.String("us-east-2b"),
+						},
+					},
+					Private: []subnetConfiguration{
+						{
+							CIDR: &mockPrivateSubnet1CIDR,
+							AZ:   aws.String("us-east-2a"),
+						},
+						{
+							CIDR: &mockPrivateSubnet2CIDR,
+							AZ:   aws.String("us-east-2b"),
+						},
+					},
+					Groups: map[string]subnetGroup{
+						"data": AdvancedToUnion[[]subnetConfiguration](subnetGroupConfiguration{
+							Routing: aws.String("isolated"),
+							Subnets: []subnetConfiguration{
+								{
+									CIDR: ipNetP("10.0.10.0/24"),
+									AZ:   aws.String("us-east-2a"),
+								},
+								{
+									CIDR: ipNetP("10.0.11.0/24"),
+									AZ:   aws.String("us-east-2b"),
+								},
+							},
+						}),
+						"edge": AdvancedToUnion[[]subnetConfiguration](subnetGroupConfiguration{
+							Routing: aws.String("public"),
+							Subnets: []subnetConfiguration{
+								{
+									CIDR: ipNetP("10.0.30.0/24"),
+									AZ:   aws.String("us-east-2a"),
+								},
+								{
+									CIDR: ipNetP("10.0.31.0/24"),
+									AZ:   aws.String("us-east-2b"),
+								},
+							},
+						}),
+					},
+				},
+			},
+		},
+		"succeed with subnet groups": {
+			in: environmentVPCConfig{
+				CIDR: &mockVPCCIDR,
+				Subnets: subnetsConfiguration{
+					Public: []subnetConfiguration{
+						{
+							CIDR: &mockPublicSubnet1CIDR,
+							AZ:   aws.String("us-east-2a"),
+						},
+						{
+							CIDR: &mockPublicSubnet2CIDR,
+							AZ:   aws.String("us-east-2b"),
+						},
+					},
+					Private: []subnetConfiguration{
+						{
+							CIDR: &mockPrivateSubnet1CIDR,
+							AZ:   aws.String("us-east-2a"),
+						},
+						{
+							CIDR: &mockPrivateSubnet2CIDR,
+							AZ:   aws.String("us-east-2b"),
+						},
+					},
+					Groups: map[string]subnetGroup{
+						"data": BasicToUnion[[]subnetConfiguration, subnetGroupConfiguration]([]subnetConfiguration{
+							{
+								CIDR: ipNetP("10.0.10.0/24"),
+								AZ:   aws.String("us-east-2a"),
+							},
+							{
+								CIDR: ipNetP("10.0.11.0/24"),
+								AZ:   aws.String("us-east-2b"),
+							},
+						}),
+					},
+				},
+			},
+		},
+		"succeed on empty config": {},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := tc.in.validate()
+			if tc.wantedErr == nil && tc.wantedErrorMsgPrefix == "" {
+				require.NoError(t, gotErr)
+			}
+			if tc.wantedErr != nil {
+				require.EqualError(t, tc.wantedErr, gotErr.Error())
+			}
+			if tc.wantedErrorMsgPrefix != "" {
+				require.Error(t, gotErr)
+				require.Contains(t, gotErr.Error(), tc.wantedErrorMsgPrefix)
+			}
+		})
+	}
+}
+
+func TestEnvironmentVPCConfig_validateAppRunnerConnectors(t *testing.T) {
+	importedPrivateVPC := environmentVPCConfig{
+		ID: aws.String("vpc-1234"),
+		Subnets: subnetsConfiguration{
+			Private: []subnetConfiguration{
+				{SubnetID: aws.String("subnet-1")},
+				{SubnetID: aws.String("subnet-2")},
+			},
+		},
+	}
+	testCases := map[string]struct {
+		vpc        environmentVPCConfig
+		connectors map[string]AppRunnerConnector
+
+		wantedErr error
+	}{
+		"error if the name is not alphanumeric": {
+			connectors: map[string]AppRunnerConnector{
+				"shared-db": {},
+			},
+			wantedErr: errors.New(`validate "app_runner_connectors[shared-db]": name must only contain letters and numbers`),
+		},
+		"error if placement and subnets are both specified": {
+			vpc: importedPrivateVPC,
+			connectors: map[string]AppRunnerConnector{
+				"shared": {
+					Placement: placementStringP(PrivateSubnetPlacement),
+					Subnets:   []string{"subnet-1"},
+				},
+			},
+			wantedErr: errors.New(`validate "app_runner_connectors[shared]": must specify one, not both, of "placement" and "subnets"`),
+		},
+		"error if the placement is invalid": {
+			connectors: map[string]AppRunnerConnector{
+				"shared": {
+					Placement: (*PlacementString)(aws.String("isolated")),
+				},
+			},
+			wantedErr: errors.New(`validate "app_runner_connectors[shared]": validate "placement": "placement" isolated must be one of public, private`),
+		},
+		"error if the placement is a subnet group": {
+			connectors: map[string]AppRunnerConnector{
+				"shared": {
+					Placement: (*PlacementString)(aws.String("data")),
+				},
+			},
+			wantedErr: errors.New(`validate "app_runner_connectors[shared]": validate "placement": "placement" data must be one of public, private`),
+		},
+		"error if subnets are specified for a managed VPC": {
+			connectors: map[string]AppRunnerConnector{
+				"shared": {
+					Subnets: []string{"subnet-1"},
+				},
+			},
+			wantedErr: errors.New(`validate "app_runner_connectors[shared]": "subnets" can only be specified when the VPC is imported, use "placement" instead`),
+		},
+		"error if a subnet is not imported in the environment": {
+			vpc: importedPrivateVPC,
+			connectors: map[string]AppRunnerConnector{
+				"shared": {
+					Subnets: []string{"subnet-1", "subnet-3"},
+				},
+			},
+			wantedErr: errors.New(`validate "app_runner_connectors[shared]": subnet subnet-3 is not one of the subnets imported in the environment`),
+		},
+		"error if the placement has no imported subnets": {
+			vpc: importedPrivateVPC,
+			connectors: map[string]AppRunnerConnector{
+				"shared": {
+					Placement: placementStringP(PublicSubnetPlacement),
+				},
+			},
+			wantedErr: errors.New(`validate "app_runner_connectors[shared]": "placement" public requires public subnets to be imported in the environment`),
+		},
+		"error if there are too many security groups": {
+			connectors: map[string]AppRunnerConnector{
+				"shared": {
+					SecurityGroups: []string{"sg-1", "sg-2", "sg-3", "sg-4", "sg-5"},
+				},
+			},
+			wantedErr: errors.New(`validate "app_runner_connectors[shared]": "security_groups" can have at most 4 security groups in addition to the environment security group`),
+		},
+		"error if a security group is not an ID": {
+			connectors: map[string]AppRunnerConnector{
+				"shared": {
+					SecurityGroups: []string{"db"},
+				},
+			},
+			wantedErr: errors.New(`validate "app_runner_connectors[shared]": security group "db" must be the ID of a security group`),
+		},
+		"error if a security group is duplicated": {
+			connectors: map[string]AppRunnerConnector{
+				"shared": {
+					SecurityGroups: []string{"sg-1", "sg-1"},
+				},
+			},
+			wantedErr: errors.New(`validate "app_runner_connectors[shared]": security group sg-1 is specified more than once`),
+		},
+		"succeed on connectors of a managed VPC": {
+			connectors: map[string]AppRunnerConnector{
+				"private": {
+					SecurityGroups: []string{"sg-1"},
+				},
+				"public": {
+					Placement: placementStringP(PublicSubnetPlacement),
+				},
+			},
+		},
+		"succeed on connectors of an imported VPC": {
+			vpc: importedPrivateVPC,
+			connectors: map[string]AppRunnerConnector{
+				"private": {},
+				"subnets": {
+					Subnets: []string{"subnet-2"},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			vpc := tc.vpc
+			vpc.AppRunnerConnectors = tc.connectors
+
+			gotErr := vpc.validate()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, gotErr, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
+func TestEnvironmentVPCConfig_validateDNS(t *testing.T) {
+	importedVPC := environmentVPCConfig{
+		ID: aws.String("vpc-1234"),
+		Subnets: subnetsConfiguration{
+			Private: []subnetConfiguration{
+				{SubnetID: aws.String("subnet-1")},
+				{SubnetID: aws.String("subnet-2")},
+			},
+		},
+	}
+	testCases := map[string]struct {
+		vpc         environmentVPCConfig
+		dhcpOptions DHCPOptions
+		dnsResolver DNSResolverConfig
+
+		wantedErr error
+	}{
+		"error if DHCP options are specified for an imported VPC": {
+			vpc: importedVPC,
+			dhcpOptions: DHCPOptions{
+				DomainName: aws.String("corp.example.com"),
+			},
+			wantedErr: errors.New(`"dhcp_options" cannot be specified with an imported VPC`),
+		},
+		"error if the domain name is empty": {
+			dhcpOptions: DHCPOptions{
+				DomainName: aws.String(""),
+			},
+			wantedErr: errors.New(`validate "dhcp_options": "domain_name" cannot be empty`),
+		},
+		"error if there are too many domain name servers": {
+			dhcpOptions: DHCPOptions{
+				DomainNameServers: []string{"10.0.0.1", "10.0.0.2", "10.0.0.3", "10.0.0.4", "10.0.0.5"},
+			},
+			wantedErr: errors.New(`validate "dhcp_options": "domain_name_servers" can have at most 4 servers`),
+		},
+		"error if a domain name server is not an IP address": {
+			dhcpOptions: DHCPOptions{
+				DomainNameServers: []string{"dns.corp.example.com"},
+			},
+			wantedErr: errors.New(`validate "dhcp_options": domain name server "dns.corp.example.com" must be an IPv4 address or AmazonProvidedDNS`),
+		},
+		"error if an NTP server is not an IP address": {
+			dhcpOptions: DHCPOptions{
+				NTPServers: []string{"time.corp.example.com"},
+			},
+			wantedErr: errors.New(`validate "dhcp_options": NTP server "time.corp.example.com" must be an IPv4 address`),
+		},
+		"error if a forwarding rule has no domain": {
+			dnsResolver: DNSResolverConfig{
+				ForwardingRules: []DNSForwardingRule{
+					{TargetIPs: []DNSTargetIP{"10.10.0.2"}},
+				},
+			},
+			wantedErr: errors.New(`validate "dns_resolver": validate "forwarding_rules[0]": "domain" must be specified`),
+		},
+		"error if a forwarding rule has no target IPs": {
+			dnsResolver: DNSResolverConfig{
+				ForwardingRules: []DNSForwardingRule{
+					{Domain: aws.String("corp.example.com")},
+				},
+			},
+			wantedErr: errors.New(`validate "dns_resolver": validate "forwarding_rules[0]": "target_ips" must be specified`),
+		},
+		"error if a target IP is not an IPv4 address": {
+			dnsResolver: DNSResolverConfig{
+				ForwardingRules: []DNSForwardingRule{
+					{
+						Domain:    aws.String("corp.example.com"),
+						TargetIPs: []DNSTargetIP{"fd00::2"},
+					},
+				},
+			},
+			wantedErr: errors.New(`validate "dns_resolver": validate "forwarding_rules[0]": validate "target_ips": "fd00::2" must be an IPv4 address`),
+		},
+		"error if a target port is invalid": {
+			dnsResolver: DNSResolverConfig{
+				ForwardingRules: []DNSForwardingRule{
+					{
+						Domain:    aws.String("corp.example.com"),
+						TargetIPs: []DNSTargetIP{"10.10.0.2:0"},
+					},
+				},
+			},
+			wantedErr: errors.New(`validate "dns_resolver": validate "forwarding_rules[0]": validate "target_ips": port "0" must be a number between 1 and 65535`),
+		},
+		"error if a domain is forwarded twice": {
+			dnsResolver: DNSResolverConfig{
+				ForwardingRules: []DNSForwardingRule{
+					{
+						Domain:    aws.String("corp.example.com"),
+						TargetIPs: []DNSTargetIP{"10.10.0.2"},
+					},
+					{
+						Domain:    aws.String("Corp.Example.com."),
+						TargetIPs: []DNSTargetIP{"10.10.0.3"},
+					},
+				},
+			},
+			wantedErr: errors.New(`validate "dns_resolver": domain Corp.Example.com. is forwarded by more than one rule`),
+		},
+		"error if a rule ID is invalid": {
+			dnsResolver: DNSResolverConfig{
+				RuleIDs: []string{"corp"},
+			},
+			wantedErr: errors.New(`validate "dns_resolver": rule ID "corp" must start with "rslvr-rr-"`),
+		},
+		"error if a rule ID is duplicated": {
+			dnsResolver: DNSResolverConfig{
+				RuleIDs: []string{"rslvr-rr-1", "rslvr-rr-1"},
+			},
+			wantedErr: errors.New(`validate "dns_resolver": rule ID rslvr-rr-1 is specified more than once`),
+		},
+		"succeed on DHCP options and forwarding rules for a managed VPC": {
+			dhcpOptions: DHCPOptions{
+				DomainName:        aws.String("corp.example.com"),
+				DomainNameServers: []string{"AmazonProvidedDNS", "10.10.0.2"},
+			},
+			dnsResolver: DNSResolverConfig{
+				ForwardingRules: []DNSForwardingRule{
+					{
+						Domain:    aws.String("corp.example.com"),
+						TargetIPs: []DNSTargetIP{"10.10.0.2", "10.10.0.3:5353"},
+					},
+				},
+			},
+		},
+		"succeed on existing rules for an imported VPC": {
+			vpc: importedVPC,
+			dnsResolver: DNSResolverConfig{
+				RuleIDs: []string{"rslvr-rr-1234567890abcdef0"},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			vpc := tc.vpc
+			vpc.DHCPOptions = tc.dhcpOptions
+			vpc.DNSResolver = tc.dnsResolver
+
+			gotErr := vpc.validate()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, gotErr, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
+func TestSubnetsConfiguration_validate(t *testing.T) {
+	var (
+		mockPublicSubnet1CIDR  = IPNet("10.0.0.0/24")
+		mockPrivateSubnet1CIDR = IPNet("10.0.3.0/24")
+	)
+	testCases := map[string]struct {
+		in                   subnetsConfiguration
+		wantedErrorMsgPrefix string
+	}{
+		"malformed public subnets": {
+			in: subnetsConfiguration{
+				Public: []subnetConfiguration{
+					{
+						CIDR:     &mockPublicSubnet1CIDR,
+						SubnetID: aws.String("mock-public-subnet-1"),
+					},
+				},
+			},
+			wantedErrorMsgPrefix: `validate "public[0]": `,
+		},
+		"malformed private subnets": {
+			in: subnetsConfiguration{
+				Private: []subnetConfiguration{
+					{
+						CIDR:     &mockPrivateSubnet1CIDR,
+						SubnetID: aws.String("mock-private-subnet-1"),
+					},
+				},
+			},
+			wantedErrorMsgPrefix: `validate "private[0]": `,
+		},
+		"success": {
+			in: subnetsConfiguration{
+				Public: []subnetConfiguration{
+					{
+						SubnetID: aws.String("mock-public-subnet-1"),
+					},
+				},
 				Private: []subnetConfiguration{
 					{
 						SubnetID: aws.String("mock-private-subnet-1"),
@@ -1082,6 +2097,172 @@ func TestEnvironmentHTTPConfig_validate(t *testing.T) {
 			},
 			wantedError: fmt.Errorf(`validate "public": parse IPNet 1.1.1.invalidip: invalid CIDR address: 1.1.1.invalidip`),
 		},
+		"public mutual tls with an invalid mode": {
+			in: EnvironmentHTTPConfig{
+				Public: PublicHTTPConfig{
+					MutualTLS: MutualTLSConfig{
+						Mode: aws.String("optional"),
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "public": validate "mutual_tls": "mode" field value 'optional' must be one of verify or passthrough`),
+		},
+		"public mutual tls verifying clients without a trust store or a ca bundle": {
+			in: EnvironmentHTTPConfig{
+				Public: PublicHTTPConfig{
+					MutualTLS: MutualTLSConfig{
+						IgnoreClientCertificateExpiry: aws.Bool(true),
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "public": validate "mutual_tls": must specify one of "trust_store" and "ca_bundle"`),
+		},
+		"public mutual tls with both a trust store and a ca bundle": {
+			in: EnvironmentHTTPConfig{
+				Public: PublicHTTPConfig{
+					MutualTLS: MutualTLSConfig{
+						TrustStore: aws.String("arn:aws:elasticloadbalancing:us-west-2:123456789012:truststore/clients/abc"),
+						CABundle:   aws.String("s3://my-bucket/ca.pem"),
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "public": validate "mutual_tls": must specify one, not both, of "trust_store" and "ca_bundle"`),
+		},
+		"public mutual tls with a malformed trust store": {
+			in: EnvironmentHTTPConfig{
+				Public: PublicHTTPConfig{
+					MutualTLS: MutualTLSConfig{
+						TrustStore: aws.String("clients"),
+					},
+				},
+			},
+			wantedErrorMsgPrefix: `validate "public": validate "mutual_tls": parse "trust_store": `,
+		},
+		"public mutual tls with a ca bundle that is not an S3 URI": {
+			in: EnvironmentHTTPConfig{
+				Public: PublicHTTPConfig{
+					MutualTLS: MutualTLSConfig{
+						CABundle: aws.String("https://my-bucket.s3.us-west-2.amazonaws.com/ca.pem"),
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "public": validate "mutual_tls": "ca_bundle" must be an S3 URI in the format s3://bucket/key`),
+		},
+		"public mutual tls in passthrough mode with a trust store": {
+			in: EnvironmentHTTPConfig{
+				Public: PublicHTTPConfig{
+					MutualTLS: MutualTLSConfig{
+						Mode:       aws.String("passthrough"),
+						TrustStore: aws.String("arn:aws:elasticloadbalancing:us-west-2:123456789012:truststore/clients/abc"),
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "public": validate "mutual_tls": "trust_store" cannot be specified when "mode" is passthrough`),
+		},
+		"private mutual tls without certificates": {
+			in: EnvironmentHTTPConfig{
+				Private: privateHTTPConfig{
+					MutualTLS: MutualTLSConfig{
+						Mode: aws.String("passthrough"),
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "private": "certificates" must be specified if "mutual_tls" is specified`),
+		},
+		"success with public mutual tls verifying clients with a ca bundle": {
+			in: EnvironmentHTTPConfig{
+				Public: PublicHTTPConfig{
+					MutualTLS: MutualTLSConfig{
+						Mode:                          aws.String("verify"),
+						CABundle:                      aws.String("s3://my-bucket/certs/ca.pem"),
+						IgnoreClientCertificateExpiry: aws.Bool(true),
+					},
+				},
+			},
+		},
+		"success with private mutual tls in passthrough mode": {
+			in: EnvironmentHTTPConfig{
+				Private: privateHTTPConfig{
+					Certificates: []string{"arn:aws:acm:us-east-1:1111111:certificate/look-like-a-good-arn"},
+					MutualTLS: MutualTLSConfig{
+						Mode: aws.String("passthrough"),
+					},
+				},
+			},
+		},
+		"public strict transport security without a max age": {
+			in: EnvironmentHTTPConfig{
+				Public: PublicHTTPConfig{
+					ResponseHeaders: ResponseHeadersConfig{
+						StrictTransportSecurity: StrictTransportSecurityConfig{
+							Preload: aws.Bool(true),
+						},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "public": validate "response_headers": validate "strict_transport_security": "max_age" must be specified`),
+		},
+		"public strict transport security with a max age that is not in whole seconds": {
+			in: EnvironmentHTTPConfig{
+				Public: PublicHTTPConfig{
+					ResponseHeaders: ResponseHeadersConfig{
+						StrictTransportSecurity: StrictTransportSecurityConfig{
+							MaxAge: durationp(1500 * time.Millisecond),
+						},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "public": validate "response_headers": validate "strict_transport_security": "max_age" must be a non-negative whole number of seconds, got 1.5s`),
+		},
+		"public custom header with an invalid name": {
+			in: EnvironmentHTTPConfig{
+				Public: PublicHTTPConfig{
+					ResponseHeaders: ResponseHeadersConfig{
+						Custom: map[string]string{"X Frame Options": "DENY"},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "public": validate "response_headers": custom header name "X Frame Options" is not a valid HTTP header name`),
+		},
+		"public custom header that has its own field": {
+			in: EnvironmentHTTPConfig{
+				Public: PublicHTTPConfig{
+					ResponseHeaders: ResponseHeadersConfig{
+						Custom: map[string]string{"Content-Security-Policy": "default-src 'self'"},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "public": validate "response_headers": custom header "Content-Security-Policy" must be configured with its own field instead of "custom"`),
+		},
+		"private custom headers": {
+			in: EnvironmentHTTPConfig{
+				Private: privateHTTPConfig{
+					ResponseHeaders: ResponseHeadersConfig{
+						Custom: map[string]string{"X-Frame-Options": "DENY"},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "private": "response_headers.custom" is only supported by the CDN in front of the public load balancer`),
+		},
+		"success with response headers": {
+			in: EnvironmentHTTPConfig{
+				Public: PublicHTTPConfig{
+					ResponseHeaders: ResponseHeadersConfig{
+						StrictTransportSecurity: StrictTransportSecurityConfig{
+							MaxAge:            durationp(365 * 24 * time.Hour),
+							IncludeSubdomains: aws.Bool(true),
+						},
+						ContentSecurityPolicy: aws.String("default-src 'self'"),
+						Custom:                map[string]string{"X-Frame-Options": "DENY"},
+					},
+				},
+				Private: privateHTTPConfig{
+					ResponseHeaders: ResponseHeadersConfig{
+						ContentSecurityPolicy: aws.String("default-src 'self'"),
+					},
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
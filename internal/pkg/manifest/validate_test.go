@@ -530,6 +530,54 @@ func TestLoadBalancedWebService_validate(t *testing.T) {
 			},
 			wantedError: fmt.Errorf(`validate load balancer health check ports: container "mockName" exposes port 80 using protocol udp invalid for health checks. Valid protocol is "TCP".`),
 		},
+		"error if deployment validation sends requests to a service without http": {
+			lbConfig: LoadBalancedWebService{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: testImageConfig,
+					HTTPOrBool: HTTPOrBool{
+						Enabled: aws.Bool(false),
+					},
+					NLBConfig: NetworkLoadBalancerConfiguration{
+						Listener: NetworkLoadBalancerListener{
+							Port: aws.String("80"),
+						},
+					},
+					DeployConfig: DeploymentConfig{
+						Validation: DeploymentValidation{
+							Path: aws.String("/healthz"),
+						},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`"http" must be specified if "deployment.validation.path" is specified`),
+		},
+		"error if deployment validation sends requests to a service requiring client certificates": {
+			lbConfig: LoadBalancedWebService{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: testImageConfig,
+					HTTPOrBool: HTTPOrBool{
+						HTTP: HTTP{
+							Main: RoutingRule{
+								Path: stringP("/"),
+							},
+							MutualTLS: aws.Bool(true),
+						},
+					},
+					DeployConfig: DeploymentConfig{
+						Validation: DeploymentValidation{
+							Path: aws.String("/healthz"),
+						},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`"deployment.validation.path" cannot be requested when "http.mutual_tls" is enabled: use "deployment.validation.command" instead`),
+		},
 		"error if fail to validate deployment": {
 			lbConfig: LoadBalancedWebService{
 				Workload: Workload{
@@ -605,6 +653,17 @@ func TestBackendService_validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "image": `,
 		},
+		"error if dashboard is enabled": {
+			config: BackendService{
+				BackendServiceConfig: BackendServiceConfig{
+					ImageConfig: testImageConfig,
+					Observability: Observability{
+						Dashboard: aws.Bool(true),
+					},
+				},
+			},
+			wantedError: errors.New(`validate "observability": "dashboard" is only supported for Worker Service`),
+		},
 		"error if fail to validate sidecars": {
 			config: BackendService{
 				BackendServiceConfig: BackendServiceConfig{
@@ -649,6 +708,20 @@ func TestBackendService_validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "publish": `,
 		},
+		"error if cdn is configured": {
+			config: BackendService{
+				BackendServiceConfig: BackendServiceConfig{
+					ImageConfig: testImageConfig,
+					HTTP: HTTP{
+						Main: RoutingRule{
+							Path: stringP("/"),
+						},
+						CDN: BasicToUnion[*bool, ServiceCDNConfig](aws.Bool(true)),
+					},
+				},
+			},
+			wantedError: errors.New(`"http.cdn" is not supported for Backend Services because they are not reachable from the internet`),
+		},
 		"error if fail to validate taskdef override": {
 			config: BackendService{
 				BackendServiceConfig: BackendServiceConfig{
@@ -773,6 +846,19 @@ func TestBackendService_validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "deployment":`,
 		},
+		"error if deployment validation sends requests": {
+			config: BackendService{
+				BackendServiceConfig: BackendServiceConfig{
+					ImageConfig: testImageConfig,
+					DeployConfig: DeploymentConfig{
+						Validation: DeploymentValidation{
+							Path: aws.String("/healthz"),
+						},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`"deployment.validation.path" is not supported for Backend Services because they are not reachable from outside the VPC: use "deployment.validation.command" instead`),
+		},
 		"error if fail to validate http": {
 			config: BackendService{
 				BackendServiceConfig: BackendServiceConfig{
@@ -854,6 +940,117 @@ func TestBackendService_validate(t *testing.T) {
 			},
 			wantedError: fmt.Errorf(`cannot set "network.connect.alias" when no ports are exposed`),
 		},
+		"error if a service connect port is not exposed by any container": {
+			config: BackendService{
+				BackendServiceConfig: BackendServiceConfig{
+					ImageConfig: ImageWithHealthcheckAndOptionalPort{
+						ImageWithOptionalPort: ImageWithOptionalPort{
+							Image: testImageConfig.Image,
+							Port:  aws.Uint16(8080),
+						},
+					},
+					Network: NetworkConfig{
+						Connect: ServiceConnectBoolOrArgs{
+							ServiceConnectArgs: ServiceConnectArgs{
+								Ports: []ServiceConnectPort{
+									{Port: aws.Uint16(50051), Alias: aws.String("api-grpc")},
+								},
+							},
+						},
+					},
+				},
+				Workload: Workload{
+					Name: aws.String("api"),
+				},
+			},
+			wantedError: fmt.Errorf(`validate "network.connect.ports[0]": port 50051 is not exposed by any container`),
+		},
+		"error if a service connect port is the default service connect target": {
+			config: BackendService{
+				BackendServiceConfig: BackendServiceConfig{
+					ImageConfig: ImageWithHealthcheckAndOptionalPort{
+						ImageWithOptionalPort: ImageWithOptionalPort{
+							Image: testImageConfig.Image,
+							Port:  aws.Uint16(8080),
+						},
+					},
+					Network: NetworkConfig{
+						Connect: ServiceConnectBoolOrArgs{
+							ServiceConnectArgs: ServiceConnectArgs{
+								Ports: []ServiceConnectPort{
+									{Port: aws.Uint16(8080), Alias: aws.String("api-http")},
+								},
+							},
+						},
+					},
+				},
+				Workload: Workload{
+					Name: aws.String("api"),
+				},
+			},
+			wantedError: fmt.Errorf(`validate "network.connect.ports[0]": port 8080 is already exposed to Service Connect by "network.connect.alias"`),
+		},
+		"error if a service connect port is exposed over UDP": {
+			config: BackendService{
+				BackendServiceConfig: BackendServiceConfig{
+					ImageConfig: ImageWithHealthcheckAndOptionalPort{
+						ImageWithOptionalPort: ImageWithOptionalPort{
+							Image: testImageConfig.Image,
+							Port:  aws.Uint16(8080),
+						},
+					},
+					Sidecars: map[string]*SidecarConfig{
+						"statsd": {
+							Port:  aws.String("8125/udp"),
+							Image: BasicToUnion[*string, ImageLocationOrBuild](aws.String("statsd")),
+						},
+					},
+					Network: NetworkConfig{
+						Connect: ServiceConnectBoolOrArgs{
+							ServiceConnectArgs: ServiceConnectArgs{
+								Ports: []ServiceConnectPort{
+									{Port: aws.Uint16(8125), Alias: aws.String("api-metrics")},
+								},
+							},
+						},
+					},
+				},
+				Workload: Workload{
+					Name: aws.String("api"),
+				},
+			},
+			wantedError: fmt.Errorf(`validate "network.connect.ports[0]": port 8125 is exposed over UDP, which Service Connect doesn't support`),
+		},
+		"valid service connect ports exposed by sidecars": {
+			config: BackendService{
+				BackendServiceConfig: BackendServiceConfig{
+					ImageConfig: ImageWithHealthcheckAndOptionalPort{
+						ImageWithOptionalPort: ImageWithOptionalPort{
+							Image: testImageConfig.Image,
+							Port:  aws.Uint16(8080),
+						},
+					},
+					Sidecars: map[string]*SidecarConfig{
+						"grpc": {
+							Port:  aws.String("50051"),
+							Image: BasicToUnion[*string, ImageLocationOrBuild](aws.String("grpc")),
+						},
+					},
+					Network: NetworkConfig{
+						Connect: ServiceConnectBoolOrArgs{
+							ServiceConnectArgs: ServiceConnectArgs{
+								Ports: []ServiceConnectPort{
+									{Port: aws.Uint16(50051), Alias: aws.String("api-grpc"), ClientPort: aws.Uint16(443)},
+								},
+							},
+						},
+					},
+				},
+				Workload: Workload{
+					Name: aws.String("api"),
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -963,12 +1160,121 @@ func TestRequestDrivenWebService_validate(t *testing.T) {
 						Port: uint16P(80),
 					},
 					Observability: Observability{
-						Tracing: aws.String("unknown-vendor"),
+						Tracing: BasicToUnion[*string, TracingConfig](aws.String("unknown-vendor")),
 					},
 				},
 			},
 			wantedErrorMsgPrefix: `validate "observability": `,
 		},
+		"error if tracing vendor is otel": {
+			config: RequestDrivenWebService{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
+					ImageConfig: ImageWithPort{
+						Image: Image{
+							ImageLocationOrBuild: ImageLocationOrBuild{
+								Location: stringP("mockLocation"),
+							},
+						},
+						Port: uint16P(80),
+					},
+					Observability: Observability{
+						Tracing: BasicToUnion[*string, TracingConfig](aws.String("otel")),
+					},
+				},
+			},
+			wantedErrorMsgPrefix: `tracing vendor "otel" is not supported for Request-Driven Web Service`,
+		},
+		"error if a secret is also defined as a variable": {
+			config: RequestDrivenWebService{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
+					ImageConfig: ImageWithPort{
+						Image: Image{
+							ImageLocationOrBuild: ImageLocationOrBuild{
+								Location: stringP("mockLocation"),
+							},
+						},
+						Port: uint16P(80),
+					},
+					Variables: map[string]Variable{
+						"DB_PASSWORD": {StringOrFromCFN{Plain: aws.String("hunter2")}},
+					},
+					Secrets: map[string]Secret{
+						"DB_PASSWORD": {from: StringOrFromCFN{Plain: aws.String("/copilot/app/env/secrets/db")}},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`secret "DB_PASSWORD" cannot also be defined in "variables"`),
+		},
+		"error if a secret is the ARN of an unsupported service": {
+			config: RequestDrivenWebService{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
+					ImageConfig: ImageWithPort{
+						Image: Image{
+							ImageLocationOrBuild: ImageLocationOrBuild{
+								Location: stringP("mockLocation"),
+							},
+						},
+						Port: uint16P(80),
+					},
+					Secrets: map[string]Secret{
+						"TOKEN": {from: StringOrFromCFN{Plain: aws.String("arn:aws:s3:::mybucket/token")}},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`secret "TOKEN" must be the ARN of an SSM parameter or a Secrets Manager secret, got "arn:aws:s3:::mybucket/token"`),
+		},
+		"error if env file does not have the .env extension": {
+			config: RequestDrivenWebService{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
+					ImageConfig: ImageWithPort{
+						Image: Image{
+							ImageLocationOrBuild: ImageLocationOrBuild{
+								Location: stringP("mockLocation"),
+							},
+						},
+						Port: uint16P(80),
+					},
+					EnvFile: aws.String("vars.txt"),
+				},
+			},
+			wantedError: fmt.Errorf("environment file vars.txt must have a .env file extension"),
+		},
+		"success with secrets from SSM and Secrets Manager": {
+			config: RequestDrivenWebService{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
+					ImageConfig: ImageWithPort{
+						Image: Image{
+							ImageLocationOrBuild: ImageLocationOrBuild{
+								Location: stringP("mockLocation"),
+							},
+						},
+						Port: uint16P(80),
+					},
+					Secrets: map[string]Secret{
+						"GITHUB_TOKEN": {from: StringOrFromCFN{Plain: aws.String("GH_TOKEN")}},
+						"API_KEY":      {from: StringOrFromCFN{Plain: aws.String("arn:aws:ssm:us-east-1:123456789012:parameter/api-key")}},
+						"DB_USERNAME":  {from: StringOrFromCFN{Plain: aws.String("arn:aws:secretsmanager:us-east-1:123456789012:secret:db-AbCdEf:username::")}},
+						"DB_PASSWORD":  {fromSecretsManager: secretsManagerSecret{Name: aws.String("db:password::")}},
+					},
+					EnvFile: aws.String("./common.env"),
+				},
+			},
+		},
 		"error if name is not set": {
 			config: RequestDrivenWebService{
 				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
@@ -1056,6 +1362,17 @@ func TestWorkerService_validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "image": `,
 		},
+		"error if fail to validate observability": {
+			config: WorkerService{
+				WorkerServiceConfig: WorkerServiceConfig{
+					ImageConfig: testImageConfig,
+					Observability: Observability{
+						Tracing: BasicToUnion[*string, TracingConfig](aws.String("unknown-vendor")),
+					},
+				},
+			},
+			wantedErrorMsgPrefix: `validate "observability": `,
+		},
 		"error if fail to validate sidecars": {
 			config: WorkerService{
 				WorkerServiceConfig: WorkerServiceConfig{
@@ -1239,18 +1556,58 @@ func TestWorkerService_validate(t *testing.T) {
 			},
 			wantedError: fmt.Errorf(`cannot set "network.connect.alias" when no ports are exposed`),
 		},
-	}
-	for name, tc := range testCases {
-		t.Run(name, func(t *testing.T) {
-			gotErr := tc.config.validate()
-
-			if tc.wantedError != nil {
-				require.EqualError(t, gotErr, tc.wantedError.Error())
-				return
-			}
-			if tc.wantedErrorMsgPrefix != "" {
-				require.Error(t, gotErr)
-				require.Contains(t, gotErr.Error(), tc.wantedErrorMsgPrefix)
+		"error if service connect ports are set without any port exposed": {
+			config: WorkerService{
+				WorkerServiceConfig: WorkerServiceConfig{
+					ImageConfig: testImageConfig,
+					Network: NetworkConfig{
+						Connect: ServiceConnectBoolOrArgs{
+							ServiceConnectArgs: ServiceConnectArgs{
+								Ports: []ServiceConnectPort{
+									{Port: aws.Uint16(50051), Alias: aws.String("worker-grpc")},
+								},
+							},
+						},
+					},
+				},
+				Workload: Workload{
+					Name: aws.String("api"),
+				},
+			},
+			wantedError: fmt.Errorf(`cannot set "network.connect.ports" when no ports are exposed`),
+		},
+		"error if service connect timeouts are set without any port exposed": {
+			config: WorkerService{
+				WorkerServiceConfig: WorkerServiceConfig{
+					ImageConfig: testImageConfig,
+					Network: NetworkConfig{
+						Connect: ServiceConnectBoolOrArgs{
+							ServiceConnectArgs: ServiceConnectArgs{
+								Timeout: ServiceConnectTimeout{
+									Idle: durationp(30 * time.Second),
+								},
+							},
+						},
+					},
+				},
+				Workload: Workload{
+					Name: aws.String("api"),
+				},
+			},
+			wantedError: fmt.Errorf(`cannot set "network.connect.timeout" when no ports are exposed`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := tc.config.validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, gotErr, tc.wantedError.Error())
+				return
+			}
+			if tc.wantedErrorMsgPrefix != "" {
+				require.Error(t, gotErr)
+				require.Contains(t, gotErr.Error(), tc.wantedErrorMsgPrefix)
 				return
 			}
 			require.NoError(t, gotErr)
@@ -1342,6 +1699,34 @@ func TestScheduledJob_validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "publish": `,
 		},
+		"error if fail to validate alerts": {
+			config: ScheduledJob{
+				ScheduledJobConfig: ScheduledJobConfig{
+					ImageConfig: testImageConfig,
+					On: JobTriggerConfig{
+						Schedule: aws.String("mockSchedule"),
+					},
+					Alerts: JobAlerts{
+						Emails: []string{"ops@example.com"},
+					},
+				},
+			},
+			wantedErrorMsgPrefix: `validate "alerts": `,
+		},
+		"error if alerts are set on a job without a schedule": {
+			config: ScheduledJob{
+				ScheduledJobConfig: ScheduledJobConfig{
+					ImageConfig: testImageConfig,
+					On: JobTriggerConfig{
+						Schedule: aws.String("none"),
+					},
+					Alerts: JobAlerts{
+						FailuresThreshold: aws.Int(3),
+					},
+				},
+			},
+			wantedError: errors.New(`"alerts" can only be specified when the job runs on a schedule with "on.schedule"`),
+		},
 		"error if fail to validate taskdef override": {
 			config: ScheduledJob{
 				ScheduledJobConfig: ScheduledJobConfig{
@@ -1449,6 +1834,28 @@ func TestPipelineManifest_validate(t *testing.T) {
 			},
 			wantedError: errors.New("pipeline name '12345678902234567890323456789042345678905234567890623456789072345678908234567890923456789010234567890' must be shorter than 100 characters"),
 		},
+		"error if the trigger is neither manual nor a schedule": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Trigger: PipelineTrigger{
+					Union: BasicToUnion[string, PipelineTriggerConfig]("weekly"),
+				},
+			},
+			wantedError: errors.New(`validate "trigger": value must be "manual" or specify a "schedule"`),
+		},
+		"error if the artifact key is not the ARN of a KMS key": {
+			Pipeline: Pipeline{
+				Name:              "release",
+				ArtifactKMSKeyARN: "arn:aws:kms:us-west-2:123456789012:alias/pipeline",
+			},
+			wantedError: errors.New(`"artifact_kms_key_arn" must be the ARN of a KMS key, got "arn:aws:kms:us-west-2:123456789012:alias/pipeline"`),
+		},
+		"valid with the ARN of a KMS key to encrypt the artifacts": {
+			Pipeline: Pipeline{
+				Name:              "release",
+				ArtifactKMSKeyARN: "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+			},
+		},
 		"should validate pipeline stages": {
 			Pipeline: Pipeline{
 				Name: "release",
@@ -1483,6 +1890,85 @@ func TestPipelineManifest_validate(t *testing.T) {
 			},
 			wantedError: errors.New(`validate stage "test" for pipeline "release": "buildspec" must be specified`),
 		},
+		"should not allow both buildspec and run_task for a pre-deployment": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Stages: []PipelineStage{
+					{
+						Name: "test",
+						PreDeployments: PrePostDeployments{
+							"migrate": &PrePostDeployment{
+								BuildspecPath: "copilot/pipelines/my-pipeline/buildspecs/migration.yml",
+								RunTask: &PrePostDeployTask{
+									Image: "migrate:v1",
+								},
+							},
+						},
+					},
+				},
+			},
+			wantedError: errors.New(`validate stage "test" for pipeline "release": must specify one, not both, of "buildspec" and "run_task"`),
+		},
+		"should validate image exists for run_task": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Stages: []PipelineStage{
+					{
+						Name: "test",
+						PreDeployments: PrePostDeployments{
+							"migrate": &PrePostDeployment{
+								RunTask: &PrePostDeployTask{
+									Command: "./migrate up",
+								},
+							},
+						},
+					},
+				},
+			},
+			wantedError: errors.New(`validate stage "test" for pipeline "release": validate "run_task": "image" must be specified`),
+		},
+		"should not allow commas in run_task variable values": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Stages: []PipelineStage{
+					{
+						Name: "test",
+						PostDeployments: PrePostDeployments{
+							"smoke": &PrePostDeployment{
+								RunTask: &PrePostDeployTask{
+									Image: "smoke:v1",
+									Variables: map[string]string{
+										"HOSTS": "a,b",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantedError: errors.New(`validate stage "test" for pipeline "release": validate "run_task": value of variable "HOSTS" cannot contain ","`),
+		},
+		"valid with a run_task pre-deployment": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Stages: []PipelineStage{
+					{
+						Name: "test",
+						PreDeployments: PrePostDeployments{
+							"migrate": &PrePostDeployment{
+								RunTask: &PrePostDeployTask{
+									Image:   "migrate:v1",
+									Command: "./migrate up",
+									Variables: map[string]string{
+										"LOG_LEVEL": "debug",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		"should validate pipeline deployments": {
 			Pipeline: Pipeline{
 				Name: "release",
@@ -1617,6 +2103,47 @@ func TestImage_validate(t *testing.T) {
 
 			wantedErrorMsgPrefix: `validate "depends_on":`,
 		},
+		"error if tags are specified without build": {
+			Image: Image{
+				ImageLocationOrBuild: ImageLocationOrBuild{
+					Location: aws.String("mockLocation"),
+				},
+				Tags: []string{"latest"},
+			},
+			wantedError: fmt.Errorf(`"build" must be specified if "tags" is specified`),
+		},
+		"error if a tag uses an unknown placeholder": {
+			Image: Image{
+				ImageLocationOrBuild: ImageLocationOrBuild{
+					Build: BuildArgsOrString{
+						BuildString: aws.String("mockBuild"),
+					},
+				},
+				Tags: []string{"latest", "${branch}"},
+			},
+			wantedError: fmt.Errorf(`validate "tags[1]": unknown placeholder "${branch}"`),
+		},
+		"error if a tag is invalid": {
+			Image: Image{
+				ImageLocationOrBuild: ImageLocationOrBuild{
+					Build: BuildArgsOrString{
+						BuildString: aws.String("mockBuild"),
+					},
+				},
+				Tags: []string{"release/${env}"},
+			},
+			wantedError: fmt.Errorf(`validate "tags[0]": "release/${env}" is not a valid tag: tags must be at most 128 characters among letters, digits, "_", "." and "-", and must not start with "." or "-"`),
+		},
+		"success with tag templates": {
+			Image: Image{
+				ImageLocationOrBuild: ImageLocationOrBuild{
+					Build: BuildArgsOrString{
+						BuildString: aws.String("mockBuild"),
+					},
+				},
+				Tags: []string{"latest", "${env}", "${git_branch}-${git_commit}"},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -1698,6 +2225,62 @@ func TestRoutingRule_validate(t *testing.T) {
 				ProtocolVersion: aws.String("gRPC"),
 			},
 		},
+		"error if stickiness type is not valid": {
+			RoutingRule: RoutingRule{
+				Path: stringP("/"),
+				Stickiness: AdvancedToUnion[*bool](StickinessConfig{
+					Type: aws.String("source_ip"),
+				}),
+			},
+			wantedError: fmt.Errorf(`validate "stickiness": "type" field value 'source_ip' must be one of lb_cookie or app_cookie`),
+		},
+		"error if stickiness cookie_name is missing for app_cookie": {
+			RoutingRule: RoutingRule{
+				Path: stringP("/"),
+				Stickiness: AdvancedToUnion[*bool](StickinessConfig{
+					Type: aws.String("app_cookie"),
+				}),
+			},
+			wantedError: fmt.Errorf(`validate "stickiness": "cookie_name" must be specified if "type" is "app_cookie"`),
+		},
+		"error if stickiness cookie_name is specified for lb_cookie": {
+			RoutingRule: RoutingRule{
+				Path: stringP("/"),
+				Stickiness: AdvancedToUnion[*bool](StickinessConfig{
+					CookieName: aws.String("SESSIONID"),
+				}),
+			},
+			wantedError: fmt.Errorf(`validate "stickiness": "cookie_name" can only be specified if "type" is "app_cookie"`),
+		},
+		"error if stickiness cookie_name uses the reserved prefix": {
+			RoutingRule: RoutingRule{
+				Path: stringP("/"),
+				Stickiness: AdvancedToUnion[*bool](StickinessConfig{
+					Type:       aws.String("app_cookie"),
+					CookieName: aws.String("AWSALBAPP-0"),
+				}),
+			},
+			wantedError: fmt.Errorf(`validate "stickiness": "cookie_name" "AWSALBAPP-0" cannot start with the reserved prefix "AWSALB"`),
+		},
+		"error if stickiness duration is out of range": {
+			RoutingRule: RoutingRule{
+				Path: stringP("/"),
+				Stickiness: AdvancedToUnion[*bool](StickinessConfig{
+					Duration: durationp(8 * 24 * time.Hour),
+				}),
+			},
+			wantedError: fmt.Errorf(`validate "stickiness": "duration" 192h0m0s must be between 1s and 168h`),
+		},
+		"should not error if app_cookie stickiness is configured correctly": {
+			RoutingRule: RoutingRule{
+				Path: stringP("/"),
+				Stickiness: AdvancedToUnion[*bool](StickinessConfig{
+					Type:       aws.String("app_cookie"),
+					CookieName: aws.String("SESSIONID"),
+					Duration:   durationp(time.Hour),
+				}),
+			},
+		},
 		"error if hosted zone set without alias": {
 			RoutingRule: RoutingRule{
 				Path:       stringP("/"),
@@ -1796,6 +2379,16 @@ func TestHTTP_validate(t *testing.T) {
 			},
 			wantedError: fmt.Errorf(`must specify one, not both, of "target_container" and "targetContainer"`),
 		},
+		"error if mutual_tls is enabled with an imported load balancer": {
+			HTTP: HTTP{
+				ImportedALB: aws.String("my-alb"),
+				MutualTLS:   aws.Bool(true),
+				Main: RoutingRule{
+					Path: stringP("/"),
+				},
+			},
+			wantedError: fmt.Errorf(`"mutual_tls" cannot be enabled with an imported load balancer "alb": configure mutual TLS on the listeners of my-alb instead`),
+		},
 		"error if the main routing rule is invalid": {
 			HTTP: HTTP{
 				Main: RoutingRule{
@@ -1818,6 +2411,118 @@ func TestHTTP_validate(t *testing.T) {
 			},
 			wantedError: fmt.Errorf(`validate "additional_rules[0]": "path" must be specified`),
 		},
+		"error if rules to the same target enable different stickiness": {
+			HTTP: HTTP{
+				Main: RoutingRule{
+					Path:       stringP("/"),
+					Stickiness: BasicToUnion[*bool, StickinessConfig](aws.Bool(true)),
+				},
+				AdditionalRoutingRules: []RoutingRule{
+					{
+						Path: stringP("/admin"),
+					},
+					{
+						Path: stringP("/api"),
+						Stickiness: AdvancedToUnion[*bool](StickinessConfig{
+							Type:       aws.String("app_cookie"),
+							CookieName: aws.String("SESSIONID"),
+						}),
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`"http.additional_rules[1].stickiness" conflicts with "http.stickiness": rules that route to the same target container and port must use the same sticky sessions configuration`),
+		},
+		"should not error if rules to different targets enable different stickiness": {
+			HTTP: HTTP{
+				Main: RoutingRule{
+					Path:       stringP("/"),
+					Stickiness: BasicToUnion[*bool, StickinessConfig](aws.Bool(true)),
+				},
+				AdditionalRoutingRules: []RoutingRule{
+					{
+						Path:            stringP("/api"),
+						TargetContainer: aws.String("api"),
+						Stickiness: AdvancedToUnion[*bool](StickinessConfig{
+							Type:       aws.String("app_cookie"),
+							CookieName: aws.String("SESSIONID"),
+						}),
+					},
+				},
+			},
+		},
+		"error if cdn is enabled with an imported load balancer": {
+			HTTP: HTTP{
+				ImportedALB: aws.String("my-alb"),
+				Main: RoutingRule{
+					Path: stringP("/"),
+				},
+				CDN: BasicToUnion[*bool, ServiceCDNConfig](aws.Bool(true)),
+			},
+			wantedError: fmt.Errorf(`"cdn" cannot be enabled with an imported load balancer "alb": place a CloudFront distribution in front of my-alb instead`),
+		},
+		"error if a cdn cache policy is missing the path": {
+			HTTP: HTTP{
+				Main: RoutingRule{
+					Path: stringP("/"),
+				},
+				CDN: AdvancedToUnion[*bool](ServiceCDNConfig{
+					CachePolicies: []CDNCachePolicy{
+						{
+							Policy: aws.String("CachingOptimized"),
+						},
+					},
+				}),
+			},
+			wantedError: fmt.Errorf(`validate "cdn": validate "cache_policies[0]": "path" must be specified`),
+		},
+		"error if a cdn cache policy is unknown": {
+			HTTP: HTTP{
+				Main: RoutingRule{
+					Path: stringP("/"),
+				},
+				CDN: AdvancedToUnion[*bool](ServiceCDNConfig{
+					CachePolicies: []CDNCachePolicy{
+						{
+							Path:   aws.String("/static/*"),
+							Policy: aws.String("CachingEverything"),
+						},
+					},
+				}),
+			},
+			wantedError: fmt.Errorf(`validate "cdn": validate "cache_policies[0]": "policy" "CachingEverything" must be the name of a managed cache policy or the ID of a cache policy`),
+		},
+		"error if the cdn certificate is specified without aliases": {
+			HTTP: HTTP{
+				Main: RoutingRule{
+					Path: stringP("/"),
+				},
+				CDN: AdvancedToUnion[*bool](ServiceCDNConfig{
+					Certificate: aws.String("arn:aws:acm:us-east-1:123456789012:certificate/abc"),
+				}),
+			},
+			wantedError: fmt.Errorf(`validate "cdn": "aliases" must be specified if "certificate" is specified`),
+		},
+		"should not error if the cdn is configured": {
+			HTTP: HTTP{
+				Main: RoutingRule{
+					Path: stringP("/"),
+				},
+				CDN: AdvancedToUnion[*bool](ServiceCDNConfig{
+					CachePolicies: []CDNCachePolicy{
+						{
+							Path:   aws.String("/static/*"),
+							Policy: aws.String("CachingOptimized"),
+						},
+						{
+							Path:   aws.String("/api/*"),
+							Policy: aws.String("4cc15a8a-d715-48a4-82b8-cc0b614638fe"),
+						},
+					},
+					OriginShield: aws.String("us-west-2"),
+					Aliases:      []string{"cdn.example.com"},
+				}),
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -1992,18 +2697,50 @@ func TestNetworkLoadBalancerConfiguration_validate(t *testing.T) {
 				},
 			},
 		},
-		"error if tcp_udp": {
+		"success if alpn is specified with tls": {
 			nlb: NetworkLoadBalancerConfiguration{
 				Listener: NetworkLoadBalancerListener{
-					Port: aws.String("443/TCP_udp"),
+					Port:      aws.String("443/tls"),
+					SSLPolicy: aws.String("ELBSecurityPolicy-TLS13-1-2-Res-2021-06"),
+					ALPN:      aws.String("HTTP2Preferred"),
 				},
 			},
-			wantedError: fmt.Errorf(`validate "port": invalid protocol TCP_udp; valid protocols include TCP, UDP and TLS`),
 		},
-		"error if tcp_udp in additional listeners": {
+		"error if alpn is not recognized": {
 			nlb: NetworkLoadBalancerConfiguration{
 				Listener: NetworkLoadBalancerListener{
-					Port: aws.String("443/tcp"),
+					Port: aws.String("443/tls"),
+					ALPN: aws.String("h2"),
+				},
+			},
+			wantedError: fmt.Errorf(`validate "alpn": value 'h2' must be one of HTTP1Only, HTTP2Only, HTTP2Optional, HTTP2Preferred or None`),
+		},
+		"error if alpn is specified without tls in additional listeners": {
+			nlb: NetworkLoadBalancerConfiguration{
+				Listener: NetworkLoadBalancerListener{
+					Port: aws.String("443/tls"),
+				},
+				AdditionalListeners: []NetworkLoadBalancerListener{
+					{
+						Port: aws.String("8080/tcp"),
+						ALPN: aws.String("HTTP2Only"),
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "additional_listeners[0]": validate "alpn": "alpn" can only be specified if the protocol of "port" is TLS`),
+		},
+		"error if tcp_udp": {
+			nlb: NetworkLoadBalancerConfiguration{
+				Listener: NetworkLoadBalancerListener{
+					Port: aws.String("443/TCP_udp"),
+				},
+			},
+			wantedError: fmt.Errorf(`validate "port": invalid protocol TCP_udp; valid protocols include TCP, UDP and TLS`),
+		},
+		"error if tcp_udp in additional listeners": {
+			nlb: NetworkLoadBalancerConfiguration{
+				Listener: NetworkLoadBalancerListener{
+					Port: aws.String("443/tcp"),
 				},
 				AdditionalListeners: []NetworkLoadBalancerListener{
 					{
@@ -2137,6 +2874,35 @@ func TestTaskConfig_validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "platform": `,
 		},
+		"error if the credential spec is not an S3 object or an SSM parameter": {
+			TaskConfig: TaskConfig{
+				Platform: PlatformArgsOrString{
+					PlatformString: (*PlatformString)(aws.String("windows/x86_64")),
+				},
+				Windows: WindowsConfig{
+					CredentialSpec: aws.String("arn:aws:secretsmanager:us-west-2:123456789012:secret:gmsa"),
+				},
+			},
+			wantedError: errors.New(`validate "windows": "credential_spec" must be the ARN of an S3 object or an SSM parameter, such as "arn:aws:s3:::my-bucket/gmsa-credspec.json"`),
+		},
+		"error if windows is specified without a Windows platform": {
+			TaskConfig: TaskConfig{
+				Windows: WindowsConfig{
+					CredentialSpec: aws.String("arn:aws:s3:::my-bucket/gmsa-credspec.json"),
+				},
+			},
+			wantedError: errors.New(`"windows" can only be specified with a Windows "platform"`),
+		},
+		"valid credential spec in an SSM parameter": {
+			TaskConfig: TaskConfig{
+				Platform: PlatformArgsOrString{
+					PlatformString: (*PlatformString)(aws.String("windows/x86_64")),
+				},
+				Windows: WindowsConfig{
+					CredentialSpec: aws.String("arn:aws:ssm:us-west-2:123456789012:parameter/gmsa-credspec"),
+				},
+			},
+		},
 		"error if fail to validate count": {
 			TaskConfig: TaskConfig{
 				Count: Count{
@@ -2386,6 +3152,31 @@ func TestAdvancedCount_validate(t *testing.T) {
 				workloadType: manifestinfo.BackendServiceType,
 			},
 		},
+		"error if spot is negative": {
+			AdvancedCount: AdvancedCount{
+				Spot:         aws.Int(-1),
+				workloadType: manifestinfo.BackendServiceType,
+			},
+			wantedError: errors.New(`"spot" must be greater than or equal to 0, got -1`),
+		},
+		"valid if spot is 0": {
+			AdvancedCount: AdvancedCount{
+				Spot:         aws.Int(0),
+				workloadType: manifestinfo.BackendServiceType,
+			},
+		},
+		"valid if the range starts at 0": {
+			AdvancedCount: AdvancedCount{
+				Range: Range{
+					Value: (*IntRangeBand)(aws.String("0-10")),
+				},
+				QueueScaling: QueueScaling{
+					AcceptableLatency: durationp(10 * time.Second),
+					AvgProcessingTime: durationp(1 * time.Second),
+				},
+				workloadType: manifestinfo.WorkerServiceType,
+			},
+		},
 		"valid when range and and at least one autoscaling fields are specified": {
 			AdvancedCount: AdvancedCount{
 				Range: Range{
@@ -2553,6 +3344,37 @@ func TestAdvancedCount_validate(t *testing.T) {
 	}
 }
 
+func TestCount_validate(t *testing.T) {
+	testCases := map[string]struct {
+		in Count
+
+		wantedError error
+	}{
+		"valid if count is 0": {
+			in: Count{
+				Value: aws.Int(0),
+			},
+		},
+		"error if count is negative": {
+			in: Count{
+				Value: aws.Int(-2),
+			},
+			wantedError: errors.New("count must be greater than or equal to 0, got -2"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := tc.in.validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, gotErr, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
 func TestPercentage_validate(t *testing.T) {
 	testCases := map[string]struct {
 		in     Percentage
@@ -3009,6 +3831,141 @@ func TestNetworkConfig_validate(t *testing.T) {
 			},
 			wantedErrorPrefix: `validate "vpc": `,
 		},
+		"error if from_services contains an empty name": {
+			config: NetworkConfig{
+				Ingress: NetworkIngressConfig{
+					FromServices: []string{"frontend", ""},
+				},
+			},
+			wantedErrorPrefix: `validate "ingress": "from_services" cannot contain empty names`,
+		},
+		"error if from_services contains a duplicate service": {
+			config: NetworkConfig{
+				Ingress: NetworkIngressConfig{
+					FromServices: []string{"frontend", "api", "frontend"},
+				},
+			},
+			wantedErrorPrefix: `validate "ingress": "from_services" contains duplicate service frontend`,
+		},
+		"success with from_services": {
+			config: NetworkConfig{
+				Ingress: NetworkIngressConfig{
+					FromServices: []string{"frontend", "api"},
+				},
+			},
+		},
+		"error if a service connect port doesn't have an alias": {
+			config: NetworkConfig{
+				Connect: ServiceConnectBoolOrArgs{
+					ServiceConnectArgs: ServiceConnectArgs{
+						Ports: []ServiceConnectPort{
+							{Port: aws.Uint16(50051)},
+						},
+					},
+				},
+			},
+			wantedErrorPrefix: `validate "connect": validate "ports[0]": "alias" must be specified`,
+		},
+		"error if a service connect port doesn't have a port": {
+			config: NetworkConfig{
+				Connect: ServiceConnectBoolOrArgs{
+					ServiceConnectArgs: ServiceConnectArgs{
+						Ports: []ServiceConnectPort{
+							{Alias: aws.String("api-grpc")},
+						},
+					},
+				},
+			},
+			wantedErrorPrefix: `validate "connect": validate "ports[0]": "port" must be specified`,
+		},
+		"error if a service connect client port is 0": {
+			config: NetworkConfig{
+				Connect: ServiceConnectBoolOrArgs{
+					ServiceConnectArgs: ServiceConnectArgs{
+						Ports: []ServiceConnectPort{
+							{Port: aws.Uint16(50051), Alias: aws.String("api-grpc"), ClientPort: aws.Uint16(0)},
+						},
+					},
+				},
+			},
+			wantedErrorPrefix: `validate "connect": validate "ports[0]": "client_port" must be greater than 0`,
+		},
+		"error if a port is exposed to service connect twice": {
+			config: NetworkConfig{
+				Connect: ServiceConnectBoolOrArgs{
+					ServiceConnectArgs: ServiceConnectArgs{
+						Ports: []ServiceConnectPort{
+							{Port: aws.Uint16(50051), Alias: aws.String("api-grpc")},
+							{Port: aws.Uint16(50051), Alias: aws.String("api-rpc")},
+						},
+					},
+				},
+			},
+			wantedErrorPrefix: `validate "connect": validate "ports[1]": port 50051 is already exposed to Service Connect`,
+		},
+		"error if a service connect port reuses the alias of the service": {
+			config: NetworkConfig{
+				Connect: ServiceConnectBoolOrArgs{
+					ServiceConnectArgs: ServiceConnectArgs{
+						Alias: aws.String("api"),
+						Ports: []ServiceConnectPort{
+							{Port: aws.Uint16(50051), Alias: aws.String("api")},
+						},
+					},
+				},
+			},
+			wantedErrorPrefix: `validate "connect": validate "ports[0]": alias "api" is already used`,
+		},
+		"error if a service connect timeout is negative": {
+			config: NetworkConfig{
+				Connect: ServiceConnectBoolOrArgs{
+					ServiceConnectArgs: ServiceConnectArgs{
+						Timeout: ServiceConnectTimeout{
+							Idle: durationp(-5 * time.Second),
+						},
+					},
+				},
+			},
+			wantedErrorPrefix: `validate "connect": validate "timeout": "idle" -5s must be a non-negative whole number of seconds`,
+		},
+		"error if a service connect timeout is not a whole number of seconds": {
+			config: NetworkConfig{
+				Connect: ServiceConnectBoolOrArgs{
+					ServiceConnectArgs: ServiceConnectArgs{
+						Timeout: ServiceConnectTimeout{
+							PerRequest: durationp(1500 * time.Millisecond),
+						},
+					},
+				},
+			},
+			wantedErrorPrefix: `validate "connect": validate "timeout": "per_request" 1.5s must be a non-negative whole number of seconds`,
+		},
+		"success with service connect timeouts": {
+			config: NetworkConfig{
+				Connect: ServiceConnectBoolOrArgs{
+					ServiceConnectArgs: ServiceConnectArgs{
+						Alias: aws.String("api"),
+						Timeout: ServiceConnectTimeout{
+							Idle:       durationp(0),
+							PerRequest: durationp(15 * time.Second),
+						},
+					},
+				},
+			},
+		},
+		"success with service connect ports": {
+			config: NetworkConfig{
+				Connect: ServiceConnectBoolOrArgs{
+					ServiceConnectArgs: ServiceConnectArgs{
+						Alias: aws.String("api"),
+						Ports: []ServiceConnectPort{
+							{Port: aws.Uint16(50051), Alias: aws.String("api-grpc")},
+							{Port: aws.Uint16(9090), Alias: aws.String("api-metrics"), ClientPort: aws.Uint16(80)},
+						},
+					},
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -3067,6 +4024,26 @@ func TestRdwsVpcConfig_validate(t *testing.T) {
 			},
 			wantedErrorPrefix: `validate "placement": `,
 		},
+		"error if connector and placement are both specified": {
+			config: rdwsVpcConfig{
+				Placement: PlacementArgOrString{
+					PlacementString: placementStringP(PrivateSubnetPlacement),
+				},
+				Connector: aws.String("shared"),
+			},
+			wantedErrorPrefix: `must specify one, not both, of "connector" and "placement"`,
+		},
+		"error if connector is empty": {
+			config: rdwsVpcConfig{
+				Connector: aws.String(""),
+			},
+			wantedErrorPrefix: `"connector" cannot be empty`,
+		},
+		"success with a connector": {
+			config: rdwsVpcConfig{
+				Connector: aws.String("shared"),
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -3111,7 +4088,8 @@ func TestVpcConfig_validate(t *testing.T) {
 
 func TestPlacementString_validate(t *testing.T) {
 	mockEmptyPlacement := PlacementString("")
-	mockInvalidPlacement := PlacementString("external")
+	mockInvalidPlacement := PlacementString("external-subnets")
+	mockSubnetGroupPlacement := PlacementString("data")
 	testCases := map[string]struct {
 		in     *PlacementString
 		wanted error
@@ -3122,7 +4100,10 @@ func TestPlacementString_validate(t *testing.T) {
 		},
 		"should return an error if placement is invalid": {
 			in:     &mockInvalidPlacement,
-			wanted: errors.New(`"placement" external must be one of public, private`),
+			wanted: errors.New(`"placement" external-subnets must be one of public, private, or the name of a subnet group`),
+		},
+		"valid with the name of a subnet group": {
+			in: &mockSubnetGroupPlacement,
 		},
 	}
 	for name, tc := range testCases {
@@ -3208,6 +4189,172 @@ func TestAppRunnerInstanceConfig_validate(t *testing.T) {
 	}
 }
 
+func TestCost_validate(t *testing.T) {
+	testCases := map[string]struct {
+		config      Cost
+		wantedError string
+	}{
+		"ok if cost is empty": {
+			config: Cost{},
+		},
+		"error if neither a budget nor anomaly detection is configured": {
+			config: Cost{
+				Emails: []string{"team@example.com"},
+			},
+			wantedError: `must specify at least one of "monthly_budget" or "anomaly_detection"`,
+		},
+		"error if the budget is not positive": {
+			config: Cost{
+				MonthlyBudget: aws.Float64(0),
+			},
+			wantedError: `"monthly_budget" must be greater than 0, got 0`,
+		},
+		"error if the alert threshold is set without a budget": {
+			config: Cost{
+				AnomalyDetection: aws.Bool(true),
+				AlertThreshold:   aws.Int(80),
+			},
+			wantedError: `"monthly_budget" must be specified if "alert_threshold" is specified`,
+		},
+		"error if the alert threshold is not positive": {
+			config: Cost{
+				MonthlyBudget:  aws.Float64(100),
+				AlertThreshold: aws.Int(-1),
+			},
+			wantedError: `"alert_threshold" must be greater than 0, got -1`,
+		},
+		"ok with a budget and anomaly detection": {
+			config: Cost{
+				MonthlyBudget:    aws.Float64(100.5),
+				AlertThreshold:   aws.Int(80),
+				AnomalyDetection: aws.Bool(true),
+				Emails:           []string{"team@example.com"},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := tc.config.validate()
+
+			if tc.wantedError != "" {
+				require.EqualError(t, gotErr, tc.wantedError)
+			} else {
+				require.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
+func TestAppConfig_validate(t *testing.T) {
+	testCases := map[string]struct {
+		config      AppConfig
+		wantedError string
+	}{
+		"ok if appconfig is empty": {
+			config: AppConfig{},
+		},
+		"error if no profiles are configured": {
+			config: AppConfig{
+				Port: aws.Uint16(2773),
+			},
+			wantedError: `"profiles" must be specified`,
+		},
+		"error if a profile name is empty": {
+			config: AppConfig{
+				Profiles: []string{""},
+			},
+			wantedError: `"profiles" must contain names between 1 and 128 characters, got ""`,
+		},
+		"error if two profiles only differ by punctuation": {
+			config: AppConfig{
+				Profiles: []string{"search-flags", "search_flags"},
+			},
+			wantedError: `"profiles" "search-flags" and "search_flags" must differ by at least one letter or digit`,
+		},
+		"error if the poll interval is not a whole number of seconds": {
+			config: AppConfig{
+				Profiles:     []string{"flags"},
+				PollInterval: durationp(1500 * time.Millisecond),
+			},
+			wantedError: `"poll_interval" must be a whole number of seconds greater than 0, got 1.5s`,
+		},
+		"error if the port is 0": {
+			config: AppConfig{
+				Profiles: []string{"flags"},
+				Port:     aws.Uint16(0),
+			},
+			wantedError: `"port" must be greater than 0`,
+		},
+		"ok with profiles, a poll interval and a port": {
+			config: AppConfig{
+				Profiles:     []string{"checkout", "search-flags"},
+				PollInterval: durationp(30 * time.Second),
+				Port:         aws.Uint16(2773),
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := tc.config.validate()
+
+			if tc.wantedError != "" {
+				require.EqualError(t, gotErr, tc.wantedError)
+			} else {
+				require.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
+func Test_validateAppConfigAgent(t *testing.T) {
+	testCases := map[string]struct {
+		appConfig         AppConfig
+		mainContainerPort *uint16
+		sidecars          map[string]*SidecarConfig
+		wantedError       string
+	}{
+		"ok if appconfig is empty": {
+			sidecars: map[string]*SidecarConfig{
+				"appconfig-agent": {},
+			},
+		},
+		"error if a sidecar uses the name of the agent": {
+			appConfig: AppConfig{
+				Profiles: []string{"flags"},
+			},
+			sidecars: map[string]*SidecarConfig{
+				"appconfig-agent": {},
+			},
+			wantedError: `sidecar name "appconfig-agent" is reserved for the AppConfig agent when "appconfig" is configured`,
+		},
+		"error if the main container listens on the port of the agent": {
+			appConfig: AppConfig{
+				Profiles: []string{"flags"},
+			},
+			mainContainerPort: aws.Uint16(2772),
+			wantedError:       `"appconfig.port" 2772 is already used by the main container: set "appconfig.port" to a different port`,
+		},
+		"ok if the agent listens on another port": {
+			appConfig: AppConfig{
+				Profiles: []string{"flags"},
+				Port:     aws.Uint16(2773),
+			},
+			mainContainerPort: aws.Uint16(2772),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := validateAppConfigAgent(tc.appConfig, tc.mainContainerPort, tc.sidecars)
+
+			if tc.wantedError != "" {
+				require.EqualError(t, gotErr, tc.wantedError)
+			} else {
+				require.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
 func TestObservability_validate(t *testing.T) {
 	testCases := map[string]struct {
 		config            Observability
@@ -3215,13 +4362,77 @@ func TestObservability_validate(t *testing.T) {
 	}{
 		"error if tracing has invalid vendor": {
 			config: Observability{
-				Tracing: aws.String("unknown-vendor"),
+				Tracing: BasicToUnion[*string, TracingConfig](aws.String("unknown-vendor")),
 			},
 			wantedErrorPrefix: `invalid tracing vendor unknown-vendor: `,
 		},
 		"ok if tracing is aws-xray": {
 			config: Observability{
-				Tracing: aws.String("awsxray"),
+				Tracing: BasicToUnion[*string, TracingConfig](aws.String("awsxray")),
+			},
+		},
+		"error if advanced tracing has invalid vendor": {
+			config: Observability{
+				Tracing: AdvancedToUnion[*string](TracingConfig{
+					Vendor: aws.String("unknown-vendor"),
+				}),
+			},
+			wantedErrorPrefix: `invalid tracing vendor unknown-vendor: `,
+		},
+		"error if advanced tracing is missing the vendor": {
+			config: Observability{
+				Tracing: AdvancedToUnion[*string](TracingConfig{
+					SamplingRate: aws.Float64(0.5),
+				}),
+			},
+			wantedErrorPrefix: `validate "tracing": "vendor" must be specified`,
+		},
+		"error if sampling rate is out of range": {
+			config: Observability{
+				Tracing: AdvancedToUnion[*string](TracingConfig{
+					Vendor:       aws.String("awsxray"),
+					SamplingRate: aws.Float64(1.5),
+				}),
+			},
+			wantedErrorPrefix: `validate "tracing": "sampling_rate" must be between 0 and 1, got 1.5`,
+		},
+		"ok if advanced tracing has a sampling rate": {
+			config: Observability{
+				Tracing: AdvancedToUnion[*string](TracingConfig{
+					Vendor:       aws.String("awsxray"),
+					SamplingRate: aws.Float64(0.05),
+				}),
+			},
+		},
+		"error if a collector configuration is specified with another vendor": {
+			config: Observability{
+				Tracing: AdvancedToUnion[*string](TracingConfig{
+					Vendor:     aws.String("awsxray"),
+					ConfigFile: aws.String("otel/config.yml"),
+				}),
+			},
+			wantedErrorPrefix: `validate "tracing": "config_file" can only be specified when "vendor" is "otel"`,
+		},
+		"error if a sampling rate is specified with the otel vendor": {
+			config: Observability{
+				Tracing: AdvancedToUnion[*string](TracingConfig{
+					Vendor:       aws.String("otel"),
+					SamplingRate: aws.Float64(0.05),
+				}),
+			},
+			wantedErrorPrefix: `validate "tracing": "sampling_rate" cannot be specified when "vendor" is "otel"`,
+		},
+		"ok if tracing is otel": {
+			config: Observability{
+				Tracing: BasicToUnion[*string, TracingConfig](aws.String("otel")),
+			},
+		},
+		"ok if otel tracing has a collector configuration": {
+			config: Observability{
+				Tracing: AdvancedToUnion[*string](TracingConfig{
+					Vendor:     aws.String("otel"),
+					ConfigFile: aws.String("otel/config.yml"),
+				}),
 			},
 		},
 		"ok if observability is empty": {
@@ -3247,9 +4458,97 @@ func TestJobTriggerConfig_validate(t *testing.T) {
 		in     *JobTriggerConfig
 		wanted error
 	}{
-		"should return an error if schedule is empty": {
+		"should return an error if neither schedule nor queue is specified": {
 			in:     &JobTriggerConfig{},
-			wanted: errors.New(`"schedule" must be specified`),
+			wanted: errors.New(`must specify at least one of "schedule" or "queue"`),
+		},
+		"should return an error if the queue name is missing": {
+			in: &JobTriggerConfig{
+				Queue: JobQueueTrigger{
+					BatchSize: aws.Int(5),
+				},
+			},
+			wanted: errors.New(`validate "queue": "name" must be specified`),
+		},
+		"should return an error if the batch size is out of range": {
+			in: &JobTriggerConfig{
+				Queue: JobQueueTrigger{
+					Name:      aws.String("orders"),
+					BatchSize: aws.Int(11),
+				},
+			},
+			wanted: errors.New(`validate "queue": "batch_size" must be between 1 and 10, got 11`),
+		},
+		"should return an error if max concurrency is not positive": {
+			in: &JobTriggerConfig{
+				Queue: JobQueueTrigger{
+					Name:           aws.String("orders"),
+					MaxConcurrency: aws.Int(0),
+				},
+			},
+			wanted: errors.New(`validate "queue": "max_concurrency" must be greater than 0, got 0`),
+		},
+		"valid with only a queue": {
+			in: &JobTriggerConfig{
+				Queue: JobQueueTrigger{
+					Name:           aws.String("arn:aws:sqs:us-west-2:123456789012:orders"),
+					BatchSize:      aws.Int(10),
+					MaxConcurrency: aws.Int(2),
+				},
+			},
+		},
+		"valid with a schedule and a queue": {
+			in: &JobTriggerConfig{
+				Schedule: aws.String("@daily"),
+				Queue: JobQueueTrigger{
+					Name: aws.String("orders"),
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.in.validate()
+
+			if tc.wanted != nil {
+				require.EqualError(t, err, tc.wanted.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestJobAlerts_validate(t *testing.T) {
+	testCases := map[string]struct {
+		in     JobAlerts
+		wanted error
+	}{
+		"valid if empty": {},
+		"should return an error if the threshold is missing": {
+			in: JobAlerts{
+				Emails: []string{"ops@example.com"},
+			},
+			wanted: errors.New(`"failures_threshold" must be specified`),
+		},
+		"should return an error if the threshold is not positive": {
+			in: JobAlerts{
+				FailuresThreshold: aws.Int(0),
+			},
+			wanted: errors.New(`"failures_threshold" must be greater than 0, got 0`),
+		},
+		"should return an error if an email is invalid": {
+			in: JobAlerts{
+				FailuresThreshold: aws.Int(3),
+				Emails:            []string{"ops@example.com", "ops"},
+			},
+			wanted: errors.New(`"emails[1]" must be an email address, got "ops"`),
+		},
+		"valid": {
+			in: JobAlerts{
+				FailuresThreshold: aws.Int(3),
+				Emails:            []string{"ops@example.com"},
+			},
 		},
 	}
 	for name, tc := range testCases {
@@ -3382,17 +4681,200 @@ func TestSubscribeConfig_validate(t *testing.T) {
 					},
 				},
 			},
-			wantedErrorPrefix: `validate "topics[0]": `,
+			wantedErrorPrefix: `validate "topics[0]": `,
+		},
+		"error if fail to validate buckets": {
+			config: SubscribeConfig{
+				Buckets: []BucketSubscription{
+					{
+						Name: aws.String("uploads"),
+					},
+				},
+			},
+			wantedErrorPrefix: `validate "buckets[0]": `,
+		},
+		"error if buckets are subscribed with a FIFO queue": {
+			config: SubscribeConfig{
+				Buckets: []BucketSubscription{
+					{
+						Name:    aws.String("uploads"),
+						Service: aws.String("api"),
+					},
+				},
+				Queue: SQSQueue{
+					FIFO: FIFOAdvanceConfigOrBool{
+						Enable: aws.Bool(true),
+					},
+				},
+			},
+			wantedErrorPrefix: `must specify one, not both, of "buckets" and "queue.fifo"`,
+		},
+		"valid bucket subscriptions": {
+			config: SubscribeConfig{
+				Buckets: []BucketSubscription{
+					{
+						Name:    aws.String("uploads"),
+						Service: aws.String("api"),
+						Prefix:  aws.String("images/"),
+					},
+				},
+			},
+		},
+		"error if fail to validate kafka": {
+			config: SubscribeConfig{
+				Kafka: KafkaSubscription{
+					Topics: []string{"orders"},
+				},
+			},
+			wantedErrorPrefix: `validate "kafka": `,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := tc.config.validate()
+
+			if tc.wantedErrorPrefix != "" {
+				require.Contains(t, gotErr.Error(), tc.wantedErrorPrefix)
+			} else {
+				require.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
+func TestKafkaSubscription_validate(t *testing.T) {
+	const (
+		mockClusterARN = "arn:aws:kafka:us-west-2:123456789012:cluster/orders/1a2b3c4d-5e6f-7a8b-9c0d-1e2f3a4b5c6d-2"
+		mockBrokers    = "b-1.orders.abc123.c2.kafka.us-west-2.amazonaws.com:9098"
+	)
+	testCases := map[string]struct {
+		in     KafkaSubscription
+		wanted error
+	}{
+		"should not return an error if kafka is not configured": {
+			in: KafkaSubscription{},
+		},
+		"should return an error if cluster_arn is missing": {
+			in: KafkaSubscription{
+				BootstrapBrokers: StringOrFromCFN{Plain: aws.String(mockBrokers)},
+				Topics:           []string{"orders"},
+			},
+			wanted: errors.New(`"cluster_arn" must be specified`),
+		},
+		"should return an error if cluster_arn is not the ARN of a MSK cluster": {
+			in: KafkaSubscription{
+				ClusterARN:       StringOrFromCFN{Plain: aws.String("arn:aws:sqs:us-west-2:123456789012:orders")},
+				BootstrapBrokers: StringOrFromCFN{Plain: aws.String(mockBrokers)},
+				Topics:           []string{"orders"},
+			},
+			wanted: errors.New(`"cluster_arn" must be the ARN of an Amazon MSK cluster`),
+		},
+		"should return an error if the import name of cluster_arn is empty": {
+			in: KafkaSubscription{
+				ClusterARN:       StringOrFromCFN{FromCFN: fromCFN{Name: aws.String("")}},
+				BootstrapBrokers: StringOrFromCFN{Plain: aws.String(mockBrokers)},
+				Topics:           []string{"orders"},
+			},
+			wanted: errors.New(`validate "cluster_arn": name cannot be an empty string`),
+		},
+		"should return an error if bootstrap_brokers is missing": {
+			in: KafkaSubscription{
+				ClusterARN: StringOrFromCFN{Plain: aws.String(mockClusterARN)},
+				Topics:     []string{"orders"},
+			},
+			wanted: errors.New(`"bootstrap_brokers" must be specified`),
+		},
+		"should return an error if bootstrap_brokers is empty": {
+			in: KafkaSubscription{
+				ClusterARN:       StringOrFromCFN{Plain: aws.String(mockClusterARN)},
+				BootstrapBrokers: StringOrFromCFN{Plain: aws.String("")},
+				Topics:           []string{"orders"},
+			},
+			wanted: errors.New(`"bootstrap_brokers" cannot be an empty string`),
+		},
+		"should return an error if topics are missing": {
+			in: KafkaSubscription{
+				ClusterARN:       StringOrFromCFN{Plain: aws.String(mockClusterARN)},
+				BootstrapBrokers: StringOrFromCFN{Plain: aws.String(mockBrokers)},
+			},
+			wanted: errors.New(`"topics" must be specified`),
+		},
+		"should return an error if a topic name is invalid": {
+			in: KafkaSubscription{
+				ClusterARN:       StringOrFromCFN{Plain: aws.String(mockClusterARN)},
+				BootstrapBrokers: StringOrFromCFN{Plain: aws.String(mockBrokers)},
+				Topics:           []string{"orders", "pay ments"},
+			},
+			wanted: errors.New(`validate "topics[1]": topic name "pay ments" must be at most 249 characters long and contain only letters, numbers, periods, underscores, and hyphens`),
+		},
+		"should return an error if the consumer group is invalid": {
+			in: KafkaSubscription{
+				ClusterARN:       StringOrFromCFN{Plain: aws.String(mockClusterARN)},
+				BootstrapBrokers: StringOrFromCFN{Plain: aws.String(mockBrokers)},
+				Topics:           []string{"orders"},
+				ConsumerGroup:    aws.String("${billing}"),
+			},
+			wanted: errors.New(`consumer group "${billing}" must be at most 249 characters long and contain only letters, numbers, periods, underscores, and hyphens`),
+		},
+		"should not return an error if kafka is configured correctly": {
+			in: KafkaSubscription{
+				ClusterARN:       StringOrFromCFN{FromCFN: fromCFN{Name: aws.String("msk-stack-ClusterArn")}},
+				BootstrapBrokers: StringOrFromCFN{FromCFN: fromCFN{Name: aws.String("msk-stack-BootstrapBrokersIAM")}},
+				SecurityGroup:    StringOrFromCFN{Plain: aws.String("sg-0123456789abcdef0")},
+				Topics:           []string{"orders", "payments.v1"},
+				ConsumerGroup:    aws.String("billing"),
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.in.validate()
+			if tc.wanted != nil {
+				require.EqualError(t, err, tc.wanted.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBucketSubscription_validate(t *testing.T) {
+	testCases := map[string]struct {
+		in     BucketSubscription
+		wanted error
+	}{
+		"should return an error if bucket name is empty": {
+			in:     BucketSubscription{},
+			wanted: errors.New(`"name" must be specified`),
+		},
+		"should return an error if service is empty": {
+			in: BucketSubscription{
+				Name: aws.String("uploads"),
+			},
+			wanted: errors.New(`"service" must be specified`),
+		},
+		"should return an error if service is in invalid format": {
+			in: BucketSubscription{
+				Name:    aws.String("uploads"),
+				Service: aws.String("!!!!!"),
+			},
+			wanted: errors.New("service name must start with a letter, contain only lower-case letters, numbers, and hyphens, and have no consecutive or trailing hyphen"),
+		},
+		"should not return an error if bucket is configured correctly": {
+			in: BucketSubscription{
+				Name:    aws.String("uploads"),
+				Service: aws.String("api"),
+				Prefix:  aws.String("images/"),
+			},
 		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			gotErr := tc.config.validate()
-
-			if tc.wantedErrorPrefix != "" {
-				require.Contains(t, gotErr.Error(), tc.wantedErrorPrefix)
+			err := tc.in.validate()
+			if tc.wanted != nil {
+				require.EqualError(t, err, tc.wanted.Error())
 			} else {
-				require.NoError(t, gotErr)
+				require.NoError(t, err)
 			}
 		})
 	}
@@ -3624,6 +5106,80 @@ func TestTopicSubscription_validate(t *testing.T) {
 	}
 }
 
+func TestSQSQueue_validate(t *testing.T) {
+	testCases := map[string]struct {
+		in     SQSQueue
+		wanted error
+	}{
+		"should return an error if tries is missing with an existing dead-letter queue": {
+			in: SQSQueue{
+				DeadLetter: DeadLetterQueue{
+					ARN: aws.String("arn:aws:sqs:us-west-2:123456789012:dead-letters"),
+				},
+			},
+			wanted: errors.New(`validate "dead_letter": "tries" must be specified if "arn" is specified`),
+		},
+		"should return an error if the dead-letter queue is not an SQS queue": {
+			in: SQSQueue{
+				DeadLetter: DeadLetterQueue{
+					Tries: aws.Uint16(3),
+					ARN:   aws.String("arn:aws:sns:us-west-2:123456789012:dead-letters"),
+				},
+			},
+			wanted: errors.New(`validate "dead_letter": "arn" must be the ARN of an SQS queue, such as "arn:aws:sqs:us-west-2:123456789012:dead-letters"`),
+		},
+		"should return an error if a FIFO queue redrives to a standard queue": {
+			in: SQSQueue{
+				DeadLetter: DeadLetterQueue{
+					Tries: aws.Uint16(3),
+					ARN:   aws.String("arn:aws:sqs:us-west-2:123456789012:dead-letters"),
+				},
+				FIFO: FIFOAdvanceConfigOrBool{
+					Enable: aws.Bool(true),
+				},
+			},
+			wanted: errors.New(`validate "dead_letter": "arn" must be the ARN of a FIFO queue when "fifo" is enabled`),
+		},
+		"should return an error if a standard queue redrives to a FIFO queue": {
+			in: SQSQueue{
+				DeadLetter: DeadLetterQueue{
+					Tries: aws.Uint16(3),
+					ARN:   aws.String("arn:aws:sqs:us-west-2:123456789012:dead-letters.fifo"),
+				},
+			},
+			wanted: errors.New(`validate "dead_letter": "arn" must be the ARN of a standard queue when "fifo" is not enabled`),
+		},
+		"should not return an error if a FIFO queue redrives to an existing FIFO queue": {
+			in: SQSQueue{
+				DeadLetter: DeadLetterQueue{
+					Tries: aws.Uint16(3),
+					ARN:   aws.String("arn:aws:sqs:us-west-2:123456789012:dead-letters.fifo"),
+				},
+				FIFO: FIFOAdvanceConfigOrBool{
+					Enable: aws.Bool(true),
+				},
+			},
+		},
+		"should not return an error if a queue redrives to a managed dead-letter queue": {
+			in: SQSQueue{
+				DeadLetter: DeadLetterQueue{
+					Tries: aws.Uint16(3),
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.in.validate()
+			if tc.wanted != nil {
+				require.EqualError(t, err, tc.wanted.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestOverrideRule_validate(t *testing.T) {
 	testCases := map[string]struct {
 		in     OverrideRule
@@ -3839,6 +5395,131 @@ func TestValidateContainerDeps(t *testing.T) {
 			},
 			wanted: fmt.Errorf("circular container dependency chain includes the following containers: [alpha beta gamma]"),
 		},
+		"should return an error if an init container is not a sidecar": {
+			in: validateDependenciesOpts{
+				mainContainerName: "mockMainContainer",
+				taskConfig: TaskConfig{
+					InitContainers: []string{"migrate"},
+				},
+			},
+			wanted: fmt.Errorf(`init container "migrate" must be one of the sidecars`),
+		},
+		"should return an error if an init container is essential": {
+			in: validateDependenciesOpts{
+				mainContainerName: "mockMainContainer",
+				sidecarConfig: map[string]*SidecarConfig{
+					"migrate": {
+						Essential: aws.Bool(true),
+					},
+				},
+				taskConfig: TaskConfig{
+					InitContainers: []string{"migrate"},
+				},
+			},
+			wanted: fmt.Errorf(`init container "migrate" cannot be marked as essential`),
+		},
+		"success with a sidecar depending on an init container to succeed": {
+			in: validateDependenciesOpts{
+				mainContainerName: "mockMainContainer",
+				sidecarConfig: map[string]*SidecarConfig{
+					"migrate": {},
+					"metrics": {
+						DependsOn: DependsOn{
+							"migrate": "success",
+						},
+					},
+				},
+				taskConfig: TaskConfig{
+					InitContainers: []string{"migrate"},
+				},
+			},
+		},
+		"should return an error if a container depends on a container without healthcheck being healthy": {
+			in: validateDependenciesOpts{
+				mainContainerName: "mockMainContainer",
+				sidecarConfig: map[string]*SidecarConfig{
+					"metrics": {
+						DependsOn: DependsOn{
+							"mockMainContainer": "healthy",
+						},
+					},
+				},
+			},
+			wanted: fmt.Errorf(`container metrics depends on container mockMainContainer being HEALTHY, but mockMainContainer does not define a "healthcheck"`),
+		},
+		"success with a sidecar depending on the main container to be healthy": {
+			in: validateDependenciesOpts{
+				mainContainerName: "mockMainContainer",
+				imageHealthCheck: ContainerHealthCheck{
+					Command: []string{"CMD", "grpc_health_probe", "-addr=:50051"},
+				},
+				sidecarConfig: map[string]*SidecarConfig{
+					"metrics": {
+						DependsOn: DependsOn{
+							"mockMainContainer": "healthy",
+						},
+					},
+				},
+			},
+		},
+		"success with an init container depending on a sidecar to be healthy": {
+			in: validateDependenciesOpts{
+				mainContainerName: "mockMainContainer",
+				sidecarConfig: map[string]*SidecarConfig{
+					"db": {
+						HealthCheck: ContainerHealthCheck{
+							Command: []string{"CMD-SHELL", "pg_isready"},
+						},
+					},
+					"migrate": {
+						DependsOn: DependsOn{
+							"db": "healthy",
+						},
+					},
+				},
+				taskConfig: TaskConfig{
+					InitContainers: []string{"migrate"},
+				},
+			},
+		},
+		"should return an error if a sidecar mounts volumes from itself": {
+			in: validateDependenciesOpts{
+				mainContainerName: "mockMainContainer",
+				sidecarConfig: map[string]*SidecarConfig{
+					"metrics": {
+						VolumesFrom: []string{"metrics"},
+					},
+				},
+			},
+			wanted: fmt.Errorf(`sidecar "metrics" cannot mount volumes from itself`),
+		},
+		"should return an error if a sidecar mounts volumes from a container that does not exist": {
+			in: validateDependenciesOpts{
+				mainContainerName: "mockMainContainer",
+				sidecarConfig: map[string]*SidecarConfig{
+					"metrics": {
+						VolumesFrom: []string{"nginx"},
+					},
+				},
+			},
+			wanted: fmt.Errorf(`sidecar "metrics" mounts volumes from container "nginx" that does not exist`),
+		},
+		"success with init containers and shared volumes": {
+			in: validateDependenciesOpts{
+				mainContainerName: "mockMainContainer",
+				sidecarConfig: map[string]*SidecarConfig{
+					"migrate": {
+						VolumesFrom: []string{"mockMainContainer"},
+					},
+					"metrics": {
+						VolumesFrom: []string{"mockMainContainer", "migrate"},
+					},
+				},
+				taskConfig: TaskConfig{
+					InitContainers: []string{"migrate"},
+				},
+			},
+		},
 		"success": {
 			in: validateDependenciesOpts{
 				mainContainerName: "alpha",
@@ -3986,6 +5667,19 @@ func TestDeploymentConfig_validate(t *testing.T) {
 					Rolling: aws.String("default"),
 				}},
 		},
+		"ok if only availability zone rebalancing is indicated": {
+			deployConfig: DeploymentConfig{
+				DeploymentControllerConfig: DeploymentControllerConfig{
+					AZRebalancing: aws.Bool(false),
+				}},
+		},
+		"error if fewer than one task definition is kept": {
+			deployConfig: DeploymentConfig{
+				DeploymentControllerConfig: DeploymentControllerConfig{
+					KeepTaskDefinitions: aws.Int(0),
+				}},
+			wanted: `"keep_task_definitions" must be at least 1`,
+		},
 		"ok if deployment is empty": {
 			deployConfig: DeploymentConfig{},
 		},
@@ -3993,6 +5687,80 @@ func TestDeploymentConfig_validate(t *testing.T) {
 			deployConfig: DeploymentConfig{
 				RollbackAlarms: BasicToUnion[[]string, AlarmArgs]([]string{"alarmName"})},
 		},
+		"error if validation has neither a path nor a command": {
+			deployConfig: DeploymentConfig{
+				Validation: DeploymentValidation{
+					Timeout: durationp(time.Minute),
+				},
+			},
+			wanted: `validate "validation": must specify one of "path" and "command"`,
+		},
+		"error if validation has both a path and a command": {
+			deployConfig: DeploymentConfig{
+				Validation: DeploymentValidation{
+					Path: aws.String("/healthz"),
+					Command: CommandOverride{
+						StringSlice: []string{"./smoke-test.sh"},
+					},
+				},
+			},
+			wanted: `validate "validation": must specify one, not both, of "path" and "command"`,
+		},
+		"error if validation has a status without a path": {
+			deployConfig: DeploymentConfig{
+				Validation: DeploymentValidation{
+					Status: aws.Int(204),
+					Command: CommandOverride{
+						StringSlice: []string{"./smoke-test.sh"},
+					},
+				},
+			},
+			wanted: `validate "validation": "path" must be specified if "status" is specified`,
+		},
+		"error if validation path is relative": {
+			deployConfig: DeploymentConfig{
+				Validation: DeploymentValidation{
+					Path: aws.String("healthz"),
+				},
+			},
+			wanted: `validate "validation": "path" must start with "/"`,
+		},
+		"error if validation status is not an HTTP status code": {
+			deployConfig: DeploymentConfig{
+				Validation: DeploymentValidation{
+					Path:   aws.String("/healthz"),
+					Status: aws.Int(42),
+				},
+			},
+			wanted: `validate "validation": "status" must be an HTTP status code between 100 and 599`,
+		},
+		"error if validation timeout is not positive": {
+			deployConfig: DeploymentConfig{
+				Validation: DeploymentValidation{
+					Path:    aws.String("/healthz"),
+					Timeout: durationp(0),
+				},
+			},
+			wanted: `validate "validation": "timeout" must be greater than 0s`,
+		},
+		"ok if validation sends requests": {
+			deployConfig: DeploymentConfig{
+				Validation: DeploymentValidation{
+					Path:    aws.String("/healthz"),
+					Status:  aws.Int(204),
+					Timeout: durationp(time.Minute),
+				},
+			},
+		},
+		"ok if validation runs a command": {
+			deployConfig: DeploymentConfig{
+				Validation: DeploymentValidation{
+					Command: CommandOverride{
+						String: aws.String("./smoke-test.sh --quick"),
+					},
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -4008,6 +5776,39 @@ func TestDeploymentConfig_validate(t *testing.T) {
 	}
 }
 
+func TestWorkloadDeploy_validate(t *testing.T) {
+	testCases := map[string]struct {
+		in     WorkloadDeploy
+		wanted string
+	}{
+		"ok if deploy is empty": {
+			in: WorkloadDeploy{},
+		},
+		"ok if depends on other workloads": {
+			in: WorkloadDeploy{
+				DependsOn: []string{"api", "db"},
+			},
+		},
+		"error if a dependency is empty": {
+			in: WorkloadDeploy{
+				DependsOn: []string{"api", ""},
+			},
+			wanted: `"depends_on[1]" must not be empty`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := tc.in.validate()
+
+			if tc.wanted != "" {
+				require.EqualError(t, gotErr, tc.wanted)
+			} else {
+				require.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
 func TestFromEnvironment_validate(t *testing.T) {
 	testCases := map[string]struct {
 		in          fromCFN
@@ -4646,6 +6447,106 @@ func TestStaticSiteConfig_validate(t *testing.T) {
 			},
 			wantedError: fmt.Errorf(`validate "files[0]": "source" must be specified`),
 		},
+		"should return error if spa and error pages are both specified": {
+			in: StaticSiteConfig{
+				HTTP: StaticSiteHTTP{
+					SPA: aws.Bool(true),
+					ErrorPages: StaticSiteErrorPages{
+						NotFound: "/404.html",
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "http": must specify one, not both, of "spa" and "error_pages"`),
+		},
+		"should return error if an error page is not an absolute path": {
+			in: StaticSiteConfig{
+				HTTP: StaticSiteHTTP{
+					ErrorPages: StaticSiteErrorPages{
+						Forbidden: "403.html",
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "http": validate "error_pages": "forbidden" "403.html" must start with "/"`),
+		},
+		"success with error pages": {
+			in: StaticSiteConfig{
+				HTTP: StaticSiteHTTP{
+					ErrorPages: StaticSiteErrorPages{
+						NotFound:  "/errors/404.html",
+						Forbidden: "/errors/403.html",
+					},
+				},
+			},
+		},
+		"success with spa": {
+			in: StaticSiteConfig{
+				HTTP: StaticSiteHTTP{
+					SPA: aws.Bool(true),
+				},
+			},
+		},
+		"should return error if a redirect doesn't start with a slash": {
+			in: StaticSiteConfig{
+				HTTP: StaticSiteHTTP{
+					Redirects: []StaticSiteRedirect{
+						{From: "old.html", To: "/new.html"},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "http": validate "redirects[0]": "from" "old.html" must start with "/"`),
+		},
+		"should return error if a redirect has no destination": {
+			in: StaticSiteConfig{
+				HTTP: StaticSiteHTTP{
+					Redirects: []StaticSiteRedirect{
+						{From: "/old.html"},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "http": validate "redirects[0]": "to" must be specified`),
+		},
+		"should return error if the destination keeps the rest of a path that isn't a prefix": {
+			in: StaticSiteConfig{
+				HTTP: StaticSiteHTTP{
+					Redirects: []StaticSiteRedirect{
+						{From: "/blog", To: "/posts/*"},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "http": validate "redirects[0]": "to" "/posts/*" can only end with "*" if "from" ends with "*"`),
+		},
+		"should return error if the redirect status is not a redirection": {
+			in: StaticSiteConfig{
+				HTTP: StaticSiteHTTP{
+					Redirects: []StaticSiteRedirect{
+						{From: "/old.html", To: "/new.html", Status: aws.Int(200)},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "http": validate "redirects[0]": "status" must be one of 301, 302, 307 or 308, got 200`),
+		},
+		"should return error if a path is redirected twice": {
+			in: StaticSiteConfig{
+				HTTP: StaticSiteHTTP{
+					Redirects: []StaticSiteRedirect{
+						{From: "/old.html", To: "/new.html"},
+						{From: "/old.html", To: "/newer.html"},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "http": validate "redirects[1]": "from" "/old.html" is already redirected`),
+		},
+		"success with redirects": {
+			in: StaticSiteConfig{
+				HTTP: StaticSiteHTTP{
+					Redirects: []StaticSiteRedirect{
+						{From: "/old.html", To: "/new.html"},
+						{From: "/blog/*", To: "/posts/*", Status: aws.Int(302)},
+						{From: "/docs/*", To: "https://docs.example.com"},
+					},
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
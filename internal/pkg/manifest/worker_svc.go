@@ -29,6 +29,7 @@ var (
 type WorkerService struct {
 	Workload            `yaml:",inline"`
 	WorkerServiceConfig `yaml:",inline"`
+	Deploy              WorkloadDeploy `yaml:"deploy"`
 	// Use *WorkerServiceConfig because of https://github.com/imdario/mergo/issues/146
 	Environments map[string]*WorkerServiceConfig `yaml:",flow"`
 	parser       template.Parser
@@ -61,17 +62,21 @@ type WorkerServiceConfig struct {
 	TaskDefOverrides []OverrideRule            `yaml:"taskdef_overrides"`
 	DeployConfig     WorkerDeploymentConfig    `yaml:"deployment"`
 	Observability    Observability             `yaml:"observability"`
+	Cost             Cost                      `yaml:"cost"`
+	AppConfig        AppConfig                 `yaml:"appconfig"`
 }
 
 // SubscribeConfig represents the configurable options for setting up subscriptions.
 type SubscribeConfig struct {
-	Topics []TopicSubscription `yaml:"topics"`
-	Queue  SQSQueue            `yaml:"queue"`
+	Topics  []TopicSubscription  `yaml:"topics"`
+	Buckets []BucketSubscription `yaml:"buckets"`
+	Queue   SQSQueue             `yaml:"queue"`
+	Kafka   KafkaSubscription    `yaml:"kafka"`
 }
 
 // IsEmpty returns empty if the struct has all zero members.
 func (s *SubscribeConfig) IsEmpty() bool {
-	return s.Topics == nil && s.Queue.IsEmpty()
+	return s.Topics == nil && s.Buckets == nil && s.Queue.IsEmpty() && s.Kafka.IsEmpty()
 }
 
 // TopicSubscription represents the configurable options for setting up a SNS Topic Subscription.
@@ -82,6 +87,30 @@ type TopicSubscription struct {
 	Queue        SQSQueueOrBool         `yaml:"queue"`
 }
 
+// BucketSubscription represents the configurable options for receiving the object-created events
+// of a S3 bucket addon through EventBridge.
+type BucketSubscription struct {
+	Name    *string `yaml:"name"`
+	Service *string `yaml:"service"`
+	Prefix  *string `yaml:"prefix"`
+}
+
+// KafkaSubscription represents the configurable options for consuming the topics of an Amazon MSK cluster
+// with IAM access control.
+type KafkaSubscription struct {
+	ClusterARN       StringOrFromCFN `yaml:"cluster_arn"`
+	BootstrapBrokers StringOrFromCFN `yaml:"bootstrap_brokers"`
+	SecurityGroup    StringOrFromCFN `yaml:"security_group"`
+	Topics           []string        `yaml:"topics"`
+	ConsumerGroup    *string         `yaml:"consumer_group"`
+}
+
+// IsEmpty returns empty if the struct has all zero members.
+func (k *KafkaSubscription) IsEmpty() bool {
+	return k.ClusterARN.isEmpty() && k.BootstrapBrokers.isEmpty() && k.SecurityGroup.isEmpty() &&
+		k.Topics == nil && k.ConsumerGroup == nil
+}
+
 // SQSQueueOrBool is a custom type which supports unmarshaling yaml which
 // can either be of type bool or type SQSQueue.
 type SQSQueueOrBool struct {
@@ -183,11 +212,12 @@ func (q *SQSQueue) IsEmpty() bool {
 // DeadLetterQueue represents the configurable options for setting up a Dead-Letter Queue.
 type DeadLetterQueue struct {
 	Tries *uint16 `yaml:"tries"`
+	ARN   *string `yaml:"arn"` // The ARN of an existing queue to use instead of creating one.
 }
 
 // IsEmpty returns empty if the struct has all zero members.
 func (q *DeadLetterQueue) IsEmpty() bool {
-	return q.Tries == nil
+	return q.Tries == nil && q.ARN == nil
 }
 
 // WorkerServiceProps represents the configuration needed to create a worker service.
@@ -277,6 +307,21 @@ func (s *WorkerService) MarshalBinary() ([]byte, error) {
 	return content.Bytes(), nil
 }
 
+// ImageTags returns the additional tag templates of the main container image.
+func (s *WorkerService) ImageTags() []string {
+	return s.ImageConfig.Image.Tags
+}
+
+// ImageLifecycle returns the lifecycle policy of the ECR repository of the workload.
+func (s *WorkerService) ImageLifecycle() ImageLifecycle {
+	return s.ImageConfig.Image.Lifecycle
+}
+
+// TracingConfigFile returns the path to the configuration of the OpenTelemetry collector sidecar, if any.
+func (s *WorkerService) TracingConfigFile() string {
+	return s.Observability.TracingConfigFile()
+}
+
 // BuildArgs returns a docker.BuildArguments object for the service given a context directory
 func (s *WorkerService) BuildArgs(contextDir string) (map[string]*DockerBuildArgs, error) {
 	required, err := requiresBuild(s.ImageConfig.Image)
@@ -301,7 +346,7 @@ func (s *WorkerService) EnvFiles() map[string]string {
 // ContainerDependencies returns a map of ContainerDependency objects for the WorkerService
 // including dependencies for its main container, any logging sidecar, and additional sidecars.
 func (s *WorkerService) ContainerDependencies() map[string]ContainerDependency {
-	return containerDependencies(aws.StringValue(s.Name), s.ImageConfig.Image, s.Logging, s.Sidecars)
+	return containerDependencies(aws.StringValue(s.Name), s.ImageConfig.Image, s.ImageConfig.HealthCheck, s.Logging, s.Sidecars, s.TaskConfig)
 }
 
 // Subscriptions returns a list of TopicSubscriotion objects which represent the SNS topics the service
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
@@ -31,6 +32,7 @@ const (
 	sqsFIFOThroughputLimitPerQueue          = "perQueue"
 	sqsDeduplicationScopeMessageGroup       = "messageGroup"
 	sqsDeduplicationScopeQueue              = "queue"
+	sqsFIFOQueueSuffix                      = ".fifo"
 )
 
 // AWS VPC subnet placement options.
@@ -125,12 +127,31 @@ type Workload struct {
 	Type *string `yaml:"type"` // must be one of the supported manifest types.
 }
 
+// WorkloadDeploy holds the configuration for deploying the workload together with other workloads of the workspace.
+type WorkloadDeploy struct {
+	DependsOn []string `yaml:"depends_on"` // Workloads that "copilot deploy" deploys before this workload.
+}
+
 // Image represents the workload's container image.
 type Image struct {
 	ImageLocationOrBuild `yaml:",inline"`
 	Credentials          *string           `yaml:"credentials"`     // ARN of the secret containing the private repository credentials.
 	DockerLabels         map[string]string `yaml:"labels,flow"`     // Apply Docker labels to the container at runtime.
 	DependsOn            DependsOn         `yaml:"depends_on,flow"` // Add any sidecar dependencies.
+	Tags                 []string          `yaml:"tags"`            // Additional tag templates to apply to the built image when it's pushed.
+	Lifecycle            ImageLifecycle    `yaml:"lifecycle"`       // Lifecycle policy of the ECR repository of the workload.
+}
+
+// ImageLifecycle represents the lifecycle policy of the ECR repository created by Copilot for a workload.
+// It overrides the lifecycle policy of the application.
+type ImageLifecycle struct {
+	KeepImages         *int `yaml:"keep_images"`
+	ExpireUntaggedDays *int `yaml:"expire_untagged_days"`
+}
+
+// IsEmpty returns true if the lifecycle policy is not configured.
+func (l ImageLifecycle) IsEmpty() bool {
+	return l.KeepImages == nil && l.ExpireUntaggedDays == nil
 }
 
 // ImageLocationOrBuild represents the docker build arguments and location of the existing image.
@@ -142,6 +163,24 @@ type ImageLocationOrBuild struct {
 // DependsOn represents container dependency for a container.
 type DependsOn map[string]string
 
+// WithInitContainers returns a copy of the dependencies that also waits for each init container to complete,
+// unless a dependency condition on the init container is already specified.
+func (d DependsOn) WithInitContainers(initContainers []string) DependsOn {
+	if len(initContainers) == 0 {
+		return d
+	}
+	deps := make(DependsOn, len(d)+len(initContainers))
+	for name, condition := range d {
+		deps[name] = condition
+	}
+	for _, name := range initContainers {
+		if _, ok := deps[name]; !ok {
+			deps[name] = dependsOnComplete
+		}
+	}
+	return deps
+}
+
 // UnmarshalYAML overrides the default YAML unmarshaling logic for the Image
 // struct, allowing it to perform more complex unmarshaling behavior.
 // This method implements the yaml.Unmarshaler (v3) interface.
@@ -178,6 +217,7 @@ func (i *ImageLocationOrBuild) BuildConfig(rootDirectory string) *DockerBuildArg
 		Args:       i.args(),
 		Target:     i.target(),
 		CacheFrom:  i.cacheFrom(),
+		CacheTo:    i.cacheTo(),
 	}
 }
 
@@ -238,6 +278,12 @@ func (i *ImageLocationOrBuild) cacheFrom() []string {
 	return i.Build.BuildArgs.CacheFrom
 }
 
+// cacheTo returns the cache to build section, if it exists.
+// Otherwise it returns nil.
+func (i *ImageLocationOrBuild) cacheTo() []string {
+	return i.Build.BuildArgs.CacheTo
+}
+
 // ImageOverride holds fields that override Dockerfile image defaults.
 type ImageOverride struct {
 	EntryPoint EntryPointOverride `yaml:"entrypoint"`
@@ -398,10 +444,11 @@ type DockerBuildArgs struct {
 	Args       map[string]string `yaml:"args,omitempty"`
 	Target     *string           `yaml:"target,omitempty"`
 	CacheFrom  []string          `yaml:"cache_from,omitempty"`
+	CacheTo    []string          `yaml:"cache_to,omitempty"`
 }
 
 func (b *DockerBuildArgs) isEmpty() bool {
-	if b.Context == nil && b.Dockerfile == nil && b.Args == nil && b.Target == nil && b.CacheFrom == nil {
+	if b.Context == nil && b.Dockerfile == nil && b.Args == nil && b.Target == nil && b.CacheFrom == nil && b.CacheTo == nil {
 		return true
 	}
 	return false
@@ -467,6 +514,13 @@ func (t *FIFOTopicAdvanceConfigOrBool) UnmarshalYAML(value *yaml.Node) error {
 type NetworkConfig struct {
 	VPC     vpcConfig                `yaml:"vpc"`
 	Connect ServiceConnectBoolOrArgs `yaml:"connect"`
+	Ingress NetworkIngressConfig     `yaml:"ingress"`
+}
+
+// NetworkIngressConfig represents the workloads allowed to reach the tasks when the environment
+// security group denies the traffic between workloads.
+type NetworkIngressConfig struct {
+	FromServices []string `yaml:"from_services"`
 }
 
 // IsEmpty returns empty if the struct has all zero members.
@@ -514,11 +568,31 @@ func (s *ServiceConnectBoolOrArgs) UnmarshalYAML(value *yaml.Node) error {
 
 // ServiceConnectArgs includes the advanced configuration for ECS Service Connect.
 type ServiceConnectArgs struct {
-	Alias *string
+	Alias   *string
+	Ports   []ServiceConnectPort  `yaml:"ports"`
+	Timeout ServiceConnectTimeout `yaml:"timeout"`
 }
 
 func (s *ServiceConnectArgs) isEmpty() bool {
-	return s.Alias == nil
+	return s.Alias == nil && len(s.Ports) == 0 && s.Timeout.IsEmpty()
+}
+
+// ServiceConnectTimeout configures the timeouts of the Service Connect proxy for the ports of the service.
+type ServiceConnectTimeout struct {
+	Idle       *time.Duration `yaml:"idle"`
+	PerRequest *time.Duration `yaml:"per_request"`
+}
+
+// IsEmpty returns true if no timeout is configured.
+func (t *ServiceConnectTimeout) IsEmpty() bool {
+	return t.Idle == nil && t.PerRequest == nil
+}
+
+// ServiceConnectPort exposes an additional container port to ECS Service Connect under its own alias.
+type ServiceConnectPort struct {
+	Port       *uint16 `yaml:"port"`
+	Alias      *string `yaml:"alias"`
+	ClientPort *uint16 `yaml:"client_port"`
 }
 
 // PlacementArgOrString represents where to place tasks.
@@ -528,8 +602,18 @@ type PlacementArgOrString struct {
 }
 
 // PlacementString represents what types of subnets (public or private subnets) to place tasks.
+// Any other value is the name of a subnet group of the environment.
 type PlacementString string
 
+// SubnetGroup returns the name of the environment's subnet group to place tasks in.
+// It returns false if the tasks are placed in the public or private subnets instead.
+func (p PlacementString) SubnetGroup() (string, bool) {
+	if p == PublicSubnetPlacement || p == PrivateSubnetPlacement {
+		return "", false
+	}
+	return string(p), true
+}
+
 // IsEmpty returns empty if the struct has all zero members.
 func (p *PlacementArgOrString) IsEmpty() bool {
 	return p.PlacementString == nil && p.PlacementArgs.isEmpty()
@@ -601,6 +685,9 @@ func (dyn *dynamicSubnets) load() error {
 	if err != nil {
 		return fmt.Errorf("get subnet IDs: %w", err)
 	}
+	if len(ids) == 0 {
+		return errors.New(`no subnets match the tags in "network.vpc.placement.subnets.from_tags"`)
+	}
 	dyn.cfg.IDs = ids
 	return nil
 }
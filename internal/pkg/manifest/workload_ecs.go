@@ -41,6 +41,10 @@ const (
 	// deployment strategies
 	ECSDefaultRollingUpdateStrategy  = "default"
 	ECSRecreateRollingUpdateStrategy = "recreate"
+
+	// Defaults for deployment validations.
+	DefaultDeploymentValidationStatus  = 200
+	DefaultDeploymentValidationTimeout = 2 * time.Minute
 )
 
 // Platform related settings.
@@ -99,31 +103,65 @@ type WorkerAlarmArgs struct {
 
 // DeploymentControllerConfig represents deployment strategies for a service.
 type DeploymentControllerConfig struct {
-	Rolling *string `yaml:"rolling"`
+	Rolling             *string `yaml:"rolling"`
+	AZRebalancing       *bool   `yaml:"availability_zone_rebalancing"`
+	KeepTaskDefinitions *int    `yaml:"keep_task_definitions"` // Number of task definition revisions kept by "svc cleanup".
 }
 
 // DeploymentConfig represents the deployment config for an ECS service.
 type DeploymentConfig struct {
 	DeploymentControllerConfig `yaml:",inline"`
 	RollbackAlarms             Union[[]string, AlarmArgs] `yaml:"rollback_alarms"`
+	Validation                 DeploymentValidation       `yaml:"validation"`
 }
 
 // WorkerDeploymentConfig represents the deployment strategies for a worker service.
 type WorkerDeploymentConfig struct {
 	DeploymentControllerConfig `yaml:",inline"`
 	WorkerRollbackAlarms       Union[[]string, WorkerAlarmArgs] `yaml:"rollback_alarms"`
+	Validation                 DeploymentValidation             `yaml:"validation"`
+}
+
+// DeploymentValidation represents a smoke test that Copilot runs against a new deployment of a service once it serves traffic.
+// The deployment is rolled back if the test fails.
+type DeploymentValidation struct {
+	Path    *string         `yaml:"path"`   // Path requested on the endpoint of the service.
+	Status  *int            `yaml:"status"` // Expected HTTP status code of the path.
+	Command CommandOverride `yaml:"command"`
+	Timeout *time.Duration  `yaml:"timeout"`
+}
+
+// IsEmpty returns true if no deployment validation is configured.
+func (v DeploymentValidation) IsEmpty() bool {
+	return v.Path == nil && v.Status == nil && (*StringSliceOrString)(&v.Command).isEmpty() && v.Timeout == nil
+}
+
+// StatusOrDefault returns the HTTP status code expected from the validation path.
+func (v DeploymentValidation) StatusOrDefault() int {
+	if v.Status != nil {
+		return aws.IntValue(v.Status)
+	}
+	return DefaultDeploymentValidationStatus
+}
+
+// TimeoutOrDefault returns how long the validation may take before it is considered failed.
+func (v DeploymentValidation) TimeoutOrDefault() time.Duration {
+	if v.Timeout != nil {
+		return *v.Timeout
+	}
+	return DefaultDeploymentValidationTimeout
 }
 
 func (d *DeploymentConfig) isEmpty() bool {
-	return d == nil || (d.DeploymentControllerConfig.isEmpty() && d.RollbackAlarms.IsZero())
+	return d == nil || (d.DeploymentControllerConfig.isEmpty() && d.RollbackAlarms.IsZero() && d.Validation.IsEmpty())
 }
 
 func (d *DeploymentControllerConfig) isEmpty() bool {
-	return d.Rolling == nil
+	return d.Rolling == nil && d.AZRebalancing == nil && d.KeepTaskDefinitions == nil
 }
 
 func (w *WorkerDeploymentConfig) isEmpty() bool {
-	return w == nil || (w.DeploymentControllerConfig.Rolling == nil && w.WorkerRollbackAlarms.IsZero())
+	return w == nil || (w.DeploymentControllerConfig.isEmpty() && w.WorkerRollbackAlarms.IsZero() && w.Validation.IsEmpty())
 }
 
 // ExposedPort will hold the port mapping configuration.
@@ -151,12 +189,35 @@ type TaskConfig struct {
 	CPU            *int                 `yaml:"cpu"`
 	Memory         *int                 `yaml:"memory"`
 	Platform       PlatformArgsOrString `yaml:"platform,omitempty"`
+	Windows        WindowsConfig        `yaml:"windows"`
 	Count          Count                `yaml:"count"`
 	ExecuteCommand ExecuteCommand       `yaml:"exec"`
 	Variables      map[string]Variable  `yaml:"variables"`
 	EnvFile        *string              `yaml:"env_file"`
 	Secrets        map[string]Secret    `yaml:"secrets"`
 	Storage        Storage              `yaml:"storage"`
+	InitContainers []string             `yaml:"init_containers"`
+}
+
+// WindowsConfig holds configuration specific to Windows containers.
+type WindowsConfig struct {
+	CredentialSpec *string `yaml:"credential_spec"` // ARN of the S3 object or SSM parameter that holds the gMSA credential spec.
+}
+
+// IsEmpty returns empty if the struct has all zero members.
+func (w *WindowsConfig) IsEmpty() bool {
+	return w.CredentialSpec == nil
+}
+
+// IsInitContainer returns true if the sidecar container with the given name must run to completion
+// before the main container starts.
+func (tc TaskConfig) IsInitContainer(name string) bool {
+	for _, initContainer := range tc.InitContainers {
+		if initContainer == name {
+			return true
+		}
+	}
+	return false
 }
 
 // Variable represents an identifier for the value of an environment variable.
@@ -307,6 +368,7 @@ type SidecarConfig struct {
 	EnvFile       *string                              `yaml:"env_file"`
 	Secrets       map[string]Secret                    `yaml:"secrets"`
 	MountPoints   []SidecarMountPoint                  `yaml:"mount_points"`
+	VolumesFrom   []string                             `yaml:"volumes_from"`
 	DockerLabels  map[string]string                    `yaml:"labels"`
 	DependsOn     DependsOn                            `yaml:"depends_on"`
 	HealthCheck   ContainerHealthCheck                 `yaml:"healthcheck"`
@@ -432,33 +494,44 @@ func envFiles(name *string, tc TaskConfig, lc Logging, sc map[string]*SidecarCon
 
 func buildArgs(contextDir string, buildArgs map[string]*DockerBuildArgs, sc map[string]*SidecarConfig) (map[string]*DockerBuildArgs, error) {
 	for name, config := range sc {
-		if _, ok := config.ImageURI(); !ok {
-			buildArgs[name] = config.Image.Advanced.BuildConfig(contextDir)
+		if config.Image.Basic != nil {
+			continue
+		}
+		img := config.Image.Advanced
+		if img.Build.isEmpty() == (img.Location == nil) {
+			return nil, fmt.Errorf(`either "image.build" or "image.location" needs to be specified for sidecar %q`, name)
+		}
+		if img.Location == nil {
+			buildArgs[name] = img.BuildConfig(contextDir)
 		}
 	}
 	return buildArgs, nil
 }
 
 // ContainerDependency represents order of container startup and shutdown.
-// Also indicates if a container is marked as essential or not.
+// Also indicates if a container is marked as essential or not, and if it has a health check
+// that other containers can wait for with the "HEALTHY" condition.
 type ContainerDependency struct {
-	IsEssential bool
-	DependsOn   DependsOn
+	IsEssential    bool
+	HasHealthCheck bool
+	DependsOn      DependsOn
 }
 
-func containerDependencies(name string, img Image, lc Logging, sc map[string]*SidecarConfig) map[string]ContainerDependency {
+func containerDependencies(name string, img Image, hc ContainerHealthCheck, lc Logging, sc map[string]*SidecarConfig, tc TaskConfig) map[string]ContainerDependency {
 	containerDependencies := make(map[string]ContainerDependency)
 	containerDependencies[name] = ContainerDependency{
-		DependsOn:   img.DependsOn,
-		IsEssential: true,
+		DependsOn:      img.DependsOn.WithInitContainers(tc.InitContainers),
+		IsEssential:    true,
+		HasHealthCheck: !hc.IsEmpty(),
 	}
 	if !lc.IsEmpty() {
 		containerDependencies[FirelensContainerName] = ContainerDependency{}
 	}
 	for name, config := range sc {
 		containerDependencies[name] = ContainerDependency{
-			DependsOn:   config.DependsOn,
-			IsEssential: config.Essential == nil || aws.BoolValue(config.Essential),
+			DependsOn:      config.DependsOn,
+			IsEssential:    !tc.IsInitContainer(name) && (config.Essential == nil || aws.BoolValue(config.Essential)),
+			HasHealthCheck: !config.HealthCheck.IsEmpty(),
 		}
 	}
 	return containerDependencies
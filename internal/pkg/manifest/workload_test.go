@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
@@ -287,6 +288,23 @@ func TestBuildArgs_UnmarshalYAML(t *testing.T) {
 				BuildString: nil,
 			},
 		},
+		"Dockerfile with cache to build opts": {
+			inContent: []byte(`build:
+  cache_from:
+    - type=registry,ref=123456789012.dkr.ecr.us-west-2.amazonaws.com/app/svc:cache
+  cache_to:
+    - type=registry,ref=123456789012.dkr.ecr.us-west-2.amazonaws.com/app/svc:cache,mode=max,image-manifest=true,oci-mediatypes=true`),
+			wantedStruct: BuildArgsOrString{
+				BuildArgs: DockerBuildArgs{
+					CacheFrom: []string{
+						"type=registry,ref=123456789012.dkr.ecr.us-west-2.amazonaws.com/app/svc:cache",
+					},
+					CacheTo: []string{
+						"type=registry,ref=123456789012.dkr.ecr.us-west-2.amazonaws.com/app/svc:cache,mode=max,image-manifest=true,oci-mediatypes=true",
+					},
+				},
+			},
+		},
 		"Error if unmarshalable": {
 			inContent: []byte(`build:
   badfield: OH NOES
@@ -315,6 +333,7 @@ func TestBuildArgs_UnmarshalYAML(t *testing.T) {
 				require.Equal(t, tc.wantedStruct.BuildArgs.Args, b.Build.BuildArgs.Args)
 				require.Equal(t, tc.wantedStruct.BuildArgs.Target, b.Build.BuildArgs.Target)
 				require.Equal(t, tc.wantedStruct.BuildArgs.CacheFrom, b.Build.BuildArgs.CacheFrom)
+				require.Equal(t, tc.wantedStruct.BuildArgs.CacheTo, b.Build.BuildArgs.CacheTo)
 			}
 		})
 	}
@@ -422,6 +441,39 @@ func TestServiceConnect_UnmarshalYAML(t *testing.T) {
 				},
 			},
 		},
+		"success with ports": {
+			inContent: []byte(`connect:
+  ports:
+    - port: 50051
+      alias: api-grpc
+    - port: 9090
+      alias: api-metrics
+      client_port: 80`),
+			wantedStruct: ServiceConnectBoolOrArgs{
+				ServiceConnectArgs: ServiceConnectArgs{
+					Ports: []ServiceConnectPort{
+						{Port: aws.Uint16(50051), Alias: aws.String("api-grpc")},
+						{Port: aws.Uint16(9090), Alias: aws.String("api-metrics"), ClientPort: aws.Uint16(80)},
+					},
+				},
+			},
+		},
+		"success with timeouts": {
+			inContent: []byte(`connect:
+  alias: api
+  timeout:
+    idle: 5m
+    per_request: 30s`),
+			wantedStruct: ServiceConnectBoolOrArgs{
+				ServiceConnectArgs: ServiceConnectArgs{
+					Alias: aws.String("api"),
+					Timeout: ServiceConnectTimeout{
+						Idle:       durationp(5 * time.Minute),
+						PerRequest: durationp(30 * time.Second),
+					},
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -825,6 +877,7 @@ func TestBuildConfig(t *testing.T) {
 						"foo/bar:latest",
 						"foo/bar/baz:1.2.3",
 					},
+					CacheTo: []string{"type=inline"},
 				},
 			},
 			wantedBuild: DockerBuildArgs{
@@ -835,6 +888,7 @@ func TestBuildConfig(t *testing.T) {
 					"foo/bar:latest",
 					"foo/bar/baz:1.2.3",
 				},
+				CacheTo: []string{"type=inline"},
 			},
 		},
 	}
@@ -1231,6 +1285,42 @@ func TestDependency_UnmarshalYAML(t *testing.T) {
 	}
 }
 
+func TestDependsOn_WithInitContainers(t *testing.T) {
+	testCases := map[string]struct {
+		in             DependsOn
+		initContainers []string
+
+		wanted DependsOn
+	}{
+		"returns the dependencies as is without init containers": {
+			in: DependsOn{
+				"nginx": "start",
+			},
+			wanted: DependsOn{
+				"nginx": "start",
+			},
+		},
+		"waits for init containers to complete unless a condition is already specified": {
+			in: DependsOn{
+				"nginx":   "start",
+				"migrate": "success",
+			},
+			initContainers: []string{"migrate", "seed"},
+			wanted: DependsOn{
+				"nginx":   "start",
+				"migrate": "success",
+				"seed":    "COMPLETE",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.WithInitContainers(tc.initContainers))
+		})
+	}
+}
+
 func TestUnmarshalPublish(t *testing.T) {
 	testCases := map[string]struct {
 		inContent     string
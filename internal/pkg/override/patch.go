@@ -4,8 +4,11 @@
 package override
 
 import (
+	"errors"
 	"fmt"
+	"path"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -72,20 +75,26 @@ func (p *Patch) Override(body []byte) ([]byte, error) {
 	}
 
 	for i := range patches {
-		patch := patches[i] // needed because operations use pointer to patch.Value
-		var err error
-		switch patch.Operation {
-		case "add":
-			err = patch.applyAdd(&root)
-		case "remove":
-			err = patch.applyRemove(&root)
-		case "replace":
-			err = patch.applyReplace(&root)
-		default:
-			return nil, fmt.Errorf("unsupported operation %q: supported operations are %q, %q, and %q.", patch.Operation, "add", "remove", "replace")
-		}
+		resolved, err := patches[i].resolve(&root)
 		if err != nil {
-			return nil, fmt.Errorf("unable to apply the %q patch at index %d: %w", patch.Operation, i, err)
+			return nil, fmt.Errorf("unable to apply the %q patch at index %d: %w", patches[i].Operation, i, err)
+		}
+		for j := range resolved {
+			patch := resolved[j] // needed because operations use pointer to patch.Value
+			var err error
+			switch patch.Operation {
+			case "add":
+				err = patch.applyAdd(&root)
+			case "remove":
+				err = patch.applyRemove(&root)
+			case "replace":
+				err = patch.applyReplace(&root)
+			default:
+				return nil, fmt.Errorf("unsupported operation %q: supported operations are %q, %q, and %q.", patch.Operation, "add", "remove", "replace")
+			}
+			if err != nil {
+				return nil, fmt.Errorf("unable to apply the %q patch at index %d: %w", patch.Operation, i, err)
+			}
 		}
 	}
 
@@ -132,11 +141,130 @@ func addYAMLPatchDescription(body *yaml.Node) {
 type yamlPatch struct {
 	Operation string `yaml:"op"`
 
+	// Target selects the resources to apply the patch to. If set, Path is relative to each of these resources.
+	Target *patchTarget `yaml:"target"`
+
 	// Path is in JSON Pointer syntax: https://www.rfc-editor.org/rfc/rfc6901
 	Path  string    `yaml:"path"`
 	Value yaml.Node `yaml:"value"`
 }
 
+// patchTarget selects resources of the template by type and logical ID, so that
+// patches keep applying when the logical IDs generated by Copilot change.
+type patchTarget struct {
+	Type      string `yaml:"type"`
+	LogicalID string `yaml:"logical_id"` // Shell pattern, such as "TaskDef*".
+}
+
+func (t *patchTarget) String() string {
+	var selectors []string
+	if t.Type != "" {
+		selectors = append(selectors, fmt.Sprintf("type %q", t.Type))
+	}
+	if t.LogicalID != "" {
+		selectors = append(selectors, fmt.Sprintf("logical ID %q", t.LogicalID))
+	}
+	return strings.Join(selectors, " and ")
+}
+
+func (t *patchTarget) validate() error {
+	if t.Type == "" && t.LogicalID == "" {
+		return errors.New(`"target" must specify "type", "logical_id", or both`)
+	}
+	if _, err := path.Match(t.LogicalID, ""); err != nil {
+		return fmt.Errorf(`invalid "logical_id" pattern %q: %w`, t.LogicalID, err)
+	}
+	return nil
+}
+
+// matches returns the logical IDs of the resources selected by the target in alphabetical order.
+func (t *patchTarget) matches(root *yaml.Node) ([]string, error) {
+	resources, err := findNodeWithPointer(root, pointer{"", "Resources"}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resources.Kind != yaml.MappingNode {
+		return nil, &errInvalidNodeKind{
+			pointer: pointer{"", "Resources"},
+			kind:    resources.Kind,
+		}
+	}
+	var ids []string
+	for i := 0; i < len(resources.Content); i += 2 {
+		id, resource := resources.Content[i].Value, resources.Content[i+1]
+		if t.LogicalID != "" {
+			if ok, _ := path.Match(t.LogicalID, id); !ok {
+				continue
+			}
+		}
+		if t.Type != "" && resourceType(resource) != t.Type {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
+func resourceType(resource *yaml.Node) string {
+	if resource.Kind != yaml.MappingNode {
+		return ""
+	}
+	i, err := findInMap(resource, "Type", nil)
+	if err != nil {
+		return ""
+	}
+	return resource.Content[i+1].Value
+}
+
+// resolve returns the patches to apply to the template. Patches without a target are returned as is.
+// A patch with a target is applied to every resource that the target selects, in alphabetical order of logical ID.
+func (p yamlPatch) resolve(root *yaml.Node) ([]yamlPatch, error) {
+	if p.Target == nil {
+		return []yamlPatch{p}, nil
+	}
+	if err := p.Target.validate(); err != nil {
+		return nil, err
+	}
+	if p.Path != "" && !strings.HasPrefix(p.Path, jsonPointerSeparator) {
+		return nil, fmt.Errorf("path %q relative to the target must be empty or start with %q", p.Path, jsonPointerSeparator)
+	}
+	ids, err := p.Target.matches(root)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("no resource matches the target with %s", p.Target)
+	}
+	patches := make([]yamlPatch, len(ids))
+	for i, id := range ids {
+		patches[i] = yamlPatch{
+			Operation: p.Operation,
+			Path:      "/Resources/" + escapePointerKey(id) + p.Path,
+			Value:     *cloneNode(&p.Value),
+		}
+	}
+	return patches, nil
+}
+
+// escapePointerKey escapes a key to use it in a JSON pointer, as described https://www.rfc-editor.org/rfc/rfc6901#section-3
+func escapePointerKey(key string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(key, "~", "~0"), "/", "~1")
+}
+
+// cloneNode returns a deep copy of node, so that a value added to several resources is not shared between them.
+func cloneNode(node *yaml.Node) *yaml.Node {
+	clone := *node
+	clone.Content = make([]*yaml.Node, len(node.Content))
+	for i, child := range node.Content {
+		clone.Content[i] = cloneNode(child)
+	}
+	if node.Alias != nil {
+		clone.Alias = cloneNode(node.Alias)
+	}
+	return &clone
+}
+
 func (p *yamlPatch) applyAdd(root *yaml.Node) error {
 	if p.Value.IsZero() {
 		return fmt.Errorf("value required")
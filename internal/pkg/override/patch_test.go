@@ -528,6 +528,159 @@ a:
   path: /a/c`,
 			expectedErr: `unable to apply the "remove" patch at index 1: key "/a": "c" not found in map`,
 		},
+		"add to every resource of a type": {
+			yaml: `
+Resources:
+  WorkerTaskDef:
+    Type: AWS::ECS::TaskDefinition
+    Properties:
+      Cpu: 256
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      DesiredCount: 1
+  TaskDef:
+    Type: AWS::ECS::TaskDefinition
+    Properties:
+      Cpu: 512`,
+			overrides: `
+- op: add
+  target:
+    type: AWS::ECS::TaskDefinition
+  path: /Properties/EphemeralStorage
+  value:
+    SizeInGiB: 50`,
+			expected: `
+Resources:
+  WorkerTaskDef:
+    Type: AWS::ECS::TaskDefinition
+    Properties:
+      Cpu: 256
+      EphemeralStorage:
+        SizeInGiB: 50
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      DesiredCount: 1
+  TaskDef:
+    Type: AWS::ECS::TaskDefinition
+    Properties:
+      Cpu: 512
+      EphemeralStorage:
+        SizeInGiB: 50`,
+		},
+		"error reports the first resource in order of logical ID that a targeted patch fails on": {
+			yaml: `
+Resources:
+  WorkerTaskDef:
+    Type: AWS::ECS::TaskDefinition
+  TaskDef:
+    Type: AWS::ECS::TaskDefinition`,
+			overrides: `
+- op: replace
+  target:
+    type: AWS::ECS::TaskDefinition
+  path: /Properties/Cpu
+  value: 1024`,
+			expectedErr: `unable to apply the "replace" patch at index 0: key "/Resources/TaskDef": "Properties" not found in map`,
+		},
+		"replace in resources that match a logical ID pattern and type": {
+			yaml: `
+Resources:
+  TaskRole:
+    Type: AWS::IAM::Role
+    Properties:
+      MaxSessionDuration: 3600
+  TaskRolePolicy:
+    Type: AWS::IAM::Policy
+  ExecutionRole:
+    Type: AWS::IAM::Role
+    Properties:
+      MaxSessionDuration: 3600`,
+			overrides: `
+- op: replace
+  target:
+    type: AWS::IAM::Role
+    logical_id: Task*
+  path: /Properties/MaxSessionDuration
+  value: 7200`,
+			expected: `
+Resources:
+  TaskRole:
+    Type: AWS::IAM::Role
+    Properties:
+      MaxSessionDuration: 7200
+  TaskRolePolicy:
+    Type: AWS::IAM::Policy
+  ExecutionRole:
+    Type: AWS::IAM::Role
+    Properties:
+      MaxSessionDuration: 3600`,
+		},
+		"remove the resources that match a target": {
+			yaml: `
+Resources:
+  LogGroup:
+    Type: AWS::Logs::LogGroup
+  Service:
+    Type: AWS::ECS::Service`,
+			overrides: `
+- op: remove
+  target:
+    logical_id: Log*`,
+			expected: `
+Resources:
+  Service:
+    Type: AWS::ECS::Service`,
+		},
+		"error if no resource matches the target": {
+			yaml: `
+Resources:
+  Service:
+    Type: AWS::ECS::Service`,
+			overrides: `
+- op: remove
+  target:
+    type: AWS::ECS::TaskDefinition
+    logical_id: Task*
+  path: /Properties/TaskRoleArn`,
+			expectedErr: `unable to apply the "remove" patch at index 0: no resource matches the target with type "AWS::ECS::TaskDefinition" and logical ID "Task*"`,
+		},
+		"error if the target is empty": {
+			yaml: `
+Resources:
+  Service:
+    Type: AWS::ECS::Service`,
+			overrides: `
+- op: remove
+  target: {}
+  path: /Properties`,
+			expectedErr: `unable to apply the "remove" patch at index 0: "target" must specify "type", "logical_id", or both`,
+		},
+		"error if the logical ID pattern is invalid": {
+			yaml: `
+Resources:
+  Service:
+    Type: AWS::ECS::Service`,
+			overrides: `
+- op: remove
+  target:
+    logical_id: "Service["
+  path: /Properties`,
+			expectedErr: `unable to apply the "remove" patch at index 0: invalid "logical_id" pattern "Service[": syntax error in pattern`,
+		},
+		"error if the path relative to the target does not start with a slash": {
+			yaml: `
+Resources:
+  Service:
+    Type: AWS::ECS::Service`,
+			overrides: `
+- op: remove
+  target:
+    type: AWS::ECS::Service
+  path: Properties`,
+			expectedErr: `unable to apply the "remove" patch at index 0: path "Properties" relative to the target must be empty or start with "/"`,
+		},
 		"updates the Description field of a CloudFormation template with YAML patch metrics": {
 			yaml: `
 Description: "CloudFormation template that represents a backend service on Amazon ECS."
@@ -0,0 +1,193 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package plugin discovers and runs the executables that extend Copilot with custom commands.
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+)
+
+const (
+	// Prefix is the prefix of the executables that Copilot runs as plugins.
+	// For example, "copilot compliance-report" runs the "copilot-compliance-report" executable.
+	Prefix = "copilot-"
+
+	// BinaryEnvVar is the environment variable set to the path of the Copilot binary that runs a plugin,
+	// so that plugins can call back into the same version of Copilot.
+	BinaryEnvVar = "COPILOT_BIN"
+
+	windowsExecutableExt = ".exe"
+)
+
+// Plugin is an executable named "copilot-<name>" that runs as the "copilot <name>" command.
+type Plugin struct {
+	Name string `json:"name"`
+	Path string `json:"path"`
+}
+
+// Finder discovers plugins in a list of directories.
+type Finder struct {
+	// Dirs is the list of directories to search, in the format of the PATH environment variable.
+	Dirs string
+}
+
+// NewFinder returns a Finder that searches the directories of the PATH environment variable.
+func NewFinder() *Finder {
+	return &Finder{
+		Dirs: os.Getenv("PATH"),
+	}
+}
+
+// Find returns the first plugin named name in the directories.
+func (f *Finder) Find(name string) (*Plugin, error) {
+	if name == "" || strings.ContainsAny(name, `/\`) || strings.HasPrefix(name, "-") {
+		return nil, &ErrNotFound{Name: name}
+	}
+	for _, dir := range filepath.SplitList(f.Dirs) {
+		if dir == "" {
+			continue
+		}
+		path := filepath.Join(dir, executableName(name))
+		info, err := os.Stat(path)
+		if err != nil || !isExecutable(info) {
+			continue
+		}
+		return &Plugin{
+			Name: name,
+			Path: path,
+		}, nil
+	}
+	return nil, &ErrNotFound{Name: name}
+}
+
+// List returns the plugins in the directories sorted by name.
+// A plugin shadows the plugins with the same name in the directories that come after it.
+func (f *Finder) List() ([]Plugin, error) {
+	found := make(map[string]Plugin)
+	for _, dir := range filepath.SplitList(f.Dirs) {
+		if dir == "" {
+			continue
+		}
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) || errors.Is(err, fs.ErrPermission) {
+				// Directories of the PATH that don't exist or can't be read don't prevent listing the other plugins.
+				continue
+			}
+			return nil, fmt.Errorf("read directory %s: %w", dir, err)
+		}
+		for _, entry := range entries {
+			name, ok := pluginName(entry.Name())
+			if !ok {
+				continue
+			}
+			if _, ok := found[name]; ok {
+				continue
+			}
+			info, err := entry.Info()
+			if err != nil || !isExecutable(info) {
+				continue
+			}
+			found[name] = Plugin{
+				Name: name,
+				Path: filepath.Join(dir, entry.Name()),
+			}
+		}
+	}
+	plugins := make([]Plugin, 0, len(found))
+	for _, p := range found {
+		plugins = append(plugins, p)
+	}
+	sort.Slice(plugins, func(i, j int) bool { return plugins[i].Name < plugins[j].Name })
+	return plugins, nil
+}
+
+// Run runs the plugin with the arguments and the standard streams.
+// If the plugin exits with a non-zero code, the error implements ExitCode() int.
+func (p *Plugin) Run(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	cmd := exec.Command(p.Path, args...)
+	cmd.Stdin = stdin
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	cmd.Env = os.Environ()
+	if bin, err := os.Executable(); err == nil {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", BinaryEnvVar, bin))
+	}
+	if err := cmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return &ErrExitCode{
+				Name:     p.Name,
+				exitCode: exitErr.ExitCode(),
+			}
+		}
+		return fmt.Errorf("run plugin %s: %w", p.Name, err)
+	}
+	return nil
+}
+
+func executableName(name string) string {
+	if runtime.GOOS == "windows" {
+		return Prefix + name + windowsExecutableExt
+	}
+	return Prefix + name
+}
+
+// pluginName returns the name of the plugin from the name of its executable.
+func pluginName(filename string) (string, bool) {
+	if runtime.GOOS == "windows" {
+		if !strings.EqualFold(filepath.Ext(filename), windowsExecutableExt) {
+			return "", false
+		}
+		filename = strings.TrimSuffix(filename, filepath.Ext(filename))
+	}
+	name, ok := strings.CutPrefix(filename, Prefix)
+	if !ok || name == "" {
+		return "", false
+	}
+	return name, true
+}
+
+func isExecutable(info fs.FileInfo) bool {
+	if info.IsDir() {
+		return false
+	}
+	if runtime.GOOS == "windows" {
+		return true // The extension of the executable is checked by the callers.
+	}
+	return info.Mode().Perm()&0111 != 0
+}
+
+// ErrNotFound is returned when there is no plugin for a command.
+type ErrNotFound struct {
+	Name string
+}
+
+func (e *ErrNotFound) Error() string {
+	return fmt.Sprintf("no %s executable found in $PATH", Prefix+e.Name)
+}
+
+// ErrExitCode is returned when a plugin exits with a non-zero code.
+type ErrExitCode struct {
+	Name     string
+	exitCode int
+}
+
+func (e *ErrExitCode) Error() string {
+	return fmt.Sprintf("plugin %s exited with code %d", e.Name, e.exitCode)
+}
+
+// ExitCode returns the exit code of the plugin.
+func (e *ErrExitCode) ExitCode() int {
+	return e.exitCode
+}
@@ -0,0 +1,141 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package plugin
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeExecutable(t *testing.T, dir, name, script string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, []byte("#!/bin/sh\n"+script+"\n"), 0755))
+	return path
+}
+
+func TestFinder_Find(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("plugins are shell scripts in the tests")
+	}
+	first, second := t.TempDir(), t.TempDir()
+	shadowing := writeExecutable(t, first, "copilot-report", "echo first")
+	writeExecutable(t, second, "copilot-report", "echo second")
+	require.NoError(t, os.WriteFile(filepath.Join(first, "copilot-notes"), []byte("not executable"), 0644))
+	require.NoError(t, os.Mkdir(filepath.Join(first, "copilot-dir"), 0755))
+	finder := &Finder{
+		Dirs: strings.Join([]string{first, "", second}, string(os.PathListSeparator)),
+	}
+
+	testCases := map[string]struct {
+		name string
+
+		wantedPath string
+	}{
+		"first executable in the directories": {
+			name:       "report",
+			wantedPath: shadowing,
+		},
+		"ignore files that are not executable": {
+			name: "notes",
+		},
+		"ignore directories": {
+			name: "dir",
+		},
+		"ignore names that are paths": {
+			name: "../report",
+		},
+		"ignore flags": {
+			name: "-report",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := finder.Find(tc.name)
+
+			if tc.wantedPath == "" {
+				var notFound *ErrNotFound
+				require.True(t, errors.As(err, &notFound))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, &Plugin{Name: tc.name, Path: tc.wantedPath}, got)
+		})
+	}
+}
+
+func TestFinder_List(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("plugins are shell scripts in the tests")
+	}
+	first, second := t.TempDir(), t.TempDir()
+	report := writeExecutable(t, first, "copilot-report", "echo first")
+	writeExecutable(t, second, "copilot-report", "echo second")
+	audit := writeExecutable(t, second, "copilot-audit-export", "echo audit")
+	writeExecutable(t, second, "copilot-", "echo no name")
+	writeExecutable(t, second, "kubectl-report", "echo other tool")
+	require.NoError(t, os.WriteFile(filepath.Join(first, "copilot-notes"), []byte("not executable"), 0644))
+	finder := &Finder{
+		Dirs: strings.Join([]string{first, filepath.Join(first, "missing"), second}, string(os.PathListSeparator)),
+	}
+
+	got, err := finder.List()
+
+	require.NoError(t, err)
+	require.Equal(t, []Plugin{
+		{Name: "audit-export", Path: audit},
+		{Name: "report", Path: report},
+	}, got)
+}
+
+func TestPlugin_Run(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("plugins are shell scripts in the tests")
+	}
+	testCases := map[string]struct {
+		script string
+
+		wantedErr    error
+		wantedStdout string
+		wantedStderr string
+	}{
+		"forwards the arguments and the standard streams": {
+			script:       `read line; echo "$line $@"; echo warning >&2; test -n "$COPILOT_BIN"`,
+			wantedStdout: "hello --env test\n",
+			wantedStderr: "warning\n",
+		},
+		"returns the exit code of the plugin": {
+			script:    "exit 3",
+			wantedErr: errors.New("plugin report exited with code 3"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			p := &Plugin{
+				Name: "report",
+				Path: writeExecutable(t, t.TempDir(), "copilot-report", tc.script),
+			}
+			stdout, stderr := &strings.Builder{}, &strings.Builder{}
+
+			err := p.Run([]string{"--env", "test"}, strings.NewReader("hello\n"), stdout, stderr)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				var exitErr *ErrExitCode
+				require.True(t, errors.As(err, &exitErr))
+				require.Equal(t, 3, exitErr.ExitCode())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedStdout, stdout.String())
+			require.Equal(t, tc.wantedStderr, stderr.String())
+		})
+	}
+}
@@ -6,15 +6,45 @@ package jobrunner
 
 import (
 	"fmt"
+	"io"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 )
 
-// StateMachineExecutor is the interface that implements the Execute method to invoke a state machine.
+const (
+	defaultPollInterval       = 10 * time.Second
+	defaultFollowPollInterval = 2 * time.Second
+
+	fmtJobLogGroupName    = "/copilot/%s-%s-%s"
+	fmtJobLogStreamPrefix = "copilot/%s/%s" // The main container of a job is named after the job.
+)
+
+// StateMachineExecutor is the interface that implements the Execute method to invoke a state machine,
+// the DescribeExecution method to wait for the execution to complete,
+// and the ExecutionTaskARNs method to find the tasks started by the execution.
 type StateMachineExecutor interface {
-	Execute(stateMachineARN string) error
+	Execute(stateMachineARN string) (string, error)
+	DescribeExecution(executionARN string) (*stepfunctions.Execution, error)
+	ExecutionTaskARNs(executionARN string) ([]string, error)
+}
+
+// LogEventsGetter is the interface to get the log events of the tasks of a job.
+type LogEventsGetter interface {
+	LogEvents(opts cloudwatchlogs.LogEventsOpts) (*cloudwatchlogs.LogEventsOutput, error)
+}
+
+// ExitCodeChecker is the interface to check the exit code of the tasks of a job.
+// If a task exited with a non-zero code, HasNonZeroExitCode returns an error that implements ExitCode() int.
+type ExitCodeChecker interface {
+	ClusterARN(app, env string) (string, error)
+	HasNonZeroExitCode(taskARNs []string, cluster string) error
 }
 
 // CFNStackResourceLister is the interface to list CloudFormation stack resources.
@@ -28,8 +58,18 @@ type JobRunner struct {
 	env string
 	job string
 
+	wait         bool
+	follow       bool
+	timeout      time.Duration
+	pollInterval time.Duration
+
 	cfn          CFNStackResourceLister
 	stateMachine StateMachineExecutor
+	logs         LogEventsGetter
+	exitCodes    ExitCodeChecker
+	logWriter    io.Writer
+	now          func() time.Time
+	sleep        func(time.Duration)
 }
 
 // Config hold the data needed to create a JobRunner.
@@ -38,25 +78,54 @@ type Config struct {
 	Env string // Name of the environment.
 	Job string // Name of the job.
 
+	Wait         bool          // Whether to wait for the execution of the job to complete.
+	Follow       bool          // Whether to stream the logs of the job's task while waiting for the execution to complete.
+	Timeout      time.Duration // Maximum time to wait for the execution. Zero to wait until it completes.
+	PollInterval time.Duration // Interval between two checks of the status of the execution. Defaults to 10 seconds, or 2 seconds when following.
+
 	// Dependencies to invoke a job.
 	CFN          CFNStackResourceLister // CloudFormation client to list stack resources.
 	StateMachine StateMachineExecutor   // StepFunction client to execute a state machine.
+
+	// Dependencies to follow the execution of a job, only required if Follow is true.
+	Logs      LogEventsGetter // CloudWatch Logs client to get the logs of the job's task.
+	ExitCodes ExitCodeChecker // ECS client to check the exit code of the job's task.
+	LogWriter io.Writer       // Where to write the logs of the job's task.
 }
 
 // New creates a new JobRunner.
 func New(cfg *Config) *JobRunner {
+	interval := cfg.PollInterval
+	if interval == 0 {
+		interval = defaultPollInterval
+		if cfg.Follow {
+			interval = defaultFollowPollInterval
+		}
+	}
 	return &JobRunner{
 		app:          cfg.App,
 		env:          cfg.Env,
 		job:          cfg.Job,
+		wait:         cfg.Wait,
+		follow:       cfg.Follow,
+		timeout:      cfg.Timeout,
+		pollInterval: interval,
 		cfn:          cfg.CFN,
 		stateMachine: cfg.StateMachine,
+		logs:         cfg.Logs,
+		exitCodes:    cfg.ExitCodes,
+		logWriter:    cfg.LogWriter,
+		now:          time.Now,
+		sleep:        time.Sleep,
 	}
 
 }
 
 // Run invokes a job.
 // An error is returned if the state machine's ARN can not be derived from the job, or the execution fails.
+// If the runner waits for the execution, an *ErrExecutionFailed is returned if it doesn't succeed.
+// If the runner follows the execution and the job's task exits with a non-zero code, the error implements ExitCode() int
+// and returns the exit code of the task.
 func (job *JobRunner) Run() error {
 	resources, err := job.cfn.StackResources(stack.NameForWorkload(job.app, job.env, job.job))
 	if err != nil {
@@ -73,8 +142,191 @@ func (job *JobRunner) Run() error {
 	if arn == "" {
 		return fmt.Errorf("state machine for job %q is not found in environment %q and application %q", job.job, job.env, job.app)
 	}
-	if err := job.stateMachine.Execute(arn); err != nil {
+	executionARN, err := job.stateMachine.Execute(arn)
+	if err != nil {
 		return fmt.Errorf("execute state machine %q: %v", arn, err)
 	}
+	if job.follow {
+		return job.followExecution(executionARN)
+	}
+	if !job.wait {
+		return nil
+	}
+	return job.waitForExecution(executionARN)
+}
+
+func (job *JobRunner) waitForExecution(executionARN string) error {
+	deadline := job.now().Add(job.timeout)
+	for {
+		execution, err := job.stateMachine.DescribeExecution(executionARN)
+		if err != nil {
+			return err
+		}
+		if execution.Status != stepfunctions.ExecutionStatusRunning {
+			return executionResult(executionARN, execution)
+		}
+		if job.timeout != 0 && !job.now().Before(deadline) {
+			return &ErrExecutionFailed{
+				ExecutionARN:   executionARN,
+				Classification: FailureTimeout,
+				Reason:         fmt.Sprintf("execution did not complete within %s and is still running", job.timeout),
+			}
+		}
+		job.sleep(job.pollInterval)
+	}
+}
+
+// followExecution writes the logs of the tasks started by the execution until it completes.
+func (job *JobRunner) followExecution(executionARN string) error {
+	deadline := job.now().Add(job.timeout)
+	logEventsOpts := cloudwatchlogs.LogEventsOpts{
+		LogGroup: fmt.Sprintf(fmtJobLogGroupName, job.app, job.env, job.job),
+	}
+	for {
+		execution, err := job.stateMachine.DescribeExecution(executionARN)
+		if err != nil {
+			return err
+		}
+		// The execution starts a new task on each retry, so we look for new tasks on every check.
+		taskARNs, err := job.stateMachine.ExecutionTaskARNs(executionARN)
+		if err != nil {
+			return err
+		}
+		if err := job.writeTaskLogs(&logEventsOpts, taskARNs); err != nil {
+			return err
+		}
+		if execution.Status != stepfunctions.ExecutionStatusRunning {
+			if err := job.checkExitCode(taskARNs); err != nil {
+				return err
+			}
+			return executionResult(executionARN, execution)
+		}
+		if job.timeout != 0 && !job.now().Before(deadline) {
+			return &ErrExecutionFailed{
+				ExecutionARN:   executionARN,
+				Classification: FailureTimeout,
+				Reason:         fmt.Sprintf("execution did not complete within %s and is still running", job.timeout),
+			}
+		}
+		job.sleep(job.pollInterval)
+	}
+}
+
+func (job *JobRunner) writeTaskLogs(opts *cloudwatchlogs.LogEventsOpts, taskARNs []string) error {
+	if len(taskARNs) == 0 {
+		return nil
+	}
+	var prefixes []string
+	for _, taskARN := range taskARNs {
+		taskID, err := ecs.TaskID(taskARN)
+		if err != nil {
+			return err
+		}
+		prefixes = append(prefixes, fmt.Sprintf(fmtJobLogStreamPrefix, job.job, taskID))
+	}
+	opts.LogStreamPrefixFilters = prefixes
+	out, err := job.logs.LogEvents(*opts)
+	if err != nil {
+		return fmt.Errorf("get log events for log group %s: %w", opts.LogGroup, err)
+	}
+	for _, event := range out.Events {
+		fmt.Fprint(job.logWriter, event.HumanString())
+	}
+	opts.StreamLastEventTime = out.StreamLastEventTime
 	return nil
 }
+
+// checkExitCode returns an error if the last task started by the execution exited with a non-zero code.
+func (job *JobRunner) checkExitCode(taskARNs []string) error {
+	if len(taskARNs) == 0 {
+		return nil
+	}
+	cluster, err := job.exitCodes.ClusterARN(job.app, job.env)
+	if err != nil {
+		return fmt.Errorf("get cluster of environment %q: %w", job.env, err)
+	}
+	return job.exitCodes.HasNonZeroExitCode(taskARNs[len(taskARNs)-1:], cluster)
+}
+
+func executionResult(executionARN string, execution *stepfunctions.Execution) error {
+	if execution.Status == stepfunctions.ExecutionStatusSucceeded {
+		return nil
+	}
+	reason := fmt.Sprintf("execution completed with status %s", execution.Status)
+	if execution.Error != "" {
+		reason = fmt.Sprintf("%s: %s", reason, execution.Error)
+	}
+	if execution.Cause != "" {
+		reason = fmt.Sprintf("%s (%s)", reason, execution.Cause)
+	}
+	return &ErrExecutionFailed{
+		ExecutionARN:   executionARN,
+		Classification: classify(execution),
+		Reason:         reason,
+	}
+}
+
+// classify returns the kind of failure of a completed execution from its status and the error that it failed with.
+func classify(execution *stepfunctions.Execution) string {
+	switch {
+	case execution.Status == stepfunctions.ExecutionStatusTimedOut,
+		execution.Error == "States.Timeout", execution.Error == "States.HeartbeatTimeout":
+		return FailureTimeout
+	case execution.Status == stepfunctions.ExecutionStatusAborted:
+		return FailureAborted
+	case isThrottling(execution.Error), isThrottling(execution.Cause):
+		return FailureThrottling
+	case execution.Error == "States.TaskFailed":
+		return FailureTaskFailed
+	case strings.HasPrefix(execution.Error, "States."):
+		return FailureStatesRuntimeError
+	default:
+		return FailureUnknown
+	}
+}
+
+func isThrottling(msg string) bool {
+	for _, marker := range []string{"Throttl", "TooManyRequests", "Rate exceeded"} {
+		if strings.Contains(msg, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// Classifications of a failed execution.
+const (
+	FailureTaskFailed         = "task-failure"
+	FailureTimeout            = "timeout"
+	FailureThrottling         = "throttling"
+	FailureStatesRuntimeError = "states-runtime-error"
+	FailureAborted            = "aborted"
+	FailureUnknown            = "unknown"
+)
+
+// exitCodes are the exit codes of "copilot job run --wait" for each classification of failure.
+var exitCodes = map[string]int{
+	FailureTaskFailed:         2,
+	FailureTimeout:            3,
+	FailureThrottling:         4,
+	FailureStatesRuntimeError: 5,
+}
+
+// ErrExecutionFailed is returned when the execution of a job that the runner waits for doesn't succeed.
+type ErrExecutionFailed struct {
+	ExecutionARN   string
+	Classification string // One of the Failure* classifications.
+	Reason         string
+}
+
+func (e *ErrExecutionFailed) Error() string {
+	return fmt.Sprintf("execution %s failed with %s: %s", e.ExecutionARN, e.Classification, e.Reason)
+}
+
+// ExitCode returns a distinct exit code for each classification of failure, and 1 for the other failures.
+func (e *ErrExecutionFailed) ExitCode() int {
+	if code, ok := exitCodes[e.Classification]; ok {
+		return code
+	}
+	return 1
+}
@@ -4,12 +4,16 @@
 package jobrunner
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions"
 	"github.com/aws/copilot-cli/internal/pkg/runner/jobrunner/mocks"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -24,14 +28,21 @@ func TestJobRunner_Run(t *testing.T) {
 		Env string
 		Job string
 
-		MockCFN func(m *mocks.MockCFNStackResourceLister)
+		Wait    bool
+		Follow  bool
+		Timeout time.Duration
 
+		MockCFN       func(m *mocks.MockCFNStackResourceLister)
+		MockLogs      func(m *mocks.MockLogEventsGetter)
+		MockExitCodes func(m *mocks.MockExitCodeChecker)
+
+		wantedLogs  string
 		wantedError error
 	}{
 
 		"missing stack": {
 			MockExecutor: func(m *mocks.MockStateMachineExecutor) {
-				m.EXPECT().Execute("arn:aws:states:us-east-1:111111111111:stateMachine:app-env-job").Return(nil).AnyTimes()
+				m.EXPECT().Execute("arn:aws:states:us-east-1:111111111111:stateMachine:app-env-job").Return("", nil).AnyTimes()
 			},
 			App: "appname",
 			Env: "envname",
@@ -44,7 +55,7 @@ func TestJobRunner_Run(t *testing.T) {
 
 		"missing statemachine resource": {
 			MockExecutor: func(m *mocks.MockStateMachineExecutor) {
-				m.EXPECT().Execute("arn:aws:states:us-east-1:111111111111:stateMachine:app-env-job").Return(nil).AnyTimes()
+				m.EXPECT().Execute("arn:aws:states:us-east-1:111111111111:stateMachine:app-env-job").Return("", nil).AnyTimes()
 			},
 			App: "appname",
 			Env: "envname",
@@ -61,7 +72,7 @@ func TestJobRunner_Run(t *testing.T) {
 
 		"failed statemachine execution": {
 			MockExecutor: func(m *mocks.MockStateMachineExecutor) {
-				m.EXPECT().Execute("arn:aws:states:us-east-1:111111111111:stateMachine:app-env-job").Return(fmt.Errorf("ExecutionLimitExceeded"))
+				m.EXPECT().Execute("arn:aws:states:us-east-1:111111111111:stateMachine:app-env-job").Return("", fmt.Errorf("ExecutionLimitExceeded"))
 			},
 			App: "appname",
 			Env: "envname",
@@ -79,7 +90,7 @@ func TestJobRunner_Run(t *testing.T) {
 
 		"run success": {
 			MockExecutor: func(m *mocks.MockStateMachineExecutor) {
-				m.EXPECT().Execute("arn:aws:states:us-east-1:111111111111:stateMachine:app-env-job").Return(nil)
+				m.EXPECT().Execute("arn:aws:states:us-east-1:111111111111:stateMachine:app-env-job").Return("arn:aws:states:us-east-1:111111111111:execution:app-env-job:1", nil)
 			},
 			App: "appname",
 			Env: "envname",
@@ -93,6 +104,212 @@ func TestJobRunner_Run(t *testing.T) {
 				}, nil)
 			},
 		},
+		"wait until the execution succeeds": {
+			MockExecutor: func(m *mocks.MockStateMachineExecutor) {
+				m.EXPECT().Execute("arn:aws:states:us-east-1:111111111111:stateMachine:app-env-job").Return("arn:aws:states:us-east-1:111111111111:execution:app-env-job:1", nil)
+				gomock.InOrder(
+					m.EXPECT().DescribeExecution("arn:aws:states:us-east-1:111111111111:execution:app-env-job:1").Return(&stepfunctions.Execution{
+						Status: stepfunctions.ExecutionStatusRunning,
+					}, nil),
+					m.EXPECT().DescribeExecution("arn:aws:states:us-east-1:111111111111:execution:app-env-job:1").Return(&stepfunctions.Execution{
+						Status: stepfunctions.ExecutionStatusSucceeded,
+					}, nil),
+				)
+			},
+			App:  "appname",
+			Env:  "envname",
+			Job:  "jobname",
+			Wait: true,
+			MockCFN: func(m *mocks.MockCFNStackResourceLister) {
+				m.EXPECT().StackResources("appname-envname-jobname").Return([]*cloudformation.StackResource{
+					{
+						ResourceType:       aws.String("AWS::StepFunctions::StateMachine"),
+						PhysicalResourceId: aws.String("arn:aws:states:us-east-1:111111111111:stateMachine:app-env-job"),
+					},
+				}, nil)
+			},
+		},
+		"wait for an execution whose task fails": {
+			MockExecutor: func(m *mocks.MockStateMachineExecutor) {
+				m.EXPECT().Execute("arn:aws:states:us-east-1:111111111111:stateMachine:app-env-job").Return("arn:aws:states:us-east-1:111111111111:execution:app-env-job:1", nil)
+				m.EXPECT().DescribeExecution("arn:aws:states:us-east-1:111111111111:execution:app-env-job:1").Return(&stepfunctions.Execution{
+					Status: stepfunctions.ExecutionStatusFailed,
+					Error:  "States.TaskFailed",
+					Cause:  "Essential container in task exited",
+				}, nil)
+			},
+			App:  "appname",
+			Env:  "envname",
+			Job:  "jobname",
+			Wait: true,
+			MockCFN: func(m *mocks.MockCFNStackResourceLister) {
+				m.EXPECT().StackResources("appname-envname-jobname").Return([]*cloudformation.StackResource{
+					{
+						ResourceType:       aws.String("AWS::StepFunctions::StateMachine"),
+						PhysicalResourceId: aws.String("arn:aws:states:us-east-1:111111111111:stateMachine:app-env-job"),
+					},
+				}, nil)
+			},
+			wantedError: &ErrExecutionFailed{
+				ExecutionARN:   "arn:aws:states:us-east-1:111111111111:execution:app-env-job:1",
+				Classification: FailureTaskFailed,
+				Reason:         "execution completed with status FAILED: States.TaskFailed (Essential container in task exited)",
+			},
+		},
+		"wait for an execution that does not complete within the timeout": {
+			MockExecutor: func(m *mocks.MockStateMachineExecutor) {
+				m.EXPECT().Execute("arn:aws:states:us-east-1:111111111111:stateMachine:app-env-job").Return("arn:aws:states:us-east-1:111111111111:execution:app-env-job:1", nil)
+				m.EXPECT().DescribeExecution("arn:aws:states:us-east-1:111111111111:execution:app-env-job:1").Return(&stepfunctions.Execution{
+					Status: stepfunctions.ExecutionStatusRunning,
+				}, nil).Times(3)
+			},
+			App:     "appname",
+			Env:     "envname",
+			Job:     "jobname",
+			Wait:    true,
+			Timeout: 20 * time.Second,
+			MockCFN: func(m *mocks.MockCFNStackResourceLister) {
+				m.EXPECT().StackResources("appname-envname-jobname").Return([]*cloudformation.StackResource{
+					{
+						ResourceType:       aws.String("AWS::StepFunctions::StateMachine"),
+						PhysicalResourceId: aws.String("arn:aws:states:us-east-1:111111111111:stateMachine:app-env-job"),
+					},
+				}, nil)
+			},
+			wantedError: &ErrExecutionFailed{
+				ExecutionARN:   "arn:aws:states:us-east-1:111111111111:execution:app-env-job:1",
+				Classification: FailureTimeout,
+				Reason:         "execution did not complete within 20s and is still running",
+			},
+		},
+		"error if the execution cannot be described": {
+			MockExecutor: func(m *mocks.MockStateMachineExecutor) {
+				m.EXPECT().Execute("arn:aws:states:us-east-1:111111111111:stateMachine:app-env-job").Return("arn:aws:states:us-east-1:111111111111:execution:app-env-job:1", nil)
+				m.EXPECT().DescribeExecution("arn:aws:states:us-east-1:111111111111:execution:app-env-job:1").Return(nil, errors.New("some error"))
+			},
+			App:  "appname",
+			Env:  "envname",
+			Job:  "jobname",
+			Wait: true,
+			MockCFN: func(m *mocks.MockCFNStackResourceLister) {
+				m.EXPECT().StackResources("appname-envname-jobname").Return([]*cloudformation.StackResource{
+					{
+						ResourceType:       aws.String("AWS::StepFunctions::StateMachine"),
+						PhysicalResourceId: aws.String("arn:aws:states:us-east-1:111111111111:stateMachine:app-env-job"),
+					},
+				}, nil)
+			},
+			wantedError: errors.New("some error"),
+		},
+		"follow the logs of the execution until its task exits with a non-zero code": {
+			MockExecutor: func(m *mocks.MockStateMachineExecutor) {
+				m.EXPECT().Execute("arn:aws:states:us-east-1:111111111111:stateMachine:app-env-job").Return("arn:aws:states:us-east-1:111111111111:execution:app-env-job:1", nil)
+				gomock.InOrder(
+					m.EXPECT().DescribeExecution("arn:aws:states:us-east-1:111111111111:execution:app-env-job:1").Return(&stepfunctions.Execution{
+						Status: stepfunctions.ExecutionStatusRunning,
+					}, nil),
+					m.EXPECT().DescribeExecution("arn:aws:states:us-east-1:111111111111:execution:app-env-job:1").Return(&stepfunctions.Execution{
+						Status: stepfunctions.ExecutionStatusFailed,
+						Error:  "States.TaskFailed",
+					}, nil),
+				)
+				gomock.InOrder(
+					m.EXPECT().ExecutionTaskARNs("arn:aws:states:us-east-1:111111111111:execution:app-env-job:1").Return(nil, nil),
+					m.EXPECT().ExecutionTaskARNs("arn:aws:states:us-east-1:111111111111:execution:app-env-job:1").Return([]string{
+						"arn:aws:ecs:us-east-1:111111111111:task/cluster/1",
+						"arn:aws:ecs:us-east-1:111111111111:task/cluster/2",
+					}, nil),
+				)
+			},
+			App:    "appname",
+			Env:    "envname",
+			Job:    "jobname",
+			Follow: true,
+			MockCFN: func(m *mocks.MockCFNStackResourceLister) {
+				m.EXPECT().StackResources("appname-envname-jobname").Return([]*cloudformation.StackResource{
+					{
+						ResourceType:       aws.String("AWS::StepFunctions::StateMachine"),
+						PhysicalResourceId: aws.String("arn:aws:states:us-east-1:111111111111:stateMachine:app-env-job"),
+					},
+				}, nil)
+			},
+			MockLogs: func(m *mocks.MockLogEventsGetter) {
+				m.EXPECT().LogEvents(cloudwatchlogs.LogEventsOpts{
+					LogGroup:               "/copilot/appname-envname-jobname",
+					LogStreamPrefixFilters: []string{"copilot/jobname/1", "copilot/jobname/2"},
+				}).Return(&cloudwatchlogs.LogEventsOutput{
+					Events: []*cloudwatchlogs.Event{
+						{
+							LogStreamName: "copilot/jobname/2",
+							Message:       "failed to generate the report",
+						},
+					},
+				}, nil)
+			},
+			MockExitCodes: func(m *mocks.MockExitCodeChecker) {
+				m.EXPECT().ClusterARN("appname", "envname").Return("cluster", nil)
+				m.EXPECT().HasNonZeroExitCode([]string{"arn:aws:ecs:us-east-1:111111111111:task/cluster/2"}, "cluster").Return(errors.New("container jobname in task 2 exited with status code 42"))
+			},
+			wantedLogs:  "copilot/jobname/2 failed to generate the report\n",
+			wantedError: errors.New("container jobname in task 2 exited with status code 42"),
+		},
+		"follow an execution whose task succeeds": {
+			MockExecutor: func(m *mocks.MockStateMachineExecutor) {
+				m.EXPECT().Execute("arn:aws:states:us-east-1:111111111111:stateMachine:app-env-job").Return("arn:aws:states:us-east-1:111111111111:execution:app-env-job:1", nil)
+				m.EXPECT().DescribeExecution("arn:aws:states:us-east-1:111111111111:execution:app-env-job:1").Return(&stepfunctions.Execution{
+					Status: stepfunctions.ExecutionStatusSucceeded,
+				}, nil)
+				m.EXPECT().ExecutionTaskARNs("arn:aws:states:us-east-1:111111111111:execution:app-env-job:1").Return([]string{
+					"arn:aws:ecs:us-east-1:111111111111:task/cluster/1",
+				}, nil)
+			},
+			App:    "appname",
+			Env:    "envname",
+			Job:    "jobname",
+			Follow: true,
+			MockCFN: func(m *mocks.MockCFNStackResourceLister) {
+				m.EXPECT().StackResources("appname-envname-jobname").Return([]*cloudformation.StackResource{
+					{
+						ResourceType:       aws.String("AWS::StepFunctions::StateMachine"),
+						PhysicalResourceId: aws.String("arn:aws:states:us-east-1:111111111111:stateMachine:app-env-job"),
+					},
+				}, nil)
+			},
+			MockLogs: func(m *mocks.MockLogEventsGetter) {
+				m.EXPECT().LogEvents(gomock.Any()).Return(&cloudwatchlogs.LogEventsOutput{}, nil)
+			},
+			MockExitCodes: func(m *mocks.MockExitCodeChecker) {
+				m.EXPECT().ClusterARN("appname", "envname").Return("cluster", nil)
+				m.EXPECT().HasNonZeroExitCode([]string{"arn:aws:ecs:us-east-1:111111111111:task/cluster/1"}, "cluster").Return(nil)
+			},
+		},
+		"error if the logs of the task cannot be retrieved": {
+			MockExecutor: func(m *mocks.MockStateMachineExecutor) {
+				m.EXPECT().Execute("arn:aws:states:us-east-1:111111111111:stateMachine:app-env-job").Return("arn:aws:states:us-east-1:111111111111:execution:app-env-job:1", nil)
+				m.EXPECT().DescribeExecution("arn:aws:states:us-east-1:111111111111:execution:app-env-job:1").Return(&stepfunctions.Execution{
+					Status: stepfunctions.ExecutionStatusRunning,
+				}, nil)
+				m.EXPECT().ExecutionTaskARNs("arn:aws:states:us-east-1:111111111111:execution:app-env-job:1").Return([]string{
+					"arn:aws:ecs:us-east-1:111111111111:task/cluster/1",
+				}, nil)
+			},
+			App:    "appname",
+			Env:    "envname",
+			Job:    "jobname",
+			Follow: true,
+			MockCFN: func(m *mocks.MockCFNStackResourceLister) {
+				m.EXPECT().StackResources("appname-envname-jobname").Return([]*cloudformation.StackResource{
+					{
+						ResourceType:       aws.String("AWS::StepFunctions::StateMachine"),
+						PhysicalResourceId: aws.String("arn:aws:states:us-east-1:111111111111:stateMachine:app-env-job"),
+					},
+				}, nil)
+			},
+			MockLogs: func(m *mocks.MockLogEventsGetter) {
+				m.EXPECT().LogEvents(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get log events for log group /copilot/appname-envname-jobname: some error"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -104,15 +321,35 @@ func TestJobRunner_Run(t *testing.T) {
 			cfn := mocks.NewMockCFNStackResourceLister(ctrl)
 			sfn := mocks.NewMockStateMachineExecutor(ctrl)
 
+			logs := mocks.NewMockLogEventsGetter(ctrl)
+			exitCodes := mocks.NewMockExitCodeChecker(ctrl)
+
 			tc.MockCFN(cfn)
 			tc.MockExecutor(sfn)
+			if tc.MockLogs != nil {
+				tc.MockLogs(logs)
+			}
+			if tc.MockExitCodes != nil {
+				tc.MockExitCodes(exitCodes)
+			}
+			logWriter := &bytes.Buffer{}
 
+			now := time.Date(2024, time.March, 1, 10, 0, 0, 0, time.UTC)
 			jobRunner := JobRunner{
 				stateMachine: sfn,
 				app:          tc.App,
 				env:          tc.Env,
 				job:          tc.Job,
+				wait:         tc.Wait,
+				follow:       tc.Follow,
+				timeout:      tc.Timeout,
+				pollInterval: 10 * time.Second,
 				cfn:          cfn,
+				logs:         logs,
+				exitCodes:    exitCodes,
+				logWriter:    logWriter,
+				now:          func() time.Time { return now },
+				sleep:        func(d time.Duration) { now = now.Add(d) },
 			}
 
 			err := jobRunner.Run()
@@ -122,6 +359,60 @@ func TestJobRunner_Run(t *testing.T) {
 			} else {
 				require.NoError(t, err)
 			}
+			require.Equal(t, tc.wantedLogs, logWriter.String())
+		})
+	}
+}
+
+func TestClassify(t *testing.T) {
+	testCases := map[string]struct {
+		in     stepfunctions.Execution
+		wanted string
+	}{
+		"timed out execution": {
+			in:     stepfunctions.Execution{Status: stepfunctions.ExecutionStatusTimedOut},
+			wanted: FailureTimeout,
+		},
+		"timed out state": {
+			in:     stepfunctions.Execution{Status: stepfunctions.ExecutionStatusFailed, Error: "States.Timeout"},
+			wanted: FailureTimeout,
+		},
+		"aborted execution": {
+			in:     stepfunctions.Execution{Status: stepfunctions.ExecutionStatusAborted},
+			wanted: FailureAborted,
+		},
+		"throttled ECS call": {
+			in: stepfunctions.Execution{
+				Status: stepfunctions.ExecutionStatusFailed,
+				Error:  "ECS.AmazonECSException",
+				Cause:  "Rate exceeded (Service: AmazonECS; Status Code: 400; Error Code: ThrottlingException)",
+			},
+			wanted: FailureThrottling,
+		},
+		"failed task": {
+			in:     stepfunctions.Execution{Status: stepfunctions.ExecutionStatusFailed, Error: "States.TaskFailed"},
+			wanted: FailureTaskFailed,
+		},
+		"states runtime error": {
+			in:     stepfunctions.Execution{Status: stepfunctions.ExecutionStatusFailed, Error: "States.Runtime"},
+			wanted: FailureStatesRuntimeError,
+		},
+		"other error": {
+			in:     stepfunctions.Execution{Status: stepfunctions.ExecutionStatusFailed, Error: "ECS.AccessDeniedException"},
+			wanted: FailureUnknown,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, classify(&tc.in))
 		})
 	}
 }
+
+func TestErrExecutionFailed_ExitCode(t *testing.T) {
+	require.Equal(t, 2, (&ErrExecutionFailed{Classification: FailureTaskFailed}).ExitCode())
+	require.Equal(t, 3, (&ErrExecutionFailed{Classification: FailureTimeout}).ExitCode())
+	require.Equal(t, 4, (&ErrExecutionFailed{Classification: FailureThrottling}).ExitCode())
+	require.Equal(t, 5, (&ErrExecutionFailed{Classification: FailureStatesRuntimeError}).ExitCode())
+	require.Equal(t, 1, (&ErrExecutionFailed{Classification: FailureAborted}).ExitCode())
+}
@@ -8,6 +8,8 @@ import (
 	reflect "reflect"
 
 	cloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	cloudwatchlogs "github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	stepfunctions "github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -34,12 +36,28 @@ func (m *MockStateMachineExecutor) EXPECT() *MockStateMachineExecutorMockRecorde
 	return m.recorder
 }
 
+// DescribeExecution mocks base method.
+func (m *MockStateMachineExecutor) DescribeExecution(executionARN string) (*stepfunctions.Execution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeExecution", executionARN)
+	ret0, _ := ret[0].(*stepfunctions.Execution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeExecution indicates an expected call of DescribeExecution.
+func (mr *MockStateMachineExecutorMockRecorder) DescribeExecution(executionARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeExecution", reflect.TypeOf((*MockStateMachineExecutor)(nil).DescribeExecution), executionARN)
+}
+
 // Execute mocks base method.
-func (m *MockStateMachineExecutor) Execute(stateMachineARN string) error {
+func (m *MockStateMachineExecutor) Execute(stateMachineARN string) (string, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Execute", stateMachineARN)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // Execute indicates an expected call of Execute.
@@ -48,6 +66,111 @@ func (mr *MockStateMachineExecutorMockRecorder) Execute(stateMachineARN interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockStateMachineExecutor)(nil).Execute), stateMachineARN)
 }
 
+// ExecutionTaskARNs mocks base method.
+func (m *MockStateMachineExecutor) ExecutionTaskARNs(executionARN string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecutionTaskARNs", executionARN)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecutionTaskARNs indicates an expected call of ExecutionTaskARNs.
+func (mr *MockStateMachineExecutorMockRecorder) ExecutionTaskARNs(executionARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecutionTaskARNs", reflect.TypeOf((*MockStateMachineExecutor)(nil).ExecutionTaskARNs), executionARN)
+}
+
+// MockLogEventsGetter is a mock of LogEventsGetter interface.
+type MockLogEventsGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockLogEventsGetterMockRecorder
+}
+
+// MockLogEventsGetterMockRecorder is the mock recorder for MockLogEventsGetter.
+type MockLogEventsGetterMockRecorder struct {
+	mock *MockLogEventsGetter
+}
+
+// NewMockLogEventsGetter creates a new mock instance.
+func NewMockLogEventsGetter(ctrl *gomock.Controller) *MockLogEventsGetter {
+	mock := &MockLogEventsGetter{ctrl: ctrl}
+	mock.recorder = &MockLogEventsGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLogEventsGetter) EXPECT() *MockLogEventsGetterMockRecorder {
+	return m.recorder
+}
+
+// LogEvents mocks base method.
+func (m *MockLogEventsGetter) LogEvents(opts cloudwatchlogs.LogEventsOpts) (*cloudwatchlogs.LogEventsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogEvents", opts)
+	ret0, _ := ret[0].(*cloudwatchlogs.LogEventsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LogEvents indicates an expected call of LogEvents.
+func (mr *MockLogEventsGetterMockRecorder) LogEvents(opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogEvents", reflect.TypeOf((*MockLogEventsGetter)(nil).LogEvents), opts)
+}
+
+// MockExitCodeChecker is a mock of ExitCodeChecker interface.
+type MockExitCodeChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockExitCodeCheckerMockRecorder
+}
+
+// MockExitCodeCheckerMockRecorder is the mock recorder for MockExitCodeChecker.
+type MockExitCodeCheckerMockRecorder struct {
+	mock *MockExitCodeChecker
+}
+
+// NewMockExitCodeChecker creates a new mock instance.
+func NewMockExitCodeChecker(ctrl *gomock.Controller) *MockExitCodeChecker {
+	mock := &MockExitCodeChecker{ctrl: ctrl}
+	mock.recorder = &MockExitCodeCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExitCodeChecker) EXPECT() *MockExitCodeCheckerMockRecorder {
+	return m.recorder
+}
+
+// ClusterARN mocks base method.
+func (m *MockExitCodeChecker) ClusterARN(app, env string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClusterARN", app, env)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClusterARN indicates an expected call of ClusterARN.
+func (mr *MockExitCodeCheckerMockRecorder) ClusterARN(app, env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClusterARN", reflect.TypeOf((*MockExitCodeChecker)(nil).ClusterARN), app, env)
+}
+
+// HasNonZeroExitCode mocks base method.
+func (m *MockExitCodeChecker) HasNonZeroExitCode(taskARNs []string, cluster string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasNonZeroExitCode", taskARNs, cluster)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HasNonZeroExitCode indicates an expected call of HasNonZeroExitCode.
+func (mr *MockExitCodeCheckerMockRecorder) HasNonZeroExitCode(taskARNs, cluster interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasNonZeroExitCode", reflect.TypeOf((*MockExitCodeChecker)(nil).HasNonZeroExitCode), taskARNs, cluster)
+}
+
 // MockCFNStackResourceLister is a mock of CFNStackResourceLister interface.
 type MockCFNStackResourceLister struct {
 	ctrl     *gomock.Controller
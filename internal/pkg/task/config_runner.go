@@ -40,6 +40,10 @@ type ConfigRunner struct {
 
 	// Platform configuration
 	OS string
+
+	// Infrastructure to launch the tasks on. Defaults to Fargate.
+	LaunchType       string
+	CapacityProvider string
 }
 
 // Run runs tasks given subnets, security groups and the cluster, and returns the tasks.
@@ -84,6 +88,9 @@ func (r *ConfigRunner) Run() ([]*Task, error) {
 		StartedBy:       startedBy,
 		PlatformVersion: platformVersion,
 		EnableExec:      true,
+
+		LaunchType:       ecsLaunchType(r.LaunchType),
+		CapacityProvider: r.CapacityProvider,
 	})
 	if err != nil {
 		return nil, &errRunTask{
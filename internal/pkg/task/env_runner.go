@@ -44,6 +44,10 @@ type EnvRunner struct {
 	// Platform configuration.
 	OS string
 
+	// Infrastructure to launch the tasks on. Defaults to Fargate.
+	LaunchType       string
+	CapacityProvider string
+
 	// Interfaces to interact with dependencies. Must not be nil.
 	VPCGetter            VPCGetter
 	ClusterGetter        ClusterGetter
@@ -69,12 +73,15 @@ func (r *EnvRunner) Run() ([]*Task, error) {
 	if err != nil {
 		return nil, fmt.Errorf(fmtErrDescribeEnvironment, r.Env, err)
 	}
-	if len(description.EnvironmentVPC.PublicSubnetIDs) == 0 {
+	subnets := description.EnvironmentVPC.PublicSubnetIDs
+	if RunsOnEC2(r.LaunchType, r.CapacityProvider) && len(description.EnvironmentVPC.PrivateSubnetIDs) != 0 {
+		// Tasks on EC2 container instances can't have a public IP, so prefer the private subnets that route through NAT.
+		subnets = description.EnvironmentVPC.PrivateSubnetIDs
+	}
+	if len(subnets) == 0 {
 		return nil, errNoSubnetFound
 	}
 
-	subnets := description.EnvironmentVPC.PublicSubnetIDs
-
 	filters := r.filtersForVPCFromAppEnv()
 	// Use only environment security group https://github.com/aws/copilot-cli/issues/1882.
 	securityGroups, err := r.VPCGetter.SecurityGroups(append(filters, ec2.Filter{
@@ -103,6 +110,9 @@ func (r *EnvRunner) Run() ([]*Task, error) {
 		StartedBy:       startedBy,
 		PlatformVersion: platformVersion,
 		EnableExec:      true,
+
+		LaunchType:       ecsLaunchType(r.LaunchType),
+		CapacityProvider: r.CapacityProvider,
 	})
 	if err != nil {
 		return nil, &errRunTask{
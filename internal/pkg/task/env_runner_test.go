@@ -86,6 +86,9 @@ func TestEnvRunner_Run(t *testing.T) {
 		arch           string
 		securityGroups []string
 
+		launchType       string
+		capacityProvider string
+
 		MockVPCGetter            func(m *mocks.MockVPCGetter)
 		MockClusterGetter        func(m *mocks.MockClusterGetter)
 		mockStarter              func(m *mocks.MockRunner)
@@ -206,6 +209,73 @@ func TestEnvRunner_Run(t *testing.T) {
 				},
 			},
 		},
+		"run on EC2 container instances in the private subnets": {
+			count:      1,
+			groupName:  "my-task",
+			launchType: LaunchTypeEC2,
+
+			MockClusterGetter: mockClusterGetter,
+			MockVPCGetter: func(m *mocks.MockVPCGetter) {
+				m.EXPECT().SecurityGroups(filtersForSecurityGroup).Return([]string{"sg-1", "sg-2"}, nil)
+			},
+			mockStarter: func(m *mocks.MockRunner) {
+				m.EXPECT().RunTask(ecs.RunTaskInput{
+					Cluster:         "cluster-1",
+					Count:           1,
+					Subnets:         []string{"subnet-023ff", "subnet-04af"},
+					SecurityGroups:  []string{"sg-1", "sg-2"},
+					TaskFamilyName:  taskFamilyName("my-task"),
+					StartedBy:       startedBy,
+					PlatformVersion: "LATEST",
+					EnableExec:      true,
+					LaunchType:      "EC2",
+				}).Return([]*ecs.Task{&taskWithENI}, nil)
+			},
+			mockEnvironmentDescriber: mockEnvironmentDescriberValid,
+			wantedTasks: []*Task{
+				{
+					TaskARN: "task-1",
+					ENI:     "eni-1",
+				},
+			},
+		},
+		"run with a capacity provider in the public subnets of an environment without private subnets": {
+			count:            1,
+			groupName:        "my-task",
+			capacityProvider: "gpu-instances",
+
+			MockClusterGetter: mockClusterGetter,
+			MockVPCGetter: func(m *mocks.MockVPCGetter) {
+				m.EXPECT().SecurityGroups(filtersForSecurityGroup).Return([]string{"sg-1", "sg-2"}, nil)
+			},
+			mockStarter: func(m *mocks.MockRunner) {
+				m.EXPECT().RunTask(ecs.RunTaskInput{
+					Cluster:          "cluster-1",
+					Count:            1,
+					Subnets:          []string{"subnet-0789ab", "subnet-0123cd"},
+					SecurityGroups:   []string{"sg-1", "sg-2"},
+					TaskFamilyName:   taskFamilyName("my-task"),
+					StartedBy:        startedBy,
+					PlatformVersion:  "LATEST",
+					EnableExec:       true,
+					CapacityProvider: "gpu-instances",
+				}).Return([]*ecs.Task{&taskWithENI}, nil)
+			},
+			mockEnvironmentDescriber: func(m *mocks.MockenvironmentDescriber) {
+				m.EXPECT().Describe().Return(&describe.EnvDescription{
+					EnvironmentVPC: describe.EnvironmentVPC{
+						ID:              "vpc-012abcd345",
+						PublicSubnetIDs: []string{"subnet-0789ab", "subnet-0123cd"},
+					},
+				}, nil)
+			},
+			wantedTasks: []*Task{
+				{
+					TaskARN: "task-1",
+					ENI:     "eni-1",
+				},
+			},
+		},
 		"run in env with extra security groups success": {
 			count:          1,
 			groupName:      "my-task",
@@ -418,6 +488,9 @@ func TestEnvRunner_Run(t *testing.T) {
 
 				OS: tc.os,
 
+				LaunchType:       tc.launchType,
+				CapacityProvider: tc.capacityProvider,
+
 				SecurityGroups: tc.securityGroups,
 
 				VPCGetter:            MockVPCGetter,
@@ -7,6 +7,8 @@ package mocks
 import (
 	reflect "reflect"
 
+	cloudwatchlogs "github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	codebuild "github.com/aws/copilot-cli/internal/pkg/aws/codebuild"
 	ec2 "github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	describe "github.com/aws/copilot-cli/internal/pkg/describe"
@@ -262,3 +264,147 @@ func (mr *MockRunnerMockRecorder) RunTask(input interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunTask", reflect.TypeOf((*MockRunner)(nil).RunTask), input)
 }
+
+// MockBuildRunner is a mock of BuildRunner interface.
+type MockBuildRunner struct {
+	ctrl     *gomock.Controller
+	recorder *MockBuildRunnerMockRecorder
+}
+
+// MockBuildRunnerMockRecorder is the mock recorder for MockBuildRunner.
+type MockBuildRunnerMockRecorder struct {
+	mock *MockBuildRunner
+}
+
+// NewMockBuildRunner creates a new mock instance.
+func NewMockBuildRunner(ctrl *gomock.Controller) *MockBuildRunner {
+	mock := &MockBuildRunner{ctrl: ctrl}
+	mock.recorder = &MockBuildRunnerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBuildRunner) EXPECT() *MockBuildRunnerMockRecorder {
+	return m.recorder
+}
+
+// Build mocks base method.
+func (m *MockBuildRunner) Build(id string) (*codebuild.Build, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Build", id)
+	ret0, _ := ret[0].(*codebuild.Build)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Build indicates an expected call of Build.
+func (mr *MockBuildRunnerMockRecorder) Build(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Build", reflect.TypeOf((*MockBuildRunner)(nil).Build), id)
+}
+
+// StartBuild mocks base method.
+func (m *MockBuildRunner) StartBuild(project string, envVars map[string]string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartBuild", project, envVars)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartBuild indicates an expected call of StartBuild.
+func (mr *MockBuildRunnerMockRecorder) StartBuild(project, envVars interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartBuild", reflect.TypeOf((*MockBuildRunner)(nil).StartBuild), project, envVars)
+}
+
+// MockLogEventsGetter is a mock of LogEventsGetter interface.
+type MockLogEventsGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockLogEventsGetterMockRecorder
+}
+
+// MockLogEventsGetterMockRecorder is the mock recorder for MockLogEventsGetter.
+type MockLogEventsGetterMockRecorder struct {
+	mock *MockLogEventsGetter
+}
+
+// NewMockLogEventsGetter creates a new mock instance.
+func NewMockLogEventsGetter(ctrl *gomock.Controller) *MockLogEventsGetter {
+	mock := &MockLogEventsGetter{ctrl: ctrl}
+	mock.recorder = &MockLogEventsGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLogEventsGetter) EXPECT() *MockLogEventsGetterMockRecorder {
+	return m.recorder
+}
+
+// LogEvents mocks base method.
+func (m *MockLogEventsGetter) LogEvents(opts cloudwatchlogs.LogEventsOpts) (*cloudwatchlogs.LogEventsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogEvents", opts)
+	ret0, _ := ret[0].(*cloudwatchlogs.LogEventsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LogEvents indicates an expected call of LogEvents.
+func (mr *MockLogEventsGetterMockRecorder) LogEvents(opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogEvents", reflect.TypeOf((*MockLogEventsGetter)(nil).LogEvents), opts)
+}
+
+// MockTasksDescriber is a mock of TasksDescriber interface.
+type MockTasksDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockTasksDescriberMockRecorder
+}
+
+// MockTasksDescriberMockRecorder is the mock recorder for MockTasksDescriber.
+type MockTasksDescriberMockRecorder struct {
+	mock *MockTasksDescriber
+}
+
+// NewMockTasksDescriber creates a new mock instance.
+func NewMockTasksDescriber(ctrl *gomock.Controller) *MockTasksDescriber {
+	mock := &MockTasksDescriber{ctrl: ctrl}
+	mock.recorder = &MockTasksDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTasksDescriber) EXPECT() *MockTasksDescriberMockRecorder {
+	return m.recorder
+}
+
+// DescribeTasks mocks base method.
+func (m *MockTasksDescriber) DescribeTasks(cluster string, taskARNs []string) ([]*ecs.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeTasks", cluster, taskARNs)
+	ret0, _ := ret[0].([]*ecs.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTasks indicates an expected call of DescribeTasks.
+func (mr *MockTasksDescriberMockRecorder) DescribeTasks(cluster, taskARNs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTasks", reflect.TypeOf((*MockTasksDescriber)(nil).DescribeTasks), cluster, taskARNs)
+}
+
+// TaskDefinition mocks base method.
+func (m *MockTasksDescriber) TaskDefinition(taskDefName string) (*ecs.TaskDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TaskDefinition", taskDefName)
+	ret0, _ := ret[0].(*ecs.TaskDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TaskDefinition indicates an expected call of TaskDefinition.
+func (mr *MockTasksDescriberMockRecorder) TaskDefinition(taskDefName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskDefinition", reflect.TypeOf((*MockTasksDescriber)(nil).TaskDefinition), taskDefName)
+}
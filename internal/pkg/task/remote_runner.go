@@ -0,0 +1,155 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package task
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codebuild"
+)
+
+const (
+	// Environment variable of the task runner project of an environment with the JSON array of arguments of "copilot task run".
+	remoteRunArgsEnvVar = "COPILOT_TASK_RUN_ARGS"
+
+	// RemoteExitCodeMarker prefixes the line written by the task runner with the exit code of "copilot task run".
+	RemoteExitCodeMarker = "COPILOT_TASK_EXIT_CODE="
+
+	defaultRemotePollInterval = 5 * time.Second
+)
+
+// RemoteRunner runs "copilot task run" with a build of the task runner project of an environment,
+// so that the caller only needs the permissions to start a build instead of the permissions to deploy the task.
+type RemoteRunner struct {
+	// Name of the task runner project of the environment.
+	Project string
+	// Arguments of "copilot task run" run by the build.
+	Args []string
+
+	// Interfaces to interact with dependencies. Must not be nil.
+	Builds BuildRunner
+	Logs   LogEventsGetter
+
+	// Writer where the logs of the build are written. Must not be nil.
+	Writer io.Writer
+
+	// Interval between two checks of the status of the build. Defaults to 5 seconds.
+	PollInterval time.Duration
+}
+
+// Run starts a build of the task runner project, writes its logs until it completes, and returns an error
+// if the build failed. If "copilot task run" exited with a non-zero code, the error implements ExitCode() int.
+func (r *RemoteRunner) Run() error {
+	if r.Builds == nil || r.Logs == nil {
+		return errors.New("build runner and log events getter must be set")
+	}
+	args, err := json.Marshal(r.Args)
+	if err != nil {
+		return fmt.Errorf("marshal arguments of the task: %w", err)
+	}
+	id, err := r.Builds.StartBuild(r.Project, map[string]string{
+		remoteRunArgsEnvVar: string(args),
+	})
+	if err != nil {
+		return err
+	}
+	interval := r.PollInterval
+	if interval == 0 {
+		interval = defaultRemotePollInterval
+	}
+	stream := &buildLogStream{
+		logs:   r.Logs,
+		w:      r.Writer,
+		cursor: make(map[string]int64),
+	}
+	for {
+		build, err := r.Builds.Build(id)
+		if err != nil {
+			return err
+		}
+		if !build.IsInProgress() {
+			// Write the last events once the build completed.
+			logErr := stream.write(build)
+			if err := remoteRunResult(build, stream.exitCode); err != nil {
+				return err
+			}
+			if logErr != nil {
+				return fmt.Errorf("get logs of build %s: %w", id, logErr)
+			}
+			return nil
+		}
+		// The log stream may not be created yet, so we retry at the next poll instead of failing.
+		_ = stream.write(build)
+		time.Sleep(interval)
+	}
+}
+
+func remoteRunResult(build *codebuild.Build, exitCode *int) error {
+	if exitCode != nil && *exitCode != 0 {
+		return &ErrRemoteExitCode{
+			BuildID:  build.ID,
+			exitCode: *exitCode,
+		}
+	}
+	if build.Status != codebuild.BuildStatusSucceeded {
+		return fmt.Errorf("build %s of the task runner completed with status %s", build.ID, build.Status)
+	}
+	return nil
+}
+
+type buildLogStream struct {
+	logs     LogEventsGetter
+	w        io.Writer
+	cursor   map[string]int64
+	exitCode *int
+}
+
+// write writes the log events of the build that were not written yet, and records the exit code if it is logged.
+func (s *buildLogStream) write(build *codebuild.Build) error {
+	if build.LogGroup == "" || build.LogStream == "" {
+		return nil
+	}
+	out, err := s.logs.LogEvents(cloudwatchlogs.LogEventsOpts{
+		LogGroup:               build.LogGroup,
+		LogStreamPrefixFilters: []string{build.LogStream},
+		StreamLastEventTime:    s.cursor,
+	})
+	if err != nil {
+		return err
+	}
+	s.cursor = out.StreamLastEventTime
+	for _, event := range out.Events {
+		msg := strings.TrimRight(event.Message, "\n")
+		if code, ok := strings.CutPrefix(msg, RemoteExitCodeMarker); ok {
+			if c, err := strconv.Atoi(strings.TrimSpace(code)); err == nil {
+				s.exitCode = &c
+			}
+			continue
+		}
+		fmt.Fprintln(s.w, msg)
+	}
+	return nil
+}
+
+// ErrRemoteExitCode is returned when "copilot task run" exits with a non-zero code in the task runner of an environment.
+type ErrRemoteExitCode struct {
+	BuildID  string
+	exitCode int
+}
+
+func (e *ErrRemoteExitCode) Error() string {
+	return fmt.Sprintf("task run by build %s exited with code %d", e.BuildID, e.exitCode)
+}
+
+// ExitCode returns the exit code of "copilot task run" in the task runner.
+func (e *ErrRemoteExitCode) ExitCode() int {
+	return e.exitCode
+}
@@ -0,0 +1,155 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package task
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codebuild"
+	"github.com/aws/copilot-cli/internal/pkg/task/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoteRunner_Run(t *testing.T) {
+	const (
+		mockProject = "phonetool-test-TaskRunner"
+		mockBuildID = "phonetool-test-TaskRunner:1234"
+	)
+	inProgress := &codebuild.Build{
+		ID:        mockBuildID,
+		Status:    codebuild.BuildStatusInProgress,
+		LogGroup:  "/aws/codebuild/phonetool-test-TaskRunner",
+		LogStream: "1234",
+	}
+	completed := func(status string) *codebuild.Build {
+		return &codebuild.Build{
+			ID:        mockBuildID,
+			Status:    status,
+			LogGroup:  "/aws/codebuild/phonetool-test-TaskRunner",
+			LogStream: "1234",
+		}
+	}
+	testCases := map[string]struct {
+		setupMocks func(builds *mocks.MockBuildRunner, logs *mocks.MockLogEventsGetter)
+
+		wantedErr      error
+		wantedExitCode int
+		wantedLogs     string
+	}{
+		"return the error if the build cannot be started": {
+			setupMocks: func(builds *mocks.MockBuildRunner, logs *mocks.MockLogEventsGetter) {
+				builds.EXPECT().StartBuild(mockProject, gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"return the error if the status of the build cannot be retrieved": {
+			setupMocks: func(builds *mocks.MockBuildRunner, logs *mocks.MockLogEventsGetter) {
+				builds.EXPECT().StartBuild(mockProject, gomock.Any()).Return(mockBuildID, nil)
+				builds.EXPECT().Build(mockBuildID).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"write the logs of the build until it succeeds": {
+			setupMocks: func(builds *mocks.MockBuildRunner, logs *mocks.MockLogEventsGetter) {
+				builds.EXPECT().StartBuild(mockProject, map[string]string{
+					"COPILOT_TASK_RUN_ARGS": `["--task-group-name","db-migrate","--command","echo 'hello'"]`,
+				}).Return(mockBuildID, nil)
+				gomock.InOrder(
+					builds.EXPECT().Build(mockBuildID).Return(inProgress, nil),
+					logs.EXPECT().LogEvents(gomock.Any()).Return(nil, errors.New("no log stream found")),
+					builds.EXPECT().Build(mockBuildID).Return(inProgress, nil),
+					logs.EXPECT().LogEvents(cloudwatchlogs.LogEventsOpts{
+						LogGroup:               "/aws/codebuild/phonetool-test-TaskRunner",
+						LogStreamPrefixFilters: []string{"1234"},
+						StreamLastEventTime:    map[string]int64{},
+					}).Return(&cloudwatchlogs.LogEventsOutput{
+						Events:              []*cloudwatchlogs.Event{{Message: "hello\n"}},
+						StreamLastEventTime: map[string]int64{"1234": 1},
+					}, nil),
+					builds.EXPECT().Build(mockBuildID).Return(completed(codebuild.BuildStatusSucceeded), nil),
+					logs.EXPECT().LogEvents(cloudwatchlogs.LogEventsOpts{
+						LogGroup:               "/aws/codebuild/phonetool-test-TaskRunner",
+						LogStreamPrefixFilters: []string{"1234"},
+						StreamLastEventTime:    map[string]int64{"1234": 1},
+					}).Return(&cloudwatchlogs.LogEventsOutput{
+						Events: []*cloudwatchlogs.Event{
+							{Message: "task completed\n"},
+							{Message: "COPILOT_TASK_EXIT_CODE=0\n"},
+						},
+						StreamLastEventTime: map[string]int64{"1234": 2},
+					}, nil),
+				)
+			},
+			wantedLogs: "hello\ntask completed\n",
+		},
+		"return the exit code of the task": {
+			setupMocks: func(builds *mocks.MockBuildRunner, logs *mocks.MockLogEventsGetter) {
+				builds.EXPECT().StartBuild(mockProject, gomock.Any()).Return(mockBuildID, nil)
+				builds.EXPECT().Build(mockBuildID).Return(completed("FAILED"), nil)
+				logs.EXPECT().LogEvents(gomock.Any()).Return(&cloudwatchlogs.LogEventsOutput{
+					Events: []*cloudwatchlogs.Event{
+						{Message: "migration failed\n"},
+						{Message: "COPILOT_TASK_EXIT_CODE=3\n"},
+					},
+				}, nil)
+			},
+			wantedErr:      errors.New("task run by build phonetool-test-TaskRunner:1234 exited with code 3"),
+			wantedExitCode: 3,
+			wantedLogs:     "migration failed\n",
+		},
+		"return the status of the build if it failed before running the task": {
+			setupMocks: func(builds *mocks.MockBuildRunner, logs *mocks.MockLogEventsGetter) {
+				builds.EXPECT().StartBuild(mockProject, gomock.Any()).Return(mockBuildID, nil)
+				builds.EXPECT().Build(mockBuildID).Return(completed("FAULT"), nil)
+				logs.EXPECT().LogEvents(gomock.Any()).Return(nil, errors.New("no log stream found"))
+			},
+			wantedErr: errors.New("build phonetool-test-TaskRunner:1234 of the task runner completed with status FAULT"),
+		},
+		"return the error if the logs of a successful build cannot be retrieved": {
+			setupMocks: func(builds *mocks.MockBuildRunner, logs *mocks.MockLogEventsGetter) {
+				builds.EXPECT().StartBuild(mockProject, gomock.Any()).Return(mockBuildID, nil)
+				builds.EXPECT().Build(mockBuildID).Return(completed(codebuild.BuildStatusSucceeded), nil)
+				logs.EXPECT().LogEvents(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get logs of build phonetool-test-TaskRunner:1234: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			builds := mocks.NewMockBuildRunner(ctrl)
+			logs := mocks.NewMockLogEventsGetter(ctrl)
+			tc.setupMocks(builds, logs)
+			w := &strings.Builder{}
+			runner := &RemoteRunner{
+				Project:      mockProject,
+				Args:         []string{"--task-group-name", "db-migrate", "--command", "echo 'hello'"},
+				Builds:       builds,
+				Logs:         logs,
+				Writer:       w,
+				PollInterval: time.Nanosecond,
+			}
+
+			err := runner.Run()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			if tc.wantedExitCode != 0 {
+				var exitErr *ErrRemoteExitCode
+				require.True(t, errors.As(err, &exitErr))
+				require.Equal(t, tc.wantedExitCode, exitErr.ExitCode())
+			}
+			require.Equal(t, tc.wantedLogs, w.String())
+		})
+	}
+}
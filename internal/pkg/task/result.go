@@ -0,0 +1,207 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package task
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/partitions"
+)
+
+const (
+	defaultResultPollInterval = 6 * time.Second
+
+	fmtTaskLogGroupName  = "/copilot/%s"
+	fmtTaskLogStreamName = "copilot-task/%s/%s"
+	fmtLogStreamURL      = "https://%s.console.%s/cloudwatch/home?region=%s#logsV2:log-groups/log-group/%s/log-events/%s"
+)
+
+// consoleDomains maps partition IDs to the domain of their AWS console.
+var consoleDomains = map[string]string{
+	"aws":        "aws.amazon.com",
+	"aws-cn":     "amazonaws.cn",
+	"aws-us-gov": "amazonaws-us-gov.com",
+}
+
+// Results is the outcome of the tasks of a group once they stopped, meant to be read by scripts.
+type Results struct {
+	Group     string       `json:"group"`
+	Succeeded bool         `json:"succeeded"` // True if every task started and all of their essential containers exited with code 0.
+	Tasks     []TaskResult `json:"tasks"`
+}
+
+// TaskResult is the outcome of a stopped task.
+type TaskResult struct {
+	TaskARN         string            `json:"taskArn"`
+	StopCode        string            `json:"stopCode,omitempty"` // For example, EssentialContainerExited or TaskFailedToStart.
+	StoppedReason   string            `json:"stoppedReason,omitempty"`
+	StartedAt       *time.Time        `json:"startedAt,omitempty"`
+	StoppedAt       *time.Time        `json:"stoppedAt,omitempty"`
+	DurationSeconds float64           `json:"durationSeconds"`
+	LogStreamURL    string            `json:"logStreamUrl"`
+	Containers      []ContainerResult `json:"containers"`
+}
+
+// ContainerResult is the outcome of a container of a stopped task.
+type ContainerResult struct {
+	Name      string `json:"name"`
+	Essential bool   `json:"essential"`
+	ExitCode  *int64 `json:"exitCode"` // Nil if the container never ran.
+	Reason    string `json:"reason,omitempty"`
+}
+
+// JSONString returns the results marshaled in JSON.
+func (r *Results) JSONString() (string, error) {
+	b, err := json.Marshal(r)
+	if err != nil {
+		return "", fmt.Errorf("marshal task results: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// ResultWaiter waits for tasks to stop and collects their results.
+type ResultWaiter struct {
+	// Name of the task group, which names the log group and log streams of the tasks.
+	GroupName string
+	// Region of the tasks.
+	Region string
+
+	// Interface to interact with dependencies. Must not be nil.
+	Describer TasksDescriber
+
+	// Interval between two checks of the status of the tasks. Defaults to 6 seconds.
+	PollInterval time.Duration
+	// Maximum time to wait for the tasks to stop. Zero to wait until they stop.
+	Timeout time.Duration
+}
+
+// Wait blocks until all the tasks are stopped, or until the timeout is reached, and returns their results.
+// All the tasks must be in the same cluster and run the same task definition.
+func (w *ResultWaiter) Wait(tasks []*Task) (*Results, error) {
+	if w.Describer == nil {
+		return nil, errors.New("tasks describer must be set")
+	}
+	if len(tasks) == 0 {
+		return nil, errors.New("no tasks to wait for")
+	}
+	interval := w.PollInterval
+	if interval == 0 {
+		interval = defaultResultPollInterval
+	}
+	taskARNs := make([]string, len(tasks))
+	for i, t := range tasks {
+		taskARNs[i] = t.TaskARN
+	}
+	deadline := time.Now().Add(w.Timeout)
+	for {
+		described, err := w.Describer.DescribeTasks(tasks[0].ClusterARN, taskARNs)
+		if err != nil {
+			return nil, err
+		}
+		if allStopped(described) {
+			return w.results(described)
+		}
+		if w.Timeout != 0 && !time.Now().Before(deadline) {
+			return nil, fmt.Errorf("tasks did not stop within %s", w.Timeout)
+		}
+		time.Sleep(interval)
+	}
+}
+
+func allStopped(tasks []*ecs.Task) bool {
+	for _, t := range tasks {
+		if aws.StringValue(t.LastStatus) != ecs.DesiredStatusStopped {
+			return false
+		}
+	}
+	return true
+}
+
+func (w *ResultWaiter) results(tasks []*ecs.Task) (*Results, error) {
+	isEssential, err := w.essentialContainers(aws.StringValue(tasks[0].TaskDefinitionArn))
+	if err != nil {
+		return nil, err
+	}
+	results := &Results{
+		Group:     w.GroupName,
+		Succeeded: true,
+		Tasks:     make([]TaskResult, len(tasks)),
+	}
+	for i, t := range tasks {
+		taskID, err := ecs.TaskID(aws.StringValue(t.TaskArn))
+		if err != nil {
+			return nil, err
+		}
+		res := TaskResult{
+			TaskARN:       aws.StringValue(t.TaskArn),
+			StopCode:      aws.StringValue(t.StopCode),
+			StoppedReason: aws.StringValue(t.StoppedReason),
+			StartedAt:     t.StartedAt,
+			StoppedAt:     t.StoppedAt,
+			LogStreamURL:  w.logStreamURL(taskID),
+			Containers:    make([]ContainerResult, len(t.Containers)),
+		}
+		if t.StartedAt != nil && t.StoppedAt != nil {
+			res.DurationSeconds = t.StoppedAt.Sub(*t.StartedAt).Seconds()
+		}
+		if t.StartedAt == nil {
+			// The task never started, for example because its image couldn't be pulled.
+			results.Succeeded = false
+		}
+		for j, c := range t.Containers {
+			essential := isEssential[aws.StringValue(c.Name)]
+			res.Containers[j] = ContainerResult{
+				Name:      aws.StringValue(c.Name),
+				Essential: essential,
+				ExitCode:  c.ExitCode,
+				Reason:    aws.StringValue(c.Reason),
+			}
+			// Sidecars that aren't essential, such as a log router, don't decide the outcome of the task.
+			if essential && (c.ExitCode == nil || aws.Int64Value(c.ExitCode) != 0) {
+				results.Succeeded = false
+			}
+		}
+		results.Tasks[i] = res
+	}
+	return results, nil
+}
+
+// essentialContainers returns whether each container of the task definition is essential, by container name.
+func (w *ResultWaiter) essentialContainers(taskDefARN string) (map[string]bool, error) {
+	taskDef, err := w.Describer.TaskDefinition(taskDefARN)
+	if err != nil {
+		return nil, fmt.Errorf("get task definition %s: %w", taskDefARN, err)
+	}
+	isEssential := make(map[string]bool)
+	for _, c := range taskDef.ContainerDefinitions {
+		// ECS marks a container as essential unless its definition says otherwise.
+		isEssential[aws.StringValue(c.Name)] = c.Essential == nil || aws.BoolValue(c.Essential)
+	}
+	return isEssential, nil
+}
+
+func (w *ResultWaiter) logStreamURL(taskID string) string {
+	domain := consoleDomains["aws"]
+	if p, err := partitions.Region(w.Region).Partition(); err == nil {
+		if d, ok := consoleDomains[p.ID()]; ok {
+			domain = d
+		}
+	}
+	return fmt.Sprintf(fmtLogStreamURL, w.Region, domain, w.Region,
+		consoleEscape(fmt.Sprintf(fmtTaskLogGroupName, w.GroupName)),
+		consoleEscape(fmt.Sprintf(fmtTaskLogStreamName, w.GroupName, taskID)))
+}
+
+// consoleEscape escapes a log group or log stream name for the fragment of a CloudWatch console URL,
+// which expects the URL-encoded name with "%" replaced by "$25".
+func consoleEscape(name string) string {
+	return strings.ReplaceAll(url.QueryEscape(name), "%", "$25")
+}
@@ -0,0 +1,244 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package task
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/task/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResultWaiter_Wait(t *testing.T) {
+	const (
+		mockCluster = "arn:aws:ecs:us-west-2:123456789012:cluster/my-project-test-Cluster-9F7Y0RLP60R7"
+		mockTaskARN = "arn:aws:ecs:us-west-2:123456789012:task/my-project-test-Cluster-9F7Y0RLP60R7/4082490ee6c245e09d2145010aa1ba8d"
+		mockTaskDef = "arn:aws:ecs:us-west-2:123456789012:task-definition/copilot-my-task:1"
+	)
+	startedAt := time.Date(2020, time.November, 23, 18, 0, 0, 0, time.UTC)
+	stoppedAt := startedAt.Add(90 * time.Second)
+	tasks := []*Task{
+		{
+			TaskARN:    mockTaskARN,
+			ClusterARN: mockCluster,
+		},
+	}
+	mockTaskDefinition := &ecs.TaskDefinition{
+		ContainerDefinitions: []*awsecs.ContainerDefinition{
+			{
+				Name: aws.String("my-task"),
+			},
+			{
+				Name:      aws.String("firelens_log_router"),
+				Essential: aws.Bool(false),
+			},
+		},
+	}
+	testCases := map[string]struct {
+		inRegion   string
+		inTimeout  time.Duration
+		setupMocks func(m *mocks.MockTasksDescriber)
+
+		wantedResults *Results
+		wantedErr     error
+	}{
+		"return the error if tasks cannot be described": {
+			inRegion: "us-west-2",
+			setupMocks: func(m *mocks.MockTasksDescriber) {
+				m.EXPECT().DescribeTasks(mockCluster, []string{mockTaskARN}).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"return an error if the tasks do not stop before the timeout": {
+			inRegion:  "us-west-2",
+			inTimeout: time.Nanosecond,
+			setupMocks: func(m *mocks.MockTasksDescriber) {
+				m.EXPECT().DescribeTasks(mockCluster, []string{mockTaskARN}).Return([]*ecs.Task{
+					{
+						TaskArn:    aws.String(mockTaskARN),
+						LastStatus: aws.String("RUNNING"),
+					},
+				}, nil)
+			},
+			wantedErr: errors.New("tasks did not stop within 1ns"),
+		},
+		"return a wrapped error if the task definition cannot be described": {
+			inRegion: "us-west-2",
+			setupMocks: func(m *mocks.MockTasksDescriber) {
+				m.EXPECT().DescribeTasks(mockCluster, []string{mockTaskARN}).Return([]*ecs.Task{
+					{
+						TaskArn:           aws.String(mockTaskARN),
+						TaskDefinitionArn: aws.String(mockTaskDef),
+						LastStatus:        aws.String(ecs.DesiredStatusStopped),
+					},
+				}, nil)
+				m.EXPECT().TaskDefinition(mockTaskDef).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get task definition arn:aws:ecs:us-west-2:123456789012:task-definition/copilot-my-task:1: some error"),
+		},
+		"wait until the tasks are stopped and report a successful run even if a non-essential container failed": {
+			inRegion: "us-west-2",
+			setupMocks: func(m *mocks.MockTasksDescriber) {
+				gomock.InOrder(
+					m.EXPECT().DescribeTasks(mockCluster, []string{mockTaskARN}).Return([]*ecs.Task{
+						{
+							TaskArn:    aws.String(mockTaskARN),
+							LastStatus: aws.String("RUNNING"),
+						},
+					}, nil),
+					m.EXPECT().DescribeTasks(mockCluster, []string{mockTaskARN}).Return([]*ecs.Task{
+						{
+							TaskArn:           aws.String(mockTaskARN),
+							TaskDefinitionArn: aws.String(mockTaskDef),
+							LastStatus:        aws.String(ecs.DesiredStatusStopped),
+							StopCode:          aws.String("EssentialContainerExited"),
+							StoppedReason:     aws.String("Essential container in task exited"),
+							StartedAt:         &startedAt,
+							StoppedAt:         &stoppedAt,
+							Containers: []*awsecs.Container{
+								{
+									Name:     aws.String("my-task"),
+									ExitCode: aws.Int64(0),
+								},
+								{
+									Name:     aws.String("firelens_log_router"),
+									ExitCode: aws.Int64(137),
+								},
+							},
+						},
+					}, nil),
+					m.EXPECT().TaskDefinition(mockTaskDef).Return(mockTaskDefinition, nil),
+				)
+			},
+			wantedResults: &Results{
+				Group:     "my-task",
+				Succeeded: true,
+				Tasks: []TaskResult{
+					{
+						TaskARN:         mockTaskARN,
+						StopCode:        "EssentialContainerExited",
+						StoppedReason:   "Essential container in task exited",
+						StartedAt:       &startedAt,
+						StoppedAt:       &stoppedAt,
+						DurationSeconds: 90,
+						LogStreamURL:    "https://us-west-2.console.aws.amazon.com/cloudwatch/home?region=us-west-2#logsV2:log-groups/log-group/$252Fcopilot$252Fmy-task/log-events/copilot-task$252Fmy-task$252F4082490ee6c245e09d2145010aa1ba8d",
+						Containers: []ContainerResult{
+							{
+								Name:      "my-task",
+								Essential: true,
+								ExitCode:  aws.Int64(0),
+							},
+							{
+								Name:     "firelens_log_router",
+								ExitCode: aws.Int64(137),
+							},
+						},
+					},
+				},
+			},
+		},
+		"report a failed run if a container exits with a non-zero code or never runs": {
+			inRegion: "cn-north-1",
+			setupMocks: func(m *mocks.MockTasksDescriber) {
+				m.EXPECT().DescribeTasks(mockCluster, []string{mockTaskARN}).Return([]*ecs.Task{
+					{
+						TaskArn:           aws.String(mockTaskARN),
+						TaskDefinitionArn: aws.String(mockTaskDef),
+						LastStatus:        aws.String(ecs.DesiredStatusStopped),
+						StopCode:          aws.String("TaskFailedToStart"),
+						StoppedReason:     aws.String("CannotPullContainerError"),
+						Containers: []*awsecs.Container{
+							{
+								Name:   aws.String("my-task"),
+								Reason: aws.String("CannotPullContainerError: pull image manifest has been retried 5 time(s)"),
+							},
+						},
+					},
+				}, nil)
+				m.EXPECT().TaskDefinition(mockTaskDef).Return(mockTaskDefinition, nil)
+			},
+			wantedResults: &Results{
+				Group:     "my-task",
+				Succeeded: false,
+				Tasks: []TaskResult{
+					{
+						TaskARN:       mockTaskARN,
+						StopCode:      "TaskFailedToStart",
+						StoppedReason: "CannotPullContainerError",
+						LogStreamURL:  "https://cn-north-1.console.amazonaws.cn/cloudwatch/home?region=cn-north-1#logsV2:log-groups/log-group/$252Fcopilot$252Fmy-task/log-events/copilot-task$252Fmy-task$252F4082490ee6c245e09d2145010aa1ba8d",
+						Containers: []ContainerResult{
+							{
+								Name:      "my-task",
+								Essential: true,
+								Reason:    "CannotPullContainerError: pull image manifest has been retried 5 time(s)",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			describer := mocks.NewMockTasksDescriber(ctrl)
+			tc.setupMocks(describer)
+			waiter := &ResultWaiter{
+				GroupName:    "my-task",
+				Region:       tc.inRegion,
+				Describer:    describer,
+				PollInterval: time.Nanosecond,
+				Timeout:      tc.inTimeout,
+			}
+
+			// WHEN
+			results, err := waiter.Wait(tasks)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedResults, results)
+		})
+	}
+}
+
+func TestResults_JSONString(t *testing.T) {
+	results := &Results{
+		Group:     "my-task",
+		Succeeded: false,
+		Tasks: []TaskResult{
+			{
+				TaskARN:         "arn:aws:ecs:us-west-2:123456789012:task/cluster/4082490ee6c245e09d2145010aa1ba8d",
+				StopCode:        "EssentialContainerExited",
+				DurationSeconds: 12.5,
+				LogStreamURL:    "https://example.com",
+				Containers: []ContainerResult{
+					{
+						Name:      "my-task",
+						Essential: true,
+						ExitCode:  aws.Int64(1),
+					},
+				},
+			},
+		},
+	}
+
+	out, err := results.JSONString()
+
+	require.NoError(t, err)
+	require.Equal(t, `{"group":"my-task","succeeded":false,"tasks":[{"taskArn":"arn:aws:ecs:us-west-2:123456789012:task/cluster/4082490ee6c245e09d2145010aa1ba8d","stopCode":"EssentialContainerExited","durationSeconds":12.5,"logStreamUrl":"https://example.com","containers":[{"name":"my-task","essential":true,"exitCode":1}]}]}
+`, out)
+}
@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codebuild"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
@@ -15,6 +17,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/template"
 
 	"github.com/aws/aws-sdk-go/aws"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
 )
 
 // VPCGetter wraps methods of getting VPC info.
@@ -47,6 +50,23 @@ type Runner interface {
 	RunTask(input ecs.RunTaskInput) ([]*ecs.Task, error)
 }
 
+// BuildRunner wraps the methods of starting a build of a CodeBuild project and getting its status.
+type BuildRunner interface {
+	StartBuild(project string, envVars map[string]string) (string, error)
+	Build(id string) (*codebuild.Build, error)
+}
+
+// LogEventsGetter wraps the method of getting log events.
+type LogEventsGetter interface {
+	LogEvents(opts cloudwatchlogs.LogEventsOpts) (*cloudwatchlogs.LogEventsOutput, error)
+}
+
+// TasksDescriber wraps the methods of describing tasks and their task definition.
+type TasksDescriber interface {
+	DescribeTasks(cluster string, taskARNs []string) ([]*ecs.Task, error)
+	TaskDefinition(taskDefName string) (*ecs.TaskDefinition, error)
+}
+
 // Task represents a one-off workload that runs until completed or an error occurs.
 type Task struct {
 	TaskARN    string
@@ -55,6 +75,15 @@ type Task struct {
 	ENI        string
 }
 
+// Launch types of a task.
+const (
+	LaunchTypeFargate = "fargate"
+	LaunchTypeEC2     = "ec2"
+)
+
+// LaunchTypes are the launch types that a task can run with.
+var LaunchTypes = []string{LaunchTypeFargate, LaunchTypeEC2}
+
 const (
 	startedBy = "copilot-task"
 
@@ -94,6 +123,27 @@ func IsValidWindowsOS(os string) bool {
 	return false
 }
 
+// RunsOnEC2 returns true if the tasks launched with the launch type or the capacity provider run on
+// EC2 container instances instead of Fargate.
+func RunsOnEC2(launchType, capacityProvider string) bool {
+	switch capacityProvider {
+	case "":
+		return launchType == LaunchTypeEC2
+	case ecs.TaskCapacityProviderFargate, ecs.TaskCapacityProviderFargateSpot:
+		return false
+	default:
+		return true
+	}
+}
+
+// ecsLaunchType returns the ECS launch type of the tasks, or an empty string to launch them on Fargate by default.
+func ecsLaunchType(launchType string) string {
+	if launchType == LaunchTypeEC2 {
+		return awsecs.LaunchTypeEc2
+	}
+	return ""
+}
+
 func taskFamilyName(groupName string) string {
 	return fmt.Sprintf(fmtTaskFamilyName, groupName)
 }
@@ -0,0 +1,40 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package task
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunsOnEC2(t *testing.T) {
+	testCases := map[string]struct {
+		inLaunchType       string
+		inCapacityProvider string
+
+		wanted bool
+	}{
+		"defaults to Fargate": {
+			wanted: false,
+		},
+		"EC2 launch type": {
+			inLaunchType: LaunchTypeEC2,
+			wanted:       true,
+		},
+		"Fargate Spot capacity provider": {
+			inCapacityProvider: "FARGATE_SPOT",
+			wanted:             false,
+		},
+		"custom capacity provider": {
+			inCapacityProvider: "gpu-instances",
+			wanted:             true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, RunsOnEC2(tc.inLaunchType, tc.inCapacityProvider))
+		})
+	}
+}
@@ -20,6 +20,7 @@ const (
 	s3ScriptsDirName            = "scripts"
 	s3CustomResourcesDirName    = "custom-resources"
 	s3EnvironmentsAddonsDirName = "environments"
+	s3DeploymentsDirName        = "deployments"
 )
 
 // MkdirSHA256 prefixes the key with the SHA256 hash of the contents of "manual/<hash>/key".
@@ -57,6 +58,12 @@ func CFNTemplate(key string, content []byte) string {
 	return path.Join(s3ArtifactDirName, s3TemplateDirName, key, fmt.Sprintf("%x.yml", sha256.Sum256(content)))
 }
 
+// DeploymentHistory returns the path to store the deployment history of a stack.
+// Example: manual/deployments/key.json.
+func DeploymentHistory(key string) string {
+	return path.Join(s3ArtifactDirName, s3DeploymentsDirName, fmt.Sprintf("%s.json", key))
+}
+
 // EnvFiles returns the path to store an env file artifact with sha256 of the content..
 // Example: manual/env-files/key/sha.env.
 func EnvFiles(key string, content []byte) string {
@@ -20,3 +20,7 @@ func TestEnvironmentAddons(t *testing.T) {
 func TestEnvironmentAddonsAsset(t *testing.T) {
 	require.Equal(t, "manual/addons/environments/assets/hash", EnvironmentAddonAsset("hash"))
 }
+
+func TestDeploymentHistory(t *testing.T) {
+	require.Equal(t, "manual/deployments/phonetool-test-api.json", DeploymentHistory("phonetool-test-api"))
+}
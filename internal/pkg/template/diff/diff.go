@@ -17,9 +17,31 @@ type Tree struct {
 	root diffNode
 }
 
-func (t Tree) Write(w io.Writer) error {
-	tw := &treeWriter{t, w}
-	return tw.write()
+// Write writes the diff tree to w, in the human-readable format unless a different format is provided.
+// An empty diff tree writes nothing regardless of the format.
+func (t Tree) Write(w io.Writer, opts ...WriteOption) error {
+	var o writeOpts
+	for _, opt := range opts {
+		opt(&o)
+	}
+	switch o.format {
+	case FormatHuman:
+		tw := &treeWriter{t, w}
+		return tw.write()
+	case FormatJSON, FormatSARIF:
+		if t.root == nil {
+			return nil
+		}
+		changes, err := t.Changes()
+		if err != nil {
+			return err
+		}
+		if o.format == FormatJSON {
+			return writeJSON(w, changes)
+		}
+		return writeSARIF(w, changes)
+	}
+	return fmt.Errorf("unsupported diff format %q", o.format)
 }
 
 // diffNode is the interface to represents the difference between two *yaml.Node.
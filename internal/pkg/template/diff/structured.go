@@ -0,0 +1,329 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/version"
+	"gopkg.in/yaml.v3"
+)
+
+// Format is the format in which a diff tree is written.
+type Format string
+
+// Formats in which a diff tree can be written.
+const (
+	FormatHuman Format = ""      // Colored, indented text meant to be read in a terminal.
+	FormatJSON  Format = "json"  // A JSON document listing every changed value.
+	FormatSARIF Format = "sarif" // A SARIF 2.1.0 log with one result per changed value.
+)
+
+// StructuredFormats are the machine-readable formats in which a diff tree can be written.
+var StructuredFormats = []string{string(FormatJSON), string(FormatSARIF)}
+
+// WriteOption configures how a diff tree is written.
+type WriteOption func(opts *writeOpts)
+
+type writeOpts struct {
+	format Format
+}
+
+// WithFormat writes the diff tree in the given format instead of the human-readable one.
+func WithFormat(format Format) WriteOption {
+	return func(opts *writeOpts) {
+		opts.format = format
+	}
+}
+
+// Actions of a Change.
+const (
+	ActionAdd    = "add"
+	ActionDelete = "delete"
+	ActionModify = "modify"
+)
+
+// Change is a single changed value of a YAML document.
+type Change struct {
+	Path     string  `json:"path"`               // The path to the value, for example "Resources.Service.Properties.DesiredCount".
+	Resource string  `json:"resource,omitempty"` // The logical ID of the CloudFormation resource that holds the value, if any.
+	Action   string  `json:"action"`
+	Old      *string `json:"old,omitempty"` // The YAML representation of the value before the change.
+	New      *string `json:"new,omitempty"` // The YAML representation of the value after the change.
+}
+
+// Changes flattens the diff tree into the list of changed values, in the order they are written.
+func (t Tree) Changes() ([]Change, error) {
+	if t.root == nil {
+		return nil, nil
+	}
+	if len(t.root.children()) == 0 {
+		change, err := newChange(t.root, nil)
+		if err != nil {
+			return nil, err
+		}
+		return []Change{change}, nil
+	}
+	var changes []Change
+	if err := collectChanges(t.root, nil, &changes); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+// collectChanges appends a change for every leaf under node, whose path from the root of the document is path.
+func collectChanges(node diffNode, path []string, changes *[]Change) error {
+	var oldIdx, newIdx int // Positions in the old and new sequences, if the children are sequence items.
+	for _, child := range node.children() {
+		var childPath []string
+		switch child := child.(type) {
+		case *unchangedNode:
+			oldIdx += child.unchangedCount()
+			newIdx += child.unchangedCount()
+			continue
+		case *seqItemNode:
+			switch {
+			case child.oldYAML() == nil && len(child.children()) == 0:
+				childPath = appendSeqIndex(path, newIdx)
+				newIdx++
+			case child.newYAML() == nil && len(child.children()) == 0:
+				childPath = appendSeqIndex(path, oldIdx)
+				oldIdx++
+			default:
+				childPath = appendSeqIndex(path, newIdx)
+				oldIdx++
+				newIdx++
+			}
+		default:
+			childPath = append(append([]string(nil), path...), child.key())
+		}
+		if len(child.children()) != 0 {
+			if err := collectChanges(child, childPath, changes); err != nil {
+				return err
+			}
+			continue
+		}
+		change, err := newChange(child, childPath)
+		if err != nil {
+			return err
+		}
+		*changes = append(*changes, change)
+	}
+	return nil
+}
+
+// appendSeqIndex returns a copy of path whose last segment is suffixed with the index of a sequence item.
+func appendSeqIndex(path []string, idx int) []string {
+	if len(path) == 0 {
+		return []string{fmt.Sprintf("[%d]", idx)}
+	}
+	out := append([]string(nil), path...)
+	out[len(out)-1] = fmt.Sprintf("%s[%d]", out[len(out)-1], idx)
+	return out
+}
+
+func newChange(node diffNode, path []string) (Change, error) {
+	change := Change{
+		Path: strings.Join(path, "."),
+	}
+	if len(path) >= 2 && path[0] == "Resources" {
+		change.Resource = strings.SplitN(path[1], "[", 2)[0]
+	}
+	var err error
+	if change.Old, err = marshalValue(node.oldYAML()); err != nil {
+		return Change{}, err
+	}
+	if change.New, err = marshalValue(node.newYAML()); err != nil {
+		return Change{}, err
+	}
+	switch {
+	case change.Old == nil:
+		change.Action = ActionAdd
+	case change.New == nil:
+		change.Action = ActionDelete
+	default:
+		change.Action = ActionModify
+	}
+	return change, nil
+}
+
+func marshalValue(node *yaml.Node) (*string, error) {
+	if node == nil {
+		return nil, nil
+	}
+	out, err := yaml.Marshal(node)
+	if err != nil {
+		return nil, fmt.Errorf("marshal YAML value: %w", err)
+	}
+	s := strings.TrimSuffix(string(out), "\n")
+	return &s, nil
+}
+
+type jsonDocument struct {
+	Changes []Change `json:"changes"`
+}
+
+func writeJSON(w io.Writer, changes []Change) error {
+	if changes == nil {
+		changes = []Change{}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(jsonDocument{Changes: changes})
+}
+
+const (
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifVersion = "2.1.0"
+	sarifInfoURI = "https://aws.github.io/copilot-cli/"
+)
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	Version        string      `json:"version,omitempty"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID               string       `json:"id"`
+	Name             string       `json:"name"`
+	ShortDescription sarifMessage `json:"shortDescription"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID     string            `json:"ruleId"`
+	Level      string            `json:"level"`
+	Message    sarifMessage      `json:"message"`
+	Locations  []sarifLocation   `json:"locations"`
+	Properties map[string]string `json:"properties,omitempty"`
+}
+
+type sarifLocation struct {
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations"`
+}
+
+type sarifLogicalLocation struct {
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+}
+
+var sarifRules = []sarifRule{
+	{
+		ID:               "template-add",
+		Name:             "TemplateValueAdded",
+		ShortDescription: sarifMessage{Text: "A value is added to the template."},
+	},
+	{
+		ID:               "template-delete",
+		Name:             "TemplateValueDeleted",
+		ShortDescription: sarifMessage{Text: "A value is deleted from the template."},
+	},
+	{
+		ID:               "template-modify",
+		Name:             "TemplateValueModified",
+		ShortDescription: sarifMessage{Text: "A value of the template is modified."},
+	},
+}
+
+func writeSARIF(w io.Writer, changes []Change) error {
+	results := make([]sarifResult, 0, len(changes))
+	for _, change := range changes {
+		results = append(results, newSARIFResult(change))
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(sarifLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs: []sarifRun{
+			{
+				Tool: sarifTool{
+					Driver: sarifDriver{
+						Name:           "copilot",
+						Version:        version.Version,
+						InformationURI: sarifInfoURI,
+						Rules:          sarifRules,
+					},
+				},
+				Results: results,
+			},
+		},
+	})
+}
+
+func newSARIFResult(change Change) sarifResult {
+	path := change.Path
+	if path == "" {
+		path = "the template"
+	}
+	result := sarifResult{
+		RuleID: "template-" + change.Action,
+		Level:  "note",
+		Locations: []sarifLocation{
+			{
+				LogicalLocations: []sarifLogicalLocation{
+					{FullyQualifiedName: change.Path},
+				},
+			},
+		},
+		Properties: make(map[string]string),
+	}
+	if change.Resource != "" {
+		result.Properties["resource"] = change.Resource
+	}
+	if change.Old != nil {
+		result.Properties["old"] = *change.Old
+	}
+	if change.New != nil {
+		result.Properties["new"] = *change.New
+	}
+	switch change.Action {
+	case ActionAdd:
+		result.Message.Text = fmt.Sprintf("Add %s.", path)
+	case ActionDelete:
+		result.Message.Text = fmt.Sprintf("Delete %s.", path)
+		// Deleting a whole resource removes it from the stack, so flag it for review.
+		if change.Resource != "" && path == "Resources."+change.Resource {
+			result.Level = "warning"
+		}
+	default:
+		result.Message.Text = fmt.Sprintf("Modify %s.", path)
+	}
+	return result
+}
+
+// WriteNoChanges writes the output of an empty diff in the given format.
+// The human-readable format prints a message, while structured formats print a document without any change.
+func WriteNoChanges(w io.Writer, format Format) error {
+	switch format {
+	case FormatJSON:
+		return writeJSON(w, nil)
+	case FormatSARIF:
+		return writeSARIF(w, nil)
+	}
+	_, err := fmt.Fprintln(w, "No changes.")
+	return err
+}
@@ -0,0 +1,307 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTree_Changes(t *testing.T) {
+	testCases := map[string]struct {
+		old  string
+		curr string
+
+		wanted []Change
+	}{
+		"no changes": {
+			old:  `Resources: {}`,
+			curr: `Resources: {}`,
+		},
+		"modify a resource property": {
+			old: `
+Resources:
+  Service:
+    Properties:
+      DesiredCount: 1`,
+			curr: `
+Resources:
+  Service:
+    Properties:
+      DesiredCount: 2`,
+			wanted: []Change{
+				{
+					Path:     "Resources.Service.Properties.DesiredCount",
+					Resource: "Service",
+					Action:   ActionModify,
+					Old:      strPtr("1"),
+					New:      strPtr("2"),
+				},
+			},
+		},
+		"add and delete resources": {
+			old: `
+Resources:
+  Queue:
+    Type: AWS::SQS::Queue`,
+			curr: `
+Resources:
+  Topic:
+    Type: AWS::SNS::Topic`,
+			wanted: []Change{
+				{
+					Path:     "Resources.Queue",
+					Resource: "Queue",
+					Action:   ActionDelete,
+					Old:      strPtr("Type: AWS::SQS::Queue"),
+				},
+				{
+					Path:     "Resources.Topic",
+					Resource: "Topic",
+					Action:   ActionAdd,
+					New:      strPtr("Type: AWS::SNS::Topic"),
+				},
+			},
+		},
+		"sequence items are indexed": {
+			old: `
+Outputs:
+  Subnets:
+    Value:
+      - a
+      - b
+      - c`,
+			curr: `
+Outputs:
+  Subnets:
+    Value:
+      - a
+      - c
+      - d`,
+			wanted: []Change{
+				{
+					Path:   "Outputs.Subnets.Value[1]",
+					Action: ActionDelete,
+					Old:    strPtr("b"),
+				},
+				{
+					Path:   "Outputs.Subnets.Value[2]",
+					Action: ActionAdd,
+					New:    strPtr("d"),
+				},
+			},
+		},
+		"modified sequence items with nested values": {
+			old: `
+Resources:
+  TaskDefinition:
+    Properties:
+      ContainerDefinitions:
+        - Name: main
+          Image: nginx:1`,
+			curr: `
+Resources:
+  TaskDefinition:
+    Properties:
+      ContainerDefinitions:
+        - Name: main
+          Image: nginx:2`,
+			wanted: []Change{
+				{
+					Path:     "Resources.TaskDefinition.Properties.ContainerDefinitions[0].Image",
+					Resource: "TaskDefinition",
+					Action:   ActionModify,
+					Old:      strPtr("nginx:1"),
+					New:      strPtr("nginx:2"),
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tree, err := From(tc.old).Parse([]byte(tc.curr))
+			require.NoError(t, err)
+
+			got, err := tree.Changes()
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestTree_Write_StructuredFormats(t *testing.T) {
+	const (
+		old = `
+Resources:
+  Queue:
+    Type: AWS::SQS::Queue
+  Service:
+    Properties:
+      DesiredCount: 1`
+		curr = `
+Resources:
+  Service:
+    Properties:
+      DesiredCount: 2`
+	)
+	testCases := map[string]struct {
+		old    string
+		format Format
+
+		wanted string
+	}{
+		"json": {
+			old:    old,
+			format: FormatJSON,
+			wanted: `{
+  "changes": [
+    {
+      "path": "Resources.Queue",
+      "resource": "Queue",
+      "action": "delete",
+      "old": "Type: AWS::SQS::Queue"
+    },
+    {
+      "path": "Resources.Service.Properties.DesiredCount",
+      "resource": "Service",
+      "action": "modify",
+      "old": "1",
+      "new": "2"
+    }
+  ]
+}
+`,
+		},
+		"sarif": {
+			old:    old,
+			format: FormatSARIF,
+			wanted: `{
+  "$schema": "https://json.schemastore.org/sarif-2.1.0.json",
+  "version": "2.1.0",
+  "runs": [
+    {
+      "tool": {
+        "driver": {
+          "name": "copilot",
+          "informationUri": "https://aws.github.io/copilot-cli/",
+          "rules": [
+            {
+              "id": "template-add",
+              "name": "TemplateValueAdded",
+              "shortDescription": {
+                "text": "A value is added to the template."
+              }
+            },
+            {
+              "id": "template-delete",
+              "name": "TemplateValueDeleted",
+              "shortDescription": {
+                "text": "A value is deleted from the template."
+              }
+            },
+            {
+              "id": "template-modify",
+              "name": "TemplateValueModified",
+              "shortDescription": {
+                "text": "A value of the template is modified."
+              }
+            }
+          ]
+        }
+      },
+      "results": [
+        {
+          "ruleId": "template-delete",
+          "level": "warning",
+          "message": {
+            "text": "Delete Resources.Queue."
+          },
+          "locations": [
+            {
+              "logicalLocations": [
+                {
+                  "fullyQualifiedName": "Resources.Queue"
+                }
+              ]
+            }
+          ],
+          "properties": {
+            "old": "Type: AWS::SQS::Queue",
+            "resource": "Queue"
+          }
+        },
+        {
+          "ruleId": "template-modify",
+          "level": "note",
+          "message": {
+            "text": "Modify Resources.Service.Properties.DesiredCount."
+          },
+          "locations": [
+            {
+              "logicalLocations": [
+                {
+                  "fullyQualifiedName": "Resources.Service.Properties.DesiredCount"
+                }
+              ]
+            }
+          ],
+          "properties": {
+            "new": "2",
+            "old": "1",
+            "resource": "Service"
+          }
+        }
+      ]
+    }
+  ]
+}
+`,
+		},
+		"empty diff writes nothing": {
+			old:    curr,
+			format: FormatJSON,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tree, err := From(tc.old).Parse([]byte(curr))
+			require.NoError(t, err)
+
+			var out strings.Builder
+			err = tree.Write(&out, WithFormat(tc.format))
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, out.String())
+		})
+	}
+}
+
+func TestWriteNoChanges(t *testing.T) {
+	testCases := map[string]struct {
+		format Format
+		wanted string
+	}{
+		"human": {
+			format: FormatHuman,
+			wanted: "No changes.\n",
+		},
+		"json": {
+			format: FormatJSON,
+			wanted: "{\n  \"changes\": []\n}\n",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var out strings.Builder
+			require.NoError(t, WriteNoChanges(&out, tc.format))
+			require.Equal(t, tc.wanted, out.String())
+		})
+	}
+}
+
+func strPtr(s string) *string {
+	return &s
+}
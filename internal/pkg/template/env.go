@@ -23,6 +23,11 @@ const (
 	SecretInitMinEnvVersion    = "v1.4.0"
 	JobRunMinEnvVersion        = "v1.12.0"
 	RunLocalProxyMinEnvVersion = "v1.32.0"
+	SvcCleanupMinEnvVersion    = "v1.34.0"
+	JobRunWaitMinEnvVersion    = "v1.34.0"
+	JobRunFollowMinEnvVersion  = "v1.34.0"
+	SvcAutoscaleMinEnvVersion  = "v1.34.0"
+	SvcRedriveMinEnvVersion    = "v1.34.0"
 )
 
 // Available env-controller managed feature names.
@@ -91,6 +96,13 @@ var (
 		"elb-access-logs",
 		"mappings-regional-configs",
 		"ar-vpc-connector",
+		"task-runner",
+		"run-task-role",
+		"app-runner-connectors",
+		"vpc-dns",
+		"listener-response-headers",
+		"backups",
+		"budget",
 	}
 )
 
@@ -128,11 +140,17 @@ type EnvOpts struct {
 	ArtifactBucketARN    string
 	ArtifactBucketKeyARN string
 
-	VPCConfig         VPCConfig
-	PublicHTTPConfig  PublicHTTPConfig
-	PrivateHTTPConfig PrivateHTTPConfig
-	Telemetry         *Telemetry
-	CDNConfig         *CDNConfig
+	VPCConfig           VPCConfig
+	PublicHTTPConfig    PublicHTTPConfig
+	PrivateHTTPConfig   PrivateHTTPConfig
+	Telemetry           *Telemetry
+	CDNConfig           *CDNConfig
+	KMSKeyARN           string // Customer managed key to encrypt the environment's resources with.
+	ImportedCluster     string // Name of an existing ECS cluster to use instead of creating one.
+	RemoteTaskRunner    *RemoteTaskRunnerConfig
+	Backups             *BackupConfig
+	Budget              *BudgetConfig
+	ELBAccessLogsBucket *ELBAccessLogsBucketConfig
 
 	SerializedManifest string // Serialized manifest used to render the environment template.
 	ForceUpdateID      string
@@ -140,6 +158,11 @@ type EnvOpts struct {
 	DelegateDNS bool
 }
 
+// RemoteTaskRunnerConfig represents the task runner that launches one-off tasks on behalf of "copilot task run --remote".
+type RemoteTaskRunnerConfig struct {
+	BinaryURL string // URL of the Copilot binary run by the task runner, pinned to the version that deployed the environment.
+}
+
 // PublicHTTPConfig represents configuration for a public facing Load Balancer.
 type PublicHTTPConfig struct {
 	HTTPConfig
@@ -166,6 +189,65 @@ func (e *EnvOpts) HasImportedCerts() bool {
 type HTTPConfig struct {
 	SSLPolicy        *string
 	ImportedCertARNs []string
+	MutualTLS        *MutualTLS
+	ResponseHeaders  *ResponseHeaders
+}
+
+// MutualTLS represents the mutual TLS authentication of clients by the HTTPS listener of a Load Balancer.
+type MutualTLS struct {
+	Mode                          string
+	TrustStoreARN                 string // Imported trust store.
+	CABundleBucket                string // Bucket and key of the CA certificates bundle of a trust store created by Copilot.
+	CABundleKey                   string
+	IgnoreClientCertificateExpiry bool
+}
+
+// ShouldCreateTrustStore returns true if Copilot should create a trust store from a CA certificates bundle.
+func (m *MutualTLS) ShouldCreateTrustStore() bool {
+	if m == nil {
+		return false
+	}
+	return m.CABundleBucket != ""
+}
+
+// ResponseHeaders represents the headers added to every response of a Load Balancer or a CloudFront distribution.
+type ResponseHeaders struct {
+	StrictTransportSecurity *StrictTransportSecurity
+	ContentSecurityPolicy   string
+	Custom                  []CustomHeader // Only supported by CloudFront.
+}
+
+// HasLoadBalancerHeaders returns true if any of the headers can be added by the listeners of a Load Balancer.
+func (h *ResponseHeaders) HasLoadBalancerHeaders() bool {
+	if h == nil {
+		return false
+	}
+	return h.StrictTransportSecurity != nil || h.ContentSecurityPolicy != ""
+}
+
+// StrictTransportSecurity represents the Strict-Transport-Security (HSTS) response header.
+type StrictTransportSecurity struct {
+	MaxAgeSeconds     int64
+	IncludeSubdomains bool
+	Preload           bool
+}
+
+// HeaderValue returns the value of the Strict-Transport-Security header.
+func (h *StrictTransportSecurity) HeaderValue() string {
+	value := fmt.Sprintf("max-age=%d", h.MaxAgeSeconds)
+	if h.IncludeSubdomains {
+		value += "; includeSubDomains"
+	}
+	if h.Preload {
+		value += "; preload"
+	}
+	return value
+}
+
+// CustomHeader represents a response header that is not covered by the security headers.
+type CustomHeader struct {
+	Name  string
+	Value string
 }
 
 // ELBAccessLogs represents configuration for ELB access logs S3 bucket.
@@ -187,6 +269,31 @@ type CDNConfig struct {
 	ImportedCertificate *string
 	TerminateTLS        bool
 	Static              *CDNStaticAssetConfig
+	ResponseHeaders     *ResponseHeaders
+}
+
+// BackupConfig represents the AWS Backup plan of the stateful resources of an environment's workloads.
+type BackupConfig struct {
+	Schedule      string // Cron expression of the backup rule.
+	RetentionDays int    // Number of days after which recovery points are deleted.
+}
+
+// BudgetConfig represents the monthly AWS Budget of the spend of the resources tagged with an environment.
+type BudgetConfig struct {
+	MonthlyLimit   float64  // Monthly limit of the budget in USD.
+	AlertThreshold int      // Percentage of the limit over which subscribers are alerted of the actual spend.
+	Emails         []string // Email addresses subscribed to the alerts.
+	Guardrail      bool     // Whether deployments to the environment warn when the forecasted spend exceeds the limit.
+}
+
+// ELBAccessLogsBucketConfig represents the hardening options of the S3 bucket created by an environment
+// for the access logs of its public load balancer.
+type ELBAccessLogsBucketConfig struct {
+	AccessLogsBucket string // Name of the bucket that receives the S3 server access logs. Empty disables server access logging.
+	AccessLogsPrefix string
+	ObjectLockMode   string // Default retention mode of S3 Object Lock, "GOVERNANCE" or "COMPLIANCE". Empty disables object lock.
+	ObjectLockDays   int
+	TLSOnly          bool // Whether requests that don't use TLS are denied.
 }
 
 // CDNStaticAssetConfig represents static assets config for a Content Delivery Network.
@@ -203,6 +310,56 @@ type VPCConfig struct {
 	AllowVPCIngress     bool
 	SecurityGroupConfig *SecurityGroupConfig
 	FlowLogs            *VPCFlowLogs
+	AppRunnerConnectors []AppRunnerConnector
+	DHCPOptions         *DHCPOptions
+	DNSResolver         *DNSResolver
+}
+
+// DHCPOptions holds the fields to create a DHCP options set for the managed VPC.
+type DHCPOptions struct {
+	DomainName        string
+	DomainNameServers []string
+	NTPServers        []string
+}
+
+// DNSResolver holds the Route 53 Resolver rules to associate with the VPC.
+type DNSResolver struct {
+	ForwardingRules []DNSForwardingRule // Rules created with an outbound endpoint in the VPC.
+	RuleIDs         []string            // Existing rules to associate with the VPC.
+}
+
+// DNSForwardingRule holds the fields to create a Route 53 Resolver rule that forwards queries for a domain.
+type DNSForwardingRule struct {
+	Domain    string
+	TargetIPs []DNSTargetIP
+}
+
+// DNSTargetIP is the address of a DNS resolver that receives forwarded queries.
+type DNSTargetIP struct {
+	IP   string
+	Port uint16
+}
+
+// HasManagedPrivateAppRunnerConnector returns true if an App Runner VPC connector is placed in the private subnets of the managed VPC.
+func (cfg VPCConfig) HasManagedPrivateAppRunnerConnector() bool {
+	if cfg.Imported != nil {
+		return false
+	}
+	for _, conn := range cfg.AppRunnerConnectors {
+		if conn.SubnetsType == PrivateSubnetsPlacement {
+			return true
+		}
+	}
+	return false
+}
+
+// AppRunnerConnector holds the fields to create an App Runner VPC connector shared by the services of an environment.
+type AppRunnerConnector struct {
+	Name string
+	// SubnetsType and SubnetIDs are mutually exclusive. They won't be set together.
+	SubnetsType    string
+	SubnetIDs      []string
+	SecurityGroups []string // Additional security groups to the environment security group.
 }
 
 // ImportVPC holds the fields to import VPC resources.
@@ -218,6 +375,31 @@ type ManagedVPC struct {
 	AZs                []string
 	PublicSubnetCIDRs  []string
 	PrivateSubnetCIDRs []string
+	SubnetGroups       []SubnetGroup // Additional tiers of subnets, sorted by name.
+}
+
+// Routing of the subnets of a subnet group.
+const (
+	SubnetGroupRoutingPrivate  = "private"  // Routed through the NAT gateways like the private subnets.
+	SubnetGroupRoutingIsolated = "isolated" // Without a route outside of the VPC.
+	SubnetGroupRoutingPublic   = "public"   // Routed through the internet gateway like the public subnets.
+)
+
+// SubnetGroupRoutings are the ways the subnets of a subnet group can be routed.
+var SubnetGroupRoutings = []string{SubnetGroupRoutingPrivate, SubnetGroupRoutingIsolated, SubnetGroupRoutingPublic}
+
+// SubnetGroup holds the fields to create a named tier of subnets with its own network ACL.
+// The subnet at index i is placed in the same availability zone as the private, or public if the routing is public, subnet at index i.
+// Privately routed subnets reach the internet through the NAT gateway of the private subnet at index i.
+type SubnetGroup struct {
+	Name    string
+	Routing string
+	CIDRs   []string
+}
+
+// SubnetGroupPlacement returns the name of the environment stack output that holds the subnets of a subnet group.
+func SubnetGroupPlacement(name string) string {
+	return fmt.Sprintf("SubnetGroup%sSubnets", name)
 }
 
 // Telemetry represents optional observability and monitoring configuration.
@@ -227,8 +409,9 @@ type Telemetry struct {
 
 // SecurityGroupConfig holds the fields to import security group config
 type SecurityGroupConfig struct {
-	Ingress []SecurityGroupRule
-	Egress  []SecurityGroupRule
+	Ingress             []SecurityGroupRule
+	Egress              []SecurityGroupRule
+	DenyIntraEnvTraffic bool // If true, the environment security group doesn't allow the traffic from itself.
 }
 
 // SecurityGroupRule holds the fields to import security group rule
@@ -241,7 +424,11 @@ type SecurityGroupRule struct {
 
 // VPCFlowLogs holds the fields to configure logging IP traffic using VPC flow logs.
 type VPCFlowLogs struct {
-	Retention *int
+	Retention              *int
+	TrafficType            string // One of ALL, ACCEPT or REJECT.
+	MaxAggregationInterval int    // In seconds.
+	S3BucketName           string // If set, the flow logs are published to the bucket instead of CloudWatch Logs.
+	S3Prefix               string
 }
 
 // ParseEnv parses an environment's CloudFormation template with the specified data object and returns its content.
@@ -28,6 +28,13 @@ func TestTemplate_ParseEnv(t *testing.T) {
 	_ = afero.WriteFile(fs, "templates/environment/partials/elb-access-logs.yml", []byte("elb-access-logs"), 0644)
 	_ = afero.WriteFile(fs, "templates/environment/partials/mappings-regional-configs.yml", []byte("mappings-regional-configs"), 0644)
 	_ = afero.WriteFile(fs, "templates/environment/partials/ar-vpc-connector.yml", []byte("ar-vpc-connector"), 0644)
+	_ = afero.WriteFile(fs, "templates/environment/partials/task-runner.yml", []byte("task-runner"), 0644)
+	_ = afero.WriteFile(fs, "templates/environment/partials/run-task-role.yml", []byte("run-task-role"), 0644)
+	_ = afero.WriteFile(fs, "templates/environment/partials/backups.yml", []byte("backups"), 0644)
+	_ = afero.WriteFile(fs, "templates/environment/partials/budget.yml", []byte("budget"), 0644)
+	_ = afero.WriteFile(fs, "templates/environment/partials/app-runner-connectors.yml", []byte("app-runner-connectors"), 0644)
+	_ = afero.WriteFile(fs, "templates/environment/partials/vpc-dns.yml", []byte("vpc-dns"), 0644)
+	_ = afero.WriteFile(fs, "templates/environment/partials/listener-response-headers.yml", []byte("listener-response-headers"), 0644)
 	tpl := &Template{
 		fs: &mockFS{
 			Fs: fs,
@@ -99,3 +106,28 @@ func TestTruncate(t *testing.T) {
 		})
 	}
 }
+
+func TestStrictTransportSecurity_HeaderValue(t *testing.T) {
+	testCases := map[string]struct {
+		in     StrictTransportSecurity
+		wanted string
+	}{
+		"only max age": {
+			in:     StrictTransportSecurity{MaxAgeSeconds: 300},
+			wanted: "max-age=300",
+		},
+		"all directives": {
+			in: StrictTransportSecurity{
+				MaxAgeSeconds:     31536000,
+				IncludeSubdomains: true,
+				Preload:           true,
+			},
+			wanted: "max-age=31536000; includeSubDomains; preload",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.HeaderValue())
+		})
+	}
+}
@@ -6,6 +6,7 @@ package template
 import (
 	"bytes"
 	"fmt"
+	"strconv"
 	"text/template"
 )
 
@@ -14,7 +15,7 @@ const (
 	fmtPipelinePartialsPath = "cicd/partials/%s.yml"
 )
 
-var pipelinePartialTemplateNames = []string{"build-action", "role-policy-document", "role-config", "actions", "action-config", "test"}
+var pipelinePartialTemplateNames = []string{"build-action", "role-policy-document", "role-config", "actions", "action-config", "test", "github-status"}
 
 // ParsePipeline parses a pipeline's CloudFormation template with the specified data object and returns its content.
 func (t *Template) ParsePipeline(data interface{}) (*Content, error) {
@@ -51,6 +52,7 @@ func withPipelineParsingFuncs() ParseOption {
 			},
 			"logicalIDSafe": ReplaceDashesFunc,
 			"alphanumeric":  StripNonAlphaNumFunc,
+			"quote":         strconv.Quote,
 		})
 	}
 }
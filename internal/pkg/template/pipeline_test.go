@@ -26,6 +26,7 @@ Resources:
 	_ = afero.WriteFile(fs, "templates/cicd/partials/actions.yml", []byte("actions"), 0644)
 	_ = afero.WriteFile(fs, "templates/cicd/partials/action-config.yml", []byte("action-config"), 0644)
 	_ = afero.WriteFile(fs, "templates/cicd/partials/test.yml", []byte("test"), 0644)
+	_ = afero.WriteFile(fs, "templates/cicd/partials/github-status.yml", []byte("github-status"), 0644)
 	tpl := &Template{
 		fs: &mockFS{
 			Fs: fs,
@@ -107,6 +107,24 @@ func TestTemplate_ParseScheduledJob(t *testing.T) {
 				Version:                  "v1.28.0",
 			},
 		},
+		"renders with alerts": {
+			opts: template.WorkloadOpts{
+				JobAlerts: &template.JobAlertsOpts{
+					FailuresThreshold: 3,
+					EvaluationPeriods: 3,
+					Period:            3600,
+					Emails:            []string{"ops@example.com", "oncall@example.com"},
+				},
+				Network: template.NetworkOpts{
+					AssignPublicIP: template.EnablePublicIP,
+					SubnetsType:    template.PublicSubnetsPlacement,
+				},
+				ServiceDiscoveryEndpoint: "test.app.local",
+				CustomResources:          customResources,
+				EnvVersion:               "v1.42.0",
+				Version:                  "v1.28.0",
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -143,6 +161,8 @@ func TestTemplate_ParseLoadBalancedWebService(t *testing.T) {
 		"RulePriorityFunction":        fakeS3Object,
 		"NLBCustomDomainFunction":     fakeS3Object,
 		"NLBCertValidatorFunction":    fakeS3Object,
+		"CDNCustomDomainFunction":     fakeS3Object,
+		"CDNCertValidatorFunction":    fakeS3Object,
 	}
 
 	testCases := map[string]struct {
@@ -173,6 +193,125 @@ func TestTemplate_ParseLoadBalancedWebService(t *testing.T) {
 				Version:         "v1.28.0",
 			},
 		},
+		"renders a valid template with AppConfig feature flags": {
+			opts: template.WorkloadOpts{
+				ALBListener: &template.ALBListener{
+					Rules: []template.ALBListenerRule{
+						{
+							Path:            "/",
+							TargetPort:      "8080",
+							TargetContainer: "main",
+							HTTPVersion:     "GRPC",
+							HTTPHealthCheck: defaultHttpHealthCheck,
+							Stickiness:      "false",
+						},
+					},
+				},
+				ServiceDiscoveryEndpoint: "test.app.local",
+				Network: template.NetworkOpts{
+					AssignPublicIP: template.EnablePublicIP,
+					SubnetsType:    template.PublicSubnetsPlacement,
+				},
+				AppConfig: &template.AppConfigOpts{
+					Profiles:            []string{"checkout", "search-flags"},
+					PollIntervalSeconds: aws.Int64(30),
+					Port:                2772,
+				},
+				ALBEnabled:      true,
+				CustomResources: customResources,
+				EnvVersion:      "v1.42.0",
+				Version:         "v1.28.0",
+			},
+		},
+		"renders a valid template with X-Ray tracing and a sampling rule": {
+			opts: template.WorkloadOpts{
+				ALBListener: &template.ALBListener{
+					Rules: []template.ALBListenerRule{
+						{
+							Path:            "/",
+							TargetPort:      "8080",
+							TargetContainer: "main",
+							HTTPVersion:     "GRPC",
+							HTTPHealthCheck: defaultHttpHealthCheck,
+							Stickiness:      "false",
+						},
+					},
+				},
+				ServiceDiscoveryEndpoint: "test.app.local",
+				Network: template.NetworkOpts{
+					AssignPublicIP: template.EnablePublicIP,
+					SubnetsType:    template.PublicSubnetsPlacement,
+				},
+				Observability: template.ObservabilityOpts{
+					Tracing:      "AWSXRAY",
+					SamplingRate: aws.Float64(0.1),
+				},
+				ALBEnabled:      true,
+				CustomResources: customResources,
+				EnvVersion:      "v1.42.0",
+				Version:         "v1.28.0",
+			},
+		},
+		"renders a valid template with an OpenTelemetry collector": {
+			opts: template.WorkloadOpts{
+				ALBListener: &template.ALBListener{
+					Rules: []template.ALBListenerRule{
+						{
+							Path:            "/",
+							TargetPort:      "8080",
+							TargetContainer: "main",
+							HTTPVersion:     "GRPC",
+							HTTPHealthCheck: defaultHttpHealthCheck,
+							Stickiness:      "false",
+						},
+					},
+				},
+				ServiceDiscoveryEndpoint: "test.app.local",
+				Network: template.NetworkOpts{
+					AssignPublicIP: template.EnablePublicIP,
+					SubnetsType:    template.PublicSubnetsPlacement,
+				},
+				Observability: template.ObservabilityOpts{
+					Tracing:         "OTEL",
+					CollectorConfig: "receivers:\n  otlp:\n    protocols:\n      grpc:\n",
+				},
+				ALBEnabled:      true,
+				CustomResources: customResources,
+				EnvVersion:      "v1.42.0",
+				Version:         "v1.28.0",
+			},
+		},
+		"renders a valid template with a budget and cost anomaly alerts": {
+			opts: template.WorkloadOpts{
+				ALBListener: &template.ALBListener{
+					Rules: []template.ALBListenerRule{
+						{
+							Path:            "/",
+							TargetPort:      "8080",
+							TargetContainer: "main",
+							HTTPVersion:     "GRPC",
+							HTTPHealthCheck: defaultHttpHealthCheck,
+							Stickiness:      "false",
+						},
+					},
+				},
+				ServiceDiscoveryEndpoint: "test.app.local",
+				Network: template.NetworkOpts{
+					AssignPublicIP: template.EnablePublicIP,
+					SubnetsType:    template.PublicSubnetsPlacement,
+				},
+				Cost: &template.CostOpts{
+					MonthlyBudget:    aws.Float64(49.99),
+					AlertThreshold:   80,
+					AnomalyDetection: true,
+					Emails:           []string{"team@example.com"},
+				},
+				ALBEnabled:      true,
+				CustomResources: customResources,
+				EnvVersion:      "v1.42.0",
+				Version:         "v1.28.0",
+			},
+		},
 		"renders a valid grpc template by default": {
 			opts: template.WorkloadOpts{
 				ALBListener: &template.ALBListener{
@@ -383,6 +522,42 @@ func TestTemplate_ParseLoadBalancedWebService(t *testing.T) {
 				Version:         "v1.28.0",
 			},
 		},
+		"renders a valid template with an init container sharing volumes": {
+			opts: template.WorkloadOpts{
+				ALBListener: &template.ALBListener{
+					Rules: []template.ALBListenerRule{
+						{
+							Path:            "/",
+							TargetPort:      "8080",
+							TargetContainer: "main",
+							HTTPVersion:     "GRPC",
+							HTTPHealthCheck: defaultHttpHealthCheck,
+							Stickiness:      "false",
+						},
+					},
+				},
+				Network: template.NetworkOpts{
+					AssignPublicIP: template.EnablePublicIP,
+					SubnetsType:    template.PublicSubnetsPlacement,
+				},
+				ServiceDiscoveryEndpoint: "test.app.local",
+				DependsOn: map[string]string{
+					"migrate": "COMPLETE",
+				},
+				Sidecars: []*template.SidecarOpts{
+					{
+						Name:        "migrate",
+						Image:       aws.String("public.ecr.aws/docker/library/busybox:latest"),
+						Essential:   aws.Bool(false),
+						VolumesFrom: []string{"main"},
+					},
+				},
+				ALBEnabled:      true,
+				CustomResources: customResources,
+				EnvVersion:      "v1.42.0",
+				Version:         "v1.28.0",
+			},
+		},
 		"renders a valid template with ephemeral storage": {
 			opts: template.WorkloadOpts{
 				ALBListener: &template.ALBListener{
@@ -467,6 +642,43 @@ DiscoveryServiceArn:
 				Version:         "v1.28.0",
 			},
 		},
+		"renders a valid template with a CloudFront distribution": {
+			opts: template.WorkloadOpts{
+				ALBListener: &template.ALBListener{
+					Rules: []template.ALBListenerRule{
+						{
+							Path:            "/",
+							TargetPort:      "8080",
+							TargetContainer: "main",
+							HTTPHealthCheck: defaultHttpHealthCheck,
+							Stickiness:      "false",
+						},
+					},
+					IsHTTPS: true,
+				},
+				CDN: &template.ServiceCDNOpts{
+					OriginDomain:       "frontend.test.phonetool.example.com",
+					OriginShieldRegion: "us-west-2",
+					CachePolicies: []template.CDNCachePolicy{
+						{
+							PathPattern: "/static/*",
+							PolicyID:    "658327ea-f89d-4fab-a63d-7e88639e58f6",
+						},
+					},
+					Aliases: []string{"cdn.example.com"},
+				},
+				AppDNSName:           aws.String("example.com"),
+				AppDNSDelegationRole: aws.String("arn:aws:iam::123456789123:role/DNSDelegationRole"),
+				Network: template.NetworkOpts{
+					AssignPublicIP: template.EnablePublicIP,
+					SubnetsType:    template.PublicSubnetsPlacement,
+				},
+				ALBEnabled:      true,
+				CustomResources: customResources,
+				EnvVersion:      "v1.42.0",
+				Version:         "v1.28.0",
+			},
+		},
 		"renders a valid template with Windows platform": {
 			opts: template.WorkloadOpts{
 				ALBListener: &template.ALBListener{
@@ -70,6 +70,9 @@ const (
 	LogicalIDHTTPListenerRuleWithDomain = "HTTPListenerRuleWithDomain"
 )
 
+// Suffix of the name of the alarm set when the validation of a deployment fails.
+const deploymentValidationAlarmType = "CopilotDeploymentValidationAlarm"
+
 const (
 	// NoExposedContainerPort indicates no port should be exposed for the service container.
 	NoExposedContainerPort = "-1"
@@ -87,12 +90,17 @@ var (
 		"workload-container",
 		"fargate-taskdef-base-properties",
 		"service-base-properties",
+		"service-security-group",
 		"servicediscovery",
 		"addons",
 		"sidecars",
 		"logconfig",
 		"autoscaling",
+		"backlog-per-task-calculator",
+		"dashboard",
 		"eventrule",
+		"job-queue-trigger",
+		"job-alerts",
 		"state-machine",
 		"state-machine-definition.json",
 		"efs-access-point",
@@ -112,6 +120,10 @@ var (
 		"alb",
 		"rollback-alarms",
 		"imported-alb-resources",
+		"xray",
+		"cost",
+		"appconfig",
+		"cdn",
 	}
 
 	// Operating systems to determine Fargate platform versions.
@@ -139,6 +151,7 @@ type SidecarOpts struct {
 	Variables    map[string]Variable
 	Secrets      map[string]Secret
 	Storage      SidecarStorageOpts
+	VolumesFrom  []string
 	DockerLabels map[string]string
 	DependsOn    map[string]string
 	EntryPoint   []string
@@ -436,6 +449,7 @@ type NetworkLoadBalancerListener struct {
 	TargetPort      string
 
 	SSLPolicy *string // The SSL policy applied when using TLS protocol.
+	ALPN      *string // The ALPN policy applied when using TLS protocol, for example HTTP2Preferred for gRPC clients.
 
 	Stickiness          *bool
 	HealthCheck         NLBHealthCheck
@@ -460,6 +474,21 @@ type NetworkLoadBalancer struct {
 	Aliases             []string
 }
 
+// ServiceCDNOpts holds configuration that's needed for a CloudFront distribution in front of a service.
+type ServiceCDNOpts struct {
+	OriginDomain       string // Domain name to reach the load balancer over HTTPS. If empty, CloudFront connects over HTTP.
+	OriginShieldRegion string
+	CachePolicies      []CDNCachePolicy
+	Aliases            []string
+	Certificate        string // ARN of an imported certificate. If empty, a certificate is requested for the aliases.
+}
+
+// CDNCachePolicy holds the cache policy applied to the requests that match a path pattern.
+type CDNCachePolicy struct {
+	PathPattern string
+	PolicyID    string
+}
+
 // ALBListenerRule holds configuration that's needed for an Application Load Balancer listener rule.
 type ALBListenerRule struct {
 	// The path that the Application Load Balancer listens to.
@@ -471,12 +500,20 @@ type ALBListenerRule struct {
 	Aliases             []string
 	AllowedSourceIps    []string
 	Stickiness          string
+	StickinessOpts      *StickinessOpts
 	HTTPHealthCheck     HTTPHealthCheckOpts
 	HTTPVersion         string
 	RedirectToHTTPS     bool // Only relevant if HTTPSListener is true.
 	DeregistrationDelay *int64
 }
 
+// StickinessOpts holds advanced sticky sessions configuration for a target group.
+type StickinessOpts struct {
+	Type       string
+	Duration   *int64 // In seconds.
+	CookieName string
+}
+
 // ALBListener holds configuration that's needed for an Application Load Balancer Listener.
 type ALBListener struct {
 	Rules             []ALBListenerRule
@@ -511,17 +548,41 @@ func (cfg *ALBListener) RulePaths() []string {
 }
 
 // ServiceConnectOpts defines the options for service connect.
-// If Client is false, logically Server must be nil.
+// If Client is false, logically Server and AdditionalServers must be empty.
 type ServiceConnectOpts struct {
-	Server *ServiceConnectServer
-	Client bool
+	Server            *ServiceConnectServer
+	AdditionalServers []ServiceConnectServer // Other container ports exposed to Service Connect with their own aliases.
+	Client            bool
+	Namespace         string // ARN of a Cloud Map namespace shared with other applications. Empty to use the environment's namespace.
+	Timeout           *ServiceConnectTimeoutOpts
+}
+
+// ServiceConnectTimeoutOpts defines the timeouts of the Service Connect proxy for the ports exposed by a service.
+type ServiceConnectTimeoutOpts struct {
+	IdleTimeoutSeconds       *int64
+	PerRequestTimeoutSeconds *int64
 }
 
 // ServiceConnectServer defines the container name and port which a service routes Service Connect through.
 type ServiceConnectServer struct {
-	Name  string
-	Port  string
-	Alias string
+	Name       string
+	Port       string
+	Alias      string
+	ClientPort string // Port that clients use with the alias. Empty to use the container port.
+}
+
+// PortName returns the name of the port mapping that Service Connect routes traffic to for the container and port,
+// or an empty string if the port is not exposed to Service Connect.
+func (opts ServiceConnectOpts) PortName(container, port string) string {
+	if opts.Server != nil && opts.Server.Name == container && opts.Server.Port == port {
+		return "target"
+	}
+	for _, server := range opts.AdditionalServers {
+		if server.Name == container && server.Port == port {
+			return fmt.Sprintf("target-%s", port)
+		}
+	}
+	return ""
 }
 
 // AdvancedCount holds configuration for autoscaling and capacity provider
@@ -581,7 +642,25 @@ type AutoscalingQueueDelayOpts struct {
 
 // ObservabilityOpts holds configurations for observability.
 type ObservabilityOpts struct {
-	Tracing string // The name of the vendor used for tracing.
+	Tracing         string   // The name of the vendor used for tracing.
+	SamplingRate    *float64 // The fraction of requests sampled by the X-Ray sampling rule. Nil uses the account's default rule.
+	CollectorConfig string   // The configuration of the OpenTelemetry collector sidecar. Empty uses the collector's default configuration.
+	Dashboard       bool     // Whether to create a CloudWatch dashboard of the service's queues and tasks.
+}
+
+// CostOpts holds configuration for the budget and cost anomaly alerts of a service.
+type CostOpts struct {
+	MonthlyBudget    *float64 // Monthly budget in USD. Nil if no budget is created.
+	AlertThreshold   int      // Percentage of the budget that triggers an alert.
+	AnomalyDetection bool
+	Emails           []string
+}
+
+// AppConfigOpts holds configuration for the AWS AppConfig feature flags of a service and the agent sidecar that serves them.
+type AppConfigOpts struct {
+	Profiles            []string // Names of the feature flags configuration profiles.
+	PollIntervalSeconds *int64   // Nil if the agent's default interval applies.
+	Port                uint16
 }
 
 // DeploymentConfigurationOpts holds configuration for rolling deployments.
@@ -591,6 +670,9 @@ type DeploymentConfigurationOpts struct {
 	// The upper limit on the number of tasks that should be running during a service deployment or when a container instance is draining.
 	MaxPercent int
 	Rollback   RollingUpdateRollbackConfig
+	// Either "ENABLED" or "DISABLED" to control whether ECS redistributes tasks across Availability Zones.
+	// Empty uses the ECS default.
+	AZRebalancing string
 }
 
 // RollingUpdateRollbackConfig holds config for rollback alarms.
@@ -601,6 +683,24 @@ type RollingUpdateRollbackConfig struct {
 	CPUUtilization    *float64
 	MemoryUtilization *float64
 	MessagesDelayed   *int
+
+	// Alarm set by Copilot when the post-deployment validation of the service fails.
+	DeploymentValidation *DeploymentValidationAlarmOpts
+}
+
+// DeploymentValidationAlarmOpts holds configuration for the alarm that rolls back a deployment failing its validation.
+type DeploymentValidationAlarmOpts struct {
+	EvaluationPeriods int // Number of minutes during which a failed validation triggers a rollback.
+}
+
+// DeploymentValidationAlarmName returns the name of the alarm that Copilot sets when the validation of a deployment fails.
+func DeploymentValidationAlarmName(app, env, svc string) string {
+	return RollingUpdateRollbackConfig{}.TruncateAlarmName(app, env, svc, deploymentValidationAlarmType)
+}
+
+// DeploymentValidationAlarmName returns the name of the alarm that Copilot sets when the validation of a deployment fails.
+func (cfg RollingUpdateRollbackConfig) DeploymentValidationAlarmName(app, env, svc string) string {
+	return DeploymentValidationAlarmName(app, env, svc)
 }
 
 // HasRollbackAlarms returns true if the client is using ABR.
@@ -610,7 +710,7 @@ func (cfg RollingUpdateRollbackConfig) HasRollbackAlarms() bool {
 
 // HasCustomAlarms returns true if the client is using Copilot-generated alarms for alarm-based rollbacks.
 func (cfg RollingUpdateRollbackConfig) HasCustomAlarms() bool {
-	return cfg.CPUUtilization != nil || cfg.MemoryUtilization != nil || cfg.MessagesDelayed != nil
+	return cfg.CPUUtilization != nil || cfg.MemoryUtilization != nil || cfg.MessagesDelayed != nil || cfg.DeploymentValidation != nil
 }
 
 // TruncateAlarmName ensures that alarm names don't exceed the 255 character limit.
@@ -631,6 +731,22 @@ type StateMachineOpts struct {
 	Retries *int
 }
 
+// JobQueueTriggerOpts holds configuration needed to start executions of a job from the messages of an SQS queue.
+type JobQueueTriggerOpts struct {
+	QueueARN       string // Set if the queue is referenced by ARN.
+	QueueName      string // Set if the queue is referenced by name, in which case it must be in the same account and region.
+	BatchSize      int
+	MaxConcurrency *int
+}
+
+// JobAlertsOpts holds configuration needed to notify when consecutive scheduled executions of a job fail or do not start.
+type JobAlertsOpts struct {
+	FailuresThreshold int      // Number of consecutive runs without a successful execution before the alarm fires.
+	EvaluationPeriods int      // Number of consecutive periods without a successful execution before the alarm fires.
+	Period            int      // Length of a period in seconds.
+	Emails            []string // Email addresses subscribed to the notifications.
+}
+
 // PublishOpts holds configuration needed if the service has publishers.
 type PublishOpts struct {
 	Topics []*Topic
@@ -656,8 +772,10 @@ type FIFOTopicConfig struct {
 
 // SubscribeOpts holds configuration needed if the service has subscriptions.
 type SubscribeOpts struct {
-	Topics []*TopicSubscription
-	Queue  *SQSQueue
+	Topics  []*TopicSubscription
+	Buckets []*BucketSubscription
+	Queue   *SQSQueue
+	Kafka   *KafkaSubscription
 }
 
 // HasTopicQueues returns true if any individual subscription has a dedicated queue.
@@ -678,6 +796,24 @@ type TopicSubscription struct {
 	Queue        *SQSQueue
 }
 
+// BucketSubscription holds information needed to render an EventBridge rule that forwards
+// the object-created events of a S3 bucket addon to the events queue.
+type BucketSubscription struct {
+	Name    *string
+	Service *string
+	Prefix  *string
+}
+
+// KafkaSubscription holds information needed to grant the tasks access to the topics of an Amazon MSK cluster
+// with IAM access control.
+type KafkaSubscription struct {
+	ClusterARN       Variable
+	BootstrapBrokers Variable
+	SecurityGroup    SecurityGroup // Optional. The security group of the cluster that accepts the traffic from the tasks.
+	Topics           []string
+	ConsumerGroup    string // Optional. Defaults to "${AppName}-${EnvName}-${WorkloadName}".
+}
+
 // SQSQueue holds information needed to render a SQS Queue in a container definition.
 type SQSQueue struct {
 	Retention       *int64
@@ -697,6 +833,7 @@ type FIFOQueueConfig struct {
 // DeadLetterQueue holds information needed to render a dead-letter SQS Queue in a container definition.
 type DeadLetterQueue struct {
 	Tries *uint16
+	ARN   string // Optional. The ARN of an existing queue to redrive messages to instead of creating one.
 }
 
 // NetworkOpts holds AWS networking configuration for the workloads.
@@ -707,6 +844,12 @@ type NetworkOpts struct {
 	SubnetsType              string
 	SubnetIDs                []string
 	DenyDefaultSecurityGroup bool
+	// ServiceSecurityGroup is true if the tasks get their own security group, because the environment security group
+	// denies the traffic between workloads. IngressFromServices are the workloads allowed to reach them.
+	ServiceSecurityGroup bool
+	IngressFromServices  []string
+	// Name of the App Runner VPC connector shared by the environment. Mutually exclusive with SubnetsType and SubnetIDs.
+	VPCConnector string
 }
 
 // SecurityGroup represents the ID of an additional security group associated with the tasks.
@@ -777,6 +920,12 @@ func (p RuntimePlatformOpts) isEmpty() bool {
 	return p.OS == "" && p.Arch == ""
 }
 
+// CredentialSpecOpts holds the location of the gMSA credential spec of a Windows container.
+type CredentialSpecOpts struct {
+	ARN  string // ARN of the S3 object or SSM parameter that holds the credential spec.
+	InS3 bool   // True if the credential spec is an S3 object, false if it's an SSM parameter.
+}
+
 // S3ObjectLocation represents an object stored in an S3 bucket.
 type S3ObjectLocation struct {
 	Bucket string // Name of the bucket.
@@ -788,9 +937,11 @@ type WorkloadOpts struct {
 	AppName            string
 	EnvName            string
 	WorkloadName       string
-	SerializedManifest string // Raw manifest file used to deploy the workload.
+	SerializedManifest string            // Raw manifest file used to deploy the workload.
+	DeployValues       map[string]string // Manifest fields overridden at deploy time, recorded for traceability.
 	EnvVersion         string
 	Version            string
+	EnvKMSKeyARN       string // Customer managed key used to encrypt the environment's resources.
 
 	// Configuration for the main container.
 	PortMappings []*PortMapping
@@ -813,6 +964,7 @@ type WorkloadOpts struct {
 	Network                  NetworkOpts
 	ExecuteCommand           *ExecuteCommandOpts
 	Platform                 RuntimePlatformOpts
+	CredentialSpec           *CredentialSpecOpts
 	DockerLabels             map[string]string
 	DependsOn                map[string]string
 	Publish                  *PublishOpts
@@ -827,8 +979,11 @@ type WorkloadOpts struct {
 	GracePeriod             *int64
 	NLB                     *NetworkLoadBalancer
 	ALBListener             *ALBListener
+	CDN                     *ServiceCDNOpts
 	DeploymentConfiguration DeploymentConfigurationOpts
 	ServiceConnectOpts      ServiceConnectOpts
+	Cost                    *CostOpts
+	AppConfig               *AppConfigOpts
 
 	// Custom Resources backed by Lambda functions.
 	CustomResources map[string]S3ObjectLocation
@@ -836,6 +991,8 @@ type WorkloadOpts struct {
 	// Additional options for job templates.
 	ScheduleExpression string
 	StateMachine       *StateMachineOpts
+	QueueTrigger       *JobQueueTriggerOpts
+	JobAlerts          *JobAlertsOpts
 
 	// Additional options for request driven web service templates.
 	StartCommand         *string
@@ -855,10 +1012,29 @@ type WorkloadOpts struct {
 	Subscribe *SubscribeOpts
 
 	// Additional options for static site template.
-	AssetMappingFileBucket string
-	AssetMappingFilePath   string
-	StaticSiteAlias        string
-	StaticSiteCert         string
+	AssetMappingFileBucket   string
+	AssetMappingFilePath     string
+	StaticSiteAlias          string
+	StaticSiteCert           string
+	StaticSiteErrorResponses []CloudFrontErrorResponse
+	StaticSiteRedirects      []CloudFrontRedirect
+}
+
+// CloudFrontErrorResponse represents a page returned by a CloudFront distribution when the origin responds with an error.
+type CloudFrontErrorResponse struct {
+	ErrorCode    int
+	ResponseCode int
+	PagePath     string
+}
+
+// CloudFrontRedirect represents a redirect response returned by the viewer request function of a CloudFront distribution.
+type CloudFrontRedirect struct {
+	Path              string // Path of the requests to redirect, or their prefix if MatchPrefix is true.
+	MatchPrefix       bool
+	Location          string
+	AppendSuffix      bool // Whether to append the rest of the path after the prefix to the location.
+	StatusCode        int
+	StatusDescription string
 }
 
 // HealthCheckProtocol returns the protocol for the Load Balancer health check,
@@ -1059,7 +1235,8 @@ func envControllerParameters(o WorkloadOpts) []string {
 			parameters = append(parameters, "AppRunnerPrivateWorkloads,")
 		}
 	}
-	if o.Network.SubnetsType == PrivateSubnetsPlacement {
+	if o.Network.SubnetsType != "" && o.Network.SubnetsType != PublicSubnetsPlacement {
+		// Private subnets and subnet groups reach the internet through the NAT gateways.
 		parameters = append(parameters, "NATWorkloads,")
 	}
 	if o.Storage != nil && o.Storage.requiresEFSCreation() {
@@ -42,13 +42,18 @@ func TestTemplate_ParseSvc(t *testing.T) {
 				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/workload-container.yml", []byte("workload-container"), 0644)
 				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/fargate-taskdef-base-properties.yml", []byte("fargate-taskdef-base-properties"), 0644)
 				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/service-base-properties.yml", []byte("service-base-properties"), 0644)
+				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/service-security-group.yml", []byte("service-security-group"), 0644)
 				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/servicediscovery.yml", []byte("servicediscovery"), 0644)
 				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/addons.yml", []byte("addons"), 0644)
 				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/sidecars.yml", []byte("sidecars"), 0644)
 				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/logconfig.yml", []byte("logconfig"), 0644)
 				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/autoscaling.yml", []byte("autoscaling"), 0644)
+				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/backlog-per-task-calculator.yml", []byte("backlog-per-task-calculator"), 0644)
+				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/dashboard.yml", []byte("dashboard"), 0644)
 				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/state-machine-definition.json.yml", []byte("state-machine-definition"), 0644)
 				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/eventrule.yml", []byte("eventrule"), 0644)
+				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/job-queue-trigger.yml", []byte("job-queue-trigger"), 0644)
+				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/job-alerts.yml", []byte("job-alerts"), 0644)
 				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/state-machine.yml", []byte("state-machine"), 0644)
 				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/efs-access-point.yml", []byte("efs-access-point"), 0644)
 				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/https-listener.yml", []byte("https-listener"), 0644)
@@ -67,6 +72,10 @@ func TestTemplate_ParseSvc(t *testing.T) {
 				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/alb.yml", []byte("alb"), 0644)
 				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/rollback-alarms.yml", []byte("rollback-alarms"), 0644)
 				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/imported-alb-resources.yml", []byte("imported-alb-resources"), 0644)
+				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/xray.yml", []byte("xray"), 0644)
+				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/cost.yml", []byte("cost"), 0644)
+				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/appconfig.yml", []byte("appconfig"), 0644)
+				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/cdn.yml", []byte("cdn"), 0644)
 
 				return fs
 			},
@@ -79,12 +88,17 @@ func TestTemplate_ParseSvc(t *testing.T) {
   workload-container
   fargate-taskdef-base-properties
   service-base-properties
+  service-security-group
   servicediscovery
   addons
   sidecars
   logconfig
   autoscaling
+  backlog-per-task-calculator
+  dashboard
   eventrule
+  job-queue-trigger
+  job-alerts
   state-machine
   state-machine-definition
   efs-access-point
@@ -104,6 +118,10 @@ func TestTemplate_ParseSvc(t *testing.T) {
   alb
   rollback-alarms
   imported-alb-resources
+  xray
+  cost
+  appconfig
+  cdn
 `,
 		},
 	}
@@ -410,6 +428,15 @@ func TestEnvControllerParameters(t *testing.T) {
 			},
 			expected: []string{},
 		},
+		"Backend in a subnet group": {
+			opts: WorkloadOpts{
+				WorkloadType: "Backend Service",
+				Network: NetworkOpts{
+					SubnetsType: SubnetGroupPlacement("data"),
+				},
+			},
+			expected: []string{"NATWorkloads,"},
+		},
 		"Backend with ALB": {
 			opts: WorkloadOpts{
 				WorkloadType: "Backend Service",
@@ -478,6 +505,77 @@ func TestRollingUpdateRollbackConfig_TruncateAlarmName(t *testing.T) {
 	}
 }
 
+func TestRollingUpdateRollbackConfig_HasCustomAlarms(t *testing.T) {
+	testCases := map[string]struct {
+		config   RollingUpdateRollbackConfig
+		expected bool
+	}{
+		"false with only existing alarms": {
+			config: RollingUpdateRollbackConfig{
+				AlarmNames: []string{"alarm"},
+			},
+		},
+		"true with a deployment validation alarm": {
+			config: RollingUpdateRollbackConfig{
+				AlarmNames:           []string{"alarm"},
+				DeploymentValidation: &DeploymentValidationAlarmOpts{EvaluationPeriods: 3},
+			},
+			expected: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.config.HasCustomAlarms())
+		})
+	}
+}
+
+func TestServiceConnectOpts_PortName(t *testing.T) {
+	opts := ServiceConnectOpts{
+		Server: &ServiceConnectServer{
+			Name: "api",
+			Port: "8080",
+		},
+		AdditionalServers: []ServiceConnectServer{
+			{
+				Name:  "grpc",
+				Port:  "50051",
+				Alias: "api-grpc",
+			},
+		},
+		Client: true,
+	}
+	testCases := map[string]struct {
+		container string
+		port      string
+		expected  string
+	}{
+		"default Service Connect port": {
+			container: "api",
+			port:      "8080",
+			expected:  "target",
+		},
+		"additional Service Connect port": {
+			container: "grpc",
+			port:      "50051",
+			expected:  "target-50051",
+		},
+		"port not exposed to Service Connect": {
+			container: "api",
+			port:      "9090",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, opts.PortName(tc.container, tc.port))
+		})
+	}
+}
+
+func TestDeploymentValidationAlarmName(t *testing.T) {
+	require.Equal(t, "phonetool-test-api-CopilotDeploymentValidationAlarm", DeploymentValidationAlarmName("phonetool", "test", "api"))
+}
+
 func TestApplicationLoadBalancer_Aliases(t *testing.T) {
 	tests := map[string]struct {
 		opts     ALBListener
@@ -8,7 +8,10 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"strings"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/dustin/go-humanize"
 	"github.com/dustin/go-humanize/english"
 
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
@@ -77,6 +80,7 @@ const (
 	deployedSvcFinalMsg  = "Service:"
 	deployedWkldFinalMsg = "Workload:"
 	taskFinalMsg         = "Task:"
+	containerFinalMsg    = "Container:"
 	workloadFinalMsg     = "Name:"
 	dockerfileFinalMsg   = "Dockerfile:"
 	topicFinalMsg        = "Topic subscriptions:"
@@ -280,6 +284,9 @@ type TaskSelector struct {
 	defaultCluster bool
 	taskGroup      string
 	taskID         string
+	tags           map[string]string
+	startedBy      string
+	latest         bool
 }
 
 // NewAppEnvSelector returns a selector that chooses applications or environments.
@@ -397,6 +404,27 @@ func WithTaskID(id string) TaskOpts {
 	}
 }
 
+// WithTaskTags sets up the tags that the tasks listed by TaskSelector must have.
+func WithTaskTags(tags map[string]string) TaskOpts {
+	return func(in *TaskSelector) {
+		in.tags = tags
+	}
+}
+
+// WithStartedBy sets up the "startedBy" value that the tasks listed by TaskSelector must have.
+func WithStartedBy(startedBy string) TaskOpts {
+	return func(in *TaskSelector) {
+		in.startedBy = startedBy
+	}
+}
+
+// WithLatest selects the most recently started task instead of prompting the user.
+func WithLatest() TaskOpts {
+	return func(in *TaskSelector) {
+		in.latest = true
+	}
+}
+
 // RunningTask has the user select a running task. Callers can provide either app and env names,
 // or use default cluster.
 func (s *TaskSelector) RunningTask(msg, help string, opts ...TaskOpts) (*awsecs.Task, error) {
@@ -409,6 +437,8 @@ func (s *TaskSelector) RunningTask(msg, help string, opts ...TaskOpts) (*awsecs.
 		TaskGroup:   s.taskGroup,
 		TaskID:      s.taskID,
 		CopilotOnly: true,
+		Tags:        s.tags,
+		StartedBy:   s.startedBy,
 	}
 	if s.defaultCluster {
 		tasks, err = s.lister.ListActiveDefaultClusterTasks(filter)
@@ -426,16 +456,26 @@ func (s *TaskSelector) RunningTask(msg, help string, opts ...TaskOpts) (*awsecs.
 			return nil, fmt.Errorf("list active tasks in environment %s: %w", s.env, err)
 		}
 	}
+	if len(tasks) == 0 {
+		return nil, fmt.Errorf("no running tasks found")
+	}
+	if s.latest {
+		latest := tasks[0]
+		for _, task := range tasks[1:] {
+			if aws.TimeValue(task.StartedAt).After(aws.TimeValue(latest.StartedAt)) {
+				latest = task
+			}
+		}
+		log.Infof("Found the most recently started task %s\n", color.HighlightUserInput(latest.String()))
+		return latest, nil
+	}
 	var taskStrList []string
 	taskStrMap := make(map[string]*awsecs.Task)
 	for _, task := range tasks {
-		taskStr := task.String()
+		taskStr := runningTaskLabel(task)
 		taskStrList = append(taskStrList, taskStr)
 		taskStrMap[taskStr] = task
 	}
-	if len(taskStrList) == 0 {
-		return nil, fmt.Errorf("no running tasks found")
-	}
 	// return if only one running task found
 	if len(taskStrList) == 1 {
 		log.Infof("Found only one running task %s\n", color.HighlightUserInput(taskStrList[0]))
@@ -453,6 +493,94 @@ func (s *TaskSelector) RunningTask(msg, help string, opts ...TaskOpts) (*awsecs.
 	return taskStrMap[task], nil
 }
 
+// runningTaskLabel returns the option displayed for a task, along with
+// when it started and the image of its first container if they are known.
+func runningTaskLabel(task *awsecs.Task) string {
+	var details []string
+	if task.StartedAt != nil {
+		details = append(details, fmt.Sprintf("started %s", humanize.Time(aws.TimeValue(task.StartedAt))))
+	}
+	if len(task.Containers) > 0 && aws.StringValue(task.Containers[0].Image) != "" {
+		details = append(details, fmt.Sprintf("image %s", aws.StringValue(task.Containers[0].Image)))
+	}
+	if len(details) == 0 {
+		return task.String()
+	}
+	return fmt.Sprintf("%s %s", task.String(), strings.Join(details, ", "))
+}
+
+// ContainerSelector is a selector for the running tasks of a workload and their containers.
+type ContainerSelector struct {
+	prompt Prompter
+}
+
+// NewContainerSelector returns a new selector that chooses a task among the running tasks of a workload, and one of its containers.
+func NewContainerSelector(prompt Prompter) *ContainerSelector {
+	return &ContainerSelector{
+		prompt: prompt,
+	}
+}
+
+// Task has the user select one of the tasks, which are displayed with when they started.
+// The task is selected without prompting if it's the only one.
+func (s *ContainerSelector) Task(msg, help string, tasks []*awsecs.Task) (*awsecs.Task, error) {
+	if len(tasks) == 0 {
+		return nil, fmt.Errorf("no running tasks found")
+	}
+	if len(tasks) == 1 {
+		log.Infof("Found only one running task %s\n", color.HighlightUserInput(tasks[0].String()))
+		return tasks[0], nil
+	}
+	options := make([]prompt.Option, len(tasks))
+	byARN := make(map[string]*awsecs.Task, len(tasks))
+	for i, task := range tasks {
+		options[i] = prompt.Option{
+			Value:        aws.StringValue(task.TaskArn),
+			FriendlyText: runningTaskLabel(task),
+		}
+		byARN[aws.StringValue(task.TaskArn)] = task
+	}
+	arn, err := s.prompt.SelectOption(msg, help, options, prompt.WithFinalMessage(taskFinalMsg))
+	if err != nil {
+		return nil, fmt.Errorf("select running task: %w", err)
+	}
+	return byARN[arn], nil
+}
+
+// Container has the user select one of the running containers of the task, including its sidecars.
+// The main container is listed first, and is selected without prompting if it's the only running container.
+func (s *ContainerSelector) Container(msg, help string, task *awsecs.Task, mainContainer string) (string, error) {
+	var main, sidecars []prompt.Option
+	for _, container := range task.Containers {
+		if aws.StringValue(container.LastStatus) != awsecs.TaskStatusRunning {
+			continue
+		}
+		name := aws.StringValue(container.Name)
+		if name == mainContainer {
+			main = append(main, prompt.Option{Value: name, Hint: "main"})
+			continue
+		}
+		hint := "sidecar"
+		if image := aws.StringValue(container.Image); image != "" {
+			hint = fmt.Sprintf("sidecar, image %s", image)
+		}
+		sidecars = append(sidecars, prompt.Option{Value: name, Hint: hint})
+	}
+	options := append(main, sidecars...)
+	switch len(options) {
+	case 0:
+		return "", fmt.Errorf("no running containers found in task %s", task.String())
+	case 1:
+		log.Infof("Found only one running container %s\n", color.HighlightUserInput(options[0].Value))
+		return options[0].Value, nil
+	}
+	container, err := s.prompt.SelectOption(msg, help, options, prompt.WithFinalMessage(containerFinalMsg))
+	if err != nil {
+		return "", fmt.Errorf("select container: %w", err)
+	}
+	return container, nil
+}
+
 // GetDeployedWorkloadOpts sets up optional parameters for GetDeployedWorkloadOpts function.
 type GetDeployedWorkloadOpts func(*DeploySelector)
 
@@ -7,8 +7,10 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	ecsapi "github.com/aws/aws-sdk-go/service/ecs"
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
@@ -3145,12 +3147,28 @@ func TestTaskSelect_Task(t *testing.T) {
 		TaskArn:           aws.String("arn:aws:ecs:us-west-2:123456789:task/0aa1ba8d4082490ee6c245e09d214501"),
 		TaskDefinitionArn: aws.String("arn:aws:ecs:us-west-2:123456789:task-definition/sample-fargate:3"),
 	}
+	mockTask3 := &awsecs.Task{
+		TaskArn:           aws.String("arn:aws:ecs:us-west-2:123456789:task/ba8d4082490ee6c245e09d2145010aa1"),
+		TaskDefinitionArn: aws.String("arn:aws:ecs:us-west-2:123456789:task-definition/sample-fargate:3"),
+		StartedAt:         aws.Time(time.Now().Add(-2 * time.Hour)),
+		Containers: []*ecsapi.Container{
+			{
+				Image: aws.String("nginx:latest"),
+			},
+		},
+	}
+	mockTask4 := &awsecs.Task{
+		TaskArn:           aws.String("arn:aws:ecs:us-west-2:123456789:task/e6c245e09d2145010aa1ba8d4082490e"),
+		TaskDefinitionArn: aws.String("arn:aws:ecs:us-west-2:123456789:task-definition/sample-fargate:4"),
+		StartedAt:         aws.Time(time.Now().Add(-10 * time.Minute)),
+	}
 	mockErr := errors.New("some error")
 	testCases := map[string]struct {
 		setupMocks func(mocks taskSelectMocks)
 		app        string
 		env        string
 		useDefault bool
+		opts       []TaskOpts
 
 		wantErr  error
 		wantTask *awsecs.Task
@@ -3222,6 +3240,39 @@ func TestTaskSelect_Task(t *testing.T) {
 			},
 			wantTask: mockTask1,
 		},
+		"success with start time and image in the options": {
+			app: mockApp,
+			env: mockEnv,
+			opts: []TaskOpts{
+				WithTaskTags(map[string]string{"team": "payments"}),
+				WithStartedBy("ci"),
+			},
+			setupMocks: func(m taskSelectMocks) {
+				m.taskLister.EXPECT().ListActiveAppEnvTasks(ecs.ListActiveAppEnvTasksOpts{
+					App: mockApp,
+					Env: mockEnv,
+					ListTasksFilter: ecs.ListTasksFilter{
+						CopilotOnly: true,
+						Tags:        map[string]string{"team": "payments"},
+						StartedBy:   "ci",
+					},
+				}).Return([]*awsecs.Task{mockTask3, mockTask4}, nil)
+				m.prompt.EXPECT().SelectOne(mockPromptText, mockHelpText, []string{
+					"ba8d4082 (sample-fargate:3) started 2 hours ago, image nginx:latest",
+					"e6c245e0 (sample-fargate:4) started 10 minutes ago",
+				}, gomock.Any()).Return("e6c245e0 (sample-fargate:4) started 10 minutes ago", nil)
+			},
+			wantTask: mockTask4,
+		},
+		"success with the most recently started task": {
+			useDefault: true,
+			opts:       []TaskOpts{WithLatest()},
+			setupMocks: func(m taskSelectMocks) {
+				m.taskLister.EXPECT().ListActiveDefaultClusterTasks(ecs.ListTasksFilter{CopilotOnly: true}).
+					Return([]*awsecs.Task{mockTask1, mockTask3, mockTask4}, nil)
+			},
+			wantTask: mockTask4,
+		},
 		"success": {
 			app: mockApp,
 			env: mockEnv,
@@ -3263,10 +3314,10 @@ func TestTaskSelect_Task(t *testing.T) {
 			var err error
 			if tc.useDefault {
 				gotTask, err = sel.RunningTask(mockPromptText, mockHelpText,
-					WithAppEnv(tc.app, tc.env), WithDefault())
+					append([]TaskOpts{WithAppEnv(tc.app, tc.env), WithDefault()}, tc.opts...)...)
 			} else {
 				gotTask, err = sel.RunningTask(mockPromptText, mockHelpText,
-					WithAppEnv(tc.app, tc.env))
+					append([]TaskOpts{WithAppEnv(tc.app, tc.env)}, tc.opts...)...)
 			}
 			if tc.wantErr != nil {
 				require.EqualError(t, err, tc.wantErr.Error())
@@ -3277,3 +3328,171 @@ func TestTaskSelect_Task(t *testing.T) {
 		})
 	}
 }
+
+func TestContainerSelector_Task(t *testing.T) {
+	mockTask1 := &awsecs.Task{
+		TaskArn:           aws.String("arn:aws:ecs:us-west-2:123456789012:task/4082490ee6c245e09d2145010aa1ba8d"),
+		TaskDefinitionArn: aws.String("arn:aws:ecs:us-west-2:123456789012:task-definition/sample-fargate:2"),
+	}
+	mockTask2 := &awsecs.Task{
+		TaskArn:           aws.String("arn:aws:ecs:us-west-2:123456789012:task/0aa1ba8d4082490ee6c245e09d214501"),
+		TaskDefinitionArn: aws.String("arn:aws:ecs:us-west-2:123456789012:task-definition/sample-fargate:2"),
+	}
+	testCases := map[string]struct {
+		inTasks    []*awsecs.Task
+		setupMocks func(m *mocks.MockPrompter)
+
+		wantedTask *awsecs.Task
+		wantedErr  error
+	}{
+		"error if there are no tasks": {
+			setupMocks: func(m *mocks.MockPrompter) {},
+			wantedErr:  errors.New("no running tasks found"),
+		},
+		"selects the only task without prompting": {
+			inTasks:    []*awsecs.Task{mockTask1},
+			setupMocks: func(m *mocks.MockPrompter) {},
+			wantedTask: mockTask1,
+		},
+		"error if the prompt fails": {
+			inTasks: []*awsecs.Task{mockTask1, mockTask2},
+			setupMocks: func(m *mocks.MockPrompter) {
+				m.EXPECT().SelectOption(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("select running task: some error"),
+		},
+		"prompts for a task among multiple tasks": {
+			inTasks: []*awsecs.Task{mockTask1, mockTask2},
+			setupMocks: func(m *mocks.MockPrompter) {
+				m.EXPECT().SelectOption("Which task?", "Help text", []prompt.Option{
+					{
+						Value:        aws.StringValue(mockTask1.TaskArn),
+						FriendlyText: "4082490e (sample-fargate:2)",
+					},
+					{
+						Value:        aws.StringValue(mockTask2.TaskArn),
+						FriendlyText: "0aa1ba8d (sample-fargate:2)",
+					},
+				}, gomock.Any()).Return(aws.StringValue(mockTask2.TaskArn), nil)
+			},
+			wantedTask: mockTask2,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			p := mocks.NewMockPrompter(ctrl)
+			tc.setupMocks(p)
+			sel := NewContainerSelector(p)
+
+			got, err := sel.Task("Which task?", "Help text", tc.inTasks)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedTask, got)
+		})
+	}
+}
+
+func TestContainerSelector_Container(t *testing.T) {
+	mockTaskARN := aws.String("arn:aws:ecs:us-west-2:123456789012:task/4082490ee6c245e09d2145010aa1ba8d")
+	testCases := map[string]struct {
+		inContainers []*ecsapi.Container
+		setupMocks   func(m *mocks.MockPrompter)
+
+		wanted    string
+		wantedErr error
+	}{
+		"error if no container is running": {
+			inContainers: []*ecsapi.Container{
+				{
+					Name:       aws.String("frontend"),
+					LastStatus: aws.String("STOPPED"),
+				},
+			},
+			setupMocks: func(m *mocks.MockPrompter) {},
+			wantedErr:  errors.New("no running containers found in task 4082490e (sample-fargate:2)"),
+		},
+		"selects the only running container without prompting": {
+			inContainers: []*ecsapi.Container{
+				{
+					Name:       aws.String("frontend"),
+					LastStatus: aws.String("RUNNING"),
+				},
+				{
+					Name:       aws.String("nginx"),
+					LastStatus: aws.String("PENDING"),
+				},
+			},
+			setupMocks: func(m *mocks.MockPrompter) {},
+			wanted:     "frontend",
+		},
+		"lists the main container before the sidecars": {
+			inContainers: []*ecsapi.Container{
+				{
+					Name:       aws.String("nginx"),
+					Image:      aws.String("public.ecr.aws/nginx/nginx:latest"),
+					LastStatus: aws.String("RUNNING"),
+				},
+				{
+					Name:       aws.String("frontend"),
+					LastStatus: aws.String("RUNNING"),
+				},
+				{
+					Name:       aws.String("firelens_log_router"),
+					LastStatus: aws.String("RUNNING"),
+				},
+			},
+			setupMocks: func(m *mocks.MockPrompter) {
+				m.EXPECT().SelectOption("Which container?", "Help text", []prompt.Option{
+					{Value: "frontend", Hint: "main"},
+					{Value: "nginx", Hint: "sidecar, image public.ecr.aws/nginx/nginx:latest"},
+					{Value: "firelens_log_router", Hint: "sidecar"},
+				}, gomock.Any()).Return("nginx", nil)
+			},
+			wanted: "nginx",
+		},
+		"error if the prompt fails": {
+			inContainers: []*ecsapi.Container{
+				{
+					Name:       aws.String("frontend"),
+					LastStatus: aws.String("RUNNING"),
+				},
+				{
+					Name:       aws.String("nginx"),
+					LastStatus: aws.String("RUNNING"),
+				},
+			},
+			setupMocks: func(m *mocks.MockPrompter) {
+				m.EXPECT().SelectOption(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("select container: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			p := mocks.NewMockPrompter(ctrl)
+			tc.setupMocks(p)
+			sel := NewContainerSelector(p)
+
+			got, err := sel.Container("Which container?", "Help text", &awsecs.Task{
+				TaskArn:           mockTaskARN,
+				TaskDefinitionArn: aws.String("arn:aws:ecs:us-west-2:123456789012:task-definition/sample-fargate:2"),
+				Containers:        tc.inContainers,
+			}, "frontend")
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
@@ -55,6 +55,7 @@ const (
 	legacyPipelineFileName    = "pipeline.yml"
 	manifestFileName          = "manifest.yml"
 	buildspecFileName         = "buildspec.yml"
+	releaseFileName           = "release.yml"
 )
 
 // ErrTraverseUpShouldStop signals that TraverseUp should stop.
@@ -429,6 +430,19 @@ func (ws *Workspace) ReadPipelineManifest(path string) (*manifest.Pipeline, erro
 	return pipelineManifest, nil
 }
 
+// ReadReleaseManifest returns the release manifest under copilot/release.yml.
+func (ws *Workspace) ReadReleaseManifest() (*manifest.Release, error) {
+	raw, err := ws.read(releaseFileName)
+	if err != nil {
+		return nil, err
+	}
+	release, err := manifest.UnmarshalRelease(raw)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal release manifest: %w", err)
+	}
+	return release, nil
+}
+
 // WriteServiceManifest writes the service's manifest under the copilot/{name}/ directory.
 func (ws *Workspace) WriteServiceManifest(marshaler encoding.BinaryMarshaler, name string) (string, error) {
 	data, err := marshaler.MarshalBinary()
@@ -447,6 +461,23 @@ func (ws *Workspace) WriteJobManifest(marshaler encoding.BinaryMarshaler, name s
 	return ws.write(data, name, manifestFileName)
 }
 
+// OverwriteWorkloadManifest replaces the contents of the workload's manifest under the copilot/{name}/ directory.
+// If successful returns the full path of the file, otherwise returns an empty string and the error.
+func (ws *Workspace) OverwriteWorkloadManifest(data []byte, name string) (string, error) {
+	filename := filepath.Join(ws.CopilotDirAbs, name, manifestFileName)
+	exist, err := ws.fs.Exists(filename)
+	if err != nil {
+		return "", fmt.Errorf("check if manifest file %s exists: %w", filename, err)
+	}
+	if !exist {
+		return "", &ErrFileNotExists{FileName: filename}
+	}
+	if err := ws.fs.WriteFile(filename, data, 0644 /* -rw-r--r-- */); err != nil {
+		return "", fmt.Errorf("write manifest file: %w", err)
+	}
+	return filename, nil
+}
+
 // WritePipelineBuildspec writes the pipeline buildspec under the copilot/pipelines/{name}/ directory.
 // If successful returns the full path of the file, otherwise returns an empty string and the error.
 func (ws *Workspace) WritePipelineBuildspec(marshaler encoding.BinaryMarshaler, name string) (string, error) {
@@ -726,6 +757,19 @@ func (w WorkloadManifest) WorkloadType() (string, error) {
 	return retrieveTypeFromManifest(w)
 }
 
+// DeployDependencies returns the workloads that the manifest declares to deploy before the workload.
+func (w WorkloadManifest) DeployDependencies() ([]string, error) {
+	wl := struct {
+		Deploy struct {
+			DependsOn []string `yaml:"depends_on"`
+		} `yaml:"deploy"`
+	}{}
+	if err := yaml.Unmarshal(w, &wl); err != nil {
+		return nil, fmt.Errorf(`unmarshal manifest file to retrieve "deploy.depends_on": %w`, err)
+	}
+	return wl.Deploy.DependsOn, nil
+}
+
 // EnvironmentManifest represents raw local environment manifest.
 type EnvironmentManifest []byte
 
@@ -10,6 +10,8 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/require"
 )
@@ -1292,6 +1294,60 @@ func TestWorkspace_Write(t *testing.T) {
 	}
 }
 
+func TestWorkspace_OverwriteWorkloadManifest(t *testing.T) {
+	testCases := map[string]struct {
+		name   string
+		mockFS func() afero.Fs
+
+		wantedPath string
+		wantedErr  error
+	}{
+		"return error if the manifest does not exist": {
+			name: "webhook",
+			mockFS: func() afero.Fs {
+				return afero.NewMemMapFs()
+			},
+			wantedErr: fmt.Errorf("file %s does not exists", filepath.FromSlash("/copilot/webhook/manifest.yml")),
+		},
+		"overwrites the existing manifest": {
+			name: "webhook",
+			mockFS: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot/webhook/", 0755)
+				afero.WriteFile(fs, "/copilot/webhook/manifest.yml", []byte("name: webhook"), 0644)
+				return fs
+			},
+			wantedPath: filepath.FromSlash("/copilot/webhook/manifest.yml"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			fs := &afero.Afero{Fs: tc.mockFS()}
+			ws := &Workspace{
+				workingDirAbs: "/",
+				CopilotDirAbs: "/copilot",
+				fs:            fs,
+			}
+
+			// WHEN
+			actualPath, actualErr := ws.OverwriteWorkloadManifest([]byte("name: webhook\ntype: Worker Service"), tc.name)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, actualErr, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, actualErr)
+			require.Equal(t, tc.wantedPath, actualPath)
+			out, err := fs.ReadFile(tc.wantedPath)
+			require.NoError(t, err)
+			require.Equal(t, "name: webhook\ntype: Worker Service", string(out))
+		})
+	}
+}
+
 func TestWorkspace_ReadWorkloadManifest(t *testing.T) {
 	const (
 		mockCopilotDir   = "/copilot"
@@ -1374,6 +1430,45 @@ flavor: vanilla`),
 	}
 }
 
+func TestWorkloadManifest_DeployDependencies(t *testing.T) {
+	testCases := map[string]struct {
+		in WorkloadManifest
+
+		wanted    []string
+		wantedErr string
+	}{
+		"returns nil if the manifest does not declare dependencies": {
+			in: []byte(`name: api
+type: Backend Service`),
+		},
+		"returns the workloads to deploy before": {
+			in: []byte(`name: frontend
+type: Load Balanced Web Service
+deploy:
+  depends_on: [api, db]`),
+			wanted: []string{"api", "db"},
+		},
+		"error if the dependencies are malformed": {
+			in: []byte(`name: frontend
+deploy:
+  depends_on: api`),
+			wantedErr: `unmarshal manifest file to retrieve "deploy.depends_on"`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.in.DeployDependencies()
+
+			if tc.wantedErr != "" {
+				require.ErrorContains(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
 func TestWorkspace_ReadEnvironmentManifest(t *testing.T) {
 	const mockEnvironmentName = "test"
 
@@ -1467,6 +1562,72 @@ flavor: vanilla`),
 	}
 }
 
+func TestWorkspace_ReadReleaseManifest(t *testing.T) {
+	copilotDir := "/copilot"
+	testCases := map[string]struct {
+		fs func() afero.Fs
+
+		wantedRelease *manifest.Release
+		wantedErr     error
+	}{
+		"error if the release manifest doesn't exist": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.Mkdir(copilotDir, 0755)
+				return fs
+			},
+			wantedErr: &ErrFileNotExists{FileName: "/copilot/release.yml"},
+		},
+		"error if the release manifest can't be unmarshaled": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll(copilotDir, 0755)
+				afero.WriteFile(fs, "/copilot/release.yml", []byte(`workloads: api`), 0644)
+				return fs
+			},
+			wantedErr: errors.New("unmarshal release manifest: yaml: unmarshal errors:\n  line 1: cannot unmarshal !!str `api` into []manifest.ReleaseWorkload"),
+		},
+		"reads the release manifest": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll(copilotDir, 0755)
+				afero.WriteFile(fs, "/copilot/release.yml", []byte(`
+workloads:
+  - name: api
+    image_tag: v1
+`), 0644)
+				return fs
+			},
+			wantedRelease: &manifest.Release{
+				Workloads: []manifest.ReleaseWorkload{
+					{Name: "api", ImageTag: aws.String("v1")},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ws := &Workspace{
+				CopilotDirAbs: copilotDir,
+				fs:            &afero.Afero{Fs: tc.fs()},
+			}
+
+			// WHEN
+			got, err := ws.ReadReleaseManifest()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedRelease, got)
+		})
+	}
+}
+
 func TestWorkspace_ReadPipelineManifest(t *testing.T) {
 	copilotDir := "/copilot"
 	testCases := map[string]struct {